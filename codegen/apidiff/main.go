@@ -0,0 +1,369 @@
+// apidiff command compares the exported API of the generated packages of two
+// source trees (for example, the tree regenerated from the updated IDL files and
+// the previous release) and reports the added, removed and changed declarations
+// and operation numbers:
+//
+//	go run ./codegen/apidiff -old .cache/api-base/msrpc -new ./msrpc -o api-diff.md
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	oldDir string
+	newDir string
+	out    string
+	j      bool
+)
+
+func init() {
+	flag.StringVar(&oldDir, "old", "", "the base source tree")
+	flag.StringVar(&newDir, "new", "msrpc/", "the regenerated source tree")
+	flag.StringVar(&out, "o", "", "the report file (default is stdout)")
+	flag.BoolVar(&j, "j", false, "json output")
+	flag.Parse()
+}
+
+// API is the set of the exported declarations per package directory
+// (relative to the tree root), the declaration key is mapped to the
+// declaration signature.
+type API map[string]map[string]string
+
+// Change is the declaration change.
+type Change struct {
+	Key string `json:"key"`
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// PackageDiff is the package API difference.
+type PackageDiff struct {
+	Package string    `json:"package"`
+	Added   []*Change `json:"added,omitempty"`
+	Removed []*Change `json:"removed,omitempty"`
+	Changed []*Change `json:"changed,omitempty"`
+}
+
+func main() {
+
+	if oldDir == "" {
+		fmt.Fprintln(os.Stderr, "apidiff: -old directory is required")
+		os.Exit(2)
+	}
+
+	oldAPI, err := Load(oldDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "apidiff: load %s: %v\n", oldDir, err)
+		os.Exit(1)
+	}
+
+	newAPI, err := Load(newDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "apidiff: load %s: %v\n", newDir, err)
+		os.Exit(1)
+	}
+
+	w := io.Writer(os.Stdout)
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "apidiff: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	diff := Diff(oldAPI, newAPI)
+
+	if j {
+		b, _ := json.MarshalIndent(diff, "", "  ")
+		fmt.Fprintln(w, string(b))
+		return
+	}
+
+	Report(w, diff)
+}
+
+// Load function parses the non-test go files of the tree and returns
+// the exported API.
+func Load(root string) (API, error) {
+
+	api := API{}
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+
+		pkg, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+
+		pkg = filepath.ToSlash(pkg)
+		if api[pkg] == nil {
+			api[pkg] = map[string]string{}
+		}
+
+		collect(api[pkg], f)
+		return nil
+	})
+
+	return api, err
+}
+
+// collect function adds the exported declarations of the file `f`.
+func collect(decls map[string]string, f *ast.File) {
+
+	ops := map[string]*[2]string{}
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				if decl.Name.IsExported() {
+					decls["func "+decl.Name.Name] = "func " + decl.Name.Name + strings.TrimPrefix(node(decl.Type), "func")
+				}
+				continue
+			}
+			recv := recvName(decl.Recv.List[0].Type)
+			if strings.HasPrefix(recv, "xxx_") && strings.HasSuffix(recv, "Operation") {
+				// the operation name and number.
+				if lit, ok := returnLit(decl); ok {
+					if ops[recv] == nil {
+						ops[recv] = &[2]string{}
+					}
+					switch decl.Name.Name {
+					case "OpName":
+						ops[recv][0], _ = strconv.Unquote(lit)
+					case "OpNum":
+						ops[recv][1] = lit
+					}
+				}
+				continue
+			}
+			if ast.IsExported(recv) && decl.Name.IsExported() {
+				decls["method "+recv+"."+decl.Name.Name] = "func (" + recv + ") " + decl.Name.Name + strings.TrimPrefix(node(decl.Type), "func")
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if spec.Name.IsExported() {
+						collectType(decls, spec)
+					}
+				case *ast.ValueSpec:
+					for i, name := range spec.Names {
+						if !name.IsExported() {
+							continue
+						}
+						sig := decl.Tok.String() + " " + name.Name
+						if spec.Type != nil {
+							sig += " " + node(spec.Type)
+						}
+						if i < len(spec.Values) {
+							sig += " = " + node(spec.Values[i])
+						}
+						decls[decl.Tok.String()+" "+name.Name] = sig
+					}
+				}
+			}
+		}
+	}
+
+	for recv, op := range ops {
+		if op[0] != "" {
+			// the property get/put operations share the operation name.
+			key := "operation " + op[0] + " (" + strings.TrimSuffix(strings.TrimPrefix(recv, "xxx_"), "Operation") + ")"
+			decls[key] = key + " opnum " + op[1]
+		}
+	}
+}
+
+// collectType function adds the type declaration, the struct fields and
+// the interface methods.
+func collectType(decls map[string]string, spec *ast.TypeSpec) {
+
+	name := spec.Name.Name
+
+	switch typ := spec.Type.(type) {
+	case *ast.StructType:
+		decls["type "+name] = "type " + name + " struct"
+		for _, field := range typ.Fields.List {
+			for _, fname := range field.Names {
+				if fname.IsExported() {
+					decls["field "+name+"."+fname.Name] = fname.Name + " " + node(field.Type)
+				}
+			}
+			if len(field.Names) == 0 {
+				decls["field "+name+"."+node(field.Type)] = "embedded " + node(field.Type)
+			}
+		}
+	case *ast.InterfaceType:
+		decls["type "+name] = "type " + name + " interface"
+		for _, method := range typ.Methods.List {
+			for _, mname := range method.Names {
+				if mname.IsExported() {
+					decls["method "+name+"."+mname.Name] = mname.Name + strings.TrimPrefix(node(method.Type), "func")
+				}
+			}
+			if len(method.Names) == 0 {
+				decls["method "+name+"."+node(method.Type)] = "embedded " + node(method.Type)
+			}
+		}
+	default:
+		sig := "type " + name
+		if spec.Assign.IsValid() {
+			sig += " ="
+		}
+		decls["type "+name] = sig + " " + node(spec.Type)
+	}
+}
+
+// recvName function returns the receiver base type name.
+func recvName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return recvName(expr.X)
+	case *ast.IndexExpr:
+		return recvName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
+
+// returnLit function returns the literal returned by the single-statement
+// function.
+func returnLit(decl *ast.FuncDecl) (string, bool) {
+	if decl.Body == nil || len(decl.Body.List) != 1 {
+		return "", false
+	}
+	ret, ok := decl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok {
+		return "", false
+	}
+	return lit.Value, true
+}
+
+// node function returns the source representation of the node.
+func node(n ast.Node) string {
+	var b bytes.Buffer
+	printer.Fprint(&b, token.NewFileSet(), n)
+	return b.String()
+}
+
+// Diff function returns the differences between the APIs.
+func Diff(oldAPI, newAPI API) []*PackageDiff {
+
+	pkgs := map[string]struct{}{}
+	for pkg := range oldAPI {
+		pkgs[pkg] = struct{}{}
+	}
+	for pkg := range newAPI {
+		pkgs[pkg] = struct{}{}
+	}
+
+	ret := []*PackageDiff{}
+
+	for pkg := range pkgs {
+
+		diff := &PackageDiff{Package: pkg}
+
+		for key, sig := range newAPI[pkg] {
+			if old, ok := oldAPI[pkg][key]; !ok {
+				diff.Added = append(diff.Added, &Change{Key: key, New: sig})
+			} else if old != sig {
+				diff.Changed = append(diff.Changed, &Change{Key: key, Old: old, New: sig})
+			}
+		}
+
+		for key, sig := range oldAPI[pkg] {
+			if _, ok := newAPI[pkg][key]; !ok {
+				diff.Removed = append(diff.Removed, &Change{Key: key, Old: sig})
+			}
+		}
+
+		if len(diff.Added)+len(diff.Removed)+len(diff.Changed) == 0 {
+			continue
+		}
+
+		for _, changes := range [][]*Change{diff.Added, diff.Removed, diff.Changed} {
+			sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+		}
+
+		ret = append(ret, diff)
+	}
+
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Package < ret[j].Package })
+
+	return ret
+}
+
+// Report function writes the markdown report.
+func Report(w io.Writer, diff []*PackageDiff) {
+
+	fmt.Fprintln(w, "# API Changes")
+	fmt.Fprintln(w)
+
+	if len(diff) == 0 {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+
+	for _, pkg := range diff {
+		fmt.Fprintf(w, "## %s\n\n", pkg.Package)
+		for _, section := range []struct {
+			name    string
+			changes []*Change
+		}{
+			{"Added", pkg.Added},
+			{"Removed", pkg.Removed},
+			{"Changed", pkg.Changed},
+		} {
+			if len(section.changes) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "### %s\n\n", section.name)
+			for _, change := range section.changes {
+				switch {
+				case change.Old == "":
+					fmt.Fprintf(w, "- `%s`\n", change.New)
+				case change.New == "":
+					fmt.Fprintf(w, "- `%s`\n", change.Old)
+				default:
+					fmt.Fprintf(w, "- `%s`: `%s` -> `%s`\n", change.Key, change.Old, change.New)
+				}
+			}
+			fmt.Fprintln(w)
+		}
+	}
+}
@@ -0,0 +1,626 @@
+// compat command generates the compatibility shims for the declarations of the
+// generated packages that were renamed or retyped by the regeneration (type and
+// value aliases, and deprecated wrappers and field accessors), so that the
+// downstream code keeps compiling across the minor releases:
+//
+//	go run ./codegen/compat -f codegen/compat/compat.yaml -dir msrpc/ -version v1.2.0
+//
+// The shims are written into the compat.go file of the package directory. The
+// shims with the "until" version less or equal to the -version are dropped.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	fn      string
+	dir     string
+	version string
+)
+
+func init() {
+	flag.StringVar(&fn, "f", "codegen/compat/compat.yaml", "the mapping file")
+	flag.StringVar(&dir, "dir", "msrpc/", "the generation dir")
+	flag.StringVar(&version, "version", "", "the version being released")
+	flag.Parse()
+}
+
+const (
+	// The shim file name.
+	shimFile = "compat.go"
+	// The shim file header.
+	shimHeader = "// Code generated by codegen/compat; DO NOT EDIT."
+)
+
+// Mapping is the set of shims per package directory (relative to the
+// generation dir).
+type Mapping struct {
+	Packages map[string][]*Shim `yaml:"packages"`
+}
+
+// Shim is the renamed or retyped declaration.
+type Shim struct {
+	// The declaration kind: type, const, var, func, method, field.
+	Kind string `yaml:"kind"`
+	// The type name (for method and field).
+	Type string `yaml:"type,omitempty"`
+	// The previous name.
+	Old string `yaml:"old"`
+	// The current name (can be omitted for the retyped field).
+	New string `yaml:"new,omitempty"`
+	// The previous field type (for the retyped field).
+	OldType string `yaml:"oldType,omitempty"`
+	// The functions that convert the field value to and from the previous
+	// type (type conversion is used by default).
+	ToOld   string `yaml:"toOld,omitempty"`
+	FromOld string `yaml:"fromOld,omitempty"`
+	// The version the declaration was changed in.
+	Since string `yaml:"since,omitempty"`
+	// The version the shim is removed in (usually, next major).
+	Until string `yaml:"until,omitempty"`
+}
+
+func main() {
+
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "compat: %v\n", err)
+		os.Exit(1)
+	}
+
+	m := &Mapping{}
+	if err := yaml.Unmarshal(b, m); err != nil {
+		fmt.Fprintf(os.Stderr, "compat: parse %s: %v\n", fn, err)
+		os.Exit(1)
+	}
+
+	pkgs := make([]string, 0, len(m.Packages))
+	for pkg := range m.Packages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	written := map[string]struct{}{}
+
+	for _, pkg := range pkgs {
+
+		shims := []*Shim{}
+		for _, shim := range m.Packages[pkg] {
+			if shim.New == "" {
+				shim.New = shim.Old
+			}
+			if shim.Until != "" && version != "" && compareVersion(version, shim.Until) >= 0 {
+				fmt.Fprintf(os.Stderr, "compat: %s: %s %s: dropped in %s\n", pkg, shim.Kind, shim.Old, shim.Until)
+				continue
+			}
+			shims = append(shims, shim)
+		}
+
+		if len(shims) == 0 {
+			continue
+		}
+
+		p, err := Load(filepath.Join(dir, pkg))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "compat: load %s: %v\n", pkg, err)
+			os.Exit(1)
+		}
+
+		src, err := Generate(p, shims)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "compat: %s: %v\n", pkg, err)
+			os.Exit(1)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, pkg, shimFile), src, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "compat: %v\n", err)
+			os.Exit(1)
+		}
+
+		written[filepath.Join(dir, pkg, shimFile)] = struct{}{}
+	}
+
+	// remove the stale shims.
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != shimFile {
+			return err
+		}
+		if _, ok := written[path]; ok || !isShim(path) {
+			return nil
+		}
+		return os.Remove(path)
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "compat: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// isShim function returns `true` if the file was generated by the command.
+func isShim(path string) bool {
+	b, err := os.ReadFile(path)
+	return err == nil && bytes.HasPrefix(b, []byte(shimHeader))
+}
+
+// Package is the package declarations.
+type Package struct {
+	// The package name.
+	Name string
+	// The type declarations.
+	Types map[string]*Decl
+	// The constant, variable and function declarations.
+	Values map[string]*Decl
+	// The methods per receiver type name.
+	Methods map[string]map[string]*Decl
+}
+
+// Decl is the declaration and the file it was declared in.
+type Decl struct {
+	// The file (used to resolve the imports).
+	File *ast.File
+	// The declaration kind.
+	Kind string
+	// The type spec, value spec or function declaration.
+	Node ast.Node
+}
+
+// Load function parses the package directory (except the tests and the shims).
+func Load(path string) (*Package, error) {
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), path, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != shimFile
+	}, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected single package, got %d", len(pkgs))
+	}
+
+	p := &Package{
+		Types:   map[string]*Decl{},
+		Values:  map[string]*Decl{},
+		Methods: map[string]map[string]*Decl{},
+	}
+
+	for name, pkg := range pkgs {
+		p.Name = name
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Recv == nil {
+						p.Values[decl.Name.Name] = &Decl{File: f, Kind: "func", Node: decl}
+						continue
+					}
+					recv := recvName(decl.Recv.List[0].Type)
+					if p.Methods[recv] == nil {
+						p.Methods[recv] = map[string]*Decl{}
+					}
+					p.Methods[recv][decl.Name.Name] = &Decl{File: f, Kind: "method", Node: decl}
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						switch spec := spec.(type) {
+						case *ast.TypeSpec:
+							p.Types[spec.Name.Name] = &Decl{File: f, Kind: "type", Node: spec}
+						case *ast.ValueSpec:
+							for _, name := range spec.Names {
+								p.Values[name.Name] = &Decl{File: f, Kind: decl.Tok.String(), Node: spec}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// generator is the shim file builder.
+type generator struct {
+	p       *Package
+	buf     bytes.Buffer
+	imports map[string]string
+}
+
+// Generate function returns the formatted shim file source.
+func Generate(p *Package, shims []*Shim) ([]byte, error) {
+
+	g := &generator{p: p, imports: map[string]string{}}
+
+	var errs []error
+	for _, shim := range shims {
+		if err := g.shim(shim); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", shim.Kind, shim.Old, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "%s\n\npackage %s\n\n", shimHeader, p.Name)
+
+	if len(g.imports) > 0 {
+		names := make([]string, 0, len(g.imports))
+		for name := range g.imports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintln(&b, "import (")
+		for _, name := range names {
+			if path, _ := strconv.Unquote(g.imports[name]); path == name {
+				fmt.Fprintf(&b, "\t%s\n", g.imports[name])
+				continue
+			}
+			fmt.Fprintf(&b, "\t%s %s\n", name, g.imports[name])
+		}
+		fmt.Fprintln(&b, ")")
+	}
+
+	b.Write(g.buf.Bytes())
+
+	return format.Source(b.Bytes())
+}
+
+// deprecated function writes the deprecation comment.
+func (g *generator) deprecated(shim *Shim, use string) {
+	fmt.Fprintf(&g.buf, "//\n// Deprecated: Use %s instead", use)
+	if shim.Since != "" {
+		fmt.Fprintf(&g.buf, " (changed in %s)", shim.Since)
+	}
+	if shim.Until != "" {
+		fmt.Fprintf(&g.buf, ", will be removed in %s", shim.Until)
+	}
+	fmt.Fprintln(&g.buf, ".")
+}
+
+// shim function writes the shim for the declaration.
+func (g *generator) shim(shim *Shim) error {
+
+	switch shim.Kind {
+	case "type", "const", "var", "func":
+		if shim.Old == shim.New {
+			return fmt.Errorf("the name is not changed")
+		}
+		if _, ok := g.p.Types[shim.Old]; ok {
+			return fmt.Errorf("the name is declared")
+		}
+		if _, ok := g.p.Values[shim.Old]; ok {
+			return fmt.Errorf("the name is declared")
+		}
+	case "method", "field":
+		if _, ok := g.p.Types[shim.Type]; !ok {
+			return fmt.Errorf("type %s is not declared", shim.Type)
+		}
+	default:
+		return fmt.Errorf("unknown kind")
+	}
+
+	switch shim.Kind {
+	case "type":
+		decl, ok := g.p.Types[shim.New]
+		if !ok {
+			return fmt.Errorf("type %s is not declared", shim.New)
+		}
+		if decl.Node.(*ast.TypeSpec).TypeParams != nil {
+			return fmt.Errorf("generic type %s is not supported", shim.New)
+		}
+		fmt.Fprintf(&g.buf, "\n// %s is an alias for %s.\n", shim.Old, shim.New)
+		g.deprecated(shim, shim.New)
+		fmt.Fprintf(&g.buf, "type %s = %s\n", shim.Old, shim.New)
+	case "const", "var":
+		decl, ok := g.p.Values[shim.New]
+		if !ok || decl.Kind != shim.Kind {
+			return fmt.Errorf("%s %s is not declared", shim.Kind, shim.New)
+		}
+		fmt.Fprintf(&g.buf, "\n// %s is an alias for %s.\n", shim.Old, shim.New)
+		g.deprecated(shim, shim.New)
+		fmt.Fprintf(&g.buf, "%s %s = %s\n", shim.Kind, shim.Old, shim.New)
+	case "func":
+		decl, ok := g.p.Values[shim.New]
+		if !ok || decl.Kind != "func" {
+			return fmt.Errorf("func %s is not declared", shim.New)
+		}
+		fmt.Fprintf(&g.buf, "\n// %s function calls %s.\n", shim.Old, shim.New)
+		g.deprecated(shim, shim.New)
+		return g.wrapper(decl, "", shim.Old, shim.New)
+	case "method":
+		if _, ok := g.p.Types[shim.Type].Node.(*ast.TypeSpec).Type.(*ast.InterfaceType); ok {
+			return fmt.Errorf("interface %s methods cannot be shimmed", shim.Type)
+		}
+		if err := g.available(shim.Type, shim.Old); err != nil {
+			return err
+		}
+		decl, ok := g.p.Methods[shim.Type][shim.New]
+		if !ok {
+			return fmt.Errorf("method %s.%s is not declared", shim.Type, shim.New)
+		}
+		recv := shim.Type
+		if _, ok := decl.Node.(*ast.FuncDecl).Recv.List[0].Type.(*ast.StarExpr); ok {
+			recv = "*" + recv
+		}
+		fmt.Fprintf(&g.buf, "\n// %s function calls %s.\n", shim.Old, shim.New)
+		g.deprecated(shim, shim.New)
+		return g.wrapper(decl, recv, shim.Old, "o."+shim.New)
+	case "field":
+		return g.field(shim)
+	}
+
+	return nil
+}
+
+// available function checks that the method name is not declared for
+// the type.
+func (g *generator) available(typ, name string) error {
+	if _, ok := g.p.Methods[typ][name]; ok {
+		return fmt.Errorf("method %s.%s is declared", typ, name)
+	}
+	if st, ok := g.p.Types[typ].Node.(*ast.TypeSpec).Type.(*ast.StructType); ok {
+		for _, field := range st.Fields.List {
+			for _, fname := range field.Names {
+				if fname.Name == name {
+					return fmt.Errorf("field %s.%s is declared", typ, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isFunc function checks that the function is declared in the package.
+func (g *generator) isFunc(name string) error {
+	if decl, ok := g.p.Values[name]; !ok || decl.Kind != "func" {
+		return fmt.Errorf("func %s is not declared", name)
+	}
+	return nil
+}
+
+// field function writes the deprecated accessors for the renamed or retyped
+// field.
+func (g *generator) field(shim *Shim) error {
+
+	decl := g.p.Types[shim.Type]
+
+	st, ok := decl.Node.(*ast.TypeSpec).Type.(*ast.StructType)
+	if !ok {
+		return fmt.Errorf("type %s is not a struct", shim.Type)
+	}
+
+	var typ ast.Expr
+	for _, field := range st.Fields.List {
+		for _, fname := range field.Names {
+			if fname.Name == shim.New {
+				typ = field.Type
+			}
+		}
+	}
+
+	if typ == nil {
+		return fmt.Errorf("field %s.%s is not declared", shim.Type, shim.New)
+	}
+
+	if shim.Old == shim.New && shim.OldType == "" {
+		return fmt.Errorf("the name and the type are not changed")
+	}
+
+	get, set := "Get"+shim.Old, "Set"+shim.Old
+	for _, name := range []string{get, set} {
+		if err := g.available(shim.Type, name); err != nil {
+			return err
+		}
+	}
+
+	if err := g.resolve(decl.File, typ); err != nil {
+		return err
+	}
+
+	newType, oldType, getVal, setVal := node(typ), node(typ), "o."+shim.New, "v"
+
+	if shim.OldType != "" {
+		expr, err := parser.ParseExpr(shim.OldType)
+		if err != nil {
+			return fmt.Errorf("parse old type: %w", err)
+		}
+		if err := g.resolve(decl.File, expr); err != nil {
+			return err
+		}
+		oldType = node(expr)
+		getVal, setVal = "("+oldType+")(o."+shim.New+")", "("+newType+")(v)"
+	}
+
+	if shim.ToOld != "" {
+		if err := g.isFunc(shim.ToOld); err != nil {
+			return err
+		}
+		getVal = shim.ToOld + "(o." + shim.New + ")"
+	}
+
+	if shim.FromOld != "" {
+		if err := g.isFunc(shim.FromOld); err != nil {
+			return err
+		}
+		setVal = shim.FromOld + "(v)"
+	}
+
+	use := shim.New + " field"
+
+	fmt.Fprintf(&g.buf, "\n// %s function returns the %s field value.\n", get, shim.New)
+	g.deprecated(shim, use)
+	fmt.Fprintf(&g.buf, "func (o *%s) %s() %s {\n\treturn %s\n}\n", shim.Type, get, oldType, getVal)
+
+	fmt.Fprintf(&g.buf, "\n// %s function sets the %s field value.\n", set, shim.New)
+	g.deprecated(shim, use)
+	fmt.Fprintf(&g.buf, "func (o *%s) %s(v %s) {\n\to.%s = %s\n}\n", shim.Type, set, oldType, shim.New, setVal)
+
+	return nil
+}
+
+// wrapper function writes the function `name` with the signature of the
+// declaration `decl` that calls `target`.
+func (g *generator) wrapper(decl *Decl, recv, name, target string) error {
+
+	fd := decl.Node.(*ast.FuncDecl)
+
+	if fd.Type.TypeParams != nil {
+		return fmt.Errorf("generic function %s is not supported", fd.Name.Name)
+	}
+
+	if err := g.resolve(decl.File, fd.Type); err != nil {
+		return err
+	}
+
+	var params, args, results []string
+
+	for i, field := range fd.Type.Params.List {
+		names := []string{}
+		for _, pname := range field.Names {
+			names = append(names, pname.Name)
+		}
+		if len(names) == 0 {
+			names = append(names, "")
+		}
+		for j := range names {
+			if names[j] == "" || names[j] == "_" || names[j] == "o" {
+				names[j] = "p" + strconv.Itoa(i) + strconv.Itoa(j)
+			}
+			params = append(params, names[j]+" "+node(field.Type))
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				names[j] += "..."
+			}
+			args = append(args, names[j])
+		}
+	}
+
+	if fd.Type.Results != nil {
+		for _, field := range fd.Type.Results.List {
+			for i := 0; i < max(1, len(field.Names)); i++ {
+				results = append(results, node(field.Type))
+			}
+		}
+	}
+
+	fmt.Fprint(&g.buf, "func ")
+	if recv != "" {
+		fmt.Fprintf(&g.buf, "(o %s) ", recv)
+	}
+	fmt.Fprintf(&g.buf, "%s(%s)", name, strings.Join(params, ", "))
+
+	switch len(results) {
+	case 0:
+		fmt.Fprintf(&g.buf, " {\n\t%s(%s)\n}\n", target, strings.Join(args, ", "))
+		return nil
+	case 1:
+		fmt.Fprintf(&g.buf, " %s", results[0])
+	default:
+		fmt.Fprintf(&g.buf, " (%s)", strings.Join(results, ", "))
+	}
+
+	fmt.Fprintf(&g.buf, " {\n\treturn %s(%s)\n}\n", target, strings.Join(args, ", "))
+
+	return nil
+}
+
+// resolve function adds the imports of the file `f` referenced by the node.
+func (g *generator) resolve(f *ast.File, n ast.Node) error {
+
+	var err error
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		x, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		for _, spec := range f.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			if name := importName(spec, path); name == x.Name {
+				g.imports[name] = spec.Path.Value
+				return false
+			}
+		}
+		err = fmt.Errorf("cannot resolve the package %s", x.Name)
+		return false
+	})
+
+	return err
+}
+
+// importName function returns the name the package is referenced by.
+func importName(spec *ast.ImportSpec, path string) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// recvName function returns the receiver base type name.
+func recvName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return recvName(expr.X)
+	case *ast.IndexExpr:
+		return recvName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
+
+// node function returns the source representation of the node.
+func node(n ast.Node) string {
+	var b bytes.Buffer
+	printer.Fprint(&b, token.NewFileSet(), n)
+	return b.String()
+}
+
+// compareVersion function compares the semantic versions (vMAJOR.MINOR.PATCH,
+// the pre-release and build suffixes are ignored).
+func compareVersion(a, b string) int {
+	va, vb := parseVersion(a), parseVersion(b)
+	for i := range va {
+		if va[i] != vb[i] {
+			if va[i] < vb[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion function returns the major, minor and patch version numbers.
+func parseVersion(v string) [3]int {
+	var ret [3]int
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	for i, part := range strings.SplitN(v, ".", 3) {
+		ret[i], _ = strconv.Atoi(part)
+	}
+	return ret
+}
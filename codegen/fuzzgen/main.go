@@ -0,0 +1,158 @@
+// fuzzgen command generates the list of the request and response types of the
+// generated packages used by the decode fuzzers (msrpc/fuzz):
+//
+//	go run ./codegen/fuzzgen -dir msrpc/ -o msrpc/fuzz/types.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	dir    string
+	out    string
+	module string
+)
+
+func init() {
+	flag.StringVar(&dir, "dir", "msrpc/", "the generation dir")
+	flag.StringVar(&out, "o", "msrpc/fuzz/types.go", "the output file")
+	flag.StringVar(&module, "I", "github.com/oiweiwei/go-msrpc/msrpc", "the generation dir import path")
+	flag.Parse()
+}
+
+// Package is the generated package with the request and response types.
+type Package struct {
+	// The import path.
+	Path string
+	// The import alias.
+	Alias string
+	// The package name.
+	Name string
+	// The request and response type names.
+	Types []string
+}
+
+func main() {
+
+	pkgs, err := Load(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fuzzgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	src, err := Generate(pkgs, filepath.Base(filepath.Dir(out)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fuzzgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(out, src, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "fuzzgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Load function returns the packages with the request and response types
+// (the types with Request or Response suffix that implement UnmarshalNDR).
+func Load(root string) ([]*Package, error) {
+
+	byDir := map[string]*Package{}
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), p, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Name.Name != "UnmarshalNDR" {
+				continue
+			}
+			star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv, ok := star.X.(*ast.Ident)
+			if !ok || !recv.IsExported() || !(strings.HasSuffix(recv.Name, "Request") || strings.HasSuffix(recv.Name, "Response")) {
+				continue
+			}
+			rel, err := filepath.Rel(root, filepath.Dir(p))
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(rel)
+			if byDir[rel] == nil {
+				byDir[rel] = &Package{
+					Path:  path.Join(module, rel),
+					Alias: strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(rel),
+					Name:  rel,
+				}
+			}
+			byDir[rel].Types = append(byDir[rel].Types, recv.Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs := make([]*Package, 0, len(byDir))
+	for _, pkg := range byDir {
+		sort.Strings(pkg.Types)
+		pkgs = append(pkgs, pkg)
+	}
+
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Path < pkgs[j].Path })
+
+	return pkgs, nil
+}
+
+// Generate function returns the formatted types list source.
+func Generate(pkgs []*Package, name string) ([]byte, error) {
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by codegen/fuzzgen; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintf(&b, "package %s\n\n", name)
+	fmt.Fprintln(&b, "import (")
+	fmt.Fprintln(&b, "\tndr \"github.com/oiweiwei/go-msrpc/ndr\"")
+	fmt.Fprintln(&b)
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "\t%s %q\n", pkg.Alias, pkg.Path)
+	}
+	fmt.Fprintln(&b, ")")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// Types is the list of the request and response types of the generated packages.")
+	fmt.Fprintln(&b, "var Types = []Type{")
+	for _, pkg := range pkgs {
+		for _, typ := range pkg.Types {
+			fmt.Fprintf(&b, "\t{%q, func() ndr.Unmarshaler { return new(%s.%s) }},\n", pkg.Name+"."+typ, pkg.Alias, typ)
+		}
+	}
+	fmt.Fprintln(&b, "}")
+
+	return format.Source(b.Bytes())
+}
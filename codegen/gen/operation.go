@@ -156,6 +156,8 @@ func (p *Generator) GenOperationUnmarshalNDR(ctx context.Context, op *midl.Opera
 
 		pp := p.NewTypeGenerator(ctx, strct)
 
+		fields, fieldScopes := []*midl.Field{}, []*Scopes{}
+
 		for _, param := range p.OperationParams(ctx, op) {
 
 			if !p.IsDir(ctx, param.Attrs.Direction, dir) || param.IsHandle() {
@@ -183,6 +185,14 @@ func (p *Generator) GenOperationUnmarshalNDR(ctx context.Context, op *midl.Opera
 			}
 			p.P("}")
 
+			fields, fieldScopes = append(fields, field), append(fieldScopes, scopes)
+		}
+
+		// check the range and size constraints (the deferred pointees
+		// are already decoded).
+		strct.Elem.Struct.Fields = fields
+		for i := range fields {
+			pp.GenFieldUnmarshalNDRConstraints(ctx, fields[i], fieldScopes[i], true)
 		}
 
 		p.P("return nil")
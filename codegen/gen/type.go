@@ -220,6 +220,14 @@ ranged_loop:
 		// process range statement for array.
 		for scopes := NewScopes(field.Scopes()); scopes != nil; scopes = scopes.Next() {
 			if scopes.Is(midl.TypeArray) {
+				if scopes.Dim().IsString || field.Attrs.Format.MultiSize {
+					// the string length is checked in the wire units, the same
+					// as it is checked on decode.
+					p.If(p.DataLen(ctx, field, scopes, fL), ">", p.B("uint64", rng.Max), func() {
+						p.P(`return fmt.Errorf("` + p.GoFieldName(field) + ` is out of range")`)
+					})
+					continue ranged_loop
+				}
 				p.If(p.Len(fL), ">", p.B("int", rng.Max), func() {
 					p.P(`return fmt.Errorf("` + p.GoFieldName(field) + ` is out of range")`)
 				})
@@ -17,6 +17,10 @@ func (p *TypeGenerator) GenStructUnmarshalNDR(ctx context.Context) {
 		for _, field := range p.Struct().Fields {
 			p.GenFieldUnmarshalNDR(ctx, field, NewScopes(field.Scopes()))
 		}
+		// check the range and size constraints.
+		for _, field := range p.Struct().Fields {
+			p.GenFieldUnmarshalNDRConstraints(ctx, field, NewScopes(field.Scopes()), false)
+		}
 		if p.Scope().Pad != 0 {
 			p.P("//", "pad", p.Scope().Pad)
 			p.GenDoAlignmentUnmarshalNDR(ctx, int(p.Scope().Pad))
@@ -26,6 +30,57 @@ func (p *TypeGenerator) GenStructUnmarshalNDR(ctx context.Context) {
 
 }
 
+// GenFieldUnmarshalNDRConstraints function generates the range() and size_is()
+// checks for the decoded field. The pointees are checked only if `deferred`
+// is set, since for the structure fields they are decoded after the structure.
+func (p *TypeGenerator) GenFieldUnmarshalNDRConstraints(ctx context.Context, field *midl.Field, scopes *Scopes, deferred bool) {
+
+	if scopes == nil || field.Attrs.Ignore || p.GoFieldName(field) == "_" {
+		return
+	}
+
+	n, isPtr := p.O(p.GoFieldName(field)), false
+	for ; scopes != nil && scopes.Is(midl.TypePointer); scopes = scopes.Next() {
+		isPtr = true
+	}
+
+	if scopes == nil || (isPtr && !deferred) {
+		return
+	}
+
+	rng := field.Attrs.Range
+
+	if scopes.Is(midl.TypeArray) {
+		if rng != nil {
+			// the string length is checked in the wire units (including
+			// the terminator), the same as it is marshaled.
+			p.CheckErr(p.B("ndr.CheckRange", p.Q(p.GoFieldName(field)), p.DataLen(ctx, field, scopes, n, ""), 0, rng.Max))
+		}
+		// check that the number of elements does not exceed the size_is.
+		if dim := scopes.Dim(); dim.IsString || field.Attrs.Format.MultiSize || !dim.SizeIs.IsIdent() {
+			return
+		}
+		if szField := p.LookupExprField(ctx, scopes.Dim().SizeIs); szField != nil && isIntegerType(p.GoFieldTypeName(ctx, p.Scope(), szField)) {
+			sz := scopes.Dim().SizeIs
+			p.CheckErr(p.B("ndr.CheckSize", p.Q(p.GoFieldName(field)), p.Len(n), sz.Expression(p.EO), p.Q(GoName(szField.Name))))
+		}
+		return
+	}
+
+	if rng != nil && !isPtr && isIntegerType(p.GoFieldTypeName(ctx, p.Scope(), field)) {
+		p.CheckErr(p.B("ndr.CheckRange", p.Q(p.GoFieldName(field)), n, rng.Min, rng.Max))
+	}
+}
+
+// isIntegerType function returns true if the go type `t` is the integer type.
+func isIntegerType(t string) bool {
+	switch t {
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64", "byte", "rune":
+		return true
+	}
+	return false
+}
+
 func (p *TypeGenerator) GenStructUnmarshalNDRSizePreamble(ctx context.Context, field *midl.Field) {
 	if !p.IsConformant() {
 		return
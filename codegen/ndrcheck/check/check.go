@@ -0,0 +1,402 @@
+// The check package verifies the NDR consistency of the generated types: the
+// zero value and the randomly filled values of every type are marshaled,
+// unmarshaled and marshaled again, and the encodings and the decoded values
+// must be equal.
+//
+// The package is used by the program generated by codegen/ndrcheck.
+package check
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// Value is the generated type value.
+type Value interface {
+	ndr.Marshaler
+	ndr.Unmarshaler
+}
+
+// Type is the generated type constructor.
+type Type struct {
+	// The type name (package path relative to the generation dir
+	// and the type name, for example, "eerr/extendederror/v1.ExtendedErrorInfo").
+	Name string
+	// The constructor.
+	New func() Value
+}
+
+// Union is the generated union type with the arm constructors.
+type Union struct {
+	// The union constructor.
+	New func() any
+	// The arm constructors.
+	Arms []func() any
+}
+
+// Config is the consistency check configuration.
+type Config struct {
+	// The random seed.
+	Seed int64
+	// The number of the randomly filled values per type.
+	N int
+	// The maximum depth of the pointers and arrays.
+	MaxDepth int
+	// The maximum length of the arrays and strings.
+	MaxLen int
+	// Check the NDR64 encoding in addition to NDR2.0.
+	NDR64 bool
+	// The union types, the unions without arm constructors are
+	// marshaled with the default discriminant.
+	Unions []Union
+}
+
+// DefaultConfig is the default consistency check configuration.
+var DefaultConfig = Config{
+	Seed:     1,
+	N:        4,
+	MaxDepth: 4,
+	MaxLen:   4,
+}
+
+// Status is the check status.
+type Status string
+
+var (
+	// The value was encoded and decoded consistently.
+	StatusOK Status = "ok"
+	// The value was rejected by the marshaler (for example, the union
+	// discriminant does not select any arm).
+	StatusSkip Status = "skip"
+	// The value was encoded inconsistently.
+	StatusFail Status = "fail"
+)
+
+// Result is the check result for single value.
+type Result struct {
+	// The type name.
+	Type string `json:"type"`
+	// The value ("zero" or "fuzz#<n>").
+	Case string `json:"case"`
+	// The transfer syntax ("ndr20" or "ndr64").
+	Syntax string `json:"syntax"`
+	// The check status.
+	Status Status `json:"status"`
+	// The failed stage ("marshal", "unmarshal", "remarshal", "compare").
+	Stage string `json:"stage,omitempty"`
+	// The error.
+	Err string `json:"error,omitempty"`
+}
+
+func (r *Result) String() string {
+	if r.Status == StatusOK {
+		return fmt.Sprintf("%s %s %s: %s", r.Status, r.Type, r.Case, r.Syntax)
+	}
+	return fmt.Sprintf("%s %s %s: %s: %s: %s", r.Status, r.Type, r.Case, r.Syntax, r.Stage, r.Err)
+}
+
+type syntax struct {
+	name      string
+	marshal   func(ndr.Marshaler, ...any) ([]byte, error)
+	unmarshal func([]byte, ndr.Unmarshaler, ...any) error
+}
+
+var (
+	ndr20 = syntax{"ndr20", ndr.Marshal, ndr.Unmarshal}
+	ndr64 = syntax{"ndr64", ndr.Marshal64, ndr.Unmarshal64}
+)
+
+// Check function checks the zero value and `cfg.N` randomly filled values of
+// the type `typ`.
+func Check(typ Type, cfg *Config) []*Result {
+
+	if cfg == nil {
+		cfg = &DefaultConfig
+	}
+
+	syntaxes := []syntax{ndr20}
+	if cfg.NDR64 {
+		syntaxes = append(syntaxes, ndr64)
+	}
+
+	f := &filler{
+		rnd:      rand.New(rand.NewSource(cfg.Seed)),
+		maxDepth: cfg.MaxDepth,
+		maxLen:   cfg.MaxLen,
+		arms:     make(map[reflect.Type][]func() any),
+		active:   make(map[reflect.Type]int),
+	}
+
+	for _, u := range cfg.Unions {
+		f.arms[reflect.TypeOf(u.New()).Elem()] = u.Arms
+	}
+
+	var ret []*Result
+
+	for i := 0; i <= cfg.N; i++ {
+		c := "zero"
+		if i > 0 {
+			c = fmt.Sprintf("fuzz#%d", i)
+		}
+		for _, s := range syntaxes {
+			v := typ.New()
+			if i > 0 {
+				// the same value for all transfer syntaxes.
+				f.rnd.Seed(cfg.Seed + int64(i))
+				f.fill(reflect.ValueOf(v).Elem(), 0)
+			}
+			res := check(typ, v, s)
+			res.Type, res.Case, res.Syntax = typ.Name, c, s.name
+			ret = append(ret, res)
+		}
+	}
+
+	return ret
+}
+
+// check function verifies that the value `v` is encoded consistently.
+func check(typ Type, v Value, s syntax) *Result {
+
+	b1, err := marshal(s, v)
+	if err != nil {
+		if errors.Is(err, ndr.ErrPanic) {
+			return fail("marshal", err)
+		}
+		return &Result{Status: StatusSkip, Stage: "marshal", Err: err.Error()}
+	}
+
+	d1 := typ.New()
+	if err := unmarshal(s, b1, d1); err != nil {
+		return fail("unmarshal", err)
+	}
+
+	b2, err := marshal(s, d1)
+	if err != nil {
+		return fail("remarshal", err)
+	}
+
+	if !bytes.Equal(b1, b2) {
+		return fail("compare", fmt.Errorf("encoding mismatch at offset %d (length %d, %d)", mismatch(b1, b2), len(b1), len(b2)))
+	}
+
+	d2 := typ.New()
+	if err := unmarshal(s, b2, d2); err != nil {
+		return fail("unmarshal", err)
+	}
+
+	if !reflect.DeepEqual(d1, d2) {
+		return fail("compare", fmt.Errorf("decoded value mismatch"))
+	}
+
+	return &Result{Status: StatusOK}
+}
+
+func fail(stage string, err error) *Result {
+	return &Result{Status: StatusFail, Stage: stage, Err: err.Error()}
+}
+
+func marshal(s syntax, v Value) (b []byte, err error) {
+	defer ndr.Recover(&err)
+	return s.marshal(v)
+}
+
+func unmarshal(s syntax, b []byte, v Value) (err error) {
+	defer ndr.Recover(&err)
+	return s.unmarshal(b, v)
+}
+
+// mismatch function returns the offset of the first differing byte.
+func mismatch(b1, b2 []byte) int {
+	for i := 0; i < len(b1) && i < len(b2); i++ {
+		if b1[i] != b2[i] {
+			return i
+		}
+	}
+	return min(len(b1), len(b2))
+}
+
+// filler fills the values with the random data.
+type filler struct {
+	rnd      *rand.Rand
+	maxDepth int
+	maxLen   int
+	arms     map[reflect.Type][]func() any
+	active   map[reflect.Type]int
+}
+
+const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// fill function fills the value `v` with the random data. The union value
+// is set to the random arm, the other interfaces are left intact, the pointers
+// and arrays are filled up to the maximum depth.
+func (f *filler) fill(v reflect.Value, depth int) {
+
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(f.rnd.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(f.rnd.Int63())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(f.rnd.Uint64())
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(f.rnd.Int31()) / 16)
+	case reflect.String:
+		b := make([]byte, f.rnd.Intn(f.maxLen+1))
+		for i := range b {
+			b[i] = letters[f.rnd.Intn(len(letters))]
+		}
+		v.SetString(string(b))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			f.fill(v.Index(i), depth)
+		}
+	case reflect.Slice:
+		if depth >= f.maxDepth {
+			return
+		}
+		n := 1 + f.rnd.Intn(f.maxLen)
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := 0; i < n; i++ {
+			f.fill(v.Index(i), depth+1)
+		}
+	case reflect.Pointer:
+		elem := v.Type().Elem()
+		if _, ok := f.arms[elem]; !ok && (depth >= 4*f.maxDepth || (depth >= f.maxDepth && f.active[elem] > 0)) {
+			// the embedded structures are marshaled as zero values when
+			// nil, so only the recursive types are cut at the maximum depth.
+			// the unions are always filled, since the default discriminant
+			// may select no arm.
+			return
+		}
+		f.active[elem]++
+		v.Set(reflect.New(elem))
+		f.fill(v.Elem(), depth+1)
+		f.active[elem]--
+	case reflect.Struct:
+		if arms, ok := f.arms[v.Type()]; ok {
+			f.fillUnion(v, arms, depth)
+			return
+		}
+		f.fillStruct(v, depth)
+	}
+}
+
+// fillUnion function sets the union value to the random arm.
+func (f *filler) fillUnion(v reflect.Value, arms []func() any, depth int) {
+
+	if len(arms) == 0 {
+		return
+	}
+
+	arm := reflect.ValueOf(arms[f.rnd.Intn(len(arms))]())
+	f.fill(arm.Elem(), depth+1)
+
+	if fld := v.FieldByName("Value"); fld.IsValid() && arm.Type().AssignableTo(fld.Type()) {
+		fld.Set(arm)
+	}
+}
+
+// fillStruct function fills the exported fields of the structure and resets
+// the fields referenced by the size_is and length_is attributes, so that the
+// sizes are derived from the filled arrays. The discriminants are set to the
+// switch values of the filled unions.
+func (f *filler) fillStruct(v reflect.Value, depth int) {
+
+	typ := v.Type()
+
+	names, refs, switches := make(map[string]int), make(map[string]bool), make(map[string]reflect.Value)
+
+	for i := 0; i < typ.NumField(); i++ {
+		fld := typ.Field(i)
+		if !fld.IsExported() {
+			continue
+		}
+		f.fill(v.Field(i), depth)
+		name, attrs := parseTag(fld.Tag.Get("idl"))
+		if name != "" {
+			names[name] = i
+		}
+		for _, attr := range refAttrs {
+			for _, ref := range identRe.FindAllString(attrs[attr], -1) {
+				refs[ref] = true
+			}
+		}
+		if sw := attrs["switch_is"]; sw != "" {
+			if switchRe.MatchString(sw) {
+				switches[strings.TrimPrefix(sw, "*")] = v.Field(i)
+			} else {
+				// the discriminant expression, use the default one.
+				for _, ref := range identRe.FindAllString(sw, -1) {
+					refs[ref] = true
+				}
+			}
+		}
+	}
+
+	for ref := range refs {
+		if i, ok := names[ref]; ok {
+			v.Field(i).Set(reflect.Zero(v.Field(i).Type()))
+		}
+	}
+
+	for ref, u := range switches {
+		if i, ok := names[ref]; ok && !refs[ref] {
+			setSwitch(v.Field(i), u)
+		}
+	}
+}
+
+// setSwitch function sets the discriminant `sw` to the switch value of
+// the union `u`.
+func setSwitch(sw, u reflect.Value) {
+
+	sw.Set(reflect.Zero(sw.Type()))
+
+	if u.Kind() != reflect.Pointer || u.IsNil() {
+		return
+	}
+
+	m := u.MethodByName("NDRSwitchValue")
+	if !m.IsValid() || m.Type().NumIn() != 1 || m.Type().NumOut() != 1 {
+		return
+	}
+
+	r := m.Call([]reflect.Value{reflect.Zero(m.Type().In(0))})[0]
+
+	switch {
+	case sw.CanInt() && r.CanInt():
+		sw.SetInt(r.Int())
+	case sw.CanInt() && r.CanUint():
+		sw.SetInt(int64(r.Uint()))
+	case sw.CanUint() && r.CanInt():
+		sw.SetUint(uint64(r.Int()))
+	case sw.CanUint() && r.CanUint():
+		sw.SetUint(r.Uint())
+	}
+}
+
+// refAttrs is the list of the attributes that reference the other fields.
+var refAttrs = []string{"size_is", "length_is", "max_is", "min_is", "first_is", "last_is"}
+
+var (
+	identRe  = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+	switchRe = regexp.MustCompile(`^\*?[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// parseTag function returns the IDL name and the attributes of the idl tag.
+func parseTag(tag string) (string, map[string]string) {
+	attrs := make(map[string]string)
+	for _, attr := range strings.Split(tag, ";") {
+		if k, v, ok := strings.Cut(attr, ":"); ok {
+			attrs[k] = v
+		}
+	}
+	return attrs["name"], attrs
+}
@@ -0,0 +1,156 @@
+package check
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+)
+
+// Report is the consistency check report.
+type Report struct {
+	// The number of the checked types.
+	Types int `json:"types"`
+	// The number of the consistently encoded values.
+	OK int `json:"ok"`
+	// The number of the values rejected by the marshaler.
+	Skipped int `json:"skipped"`
+	// The number of the inconsistently encoded values.
+	Failed int `json:"failed"`
+	// The failed (and skipped, if verbose) results.
+	Results []*Result `json:"results,omitempty"`
+}
+
+// Run function checks the types and returns the report. The skipped results
+// are included into the report if `verbose` is set.
+func Run(types []Type, cfg *Config, verbose bool) *Report {
+
+	r := &Report{Types: len(types)}
+
+	for _, typ := range types {
+		for _, res := range Check(typ, cfg) {
+			switch res.Status {
+			case StatusOK:
+				r.OK++
+				continue
+			case StatusSkip:
+				if r.Skipped++; !verbose {
+					continue
+				}
+			case StatusFail:
+				r.Failed++
+			}
+			r.Results = append(r.Results, res)
+		}
+	}
+
+	sort.SliceStable(r.Results, func(i, j int) bool {
+		return r.Results[i].Status == StatusFail && r.Results[j].Status != StatusFail
+	})
+
+	return r
+}
+
+// WriteTo function writes the text report.
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+
+	var n int64
+
+	for _, res := range r.Results {
+		m, err := fmt.Fprintln(w, res)
+		if n += int64(m); err != nil {
+			return n, err
+		}
+	}
+
+	m, err := fmt.Fprintf(w, "types: %d, ok: %d, skipped: %d, failed: %d\n", r.Types, r.OK, r.Skipped, r.Failed)
+	return n + int64(m), err
+}
+
+// Main function is the entrypoint of the generated check program. The
+// program exits with the non-zero code if any value is encoded inconsistently.
+func Main(types []Type, unions []Union) {
+
+	var (
+		cfg     = DefaultConfig
+		out     string
+		run     string
+		skip    string
+		j       bool
+		verbose bool
+	)
+
+	flag.Int64Var(&cfg.Seed, "seed", cfg.Seed, "the random seed")
+	flag.IntVar(&cfg.N, "n", cfg.N, "the number of the randomly filled values per type")
+	flag.IntVar(&cfg.MaxDepth, "depth", cfg.MaxDepth, "the maximum depth of the pointers and arrays")
+	flag.IntVar(&cfg.MaxLen, "len", cfg.MaxLen, "the maximum length of the arrays and strings")
+	flag.BoolVar(&cfg.NDR64, "ndr64", false, "check the NDR64 encoding")
+	flag.StringVar(&run, "run", "", "check only the types matching the regular expression")
+	flag.StringVar(&skip, "skip", "", "do not check the types matching the regular expression")
+	flag.StringVar(&out, "o", "", "the report file (default is stdout)")
+	flag.BoolVar(&j, "j", false, "json output")
+	flag.BoolVar(&verbose, "v", false, "report the skipped values")
+	flag.Parse()
+
+	runRe, err := compile(run)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	skipRe, err := compile(skip)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	filtered := types[:0:0]
+	for _, typ := range types {
+		if (runRe == nil || runRe.MatchString(typ.Name)) && (skipRe == nil || !skipRe.MatchString(typ.Name)) {
+			filtered = append(filtered, typ)
+		}
+	}
+
+	cfg.Unions = unions
+
+	report := Run(filtered, &cfg, verbose)
+
+	w := io.Writer(os.Stdout)
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+			os.Exit(2)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if j {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		_, err = report.WriteTo(w)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	if report.Failed > 0 {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %d inconsistently encoded values\n", report.Failed)
+		os.Exit(1)
+	}
+}
+
+// compile function compiles the non-empty regular expression.
+func compile(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
@@ -0,0 +1,243 @@
+// ndrcheck command verifies the NDR consistency of the generated packages:
+// the zero value and the randomly filled values of every type are marshaled,
+// unmarshaled and marshaled again (see codegen/ndrcheck/check package), and
+// the inconsistently encoded types (the generator bugs) are reported:
+//
+//	go run ./codegen/ndrcheck -dir msrpc/ -o ndrcheck.txt -- -n 8 -ndr64
+//
+// Since the types must be compiled in, the command generates the check
+// program into the work dir and runs it; the arguments after "--" are passed
+// to the check program (see check.Main).
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	dir    string
+	out    string
+	work   string
+	module string
+)
+
+func init() {
+	flag.StringVar(&dir, "dir", "msrpc/", "the generation dir")
+	flag.StringVar(&out, "o", "", "the report file (default is stdout)")
+	flag.StringVar(&work, "work", ".cache/ndrcheck", "the check program dir")
+	flag.StringVar(&module, "I", "github.com/oiweiwei/go-msrpc/msrpc", "the generation dir import path")
+	flag.Parse()
+}
+
+// Package is the generated package with the NDR types.
+type Package struct {
+	// The import path.
+	Path string
+	// The import alias.
+	Alias string
+	// The package name.
+	Name string
+	// The type names.
+	Types []string
+	// The union arm type names.
+	Unions map[string][]string
+}
+
+func main() {
+
+	pkgs, err := Load(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	src, err := Generate(pkgs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	if err := os.MkdirAll(work, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	if err := os.WriteFile(filepath.Join(work, "main.go"), src, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	args := []string{"run", "./" + filepath.ToSlash(filepath.Clean(work))}
+	if out != "" {
+		args = append(args, "-o", out)
+	}
+
+	cmd := exec.Command("go", append(args, flag.Args()...)...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		if exit, ok := err.(*exec.ExitError); ok {
+			os.Exit(exit.ExitCode())
+		}
+		fmt.Fprintf(os.Stderr, "ndrcheck: %v\n", err)
+		os.Exit(2)
+	}
+}
+
+// Load function returns the packages with the types that implement both
+// MarshalNDR and UnmarshalNDR, and the union types (the types that implement
+// NDRSwitchValue) with the arm types.
+func Load(root string) ([]*Package, error) {
+
+	byDir := map[string]*Package{}
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), p, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+
+		methods, unions, arms := map[string]int{}, map[string]bool{}, map[string][]string{}
+
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil {
+				continue
+			}
+			star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv, ok := star.X.(*ast.Ident)
+			if !ok || !recv.IsExported() {
+				continue
+			}
+			switch name := fd.Name.Name; {
+			case name == "MarshalNDR" || name == "UnmarshalNDR":
+				methods[recv.Name]++
+			case name == "NDRSwitchValue":
+				unions[recv.Name] = true
+			case strings.HasPrefix(name, "is_"):
+				// the union arm marker method.
+				arms[strings.TrimPrefix(name, "is_")] = append(arms[strings.TrimPrefix(name, "is_")], recv.Name)
+			}
+		}
+
+		rel, err := filepath.Rel(root, filepath.Dir(p))
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		pkg := byDir[rel]
+		if pkg == nil {
+			pkg = &Package{
+				Path:   path.Join(module, rel),
+				Alias:  strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(rel),
+				Name:   rel,
+				Unions: map[string][]string{},
+			}
+		}
+
+		for typ, n := range methods {
+			if n == 2 {
+				pkg.Types = append(pkg.Types, typ)
+			}
+		}
+
+		for typ := range unions {
+			pkg.Unions[typ] = append(pkg.Unions[typ], arms[typ]...)
+		}
+
+		if len(pkg.Types) > 0 {
+			byDir[rel] = pkg
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs := make([]*Package, 0, len(byDir))
+	for _, pkg := range byDir {
+		sort.Strings(pkg.Types)
+		for _, arms := range pkg.Unions {
+			sort.Strings(arms)
+		}
+		pkgs = append(pkgs, pkg)
+	}
+
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Path < pkgs[j].Path })
+
+	return pkgs, nil
+}
+
+// Generate function returns the formatted check program source.
+func Generate(pkgs []*Package) ([]byte, error) {
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by codegen/ndrcheck; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package main")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "import (")
+	fmt.Fprintln(&b, "\tcheck \"github.com/oiweiwei/go-msrpc/codegen/ndrcheck/check\"")
+	fmt.Fprintln(&b)
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "\t%s %q\n", pkg.Alias, pkg.Path)
+	}
+	fmt.Fprintln(&b, ")")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// types is the list of the types of the generated packages.")
+	fmt.Fprintln(&b, "var types = []check.Type{")
+	for _, pkg := range pkgs {
+		for _, typ := range pkg.Types {
+			fmt.Fprintf(&b, "\t{Name: %q, New: func() check.Value { return new(%s.%s) }},\n", pkg.Name+"."+typ, pkg.Alias, typ)
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// unions is the list of the union types of the generated packages.")
+	fmt.Fprintln(&b, "var unions = []check.Union{")
+	for _, pkg := range pkgs {
+		names := make([]string, 0, len(pkg.Unions))
+		for typ := range pkg.Unions {
+			names = append(names, typ)
+		}
+		sort.Strings(names)
+		for _, typ := range names {
+			fmt.Fprintf(&b, "\t{New: func() any { return new(%s.%s) }, Arms: []func() any{\n", pkg.Alias, typ)
+			for _, arm := range pkg.Unions[typ] {
+				fmt.Fprintf(&b, "\t\tfunc() any { return new(%s.%s) },\n", pkg.Alias, arm)
+			}
+			fmt.Fprintln(&b, "\t}},")
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "func main() { check.Main(types, unions) }")
+
+	return format.Source(b.Bytes())
+}
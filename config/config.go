@@ -2,18 +2,25 @@ package config
 
 import (
 	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"html/template"
 	"net"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	krb5_config "github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
 	zerolog "github.com/rs/zerolog"
 
 	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/rpch"
 	"github.com/oiweiwei/go-msrpc/smb2"
 
 	"github.com/oiweiwei/go-msrpc/ssp"
@@ -21,6 +28,7 @@ import (
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 	"github.com/oiweiwei/go-msrpc/ssp/krb5"
 	"github.com/oiweiwei/go-msrpc/ssp/ntlm"
+	"github.com/oiweiwei/go-msrpc/ssp/sspi"
 
 	"github.com/oiweiwei/go-msrpc/msrpc/well_known"
 
@@ -58,6 +66,9 @@ type Config struct {
 	Workstation string `json:"workstation"`
 	// The timeout for the connection.
 	Timeout time.Duration `json:"timeout"`
+	// The SOCKS5 or HTTP CONNECT proxy URL to tunnel the TCP connections
+	// through (socks5://host:port, http://host:port).
+	Proxy string `json:"proxy"`
 
 	// The credential configuration.
 	Credential struct {
@@ -65,10 +76,19 @@ type Config struct {
 		Password string `json:"password"`
 		// The NT hash to use.
 		NTHash string `json:"nt_hash"`
+		// The Kerberos AES128 or AES256 key (hex-encoded) to use. (combined
+		// with the NT hash if set)
+		AESKey string `json:"aes_key,omitempty"`
+		// The path to the Kerberos ticket file (ccache or KRB-CRED (.kirbi)
+		// file) to use.
+		Ticket string `json:"ticket_path,omitempty"`
 		// The machine account password to use.
 		MachineAccountPassword string `json:"machine_account_password"`
 		// The machine account NT hash.
 		MachineAccountNTHash string `json:"machine_account_nt_hash"`
+		// The flag that indicates whether the passwords and keys must be
+		// kept in the locked, zeroizable memory.
+		Secure bool `json:"secure,omitempty"`
 	} `json:"credential"`
 
 	// The auth configuration.
@@ -83,6 +103,32 @@ type Config struct {
 		TargetName string `json:"target_name"`
 		// The flag that indicates whether the SPNEGO should be used.
 		SPNEGO bool `json:"spnego"`
+		// The ordered list of mechanisms to offer with SPNEGO (ntlm, krb5).
+		// (default is the auth type)
+		SPNEGOMechanisms StringSlice `json:"spnego_mechanisms,omitempty"`
+		// The flag that indicates whether the Windows SSPI should be used
+		// instead of the built-in mechanisms (the logon session credentials
+		// are used if no password is set).
+		SSPI bool `json:"sspi,omitempty"`
+		// The flag that indicates whether the mutual authentication is
+		// required.
+		RequireMutualAuthn bool `json:"require_mutual_authn,omitempty"`
+		// The patterns the server principal must match (ie
+		// "host/*.contoso.net@CONTOSO.NET").
+		ServerPrincipals StringSlice `json:"server_principals,omitempty"`
+		// The flag that indicates whether the fallback to the mechanism
+		// other than the preferred auth type (ie from krb5 to ntlm) or to
+		// the anonymous authentication is denied.
+		DenyDowngrade bool `json:"deny_downgrade,omitempty"`
+		// The flag that indicates whether the NTLMv1 authentication is
+		// denied.
+		DenyNTLMv1 bool `json:"deny_ntlm_v1,omitempty"`
+		// The flag that indicates whether the privacy auth level is
+		// required.
+		RequirePrivacy bool `json:"require_privacy,omitempty"`
+		// The flag that indicates whether the unauthenticated bind is
+		// denied.
+		DenyUnauthenticated bool `json:"deny_unauthenticated,omitempty"`
 
 		// The auth configuration for KRB5.
 		KRB5 struct {
@@ -96,8 +142,41 @@ type Config struct {
 			EncryptionTypes StringSlice `json:"encryption_types"`
 			// The path to the keytab file.
 			Keytab string `json:"keytab_path"`
+			// The key version number to select from the keytab. (default
+			// is the highest version)
+			KeytabKVNO int `json:"keytab_kvno,omitempty"`
 			// The path to the ccache file.
 			CCache string `json:"ccache_path"`
+			// The user to impersonate with S4U2Self and S4U2Proxy
+			// (constrained delegation).
+			Impersonate string `json:"impersonate,omitempty"`
+			// The path to the PEM-encoded certificate file for the
+			// PKINIT authentication.
+			PKINITCertificate string `json:"pkinit_certificate_path,omitempty"`
+			// The path to the PEM-encoded private key file for the
+			// PKINIT authentication.
+			PKINITKey string `json:"pkinit_key_path,omitempty"`
+			// The path to the PKCS#12 (PFX) file with the certificate
+			// and the private key for the PKINIT authentication.
+			PKINITPFX string `json:"pkinit_pfx_path,omitempty"`
+			// The PKCS#12 (PFX) file password.
+			PKINITPFXPassword string `json:"pkinit_pfx_password,omitempty"`
+			// The path to the PEM-encoded root certificates to verify
+			// the KDC certificate. (default is the system roots)
+			PKINITRootCAs string `json:"pkinit_root_cas_path,omitempty"`
+			// The flag that indicates whether the KDC certificate
+			// verification should be skipped.
+			PKINITInsecureSkipVerify bool `json:"pkinit_insecure_skip_verify,omitempty"`
+			// The (machine account) principal used to obtain the FAST
+			// armor ticket ("DOMAIN\HOST$").
+			FASTArmorPrincipal string `json:"fast_armor_principal,omitempty"`
+			// The FAST armor principal password.
+			FASTArmorPassword string `json:"fast_armor_password,omitempty"`
+			// The path to the keytab file with the FAST armor principal
+			// keys.
+			FASTArmorKeytab string `json:"fast_armor_keytab_path,omitempty"`
+			// The path to the ccache file with the FAST armor ticket.
+			FASTArmorCCache string `json:"fast_armor_ccache_path,omitempty"`
 			// The flag that indicates whether the 3-leg DCE authentication
 			// should be used. (default true)
 			DCEStyle bool `json:"dce_style"`
@@ -143,6 +222,10 @@ type Config struct {
 		Seal bool `json:"seal"`
 		// The SMB2/3 dialect to use.
 		Dialect string `json:"dialect"`
+		// The share that hosts the named pipes. (default is IPC$)
+		Share string `json:"share"`
+		// The named pipe path overrides (pipe name to the path).
+		Pipes map[string]string `json:"pipes"`
 	} `json:"smb"`
 
 	// The Endpoint Mapper configuration.
@@ -154,12 +237,58 @@ type Config struct {
 		AuthLevel string `json:"auth_level"`
 	} `json:"epm"`
 
-	// The protocol to use. (ncacn_np or smb, ncacn_ip_tcp or tcp)
+	// The protocol to use. (ncacn_np or smb, ncacn_ip_tcp or tcp, ncacn_http or http, ncadg_ip_udp or udp)
 	Protocol string `json:"protocol"`
 
+	// The RPC over HTTP configuration.
+	HTTP struct {
+		// The RPC proxy address (host[:port]). If not set, RPC over HTTP v1
+		// direct connection is used.
+		RPCProxy string `json:"rpc_proxy"`
+		// Use plain HTTP to connect to RPC proxy.
+		Insecure bool `json:"insecure"`
+		// The RPC proxy Basic authentication credentials. (the user
+		// credentials are used by default).
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"http"`
+
+	// The TLS configuration for ncacn_ip_tcp transport.
+	TLS struct {
+		// The flag that indicates whether the TCP connections should be
+		// wrapped in TLS.
+		Enabled bool `json:"enabled"`
+		// The flag that indicates whether the server certificate
+		// verification should be skipped.
+		Insecure bool `json:"insecure"`
+		// The server name for certificate verification.
+		ServerName string `json:"server_name"`
+	} `json:"tls"`
+
 	// The transfer encoding to use (ndr20, ndr64)
 	TrasnferEncoding string `json:"transfer_encoding"`
 
+	// The retry policy for the calls and the endpoint mapper lookups. (no
+	// retries if not set)
+	Retry *dcerpc.RetryPolicy `json:"retry,omitempty"`
+
+	// The rate limits.
+	RateLimit struct {
+		// The maximum average number of the calls per second. (no limit
+		// if zero)
+		CallsPerSecond float64 `json:"calls_per_second,omitempty"`
+		// The maximum number of the calls that can be made at once.
+		// (default is 1)
+		Burst int `json:"burst,omitempty"`
+		// The maximum number of the calls awaiting the response on the
+		// single connection. (default is 64)
+		OutstandingCalls int `json:"outstanding_calls,omitempty"`
+	} `json:"rate_limit"`
+
+	// The target policy (allow-list/deny-list of hosts, ports and interfaces).
+	// If not set, the global policy is used.
+	Policy *dcerpc.TargetPolicy `json:"policy,omitempty"`
+
 	// The flag that indicates whether credentials and mechanisms should be
 	// included into connection options. If GlobalCredentials is true, then
 	// credentials and mechanisms are not included into connection options.
@@ -171,6 +300,10 @@ type Config struct {
 
 	// The flag that indicates whether Netlogon SSP should be used.
 	useNetlogonSSP bool
+
+	// The signer for the PKINIT certificate private key (ie the hardware
+	// token key).
+	signer crypto.Signer
 }
 
 // New function returns a new configuration.
@@ -222,6 +355,14 @@ func (cfg *Config) UseNetlogonSSP() *Config {
 	return cfg
 }
 
+// UseSigner function sets the signer for the PKINIT certificate private key,
+// so that the key that never leaves the hardware (smart card, HSM) can be used.
+// The PKINIT certificate file is loaded without the key file.
+func (cfg *Config) UseSigner(signer crypto.Signer) *Config {
+	cfg.signer = signer
+	return cfg
+}
+
 // DisableEPM function disables the Endpoint Mapper.
 func (cfg *Config) DisableEPM() *Config {
 	cfg.EPM.Enabled = false
@@ -262,6 +403,11 @@ func (cfg *Config) KRB5() *krb5.Config {
 		kcfg.CCachePath = cfg.Auth.KRB5.CCache
 	}
 
+	kcfg.Impersonate = cfg.Auth.KRB5.Impersonate
+	kcfg.PKINITRootCAs, _ = cfg.PKINITRootCAs()
+	kcfg.PKINITInsecureSkipVerify = cfg.Auth.KRB5.PKINITInsecureSkipVerify
+	kcfg.FASTArmor, _ = cfg.FASTArmorCredential()
+
 	if cfg.Auth.KRB5.ConfigFile != "" {
 		kcfg.KRB5ConfigPath = cfg.Auth.KRB5.ConfigFile
 		return kcfg
@@ -276,28 +422,112 @@ func (cfg *Config) KRB5() *krb5.Config {
 	return kcfg
 }
 
+// AuthTypes function returns the ordered list of auth types. When SPNEGO
+// is used, the SPNEGO mechanisms list (if set) takes precedence over the
+// auth type, the SPNEGO offers the mechanisms in the same order.
+func (cfg *Config) AuthTypes() []string {
+	if cfg.Auth.SPNEGO && len(cfg.Auth.SPNEGOMechanisms) > 0 {
+		return cfg.Auth.SPNEGOMechanisms
+	}
+	return []string{cfg.Auth.Type}
+}
+
+// hasAuthType function returns true if the auth type is in use.
+func (cfg *Config) hasAuthType(authType string) bool {
+	for _, t := range cfg.AuthTypes() {
+		if t == authType {
+			return true
+		}
+	}
+	return false
+}
+
 // Mechanisms function returns the set of mechanisms.
 // If GlobalCredentials is true, then mechanisms are not included into
 // connection options. You should manually call gssapi.AddMechanism
 // function to add mechanisms into global security context.
 func (cfg *Config) Mechanisms() []gssapi.MechanismFactory {
 
+	if cfg.Auth.SSPI {
+		return cfg.sspiMechanisms(true)
+	}
+
 	mechanisms := []gssapi.MechanismFactory{}
 
 	if cfg.Auth.SPNEGO {
 		mechanisms = append(mechanisms, ssp.SPNEGO)
 	}
 
-	switch cfg.Auth.Type {
-	case "ntlm":
-		mechanisms = append(mechanisms, gssapi.WithDefaultConfig(ssp.NTLM, cfg.NTLM()))
-	case "krb5":
-		mechanisms = append(mechanisms, gssapi.WithDefaultConfig(ssp.KRB5, cfg.KRB5()))
+	for _, authType := range cfg.AuthTypes() {
+		switch authType {
+		case "ntlm":
+			mechanisms = append(mechanisms, gssapi.WithDefaultConfig(ssp.NTLM, cfg.NTLM()))
+		case "krb5":
+			mechanisms = append(mechanisms, gssapi.WithDefaultConfig(ssp.KRB5, cfg.KRB5()))
+		}
+	}
+
+	return mechanisms
+}
+
+// sspiMechanisms function returns the SSPI mechanisms for the auth types,
+// the Negotiate package if SPNEGO is used.
+func (cfg *Config) sspiMechanisms(dceStyle bool) []gssapi.MechanismFactory {
+
+	caps := gssapi.Cap(0)
+	if dceStyle && cfg.Auth.KRB5.DCEStyle {
+		caps |= gssapi.DCEStyle
+	}
+
+	if cfg.Auth.SPNEGO {
+		return []gssapi.MechanismFactory{sspi.NewMechanism(sspi.PackageNegotiate, caps)}
+	}
+
+	mechanisms := []gssapi.MechanismFactory{}
+
+	for _, authType := range cfg.AuthTypes() {
+		switch authType {
+		case "ntlm":
+			mechanisms = append(mechanisms, sspi.NewMechanism(sspi.PackageNTLM))
+		case "krb5":
+			mechanisms = append(mechanisms, sspi.NewMechanism(sspi.PackageKerberos, caps))
+		}
 	}
 
 	return mechanisms
 }
 
+// AuthnPolicy function returns the client authentication policy, or nil
+// if the policy is not configured.
+func (cfg *Config) AuthnPolicy() *dcerpc.AuthnPolicy {
+
+	if !cfg.Auth.RequireMutualAuthn && len(cfg.Auth.ServerPrincipals) == 0 && !cfg.Auth.DenyDowngrade &&
+		!cfg.Auth.DenyNTLMv1 && !cfg.Auth.RequirePrivacy && !cfg.Auth.DenyUnauthenticated {
+		return nil
+	}
+
+	policy := &dcerpc.AuthnPolicy{
+		RequireMutualAuthn:  cfg.Auth.RequireMutualAuthn,
+		ServerPrincipals:    cfg.Auth.ServerPrincipals,
+		DenyAnonymous:       cfg.Auth.DenyDowngrade,
+		DenyNTLMv1:          cfg.Auth.DenyNTLMv1,
+		RequirePrivacy:      cfg.Auth.RequirePrivacy,
+		DenyUnauthenticated: cfg.Auth.DenyUnauthenticated,
+	}
+
+	if authTypes := cfg.AuthTypes(); cfg.Auth.DenyDowngrade && len(authTypes) > 0 {
+		// only the preferred mechanism is allowed.
+		switch authTypes[0] {
+		case "ntlm":
+			policy.Mechanisms = []gssapi.OID{ssp.MechanismTypeNTLM}
+		case "krb5":
+			policy.Mechanisms = []gssapi.OID{ssp.MechanismTypeKRB5}
+		}
+	}
+
+	return policy
+}
+
 // MachineAccountCredentials function returns the set of machine account
 // credentials.
 func (cfg *Config) MachineAccountCredentials() []credential.Credential {
@@ -306,12 +536,12 @@ func (cfg *Config) MachineAccountCredentials() []credential.Credential {
 
 	if cfg.Credential.MachineAccountPassword != "" {
 		creds = append(creds, credential.NewFromPassword(cfg.Username, cfg.Credential.MachineAccountPassword,
-			credential.Workstation(cfg.Workstation)))
+			cfg.credentialOptions()...))
 	}
 
 	if cfg.Credential.MachineAccountNTHash != "" {
 		creds = append(creds, credential.NewFromNTHash(cfg.Username, cfg.Credential.MachineAccountNTHash,
-			credential.Workstation(cfg.Workstation)))
+			cfg.credentialOptions()...))
 	}
 
 	return creds
@@ -328,16 +558,27 @@ func (cfg *Config) Credentials() []credential.Credential {
 
 	if cfg.Credential.Password != "" {
 		creds = append(creds, credential.NewFromPassword(cfg.Username, cfg.Credential.Password,
-			credential.Workstation(cfg.Workstation)))
+			cfg.credentialOptions()...))
 	}
 
-	if cfg.Credential.NTHash != "" {
+	if keys, _ := cfg.EncryptionKeysCredential(); keys != nil {
+		creds = append(creds, keys)
+	} else if cfg.Credential.NTHash != "" {
 		creds = append(creds, credential.NewFromNTHash(cfg.Username, cfg.Credential.NTHash,
-			credential.Workstation(cfg.Workstation)))
+			cfg.credentialOptions()...))
+	}
+
+	if ticket, _ := cfg.TicketCredential(); ticket != nil {
+		creds = append(creds, ticket)
 	}
 
 	if cfg.Auth.KRB5.Keytab != "" {
-		creds = append(creds, credential.NewFromKeytabFile(cfg.Username, cfg.Auth.KRB5.Keytab))
+		creds = append(creds, credential.NewFromKeytabFile(cfg.Username, cfg.Auth.KRB5.Keytab,
+			credential.KVNO(cfg.Auth.KRB5.KeytabKVNO)))
+	}
+
+	if cert, _ := cfg.CertificateCredential(); cert != nil {
+		creds = append(creds, cert)
 	}
 
 	creds = append(creds, cfg.MachineAccountCredentials()...)
@@ -349,6 +590,130 @@ func (cfg *Config) Credentials() []credential.Credential {
 	return creds
 }
 
+// EncryptionKeysCredential function returns the encryption keys credential
+// with the AES key and the NT hash (as RC4-HMAC key). The function returns
+// `nil` if no AES key is configured.
+func (cfg *Config) EncryptionKeysCredential() (credential.EncryptionKeys, error) {
+
+	if cfg.Credential.AESKey == "" {
+		return nil, nil
+	}
+
+	key, err := credential.ParseAESKey(cfg.Credential.AESKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []credential.EncryptionKey{key}
+
+	if cfg.Credential.NTHash != "" {
+		ntHash, err := hex.DecodeString(cfg.Credential.NTHash)
+		if err != nil {
+			return nil, fmt.Errorf("nt hash: %w", err)
+		}
+		keys = append(keys, credential.EncryptionKey{EType: credential.ETypeRC4, Key: ntHash})
+	}
+
+	return credential.NewFromEncryptionKeys(cfg.Username, keys, cfg.credentialOptions()...), nil
+}
+
+// credentialOptions function returns the options for the password, NT hash
+// and encryption keys credentials.
+func (cfg *Config) credentialOptions() []credential.Option {
+	opts := []credential.Option{credential.Workstation(cfg.Workstation)}
+	if cfg.Credential.Secure {
+		opts = append(opts, credential.Secure())
+	}
+	return opts
+}
+
+// TicketCredential function loads the ticket credential (from the ccache or
+// KRB-CRED file). The function returns `nil` if no ticket is configured.
+func (cfg *Config) TicketCredential() (credential.CCache, error) {
+
+	if cfg.Credential.Ticket == "" {
+		return nil, nil
+	}
+
+	return credential.LoadTicketFile(cfg.Username, cfg.Credential.Ticket, credential.Workstation(cfg.Workstation))
+}
+
+// CertificateCredential function loads the certificate credential for the
+// PKINIT authentication (from the PFX file or the PEM-encoded certificate
+// and key files). The function returns `nil` if no certificate is configured.
+func (cfg *Config) CertificateCredential() (credential.Certificate, error) {
+
+	if cfg.Auth.KRB5.PKINITPFX != "" {
+		return credential.LoadPFXFile(cfg.Username, cfg.Auth.KRB5.PKINITPFX, cfg.Auth.KRB5.PKINITPFXPassword,
+			credential.Workstation(cfg.Workstation))
+	}
+
+	if cfg.Auth.KRB5.PKINITCertificate != "" && cfg.signer != nil {
+		return credential.LoadCertificateFileWithSigner(cfg.Username, cfg.Auth.KRB5.PKINITCertificate, cfg.signer,
+			credential.Workstation(cfg.Workstation))
+	}
+
+	if cfg.Auth.KRB5.PKINITCertificate != "" {
+		return credential.LoadCertificateFile(cfg.Username, cfg.Auth.KRB5.PKINITCertificate, cfg.Auth.KRB5.PKINITKey,
+			credential.Workstation(cfg.Workstation))
+	}
+
+	return nil, nil
+}
+
+// FASTArmorCredential function returns the credential used to obtain the
+// FAST armor ticket (from the ccache, keytab or password). The function
+// returns `nil` if no FAST armor is configured.
+func (cfg *Config) FASTArmorCredential() (credential.Credential, error) {
+
+	if cfg.Auth.KRB5.FASTArmorCCache != "" {
+		cc, err := credentials.LoadCCache(cfg.Auth.KRB5.FASTArmorCCache)
+		if err != nil {
+			return nil, fmt.Errorf("fast armor: load ccache: %w", err)
+		}
+		return credential.NewFromCCache(cfg.Auth.KRB5.FASTArmorPrincipal, cc), nil
+	}
+
+	if cfg.Auth.KRB5.FASTArmorPrincipal == "" {
+		if cfg.Auth.KRB5.FASTArmorKeytab != "" || cfg.Auth.KRB5.FASTArmorPassword != "" {
+			return nil, fmt.Errorf("fast armor: principal is required")
+		}
+		return nil, nil
+	}
+
+	if cfg.Auth.KRB5.FASTArmorKeytab != "" {
+		return credential.LoadKeytabFile(cfg.Auth.KRB5.FASTArmorPrincipal, cfg.Auth.KRB5.FASTArmorKeytab)
+	}
+
+	if cfg.Auth.KRB5.FASTArmorPassword != "" {
+		return credential.NewFromPassword(cfg.Auth.KRB5.FASTArmorPrincipal, cfg.Auth.KRB5.FASTArmorPassword), nil
+	}
+
+	return nil, fmt.Errorf("fast armor: keytab, password or ccache is required")
+}
+
+// PKINITRootCAs function returns the root certificates to verify the KDC
+// certificate, or `nil` (the system roots) if no root certificates are
+// configured.
+func (cfg *Config) PKINITRootCAs() (*x509.CertPool, error) {
+
+	if cfg.Auth.KRB5.PKINITRootCAs == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(cfg.Auth.KRB5.PKINITRootCAs)
+	if err != nil {
+		return nil, fmt.Errorf("pkinit root cas: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("pkinit root cas: no certificates found")
+	}
+
+	return pool, nil
+}
+
 // ClientOptions function returns the set of client options.
 func (cfg *Config) ClientOptions(ctx context.Context) []dcerpc.Option {
 
@@ -376,17 +741,25 @@ func (cfg *Config) ClientOptions(ctx context.Context) []dcerpc.Option {
 		options = append(options, dcerpc.WithSeal())
 	}
 
-	if !cfg.useGlobalCredentials {
+	if !cfg.useGlobalCredentials && cfg.Auth.SSPI {
+
+		for _, mech := range cfg.sspiMechanisms(true) {
+			options = append(options, dcerpc.WithMechanism(mech))
+		}
+
+	} else if !cfg.useGlobalCredentials {
 
 		if cfg.Auth.SPNEGO {
 			options = append(options, dcerpc.WithMechanism(ssp.SPNEGO))
 		}
 
-		switch cfg.Auth.Type {
-		case "ntlm":
-			options = append(options, dcerpc.WithMechanism(ssp.NTLM, cfg.NTLM()))
-		case "krb5":
-			options = append(options, dcerpc.WithMechanism(ssp.KRB5, cfg.KRB5()))
+		for _, authType := range cfg.AuthTypes() {
+			switch authType {
+			case "ntlm":
+				options = append(options, dcerpc.WithMechanism(ssp.NTLM, cfg.NTLM()))
+			case "krb5":
+				options = append(options, dcerpc.WithMechanism(ssp.KRB5, cfg.KRB5()))
+			}
 		}
 
 		if cfg.useNetlogonSSP {
@@ -398,6 +771,10 @@ func (cfg *Config) ClientOptions(ctx context.Context) []dcerpc.Option {
 		options = append(options, dcerpc.WithTargetName(cfg.Auth.TargetName))
 	}
 
+	if policy := cfg.AuthnPolicy(); policy != nil {
+		options = append(options, dcerpc.WithAuthnPolicy(policy))
+	}
+
 	if cfg.Verify.BitMask {
 		options = append(options, dcerpc.WithVerifyBitMask(true))
 	}
@@ -480,10 +857,64 @@ func (cfg *Config) getDialOptions() []dcerpc.Option {
 		options = append(options, dcerpc.WithTimeout(cfg.Timeout))
 	}
 
+	if cfg.Proxy != "" {
+		options = append(options, dcerpc.WithProxy(cfg.Proxy))
+	}
+
+	if cfg.TLS.Enabled {
+		options = append(options, dcerpc.WithTLS(&tls.Config{
+			InsecureSkipVerify: cfg.TLS.Insecure,
+			ServerName:         cfg.TLS.ServerName,
+		}))
+	}
+
 	if cfg.SMB.Port != 0 {
 		options = append(options, dcerpc.WithSMBPort(cfg.SMB.Port))
 	}
 
+	if cfg.SMB.Share != "" {
+		options = append(options, dcerpc.WithSMBShareName(cfg.SMB.Share))
+	}
+
+	for pipe, path := range cfg.SMB.Pipes {
+		options = append(options, dcerpc.WithNamedPipePath(pipe, path))
+	}
+
+	if cfg.Policy != nil {
+		options = append(options, dcerpc.WithTargetPolicy(cfg.Policy))
+	}
+
+	if cfg.Retry != nil {
+		options = append(options, dcerpc.WithRetryPolicy(cfg.Retry))
+	}
+
+	if cfg.RateLimit.CallsPerSecond > 0 {
+		options = append(options, dcerpc.WithRateLimit(&dcerpc.RateLimit{
+			CallsPerSecond: cfg.RateLimit.CallsPerSecond,
+			Burst:          cfg.RateLimit.Burst,
+		}))
+	}
+
+	if cfg.RateLimit.OutstandingCalls > 0 {
+		options = append(options, dcerpc.WithOutstandingCalls(cfg.RateLimit.OutstandingCalls))
+	}
+
+	if cfg.HTTP.RPCProxy != "" {
+		proxy := &rpch.Config{
+			Proxy:    cfg.HTTP.RPCProxy,
+			Insecure: cfg.HTTP.Insecure,
+			Username: cfg.HTTP.Username,
+			Password: cfg.HTTP.Password,
+		}
+		if proxy.Username == "" {
+			proxy.Username, proxy.Password = cfg.Username, cfg.Credential.Password
+			if cfg.Domain != "" {
+				proxy.Username = cfg.Domain + "\\" + cfg.Username
+			}
+		}
+		options = append(options, dcerpc.WithRPCProxy(proxy))
+	}
+
 	if dialer := cfg.SMBDialerOptions(); len(dialer) > 0 {
 		options = append(options, dcerpc.WithSMBDialer(smb2.NewDialer(dialer...)))
 	}
@@ -511,6 +942,10 @@ func (cfg *Config) ServerAddr() string {
 		return "ncacn_np:" + cfg.ServerAddress
 	case "ncacn_ip_tcp", "tcp":
 		return "ncacn_ip_tcp:" + cfg.ServerAddress
+	case "ncacn_http", "http":
+		return "ncacn_http:" + cfg.ServerAddress
+	case "ncadg_ip_udp", "udp":
+		return "ncadg_ip_udp:" + cfg.ServerAddress
 	}
 
 	return cfg.Server
@@ -532,17 +967,25 @@ func (cfg *Config) SMBDialerOptions() []smb2.DialerOption {
 
 	gssOptions := []gssapi.ContextOption{}
 
-	if !cfg.useGlobalCredentials {
+	if !cfg.useGlobalCredentials && cfg.Auth.SSPI {
+
+		for _, mech := range cfg.sspiMechanisms(false) {
+			gssOptions = append(gssOptions, gssapi.WithMechanismFactory(mech))
+		}
+
+	} else if !cfg.useGlobalCredentials {
 
 		if cfg.Auth.SPNEGO {
 			gssOptions = append(gssOptions, gssapi.WithMechanismFactory(ssp.SPNEGO))
 		}
 
-		switch cfg.Auth.Type {
-		case "ntlm":
-			gssOptions = append(gssOptions, gssapi.WithMechanismFactory(ssp.NTLM, cfg.NTLM()))
-		case "krb5":
-			gssOptions = append(gssOptions, gssapi.WithMechanismFactory(ssp.KRB5, cfg.KRB5()))
+		for _, authType := range cfg.AuthTypes() {
+			switch authType {
+			case "ntlm":
+				gssOptions = append(gssOptions, gssapi.WithMechanismFactory(ssp.NTLM, cfg.NTLM()))
+			case "krb5":
+				gssOptions = append(gssOptions, gssapi.WithMechanismFactory(ssp.KRB5, cfg.KRB5()))
+			}
 		}
 
 	}
@@ -732,6 +1175,17 @@ func (cfg *Config) ParseServerAddr() error {
 			case "smb2_seal":
 				cfg.SMB.Seal = true
 			}
+			// smb2 endpoint options.
+			switch k, v, _ := strings.Cut(extra, "="); k {
+			case "smb_port":
+				port, err := strconv.Atoi(v)
+				if err != nil {
+					return fmt.Errorf("server address: invalid smb_port: %w", err)
+				}
+				cfg.SMB.Port = port
+			case "share":
+				cfg.SMB.Share = v
+			}
 		}
 	}
 
@@ -755,6 +1209,14 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid auth type: %s", cfg.Auth.Type)
 	}
 
+	for _, authType := range cfg.Auth.SPNEGOMechanisms {
+		switch authType {
+		case "ntlm", "krb5":
+		default:
+			return fmt.Errorf("invalid spnego mechanism: %s", authType)
+		}
+	}
+
 	if err := ValidateTransferEncoding(cfg.TrasnferEncoding); err != nil {
 		return err
 	}
@@ -767,6 +1229,22 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
+	if cfg.Auth.SSPI && !sspi.Available {
+		return fmt.Errorf("sspi: %w", sspi.ErrNotSupported)
+	}
+
+	if cfg.Auth.DenyNTLMv1 && cfg.Auth.NTLM.NTLMv1 {
+		return fmt.Errorf("ntlm v1 is denied by the authentication policy")
+	}
+
+	if cfg.Auth.RequirePrivacy && cfg.Auth.Level != "privacy" {
+		return fmt.Errorf("auth level %q: privacy is required by the authentication policy", cfg.Auth.Level)
+	}
+
+	if cfg.Auth.DenyUnauthenticated && cfg.Auth.Level == "none" {
+		return fmt.Errorf("unauthenticated bind is denied by the authentication policy")
+	}
+
 	if cfg.EPM.Enabled {
 		if cfg.EPM.AuthLevel != "" {
 			if err := ValidateAuthLevel(cfg.EPM.AuthLevel); err != nil {
@@ -803,7 +1281,15 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("domain is required")
 	}
 
-	if cfg.Auth.Type == "krb5" {
+	if _, err := cfg.EncryptionKeysCredential(); err != nil {
+		return fmt.Errorf("credential: %w", err)
+	}
+
+	if _, err := cfg.TicketCredential(); err != nil {
+		return fmt.Errorf("credential: %w", err)
+	}
+
+	if cfg.hasAuthType("krb5") {
 		if len(cfg.Auth.KRB5.EncryptionTypes) == 0 {
 			cfg.Auth.KRB5.EncryptionTypes = []string{"aes128-cts-hmac-sha1-96", "aes256-cts-hmac-sha1-96", "arcfour-hmac-md5"}
 		}
@@ -812,6 +1298,18 @@ func (cfg *Config) Validate() error {
 				return err
 			}
 		}
+		if cfg.Auth.KRB5.PKINITCertificate != "" && cfg.Auth.KRB5.PKINITKey == "" && cfg.signer == nil {
+			return fmt.Errorf("pkinit: key is required")
+		}
+		if _, err := cfg.CertificateCredential(); err != nil {
+			return fmt.Errorf("pkinit: %w", err)
+		}
+		if _, err := cfg.PKINITRootCAs(); err != nil {
+			return err
+		}
+		if _, err := cfg.FASTArmorCredential(); err != nil {
+			return err
+		}
 	}
 
 	if cfg.Username != "" && credential.DomainName(cfg.Username) == "" {
@@ -832,7 +1330,7 @@ func (cfg *Config) Validate() error {
 
 	if cfg.Protocol != "" {
 		switch cfg.Protocol {
-		case "ncacn_np", "ncacn_ip_tcp", "smb", "tcp":
+		case "ncacn_np", "ncacn_ip_tcp", "ncacn_http", "ncadg_ip_udp", "smb", "tcp", "http", "udp":
 		default:
 			return fmt.Errorf("invalid protocol: %s", cfg.Protocol)
 		}
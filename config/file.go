@@ -0,0 +1,211 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+// EnvConfigPath is the environment variable that points to the default
+// configuration file.
+const EnvConfigPath = "MSRPC_CONFIG"
+
+// Load function returns the default configuration populated from the
+// YAML (or JSON) configuration file `p` (if not empty) and then from
+// the environment overrides.
+//
+//	cfg, err := config.Load("/etc/msrpc.yaml")
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	if err := cfg.Validate(); err != nil {
+//		// handle error.
+//	}
+//
+//	conn, err := dcerpc.Dial(ctx, cfg.ServerAddr(), cfg.DialOptions(ctx)...)
+func Load(p string) (*Config, error) {
+
+	cfg := New()
+
+	if p == "" {
+		p = os.Getenv(EnvConfigPath)
+	}
+
+	if p != "" {
+		if err := cfg.LoadFile(p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.LoadEnv(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// LoadFile function reads the configuration from the YAML (or JSON) file.
+// The durations can be specified either as a number of nanoseconds or
+// as a duration string ("30s", "1m").
+func (cfg *Config) LoadFile(p string) error {
+
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	var raw map[string]any
+
+	if err := yaml.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("load config: %s: %w", p, err)
+	}
+
+	if err := convertDurations(raw); err != nil {
+		return fmt.Errorf("load config: %s: %w", p, err)
+	}
+
+	// re-encode the document to use json tags of the config structure.
+	if b, err = json.Marshal(raw); err != nil {
+		return fmt.Errorf("load config: %s: %w", p, err)
+	}
+
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return fmt.Errorf("load config: %s: %w", p, err)
+	}
+
+	return nil
+}
+
+// convertDurations function converts the duration strings into
+// the number of nanoseconds for the keys named "timeout" or with
+// "_timeout", "_interval" or "_backoff" suffix.
+func convertDurations(raw map[string]any) error {
+	for k, v := range raw {
+		switch v := v.(type) {
+		case map[string]any:
+			if err := convertDurations(v); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+		case string:
+			if k != "timeout" && !strings.HasSuffix(k, "_timeout") && !strings.HasSuffix(k, "_interval") && !strings.HasSuffix(k, "_backoff") {
+				continue
+			}
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+			raw[k] = int64(d)
+		}
+	}
+	return nil
+}
+
+// LoadEnv function applies the environment overrides to the configuration.
+//
+// Following environment variables are recognized: MSRPC_DEBUG, MSRPC_SERVER,
+// MSRPC_DOMAIN, MSRPC_USERNAME, MSRPC_PASSWORD, MSRPC_NT_HASH, MSRPC_AES_KEY,
+// MSRPC_TICKET, MSRPC_WORKSTATION, MSRPC_TIMEOUT, MSRPC_PROTOCOL, MSRPC_AUTH_LEVEL,
+// MSRPC_AUTH_TYPE, MSRPC_AUTH_SPNEGO, MSRPC_TARGET_NAME, MSRPC_KRB5_CONFIG,
+// MSRPC_KRB5_KEYTAB, MSRPC_KRB5_CCACHE, MSRPC_PROXY, MSRPC_RETRY_MAX_ATTEMPTS,
+// MSRPC_RATE_LIMIT, MSRPC_RATE_LIMIT_BURST, MSRPC_OUTSTANDING_CALLS.
+func (cfg *Config) LoadEnv() error {
+
+	for env, v := range map[string]*string{
+		"MSRPC_SERVER":      &cfg.Server,
+		"MSRPC_DOMAIN":      &cfg.Domain,
+		"MSRPC_USERNAME":    &cfg.Username,
+		"MSRPC_PASSWORD":    &cfg.Credential.Password,
+		"MSRPC_NT_HASH":     &cfg.Credential.NTHash,
+		"MSRPC_AES_KEY":     &cfg.Credential.AESKey,
+		"MSRPC_TICKET":      &cfg.Credential.Ticket,
+		"MSRPC_WORKSTATION": &cfg.Workstation,
+		"MSRPC_PROTOCOL":    &cfg.Protocol,
+		"MSRPC_AUTH_LEVEL":  &cfg.Auth.Level,
+		"MSRPC_AUTH_TYPE":   &cfg.Auth.Type,
+		"MSRPC_TARGET_NAME": &cfg.Auth.TargetName,
+		"MSRPC_KRB5_CONFIG": &cfg.Auth.KRB5.ConfigFile,
+		"MSRPC_KRB5_KEYTAB": &cfg.Auth.KRB5.Keytab,
+		"MSRPC_KRB5_CCACHE": &cfg.Auth.KRB5.CCache,
+		"MSRPC_PROXY":       &cfg.Proxy,
+	} {
+		if s, ok := os.LookupEnv(env); ok {
+			*v = s
+		}
+	}
+
+	for env, v := range map[string]*bool{
+		"MSRPC_DEBUG":       &cfg.Debug,
+		"MSRPC_AUTH_SPNEGO": &cfg.Auth.SPNEGO,
+	} {
+		if s, ok := os.LookupEnv(env); ok {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return fmt.Errorf("load env: %s: %w", env, err)
+			}
+			*v = b
+		}
+	}
+
+	if s, ok := os.LookupEnv("MSRPC_TIMEOUT"); ok {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("load env: MSRPC_TIMEOUT: %w", err)
+		}
+		cfg.Timeout = d
+	}
+
+	for env, v := range map[string]*int{
+		"MSRPC_RATE_LIMIT_BURST":  &cfg.RateLimit.Burst,
+		"MSRPC_OUTSTANDING_CALLS": &cfg.RateLimit.OutstandingCalls,
+	} {
+		if s, ok := os.LookupEnv(env); ok {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return fmt.Errorf("load env: %s: %w", env, err)
+			}
+			*v = n
+		}
+	}
+
+	if s, ok := os.LookupEnv("MSRPC_RATE_LIMIT"); ok {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("load env: MSRPC_RATE_LIMIT: %w", err)
+		}
+		cfg.RateLimit.CallsPerSecond = f
+	}
+
+	if s, ok := os.LookupEnv("MSRPC_RETRY_MAX_ATTEMPTS"); ok {
+		if err := cfg.SetRetryMaxAttempts(s); err != nil {
+			return fmt.Errorf("load env: MSRPC_RETRY_MAX_ATTEMPTS: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SetRetryMaxAttempts function sets the maximum number of the call attempts
+// (the default retry policy is used if the retry policy is not set).
+func (cfg *Config) SetRetryMaxAttempts(s string) error {
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Retry == nil {
+		cfg.Retry = dcerpc.DefaultRetryPolicy()
+	}
+
+	cfg.Retry.MaxAttempts = n
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfig function writes the configuration file `name` into the
+// temporary directory and returns its path.
+func writeConfig(t *testing.T, name, content string) string {
+	p := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return p
+}
+
+func TestLoadFile(t *testing.T) {
+
+	for _, tc := range []struct {
+		name    string
+		content string
+	}{
+		{"msrpc.yaml", `
+username: user
+domain: contoso.net
+timeout: 1m
+credential:
+  nt_hash: 8846f7eaee8fb117ad06bdd830b7586c
+auth:
+  target_name: host/dc01
+  spnego: true
+smb:
+  pipes:
+    lsarpc: lsass
+retry:
+  max_attempts: 3
+  initial_backoff: 250ms
+  max_backoff: 10s
+rate_limit:
+  calls_per_second: 2.5
+  burst: 4
+`},
+		{"msrpc.json", `{
+  "username": "user",
+  "domain": "contoso.net",
+  "timeout": 60000000000,
+  "credential": {"nt_hash": "8846f7eaee8fb117ad06bdd830b7586c"},
+  "auth": {"target_name": "host/dc01", "spnego": true},
+  "smb": {"pipes": {"lsarpc": "lsass"}},
+  "retry": {"max_attempts": 3, "initial_backoff": "250ms", "max_backoff": "10s"},
+  "rate_limit": {"calls_per_second": 2.5, "burst": 4}
+}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+
+			cfg := New()
+
+			if err := cfg.LoadFile(writeConfig(t, tc.name, tc.content)); err != nil {
+				t.Fatalf("load file: %v", err)
+			}
+
+			if cfg.Username != "user" || cfg.Domain != "contoso.net" || cfg.Timeout != time.Minute {
+				t.Fatalf("unexpected config: %q, %q, %v", cfg.Username, cfg.Domain, cfg.Timeout)
+			}
+
+			if cfg.Credential.NTHash != "8846f7eaee8fb117ad06bdd830b7586c" || cfg.Auth.TargetName != "host/dc01" || !cfg.Auth.SPNEGO {
+				t.Fatalf("unexpected credential or auth: %+v, %+v", cfg.Credential, cfg.Auth)
+			}
+
+			if cfg.SMB.Pipes["lsarpc"] != "lsass" {
+				t.Fatalf("unexpected smb pipes: %v", cfg.SMB.Pipes)
+			}
+
+			if cfg.Retry == nil || cfg.Retry.MaxAttempts != 3 || cfg.Retry.InitialBackoff != 250*time.Millisecond || cfg.Retry.MaxBackoff != 10*time.Second {
+				t.Fatalf("unexpected retry policy: %+v", cfg.Retry)
+			}
+
+			if cfg.RateLimit.CallsPerSecond != 2.5 || cfg.RateLimit.Burst != 4 {
+				t.Fatalf("unexpected rate limit: %+v", cfg.RateLimit)
+			}
+		})
+	}
+
+	if err := New().LoadFile(writeConfig(t, "msrpc.yaml", "retry:\n  initial_backoff: soon\n")); err == nil || !strings.Contains(err.Error(), "retry: initial_backoff") {
+		t.Fatalf("expected initial_backoff error, got %v", err)
+	}
+
+	if err := New().LoadFile(filepath.Join(t.TempDir(), "missing.yaml")); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+}
+
+func TestConvertDurations(t *testing.T) {
+
+	raw := map[string]any{
+		"timeout":       "1m",
+		"conn_timeout":  "5s",
+		"ping_interval": "30s",
+		"name":          "10s",
+		"retry": map[string]any{
+			"initial_backoff": "100ms",
+			"max_backoff":     int64(time.Second),
+		},
+	}
+
+	if err := convertDurations(raw); err != nil {
+		t.Fatalf("convert durations: %v", err)
+	}
+
+	for k, v := range map[string]any{
+		"timeout":       int64(time.Minute),
+		"conn_timeout":  int64(5 * time.Second),
+		"ping_interval": int64(30 * time.Second),
+		"name":          "10s",
+	} {
+		if raw[k] != v {
+			t.Fatalf("%s: unexpected value: %v", k, raw[k])
+		}
+	}
+
+	retry := raw["retry"].(map[string]any)
+	if retry["initial_backoff"] != int64(100*time.Millisecond) || retry["max_backoff"] != int64(time.Second) {
+		t.Fatalf("retry: unexpected values: %v", retry)
+	}
+
+	err := convertDurations(map[string]any{"smb": map[string]any{"idle_timeout": "1"}})
+	if err == nil || !strings.HasPrefix(err.Error(), "smb: idle_timeout:") {
+		t.Fatalf("expected idle_timeout error, got %v", err)
+	}
+}
+
+func TestLoadEnv(t *testing.T) {
+
+	for env, v := range map[string]string{
+		"MSRPC_DEBUG":              "maybe",
+		"MSRPC_AUTH_SPNEGO":        "maybe",
+		"MSRPC_TIMEOUT":            "30",
+		"MSRPC_RATE_LIMIT":         "fast",
+		"MSRPC_RATE_LIMIT_BURST":   "1.5",
+		"MSRPC_OUTSTANDING_CALLS":  "many",
+		"MSRPC_RETRY_MAX_ATTEMPTS": "three",
+	} {
+		t.Run(env, func(t *testing.T) {
+			t.Setenv(env, v)
+			if err := New().LoadEnv(); err == nil || !strings.Contains(err.Error(), env) {
+				t.Fatalf("expected %s error, got %v", env, err)
+			}
+		})
+	}
+
+	t.Setenv("MSRPC_USERNAME", "env")
+	t.Setenv("MSRPC_TIMEOUT", "5s")
+	t.Setenv("MSRPC_RATE_LIMIT", "2")
+	t.Setenv("MSRPC_RETRY_MAX_ATTEMPTS", "5")
+
+	cfg := New()
+
+	if err := cfg.LoadEnv(); err != nil {
+		t.Fatalf("load env: %v", err)
+	}
+
+	if cfg.Username != "env" || cfg.Timeout != 5*time.Second || cfg.RateLimit.CallsPerSecond != 2 {
+		t.Fatalf("unexpected config: %q, %v, %v", cfg.Username, cfg.Timeout, cfg.RateLimit.CallsPerSecond)
+	}
+
+	if cfg.Retry == nil || cfg.Retry.MaxAttempts != 5 || cfg.Retry.InitialBackoff == 0 {
+		t.Fatalf("unexpected retry policy: %+v", cfg.Retry)
+	}
+}
+
+func TestLoad(t *testing.T) {
+
+	p := writeConfig(t, "msrpc.yaml", `
+username: file
+workstation: file
+timeout: 1m
+`)
+
+	t.Setenv(EnvConfigPath, p)
+	t.Setenv("MSRPC_USERNAME", "env")
+
+	// file -> env (the flags are applied on top by config/flag).
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	if cfg.Username != "env" || cfg.Workstation != "file" || cfg.Timeout != time.Minute {
+		t.Fatalf("unexpected precedence: %q, %q, %v", cfg.Username, cfg.Workstation, cfg.Timeout)
+	}
+
+	// the explicit path takes precedence over MSRPC_CONFIG.
+	if cfg, err = Load(writeConfig(t, "other.yaml", "workstation: other\n")); err != nil || cfg.Workstation != "other" {
+		t.Fatalf("load: %v", err)
+	}
+
+	t.Setenv("MSRPC_TIMEOUT", "soon")
+
+	if _, err := Load(""); err == nil || !strings.Contains(err.Error(), "MSRPC_TIMEOUT") {
+		t.Fatalf("expected MSRPC_TIMEOUT error, got %v", err)
+	}
+}
@@ -2,13 +2,17 @@ package command_line
 
 import (
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/oiweiwei/go-msrpc/config"
 )
 
 func BindFlags(c *config.Config, flagSet *flag.FlagSet) {
 
+	flagSet.Var(&configFlag{cfg: c}, "config", "path to the YAML/JSON configuration file (overridden by the environment and the other flags)")
+
 	flagSet.BoolVar(&c.Debug, "debug", c.Debug, "enable debug output")
 
 	flagSet.StringVar(&c.Server, "server", c.Server, "server to connect to")
@@ -17,24 +21,48 @@ func BindFlags(c *config.Config, flagSet *flag.FlagSet) {
 	flagSet.StringVar(&c.Workstation, "workstation", c.Workstation, "workstation to authenticate from")
 
 	flagSet.DurationVar(&c.Timeout, "timeout", c.Timeout, "timeout")
+	flagSet.StringVar(&c.Proxy, "proxy", c.Proxy, "proxy url to tunnel tcp connections: socks5://host:port, http://host:port")
 
 	flagSet.StringVar(&c.TrasnferEncoding, "transfer-encoding", c.TrasnferEncoding, "transfer encoding: ndr20, ndr64")
 
 	flagSet.StringVar(&c.Credential.Password, "password", c.Credential.Password, "password to authenticate with")
 	flagSet.StringVar(&c.Credential.NTHash, "nthash", c.Credential.NTHash, "NT hash to authenticate with")
+	flagSet.StringVar(&c.Credential.AESKey, "aes-key", c.Credential.AESKey, "kerberos AES128 or AES256 key (hex) to authenticate with")
+	flagSet.StringVar(&c.Credential.Ticket, "ticket-path", c.Credential.Ticket, "path to kerberos ticket (ccache or kirbi) to authenticate with")
 	flagSet.StringVar(&c.Credential.MachineAccountPassword, "machine-account-password", c.Credential.MachineAccountPassword, "machine account password to authenticate with")
 	flagSet.StringVar(&c.Credential.MachineAccountNTHash, "machine-account-nthash", c.Credential.MachineAccountNTHash, "machine account NT hash to authenticate with")
+	flagSet.BoolVar(&c.Credential.Secure, "secure-credential", c.Credential.Secure, "keep the passwords and keys in the locked memory")
 
 	flagSet.StringVar(&c.Auth.Level, "auth-level", c.Auth.Level, "authentication level: none, connect, call, pkt, integrity, privacy")
 	flagSet.StringVar(&c.Auth.Type, "auth-type", c.Auth.Type, "authentication type: ntlm, krb5")
 	flagSet.StringVar(&c.Auth.TargetName, "target-name", c.Auth.TargetName, "target name")
 	flagSet.BoolVar(&c.Auth.SPNEGO, "auth-spnego", c.Auth.SPNEGO, "use spnego")
+	flagSet.BoolVar(&c.Auth.SSPI, "auth-sspi", c.Auth.SSPI, "use the windows sspi (logon session credentials if no password is set)")
+	flagSet.Var(&c.Auth.SPNEGOMechanisms, "auth-spnego-mechanisms", "ordered list of mechanisms to offer with spnego: krb5, ntlm")
+	flagSet.BoolVar(&c.Auth.RequireMutualAuthn, "auth-require-mutual-authn", c.Auth.RequireMutualAuthn, "require mutual authentication")
+	flagSet.Var(&c.Auth.ServerPrincipals, "auth-server-principal", "pattern the server principal must match, ie host/*.contoso.net@CONTOSO.NET (can be repeated)")
+	flagSet.BoolVar(&c.Auth.DenyDowngrade, "auth-deny-downgrade", c.Auth.DenyDowngrade, "fail if the authentication falls back to another mechanism or anonymous")
+	flagSet.BoolVar(&c.Auth.DenyNTLMv1, "auth-deny-ntlmv1", c.Auth.DenyNTLMv1, "fail if the ntlm v1 authentication is used")
+	flagSet.BoolVar(&c.Auth.RequirePrivacy, "auth-require-privacy", c.Auth.RequirePrivacy, "fail if the auth level is lower than privacy")
+	flagSet.BoolVar(&c.Auth.DenyUnauthenticated, "auth-deny-unauthenticated", c.Auth.DenyUnauthenticated, "fail if the bind is not authenticated")
 	flagSet.StringVar(&c.Auth.Impersonation, "impersonation", c.Auth.Impersonation, "impersonation level: anonymous, identify, impersonate, delegate")
 	flagSet.StringVar(&c.Auth.KRB5.ConfigFile, "krb5-config-file", c.Auth.KRB5.ConfigFile, "path to krb5.conf")
 	flagSet.StringVar(&c.Auth.KRB5.KDCServer, "krb5-kdc-server", c.Auth.KRB5.KDCServer, "KDC server to authenticate to")
 	flagSet.StringVar(&c.Auth.KRB5.AdminServer, "krb5-admin-server", c.Auth.KRB5.AdminServer, "admin server to authenticate to")
 	flagSet.StringVar(&c.Auth.KRB5.Keytab, "krb5-keytab-path", c.Auth.KRB5.Keytab, "path to keytab")
+	flagSet.IntVar(&c.Auth.KRB5.KeytabKVNO, "krb5-keytab-kvno", c.Auth.KRB5.KeytabKVNO, "key version number to use from keytab (default is the highest version)")
 	flagSet.StringVar(&c.Auth.KRB5.CCache, "krb5-ccache-path", c.Auth.KRB5.CCache, "path to ccache")
+	flagSet.StringVar(&c.Auth.KRB5.Impersonate, "krb5-impersonate", c.Auth.KRB5.Impersonate, "user to impersonate with S4U2Self and S4U2Proxy (constrained delegation)")
+	flagSet.StringVar(&c.Auth.KRB5.PKINITCertificate, "krb5-pkinit-cert-path", c.Auth.KRB5.PKINITCertificate, "path to PEM-encoded certificate for PKINIT")
+	flagSet.StringVar(&c.Auth.KRB5.PKINITKey, "krb5-pkinit-key-path", c.Auth.KRB5.PKINITKey, "path to PEM-encoded private key for PKINIT")
+	flagSet.StringVar(&c.Auth.KRB5.PKINITPFX, "krb5-pkinit-pfx-path", c.Auth.KRB5.PKINITPFX, "path to PKCS#12 (PFX) file for PKINIT")
+	flagSet.StringVar(&c.Auth.KRB5.PKINITPFXPassword, "krb5-pkinit-pfx-password", c.Auth.KRB5.PKINITPFXPassword, "PKCS#12 (PFX) file password")
+	flagSet.StringVar(&c.Auth.KRB5.PKINITRootCAs, "krb5-pkinit-root-cas-path", c.Auth.KRB5.PKINITRootCAs, "path to PEM-encoded root certificates to verify the KDC certificate")
+	flagSet.BoolVar(&c.Auth.KRB5.PKINITInsecureSkipVerify, "krb5-pkinit-insecure-skip-verify", c.Auth.KRB5.PKINITInsecureSkipVerify, "skip KDC certificate verification for PKINIT")
+	flagSet.StringVar(&c.Auth.KRB5.FASTArmorPrincipal, "krb5-fast-armor-principal", c.Auth.KRB5.FASTArmorPrincipal, "machine account principal to obtain the FAST armor ticket")
+	flagSet.StringVar(&c.Auth.KRB5.FASTArmorPassword, "krb5-fast-armor-password", c.Auth.KRB5.FASTArmorPassword, "FAST armor principal password")
+	flagSet.StringVar(&c.Auth.KRB5.FASTArmorKeytab, "krb5-fast-armor-keytab-path", c.Auth.KRB5.FASTArmorKeytab, "path to keytab with FAST armor principal keys")
+	flagSet.StringVar(&c.Auth.KRB5.FASTArmorCCache, "krb5-fast-armor-ccache-path", c.Auth.KRB5.FASTArmorCCache, "path to ccache with FAST armor ticket")
 	flagSet.Var(&c.Auth.KRB5.EncryptionTypes, "krb5-encryption-types", "encryption types to use: aes256-cts-hmac-sha1-96, aes128-cts-hmac-sha1-96, arcfour-hmac-md5")
 	flagSet.BoolVar(&c.Auth.KRB5.DCEStyle, "krb5-dce-style", c.Auth.KRB5.DCEStyle, "use DCE style")
 	flagSet.BoolVar(&c.Auth.KRB5.DisablePAFXFAST, "krb5-disable-pafx-fast", c.Auth.KRB5.DisablePAFXFAST, "disable PA-FX-FAST")
@@ -51,20 +79,119 @@ func BindFlags(c *config.Config, flagSet *flag.FlagSet) {
 	flagSet.BoolVar(&c.SMB.Sign, "smb-sign", false, "SMB signing")
 	flagSet.BoolVar(&c.SMB.Seal, "smb-seal", false, "SMB sealing")
 	flagSet.StringVar(&c.SMB.Dialect, "smb-dialect", c.SMB.Dialect, "SMB dialect: 2.0.2 (202), 2.1.0 (210), 3.0.0 (300), 3.0.2 (302), 3.1.1 (311)")
+	flagSet.StringVar(&c.SMB.Share, "smb-share", c.SMB.Share, "SMB share that hosts the named pipes (default IPC$)")
+	flagSet.Func("smb-pipe", "named pipe path override in form pipe=path (can be repeated)", func(s string) error {
+		pipe, path, ok := strings.Cut(s, "=")
+		if !ok {
+			return fmt.Errorf("invalid named pipe override: %s", s)
+		}
+		if c.SMB.Pipes == nil {
+			c.SMB.Pipes = make(map[string]string)
+		}
+		c.SMB.Pipes[pipe] = path
+		return nil
+	})
 
 	flagSet.BoolVar(&c.EPM.Enabled, "epm", c.EPM.Enabled, "use endpoint mapper")
 	flagSet.StringVar(&c.EPM.AuthLevel, "epm-auth-level", c.EPM.AuthLevel, "endpoint mapper authentication level: none, connect, call, pkt, integrity, privacy")
 
-	flagSet.StringVar(&c.Protocol, "protocol", c.Protocol, "protocol to use, ncacn_np (smb), ncacn_ip_tcp (tcp)")
+	flagSet.StringVar(&c.Protocol, "protocol", c.Protocol, "protocol to use, ncacn_np (smb), ncacn_ip_tcp (tcp), ncacn_http (http), ncadg_ip_udp (udp)")
+	flagSet.BoolVar(&c.TLS.Enabled, "tls", c.TLS.Enabled, "wrap ncacn_ip_tcp connections in tls")
+	flagSet.BoolVar(&c.TLS.Insecure, "tls-insecure", c.TLS.Insecure, "skip tls server certificate verification")
+	flagSet.StringVar(&c.TLS.ServerName, "tls-server-name", c.TLS.ServerName, "tls server name")
+
+	flagSet.StringVar(&c.HTTP.RPCProxy, "rpc-proxy", c.HTTP.RPCProxy, "RPC over HTTP proxy address (host[:port])")
+
+	flagSet.Func("retry-max-attempts", "maximum number of the call attempts (enables the retries with the default backoff)", c.SetRetryMaxAttempts)
+	flagSet.Float64Var(&c.RateLimit.CallsPerSecond, "rate-limit", c.RateLimit.CallsPerSecond, "maximum number of the calls per second")
+	flagSet.IntVar(&c.RateLimit.Burst, "rate-limit-burst", c.RateLimit.Burst, "maximum number of the calls that can be made at once")
+	flagSet.IntVar(&c.RateLimit.OutstandingCalls, "outstanding-calls", c.RateLimit.OutstandingCalls, "maximum number of the calls awaiting the response on the single connection")
+}
+
+// configFlag is the -config flag. The configuration file is loaded before
+// the environment overrides and the other flags are applied (see
+// ParseAndValidate), so that the flag is a no-op when the flags are parsed.
+type configFlag struct {
+	cfg *config.Config
+	// The configuration file path.
+	path string
+	// The flag that indicates whether the file was loaded.
+	loaded bool
+}
+
+func (f *configFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.path
+}
+
+func (f *configFlag) Set(p string) error {
+	if f.loaded && f.path == p {
+		return nil
+	}
+	f.path, f.loaded = p, true
+	return f.cfg.LoadFile(p)
 }
 
+// configPath function returns the -config flag value from the arguments.
+func configPath(args []string) string {
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--" {
+			break
+		}
+		name, value, ok := strings.Cut(strings.TrimLeft(args[i], "-"), "=")
+		if !strings.HasPrefix(args[i], "-") || name != "config" {
+			continue
+		}
+		if ok {
+			return value
+		}
+		if i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// ParseAndValidate function applies the configuration file (the -config
+// flag or the MSRPC_CONFIG environment variable), the environment overrides
+// and the command line flags in that order, and validates the configuration.
 func ParseAndValidate(cfg *config.Config, flagSet *flag.FlagSet) error {
+	return parseAndValidate(cfg, flagSet, os.Args[1:])
+}
+
+func parseAndValidate(cfg *config.Config, flagSet *flag.FlagSet, args []string) error {
+
+	p := configPath(args)
+	if p == "" {
+		p = os.Getenv(config.EnvConfigPath)
+	}
 
-	flagSet.Parse(os.Args[1:])
+	if p != "" {
+		if fl := flagSet.Lookup("config"); fl != nil {
+			if f, ok := fl.Value.(*configFlag); ok {
+				f.path, f.loaded = p, true
+			}
+		}
+		if err := cfg.LoadFile(p); err != nil {
+			return err
+		}
+	}
+
+	if err := cfg.LoadEnv(); err != nil {
+		return err
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
 
 	if cfg.Server == "" && flagSet.NArg() > 0 {
 		cfg.Server = flagSet.Arg(0)
-		flagSet.Parse(flagSet.Args()[1:])
+		if err := flagSet.Parse(flagSet.Args()[1:]); err != nil {
+			return err
+		}
 	}
 
 	return cfg.Validate()
@@ -0,0 +1,94 @@
+package command_line
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/config"
+)
+
+func TestParsePrecedence(t *testing.T) {
+
+	p := filepath.Join(t.TempDir(), "msrpc.yaml")
+
+	if err := os.WriteFile(p, []byte(`
+username: file
+domain: contoso.net
+workstation: file
+timeout: 1m
+retry:
+  max_attempts: 4
+  initial_backoff: 1s
+rate_limit:
+  calls_per_second: 1
+  burst: 2
+`), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	t.Setenv(config.EnvConfigPath, "")
+	t.Setenv("MSRPC_USERNAME", "env")
+	t.Setenv("MSRPC_WORKSTATION", "env")
+	t.Setenv("MSRPC_RATE_LIMIT", "5")
+
+	cfg := config.New()
+
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	BindFlags(cfg, flagSet)
+
+	// the flag before the -config flag is not overridden by the file.
+	args := []string{"-timeout", "5s", "-config", p, "-workstation", "flag", "127.0.0.1"}
+
+	if err := parseAndValidate(cfg, flagSet, args); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	// file -> env -> flags.
+	if cfg.Username != "contoso.net\\env" || cfg.Workstation != "flag" || cfg.Timeout != 5*time.Second {
+		t.Fatalf("unexpected precedence: %q, %q, %v", cfg.Username, cfg.Workstation, cfg.Timeout)
+	}
+
+	if cfg.Retry == nil || cfg.Retry.MaxAttempts != 4 || cfg.Retry.InitialBackoff != time.Second {
+		t.Fatalf("unexpected retry policy: %+v", cfg.Retry)
+	}
+
+	if cfg.RateLimit.CallsPerSecond != 5 || cfg.RateLimit.Burst != 2 {
+		t.Fatalf("unexpected rate limit: %+v", cfg.RateLimit)
+	}
+
+	if cfg.Server != "127.0.0.1" {
+		t.Fatalf("unexpected server: %q", cfg.Server)
+	}
+}
+
+func TestParsePrecedenceEnvConfig(t *testing.T) {
+
+	p := filepath.Join(t.TempDir(), "msrpc.json")
+
+	if err := os.WriteFile(p, []byte(`{"username": "file", "domain": "contoso.net", "workstation": "file", "timeout": "1m"}`), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	// the configuration file is taken from the environment.
+	t.Setenv(config.EnvConfigPath, p)
+	t.Setenv("MSRPC_USERNAME", "env")
+	t.Setenv("MSRPC_WORKSTATION", "env")
+	t.Setenv("MSRPC_TIMEOUT", "30s")
+
+	cfg := config.New()
+
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	BindFlags(cfg, flagSet)
+
+	if err := parseAndValidate(cfg, flagSet, []string{"-workstation", "flag", "127.0.0.1"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	// file -> env -> flags.
+	if cfg.Username != "contoso.net\\env" || cfg.Workstation != "flag" || cfg.Timeout != 30*time.Second {
+		t.Fatalf("unexpected precedence: %q, %q, %v", cfg.Username, cfg.Workstation, cfg.Timeout)
+	}
+}
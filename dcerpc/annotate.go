@@ -0,0 +1,101 @@
+package dcerpc
+
+// annotate.go contains the response annotation hook (for example, the
+// resolution of the security identifiers to the account names).
+
+import (
+	"context"
+)
+
+// Annotations is the set of the response annotations produced by the
+// connection annotator. The key and value format is defined by the annotator
+// (for example, the SID string is mapped to the account name).
+type Annotations map[string]string
+
+// Annotator interface annotates the decoded output parameters of the
+// operation.
+type Annotator interface {
+	// Annotate function adds the annotations for the operation output
+	// parameters to `a`.
+	Annotate(ctx context.Context, op Operation, a Annotations) error
+}
+
+// AnnotatorFunc is the function implementing the Annotator interface.
+type AnnotatorFunc func(context.Context, Operation, Annotations) error
+
+// Annotate function calls f(ctx, op, a).
+func (f AnnotatorFunc) Annotate(ctx context.Context, op Operation, a Annotations) error {
+	return f(ctx, op, a)
+}
+
+// WithAnnotator option sets the annotator called for every successfully
+// decoded response on the connection. The annotations are best-effort, the
+// annotator error does not fail the call.
+//
+// The annotator is called synchronously after the call is completed, and
+// must not perform the calls over the annotated connection with the same
+// annotator to avoid the recursion. The option is ignored by the
+// connectionless protocol (ncadg_ip_udp).
+func WithAnnotator(a Annotator) ConnectOption {
+	return func(o *Transport) { o.Annotator = a }
+}
+
+// AnnotationsOption option requests the response annotations.
+type AnnotationsOption struct {
+	// The annotations target.
+	Annotations *Annotations
+}
+
+// CallOption interface implementation.
+func (AnnotationsOption) is_rpcCallOption() {}
+
+// WithAnnotations option stores the annotations produced by the connection
+// annotator (see WithAnnotator) for the call response to `a`:
+//
+//	var names dcerpc.Annotations
+//
+//	resp, err := cli.QuerySecurity(ctx, req, dcerpc.WithAnnotations(&names))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	for sid, name := range names {
+//		fmt.Println(sid, name)
+//	}
+func WithAnnotations(a *Annotations) AnnotationsOption {
+	return AnnotationsOption{Annotations: a}
+}
+
+// HasAnnotations function returns the annotations option if the set of
+// call options contains one.
+func HasAnnotations(opts []CallOption) (AnnotationsOption, bool) {
+	for i := range opts {
+		if opt, ok := (any)(opts[i]).(AnnotationsOption); ok && opt.Annotations != nil {
+			return opt, true
+		}
+	}
+	return AnnotationsOption{}, false
+}
+
+// annotate function annotates the decoded operation output parameters.
+func (c *clientConn) annotate(ctx context.Context, tr *transport, op Operation, opts []CallOption) {
+
+	if tr == nil || tr.settings.Annotator == nil {
+		return
+	}
+
+	a := Annotations{}
+
+	if err := tr.settings.Annotator.Annotate(ctx, op, a); err != nil {
+		c.logger.Debug().Err(err).Str("op", op.OpName()).Msg("annotate")
+	}
+
+	if opt, ok := HasAnnotations(opts); ok {
+		if *opt.Annotations == nil {
+			*opt.Annotations = Annotations{}
+		}
+		for k, v := range a {
+			(*opt.Annotations)[k] = v
+		}
+	}
+}
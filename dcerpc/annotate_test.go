@@ -0,0 +1,29 @@
+package dcerpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+func TestAnnotator(t *testing.T) {
+
+	cc, _ := testEchoServer(t, dcerpc.WithAnnotator(dcerpc.AnnotatorFunc(func(ctx context.Context, op dcerpc.Operation, a dcerpc.Annotations) error {
+		if op, ok := op.(*echoOp); ok {
+			a["reply"] = fmt.Sprint(op.Reply)
+		}
+		return nil
+	})))
+
+	var a dcerpc.Annotations
+
+	if err := cc.Invoke(context.Background(), &echoOp{Value: 2}, dcerpc.WithAnnotations(&a)); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	if a["reply"] != "2" {
+		t.Fatalf("unexpected annotations: %v", a)
+	}
+}
@@ -0,0 +1,164 @@
+package dcerpc
+
+// authn_policy.go contains the client authentication policy enforced once
+// the security context is established.
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+var (
+	// The server principal does not match the expected service principal names.
+	ErrServerPrincipalMismatch = errors.New("server principal does not match the expected service principal name")
+	// The security context was established with the mechanism not allowed by
+	// the policy, or anonymously.
+	ErrAuthnDowngrade = errors.New("authentication was downgraded")
+	// The NTLMv1 challenge response was used while denied by the policy.
+	ErrNTLMv1NotAllowed = errors.New("ntlmv1 authentication is not allowed")
+	// The authentication level is lower than the packet privacy while the
+	// privacy is required by the policy.
+	ErrPrivacyRequired = errors.New("packet privacy authentication level is required")
+	// The bind without the authentication was attempted while denied by the
+	// policy.
+	ErrUnauthenticatedBind = errors.New("unauthenticated bind is not allowed")
+)
+
+// AuthnPolicy represents the client authentication policy. The policy is
+// verified once the security context is established, the bind (or alter
+// context) fails if the security context does not satisfy the policy, so
+// that the relay of the authentication and the downgrade are detected.
+type AuthnPolicy struct {
+	// The flag that indicates whether the mutual authentication is
+	// required (see RequireMutualAuthn).
+	RequireMutualAuthn bool
+	// The list of the patterns the server principal must match, for
+	// example "host/*.contoso.net@CONTOSO.NET" (see path.Match, the
+	// match is case-insensitive). The security context that does not
+	// provide the server principal (ie NTLM) does not match.
+	ServerPrincipals []string
+	// The list of the mechanisms allowed to establish the security
+	// context (for SPNEGO, the negotiated mechanism), for example
+	// Kerberos only to deny the fallback to NTLM.
+	Mechanisms []gssapi.OID
+	// The flag that indicates whether the anonymous security context
+	// is denied.
+	DenyAnonymous bool
+	// The flag that indicates whether the NTLMv1 (including NTLMv1 with
+	// the extended session security) is denied.
+	DenyNTLMv1 bool
+	// The flag that indicates whether the packet privacy authentication
+	// level is required (see WithSeal).
+	RequirePrivacy bool
+	// The flag that indicates whether the bind without the authentication
+	// (the authentication level none, see WithInsecure) is denied.
+	DenyUnauthenticated bool
+}
+
+// WithAuthnPolicy option sets the client authentication policy for the
+// security context:
+//
+//	cli, err := winreg.NewWinregClient(ctx, conn, dcerpc.WithSeal(), dcerpc.WithAuthnPolicy(&dcerpc.AuthnPolicy{
+//		RequireMutualAuthn: true,
+//		ServerPrincipals:   []string{"host/*.contoso.net@CONTOSO.NET"},
+//		Mechanisms:         []gssapi.OID{ssp.MechanismTypeKRB5},
+//		DenyAnonymous:      true,
+//	}))
+func WithAuthnPolicy(p *AuthnPolicy) SecurityOption {
+	return SecurityOption(func(ctx *Security) {
+		if ctx.AuthnPolicy = p; p != nil && p.RequireMutualAuthn {
+			ctx.RequireMutualAuthn = true
+		}
+	})
+}
+
+// MatchServerPrincipal function returns `true` if the server principal
+// matches any of the policy server principal patterns (or the list of
+// patterns is empty).
+func (p *AuthnPolicy) MatchServerPrincipal(principal string) bool {
+
+	if p == nil || len(p.ServerPrincipals) == 0 {
+		return true
+	}
+
+	if principal == "" {
+		return false
+	}
+
+	for _, pattern := range p.ServerPrincipals {
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(principal)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verifyLevel function verifies the security context `cc` authentication
+// level against the policy before the security context is established.
+func (p *AuthnPolicy) verifyLevel(cc *Security) error {
+
+	if p == nil {
+		return nil
+	}
+
+	unauthenticated := cc.Level == AuthLevelNone || cc.Type == AuthTypeNone
+
+	if p.DenyUnauthenticated && unauthenticated {
+		return fmt.Errorf("%w: %w", ErrAuthnDowngrade, ErrUnauthenticatedBind)
+	}
+
+	if p.RequirePrivacy && (unauthenticated || cc.Level < AuthLevelPktPrivacy) {
+		return fmt.Errorf("%w: %w: level %d", ErrAuthnDowngrade, ErrPrivacyRequired, cc.Level)
+	}
+
+	return nil
+}
+
+// verify function verifies the established security context `cc` against
+// the policy.
+func (p *AuthnPolicy) verify(cc *Security) error {
+
+	if p == nil {
+		return nil
+	}
+
+	if p.RequireMutualAuthn && !cc.mutualAuthn() {
+		return ErrMutualAuthnNotPerformed
+	}
+
+	if len(p.Mechanisms) > 0 {
+		mech, _ := getAttribute[gssapi.OID](cc, gssapi.AttributeMechanismType)
+		if !hasMechanism(p.Mechanisms, mech) {
+			return fmt.Errorf("%w: mechanism %s is not allowed", ErrAuthnDowngrade, mech)
+		}
+	}
+
+	if version, _ := getAttribute[int](cc, gssapi.AttributeNTLMVersion); p.DenyNTLMv1 && version == 1 {
+		return fmt.Errorf("%w: %w", ErrAuthnDowngrade, ErrNTLMv1NotAllowed)
+	}
+
+	if anonymous, _ := getAttribute[bool](cc, gssapi.AttributeAnonymous); p.DenyAnonymous && anonymous {
+		return fmt.Errorf("%w: anonymous security context", ErrAuthnDowngrade)
+	}
+
+	if principal, _ := getAttribute[string](cc, gssapi.AttributeServerPrincipal); !p.MatchServerPrincipal(principal) {
+		return fmt.Errorf("%w: %q", ErrServerPrincipalMismatch, principal)
+	}
+
+	return nil
+}
+
+// hasMechanism function returns `true` if the mechanism `mech` is in the list.
+func hasMechanism(mechs []gssapi.OID, mech gssapi.OID) bool {
+	for i := range mechs {
+		if mech != nil && mechs[i].Equal(mech) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+func TestAuthnPolicy(t *testing.T) {
+
+	policy := &AuthnPolicy{
+		ServerPrincipals: []string{"host/*.contoso.net@CONTOSO.NET"},
+		Mechanisms:       []gssapi.OID{ssp.MechanismTypeKRB5},
+		DenyAnonymous:    true,
+	}
+
+	for _, testCase := range []struct {
+		Name   string
+		Policy *AuthnPolicy
+		Attrs  map[string]any
+		Err    error
+	}{
+		{"no policy", nil, nil, nil},
+		{"kerberos", policy, map[string]any{
+			gssapi.AttributeMechanismType:   ssp.MechanismTypeKRB5,
+			gssapi.AttributeServerPrincipal: "host/DC01.contoso.net@CONTOSO.NET",
+		}, nil},
+		{"principal mismatch", policy, map[string]any{
+			gssapi.AttributeMechanismType:   ssp.MechanismTypeKRB5,
+			gssapi.AttributeServerPrincipal: "host/evil.example.com@EXAMPLE.COM",
+		}, ErrServerPrincipalMismatch},
+		{"ntlm fallback", policy, map[string]any{
+			gssapi.AttributeMechanismType: ssp.MechanismTypeNTLM,
+		}, ErrAuthnDowngrade},
+		{"anonymous", &AuthnPolicy{DenyAnonymous: true}, map[string]any{
+			gssapi.AttributeMechanismType: ssp.MechanismTypeNTLM,
+			gssapi.AttributeAnonymous:     true,
+		}, ErrAuthnDowngrade},
+		{"mutual authn", &AuthnPolicy{RequireMutualAuthn: true}, map[string]any{
+			gssapi.AttributeMutualAuthn: false,
+		}, ErrMutualAuthnNotPerformed},
+		{"ntlmv1", &AuthnPolicy{DenyNTLMv1: true}, map[string]any{
+			gssapi.AttributeMechanismType: ssp.MechanismTypeNTLM,
+			gssapi.AttributeNTLMVersion:   1,
+		}, ErrNTLMv1NotAllowed},
+		{"ntlmv2", &AuthnPolicy{DenyNTLMv1: true}, map[string]any{
+			gssapi.AttributeMechanismType: ssp.MechanismTypeNTLM,
+			gssapi.AttributeNTLMVersion:   2,
+		}, nil},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+
+			ctx := gssapi.NewSecurityContext(context.Background())
+			for name, value := range testCase.Attrs {
+				gssapi.SetAttribute(ctx, name, value)
+			}
+
+			sec := &Security{ctx: ctx}
+			WithAuthnPolicy(testCase.Policy)(sec)
+
+			if testCase.Policy != nil && sec.RequireMutualAuthn != testCase.Policy.RequireMutualAuthn {
+				t.Fatalf("require mutual authn is not set")
+			}
+
+			if err := sec.AuthnPolicy.verify(sec); !errors.Is(err, testCase.Err) || (err == nil) != (testCase.Err == nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthnPolicyLevel(t *testing.T) {
+
+	for _, testCase := range []struct {
+		Name   string
+		Policy *AuthnPolicy
+		Level  AuthLevel
+		Type   AuthType
+		Err    error
+	}{
+		{"no policy", nil, AuthLevelNone, AuthTypeNone, nil},
+		{"unauthenticated", &AuthnPolicy{DenyUnauthenticated: true}, AuthLevelNone, AuthTypeNone, ErrUnauthenticatedBind},
+		{"authenticated", &AuthnPolicy{DenyUnauthenticated: true}, AuthLevelConnect, AuthTypeWinNT, nil},
+		{"integrity", &AuthnPolicy{RequirePrivacy: true}, AuthLevelPktIntegrity, AuthTypeWinNT, ErrPrivacyRequired},
+		{"privacy", &AuthnPolicy{RequirePrivacy: true}, AuthLevelPktPrivacy, AuthTypeKerberos, nil},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+
+			sec := &Security{Level: testCase.Level, Type: testCase.Type, AuthnPolicy: testCase.Policy}
+
+			if err := sec.AuthnPolicy.verifyLevel(sec); !errors.Is(err, testCase.Err) || (err == nil) != (testCase.Err == nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if testCase.Err == nil {
+				return
+			}
+
+			// the bind fails before the security context is established.
+			if _, err := sec.Init(context.Background(), nil); !errors.Is(err, ErrAuthnDowngrade) || sec.Established() {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
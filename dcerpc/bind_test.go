@@ -0,0 +1,56 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+func TestBindNak(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		return nil, nil
+	})
+
+	go srv.Serve(ln)
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	// the test server does not support the authentication.
+	_, err = conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithSign(),
+		dcerpc.WithMechanism(ssp.NTLM), dcerpc.WithCredentials(credential.NewFromPassword("user", "password")))
+	if !errors.Is(err, dcerpc.ErrBindRejected) || !errors.Is(err, dcerpc.ErrAuthTypeNotRecognized) {
+		t.Fatalf("expected authentication type not recognized, got %v", err)
+	}
+
+	var nak *dcerpc.BindNak
+	if !errors.As(err, &nak) || nak.ProviderRejectReason != dcerpc.AuthTypeNotRecognized {
+		t.Fatalf("expected bind_nak, got %v", err)
+	}
+
+	// the supported protocol versions are reported.
+	nak = &dcerpc.BindNak{
+		ProviderRejectReason: dcerpc.ReasonProtocolVersionNotSupported,
+		VersionList:          []*dcerpc.Version{{Major: 5, Minor: 0}},
+	}
+
+	if !errors.Is(nak, dcerpc.ErrProtocolVersionNotSupported) || !strings.HasSuffix(nak.Error(), "supported versions: 5.0") {
+		t.Fatalf("unexpected error: %v", nak)
+	}
+}
@@ -19,12 +19,14 @@ func (p ProtocolSequence) String() string {
 	switch p {
 	case ProtocolSequenceIPTCP:
 		return "ncacn_ip_tcp"
+	case ProtocolSequenceIPUDP:
+		return "ncadg_ip_udp"
 	case ProtocolSequenceNamedPipe:
 		return "ncacn_np"
 	case ProtocolSequenceHTTP:
 		return "ncacn_http"
 	case ProtocolSequenceLRPC:
-		return "ncacnlrpc"
+		return "ncalrpc"
 	}
 
 	return "unknown"
@@ -132,11 +134,28 @@ func afterSym(s string, sym string) string { return s[strings.LastIndex(s, sym)+
 
 func afterSlash(s string) string { return afterSym(s, "\\") }
 
+// ShareName function returns the SMB share name that hosts the named
+// pipes. (IPC$ unless overridden with `share=` endpoint option).
 func (s StringBinding) ShareName() string {
+	share := "IPC$"
+	if v, ok := s.ExtraValue("share"); ok && v != "" {
+		share = strings.Trim(v, "\\")
+	}
 	if s.ComputerName != "" {
-		return "\\\\" + strings.TrimLeft(s.ComputerName, "\\") + "\\IPC$"
+		return "\\\\" + strings.TrimLeft(s.ComputerName, "\\") + "\\" + share
+	}
+	return share
+}
+
+// ExtraValue function returns the value of the `key=value` endpoint
+// option.
+func (s StringBinding) ExtraValue(key string) (string, bool) {
+	for _, extra := range s.Extra {
+		if k, v, ok := strings.Cut(extra, "="); ok && strings.EqualFold(k, key) {
+			return v, true
+		}
 	}
-	return "IPC$"
+	return "", false
 }
 
 // MatchTarget function returns true if the target name of the string binding
@@ -155,7 +174,12 @@ func (s StringBinding) TargetName() string {
 	return s.NetworkAddress
 }
 
+// NamedPipe function returns the named pipe name. (or the pipe path
+// if overridden with `pipe=` endpoint option).
 func (s StringBinding) NamedPipe() string {
+	if v, ok := s.ExtraValue("pipe"); ok && v != "" {
+		return strings.TrimPrefix(strings.TrimLeft(v, "\\"), "pipe\\")
+	}
 	return afterSlash(s.Endpoint)
 }
 
@@ -166,6 +190,8 @@ func (s StringBinding) URL() *url.URL {
 	switch s.ProtocolSequence {
 	case ProtocolSequenceIPTCP:
 		u.Scheme, u.Host = "tcp", net.JoinHostPort(s.NetworkAddress, s.Endpoint)
+	case ProtocolSequenceIPUDP:
+		u.Scheme, u.Host = "udp", net.JoinHostPort(s.NetworkAddress, s.Endpoint)
 	case ProtocolSequenceNamedPipe:
 		if u.Scheme, u.Host = "smb", afterSlash(s.NetworkAddress); u.Host == "" {
 			u.Host = afterSlash(s.ComputerName)
@@ -198,23 +224,119 @@ func (s StringBinding) String() string {
 		ret += s.ComputerName
 	}
 
-	if s.Endpoint != "" {
-		ret += "[" + s.Endpoint + "]"
+	if s.Endpoint != "" || len(s.Extra) > 0 {
+		ret += "[" + strings.Join(append([]string{s.Endpoint}, s.Extra...), ",") + "]"
 	}
 
 	return ret
 }
 
+// ComposeStringBinding function returns the string binding composed from
+// the object UUID (optional), protocol sequence, network address, endpoint
+// and endpoint options (`key=value`), similar to the RpcStringBindingCompose:
+//
+//	// ncacn_np:dc01[\pipe\srvsvc,share=IPC$]
+//	b, err := dcerpc.ComposeStringBinding("", "ncacn_np", "dc01", "\\pipe\\srvsvc", "share=IPC$")
+func ComposeStringBinding(objectUUID, protocolSequence, networkAddress, endpoint string, options ...string) (*StringBinding, error) {
+
+	b := &StringBinding{
+		ProtocolSequence: ProtocolSequenceFromString(protocolSequence),
+		Endpoint:         endpoint,
+		Extra:            options,
+	}
+
+	if b.ProtocolSequence == 0 {
+		return nil, fmt.Errorf("compose string binding: unknown protocol sequence %q", protocolSequence)
+	}
+
+	if objectUUID != "" {
+		u, err := uuid.Parse(objectUUID)
+		if err != nil {
+			return nil, fmt.Errorf("compose string binding: object uuid: %w", err)
+		}
+		b.ObjectUUID = u
+	}
+
+	switch b.ProtocolSequence {
+	case ProtocolSequenceNamedPipe:
+		b.ComputerName = networkAddress
+	default:
+		b.NetworkAddress = networkAddress
+	}
+
+	if strings.ContainsAny(networkAddress+endpoint, "[],@") {
+		return nil, fmt.Errorf("compose string binding: malformed network address or endpoint")
+	}
+
+	for _, o := range options {
+		if strings.ContainsAny(o, "[],") {
+			return nil, fmt.Errorf("compose string binding: malformed endpoint option %q", o)
+		}
+	}
+
+	return b, nil
+}
+
+// ServerAddr function returns the server address for the string binding
+// (the network address or the computer name).
+func (s StringBinding) ServerAddr() string {
+	if s.NetworkAddress != "" {
+		return s.NetworkAddress
+	}
+	return s.ComputerName
+}
+
+// DialOptions function returns the options that connect to the string
+// binding endpoint (if set) with the endpoint options applied:
+//
+//	b, err := dcerpc.ParseStringBinding("ncacn_np:dc01[\\pipe\\srvsvc,smb_port=4445]")
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	opts, err := b.DialOptions()
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	conn, err := dcerpc.Dial(ctx, b.ServerAddr(), opts...)
+func (s StringBinding) DialOptions() ([]Option, error) {
+
+	var opts []Option
+
+	if port, ok := s.ExtraValue("smb_port"); ok {
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("dial options: invalid smb_port: %w", err)
+		}
+		opts = append(opts, WithSMBPort(int(p)))
+	}
+
+	if s.ObjectUUID != nil {
+		opts = append(opts, WithObjectUUID(s.ObjectUUID))
+	}
+
+	if s.Complete() {
+		// the share and rpc proxy endpoint options are applied
+		// per endpoint.
+		opts = append(opts, WithEndpoint(s.String()))
+	}
+
+	return opts, nil
+}
+
 func ProtocolSequenceFromString(s string) ProtocolSequence {
 	var p ProtocolSequence
 	switch strings.ToLower(s) {
 	case "ncacn_ip_tcp":
 		p = ProtocolSequenceIPTCP
+	case "ncadg_ip_udp":
+		p = ProtocolSequenceIPUDP
 	case "ncacn_np":
 		p = ProtocolSequenceNamedPipe
 	case "ncacn_http":
 		p = ProtocolSequenceHTTP
-	case "ncacnlrpc":
+	case "ncalrpc", "ncacnlrpc":
 		p = ProtocolSequenceLRPC
 	}
 	return p
@@ -295,6 +417,9 @@ func ParseBindingURL(s string) (*Binding, error) {
 			return nil, fmt.Errorf("invalid binding url: named pipe is missing")
 		}
 		url.StringBinding.Endpoint, p = p[0], p[1:]
+		if u.Port() != "" {
+			url.StringBinding.Extra = append(url.StringBinding.Extra, "smb_port="+u.Port())
+		}
 	case "alpc":
 		url.StringBinding.ProtocolSequence = ProtocolSequenceLRPC
 		url.StringBinding.Endpoint = u.Hostname()
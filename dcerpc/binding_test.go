@@ -0,0 +1,113 @@
+package dcerpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestStringBinding(t *testing.T) {
+
+	for _, tc := range []struct {
+		s, str, addr, pipe, share string
+	}{
+		{"ncacn_ip_tcp:dc01[135]", "ncacn_ip_tcp:dc01[135]", "dc01", "", "IPC$"},
+		{"ncacn_np:dc01[\\pipe\\srvsvc]", "ncacn_np:dc01[\\pipe\\srvsvc]", "dc01", "srvsvc", "\\\\dc01\\IPC$"},
+		{"ncacn_np:dc01[winreg,share=RPC$,smb_port=4445]", "ncacn_np:dc01[winreg,share=RPC$,smb_port=4445]", "dc01", "winreg", "\\\\dc01\\RPC$"},
+		{"ncacn_http:mail[6001,RpcProxy=mail:443]", "ncacn_http:mail[6001,RpcProxy=mail:443]", "mail", "", "IPC$"},
+		{"12345678-1234-1234-1234-123456789abc@ncadg_ip_udp:10.0.0.1[1034]", "12345678-1234-1234-1234-123456789abc@ncadg_ip_udp:10.0.0.1[1034]", "10.0.0.1", "", "IPC$"},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+
+			b, err := dcerpc.ParseStringBinding(tc.s)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+
+			if b.String() != tc.str || b.ServerAddr() != tc.addr || b.ShareName() != tc.share {
+				t.Fatalf("unexpected binding: %q, %q, %q", b, b.ServerAddr(), b.ShareName())
+			}
+
+			if tc.pipe != "" && b.NamedPipe() != tc.pipe {
+				t.Fatalf("expected pipe %q, got %q", tc.pipe, b.NamedPipe())
+			}
+
+			var uuid string
+			if b.ObjectUUID != nil {
+				uuid = b.ObjectUUID.String()
+			}
+
+			b2, err := dcerpc.ComposeStringBinding(uuid, b.ProtocolSequence.String(), b.ServerAddr(), b.Endpoint, b.Extra...)
+			if err != nil {
+				t.Fatalf("compose: %v", err)
+			}
+
+			if b2.String() != tc.str {
+				t.Fatalf("expected %q, got %q", tc.str, b2)
+			}
+		})
+	}
+
+	for _, tc := range [][]string{
+		{"", "ncacn_unknown", "dc01", "135"},
+		{"not-a-uuid", "ncacn_ip_tcp", "dc01", "135"},
+		{"", "ncacn_np", "dc01[x]", "srvsvc"},
+		{"", "ncacn_np", "dc01", "srvsvc", "share=a,b"},
+	} {
+		if _, err := dcerpc.ComposeStringBinding(tc[0], tc[1], tc[2], tc[3], tc[4:]...); err == nil {
+			t.Fatalf("%v: expected error", tc)
+		}
+	}
+}
+
+func TestStringBindingDialOptions(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	b, err := dcerpc.ComposeStringBinding("", "ncacn_ip_tcp", "127.0.0.1", "135")
+	if err != nil {
+		t.Fatalf("compose: %v", err)
+	}
+
+	opts, err := b.DialOptions()
+	if err != nil {
+		t.Fatalf("dial options: %v", err)
+	}
+
+	conn, err := dcerpc.Dial(ctx, b.ServerAddr(), append(opts, dcerpc.WithDialer(ln))...)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	op := &echoOp{Value: 5}
+	if err := cc.Invoke(ctx, op); err != nil || op.Reply != 5 {
+		t.Fatalf("invoke: %v, reply %d", err, op.Reply)
+	}
+
+	b.Extra = []string{"smb_port=x"}
+	if _, err := b.DialOptions(); err == nil {
+		t.Fatalf("expected invalid smb_port error")
+	}
+}
@@ -0,0 +1,167 @@
+package dcerpc
+
+// budget.go contains the per-operation request/response size and latency
+// budgets.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// ErrBudgetExceeded is returned (wrapped into BudgetExceededError) when the
+// call exceeds the operation budget.
+var ErrBudgetExceeded = errors.New("budget exceeded")
+
+// BudgetKind is the kind of the exceeded budget.
+type BudgetKind int
+
+const (
+	// The request stub data size budget.
+	BudgetRequestSize BudgetKind = iota + 1
+	// The response stub data size budget.
+	BudgetResponseSize
+	// The call latency budget.
+	BudgetLatency
+)
+
+func (k BudgetKind) String() string {
+	switch k {
+	case BudgetRequestSize:
+		return "request size"
+	case BudgetResponseSize:
+		return "response size"
+	case BudgetLatency:
+		return "latency"
+	}
+	return "unknown"
+}
+
+// BudgetExceededError is the budget violation error.
+type BudgetExceededError struct {
+	// The operation name.
+	OpName string
+	// The exceeded budget.
+	Kind BudgetKind
+	// The budget limit (bytes or nanoseconds).
+	Limit int64
+	// The actual value (bytes or nanoseconds), the response size
+	// can be reported partially, as the response is not read entirely.
+	Actual int64
+}
+
+func (e *BudgetExceededError) Error() string {
+	if e.Kind == BudgetLatency {
+		return fmt.Sprintf("%s: %s %v: %s", e.OpName, e.Kind, time.Duration(e.Limit), ErrBudgetExceeded)
+	}
+	return fmt.Sprintf("%s: %s %d > %d: %s", e.OpName, e.Kind, e.Actual, e.Limit, ErrBudgetExceeded)
+}
+
+func (e *BudgetExceededError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// Budget is the operation budget, zero value disables the limit.
+type Budget struct {
+	// The maximum request stub data size.
+	MaxRequestSize int `json:"max_request_size,omitempty" yaml:"max_request_size,omitempty"`
+	// The maximum response stub data size.
+	MaxResponseSize int `json:"max_response_size,omitempty" yaml:"max_response_size,omitempty"`
+	// The maximum call latency.
+	MaxLatency time.Duration `json:"max_latency,omitempty" yaml:"max_latency,omitempty"`
+}
+
+// Budgets is the set of the operation budgets.
+//
+// The operations are matched by the full operation name (for example,
+// "/srvsvc/v3/NetrShareEnum") or by the method name ("NetrShareEnum"):
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", dcerpc.WithBudgets(&dcerpc.Budgets{
+//		Default: dcerpc.Budget{MaxLatency: 30 * time.Second},
+//		Operations: map[string]dcerpc.Budget{
+//			"NetrShareEnum": {MaxResponseSize: 16 << 20},
+//		},
+//	}))
+//
+// The request size budget is checked before the request is sent. If the
+// response size budget is exceeded, the rest of the response is discarded
+// without decoding. If the latency budget is exceeded, the transport is
+// closed, as for the timeout.
+type Budgets struct {
+	// The budget for the operations without the explicit budget.
+	Default Budget `json:"default,omitempty" yaml:"default,omitempty"`
+	// The budgets per operation name.
+	Operations map[string]Budget `json:"operations,omitempty" yaml:"operations,omitempty"`
+}
+
+// WithBudgets option sets the operation budgets for the connection.
+func WithBudgets(b *Budgets) ConnectOption {
+	return func(o *Transport) { o.Budgets = b }
+}
+
+// Budget function returns the budget for the operation.
+func (b *Budgets) Budget(op Operation) Budget {
+
+	if b == nil {
+		return Budget{}
+	}
+
+	name := op.OpName()
+
+	if budget, ok := b.Operations[name]; ok {
+		return budget
+	}
+
+	if budget, ok := b.Operations[name[strings.LastIndex(name, "/")+1:]]; ok {
+		return budget
+	}
+
+	return b.Default
+}
+
+// checkRequest function checks the request stub data size.
+func (b Budget) checkRequest(ctx context.Context, op Operation, p *Presentation) error {
+
+	if b.MaxRequestSize <= 0 {
+		return nil
+	}
+
+	stub, err := p.TransferEncoding()(nil).Marshal(ctx, ndr.MarshalNDRFunc(op.MarshalNDRRequest))
+	if err != nil {
+		return err
+	}
+
+	if len(stub) > b.MaxRequestSize {
+		return &BudgetExceededError{OpName: op.OpName(), Kind: BudgetRequestSize, Limit: int64(b.MaxRequestSize), Actual: int64(len(stub))}
+	}
+
+	return nil
+}
+
+// checkResponse function checks the response stub data size (`hint` is
+// the allocation hint of the response).
+func (b Budget) checkResponse(op Operation, sz, hint int) error {
+
+	if b.MaxResponseSize <= 0 {
+		return nil
+	}
+
+	if sz = max(sz, hint); sz > b.MaxResponseSize {
+		return &BudgetExceededError{OpName: op.OpName(), Kind: BudgetResponseSize, Limit: int64(b.MaxResponseSize), Actual: int64(sz)}
+	}
+
+	return nil
+}
+
+// latencyErr function converts the deadline error to the budget violation
+// if the latency budget has expired.
+func (b Budget) latencyErr(ctx, parent context.Context, op Operation, err error) error {
+	if b.MaxLatency <= 0 || parent.Err() != nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	return &BudgetExceededError{OpName: op.OpName(), Kind: BudgetLatency, Limit: int64(b.MaxLatency), Actual: int64(b.MaxLatency)}
+}
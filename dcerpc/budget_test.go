@@ -0,0 +1,79 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+func TestBudgets(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, started := testEchoServer(t, dcerpc.WithBudgets(&dcerpc.Budgets{
+		Default: dcerpc.Budget{MaxLatency: 100 * time.Millisecond},
+		Operations: map[string]dcerpc.Budget{
+			"Echo": {MaxRequestSize: 2, MaxLatency: 100 * time.Millisecond},
+		},
+	}))
+
+	var budgetErr *dcerpc.BudgetExceededError
+
+	// the request is not sent.
+	if err := cc.Invoke(ctx, &echoOp{Value: 1}); !errors.As(err, &budgetErr) || budgetErr.Kind != dcerpc.BudgetRequestSize {
+		t.Fatalf("expected request size budget error, got %v", err)
+	}
+
+	select {
+	case <-started:
+		t.Fatalf("unexpected request")
+	default:
+	}
+
+	// the slow call exceeds the latency budget.
+	if err := cc.Invoke(ctx, &slowEchoOp{echoOp{Value: 1}}); !errors.As(err, &budgetErr) || budgetErr.Kind != dcerpc.BudgetLatency {
+		t.Fatalf("expected latency budget error, got %v", err)
+	}
+
+	if !errors.Is(budgetErr, dcerpc.ErrBudgetExceeded) {
+		t.Fatalf("expected budget exceeded error")
+	}
+}
+
+// slowEchoOp is the echo operation without the budget.
+type slowEchoOp struct {
+	echoOp
+}
+
+func (o *slowEchoOp) OpName() string { return "SlowEcho" }
+
+// listValuesOp is the values operation served by the echo server.
+type listValuesOp struct {
+	valuesOp
+}
+
+func (o *listValuesOp) OpNum() int { return 1 }
+
+func TestResponseSizeBudget(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t, dcerpc.WithBudgets(&dcerpc.Budgets{
+		Operations: map[string]dcerpc.Budget{"Values": {MaxResponseSize: 1024}},
+	}))
+
+	var budgetErr *dcerpc.BudgetExceededError
+
+	if err := cc.Invoke(ctx, &listValuesOp{}); !errors.As(err, &budgetErr) || budgetErr.Kind != dcerpc.BudgetResponseSize {
+		t.Fatalf("expected response size budget error, got %v", err)
+	}
+
+	// the rest of the response is discarded, the connection is usable.
+	echo := &echoOp{Value: 7}
+	if err := cc.Invoke(ctx, echo); err != nil || echo.Reply != 7 {
+		t.Fatalf("invoke: %v (reply %d)", err, echo.Reply)
+	}
+}
@@ -0,0 +1,22 @@
+package dcerpc
+
+// call_buffer.go contains the pool of the call fragment buffers, so that the
+// buffers are not allocated for every call (and every cancellation).
+
+// getBuffer function returns the fragment buffer of size `size` from the
+// transport buffer pool. The buffer must be returned with putBuffer once the
+// call is completed.
+func (t *transport) getBuffer(size int) *[]byte {
+	if b, ok := t.buffers.Get().(*[]byte); ok && cap(*b) >= size {
+		*b = (*b)[:size]
+		return b
+	}
+	// the pool is empty or the fragment size was renegotiated.
+	b := make([]byte, size)
+	return &b
+}
+
+// putBuffer function returns the fragment buffer to the transport buffer pool.
+func (t *transport) putBuffer(b *[]byte) {
+	t.buffers.Put(b)
+}
@@ -0,0 +1,138 @@
+package dcerpc
+
+// cancel.go contains the call cancellation (co_cancel and co_orphaned PDUs).
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+)
+
+// WithCancel option enables the cancel PDUs: when the caller context is
+// cancelled while the request is being sent, the orphaned PDU is sent to
+// the server, and when the context is cancelled while the response is
+// awaited, the cancel PDU is sent and the response (or fault) is awaited
+// at most `timeout` and discarded. The connection remains open unless the
+// server does not respond in time (or the keep connection open on orphaned
+// feature was not negotiated, see WithBindFeatures).
+//
+// Without the option the transport is closed when the call is cancelled.
+func WithCancel(timeout time.Duration) ConnectOption {
+	return func(o *Transport) { o.CancelTimeout = timeout }
+}
+
+// controlCall option makes the call that shares the call identifier with
+// the outstanding call and does not await the response.
+type controlCall uint32
+
+// canCancel function returns `true` if the call interrupted with error `err`
+// can be cancelled without closing the transport.
+func (c *clientConn) canCancel(ctx context.Context, err error) bool {
+	return c.transport.settings.CancelTimeout > 0 && ctx.Err() != nil &&
+		errors.Is(err, ctx.Err()) && c.transport.HasErr() == nil
+}
+
+// orphan function aborts the call interrupted by the caller while sending
+// the request. If any request fragment was sent, the orphaned PDU is sent to
+// the server. If the request was sent entirely, the call is cancelled.
+func (c *clientConn) orphan(ctx context.Context, call Call, pkt *Packet, buffer []byte, err error) error {
+
+	if !c.canCancel(ctx, err) {
+		return c.fail(ctx, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.transport.settings.CancelTimeout)
+	defer cancel()
+
+	sent, serr := c.transport.settle(ctx, call)
+	if serr != nil {
+		return c.fail(ctx, err)
+	}
+
+	if !sent {
+		// nothing was sent, release the call.
+		c.transport.abandon(call)
+		return err
+	}
+
+	if pkt.IsLastFrag() {
+		// the request was sent, await the response.
+		return c.cancelCall(ctx, call, buffer, err)
+	}
+
+	if werr := c.writePacket(ctx, call, &Packet{
+		Header: Header{PacketFlags: PacketFlagFirstFrag | PacketFlagLastFrag},
+		PDU:    &Orphaned{},
+	}, buffer); werr != nil {
+		return c.fail(ctx, err)
+	}
+
+	if !c.transport.settings.KeepConnOpenOnOrphaned {
+		// the server may close the connection.
+		return c.fail(ctx, err)
+	}
+
+	return err
+}
+
+// cancel function cancels the call interrupted by the caller while awaiting
+// the response.
+func (c *clientConn) cancel(ctx context.Context, call Call, buffer []byte, err error) error {
+
+	if !c.canCancel(ctx, err) {
+		return c.fail(ctx, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.transport.settings.CancelTimeout)
+	defer cancel()
+
+	return c.cancelCall(ctx, call, buffer, err)
+}
+
+// cancelCall function sends the cancel PDU for the call and drains the
+// response.
+func (c *clientConn) cancelCall(ctx context.Context, call Call, buffer []byte, err error) error {
+
+	ctl, werr := c.transport.MakeCall(ctx, controlCall(call.ID()))
+	if werr != nil {
+		return c.fail(ctx, err)
+	}
+
+	buf := c.transport.getBuffer(c.bufferSize)
+	defer c.transport.putBuffer(buf)
+
+	if werr = c.writePacket(ctx, ctl, &Packet{
+		Header: Header{PacketFlags: PacketFlagFirstFrag | PacketFlagLastFrag},
+		PDU:    &Cancel{},
+	}, *buf); werr != nil {
+		return c.fail(ctx, err)
+	}
+
+	// the response stub data is skipped.
+	pkt := &Packet{Body: &Body{err: err}}
+
+	for !pkt.IsLastFrag() {
+		if _, rerr := c.readPacket(ctx, call, pkt, buffer); rerr != nil {
+			if errors.Is(rerr, rpcerrors.NCACancel) {
+				// the call was cancelled by the server.
+				return fmt.Errorf("%w: %w", err, rerr)
+			}
+			return c.fail(ctx, err)
+		}
+	}
+
+	return err
+}
+
+// fail function closes the transport on error.
+func (c *clientConn) fail(ctx context.Context, err error) error {
+	if terr := c.transport.HasErr(); terr != nil {
+		err = terr
+	}
+	// close transport on error.
+	c.transport.Close(ctx)
+	return err
+}
@@ -0,0 +1,84 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestCancel(t *testing.T) {
+
+	cc, started := testEchoServer(t, dcerpc.WithCancel(time.Second))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	err := cc.Invoke(ctx, &echoOp{Value: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+
+	if !errors.Is(err, rpcerrors.NCACancel) {
+		t.Fatalf("expected cancel fault, got %v", err)
+	}
+
+	// the connection remains open.
+	op := &echoOp{Value: 2}
+	if err := cc.Invoke(context.Background(), op); err != nil || op.Reply != 2 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+}
+
+// orphanOp operation request spans several fragments, the request marshaling
+// is interrupted by the cancellation.
+type orphanOp struct {
+	echoOp
+	cancel context.CancelFunc
+}
+
+func (o *orphanOp) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	for i := 0; i < 4096; i++ {
+		w.WriteData(uint32(i))
+	}
+	o.cancel()
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestKeepConnOpenOnOrphaned(t *testing.T) {
+
+	for _, tc := range []struct {
+		name     string
+		opts     []dcerpc.Option
+		keepOpen bool
+	}{
+		{"negotiated", nil, true},
+		{"not negotiated", []dcerpc.Option{dcerpc.WithBindFeatures(0)}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+
+			cc, _ := testEchoServer(t, append(tc.opts, dcerpc.WithCancel(time.Second))...)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if err := cc.Invoke(ctx, &orphanOp{cancel: cancel}); !errors.Is(err, context.Canceled) {
+				t.Fatalf("expected context canceled, got %v", err)
+			}
+
+			op := &echoOp{Value: 2}
+			if err := cc.Invoke(context.Background(), op); (err == nil) != tc.keepOpen {
+				t.Fatalf("invoke after orphaned: %v", err)
+			}
+		})
+	}
+}
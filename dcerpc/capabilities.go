@@ -0,0 +1,88 @@
+package dcerpc
+
+// capabilities.go contains the report of the features implemented in pure
+// Go versus the features that require the operating system facilities.
+//
+// The library does not use cgo. The `purego` build tag additionally excludes
+// the code that relies on the operating system facilities (like the Windows
+// local named pipes). The tag does not disable cgo (as the `purego` tag is shared
+// with the other modules), so build with CGO_ENABLED=0 for the standard library to
+// use the pure Go DNS resolver and user lookups (this is verified by TestNoCgo and
+// the `check-purego` make target):
+//
+//	CGO_ENABLED=0 go build -tags purego ./...
+
+import (
+	"runtime"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+// Capability is the library feature.
+type Capability struct {
+	// The feature name, for example, "ncacn_np" or "krb5".
+	Name string `json:"name"`
+	// The feature description.
+	Description string `json:"description"`
+	// The flag that indicates whether the feature is implemented in
+	// pure Go (does not depend on the operating system facilities).
+	PureGo bool `json:"pure_go"`
+	// The operating system facility required by the feature.
+	Requires string `json:"requires,omitempty"`
+	// The flag that indicates whether the feature is available in
+	// this build.
+	Available bool `json:"available"`
+}
+
+// CapabilityReport is the library capability report.
+type CapabilityReport struct {
+	// The flag that indicates whether the library was built with the
+	// `purego` build tag.
+	PureGo bool `json:"pure_go"`
+	// The target operating system.
+	GOOS string `json:"goos"`
+	// The target architecture.
+	GOARCH string `json:"goarch"`
+	// The features.
+	Capabilities []Capability `json:"capabilities"`
+}
+
+// Has function returns `true` if the feature with the name is available.
+func (r *CapabilityReport) Has(name string) bool {
+	for _, c := range r.Capabilities {
+		if c.Name == name {
+			return c.Available
+		}
+	}
+	return false
+}
+
+// Capabilities function returns the report of the features available in
+// this build.
+func Capabilities() *CapabilityReport {
+
+	localPipes := runtime.GOOS == "windows" && !PureGo
+	sspi := runtime.GOOS == "windows" && !PureGo
+
+	return &CapabilityReport{
+		PureGo: PureGo,
+		GOOS:   runtime.GOOS,
+		GOARCH: runtime.GOARCH,
+		Capabilities: []Capability{
+			{Name: "ncacn_ip_tcp", Description: "connection-oriented RPC over TCP", PureGo: true, Available: true},
+			{Name: "ncacn_np", Description: "connection-oriented RPC over SMB2 named pipes", PureGo: true, Available: true},
+			{Name: "ncacn_http", Description: "connection-oriented RPC over HTTP (RPC proxy)", PureGo: true, Available: true},
+			{Name: "ncadg_ip_udp", Description: "connectionless RPC over UDP", PureGo: true, Available: true},
+			{Name: "tls", Description: "connection-oriented RPC over TLS", PureGo: true, Available: true},
+			{Name: "proxy", Description: "SOCKS5 and HTTP CONNECT proxies", PureGo: true, Available: true},
+			{Name: "ncacn_np_local", Description: "local named pipes opened with the operating system", Requires: "windows", Available: localPipes},
+			{Name: "ncalrpc", Description: "local RPC mapped to the local named pipes (ALPC is not implemented)", Requires: "windows", Available: localPipes},
+			{Name: "ntlm", Description: "NTLM authentication", PureGo: true, Available: true},
+			{Name: "krb5", Description: "Kerberos authentication", PureGo: true, Available: true},
+			{Name: "spnego", Description: "SPNEGO authentication", PureGo: true, Available: true},
+			{Name: "netlogon", Description: "Netlogon secure channel authentication", PureGo: true, Available: true},
+			{Name: "sspi", Description: "Windows SSPI authentication (see ssp/sspi)", Requires: "windows", Available: sspi},
+			{Name: "mlock", Description: "credential secrets kept in the locked memory", Requires: "mlock or VirtualLock", Available: credential.MemoryLock},
+		},
+	}
+}
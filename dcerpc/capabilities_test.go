@@ -0,0 +1,77 @@
+package dcerpc_test
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+func TestCapabilities(t *testing.T) {
+
+	report := dcerpc.Capabilities()
+
+	if report.PureGo != dcerpc.PureGo || report.GOOS != runtime.GOOS {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if !report.Has("ncacn_ip_tcp") || !report.Has("krb5") {
+		t.Fatalf("expected pure go features to be available")
+	}
+
+	if report.Has("unknown") {
+		t.Fatalf("expected unknown feature to be unavailable")
+	}
+
+	if report.Has("ncacn_np_local") != (runtime.GOOS == "windows" && !dcerpc.PureGo) {
+		t.Fatalf("unexpected local pipes availability")
+	}
+
+	if report.Has("ncalrpc") != report.Has("ncacn_np_local") {
+		t.Fatalf("unexpected ncalrpc availability")
+	}
+
+	for _, c := range report.Capabilities {
+		if c.PureGo && !c.Available {
+			t.Fatalf("%s: pure go feature is not available", c.Name)
+		}
+	}
+}
+
+func TestNoCgo(t *testing.T) {
+
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool is not found")
+	}
+
+	// the packages outside of the standard library that use cgo.
+	out, err := exec.Command(gobin, "list", "-e", "-deps",
+		"-f", "{{if and .CgoFiles (not .Standard)}}{{.ImportPath}}{{end}}",
+		"github.com/oiweiwei/go-msrpc/...").Output()
+	if err != nil {
+		t.Skipf("go list: %v", err)
+	}
+
+	if pkgs := strings.TrimSpace(string(out)); pkgs != "" {
+		t.Fatalf("packages use cgo:\n%s", pkgs)
+	}
+
+	// the purego build without cgo.
+	cmd := exec.Command(gobin, "build", "-tags", "purego",
+		"github.com/oiweiwei/go-msrpc/dcerpc/...",
+		"github.com/oiweiwei/go-msrpc/ssp/...",
+		"github.com/oiweiwei/go-msrpc/ndr/...")
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=0")
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("purego build: %v\n%s", err, out)
+	}
+}
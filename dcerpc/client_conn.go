@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 	"github.com/rs/zerolog"
 )
 
@@ -28,16 +31,33 @@ type clientConn struct {
 	verify *VerificationTrailer
 	// The communication channel.
 	transport *transport
-	// The receive/transmit buffers.
-	buffer []byte
+	// The receive/transmit buffer size. (the buffer is taken from the
+	// transport pool per call, so that the calls can be performed
+	// concurrently).
+	bufferSize int
 	// The subconnections (connection negotiated with
 	// the same bind instruction).
 	subs []*clientConn
 	// The flag that indicates whether the connection
 	// is closed.
 	closed bool
+	// The flag that indicates whether the connection
+	// is being closed (set without the connection lock).
+	closing atomic.Bool
 	// Logger.
 	logger zerolog.Logger
+	// The client connections established with alternate
+	// credentials (see WithCallCredentials).
+	altMu sync.Mutex
+	alts  map[any]*clientConn
+	// The per-object security (see SetObjectSecurity).
+	objects map[uuid.UUID]*objectSecurity
+	// The bind options (used to re-bind the connection on reconnect).
+	opts []Option
+	// The default object UUID for the calls.
+	object *uuid.UUID
+	// The unary interceptor chain.
+	interceptor UnaryInterceptor
 }
 
 // SubConn interface implements the sub-connection query method
@@ -76,8 +96,38 @@ func (c *clientConn) SubConn(ctx context.Context, abstractSyntax *SyntaxID) (Con
 
 // Bind function establishes new client connection on the same transport
 // as for current client connection.
+//
+// If no security options are provided, the presentation context for the
+// abstract syntax is added to the connection with alter_context within the
+// security context of the current client connection (the presentation context
+// that was already negotiated is reused), so that the generated client for
+// another interface is attached to the already-bound connection:
+//
+//	lsa, err := lsarpc.NewLsarpcClient(ctx, samr.Conn())
 func (c *clientConn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
-	return c.transport.Bind(ctx, opts...)
+
+	if hasSecurityOptions(opts) {
+		return c.transport.Bind(ctx, opts...)
+	}
+
+	if _, ok := gssapi.GetAttribute(ctx, gssapi.AttributeRPCContext); ok {
+		// the security context is provided with the context.
+		return c.transport.Bind(ctx, opts...)
+	}
+
+	o, err := ParseOptions(ctx, append(opts, withEstablishedSecurity(c.security))...)
+	if err != nil {
+		return nil, fmt.Errorf("bind: parse options: %w", err)
+	}
+
+	if len(o.AbstractSyntaxes) == 1 {
+		// the presentation context was negotiated for the connection.
+		if cc, err := c.SubConn(ctx, o.AbstractSyntaxes[0]); err == nil {
+			return cc, nil
+		}
+	}
+
+	return c.transport.Bind(ctx, append([]Option{WithLogger(c.logger), withEstablishedSecurity(c.security)}, opts...)...)
 }
 
 // AlterContext function negotiates the new security context for the client connection.
@@ -108,38 +158,250 @@ func (c *clientConn) AlterContext(ctx context.Context, opts ...Option) error {
 }
 
 func (c *clientConn) Context() context.Context {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	if c.security != nil && c.security.ctx != nil {
 		return c.security.ctx
 	}
 	return context.Background()
 }
 
-// Invoke function invokes the operation.
-func (c *clientConn) Invoke(ctx context.Context, op Operation, opts ...CallOption) error {
+// Info function returns the connection security context information.
+func (c *clientConn) Info() *ConnInfo {
 
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	if err := c.invoke(ctx, op, opts...); err != nil {
-		return fmt.Errorf("dcerpc: invoke: %s: %w", op.OpName(), err)
+	info := c.security.Info()
+	info.GroupID = c.transport.settings.GroupID
+	info.State = c.transport.State()
+
+	if c.presentation != nil {
+		info.AbstractSyntax = c.presentation.AbstractSyntax
+		info.TransferSyntax = c.presentation.TransferSyntax
 	}
 
+	return info
+}
+
+// Invoke function invokes the operation.
+func (c *clientConn) Invoke(ctx context.Context, op Operation, opts ...CallOption) error {
+	return intercept(ctx, c.interceptor, c.callInfo(opts, nil), op, opts, c.invokeOp)
+}
+
+// invokeOp function invokes the operation (bypassing the interceptors).
+func (c *clientConn) invokeOp(ctx context.Context, op Operation, opts ...CallOption) error {
+
+	if creds, ok := HasCallCredentials(opts); ok {
+		alt, err := c.impersonate(ctx, creds)
+		if err != nil {
+			return fmt.Errorf("dcerpc: invoke: %s: alternate credentials: %w", op.OpName(), err)
+		}
+		return alt.invokeOp(ctx, op, withoutCallCredentials(opts)...)
+	}
+
+	obj, _ := HasObjectUUID(opts)
+	if alt, ok, err := c.objectConn(ctx, obj, opts); err != nil {
+		return fmt.Errorf("dcerpc: invoke: %s: object security: %w", op.OpName(), err)
+	} else if ok {
+		return alt.invokeOp(ctx, op, opts...)
+	}
+
+	c.renew(ctx)
+
+	tr, err := c.invokeRetry(ctx, op, opts...)
+	if err != nil && c.reauthenticate(ctx, err) {
+		tr, err = c.invokeRetry(ctx, op, opts...)
+	}
+
+	if err != nil {
+		return fmt.Errorf("dcerpc: invoke: %s: %w", op.OpName(), c.reconnect(ctx, tr, err))
+	}
+
+	c.annotate(ctx, tr, op, opts)
+
 	return nil
 }
 
 // InvokeObject function invokes the operation with ObjectUUID.
 func (c *clientConn) InvokeObject(ctx context.Context, obj *uuid.UUID, op Operation, opts ...CallOption) error {
+	return intercept(ctx, c.interceptor, c.callInfo(opts, obj), op, opts, func(ctx context.Context, op Operation, opts ...CallOption) error {
+		return c.invokeObject(ctx, obj, op, opts...)
+	})
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+// invokeObject function invokes the operation with ObjectUUID (bypassing
+// the interceptors).
+func (c *clientConn) invokeObject(ctx context.Context, obj *uuid.UUID, op Operation, opts ...CallOption) error {
+
+	if creds, ok := HasCallCredentials(opts); ok {
+		alt, err := c.impersonate(ctx, creds)
+		if err != nil {
+			return fmt.Errorf("dcerpc: invoke_object: %s: %s: alternate credentials: %w", obj.String(), op.OpName(), err)
+		}
+		return alt.invokeObject(ctx, obj, op, withoutCallCredentials(opts)...)
+	}
 
-	if err := c.invoke(ctx, op, append(opts, WithObjectUUID(obj))...); err != nil {
-		return fmt.Errorf("dcerpc: invoke_object: %s: %s: %w", obj.String(), op.OpName(), err)
+	if alt, ok, err := c.objectConn(ctx, obj, opts); err != nil {
+		return fmt.Errorf("dcerpc: invoke_object: %s: %s: object security: %w", obj.String(), op.OpName(), err)
+	} else if ok {
+		return alt.invokeObject(ctx, obj, op, opts...)
 	}
 
+	c.renew(ctx)
+
+	tr, err := c.invokeRetry(ctx, op, append(opts, WithObjectUUID(obj))...)
+	if err != nil && c.reauthenticate(ctx, err) {
+		tr, err = c.invokeRetry(ctx, op, append(opts, WithObjectUUID(obj))...)
+	}
+
+	if err != nil {
+		return fmt.Errorf("dcerpc: invoke_object: %s: %s: %w", obj.String(), op.OpName(), c.reconnect(ctx, tr, err))
+	}
+
+	c.annotate(ctx, tr, op, opts)
+
 	return nil
 }
 
+// invokeRetry function invokes the operation (retrying the transient faults
+// according to the retry policy) and returns the transport the operation
+// was last invoked on.
+func (c *clientConn) invokeRetry(ctx context.Context, op Operation, opts ...CallOption) (*transport, error) {
+
+	c.mu.RLock()
+	policy := c.transport.settings.RetryPolicy
+	c.mu.RUnlock()
+
+	var tr *transport
+
+	err := policy.retry(ctx, func() error {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		tr = c.transport
+		return c.invoke(ctx, op, opts...)
+	})
+
+	return tr, err
+}
+
+// callInfo function returns the call information for the interceptors.
+func (c *clientConn) callInfo(opts []CallOption, obj *uuid.UUID) *CallInfo {
+
+	if c.interceptor == nil {
+		return nil
+	}
+
+	info := &CallInfo{ObjectUUID: obj}
+
+	if info.ObjectUUID == nil {
+		if info.ObjectUUID, _ = HasObjectUUID(opts); info.ObjectUUID == nil {
+			info.ObjectUUID = c.object
+		}
+	}
+
+	c.mu.RLock()
+	if c.presentation != nil {
+		info.AbstractSyntax = c.presentation.AbstractSyntax
+	}
+	c.mu.RUnlock()
+
+	return info
+}
+
+// impersonate function returns the client connection for the same presentation
+// context bound with the alternate credentials. The connection is established
+// on the same transport if security context multiplexing is supported, otherwise
+// the new transport is dialed.
+func (c *clientConn) impersonate(ctx context.Context, o CallCredentialsOption) (*clientConn, error) {
+
+	c.altMu.Lock()
+	defer c.altMu.Unlock()
+
+	key, cacheable := o.Credentials, o.Credentials != nil && reflect.TypeOf(o.Credentials).Comparable()
+	if cacheable && len(o.Options) == 0 {
+		if alt, ok := c.alts[key]; ok && alt.isActive() {
+			return alt, nil
+		}
+	} else {
+		cacheable = false
+	}
+
+	// the connection can be re-established or altered concurrently.
+	c.mu.RLock()
+	t, presentation, security := c.transport, c.presentation, c.security
+	c.mu.RUnlock()
+
+	creds := o.Credentials
+	if creds == nil && security != nil && security.ctx != nil {
+		// inherit the credentials of the connection.
+		if cred := gssapi.FromContext(security.ctx).Credential; cred != nil {
+			creds = cred
+		}
+	}
+
+	opts := []Option{
+		WithAbstractSyntax(presentation.AbstractSyntax),
+		WithCredentials(creds),
+	}
+
+	if presentation.TransferSyntax != nil && presentation.TransferSyntax.Is(TransferNDR64SyntaxV1_0) {
+		opts = append(opts, WithNDR64())
+	}
+
+	if security != nil {
+		opts = append(opts,
+			WithSecurityLevel(security.Level),
+			WithSecurtyProvider(security.Type),
+			WithTargetName(security.TargetName))
+		if security.AuthnPolicy != nil {
+			opts = append(opts, WithAuthnPolicy(security.AuthnPolicy))
+		}
+	}
+
+	if !t.settings.SecurityContextMultiplexing && security != nil && security.Level >= AuthLevelConnect {
+		// the second security context cannot be negotiated on the same
+		// transport, establish the new one.
+		if t.conn == nil {
+			return nil, fmt.Errorf("security context multiplexing is not supported")
+		}
+		var err error
+		if t, err = t.conn.redial(ctx, t.binding); err != nil {
+			return nil, fmt.Errorf("dial: %w", err)
+		}
+	}
+
+	cc, err := t.Bind(ctx, append(opts, o.Options...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	alt := cc.(*clientConn)
+
+	if cacheable {
+		if c.alts == nil {
+			c.alts = make(map[any]*clientConn)
+		}
+		c.alts[key] = alt
+	}
+
+	return alt, nil
+}
+
+// withoutCallCredentials function returns the call options without
+// the alternate credentials option.
+func withoutCallCredentials(opts []CallOption) []CallOption {
+	ret := make([]CallOption, 0, len(opts))
+	for i := range opts {
+		if _, ok := (any)(opts[i]).(CallCredentialsOption); !ok {
+			ret = append(ret, opts[i])
+		}
+	}
+	return ret
+}
+
 // RegsiterServer: NYI.
 func (c *clientConn) RegisterServer(h ServerHandle, opts ...Option) {
 	// NYI.
@@ -148,7 +410,7 @@ func (c *clientConn) RegisterServer(h ServerHandle, opts ...Option) {
 // BodyReader function returns the new packet body as a reader for sequential
 // request marshaling.
 func (c *clientConn) BodyReader(ctx context.Context, op Operation) *Body {
-	return NewBody(ctx, op, c.presentation, false)
+	return NewBody(ctx, op, c.presentation, false, c.transport.settings.DecodeLimits)
 }
 
 // BodyWriter function returns the new packet body as a writer for sequential
@@ -158,17 +420,55 @@ func (c *clientConn) BodyWriter(ctx context.Context, op Operation) *Body {
 }
 
 // invoke.
-func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOption) error {
+func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOption) (err error) {
 
 	if c.isClosed() {
 		return ErrConnClosed
 	}
 
+	// register the outstanding call (awaited by Close).
+	if err := c.transport.enter(); err != nil {
+		return err
+	}
+
+	defer c.transport.leave()
+
 	if c.presentation.Error != nil {
 		return c.presentation.Error
 	}
 
-	obj, _ := HasObjectUUID(opts)
+	if sec, ok := HasCallSecurity(opts); ok {
+		if c, err = c.withSecurity(sec); err != nil {
+			return err
+		}
+	}
+
+	budget := c.transport.settings.Budgets.Budget(op)
+
+	if err := budget.checkRequest(ctx, op, c.presentation); err != nil {
+		return err
+	}
+
+	if budget.MaxLatency > 0 {
+		var (
+			parent = ctx
+			cancel context.CancelFunc
+		)
+		ctx, cancel = context.WithTimeout(ctx, budget.MaxLatency)
+		defer cancel()
+		defer func() {
+			if err != nil {
+				err = budget.latencyErr(ctx, parent, op, err)
+			}
+		}()
+	}
+
+	obj, ok := HasObjectUUID(opts)
+	if !ok {
+		obj = c.object
+	}
+
+	profile := c.transport.settings.TrafficProfile
 
 	call, err := c.transport.MakeCall(ctx)
 	if err != nil {
@@ -190,15 +490,24 @@ func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOptio
 		SecurityTrailer:     c.security.SecurityTrailer(),
 	}
 
+	tr := c.transport
+
+	buf := tr.getBuffer(c.bufferSize)
+	defer tr.putBuffer(buf)
+
+	buffer := *buf
+
 	bodyWriter := c.BodyWriter(ctx, op)
 	defer bodyWriter.Close()
 
 	for pkt.Body = bodyWriter; !pkt.IsLastFrag(); {
 		// allocate auth_data.
 		pkt.AuthData = make([]byte, c.security.AuthLength(ctx, pkt))
+		// select the fragment size.
+		pkt.fragSize = profile.FragmentSize(c.transport.settings.MaxXmitFrag)
 		// encode packet fragment.
-		if err = c.WritePacket(ctx, call, pkt); err != nil {
-			return fmt.Errorf("request: %w", err)
+		if err = c.writePacket(ctx, call, pkt, buffer); err != nil {
+			return fmt.Errorf("request: %w", c.orphan(ctx, call, pkt, buffer, err))
 		}
 		// clear the first frag.
 		pkt.Header.PacketFlags &= ^PacketFlagFirstFrag
@@ -206,17 +515,80 @@ func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOptio
 
 	// clear last frag flag to start the for-loop.
 	pkt.Header.PacketFlags &= ^PacketFlagLastFrag
+	pkt.fragSize = 0
+
+	// the request header is used to verify the response verification trailer.
+	reqHdr := pkt.Header
+	reqHdr.PacketType, reqHdr.PacketDRep = PacketTypeRequest, c.transport.settings.DataRepresentation
+	pkt.VerificationTrailer = VerificationTrailer{}
 
 	bodyReader := c.BodyReader(ctx, op)
 	defer bodyReader.Close()
 
+	lenient, ok := HasLenientDecode(opts)
+	bodyReader.lenient = ok
+
+	var (
+		size    int
+		exceeds error
+	)
+
 	for pkt.Body = bodyReader; !pkt.IsLastFrag(); {
 		// decode packet fragment.
-		if pkt, err = c.ReadPacket(ctx, call, pkt); err != nil {
+		if _, err = c.readPacket(ctx, call, pkt, buffer); err != nil {
+			if fault := (*FaultError)(nil); errors.As(err, &fault) && fault.last {
+				// the call is completed with the fault, the transport
+				// remains usable.
+				return fmt.Errorf("response: %w", err)
+			}
+			return fmt.Errorf("response: %w", c.cancel(ctx, call, buffer, err))
+		}
+		hint := 0
+		if pdu, ok := pkt.PDU.(*Response); ok {
+			if pdu.ContextID != c.presentation.ID() {
+				// the response for the presentation context other than requested.
+				return fmt.Errorf("response: %w", c.fail(ctx, fmt.Errorf("%w: p_cont_id %d, expected %d", ErrPresentationContextMismatch, pdu.ContextID, c.presentation.ID())))
+			}
+			hint = int(pdu.AllocHint)
+		}
+		size += len(pkt.StubDataBytes())
+		if limit := c.transport.settings.MaxReassemblySize; limit > 0 && max(size, hint) > limit {
+			// the rest of the response is not awaited.
+			return fmt.Errorf("response: %w", c.fail(ctx, fmt.Errorf("%w: %d bytes, limit %d bytes", ErrReassemblyLimitExceeded, max(size, hint), limit)))
+		}
+		if exceeds == nil {
+			if exceeds = budget.checkResponse(op, size, hint); exceeds != nil {
+				// skip the rest of the response.
+				bodyReader.discard(exceeds)
+			}
+		}
+	}
+
+	if exceeds != nil {
+		return exceeds
+	}
+
+	if len(pkt.VerificationTrailer.Commands) > 0 {
+		// the server echoed the verification trailer.
+		if err := pkt.VerificationTrailer.Verify(&VerificationInfo{
+			Header:         reqHdr,
+			ContextID:      c.presentation.ID(),
+			OpNum:          uint16(op.OpNum()),
+			AbstractSyntax: c.presentation.AbstractSyntax,
+			TransferSyntax: c.presentation.TransferSyntax,
+			SignHeader:     c.security.SignHeader,
+		}); err != nil {
 			return fmt.Errorf("response: %w", err)
 		}
 	}
 
+	if err := bodyReader.Err(); err != nil {
+		// report the salvaged response.
+		*lenient.Err = &DecodeError{OpName: op.OpName(), Offset: bodyReader.Offset(), Err: err}
+		c.logger.Debug().Uint32("call_id", call.ID()).Err(err).Msg("partial operation output")
+		return nil
+	}
+
 	c.logger.Debug().Uint32("call_id", call.ID()).Interface("out", op).Msg("operation output")
 
 	return nil
@@ -236,6 +608,9 @@ func (c *clientConn) Wrap(ctx context.Context, hdr Header, raw []byte, call Call
 	}
 
 	if c.security.CanWrap(ctx, pkt) {
+		if hdr.AuthLength != 0 {
+			c.transport.settings.tap(TapOutbound, true, hdr, raw)
+		}
 		if err := c.security.Wrap(ctx, pkt); err != nil {
 			return err
 		}
@@ -266,6 +641,9 @@ func (c *clientConn) Unwrap(ctx context.Context, hdr Header, raw []byte, call Ca
 		if err := c.security.Unwrap(ctx, pkt, call.Ready); err != nil {
 			return err
 		}
+		if hdr.AuthLength != 0 {
+			c.transport.settings.tap(TapInbound, true, hdr, raw)
+		}
 	} else {
 		call.Ready(ctx)
 	}
@@ -275,36 +653,31 @@ func (c *clientConn) Unwrap(ctx context.Context, hdr Header, raw []byte, call Ca
 }
 
 // WritePacket function encodes, encrypts/signs and sends the packet to the server.
-func (c *clientConn) WritePacket(ctx context.Context, call Call, pkt *Packet) error {
-	if err := c.writePacket(ctx, call, pkt); err != nil {
-		if terr := c.transport.HasErr(); terr != nil {
-			err = terr
-		}
-		// close transport on error.
-		c.transport.Close(ctx)
-		return err
+func (c *clientConn) WritePacket(ctx context.Context, call Call, pkt *Packet, buffer []byte) error {
+	if err := c.writePacket(ctx, call, pkt, buffer); err != nil {
+		return c.fail(ctx, err)
 	}
 	return nil
 }
 
-func (c *clientConn) writePacket(ctx context.Context, call Call, pkt *Packet) error {
+func (c *clientConn) writePacket(ctx context.Context, call Call, pkt *Packet, buffer []byte) error {
 
 	pkt.Header.CallID = call.ID()
 
 	c.logger.Debug().EmbedObject(pkt.Header).EmbedObject(pkt.PDU).Msg("writing packet")
 
-	if err := c.transport.EncodePacket(ctx, pkt, c.buffer); err != nil {
+	if err := c.transport.EncodePacket(ctx, pkt, buffer); err != nil {
 		return fmt.Errorf("encode packet: %w", err)
 	}
 
-	if err := c.Wrap(ctx, pkt.Header, c.buffer, call); err != nil {
+	if err := c.Wrap(ctx, pkt.Header, buffer, call); err != nil {
 		return fmt.Errorf("wrap packet: %w", err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, c.transport.settings.Timeout)
 	defer cancel()
 
-	if err := call.WriteBuffer(ctx, pkt.Header, c.buffer); err != nil {
+	if err := call.WriteBuffer(ctx, pkt.Header, buffer); err != nil {
 		return fmt.Errorf("write buffer: %w", err)
 	}
 
@@ -315,39 +688,34 @@ func (c *clientConn) writePacket(ctx context.Context, call Call, pkt *Packet) er
 
 // ReadPacket function reads, decrypts/verifies signature, and decodes the
 // packet retrieved from the server.
-func (c *clientConn) ReadPacket(ctx context.Context, call Call, pkt *Packet) (*Packet, error) {
+func (c *clientConn) ReadPacket(ctx context.Context, call Call, pkt *Packet, buffer []byte) (*Packet, error) {
 
-	pkt, err := c.readPacket(ctx, call, pkt)
+	pkt, err := c.readPacket(ctx, call, pkt, buffer)
 	if err != nil {
-		if terr := c.transport.HasErr(); terr != nil {
-			err = terr
-		}
-		// close transport on error.
-		c.transport.Close(ctx)
-		return nil, err
+		return nil, c.fail(ctx, err)
 	}
 
 	return pkt, nil
 }
 
 // readPacket.
-func (c *clientConn) readPacket(ctx context.Context, call Call, pkt *Packet) (*Packet, error) {
+func (c *clientConn) readPacket(ctx context.Context, call Call, pkt *Packet, buffer []byte) (*Packet, error) {
 
 	ctx, cancel := context.WithTimeout(ctx, c.transport.settings.Timeout)
 	defer cancel()
 
-	hdr, err := call.ReadBuffer(ctx, c.buffer)
+	hdr, err := call.ReadBuffer(ctx, buffer)
 	if err != nil {
 		return nil, fmt.Errorf("read buffer: %w", err)
 	}
 
 	c.logger.Debug().EmbedObject(hdr).Msg("reading packet")
 
-	if err := c.Unwrap(ctx, hdr, c.buffer, call); err != nil {
+	if err := c.Unwrap(ctx, hdr, buffer, call); err != nil {
 		return nil, fmt.Errorf("unwrap packet: %w", err)
 	}
 
-	if pkt, err = c.transport.DecodePacket(ctx, pkt, c.buffer); err != nil {
+	if pkt, err = c.transport.DecodePacket(ctx, pkt, buffer); err != nil {
 		return nil, fmt.Errorf("decode packet: %w", err)
 	}
 
@@ -361,23 +729,42 @@ func (c *clientConn) isClosed() bool {
 	return c.closed
 }
 
+// isActive function returns `true` if the connection is not closed and
+// the transport is not broken.
+func (c *clientConn) isActive() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return !c.isClosed() && c.transport.HasErr() == nil
+}
+
 // Close function closes the client connection and underlying transport.
+// The new calls are rejected with ErrConnClosed, the outstanding calls are
+// awaited until the context is done and then aborted. The function can be
+// called multiple times and concurrently.
 func (c *clientConn) Close(ctx context.Context) error {
 
+	for _, sub := range c.subs {
+		// prevent the reconnect of the sub-connections.
+		sub.closing.Store(true)
+	}
+
+	c.mu.RLock()
+	tr := c.transport
+	c.mu.RUnlock()
+
+	// close the transport, this will shut down the socket/named pipe
+	// and remove the transport from the list of active transports
+	// of the group conn.
+	err := tr.gracefulClose(ctx)
+
+	// the outstanding calls are completed (or aborted) at this point.
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.isClosed() {
-		return fmt.Errorf("connection has already been closed")
-	}
-
 	for _, sub := range c.subs {
 		// mark all sub-connections as closed.
 		sub.closed = true
 	}
 
-	// close the transport, this will shut down the socket/named pipe
-	// and remove the transport from the list of active transports
-	// of the group conn.
-	return c.transport.Close(ctx)
+	return err
 }
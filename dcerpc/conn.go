@@ -2,13 +2,18 @@ package dcerpc
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"net"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/rpch"
 	"github.com/oiweiwei/go-msrpc/smb2"
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 	"github.com/rs/zerolog"
@@ -90,6 +95,8 @@ type conn struct {
 	logger zerolog.Logger
 	// options.
 	opts []Option
+	// The SMB session shared by the named pipe transports.
+	smb *smb2.Session
 }
 
 // Dial function creates a new transport set that should be used for
@@ -104,8 +111,12 @@ func Dial(ctx context.Context, addr string, opts ...Option) (Conn, error) {
 		}
 		o(&settings)
 	}
-	// establish new association group.
-	group := &Group{}
+	// join the association group provided with the WithGroup
+	// option, or establish new association group.
+	group := groupFromOptions(opts)
+	if group == nil {
+		group = &Group{}
+	}
 	group.SetID(settings.GroupID)
 	// new transport set.
 	tr := &conn{
@@ -116,7 +127,17 @@ func Dial(ctx context.Context, addr string, opts ...Option) (Conn, error) {
 		opts:       append(opts, WithGroup(group)),
 	}
 
-	ip, hostName, binding, err := ParseServerAddrWithDNSLookup(addr, true)
+	if settings.Proxy != "" {
+		// tunnel the tcp connections through the proxy.
+		dialer, err := NewProxyDialer(settings.Proxy, settings.Dialer)
+		if err != nil {
+			return nil, fmt.Errorf("dial: %w", err)
+		}
+		settings.Dialer = dialer
+	}
+
+	// the server name is resolved by the proxy.
+	ip, hostName, binding, err := ParseServerAddrWithDNSLookup(addr, settings.Proxy == "")
 	if err != nil {
 		return nil, fmt.Errorf("dial: %w", err)
 	}
@@ -132,12 +153,41 @@ func Dial(ctx context.Context, addr string, opts ...Option) (Conn, error) {
 	if binding != nil {
 		// set the string binding.
 		tr.settings.StringBinding = *binding
+		// the binding object uuid is the default object uuid for the calls
+		// (unless overridden with the option).
+		if binding.ObjectUUID != nil {
+			tr.opts = append([]Option{WithObjectUUID(binding.ObjectUUID)}, tr.opts...)
+		}
+		// apply the named pipe endpoint options.
+		if port, ok := binding.ExtraValue("smb_port"); ok {
+			if tr.settings.SMBPort, err = strconv.Atoi(port); err != nil {
+				return nil, fmt.Errorf("dial: invalid smb_port: %w", err)
+			}
+		}
+		if share, ok := binding.ExtraValue("share"); ok {
+			tr.settings.SMBShareName = share
+		}
+		// apply the rpc proxy endpoint option.
+		if proxy, ok := binding.ExtraValue("RpcProxy"); ok {
+			cfg := rpch.Config{}
+			if tr.settings.RPCProxy != nil {
+				cfg = *tr.settings.RPCProxy
+			}
+			cfg.Proxy, tr.settings.RPCProxy = proxy, &cfg
+		}
 		// set the server address.
 		if tr.serverAddr = binding.NetworkAddress; tr.serverAddr == "" {
 			tr.serverAddr = binding.ComputerName
 		}
 	}
 
+	// check the target policy.
+	if err := tr.settings.TargetPolicy.CheckHost(tr.serverAddr, tr.settings.HostName); err != nil {
+		return nil, fmt.Errorf("dial: %w", err)
+	}
+
+	group.join(tr)
+
 	// return the transport set.
 	return tr, nil
 }
@@ -198,6 +248,11 @@ func (t *conn) Context() context.Context {
 	return context.Background()
 }
 
+// Info.
+func (t *conn) Info() *ConnInfo {
+	return &ConnInfo{GroupID: t.group.GroupID()}
+}
+
 // Invoke.
 func (t *conn) Invoke(_ context.Context, _ Operation, _ ...CallOption) error {
 	return fmt.Errorf("invoke: connection is not binded")
@@ -208,21 +263,35 @@ func (t *conn) InvokeObject(_ context.Context, _ *uuid.UUID, _ Operation, _ ...C
 	return fmt.Errorf("invoke_object: connection is not binded")
 }
 
-// Close.
+// Close function closes all transports (see clientConn.Close) and logs off
+// the SMB session.
 func (t *conn) Close(ctx context.Context) error {
 
 	t.mu.Lock()
-	defer t.mu.Unlock()
+	transports := t.transports
+	t.transports = make(map[string][]*transport)
+	t.mu.Unlock()
 
-	for _, transports := range t.transports {
+	for _, transports := range transports {
 		for i := range transports {
-			if err := transports[i].shutdown(ctx); err != nil {
-				t.logger.Err(err).Msg("shutdown transport")
+			if err := transports[i].gracefulClose(ctx); err != nil {
+				t.logger.Err(err).Msg("close transport")
 			}
 		}
 	}
 
-	t.transports = make(map[string][]*transport)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.smb != nil {
+		if err := t.smb.Logoff(); err != nil {
+			t.logger.Err(err).Msg("smb session logoff")
+		}
+		t.smb = nil
+	}
+
+	t.group.leave(t)
+
 	return nil
 }
 
@@ -236,38 +305,65 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	conn, stale, err := t.bind(ctx, false, opts...)
+	if err != nil && stale {
+		// the cached endpoint is stale, resolve the endpoint again. the
+		// bind, authentication and policy errors are returned as is, so
+		// that the credentials are not tried twice.
+		t.logger.Debug().Err(err).Msg("bind: cached endpoint is unreachable, refreshing")
+		conn, _, err = t.bind(ctx, true, opts...)
+	}
+
+	return conn, err
+}
+
+// bind function binds the presentation context, and returns the flag that
+// indicates whether the bindings were taken from the endpoint cache and
+// could not be reached (dial or connection error). If `refresh` is set, the
+// endpoint cache is bypassed.
+func (t *conn) bind(ctx context.Context, refresh bool, opts ...Option) (Conn, bool, error) {
+
 	opts = append(t.opts, opts...)
 
 	// parse selected option.
 	o, err := ParseOptions(ctx, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("bind: parse options: %w", err)
+		return nil, false, fmt.Errorf("bind: parse options: %w", err)
 	}
 
 	t.logger = o.Logger
 
-	var bindings []StringBinding
+	for _, syntax := range o.AbstractSyntaxes {
+		if err := t.settings.TargetPolicy.CheckInterface(syntax); err != nil {
+			return nil, false, fmt.Errorf("bind: %w", err)
+		}
+	}
+
+	var (
+		bindings []StringBinding
+		cached   bool
+	)
 
 	if len(o.Bindings) > 0 {
 		// first check the bindings provided by WithEndpoint parameter.
 		for i := range o.Bindings {
 			binding, err := ParseStringBinding(o.Bindings[i])
 			if err != nil {
-				return nil, fmt.Errorf("bind: parse string binding: %w", err)
+				return nil, cached, fmt.Errorf("bind: parse string binding: %w", err)
 			}
 
 			if !binding.Complete() {
 				// try to complete the binding with endpoint mapper.
 				if t.settings.EndpointMapper != nil {
-					bs, err := t.settings.EndpointMapper.Map(ctx, &Binding{
+					bs, fromCache, err := t.mapEndpoint(ctx, &Binding{
 						SyntaxID:      *o.AbstractSyntaxes[0],
 						StringBinding: *binding,
-					})
+					}, refresh)
 					if err != nil {
-						return nil, fmt.Errorf("bind: endpoint mapper: %w", err)
+						return nil, cached, fmt.Errorf("bind: endpoint mapper: %w", err)
 					}
 					if len(bs) > 0 {
-						binding = &bs[0]
+						binding, cached = &bs[0], cached || fromCache
 					}
 				}
 			}
@@ -280,11 +376,11 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		// use endpoint mapper to retrieve the bindings.
 		if t.settings.EndpointMapper != nil {
 			// figure out the string binding from the endpoint mapper.
-			if bindings, err = t.settings.EndpointMapper.Map(ctx, &Binding{
+			if bindings, cached, err = t.mapEndpoint(ctx, &Binding{
 				SyntaxID:      *o.AbstractSyntaxes[0],
 				StringBinding: t.settings.StringBinding,
-			}); err != nil {
-				return nil, fmt.Errorf("bind: endpoint mapper: %w", err)
+			}, refresh); err != nil {
+				return nil, cached, fmt.Errorf("bind: endpoint mapper: %w", err)
 			}
 		}
 	}
@@ -304,6 +400,12 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 
 	t.logger.Debug().Str("target_name", target).Interface("bindings", bindings).Msgf("found %d bindings", len(bindings))
 
+	if len(bindings) > 0 && bindings[0].ProtocolSequence == ProtocolSequenceIPUDP {
+		// connectionless protocol does not use the transports.
+		conn, err := t.bindDatagram(ctx, bindings[0], o)
+		return conn, cached && err != nil && isConnErr(err), err
+	}
+
 	var selected []*transport
 
 	if !t.settings.NoReuseTransport {
@@ -313,7 +415,7 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 				// check if connections are alive.
 				active := false
 				for j := range selected {
-					if selected[j].err == nil {
+					if selected[j].HasErr() == nil {
 						active = true
 						break
 					}
@@ -328,14 +430,19 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		}
 	}
 
+	// connErr is set if the bindings could not be reached.
+	var connErr bool
+
 	if len(selected) == 0 {
 		t.logger.Debug().Msgf("no established transport was found")
 		for i := range bindings {
 			t.logger.Debug().Msgf("etablishing new transport for binding %s", bindings[i])
 			if selected, err = t.dial(ctx, bindings[i]); err != nil {
 				t.logger.Error().Err(err).Msgf("bind: dial %s error", bindings[i])
+				connErr = true
 				continue
 			}
+			connErr = false
 			t.logger.Debug().Msgf("new transport for binding %s has been successfully established", bindings[i])
 			t.transports[bindings[i].String()] = append(t.transports[bindings[i].String()], selected...)
 			break
@@ -346,23 +453,71 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 
 	for i := range selected {
 		// skip dead connections.
-		if selected[i].err != nil {
+		if selected[i].HasErr() != nil {
 			continue
 		}
 		t.logger.Debug().Msgf("binding the selected transport")
 		if conn, err = selected[i].Bind(ctx, opts...); err != nil {
 			t.logger.Err(err).Msgf("selected transport error")
+			connErr = isConnErr(err)
 			continue
 		}
 		t.logger.Debug().Msgf("selected transport has been successfully binded")
-		return conn, nil
+		return conn, cached, nil
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("bind: could not bind the selected transport: %w", err)
+		return nil, cached && connErr, fmt.Errorf("bind: could not bind the selected transport: %w", err)
 	}
 
-	return nil, fmt.Errorf("bind: could not find matching binding")
+	return nil, cached, fmt.Errorf("bind: could not find matching binding")
+}
+
+// isConnErr function returns `true` if the error is the connection error
+// (the endpoint is unreachable or the transport is broken), and not the
+// bind, authentication or policy error.
+func isConnErr(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, ErrShutdown) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, net.ErrClosed)
+}
+
+// mapEndpoint function resolves the binding with the endpoint mapper
+// (retrying the transient failures according to the retry policy), and
+// returns the flag that indicates whether the bindings were taken from the
+// endpoint cache. If `refresh` is set, the cached bindings are dropped.
+func (t *conn) mapEndpoint(ctx context.Context, binding *Binding, refresh bool) ([]StringBinding, bool, error) {
+
+	if binding.StringBinding.NetworkAddress == "" && binding.StringBinding.ComputerName == "" {
+		// the endpoint mapper of the server is queried.
+		b := *binding
+		b.StringBinding.NetworkAddress, binding = t.serverAddr, &b
+	}
+
+	// the bindings are cached for the host which endpoint mapper is queried.
+	host := binding.StringBinding.NetworkAddress
+	if host == "" {
+		host = binding.StringBinding.ComputerName
+	}
+
+	if refresh {
+		t.settings.EndpointCache.Invalidate(host, binding)
+	} else if bindings, ok := t.settings.EndpointCache.Get(host, binding); ok {
+		t.logger.Debug().Interface("bindings", bindings).Msg("endpoint mapper: found cached bindings")
+		return bindings, true, nil
+	}
+
+	var bindings []StringBinding
+
+	err := t.settings.RetryPolicy.retry(ctx, func() (err error) {
+		bindings, err = t.settings.EndpointMapper.Map(ctx, binding)
+		return err
+	})
+
+	if err == nil {
+		t.settings.EndpointCache.Put(host, binding, bindings)
+	}
+
+	return bindings, false, err
 }
 
 func (t *conn) dial(ctx context.Context, binding StringBinding) ([]*transport, error) {
@@ -374,6 +529,17 @@ func (t *conn) dial(ctx context.Context, binding StringBinding) ([]*transport, e
 
 	settings := *t.settings
 
+	if settings.ChannelBindings == nil {
+		// the channel bindings provided by the connection (tls).
+		settings.ChannelBindings, _ = ConnChannelBindings(conn)
+	}
+
+	// the outstanding calls window.
+	var window chan struct{}
+	if settings.MultiplexingOutstandingCalls > 0 {
+		window = make(chan struct{}, settings.MultiplexingOutstandingCalls)
+	}
+
 	return []*transport{{
 		id:       rand.Int(),
 		cc:       NewBufferedConn(conn, t.settings.MaxRecvFrag),
@@ -381,12 +547,32 @@ func (t *conn) dial(ctx context.Context, binding StringBinding) ([]*transport, e
 		tx:       make([]byte, t.settings.MaxXmitFrag),
 		rx:       make([]byte, t.settings.MaxRecvFrag),
 		txQ:      make(chan *call),
-		rxQ:      make(chan *call, 64),
+		rxQ:      make(chan *call, max(cap(window), 64)),
 		logger:   t.logger,
 		conn:     t,
+		binding:  binding,
+		window:   window,
+		done:     make(chan struct{}),
 	}}, nil
 }
 
+// redial function establishes the new transport for the binding and
+// registers it within the transport set.
+func (t *conn) redial(ctx context.Context, binding StringBinding) (*transport, error) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	selected, err := t.dial(ctx, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	t.transports[binding.String()] = append(t.transports[binding.String()], selected...)
+
+	return selected[0], nil
+}
+
 func (t *conn) dialConn(ctx context.Context, binding StringBinding) (RawConn, error) {
 
 	switch binding.ProtocolSequence {
@@ -398,6 +584,10 @@ func (t *conn) dialConn(ctx context.Context, binding StringBinding) (RawConn, er
 			addr = net.JoinHostPort(t.serverAddr, binding.Endpoint)
 		}
 
+		if err := t.settings.TargetPolicy.CheckAddr(addr, t.settings.HostName); err != nil {
+			return nil, fmt.Errorf("ncacn_ip_tcp: %w", err)
+		}
+
 		t.logger.Debug().Msgf("dialing tcp %s", addr)
 
 		var (
@@ -415,14 +605,44 @@ func (t *conn) dialConn(ctx context.Context, binding StringBinding) (RawConn, er
 			}
 		}
 
+		if t.settings.TLSConfig != nil {
+			if conn, err = t.dialTLS(ctx, conn, binding); err != nil {
+				return nil, fmt.Errorf("ncacn_ip_tcp: tls: %w", err)
+			}
+		}
+
 		t.logger.Debug().Msgf("dialing tcp %s done", addr)
 
 		return conn, nil
 
 	case ProtocolSequenceNamedPipe:
 
+		if t.settings.LocalPipes && isLocalHost(t.serverAddr) {
+
+			pipeName := t.settings.NamedPipePath(binding.NamedPipe())
+
+			t.logger.Debug().Msgf("dialing local named pipe %s", pipeName)
+
+			conn, err := dialLocalPipe(ctx, pipeName, t.settings.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("ncacn_np: local: %w", err)
+			}
+
+			t.logger.Debug().Msgf("dialing local named pipe done")
+
+			return conn, nil
+		}
+
+		addr := net.JoinHostPort(t.serverAddr, strconv.Itoa(t.settings.SMBPort))
+
+		if err := t.settings.TargetPolicy.CheckAddr(addr, t.settings.HostName); err != nil {
+			return nil, fmt.Errorf("ncacn_np: %w", err)
+		}
+
+		shareName, pipeName := t.settings.ShareName(binding), t.settings.NamedPipePath(binding.NamedPipe())
+
 		t.logger.Debug().Msgf("dialing smb named pipe %s:%d:%s\\%s",
-			t.serverAddr, t.settings.SMBPort, binding.ShareName(), binding.NamedPipe())
+			t.serverAddr, t.settings.SMBPort, shareName, pipeName)
 
 		dialer, err := smb2.CompatDialer(t.settings.SMBDialer)
 		if err != nil {
@@ -434,18 +654,29 @@ func (t *conn) dialConn(ctx context.Context, binding StringBinding) (RawConn, er
 			if o.Security != nil && o.Security.TargetName != "" {
 				opts = append(opts, gssapi.WithTargetName(o.Security.TargetName))
 			}
-			opts = append(opts, o.SecurityOptions...)
+			opts = append(opts, t.settings.smbSecurityOptions(o.SecurityOptions)...)
+			if t.settings.ChannelBindings != nil {
+				opts = append(opts, gssapi.WithChannelBindings(t.settings.ChannelBindings))
+			}
 			dialer = smb2.NewDialer(smb2.WithSecurity(opts...))
 		}
 
+		if t.smb == nil && t.settings.SMBSession != nil {
+			if t.smb, err = smb2.NewSession(t.settings.SMBSession); err != nil {
+				return nil, fmt.Errorf("ncacn_np: %w", err)
+			}
+		}
+
 		pipe := &smb2.NamedPipe{
 			Logger:    t.logger,
 			Address:   t.serverAddr,
 			Port:      t.settings.SMBPort,
 			Timeout:   t.settings.Timeout,
 			Dialer:    dialer,
-			ShareName: binding.ShareName(),
-			Name:      binding.NamedPipe(),
+			ShareName: shareName,
+			Name:      pipeName,
+			// reuse the authenticated session for all named pipes.
+			Session: t.smb,
 		}
 
 		if t.settings.Dialer != nil {
@@ -453,17 +684,172 @@ func (t *conn) dialConn(ctx context.Context, binding StringBinding) (RawConn, er
 		}
 
 		if err := pipe.Connect(ctx); err != nil {
-			return nil, fmt.Errorf("ncacn_np: %w", err)
+			if t.smb == nil || t.smb.IsExternal() {
+				return nil, fmt.Errorf("ncacn_np: %w", err)
+			}
+			// the shared session might be terminated, retry with new one.
+			t.logger.Debug().Err(err).Msg("shared smb session error, reconnecting")
+			pipe.Session, t.smb = nil, nil
+			if err := pipe.Connect(ctx); err != nil {
+				return nil, fmt.Errorf("ncacn_np: %w", err)
+			}
 		}
 
+		t.smb = pipe.Session
+
 		t.logger.Debug().Msgf("dialing smb named pipe done")
 
 		return pipe, nil
+
+	case ProtocolSequenceHTTP:
+
+		port, err := strconv.Atoi(binding.Endpoint)
+		if err != nil || binding.Endpoint == "" {
+			port = rpch.DefaultPort
+		}
+
+		var proxy *rpch.Config
+
+		if t.settings.RPCProxy != nil {
+			cfg := *t.settings.RPCProxy
+			proxy = &cfg
+		}
+
+		if addr, ok := binding.ExtraValue("RpcProxy"); ok {
+			if proxy == nil {
+				proxy = &rpch.Config{}
+			}
+			proxy.Proxy = addr
+		}
+
+		var dial func(context.Context, string, string) (net.Conn, error)
+		if t.settings.Dialer != nil {
+			dial = t.settings.Dialer.DialContext
+		}
+
+		if proxy == nil || proxy.Proxy == "" {
+
+			addr := net.JoinHostPort(t.serverAddr, strconv.Itoa(port))
+			if binding.NetworkAddress != "" && binding.NetworkAddress != "0.0.0.0" {
+				addr = net.JoinHostPort(binding.NetworkAddress, strconv.Itoa(port))
+			}
+
+			if err := t.settings.TargetPolicy.CheckAddr(addr, t.settings.HostName); err != nil {
+				return nil, fmt.Errorf("ncacn_http: %w", err)
+			}
+
+			t.logger.Debug().Msgf("dialing http %s", addr)
+
+			ctx, cancel := context.WithTimeout(ctx, t.settings.Timeout)
+			defer cancel()
+
+			conn, err := rpch.DialDirect(ctx, dial, addr)
+			if err != nil {
+				return nil, fmt.Errorf("ncacn_http: %w", err)
+			}
+
+			return conn, nil
+		}
+
+		// the rpc server name as seen by the rpc proxy.
+		server := t.settings.HostName
+		if server == "" {
+			server = t.serverAddr
+		}
+
+		if err := t.settings.TargetPolicy.CheckAddr(proxy.ProxyAddr()); err != nil {
+			return nil, fmt.Errorf("ncacn_http: rpc proxy: %w", err)
+		}
+
+		if err := t.settings.TargetPolicy.CheckAddr(net.JoinHostPort(server, strconv.Itoa(port))); err != nil {
+			return nil, fmt.Errorf("ncacn_http: %w", err)
+		}
+
+		if proxy.Dial == nil {
+			proxy.Dial = dial
+		}
+
+		if proxy.Timeout == 0 {
+			proxy.Timeout = t.settings.Timeout
+		}
+
+		t.logger.Debug().Msgf("dialing http %s:%d via rpc proxy %s", server, port, proxy.ProxyAddr())
+
+		conn, err := rpch.Dial(ctx, proxy, server, port)
+		if err != nil {
+			return nil, fmt.Errorf("ncacn_http: %w", err)
+		}
+
+		t.logger.Debug().Msgf("dialing http done")
+
+		return conn, nil
+
+	case ProtocolSequenceLRPC:
+
+		if !localPipesSupported {
+			return nil, fmt.Errorf("ncalrpc: %s: alpc is not supported, use ncacn_np with local pipes option", binding.String())
+		}
+
+		// the alpc port is not opened, the endpoint is opened as the
+		// local named pipe with the same name.
+		pipeName := t.settings.NamedPipePath(binding.NamedPipe())
+
+		t.logger.Debug().Msgf("dialing local named pipe %s for ncalrpc", pipeName)
+
+		conn, err := dialLocalPipe(ctx, pipeName, t.settings.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("ncalrpc: local: %w", err)
+		}
+
+		t.logger.Debug().Msgf("dialing local named pipe done")
+
+		return conn, nil
 	}
 
 	return nil, fmt.Errorf("ncacn: %s: not supported", binding.String())
 }
 
+// dialTLS function performs the TLS handshake over the connection.
+func (t *conn) dialTLS(ctx context.Context, conn RawConn, binding StringBinding) (RawConn, error) {
+
+	cfg := t.settings.TLSConfig.Clone()
+
+	if cfg.ServerName == "" {
+		if cfg.ServerName = t.settings.HostName; cfg.ServerName == "" {
+			if cfg.ServerName = binding.NetworkAddress; cfg.ServerName == "" || cfg.ServerName == "0.0.0.0" {
+				cfg.ServerName = t.serverAddr
+			}
+		}
+	}
+
+	if t.settings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.settings.Timeout)
+		defer cancel()
+	}
+
+	tlsConn := tls.Client(conn.(net.Conn), cfg)
+
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
+
+// isLocalHost function returns `true` if address refers to the local host.
+func isLocalHost(addr string) bool {
+
+	switch strings.ToLower(addr) {
+	case "", ".", "localhost":
+		return true
+	}
+
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.IsLoopback()
+}
+
 func (c *conn) closeTransport(ctx context.Context, tr *transport) error {
 
 	c.mu.Lock()
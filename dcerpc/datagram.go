@@ -0,0 +1,245 @@
+package dcerpc
+
+// datagram.go contains the connectionless (ncadg_*) PDU header
+// definitions.
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/rs/zerolog"
+)
+
+// The connectionless header size.
+const DatagramHeaderSize = 80
+
+// The connectionless protocol version.
+const DatagramRPCVersion = 4
+
+// The connectionless packet types.
+const (
+	PacketTypeDatagramRequest   PacketType = 0
+	PacketTypeDatagramPing      PacketType = 1
+	PacketTypeDatagramResponse  PacketType = 2
+	PacketTypeDatagramFault     PacketType = 3
+	PacketTypeDatagramWorking   PacketType = 4
+	PacketTypeDatagramNoCall    PacketType = 5
+	PacketTypeDatagramReject    PacketType = 6
+	PacketTypeDatagramAck       PacketType = 7
+	PacketTypeDatagramCancel    PacketType = 8
+	PacketTypeDatagramFack      PacketType = 9
+	PacketTypeDatagramCancelAck PacketType = 10
+)
+
+// DatagramFlag is the connectionless header flags1 field.
+type DatagramFlag uint8
+
+func (f DatagramFlag) IsSet(ff DatagramFlag) bool {
+	return f&ff != 0
+}
+
+const (
+	// Reserved for use by implementations.
+	DatagramFlagReserved01 DatagramFlag = 0x01
+	// Last fragment of a multi-fragment packet.
+	DatagramFlagLastFrag DatagramFlag = 0x02
+	// The packet is a fragment.
+	DatagramFlagFrag DatagramFlag = 0x04
+	// The receiver is not requested to send a fack for the fragment.
+	DatagramFlagNoFack DatagramFlag = 0x08
+	// The request is for a maybe call.
+	DatagramFlagMaybe DatagramFlag = 0x10
+	// The request is for an idempotent call.
+	DatagramFlagIdempotent DatagramFlag = 0x20
+	// The request is for a broadcast call.
+	DatagramFlagBroadcast DatagramFlag = 0x40
+)
+
+func (f DatagramFlag) String() string {
+
+	ret, sep := "", ""
+	if f.IsSet(DatagramFlagLastFrag) {
+		ret, sep = ret+sep+"last_frag", "|"
+	}
+
+	if f.IsSet(DatagramFlagFrag) {
+		ret, sep = ret+sep+"frag", "|"
+	}
+
+	if f.IsSet(DatagramFlagNoFack) {
+		ret, sep = ret+sep+"no_fack", "|"
+	}
+
+	if f.IsSet(DatagramFlagMaybe) {
+		ret, sep = ret+sep+"maybe", "|"
+	}
+
+	if f.IsSet(DatagramFlagIdempotent) {
+		ret, sep = ret+sep+"idempotent", "|"
+	}
+
+	if f.IsSet(DatagramFlagBroadcast) {
+		ret, sep = ret+sep+"broadcast", "|"
+	}
+
+	return ret
+}
+
+// The cancel was pending at the call end (flags2 field).
+const DatagramFlag2CancelPending uint8 = 0x02
+
+// DatagramHeader is the connectionless PDU header.
+type DatagramHeader struct {
+	RPCVersion    uint8
+	PacketType    PacketType
+	Flags         DatagramFlag
+	Flags2        uint8
+	PacketDRep    ndr.DataRepresentation
+	SerialNumber  uint16
+	ObjectUUID    *uuid.UUID
+	InterfaceID   *uuid.UUID
+	ActivityID    *uuid.UUID
+	ServerBoot    uint32
+	IfVersion     uint32
+	SequenceNum   uint32
+	OpNum         uint16
+	InterfaceHint uint16
+	ActivityHint  uint16
+	BodyLength    uint16
+	FragmentNum   uint16
+	AuthProto     uint8
+}
+
+func (h DatagramHeader) MarshalZerologObject(e *zerolog.Event) {
+	e.Uint8("packet_type", uint8(h.PacketType))
+	e.Stringer("flags", h.Flags)
+	e.Stringer("activity_id", h.ActivityID)
+	e.Uint32("seq_num", h.SequenceNum)
+	e.Uint16("frag_num", h.FragmentNum)
+	e.Uint16("body_length", h.BodyLength)
+}
+
+// DatagramIfVersion function returns the if_vers header field value for
+// the syntax identifier.
+func DatagramIfVersion(s *SyntaxID) uint32 {
+	return uint32(s.IfVersionMajor) | uint32(s.IfVersionMinor)<<16
+}
+
+// encodeUUID function encodes the UUID using the byte order.
+func encodeUUID(b []byte, u *uuid.UUID, order binary.ByteOrder) {
+	if u == nil {
+		u = &uuid.UUID{}
+	}
+	order.PutUint32(b[0:], u.TimeLow)
+	order.PutUint16(b[4:], u.TimeMid)
+	order.PutUint16(b[6:], u.TimeHiAndVersion)
+	b[8], b[9] = u.ClockSeqHiAndReserved, u.ClockSeqLow
+	copy(b[10:16], u.Node[:])
+}
+
+// decodeUUID function decodes the UUID using the byte order.
+func decodeUUID(b []byte, order binary.ByteOrder) *uuid.UUID {
+	u := &uuid.UUID{
+		TimeLow:               order.Uint32(b[0:]),
+		TimeMid:               order.Uint16(b[4:]),
+		TimeHiAndVersion:      order.Uint16(b[6:]),
+		ClockSeqHiAndReserved: b[8],
+		ClockSeqLow:           b[9],
+	}
+	copy(u.Node[:], b[10:16])
+	return u
+}
+
+// Bytes function encodes the header. The header is always encoded using
+// the data representation from the PacketDRep field.
+func (h *DatagramHeader) Bytes() []byte {
+
+	b, order := make([]byte, DatagramHeaderSize), h.PacketDRep.ByteOrder()
+
+	b[0], b[1], b[2], b[3] = h.RPCVersion, uint8(h.PacketType), uint8(h.Flags), h.Flags2
+	binary.LittleEndian.PutUint32(b[4:], uint32(h.PacketDRep))
+	b[7] = uint8(h.SerialNumber >> 8)
+	encodeUUID(b[8:], h.ObjectUUID, order)
+	encodeUUID(b[24:], h.InterfaceID, order)
+	encodeUUID(b[40:], h.ActivityID, order)
+	order.PutUint32(b[56:], h.ServerBoot)
+	order.PutUint32(b[60:], h.IfVersion)
+	order.PutUint32(b[64:], h.SequenceNum)
+	order.PutUint16(b[68:], h.OpNum)
+	order.PutUint16(b[70:], h.InterfaceHint)
+	order.PutUint16(b[72:], h.ActivityHint)
+	order.PutUint16(b[74:], h.BodyLength)
+	order.PutUint16(b[76:], h.FragmentNum)
+	b[78], b[79] = h.AuthProto, uint8(h.SerialNumber)
+
+	return b
+}
+
+// ParseDatagramHeader function decodes the connectionless header.
+func ParseDatagramHeader(b []byte) (*DatagramHeader, error) {
+
+	if len(b) < DatagramHeaderSize {
+		return nil, fmt.Errorf("datagram header: short buffer: %d bytes", len(b))
+	}
+
+	if b[0] != DatagramRPCVersion {
+		return nil, fmt.Errorf("datagram header: unsupported version: %d", b[0])
+	}
+
+	h := &DatagramHeader{
+		RPCVersion: b[0],
+		PacketType: PacketType(b[1]),
+		Flags:      DatagramFlag(b[2]),
+		Flags2:     b[3],
+		PacketDRep: ndr.DataRepresentation(uint32(b[4]) | uint32(b[5])<<8 | uint32(b[6])<<16),
+		AuthProto:  b[78],
+	}
+
+	order := h.PacketDRep.ByteOrder()
+
+	h.SerialNumber = uint16(b[7])<<8 | uint16(b[79])
+	h.ObjectUUID = decodeUUID(b[8:], order)
+	h.InterfaceID = decodeUUID(b[24:], order)
+	h.ActivityID = decodeUUID(b[40:], order)
+	h.ServerBoot = order.Uint32(b[56:])
+	h.IfVersion = order.Uint32(b[60:])
+	h.SequenceNum = order.Uint32(b[64:])
+	h.OpNum = order.Uint16(b[68:])
+	h.InterfaceHint = order.Uint16(b[70:])
+	h.ActivityHint = order.Uint16(b[72:])
+	h.BodyLength = order.Uint16(b[74:])
+	h.FragmentNum = order.Uint16(b[76:])
+
+	if len(b) < DatagramHeaderSize+int(h.BodyLength) {
+		return nil, fmt.Errorf("datagram header: truncated body: %d < %d bytes", len(b)-DatagramHeaderSize, h.BodyLength)
+	}
+
+	return h, nil
+}
+
+// DatagramFack is the connectionless fack PDU body.
+type DatagramFack struct {
+	Version      uint8
+	WindowSize   uint16
+	MaxTSDU      uint32
+	MaxFragSize  uint32
+	SerialNumber uint16
+	SelectiveAck []uint32
+}
+
+// Bytes function encodes the fack body using the byte order.
+func (f *DatagramFack) Bytes(order binary.ByteOrder) []byte {
+	b := make([]byte, 16+4*len(f.SelectiveAck))
+	b[0] = f.Version
+	order.PutUint16(b[2:], f.WindowSize)
+	order.PutUint32(b[4:], f.MaxTSDU)
+	order.PutUint32(b[8:], f.MaxFragSize)
+	order.PutUint16(b[12:], f.SerialNumber)
+	order.PutUint16(b[14:], uint16(len(f.SelectiveAck)))
+	for i := range f.SelectiveAck {
+		order.PutUint32(b[16+4*i:], f.SelectiveAck[i])
+	}
+	return b
+}
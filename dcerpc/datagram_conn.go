@@ -0,0 +1,655 @@
+package dcerpc
+
+// datagram_conn.go contains the connectionless (ncadg_ip_udp) client
+// protocol engine.
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/rs/zerolog"
+)
+
+var (
+	// The maximum connectionless PDU size (including header) that fits
+	// into the single ethernet frame.
+	DatagramFragmentSize = 1464
+	// The interval after which the request is retransmitted if no
+	// response has been received.
+	DatagramRetransmitInterval = time.Second
+)
+
+// The conversation manager (conv) interface that is used by the
+// connectionless servers to call back the client activity.
+var convSyntaxV3_0 = &SyntaxID{
+	IfUUID:         uuid.MustParse("333a2276-0000-0000-0d00-00809c000000"),
+	IfVersionMajor: 3,
+}
+
+// The DCE/RPC connectionless client connection.
+//
+// The connection maintains the single activity, so the calls
+// are serialized.
+type datagramConn struct {
+	mu sync.Mutex
+	// The group connection.
+	group *conn
+	// The UDP socket.
+	cc net.Conn
+	// The settings.
+	settings *Transport
+	// The abstract syntax.
+	syntax *SyntaxID
+	// The default object UUID for the calls.
+	object *uuid.UUID
+	// The unary interceptor chain.
+	interceptor UnaryInterceptor
+	// The activity identifier.
+	activity *uuid.UUID
+	// The current sequence number.
+	seq uint32
+	// The server boot time (zero until the first response).
+	serverBoot uint32
+	// The interface and activity hints returned by server.
+	ihint, ahint uint16
+	// The receive buffer.
+	rx []byte
+	// The flag that indicates whether the connection is closed.
+	closed bool
+	// The last write time.
+	active time.Time
+	// The channel is closed when the connection is closed.
+	done chan struct{}
+	// Logger.
+	logger zerolog.Logger
+}
+
+// newActivityID function generates the random (version 4) activity UUID.
+func newActivityID() *uuid.UUID {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6], b[8] = (b[6]&0x0f)|0x40, (b[8]&0x3f)|0x80
+	u := &uuid.UUID{}
+	u.DecodeBinary(b)
+	return u
+}
+
+// bindDatagram function establishes the connectionless client connection.
+// There is no presentation context negotiation for the connectionless
+// protocol, so the UDP socket is just dialed.
+func (t *conn) bindDatagram(ctx context.Context, binding StringBinding, o *option) (Conn, error) {
+
+	if o.Security != nil && o.Security.Level > AuthLevelNone {
+		return nil, fmt.Errorf("ncadg_ip_udp: authentication is not supported")
+	}
+
+	addr := net.JoinHostPort(binding.NetworkAddress, binding.Endpoint)
+
+	if binding.NetworkAddress == "" || binding.NetworkAddress == "0.0.0.0" {
+		addr = net.JoinHostPort(t.serverAddr, binding.Endpoint)
+	}
+
+	if err := t.settings.TargetPolicy.CheckAddr(addr, t.settings.HostName); err != nil {
+		return nil, fmt.Errorf("ncadg_ip_udp: %w", err)
+	}
+
+	t.logger.Debug().Msgf("dialing udp %s", addr)
+
+	var (
+		cc  net.Conn
+		err error
+	)
+
+	if t.settings.Dialer != nil {
+		if cc, err = t.settings.Dialer.DialContext(ctx, "udp", addr); err != nil {
+			return nil, fmt.Errorf("ncadg_ip_udp: custom dialer: %w", err)
+		}
+	} else {
+		if cc, err = (&net.Dialer{Timeout: t.settings.Timeout}).DialContext(ctx, "udp", addr); err != nil {
+			return nil, fmt.Errorf("ncadg_ip_udp: %w", err)
+		}
+	}
+
+	c := &datagramConn{
+		group:       t,
+		cc:          cc,
+		settings:    t.settings,
+		syntax:      o.AbstractSyntaxes[0],
+		object:      o.ObjectUUID,
+		activity:    newActivityID(),
+		interceptor: chainInterceptors(o.Interceptors),
+		ihint:       0xFFFF,
+		ahint:       0xFFFF,
+		rx:          make([]byte, 0xFFFF),
+		active:      time.Now(),
+		done:        make(chan struct{}),
+		logger:      o.Logger,
+	}
+
+	if t.settings.KeepAlive > 0 {
+		go c.keepAlive()
+	}
+
+	return c, nil
+}
+
+// Bind function establishes new client connection using the group connection.
+func (c *datagramConn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
+	return c.group.Bind(ctx, opts...)
+}
+
+// AlterContext function is not supported for the connectionless protocol.
+func (c *datagramConn) AlterContext(ctx context.Context, opts ...Option) error {
+	return fmt.Errorf("alter connection context: not supported for ncadg_ip_udp")
+}
+
+func (c *datagramConn) Context() context.Context {
+	return context.Background()
+}
+
+// Info function returns the connection information. (the security
+// is not supported for the connectionless protocol).
+func (c *datagramConn) Info() *ConnInfo {
+	return &ConnInfo{}
+}
+
+// Invoke function invokes the operation.
+func (c *datagramConn) Invoke(ctx context.Context, op Operation, opts ...CallOption) error {
+	return intercept(ctx, c.interceptor, c.callInfo(opts, nil), op, opts, c.invokeOp)
+}
+
+// invokeOp function invokes the operation (bypassing the interceptors).
+func (c *datagramConn) invokeOp(ctx context.Context, op Operation, opts ...CallOption) error {
+
+	if err := c.invokeRetry(ctx, op, opts...); err != nil {
+		return fmt.Errorf("dcerpc: invoke: %s: %w", op.OpName(), err)
+	}
+
+	return nil
+}
+
+// InvokeObject function invokes the operation with ObjectUUID.
+func (c *datagramConn) InvokeObject(ctx context.Context, obj *uuid.UUID, op Operation, opts ...CallOption) error {
+	return intercept(ctx, c.interceptor, c.callInfo(opts, obj), op, opts, func(ctx context.Context, op Operation, opts ...CallOption) error {
+		return c.invokeObject(ctx, obj, op, opts...)
+	})
+}
+
+// invokeObject function invokes the operation with ObjectUUID (bypassing
+// the interceptors).
+func (c *datagramConn) invokeObject(ctx context.Context, obj *uuid.UUID, op Operation, opts ...CallOption) error {
+
+	if err := c.invokeRetry(ctx, op, append(opts, WithObjectUUID(obj))...); err != nil {
+		return fmt.Errorf("dcerpc: invoke_object: %s: %s: %w", obj.String(), op.OpName(), err)
+	}
+
+	return nil
+}
+
+// invokeRetry function invokes the operation (retrying the transient faults
+// according to the retry policy).
+func (c *datagramConn) invokeRetry(ctx context.Context, op Operation, opts ...CallOption) error {
+	return c.settings.RetryPolicy.retry(ctx, func() error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.invoke(ctx, op, opts...)
+	})
+}
+
+// callInfo function returns the call information for the interceptors.
+func (c *datagramConn) callInfo(opts []CallOption, obj *uuid.UUID) *CallInfo {
+
+	if c.interceptor == nil {
+		return nil
+	}
+
+	info := &CallInfo{AbstractSyntax: c.syntax, ObjectUUID: obj}
+
+	if info.ObjectUUID == nil {
+		if info.ObjectUUID, _ = HasObjectUUID(opts); info.ObjectUUID == nil {
+			info.ObjectUUID = c.object
+		}
+	}
+
+	return info
+}
+
+// RegsiterServer: NYI.
+func (c *datagramConn) RegisterServer(h ServerHandle, opts ...Option) {
+	// NYI.
+}
+
+// Close function closes the UDP socket. The function can be called
+// multiple times.
+func (c *datagramConn) Close(ctx context.Context) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	c.closed = true
+	close(c.done)
+
+	return c.cc.Close()
+}
+
+// header function returns the header for the current call.
+func (c *datagramConn) header(typ PacketType, flags DatagramFlag, obj *uuid.UUID, opNum int) *DatagramHeader {
+	return &DatagramHeader{
+		RPCVersion:    DatagramRPCVersion,
+		PacketType:    typ,
+		Flags:         flags,
+		PacketDRep:    ndr.DefaultDataRepresentation,
+		ObjectUUID:    obj,
+		InterfaceID:   c.syntax.IfUUID,
+		ActivityID:    c.activity,
+		ServerBoot:    c.serverBoot,
+		IfVersion:     DatagramIfVersion(c.syntax),
+		SequenceNum:   c.seq,
+		OpNum:         uint16(opNum),
+		InterfaceHint: c.ihint,
+		ActivityHint:  c.ahint,
+	}
+}
+
+// write function writes the PDU to the socket.
+func (c *datagramConn) write(hdr *DatagramHeader, body []byte) error {
+	hdr.BodyLength, c.active = uint16(len(body)), time.Now()
+	c.logger.Debug().EmbedObject(hdr).Msg("write datagram")
+	_, err := c.cc.Write(append(hdr.Bytes(), body...))
+	return err
+}
+
+// fragments function splits the request body to the fragments.
+func (c *datagramConn) fragments(body []byte) [][]byte {
+
+	sz := (DatagramFragmentSize - DatagramHeaderSize) &^ 7
+	if len(body) <= sz {
+		return [][]byte{body}
+	}
+
+	var frags [][]byte
+	for len(body) > sz {
+		frags, body = append(frags, body[:sz]), body[sz:]
+	}
+
+	return append(frags, body)
+}
+
+// invoke.
+func (c *datagramConn) invoke(ctx context.Context, op Operation, opts ...CallOption) error {
+
+	if c.closed {
+		return ErrConnClosed
+	}
+
+	obj, ok := HasObjectUUID(opts)
+	if !ok {
+		obj = c.object
+	}
+
+	w := ndr.NDR20(nil)
+	if err := op.MarshalNDRRequest(ctx, w); err != nil {
+		return fmt.Errorf("request: marshal: %w", err)
+	}
+
+	if _, ok := ctx.Deadline(); !ok && c.settings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.settings.Timeout)
+		defer cancel()
+	}
+
+	var flags DatagramFlag
+	if HasIdempotent(opts) {
+		flags |= DatagramFlagIdempotent
+	}
+	if HasMaybe(opts) {
+		flags |= DatagramFlagMaybe | DatagramFlagIdempotent
+	}
+
+	frags := c.fragments(w.Bytes())
+
+	// the sequence number identifies the call within the activity.
+	defer func() { c.seq++ }()
+
+	send := func() error {
+		for i := range frags {
+			hdr := c.header(PacketTypeDatagramRequest, flags, obj, op.OpNum())
+			if hdr.FragmentNum = uint16(i); len(frags) > 1 {
+				if hdr.Flags |= DatagramFlagFrag; i == len(frags)-1 {
+					hdr.Flags |= DatagramFlagLastFrag
+				} else {
+					hdr.Flags |= DatagramFlagNoFack
+				}
+			}
+			if err := c.write(hdr, frags[i]); err != nil {
+				return fmt.Errorf("request: write: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if err := send(); err != nil {
+		return err
+	}
+
+	if flags.IsSet(DatagramFlagMaybe) {
+		// no response is expected for maybe call.
+		return nil
+	}
+
+	resp, err := c.recv(ctx, op, send)
+	if err != nil {
+		return err
+	}
+
+	if !flags.IsSet(DatagramFlagIdempotent) {
+		// acknowledge the receipt of the response for at-most-once call.
+		if err := c.write(c.header(PacketTypeDatagramAck, 0, obj, op.OpNum()), nil); err != nil {
+			c.logger.Debug().Err(err).Msg("ack: write error")
+		}
+	}
+
+	if err := resp.wait(); err != nil {
+		return fmt.Errorf("response: unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+// responseStream is the response fragment reassembly pipeline: the
+// fragments received in order are decoded as they arrive, only the
+// fragments received out of order are buffered.
+type responseStream struct {
+	// The pipe to the decoder.
+	w *io.PipeWriter
+	// The next fragment number to decode.
+	next uint16
+	// The fragments received out of order.
+	pending map[uint16][]byte
+	// The decoding result.
+	done chan error
+	// The decoding error (once the decoder has stopped).
+	err error
+	// The flag that indicates whether the decoder has stopped.
+	stopped bool
+}
+
+// newResponseStream function starts the decoder of the response stub data
+// with the data representation `drep` and NDR options `opts`.
+func newResponseStream(ctx context.Context, op Operation, drep ndr.DataRepresentation, opts ...any) *responseStream {
+
+	r, w := io.Pipe()
+
+	s := &responseStream{w: w, pending: make(map[uint16][]byte), done: make(chan error, 1)}
+
+	go func() {
+		err := unmarshalResponse(ctx, op, ndr.NDR20(nil, append(opts, drep, ndr.NewReaderChunk(r, drep, 0))...))
+		// unblock the fragments not consumed by the decoder.
+		r.CloseWithError(io.ErrClosedPipe)
+		s.done <- err
+	}()
+
+	return s
+}
+
+// received function returns `true` if any fragment was received.
+func (s *responseStream) received() bool {
+	return s != nil && (s.next > 0 || len(s.pending) > 0)
+}
+
+// put function passes the fragment to the decoder (or buffers the fragment
+// received out of order). The fragment body is not retained unless it is
+// buffered.
+func (s *responseStream) put(num uint16, body []byte) error {
+
+	if s.stopped || num < s.next {
+		// duplicate (retransmitted) fragment.
+		return s.err
+	}
+
+	if num != s.next {
+		if _, ok := s.pending[num]; !ok {
+			s.pending[num] = append([]byte(nil), body...)
+		}
+		return nil
+	}
+
+	for {
+		if _, err := s.w.Write(body); err != nil {
+			// the decoder has stopped (the trailing data is ignored).
+			s.stop()
+			return s.err
+		}
+		s.next++
+		var ok bool
+		if body, ok = s.pending[s.next]; !ok {
+			return nil
+		}
+		delete(s.pending, s.next)
+	}
+}
+
+// complete function returns `true` if all fragments up to the last one
+// were passed to the decoder.
+func (s *responseStream) complete(last int) bool {
+	return s.stopped || (last >= 0 && int(s.next) > last)
+}
+
+// stop function waits for the decoder result.
+func (s *responseStream) stop() {
+	if !s.stopped {
+		s.stopped, s.err = true, <-s.done
+	}
+}
+
+// close function terminates the decoder with the error `err` (or
+// indicates the end of stub data if `err` is nil).
+func (s *responseStream) close(err error) {
+	if s != nil {
+		s.w.CloseWithError(err)
+	}
+}
+
+// wait function waits for the decoder and returns the decoding error.
+func (s *responseStream) wait() error {
+	s.close(nil)
+	s.stop()
+	return s.err
+}
+
+// unmarshalResponse function unmarshals the response, the panic raised while
+// unmarshaling the malformed response is returned as error.
+func unmarshalResponse(ctx context.Context, op Operation, r ndr.Reader) (err error) {
+	defer ndr.Recover(&err)
+	return op.UnmarshalNDRResponse(ctx, r)
+}
+
+// recv function receives the response fragments and passes them to the
+// decoder, the request is retransmitted using `resend` if no response was
+// received in time.
+func (c *datagramConn) recv(ctx context.Context, op Operation, resend func() error) (resp *responseStream, err error) {
+
+	last := -1
+
+	defer func() {
+		if err != nil {
+			// terminate the decoder.
+			resp.close(err)
+			if resp != nil {
+				resp.stop()
+			}
+		}
+	}()
+
+	for {
+
+		if err := ctx.Err(); err != nil {
+			return resp, fmt.Errorf("response: %w", err)
+		}
+
+		c.cc.SetReadDeadline(time.Now().Add(DatagramRetransmitInterval))
+
+		n, err := c.cc.Read(c.rx)
+		if err != nil {
+			if os.IsTimeout(err) {
+				c.logger.Debug().Uint32("seq_num", c.seq).Msg("retransmitting the request")
+				if err := resend(); err != nil {
+					return resp, err
+				}
+				continue
+			}
+			return resp, fmt.Errorf("response: read: %w", err)
+		}
+
+		hdr, err := ParseDatagramHeader(c.rx[:n])
+		if err != nil {
+			c.logger.Debug().Err(err).Msg("skipping malformed datagram")
+			continue
+		}
+
+		body := c.rx[DatagramHeaderSize : DatagramHeaderSize+int(hdr.BodyLength)]
+
+		c.logger.Debug().EmbedObject(hdr).Msg("read datagram")
+
+		if hdr.PacketType == PacketTypeDatagramRequest && hdr.InterfaceID.Equals(convSyntaxV3_0.IfUUID) {
+			// server callback to verify the activity sequence number.
+			if err := c.conv(hdr, body); err != nil {
+				return resp, fmt.Errorf("conv callback: %w", err)
+			}
+			continue
+		}
+
+		if !hdr.ActivityID.Equals(c.activity) || hdr.SequenceNum != c.seq {
+			// packet for another (previous) call.
+			continue
+		}
+
+		order := hdr.PacketDRep.ByteOrder()
+
+		switch hdr.PacketType {
+		case PacketTypeDatagramResponse:
+
+			c.serverBoot, c.ihint, c.ahint = hdr.ServerBoot, hdr.InterfaceHint, hdr.ActivityHint
+
+			if resp == nil {
+				resp = newResponseStream(ctx, op, hdr.PacketDRep, c.settings.DecodeLimits)
+			}
+
+			if !hdr.Flags.IsSet(DatagramFlagFrag) || hdr.Flags.IsSet(DatagramFlagLastFrag) {
+				last = int(hdr.FragmentNum)
+			}
+
+			if err := resp.put(hdr.FragmentNum, body); err != nil {
+				return resp, fmt.Errorf("response: unmarshal: %w", err)
+			}
+
+			if hdr.Flags.IsSet(DatagramFlagFrag) && !hdr.Flags.IsSet(DatagramFlagNoFack) {
+				if err := c.fack(hdr, op); err != nil {
+					return resp, err
+				}
+			}
+
+			if !resp.complete(last) {
+				continue
+			}
+
+			return resp, nil
+
+		case PacketTypeDatagramFault, PacketTypeDatagramReject:
+
+			var status uint32
+			if len(body) >= 4 {
+				status = order.Uint32(body)
+			}
+
+			if hdr.PacketType == PacketTypeDatagramReject {
+				return resp, fmt.Errorf("rejected: %w", errors.New(ctx, status))
+			}
+
+			return resp, errors.New(ctx, status)
+
+		case PacketTypeDatagramNoCall:
+			// server has not received the request.
+			if !resp.received() {
+				if err := resend(); err != nil {
+					return resp, err
+				}
+			}
+		case PacketTypeDatagramWorking, PacketTypeDatagramFack:
+			// server is processing the call.
+		}
+	}
+}
+
+// fack function acknowledges the received response fragment.
+func (c *datagramConn) fack(hdr *DatagramHeader, op Operation) error {
+
+	ack := c.header(PacketTypeDatagramFack, 0, nil, op.OpNum())
+	ack.FragmentNum, ack.SerialNumber = hdr.FragmentNum, hdr.SerialNumber
+
+	body := (&DatagramFack{
+		WindowSize:   16,
+		MaxTSDU:      uint32(len(c.rx)),
+		MaxFragSize:  uint32(DatagramFragmentSize),
+		SerialNumber: hdr.SerialNumber,
+	}).Bytes(binary.LittleEndian)
+
+	if err := c.write(ack, body); err != nil {
+		return fmt.Errorf("fack: write: %w", err)
+	}
+
+	return nil
+}
+
+// conv function handles the conversation manager callback (conv_who_are_you,
+// conv_who_are_you2 and conv_are_you_there) that server performs to learn
+// the activity sequence number.
+func (c *datagramConn) conv(hdr *DatagramHeader, body []byte) error {
+
+	order := hdr.PacketDRep.ByteOrder()
+
+	var resp []byte
+
+	switch hdr.OpNum {
+	case 0: // conv_who_are_you(actuid, boot_time) -> (seq, st)
+		resp = make([]byte, 8)
+		order.PutUint32(resp[0:], c.seq)
+	case 1: // conv_who_are_you2(actuid, boot_time) -> (seq, cas_uuid, st)
+		resp = make([]byte, 24)
+		order.PutUint32(resp[0:], c.seq)
+		encodeUUID(resp[4:], c.activity, order)
+	case 2: // conv_are_you_there(actuid, boot_time) -> (st)
+		resp = make([]byte, 4)
+	default:
+		rej := *hdr
+		rej.PacketType, rej.Flags, rej.InterfaceHint, rej.ActivityHint = PacketTypeDatagramReject, 0, 0xFFFF, 0xFFFF
+		resp = make([]byte, 4)
+		order.PutUint32(resp, 0x1C010003) // nca_s_op_rng_error.
+		return c.write(&rej, resp)
+	}
+
+	if len(body) >= 20 && hdr.OpNum < 2 {
+		// the boot time is the server boot time, capture it.
+		c.serverBoot = order.Uint32(body[16:])
+	}
+
+	ret := *hdr
+	ret.PacketType, ret.Flags, ret.FragmentNum = PacketTypeDatagramResponse, DatagramFlagNoFack, 0
+	ret.InterfaceHint, ret.ActivityHint = 0xFFFF, 0xFFFF
+
+	return c.write(&ret, resp)
+}
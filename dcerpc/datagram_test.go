@@ -0,0 +1,229 @@
+package dcerpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+type datagramTestOp struct {
+	In   uint32
+	Blob []byte
+	Out  uint32
+}
+
+func (o *datagramTestOp) OpNum() int     { return 7 }
+func (o *datagramTestOp) OpName() string { return "/test/v1/Sum" }
+
+func (o *datagramTestOp) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	w.WriteData(o.In)
+	w.Write(o.Blob)
+	return w.Err()
+}
+
+func (o *datagramTestOp) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	return nil
+}
+
+func (o *datagramTestOp) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return nil
+}
+
+func (o *datagramTestOp) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	r.ReadData(&o.Out)
+	return r.Err()
+}
+
+// serveDatagram function serves single fragmented request: it performs the
+// conv_who_are_you2 callback and returns the sum of request bytes.
+func serveDatagram(pc net.PacketConn, syntax *SyntaxID) error {
+
+	var (
+		b    = make([]byte, 0xFFFF)
+		body []byte
+		req  *DatagramHeader
+	)
+
+	pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	for {
+		n, addr, err := pc.ReadFrom(b)
+		if err != nil {
+			return err
+		}
+		hdr, err := ParseDatagramHeader(b[:n])
+		if err != nil {
+			return err
+		}
+		switch {
+		case hdr.PacketType == PacketTypeDatagramRequest:
+			if !hdr.InterfaceID.Equals(syntax.IfUUID) || hdr.IfVersion != DatagramIfVersion(syntax) || hdr.OpNum != 7 {
+				return fmt.Errorf("unexpected request header")
+			}
+			if hdr.FragmentNum == 0 {
+				body = nil
+			}
+			body = append(body, b[DatagramHeaderSize:n]...)
+			if hdr.Flags.IsSet(DatagramFlagFrag) && !hdr.Flags.IsSet(DatagramFlagLastFrag) {
+				continue
+			}
+			req = hdr
+			// callback the client activity.
+			conv := &DatagramHeader{
+				RPCVersion:    DatagramRPCVersion,
+				PacketType:    PacketTypeDatagramRequest,
+				Flags:         DatagramFlagIdempotent,
+				PacketDRep:    ndr.DefaultDataRepresentation,
+				InterfaceID:   convSyntaxV3_0.IfUUID,
+				ActivityID:    &uuid.UUID{TimeLow: 1},
+				IfVersion:     DatagramIfVersion(convSyntaxV3_0),
+				OpNum:         1,
+				InterfaceHint: 0xFFFF,
+				ActivityHint:  0xFFFF,
+			}
+			convBody := make([]byte, 20)
+			encodeUUID(convBody, req.ActivityID, binary.LittleEndian)
+			binary.LittleEndian.PutUint32(convBody[16:], 0x12345678)
+			conv.BodyLength = uint16(len(convBody))
+			pc.WriteTo(append(conv.Bytes(), convBody...), addr)
+		case hdr.PacketType == PacketTypeDatagramResponse && hdr.InterfaceID.Equals(convSyntaxV3_0.IfUUID):
+			if seq := binary.LittleEndian.Uint32(b[DatagramHeaderSize:]); seq != req.SequenceNum {
+				return fmt.Errorf("conv: unexpected sequence number: %d", seq)
+			}
+			var sum uint32
+			for _, c := range body[4:] {
+				sum += uint32(c)
+			}
+			resp := *req
+			resp.PacketType, resp.Flags, resp.FragmentNum, resp.ServerBoot = PacketTypeDatagramResponse, DatagramFlagNoFack, 0, 0x12345678
+			out := binary.LittleEndian.AppendUint32(nil, sum+binary.LittleEndian.Uint32(body))
+			resp.BodyLength = uint16(len(out))
+			pc.WriteTo(append(resp.Bytes(), out...), addr)
+		case hdr.PacketType == PacketTypeDatagramAck:
+			if hdr.SequenceNum != req.SequenceNum || hdr.ServerBoot != 0x12345678 {
+				return fmt.Errorf("ack: unexpected header")
+			}
+			return nil
+		}
+	}
+}
+
+func TestDatagram(t *testing.T) {
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen udp: %v", err)
+	}
+	defer pc.Close()
+
+	syntax := &SyntaxID{IfUUID: uuid.MustParse("12345678-1234-abcd-ef00-0123456789ab"), IfVersionMajor: 1, IfVersionMinor: 2}
+
+	done := make(chan error, 1)
+	go func() { done <- serveDatagram(pc, syntax) }()
+
+	ctx := context.Background()
+
+	port := strconv.Itoa(pc.LocalAddr().(*net.UDPAddr).Port)
+
+	cc, err := Dial(ctx, "ncadg_ip_udp:127.0.0.1["+port+"]")
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	conn, err := cc.Bind(ctx, WithInsecure(), WithAbstractSyntax(syntax))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	// request must span several fragments.
+	op := &datagramTestOp{In: 10, Blob: bytes.Repeat([]byte{1}, 3*DatagramFragmentSize)}
+
+	if err := conn.Invoke(ctx, op); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	if op.Out != uint32(10+len(op.Blob)) {
+		t.Errorf("invoke: unexpected output: %d", op.Out)
+	}
+
+	if err := <-done; err != nil {
+		t.Errorf("server: %v", err)
+	}
+}
+
+// valuesTestOp operation returns the list of values.
+type valuesTestOp struct {
+	Values []uint32
+}
+
+func (o *valuesTestOp) OpNum() int                                                { return 0 }
+func (o *valuesTestOp) OpName() string                                            { return "Values" }
+func (o *valuesTestOp) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error { return nil }
+
+func (o *valuesTestOp) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error { return nil }
+
+func (o *valuesTestOp) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return ndr.WriteConformantArray(ctx, w, o.Values, ndr.WriteElem[uint32])
+}
+
+func (o *valuesTestOp) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	return ndr.ReadConformantArray(ctx, r, &o.Values, ndr.ReadElem[uint32])
+}
+
+func TestResponseStream(t *testing.T) {
+
+	ctx := context.Background()
+
+	values := make([]uint32, 4096)
+	for i := range values {
+		values[i] = uint32(i + 1)
+	}
+
+	stub, err := ndr.Marshal(ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+		return ndr.WriteConformantArray(ctx, w, values, ndr.WriteElem[uint32])
+	}))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var frags [][]byte
+	for sz := len(stub)/4 + 1; len(stub) > 0; {
+		n := min(sz, len(stub))
+		frags, stub = append(frags, stub[:n]), stub[n:]
+	}
+
+	op := &valuesTestOp{}
+	resp := newResponseStream(ctx, op, ndr.DefaultDataRepresentation)
+
+	// the fragments received out of order and retransmitted.
+	for _, i := range []uint16{2, 0, 0, 3, 2, 1} {
+		if resp.complete(len(frags) - 1) {
+			t.Fatalf("complete: fragment %d is not received", i)
+		}
+		if err := resp.put(i, frags[i]); err != nil {
+			t.Fatalf("put: %v", err)
+		}
+	}
+
+	if !resp.complete(len(frags) - 1) {
+		t.Fatalf("complete: expected all fragments received")
+	}
+
+	if err := resp.wait(); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+
+	if !reflect.DeepEqual(op.Values, values) {
+		t.Fatalf("values: got %d values, want %d", len(op.Values), len(values))
+	}
+}
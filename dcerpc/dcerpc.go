@@ -2,12 +2,14 @@ package dcerpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"io"
 	"sync"
 
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
 	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 )
 
 var (
@@ -40,6 +42,9 @@ const DefaultXmitSize = 4096
 // The MinimumXmitSize.
 const MinimumXmitSize = 4096
 
+// The MaximumXmitSize (the fragment length is 16-bit).
+const MaximumXmitSize = 0xFFFF
+
 // The DCE/RPC Operation.
 type Operation = ndr.Operation
 
@@ -48,6 +53,29 @@ type RawConn interface {
 	io.ReadWriteCloser
 }
 
+// ChannelBindingsConn interface is implemented by the raw connections
+// (ie, returned by the custom dialer) that provide the channel binding
+// material for the security contexts.
+type ChannelBindingsConn interface {
+	// ChannelBindings function returns the channel bindings.
+	ChannelBindings() (gssapi.ChannelBindings, error)
+}
+
+// ConnChannelBindings function returns the channel bindings for the raw
+// connection: the connection-provided channel bindings (ChannelBindingsConn)
+// or tls-server-end-point channel bindings for the TLS connection.
+func ConnChannelBindings(conn RawConn) (gssapi.ChannelBindings, error) {
+
+	switch conn := conn.(type) {
+	case ChannelBindingsConn:
+		return conn.ChannelBindings()
+	case interface{ ConnectionState() tls.ConnectionState }:
+		return gssapi.TLSChannelBindings(conn.ConnectionState())
+	}
+
+	return nil, gssapi.ErrNoChannelBindings
+}
+
 // The DCE/RPC Connection.
 type Conn interface {
 	Bind(context.Context, ...Option) (Conn, error)
@@ -59,10 +87,14 @@ type Conn interface {
 	Invoke(context.Context, Operation, ...CallOption) error
 	// Invoke Object.
 	InvokeObject(context.Context, *uuid.UUID, Operation, ...CallOption) error
-	// Close.
+	// Close function closes the connection. The new calls are rejected,
+	// the outstanding calls are awaited until the context is done and
+	// then aborted. The function can be called multiple times.
 	Close(context.Context) error
 	// RegisterServer.
 	RegisterServer(ServerHandle, ...Option)
+	// Info.
+	Info() *ConnInfo
 }
 
 var (
@@ -74,6 +106,30 @@ var (
 	ErrNoSecurityContext = errors.New("security context is empty")
 	// Presentation Context is empty.
 	ErrNoPresentationContext = errors.New("presentation context is empty")
+	// Header signing is required, but was not negotiated.
+	ErrHeaderSignNotSupported = errors.New("header signing is not supported by the server")
+	// Bind (or alter context) was rejected by the server.
+	ErrBindRejected = errors.New("bind rejected")
+	// The bind_nak reject reasons.
+	ErrReasonNotSpecified          = errors.New("reason not specified")
+	ErrTemporaryCongestion         = errors.New("temporary congestion")
+	ErrLocalLimitExceeded          = errors.New("local limit exceeded")
+	ErrCalledPAddrUnknown          = errors.New("called presentation address unknown")
+	ErrProtocolVersionNotSupported = errors.New("protocol version not supported")
+	ErrDefaultContextNotSupported  = errors.New("default context not supported")
+	ErrUserDataNotReadable         = errors.New("user data not readable")
+	ErrNoPSAPAvailable             = errors.New("no presentation service access point available")
+	ErrAuthTypeNotRecognized       = errors.New("authentication type not recognized")
+	ErrInvalidChecksum             = errors.New("invalid checksum")
+	// The presentation context rejection reasons.
+	ErrAbstractSyntaxNotSupported = errors.New("abstract syntax not supported")
+	ErrTransferSyntaxNotSupported = errors.New("proposed transfer syntaxes not supported")
+	// Mutual authentication is required, but was not performed.
+	ErrMutualAuthnNotPerformed = errors.New("mutual authentication was not performed")
+	// Verification trailer does not match the request.
+	ErrVerificationTrailer = errors.New("verification trailer mismatch")
+	// Response presentation context does not match the request.
+	ErrPresentationContextMismatch = errors.New("presentation context mismatch")
 )
 
 // ServerHandle is a function that must accept the incoming reader and operation
@@ -83,9 +139,15 @@ type ServerHandle func(context.Context, int, ndr.Reader) (Operation, error)
 // Group is used to hold the association group identifier.
 // The association can be passed through the options using multiple
 // connections, thus sharing the same group id between them.
+//
+// The group can be also passed to the Dial function, so that multiple
+// connections (Conn) join the same association group on the server (and
+// share the context handles).
 type Group struct {
 	mu sync.Mutex
 	id int
+	// The connections that joined the association group.
+	conns []*conn
 }
 
 // GroupID function returns the association group
@@ -112,3 +174,53 @@ func (a *Group) SetID(id int) int {
 	}
 	return id
 }
+
+// reset function resets the association group id (the new association
+// group is requested with next bind).
+func (a *Group) reset() {
+	if a != nil {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		a.id = 0
+	}
+}
+
+// join function adds the connection to the association group.
+func (a *Group) join(c *conn) {
+	if a != nil {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		a.conns = append(a.conns, c)
+	}
+}
+
+// leave function removes the connection from the association group.
+func (a *Group) leave(c *conn) {
+	if a != nil {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for i := range a.conns {
+			if a.conns[i] == c {
+				a.conns = append(a.conns[:i], a.conns[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// hasActiveTransport function returns `true` if any connection within
+// the association group has the active transport (other than `except`).
+func (a *Group) hasActiveTransport(except *transport) bool {
+	if a == nil {
+		return false
+	}
+	a.mu.Lock()
+	conns := append([]*conn(nil), a.conns...)
+	a.mu.Unlock()
+	for _, c := range conns {
+		if c.hasActiveTransport(except) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package dcerpc
+
+import (
+	"fmt"
+)
+
+// DecodeError is the response stub data decoding error.
+type DecodeError struct {
+	// The operation name.
+	OpName string
+	// The offset in the response stub data where the decoding failed.
+	Offset int
+	// The decoding error.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s: decode_stub_data: offset %d: %v", e.OpName, e.Offset, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// LenientDecodeOption option requests the partial response salvage.
+type LenientDecodeOption struct {
+	// The decoding error target.
+	Err **DecodeError
+}
+
+// CallOption interface implementation.
+func (LenientDecodeOption) is_rpcCallOption() {}
+
+// WithLenientDecode option requests the lenient decoding of the response:
+// if the response stub data is malformed, the remaining fragments are
+// drained, the call succeeds with the successfully decoded prefix of the
+// output parameters, and the failure point is stored to `err`.
+//
+// The option is intended for the scanning of the non-conformant servers,
+// the output parameters that follow the failure point are left untouched:
+//
+//	var derr *dcerpc.DecodeError
+//
+//	resp, err := cli.NetrShareEnum(ctx, req, dcerpc.WithLenientDecode(&derr))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	if derr != nil {
+//		// resp is incomplete.
+//	}
+//
+// The option is ignored by the connectionless protocol (ncadg_ip_udp).
+func WithLenientDecode(err **DecodeError) LenientDecodeOption {
+	return LenientDecodeOption{Err: err}
+}
+
+// HasLenientDecode function returns the lenient decode option if the set
+// of call options contains one.
+func HasLenientDecode(opts []CallOption) (LenientDecodeOption, bool) {
+	for i := range opts {
+		if opt, ok := (any)(opts[i]).(LenientDecodeOption); ok && opt.Err != nil {
+			return opt, true
+		}
+	}
+	return LenientDecodeOption{}, false
+}
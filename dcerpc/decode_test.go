@@ -0,0 +1,149 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+var errInvalidValue = errors.New("invalid value")
+
+// valuesOp operation returns the list of values, the zero value is
+// rejected by the client.
+type valuesOp struct {
+	Values []uint32
+}
+
+func (o *valuesOp) OpNum() int     { return 0 }
+func (o *valuesOp) OpName() string { return "Values" }
+
+func (o *valuesOp) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error    { return nil }
+func (o *valuesOp) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error  { return nil }
+func (o *valuesOp) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error   { return o.write(w) }
+func (o *valuesOp) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error { return o.read(r) }
+
+func (o *valuesOp) write(w ndr.Writer) error {
+	w.WriteData(uint32(len(o.Values)))
+	for _, v := range o.Values {
+		w.WriteData(v)
+	}
+	return w.Err()
+}
+
+func (o *valuesOp) read(r ndr.Reader) error {
+	var n uint32
+	if err := r.ReadData(&n); err != nil {
+		return err
+	}
+	for i := 0; i < int(n); i++ {
+		var v uint32
+		if err := r.ReadData(&v); err != nil {
+			return err
+		}
+		if v == 0 {
+			return errInvalidValue
+		}
+		o.Values = append(o.Values, v)
+	}
+	return nil
+}
+
+func TestLenientDecode(t *testing.T) {
+
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+
+	syntax := &dcerpc.SyntaxID{IfUUID: uuid.New(0x12345678, 0x1234, 0x1234, 0x12, 0x34, [6]byte{1, 2, 3, 4, 5, 6}), IfVersionMajor: 1}
+
+	// the response spans several fragments, the malformed value is
+	// in the first one.
+	values := make([]uint32, 2048)
+	for i := range values {
+		values[i] = uint32(i + 1)
+	}
+	values[10] = 0
+
+	srv := dcerpc.NewServer()
+	srv.Register(syntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		return &valuesOp{Values: values}, nil
+	})
+
+	go srv.Serve(ln)
+
+	ctx := context.Background()
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(syntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if err := cc.Invoke(ctx, &valuesOp{}); !errors.Is(err, errInvalidValue) {
+		t.Fatalf("expected decode error, got %v", err)
+	}
+
+	// the strict decoding closes the transport.
+	if cc, err = conn.Bind(ctx, dcerpc.WithAbstractSyntax(syntax), dcerpc.WithInsecure()); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	var derr *dcerpc.DecodeError
+
+	op := &valuesOp{}
+	if err := cc.Invoke(ctx, op, dcerpc.WithLenientDecode(&derr)); err != nil {
+		t.Fatalf("lenient invoke: %v", err)
+	}
+
+	if derr == nil || !errors.Is(derr, errInvalidValue) || derr.Offset == 0 {
+		t.Fatalf("unexpected decode error: %v", derr)
+	}
+
+	if len(op.Values) != 10 {
+		t.Fatalf("unexpected decoded prefix: %d values", len(op.Values))
+	}
+
+	// the remaining fragments are drained, the connection is usable.
+	derr = nil
+	if err := cc.Invoke(ctx, &valuesOp{}, dcerpc.WithLenientDecode(&derr)); err != nil || derr == nil {
+		t.Fatalf("second lenient invoke: %v, %v", err, derr)
+	}
+}
+
+// panicOp operation panics while decoding the response.
+type panicOp struct {
+	echoOp
+	values []uint32
+}
+
+func (o *panicOp) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	var idx uint32
+	if err := r.ReadData(&idx); err != nil {
+		return err
+	}
+	return r.ReadData(&o.values[idx])
+}
+
+func TestDecodePanic(t *testing.T) {
+
+	cc, _ := testEchoServer(t)
+
+	var derr *dcerpc.DecodeError
+
+	if err := cc.Invoke(context.Background(), &panicOp{echoOp: echoOp{Value: 2}}, dcerpc.WithLenientDecode(&derr)); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	var perr *ndr.PanicError
+	if !errors.As(derr, &perr) || len(perr.Path) == 0 || perr.Path[0] != "dcerpc_test.panicOp" {
+		t.Fatalf("unexpected decode error: %v", derr)
+	}
+}
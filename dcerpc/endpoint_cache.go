@@ -0,0 +1,154 @@
+package dcerpc
+
+// endpoint_cache.go contains the cache of the endpoints resolved with the
+// endpoint mapper.
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+// The default time-to-live of the cached endpoints.
+var DefaultEndpointCacheTTL = 10 * time.Minute
+
+// EndpointCache is the cache of the endpoints resolved with the endpoint
+// mapper, per host, interface and protocol sequence. The cache can be shared
+// between the connections. The cached endpoint that cannot be dialed or bound
+// is considered stale: the entry is dropped and the endpoint is resolved again.
+type EndpointCache struct {
+	// The time-to-live of the cached endpoints (DefaultEndpointCacheTTL
+	// if zero).
+	TTL time.Duration
+
+	mu      sync.Mutex
+	entries map[endpointCacheKey]*endpointCacheEntry
+}
+
+// endpointCacheKey is the endpoint cache key.
+type endpointCacheKey struct {
+	host           string
+	ifUUID         uuid.UUID
+	ifVersionMajor uint16
+	ifVersionMinor uint16
+	protocol       ProtocolSequence
+}
+
+// endpointCacheEntry is the endpoint cache entry.
+type endpointCacheEntry struct {
+	// The resolved bindings.
+	bindings []StringBinding
+	// The entry expiration time.
+	expiry time.Time
+}
+
+// NewEndpointCache function returns the new endpoint cache with the
+// time-to-live `ttl`.
+func NewEndpointCache(ttl time.Duration) *EndpointCache {
+	return &EndpointCache{TTL: ttl}
+}
+
+// WithEndpointCache option sets the cache of the endpoints resolved with the
+// endpoint mapper (see WithEndpointMapper):
+//
+//	cache := dcerpc.NewEndpointCache(time.Hour)
+//
+//	conn, err := dcerpc.Dial(ctx, "dc01.contoso.net", epm.AutoEndpointMapper(), dcerpc.WithEndpointCache(cache))
+func WithEndpointCache(c *EndpointCache) ConnectOption {
+	return func(o *Transport) { o.EndpointCache = c }
+}
+
+// endpointKey function returns the cache key for the host and the binding.
+func endpointKey(host string, binding *Binding) endpointCacheKey {
+
+	key := endpointCacheKey{
+		host:           strings.ToLower(host),
+		ifVersionMajor: binding.SyntaxID.IfVersionMajor,
+		ifVersionMinor: binding.SyntaxID.IfVersionMinor,
+		protocol:       binding.StringBinding.ProtocolSequence,
+	}
+
+	if binding.SyntaxID.IfUUID != nil {
+		key.ifUUID = *binding.SyntaxID.IfUUID
+	}
+
+	return key
+}
+
+// Get function returns the cached bindings for the host and the binding.
+func (c *EndpointCache) Get(host string, binding *Binding) ([]StringBinding, bool) {
+
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := endpointKey(host, binding)
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(e.expiry) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return append([]StringBinding{}, e.bindings...), true
+}
+
+// Put function saves the bindings resolved for the host and the binding.
+func (c *EndpointCache) Put(host string, binding *Binding, bindings []StringBinding) {
+
+	if c == nil || len(bindings) == 0 {
+		return
+	}
+
+	ttl := c.TTL
+	if ttl <= 0 {
+		ttl = DefaultEndpointCacheTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[endpointCacheKey]*endpointCacheEntry)
+	}
+
+	c.entries[endpointKey(host, binding)] = &endpointCacheEntry{
+		bindings: append([]StringBinding{}, bindings...),
+		expiry:   time.Now().Add(ttl),
+	}
+}
+
+// Invalidate function drops the cached bindings for the host and the binding.
+func (c *EndpointCache) Invalidate(host string, binding *Binding) {
+
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, endpointKey(host, binding))
+}
+
+// Len function returns the number of the cached entries.
+func (c *EndpointCache) Len() int {
+
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
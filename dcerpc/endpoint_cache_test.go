@@ -0,0 +1,155 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+// testMapper is the endpoint mapper that returns the endpoint `port`.
+type testMapper struct {
+	port  atomic.Value
+	calls atomic.Int32
+	host  atomic.Value
+}
+
+func (m *testMapper) Map(ctx context.Context, in *dcerpc.Binding) ([]dcerpc.StringBinding, error) {
+	m.calls.Add(1)
+	m.host.Store(in.StringBinding.NetworkAddress)
+	return []dcerpc.StringBinding{{ProtocolSequence: dcerpc.ProtocolSequenceIPTCP, Endpoint: m.port.Load().(string)}}, nil
+}
+
+// testPortDialer is the memory dialer that refuses the connections to the
+// port `deny`.
+type testPortDialer struct {
+	ln   *dcerpc.MemoryListener
+	deny string
+}
+
+func (d *testPortDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if _, port, _ := net.SplitHostPort(addr); port == d.deny {
+		return nil, errors.New("connection refused")
+	}
+	return d.ln.DialContext(ctx, network, addr)
+}
+
+func TestEndpointCache(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		return op, op.UnmarshalNDRRequest(ctx, r)
+	})
+
+	go srv.Serve(ln)
+
+	mapper, cache := &testMapper{}, dcerpc.NewEndpointCache(time.Hour)
+	mapper.port.Store("49664")
+
+	bind := func() error {
+		conn, err := dcerpc.Dial(ctx, "127.0.0.1",
+			dcerpc.WithDialer(&testPortDialer{ln: ln, deny: "49665"}),
+			dcerpc.WithEndpointMapper(mapper),
+			dcerpc.WithEndpointCache(cache))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+		_, err = conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+		return err
+	}
+
+	if err := bind(); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if host, _ := mapper.host.Load().(string); host != "127.0.0.1" {
+		t.Fatalf("unexpected mapped host: %q", host)
+	}
+
+	// the endpoint is taken from the cache.
+	if err := bind(); err != nil || mapper.calls.Load() != 1 || cache.Len() != 1 {
+		t.Fatalf("bind: %v (mapper calls %d)", err, mapper.calls.Load())
+	}
+
+	// the cached endpoint is stale, the endpoint is resolved again.
+	cache.Put("127.0.0.1", &dcerpc.Binding{SyntaxID: *echoSyntax, StringBinding: dcerpc.StringBinding{NetworkAddress: "127.0.0.1"}},
+		[]dcerpc.StringBinding{{ProtocolSequence: dcerpc.ProtocolSequenceIPTCP, Endpoint: "49665"}})
+
+	if err := bind(); err != nil || mapper.calls.Load() != 2 {
+		t.Fatalf("bind: %v (mapper calls %d)", err, mapper.calls.Load())
+	}
+
+	// the authentication error on the reachable cached endpoint is returned
+	// as is, the endpoint is not resolved again.
+	conn, err := dcerpc.Dial(ctx, "127.0.0.1",
+		dcerpc.WithDialer(&testPortDialer{ln: ln, deny: "49665"}),
+		dcerpc.WithEndpointMapper(mapper),
+		dcerpc.WithEndpointCache(cache))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithSign(),
+		dcerpc.WithMechanism(ssp.NTLM), dcerpc.WithCredentials(credential.NewFromPassword("user", "password")))
+	if !errors.Is(err, dcerpc.ErrBindRejected) || mapper.calls.Load() != 2 {
+		t.Fatalf("bind: %v (mapper calls %d)", err, mapper.calls.Load())
+	}
+}
+
+func TestEndpointCacheHost(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		return op, op.UnmarshalNDRRequest(ctx, r)
+	})
+
+	go srv.Serve(ln)
+
+	mapper, cache := &testMapper{}, dcerpc.NewEndpointCache(time.Hour)
+	mapper.port.Store("49664")
+
+	conn, err := dcerpc.Dial(ctx, "127.0.0.1",
+		dcerpc.WithDialer(&testPortDialer{ln: ln}),
+		dcerpc.WithEndpointMapper(mapper),
+		dcerpc.WithEndpointCache(cache))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	if _, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithEndpoint("ncacn_ip_tcp:127.0.0.2"), dcerpc.WithInsecure()); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	// the bindings are cached for the host named by the binding.
+	binding := &dcerpc.Binding{SyntaxID: *echoSyntax, StringBinding: dcerpc.StringBinding{ProtocolSequence: dcerpc.ProtocolSequenceIPTCP}}
+
+	if _, ok := cache.Get("127.0.0.2", binding); !ok {
+		t.Fatalf("bindings are not cached for the binding host")
+	}
+
+	if _, ok := cache.Get("127.0.0.1", binding); ok {
+		t.Fatalf("bindings are cached for the server address")
+	}
+}
@@ -2,6 +2,7 @@ package errors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 )
@@ -54,3 +55,33 @@ func (err *Error) Error() string {
 	}
 	return fmt.Sprintf("error: %v", err.Value)
 }
+
+// StatusCoder is the error with the status code.
+type StatusCoder interface {
+	error
+	// StatusCode function returns the status code.
+	StatusCode() uint32
+}
+
+// Code function returns the status code of the first error in the error
+// tree that carries the status code (the fault status, or the status
+// returned by the operation).
+func Code(err error) (uint32, bool) {
+
+	var coder StatusCoder
+	if errors.As(err, &coder) {
+		return coder.StatusCode(), true
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		switch v := e.Value.(type) {
+		case uint32:
+			return v, true
+		case int32:
+			return uint32(v), true
+		}
+	}
+
+	return 0, false
+}
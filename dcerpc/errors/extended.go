@@ -0,0 +1,69 @@
+package errors
+
+import (
+	"context"
+	"sync"
+)
+
+// ExtendedErrorDecoder is the function that decodes the extended error
+// information (MS-EERR) attached to the fault.
+type ExtendedErrorDecoder func(context.Context, []byte) (error, error)
+
+var (
+	extendedErrorMu      sync.RWMutex
+	extendedErrorDecoder ExtendedErrorDecoder
+)
+
+// SetExtendedErrorDecoder function sets the extended error information
+// decoder (see "github.com/oiweiwei/go-msrpc/msrpc/erref/eerr" package).
+func SetExtendedErrorDecoder(d ExtendedErrorDecoder) {
+	extendedErrorMu.Lock()
+	defer extendedErrorMu.Unlock()
+	extendedErrorDecoder = d
+}
+
+// ExtendedError is the fault error with the extended error information
+// attached by the server.
+type ExtendedError struct {
+	// The fault error.
+	Err error
+	// The decoded extended error information (nil if no decoder is set
+	// or the extended error information is malformed).
+	Info error
+	// The encoded extended error information.
+	Data []byte
+}
+
+func (e *ExtendedError) Error() string {
+	if e.Info == nil {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Info.Error()
+}
+
+// Unwrap function returns the fault error and the extended error information.
+func (e *ExtendedError) Unwrap() []error {
+	if e.Info == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Err, e.Info}
+}
+
+// NewExtended function returns the error for the fault status `value` with
+// the extended error information `b`.
+func NewExtended(ctx context.Context, value any, b []byte) error {
+
+	err := &ExtendedError{Err: New(ctx, value), Data: append([]byte(nil), b...)}
+
+	extendedErrorMu.RLock()
+	decode := extendedErrorDecoder
+	extendedErrorMu.RUnlock()
+
+	if decode != nil {
+		if info, derr := decode(ctx, err.Data); derr == nil {
+			err.Info = info
+		}
+	}
+
+	return err
+}
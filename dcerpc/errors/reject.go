@@ -15,13 +15,21 @@ type RPCMapper struct{}
 func (RPCMapper) MapValue(ctx context.Context, value any) error {
 	switch code := value.(type) {
 	case uint32:
-		return FromCode(code)
+		return fromCode(code)
 	case int32:
-		return FromCode(uint32(code))
+		return fromCode(uint32(code))
 	}
 	return nil
 }
 
+// fromCode function maps the reject or fault status code.
+func fromCode(code uint32) error {
+	if err := FromCode(code); err != nil {
+		return err
+	}
+	return FaultFromCode(code)
+}
+
 type RPCError struct {
 	Code    uint32
 	Name    string
@@ -31,3 +39,8 @@ type RPCError struct {
 func (e *RPCError) Error() string {
 	return fmt.Sprintf("fault: %s (0x%08x): %s", e.Name, e.Code, e.Details)
 }
+
+// StatusCode function returns the fault status code.
+func (e *RPCError) StatusCode() uint32 {
+	return e.Code
+}
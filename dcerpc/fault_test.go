@@ -0,0 +1,114 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+
+	extendederror "github.com/oiweiwei/go-msrpc/msrpc/eerr/extendederror/v1"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/eerr"
+)
+
+func TestExtendedErrorInfo(t *testing.T) {
+
+	ctx := context.Background()
+
+	// access denied (5) reported by the security provider caused by the
+	// logon failure (1326).
+	info := &extendederror.ExtendedErrorInfo{
+		ComputerName: &extendederror.ComputerName{
+			Type: extendederror.ComputerNamePresentTypePresent,
+			ComputerName: &extendederror.ComputerName_ComputerName{
+				Value: &extendederror.ComputerName_Name{
+					Name: &extendederror.UnicodeString{String: utf16.Encode([]rune("DC01\x00"))},
+				},
+			},
+		},
+		ProcessID:           700,
+		Timestamp:           133000000000000000,
+		GeneratingComponent: uint32(eerr.ComponentRuntime),
+		Status:              5,
+		DetectionLocation:   1710,
+		Params: []*extendederror.ExtendedErrorParam{{
+			Type: extendederror.ExtendedErrorParamTypesInternalANSIString,
+			ExtendedErrorParam: &extendederror.ExtendedErrorParam_ExtendedErrorParam{
+				Value: &extendederror.ExtendedErrorParam_ANSIString{
+					ANSIString: &extendederror.ANSIString{String: []byte("srvsvc\x00")},
+				},
+			},
+		}},
+		Next: &extendederror.ExtendedErrorInfo{
+			ComputerName: &extendederror.ComputerName{
+				Type:         extendederror.ComputerNamePresentTypeNotPresent,
+				ComputerName: &extendederror.ComputerName_ComputerName{Value: &extendederror.ComputerName_2{}},
+			},
+			ProcessID:           700,
+			GeneratingComponent: uint32(eerr.ComponentSecurityProvider),
+			Status:              1326,
+			DetectionLocation:   2010,
+			Params: []*extendederror.ExtendedErrorParam{{
+				Type: extendederror.ExtendedErrorParamTypesInternalLongValue,
+				ExtendedErrorParam: &extendederror.ExtendedErrorParam_ExtendedErrorParam{
+					Value: &extendederror.ExtendedErrorParam_LValue{LValue: -1},
+				},
+			}},
+		},
+	}
+
+	b, err := ndr.MarshalWithTypeSerializationV1(ndr.MarshalerPointer(info))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		return nil, &rpcerrors.ExtendedError{Err: rpcerrors.NCSUserDefined, Data: b}
+	})
+
+	go srv.Serve(ln)
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	err = cc.Invoke(ctx, &echoOp{Value: 1})
+	if !errors.Is(err, rpcerrors.NCSUserDefined) {
+		t.Fatalf("expected user-defined fault, got %v", err)
+	}
+
+	var eeInfo *eerr.Error
+	if !errors.As(err, &eeInfo) {
+		t.Fatalf("expected extended error information, got %v", err)
+	}
+
+	if len(eeInfo.Records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(eeInfo.Records))
+	}
+
+	rec := eeInfo.Records[0]
+	if rec.ComputerName != "DC01" || rec.ProcessID != 700 || rec.GeneratingComponent != eerr.ComponentRuntime ||
+		rec.Status != 5 || rec.DetectionLocation != 1710 || len(rec.Params) != 1 || rec.Params[0] != "srvsvc" || rec.Time.Year() != 2022 {
+		t.Fatalf("unexpected record: %+v", rec)
+	}
+
+	rec = eeInfo.Records[1]
+	if rec.ComputerName != "" || rec.GeneratingComponent != eerr.ComponentSecurityProvider ||
+		rec.Status != 1326 || rec.DetectionLocation != 2010 || len(rec.Params) != 1 || rec.Params[0] != int32(-1) {
+		t.Fatalf("unexpected record: %+v", rec)
+	}
+}
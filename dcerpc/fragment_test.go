@@ -0,0 +1,38 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+func TestFragmentSizes(t *testing.T) {
+
+	ctx := context.Background()
+
+	// the response (8K) is received in the multiple fragments.
+	cc, _ := testEchoServer(t, dcerpc.WithMaxXmitFrag(16384), dcerpc.WithMaxRecvFrag(5840))
+
+	op := &listValuesOp{}
+	if err := cc.Invoke(ctx, op); err != nil || len(op.Values) != 2048 {
+		t.Fatalf("invoke: %v (values %d)", err, len(op.Values))
+	}
+}
+
+func TestReassemblyLimit(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t, dcerpc.WithMaxReassemblySize(1024))
+
+	echo := &echoOp{Value: 7}
+	if err := cc.Invoke(ctx, echo); err != nil || echo.Reply != 7 {
+		t.Fatalf("invoke: %v (reply %d)", err, echo.Reply)
+	}
+
+	if err := cc.Invoke(ctx, &listValuesOp{}); !errors.Is(err, dcerpc.ErrReassemblyLimitExceeded) {
+		t.Fatalf("expected reassembly limit exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package dcerpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestSharedGroup(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	bind := func(opts ...dcerpc.Option) dcerpc.Conn {
+		t.Helper()
+		conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", append(opts, dcerpc.WithDialer(ln))...)
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		t.Cleanup(func() { conn.Close(ctx) })
+		cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+		if err != nil {
+			t.Fatalf("bind: %v", err)
+		}
+		if err := cc.Invoke(ctx, &echoOp{Value: 1}); err != nil {
+			t.Fatalf("invoke: %v", err)
+		}
+		return cc
+	}
+
+	group := &dcerpc.Group{}
+
+	cc1 := bind(dcerpc.WithGroup(group))
+	if cc1.Info().GroupID == 0 || cc1.Info().GroupID != group.GroupID() {
+		t.Fatalf("expected group id %d, got %d", group.GroupID(), cc1.Info().GroupID)
+	}
+
+	// the second connection joins the association group.
+	if cc2 := bind(dcerpc.WithGroup(group)); cc2.Info().GroupID != group.GroupID() {
+		t.Fatalf("expected group id %d, got %d", group.GroupID(), cc2.Info().GroupID)
+	}
+
+	// join the association group by identifier.
+	if cc3 := bind(dcerpc.WithGroupID(cc1.Info().GroupID)); cc3.Info().GroupID != group.GroupID() {
+		t.Fatalf("expected group id %d, got %d", group.GroupID(), cc3.Info().GroupID)
+	}
+
+	// the new association group.
+	if cc4 := bind(); cc4.Info().GroupID == group.GroupID() {
+		t.Fatalf("expected new group id, got %d", cc4.Info().GroupID)
+	}
+}
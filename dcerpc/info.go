@@ -0,0 +1,92 @@
+package dcerpc
+
+// info.go contains the connection information.
+
+import (
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// ConnInfo is the connection information.
+type ConnInfo struct {
+	// The security context identifier.
+	ContextID uint32 `json:"context_id"`
+	// The authentication type.
+	AuthType AuthType `json:"auth_type"`
+	// The authentication level.
+	AuthLevel AuthLevel `json:"auth_level"`
+	// The flag that indicates whether the security context is established.
+	Established bool `json:"established"`
+	// The target name requested by the client.
+	TargetName string `json:"target_name,omitempty"`
+	// The server principal name the security context was established
+	// with (for Kerberos, the principal name of the service ticket).
+	ServerPrincipal string `json:"server_principal,omitempty"`
+	// The flag that indicates whether the server was authenticated
+	// (for Kerberos, the AP-REP was received and verified). The server
+	// principal is verified only when the mutual authentication succeeded.
+	MutualAuthn bool `json:"mutual_authn"`
+	// The security context expiration time (for Kerberos, the service
+	// ticket end time), zero if unknown.
+	Expiry time.Time `json:"expiry"`
+	// The flag that indicates whether the header signing was negotiated.
+	SignHeader bool `json:"sign_header"`
+	// The flag that indicates whether the security context multiplexing
+	// was negotiated.
+	Multiplexing bool `json:"multiplexing"`
+	// The association group identifier. The identifier can be used to
+	// join the association group with the WithGroupID option.
+	GroupID int `json:"group_id,omitempty"`
+	// The connection state.
+	State ConnState `json:"state"`
+	// The abstract syntax (with the negotiated interface version) the
+	// connection is bound to.
+	AbstractSyntax *SyntaxID `json:"abstract_syntax,omitempty"`
+	// The negotiated transfer syntax.
+	TransferSyntax *SyntaxID `json:"transfer_syntax,omitempty"`
+}
+
+// Info function returns the security context information.
+func (cc *Security) Info() *ConnInfo {
+
+	if cc == nil {
+		return &ConnInfo{}
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	info := &ConnInfo{
+		ContextID:    cc.id,
+		AuthType:     cc.Type,
+		AuthLevel:    cc.Level,
+		Established:  cc.established,
+		TargetName:   cc.TargetName,
+		SignHeader:   cc.SignHeader,
+		Multiplexing: cc.Multiplexing,
+	}
+
+	info.ServerPrincipal, _ = getAttribute[string](cc, gssapi.AttributeServerPrincipal)
+	info.MutualAuthn, _ = getAttribute[bool](cc, gssapi.AttributeMutualAuthn)
+	info.Expiry, _ = getAttribute[time.Time](cc, gssapi.AttributeExpiry)
+
+	return info
+}
+
+// mutualAuthn function returns `true` if the server was authenticated.
+func (cc *Security) mutualAuthn() bool {
+	ok, _ := getAttribute[bool](cc, gssapi.AttributeMutualAuthn)
+	return ok
+}
+
+// getAttribute function returns the typed security context attribute.
+func getAttribute[T any](cc *Security, name string) (T, bool) {
+	if cc.ctx == nil {
+		var v T
+		return v, false
+	}
+	attr, _ := gssapi.GetAttribute(cc.ctx, name)
+	v, ok := attr.(T)
+	return v, ok
+}
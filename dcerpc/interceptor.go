@@ -0,0 +1,93 @@
+package dcerpc
+
+// interceptor.go contains the client-side unary interceptors (the middleware
+// wrapping every operation invocation, like logging, metrics, retries or
+// auditing).
+
+import (
+	"context"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+// CallInfo is the information about the invoked operation.
+type CallInfo struct {
+	// The interface (abstract syntax) identifier.
+	AbstractSyntax *SyntaxID
+	// The object UUID for the call (if any).
+	ObjectUUID *uuid.UUID
+	// The operation number.
+	OpNum int
+	// The operation name.
+	OpName string
+}
+
+// Invoker is the function that invokes the operation.
+type Invoker func(ctx context.Context, op Operation, opts ...CallOption) error
+
+// UnaryInterceptor is the function that intercepts the operation invocation.
+// The interceptor must call the `invoker` to proceed with the invocation (or
+// can call it multiple times to retry the operation). The operation `op`
+// holds the request parameters before the `invoker` is called and the
+// response parameters after the `invoker` returns.
+type UnaryInterceptor func(ctx context.Context, info *CallInfo, op Operation, opts []CallOption, invoker Invoker) error
+
+// WithUnaryInterceptor option appends the interceptors to the interceptor
+// chain. The first interceptor is the outermost one. When passed to the
+// Dial function, the interceptors wrap the calls of all clients bound on
+// the connection (and precede the interceptors passed to the client):
+//
+//	logging := func(ctx context.Context, info *dcerpc.CallInfo, op dcerpc.Operation, opts []dcerpc.CallOption, invoker dcerpc.Invoker) error {
+//		start := time.Now()
+//		err := invoker(ctx, op, opts...)
+//		log.Printf("%s: %s: %v: %v", info.AbstractSyntax, info.OpName, time.Since(start), err)
+//		return err
+//	}
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithUnaryInterceptor(logging))
+func WithUnaryInterceptor(interceptors ...UnaryInterceptor) BindOption {
+	return BindOption(func(opt *option) {
+		for _, i := range interceptors {
+			if i != nil {
+				opt.Interceptors = append(opt.Interceptors, i)
+			}
+		}
+	})
+}
+
+// chainInterceptors function returns the single interceptor that calls
+// the interceptors in order (or nil if there are no interceptors).
+func chainInterceptors(interceptors []UnaryInterceptor) UnaryInterceptor {
+
+	switch len(interceptors) {
+	case 0:
+		return nil
+	case 1:
+		return interceptors[0]
+	}
+
+	return func(ctx context.Context, info *CallInfo, op Operation, opts []CallOption, invoker Invoker) error {
+		return interceptors[0](ctx, info, op, opts, chainInvoker(interceptors[1:], info, invoker))
+	}
+}
+
+// chainInvoker function returns the invoker that calls the next interceptor
+// in the chain.
+func chainInvoker(interceptors []UnaryInterceptor, info *CallInfo, invoker Invoker) Invoker {
+	if len(interceptors) == 0 {
+		return invoker
+	}
+	return func(ctx context.Context, op Operation, opts ...CallOption) error {
+		return interceptors[0](ctx, info, op, opts, chainInvoker(interceptors[1:], info, invoker))
+	}
+}
+
+// intercept function invokes the operation through the interceptor
+// chain (if any).
+func intercept(ctx context.Context, i UnaryInterceptor, info *CallInfo, op Operation, opts []CallOption, invoker Invoker) error {
+	if i == nil {
+		return invoker(ctx, op, opts...)
+	}
+	info.OpNum, info.OpName = op.OpNum(), op.OpName()
+	return i(ctx, info, op, opts, invoker)
+}
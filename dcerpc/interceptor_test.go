@@ -0,0 +1,95 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	var served atomic.Int32
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		served.Add(1)
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	var trace []string
+
+	record := func(name string) dcerpc.UnaryInterceptor {
+		return func(ctx context.Context, info *dcerpc.CallInfo, op dcerpc.Operation, opts []dcerpc.CallOption, invoker dcerpc.Invoker) error {
+			if info.AbstractSyntax != echoSyntax || info.OpName != "Echo" || info.OpNum != 0 {
+				t.Errorf("%s: unexpected call info: %+v", name, info)
+			}
+			trace = append(trace, name+":before")
+			err := invoker(ctx, op, opts...)
+			trace = append(trace, name+":after")
+			return err
+		}
+	}
+
+	// the retry interceptor invokes the operation twice.
+	retry := func(ctx context.Context, info *dcerpc.CallInfo, op dcerpc.Operation, opts []dcerpc.CallOption, invoker dcerpc.Invoker) error {
+		if err := invoker(ctx, op, opts...); err != nil {
+			return err
+		}
+		return invoker(ctx, op, opts...)
+	}
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln), dcerpc.WithUnaryInterceptor(record("conn")))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure(), dcerpc.WithUnaryInterceptor(record("client"), retry))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	op := &echoOp{Value: 7}
+	if err := cc.Invoke(ctx, op); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	if op.Reply != 7 || served.Load() != 2 {
+		t.Fatalf("expected reply 7 served twice, got %d served %d", op.Reply, served.Load())
+	}
+
+	if expected := []string{"conn:before", "client:before", "client:after", "conn:after"}; !reflect.DeepEqual(trace, expected) {
+		t.Fatalf("expected trace %v, got %v", expected, trace)
+	}
+
+	// the interceptor can fail the call without invoking the operation.
+	errDenied := errors.New("denied")
+
+	cc, err = conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure(), dcerpc.WithUnaryInterceptor(
+		func(ctx context.Context, info *dcerpc.CallInfo, op dcerpc.Operation, opts []dcerpc.CallOption, invoker dcerpc.Invoker) error {
+			return errDenied
+		}))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 1}); !errors.Is(err, errDenied) || served.Load() != 2 {
+		t.Fatalf("expected denied error, got %v (served %d)", err, served.Load())
+	}
+}
@@ -0,0 +1,133 @@
+package dcerpc
+
+import (
+	"context"
+	"time"
+)
+
+// WithKeepAlive option enables the idle connection keepalive: if no PDU
+// was sent or received on the connection within the `interval`, the harmless
+// PDU is sent to keep the NAT and firewall state alive. For the connection-oriented
+// transports the alter_context that re-proposes the already negotiated presentation
+// context (without the authentication data) is sent, for the connectionless
+// transport the ping for the last call is sent:
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithKeepAlive(5*time.Minute))
+func WithKeepAlive(interval time.Duration) ConnectOption {
+	return func(o *Transport) {
+		o.KeepAlive = interval
+	}
+}
+
+// touch function records the transport activity time.
+func (c *transport) touch() {
+	c.active.Store(time.Now().UnixNano())
+}
+
+// idle function returns the time since the last transport activity.
+func (c *transport) idle() time.Duration {
+	return time.Since(time.Unix(0, c.active.Load()))
+}
+
+// keepAlive function sends the keepalive alter_context for the presentation
+// `p` once the transport is idle for the keepalive interval, until the
+// transport is closed.
+func (c *transport) keepAlive(ctx context.Context, p *Presentation, sec *Security) {
+
+	interval := c.settings.KeepAlive
+
+	t := time.NewTimer(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		if c.HasErr() != nil {
+			return
+		}
+
+		if idle := c.idle(); idle < interval || c.hasPending() {
+			// the transport is in use.
+			t.Reset(max(interval-idle, interval/4))
+			continue
+		}
+
+		if err := c.ping(ctx, p, sec); err != nil {
+			c.logger.Warn().Err(err).Msg("keepalive failed")
+		} else {
+			c.logger.Debug().Msg("keepalive")
+		}
+
+		t.Reset(interval)
+	}
+}
+
+// ping function sends the alter_context that re-proposes the negotiated
+// presentation context `p` within the established security context `sec`.
+func (c *transport) ping(ctx context.Context, p *Presentation, sec *Security) error {
+
+	if c.settings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.settings.Timeout)
+		defer cancel()
+	}
+
+	_, err := c.AlterContext(ctx, withPresentation(p), withEstablishedSecurity(sec), withTransferSyntax(p.TransferSyntax))
+	return err
+}
+
+// withTransferSyntax option sets the transfer syntax proposed for the
+// presentation contexts.
+func withTransferSyntax(s *SyntaxID) BindOption {
+	return BindOption(func(opt *option) {
+		opt.TransferSyntaxes = []*SyntaxID{s}
+	})
+}
+
+// keepAlive function sends the ping for the last call once the connection
+// is idle for the keepalive interval, until the connection is closed.
+func (c *datagramConn) keepAlive() {
+
+	interval := c.settings.KeepAlive
+
+	t := time.NewTimer(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-t.C:
+		}
+
+		c.mu.Lock()
+
+		if c.closed {
+			c.mu.Unlock()
+			return
+		}
+
+		if idle := time.Since(c.active); idle < interval {
+			c.mu.Unlock()
+			t.Reset(interval - idle)
+			continue
+		}
+
+		// the ping for the completed call is answered with nocall (or
+		// the cached response), that is skipped by the next call.
+		hdr := c.header(PacketTypeDatagramPing, DatagramFlagIdempotent, nil, 0)
+		hdr.SequenceNum--
+
+		if err := c.write(hdr, nil); err != nil {
+			c.logger.Warn().Err(err).Msg("keepalive failed")
+		}
+
+		c.mu.Unlock()
+
+		t.Reset(interval)
+	}
+}
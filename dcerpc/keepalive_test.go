@@ -0,0 +1,83 @@
+package dcerpc_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// writeCountingConn counts the writes to the connection.
+type writeCountingConn struct {
+	net.Conn
+	n *atomic.Int32
+}
+
+func (c *writeCountingConn) Write(b []byte) (int, error) {
+	c.n.Add(1)
+	return c.Conn.Write(b)
+}
+
+// writeCountingDialer counts the writes to the established connections.
+type writeCountingDialer struct {
+	dcerpc.Dialer
+	n atomic.Int32
+}
+
+func (d *writeCountingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.Dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	return &writeCountingConn{Conn: conn, n: &d.n}, nil
+}
+
+func TestKeepAlive(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	dialer := &writeCountingDialer{Dialer: ln}
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(dialer), dcerpc.WithKeepAlive(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	bound := dialer.n.Load()
+
+	time.Sleep(150 * time.Millisecond)
+
+	if n := dialer.n.Load() - bound; n < 2 {
+		t.Fatalf("expected keepalive pings, got %d writes", n)
+	}
+
+	// the connection is usable after the keepalive.
+	op := &echoOp{Value: 7}
+	if err := cc.Invoke(ctx, op); err != nil || op.Reply != 7 {
+		t.Fatalf("invoke: %v, reply %d", err, op.Reply)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !windows || purego
+
+package dcerpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// localPipesSupported is `true` if the local named pipes can be opened.
+const localPipesSupported = false
+
+// dialLocalPipe function is not supported on non-windows platforms
+// (and by the `purego` build).
+func dialLocalPipe(ctx context.Context, name string, timeout time.Duration) (RawConn, error) {
+	if PureGo {
+		return nil, fmt.Errorf("local named pipes are not supported by the purego build")
+	}
+	return nil, fmt.Errorf("local named pipes are supported on windows only")
+}
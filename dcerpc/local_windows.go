@@ -0,0 +1,52 @@
+//go:build windows && !purego
+
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// localPipesSupported is `true` if the local named pipes can be opened.
+const localPipesSupported = true
+
+// dialLocalPipe function opens the named pipe `\\.\pipe\<name>` on the local
+// host. If all pipe instances are busy, the call is retried until the timeout
+// or context expires.
+func dialLocalPipe(ctx context.Context, name string, timeout time.Duration) (RawConn, error) {
+
+	path := `\\.\pipe\` + name
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		f, err := os.OpenFile(path, os.O_RDWR, 0)
+		if err == nil {
+			mode := uint32(windows.PIPE_READMODE_MESSAGE)
+			if err := windows.SetNamedPipeHandleState(windows.Handle(f.Fd()), &mode, nil, nil); err != nil {
+				f.Close()
+				return nil, fmt.Errorf("set pipe message mode: %w", err)
+			}
+			return f, nil
+		}
+
+		if !errors.Is(err, windows.ERROR_PIPE_BUSY) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s: pipe busy: %w", path, ctx.Err())
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package dcerpc
+
+// memory.go contains the unix socket and in-process transports, that are
+// intended for integration testing against the servers running in the
+// same process.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+)
+
+// WithUnixSocket option sets the dialer that connects all ncacn_ip_tcp
+// connections to the unix domain socket `path` regardless of the
+// server address and the port.
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithUnixSocket("/tmp/rpc.sock"))
+func WithUnixSocket(path string) ConnectOption {
+	return WithDialer(&unixDialer{path: path})
+}
+
+// unixDialer dials the unix domain socket.
+type unixDialer struct {
+	path string
+}
+
+func (d *unixDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "unix", d.path)
+	if err != nil {
+		return nil, fmt.Errorf("unix: %w", err)
+	}
+	return conn, nil
+}
+
+// ErrListenerClosed is returned when the memory listener is closed.
+var ErrListenerClosed = errors.New("memory: listener is closed")
+
+// MemoryListener is the in-process listener that implements both net.Listener
+// and Dialer interfaces. Every dial creates the synchronous in-memory
+// connection (see net.Pipe) and passes the server side to the Accept function.
+//
+//	ln := dcerpc.NewMemoryListener()
+//	defer ln.Close()
+//
+//	go srv.Serve(ln)
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+type MemoryListener struct {
+	conns chan net.Conn
+	done  chan struct{}
+	once  sync.Once
+}
+
+// NewMemoryListener function returns the new in-process listener.
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+// DialContext function returns the client side of the new in-memory
+// connection. The network and address are ignored.
+func (ln *MemoryListener) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+
+	client, server := net.Pipe()
+
+	select {
+	case ln.conns <- server:
+		return client, nil
+	case <-ln.done:
+	case <-ctx.Done():
+		client.Close()
+		server.Close()
+		return nil, ctx.Err()
+	}
+
+	client.Close()
+	server.Close()
+
+	return nil, ErrListenerClosed
+}
+
+// Accept function returns the server side of the next dialed connection.
+func (ln *MemoryListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-ln.conns:
+		return conn, nil
+	case <-ln.done:
+		return nil, ErrListenerClosed
+	}
+}
+
+// Close function closes the listener. The connections that are already
+// accepted are not closed.
+func (ln *MemoryListener) Close() error {
+	ln.once.Do(func() { close(ln.done) })
+	return nil
+}
+
+// Addr function returns the listener address.
+func (ln *MemoryListener) Addr() net.Addr {
+	return memoryAddr{}
+}
+
+type memoryAddr struct{}
+
+func (memoryAddr) Network() string { return "memory" }
+func (memoryAddr) String() string  { return "memory" }
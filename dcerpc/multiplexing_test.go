@@ -0,0 +1,151 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// echoOp operation returns the request value.
+type echoOp struct {
+	Value uint32
+	Reply uint32
+}
+
+func (o *echoOp) OpNum() int     { return 0 }
+func (o *echoOp) OpName() string { return "Echo" }
+
+func (o *echoOp) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	return w.WriteData(o.Value)
+}
+
+func (o *echoOp) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	return r.ReadData(&o.Value)
+}
+
+func (o *echoOp) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return w.WriteData(o.Value)
+}
+
+func (o *echoOp) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	return r.ReadData(&o.Reply)
+}
+
+// echoSyntax is the abstract syntax of the echo server.
+var echoSyntax = &dcerpc.SyntaxID{IfUUID: uuid.New(0x87654321, 0x4321, 0x4321, 0x43, 0x21, [6]byte{6, 5, 4, 3, 2, 1}), IfVersionMajor: 1}
+
+// testEchoServer function starts the server where the call with value 1
+// is blocked until the call with another value is served (or cancelled,
+// or timed out).
+// The operation 1 returns the list of 2048 values.
+func testEchoServer(t *testing.T, opts ...dcerpc.Option) (dcerpc.Conn, <-chan struct{}) {
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	started, release := make(chan struct{}), make(chan struct{})
+	var startOnce, releaseOnce sync.Once
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		if opNum == 1 {
+			op := &valuesOp{Values: make([]uint32, 2048)}
+			for i := range op.Values {
+				op.Values[i] = uint32(i + 1)
+			}
+			return op, nil
+		}
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		if op.Value == 1 {
+			startOnce.Do(func() { close(started) })
+			select {
+			case <-release:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Second):
+			}
+		} else {
+			releaseOnce.Do(func() { close(release) })
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	ctx := context.Background()
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", append(opts, dcerpc.WithDialer(ln))...)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	return cc, started
+}
+
+func TestConcurrentCalls(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, started := testEchoServer(t)
+
+	slow := make(chan error, 1)
+	go func() {
+		op := &echoOp{Value: 1}
+		if err := cc.Invoke(ctx, op); err != nil || op.Reply != 1 {
+			slow <- errors.Join(err, errors.New("unexpected reply"))
+			return
+		}
+		slow <- nil
+	}()
+
+	<-started
+
+	// the second call is served while the first one is outstanding.
+	op := &echoOp{Value: 2}
+	if err := cc.Invoke(ctx, op); err != nil || op.Reply != 2 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+
+	select {
+	case err := <-slow:
+		if err != nil {
+			t.Fatalf("invoke: %v", err)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatalf("the first call is not completed")
+	}
+}
+
+func TestOutstandingCallsWindow(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, started := testEchoServer(t, dcerpc.WithOutstandingCalls(1))
+
+	go cc.Invoke(ctx, &echoOp{Value: 1})
+
+	<-started
+
+	// the second call is blocked by the window.
+	tctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	if err := cc.Invoke(tctx, &echoOp{Value: 2}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package dcerpc
+
+// object_security.go contains the per-object security (the security
+// blanket of the DCOM proxy, see CoSetProxyBlanket).
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+var (
+	// The connection does not support the per-object security.
+	ErrObjectSecurityNotSupported = errors.New("object security is not supported")
+)
+
+// ObjectSecurityConn interface implements the per-object security.
+type ObjectSecurityConn interface {
+	// Conn.
+	Conn
+	// SetObjectSecurity function sets the credentials and the security
+	// options for the calls with the object UUID (for DCOM, the interface
+	// pointer identifier). The separate security context is negotiated (with
+	// alter_context, if the security context multiplexing is supported) when
+	// the first call on the object is issued, and is cached for the subsequent
+	// calls. If `creds` is nil, the credentials of the connection are used.
+	// If both `creds` and `opts` are empty, the object security is reset.
+	SetObjectSecurity(obj *uuid.UUID, creds any, opts ...Option)
+}
+
+// objectSecurity structure represents the object security.
+type objectSecurity struct {
+	mu sync.Mutex
+	// The alternate credentials option.
+	creds CallCredentialsOption
+	// The client connection established for the object security.
+	conn *clientConn
+}
+
+// SetObjectSecurity function sets the credentials and the security options
+// for the calls with the object UUID.
+func (c *clientConn) SetObjectSecurity(obj *uuid.UUID, creds any, opts ...Option) {
+
+	if obj == nil {
+		return
+	}
+
+	c.altMu.Lock()
+	defer c.altMu.Unlock()
+
+	if creds == nil && len(opts) == 0 {
+		delete(c.objects, *obj)
+		return
+	}
+
+	if c.objects == nil {
+		c.objects = make(map[uuid.UUID]*objectSecurity)
+	}
+
+	c.objects[*obj] = &objectSecurity{creds: WithCallCredentials(creds, opts...)}
+}
+
+// objectConn function returns the client connection established for the
+// object security, or `false` if the object security is not set.
+func (c *clientConn) objectConn(ctx context.Context, obj *uuid.UUID, opts []CallOption) (*clientConn, bool, error) {
+
+	if obj == nil {
+		return nil, false, nil
+	}
+
+	if _, ok := HasCallSecurity(opts); ok {
+		// the security context is selected explicitly.
+		return nil, false, nil
+	}
+
+	c.altMu.Lock()
+	o, ok := c.objects[*obj]
+	c.altMu.Unlock()
+
+	if !ok {
+		return nil, false, nil
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.conn != nil && o.conn.isActive() {
+		return o.conn, true, nil
+	}
+
+	conn, err := c.impersonate(ctx, o.creds)
+	if err != nil {
+		return nil, true, err
+	}
+
+	o.conn = conn
+
+	return conn, true, nil
+}
+
+// resetObjectSecurity function drops the client connections established for
+// the object security, so that they are re-established on demand.
+func (c *clientConn) resetObjectSecurity() {
+
+	c.altMu.Lock()
+	defer c.altMu.Unlock()
+
+	for obj, o := range c.objects {
+		c.objects[obj] = &objectSecurity{creds: o.creds}
+	}
+}
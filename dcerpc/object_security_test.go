@@ -0,0 +1,49 @@
+package dcerpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+func TestObjectSecurity(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t)
+
+	sc, ok := cc.(dcerpc.ObjectSecurityConn)
+	if !ok {
+		t.Fatalf("object security is not supported")
+	}
+
+	obj, other := uuid.New(1, 0, 0, 0, 0, [6]byte{}), uuid.New(2, 0, 0, 0, 0, [6]byte{})
+
+	sc.SetObjectSecurity(obj, nil, dcerpc.WithInsecure())
+
+	op := &echoOp{Value: 2}
+	if err := cc.Invoke(ctx, op, dcerpc.WithObjectUUID(other)); err != nil || op.Reply != 2 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+
+	op = &echoOp{Value: 3}
+	if err := cc.Invoke(ctx, op, dcerpc.WithObjectUUID(obj)); err != nil || op.Reply != 3 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+
+	// the security context is cached for the subsequent calls.
+	op = &echoOp{Value: 5}
+	if err := cc.InvokeObject(ctx, obj, op); err != nil || op.Reply != 5 {
+		t.Fatalf("invoke_object: %v (reply %d)", err, op.Reply)
+	}
+
+	// reset the object security.
+	sc.SetObjectSecurity(obj, nil)
+
+	op = &echoOp{Value: 4}
+	if err := cc.Invoke(ctx, op, dcerpc.WithObjectUUID(obj)); err != nil || op.Reply != 4 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+}
@@ -0,0 +1,90 @@
+package dcerpc_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestObjectUUID(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	var (
+		mu     sync.Mutex
+		object *uuid.UUID
+	)
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		mu.Lock()
+		object, _ = dcerpc.ObjectUUIDFromContext(ctx)
+		mu.Unlock()
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	obj1 := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	obj2 := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	invoke := func(cc dcerpc.Conn, expected *uuid.UUID, opts ...dcerpc.CallOption) {
+		t.Helper()
+		if err := cc.Invoke(ctx, &echoOp{Value: 1}, opts...); err != nil {
+			t.Fatalf("invoke: %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if (expected == nil) != (object == nil) || (expected != nil && expected.String() != object.String()) {
+			t.Fatalf("expected object %v, got %v", expected, object)
+		}
+	}
+
+	// the string binding object uuid is the default object uuid.
+	conn, err := dcerpc.Dial(ctx, obj1.String()+"@ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	invoke(cc, obj1)
+	invoke(cc, obj2, dcerpc.WithObjectUUID(obj2))
+
+	// the bind option overrides the string binding object uuid.
+	cc, err = conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure(), dcerpc.WithObjectUUID(obj2))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	invoke(cc, obj2)
+
+	conn2, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn2.Close(ctx) })
+
+	cc, err = conn2.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	invoke(cc, nil)
+	invoke(cc, obj1, dcerpc.WithObjectUUID(obj1))
+}
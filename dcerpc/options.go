@@ -43,6 +43,13 @@ type option struct {
 	Logger zerolog.Logger
 	// The binding string.
 	Bindings []string
+	// The default object UUID for the calls.
+	ObjectUUID *uuid.UUID
+	// The unary interceptors.
+	Interceptors []UnaryInterceptor
+	// The flag that indicates whether the interface minor version
+	// is negotiated when the presentation context is rejected.
+	VersionNegotiation bool
 }
 
 // TargetBinding returns the string representation without any trailing slashes or
@@ -93,14 +100,18 @@ func (SecurityContextOption) is_rpcOption() {}
 //	cli, err := winreg.NewWinregClient(ctx, conn, dcerpc.WithSeal(), dcerpc.WithMechanism(ssp.NTLM))
 func WithMechanism(m gssapi.MechanismFactory, defaultConfig ...gssapi.MechanismConfig) SecurityContextOption {
 	return SecurityContextOption(func(o *option) {
-		if len(defaultConfig) > 0 {
-			o.SecurityOptions = append(o.SecurityOptions, gssapi.WithDefaultConfig(m, defaultConfig[0]))
-		} else {
-			o.SecurityOptions = append(o.SecurityOptions, m)
-		}
+		o.SecurityOptions = append(o.SecurityOptions, mechanismOption(m, defaultConfig...))
 	})
 }
 
+// mechanismOption function returns the mechanism security context option.
+func mechanismOption(m gssapi.MechanismFactory, defaultConfig ...gssapi.MechanismConfig) gssapi.ContextOption {
+	if len(defaultConfig) > 0 {
+		return gssapi.WithDefaultConfig(m, defaultConfig[0])
+	}
+	return m
+}
+
 // WithCredentials option specifies the credentials set for the
 // security context.
 //
@@ -118,15 +129,18 @@ func WithMechanism(m gssapi.MechanismFactory, defaultConfig ...gssapi.MechanismC
 //	// creds := gssapi.NewCredential("spn/my-spn", []gssapi.OID{ssp.NTLM}, gssapi.Initiate, credential.NewFromPassword(...))
 func WithCredentials(creds any) SecurityContextOption {
 	return SecurityContextOption(func(o *option) {
-		switch creds.(type) {
-		case gssapi.Credential:
-			o.SecurityOptions = append(o.SecurityOptions, creds)
-		default:
-			o.SecurityOptions = append(o.SecurityOptions, gssapi.NewCredential("", nil, gssapi.InitiateAndAccept, creds))
-		}
+		o.SecurityOptions = append(o.SecurityOptions, credentialOption(creds))
 	})
 }
 
+// credentialOption function returns the credential security context option.
+func credentialOption(creds any) gssapi.ContextOption {
+	if _, ok := creds.(gssapi.Credential); ok {
+		return creds
+	}
+	return gssapi.NewCredential("", nil, gssapi.InitiateAndAccept, creds)
+}
+
 // NoBindOption option indicates that no bind must be performed
 // for this connection.
 type NoBindOption struct{ Conn Conn }
@@ -165,6 +179,12 @@ func (ObjectUUIDOption) is_rpcCallOption() {}
 
 // WithObjectUUID option specifies the object UUID for the RPC call.
 // For more explicit scenarios, use InvokeObject RPC.
+//
+// When provided to the Dial, Bind or the client constructor, the option
+// sets the default object UUID for all calls performed by the client (the
+// call option takes precedence):
+//
+//	cli, err := winreg.NewWinregClient(ctx, conn, dcerpc.WithObjectUUID(obj))
 func WithObjectUUID(u *uuid.UUID) ObjectUUIDOption {
 	if u == nil {
 		u = &uuid.UUID{}
@@ -174,6 +194,100 @@ func WithObjectUUID(u *uuid.UUID) ObjectUUIDOption {
 	})
 }
 
+// CallCredentialsOption option specifies the alternate credentials
+// for the RPC call.
+type CallCredentialsOption struct {
+	// The credentials used to establish the alternate security context.
+	Credentials any
+	// The additional bind options for the alternate security context.
+	Options []Option
+}
+
+// CallOption interface implementation.
+func (CallCredentialsOption) is_rpcCallOption() {}
+
+// WithCallCredentials option specifies the credentials the call must
+// be performed with (impersonation).
+//
+// The client connection transparently establishes (and caches) the
+// separate security context for the credentials: on the same transport
+// if the security context multiplexing was negotiated, and on the new
+// transport otherwise. The security level, provider and target name are
+// inherited from the client connection, unless overridden by `opts`.
+//
+// The credentials are used as a cache key when comparable, so prefer
+// to pass the same credentials pointer for subsequent calls.
+//
+//	admin := credential.NewFromPassword("CONTOSO\\dhcp-admin", os.Getenv("PASSWORD"))
+//
+//	resp, err := cli.EnumKey(ctx, req, dcerpc.WithCallCredentials(admin))
+func WithCallCredentials(creds any, opts ...Option) CallCredentialsOption {
+	return CallCredentialsOption{Credentials: creds, Options: opts}
+}
+
+// HasCallCredentials function returns the alternate credentials option
+// if the set of call options contains one.
+func HasCallCredentials(opts []CallOption) (CallCredentialsOption, bool) {
+	for i := range opts {
+		if opt, ok := (any)(opts[i]).(CallCredentialsOption); ok {
+			return opt, true
+		}
+	}
+	return CallCredentialsOption{}, false
+}
+
+// IdempotentOption option marks the call as idempotent (connectionless
+// protocol only).
+type IdempotentOption struct{}
+
+// CallOption interface implementation.
+func (IdempotentOption) is_rpcCallOption() {}
+
+// WithIdempotent option marks the call as idempotent, so the server may
+// execute it more than once and the client does not acknowledge the response.
+// The option is used by the connectionless protocol (ncadg_ip_udp) and is
+// ignored by the connection-oriented protocol.
+func WithIdempotent() IdempotentOption {
+	return IdempotentOption{}
+}
+
+// HasIdempotent function returns `true` if set of call options contains
+// the Idempotent option.
+func HasIdempotent(opts []CallOption) bool {
+	for i := range opts {
+		if _, ok := (any)(opts[i]).(IdempotentOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// MaybeOption option requests the maybe call semantics (connectionless
+// protocol only).
+type MaybeOption struct{}
+
+// CallOption interface implementation.
+func (MaybeOption) is_rpcCallOption() {}
+
+// WithMaybe option requests the maybe call semantics: the request is sent
+// once and no response is awaited, the output parameters are left untouched.
+// The option is used by the connectionless protocol (ncadg_ip_udp) and is
+// ignored by the connection-oriented protocol.
+func WithMaybe() MaybeOption {
+	return MaybeOption{}
+}
+
+// HasMaybe function returns `true` if set of call options contains
+// the Maybe option.
+func HasMaybe(opts []CallOption) bool {
+	for i := range opts {
+		if _, ok := (any)(opts[i]).(MaybeOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // BindOption represents the DCE/RPC binding option.
 type BindOption func(*option)
 
@@ -189,8 +303,45 @@ func withPresentation(p *Presentation) BindOption {
 	})
 }
 
+// withEstablishedSecurity option specifies the established security context
+// for the presentation contexts added with alter_context.
+func withEstablishedSecurity(sec *Security) BindOption {
+	return BindOption(func(opt *option) {
+		if sec != nil && opt.Security == nil {
+			opt.Security = sec
+		}
+	})
+}
+
+// hasSecurityOptions function returns `true` if the options request the new
+// security context.
+func hasSecurityOptions(opts []Option) bool {
+	for i := range opts {
+		switch opts[i].(type) {
+		case SecurityOption, SecurityContextOption:
+			return true
+		}
+	}
+	return false
+}
+
+// securityOptions function returns the security options from the set
+// of options `opts`.
+func securityOptions(opts []Option) []Option {
+	ret := make([]Option, 0, len(opts))
+	for i := range opts {
+		switch opts[i].(type) {
+		case SecurityOption, SecurityContextOption:
+			ret = append(ret, opts[i])
+		}
+	}
+	return ret
+}
+
 // WithGroup option specifies the association group for the
 // connection or is used to initialize the association group id.
+// When passed to the Dial function, the connection joins the
+// association group (shared with other connections).
 func WithGroup(g *Group) BindOption {
 	return BindOption(func(opt *option) {
 		if g != nil {
@@ -199,6 +350,18 @@ func WithGroup(g *Group) BindOption {
 	})
 }
 
+// groupFromOptions function returns the association group specified
+// with the WithGroup option.
+func groupFromOptions(opts []Option) *Group {
+	o := &option{}
+	for i := range opts {
+		if opt, ok := opts[i].(BindOption); ok {
+			opt(o)
+		}
+	}
+	return o.Group
+}
+
 // WithAbstractSyntax option specifies the abstract syntax for
 // the DCE/RPC connection.
 func WithAbstractSyntax(abstractSyntax *SyntaxID) BindOption {
@@ -281,7 +444,29 @@ func (SecurityOption) is_secOption() {}
 // NoHeaderSign option disables the header signing.
 func NoHeaderSign() SecurityOption {
 	return SecurityOption(func(ctx *Security) {
-		ctx.RequestHeaderSign = 0
+		ctx.RequestHeaderSign, ctx.RequireHeaderSign = 0, false
+	})
+}
+
+// RequireHeaderSign option requests the header signing and fails the
+// bind (or alter context) if the server does not support it, so that the
+// PDU headers are always protected at the packet integrity and privacy
+// levels (some hardened server configurations reject the unsigned headers).
+func RequireHeaderSign() SecurityOption {
+	return SecurityOption(func(ctx *Security) {
+		ctx.RequestHeaderSign, ctx.RequireHeaderSign = PacketFlagSupportHeaderSign, true
+	})
+}
+
+// RequireMutualAuthn option requests the mutual authentication and fails
+// the bind (or alter context) if the server was not authenticated by the
+// security context (for example, the SPNEGO negotiation fell back to NTLM,
+// or the Kerberos AP-REP was not received), so that the connection to the
+// server spoofing the target name is detected. The verified server principal
+// is reported by the connection Info function.
+func RequireMutualAuthn() SecurityOption {
+	return SecurityOption(func(ctx *Security) {
+		ctx.RequireMutualAuthn = true
 	})
 }
 
@@ -326,6 +511,14 @@ func Anonymize() SecurityOption {
 	})
 }
 
+// WithImpersonationLevel option specifies the impersonation level (see
+// Impersonate, Delegate, Identify and Anonymize).
+func WithImpersonationLevel(lvl ImpersonationLevel) SecurityOption {
+	return SecurityOption(func(ctx *Security) {
+		ctx.Impersonation = lvl
+	})
+}
+
 // WithInsecure option specifies the plain-text connection over RPC.
 func WithInsecure() SecurityOption {
 	return SecurityOption(func(ctx *Security) {
@@ -466,6 +659,8 @@ func ParseOptions(ctx context.Context, opts ...Option) (*option, error) {
 			o(option.Security)
 		case BindOption:
 			o(option)
+		case ObjectUUIDOption:
+			option.ObjectUUID = o()
 		}
 	}
 
@@ -36,6 +36,8 @@ type Packet struct {
 	raw []byte
 	// stub start and stub end.
 	start, end int
+	// The fragment size limit (selected by the traffic profile).
+	fragSize int
 }
 
 func (p *Packet) IsLastFrag() bool {
@@ -92,6 +94,12 @@ type Body struct {
 	chnk *ndr.WaitChunk
 	// done.
 	done bool
+	// lenient is `true` if the decoding error must not fail the call.
+	lenient bool
+	// the number of stub data bytes processed.
+	offset int
+	// the decoding error (lenient mode).
+	err error
 }
 
 func (body *Body) SetDone() {
@@ -112,22 +120,34 @@ func (body *Body) IsDone() bool {
 }
 
 // NewBody function returns the new stub reader (when marshal is `false`), or
-// writer, (when marshal is `true`).
-func NewBody(ctx context.Context, op Operation, p *Presentation, marshal bool) *Body {
+// writer, (when marshal is `true`). The `opts` are the NDR options (for example,
+// the decoding limits).
+func NewBody(ctx context.Context, op Operation, p *Presentation, marshal bool, opts ...any) *Body {
 
 	chnk := ndr.NewWaitChunk()
-	body := &Body{chnk: chnk, ndr: p.TransferEncoding()(nil, chnk)}
+	body := &Body{chnk: chnk, ndr: p.TransferEncoding()(nil, append(opts, chnk)...)}
 
 	// start marshaling/unmarshaling routine.
 	go func() {
 		// done will indicate the end of marshaling.
 		defer body.SetDone()
+		// the malformed input must not panic the process.
+		defer func() {
+			if r := recover(); r != nil {
+				body.ndr.SetErr(ndr.NewPanicError(r))
+			}
+		}()
 		if marshal {
 			body.ndr.Write(nil) // do nil write.
-			op.MarshalNDRRequest(ctx, body.ndr)
+			if err := op.MarshalNDRRequest(ctx, body.ndr); err != nil {
+				body.ndr.SetErr(err)
+			}
 		} else {
 			body.ndr.Read(nil) // do nil read.
-			op.UnmarshalNDRResponse(ctx, body.ndr)
+			if err := op.UnmarshalNDRResponse(ctx, body.ndr); err != nil {
+				// propagate the validation errors.
+				body.ndr.SetErr(err)
+			}
 		}
 	}()
 
@@ -138,12 +158,17 @@ func NewBody(ctx context.Context, op Operation, p *Presentation, marshal bool) *
 // to unmarshaller.
 func (body *Body) DecodeFrom(b []byte, frmt ndr.DataRepresentation, maxLen int) (int, error) {
 
-	if body.IsDone() {
+	if body.IsDone() || body.err != nil {
 		return 0, io.EOF
 	}
 
 	n := body.chnk.Wait(b, frmt, maxLen)
-	if body.ndr.Err() != nil {
+	if body.offset += n; body.ndr.Err() != nil {
+		if body.lenient {
+			// keep the decoded prefix and skip the rest of the stub data.
+			body.err = body.ndr.Err()
+			return n, io.EOF
+		}
 		return n, body.ndr.Err()
 	}
 
@@ -154,6 +179,29 @@ func (body *Body) DecodeFrom(b []byte, frmt ndr.DataRepresentation, maxLen int)
 	return n, nil
 }
 
+// discard function stops the decoding, the rest of the stub data is
+// skipped.
+func (body *Body) discard(err error) {
+	body.err = err
+}
+
+// Err function returns the decoding error suppressed in the lenient mode
+// (or the error the decoding was stopped with).
+func (body *Body) Err() error {
+	if body != nil {
+		return body.err
+	}
+	return nil
+}
+
+// Offset function returns the number of the stub data bytes processed.
+func (body *Body) Offset() int {
+	if body != nil {
+		return body.offset
+	}
+	return 0
+}
+
 // Close function terminates the marshaling/unmarshaling routines
 // that haven't yet completed.
 func (body *Body) Close() {
@@ -188,7 +236,7 @@ func (c *transport) Codec(raw []byte, drep ndr.DataRepresentation) ndr.NDR {
 // IsSecurePacket function returns `true` if security trailer is/must_be appended
 // to the packet.
 func (c *transport) IsSecurePacket(ctx context.Context, pkt *Packet) bool {
-	return pkt.Header.AuthLength != 0 || (pkt.SecurityTrailer.AuthLevel > AuthLevelConnect) || (pkt.SecurityTrailer.AuthLevel >= AuthLevelConnect && c.settings.IsSecurityMultiplexed())
+	return pkt.Header.AuthLength != 0 || (pkt.SecurityTrailer.AuthLevel > AuthLevelConnect) || (pkt.SecurityTrailer.AuthLevel >= AuthLevelConnect && c.isSecurityMultiplexed())
 }
 
 // DecodeFragmentFromBuffer function decodes the fragment in buffer `raw`, unwraps the contents
@@ -199,7 +247,8 @@ func (c *transport) DecodePacket(ctx context.Context, pkt *Packet, raw []byte) (
 
 	r := c.Codec(raw, c.settings.DataRepresentation)
 
-	// unmarshal header.
+	// unmarshal header. (the codec switches to the data representation
+	// of the received PDU after reading the label).
 	if err := pkt.Header.ReadFrom(ctx, r); err != nil {
 		return nil, fmt.Errorf("read_header: %v", err)
 	}
@@ -212,40 +261,11 @@ func (c *transport) DecodePacket(ctx context.Context, pkt *Packet, raw []byte) (
 	}
 
 	// unmarshal pdu.
-	switch pkt.Header.PacketType {
-	case PacketTypeRequest:
-		req := new(Request)
-		// expect object uuid.
-		if pkt.Header.PacketFlags&PacketFlagObjectUUID != 0 {
-			// capture object uuid in packet level.
-			req.ObjectUUID = new(uuid.UUID)
-		}
-		pkt.PDU = req
-	case PacketTypeResponse:
-		pkt.PDU = new(Response)
-	case PacketTypeFault:
-		pkt.PDU = new(Fault)
-	case PacketTypeBind:
-		pkt.PDU = new(Bind)
-	case PacketTypeBindAck:
-		pkt.PDU = new(BindAck)
-	case PacketTypeBindNak:
-		pkt.PDU = new(BindNak)
-	case PacketTypeAlterContext:
-		pkt.PDU = new(AlterContext)
-	case PacketTypeAlterContextResponse:
-		pkt.PDU = new(AlterContextResponse)
-	case PacketTypeAuth3:
-		pkt.PDU = new(Auth3)
-	case PacketTypeShutdown:
-		pkt.PDU = new(Shutdown)
-	case PacketTypeCancel:
-		pkt.PDU = new(Cancel)
-	case PacketTypeOrphaned:
-		pkt.PDU = new(Orphaned)
-	default:
-		return nil, fmt.Errorf("read_pdu_header: unknown header type 0x%02x", pkt.Header.PacketType)
+	pdu, err := newPDU(pkt.Header)
+	if err != nil {
+		return nil, err
 	}
+	pkt.PDU = pdu
 	// unmarshal pdu header.
 	if err := pkt.PDU.ReadFrom(ctx, r); err != nil {
 		return nil, fmt.Errorf("read_pdu_header: %v", err)
@@ -260,7 +280,14 @@ func (c *transport) DecodePacket(ctx context.Context, pkt *Packet, raw []byte) (
 		maxLen = int(pdu.AllocHint)
 	case *Fault:
 		if pdu.Status != 0 {
-			return nil, errors.New(ctx, pdu.Status)
+			ferr := &FaultError{Status: pdu.Status, last: pkt.IsLastFrag()}
+			if pdu.Flags&FaultFlagExtendedErrorInfo != 0 && r.Offset() < pkt.end {
+				// the extended error information follows the fault header.
+				ferr.Err = errors.NewExtended(ctx, pdu.Status, pkt.raw[r.Offset():pkt.end])
+			} else {
+				ferr.Err = errors.New(ctx, pdu.Status)
+			}
+			return nil, ferr
 		}
 		maxLen = int(pdu.AllocHint)
 	case *BindNak:
@@ -289,11 +316,12 @@ func (c *transport) DecodePacket(ctx context.Context, pkt *Packet, raw []byte) (
 	n, err := pkt.Body.DecodeFrom(pkt.raw[pkt.start:pkt.end], pkt.Header.PacketDRep, maxLen)
 	if err != nil {
 		if err != io.EOF {
-			return nil, fmt.Errorf("decode_stub_data: %v", err)
+			return nil, fmt.Errorf("decode_stub_data: %w", err)
 		}
 	}
-	// check the trailer.
-	if expN := pkt.end - pkt.start; expN != n {
+	// check the trailer. (the unread stub data of the malformed response is
+	// skipped in the lenient mode).
+	if expN := pkt.end - pkt.start; expN != n && pkt.Body.Err() == nil {
 		// find the verification trailer signature.
 		idx := bytes.Index(pkt.raw[pkt.start+n:pkt.end], VerificationTrailerSignature[:])
 		if idx == -1 {
@@ -311,6 +339,120 @@ func (c *transport) DecodePacket(ctx context.Context, pkt *Packet, raw []byte) (
 	return pkt, nil
 }
 
+// newPDU function returns the empty PDU header for the packet header `hdr`.
+func newPDU(hdr Header) (PDU, error) {
+	switch hdr.PacketType {
+	case PacketTypeRequest:
+		req := new(Request)
+		// expect object uuid.
+		if hdr.PacketFlags&PacketFlagObjectUUID != 0 {
+			// capture object uuid in packet level.
+			req.ObjectUUID = new(uuid.UUID)
+		}
+		return req, nil
+	case PacketTypeResponse:
+		return new(Response), nil
+	case PacketTypeFault:
+		return new(Fault), nil
+	case PacketTypeBind:
+		return new(Bind), nil
+	case PacketTypeBindAck:
+		return new(BindAck), nil
+	case PacketTypeBindNak:
+		return new(BindNak), nil
+	case PacketTypeAlterContext:
+		return new(AlterContext), nil
+	case PacketTypeAlterContextResponse:
+		return new(AlterContextResponse), nil
+	case PacketTypeAuth3:
+		return new(Auth3), nil
+	case PacketTypeShutdown:
+		return new(Shutdown), nil
+	case PacketTypeCancel:
+		return new(Cancel), nil
+	case PacketTypeOrphaned:
+		return new(Orphaned), nil
+	}
+	return nil, fmt.Errorf("read_pdu_header: unknown header type 0x%02x", hdr.PacketType)
+}
+
+// ParsePacket function decodes the connection-oriented PDU `b` without the
+// connection state (for example, to inspect the captured traffic, or to fuzz
+// the PDU decoders that face the untrusted network input): the header, the
+// PDU header, the security trailer and the auth data are decoded, and the stub
+// data is returned raw (see StubDataBytes). Unlike the connection, the fault
+// PDU is returned as the packet, and the bytes that follow the fragment are
+// ignored.
+func ParsePacket(ctx context.Context, b []byte) (pkt *Packet, err error) {
+
+	// the malformed input must not panic the process.
+	defer func() {
+		if r := recover(); r != nil {
+			pkt, err = nil, ndr.NewPanicError(r)
+		}
+	}()
+
+	if len(b) < HeaderSize {
+		return nil, fmt.Errorf("read_header: short buffer: %d bytes", len(b))
+	}
+
+	pkt = &Packet{}
+
+	r := ndr.NDR20(b, ndr.DefaultDataRepresentation)
+
+	// unmarshal header. (the codec switches to the data representation
+	// of the packet after reading the label).
+	if err := pkt.Header.ReadFrom(ctx, r); err != nil {
+		return nil, fmt.Errorf("read_header: %v", err)
+	}
+
+	if pkt.Header.RPCVersion != 5 {
+		return nil, fmt.Errorf("read_header: unsupported version %d.%d", pkt.Header.RPCVersion, pkt.Header.RPCVersionMinor)
+	}
+
+	if fl := int(pkt.Header.FragLength); fl < HeaderSize || fl > len(b) {
+		return nil, fmt.Errorf("read_header: invalid fragment length %d (%d bytes)", fl, len(b))
+	}
+
+	pkt.raw, pkt.end = b[:pkt.Header.FragLength], int(pkt.Header.FragLength)
+
+	if pkt.Header.AuthLength != 0 {
+		// adjust stub end to exclude security trailer.
+		if pkt.end -= SecurityTrailerSize + int(pkt.Header.AuthLength); pkt.end < HeaderSize {
+			return nil, fmt.Errorf("read_header: invalid auth length %d", pkt.Header.AuthLength)
+		}
+	}
+
+	if pkt.PDU, err = newPDU(pkt.Header); err != nil {
+		return nil, err
+	}
+
+	// unmarshal pdu header.
+	if err := pkt.PDU.ReadFrom(ctx, r); err != nil {
+		return nil, fmt.Errorf("read_pdu_header: %v", err)
+	}
+
+	if pkt.start = r.Offset(); pkt.start > pkt.end {
+		return nil, fmt.Errorf("read_pdu_header: pdu header overlaps security trailer")
+	}
+
+	if pkt.Header.AuthLength != 0 {
+		r := ndr.NDR20(pkt.raw[pkt.end:], pkt.Header.PacketDRep)
+		// read security trailer.
+		if err := pkt.SecurityTrailer.ReadFrom(ctx, r); err != nil {
+			return nil, fmt.Errorf("read_security_trailer: %v", err)
+		}
+		// read auth data.
+		pkt.AuthData = pkt.raw[pkt.end+SecurityTrailerSize:]
+		// trim auth padding.
+		if pad := int(pkt.SecurityTrailer.AuthPadLength); pkt.end-pad >= pkt.start {
+			pkt.end -= pad
+		}
+	}
+
+	return pkt, nil
+}
+
 // zeroPad for writing header and auth padding data.
 var zeroPad = [16]byte{}
 
@@ -319,6 +461,11 @@ func (c *transport) EncodePacket(ctx context.Context, pkt *Packet, raw []byte) e
 
 	raw = raw[:c.settings.MaxXmitFrag]
 
+	if pkt.fragSize > 0 && pkt.fragSize < len(raw) {
+		// limit the fragment size.
+		raw = raw[:pkt.fragSize]
+	}
+
 	// set initial buffer settings.
 	pkt.raw, pkt.end = raw, len(raw)
 
@@ -381,7 +528,7 @@ func (c *transport) EncodePacket(ctx context.Context, pkt *Packet, raw []byte) e
 	n, err := pkt.Body.EncodeTo(raw[pkt.start:pkt.end], pkt.Header.PacketDRep, 0)
 	if err != nil {
 		if err != io.EOF {
-			return fmt.Errorf("encode_stub_data: %v", err)
+			return fmt.Errorf("encode_stub_data: %w", err)
 		}
 		pkt.Header.PacketFlags |= PacketFlagLastFrag
 	}
@@ -4,6 +4,7 @@ package dcerpc
 
 import (
 	"context"
+	"strings"
 
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
 	"github.com/oiweiwei/go-msrpc/ndr"
@@ -294,20 +295,55 @@ func (pdu *BindNak) MarshalZerologObject(e *zerolog.Event) {}
 
 func (pdu *BindNak) Error() string {
 
+	msg := "bind rejected: " + pdu.reason().Error()
+
+	if len(pdu.VersionList) > 0 {
+		versions := make([]string, len(pdu.VersionList))
+		for i := range pdu.VersionList {
+			versions[i] = pdu.VersionList[i].String()
+		}
+		msg += ": supported versions: " + strings.Join(versions, ", ")
+	}
+
+	return msg
+}
+
+// Unwrap function returns the ErrBindRejected and the error for the
+// reject reason, so that the reason can be checked with errors.Is:
+//
+//	if errors.Is(err, dcerpc.ErrProtocolVersionNotSupported) {
+//		var nak *dcerpc.BindNak
+//		if errors.As(err, &nak) {
+//			// nak.VersionList contains the supported protocol versions.
+//		}
+//	}
+func (pdu *BindNak) Unwrap() []error {
+	return []error{ErrBindRejected, pdu.reason()}
+}
+
+// reason function returns the error for the reject reason.
+func (pdu *BindNak) reason() error {
 	switch pdu.ProviderRejectReason {
-	case AbstractSyntaxNotSupported:
-		return "bind: abstract syntax is not supported"
-	case ProposedTransferSyntaxesNotSupported:
-		return "bind: proposed transfer syntaxes not supported"
-	case LocalLimitExceeded:
-		return "bind: local limit exceeded"
+	case ReasonTemporaryCongestion:
+		return ErrTemporaryCongestion
+	case ReasonLocalLimitExceeded:
+		return ErrLocalLimitExceeded
+	case ReasonCalledPAddrUnknown:
+		return ErrCalledPAddrUnknown
+	case ReasonProtocolVersionNotSupported:
+		return ErrProtocolVersionNotSupported
+	case ReasonDefaultContextNotSupported:
+		return ErrDefaultContextNotSupported
+	case ReasonUserDataNotReadable:
+		return ErrUserDataNotReadable
+	case ReasonNoPSAPAvailable:
+		return ErrNoPSAPAvailable
 	case AuthTypeNotRecognized:
-		return "bind: authentication type was not recognized"
+		return ErrAuthTypeNotRecognized
 	case InvalidChecksum:
-		return "bind: invalid checksum"
-	default:
-		return "bind: unknown error"
+		return ErrInvalidChecksum
 	}
+	return ErrReasonNotSpecified
 }
 
 func (pdu *BindNak) WriteTo(ctx context.Context, w ndr.Writer) error {
@@ -363,6 +399,35 @@ type Fault struct {
 	Pad         [4]byte
 }
 
+// FaultError is the error for the fault PDU with the non-zero status.
+type FaultError struct {
+	// The fault status.
+	Status uint32
+	// The error mapped from the status (and the extended error
+	// information).
+	Err error
+	// The flag that indicates whether the fault is the last fragment
+	// of the response.
+	last bool
+}
+
+func (e *FaultError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FaultError) Unwrap() error {
+	return e.Err
+}
+
+// StatusCode function returns the fault status.
+func (e *FaultError) StatusCode() uint32 {
+	return e.Status
+}
+
+// FaultFlagExtendedErrorInfo is the fault flag that indicates that the
+// fault stub data contains the extended error information (MS-EERR).
+const FaultFlagExtendedErrorInfo uint8 = 0x01
+
 func (pdu *Fault) MarshalZerologObject(e *zerolog.Event) {
 	e.Uint32("alloc_hint", pdu.AllocHint)
 	e.Uint16("context_id", pdu.ContextID)
@@ -477,6 +542,7 @@ func (pdu *Response) MarshalZerologObject(e *zerolog.Event) {
 func (pdu *Response) WriteTo(ctx context.Context, w ndr.Writer) error {
 	w.WriteData(pdu.AllocHint)
 	w.WriteData(pdu.ContextID)
+	w.WriteData(pdu.CancelCount)
 	w.WriteData((uint8)(0)) // pad.
 	return w.Err()
 }
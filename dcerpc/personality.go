@@ -0,0 +1,188 @@
+package dcerpc
+
+// personality.go contains the server emulation profiles.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+)
+
+// OSVersion is the operating system version reported by the emulated server.
+type OSVersion struct {
+	// The major version.
+	Major uint32 `json:"major"`
+	// The minor version.
+	Minor uint32 `json:"minor"`
+	// The build number.
+	Build uint32 `json:"build"`
+}
+
+// String function returns the version in "major.minor.build" format.
+func (v OSVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Build)
+}
+
+// Personality is the server emulation profile that defines the protocol
+// behavior of the server (the fragment sizes and bind-time features
+// acknowledged in bind, the operations supported for the interfaces and
+// the fault statuses), so that the server mimics the specific Windows
+// release. The operating system version is not used by the runtime and is
+// exposed to the server handlers with PersonalityFromContext function
+// (for example, to populate the version fields of the NetrServerGetInfo
+// response).
+type Personality struct {
+	// The profile name.
+	Name string `json:"name"`
+	// The operating system version.
+	Version OSVersion `json:"version"`
+	// The fragment size (max_xmit_frag, max_recv_frag) acknowledged
+	// in bind. The size is limited by the sizes proposed by the client.
+	// (zero accepts the sizes proposed by the client).
+	MaxFrag int `json:"max_frag"`
+	// The bind-time features acknowledged (the features proposed by
+	// the client and not supported by the runtime are not acknowledged).
+	BindFeatures ProviderReason `json:"bind_features"`
+	// The secondary address (port) is returned in bind_ack.
+	SecondaryAddr bool `json:"secondary_addr"`
+	// The number of operations supported for the interface (the abstract
+	// syntax "uuid/vX.Y" is mapped to the number of operations), the
+	// requests with the operation number beyond are rejected with the
+	// OpRangeFault status.
+	Operations map[string]int `json:"operations,omitempty"`
+	// The fault status for the operation number out of range.
+	OpRangeFault uint32 `json:"op_range_fault"`
+	// The fault status for the request with unknown presentation
+	// context.
+	UnknownInterfaceFault uint32 `json:"unknown_interface_fault"`
+	// The fault status for the request rejected by the runtime (for
+	// example, with verification trailer mismatch).
+	ProtocolFault uint32 `json:"protocol_fault"`
+}
+
+// WithOperations function returns the copy of the personality that supports
+// `n` operations (opnums 0..n-1) for the interface `syntax`.
+//
+//	// NetrServerGetInfo (opnum 21) is the last supported operation.
+//	p := dcerpc.PersonalityServer2019.WithOperations(srvsvc.SrvsvcSyntaxV3_0, 22)
+func (p *Personality) WithOperations(syntax *SyntaxID, n int) *Personality {
+
+	cpy := *p
+
+	cpy.Operations = make(map[string]int, len(p.Operations)+1)
+	for k, v := range p.Operations {
+		cpy.Operations[k] = v
+	}
+	cpy.Operations[syntaxKey(syntax)] = n
+
+	return &cpy
+}
+
+// supports function returns `true` if the operation is supported for the interface.
+func (p *Personality) supports(syntax *SyntaxID, opNum int) bool {
+	if syntax == nil || len(p.Operations) == 0 {
+		return true
+	}
+	if n, ok := p.Operations[syntaxKey(syntax)]; ok {
+		return opNum < n
+	}
+	return true
+}
+
+var (
+	// The default server behavior.
+	defaultPersonality = &Personality{
+		BindFeatures:          KeepConnOpenOnOrphaned | SecurityContextMultiplexing,
+		OpRangeFault:          rpcerrors.OperationRangeError.Code,
+		UnknownInterfaceFault: rpcerrors.UnknownInterface.Code,
+		ProtocolFault:         rpcerrors.ProtocolError.Code,
+	}
+
+	// Windows Server 2012 R2.
+	PersonalityServer2012R2 = &Personality{
+		Name:                  "Windows Server 2012 R2",
+		Version:               OSVersion{Major: 6, Minor: 3, Build: 9600},
+		MaxFrag:               5840,
+		BindFeatures:          KeepConnOpenOnOrphaned | SecurityContextMultiplexing,
+		SecondaryAddr:         true,
+		OpRangeFault:          rpcerrors.OperationRangeError.Code,
+		UnknownInterfaceFault: rpcerrors.UnknownInterface.Code,
+		ProtocolFault:         rpcerrors.ProtocolError.Code,
+	}
+
+	// Windows Server 2019.
+	PersonalityServer2019 = &Personality{
+		Name:                  "Windows Server 2019",
+		Version:               OSVersion{Major: 10, Minor: 0, Build: 17763},
+		MaxFrag:               5840,
+		BindFeatures:          KeepConnOpenOnOrphaned | SecurityContextMultiplexing,
+		SecondaryAddr:         true,
+		OpRangeFault:          rpcerrors.OperationRangeError.Code,
+		UnknownInterfaceFault: rpcerrors.UnknownInterface.Code,
+		ProtocolFault:         rpcerrors.ProtocolError.Code,
+	}
+
+	// Windows Server 2022.
+	PersonalityServer2022 = &Personality{
+		Name:                  "Windows Server 2022",
+		Version:               OSVersion{Major: 10, Minor: 0, Build: 20348},
+		MaxFrag:               5840,
+		BindFeatures:          KeepConnOpenOnOrphaned | SecurityContextMultiplexing,
+		SecondaryAddr:         true,
+		OpRangeFault:          rpcerrors.OperationRangeError.Code,
+		UnknownInterfaceFault: rpcerrors.UnknownInterface.Code,
+		ProtocolFault:         rpcerrors.ProtocolError.Code,
+	}
+)
+
+// Personalities is the list of the shipped personality profiles.
+var Personalities = []*Personality{
+	PersonalityServer2012R2,
+	PersonalityServer2019,
+	PersonalityServer2022,
+}
+
+// LookupPersonality function returns the shipped personality profile by name
+// (case-insensitive, "Windows Server" prefix can be omitted, for example,
+// "2012 R2", "2012R2", "windows server 2022").
+func LookupPersonality(name string) (*Personality, bool) {
+
+	normalize := func(s string) string {
+		s = strings.ToLower(strings.ReplaceAll(s, " ", ""))
+		return strings.TrimPrefix(s, "windowsserver")
+	}
+
+	for _, p := range Personalities {
+		if normalize(p.Name) == normalize(name) {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// ServerOption is the server option.
+type ServerOption func(*Server)
+
+// WithPersonality option sets the server personality profile:
+//
+//	srv := dcerpc.NewServer(dcerpc.WithPersonality(dcerpc.PersonalityServer2019))
+func WithPersonality(p *Personality) ServerOption {
+	return func(s *Server) {
+		if p != nil {
+			s.personality = p
+		}
+	}
+}
+
+type personalityKey struct{}
+
+// PersonalityFromContext function returns the personality profile of the server
+// that dispatches the request. The function returns `false` if no personality was
+// selected for the server.
+func PersonalityFromContext(ctx context.Context) (*Personality, bool) {
+	p, ok := ctx.Value(personalityKey{}).(*Personality)
+	return p, ok
+}
@@ -0,0 +1,62 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestPersonality(t *testing.T) {
+
+	ctx := context.Background()
+
+	p, ok := dcerpc.LookupPersonality("2019")
+	if !ok || p != dcerpc.PersonalityServer2019 {
+		t.Fatalf("lookup personality: %v", p)
+	}
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	// the echo operation is the only supported operation.
+	srv := dcerpc.NewServer(dcerpc.WithPersonality(p.WithOperations(echoSyntax, 1)))
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		// the handler reports the build number of the personality.
+		if p, ok := dcerpc.PersonalityFromContext(ctx); ok {
+			op.Value = p.Version.Build
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln), dcerpc.WithFragmentSize(16384))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	op := &echoOp{Value: 1}
+	if err := cc.Invoke(ctx, op); err != nil || op.Reply != 17763 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+
+	// the operations not supported by the personality are rejected.
+	values := &listValuesOp{}
+	if err := cc.Invoke(ctx, values); !errors.Is(err, rpcerrors.OperationRangeError) {
+		t.Fatalf("expected operation range error, got %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+package dcerpc
+
+// policy.go contains the target policy guard definitions.
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	// The target is denied by the policy.
+	ErrTargetDenied = errors.New("target denied by policy")
+	// The interface is denied by the policy.
+	ErrInterfaceDenied = errors.New("interface denied by policy")
+)
+
+// The environment variables used to configure the global target policy.
+const (
+	// The comma-separated list of allowed hosts (glob, CIDR or exact match).
+	EnvAllowHosts = "MSRPC_ALLOW_HOSTS"
+	// The comma-separated list of denied hosts (glob, CIDR or exact match).
+	EnvDenyHosts = "MSRPC_DENY_HOSTS"
+	// The comma-separated list of allowed ports.
+	EnvAllowPorts = "MSRPC_ALLOW_PORTS"
+	// The comma-separated list of denied ports.
+	EnvDenyPorts = "MSRPC_DENY_PORTS"
+	// The comma-separated list of allowed interface UUIDs.
+	EnvAllowInterfaces = "MSRPC_ALLOW_INTERFACES"
+	// The comma-separated list of denied interface UUIDs.
+	EnvDenyInterfaces = "MSRPC_DENY_INTERFACES"
+)
+
+// TargetPolicy represents the allow-list/deny-list of the targets
+// and interfaces the client is permitted to reach.
+//
+// The deny-list always takes precedence over the allow-list. An empty
+// allow-list permits any target which is not explicitly denied.
+type TargetPolicy struct {
+	// The allowed hosts. Each entry is either the exact host name or
+	// IP address, the glob pattern (`*.contoso.net`) or the CIDR
+	// network (`10.0.0.0/8`).
+	AllowHosts []string `json:"allow_hosts,omitempty" yaml:"allow_hosts,omitempty"`
+	// The denied hosts. (same format as AllowHosts).
+	DenyHosts []string `json:"deny_hosts,omitempty" yaml:"deny_hosts,omitempty"`
+	// The allowed TCP ports (including SMB port for named pipes).
+	AllowPorts []int `json:"allow_ports,omitempty" yaml:"allow_ports,omitempty"`
+	// The denied TCP ports.
+	DenyPorts []int `json:"deny_ports,omitempty" yaml:"deny_ports,omitempty"`
+	// The allowed interface UUIDs.
+	AllowInterfaces []string `json:"allow_interfaces,omitempty" yaml:"allow_interfaces,omitempty"`
+	// The denied interface UUIDs.
+	DenyInterfaces []string `json:"deny_interfaces,omitempty" yaml:"deny_interfaces,omitempty"`
+}
+
+var (
+	targetPolicyMu sync.RWMutex
+	targetPolicy   *TargetPolicy
+)
+
+func init() {
+	targetPolicy = TargetPolicyFromEnv()
+}
+
+// SetTargetPolicy function sets the global target policy. The global policy
+// is used by every new connection, unless the WithTargetPolicy option is
+// provided.
+func SetTargetPolicy(p *TargetPolicy) {
+	targetPolicyMu.Lock()
+	defer targetPolicyMu.Unlock()
+	targetPolicy = p
+}
+
+// GlobalTargetPolicy function returns the global target policy.
+func GlobalTargetPolicy() *TargetPolicy {
+	targetPolicyMu.RLock()
+	defer targetPolicyMu.RUnlock()
+	return targetPolicy
+}
+
+// WithTargetPolicy option sets the target policy for the connection.
+func WithTargetPolicy(p *TargetPolicy) ConnectOption {
+	return func(o *Transport) { o.TargetPolicy = p }
+}
+
+// TargetPolicyFromEnv function returns the target policy configured
+// with environment variables, or nil if none of the variables is set.
+func TargetPolicyFromEnv() *TargetPolicy {
+
+	p, set := &TargetPolicy{}, false
+
+	for env, v := range map[string]*[]string{
+		EnvAllowHosts:      &p.AllowHosts,
+		EnvDenyHosts:       &p.DenyHosts,
+		EnvAllowInterfaces: &p.AllowInterfaces,
+		EnvDenyInterfaces:  &p.DenyInterfaces,
+	} {
+		if *v = splitList(os.Getenv(env)); len(*v) > 0 {
+			set = true
+		}
+	}
+
+	for env, v := range map[string]*[]int{
+		EnvAllowPorts: &p.AllowPorts,
+		EnvDenyPorts:  &p.DenyPorts,
+	} {
+		for _, port := range splitList(os.Getenv(env)) {
+			if n, err := strconv.Atoi(port); err == nil {
+				*v, set = append(*v, n), true
+			}
+		}
+	}
+
+	if !set {
+		return nil
+	}
+
+	return p
+}
+
+// splitList function splits the comma-separated list.
+func splitList(s string) []string {
+	var ret []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+// matchHost function returns `true` if any of the host names matches
+// the pattern.
+func matchHost(pattern string, hosts ...string) bool {
+
+	_, network, _ := net.ParseCIDR(pattern)
+
+	for _, host := range hosts {
+		if host == "" {
+			continue
+		}
+		if network != nil {
+			if ip := net.ParseIP(host); ip != nil && network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(host)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckHost function checks whether the target host is permitted by the
+// policy. Multiple names (for example, host name and resolved IP address)
+// can be provided for the same target.
+func (p *TargetPolicy) CheckHost(hosts ...string) error {
+
+	if p == nil {
+		return nil
+	}
+
+	for _, pattern := range p.DenyHosts {
+		if matchHost(pattern, hosts...) {
+			return fmt.Errorf("%w: host %s matches deny rule %q", ErrTargetDenied, strings.Join(hosts, "/"), pattern)
+		}
+	}
+
+	if len(p.AllowHosts) == 0 {
+		return nil
+	}
+
+	for _, pattern := range p.AllowHosts {
+		if matchHost(pattern, hosts...) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: host %s is not in the allow-list", ErrTargetDenied, strings.Join(hosts, "/"))
+}
+
+// CheckPort function checks whether the target port is permitted by the
+// policy.
+func (p *TargetPolicy) CheckPort(port int) error {
+
+	if p == nil {
+		return nil
+	}
+
+	for _, deny := range p.DenyPorts {
+		if deny == port {
+			return fmt.Errorf("%w: port %d is denied", ErrTargetDenied, port)
+		}
+	}
+
+	if len(p.AllowPorts) == 0 {
+		return nil
+	}
+
+	for _, allow := range p.AllowPorts {
+		if allow == port {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: port %d is not in the allow-list", ErrTargetDenied, port)
+}
+
+// CheckInterface function checks whether the abstract syntax is permitted
+// by the policy.
+func (p *TargetPolicy) CheckInterface(syntax *SyntaxID) error {
+
+	if p == nil || syntax == nil || syntax.IfUUID == nil {
+		return nil
+	}
+
+	id := strings.ToLower(syntax.IfUUID.String())
+
+	for _, deny := range p.DenyInterfaces {
+		if strings.ToLower(deny) == id {
+			return fmt.Errorf("%w: interface %s is denied", ErrInterfaceDenied, id)
+		}
+	}
+
+	if len(p.AllowInterfaces) == 0 {
+		return nil
+	}
+
+	for _, allow := range p.AllowInterfaces {
+		if strings.ToLower(allow) == id {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: interface %s is not in the allow-list", ErrInterfaceDenied, id)
+}
+
+// CheckAddr function checks the host and port parts of the network
+// address.
+func (p *TargetPolicy) CheckAddr(addr string, names ...string) error {
+
+	if p == nil {
+		return nil
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return p.CheckHost(append(names, addr)...)
+	}
+
+	if err := p.CheckHost(append(names, host)...); err != nil {
+		return err
+	}
+
+	if n, err := strconv.Atoi(port); err == nil {
+		return p.CheckPort(n)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package dcerpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+func TestTargetPolicy(t *testing.T) {
+
+	p := &TargetPolicy{
+		AllowHosts:     []string{"*.contoso.net", "10.0.0.0/8"},
+		DenyHosts:      []string{"dc01.contoso.net"},
+		DenyPorts:      []int{139},
+		DenyInterfaces: []string{"12345778-1234-ABCD-EF00-0123456789AC"},
+	}
+
+	for _, testCase := range []struct {
+		Addr   string
+		Denied bool
+	}{
+		{"srv01.contoso.net:135", false},
+		{"DC01.contoso.net:135", true},
+		{"10.1.2.3:445", false},
+		{"10.1.2.3:139", true},
+		{"192.168.0.1:135", true},
+		{"fabrikam.net", true},
+	} {
+		if err := p.CheckAddr(testCase.Addr); (err != nil) != testCase.Denied || (err != nil && !errors.Is(err, ErrTargetDenied)) {
+			t.Errorf("check addr %s: unexpected result: %v", testCase.Addr, err)
+		}
+	}
+
+	samr := &SyntaxID{IfUUID: uuid.MustParse("12345778-1234-abcd-ef00-0123456789ac"), IfVersionMajor: 1}
+	if err := p.CheckInterface(samr); !errors.Is(err, ErrInterfaceDenied) {
+		t.Errorf("check interface: expected denied, got %v", err)
+	}
+
+	if err := (*TargetPolicy)(nil).CheckAddr("192.168.0.1:135"); err != nil {
+		t.Errorf("nil policy must allow any target: %v", err)
+	}
+}
@@ -0,0 +1,455 @@
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// ErrPoolClosed is returned when the connection pool is closed.
+var ErrPoolClosed = errors.New("dcerpc: connection pool is closed")
+
+// ConnectionPool is the cache of the associations (transport sets) keyed by
+// the server host, transport and authentication identity (the user name and
+// the credential instance, so the credentials must be reused to share the
+// association). The connections
+// returned by the pool share the underlying association, the bind requests
+// with the same abstract syntax and security level reuse the already
+// established presentation context:
+//
+//	pool := dcerpc.NewConnectionPool(dcerpc.WithTimeout(10 * time.Second))
+//	defer pool.Close(ctx)
+//
+//	conn, err := pool.Dial(ctx, "ncacn_ip_tcp:dc.contoso.net", dcerpc.WithCredentials(creds))
+//	if err != nil {
+//		// handle error.
+//	}
+//	// release the connection (the association is kept by the pool).
+//	defer conn.Close(ctx)
+//
+//	cli, err := winreg.NewWinregClient(ctx, conn, dcerpc.WithSeal())
+//
+// Every connection (and every client bound with it) holds the reference to
+// the association, the Close method releases the reference. The idle
+// associations are kept open until the pool is closed (or pruned).
+type ConnectionPool struct {
+	mu sync.Mutex
+	// the default options.
+	opts []Option
+	// the associations.
+	conns  map[poolKey]*poolEntry
+	closed bool
+}
+
+// poolKey is the association key.
+type poolKey struct {
+	host, transport, identity string
+}
+
+// poolEntry is the pooled association.
+type poolEntry struct {
+	pool *ConnectionPool
+	key  poolKey
+	cc   Conn
+	// the number of references.
+	refs int
+	// the bind lock.
+	mu sync.Mutex
+	// the bound presentation contexts.
+	bound map[string]Conn
+}
+
+// NewConnectionPool function returns the new connection pool. The options
+// `opts` are applied to all connections established by the pool.
+func NewConnectionPool(opts ...Option) *ConnectionPool {
+	return &ConnectionPool{opts: opts, conns: make(map[poolKey]*poolEntry)}
+}
+
+// Dial function returns the connection to the server `addr`. The connection
+// shares the association with other connections of the same key, the new
+// association is established (see Dial function) if none exists.
+func (p *ConnectionPool) Dial(ctx context.Context, addr string, opts ...Option) (Conn, error) {
+
+	opts = append(append([]Option{}, p.opts...), opts...)
+
+	key, err := newPoolKey(ctx, addr, opts)
+	if err != nil {
+		return nil, fmt.Errorf("pool: %w", err)
+	}
+
+	if conn, err := p.lookup(key); conn != nil || err != nil {
+		return conn, err
+	}
+
+	// the association is established without the pool lock held.
+	cc, err := Dial(ctx, addr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("pool: %w", err)
+	}
+
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+		cc.Close(ctx)
+		return nil, ErrPoolClosed
+	}
+
+	if e, ok := p.conns[key]; ok {
+		// the concurrent dial has established the association first.
+		conn := e.acquire(nil)
+		p.mu.Unlock()
+		cc.Close(ctx)
+		return conn, nil
+	}
+
+	e := &poolEntry{pool: p, key: key, cc: cc, bound: make(map[string]Conn)}
+	p.conns[key] = e
+
+	conn := e.acquire(nil)
+
+	p.mu.Unlock()
+
+	return conn, nil
+}
+
+// lookup function returns the new reference to the established association
+// for the key (or nil if none exists).
+func (p *ConnectionPool) lookup(key poolKey) (Conn, error) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, ErrPoolClosed
+	}
+
+	if e, ok := p.conns[key]; ok {
+		return e.acquire(nil), nil
+	}
+
+	return nil, nil
+}
+
+// Len function returns the number of associations in the pool.
+func (p *ConnectionPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.conns)
+}
+
+// Prune function closes the associations that are not referenced.
+func (p *ConnectionPool) Prune(ctx context.Context) error {
+
+	p.mu.Lock()
+
+	var idle []*poolEntry
+
+	for k, e := range p.conns {
+		if e.refs > 0 {
+			continue
+		}
+		delete(p.conns, k)
+		idle = append(idle, e)
+	}
+
+	p.mu.Unlock()
+
+	return closeEntries(ctx, idle)
+}
+
+// Close function closes all associations of the pool. The connections
+// acquired from the pool become unusable.
+func (p *ConnectionPool) Close(ctx context.Context) error {
+
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+
+	p.closed = true
+
+	var entries []*poolEntry
+
+	for k, e := range p.conns {
+		delete(p.conns, k)
+		entries = append(entries, e)
+	}
+
+	p.mu.Unlock()
+
+	return closeEntries(ctx, entries)
+}
+
+// closeEntries function closes the associations removed from the pool.
+func closeEntries(ctx context.Context, entries []*poolEntry) error {
+	var err error
+	for _, e := range entries {
+		err = errors.Join(err, e.cc.Close(ctx))
+	}
+	return err
+}
+
+// acquire function returns the new reference to the association. The `cc`
+// is the bound connection (nil for the unbound one). Must be called with the
+// pool lock held.
+func (e *poolEntry) acquire(cc Conn) *pooledConn {
+	e.refs++
+	return &pooledConn{entry: e, cc: cc}
+}
+
+// release function releases the reference.
+func (e *poolEntry) release() {
+	e.pool.mu.Lock()
+	defer e.pool.mu.Unlock()
+	if e.refs > 0 {
+		e.refs--
+	}
+}
+
+// evict function removes the bound presentation context from the cache.
+func (e *poolEntry) evict(cc Conn) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for k := range e.bound {
+		if e.bound[k] == cc {
+			delete(e.bound, k)
+		}
+	}
+}
+
+// pooledConn is the reference to the pooled association. The bound
+// connection is shared by all references with the same presentation key.
+type pooledConn struct {
+	entry *poolEntry
+	// the bound connection (nil if not bound).
+	cc   Conn
+	once sync.Once
+}
+
+// Bind function binds the association or returns the connection with the
+// already established presentation context.
+func (c *pooledConn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
+
+	if cc, ok := HasNoBind(opts); ok {
+		return cc, nil
+	}
+
+	key := presentationKey(ctx, opts)
+
+	e := c.entry
+
+	// the bind is serialized per association.
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	cc, ok := e.bound[key]
+	if !ok {
+		var err error
+		if cc, err = e.cc.Bind(ctx, opts...); err != nil {
+			return nil, err
+		}
+		e.bound[key] = cc
+	}
+
+	e.pool.mu.Lock()
+	defer e.pool.mu.Unlock()
+
+	if e.pool.closed {
+		return nil, ErrPoolClosed
+	}
+
+	return e.acquire(cc), nil
+}
+
+// AlterContext function alters the context of the bound connection.
+func (c *pooledConn) AlterContext(ctx context.Context, opts ...Option) error {
+	if c.cc == nil {
+		return fmt.Errorf("alter context: the transport is not binded")
+	}
+	return c.cc.AlterContext(ctx, opts...)
+}
+
+// Context function returns the connection context.
+func (c *pooledConn) Context() context.Context {
+	if c.cc == nil {
+		return c.entry.cc.Context()
+	}
+	return c.cc.Context()
+}
+
+// Info function returns the connection information.
+func (c *pooledConn) Info() *ConnInfo {
+	if c.cc == nil {
+		return c.entry.cc.Info()
+	}
+	return c.cc.Info()
+}
+
+// Invoke function invokes the operation over the shared association.
+func (c *pooledConn) Invoke(ctx context.Context, op Operation, opts ...CallOption) error {
+	if c.cc == nil {
+		return c.entry.cc.Invoke(ctx, op, opts...)
+	}
+	return c.checkErr(c.cc.Invoke(ctx, op, opts...))
+}
+
+// InvokeObject function invokes the operation over the shared association.
+func (c *pooledConn) InvokeObject(ctx context.Context, id *uuid.UUID, op Operation, opts ...CallOption) error {
+	if c.cc == nil {
+		return c.entry.cc.InvokeObject(ctx, id, op, opts...)
+	}
+	return c.checkErr(c.cc.InvokeObject(ctx, id, op, opts...))
+}
+
+// checkErr function evicts the bound connection if the transport is broken,
+// so the next bind establishes the new one.
+func (c *pooledConn) checkErr(err error) error {
+	if errors.Is(err, ErrShutdown) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, net.ErrClosed) {
+		c.entry.evict(c.cc)
+	}
+	return err
+}
+
+// Close function releases the reference to the association. The association
+// is not closed.
+func (c *pooledConn) Close(ctx context.Context) error {
+	c.once.Do(c.entry.release)
+	return nil
+}
+
+// RegisterServer.
+func (c *pooledConn) RegisterServer(h ServerHandle, opts ...Option) {
+	if c.cc != nil {
+		c.cc.RegisterServer(h, opts...)
+	}
+}
+
+// newPoolKey function returns the association key for the server address and
+// connect and security options.
+func newPoolKey(ctx context.Context, addr string, opts []Option) (poolKey, error) {
+
+	settings := NewTransport()
+	for _, o := range opts {
+		if o, ok := o.(ConnectOption); ok {
+			o(&settings)
+		}
+	}
+
+	ip, host, binding, err := ParseServerAddr(addr)
+	if err != nil {
+		return poolKey{}, err
+	}
+
+	key := poolKey{host: strings.ToLower(host), identity: identity(ctx, opts)}
+
+	if ip != nil {
+		key.host = ip.String()
+	}
+
+	if binding != nil {
+		if key.host = strings.ToLower(binding.NetworkAddress); key.host == "" {
+			key.host = strings.ToLower(binding.ComputerName)
+		}
+		key.transport = binding.ProtocolSequence.String()
+	}
+
+	switch {
+	case settings.RPCProxy != nil:
+		key.transport += "+rpch"
+	case settings.TLSConfig != nil:
+		key.transport += "+tls"
+	}
+
+	if settings.Proxy != "" {
+		key.transport += "+" + settings.Proxy
+	}
+
+	return key, nil
+}
+
+// identity function returns the authentication identity of the credentials
+// provided with the options: the domain and user name and the credential
+// instance, so that the callers with the same user name and different secrets
+// do not share the authenticated association (the credential instance must be
+// reused to share the association).
+func identity(ctx context.Context, opts []Option) string {
+
+	var ids []string
+
+	for _, o := range ParseSecurityOptions(ctx, opts...).SecurityOptions {
+		v := any(o)
+		if cred, ok := v.(gssapi.Credential); ok {
+			v = cred.Value()
+		}
+		if cred, ok := v.(interface {
+			UserName() string
+			DomainName() string
+		}); ok {
+			ids = append(ids, strings.ToLower(cred.DomainName()+"\\"+cred.UserName())+"@"+instance(v))
+		}
+	}
+
+	sort.Strings(ids)
+
+	return strings.Join(ids, ",")
+}
+
+var instanceID atomic.Uint64
+
+// instance function returns the identifier of the credential instance `v` (the
+// pointer address, or the unique identifier if the credential is not a pointer,
+// so that such credentials never share the association).
+func instance(v any) string {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		return fmt.Sprintf("%#x", rv.Pointer())
+	}
+	return fmt.Sprintf("#%d", instanceID.Add(1))
+}
+
+// presentationKey function returns the key of the presentation context: the
+// abstract syntaxes, the authentication type and level and the identity.
+func presentationKey(ctx context.Context, opts []Option) string {
+
+	o, sec := &option{}, &Security{}
+
+	for i := range opts {
+		switch opt := (any)(opts[i]).(type) {
+		case BindOption:
+			opt(o)
+		case SecurityOption:
+			opt(sec)
+		}
+	}
+
+	var b strings.Builder
+
+	syntaxes := o.AbstractSyntaxes
+	for _, p := range o.Presentations {
+		syntaxes = append(syntaxes, p.AbstractSyntax)
+	}
+
+	for _, syntax := range syntaxes {
+		if syntax != nil {
+			fmt.Fprintf(&b, "%s:%d.%d;", syntax.IfUUID, syntax.IfVersionMajor, syntax.IfVersionMinor)
+		}
+	}
+
+	fmt.Fprintf(&b, "%d/%d/%s", sec.Type, sec.Level, identity(ctx, opts))
+
+	return b.String()
+}
+
+var _ Conn = (*pooledConn)(nil)
@@ -0,0 +1,140 @@
+package dcerpc_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	srvsvc "github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+// countingDialer counts the established connections.
+type countingDialer struct {
+	dcerpc.Dialer
+	n atomic.Int32
+}
+
+func (d *countingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.n.Add(1)
+	return d.Dialer.DialContext(ctx, network, address)
+}
+
+func TestConnectionPool(t *testing.T) {
+
+	ctx := context.Background()
+
+	srv := dcerpc.NewServer()
+	srv.Register(srvsvc.SrvsvcSyntaxV3_0, srvsvc.NewSrvsvcServerHandle(&todServer{}))
+
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+
+	go srv.Serve(ln)
+
+	dialer := &countingDialer{Dialer: ln}
+
+	pool := dcerpc.NewConnectionPool(dcerpc.WithDialer(dialer))
+
+	remoteToD := func(conn dcerpc.Conn) {
+		t.Helper()
+		cli, err := srvsvc.NewSrvsvcClient(ctx, conn, dcerpc.WithInsecure())
+		if err != nil {
+			t.Fatalf("bind: %v", err)
+		}
+		if _, err := cli.RemoteToD(ctx, &srvsvc.RemoteToDRequest{ServerName: "localhost"}); err != nil {
+			t.Fatalf("remote tod: %v", err)
+		}
+	}
+
+	conn1, err := pool.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]")
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	conn2, err := pool.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]")
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	remoteToD(conn1)
+	// the reference is released, the association is kept.
+	conn1.Close(ctx)
+	remoteToD(conn2)
+
+	if n := dialer.n.Load(); n != 1 {
+		t.Fatalf("expected single connection, got %d", n)
+	}
+
+	// the different identity uses the different association.
+	conn3, err := pool.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithCredentials(credential.NewFromPassword("CONTOSO\\user", "")))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn3.Close(ctx)
+
+	if n := pool.Len(); n != 2 {
+		t.Fatalf("expected two associations, got %d", n)
+	}
+
+	// the same user name with the different secret does not share the
+	// authenticated association, the same credential instance does.
+	creds := credential.NewFromPassword("CONTOSO\\user", "other")
+
+	for i := 0; i < 2; i++ {
+		conn, err := pool.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithCredentials(creds))
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		defer conn.Close(ctx)
+	}
+
+	if n := pool.Len(); n != 3 {
+		t.Fatalf("expected three associations, got %d", n)
+	}
+
+	if err := pool.Close(ctx); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	if _, err := pool.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]"); err != dcerpc.ErrPoolClosed {
+		t.Fatalf("expected pool closed error, got %v", err)
+	}
+}
+
+func TestConnectionPoolConcurrentDial(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+
+	pool := dcerpc.NewConnectionPool(dcerpc.WithDialer(ln))
+	defer pool.Close(ctx)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conn, err := pool.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]")
+			if err != nil {
+				t.Errorf("dial: %v", err)
+				return
+			}
+			defer conn.Close(ctx)
+			pool.Len()
+		}()
+	}
+
+	wg.Wait()
+
+	// the associations established by the concurrent dials are closed.
+	if n := pool.Len(); n != 1 {
+		t.Fatalf("expected single association, got %d", n)
+	}
+}
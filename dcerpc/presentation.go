@@ -73,10 +73,10 @@ func (c *transport) PresentationsToContextList(ps []*Presentation, transferSynta
 		})
 	}
 
-	if !c.IsBinded() && len(ret) > 0 {
+	if !c.IsBinded() && len(ret) > 0 && c.settings.BindFeatures != 0 {
 		ret = append(ret, &Context{
 			AbstractSyntax:   ret[len(ret)-1].AbstractSyntax,
-			TransferSyntaxes: []*SyntaxID{BindFeatureSyntaxV1_0},
+			TransferSyntaxes: []*SyntaxID{NewBindFeatureSyntaxV1_0(c.settings.BindFeatures)},
 		})
 	}
 
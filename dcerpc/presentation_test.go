@@ -0,0 +1,74 @@
+package dcerpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// offsetSyntax is the abstract syntax of the echo interface that adds the
+// offset to the value.
+var offsetSyntax = &dcerpc.SyntaxID{IfUUID: uuid.New(0x12345678, 0x1234, 0x1234, 0x12, 0x34, [6]byte{1, 2, 3, 4, 5, 6}), IfVersionMajor: 1}
+
+func TestAlterContextPresentation(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	handle := func(offset uint32) dcerpc.ServerHandle {
+		return func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+			op := &echoOp{}
+			if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+				return nil, err
+			}
+			op.Value += offset
+			return op, nil
+		}
+	}
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, handle(0))
+	srv.Register(offsetSyntax, handle(1000))
+
+	go srv.Serve(ln)
+
+	dialer := &countingDialer{Dialer: ln}
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(dialer))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	echo, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	// the presentation context is added with alter_context.
+	offset, err := echo.Bind(ctx, dcerpc.WithAbstractSyntax(offsetSyntax))
+	if err != nil {
+		t.Fatalf("alter context: %v", err)
+	}
+
+	for i, cc := range []dcerpc.Conn{echo, offset, echo, offset} {
+		op := &echoOp{Value: uint32(i)}
+		if err := cc.Invoke(ctx, op); err != nil || op.Reply != uint32(i+1000*(i%2)) {
+			t.Fatalf("invoke %d: %v (reply %d)", i, err, op.Reply)
+		}
+	}
+
+	// the negotiated presentation context is reused.
+	if again, err := offset.Bind(ctx, dcerpc.WithAbstractSyntax(offsetSyntax)); err != nil || again != offset {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if n := dialer.n.Load(); n != 1 {
+		t.Fatalf("expected single connection, got %d", n)
+	}
+}
@@ -0,0 +1,182 @@
+package dcerpc
+
+// proxy.go contains the SOCKS5 and HTTP CONNECT proxy dialers.
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// WithProxy option sets the proxy that is used to tunnel all TCP connections
+// (ncacn_ip_tcp, ncacn_np, ncacn_http). The proxy URL format is:
+//
+//	socks5://[user:password@]host:port   - SOCKS5 proxy.
+//	http://[user:password@]host:port     - HTTP CONNECT proxy.
+//	https://[user:password@]host:port    - HTTP CONNECT proxy over TLS.
+//
+// When proxy is set, the server name is not resolved locally, but passed
+// to the proxy as-is. The option can be combined with WithDialer to reach
+// the proxy itself (proxy chaining).
+//
+// Note, that the endpoint mapper connection options must include the proxy
+// option too:
+//
+//	opts := []dcerpc.Option{dcerpc.WithProxy("socks5://127.0.0.1:1080")}
+//
+//	conn, err := dcerpc.Dial(ctx, "dc01.contoso.net", append(opts, epm.EndpointMapper(ctx, "dc01.contoso.net", opts...))...)
+func WithProxy(proxyURL string) ConnectOption {
+	return func(o *Transport) { o.Proxy = proxyURL }
+}
+
+// NewProxyDialer function returns the dialer that connects to the target
+// through the proxy `proxyURL` (see WithProxy). The `forward` dialer is used
+// to connect to the proxy. (default net.Dialer is used if nil).
+func NewProxyDialer(proxyURL string, forward Dialer) (Dialer, error) {
+
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: invalid url: %w", err)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy: invalid url: host is missing")
+	}
+
+	if forward == nil {
+		forward = &net.Dialer{}
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "socks5", "socks5h":
+
+		var auth *proxy.Auth
+		if u.User != nil {
+			auth = &proxy.Auth{User: u.User.Username()}
+			auth.Password, _ = u.User.Password()
+		}
+
+		d, err := proxy.SOCKS5("tcp", hostPort(u, "1080"), auth, forwardDialer{forward})
+		if err != nil {
+			return nil, fmt.Errorf("proxy: socks5: %w", err)
+		}
+
+		return d.(Dialer), nil
+
+	case "http", "https":
+
+		return &httpProxyDialer{
+			proxy:   u,
+			addr:    hostPort(u, map[string]string{"http": "8080", "https": "443"}[strings.ToLower(u.Scheme)]),
+			forward: forward,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("proxy: unsupported scheme %q", u.Scheme)
+}
+
+// hostPort function returns the proxy address with the default port.
+func hostPort(u *url.URL, port string) string {
+	if u.Port() != "" {
+		port = u.Port()
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// forwardDialer adapts the Dialer to the proxy.Dialer and
+// proxy.ContextDialer interfaces.
+type forwardDialer struct {
+	Dialer
+}
+
+func (d forwardDialer) Dial(network, addr string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, addr)
+}
+
+// httpProxyDialer is the HTTP CONNECT proxy dialer.
+type httpProxyDialer struct {
+	proxy   *url.URL
+	addr    string
+	forward Dialer
+}
+
+// DialContext function establishes the tunnel to the `addr` through the
+// HTTP proxy.
+func (d *httpProxyDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+
+	if network != "tcp" && network != "tcp4" && network != "tcp6" {
+		return nil, fmt.Errorf("proxy: http: network %q is not supported", network)
+	}
+
+	conn, err := d.forward.DialContext(ctx, "tcp", d.addr)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: http: %w", err)
+	}
+
+	if strings.EqualFold(d.proxy.Scheme, "https") {
+		conn = tls.Client(conn, &tls.Config{ServerName: d.proxy.Hostname()})
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+
+	if d.proxy.User != nil {
+		password, _ := d.proxy.User.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(d.proxy.User.Username()+":"+password)))
+	}
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("proxy: http: write connect: %w", err)
+	}
+
+	br := bufio.NewReader(conn)
+
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("proxy: http: read connect: %w", err)
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy: http: connect %s: %s", addr, resp.Status)
+	}
+
+	if br.Buffered() > 0 {
+		// the data sent by the server before the first request.
+		return &bufferedProxyConn{Conn: conn, r: br}, nil
+	}
+
+	return conn, nil
+}
+
+// bufferedProxyConn is the connection with the buffered data.
+type bufferedProxyConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedProxyConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
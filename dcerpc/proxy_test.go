@@ -0,0 +1,96 @@
+package dcerpc
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+)
+
+func TestHTTPProxyDialer(t *testing.T) {
+
+	// echo server.
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer target.Close()
+
+	go func() {
+		for {
+			c, err := target.Accept()
+			if err != nil {
+				return
+			}
+			go func() { io.Copy(c, c); c.Close() }()
+		}
+	}()
+
+	// http connect proxy.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+
+		req, err := http.ReadRequest(bufio.NewReader(c))
+		if err != nil || req.Method != http.MethodConnect {
+			return
+		}
+
+		// base64("user:pass").
+		if req.Header.Get("Proxy-Authorization") != "Basic dXNlcjpwYXNz" {
+			io.WriteString(c, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+			return
+		}
+
+		up, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			io.WriteString(c, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+			return
+		}
+		defer up.Close()
+
+		io.WriteString(c, "HTTP/1.1 200 Connection Established\r\n\r\n")
+
+		go io.Copy(up, c)
+		io.Copy(c, up)
+	}()
+
+	d, err := NewProxyDialer("http://user:pass@"+ln.Addr().String(), nil)
+	if err != nil {
+		t.Fatalf("new proxy dialer: %v", err)
+	}
+
+	conn, err := d.DialContext(context.Background(), "tcp", target.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := io.WriteString(conn, "ping"); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	b := make([]byte, 4)
+	if _, err := io.ReadFull(conn, b); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	if string(b) != "ping" {
+		t.Fatalf("unexpected echo: %q", b)
+	}
+
+	if _, err := NewProxyDialer("ftp://127.0.0.1", nil); err == nil {
+		t.Fatalf("expected unsupported scheme error")
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !purego
+
+package dcerpc
+
+// PureGo is `true` if the library is built with the `purego` build tag.
+const PureGo = false
@@ -0,0 +1,95 @@
+package dcerpc
+
+// rate_limit.go contains the client-side call rate limiting.
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit is the call rate limit (the token bucket).
+type RateLimit struct {
+	// The maximum average number of the calls per second (no limit if zero).
+	CallsPerSecond float64 `json:"calls_per_second,omitempty" yaml:"calls_per_second,omitempty"`
+	// The maximum number of the calls that can be made at once (1 if zero).
+	Burst int `json:"burst,omitempty" yaml:"burst,omitempty"`
+}
+
+// WithRateLimit option limits the rate of the calls with the interceptor (see
+// RateLimitInterceptor). When passed to the Dial function, the limit is shared
+// by all clients bound on the connection:
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithRateLimit(&dcerpc.RateLimit{CallsPerSecond: 10, Burst: 5}))
+func WithRateLimit(l *RateLimit) BindOption {
+	return WithUnaryInterceptor(RateLimitInterceptor(l))
+}
+
+// RateLimitInterceptor function returns the interceptor that delays the calls
+// exceeding the rate limit `l` (or nil if the limit is not set). The call is
+// failed with the context error if the context is done before the call is
+// permitted.
+func RateLimitInterceptor(l *RateLimit) UnaryInterceptor {
+
+	if l == nil || l.CallsPerSecond <= 0 {
+		return nil
+	}
+
+	b := &tokenBucket{rate: l.CallsPerSecond, burst: float64(max(l.Burst, 1))}
+	b.tokens = b.burst
+
+	return func(ctx context.Context, info *CallInfo, op Operation, opts []CallOption, invoker Invoker) error {
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+		return invoker(ctx, op, opts...)
+	}
+}
+
+// tokenBucket is the token bucket rate limiter.
+type tokenBucket struct {
+	mu sync.Mutex
+	// The number of the tokens added per second.
+	rate float64
+	// The bucket size.
+	burst float64
+	// The number of the available tokens (negative if reserved).
+	tokens float64
+	// The time the tokens were last updated.
+	last time.Time
+}
+
+// wait function reserves the token and waits until the token is available.
+func (b *tokenBucket) wait(ctx context.Context) error {
+
+	b.mu.Lock()
+
+	now := time.Now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	delay := time.Duration(-b.tokens / b.rate * float64(time.Second))
+
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// return the reserved token.
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
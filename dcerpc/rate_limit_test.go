@@ -0,0 +1,39 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+func TestRateLimit(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t, dcerpc.WithRateLimit(&dcerpc.RateLimit{CallsPerSecond: 20, Burst: 2}))
+
+	start := time.Now()
+
+	// the burst is permitted at once, then the calls are delayed by 50ms.
+	for i := 0; i < 4; i++ {
+		if err := cc.Invoke(ctx, &echoOp{Value: 2}); err != nil {
+			t.Fatalf("invoke: %v", err)
+		}
+	}
+
+	if d := time.Since(start); d < 90*time.Millisecond {
+		t.Fatalf("calls are not delayed: %v", d)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	cc.Invoke(ctx, &echoOp{Value: 2})
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 2}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
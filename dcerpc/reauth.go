@@ -0,0 +1,127 @@
+package dcerpc
+
+// reauth.go contains the in-place security context re-establishment after
+// the security context expires or is invalidated by the server.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/errors"
+)
+
+// The status codes that report the expired or invalidated security context.
+const (
+	// RPC_S_SEC_PKG_ERROR.
+	StatusSecPkgError uint32 = 0x00000721
+	// SEC_E_CONTEXT_EXPIRED.
+	StatusContextExpired uint32 = 0x80090317
+	// nca_invalid_checksum (the server cannot verify the message with the
+	// security context).
+	StatusNCAInvalidChecksum uint32 = 0x1C00001F
+)
+
+// DefaultReauthCodes is the default set of the status codes that trigger
+// the security context re-establishment.
+var DefaultReauthCodes = []uint32{
+	StatusSecPkgError,
+	StatusContextExpired,
+	StatusNCAInvalidChecksum,
+}
+
+// ReauthConn interface implements the in-place security context
+// re-establishment.
+type ReauthConn interface {
+	// Conn.
+	Conn
+	// Reauthenticate function establishes the new security context for
+	// the presentation contexts of the connection with alter_context on
+	// the same transport (the fresh authentication handshake, with the
+	// same options the connection was bound with), and replaces the
+	// security context of the connection.
+	Reauthenticate(context.Context) error
+}
+
+// WithReauthentication option enables the in-place security context
+// re-establishment: when the call fails with the fault from the status
+// code set (DefaultReauthCodes if empty), the security context is
+// re-established with alter_context (see ReauthConn), and the call is
+// retried once on the same connection, so that the bound presentation
+// contexts are kept:
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithReauthentication())
+//
+// The failed re-establishment is reported with the OnRenewalFailure function
+// (see WithTicketRenewal), and the original fault is returned.
+func WithReauthentication(codes ...uint32) ConnectOption {
+	return func(o *Transport) {
+		o.Reauthenticate, o.ReauthCodes = true, codes
+	}
+}
+
+// Reauthenticate function re-establishes the security context of the
+// connection.
+func (c *clientConn) Reauthenticate(ctx context.Context) error {
+
+	c.mu.RLock()
+	sec := c.security
+	c.mu.RUnlock()
+
+	if !sec.Established() || sec.Level == AuthLevelNone || sec.Type == AuthTypeNone {
+		return fmt.Errorf("reauthenticate: %w", ErrRenewalNotSupported)
+	}
+
+	if err := c.renewSecurity(ctx, sec); err != nil {
+		return fmt.Errorf("reauthenticate: %w", err)
+	}
+
+	return nil
+}
+
+// reauthenticate function re-establishes the security context if the call
+// error `err` reports the expired or invalidated security context, and
+// returns `true` if the call must be retried.
+func (c *clientConn) reauthenticate(ctx context.Context, err error) bool {
+
+	c.mu.RLock()
+	tr := c.transport
+	c.mu.RUnlock()
+
+	if !tr.settings.Reauthenticate || !reauthRequired(tr.settings.ReauthCodes, err) {
+		return false
+	}
+
+	if err := c.Reauthenticate(ctx); err != nil {
+		c.logger.Warn().Err(err).Msg("security context re-establishment failed")
+		if fn := tr.settings.OnRenewalFailure; fn != nil {
+			fn(ctx, c, err)
+		}
+		return false
+	}
+
+	c.logger.Debug().Msg("security context re-established")
+
+	return true
+}
+
+// reauthRequired function returns `true` if the error status code is in
+// the set `codes` (DefaultReauthCodes if empty).
+func reauthRequired(codes []uint32, err error) bool {
+
+	code, ok := errors.Code(err)
+	if !ok {
+		return false
+	}
+
+	if len(codes) == 0 {
+		codes = DefaultReauthCodes
+	}
+
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
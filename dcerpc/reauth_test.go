@@ -0,0 +1,64 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+)
+
+func TestReauthentication(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("NotSupported", func(t *testing.T) {
+
+		var failed error
+		onFailure := func(ctx context.Context, cc dcerpc.Conn, err error) { failed = err }
+
+		cc, calls := testBusyServer(t, 1, rpcerrors.InvalidChecksum,
+			dcerpc.WithReauthentication(), dcerpc.WithTicketRenewal(0, onFailure))
+
+		// the insecure connection cannot be re-authenticated, the original
+		// fault is returned.
+		if err := cc.Invoke(ctx, &echoOp{Value: 7}); !errors.Is(err, rpcerrors.InvalidChecksum) {
+			t.Fatalf("invoke: unexpected error: %v", err)
+		}
+
+		if !errors.Is(failed, dcerpc.ErrRenewalNotSupported) {
+			t.Fatalf("failure callback: unexpected error: %v", failed)
+		}
+
+		if n := calls.Load(); n != 1 {
+			t.Fatalf("calls: got %d, want 1", n)
+		}
+
+		rc, ok := cc.(dcerpc.ReauthConn)
+		if !ok {
+			t.Fatalf("connection does not implement ReauthConn")
+		}
+
+		if err := rc.Reauthenticate(ctx); !errors.Is(err, dcerpc.ErrRenewalNotSupported) {
+			t.Fatalf("reauthenticate: unexpected error: %v", err)
+		}
+	})
+
+	t.Run("OtherCode", func(t *testing.T) {
+
+		var failed error
+		onFailure := func(ctx context.Context, cc dcerpc.Conn, err error) { failed = err }
+
+		cc, _ := testBusyServer(t, 1, rpcerrors.ServerTooBusy,
+			dcerpc.WithReauthentication(), dcerpc.WithTicketRenewal(0, onFailure))
+
+		if err := cc.Invoke(ctx, &echoOp{Value: 7}); !errors.Is(err, rpcerrors.ServerTooBusy) {
+			t.Fatalf("invoke: unexpected error: %v", err)
+		}
+
+		if failed != nil {
+			t.Fatalf("unexpected re-authentication: %v", failed)
+		}
+	})
+}
@@ -0,0 +1,171 @@
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrReconnected is returned (along with the original error) for the call
+// that failed because the connection was broken, once the connection is
+// re-established. The call is not replayed, since the context handles
+// the call may refer to are not valid for the new connection.
+var ErrReconnected = errors.New("connection was re-established")
+
+// ReconnectFunc is the function called after the connection is
+// re-established. The connection `cc` is the re-bound client connection,
+// the function must re-acquire the context handles (and resume handles)
+// obtained with the broken connection. The error returned by the function
+// is returned to the caller of the failed call.
+type ReconnectFunc func(ctx context.Context, cc Conn) error
+
+// WithReconnect option enables the automatic reconnect: when the connection
+// is broken, the client connection re-dials the same binding, re-binds
+// the presentation contexts with the same options (that includes the
+// authentication), and calls the function `fn` (if set):
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithReconnect(3, func(ctx context.Context, cc dcerpc.Conn) error {
+//		// re-open the service control manager handle.
+//		return reopen(ctx)
+//	}))
+//
+// The failed call returns the error that wraps ErrReconnected.
+func WithReconnect(attempts int, fn ReconnectFunc) ConnectOption {
+	return func(o *Transport) {
+		o.ReconnectAttempts, o.OnReconnect = attempts, fn
+	}
+}
+
+// The delay between the reconnect attempts.
+var reconnectBackoff = 100 * time.Millisecond
+
+// reconnect function re-establishes the broken transport `tr` and returns
+// the error for the failed call `cause`.
+func (c *clientConn) reconnect(ctx context.Context, tr *transport, cause error) error {
+
+	if tr.settings.ReconnectAttempts <= 0 || tr.conn == nil || tr.HasErr() == nil {
+		// not enabled or not a transport error.
+		return cause
+	}
+
+	if c.closing.Load() {
+		// the call was aborted by Close.
+		return cause
+	}
+
+	c.mu.Lock()
+
+	if c.isClosed() {
+		c.mu.Unlock()
+		return cause
+	}
+
+	if c.transport != tr {
+		// re-established by the concurrent call.
+		c.mu.Unlock()
+		return fmt.Errorf("%w: %w", ErrReconnected, cause)
+	}
+
+	var err error
+
+	for i := 0; i < tr.settings.ReconnectAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				c.mu.Unlock()
+				return fmt.Errorf("%w: reconnect: %w", cause, ctx.Err())
+			case <-time.After(time.Duration(i) * reconnectBackoff):
+			}
+		}
+		if err = c.rebind(ctx); err == nil {
+			break
+		}
+		c.logger.Debug().Err(err).Int("attempt", i+1).Msg("reconnect")
+	}
+
+	c.mu.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("%w: reconnect: %w", cause, err)
+	}
+
+	// release the broken transport.
+	if err := tr.Close(ctx); err != nil {
+		c.logger.Debug().Err(err).Msg("close broken transport")
+	}
+
+	if fn := tr.settings.OnReconnect; fn != nil {
+		if err := fn(ctx, c); err != nil {
+			return fmt.Errorf("%w: %w: reconnect callback: %w", ErrReconnected, cause, err)
+		}
+	}
+
+	return fmt.Errorf("%w: %w", ErrReconnected, cause)
+}
+
+// rebind function dials the new transport for the binding and binds the
+// connection (and the sub-connections) with the saved options. Must be
+// called with the connection lock held.
+func (c *clientConn) rebind(ctx context.Context) error {
+
+	old := c.transport
+
+	if !old.conn.group.hasActiveTransport(old) {
+		// the association group is released by the server with the
+		// last connection (of all connections that joined the group).
+		old.conn.group.reset()
+	}
+
+	tr, err := old.conn.redial(ctx, old.binding)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+
+	cc, err := tr.Bind(ctx, c.opts...)
+	if err != nil {
+		tr.Close(ctx)
+		return fmt.Errorf("bind: %w", err)
+	}
+
+	new := cc.(*clientConn)
+
+	if len(new.subs) != len(c.subs) {
+		tr.Close(ctx)
+		return fmt.Errorf("bind: presentation context mismatch")
+	}
+
+	for i, sub := range c.subs {
+		sub.transport = new.subs[i].transport
+		sub.security = new.subs[i].security
+		sub.presentation = new.subs[i].presentation
+		sub.verify = new.subs[i].verify
+		sub.bufferSize = new.subs[i].bufferSize
+		// the alternate credentials connections are re-established
+		// on demand.
+		sub.altMu.Lock()
+		sub.alts = nil
+		sub.altMu.Unlock()
+		sub.resetObjectSecurity()
+	}
+
+	return nil
+}
+
+// hasActiveTransport function returns `true` if the transport set contains
+// the active transport other than `except`.
+func (t *conn) hasActiveTransport(except *transport) bool {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, transports := range t.transports {
+		for _, tr := range transports {
+			if tr != except && tr.IsBinded() && tr.HasErr() == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,91 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	srvsvc "github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+)
+
+// dropDialer records the established connections to drop them.
+type dropDialer struct {
+	dcerpc.Dialer
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (d *dropDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.Dialer.DialContext(ctx, network, address)
+	if err == nil {
+		d.mu.Lock()
+		d.conns = append(d.conns, conn)
+		d.mu.Unlock()
+	}
+	return conn, err
+}
+
+func (d *dropDialer) drop() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, conn := range d.conns {
+		conn.Close()
+	}
+	return len(d.conns)
+}
+
+func TestReconnect(t *testing.T) {
+
+	ctx := context.Background()
+
+	srv := dcerpc.NewServer()
+	srv.Register(srvsvc.SrvsvcSyntaxV3_0, srvsvc.NewSrvsvcServerHandle(&todServer{}))
+
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+
+	go srv.Serve(ln)
+
+	dialer, reconnected := &dropDialer{Dialer: ln}, 0
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(dialer),
+		dcerpc.WithReconnect(2, func(ctx context.Context, cc dcerpc.Conn) error {
+			reconnected++
+			return nil
+		}))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	cli, err := srvsvc.NewSrvsvcClient(ctx, conn, dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if _, err := cli.RemoteToD(ctx, &srvsvc.RemoteToDRequest{ServerName: "localhost"}); err != nil {
+		t.Fatalf("remote tod: %v", err)
+	}
+
+	dialer.drop()
+
+	// the call fails, the connection is re-established.
+	if _, err := cli.RemoteToD(ctx, &srvsvc.RemoteToDRequest{ServerName: "localhost"}); !errors.Is(err, dcerpc.ErrReconnected) {
+		t.Fatalf("expected reconnected error, got %v", err)
+	}
+
+	if reconnected != 1 {
+		t.Fatalf("expected single reconnect callback, got %d", reconnected)
+	}
+
+	if _, err := cli.RemoteToD(ctx, &srvsvc.RemoteToDRequest{ServerName: "localhost"}); err != nil {
+		t.Fatalf("remote tod after reconnect: %v", err)
+	}
+
+	if n := dialer.drop(); n != 2 {
+		t.Fatalf("expected two connections, got %d", n)
+	}
+}
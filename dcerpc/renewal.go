@@ -0,0 +1,137 @@
+package dcerpc
+
+// renewal.go contains the automatic security context renewal for the
+// long-lived connections.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// ErrRenewalNotSupported is returned when the security context cannot be
+// renewed, since the connection was not bound with the security options.
+var ErrRenewalNotSupported = errors.New("security context renewal is not supported for the connection")
+
+// RenewalFailureFunc is the function called when the security context of
+// the connection `cc` cannot be renewed. The connection keeps using the
+// current security context, the renewal is retried with the later calls.
+type RenewalFailureFunc func(ctx context.Context, cc Conn, err error)
+
+// WithTicketRenewal option enables the automatic security context renewal:
+// when the security context expires within `before` (for Kerberos, the
+// service ticket end time), the call first obtains the new tickets and
+// re-establishes the security context with alter_context on the same
+// transport, using the same options the connection was bound with. If the
+// renewal fails, the function `fn` (if set) is called:
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithTicketRenewal(10*time.Minute, func(ctx context.Context, cc dcerpc.Conn, err error) {
+//		log.Printf("ticket renewal failed: %v", err)
+//	}))
+//
+// The expiration time of the security context is reported with ConnInfo.
+func WithTicketRenewal(before time.Duration, fn RenewalFailureFunc) ConnectOption {
+	return func(o *Transport) {
+		o.RenewBefore, o.OnRenewalFailure = before, fn
+	}
+}
+
+// The delay before the failed renewal is retried.
+var renewalRetryInterval = time.Minute
+
+// needsRenewal function returns `true` if the established security context
+// expires within `before`.
+func (cc *Security) needsRenewal(before time.Duration) bool {
+
+	if cc == nil || before <= 0 {
+		return false
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if !cc.established || time.Now().Before(cc.renewAfter) {
+		return false
+	}
+
+	expiry, ok := getAttribute[time.Time](cc, gssapi.AttributeExpiry)
+
+	return ok && !expiry.IsZero() && time.Until(expiry) < before
+}
+
+// renew function renews the security context of the connection if it is
+// about to expire.
+func (c *clientConn) renew(ctx context.Context) {
+
+	c.mu.RLock()
+	tr, sec := c.transport, c.security
+	c.mu.RUnlock()
+
+	if !sec.needsRenewal(tr.settings.RenewBefore) {
+		return
+	}
+
+	err := c.renewSecurity(ctx, sec)
+	if err == nil {
+		c.logger.Debug().Msg("security context renewed")
+		return
+	}
+
+	sec.mu.Lock()
+	sec.renewAfter = time.Now().Add(renewalRetryInterval)
+	sec.mu.Unlock()
+
+	c.logger.Warn().Err(err).Msg("security context renewal failed")
+
+	if fn := tr.settings.OnRenewalFailure; fn != nil {
+		fn(ctx, c, err)
+	}
+}
+
+// renewSecurity function establishes the new security context `sec` for the
+// presentation contexts of the connection with alter_context and replaces the
+// security context `sec` of the connection (and the sub-connections).
+func (c *clientConn) renewSecurity(ctx context.Context, sec *Security) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.isClosed() {
+		return fmt.Errorf("renew security context: %w", ErrConnClosed)
+	}
+
+	if c.security != sec {
+		// renewed by the concurrent call.
+		return nil
+	}
+
+	opts := securityOptions(c.opts)
+	if len(opts) == 0 {
+		return fmt.Errorf("renew security context: %w", ErrRenewalNotSupported)
+	}
+
+	for _, sub := range c.subs {
+		opts = append(opts, withPresentation(sub.presentation))
+	}
+
+	new, err := c.transport.AlterContext(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("renew security context: %w", err)
+	}
+
+	renewed := new.(*clientConn).security
+	if renewed == sec {
+		return fmt.Errorf("renew security context: %w", ErrRenewalNotSupported)
+	}
+
+	for _, sub := range c.subs {
+		if sub.security == sec {
+			sub.security = renewed
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package dcerpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+func TestNeedsRenewal(t *testing.T) {
+
+	ctx := gssapi.NewSecurityContext(context.Background())
+
+	sec := &Security{ctx: ctx, established: true, Type: AuthTypeKerberos, Level: AuthLevelPktPrivacy}
+
+	if sec.needsRenewal(time.Hour) {
+		t.Fatalf("renewal without expiry")
+	}
+
+	gssapi.SetAttribute(ctx, gssapi.AttributeExpiry, time.Now().Add(30*time.Minute))
+
+	if !sec.needsRenewal(time.Hour) {
+		t.Fatalf("renewal expected")
+	}
+
+	if sec.needsRenewal(10*time.Minute) || sec.needsRenewal(0) {
+		t.Fatalf("unexpected renewal")
+	}
+
+	if info := sec.Info(); info.Expiry.IsZero() {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+
+	sec.renewAfter = time.Now().Add(time.Minute)
+
+	if sec.needsRenewal(time.Hour) {
+		t.Fatalf("renewal before retry interval")
+	}
+
+	if (*Security)(nil).needsRenewal(time.Hour) {
+		t.Fatalf("renewal for empty security context")
+	}
+}
@@ -0,0 +1,153 @@
+package dcerpc
+
+// retry.go contains the retry policy for the transient faults.
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/errors"
+)
+
+// The transient status codes retried by default.
+const (
+	// RPC_S_SERVER_TOO_BUSY.
+	StatusServerTooBusy uint32 = 0x000006BB
+	// nca_server_too_busy.
+	StatusNCAServerTooBusy uint32 = 0x1C010014
+	// EPT_S_NOT_REGISTERED.
+	StatusEptNotRegistered uint32 = 0x000006D9
+	// ept_s_not_registered (DCE).
+	StatusDCEEptNotRegistered uint32 = 0x16C9A0D6
+)
+
+// DefaultRetryCodes is the default set of the retryable status codes.
+var DefaultRetryCodes = []uint32{
+	StatusServerTooBusy,
+	StatusNCAServerTooBusy,
+	StatusEptNotRegistered,
+	StatusDCEEptNotRegistered,
+}
+
+// RetryPolicy is the retry policy for the transient faults. The call is
+// retried when it fails with the fault (or the endpoint mapper returns the
+// status) from the retryable code set. The retryable faults are reported
+// by the server before the call is executed, so the calls are retried
+// regardless of the idempotency.
+type RetryPolicy struct {
+	// The maximum number of attempts (including the first one), the
+	// value less than 2 disables the retries.
+	MaxAttempts int `json:"max_attempts,omitempty" yaml:"max_attempts,omitempty"`
+	// The delay before the first retry (100ms if zero).
+	InitialBackoff time.Duration `json:"initial_backoff,omitempty" yaml:"initial_backoff,omitempty"`
+	// The maximum delay between the attempts (no limit if zero).
+	MaxBackoff time.Duration `json:"max_backoff,omitempty" yaml:"max_backoff,omitempty"`
+	// The backoff multiplier (2 if zero).
+	Multiplier float64 `json:"multiplier,omitempty" yaml:"multiplier,omitempty"`
+	// The jitter fraction (0..1), the delay is randomized within
+	// [delay*(1-jitter), delay].
+	Jitter float64 `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+	// The retryable status codes (DefaultRetryCodes if empty).
+	Codes []uint32 `json:"codes,omitempty" yaml:"codes,omitempty"`
+}
+
+// DefaultRetryPolicy function returns the policy with 3 attempts and the
+// exponential backoff starting at 100ms.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// WithRetryPolicy option sets the retry policy for the calls and for the
+// endpoint mapper lookups performed on bind:
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithRetryPolicy(dcerpc.DefaultRetryPolicy()))
+//
+// The retries are performed below the interceptors (see WithUnaryInterceptor),
+// the interceptors observe the single invocation.
+func WithRetryPolicy(p *RetryPolicy) ConnectOption {
+	return func(o *Transport) { o.RetryPolicy = p }
+}
+
+// Retryable function returns `true` if the error is the transient fault.
+func (p *RetryPolicy) Retryable(err error) bool {
+
+	if p == nil || err == nil {
+		return false
+	}
+
+	code, ok := errors.Code(err)
+	if !ok {
+		return false
+	}
+
+	codes := p.Codes
+	if len(codes) == 0 {
+		codes = DefaultRetryCodes
+	}
+
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Backoff function returns the delay before the attempt `n` (starting from 1
+// for the first retry).
+func (p *RetryPolicy) Backoff(n int) time.Duration {
+
+	delay, mul := p.InitialBackoff, p.Multiplier
+	if delay <= 0 {
+		delay = 100 * time.Millisecond
+	}
+	if mul < 1 {
+		mul = 2
+	}
+
+	for i := 1; i < n; i++ {
+		if delay = time.Duration(float64(delay) * mul); p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		delay -= time.Duration(rand.Float64() * jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// retry function calls `fn` until it succeeds, fails with the non-retryable
+// error, the attempts are exhausted, or the context is done.
+func (p *RetryPolicy) retry(ctx context.Context, fn func() error) error {
+
+	err := fn()
+
+	if p == nil {
+		return err
+	}
+
+	for n := 1; n < p.MaxAttempts && p.Retryable(err); n++ {
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(p.Backoff(n)):
+		}
+		err = fn()
+	}
+
+	return err
+}
@@ -0,0 +1,137 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// testBusyServer function starts the server that fails the first `busy`
+// calls with the fault `fault`.
+func testBusyServer(t *testing.T, busy int32, fault error, opts ...dcerpc.Option) (dcerpc.Conn, *atomic.Int32) {
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	calls := new(atomic.Int32)
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		if calls.Add(1) <= busy {
+			return nil, fault
+		}
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	ctx := context.Background()
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", append(opts, dcerpc.WithDialer(ln))...)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	return cc, calls
+}
+
+func TestRetryPolicy(t *testing.T) {
+
+	ctx := context.Background()
+
+	policy := &dcerpc.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	t.Run("Retried", func(t *testing.T) {
+
+		var intercepted int
+		count := func(ctx context.Context, info *dcerpc.CallInfo, op dcerpc.Operation, opts []dcerpc.CallOption, invoker dcerpc.Invoker) error {
+			intercepted++
+			return invoker(ctx, op, opts...)
+		}
+
+		cc, calls := testBusyServer(t, 2, rpcerrors.ServerTooBusy, dcerpc.WithRetryPolicy(policy), dcerpc.WithUnaryInterceptor(count))
+
+		op := &echoOp{Value: 7}
+		if err := cc.Invoke(ctx, op); err != nil || op.Reply != 7 {
+			t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+		}
+
+		if n := calls.Load(); n != 3 {
+			t.Fatalf("calls: got %d, want 3", n)
+		}
+
+		// the interceptors observe the single invocation.
+		if intercepted != 1 {
+			t.Fatalf("intercepted: got %d, want 1", intercepted)
+		}
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+
+		cc, calls := testBusyServer(t, 5, rpcerrors.ServerTooBusy, dcerpc.WithRetryPolicy(policy))
+
+		if err := cc.Invoke(ctx, &echoOp{Value: 7}); !errors.Is(err, rpcerrors.ServerTooBusy) {
+			t.Fatalf("invoke: got %v, want %v", err, rpcerrors.ServerTooBusy)
+		}
+
+		if n := calls.Load(); n != 3 {
+			t.Fatalf("calls: got %d, want 3", n)
+		}
+	})
+
+	t.Run("NotRetryable", func(t *testing.T) {
+
+		cc, calls := testBusyServer(t, 1, rpcerrors.UnsupportedType, dcerpc.WithRetryPolicy(policy))
+
+		if err := cc.Invoke(ctx, &echoOp{Value: 7}); !errors.Is(err, rpcerrors.UnsupportedType) {
+			t.Fatalf("invoke: got %v, want %v", err, rpcerrors.UnsupportedType)
+		}
+
+		if n := calls.Load(); n != 1 {
+			t.Fatalf("calls: got %d, want 1", n)
+		}
+	})
+
+	t.Run("Win32", func(t *testing.T) {
+
+		// RPC_S_SERVER_TOO_BUSY reported with the win32 status code.
+		busy := &rpcerrors.RPCError{Code: dcerpc.StatusServerTooBusy, Name: "RPC_S_SERVER_TOO_BUSY"}
+
+		cc, calls := testBusyServer(t, 1, busy, dcerpc.WithRetryPolicy(policy))
+
+		if err := cc.Invoke(ctx, &echoOp{Value: 7}); err != nil {
+			t.Fatalf("invoke: %v", err)
+		}
+
+		if n := calls.Load(); n != 2 {
+			t.Fatalf("calls: got %d, want 2", n)
+		}
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+
+	p := &dcerpc.RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+
+	for n, want := range []time.Duration{10, 20, 40, 50, 50} {
+		if got := p.Backoff(n + 1); got != want*time.Millisecond {
+			t.Fatalf("backoff %d: got %v, want %v", n+1, got, want*time.Millisecond)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/oiweiwei/go-msrpc/ndr"
 	"github.com/oiweiwei/go-msrpc/ssp"
@@ -96,6 +97,10 @@ const (
 	AuthTypeGSSChannel AuthType = 0x0E // 14
 	// Use the Microsoft Kerberos SSP.
 	AuthTypeKerberos AuthType = 0x10 // 16
+	// Use the CredSSP (TSSSP) SSP. This SSP authenticates the client
+	// with the Negotiate SSP over the TLS channel and delegates the
+	// client credentials to the server.
+	AuthTypeCredSSP AuthType = 0x16 // 22
 	// The Netlogon Secure Channel.
 	AuthTypeNetLogon AuthType = 0x44 // 68
 	// Use the default authentication service.
@@ -131,7 +136,7 @@ func (st *SecurityTrailer) WriteTo(ctx context.Context, w ndr.Writer) error {
 
 func (v AuthType) Legs() int {
 	switch v {
-	case AuthTypeNone, AuthTypeGSSNegotiate, AuthTypeGSSChannel:
+	case AuthTypeNone, AuthTypeGSSNegotiate, AuthTypeGSSChannel, AuthTypeCredSSP:
 		return LegsEven
 	case AuthTypeWinNT, AuthTypeKerberos, AuthTypeNetLogon:
 		return LegsOdd
@@ -195,11 +200,25 @@ type Security struct {
 	// The flag that indicates whether the header signing is
 	// supported.
 	SignHeader bool
+	// The flag that indicates whether the header signing is
+	// required (see RequireHeaderSign).
+	RequireHeaderSign bool
 	// The flag that indicates whether the security context
 	// multiplexing is supported.
 	Multiplexing bool
+	// The flag that indicates whether the mutual authentication
+	// is required (see RequireMutualAuthn).
+	RequireMutualAuthn bool
 	// The target name.
 	TargetName string
+	// The channel bindings (derived from the transport, see
+	// WithChannelBindings).
+	ChannelBindings gssapi.ChannelBindings
+	// The client authentication policy (see WithAuthnPolicy).
+	AuthnPolicy *AuthnPolicy
+	// The time until the failed security context renewal is not
+	// retried.
+	renewAfter time.Time
 }
 
 // ID returns the security context identifier.
@@ -224,6 +243,19 @@ func NewSecurity(ctx context.Context, opts ...gssapi.ContextOption) *Security {
 	}
 }
 
+// negotiateHeaderSign function saves the header signing negotiated with
+// the first bind_ack or alter_context_resp PDU for the security context.
+func (o *Security) negotiateHeaderSign(pkt *Packet) error {
+
+	o.SignHeader = o.RequestHeaderSign != 0 && pkt.Header.PacketFlags.IsSet(PacketFlagSupportHeaderSign)
+
+	if o.RequireHeaderSign && !o.SignHeader && o.Level >= AuthLevelPktIntegrity {
+		return ErrHeaderSignNotSupported
+	}
+
+	return nil
+}
+
 // MechanismToAuthType function converts the mechanism OID to the
 // DCE/RPC authentication type.
 func MechanismToAuthType(mech gssapi.OID) AuthType {
@@ -235,6 +267,8 @@ func MechanismToAuthType(mech gssapi.OID) AuthType {
 		return AuthTypeGSSNegotiate
 	case mech.Equal(ssp.MechanismTypeNetlogon):
 		return AuthTypeNetLogon
+	case mech.Equal(ssp.MechanismTypeCredSSP):
+		return AuthTypeCredSSP
 	case mech.Equal(ssp.MechanismTypeNTLM):
 		return AuthTypeWinNT
 	}
@@ -279,6 +313,10 @@ func (cc *Security) Init(ctx context.Context, b []byte) ([]byte, error) {
 		return []byte{}, nil
 	}
 
+	if err := cc.AuthnPolicy.verifyLevel(cc); err != nil {
+		return nil, fmt.Errorf("init security context: authentication policy: %w", err)
+	}
+
 	if cc.Level == AuthLevelNone || cc.Type == AuthTypeNone {
 		cc.established = true
 		return []byte{}, nil
@@ -290,6 +328,14 @@ func (cc *Security) Init(ctx context.Context, b []byte) ([]byte, error) {
 	}
 
 	if cc.established = gssapi.IsComplete(cc.ctx); cc.established {
+		if cc.RequireMutualAuthn && !cc.mutualAuthn() {
+			cc.established = false
+			return nil, fmt.Errorf("init security context: %w", ErrMutualAuthnNotPerformed)
+		}
+		if err := cc.AuthnPolicy.verify(cc); err != nil {
+			cc.established = false
+			return nil, fmt.Errorf("init security context: authentication policy: %w", err)
+		}
 		gssapi.SetAttribute(cc.ctx, gssapi.AttributeRPCContext, cc) // save established security context.
 	}
 
@@ -533,6 +579,10 @@ func (cc *Security) options(mask ...SecurityMask) []gssapi.Option {
 		opts = append(opts, gssapi.WithTargetName(cc.TargetName))
 	}
 
+	if cc.ChannelBindings != nil {
+		opts = append(opts, gssapi.WithChannelBindings(cc.ChannelBindings))
+	}
+
 	switch cc.Level {
 	case AuthLevelPktPrivacy:
 		if !optSkipSeal(mask) {
@@ -545,6 +595,10 @@ func (cc *Security) options(mask ...SecurityMask) []gssapi.Option {
 		}
 	}
 
+	if cc.RequireMutualAuthn {
+		opts = append(opts, gssapi.WithRequest(gssapi.MutualAuthn))
+	}
+
 	switch cc.Impersonation {
 	case ImpersonationLevelDelegate:
 		opts = append(opts, gssapi.WithRequest(gssapi.Delegation))
@@ -563,6 +617,8 @@ func (cc *Security) options(mask ...SecurityMask) []gssapi.Option {
 		opts = append(opts, gssapi.WithMechanismType(ssp.MechanismTypeSPNEGO))
 	case AuthTypeNetLogon:
 		opts = append(opts, gssapi.WithMechanismType(ssp.MechanismTypeNetlogon))
+	case AuthTypeCredSSP:
+		opts = append(opts, gssapi.WithMechanismType(ssp.MechanismTypeCredSSP))
 	case AuthTypeDefault:
 		opts = append(opts, gssapi.WithMechanismType(ssp.MechanismTypeDefault(cc.ctx)))
 	}
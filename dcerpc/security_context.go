@@ -0,0 +1,128 @@
+package dcerpc
+
+// security_context.go contains the security context multiplexing (several
+// security contexts on the single connection).
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	// The security context multiplexing was not negotiated for the connection.
+	ErrNoSecurityContextMultiplexing = errors.New("security context multiplexing is not negotiated")
+	// The security context was not established on the connection.
+	ErrSecurityContextNotBound = errors.New("security context is not established on the connection")
+)
+
+// SecurityContextConn interface implements the security context multiplexing.
+type SecurityContextConn interface {
+	// Conn.
+	Conn
+	// NewSecurityContext function establishes the additional security context
+	// for the presentation contexts of the connection. Unlike AlterContext, the
+	// security context of the connection is not replaced, and the new one is
+	// selected per call with WithSecurityContext option.
+	NewSecurityContext(context.Context, ...Option) (*Security, error)
+}
+
+// CallSecurityOption option selects the security context for the call.
+type CallSecurityOption struct {
+	// The security context that signs/seals the call PDUs.
+	Security *Security
+}
+
+// CallOption interface implementation.
+func (CallSecurityOption) is_rpcCallOption() {}
+
+// WithSecurityContext option selects the security context that signs/seals the
+// call PDUs. The security context must be established on the same connection
+// with NewSecurityContext method:
+//
+//	sc, ok := cc.(dcerpc.SecurityContextConn)
+//	if !ok {
+//		// not supported.
+//	}
+//
+//	admin, err := sc.NewSecurityContext(ctx, dcerpc.WithCredentials(adminCreds), dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	resp, err := cli.SetValue(ctx, req, dcerpc.WithSecurityContext(admin))
+//
+// The call fails with ErrSecurityContextNotBound if the security context was not
+// established on the connection (including the re-established connection, see
+// WithReconnect).
+func WithSecurityContext(sec *Security) CallSecurityOption {
+	return CallSecurityOption{Security: sec}
+}
+
+// HasCallSecurity function returns the security context selected for the call
+// if the set of call options contains one.
+func HasCallSecurity(opts []CallOption) (*Security, bool) {
+	for i := range opts {
+		if opt, ok := (any)(opts[i]).(CallSecurityOption); ok && opt.Security != nil {
+			return opt.Security, true
+		}
+	}
+	return nil, false
+}
+
+// NewSecurityContext function establishes the additional security context for
+// the presentation contexts of the connection.
+func (c *clientConn) NewSecurityContext(ctx context.Context, opts ...Option) (*Security, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.isClosed() {
+		return nil, fmt.Errorf("new security context: %w", ErrConnClosed)
+	}
+
+	if !c.transport.settings.SecurityContextMultiplexing {
+		return nil, fmt.Errorf("new security context: %w", ErrNoSecurityContextMultiplexing)
+	}
+
+	for _, sub := range c.subs {
+		opts = append(opts, withPresentation(sub.presentation))
+	}
+
+	new, err := c.transport.AlterContext(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("new security context: %w", err)
+	}
+
+	if sec := new.(*clientConn).security; sec != c.security {
+		return sec, nil
+	}
+
+	return nil, fmt.Errorf("new security context: the security options are not set")
+}
+
+// withSecurity function returns the client connection that uses the security
+// context `sec` for the call.
+func (c *clientConn) withSecurity(sec *Security) (*clientConn, error) {
+
+	if sec == c.security {
+		return c, nil
+	}
+
+	if !c.transport.hasSecurityContext(sec) {
+		return nil, ErrSecurityContextNotBound
+	}
+
+	return &clientConn{
+		mu:           c.mu,
+		presentation: c.presentation,
+		security:     sec,
+		verify:       c.verify,
+		transport:    c.transport,
+		bufferSize:   c.bufferSize,
+		subs:         c.subs,
+		closed:       c.closed,
+		logger:       c.logger,
+		opts:         c.opts,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+func TestSecurityContextMultiplexing(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t)
+
+	sec, err := cc.(dcerpc.SecurityContextConn).NewSecurityContext(ctx, dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("new security context: %v", err)
+	}
+
+	op := &echoOp{Value: 2}
+	if err := cc.Invoke(ctx, op, dcerpc.WithSecurityContext(sec)); err != nil || op.Reply != 2 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+
+	// the security context established on another connection.
+	other, _ := testEchoServer(t)
+
+	foreign, err := other.(dcerpc.SecurityContextConn).NewSecurityContext(ctx, dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("new security context: %v", err)
+	}
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 2}, dcerpc.WithSecurityContext(foreign)); !errors.Is(err, dcerpc.ErrSecurityContextNotBound) {
+		t.Fatalf("expected security context not bound, got %v", err)
+	}
+}
+
+func TestSecurityContextNotMultiplexed(t *testing.T) {
+
+	cc, _ := testEchoServer(t, dcerpc.WithBindFeatures(dcerpc.KeepConnOpenOnOrphaned))
+
+	_, err := cc.(dcerpc.SecurityContextConn).NewSecurityContext(context.Background(), dcerpc.WithInsecure())
+	if !errors.Is(err, dcerpc.ErrNoSecurityContextMultiplexing) {
+		t.Fatalf("expected no security context multiplexing, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+func TestNegotiateHeaderSign(t *testing.T) {
+
+	for _, testCase := range []struct {
+		Name     string
+		Opts     []SecurityOption
+		Level    AuthLevel
+		Flags    PacketFlag
+		Sign     bool
+		Required bool
+	}{
+		{"default", nil, AuthLevelPktIntegrity, PacketFlagSupportHeaderSign, true, false},
+		{"not supported", nil, AuthLevelPktIntegrity, 0, false, false},
+		{"no header sign", []SecurityOption{NoHeaderSign()}, AuthLevelPktPrivacy, PacketFlagSupportHeaderSign, false, false},
+		{"required", []SecurityOption{RequireHeaderSign()}, AuthLevelPktPrivacy, PacketFlagSupportHeaderSign, true, false},
+		{"required not supported", []SecurityOption{RequireHeaderSign()}, AuthLevelPktIntegrity, 0, false, true},
+		{"required connect level", []SecurityOption{RequireHeaderSign()}, AuthLevelConnect, 0, false, false},
+		{"required overridden", []SecurityOption{RequireHeaderSign(), NoHeaderSign()}, AuthLevelPktIntegrity, 0, false, false},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+
+			sec := &Security{RequestHeaderSign: PacketFlagSupportHeaderSign, Level: testCase.Level}
+			for _, opt := range testCase.Opts {
+				opt(sec)
+			}
+
+			err := sec.negotiateHeaderSign(&Packet{Header: Header{PacketFlags: PacketFlagFirstFrag | PacketFlagLastFrag | testCase.Flags}})
+			if errors.Is(err, ErrHeaderSignNotSupported) != testCase.Required {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if sec.SignHeader != testCase.Sign {
+				t.Fatalf("sign header: expected %v, got %v", testCase.Sign, sec.SignHeader)
+			}
+		})
+	}
+}
+
+func TestSecurityInfo(t *testing.T) {
+
+	ctx := gssapi.NewSecurityContext(context.Background())
+
+	sec := &Security{ctx: ctx, Type: AuthTypeKerberos, Level: AuthLevelPktPrivacy, TargetName: "host/dc01.contoso.net"}
+	RequireMutualAuthn()(sec)
+
+	if info := sec.Info(); info.MutualAuthn || info.ServerPrincipal != "" || info.TargetName != "host/dc01.contoso.net" {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+
+	gssapi.SetAttribute(ctx, gssapi.AttributeServerPrincipal, "host/dc01.contoso.net@CONTOSO.NET")
+	gssapi.SetAttribute(ctx, gssapi.AttributeMutualAuthn, true)
+
+	info := sec.Info()
+	if !info.MutualAuthn || info.ServerPrincipal != "host/dc01.contoso.net@CONTOSO.NET" || info.AuthType != AuthTypeKerberos {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+
+	if (*Security)(nil).Info().AuthLevel != 0 {
+		t.Fatalf("unexpected info for empty security context")
+	}
+}
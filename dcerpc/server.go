@@ -0,0 +1,584 @@
+package dcerpc
+
+// server.go contains the minimal connection-oriented server that dispatches
+// the requests to the generated server stubs. The server does not support
+// authentication and is intended for the integration tests. The request
+// verification trailer (SEC_VT) is verified before the dispatch.
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// Server is the unauthenticated connection-oriented RPC server.
+//
+//	srv := dcerpc.NewServer()
+//	srv.Register(srvsvc.SrvsvcSyntaxV3_0, srvsvc.NewSrvsvcServerHandle(impl))
+//
+//	ln := dcerpc.NewMemoryListener()
+//	defer ln.Close()
+//
+//	go srv.Serve(ln)
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	cli, err := srvsvc.NewSrvsvcClient(ctx, conn, dcerpc.WithInsecure())
+//
+// The server behaves as the Windows server selected with WithPersonality option.
+type Server struct {
+	mu       sync.RWMutex
+	handlers map[string]ServerHandle
+	groupID  atomic.Uint32
+	// the server personality profile.
+	personality *Personality
+}
+
+// NewServer function returns the new server.
+func NewServer(opts ...ServerOption) *Server {
+	s := &Server{handlers: make(map[string]ServerHandle)}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// profile function returns the server personality profile.
+func (s *Server) profile() *Personality {
+	if s.personality != nil {
+		return s.personality
+	}
+	return defaultPersonality
+}
+
+func syntaxKey(syntax *SyntaxID) string {
+	return fmt.Sprintf("%s/v%d.%d", syntax.IfUUID, syntax.IfVersionMajor, syntax.IfVersionMinor)
+}
+
+// Register function registers the server handle `h` for the abstract
+// syntax `syntax`.
+func (s *Server) Register(syntax *SyntaxID, h ServerHandle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[syntaxKey(syntax)] = h
+}
+
+func (s *Server) handler(syntax *SyntaxID) (ServerHandle, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	h, ok := s.handlers[syntaxKey(syntax)]
+	return h, ok
+}
+
+// Serve function accepts the connections on the listener `ln` and serves
+// each connection in the separate goroutine. The function returns when
+// the listener is closed.
+func (s *Server) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			s.ServeConn(context.Background(), conn)
+		}()
+	}
+}
+
+// ServeConn function serves the single connection until the connection
+// is closed or the context is cancelled.
+func (s *Server) ServeConn(ctx context.Context, cc RawConn) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if s.personality != nil {
+		ctx = context.WithValue(ctx, personalityKey{}, s.personality)
+	}
+
+	go func() {
+		<-ctx.Done()
+		cc.Close()
+	}()
+
+	conn := &serverConn{
+		srv:      s,
+		profile:  s.profile(),
+		cc:       cc,
+		maxFrag:  DefaultXmitSize,
+		contexts: make(map[uint16]ServerHandle),
+		syntaxes: make(map[uint16]*VerifyPresentation),
+		calls:    make(map[uint32]*serverCall),
+		cancels:  make(map[uint32]context.CancelFunc),
+	}
+
+	for {
+		if err := conn.serve(ctx); err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("server: %w", err)
+		}
+	}
+}
+
+// serverCall is the request being reassembled.
+type serverCall struct {
+	contextID uint16
+	opNum     uint16
+	object    *uuid.UUID
+	stub      bytes.Buffer
+}
+
+type objectUUIDKey struct{}
+
+// ObjectUUIDFromContext function returns the object UUID of the request
+// being dispatched by the server. The function returns `false` if the
+// request has no object UUID.
+func ObjectUUIDFromContext(ctx context.Context) (*uuid.UUID, bool) {
+	u, ok := ctx.Value(objectUUIDKey{}).(*uuid.UUID)
+	return u, ok
+}
+
+// serverConn is the server connection state.
+type serverConn struct {
+	srv     *Server
+	profile *Personality
+	cc      RawConn
+	maxFrag int
+	groupID uint32
+	// the requests are dispatched concurrently if the concurrent
+	// multiplexing was requested by the client.
+	mpx      bool
+	mu       sync.RWMutex
+	wmu      sync.Mutex
+	contexts map[uint16]ServerHandle
+	// the negotiated syntaxes of the presentation contexts.
+	syntaxes map[uint16]*VerifyPresentation
+	calls    map[uint32]*serverCall
+	// the cancel functions of the calls being dispatched.
+	cancels map[uint32]context.CancelFunc
+	// the connection is kept open after the orphaned PDU.
+	keepOpen bool
+	// the bind PDU carried the PFC_SUPPORT_HEADER_SIGN flag.
+	signHeader bool
+}
+
+// read function reads the next fragment.
+func (c *serverConn) read(ctx context.Context) (Header, []byte, error) {
+
+	var hdr Header
+
+	b := make([]byte, HeaderSize)
+	if _, err := io.ReadFull(c.cc, b); err != nil {
+		return hdr, nil, err
+	}
+
+	// the fields that follow the data representation label are decoded
+	// with the sender data representation.
+	if err := hdr.ReadFrom(ctx, ndr.NDR20(b)); err != nil {
+		return hdr, nil, fmt.Errorf("read header: %w", err)
+	}
+
+	if int(hdr.FragLength) < HeaderSize {
+		return hdr, nil, fmt.Errorf("read header: invalid fragment length %d", hdr.FragLength)
+	}
+
+	frag := make([]byte, hdr.FragLength)
+	copy(frag, b)
+
+	if _, err := io.ReadFull(c.cc, frag[HeaderSize:]); err != nil {
+		return hdr, nil, err
+	}
+
+	return hdr, frag, nil
+}
+
+// write function writes the PDU with the stub data as a response to the
+// request `req` (using the client data representation).
+func (c *serverConn) write(ctx context.Context, req Header, flags PacketFlag, pdu PDU, stub []byte) error {
+
+	hdr := Header{
+		RPCVersion:  5,
+		PacketType:  PDUToPacketType(pdu),
+		PacketFlags: flags,
+		PacketDRep:  req.PacketDRep,
+		CallID:      req.CallID,
+	}
+
+	b, err := ndr.NDR20(nil, hdr.PacketDRep).Marshal(ctx, ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+		hdr.WriteTo(ctx, w)
+		pdu.WriteTo(ctx, w)
+		if len(stub) > 0 {
+			w.Write(stub)
+		}
+		return w.Err()
+	}))
+	if err != nil {
+		return fmt.Errorf("write %s: %w", hdr.PacketType, err)
+	}
+
+	// set the fragment length.
+	hdr.PacketDRep.ByteOrder().PutUint16(b[8:], uint16(len(b)))
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	_, err = c.cc.Write(b)
+	return err
+}
+
+// serve function processes the next fragment.
+func (c *serverConn) serve(ctx context.Context) error {
+
+	hdr, frag, err := c.read(ctx)
+	if err != nil {
+		return err
+	}
+
+	r := ndr.NDR20(frag, hdr.PacketDRep)
+	// skip the header.
+	r.Read(make([]byte, HeaderSize))
+
+	switch hdr.PacketType {
+	case PacketTypeBind, PacketTypeAlterContext:
+
+		var (
+			maxXmitFrag, maxRecvFrag uint16
+			groupID                  uint32
+			contexts                 []*Context
+		)
+
+		if hdr.PacketType == PacketTypeBind {
+			pdu := &Bind{}
+			if err := pdu.ReadFrom(ctx, r); err != nil {
+				return fmt.Errorf("read bind: %w", err)
+			}
+			maxXmitFrag, maxRecvFrag, groupID, contexts = pdu.MaxXmitFrag, pdu.MaxRecvFrag, pdu.AssocGroupID, pdu.ContextList
+		} else {
+			pdu := &AlterContext{}
+			if err := pdu.ReadFrom(ctx, r); err != nil {
+				return fmt.Errorf("read alter context: %w", err)
+			}
+			contexts = pdu.ContextList
+		}
+
+		if hdr.AuthLength != 0 {
+			// authentication is not supported.
+			return c.write(ctx, hdr, PacketFlagFirstFrag|PacketFlagLastFrag, &BindNak{ProviderRejectReason: AuthTypeNotRecognized}, nil)
+		}
+
+		results := c.negotiate(contexts)
+
+		if hdr.PacketType == PacketTypeAlterContext {
+			return c.write(ctx, hdr, PacketFlagFirstFrag|PacketFlagLastFrag, &AlterContextResponse{
+				MaxXmitFrag:  uint16(c.maxFrag),
+				MaxRecvFrag:  uint16(c.maxFrag),
+				AssocGroupID: c.groupID,
+				ResultList:   results,
+			}, nil)
+		}
+
+		if maxRecvFrag > 0 {
+			c.maxFrag = int(maxRecvFrag)
+		}
+
+		if c.profile.MaxFrag > 0 {
+			c.maxFrag = min(c.maxFrag, c.profile.MaxFrag)
+			maxXmitFrag = min(maxXmitFrag, uint16(c.profile.MaxFrag))
+		}
+
+		if c.groupID = groupID; c.groupID == 0 {
+			c.groupID = c.srv.groupID.Add(1)
+		}
+
+		// accept the concurrent multiplexing.
+		c.mpx = hdr.PacketFlags.IsSet(PacketFlagConcMPX)
+		c.signHeader = hdr.PacketFlags.IsSet(PacketFlagSupportHeaderSign)
+
+		// the server transmits at most the client receive size.
+		return c.write(ctx, hdr, PacketFlagFirstFrag|PacketFlagLastFrag|(hdr.PacketFlags&PacketFlagConcMPX), &BindAck{
+			MaxXmitFrag:  uint16(c.maxFrag),
+			MaxRecvFrag:  maxXmitFrag,
+			AssocGroupID: c.groupID,
+			PortSpec:     c.secondaryAddr(),
+			ResultList:   results,
+		}, nil)
+
+	case PacketTypeRequest:
+
+		pdu := &Request{}
+		if hdr.PacketFlags&PacketFlagObjectUUID != 0 {
+			pdu.ObjectUUID = &uuid.UUID{}
+		}
+
+		if err := pdu.ReadFrom(ctx, r); err != nil {
+			return fmt.Errorf("read request: %w", err)
+		}
+
+		call, ok := c.calls[hdr.CallID]
+		if !ok || hdr.PacketFlags&PacketFlagFirstFrag != 0 {
+			call = &serverCall{contextID: pdu.ContextID, opNum: pdu.OpNum, object: pdu.ObjectUUID}
+			c.calls[hdr.CallID] = call
+		}
+
+		call.stub.Write(frag[r.Offset():])
+
+		if hdr.PacketFlags&PacketFlagLastFrag == 0 {
+			return nil
+		}
+
+		delete(c.calls, hdr.CallID)
+
+		if !c.mpx {
+			return c.invoke(ctx, hdr, call)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+
+		c.mu.Lock()
+		c.cancels[hdr.CallID] = cancel
+		c.mu.Unlock()
+
+		go func() {
+			defer func() {
+				c.mu.Lock()
+				delete(c.cancels, hdr.CallID)
+				c.mu.Unlock()
+				cancel()
+			}()
+			if err := c.invoke(ctx, hdr, call); err != nil {
+				// terminate the connection.
+				c.cc.Close()
+			}
+		}()
+
+		return nil
+
+	case PacketTypeCancel:
+		// cancel the call being dispatched, the handler fault is
+		// reported as nca_s_fault_cancel.
+		c.mu.RLock()
+		cancel, ok := c.cancels[hdr.CallID]
+		c.mu.RUnlock()
+		if ok {
+			cancel()
+		}
+		return nil
+
+	case PacketTypeOrphaned:
+		// drop the partially received request.
+		delete(c.calls, hdr.CallID)
+		if !c.keepOpen {
+			// keep connection open on orphaned was not negotiated.
+			return io.EOF
+		}
+		return nil
+
+	case PacketTypeAuth3:
+		return nil
+	}
+
+	return fmt.Errorf("unexpected packet type %s", hdr.PacketType)
+}
+
+// secondaryAddr function returns the secondary address (the local port) of
+// the connection if the personality profile requires it.
+func (c *serverConn) secondaryAddr() string {
+	if !c.profile.SecondaryAddr {
+		return ""
+	}
+	if cc, ok := c.cc.(interface{ LocalAddr() net.Addr }); ok && cc.LocalAddr() != nil {
+		if _, port, err := net.SplitHostPort(cc.LocalAddr().String()); err == nil {
+			return port
+		}
+	}
+	return ""
+}
+
+// negotiate function returns the presentation context negotiation results.
+func (c *serverConn) negotiate(contexts []*Context) []*Result {
+
+	results := make([]*Result, len(contexts))
+
+	for i, p := range contexts {
+
+		// the rejected contexts carry the empty transfer syntax.
+		results[i] = &Result{
+			DefResult:      ProviderRejection,
+			ProviderReason: AbstractSyntaxNotSupported,
+			TransferSyntax: &SyntaxID{IfUUID: &uuid.UUID{}},
+		}
+
+		if flags, ok := bindFeatures(p.TransferSyntaxes); ok {
+			// only the keep connection open on orphaned and the security context
+			// multiplexing features are supported.
+			results[i].DefResult, results[i].ProviderReason = NegotiateAck, flags&c.profile.BindFeatures&(KeepConnOpenOnOrphaned|SecurityContextMultiplexing)
+			c.keepOpen = flags&KeepConnOpenOnOrphaned != 0
+			continue
+		}
+
+		h, ok := c.srv.handler(p.AbstractSyntax)
+		if !ok {
+			continue
+		}
+
+		results[i].ProviderReason = ProposedTransferSyntaxesNotSupported
+
+		for _, syntax := range p.TransferSyntaxes {
+			if syntax.Is(TransferNDRSyntaxV2_0) {
+				results[i].DefResult, results[i].ProviderReason = Acceptance, 0
+				results[i].TransferSyntax = TransferNDRSyntaxV2_0
+				c.mu.Lock()
+				c.contexts[p.ContextID] = h
+				c.syntaxes[p.ContextID] = &VerifyPresentation{InterfaceID: p.AbstractSyntax, TransferSyntax: TransferNDRSyntaxV2_0}
+				c.mu.Unlock()
+				break
+			}
+		}
+	}
+
+	return results
+}
+
+// bindFeatures function returns the bind-time features proposed by the
+// client if the transfer syntax list is the bind-time feature negotiation.
+func bindFeatures(syntaxes []*SyntaxID) (ProviderReason, bool) {
+	for _, syntax := range syntaxes {
+		if syntax != nil && syntax.IfUUID != nil && syntax.IfUUID.TimeLow == BindFeature.TimeLow &&
+			syntax.IfUUID.TimeMid == BindFeature.TimeMid && syntax.IfUUID.TimeHiAndVersion == BindFeature.TimeHiAndVersion {
+			return ProviderReason(syntax.IfUUID.ClockSeqHiAndReserved) & BindFlags, true
+		}
+	}
+	return 0, false
+}
+
+// handle function calls the handler, the panic raised while unmarshaling the
+// malformed request (or by the handler) is returned as error.
+func (c *serverConn) handle(ctx context.Context, h ServerHandle, opNum int, r ndr.Reader) (op Operation, err error) {
+	defer ndr.Recover(&err)
+	return h(ctx, opNum, r)
+}
+
+// invoke function dispatches the request and sends the response.
+func (c *serverConn) invoke(ctx context.Context, hdr Header, call *serverCall) error {
+
+	c.mu.RLock()
+	h, ok := c.contexts[call.contextID]
+	syntax := c.syntaxes[call.contextID]
+	c.mu.RUnlock()
+
+	if !ok {
+		return c.fault(ctx, hdr, call.contextID, c.profile.UnknownInterfaceFault)
+	}
+
+	if !c.profile.supports(syntax.InterfaceID, int(call.opNum)) {
+		return c.fault(ctx, hdr, call.contextID, c.profile.OpRangeFault)
+	}
+
+	if call.object != nil {
+		ctx = context.WithValue(ctx, objectUUIDKey{}, call.object)
+	}
+
+	stub, vt := splitVerificationTrailer(ctx, call.stub.Bytes())
+	if vt != nil {
+		if err := vt.Verify(&VerificationInfo{
+			Header:         hdr,
+			ContextID:      call.contextID,
+			OpNum:          call.opNum,
+			AbstractSyntax: syntax.InterfaceID,
+			TransferSyntax: syntax.TransferSyntax,
+			SignHeader:     c.signHeader,
+		}); err != nil {
+			// reject the request with spoofed header or presentation context.
+			return c.fault(ctx, hdr, call.contextID, c.profile.ProtocolFault)
+		}
+	}
+
+	op, err := c.handle(ctx, h, int(call.opNum), ndr.NDR20(stub, hdr.PacketDRep))
+	if err != nil {
+		if ctx.Err() != nil {
+			// the call was cancelled by the client.
+			return c.fault(context.WithoutCancel(ctx), hdr, call.contextID, rpcerrors.NCACancel.Code)
+		}
+		status := rpcerrors.NCSUserDefined.Code
+		var rpcErr *rpcerrors.RPCError
+		if errors.As(err, &rpcErr) {
+			status = rpcErr.Code
+		}
+		var extErr *rpcerrors.ExtendedError
+		if errors.As(err, &extErr) && len(extErr.Data) > 0 {
+			// attach the extended error information.
+			return c.extendedFault(ctx, hdr, call.contextID, status, extErr.Data)
+		}
+		return c.fault(ctx, hdr, call.contextID, status)
+	}
+
+	if op == nil {
+		return c.fault(ctx, hdr, call.contextID, c.profile.OpRangeFault)
+	}
+
+	stub, err = ndr.NDR20(nil, hdr.PacketDRep).Marshal(ctx, ndr.MarshalNDRFunc(op.MarshalNDRResponse))
+	if err != nil {
+		return c.fault(ctx, hdr, call.contextID, rpcerrors.NCSUserDefined.Code)
+	}
+
+	// the maximum stub data size of the response fragment.
+	size := c.maxFrag - HeaderSize - 8
+
+	flags := PacketFlagFirstFrag
+
+	for {
+		n := len(stub)
+		if n > size {
+			n = size
+		} else {
+			flags |= PacketFlagLastFrag
+		}
+
+		if err := c.write(ctx, hdr, flags, &Response{
+			AllocHint: uint32(len(stub)),
+			ContextID: call.contextID,
+		}, stub[:n]); err != nil {
+			return err
+		}
+
+		if stub = stub[n:]; len(stub) == 0 {
+			return nil
+		}
+
+		flags = 0
+	}
+}
+
+// fault function sends the fault with the status.
+func (c *serverConn) fault(ctx context.Context, req Header, contextID uint16, status uint32) error {
+	return c.write(ctx, req, PacketFlagFirstFrag|PacketFlagLastFrag, &Fault{
+		ContextID: contextID,
+		Status:    status,
+	}, nil)
+}
+
+// extendedFault function sends the fault with the status and the extended
+// error information.
+func (c *serverConn) extendedFault(ctx context.Context, req Header, contextID uint16, status uint32, info []byte) error {
+	return c.write(ctx, req, PacketFlagFirstFrag|PacketFlagLastFrag, &Fault{
+		AllocHint: uint32(len(info)),
+		ContextID: contextID,
+		Flags:     FaultFlagExtendedErrorInfo,
+		Status:    status,
+	}, info)
+}
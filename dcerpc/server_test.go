@@ -0,0 +1,92 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	srvsvc "github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+type todServer struct {
+	srvsvc.SrvsvcServer
+}
+
+func (s *todServer) RemoteToD(ctx context.Context, req *srvsvc.RemoteToDRequest) (*srvsvc.RemoteToDResponse, error) {
+	if req.ServerName == "" {
+		return nil, rpcerrors.ServerTooBusy
+	}
+	return &srvsvc.RemoteToDResponse{BufferPointer: &srvsvc.TimeOfDayInfo{ElapsedTime: 1700000000, Hours: 22}}, nil
+}
+
+func testServer(t *testing.T, ln net.Listener, opts ...dcerpc.Option) {
+
+	srv := dcerpc.NewServer()
+	srv.Register(srvsvc.SrvsvcSyntaxV3_0, srvsvc.NewSrvsvcServerHandle(&todServer{}))
+
+	go srv.Serve(ln)
+
+	ctx := context.Background()
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", opts...)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	cli, err := srvsvc.NewSrvsvcClient(ctx, conn, dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	resp, err := cli.RemoteToD(ctx, &srvsvc.RemoteToDRequest{ServerName: "localhost"})
+	if err != nil {
+		t.Fatalf("remote tod: %v", err)
+	}
+
+	if resp.BufferPointer == nil || resp.BufferPointer.ElapsedTime != 1700000000 || resp.BufferPointer.Hours != 22 {
+		t.Fatalf("unexpected response: %+v", resp.BufferPointer)
+	}
+
+	// the server error is returned as fault.
+	if _, err := cli.RemoteToD(ctx, &srvsvc.RemoteToDRequest{}); !errors.Is(err, rpcerrors.ServerTooBusy) {
+		t.Fatalf("expected fault, got %v", err)
+	}
+}
+
+func TestMemoryTransport(t *testing.T) {
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+	testServer(t, ln, dcerpc.WithDialer(ln))
+}
+
+func TestBigEndianTransport(t *testing.T) {
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+	testServer(t, ln, dcerpc.WithDialer(ln), dcerpc.WithDataRepresentation(ndr.BigEndianDataRepresentation))
+}
+
+func TestUnixTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rpc.sock")
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Skipf("unix sockets are not supported: %v", err)
+	}
+	defer ln.Close()
+	testServer(t, ln, dcerpc.WithUnixSocket(path))
+}
+
+func TestVerificationTrailer(t *testing.T) {
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+	// the server rejects the requests with the mismatching verification trailer.
+	testServer(t, ln, dcerpc.WithDialer(ln),
+		dcerpc.WithVerifyBitMask(true),
+		dcerpc.WithVerifyHeader2(true),
+		dcerpc.WithVerifyPresentation(true))
+}
@@ -0,0 +1,188 @@
+package dcerpc
+
+// state.go contains the connection lifecycle state machine.
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ConnState is the connection (transport) lifecycle state. The state
+// only moves forward:
+//
+//	connecting -> bound -> authenticating -> active -> draining -> closed
+//
+// The authenticating state is skipped for the connections that establish
+// the security context with the bind exchange (or the insecure connections),
+// and the draining state is skipped for the broken connections.
+type ConnState int
+
+const (
+	// The transport is connected, the bind is not completed.
+	StateConnecting ConnState = iota
+	// The bind_ack is received.
+	StateBound
+	// The security context is being established (alter_context/auth3).
+	StateAuthenticating
+	// The connection is ready for the calls.
+	StateActive
+	// The connection is being closed, the new calls are rejected and
+	// the outstanding calls are awaited.
+	StateDraining
+	// The connection is closed.
+	StateClosed
+)
+
+func (s ConnState) String() string {
+	switch s {
+	case StateConnecting:
+		return "connecting"
+	case StateBound:
+		return "bound"
+	case StateAuthenticating:
+		return "authenticating"
+	case StateActive:
+		return "active"
+	case StateDraining:
+		return "draining"
+	case StateClosed:
+		return "closed"
+	}
+	return fmt.Sprintf("ConnState(%d)", int(s))
+}
+
+// MarshalText function returns the state name.
+func (s ConnState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ConnStateEvent is the connection state transition.
+type ConnStateEvent struct {
+	// The time of the transition.
+	Time time.Time
+	// The string binding the connection was dialed with.
+	Binding string
+	// The previous state.
+	From ConnState
+	// The new state.
+	To ConnState
+	// The error that caused the transition (for the broken connection).
+	Err error
+}
+
+// ConnStateFunc is the connection state transition hook.
+type ConnStateFunc func(*ConnStateEvent)
+
+// WithStateHook option sets the function that receives the connection
+// state transitions (for example, to maintain the metrics of the active
+// connections):
+//
+//	conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithStateHook(func(ev *dcerpc.ConnStateEvent) {
+//		log.Printf("%s: %s -> %s: %v", ev.Binding, ev.From, ev.To, ev.Err)
+//	}))
+//
+// The function is called synchronously and can be called concurrently for
+// the different connections. The function must not close the connection.
+func WithStateHook(fn ConnStateFunc) ConnectOption {
+	return func(o *Transport) { o.OnStateChange = fn }
+}
+
+// State function returns the transport state.
+func (t *transport) State() ConnState {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.state
+}
+
+// setState function moves the transport to the state `to` and returns
+// `true` if the transition was made (the state only moves forward).
+func (t *transport) setState(to ConnState, err error) bool {
+
+	t.mu.Lock()
+	from := t.state
+	if to <= from {
+		t.mu.Unlock()
+		return false
+	}
+	t.state = to
+	if to == StateClosed && t.done != nil {
+		close(t.done)
+	}
+	t.mu.Unlock()
+
+	t.logger.Debug().Err(err).Stringer("from", from).Stringer("to", to).Msg("state")
+
+	if fn := t.settings.OnStateChange; fn != nil {
+		fn(&ConnStateEvent{
+			Time:    time.Now(),
+			Binding: t.binding.String(),
+			From:    from,
+			To:      to,
+			Err:     err,
+		})
+	}
+
+	return true
+}
+
+// enter function registers the outstanding call. The calls are rejected
+// once the transport is draining.
+func (t *transport) enter() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.state >= StateDraining {
+		return ErrConnClosed
+	}
+	t.calls++
+	return nil
+}
+
+// leave function unregisters the outstanding call.
+func (t *transport) leave() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.calls--; t.calls == 0 && t.drained != nil {
+		close(t.drained)
+		t.drained = nil
+	}
+}
+
+// drain function waits until the outstanding calls are completed or the
+// context is done.
+func (t *transport) drain(ctx context.Context) error {
+
+	t.mu.Lock()
+	if t.calls == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	if t.drained == nil {
+		t.drained = make(chan struct{})
+	}
+	drained := t.drained
+	t.mu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// closed function waits until the transport is closed (by the concurrent
+// Close call) or the context is done.
+func (t *transport) closed(ctx context.Context) error {
+
+	t.mu.RLock()
+	done := t.done
+	t.mu.RUnlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,136 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+// stateRecorder records the connection state transitions.
+type stateRecorder struct {
+	mu     sync.Mutex
+	states []dcerpc.ConnState
+}
+
+func (r *stateRecorder) hook(ev *dcerpc.ConnStateEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states = append(r.states, ev.To)
+}
+
+func (r *stateRecorder) get() []dcerpc.ConnState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]dcerpc.ConnState(nil), r.states...)
+}
+
+func TestConnState(t *testing.T) {
+
+	ctx := context.Background()
+
+	rec := &stateRecorder{}
+
+	cc, _ := testEchoServer(t, dcerpc.WithStateHook(rec.hook))
+
+	if state := cc.(interface{ Info() *dcerpc.ConnInfo }).Info().State; state != dcerpc.StateActive {
+		t.Fatalf("state: got %s, want %s", state, dcerpc.StateActive)
+	}
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 2}); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := cc.Close(ctx); err != nil {
+				t.Errorf("close: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// close is idempotent.
+	if err := cc.Close(ctx); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	want := []dcerpc.ConnState{dcerpc.StateBound, dcerpc.StateActive, dcerpc.StateDraining, dcerpc.StateClosed}
+	if got := rec.get(); len(got) != len(want) {
+		t.Fatalf("states: got %v, want %v", got, want)
+	} else {
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("states: got %v, want %v", got, want)
+			}
+		}
+	}
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 2}); !errors.Is(err, dcerpc.ErrConnClosed) {
+		t.Fatalf("invoke after close: got %v, want %v", err, dcerpc.ErrConnClosed)
+	}
+}
+
+func TestCloseDrain(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, started := testEchoServer(t)
+
+	// the outstanding call is completed before the connection is closed.
+	done := make(chan error, 1)
+	go func() {
+		op := &echoOp{Value: 1}
+		err := cc.Invoke(ctx, op)
+		if err == nil && op.Reply != 1 {
+			err = errors.New("unexpected reply")
+		}
+		done <- err
+	}()
+
+	<-started
+
+	if err := cc.Close(ctx); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatalf("outstanding call: %v", err)
+	}
+}
+
+func TestCloseAbort(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, started := testEchoServer(t)
+
+	// the outstanding call is aborted when the close context is done.
+	done := make(chan error, 1)
+	go func() { done <- cc.Invoke(ctx, &echoOp{Value: 1}) }()
+
+	<-started
+
+	closeCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	if err := cc.Close(closeCtx); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	if err := <-done; err == nil {
+		t.Fatalf("outstanding call: expected error")
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("close: took %v", elapsed)
+	}
+}
@@ -0,0 +1,132 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+// stress function runs `n` goroutines performing `calls` calls each and
+// returns the first error.
+func stress(n, calls int, fn func(g, i int) error) error {
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+		ret  error
+	)
+
+	for g := 0; g < n; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < calls; i++ {
+				if err := fn(g, i); err != nil {
+					once.Do(func() { ret = err })
+					return
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+	return ret
+}
+
+func TestConcurrentInvokeStress(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t, dcerpc.WithOutstandingCalls(8))
+
+	err := stress(16, 50, func(g, i int) error {
+		if i%10 == 0 {
+			// the multi-fragment response.
+			op := &listValuesOp{}
+			if err := cc.Invoke(ctx, op); err != nil {
+				return err
+			}
+			if len(op.Values) != 2048 || op.Values[2047] != 2048 {
+				return fmt.Errorf("unexpected values: %d", len(op.Values))
+			}
+			return nil
+		}
+		op := &echoOp{Value: uint32(g<<16 | i + 2)}
+		if err := cc.Invoke(ctx, op); err != nil {
+			return err
+		}
+		if op.Reply != op.Value {
+			return fmt.Errorf("unexpected reply %d for %d", op.Reply, op.Value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+}
+
+func TestConcurrentBindStress(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t)
+
+	// the presentation contexts are negotiated while the calls are in progress.
+	err := stress(8, 20, func(g, i int) error {
+		if g%2 == 0 {
+			op := &echoOp{Value: uint32(g<<16 | i + 2)}
+			if err := cc.Invoke(ctx, op); err != nil || op.Reply != op.Value {
+				return errors.Join(err, fmt.Errorf("unexpected reply %d for %d", op.Reply, op.Value))
+			}
+			return nil
+		}
+		sub, err := cc.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		op := &echoOp{Value: uint32(g<<16 | i + 2)}
+		if err := sub.Invoke(ctx, op); err != nil || op.Reply != op.Value {
+			return errors.Join(err, fmt.Errorf("unexpected reply %d for %d", op.Reply, op.Value))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+}
+
+func TestConcurrentCloseStress(t *testing.T) {
+
+	ctx := context.Background()
+
+	cc, _ := testEchoServer(t)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- stress(8, 100, func(g, i int) error {
+			if err := cc.Invoke(ctx, &echoOp{Value: uint32(g<<16 | i + 2)}); err != nil {
+				// the calls fail once the connection is closed.
+				return nil
+			}
+			return nil
+		})
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	cc.Close(ctx)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the calls are not completed after close")
+	}
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 2}); !errors.Is(err, dcerpc.ErrConnClosed) {
+		t.Fatalf("expected connection closed, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package dcerpc
+
+// stub.go contains the functions to encode and decode the stub data of the
+// operations without the connection (for example, to craft the payloads for
+// the other transports or to build the test fixtures).
+//
+// The generated request and response structures (XxxRequest, XxxResponse)
+// implement the ndr.Marshaler and ndr.Unmarshaler interfaces, so the stub
+// data can be produced for any method of the generated client:
+//
+//	req, err := dcerpc.EncodeStub(ctx, &srvsvc.RemoteToDRequest{ServerName: "localhost"})
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	// parse the response stub data received over the other transport.
+//	resp := &srvsvc.RemoteToDResponse{}
+//	if err := dcerpc.DecodeStub(ctx, data, resp); err != nil {
+//		// handle error.
+//	}
+
+import (
+	"context"
+
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// StubOption is the stub encoding option.
+type StubOption func(*stubOption)
+
+type stubOption struct {
+	ndr64 bool
+	drep  ndr.DataRepresentation
+}
+
+// WithStubNDR64 option selects the NDR64 transfer syntax for the stub data.
+func WithStubNDR64() StubOption {
+	return func(o *stubOption) { o.ndr64 = true }
+}
+
+// WithStubDataRepresentation option sets the data representation of the
+// stub data. (default is little-endian, ASCII, IEEE floating point).
+func WithStubDataRepresentation(drep ndr.DataRepresentation) StubOption {
+	return func(o *stubOption) { o.drep = drep }
+}
+
+func newStubEncoding(b []byte, opts []StubOption) ndr.NDR {
+
+	o := &stubOption{drep: ndr.DefaultDataRepresentation}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.ndr64 {
+		return ndr.NDR64(b, o.drep)
+	}
+
+	return ndr.NDR20(b, o.drep)
+}
+
+// EncodeStub function returns the stub data for the value `v` (generated
+// request or response structure).
+func EncodeStub(ctx context.Context, v ndr.Marshaler, opts ...StubOption) ([]byte, error) {
+	return newStubEncoding(nil, opts).Marshal(ctx, v)
+}
+
+// DecodeStub function decodes the stub data `b` into the value `v` (generated
+// request or response structure).
+func DecodeStub(ctx context.Context, b []byte, v ndr.Unmarshaler, opts ...StubOption) error {
+	return newStubEncoding(b, opts).Unmarshal(ctx, v)
+}
+
+// EncodeRequest function returns the request stub data for the operation
+// `op` (the same data as sent by the client in the request PDU body).
+func EncodeRequest(ctx context.Context, op Operation, opts ...StubOption) ([]byte, error) {
+	return EncodeStub(ctx, ndr.MarshalNDRFunc(op.MarshalNDRRequest), opts...)
+}
+
+// DecodeRequest function decodes the request stub data `b` into the
+// operation `op`.
+func DecodeRequest(ctx context.Context, b []byte, op Operation, opts ...StubOption) error {
+	return DecodeStub(ctx, b, ndr.UnmarshalNDRFunc(op.UnmarshalNDRRequest), opts...)
+}
+
+// EncodeResponse function returns the response stub data for the operation
+// `op` (the same data as sent by the server in the response PDU body).
+func EncodeResponse(ctx context.Context, op Operation, opts ...StubOption) ([]byte, error) {
+	return EncodeStub(ctx, ndr.MarshalNDRFunc(op.MarshalNDRResponse), opts...)
+}
+
+// DecodeResponse function decodes the response stub data `b` into the
+// operation `op`.
+func DecodeResponse(ctx context.Context, b []byte, op Operation, opts ...StubOption) error {
+	return DecodeStub(ctx, b, ndr.UnmarshalNDRFunc(op.UnmarshalNDRResponse), opts...)
+}
@@ -0,0 +1,57 @@
+package dcerpc_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	srvsvc "github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestStub(t *testing.T) {
+
+	ctx := context.Background()
+
+	for _, opts := range [][]dcerpc.StubOption{
+		nil,
+		{dcerpc.WithStubNDR64()},
+		{dcerpc.WithStubDataRepresentation(ndr.BigEndianDataRepresentation)},
+	} {
+
+		b, err := dcerpc.EncodeStub(ctx, &srvsvc.RemoteToDRequest{ServerName: "localhost"}, opts...)
+		if err != nil {
+			t.Fatalf("encode request: %v", err)
+		}
+
+		req := &srvsvc.RemoteToDRequest{}
+		if err := dcerpc.DecodeStub(ctx, b, req, opts...); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+
+		if req.ServerName != "localhost" {
+			t.Fatalf("unexpected server name: %q", req.ServerName)
+		}
+
+		resp := &srvsvc.RemoteToDResponse{BufferPointer: &srvsvc.TimeOfDayInfo{ElapsedTime: 1700000000, Hours: 22}}
+
+		if b, err = dcerpc.EncodeStub(ctx, resp, opts...); err != nil {
+			t.Fatalf("encode response: %v", err)
+		}
+
+		out := &srvsvc.RemoteToDResponse{}
+		if err := dcerpc.DecodeStub(ctx, b, out, opts...); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+
+		if out.BufferPointer == nil || *out.BufferPointer != *resp.BufferPointer {
+			t.Fatalf("unexpected response: %+v", out.BufferPointer)
+		}
+
+		// the re-encoded response is identical.
+		if b2, _ := dcerpc.EncodeStub(ctx, out, opts...); !bytes.Equal(b, b2) {
+			t.Fatalf("unexpected stub data: %x != %x", b2, b)
+		}
+	}
+}
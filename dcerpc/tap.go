@@ -0,0 +1,108 @@
+package dcerpc
+
+// tap.go contains the raw PDU tap hook (for debugging the interoperability
+// issues).
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// TapDirection is the direction of the tapped PDU.
+type TapDirection int
+
+const (
+	// The PDU is sent to the server.
+	TapOutbound TapDirection = 1
+	// The PDU is received from the server.
+	TapInbound TapDirection = 2
+)
+
+func (d TapDirection) String() string {
+	switch d {
+	case TapOutbound:
+		return "out"
+	case TapInbound:
+		return "in"
+	}
+	return fmt.Sprintf("TapDirection(%d)", int(d))
+}
+
+// TapPDU is the tapped PDU fragment.
+type TapPDU struct {
+	// The time the fragment was sent or received.
+	Time time.Time
+	// The fragment direction.
+	Direction TapDirection
+	// The flag that indicates whether the fragment is the plain
+	// text fragment (before the sealing for outbound fragments and after
+	// the unsealing for inbound fragments). The fragment sent over the
+	// wire has Plain set to `false`.
+	Plain bool
+	// The fragment header.
+	Header Header
+	// The fragment bytes (including the header and security trailer),
+	// the data is owned by the tap.
+	Data []byte
+}
+
+// TapFunc is the raw PDU tap function.
+type TapFunc func(*TapPDU)
+
+// WithTap option sets the function that receives every fragment sent to or
+// received from the server. The fragments are reported as sent over the wire,
+// and, for the signed or sealed fragments, additionally before the sealing
+// (outbound) or after the unsealing (inbound).
+//
+// The function is called synchronously by the sender and receiver routines
+// and can be called concurrently. The option is ignored by the connectionless
+// protocol (ncadg_ip_udp).
+func WithTap(fn TapFunc) ConnectOption {
+	return func(o *Transport) { o.Tap = fn }
+}
+
+// WithTapWriter option writes the hex dump of every fragment sent to or
+// received from the server to `w` (see WithTap):
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", dcerpc.WithTapWriter(os.Stderr))
+func WithTapWriter(w io.Writer) ConnectOption {
+	return WithTap(NewTapWriter(w))
+}
+
+// NewTapWriter function returns the tap function that writes the hex dump
+// of the fragments to `w`.
+func NewTapWriter(w io.Writer) TapFunc {
+	var mu sync.Mutex
+	return func(pdu *TapPDU) {
+		layer := "wire"
+		if pdu.Plain {
+			layer = "plain"
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, "%s %s %s %s call_id=%d flags=%s frag_length=%d auth_length=%d\n%s",
+			pdu.Time.Format(time.RFC3339Nano), pdu.Direction, layer, pdu.Header.PacketType,
+			pdu.Header.CallID, pdu.Header.PacketFlags, pdu.Header.FragLength, pdu.Header.AuthLength,
+			hex.Dump(pdu.Data))
+	}
+}
+
+// tap function reports the fragment `p` to the tap function (if set).
+func (s *Transport) tap(dir TapDirection, plain bool, hdr Header, p []byte) {
+	if s.Tap == nil {
+		return
+	}
+	if int(hdr.FragLength) <= len(p) {
+		p = p[:hdr.FragLength]
+	}
+	s.Tap(&TapPDU{
+		Time:      time.Now(),
+		Direction: dir,
+		Plain:     plain,
+		Header:    hdr,
+		Data:      append([]byte(nil), p...),
+	})
+}
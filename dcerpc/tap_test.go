@@ -0,0 +1,92 @@
+package dcerpc_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestTap(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	var (
+		mu   sync.Mutex
+		pdus []*dcerpc.TapPDU
+		dump bytes.Buffer
+	)
+
+	dumpTap := dcerpc.NewTapWriter(&dump)
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln), dcerpc.WithTap(func(pdu *dcerpc.TapPDU) {
+		mu.Lock()
+		defer mu.Unlock()
+		pdus = append(pdus, pdu)
+		dumpTap(pdu)
+	}))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(echoSyntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 1}); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	expected := []struct {
+		dir dcerpc.TapDirection
+		typ dcerpc.PacketType
+	}{
+		{dcerpc.TapOutbound, dcerpc.PacketTypeBind},
+		{dcerpc.TapInbound, dcerpc.PacketTypeBindAck},
+		{dcerpc.TapOutbound, dcerpc.PacketTypeRequest},
+		{dcerpc.TapInbound, dcerpc.PacketTypeResponse},
+	}
+
+	if len(pdus) != len(expected) {
+		t.Fatalf("expected %d pdus, got %d\n%s", len(expected), len(pdus), dump.String())
+	}
+
+	for i, pdu := range pdus {
+		if pdu.Direction != expected[i].dir || pdu.Header.PacketType != expected[i].typ || pdu.Plain {
+			t.Fatalf("pdu %d: expected %s %s, got %s %s (plain %v)", i, expected[i].dir, expected[i].typ, pdu.Direction, pdu.Header.PacketType, pdu.Plain)
+		}
+		if len(pdu.Data) != int(pdu.Header.FragLength) || pdu.Time.IsZero() {
+			t.Fatalf("pdu %d: invalid data length %d or time", i, len(pdu.Data))
+		}
+		if pdu.Data[2] != byte(pdu.Header.PacketType) {
+			t.Fatalf("pdu %d: packet type mismatch", i)
+		}
+	}
+
+	if s := dump.String(); !strings.Contains(s, "out wire request") || !strings.Contains(s, "in wire response") {
+		t.Fatalf("unexpected dump: %s", s)
+	}
+}
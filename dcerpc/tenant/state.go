@@ -0,0 +1,278 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+// state is the tenant runtime state.
+type state struct {
+	mu    sync.Mutex
+	name  string
+	opts  []dcerpc.Option
+	quota Quota
+	// the call slots (nil if unlimited).
+	calls chan struct{}
+	// the token bucket.
+	tokens float64
+	last   time.Time
+	// the open connections.
+	conns map[*conn]struct{}
+	stats Stats
+	// the time source.
+	now func() time.Time
+}
+
+func newState(t *Tenant) *state {
+
+	s := &state{
+		name:  t.Name,
+		opts:  append([]dcerpc.Option(nil), t.Options...),
+		quota: t.Quota,
+		conns: make(map[*conn]struct{}),
+		stats: Stats{Name: t.Name},
+		now:   time.Now,
+	}
+
+	if s.quota.MaxConcurrentCalls > 0 {
+		s.calls = make(chan struct{}, s.quota.MaxConcurrentCalls)
+	}
+
+	if s.quota.Burst <= 0 {
+		s.quota.Burst = 1
+	}
+
+	s.tokens = float64(s.quota.Burst)
+
+	return s
+}
+
+func (s *state) snapshot() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// acquireConn function reserves the connection slot.
+func (s *state) acquireConn() error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.quota.MaxConns > 0 && s.stats.Conns >= s.quota.MaxConns {
+		s.stats.Rejected++
+		return fmt.Errorf("%w: %s: connection limit %d", ErrQuotaExceeded, s.name, s.quota.MaxConns)
+	}
+
+	s.stats.Conns++
+
+	return nil
+}
+
+// releaseConn function releases the connection slot.
+func (s *state) releaseConn(c *conn, failed bool) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c != nil {
+		if _, ok := s.conns[c]; !ok {
+			// already released.
+			return
+		}
+		delete(s.conns, c)
+	}
+
+	if s.stats.Conns--; failed {
+		s.stats.DialErrors++
+	}
+}
+
+// track function registers the established connection.
+func (s *state) track(cc dcerpc.Conn) dcerpc.Conn {
+
+	c := &conn{Conn: cc, state: s}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.conns[c] = struct{}{}
+	s.stats.Dials++
+
+	return c
+}
+
+// closeAll function closes all open connections.
+func (s *state) closeAll(ctx context.Context) error {
+
+	s.mu.Lock()
+	conns := make([]*conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+
+	var err error
+
+	for _, c := range conns {
+		if cerr := c.Close(ctx); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
+// wait function returns the delay required by the rate limit, and
+// consumes the token if no delay is required.
+func (s *state) wait() time.Duration {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.quota.CallsPerSecond <= 0 {
+		return 0
+	}
+
+	now := s.now()
+
+	if !s.last.IsZero() {
+		s.tokens = math.Min(float64(s.quota.Burst), s.tokens+now.Sub(s.last).Seconds()*s.quota.CallsPerSecond)
+	}
+
+	s.last = now
+
+	if s.tokens >= 1 {
+		s.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - s.tokens) / s.quota.CallsPerSecond * float64(time.Second))
+}
+
+// acquireCall function waits for the call slot and the rate limit.
+func (s *state) acquireCall(ctx context.Context) error {
+
+	for d := s.wait(); d > 0; d = s.wait() {
+		select {
+		case <-ctx.Done():
+			s.reject()
+			return fmt.Errorf("%w: %s: call rate: %w", ErrQuotaExceeded, s.name, ctx.Err())
+		case <-time.After(d):
+		}
+	}
+
+	if s.calls != nil {
+		select {
+		case <-ctx.Done():
+			s.reject()
+			return fmt.Errorf("%w: %s: concurrent calls: %w", ErrQuotaExceeded, s.name, ctx.Err())
+		case s.calls <- struct{}{}:
+		}
+	}
+
+	s.mu.Lock()
+	s.stats.ActiveCalls++
+	s.mu.Unlock()
+
+	return nil
+}
+
+// releaseCall function releases the call slot and records the call.
+func (s *state) releaseCall(start time.Time, err error) {
+
+	if s.calls != nil {
+		<-s.calls
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.ActiveCalls--
+	s.stats.Calls++
+	s.stats.CallTime += s.now().Sub(start)
+
+	if err != nil {
+		s.stats.CallErrors++
+	}
+}
+
+func (s *state) reject() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Rejected++
+}
+
+// conn is the connection subject to the tenant quota.
+type conn struct {
+	dcerpc.Conn
+	state *state
+	// the dialed connection (nil for bound connections).
+	root *conn
+}
+
+// Bind function binds the client connection, the calls on the returned
+// connection are subject to the tenant quota.
+func (c *conn) Bind(ctx context.Context, opts ...dcerpc.Option) (dcerpc.Conn, error) {
+
+	cc, err := c.Conn.Bind(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &conn{Conn: cc, state: c.state, root: c.rootConn()}, nil
+}
+
+func (c *conn) rootConn() *conn {
+	if c.root != nil {
+		return c.root
+	}
+	return c
+}
+
+// Invoke function invokes the operation within the tenant quota.
+func (c *conn) Invoke(ctx context.Context, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+
+	if err := c.state.acquireCall(ctx); err != nil {
+		return err
+	}
+
+	start := c.state.now()
+	err := c.Conn.Invoke(ctx, op, opts...)
+	c.state.releaseCall(start, err)
+
+	return err
+}
+
+// InvokeObject function invokes the operation within the tenant quota.
+func (c *conn) InvokeObject(ctx context.Context, obj *uuid.UUID, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+
+	if err := c.state.acquireCall(ctx); err != nil {
+		return err
+	}
+
+	start := c.state.now()
+	err := c.Conn.InvokeObject(ctx, obj, op, opts...)
+	c.state.releaseCall(start, err)
+
+	return err
+}
+
+// Close function closes the connection, closing the dialed connection
+// releases the tenant connection slot.
+func (c *conn) Close(ctx context.Context) error {
+
+	err := c.Conn.Close(ctx)
+
+	if c.root == nil {
+		c.state.releaseConn(c, false)
+	}
+
+	return err
+}
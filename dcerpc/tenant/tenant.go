@@ -0,0 +1,212 @@
+// The tenant package implements the client factory for the applications that
+// manage many unrelated environments (customers, tenants) from the single
+// process.
+//
+// Each tenant carries its own credentials and connection options, so that the
+// options of one tenant are never applied to the connections of another, and its
+// own quota (number of connections, concurrent calls and call rate) and metrics:
+//
+//	f := tenant.NewFactory(dcerpc.WithLogger(logger))
+//
+//	f.Register(&tenant.Tenant{
+//		Name: "contoso",
+//		Options: []dcerpc.Option{
+//			dcerpc.WithCredentials(contosoCreds),
+//			dcerpc.WithSeal(),
+//		},
+//		Quota: tenant.Quota{MaxConns: 4, MaxConcurrentCalls: 8, CallsPerSecond: 20},
+//	})
+//
+//	conn, err := f.Dial(ctx, "contoso", "dc01.contoso.net")
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	defer conn.Close(ctx)
+//
+//	cli, err := samr.NewSamrClient(ctx, conn, dcerpc.WithSeal())
+//
+//	stats := f.Stats("contoso")
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+var (
+	// The tenant is not registered.
+	ErrUnknownTenant = errors.New("tenant: unknown tenant")
+	// The tenant is already registered.
+	ErrTenantExists = errors.New("tenant: tenant already exists")
+	// The tenant quota is exceeded.
+	ErrQuotaExceeded = errors.New("tenant: quota exceeded")
+)
+
+// Quota is the tenant resource quota. The zero value of each
+// field means no limit.
+type Quota struct {
+	// The maximum number of connections (Dial calls) open at the same time.
+	MaxConns int `json:"max_conns,omitempty" yaml:"max_conns,omitempty"`
+	// The maximum number of calls in progress, the exceeding calls wait
+	// until the call slot is released (or context is done).
+	MaxConcurrentCalls int `json:"max_concurrent_calls,omitempty" yaml:"max_concurrent_calls,omitempty"`
+	// The sustained call rate, the exceeding calls wait until the rate
+	// allows (or context is done).
+	CallsPerSecond float64 `json:"calls_per_second,omitempty" yaml:"calls_per_second,omitempty"`
+	// The maximum burst of calls above the rate. (default is 1).
+	Burst int `json:"burst,omitempty" yaml:"burst,omitempty"`
+}
+
+// Tenant is the tenant definition.
+type Tenant struct {
+	// The tenant name (label).
+	Name string
+	// The tenant options (credentials, security, transport options),
+	// the options are applied after the factory options.
+	Options []dcerpc.Option
+	// The tenant quota.
+	Quota Quota
+}
+
+// Stats is the tenant metrics snapshot.
+type Stats struct {
+	// The tenant name.
+	Name string `json:"name"`
+	// The number of open connections.
+	Conns int `json:"conns"`
+	// The total number of established connections.
+	Dials uint64 `json:"dials"`
+	// The number of failed dial attempts.
+	DialErrors uint64 `json:"dial_errors"`
+	// The number of calls in progress.
+	ActiveCalls int `json:"active_calls"`
+	// The total number of calls.
+	Calls uint64 `json:"calls"`
+	// The number of calls that returned error.
+	CallErrors uint64 `json:"call_errors"`
+	// The number of requests (dials, calls) rejected due to the quota.
+	Rejected uint64 `json:"rejected"`
+	// The total duration of the calls.
+	CallTime time.Duration `json:"call_time"`
+}
+
+// Factory is the multi-tenant connection factory.
+type Factory struct {
+	mu sync.Mutex
+	// The options common for all tenants.
+	opts []dcerpc.Option
+	// The tenants.
+	tenants map[string]*state
+}
+
+// NewFactory function returns the new factory with the set of options
+// common for all tenants (logger, timeouts, target policy, etc).
+//
+// Note, that credentials must not be passed as the common options.
+func NewFactory(opts ...dcerpc.Option) *Factory {
+	return &Factory{opts: opts, tenants: make(map[string]*state)}
+}
+
+// Register function registers the tenant.
+func (f *Factory) Register(t *Tenant) error {
+
+	if t == nil || t.Name == "" {
+		return fmt.Errorf("tenant: name is required")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.tenants[t.Name]; ok {
+		return fmt.Errorf("%w: %s", ErrTenantExists, t.Name)
+	}
+
+	f.tenants[t.Name] = newState(t)
+
+	return nil
+}
+
+// Unregister function removes the tenant and closes all its connections.
+func (f *Factory) Unregister(ctx context.Context, name string) error {
+
+	f.mu.Lock()
+	s, ok := f.tenants[name]
+	delete(f.tenants, name)
+	f.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownTenant, name)
+	}
+
+	return s.closeAll(ctx)
+}
+
+// Tenants function returns the sorted list of registered tenant names.
+func (f *Factory) Tenants() []string {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	names := make([]string, 0, len(f.tenants))
+	for name := range f.tenants {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Stats function returns the metrics snapshot for the tenant.
+func (f *Factory) Stats(name string) (Stats, bool) {
+
+	s, ok := f.tenant(name)
+	if !ok {
+		return Stats{}, false
+	}
+
+	return s.snapshot(), true
+}
+
+// Dial function establishes the connection to `addr` on behalf of the tenant.
+// The factory options, tenant options and `opts` are applied in this order.
+// The returned connection (and all connections bound from it) is subject
+// to the tenant quota.
+func (f *Factory) Dial(ctx context.Context, name string, addr string, opts ...dcerpc.Option) (dcerpc.Conn, error) {
+
+	s, ok := f.tenant(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTenant, name)
+	}
+
+	if err := s.acquireConn(); err != nil {
+		return nil, err
+	}
+
+	dialOpts := make([]dcerpc.Option, 0, len(f.opts)+len(s.opts)+len(opts))
+	dialOpts = append(dialOpts, f.opts...)
+	dialOpts = append(dialOpts, s.opts...)
+	dialOpts = append(dialOpts, opts...)
+
+	cc, err := dcerpc.Dial(ctx, addr, dialOpts...)
+	if err != nil {
+		s.releaseConn(nil, true)
+		return nil, fmt.Errorf("tenant: %s: %w", name, err)
+	}
+
+	return s.track(cc), nil
+}
+
+func (f *Factory) tenant(name string) (*state, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, ok := f.tenants[name]
+	return s, ok
+}
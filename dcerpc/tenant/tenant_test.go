@@ -0,0 +1,107 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+type fakeConn struct {
+	dcerpc.Conn
+	block chan struct{}
+}
+
+func (c *fakeConn) Bind(ctx context.Context, opts ...dcerpc.Option) (dcerpc.Conn, error) {
+	return c, nil
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+	if c.block != nil {
+		<-c.block
+	}
+	return nil
+}
+
+func (c *fakeConn) Close(ctx context.Context) error { return nil }
+
+func TestQuota(t *testing.T) {
+
+	ctx := context.Background()
+
+	s := newState(&Tenant{Name: "contoso", Quota: Quota{MaxConns: 1, MaxConcurrentCalls: 1}})
+
+	if err := s.acquireConn(); err != nil {
+		t.Fatalf("acquire connection: %v", err)
+	}
+
+	fake := &fakeConn{block: make(chan struct{})}
+	cc := s.track(fake)
+
+	if err := s.acquireConn(); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("acquire connection: expected quota error, got %v", err)
+	}
+
+	bound, err := cc.Bind(ctx)
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- bound.Invoke(ctx, nil) }()
+
+	// wait for the first call to take the slot.
+	for s.snapshot().ActiveCalls == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := bound.Invoke(tctx, nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("invoke: expected quota error, got %v", err)
+	}
+
+	close(fake.block)
+
+	if err := <-done; err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	// closing the bound connection does not release the slot.
+	bound.Close(ctx)
+
+	if stats := s.snapshot(); stats.Conns != 1 || stats.Calls != 1 || stats.Rejected != 2 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	cc.Close(ctx)
+
+	if stats := s.snapshot(); stats.Conns != 0 {
+		t.Fatalf("connection slot is not released: %+v", stats)
+	}
+}
+
+func TestRate(t *testing.T) {
+
+	s := newState(&Tenant{Name: "contoso", Quota: Quota{CallsPerSecond: 10, Burst: 2}})
+
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+
+	if s.wait() != 0 || s.wait() != 0 {
+		t.Fatalf("burst is not allowed")
+	}
+
+	if d := s.wait(); d != 100*time.Millisecond {
+		t.Fatalf("unexpected delay: %v", d)
+	}
+
+	now = now.Add(100 * time.Millisecond)
+
+	if d := s.wait(); d != 0 {
+		t.Fatalf("unexpected delay: %v", d)
+	}
+}
@@ -0,0 +1,79 @@
+package dcerpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+func TestTLSTransport(t *testing.T) {
+
+	// borrow the self-signed certificate of the test server.
+	srv := httptest.NewTLSServer(nil)
+	defer srv.Close()
+
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: srv.TLS.Certificates})
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		io.Copy(c, c)
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+
+	ctx := context.Background()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+
+	cc, err := Dial(ctx, host, WithTLS(&tls.Config{RootCAs: roots}))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	raw, err := cc.(*conn).dialConn(ctx, StringBinding{ProtocolSequence: ProtocolSequenceIPTCP, Endpoint: port})
+	if err != nil {
+		t.Fatalf("dial tcp: %v", err)
+	}
+	defer raw.Close()
+
+	if _, ok := raw.(*tls.Conn); !ok {
+		t.Fatalf("connection is not tls: %T", raw)
+	}
+
+	cb, err := ConnChannelBindings(raw)
+	if err != nil {
+		t.Fatalf("channel bindings: %v", err)
+	}
+
+	b1, _ := cb.Marshal()
+	b2, _ := gssapi.TLSServerEndPoint(srv.Certificate()).Marshal()
+
+	if !bytes.Equal(b1, b2) || !bytes.Contains(b1, []byte(gssapi.TLSServerEndPointPrefix)) {
+		t.Fatalf("channel bindings: unexpected %x", b1)
+	}
+
+	if _, err := io.WriteString(raw, "ping"); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	b := make([]byte, 4)
+	if _, err := io.ReadFull(raw, b); err != nil || string(b) != "ping" {
+		t.Fatalf("read: %q %v", b, err)
+	}
+}
@@ -0,0 +1,163 @@
+package dcerpc
+
+// traffic.go contains the traffic shaping profile definitions.
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// MinTrafficFragmentSize is the smallest fragment size the traffic profile
+// can select (it must fit the PDU header, verification and security trailers).
+const MinTrafficFragmentSize = 512
+
+// TrafficProfile represents the traffic shaping profile that randomizes
+// the inter-call timing, request fragment sizes and bind ordering within
+// the protocol-legal bounds.
+//
+// The profile is intended for red-team simulation and IDS testing:
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", dcerpc.WithTrafficProfile(&dcerpc.TrafficProfile{
+//		MinDelay:        100 * time.Millisecond,
+//		MaxDelay:        2 * time.Second,
+//		MinFragmentSize: 1024,
+//		ShuffleBind:     true,
+//	}))
+//
+// The inter-call delay is applied with the interceptor (see TrafficInterceptor),
+// that is appended to the end of the interceptor chain of every client bound on
+// the connection. The fragment sizes and the bind ordering are applied by the
+// transport, as the interceptors observe the operation, not the PDUs.
+type TrafficProfile struct {
+	// The minimum delay before each call.
+	MinDelay time.Duration `json:"min_delay,omitempty" yaml:"min_delay,omitempty"`
+	// The maximum delay before each call.
+	MaxDelay time.Duration `json:"max_delay,omitempty" yaml:"max_delay,omitempty"`
+	// The minimum request fragment size. If set, the request fragment
+	// size is selected randomly between MinFragmentSize and MaxFragmentSize.
+	MinFragmentSize int `json:"min_fragment_size,omitempty" yaml:"min_fragment_size,omitempty"`
+	// The maximum request fragment size. (the negotiated max_xmit_frag
+	// is used if not set, or set to larger value).
+	MaxFragmentSize int `json:"max_fragment_size,omitempty" yaml:"max_fragment_size,omitempty"`
+	// The flag that indicates whether the presentation context list
+	// must be shuffled for the bind request.
+	ShuffleBind bool `json:"shuffle_bind,omitempty" yaml:"shuffle_bind,omitempty"`
+	// The random source. (default math/rand source is used if not set).
+	// The source is used under the profile lock, since *rand.Rand is not
+	// safe for concurrent use (the source must not be shared with the other
+	// goroutines).
+	Rand *rand.Rand `json:"-" yaml:"-"`
+
+	mu sync.Mutex
+}
+
+// WithTrafficProfile option sets the traffic shaping profile for the
+// connection.
+func WithTrafficProfile(p *TrafficProfile) ConnectOption {
+	return func(o *Transport) { o.TrafficProfile = p }
+}
+
+// int63n function returns the random number in [0, n).
+func (p *TrafficProfile) int63n(n int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+	if p.Rand != nil {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.Rand.Int63n(n)
+	}
+	return rand.Int63n(n)
+}
+
+// TrafficInterceptor function returns the interceptor that delays the calls
+// according to the traffic profile `p` (or nil if the delay is not set).
+func TrafficInterceptor(p *TrafficProfile) UnaryInterceptor {
+
+	if p == nil || p.MaxDelay <= 0 {
+		return nil
+	}
+
+	return func(ctx context.Context, info *CallInfo, op Operation, opts []CallOption, invoker Invoker) error {
+		if err := p.Wait(ctx); err != nil {
+			return err
+		}
+		return invoker(ctx, op, opts...)
+	}
+}
+
+// Delay function returns the randomized delay before the next call.
+func (p *TrafficProfile) Delay() time.Duration {
+	if p == nil || p.MaxDelay <= 0 {
+		return 0
+	}
+	if p.MaxDelay <= p.MinDelay {
+		return p.MinDelay
+	}
+	return p.MinDelay + time.Duration(p.int63n(int64(p.MaxDelay-p.MinDelay)))
+}
+
+// Wait function blocks for the randomized delay or until the context
+// is done.
+func (p *TrafficProfile) Wait(ctx context.Context) error {
+
+	d := p.Delay()
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// FragmentSize function returns the randomized fragment size bounded by
+// the negotiated maximum transmit fragment size, or 0 if fragment size
+// randomization is disabled.
+func (p *TrafficProfile) FragmentSize(maxXmitFrag int) int {
+
+	if p == nil || p.MinFragmentSize <= 0 {
+		return 0
+	}
+
+	lo, hi := p.MinFragmentSize, maxXmitFrag
+	if p.MaxFragmentSize > 0 && p.MaxFragmentSize < hi {
+		hi = p.MaxFragmentSize
+	}
+
+	if lo < MinTrafficFragmentSize {
+		lo = MinTrafficFragmentSize
+	}
+
+	if lo >= hi {
+		return hi
+	}
+
+	// keep the fragment size 8-byte aligned.
+	return (lo + int(p.int63n(int64(hi-lo+1)))) &^ 7
+}
+
+// Shuffle function returns the shuffled copy of the presentation context
+// list (or the original list if bind shuffling is disabled).
+func (p *TrafficProfile) Shuffle(ps []*Presentation) []*Presentation {
+
+	if p == nil || !p.ShuffleBind || len(ps) < 2 {
+		return ps
+	}
+
+	ret := append([]*Presentation{}, ps...)
+	for i := len(ret) - 1; i > 0; i-- {
+		j := int(p.int63n(int64(i + 1)))
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+
+	return ret
+}
@@ -0,0 +1,51 @@
+package dcerpc_test
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+func TestTrafficProfile(t *testing.T) {
+
+	ctx := context.Background()
+
+	profile := &dcerpc.TrafficProfile{
+		MinDelay:        20 * time.Millisecond,
+		MaxDelay:        30 * time.Millisecond,
+		MinFragmentSize: 1024,
+		Rand:            rand.New(rand.NewSource(1)),
+	}
+
+	cc, _ := testEchoServer(t, dcerpc.WithTrafficProfile(profile))
+
+	var (
+		wg    sync.WaitGroup
+		start = time.Now()
+	)
+
+	// the shared random source is used concurrently (go test -race).
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := cc.Invoke(ctx, &echoOp{Value: 2}); err != nil {
+				t.Errorf("invoke: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if d := time.Since(start); d < 20*time.Millisecond {
+		t.Fatalf("calls are not delayed: %v", d)
+	}
+
+	if i := dcerpc.TrafficInterceptor(&dcerpc.TrafficProfile{MinFragmentSize: 1024}); i != nil {
+		t.Fatalf("expected no interceptor without delay")
+	}
+}
@@ -39,6 +39,8 @@ type transport struct {
 	settings *Transport
 	// The transmit, receive buffers.
 	tx, rx []byte
+	// The call fragment buffers (see getBuffer).
+	buffers sync.Pool
 	// The channel for the callers.
 	txQ, rxQ chan *call
 	// logger.
@@ -50,6 +52,26 @@ type transport struct {
 	closeWait *sync.WaitGroup
 	// The transport connection.
 	conn *conn
+	// The string binding the transport was dialed with.
+	binding StringBinding
+	// The outstanding calls window.
+	window chan struct{}
+	// The calls awaiting the response (concurrent multiplexing).
+	pendingMu sync.Mutex
+	pending   map[uint32]*call
+	// The security contexts established on the transport.
+	securities map[*Security]struct{}
+	// The last activity time (unix nanoseconds).
+	active atomic.Int64
+	// The lifecycle state.
+	state ConnState
+	// The channel closed when the transport is closed.
+	done      chan struct{}
+	closeOnce sync.Once
+	// The number of the outstanding calls and the channel closed when
+	// the last outstanding call is completed (while draining).
+	calls   int
+	drained chan struct{}
 }
 
 func (t *transport) IsBinded() bool {
@@ -70,6 +92,43 @@ func (t *transport) HasErr() error {
 	return t.err
 }
 
+// addSecurityContext function increments the number of the security
+// contexts negotiated on the transport (the calls on the transport may
+// be in progress).
+func (t *transport) addSecurityContext() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.settings.SecurityContextCount++
+}
+
+// addSecurity function registers the security context established on
+// the transport.
+func (t *transport) addSecurity(sec *Security) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.securities == nil {
+		t.securities = make(map[*Security]struct{})
+	}
+	t.securities[sec] = struct{}{}
+}
+
+// hasSecurityContext function returns `true` if the security context
+// was established on the transport.
+func (t *transport) hasSecurityContext(sec *Security) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.securities[sec]
+	return ok
+}
+
+// isSecurityMultiplexed function returns `true` if the security context
+// multiplexing is enabled and there are more than one security context.
+func (t *transport) isSecurityMultiplexed() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.settings.IsSecurityMultiplexed()
+}
+
 func (t *transport) WithErr(err error) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -109,23 +168,119 @@ func (t *transport) makeCall(ctx context.Context, opts ...any) (Call, error) {
 	}
 
 	for _, opt := range opts {
-		switch opt.(type) {
+		switch opt := opt.(type) {
 		case noCopy:
 			call.noCopy = true
+		case controlCall:
+			call.id, call.control = uint32(opt), true
 		}
 	}
 
 	if t.IsBinded() {
+		// acquire the outstanding calls window slot.
+		if t.window != nil && !call.control {
+			select {
+			case t.window <- struct{}{}:
+				call.slot = true
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
 		t.txQ <- call
 	}
 
 	return call, nil
 }
 
+// register function adds the call to the list of calls awaiting the
+// response.
+func (t *transport) register(c *call) {
+	t.pendingMu.Lock()
+	defer t.pendingMu.Unlock()
+	if t.pending == nil {
+		t.pending = make(map[uint32]*call)
+	}
+	t.pending[c.id] = c
+}
+
+// pendingCall function returns the call awaiting the response.
+func (t *transport) pendingCall(id uint32) (*call, bool) {
+	t.pendingMu.Lock()
+	defer t.pendingMu.Unlock()
+	call, ok := t.pending[id]
+	return call, ok
+}
+
+// hasPending function returns `true` if any call awaits the response.
+func (t *transport) hasPending() bool {
+	t.pendingMu.Lock()
+	defer t.pendingMu.Unlock()
+	return len(t.pending) > 0
+}
+
+// release function removes the call from the list of calls awaiting
+// the response and releases the outstanding calls window slot.
+func (t *transport) release(call *call) {
+	t.pendingMu.Lock()
+	defer t.pendingMu.Unlock()
+	// (the control call shares the identifier with the pending call).
+	if t.pending[call.id] == call {
+		delete(t.pending, call.id)
+	}
+	if call.slot {
+		call.slot = false
+		<-t.window
+	}
+}
+
+// complete function releases the call and notifies the caller that
+// no more fragments will be received.
+func (t *transport) complete(call *call) {
+	t.release(call)
+	call.doneOnce.Do(func() { close(call.done) })
+}
+
+// abandon function completes the call abandoned by the caller.
+func (t *transport) abandon(c Call) {
+	if c, ok := c.(*call); ok {
+		t.complete(c)
+	}
+}
+
+// settle function waits for the status of the fragment being sent for the
+// call interrupted by the caller and returns `true` if any fragment was sent.
+func (t *transport) settle(ctx context.Context, c Call) (bool, error) {
+	if c, ok := c.(*call); ok {
+		return c.settle(ctx)
+	}
+	return true, nil
+}
+
+// abort function completes all calls awaiting the response.
+func (t *transport) abort() {
+	t.pendingMu.Lock()
+	calls := make([]*call, 0, len(t.pending))
+	for _, call := range t.pending {
+		calls = append(calls, call)
+	}
+	t.pendingMu.Unlock()
+	for _, call := range calls {
+		t.complete(call)
+	}
+}
+
 func (c *transport) CallID() uint32 {
 	return c.cid.Add(1)
 }
 
+// bindChannel function sets the transport channel bindings for the security
+// context that is not established yet.
+func (c *transport) bindChannel(o *Security) {
+	if o != nil && o.ChannelBindings == nil && c.settings.ChannelBindings != nil && !o.Established() {
+		o.ChannelBindings = c.settings.ChannelBindings
+	}
+}
+
 // AlterContext function establishes new presentation or security (or both) context(s).
 func (c *transport) AlterContext(ctx context.Context, opts ...Option) (Conn, error) {
 
@@ -156,10 +311,16 @@ func (c *transport) AlterContext(ctx context.Context, opts ...Option) (Conn, err
 		SecurityTrailer: o.Security.SecurityTrailer(),
 	}
 
-	if o.Security == nil || o.Security.Established() {
+	// the header signing is negotiated with the security context.
+	negotiate := !o.Security.Established()
+
+	if o.Security == nil || !negotiate {
 		pkt.SecurityTrailer = SecurityTrailer{}
 	}
 
+	// bind the security context to the channel.
+	c.bindChannel(o.Security)
+
 	// set auth data.
 	if pkt.AuthData, err = o.Security.Init(ctx, nil); err != nil {
 		return nil, fmt.Errorf("alter context: init security: %w", err)
@@ -178,7 +339,11 @@ func (c *transport) AlterContext(ctx context.Context, opts ...Option) (Conn, err
 		return nil, fmt.Errorf("alter context: unexpected response: %s", pkt.Header.PacketType)
 	}
 
-	o.Security.SignHeader = pkt.Header.PacketFlags.IsSet(PacketFlagSupportHeaderSign)
+	if negotiate {
+		if err = o.Security.negotiateHeaderSign(pkt); err != nil {
+			return nil, fmt.Errorf("alter context: %w", err)
+		}
+	}
 
 	c.PresentationFromContextList(o.Presentations, pdu.ResultList)
 
@@ -232,16 +397,14 @@ func (c *transport) AlterContext(ctx context.Context, opts ...Option) (Conn, err
 		if _, ok := pkt.PDU.(*AlterContextResponse); !ok {
 			return nil, fmt.Errorf("alter context: unexpected response: %s", pkt.Header.PacketType)
 		}
-
-		o.Security.SignHeader = pkt.Header.PacketFlags.IsSet(PacketFlagSupportHeaderSign)
 	}
 
 	if o.IsNewSecurity && o.Security.Level >= AuthLevelConnect {
 		// increment security context count for multiplexing.
-		c.settings.SecurityContextCount++
+		c.addSecurityContext()
 	}
 
-	return c.makeConn(o), nil
+	return c.makeConn(o, opts), nil
 }
 
 // makeVerify function constructs the verification trailer for the connection.
@@ -268,7 +431,7 @@ func (c *transport) makeVerify(i int, o *option) *VerificationTrailer {
 				Command:  bitMask,
 				Required: verify.Required,
 			})
-		case VerifyHeader2:
+		case *VerifyHeader2:
 			out = append(out, &VerificationCommand{
 				Command:  &VerifyHeader2{},
 				Required: verify.Required,
@@ -284,29 +447,61 @@ func (c *transport) makeVerify(i int, o *option) *VerificationTrailer {
 // makeConn function constructs the client connection for each
 // presentation context and returns the first presentation context
 // connection as a client connection. The rest client connections
-// can be accessed via SubConns method.
-func (c *transport) makeConn(o *option) *clientConn {
+// can be accessed via SubConns method. The options `opts` are saved
+// to re-bind the connection on reconnect.
+func (c *transport) makeConn(o *option, opts []Option) *clientConn {
 
 	conns, mu := make([]*clientConn, len(o.Presentations)), new(sync.RWMutex)
 
+	if o.Security != nil {
+		c.addSecurity(o.Security)
+	}
+
+	interceptors := o.Interceptors
+	if i := TrafficInterceptor(c.settings.TrafficProfile); i != nil {
+		// the traffic profile delay is the innermost interceptor.
+		interceptors = append(interceptors[:len(interceptors):len(interceptors)], i)
+	}
+
 	for i := range conns {
 		conns[i] = &clientConn{
 			mu:           mu,
 			transport:    c,
-			buffer:       make([]byte, c.settings.FragmentSize()),
+			bufferSize:   c.settings.FragmentSize(),
 			security:     o.Security,
 			verify:       c.makeVerify(i, o),
 			presentation: o.Presentations[i],
 			subs:         conns,
 			logger:       o.Logger,
+			opts:         opts,
+			object:       o.ObjectUUID,
+			interceptor:  chainInterceptors(interceptors),
 		}
 	}
 
 	return conns[0]
 }
 
+// Bind function binds the presentation contexts (or adds the presentation
+// contexts with alter_context if the transport is already bound) and negotiates
+// the interface minor version if requested (see WithVersionNegotiation).
 func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 
+	cc, err := c.bind(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if cc, err = c.negotiateVersion(ctx, cc, opts); err != nil {
+		return nil, fmt.Errorf("bind: negotiate version: %w", err)
+	}
+
+	return cc, nil
+}
+
+// bind function binds the presentation contexts.
+func (c *transport) bind(ctx context.Context, opts ...Option) (Conn, error) {
+
 	if err := c.HasErr(); err != nil {
 		return nil, fmt.Errorf("bind: %w", err)
 	}
@@ -337,6 +532,9 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 	// set/override the settings group id if association is non-zero.
 	c.settings.GroupID = o.Group.SetID(c.settings.GroupID)
 
+	// the presentation context list order (can be shuffled by the traffic profile).
+	presentations := c.settings.TrafficProfile.Shuffle(o.Presentations)
+
 	pkt := &Packet{
 		Header: Header{
 			PacketFlags: PacketFlagFirstFrag | PacketFlagLastFrag | PacketFlagConcMPX | o.Security.RequestHeaderSign,
@@ -345,10 +543,16 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 			MaxXmitFrag:  uint16(c.settings.MaxXmitFrag),
 			MaxRecvFrag:  uint16(c.settings.MaxRecvFrag),
 			AssocGroupID: uint32(c.settings.GroupID),
-			ContextList:  c.PresentationsToContextList(o.Presentations, o.TransferSyntaxes),
+			ContextList:  c.PresentationsToContextList(presentations, o.TransferSyntaxes),
 		},
 		SecurityTrailer: o.Security.SecurityTrailer(),
 	}
+	// the header signing is negotiated with the security context.
+	negotiate := !o.Security.Established()
+
+	// bind the security context to the channel.
+	c.bindChannel(o.Security)
+
 	// set auth data.
 	if pkt.AuthData, err = o.Security.Init(ctx, nil); err != nil {
 		return nil, fmt.Errorf("bind: %w", err)
@@ -366,16 +570,21 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 
 	case *BindAck:
 
-		if c.settings.MaxRecvFrag != int(pdu.MaxRecvFrag) {
+		// the client transmits at most the server receive size and receives
+		// at most the server transmit size (limited by the proposed sizes).
+		maxXmitFrag := negotiateFragmentSize(c.settings.MaxXmitFrag, int(pdu.MaxRecvFrag))
+		maxRecvFrag := negotiateFragmentSize(c.settings.MaxRecvFrag, int(pdu.MaxXmitFrag))
+
+		if c.settings.MaxRecvFrag != maxRecvFrag {
 			// reset buffered connector.
-			c.cc = c.cc.(*BufferedConn).Resized(int(pdu.MaxRecvFrag))
+			c.cc = c.cc.(*BufferedConn).Resized(maxRecvFrag)
 		}
 
 		sz := c.settings.FragmentSize()
 
 		// save retrieved parameters.
-		c.settings.MaxRecvFrag = int(pdu.MaxRecvFrag)
-		c.settings.MaxXmitFrag = int(pdu.MaxXmitFrag)
+		c.settings.MaxRecvFrag = maxRecvFrag
+		c.settings.MaxXmitFrag = maxXmitFrag
 		c.settings.GroupID = int(pdu.AssocGroupID)
 		c.settings.SecondaryAddr = pdu.PortSpec
 
@@ -387,21 +596,32 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		}
 
 		// save negotiated header sign parameter.
-		o.Security.SignHeader = pkt.Header.PacketFlags.IsSet(PacketFlagSupportHeaderSign)
+		if negotiate {
+			if err = o.Security.negotiateHeaderSign(pkt); err != nil {
+				return nil, c.asyncClose(ctx, fmt.Errorf("bind: %w", err))
+			}
+		}
 		c.settings.Multiplexing = pkt.Header.PacketFlags.IsSet(PacketFlagConcMPX)
 
-		feature := c.PresentationFromContextList(o.Presentations, pdu.ResultList)
-		c.settings.KeepConnOpenOnOrphaned = feature.KeepConnOpenOnOrphaned()
-		c.settings.SecurityContextMultiplexing = feature.SecurityContextMultiplexing()
+		// the features not proposed by the client are ignored.
+		feature := c.PresentationFromContextList(presentations, pdu.ResultList)
+		c.settings.KeepConnOpenOnOrphaned = feature.KeepConnOpenOnOrphaned() && c.settings.BindFeatures&KeepConnOpenOnOrphaned != 0
+		c.settings.SecurityContextMultiplexing = feature.SecurityContextMultiplexing() && c.settings.BindFeatures&SecurityContextMultiplexing != 0
 
 		c.logger.Debug().EmbedObject(c.settings).Msg("negotiated_features")
 
+		c.setState(StateBound, nil)
+
 	case *BindNak:
 		return nil, c.asyncClose(ctx, fmt.Errorf("bind: %w", pdu))
 	default:
 		return nil, c.asyncClose(ctx, fmt.Errorf("bind: unexpected response: %s", pkt.Header.PacketType))
 	}
 
+	if !o.Security.Established() {
+		c.setState(StateAuthenticating, nil)
+	}
+
 	for !o.Security.Established() {
 		// alter context until the security context is established.
 		pkt = &Packet{
@@ -450,19 +670,18 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		if _, ok := pkt.PDU.(*AlterContextResponse); !ok {
 			return nil, c.asyncClose(ctx, fmt.Errorf("bind: alter context: unexpected response: %s", pkt.Header.PacketType))
 		}
-
-		o.Security.SignHeader = pkt.Header.PacketFlags.IsSet(PacketFlagSupportHeaderSign)
 	}
 
 	if o.IsNewSecurity && o.Security.Level >= AuthLevelConnect {
 		// increment security context count for multiplexing.
-		c.settings.SecurityContextCount++
+		c.addSecurityContext()
 	}
 
 	ctx, c.close = context.WithCancel(ctx)
 	c.closeWait = new(sync.WaitGroup)
 
 	c.Binded()
+	c.setState(StateActive, nil)
 
 	// run receiver.
 	c.closeWait.Add(1)
@@ -484,7 +703,25 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		}
 	}()
 
-	return c.makeConn(o), nil
+	if c.settings.KeepAlive > 0 {
+		for _, p := range o.Presentations {
+			if p.Error == nil && p.TransferSyntax != nil {
+				// run keepalive. (the copy of the presentation is
+				// re-proposed, since the alter_context result updates
+				// the presentation).
+				p := &Presentation{id: p.id, AbstractSyntax: p.AbstractSyntax, TransferSyntax: p.TransferSyntax}
+				c.touch()
+				c.closeWait.Add(1)
+				go func() {
+					defer c.closeWait.Done()
+					c.keepAlive(ctx, p, o.Security)
+				}()
+				break
+			}
+		}
+	}
+
+	return c.makeConn(o, opts), nil
 }
 
 // WritePacket function encodes and writes packet to the connection.
@@ -592,33 +829,74 @@ func (t *transport) shutdown(ctx context.Context) error {
 
 func (t *transport) asyncClose(ctx context.Context, err error) error {
 	if err != nil {
-		go func() { t.logger.Err(t.Close(ctx)).Msg("transport is closing") }()
+		go func() { t.logger.Err(t.terminate(ctx, err)).Msg("transport is closing") }()
 	}
 	return err
 }
 
 // Close function closes the transport's underlying connection and removes
-// the transport from the conn's active transports.
+// the transport from the conn's active transports. The outstanding calls
+// are aborted with ErrClosed.
 func (t *transport) Close(ctx context.Context) error {
+	return t.terminate(ctx, nil)
+}
 
-	if err := t.HasErr(); err != nil && err == ErrClosed {
-		return err
+// gracefulClose function waits for the outstanding calls (until the context
+// is done) and closes the transport. The new calls are rejected once the
+// function is called. The function can be called concurrently and multiple
+// times, the subsequent calls wait until the transport is closed.
+func (t *transport) gracefulClose(ctx context.Context) error {
+
+	if !t.setState(StateDraining, nil) {
+		// closed or being closed by the concurrent call.
+		return t.closed(ctx)
 	}
 
-	// set closed whatever it takes.
-	defer t.WithErr(ErrClosed)
+	if err := t.drain(ctx); err != nil {
+		t.logger.Debug().Err(err).Msg("abort outstanding calls")
+	}
+
+	return t.terminate(ctx, nil)
+}
+
+// terminate function closes the transport without waiting for the
+// outstanding calls. The error `cause` is the error the transport is
+// closed due to (if any).
+func (t *transport) terminate(ctx context.Context, cause error) error {
+
+	first := false
+	if t.closeOnce.Do(func() { first = true }); !first {
+		// closed by the concurrent call.
+		return t.closed(ctx)
+	}
+
+	// set closed whatever it takes (the outstanding calls are aborted
+	// with ErrClosed).
+	t.WithErr(ErrClosed)
 
 	// remove the transport from the list of active transports.
 	if err := t.conn.closeTransport(ctx, t); err != nil {
-		t.logger.Error().Err(err).Msg("close transport error")
+		t.logger.Debug().Err(err).Msg("close transport")
 	}
 
 	if err := t.shutdown(ctx); err != nil {
 		t.logger.Error().Err(err).Msg("close transport error")
 	}
 
+	t.setState(StateClosed, cause)
+
 	t.logger.Debug().Msg("closed")
 
 	// done.
 	return nil
 }
+
+// negotiateFragmentSize function returns the fragment size negotiated from
+// the proposed size and the size acknowledged by the server.
+func negotiateFragmentSize(proposed, acked int) int {
+	if acked < MinimumXmitSize {
+		// the server must accept at least the minimum size.
+		return proposed
+	}
+	return min(proposed, acked)
+}
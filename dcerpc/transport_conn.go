@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 )
 
@@ -13,6 +14,8 @@ var (
 	ErrPacketTooLong = errors.New("packet is too long")
 	// Too small buffer error.
 	ErrBufferTooSmall = errors.New("buffer is too small")
+	// Too large reassembled PDU error.
+	ErrReassemblyLimitExceeded = errors.New("reassembled pdu size exceeds the limit")
 )
 
 // Call interface provides the exclusive access to the transport.
@@ -43,7 +46,19 @@ type call struct {
 	// The flag that indicates whether to perform copy
 	// from xmit/recv buffers to connection buffer.
 	noCopy bool
-	done   chan struct{}
+	// The flag that indicates whether the call holds the
+	// outstanding calls window slot.
+	slot bool
+	// The flag that indicates whether the call carries the control
+	// PDU for the outstanding call (no response is awaited).
+	control bool
+	// The flags that indicate whether any fragment was passed to the
+	// sender and whether the sender status is not yet received.
+	sent, inflight bool
+	// The channel is closed when the call is completed or
+	// aborted (concurrent multiplexing).
+	done     chan struct{}
+	doneOnce sync.Once
 }
 
 // ID returns the call identifier.
@@ -65,6 +80,8 @@ func (c *call) ReadBuffer(ctx context.Context, p []byte) (Header, error) {
 		if !ok {
 			return Header{}, io.ErrUnexpectedEOF
 		}
+	case <-c.done:
+		return Header{}, io.ErrUnexpectedEOF
 	case <-ctx.Done():
 		return Header{}, ctx.Err()
 	}
@@ -96,18 +113,35 @@ func (c *call) WriteBuffer(ctx context.Context, hdr Header, p []byte) error {
 	// ready.
 	select {
 	case c.outQ <- hdr:
+		c.sent, c.inflight = true, true
 	case <-ctx.Done():
 		return ctx.Err()
 	}
 	// wait for response.
 	select {
 	case err := <-c.inQ:
+		c.inflight = false
 		return err
 	case <-ctx.Done():
 		return ctx.Err()
 	}
 }
 
+// settle function waits for the status of the fragment being sent
+// (if any) and returns `true` if any fragment was sent.
+func (c *call) settle(ctx context.Context) (bool, error) {
+	if !c.inflight {
+		return c.sent, nil
+	}
+	select {
+	case err := <-c.inQ:
+		c.inflight = false
+		return true, err
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
+
 // WriteBuffer function writes the data `p` to the wire.
 func (c *transport) WriteBuffer(ctx context.Context, hdr Header, p []byte) error {
 
@@ -117,7 +151,9 @@ func (c *transport) WriteBuffer(ctx context.Context, hdr Header, p []byte) error
 
 	p = p[:hdr.FragLength]
 
-	return doWithTimeout(ctx, c.settings.Timeout, func() error {
+	defer c.touch()
+
+	if err := doWithTimeout(ctx, c.settings.Timeout, func() error {
 		for n := 0; n < int(hdr.FragLength); {
 			actual, err := c.cc.Write(p[n:])
 			if err != nil {
@@ -126,7 +162,13 @@ func (c *transport) WriteBuffer(ctx context.Context, hdr Header, p []byte) error
 			n += actual
 		}
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	c.settings.tap(TapOutbound, false, hdr, p)
+
+	return nil
 }
 
 // ReadBuffer function reads the bytes from the wire into the buffer `p`.
@@ -134,7 +176,7 @@ func (c *transport) ReadBuffer(ctx context.Context, p []byte) (Header, error) {
 
 	var hdr Header
 
-	if len(p) < c.settings.MaxXmitFrag {
+	if len(p) < c.settings.MaxRecvFrag {
 		return hdr, ErrBufferTooSmall
 	}
 
@@ -153,7 +195,8 @@ func (c *transport) ReadBuffer(ctx context.Context, p []byte) (Header, error) {
 	}); err != nil {
 		return hdr, err
 	}
-	// unmarshal header.
+	// unmarshal header. (the fragment length is decoded with the data
+	// representation label of the received PDU).
 	if err := (&hdr).ReadFrom(ctx, c.Codec(p[:HeaderSize], c.settings.DataRepresentation)); err != nil {
 		return hdr, err
 	}
@@ -175,6 +218,10 @@ func (c *transport) ReadBuffer(ctx context.Context, p []byte) (Header, error) {
 		return hdr, err
 	}
 
+	c.touch()
+
+	c.settings.tap(TapInbound, false, hdr, p)
+
 	return hdr, nil
 }
 
@@ -186,6 +233,13 @@ func (t *transport) recvLoop(ctx context.Context) error {
 	for {
 		select {
 		case call := <-t.rxQ:
+			if t.settings.Multiplexing {
+				// the responses are dispatched by the call identifier.
+				if err := t.demux(ctx); err != nil {
+					t.logger.Error().Err(err).Msg("serving responses error")
+				}
+				continue
+			}
 			t.logger.Debug().Uint32("call_id", call.ID()).Msg("serving response")
 			if err := t.recv(ctx, call); err != nil {
 				t.logger.Error().Uint32("call_id", call.ID()).Err(err).Msg("serving response error")
@@ -200,12 +254,9 @@ func (t *transport) recvLoop(ctx context.Context) error {
 // recv.
 func (t *transport) recv(ctx context.Context, call *call) error {
 
-	if t.settings.Multiplexing {
-		defer close(call.done)
-	}
-
 	// close output query for preventing the deadlock.
 	defer close(call.outQ)
+	defer t.release(call)
 
 	if err := t.HasErr(); err != nil {
 		return err
@@ -257,6 +308,68 @@ func (t *transport) recv(ctx context.Context, call *call) error {
 	return nil
 }
 
+// demux function reads the response fragments and dispatches them to the
+// outstanding calls until no call awaits the response. (used when the
+// concurrent multiplexing is negotiated, so the responses can arrive in
+// any order).
+func (t *transport) demux(ctx context.Context) error {
+
+	var deadline *time.Timer
+	defer clearTimer(&deadline)
+
+	for t.hasPending() {
+
+		if err := t.HasErr(); err != nil {
+			t.abort()
+			return err
+		}
+
+		// read packet from buffer.
+		hdr, err := t.ReadBuffer(ctx, t.rx)
+		if err != nil {
+			t.logger.Error().Err(err).Msg("receiver: read buffer error")
+			// critical error.
+			t.abort()
+			return t.WithErr(err)
+		}
+
+		call, ok := t.pendingCall(hdr.CallID)
+		if !ok {
+			t.logger.Error().Msgf("receiver: call_id %d was not found", hdr.CallID)
+			// discard packet for unknown call id.
+			continue
+		}
+
+		// indicate ready to copy the buffer.
+		newTimer(&deadline, t.settings.Deadline)
+		select {
+		case call.outQ <- hdr:
+		case <-deadline.C:
+			t.abort()
+			return t.WithErr(fmt.Errorf("caller-receiver timer expired"))
+		case <-ctx.Done():
+			return nil
+		}
+
+		// wait for buffer copy.
+		newTimer(&deadline, t.settings.Deadline)
+		select {
+		case <-call.inQ:
+		case <-deadline.C:
+			t.abort()
+			return t.WithErr(fmt.Errorf("caller-ready timer expired"))
+		case <-ctx.Done():
+			return nil
+		}
+
+		if hdr.PacketFlags.IsSet(PacketFlagLastFrag) {
+			t.complete(call)
+		}
+	}
+
+	return nil
+}
+
 // sendLoop.
 func (t *transport) sendLoop(ctx context.Context) error {
 
@@ -284,14 +397,23 @@ func (t *transport) send(ctx context.Context, call *call) error {
 	defer clearTimer(&deadline)
 
 	if err := t.HasErr(); err != nil {
+		t.release(call)
 		return err
 	}
 
+	if t.settings.Multiplexing && !call.control {
+		// register the call before the response can be received.
+		t.register(call)
+	}
+
 	for {
 		// wait for write done.
 		var hdr Header
 		select {
 		case hdr = <-call.outQ:
+		case <-call.done:
+			// the call was abandoned by the caller.
+			return nil
 		case <-ctx.Done():
 			return nil
 		}
@@ -309,13 +431,16 @@ func (t *transport) send(ctx context.Context, call *call) error {
 		}
 
 		if err := t.WithErr(err); err != nil {
+			t.release(call)
 			return err
 		}
 
 		// finish send.
 		if hdr.PacketFlags.IsSet(PacketFlagLastFrag) {
 			t.logger.Debug().Uint32("call_id", hdr.CallID).Msg("send is done")
-			if hdr.PacketType == PacketTypeAuth3 {
+			if call.control || hdr.PacketType == PacketTypeAuth3 || hdr.PacketType == PacketTypeOrphaned {
+				// no response is expected.
+				t.release(call)
 				return nil
 			}
 			break
@@ -328,14 +453,6 @@ func (t *transport) send(ctx context.Context, call *call) error {
 	case <-ctx.Done():
 	}
 
-	// wait for receive completes.
-	if t.settings.Multiplexing {
-		select {
-		case <-call.done:
-		case <-ctx.Done():
-		}
-	}
-
 	return nil
 }
 
@@ -2,12 +2,17 @@ package dcerpc
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
 
+	"github.com/oiweiwei/go-msrpc/rpch"
+
 	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 )
 
 // The Endpoint Mapper interface maps the given syntax identifier
@@ -22,6 +27,11 @@ type Transport struct {
 	MaxRecvFrag int
 	// The transmit buffer size.
 	MaxXmitFrag int
+	// The maximum size of the reassembled response stub data (zero
+	// disables the limit).
+	MaxReassemblySize int
+	// The response stub data decoding limits (nil disables the limits).
+	DecodeLimits *ndr.Limits
 	// The association group identifier.
 	GroupID int
 	// The hostname.
@@ -35,6 +45,9 @@ type Transport struct {
 	// a client is allowed to send another request on a connection
 	// before it receives a response on a previous request
 	Multiplexing bool
+	// The bind-time features proposed by the client (zero disables
+	// the bind-time feature negotiation).
+	BindFeatures ProviderReason
 	// The server supports keeping the connection open after an
 	// orphaned PDU is received.
 	KeepConnOpenOnOrphaned bool
@@ -55,15 +68,79 @@ type Transport struct {
 	Dialer Dialer
 	// SMB port.
 	SMBPort int
+	// SMB share that hosts the named pipes. (default is IPC$).
+	SMBShareName string
+	// The named pipe path overrides (pipe name to the path
+	// relative to the share).
+	NamedPipes map[string]string
 	// SMB dialer.
 	SMBDialer any
+	// The security context options (credentials and mechanisms) for
+	// the SMB session. (if not set, the SMB session is established with
+	// the security context options of the RPC connection).
+	SMBSecurityOptions []gssapi.ContextOption
+	// The SOCKS5 or HTTP CONNECT proxy URL for TCP connections.
+	Proxy string
+	// The TLS configuration for ncacn_ip_tcp connections. (if set, the
+	// TCP connection is wrapped in TLS).
+	TLSConfig *tls.Config
+	// The channel bindings for the security contexts established over the
+	// connection. (if not set, the channel bindings are derived from the
+	// connection, see ChannelBindingsConn).
+	ChannelBindings gssapi.ChannelBindings
+	// The already established SMB session or mounted share (tree)
+	// to open the named pipes with.
+	SMBSession any
+	// If set to `true`, the named pipes on the local host are opened
+	// directly with the operating system (windows only) instead of SMB.
+	LocalPipes bool
+	// The RPC over HTTP v2 proxy configuration.
+	RPCProxy *rpch.Config
 	// Endpoint Mapper.
 	EndpointMapper EndpointMapper
+	// The cache of the endpoints resolved with the endpoint mapper.
+	EndpointCache *EndpointCache
 	// Preferred protocol sequence.
 	StringBinding StringBinding
 	// If set to `true`, new connection will be established
 	// for every new client with matching binding.
 	NoReuseTransport bool
+	// The target policy guard.
+	TargetPolicy *TargetPolicy
+	// The traffic shaping profile.
+	TrafficProfile *TrafficProfile
+	// The operation budgets.
+	Budgets *Budgets
+	// The time to await the response after the cancel PDU is sent
+	// (zero disables the cancel PDUs).
+	CancelTimeout time.Duration
+	// The number of attempts to re-establish the broken connection
+	// (zero disables the automatic reconnect).
+	ReconnectAttempts int
+	// The function called after the connection is re-established.
+	OnReconnect ReconnectFunc
+	// The annotator of the decoded responses.
+	Annotator Annotator
+	// The interval of the idle connection keepalive (zero disables
+	// the keepalive).
+	KeepAlive time.Duration
+	// The raw PDU tap function.
+	Tap TapFunc
+	// The connection state transition hook.
+	OnStateChange ConnStateFunc
+	// The retry policy for the transient faults.
+	RetryPolicy *RetryPolicy
+	// The time before the security context expiration to renew the
+	// security context (zero disables the automatic renewal).
+	RenewBefore time.Duration
+	// The function called when the security context renewal fails.
+	OnRenewalFailure RenewalFailureFunc
+	// The flag that indicates whether the security context is
+	// re-established when the call fails with the reauth status code.
+	Reauthenticate bool
+	// The status codes that trigger the security context re-establishment
+	// (DefaultReauthCodes if empty).
+	ReauthCodes []uint32
 }
 
 // The transport connection option.
@@ -75,16 +152,95 @@ func (ConnectOption) is_rpcOption() {}
 func WithFragmentSize(sz int) ConnectOption {
 	return func(o *Transport) {
 		if sz >= MinimumXmitSize { // minimum size.
-			o.MaxXmitFrag, o.MaxRecvFrag = sz, sz
+			o.MaxXmitFrag, o.MaxRecvFrag = min(sz, MaximumXmitSize), min(sz, MaximumXmitSize)
 		}
 	}
 }
 
+// WithMaxXmitFrag option sets the maximum size of the fragment sent by the
+// client (max_xmit_frag). The size is negotiated down to the server receive
+// size during the bind. (the size is between MinimumXmitSize and MaximumXmitSize).
+//
+// The large fragments reduce the number of the round-trips for the bulk
+// uploads (for example, when the server is reached over the high-latency link).
+func WithMaxXmitFrag(sz int) ConnectOption {
+	return func(o *Transport) {
+		if sz >= MinimumXmitSize {
+			o.MaxXmitFrag = min(sz, MaximumXmitSize)
+		}
+	}
+}
+
+// WithMaxRecvFrag option sets the maximum size of the fragment accepted by
+// the client (max_recv_frag). The fragment that exceeds the size terminates
+// the connection with ErrPacketTooLong. (the size is between MinimumXmitSize
+// and MaximumXmitSize).
+func WithMaxRecvFrag(sz int) ConnectOption {
+	return func(o *Transport) {
+		if sz >= MinimumXmitSize {
+			o.MaxRecvFrag = min(sz, MaximumXmitSize)
+		}
+	}
+}
+
+// WithMaxReassemblySize option sets the hard limit for the total size of the
+// response stub data reassembled from the fragments. The call which response
+// (or the allocation hint) exceeds the limit fails with ErrReassemblyLimitExceeded
+// and the connection is closed, since the server may continue to stream the
+// fragments:
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", dcerpc.WithMaxReassemblySize(64<<20))
+//
+// Unlike the operation budgets (see WithBudgets), the limit applies to every call
+// on the connection.
+func WithMaxReassemblySize(sz int) ConnectOption {
+	return func(o *Transport) { o.MaxReassemblySize = sz }
+}
+
+// WithDecodeLimits option sets the resource limits for decoding the response
+// stub data (the conformant array count, string length, pointer depth and the
+// total number of decoded bytes), so that the hostile server cannot exhaust the
+// client memory. The call which response exceeds the limits fails with the error
+// that wraps ndr.ErrLimitExceeded:
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", dcerpc.WithDecodeLimits(ndr.DefaultLimits()))
+func WithDecodeLimits(l *ndr.Limits) ConnectOption {
+	return func(o *Transport) { o.DecodeLimits = l }
+}
+
 func WithNewTransport() ConnectOption {
 	return func(o *Transport) { o.NoReuseTransport = true }
 }
 
-// WithGroupID option sets the association group identifier.
+// WithDataRepresentation option sets the data representation of the outgoing
+// PDUs (for example, ndr.BigEndianDataRepresentation for the interop with the
+// non-Windows DCE implementations). The incoming PDUs are always decoded with
+// the data representation of the sender.
+func WithDataRepresentation(drep ndr.DataRepresentation) ConnectOption {
+	return func(o *Transport) { o.DataRepresentation = drep }
+}
+
+// WithOutstandingCalls option sets the maximum number of the calls awaiting
+// the response on the single connection (the calls beyond the window are
+// blocked until the response for the earlier call is received). If the
+// concurrent multiplexing is negotiated, the responses are dispatched by
+// the call identifier and can be received in any order. (default is 64,
+// zero disables the limit).
+func WithOutstandingCalls(n int) ConnectOption {
+	return func(o *Transport) { o.MultiplexingOutstandingCalls = n }
+}
+
+// WithBindFeatures option sets the bind-time features proposed by the client
+// (default is dcerpc.BindFlags). If dcerpc.KeepConnOpenOnOrphaned is not
+// acknowledged by the server, the connection is closed once the orphaned PDU
+// is sent, since the server may close the connection. The zero value disables
+// the bind-time feature negotiation.
+func WithBindFeatures(flags ProviderReason) ConnectOption {
+	return func(o *Transport) { o.BindFeatures = flags & BindFlags }
+}
+
+// WithGroupID option sets the association group identifier. The option
+// can be used to join the existing association group (see ConnInfo).
 func WithGroupID(id int) ConnectOption {
 	return func(o *Transport) { o.GroupID = id }
 }
@@ -100,6 +256,32 @@ func WithDialer(dialer Dialer) ConnectOption {
 	return func(o *Transport) { o.Dialer = dialer }
 }
 
+// WithTLS option wraps the ncacn_ip_tcp connections in TLS with the
+// configuration `cfg` (the server name is set to the server host name
+// if not set). The option is intended for the tunneled deployments
+// (the TLS terminating proxy in front of the RPC server):
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:rpc.contoso.net[8443]", dcerpc.WithTLS(&tls.Config{}))
+func WithTLS(cfg *tls.Config) ConnectOption {
+	return func(o *Transport) {
+		if o.TLSConfig = cfg; o.TLSConfig == nil {
+			o.TLSConfig = &tls.Config{}
+		}
+	}
+}
+
+// WithChannelBindings option sets the channel bindings for the security
+// contexts established over the connection (and the SMB session for the
+// ncacn_np transport). The option is intended for the targets that enforce
+// the Extended Protection for Authentication when the channel is terminated
+// outside of the connection (ie, the TLS terminating proxy):
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:rpc.contoso.net[8443]",
+//		dcerpc.WithChannelBindings(gssapi.TLSServerEndPoint(proxyCert)))
+func WithChannelBindings(cb gssapi.ChannelBindings) ConnectOption {
+	return func(o *Transport) { o.ChannelBindings = cb }
+}
+
 // WithTimeout option sets the networking timeout.
 func WithTimeout(timeout time.Duration) ConnectOption {
 	return func(o *Transport) { o.Timeout = timeout }
@@ -110,11 +292,147 @@ func WithSMBPort(port int) ConnectOption {
 	return func(o *Transport) { o.SMBPort = port }
 }
 
+// WithSMBShareName function sets the SMB share that hosts the named pipes.
+// (for example, for non-Windows SMB servers hosting RPC services).
+func WithSMBShareName(share string) ConnectOption {
+	return func(o *Transport) { o.SMBShareName = share }
+}
+
+// WithNamedPipePath function overrides the path of the named pipe `pipe`:
+//
+//	// open \\server\IPC$\custom\lsarpc instead of \\server\IPC$\lsarpc.
+//	conn, err := dcerpc.Dial(ctx, "ncacn_np:server[lsarpc]", dcerpc.WithNamedPipePath("lsarpc", "custom\\lsarpc"))
+func WithNamedPipePath(pipe, path string) ConnectOption {
+	return func(o *Transport) {
+		pipes := make(map[string]string, len(o.NamedPipes)+1)
+		for k, v := range o.NamedPipes {
+			pipes[k] = v
+		}
+		pipes[strings.ToLower(afterSlash(pipe))] = path
+		o.NamedPipes = pipes
+	}
+}
+
+// NamedPipePath function returns the named pipe path for the pipe name.
+func (s Transport) NamedPipePath(pipe string) string {
+	if path, ok := s.NamedPipes[strings.ToLower(pipe)]; ok {
+		return path
+	}
+	return pipe
+}
+
+// ShareName function returns the SMB share name for the string binding.
+func (s Transport) ShareName(binding StringBinding) string {
+	if _, ok := binding.ExtraValue("share"); ok || s.SMBShareName == "" {
+		return binding.ShareName()
+	}
+	binding.Extra = append(append([]string{}, binding.Extra...), "share="+s.SMBShareName)
+	return binding.ShareName()
+}
+
+// WithRPCProxy function sets the RPC proxy configuration for the ncacn_http
+// connections. If not set, RPC over HTTP v1 direct connection is used,
+// unless the `RpcProxy=` endpoint option is provided within the string binding:
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_http:mail.contoso.net[6001,RpcProxy=mail.contoso.net:443]", dcerpc.WithRPCProxy(&rpch.Config{
+//		Username: "CONTOSO\\user",
+//		Password: "password",
+//	}))
+func WithRPCProxy(cfg *rpch.Config) ConnectOption {
+	return func(o *Transport) { o.RPCProxy = cfg }
+}
+
 // WithSMBDialer function sets the SMB dialer.
 func WithSMBDialer(dialer any) ConnectOption {
 	return func(o *Transport) { o.SMBDialer = dialer }
 }
 
+// WithSMBCredentials option sets the credentials for the SMB session, so that
+// the named pipe transport is authenticated with the identity other than the
+// identity of the RPC security context (for example, the DC that requires the
+// machine account for the SMB session, and the user account for the RPC calls):
+//
+//	machine := credential.NewFromPassword("CONTOSO\\WS01$", os.Getenv("MACHINE_PASSWORD"))
+//	user := credential.NewFromPassword("CONTOSO\\user", os.Getenv("PASSWORD"))
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_np:dc01[netlogon]", dcerpc.WithSMBCredentials(machine))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	cli, err := netlogon.NewLogonClient(ctx, conn, dcerpc.WithSeal(), dcerpc.WithCredentials(user))
+//
+// The SMB session uses the mechanisms of the RPC connection unless overridden
+// with WithSMBMechanism option. The option is ignored when the SMB dialer or
+// session is provided (see WithSMBDialer, WithSMBSession).
+func WithSMBCredentials(creds any) ConnectOption {
+	return func(o *Transport) { o.SMBSecurityOptions = append(o.SMBSecurityOptions, credentialOption(creds)) }
+}
+
+// WithSMBMechanism option sets the allowed mechanism for the SMB session.
+func WithSMBMechanism(m gssapi.MechanismFactory, defaultConfig ...gssapi.MechanismConfig) ConnectOption {
+	return func(o *Transport) {
+		o.SMBSecurityOptions = append(o.SMBSecurityOptions, mechanismOption(m, defaultConfig...))
+	}
+}
+
+// smbSecurityOptions function returns the security context options for the
+// SMB session given the RPC security context options `opts`.
+func (s *Transport) smbSecurityOptions(opts []gssapi.ContextOption) []gssapi.ContextOption {
+
+	if len(s.SMBSecurityOptions) == 0 {
+		return opts
+	}
+
+	smbOpts := append([]gssapi.ContextOption{}, s.SMBSecurityOptions...)
+
+	for _, o := range smbOpts {
+		if _, ok := o.(gssapi.MechanismFactory); ok {
+			return smbOpts
+		}
+	}
+
+	// inherit the mechanisms of the rpc security context.
+	for _, o := range opts {
+		if _, ok := o.(gssapi.MechanismFactory); ok {
+			smbOpts = append(smbOpts, o)
+		}
+	}
+
+	return smbOpts
+}
+
+// WithSMBSession function sets the already established SMB2/3 session
+// (*smb2.Session) or mounted IPC$ share (*smb2.Share) from the
+// github.com/oiweiwei/go-smb2.fork package, that is used to open the named
+// pipes instead of establishing the new SMB connection:
+//
+//	session, err := (&smb2.Dialer{Initiator: initiator}).Dial(tcpConn)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_np:dc01[samr]", dcerpc.WithSMBSession(session))
+//
+// The session is not logged off when the connection is closed.
+func WithSMBSession(session any) ConnectOption {
+	return func(o *Transport) { o.SMBSession = session }
+}
+
+// WithLocalPipes option enables the local transport: when the server
+// address refers to the local host ("localhost", "." or the loopback
+// address), the ncacn_np bindings are opened as `\\.\pipe\<name>` with the
+// operating system, bypassing the SMB stack. The caller's process token is
+// used for the authentication at the named pipe level. (Windows only).
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_np:localhost[lsarpc]", dcerpc.WithLocalPipes())
+//
+// Note, that ALPC is not implemented, the ncalrpc bindings are always opened
+// as the local named pipes (windows only).
+func WithLocalPipes() ConnectOption {
+	return func(o *Transport) { o.LocalPipes = true }
+}
+
 // WithEndpointMapper option sets the endpoint mapper to find the endpoint
 // (port or named pipe) for the selected abstract syntax.
 //
@@ -140,15 +458,18 @@ func NewTransport() Transport {
 		MaxXmitFrag:                  DefaultXmitSize,
 		DataRepresentation:           ndr.DefaultDataRepresentation,
 		MultiplexingOutstandingCalls: 64,
+		BindFeatures:                 BindFlags,
 		Timeout:                      10 * time.Second,
 		Deadline:                     3 * time.Second,
 		SMBPort:                      445,
+		TargetPolicy:                 GlobalTargetPolicy(),
 	}
 }
 
 func (s Transport) MarshalZerologObject(e *zerolog.Event) {
 	e.Int("max_xmit_frag", s.MaxXmitFrag)
 	e.Int("max_recv_frag", s.MaxRecvFrag)
+	e.Int("max_reassembly_size", s.MaxReassemblySize)
 	e.Int("group_id", s.GroupID)
 	e.Str("secondary_addr", s.SecondaryAddr)
 	e.Bool("multiplexing", s.Multiplexing)
@@ -0,0 +1,48 @@
+package dcerpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+func TestSMBSecurityOptions(t *testing.T) {
+
+	user := credential.NewFromPassword("CONTOSO\\user", "password")
+	machine := credential.NewFromPassword("CONTOSO\\WS01$", "password")
+
+	rpc := ParseSecurityOptions(context.Background(), WithMechanism(ssp.KRB5), WithCredentials(user)).SecurityOptions
+
+	credentials := func(opts []gssapi.ContextOption) []any {
+		var ret []any
+		for _, o := range opts {
+			if cred, ok := o.(gssapi.Credential); ok {
+				ret = append(ret, cred.Value())
+			}
+		}
+		return ret
+	}
+
+	// the smb session shares the rpc security context options by default.
+	s := NewTransport()
+	if opts := s.smbSecurityOptions(rpc); len(opts) != 2 || credentials(opts)[0] != user {
+		t.Fatalf("unexpected smb security options: %v", opts)
+	}
+
+	// the smb credentials inherit the rpc mechanisms.
+	WithSMBCredentials(machine)(&s)
+	opts := s.smbSecurityOptions(rpc)
+	if len(opts) != 2 || len(credentials(opts)) != 1 || credentials(opts)[0] != machine || opts[1] != ssp.KRB5 {
+		t.Fatalf("unexpected smb security options: %v", opts)
+	}
+
+	// the smb mechanism overrides the rpc mechanisms.
+	WithSMBMechanism(ssp.NTLM)(&s)
+	opts = s.smbSecurityOptions(rpc)
+	if len(opts) != 2 || credentials(opts)[0] != machine || opts[1] != ssp.NTLM {
+		t.Fatalf("unexpected smb security options: %v", opts)
+	}
+}
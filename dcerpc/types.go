@@ -2,6 +2,7 @@ package dcerpc
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
 	"github.com/oiweiwei/go-msrpc/ndr"
@@ -143,6 +144,15 @@ const (
 	AuthTypeNotRecognized                ProviderReason = 0x0008
 	InvalidChecksum                      ProviderReason = 0x0009
 
+	// bind_nak reject reasons (p_reject_reason_t).
+	ReasonTemporaryCongestion         ProviderReason = 0x0001
+	ReasonLocalLimitExceeded          ProviderReason = 0x0002
+	ReasonCalledPAddrUnknown          ProviderReason = 0x0003
+	ReasonProtocolVersionNotSupported ProviderReason = 0x0004
+	ReasonDefaultContextNotSupported  ProviderReason = 0x0005
+	ReasonUserDataNotReadable         ProviderReason = 0x0006
+	ReasonNoPSAPAvailable             ProviderReason = 0x0007
+
 	// bind time feature negotiation flags.
 	SecurityContextMultiplexing ProviderReason = 0x0001
 	KeepConnOpenOnOrphaned      ProviderReason = 0x0002
@@ -184,6 +194,19 @@ func (result *Result) Error() string {
 	return def
 }
 
+// Unwrap function returns the error for the provider reason.
+func (result *Result) Unwrap() error {
+	switch result.ProviderReason {
+	case AbstractSyntaxNotSupported:
+		return ErrAbstractSyntaxNotSupported
+	case ProposedTransferSyntaxesNotSupported:
+		return ErrTransferSyntaxNotSupported
+	case LocalLimitExceeded:
+		return ErrLocalLimitExceeded
+	}
+	return nil
+}
+
 func (result *Result) WriteTo(ctx context.Context, w ndr.Writer) error {
 	w.WriteData((uint16)(result.DefResult))
 	w.WriteData((uint16)(result.ProviderReason))
@@ -250,6 +273,11 @@ type Version struct {
 	Minor uint8
 }
 
+// String function returns the version in "major.minor" format.
+func (version *Version) String() string {
+	return strconv.Itoa(int(version.Major)) + "." + strconv.Itoa(int(version.Minor))
+}
+
 func (version *Version) WriteTo(ctx context.Context, w ndr.Writer) error {
 	w.WriteData(version.Major)
 	w.WriteData(version.Minor)
@@ -230,6 +230,80 @@ func (o *VerificationTrailer) ReadFrom(ctx context.Context, r ndr.Reader) error
 	return r.Err()
 }
 
+// VerificationInfo is the request state the verification trailer is
+// verified against.
+type VerificationInfo struct {
+	// The request PDU header.
+	Header Header
+	// The request presentation context identifier.
+	ContextID uint16
+	// The request operation number.
+	OpNum uint16
+	// The abstract syntax negotiated for the presentation context.
+	AbstractSyntax *SyntaxID
+	// The transfer syntax negotiated for the presentation context.
+	TransferSyntax *SyntaxID
+	// The PFC_SUPPORT_HEADER_SIGN flag was present in the bind PDU.
+	SignHeader bool
+}
+
+// Verify function verifies the verification trailer commands against the
+// request. The command mismatch or the unknown command that must be processed
+// is returned as ErrVerificationTrailer. The unknown optional commands are ignored.
+func (o *VerificationTrailer) Verify(info *VerificationInfo) error {
+
+	for _, cmd := range o.Commands {
+		switch v := cmd.Command.(type) {
+		case VerifyBitMask:
+			if v&VerifyBitMaskSupportHeaderSign != 0 && !info.SignHeader {
+				return fmt.Errorf("%w: header signing is not supported for the connection", ErrVerificationTrailer)
+			}
+		case *VerifyHeader2:
+			if v.PacketType != info.Header.PacketType || v.PacketDRep != info.Header.PacketDRep ||
+				v.CallID != info.Header.CallID || v.ContextID != info.ContextID || v.OpNum != info.OpNum {
+				return fmt.Errorf("%w: header2: call_id %d, p_cont_id %d, opnum %d", ErrVerificationTrailer, v.CallID, v.ContextID, v.OpNum)
+			}
+		case *VerifyPresentation:
+			if !v.InterfaceID.Is(info.AbstractSyntax) || !v.TransferSyntax.Is(info.TransferSyntax) {
+				return fmt.Errorf("%w: pcontext: %s v%d.%d", ErrVerificationTrailer, v.InterfaceID.IfUUID, v.InterfaceID.IfVersionMajor, v.InterfaceID.IfVersionMinor)
+			}
+		default:
+			if cmd.Required {
+				return fmt.Errorf("%w: unsupported command %#04x", ErrVerificationTrailer, uint16(cmd.CommandType))
+			}
+		}
+	}
+
+	return nil
+}
+
+// splitVerificationTrailer function returns the stub data without the verification
+// trailer and the trailer (if present). The trailer is located at the 4-byte
+// aligned offset at the end of the stub data.
+func splitVerificationTrailer(ctx context.Context, stub []byte) ([]byte, *VerificationTrailer) {
+
+	for end := len(stub); ; {
+
+		idx := bytes.LastIndex(stub[:end], VerificationTrailerSignature[:])
+		if idx < 0 {
+			return stub, nil
+		}
+
+		if end = idx; idx%4 != 0 {
+			continue
+		}
+
+		vt := &VerificationTrailer{}
+		r := ndr.NDR20(stub[idx:], ndr.DefaultDataRepresentation)
+		if err := vt.ReadFrom(ctx, r); err != nil || r.Offset() != len(stub)-idx {
+			// not a trailer, or the trailer is not at the end of the stub data.
+			continue
+		}
+
+		return stub[:idx], vt
+	}
+}
+
 type VerificationCommand struct {
 	CommandType CommandType
 	Required    bool
@@ -0,0 +1,67 @@
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestVerificationTrailerVerify(t *testing.T) {
+
+	ctx := context.Background()
+
+	syntax := &SyntaxID{IfUUID: &uuid.UUID{TimeLow: 1}, IfVersionMajor: 1}
+
+	info := &VerificationInfo{
+		Header:         Header{PacketType: PacketTypeRequest, PacketDRep: ndr.DefaultDataRepresentation, CallID: 7},
+		ContextID:      1,
+		OpNum:          3,
+		AbstractSyntax: syntax,
+		TransferSyntax: TransferNDRSyntaxV2_0,
+	}
+
+	header2 := func(callID uint32, contextID uint16) *VerifyHeader2 {
+		return &VerifyHeader2{PacketType: PacketTypeRequest, PacketDRep: ndr.DefaultDataRepresentation, CallID: callID, ContextID: contextID, OpNum: 3}
+	}
+
+	for _, testCase := range []struct {
+		Name     string
+		Commands []*VerificationCommand
+		Err      bool
+	}{
+		{"valid", []*VerificationCommand{
+			{Command: VerifyBitMask(0), Required: true},
+			{Command: header2(7, 1), Required: true},
+			{Command: &VerifyPresentation{InterfaceID: syntax, TransferSyntax: TransferNDRSyntaxV2_0}, Required: true},
+		}, false},
+		{"header sign not negotiated", []*VerificationCommand{{Command: VerifyBitMaskSupportHeaderSign}}, true},
+		{"spoofed call id", []*VerificationCommand{{Command: header2(8, 1)}}, true},
+		{"spoofed context", []*VerificationCommand{{Command: header2(7, 2)}}, true},
+		{"spoofed interface", []*VerificationCommand{{Command: &VerifyPresentation{InterfaceID: TransferNDRSyntaxV2_0, TransferSyntax: TransferNDRSyntaxV2_0}}}, true},
+		{"unknown optional", []*VerificationCommand{{CommandType: 0x10, Command: []byte{1, 2, 3, 4}}}, false},
+		{"unknown required", []*VerificationCommand{{CommandType: 0x10, Command: []byte{1, 2, 3, 4}, Required: true}}, true},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+
+			vt := &VerificationTrailer{Commands: testCase.Commands}
+
+			b, err := ndr.NDR20(nil, ndr.DefaultDataRepresentation).Marshal(ctx, ndr.MarshalNDRFunc(vt.WriteTo))
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			// stub data (6 bytes), pad (2 bytes), verification trailer.
+			stub, decoded := splitVerificationTrailer(ctx, append([]byte{1, 2, 3, 4, 5, 6, 0, 0}, b...))
+			if decoded == nil || len(stub) != 8 {
+				t.Fatalf("trailer not found: %d", len(stub))
+			}
+
+			if err := decoded.Verify(info); errors.Is(err, ErrVerificationTrailer) != testCase.Err {
+				t.Fatalf("unexpected verify result: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package dcerpc
+
+// version.go contains the interface minor version negotiation.
+
+import (
+	"context"
+	"errors"
+)
+
+// The maximum number of the lower minor versions proposed with the version
+// negotiation.
+var MaxVersionFallback = 16
+
+// WithVersionNegotiation option enables the interface minor version negotiation:
+// when the presentation context is rejected with the abstract syntax not supported
+// (as the server implements the older minor version of the interface), the lower
+// minor versions are proposed with alter_context, and the connection is bound to
+// the highest version accepted by the server. The negotiated version is returned
+// with the connection information (see ConnInfo).
+//
+//	cli, err := drsuapi.NewDrsuapiClient(ctx, conn, dcerpc.WithVersionNegotiation())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	syntax := cli.Conn().Info().AbstractSyntax
+func WithVersionNegotiation() BindOption {
+	return BindOption(func(o *option) {
+		o.VersionNegotiation = true
+	})
+}
+
+// hasVersionNegotiation function returns `true` if the set of options enables
+// the version negotiation.
+func hasVersionNegotiation(opts []Option) bool {
+	o := &option{}
+	for i := range opts {
+		if opt, ok := opts[i].(BindOption); ok {
+			opt(o)
+		}
+	}
+	return o.VersionNegotiation
+}
+
+// withPresentations option specifies the presentation context list.
+func withPresentations(ps []*Presentation) BindOption {
+	return BindOption(func(o *option) {
+		o.Presentations = ps
+	})
+}
+
+// negotiateVersion function proposes the lower minor versions of the abstract
+// syntax rejected for the connection `cc` and returns the connection bound to the
+// highest accepted version. If no version was accepted, `cc` is returned.
+func (c *transport) negotiateVersion(ctx context.Context, cc Conn, opts []Option) (Conn, error) {
+
+	conn, ok := cc.(*clientConn)
+	if !ok || !hasVersionNegotiation(opts) {
+		return cc, nil
+	}
+
+	p := conn.presentation
+	if p == nil || p.AbstractSyntax == nil || p.AbstractSyntax.IfVersionMinor == 0 {
+		return cc, nil
+	}
+
+	if !errors.Is(p.Error, ErrAbstractSyntaxNotSupported) {
+		return cc, nil
+	}
+
+	syntax := p.AbstractSyntax
+
+	var ps []*Presentation
+	// propose the versions in the descending order.
+	for minor := int(syntax.IfVersionMinor) - 1; minor >= 0 && len(ps) < MaxVersionFallback; minor-- {
+		ps = append(ps, NewPresentation(&SyntaxID{
+			IfUUID:         syntax.IfUUID,
+			IfVersionMajor: syntax.IfVersionMajor,
+			IfVersionMinor: uint16(minor),
+		}))
+	}
+
+	if len(ps) == 0 {
+		return cc, nil
+	}
+
+	// the security context is established with the bind.
+	alterOpts := []Option{WithLogger(conn.logger), withEstablishedSecurity(conn.security)}
+	for i := range opts {
+		switch opts[i].(type) {
+		case SecurityOption, SecurityContextOption:
+		default:
+			alterOpts = append(alterOpts, opts[i])
+		}
+	}
+
+	alt, err := c.AlterContext(ctx, append(alterOpts, withPresentations(ps))...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sub := range alt.(*clientConn).subs {
+		if sub.presentation.Error == nil {
+			c.logger.Debug().
+				Str("uuid", syntax.IfUUID.String()).
+				Uint16("requested_version_minor", syntax.IfVersionMinor).
+				Uint16("negotiated_version_minor", sub.presentation.AbstractSyntax.IfVersionMinor).
+				Msg("negotiated_version")
+			// keep the original options, so that the version is
+			// negotiated again on reconnect.
+			for _, s := range sub.subs {
+				s.opts = opts
+			}
+			return sub, nil
+		}
+	}
+
+	return cc, nil
+}
@@ -0,0 +1,63 @@
+package dcerpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestVersionNegotiation(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	// the server implements the version 1.0 of the interface.
+	srv := dcerpc.NewServer()
+	srv.Register(echoSyntax, func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		op := &echoOp{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, nil
+	})
+
+	go srv.Serve(ln)
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	// the client requests the version 1.2.
+	syntax := &dcerpc.SyntaxID{IfUUID: echoSyntax.IfUUID, IfVersionMajor: 1, IfVersionMinor: 2}
+
+	cc, err := conn.Bind(ctx, dcerpc.WithAbstractSyntax(syntax), dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if err := cc.Invoke(ctx, &echoOp{Value: 1}); !errors.Is(err, dcerpc.ErrAbstractSyntaxNotSupported) {
+		t.Fatalf("expected abstract syntax not supported, got %v", err)
+	}
+
+	// the lower version is negotiated with alter_context.
+	cc, err = conn.Bind(ctx, dcerpc.WithAbstractSyntax(syntax), dcerpc.WithInsecure(), dcerpc.WithVersionNegotiation())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	op := &echoOp{Value: 2}
+	if err := cc.Invoke(ctx, op); err != nil || op.Reply != 2 {
+		t.Fatalf("invoke: %v (reply %d)", err, op.Reply)
+	}
+
+	if info := cc.Info(); info.AbstractSyntax == nil || info.AbstractSyntax.IfVersionMinor != 0 {
+		t.Fatalf("unexpected negotiated syntax: %+v", info.AbstractSyntax)
+	}
+}
@@ -0,0 +1,194 @@
+//go:build exclude
+
+// ids_corpus.go script generates the labeled pcap corpus of the representative
+// benign calls and known-abuse patterns for the IDS/IPS rule testing.
+//
+// The calls are recorded against the in-process loopback responder, no network
+// traffic is produced.
+//
+//	go run examples/ids_corpus.go -o ./corpus
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/corpus"
+	"github.com/oiweiwei/go-msrpc/msrpc/drsr/drsuapi/v4"
+	"github.com/oiweiwei/go-msrpc/msrpc/lsat/lsarpc/v0"
+	"github.com/oiweiwei/go-msrpc/msrpc/rrp/winreg/v1"
+	"github.com/oiweiwei/go-msrpc/msrpc/samr/samr/v1"
+	"github.com/oiweiwei/go-msrpc/msrpc/scmr/svcctl/v2"
+	"github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+	"github.com/oiweiwei/go-msrpc/msrpc/tsch/itaskschedulerservice/v1"
+	"github.com/oiweiwei/go-msrpc/msrpc/wkst/wkssvc/v1"
+)
+
+var (
+	dir   = flag.String("o", "corpus", "output directory")
+	sweep = flag.Bool("sweep", false, "also record every method of each interface")
+)
+
+var scenarios = []*corpus.Scenario{
+	// benign.
+	{
+		Name:      "srvsvc-share-enum",
+		Class:     corpus.ClassBenign,
+		NewClient: corpus.Client(srvsvc.NewSrvsvcClient),
+		Steps: []*corpus.Step{
+			{Method: "GetInfo"},
+			{Method: "ShareEnum", Request: &srvsvc.ShareEnumRequest{ServerName: "\\\\dc01"}},
+		},
+	},
+	{
+		Name:      "wkssvc-get-info",
+		Class:     corpus.ClassBenign,
+		NewClient: corpus.Client(wkssvc.NewWkssvcClient),
+		Steps:     []*corpus.Step{{Method: "GetInfo"}},
+	},
+	{
+		Name:      "lsarpc-lookup-names",
+		Class:     corpus.ClassBenign,
+		NewClient: corpus.Client(lsarpc.NewLsarpcClient),
+		Steps: []*corpus.Step{
+			{Method: "OpenPolicy2"},
+			{Method: "LookupNames2"},
+			{Method: "Close"},
+		},
+	},
+	{
+		Name:      "winreg-query-value",
+		Class:     corpus.ClassBenign,
+		NewClient: corpus.Client(winreg.NewWinregClient),
+		Steps: []*corpus.Step{
+			{Method: "OpenLocalMachine"},
+			{Method: "BaseRegOpenKey"},
+			{Method: "BaseRegQueryValue"},
+			{Method: "BaseRegCloseKey"},
+		},
+	},
+	// known-abuse patterns.
+	{
+		Name:      "scmr-remote-service",
+		Class:     corpus.ClassAbuse,
+		Technique: "T1569.002",
+		NewClient: corpus.Client(svcctl.NewSvcctlClient),
+		Steps: []*corpus.Step{
+			{Method: "OpenSCMW"},
+			{Method: "CreateServiceW", Request: &svcctl.CreateServiceWRequest{
+				ServiceName:    "updsvc",
+				DisplayName:    "updsvc",
+				BinaryPathName: "%COMSPEC% /C whoami > C:\\Windows\\Temp\\out.txt",
+			}},
+			{Method: "StartServiceW"},
+			{Method: "DeleteService"},
+		},
+	},
+	{
+		Name:      "tsch-remote-task",
+		Class:     corpus.ClassAbuse,
+		Technique: "T1053.005",
+		NewClient: corpus.Client(itaskschedulerservice.NewTaskSchedulerServiceClient),
+		Steps: []*corpus.Step{
+			{Method: "RegisterTask", Request: &itaskschedulerservice.RegisterTaskRequest{
+				Path: "\\updtask",
+				XML:  "<Task><Actions><Exec><Command>cmd.exe</Command></Exec></Actions></Task>",
+			}},
+			{Method: "Run", Request: &itaskschedulerservice.RunRequest{Path: "\\updtask"}},
+			{Method: "Delete", Request: &itaskschedulerservice.DeleteRequest{Path: "\\updtask"}},
+		},
+	},
+	{
+		Name:      "winreg-save-sam",
+		Class:     corpus.ClassAbuse,
+		Technique: "T1003.002",
+		NewClient: corpus.Client(winreg.NewWinregClient),
+		Steps: []*corpus.Step{
+			{Method: "OpenLocalMachine"},
+			{Method: "BaseRegOpenKey", Request: &winreg.BaseRegOpenKeyRequest{SubKey: &winreg.UnicodeString{Buffer: "SAM\x00"}}},
+			{Method: "BaseRegSaveKey", Request: &winreg.BaseRegSaveKeyRequest{File: &winreg.UnicodeString{Buffer: "C:\\Windows\\Temp\\sam.save\x00"}}},
+		},
+	},
+	{
+		Name:      "drsuapi-dcsync",
+		Class:     corpus.ClassAbuse,
+		Technique: "T1003.006",
+		NewClient: corpus.Client(drsuapi.NewDrsuapiClient),
+		Steps: []*corpus.Step{
+			{Method: "Bind"},
+			{Method: "CrackNames"},
+			{Method: "GetNCChanges"},
+			{Method: "Unbind"},
+		},
+	},
+	{
+		Name:      "samr-user-enumeration",
+		Class:     corpus.ClassAbuse,
+		Technique: "T1087.002",
+		NewClient: corpus.Client(samr.NewSamrClient),
+		Steps: []*corpus.Step{
+			{Method: "Connect5"},
+			{Method: "EnumerateDomainsInSAMServer"},
+			{Method: "OpenDomain"},
+			{Method: "EnumerateUsersInDomain"},
+			{Method: "GetMembersInAlias"},
+		},
+	},
+}
+
+// sweeps is the list of the interfaces to record with the -sweep flag.
+var sweeps = map[string]corpus.ClientFactory{
+	"srvsvc":  corpus.Client(srvsvc.NewSrvsvcClient),
+	"wkssvc":  corpus.Client(wkssvc.NewWkssvcClient),
+	"lsarpc":  corpus.Client(lsarpc.NewLsarpcClient),
+	"winreg":  corpus.Client(winreg.NewWinregClient),
+	"svcctl":  corpus.Client(svcctl.NewSvcctlClient),
+	"samr":    corpus.Client(samr.NewSamrClient),
+	"drsuapi": corpus.Client(drsuapi.NewDrsuapiClient),
+	"tsch":    corpus.Client(itaskschedulerservice.NewTaskSchedulerServiceClient),
+}
+
+func main() {
+
+	flag.Parse()
+
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *sweep {
+		for name, newClient := range sweeps {
+			scenarios = append(scenarios, &corpus.Scenario{
+				Name:      "sweep-" + name,
+				Class:     corpus.ClassBenign,
+				NewClient: newClient,
+			})
+		}
+	}
+
+	g := &corpus.Generator{Dir: *dir}
+
+	for _, s := range scenarios {
+		if err := g.Generate(context.Background(), s); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println("generated", filepath.Join(*dir, s.Name+".pcap"))
+	}
+
+	f, err := os.Create(filepath.Join(*dir, "labels.jsonl"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	if err := g.WriteLabels(f); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
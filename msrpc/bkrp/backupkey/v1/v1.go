@@ -214,6 +214,9 @@ func (o *xxx_BackupKeyOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DataIn", len(o.DataIn), o.DataInLength, "DataInLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -331,6 +334,9 @@ func (o *xxx_BackupKeyOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DataOut", len(o.DataOut), o.DataOutLength, "DataOutLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -204,6 +204,9 @@ func (o *xxx_ExchangePublicKeysOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ClientKey", len(o.ClientKey), o.ClientKeyLength, "ClientKeyLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -321,6 +324,9 @@ func (o *xxx_ExchangePublicKeysOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerKey", len(o.ServerKey), o.ServerKeyLength, "ServerKeyLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,394 @@
+// The capability package contains the matrix of the interface methods
+// (opnums) supported by the Windows Server versions, so that the tools can
+// adapt to the older servers instead of failing with nca_op_rng_error fault.
+//
+// The package ships the matrix for the MS-DHCPM dhcpsrv2 interface only (see
+// Dhcpsrv2), the matrices for the other interfaces can be added with the
+// Register function.
+//
+// The server version can be set explicitly (for example, from the build
+// number reported by the server), or fingerprinted by probing the first
+// read-only method introduced in each version:
+//
+//	cli, err := dhcpsrv2.NewDhcpsrv2Client(ctx, conn, dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	caps, err := capability.New(cli.Conn(), dhcpsrv2.Dhcpsrv2SyntaxV1_0)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	if ok, _ := caps.SupportsMethod(ctx, "GetPolicyExV4"); !ok {
+//		// fallback to GetPolicyV4.
+//	}
+//
+// The probe sends the request with the empty stub data: the server that does not
+// implement the method rejects the request with nca_op_rng_error fault, the server
+// that implements it normally fails to unmarshal the request. Since the server
+// may still execute the method, only the methods without side effects (listed in
+// Interface.Probes) are probed. If the version change has no such methods, the
+// fingerprinting stops at the previous version, so that the matrix never reports
+// the method that the server may not implement.
+package capability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// ServerVersion is the Windows Server version.
+type ServerVersion int
+
+const (
+	// Unknown version.
+	Unknown ServerVersion = iota
+	WindowsServer2008R2
+	WindowsServer2012
+	WindowsServer2012R2
+	WindowsServer2016
+	WindowsServer2019
+	WindowsServer2022
+	WindowsServer2025
+)
+
+// versions is the list of versions and the corresponding build numbers.
+var versions = []struct {
+	Version ServerVersion
+	Name    string
+	Build   int
+}{
+	{WindowsServer2008R2, "Windows Server 2008 R2", 7601},
+	{WindowsServer2012, "Windows Server 2012", 9200},
+	{WindowsServer2012R2, "Windows Server 2012 R2", 9600},
+	{WindowsServer2016, "Windows Server 2016", 14393},
+	{WindowsServer2019, "Windows Server 2019", 17763},
+	{WindowsServer2022, "Windows Server 2022", 20348},
+	{WindowsServer2025, "Windows Server 2025", 26100},
+}
+
+func (v ServerVersion) String() string {
+	for _, ver := range versions {
+		if ver.Version == v {
+			return ver.Name
+		}
+	}
+	return "unknown"
+}
+
+// Build function returns the build number of the version release.
+func (v ServerVersion) Build() int {
+	for _, ver := range versions {
+		if ver.Version == v {
+			return ver.Build
+		}
+	}
+	return 0
+}
+
+// ServerVersionFromBuild function returns the latest version released
+// at or before the build number.
+func ServerVersionFromBuild(build int) ServerVersion {
+	ret := Unknown
+	for _, ver := range versions {
+		if ver.Build <= build {
+			ret = ver.Version
+		}
+	}
+	return ret
+}
+
+// Change is the interface change introduced in the server version.
+type Change struct {
+	// The server version.
+	Version ServerVersion
+	// The opnums added in this version.
+	Added []int
+	// The opnums removed (deprecated and no longer served) in this version.
+	Removed []int
+}
+
+// Interface is the interface capability definition.
+type Interface struct {
+	// The interface name.
+	Name string
+	// The interface syntax.
+	SyntaxID *dcerpc.SyntaxID
+	// The method names indexed by opnum.
+	Methods []string
+	// The list of changes in ascending version order. The methods that
+	// are not mentioned are supported by all versions.
+	Changes []Change
+	// The opnums of the methods without side effects (Get, Enum, Query),
+	// that can be probed with the empty stub data.
+	Probes []int
+}
+
+// ErrUnsafeProbe is returned when the probed method is not listed in the
+// interface probes.
+var ErrUnsafeProbe = errors.New("capability: method may have side effects")
+
+// OpNum function returns the opnum for the method name.
+func (i *Interface) OpNum(name string) (int, bool) {
+	for opnum := range i.Methods {
+		if i.Methods[opnum] == name {
+			return opnum, true
+		}
+	}
+	return 0, false
+}
+
+// Supports function returns `true` if the method with opnum is supported
+// by the server version.
+func (i *Interface) Supports(ver ServerVersion, opnum int) bool {
+
+	if opnum < 0 || opnum >= len(i.Methods) {
+		return false
+	}
+
+	supported := true
+
+	for _, change := range i.Changes {
+		if contains(change.Added, opnum) {
+			supported = ver >= change.Version
+		}
+		if contains(change.Removed, opnum) && ver >= change.Version {
+			supported = false
+		}
+	}
+
+	return supported
+}
+
+// probeFor function returns the first opnum from `opnums` that can be probed.
+func (i *Interface) probeFor(opnums []int) (int, bool) {
+	for _, opnum := range opnums {
+		if contains(i.Probes, opnum) {
+			return opnum, true
+		}
+	}
+	return 0, false
+}
+
+func contains(opnums []int, opnum int) bool {
+	for _, n := range opnums {
+		if n == opnum {
+			return true
+		}
+	}
+	return false
+}
+
+// Range function returns the list of opnums from `from` to `to` (inclusive).
+func Range(from, to int) []int {
+	ret := make([]int, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		ret = append(ret, i)
+	}
+	return ret
+}
+
+var (
+	mu     sync.RWMutex
+	matrix = map[string]*Interface{}
+)
+
+func key(syntax *dcerpc.SyntaxID) string {
+	return fmt.Sprintf("%s/v%d.%d", syntax.IfUUID, syntax.IfVersionMajor, syntax.IfVersionMinor)
+}
+
+// Register function adds the interface definition to the matrix.
+func Register(iface *Interface) {
+	mu.Lock()
+	defer mu.Unlock()
+	matrix[key(iface.SyntaxID)] = iface
+}
+
+// Lookup function returns the interface definition.
+func Lookup(syntax *dcerpc.SyntaxID) (*Interface, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	iface, ok := matrix[key(syntax)]
+	return iface, ok
+}
+
+// Capabilities is the server capability checker for the single interface.
+type Capabilities struct {
+	mu sync.Mutex
+	cc dcerpc.Conn
+	// the interface definition.
+	iface *Interface
+	// the server version.
+	version ServerVersion
+	// the probe results.
+	probes map[int]bool
+}
+
+// Option is the capability checker option.
+type Option func(*Capabilities)
+
+// WithServerVersion option sets the known server version, so that the
+// fingerprinting is not required.
+func WithServerVersion(ver ServerVersion) Option {
+	return func(c *Capabilities) { c.version = ver }
+}
+
+// WithBuild option sets the server build number.
+func WithBuild(build int) Option {
+	return WithServerVersion(ServerVersionFromBuild(build))
+}
+
+// New function returns the capability checker for the interface bound
+// over the connection `cc`.
+func New(cc dcerpc.Conn, syntax *dcerpc.SyntaxID, opts ...Option) (*Capabilities, error) {
+
+	iface, ok := Lookup(syntax)
+	if !ok {
+		return nil, fmt.Errorf("capability: interface %s is not registered", key(syntax))
+	}
+
+	c := &Capabilities{cc: cc, iface: iface, probes: make(map[int]bool)}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	return c, nil
+}
+
+// ServerVersion function returns the known or fingerprinted server version.
+// Note, that the fingerprint is accurate only up to the latest version that
+// changed the interface.
+func (c *Capabilities) ServerVersion(ctx context.Context) (ServerVersion, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.version != Unknown {
+		return c.version, nil
+	}
+
+	version := versions[0].Version
+
+	for _, change := range c.iface.Changes {
+		if len(change.Added) == 0 || change.Version <= version {
+			continue
+		}
+		opnum, ok := c.iface.probeFor(change.Added)
+		if !ok {
+			// the change cannot be fingerprinted safely.
+			break
+		}
+		ok, err := c.probe(ctx, opnum)
+		if err != nil {
+			return Unknown, err
+		}
+		if !ok {
+			break
+		}
+		version = change.Version
+	}
+
+	c.version = version
+
+	return c.version, nil
+}
+
+// SupportsMethod function returns `true` if the server supports the method.
+func (c *Capabilities) SupportsMethod(ctx context.Context, name string) (bool, error) {
+
+	opnum, ok := c.iface.OpNum(name)
+	if !ok {
+		return false, fmt.Errorf("capability: %s: unknown method %q", c.iface.Name, name)
+	}
+
+	ver, err := c.ServerVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return c.iface.Supports(ver, opnum), nil
+}
+
+// Probe function checks whether the server implements the method with
+// the opnum by sending the request with empty stub data. The method must be
+// listed in the interface probes, otherwise ErrUnsafeProbe is returned.
+func (c *Capabilities) Probe(ctx context.Context, opnum int) (bool, error) {
+
+	if !contains(c.iface.Probes, opnum) {
+		return false, fmt.Errorf("%w: %s: opnum %d", ErrUnsafeProbe, c.iface.Name, opnum)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.probe(ctx, opnum)
+}
+
+func (c *Capabilities) probe(ctx context.Context, opnum int) (bool, error) {
+
+	if ok, found := c.probes[opnum]; found {
+		return ok, nil
+	}
+
+	err := c.cc.Invoke(ctx, &probeOperation{opnum: opnum})
+	if err == nil {
+		// the read-only method was executed.
+		c.probes[opnum] = true
+		return true, nil
+	}
+
+	if errors.Is(err, rpcerrors.OperationRangeError) {
+		c.probes[opnum] = false
+		return false, nil
+	}
+
+	if isTransportError(ctx, err) {
+		return false, fmt.Errorf("capability: probe opnum %d: %w", opnum, err)
+	}
+
+	// the server fails to unmarshal the request (nca_s_fault_ndr,
+	// rpc_x_bad_stub_data, etc).
+	c.probes[opnum] = true
+
+	return true, nil
+}
+
+// isTransportError function returns `true` if the error is not the fault
+// returned by the server for the request.
+func isTransportError(ctx context.Context, err error) bool {
+
+	if ctx.Err() != nil || errors.Is(err, rpcerrors.UnknownInterface) {
+		return true
+	}
+
+	if errors.Is(err, dcerpc.ErrShutdown) || errors.Is(err, dcerpc.ErrConnClosed) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
+
+// probeOperation is the operation with the empty stub data.
+type probeOperation struct {
+	opnum int
+}
+
+func (o *probeOperation) OpNum() int                                                  { return o.opnum }
+func (o *probeOperation) OpName() string                                              { return "probe" }
+func (o *probeOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error   { return nil }
+func (o *probeOperation) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error { return nil }
+func (o *probeOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error  { return nil }
+func (o *probeOperation) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	return nil
+}
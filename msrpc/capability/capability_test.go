@@ -0,0 +1,114 @@
+package capability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+)
+
+// server is the fake connection that implements opnums below `max`.
+type server struct {
+	dcerpc.Conn
+	max    int
+	calls  int
+	opnums []int
+}
+
+func (s *server) Invoke(ctx context.Context, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+	s.opnums = append(s.opnums, op.OpNum())
+	if s.calls++; op.OpNum() >= s.max {
+		return rpcerrors.OperationRangeError
+	}
+	// rpc_x_bad_stub_data.
+	return fmt.Errorf("%w", &rpcerrors.Error{Value: uint32(0x000006f7)})
+}
+
+func TestSupportsMethod(t *testing.T) {
+
+	ctx := context.Background()
+
+	// windows server 2012 r2.
+	srv := &server{max: 126}
+
+	caps, err := New(srv, Dhcpsrv2.SyntaxID)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+
+	for name, expected := range map[string]bool{
+		"EnumSubnetClientsV5": true,
+		"CreatePolicyV4":      true,
+		"GetFreeIPAddressV4":  true,
+		"GetPolicyExV4":       false,
+	} {
+		ok, err := caps.SupportsMethod(ctx, name)
+		if err != nil {
+			t.Fatalf("supports method: %s: %v", name, err)
+		}
+		if ok != expected {
+			t.Errorf("supports method: %s: expected %v, got %v", name, expected, ok)
+		}
+	}
+
+	if ver, _ := caps.ServerVersion(ctx); ver != WindowsServer2012R2 {
+		t.Errorf("server version: unexpected %s", ver)
+	}
+
+	if srv.calls != 3 {
+		t.Errorf("probes are not cached: %d calls", srv.calls)
+	}
+
+	for _, opnum := range srv.opnums {
+		if !contains(Dhcpsrv2.Probes, opnum) {
+			t.Errorf("probe: opnum %d may have side effects", opnum)
+		}
+	}
+
+	// CreatePolicyExV4.
+	if _, err := caps.Probe(ctx, 126); !errors.Is(err, ErrUnsafeProbe) {
+		t.Errorf("probe: expected unsafe probe error, got %v", err)
+	}
+
+	if _, err := caps.SupportsMethod(ctx, "NoSuchMethod"); err == nil {
+		t.Errorf("supports method: expected unknown method error")
+	}
+
+	// known version, no probes.
+	caps, _ = New(srv, Dhcpsrv2.SyntaxID, WithBuild(9200))
+
+	if ok, _ := caps.SupportsMethod(ctx, "GetFreeIPAddressV4"); ok || srv.calls != 3 {
+		t.Errorf("supports method: unexpected result for known version")
+	}
+}
+
+func TestServerVersionNoProbe(t *testing.T) {
+
+	ctx := context.Background()
+
+	Register(&Interface{
+		Name:     "test",
+		SyntaxID: &dcerpc.SyntaxID{IfVersionMajor: 1},
+		Methods:  []string{"Get", "Delete", "Enum"},
+		Changes: []Change{
+			{Version: WindowsServer2012, Added: []int{1}},
+			{Version: WindowsServer2016, Added: []int{2}},
+		},
+		Probes: []int{0, 2},
+	})
+
+	srv := &server{max: 3}
+
+	caps, err := New(srv, &dcerpc.SyntaxID{IfVersionMajor: 1})
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+
+	// the change without the probes stops the fingerprinting.
+	if ver, _ := caps.ServerVersion(ctx); ver != WindowsServer2008R2 || srv.calls != 0 {
+		t.Errorf("server version: unexpected %s (%d calls)", ver, srv.calls)
+	}
+}
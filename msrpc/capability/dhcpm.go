@@ -0,0 +1,180 @@
+package capability
+
+import (
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+func init() {
+	Register(Dhcpsrv2)
+}
+
+// Dhcpsrv2 is the MS-DHCPM dhcpsrv2 interface capability definition.
+var Dhcpsrv2 = &Interface{
+	Name:     "dhcpsrv2",
+	SyntaxID: dhcpsrv2.Dhcpsrv2SyntaxV1_0,
+	Methods: []string{
+		"EnumSubnetClientsV5",                   // 0
+		"SetMScopeInfo",                         // 1
+		"GetMScopeInfo",                         // 2
+		"EnumMScopes",                           // 3
+		"AddMScopeElement",                      // 4
+		"EnumMScopeElements",                    // 5
+		"RemoveMScopeElement",                   // 6
+		"DeleteMScope",                          // 7
+		"ScanMDatabase",                         // 8
+		"CreateMClientInfo",                     // 9
+		"SetMClientInfo",                        // 10
+		"GetMClientInfo",                        // 11
+		"DeleteMClientInfo",                     // 12
+		"EnumMScopeClients",                     // 13
+		"CreateOptionV5",                        // 14
+		"SetOptionInfoV5",                       // 15
+		"GetOptionInfoV5",                       // 16
+		"EnumOptionsV5",                         // 17
+		"RemoveOptionV5",                        // 18
+		"SetOptionValueV5",                      // 19
+		"SetOptionValuesV5",                     // 20
+		"GetOptionValueV5",                      // 21
+		"EnumOptionValuesV5",                    // 22
+		"RemoveOptionValueV5",                   // 23
+		"CreateClass",                           // 24
+		"ModifyClass",                           // 25
+		"DeleteClass",                           // 26
+		"GetClassInfo",                          // 27
+		"EnumClasses",                           // 28
+		"GetAllOptions",                         // 29
+		"GetAllOptionValues",                    // 30
+		"GetMCastMIBInfo",                       // 31
+		"AuditLogSetParams",                     // 32
+		"AuditLogGetParams",                     // 33
+		"ServerQueryAttribute",                  // 34
+		"ServerQueryAttributes",                 // 35
+		"ServerRedoAuthorization",               // 36
+		"AddSubnetElementV5",                    // 37
+		"EnumSubnetElementsV5",                  // 38
+		"RemoveSubnetElementV5",                 // 39
+		"GetServerBindingInfo",                  // 40
+		"SetServerBindingInfo",                  // 41
+		"QueryDNSRegCredentials",                // 42
+		"SetDNSRegCredentials",                  // 43
+		"BackupDatabase",                        // 44
+		"RestoreDatabase",                       // 45
+		"GetServerSpecificStrings",              // 46
+		"CreateOptionV6",                        // 47
+		"SetOptionInfoV6",                       // 48
+		"GetOptionInfoV6",                       // 49
+		"EnumOptionsV6",                         // 50
+		"RemoveOptionV6",                        // 51
+		"SetOptionValueV6",                      // 52
+		"EnumOptionValuesV6",                    // 53
+		"RemoveOptionValueV6",                   // 54
+		"GetAllOptionsV6",                       // 55
+		"GetAllOptionValuesV6",                  // 56
+		"CreateSubnetV6",                        // 57
+		"EnumSubnetsV6",                         // 58
+		"AddSubnetElementV6",                    // 59
+		"EnumSubnetElementsV6",                  // 60
+		"RemoveSubnetElementV6",                 // 61
+		"DeleteSubnetV6",                        // 62
+		"GetSubnetInfoV6",                       // 63
+		"EnumSubnetClientsV6",                   // 64
+		"ServerSetConfigV6",                     // 65
+		"ServerGetConfigV6",                     // 66
+		"SetSubnetInfoV6",                       // 67
+		"GetMIBInfoV6",                          // 68
+		"GetServerBindingInfoV6",                // 69
+		"SetServerBindingInfoV6",                // 70
+		"SetClientInfoV6",                       // 71
+		"GetClientInfoV6",                       // 72
+		"DeleteClientInfoV6",                    // 73
+		"CreateClassV6",                         // 74
+		"ModifyClassV6",                         // 75
+		"DeleteClassV6",                         // 76
+		"EnumClassesV6",                         // 77
+		"GetOptionValueV6",                      // 78
+		"SetSubnetDelayOffer",                   // 79
+		"GetSubnetDelayOffer",                   // 80
+		"GetMIBInfoV5",                          // 81
+		"AddFilterV4",                           // 82
+		"DeleteFilterV4",                        // 83
+		"SetFilterV4",                           // 84
+		"GetFilterV4",                           // 85
+		"EnumFilterV4",                          // 86
+		"SetDNSRegCredentialsV5",                // 87
+		"EnumSubnetClientsFilterStatusInfo",     // 88
+		"FailoverCreateRelationshipV4",          // 89
+		"FailoverSetRelationshipV4",             // 90
+		"FailoverDeleteRelationshipV4",          // 91
+		"FailoverGetRelationshipV4",             // 92
+		"FailoverEnumRelationshipV4",            // 93
+		"FailoverAddScopeToRelationshipV4",      // 94
+		"FailoverDeleteScopeFromRelationshipV4", // 95
+		"FailoverGetScopeRelationshipV4",        // 96
+		"FailoverGetScopeStatisticsV4",          // 97
+		"FailoverGetClientInfoV4",               // 98
+		"FailoverGetSystemTimeV4",               // 99
+		"FailoverTriggerAddrAllocationV4",       // 100
+		"SetOptionValueV4",                      // 101
+		"SetOptionValuesV4",                     // 102
+		"GetOptionValueV4",                      // 103
+		"RemoveOptionValueV4",                   // 104
+		"GetAllOptionValuesV4",                  // 105
+		"QueryPolicyEnforcementV4",              // 106
+		"SetPolicyEnforcementV4",                // 107
+		"CreatePolicyV4",                        // 108
+		"GetPolicyV4",                           // 109
+		"SetPolicyV4",                           // 110
+		"DeletePolicyV4",                        // 111
+		"EnumPoliciesV4",                        // 112
+		"AddPolicyRangeV4",                      // 113
+		"RemovePolicyRangeV4",                   // 114
+		"EnumSubnetClientsV4",                   // 115
+		"SetStatelessStoreParamsV6",             // 116
+		"GetStatelessStoreParamsV6",             // 117
+		"GetStatelessStatisticsV6",              // 118
+		"EnumSubnetReservationsV4",              // 119
+		"GetFreeIPAddressV4",                    // 120
+		"GetFreeIPAddressV6",                    // 121
+		"CreateClientInfoV4",                    // 122
+		"GetClientInfoV4",                       // 123
+		"CreateClientInfoV6",                    // 124
+		"FailoverGetAddressStatusV4",            // 125
+		"CreatePolicyExV4",                      // 126
+		"GetPolicyExV4",                         // 127
+		"SetPolicyExV4",                         // 128
+		"EnumPoliciesExV4",                      // 129
+		"EnumSubnetClientsExV4",                 // 130
+		"CreateClientInfoExV4",                  // 131
+		"GetClientInfoExV4",                     // 132
+	},
+	Changes: []Change{
+		// DHCP failover and policies.
+		{Version: WindowsServer2012, Added: Range(89, 119)},
+		// free address lookup, client creation, failover address status.
+		{Version: WindowsServer2012R2, Added: Range(120, 125)},
+		// extended policies and client information.
+		{Version: WindowsServer2016, Added: Range(126, 132)},
+	},
+	Probes: []int{
+		92,  // FailoverGetRelationshipV4
+		93,  // FailoverEnumRelationshipV4
+		96,  // FailoverGetScopeRelationshipV4
+		97,  // FailoverGetScopeStatisticsV4
+		99,  // FailoverGetSystemTimeV4
+		106, // QueryPolicyEnforcementV4
+		109, // GetPolicyV4
+		112, // EnumPoliciesV4
+		115, // EnumSubnetClientsV4
+		117, // GetStatelessStoreParamsV6
+		118, // GetStatelessStatisticsV6
+		119, // EnumSubnetReservationsV4
+		120, // GetFreeIPAddressV4
+		121, // GetFreeIPAddressV6
+		123, // GetClientInfoV4
+		125, // FailoverGetAddressStatusV4
+		127, // GetPolicyExV4
+		129, // EnumPoliciesExV4
+		130, // EnumSubnetClientsExV4
+		132, // GetClientInfoExV4
+	},
+}
@@ -929,13 +929,13 @@ func (o *xxx_PokeOperation) xxx_PrepareRequestPayload(ctx context.Context) error
 	if o.Blob != nil && o.SizeOfBlob == 0 {
 		o.SizeOfBlob = uint32(len(o.Blob))
 	}
-	if len(o.CalleeUUID) > int(37) {
+	if ndr.CharNLen(o.CalleeUUID) > uint64(37) {
 		return fmt.Errorf("CalleeUUID is out of range")
 	}
-	if len(o.HostName) > int(16) {
+	if ndr.CharNLen(o.HostName) > uint64(16) {
 		return fmt.Errorf("HostName is out of range")
 	}
-	if len(o.UUIDString) > int(37) {
+	if ndr.CharNLen(o.UUIDString) > uint64(37) {
 		return fmt.Errorf("UUIDString is out of range")
 	}
 	if o.SizeOfBlob < uint32(8) || o.SizeOfBlob > uint32(8) {
@@ -1062,6 +1062,21 @@ func (o *xxx_PokeOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reade
 			}
 		}
 	}
+	if err := ndr.CheckRange("CalleeUUID", ndr.CharNLen(o.CalleeUUID), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("HostName", ndr.CharNLen(o.HostName), 0, 16); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("UUIDString", ndr.CharNLen(o.UUIDString), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SizeOfBlob", o.SizeOfBlob, 8, 8); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Blob", len(o.Blob), o.SizeOfBlob, "SizeOfBlob"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1231,19 +1246,19 @@ func (o *xxx_BuildContextOperation) xxx_PrepareRequestPayload(ctx context.Contex
 	if o.Blob != nil && o.SizeOfBlob == 0 {
 		o.SizeOfBlob = uint32(len(o.Blob))
 	}
-	if len(o.CalleeUUID) > int(37) {
+	if ndr.CharNLen(o.CalleeUUID) > uint64(37) {
 		return fmt.Errorf("CalleeUUID is out of range")
 	}
-	if len(o.HostName) > int(16) {
+	if ndr.CharNLen(o.HostName) > uint64(16) {
 		return fmt.Errorf("HostName is out of range")
 	}
-	if len(o.UUIDString) > int(37) {
+	if ndr.CharNLen(o.UUIDString) > uint64(37) {
 		return fmt.Errorf("UUIDString is out of range")
 	}
-	if len(o.GUIDIn) > int(37) {
+	if ndr.CharNLen(o.GUIDIn) > uint64(37) {
 		return fmt.Errorf("GUIDIn is out of range")
 	}
-	if len(o.GUIDOut) > int(37) {
+	if ndr.CharNLen(o.GUIDOut) > uint64(37) {
 		return fmt.Errorf("GUIDOut is out of range")
 	}
 	if o.SizeOfBlob < uint32(8) || o.SizeOfBlob > uint32(8) {
@@ -1436,11 +1451,32 @@ func (o *xxx_BuildContextOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			}
 		}
 	}
+	if err := ndr.CheckRange("CalleeUUID", ndr.CharNLen(o.CalleeUUID), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("HostName", ndr.CharNLen(o.HostName), 0, 16); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("UUIDString", ndr.CharNLen(o.UUIDString), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("GUIDIn", ndr.CharNLen(o.GUIDIn), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("GUIDOut", ndr.CharNLen(o.GUIDOut), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SizeOfBlob", o.SizeOfBlob, 8, 8); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Blob", len(o.Blob), o.SizeOfBlob, "SizeOfBlob"); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (o *xxx_BuildContextOperation) xxx_PrepareResponsePayload(ctx context.Context) error {
-	if len(o.GUIDOut) > int(37) {
+	if ndr.CharNLen(o.GUIDOut) > uint64(37) {
 		return fmt.Errorf("GUIDOut is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
@@ -1525,6 +1561,9 @@ func (o *xxx_BuildContextOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("GUIDOut", ndr.CharNLen(o.GUIDOut), 0, 37); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2050,6 +2089,15 @@ func (o *xxx_SendReceiveOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			}
 		}
 	}
+	if err := ndr.CheckRange("MessagesCount", o.MessagesCount, 1, 4095); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SizeOfBoxCar", o.SizeOfBoxCar, 40, 81920); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("BoxCar", len(o.BoxCar), o.SizeOfBoxCar, "SizeOfBoxCar"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2602,13 +2650,13 @@ func (o *xxx_PokeWOperation) xxx_PrepareRequestPayload(ctx context.Context) erro
 	if o.Blob != nil && o.SizeOfBlob == 0 {
 		o.SizeOfBlob = uint32(len(o.Blob))
 	}
-	if len(o.CalleeUUID) > int(37) {
+	if ndr.UTF16NLen(o.CalleeUUID) > uint64(37) {
 		return fmt.Errorf("CalleeUUID is out of range")
 	}
-	if len(o.HostName) > int(16) {
+	if ndr.UTF16NLen(o.HostName) > uint64(16) {
 		return fmt.Errorf("HostName is out of range")
 	}
-	if len(o.UUIDString) > int(37) {
+	if ndr.UTF16NLen(o.UUIDString) > uint64(37) {
 		return fmt.Errorf("UUIDString is out of range")
 	}
 	if o.SizeOfBlob < uint32(8) || o.SizeOfBlob > uint32(8) {
@@ -2735,6 +2783,21 @@ func (o *xxx_PokeWOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Read
 			}
 		}
 	}
+	if err := ndr.CheckRange("CalleeUUID", ndr.UTF16NLen(o.CalleeUUID), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("HostName", ndr.UTF16NLen(o.HostName), 0, 16); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("UUIDString", ndr.UTF16NLen(o.UUIDString), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SizeOfBlob", o.SizeOfBlob, 8, 8); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Blob", len(o.Blob), o.SizeOfBlob, "SizeOfBlob"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2899,19 +2962,19 @@ func (o *xxx_BuildContextWOperation) xxx_PrepareRequestPayload(ctx context.Conte
 	if o.Blob != nil && o.SizeOfBlob == 0 {
 		o.SizeOfBlob = uint32(len(o.Blob))
 	}
-	if len(o.CalleeUUID) > int(37) {
+	if ndr.UTF16NLen(o.CalleeUUID) > uint64(37) {
 		return fmt.Errorf("CalleeUUID is out of range")
 	}
-	if len(o.HostName) > int(16) {
+	if ndr.UTF16NLen(o.HostName) > uint64(16) {
 		return fmt.Errorf("HostName is out of range")
 	}
-	if len(o.UUIDString) > int(37) {
+	if ndr.UTF16NLen(o.UUIDString) > uint64(37) {
 		return fmt.Errorf("UUIDString is out of range")
 	}
-	if len(o.GUIDIn) > int(37) {
+	if ndr.UTF16NLen(o.GUIDIn) > uint64(37) {
 		return fmt.Errorf("GUIDIn is out of range")
 	}
-	if len(o.GUIDOut) > int(37) {
+	if ndr.UTF16NLen(o.GUIDOut) > uint64(37) {
 		return fmt.Errorf("GUIDOut is out of range")
 	}
 	if o.SizeOfBlob < uint32(8) || o.SizeOfBlob > uint32(8) {
@@ -3104,11 +3167,32 @@ func (o *xxx_BuildContextWOperation) UnmarshalNDRRequest(ctx context.Context, w
 			}
 		}
 	}
+	if err := ndr.CheckRange("CalleeUUID", ndr.UTF16NLen(o.CalleeUUID), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("HostName", ndr.UTF16NLen(o.HostName), 0, 16); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("UUIDString", ndr.UTF16NLen(o.UUIDString), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("GUIDIn", ndr.UTF16NLen(o.GUIDIn), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("GUIDOut", ndr.UTF16NLen(o.GUIDOut), 0, 37); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SizeOfBlob", o.SizeOfBlob, 8, 8); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Blob", len(o.Blob), o.SizeOfBlob, "SizeOfBlob"); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (o *xxx_BuildContextWOperation) xxx_PrepareResponsePayload(ctx context.Context) error {
-	if len(o.GUIDOut) > int(37) {
+	if ndr.UTF16NLen(o.GUIDOut) > uint64(37) {
 		return fmt.Errorf("GUIDOut is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
@@ -3193,6 +3277,9 @@ func (o *xxx_BuildContextWOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("GUIDOut", ndr.UTF16NLen(o.GUIDOut), 0, 37); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1506,6 +1506,9 @@ func (o *EnumList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Entry", len(o.Entry), o.EntryCount, "EntryCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9083,6 +9086,9 @@ func (o *xxx_SetValueOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10015,6 +10021,9 @@ func (o *xxx_EnumValueOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13328,6 +13337,9 @@ func (o *xxx_SetGroupNodeListOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("NodeList", len(o.NodeList), o.ListLength, "ListLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16900,6 +16912,9 @@ func (o *xxx_NodeResourceControlOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17280,6 +17295,9 @@ func (o *xxx_ResourceControlOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17694,6 +17712,9 @@ func (o *xxx_NodeResourceTypeControlOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18092,6 +18113,9 @@ func (o *xxx_ResourceTypeControlOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18494,6 +18518,9 @@ func (o *xxx_NodeGroupControlOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18874,6 +18901,9 @@ func (o *xxx_GroupControlOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19273,6 +19303,9 @@ func (o *xxx_NodeNodeControlOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19653,6 +19686,9 @@ func (o *xxx_NodeControlOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21051,6 +21087,12 @@ func (o *xxx_SetNetworkPriorityOrderOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("NetworkCount", o.NetworkCount, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("NetworkIDList", len(o.NetworkIDList), o.NetworkCount, "NetworkCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21342,6 +21384,9 @@ func (o *xxx_NodeNetworkControlOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21722,6 +21767,9 @@ func (o *xxx_NetworkControlOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23454,6 +23502,9 @@ func (o *xxx_NodeNetInterfaceControlOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23836,6 +23887,9 @@ func (o *xxx_NetInterfaceControlOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25549,6 +25603,9 @@ func (o *xxx_NodeClusterControlOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25929,6 +25986,9 @@ func (o *xxx_ClusterControlOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
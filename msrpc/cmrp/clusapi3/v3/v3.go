@@ -2703,6 +2703,9 @@ func (o *EnumList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Entry", len(o.Entry), o.EntryCount, "EntryCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3383,6 +3386,9 @@ func (o *GroupEnumList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Entry", len(o.Entry), o.EntryCount, "EntryCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3493,6 +3499,9 @@ func (o *ResourceEnumList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Entry", len(o.Entry), o.EntryCount, "EntryCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12934,6 +12943,9 @@ func (o *xxx_SetValueOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13942,6 +13954,9 @@ func (o *xxx_EnumValueOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21346,6 +21361,9 @@ func (o *xxx_NodeResourceControlOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21742,6 +21760,9 @@ func (o *xxx_ResourceControlOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22172,6 +22193,9 @@ func (o *xxx_NodeResourceTypeControlOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22586,6 +22610,9 @@ func (o *xxx_ResourceTypeControlOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23004,6 +23031,9 @@ func (o *xxx_NodeGroupControlOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23400,6 +23430,9 @@ func (o *xxx_GroupControlOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23815,6 +23848,9 @@ func (o *xxx_NodeNodeControlOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24211,6 +24247,9 @@ func (o *xxx_NodeControlOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25705,6 +25744,12 @@ func (o *xxx_SetNetworkPriorityOrderOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("NetworkCount", o.NetworkCount, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("NetworkIDList", len(o.NetworkIDList), o.NetworkCount, "NetworkCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26012,6 +26057,9 @@ func (o *xxx_NodeNetworkControlOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26408,6 +26456,9 @@ func (o *xxx_NetworkControlOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -28252,6 +28303,9 @@ func (o *xxx_NodeNetInterfaceControlOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -28650,6 +28704,9 @@ func (o *xxx_NetInterfaceControlOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -30475,6 +30532,9 @@ func (o *xxx_NodeClusterControlOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -30871,6 +30931,9 @@ func (o *xxx_ClusterControlOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -31322,6 +31385,9 @@ func (o *xxx_SetServiceAccountPasswordOperation) UnmarshalNDRRequest(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ReturnStatusBufferSize", o.ReturnStatusBufferSize, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -32307,6 +32373,9 @@ func (o *xxx_ExecuteBatchOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			}
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -32826,6 +32895,9 @@ func (o *xxx_GetBatchNotificationOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -36498,6 +36570,9 @@ func (o *xxx_OnlineGroupExOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferLength, "InBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -36791,6 +36866,9 @@ func (o *xxx_OfflineGroupExOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferLength, "InBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -37084,6 +37162,9 @@ func (o *xxx_MoveGroupExOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferLength, "InBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -37433,6 +37514,9 @@ func (o *xxx_MoveGroupToNodeExOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferLength, "InBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -37896,6 +37980,9 @@ func (o *xxx_OnlineResourceExOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferLength, "InBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -38190,6 +38277,9 @@ func (o *xxx_OfflineResourceExOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferLength, "InBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -39105,6 +39195,9 @@ func (o *xxx_GetNotifyV2Operation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Notifications", len(o.Notifications), o.NotificationsLength, "NotificationsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -39795,6 +39888,12 @@ func (o *xxx_CreateGroupEnumOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Properties", len(o.Properties), o.PropertiesLength, "PropertiesLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ReadOnlyProperties", len(o.ReadOnlyProperties), o.ReadOnlyPropertiesLength, "ReadOnlyPropertiesLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -40227,6 +40326,12 @@ func (o *xxx_CreateResourceEnumOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Properties", len(o.Properties), o.PropertiesLength, "PropertiesLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ReadOnlyProperties", len(o.ReadOnlyProperties), o.ReadOnlyPropertiesLength, "ReadOnlyPropertiesLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -40546,6 +40651,9 @@ func (o *xxx_ExecuteReadBatchOperation) UnmarshalNDRRequest(ctx context.Context,
 			}
 		}
 	}
+	if err := ndr.CheckSize("InData", len(o.InData), o.InDataLength, "InDataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -40675,6 +40783,9 @@ func (o *xxx_ExecuteReadBatchOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("OutData", len(o.OutData), o.OutDataLength, "OutDataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41123,6 +41234,9 @@ func (o *xxx_GetNotifyAsyncOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Notifications", len(o.Notifications), o.NotificationsLength, "NotificationsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41687,6 +41801,9 @@ func (o *xxx_ExecuteReadBatchExOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InData", len(o.InData), o.InDataLength, "InDataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41816,6 +41933,9 @@ func (o *xxx_ExecuteReadBatchExOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("OutData", len(o.OutData), o.OutDataLength, "OutDataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -43720,6 +43840,12 @@ func (o *xxx_NodeGroupSetControlOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("OutBufferSize", o.OutBufferSize, 0, 2147483647); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -44119,6 +44245,12 @@ func (o *xxx_GroupSetControlOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InBuffer", len(o.InBuffer), o.InBufferSize, "InBufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("OutBufferSize", o.OutBufferSize, 0, 2147483647); err != nil {
+		return err
+	}
 	return nil
 }
 
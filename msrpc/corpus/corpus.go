@@ -0,0 +1,407 @@
+// The corpus package generates the labeled pcap corpus of representative
+// DCE/RPC calls for detection engineering (IDS/IPS rule testing).
+//
+// Each scenario drives the generated client stubs against the loopback
+// responder which accepts every presentation context and answers each
+// request with the zero-valued response marshaled by the same stub (or
+// with the fault PDU, see Generator.FaultStatus). The conversation is recorded as the
+// synthesized Ethernet/IPv4/TCP capture (one pcap file per scenario),
+// and every request frame is labeled using the call dictionary:
+//
+//	g := &corpus.Generator{Dir: "./corpus"}
+//
+//	err := g.Generate(ctx, &corpus.Scenario{
+//		Name:      "scmr-remote-service",
+//		Class:     corpus.ClassAbuse,
+//		Technique: "T1569.002",
+//		NewClient: corpus.Client(svcctl.NewSvcctlClient),
+//		Steps: []*corpus.Step{
+//			{Method: "OpenSCMW"},
+//			{Method: "CreateServiceW", Request: &svcctl.CreateServiceWRequest{ServiceName: "svc"}},
+//			{Method: "StartServiceW"},
+//		},
+//	})
+//
+//	// write labels.jsonl.
+//	g.WriteLabels(f)
+//
+// If the scenario has no steps, every method of the client is invoked
+// with the zero-valued request (the interface sweep).
+package corpus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dictionary"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// The scenario classes.
+const (
+	// The benign (administrative) traffic.
+	ClassBenign = "benign"
+	// The known-abuse pattern.
+	ClassAbuse = "abuse"
+)
+
+// ClientFactory is the generic client stub constructor.
+type ClientFactory func(context.Context, dcerpc.Conn, ...dcerpc.Option) (any, error)
+
+// Client function converts the generated client constructor into the
+// ClientFactory.
+func Client[T any](fn func(context.Context, dcerpc.Conn, ...dcerpc.Option) (T, error)) ClientFactory {
+	return func(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.Option) (any, error) {
+		return fn(ctx, cc, opts...)
+	}
+}
+
+// Step represents the single call of the scenario.
+type Step struct {
+	// The client method name (ie, "CreateServiceW").
+	Method string
+	// The request structure pointer (zero-valued request is used if nil).
+	Request any
+}
+
+// Scenario represents the sequence of calls recorded into the single
+// pcap file.
+type Scenario struct {
+	// The scenario name (the pcap file name).
+	Name string
+	// The scenario class (ClassBenign or ClassAbuse).
+	Class string
+	// The optional technique identifier (ie, MITRE ATT&CK id).
+	Technique string
+	// The client stub constructor.
+	NewClient ClientFactory
+	// The calls. (all client methods are called if empty).
+	Steps []*Step
+}
+
+// Label represents the label of the single request frame.
+type Label struct {
+	// The pcap file name.
+	File string `json:"file"`
+	// The frame number (1-based).
+	Frame int `json:"frame"`
+	// The call identifier.
+	CallID uint32 `json:"call_id"`
+	// The scenario name.
+	Scenario string `json:"scenario"`
+	// The scenario class.
+	Class string `json:"class"`
+	// The technique identifier.
+	Technique string `json:"technique,omitempty"`
+	// The interface UUID.
+	InterfaceUUID string `json:"interface_uuid"`
+	// The operation number.
+	OpNum int `json:"opnum"`
+	// The protocol name.
+	Protocol string `json:"protocol,omitempty"`
+	// The method name.
+	Method string `json:"method,omitempty"`
+	// The sensitivity level.
+	Sensitivity string `json:"sensitivity,omitempty"`
+	// The sensitivity tags.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Generator represents the corpus generator.
+type Generator struct {
+	// The output directory.
+	Dir string
+	// The client IPv4 address. (default 10.0.0.100).
+	ClientAddr string
+	// The server IPv4 address. (default 10.0.0.10).
+	ServerAddr string
+	// The server TCP port. (default 49667).
+	Port int
+	// The capture start time. (default 2024-01-01T00:00:00Z).
+	Time time.Time
+	// The interval between the frames. (default 1ms).
+	Interval time.Duration
+	// The fault status returned for every request. If not set, the
+	// zero-valued response is returned. Note, that the client terminates
+	// the connection on fault, so the scenario with the fault status
+	// records only the first call.
+	FaultStatus uint32
+
+	mu     sync.Mutex
+	labels []*Label
+}
+
+// Labels function returns the labels of all generated scenarios.
+func (g *Generator) Labels() []*Label {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]*Label{}, g.labels...)
+}
+
+// WriteLabels function writes the labels as JSON lines.
+func (g *Generator) WriteLabels(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, l := range g.Labels() {
+		if err := enc.Encode(l); err != nil {
+			return fmt.Errorf("corpus: write labels: %w", err)
+		}
+	}
+	return nil
+}
+
+// pipeDialer is the dialer that returns the client side of the
+// in-memory pipe.
+type pipeDialer struct {
+	conn net.Conn
+}
+
+func (d *pipeDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if d.conn == nil {
+		return nil, fmt.Errorf("corpus: connection is already used")
+	}
+	conn := d.conn
+	d.conn = nil
+	return conn, nil
+}
+
+func (g *Generator) defaults() (net.IP, net.IP, int, error) {
+
+	clientIP, serverIP := net.ParseIP("10.0.0.100"), net.ParseIP("10.0.0.10")
+
+	if g.ClientAddr != "" {
+		if clientIP = net.ParseIP(g.ClientAddr); clientIP == nil || clientIP.To4() == nil {
+			return nil, nil, 0, fmt.Errorf("corpus: invalid client address %q", g.ClientAddr)
+		}
+	}
+
+	if g.ServerAddr != "" {
+		if serverIP = net.ParseIP(g.ServerAddr); serverIP == nil || serverIP.To4() == nil {
+			return nil, nil, 0, fmt.Errorf("corpus: invalid server address %q", g.ServerAddr)
+		}
+	}
+
+	if g.Port == 0 {
+		g.Port = 49667
+	}
+
+	if g.Time.IsZero() {
+		g.Time = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	if g.Interval == 0 {
+		g.Interval = time.Millisecond
+	}
+
+	return clientIP, serverIP, g.Port, nil
+}
+
+// Generate function records the scenario into the `<Dir>/<Name>.pcap` file.
+func (g *Generator) Generate(ctx context.Context, s *Scenario) error {
+
+	if s.Name == "" || s.NewClient == nil {
+		return fmt.Errorf("corpus: scenario name and client must be set")
+	}
+
+	clientIP, serverIP, port, err := g.defaults()
+	if err != nil {
+		return err
+	}
+
+	file := s.Name + ".pcap"
+
+	f, err := os.Create(filepath.Join(g.Dir, file))
+	if err != nil {
+		return fmt.Errorf("corpus: %w", err)
+	}
+	defer f.Close()
+
+	cli, srv := net.Pipe()
+
+	// the client port is derived from the number of generated labels to
+	// keep the conversations distinct.
+	g.mu.Lock()
+	clientPort := uint16(50000 + len(g.labels)%10000)
+	g.mu.Unlock()
+
+	pcap := newPCAPWriter(f,
+		&endpoint{mac: net.HardwareAddr{0x02, 0, 0, 0, 0, 0x01}, ip: clientIP, port: clientPort, seq: 1000},
+		&endpoint{mac: net.HardwareAddr{0x02, 0, 0, 0, 0, 0x02}, ip: serverIP, port: uint16(port), seq: 5000},
+		g.Time, g.Interval)
+
+	if err := pcap.Handshake(); err != nil {
+		return fmt.Errorf("corpus: %w", err)
+	}
+
+	var labels []*Label
+
+	r := &responder{
+		conn:     srv,
+		pcap:     pcap,
+		port:     port,
+		status:   g.FaultStatus,
+		contexts: make(map[uint16]*dcerpc.SyntaxID),
+		onRequest: func(frame int, callID uint32, syntax *dcerpc.SyntaxID, entry *dictionary.Entry, opNum int) {
+			l := &Label{
+				File:      file,
+				Frame:     frame,
+				CallID:    callID,
+				Scenario:  s.Name,
+				Class:     s.Class,
+				Technique: s.Technique,
+				OpNum:     opNum,
+			}
+			if syntax != nil && syntax.IfUUID != nil {
+				l.InterfaceUUID = syntax.IfUUID.String()
+			}
+			if entry != nil {
+				l.Protocol, l.Method, l.Sensitivity, l.Tags = entry.Protocol, entry.Method, entry.Sensitivity, entry.Tags
+			}
+			labels = append(labels, l)
+		},
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.Serve(ctx)
+	}()
+
+	err = g.run(ctx, s, r, &pipeDialer{conn: cli}, serverIP, port)
+
+	cli.Close()
+
+	if serr := <-done; err == nil {
+		err = serr
+	}
+
+	srv.Close()
+
+	if err != nil {
+		return fmt.Errorf("corpus: %s: %w", s.Name, err)
+	}
+
+	g.mu.Lock()
+	g.labels = append(g.labels, labels...)
+	g.mu.Unlock()
+
+	return nil
+}
+
+// run function dials the loopback responder and invokes the scenario
+// steps.
+func (g *Generator) run(ctx context.Context, s *Scenario, r *responder, dialer dcerpc.Dialer, serverIP net.IP, port int) error {
+
+	addr := "ncacn_ip_tcp:" + serverIP.String() + "[" + strconv.Itoa(port) + "]"
+
+	// the target policy is not applied, since the connection never leaves the process.
+	cc, err := dcerpc.Dial(ctx, addr, dcerpc.WithDialer(dialer), dcerpc.WithTargetPolicy(nil))
+	if err != nil {
+		return err
+	}
+	defer cc.Close(ctx)
+
+	cli, err := s.NewClient(ctx, cc, dcerpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+
+	steps := s.Steps
+	if len(steps) == 0 {
+		steps = Sweep(cli)
+	}
+
+	for _, step := range steps {
+		if err := invoke(ctx, cli, step, r.SetStub); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sweep function returns the steps that invoke every method of the client
+// with the zero-valued request.
+func Sweep(cli any) []*Step {
+
+	var steps []*Step
+
+	typ := reflect.TypeOf(cli)
+	for i := 0; i < typ.NumMethod(); i++ {
+		if _, ok := requestType(typ.Method(i).Type, 1); ok {
+			steps = append(steps, &Step{Method: typ.Method(i).Name})
+		}
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Method < steps[j].Method })
+
+	return steps
+}
+
+// requestType function returns the request structure type for the client
+// method `func(ctx, *Request, ...CallOption) (*Response, error)`. The
+// offset is 1 for method expressions (receiver argument) and 0 for
+// bound method values.
+func requestType(m reflect.Type, offset int) (reflect.Type, bool) {
+	if m.NumIn() != offset+3 || !m.IsVariadic() || m.NumOut() != 2 {
+		return nil, false
+	}
+	if req := m.In(offset + 1); req.Kind() == reflect.Ptr && req.Elem().Kind() == reflect.Struct {
+		return req, true
+	}
+	return nil, false
+}
+
+// invoke function prepares the zero-valued response stub and invokes the
+// step. The call errors (faults) are expected and ignored, the panics are
+// converted into the errors.
+func invoke(ctx context.Context, cli any, step *Step, setStub func([]byte)) (err error) {
+
+	m := reflect.ValueOf(cli).MethodByName(step.Method)
+	if !m.IsValid() {
+		return fmt.Errorf("%s: method not found", step.Method)
+	}
+
+	typ, ok := requestType(m.Type(), 0)
+	if !ok {
+		return fmt.Errorf("%s: unsupported method signature", step.Method)
+	}
+
+	req := reflect.New(typ.Elem())
+	if step.Request != nil {
+		if req = reflect.ValueOf(step.Request); req.Type() != typ {
+			return fmt.Errorf("%s: request must be %s", step.Method, typ)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: panic: %v", step.Method, r)
+		}
+	}()
+
+	if resp, ok := reflect.New(m.Type().Out(0).Elem()).Interface().(ndr.Marshaler); ok {
+		// the zero-valued response cannot always be marshaled (for example,
+		// due to the union switch), the empty stub is sent in that case.
+		b, _ := ndr.Marshal(resp)
+		setStub(b)
+	}
+
+	out := m.Call([]reflect.Value{reflect.ValueOf(ctx), req})
+
+	if err, _ := out[1].Interface().(error); err != nil && errors.Is(err, io.ErrClosedPipe) {
+		return fmt.Errorf("%s: %w", step.Method, err)
+	}
+
+	return nil
+}
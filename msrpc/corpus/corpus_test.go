@@ -0,0 +1,51 @@
+package corpus
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/scmr/svcctl/v2"
+)
+
+func TestGenerate(t *testing.T) {
+
+	g := &Generator{Dir: t.TempDir()}
+
+	err := g.Generate(context.Background(), &Scenario{
+		Name:      "scmr-remote-service",
+		Class:     ClassAbuse,
+		Technique: "T1569.002",
+		NewClient: Client(svcctl.NewSvcctlClient),
+		Steps: []*Step{
+			{Method: "OpenSCMW"},
+			{Method: "CreateServiceW", Request: &svcctl.CreateServiceWRequest{ServiceName: "svc", BinaryPathName: "cmd.exe"}},
+			{Method: "StartServiceW"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	labels := g.Labels()
+	if len(labels) != 3 {
+		t.Fatalf("expected 3 labels, got %d", len(labels))
+	}
+
+	for i, method := range []string{"ROpenSCManagerW", "RCreateServiceW", "RStartServiceW"} {
+		if labels[i].Method != method || labels[i].Class != ClassAbuse || labels[i].Protocol != "scmr" {
+			t.Errorf("label %d: unexpected %+v", i, labels[i])
+		}
+	}
+
+	b, err := os.ReadFile(filepath.Join(g.Dir, "scmr-remote-service.pcap"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(b, []byte{0xd4, 0xc3, 0xb2, 0xa1}) || !bytes.Contains(b, []byte("c\x00m\x00d\x00.\x00e\x00x\x00e\x00")) {
+		t.Errorf("unexpected pcap contents")
+	}
+}
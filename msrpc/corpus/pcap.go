@@ -0,0 +1,183 @@
+package corpus
+
+// pcap.go contains the minimal pcap writer that synthesizes the
+// Ethernet/IPv4/TCP framing for the recorded DCE/RPC PDUs.
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+)
+
+const (
+	// The pcap magic number (microsecond resolution).
+	pcapMagic = 0xa1b2c3d4
+	// The Ethernet link type.
+	linkTypeEthernet = 1
+	// The maximum snapshot length.
+	snapLen = 0x40000
+
+	tcpFlagFIN = 0x01
+	tcpFlagSYN = 0x02
+	tcpFlagPSH = 0x08
+	tcpFlagACK = 0x10
+)
+
+// endpoint represents the single side of the synthesized TCP connection.
+type endpoint struct {
+	mac  net.HardwareAddr
+	ip   net.IP
+	port uint16
+	seq  uint32
+}
+
+// pcapWriter writes the synthesized TCP conversation in the pcap format.
+type pcapWriter struct {
+	w      io.Writer
+	ts     time.Time
+	step   time.Duration
+	frames int
+	client *endpoint
+	server *endpoint
+	err    error
+}
+
+// newPCAPWriter function returns the new pcap writer and writes the
+// pcap global header.
+func newPCAPWriter(w io.Writer, client, server *endpoint, ts time.Time, step time.Duration) *pcapWriter {
+
+	p := &pcapWriter{w: w, ts: ts, step: step, client: client, server: server}
+
+	hdr := make([]byte, 24)
+	binary.LittleEndian.PutUint32(hdr[0:], pcapMagic)
+	binary.LittleEndian.PutUint16(hdr[4:], 2)
+	binary.LittleEndian.PutUint16(hdr[6:], 4)
+	binary.LittleEndian.PutUint32(hdr[16:], snapLen)
+	binary.LittleEndian.PutUint32(hdr[20:], linkTypeEthernet)
+
+	_, p.err = w.Write(hdr)
+
+	return p
+}
+
+// Frames function returns the number of frames written.
+func (p *pcapWriter) Frames() int {
+	return p.frames
+}
+
+// Handshake function writes the TCP three-way handshake.
+func (p *pcapWriter) Handshake() error {
+	p.segment(p.client, p.server, tcpFlagSYN, nil)
+	p.client.seq++
+	p.segment(p.server, p.client, tcpFlagSYN|tcpFlagACK, nil)
+	p.server.seq++
+	return p.segment(p.client, p.server, tcpFlagACK, nil)
+}
+
+// Close function writes the TCP connection teardown.
+func (p *pcapWriter) Close() error {
+	p.segment(p.client, p.server, tcpFlagFIN|tcpFlagACK, nil)
+	p.client.seq++
+	p.segment(p.server, p.client, tcpFlagFIN|tcpFlagACK, nil)
+	p.server.seq++
+	return p.segment(p.client, p.server, tcpFlagACK, nil)
+}
+
+// Request function writes the client-to-server data segment and returns
+// the frame number (1-based).
+func (p *pcapWriter) Request(b []byte) (int, error) {
+	err := p.segment(p.client, p.server, tcpFlagPSH|tcpFlagACK, b)
+	return p.frames, err
+}
+
+// Response function writes the server-to-client data segment and returns
+// the frame number (1-based).
+func (p *pcapWriter) Response(b []byte) (int, error) {
+	err := p.segment(p.server, p.client, tcpFlagPSH|tcpFlagACK, b)
+	return p.frames, err
+}
+
+// segment function writes the single TCP segment.
+func (p *pcapWriter) segment(src, dst *endpoint, flags uint8, data []byte) error {
+
+	if p.err != nil {
+		return p.err
+	}
+
+	frame := make([]byte, 14+20+20+len(data))
+
+	// ethernet header.
+	copy(frame[0:], dst.mac)
+	copy(frame[6:], src.mac)
+	binary.BigEndian.PutUint16(frame[12:], 0x0800)
+
+	// ipv4 header.
+	ip := frame[14:34]
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(20+20+len(data)))
+	binary.BigEndian.PutUint16(ip[4:], uint16(p.frames))
+	ip[6] = 0x40 // don't fragment.
+	ip[8] = 128
+	ip[9] = 6
+	copy(ip[12:], src.ip.To4())
+	copy(ip[16:], dst.ip.To4())
+	binary.BigEndian.PutUint16(ip[10:], checksum(ip, 0))
+
+	// tcp header.
+	tcp := frame[34:]
+	binary.BigEndian.PutUint16(tcp[0:], src.port)
+	binary.BigEndian.PutUint16(tcp[2:], dst.port)
+	binary.BigEndian.PutUint32(tcp[4:], src.seq)
+	if flags&tcpFlagACK != 0 {
+		binary.BigEndian.PutUint32(tcp[8:], dst.seq)
+	}
+	tcp[12] = 5 << 4
+	tcp[13] = flags
+	binary.BigEndian.PutUint16(tcp[14:], 0xffff)
+	copy(tcp[20:], data)
+
+	// pseudo-header sum.
+	var sum uint32
+	for _, b := range [][]byte{ip[12:16], ip[16:20]} {
+		sum += uint32(binary.BigEndian.Uint16(b[0:])) + uint32(binary.BigEndian.Uint16(b[2:]))
+	}
+	sum += 6 + uint32(len(tcp))
+	binary.BigEndian.PutUint16(tcp[16:], checksum(tcp, sum))
+
+	src.seq += uint32(len(data))
+
+	// record header.
+	ts := p.ts.Add(time.Duration(p.frames) * p.step)
+	rec := make([]byte, 16)
+	binary.LittleEndian.PutUint32(rec[0:], uint32(ts.Unix()))
+	binary.LittleEndian.PutUint32(rec[4:], uint32(ts.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(rec[8:], uint32(len(frame)))
+	binary.LittleEndian.PutUint32(rec[12:], uint32(len(frame)))
+
+	if _, p.err = p.w.Write(rec); p.err != nil {
+		return p.err
+	}
+
+	if _, p.err = p.w.Write(frame); p.err != nil {
+		return p.err
+	}
+
+	p.frames++
+
+	return nil
+}
+
+// checksum function computes the internet checksum.
+func checksum(b []byte, sum uint32) uint16 {
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	if len(b)%2 != 0 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
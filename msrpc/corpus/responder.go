@@ -0,0 +1,301 @@
+package corpus
+
+// responder.go contains the loopback DCE/RPC responder that accepts the
+// presentation contexts and answers every request with the prepared
+// response stub (or with the fault PDU).
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/msrpc/dictionary"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// responder represents the loopback responder that records the
+// conversation into the pcap.
+type responder struct {
+	conn     net.Conn
+	pcap     *pcapWriter
+	port     int
+	status   uint32
+	maxRecv  int
+	contexts map[uint16]*dcerpc.SyntaxID
+	// the response stub data for the next request.
+	mu   sync.Mutex
+	stub []byte
+	// the request callback.
+	onRequest func(frame int, callID uint32, syntax *dcerpc.SyntaxID, entry *dictionary.Entry, opNum int)
+}
+
+// Serve function serves the connection until the client closes it.
+func (r *responder) Serve(ctx context.Context) error {
+
+	for {
+
+		raw, err := r.readPDU()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrClosedPipe) {
+				return r.pcap.Close()
+			}
+			return err
+		}
+
+		frame, err := r.pcap.Request(raw)
+		if err != nil {
+			return err
+		}
+
+		resp, err := r.handle(ctx, frame, raw)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil {
+			continue
+		}
+
+		if _, err := r.pcap.Response(resp); err != nil {
+			return err
+		}
+
+		if _, err := r.conn.Write(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// readPDU function reads the single PDU from the connection.
+func (r *responder) readPDU() ([]byte, error) {
+
+	hdr := make([]byte, 16)
+	if _, err := io.ReadFull(r.conn, hdr); err != nil {
+		return nil, err
+	}
+
+	fragLen := int(binary.LittleEndian.Uint16(hdr[8:]))
+	if fragLen < len(hdr) {
+		return nil, fmt.Errorf("responder: invalid fragment length %d", fragLen)
+	}
+
+	raw := make([]byte, fragLen)
+	copy(raw, hdr)
+
+	if _, err := io.ReadFull(r.conn, raw[len(hdr):]); err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+// handle function handles the PDU and returns the response PDU (if any).
+func (r *responder) handle(ctx context.Context, frame int, raw []byte) ([]byte, error) {
+
+	codec := ndr.NDR20(raw)
+
+	hdr := dcerpc.Header{}
+	if err := hdr.ReadFrom(ctx, codec); err != nil {
+		return nil, fmt.Errorf("responder: read header: %w", err)
+	}
+
+	switch hdr.PacketType {
+	case dcerpc.PacketTypeBind:
+
+		pdu := &dcerpc.Bind{}
+		if err := pdu.ReadFrom(ctx, codec); err != nil {
+			return nil, fmt.Errorf("responder: read bind: %w", err)
+		}
+
+		r.maxRecv = int(pdu.MaxRecvFrag)
+
+		return r.encode(ctx, hdr, dcerpc.PacketTypeBindAck, &dcerpc.BindAck{
+			MaxXmitFrag:  pdu.MaxXmitFrag,
+			MaxRecvFrag:  pdu.MaxRecvFrag,
+			AssocGroupID: 0x1234,
+			PortSpec:     strconv.Itoa(r.port),
+			ResultList:   r.accept(pdu.ContextList),
+		})
+
+	case dcerpc.PacketTypeAlterContext:
+
+		pdu := &dcerpc.AlterContext{}
+		if err := pdu.ReadFrom(ctx, codec); err != nil {
+			return nil, fmt.Errorf("responder: read alter_context: %w", err)
+		}
+
+		return r.encode(ctx, hdr, dcerpc.PacketTypeAlterContextResponse, &dcerpc.AlterContextResponse{
+			MaxXmitFrag:  pdu.MaxXmitFrag,
+			MaxRecvFrag:  pdu.MaxRecvFrag,
+			AssocGroupID: 0x1234,
+			ResultList:   r.accept(pdu.ContextList),
+		})
+
+	case dcerpc.PacketTypeRequest:
+
+		pdu := &dcerpc.Request{}
+		if hdr.PacketFlags.IsSet(dcerpc.PacketFlagObjectUUID) {
+			pdu.ObjectUUID = &uuid.UUID{}
+		}
+
+		if err := pdu.ReadFrom(ctx, codec); err != nil {
+			return nil, fmt.Errorf("responder: read request: %w", err)
+		}
+
+		if hdr.PacketFlags.IsSet(dcerpc.PacketFlagFirstFrag) && r.onRequest != nil {
+			syntax := r.contexts[pdu.ContextID]
+			var entry *dictionary.Entry
+			if syntax != nil {
+				entry, _ = dictionary.Lookup(syntax.IfUUID, int(pdu.OpNum))
+			}
+			r.onRequest(frame, hdr.CallID, syntax, entry, int(pdu.OpNum))
+		}
+
+		if !hdr.PacketFlags.IsSet(dcerpc.PacketFlagLastFrag) {
+			return nil, nil
+		}
+
+		if r.status != 0 {
+			return r.encode(ctx, hdr, dcerpc.PacketTypeFault, &dcerpc.Fault{
+				ContextID: pdu.ContextID,
+				Status:    r.status,
+			})
+		}
+
+		return r.response(ctx, hdr, pdu.ContextID, r.nextStub())
+	}
+
+	// ignore auth3, cancel, orphaned and shutdown PDUs.
+	return nil, nil
+}
+
+// accept function accepts the NDR transfer syntax for every presentation
+// context.
+func (r *responder) accept(contexts []*dcerpc.Context) []*dcerpc.Result {
+
+	results := make([]*dcerpc.Result, len(contexts))
+
+	for i, c := range contexts {
+
+		results[i] = &dcerpc.Result{
+			DefResult:      dcerpc.ProviderRejection,
+			ProviderReason: dcerpc.ProposedTransferSyntaxesNotSupported,
+			TransferSyntax: &dcerpc.SyntaxID{IfUUID: &uuid.UUID{}},
+		}
+
+		for _, ts := range c.TransferSyntaxes {
+			if ts.IfUUID.Equals(dcerpc.TransferNDR) {
+				results[i] = &dcerpc.Result{DefResult: dcerpc.Acceptance, TransferSyntax: ts}
+				r.contexts[c.ContextID] = c.AbstractSyntax
+				break
+			}
+			if ts.IfUUID.TimeLow == dcerpc.BindFeature.TimeLow && ts.IfUUID.TimeMid == dcerpc.BindFeature.TimeMid {
+				results[i] = &dcerpc.Result{DefResult: dcerpc.NegotiateAck, TransferSyntax: &dcerpc.SyntaxID{IfUUID: &uuid.UUID{}}}
+				break
+			}
+		}
+	}
+
+	return results
+}
+
+// SetStub function sets the response stub data for the next request.
+func (r *responder) SetStub(b []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stub = b
+}
+
+func (r *responder) nextStub() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b := r.stub
+	r.stub = nil
+	return b
+}
+
+// response function encodes the (possibly fragmented) response PDU.
+func (r *responder) response(ctx context.Context, req dcerpc.Header, contextID uint16, stub []byte) ([]byte, error) {
+
+	maxStub := len(stub)
+	if r.maxRecv > dcerpc.RequestSize+16 {
+		maxStub = r.maxRecv - dcerpc.RequestSize - 16
+	}
+
+	var ret []byte
+
+	for off := 0; off == 0 || off < len(stub); off += maxStub {
+
+		chunk := stub[off:min(off+maxStub, len(stub))]
+
+		hdr := dcerpc.Header{
+			RPCVersion: 5,
+			PacketType: dcerpc.PacketTypeResponse,
+			PacketDRep: req.PacketDRep,
+			CallID:     req.CallID,
+		}
+
+		if off == 0 {
+			hdr.PacketFlags |= dcerpc.PacketFlagFirstFrag
+		}
+
+		if off+maxStub >= len(stub) {
+			hdr.PacketFlags |= dcerpc.PacketFlagLastFrag
+		}
+
+		w := ndr.NDR20(nil)
+		if err := hdr.WriteTo(ctx, w); err != nil {
+			return nil, fmt.Errorf("responder: write header: %w", err)
+		}
+
+		b := w.Bytes()
+		// alloc_hint, p_cont_id, cancel_count, reserved.
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(stub)-off))
+		b = binary.LittleEndian.AppendUint16(b, contextID)
+		b = append(b, 0, 0)
+		b = append(b, chunk...)
+		binary.LittleEndian.PutUint16(b[8:], uint16(len(b)))
+
+		ret = append(ret, b...)
+	}
+
+	return ret, nil
+}
+
+// encode function encodes the response PDU.
+func (r *responder) encode(ctx context.Context, req dcerpc.Header, typ dcerpc.PacketType, pdu dcerpc.PDU) ([]byte, error) {
+
+	hdr := dcerpc.Header{
+		RPCVersion:  5,
+		PacketType:  typ,
+		PacketFlags: dcerpc.PacketFlagFirstFrag | dcerpc.PacketFlagLastFrag,
+		PacketDRep:  req.PacketDRep,
+		CallID:      req.CallID,
+	}
+
+	if typ == dcerpc.PacketTypeFault {
+		hdr.PacketFlags |= dcerpc.PacketFlagDidNotExecute
+	}
+
+	w := ndr.NDR20(nil)
+
+	if err := hdr.WriteTo(ctx, w); err != nil {
+		return nil, fmt.Errorf("responder: write header: %w", err)
+	}
+
+	if err := pdu.WriteTo(ctx, w); err != nil {
+		return nil, fmt.Errorf("responder: write %s: %w", typ, err)
+	}
+
+	b := w.Bytes()
+	binary.LittleEndian.PutUint16(b[8:], uint16(len(b)))
+
+	return b, nil
+}
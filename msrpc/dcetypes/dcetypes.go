@@ -146,6 +146,9 @@ func (o *Tower) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TowerOctetString", len(o.TowerOctetString), o.TowerLength, "TowerLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -430,6 +433,9 @@ func (o *InterfaceIDVector) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InterfaceID", len(o.InterfaceID), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
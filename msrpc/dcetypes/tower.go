@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
@@ -48,23 +49,97 @@ var (
 	ProtocolNetBIOS3          = 0x22
 )
 
+// ErrInvalidTower is returned when the tower octet string cannot be decoded.
+var ErrInvalidTower = errors.New("dcetypes: invalid tower")
+
+// Floor structure represents the protocol tower floor. The floor consists
+// of the left-hand side (the protocol identifier, and for the UUID floors,
+// the UUID and the major version) and the right-hand side (the protocol
+// specific data).
 type Floor struct {
-	Protocol     uint8      `json:"protocol"`
-	UUID         *uuid.UUID `json:"uuid,omitempty"`
-	VersionMajor uint16     `json:"version_major"`
-	Data         []byte     `json:"data"`
+	// The protocol identifier (Protocol* constants).
+	Protocol uint8 `json:"protocol"`
+	// The interface or transfer syntax UUID (for ProtocolUUID floors).
+	UUID *uuid.UUID `json:"uuid,omitempty"`
+	// The major version (for ProtocolUUID floors).
+	VersionMajor uint16 `json:"version_major"`
+	// The right-hand side data.
+	Data []byte `json:"data"`
+}
+
+// NewUUIDFloor function returns the interface or transfer syntax floor.
+func NewUUIDFloor(u *uuid.UUID, major, minor uint16) *Floor {
+	return &Floor{
+		Protocol:     uint8(ProtocolUUID),
+		UUID:         u,
+		VersionMajor: major,
+		Data:         binary.LittleEndian.AppendUint16(nil, minor),
+	}
+}
+
+// NewSyntaxFloor function returns the floor for the syntax identifier.
+func NewSyntaxFloor(s *dcerpc.SyntaxID) *Floor {
+	return NewUUIDFloor(s.IfUUID, s.IfVersionMajor, s.IfVersionMinor)
+}
+
+// NewProtocolFloor function returns the RPC protocol floor (ProtocolRPC_CO
+// or ProtocolRPC_CL) with the minor version.
+func NewProtocolFloor(protocol int, minor uint16) *Floor {
+	return &Floor{
+		Protocol: uint8(protocol),
+		Data:     binary.LittleEndian.AppendUint16(nil, minor),
+	}
+}
+
+// NewPortFloor function returns the port floor (ProtocolTCP, ProtocolUDP or
+// ProtocolHTTP).
+func NewPortFloor(protocol int, port uint16) *Floor {
+	return &Floor{
+		Protocol: uint8(protocol),
+		Data:     binary.BigEndian.AppendUint16(nil, port),
+	}
+}
+
+// NewStringFloor function returns the floor with the null-terminated string
+// data (ProtocolNamedPipe, ProtocolLRPC or ProtocolNetBIOS).
+func NewStringFloor(protocol int, s string) *Floor {
+	return &Floor{
+		Protocol: uint8(protocol),
+		Data:     append([]byte(s), 0),
+	}
 }
 
+// NewIPFloor function returns the IPv4 address floor. The unspecified
+// address is used if ip is not an IPv4 address.
+func NewIPFloor(ip net.IP) *Floor {
+	data := make([]byte, 4)
+	if ip4 := ip.To4(); ip4 != nil {
+		copy(data, ip4)
+	}
+	return &Floor{Protocol: uint8(ProtocolIP), Data: data}
+}
+
+// VersionMinor function returns the minor version (for ProtocolUUID,
+// ProtocolRPC_CO and ProtocolRPC_CL floors).
 func (f *Floor) VersionMinor() uint16 {
+	if len(f.Data) < 2 {
+		return 0
+	}
 	return binary.LittleEndian.Uint16(f.Data)
 }
 
+// Port function returns the port (for ProtocolTCP, ProtocolUDP and
+// ProtocolHTTP floors).
 func (f *Floor) Port() uint16 {
+	if len(f.Data) < 2 {
+		return 0
+	}
 	return binary.BigEndian.Uint16(f.Data)
 }
 
+// Str function returns the string data without the null-terminator.
 func (f *Floor) Str() string {
-	return string(f.Data[:len(f.Data)-1])
+	return string(bytes.TrimRight(f.Data, "\x00"))
 }
 
 func (f *Floor) IP() net.IP {
@@ -100,6 +175,7 @@ func (f *Floor) String() string {
 	}
 }
 
+// FloorsToTower function encodes the floors into the tower.
 func FloorsToTower(floors []*Floor) *Tower {
 
 	buf := bytes.NewBuffer(nil)
@@ -127,6 +203,85 @@ func FloorsToTower(floors []*Floor) *Tower {
 	}
 }
 
+// NewTower function returns the tower for the binding. The tower consists
+// of the interface syntax, transfer syntax (NDR if not set), RPC protocol,
+// endpoint and host floors:
+//
+//	tower, err := dcetypes.NewTower(&dcerpc.Binding{
+//		SyntaxID:      *samr.SamrSyntaxV1_0,
+//		StringBinding: dcerpc.StringBinding{ProtocolSequence: dcerpc.ProtocolSequenceIPTCP},
+//	})
+//
+// The empty endpoint is encoded as the zero port or the empty string, so
+// that the tower can be used as the ept_map request tower.
+func NewTower(b *dcerpc.Binding) (*Tower, error) {
+
+	if b == nil || b.SyntaxID.IfUUID == nil {
+		return nil, fmt.Errorf("%w: interface syntax is not set", ErrInvalidTower)
+	}
+
+	transferSyntax := b.TransferSyntaxID
+	if transferSyntax.IfUUID == nil {
+		transferSyntax = *dcerpc.TransferNDRSyntaxV2_0
+	}
+
+	floors := []*Floor{
+		NewSyntaxFloor(&b.SyntaxID),
+		NewSyntaxFloor(&transferSyntax),
+	}
+
+	sb := b.StringBinding
+
+	port := func() (uint16, error) {
+		if sb.Endpoint == "" {
+			return 0, nil
+		}
+		p, err := strconv.ParseUint(sb.Endpoint, 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid port %q", ErrInvalidTower, sb.Endpoint)
+		}
+		return uint16(p), nil
+	}
+
+	switch sb.ProtocolSequence {
+	case dcerpc.ProtocolSequenceIPTCP, dcerpc.ProtocolSequenceIPUDP, dcerpc.ProtocolSequenceHTTP:
+		p, err := port()
+		if err != nil {
+			return nil, err
+		}
+		switch sb.ProtocolSequence {
+		case dcerpc.ProtocolSequenceIPUDP:
+			floors = append(floors, NewProtocolFloor(ProtocolRPC_CL, 0), NewPortFloor(ProtocolUDP, p))
+		case dcerpc.ProtocolSequenceHTTP:
+			floors = append(floors, NewProtocolFloor(ProtocolRPC_CO, 0), NewPortFloor(ProtocolHTTP, p))
+		default:
+			floors = append(floors, NewProtocolFloor(ProtocolRPC_CO, 0), NewPortFloor(ProtocolTCP, p))
+		}
+		floors = append(floors, NewIPFloor(net.ParseIP(sb.NetworkAddress)))
+	case dcerpc.ProtocolSequenceNamedPipe:
+		floors = append(floors,
+			NewProtocolFloor(ProtocolRPC_CO, 0),
+			NewStringFloor(ProtocolNamedPipe, sb.Endpoint),
+			NewStringFloor(ProtocolNetBIOS, sb.ComputerName))
+	case dcerpc.ProtocolSequenceLRPC:
+		floors = append(floors,
+			NewProtocolFloor(ProtocolRPC_CO, 0),
+			NewStringFloor(ProtocolLRPC, sb.Endpoint))
+	default:
+		return nil, fmt.Errorf("%w: unsupported protocol sequence %q", ErrInvalidTower, sb.ProtocolSequence)
+	}
+
+	return FloorsToTower(floors), nil
+}
+
+// ParseTower function decodes and validates the tower octet string.
+func ParseTower(b []byte) (*Tower, error) {
+	if _, err := DecodeFloors(b); err != nil {
+		return nil, err
+	}
+	return &Tower{TowerOctetString: b}, nil
+}
+
 func (o *Tower) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.Floors())
 }
@@ -182,40 +337,72 @@ func (o *Tower) Binding() *dcerpc.Binding {
 	return &b
 }
 
-// Floors function returns the decoded tower floors.
+// Floors function returns the decoded tower floors. The malformed tail of
+// the tower is ignored (see DecodeFloors).
 func (o *Tower) Floors() []*Floor {
+	floors, _ := DecodeFloors(o.TowerOctetString)
+	return floors
+}
+
+// DecodeFloors function decodes the tower octet string into the floors.
+// The floors decoded before the error are returned along with the error.
+func DecodeFloors(b []byte) ([]*Floor, error) {
+
+	if len(b) < 2 {
+		return nil, fmt.Errorf("%w: floor count is truncated", ErrInvalidTower)
+	}
 
-	buf := bytes.NewBuffer(o.TowerOctetString)
+	n := int(binary.LittleEndian.Uint16(b))
+	b = b[2:]
+
+	// read function reads the length-prefixed floor side.
+	read := func() ([]byte, error) {
+		if len(b) < 2 {
+			return nil, fmt.Errorf("%w: floor length is truncated", ErrInvalidTower)
+		}
+		l := int(binary.LittleEndian.Uint16(b))
+		if len(b) < 2+l {
+			return nil, fmt.Errorf("%w: floor data is truncated", ErrInvalidTower)
+		}
+		side := b[2 : 2+l]
+		b = b[2+l:]
+		return side, nil
+	}
 
-	var l uint16
-	binary.Read(buf, binary.LittleEndian, &l)
+	floors := make([]*Floor, 0, min(n, 16))
 
-	floors := make([]*Floor, l)
+	for i := 0; i < n; i++ {
 
-	for i := range floors {
+		lhs, err := read()
+		if err != nil {
+			return floors, fmt.Errorf("floor %d: %w", i, err)
+		}
 
-		floors[i] = &Floor{}
+		if len(lhs) < 1 {
+			return floors, fmt.Errorf("floor %d: %w: empty protocol identifier", i, ErrInvalidTower)
+		}
 
-		binary.Read(buf, binary.LittleEndian, &l)
+		floor := &Floor{Protocol: lhs[0]}
 
-		if l >= 1 {
-			binary.Read(buf, binary.LittleEndian, &floors[i].Protocol)
-			l -= 1
+		if lhs = lhs[1:]; len(lhs) >= 16 {
+			floor.UUID = new(uuid.UUID)
+			binary.Read(bytes.NewReader(lhs[:16]), binary.LittleEndian, floor.UUID)
+			lhs = lhs[16:]
 		}
-		if l >= 16 {
-			floors[i].UUID = new(uuid.UUID)
-			binary.Read(buf, binary.LittleEndian, floors[i].UUID)
-			l -= 16
+
+		if len(lhs) >= 2 {
+			floor.VersionMajor = binary.LittleEndian.Uint16(lhs)
 		}
-		if l >= 2 {
-			binary.Read(buf, binary.LittleEndian, &floors[i].VersionMajor)
-			l -= 2
+
+		rhs, err := read()
+		if err != nil {
+			return floors, fmt.Errorf("floor %d: %w", i, err)
 		}
 
-		binary.Read(buf, binary.LittleEndian, &l)
-		floors[i].Data = make([]byte, l)
-		buf.Read(floors[i].Data)
+		floor.Data = append([]byte{}, rhs...)
+
+		floors = append(floors, floor)
 	}
 
-	return floors
+	return floors, nil
 }
@@ -0,0 +1,53 @@
+package dcetypes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+func TestTower(t *testing.T) {
+
+	ifUUID := uuid.New(0x12345778, 0x1234, 0xabcd, 0xef, 0x00, [6]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xac})
+
+	for _, sb := range []dcerpc.StringBinding{
+		{ProtocolSequence: dcerpc.ProtocolSequenceIPTCP, NetworkAddress: "10.0.0.1", Endpoint: "49664"},
+		{ProtocolSequence: dcerpc.ProtocolSequenceIPUDP, NetworkAddress: "10.0.0.1", Endpoint: "135"},
+		{ProtocolSequence: dcerpc.ProtocolSequenceNamedPipe, ComputerName: "DC01", Endpoint: `\pipe\lsass`},
+		{ProtocolSequence: dcerpc.ProtocolSequenceLRPC, Endpoint: "LRPC-0123456789abcdef"},
+	} {
+
+		in := &dcerpc.Binding{
+			SyntaxID:         dcerpc.SyntaxID{IfUUID: ifUUID, IfVersionMajor: 1, IfVersionMinor: 2},
+			TransferSyntaxID: *dcerpc.TransferNDRSyntaxV2_0,
+			StringBinding:    sb,
+		}
+
+		tower, err := NewTower(in)
+		if err != nil {
+			t.Fatalf("new tower: %s: %v", sb, err)
+		}
+
+		if _, err := ParseTower(tower.TowerOctetString); err != nil {
+			t.Fatalf("parse tower: %s: %v", sb, err)
+		}
+
+		out := tower.Binding()
+
+		if !out.SyntaxID.Is(&in.SyntaxID) || !out.TransferSyntaxID.Is(&in.TransferSyntaxID) || out.StringBinding.String() != sb.String() {
+			t.Fatalf("unexpected binding: %s, expected %s", out.StringBinding, sb)
+		}
+	}
+
+	b := FloorsToTower([]*Floor{NewSyntaxFloor(dcerpc.TransferNDRSyntaxV2_0), NewPortFloor(ProtocolTCP, 135)}).TowerOctetString
+
+	if _, err := ParseTower(b[:len(b)-1]); !errors.Is(err, ErrInvalidTower) {
+		t.Fatalf("parse truncated tower: %v", err)
+	}
+
+	if floors := (&Tower{TowerOctetString: b[:len(b)-1]}).Floors(); len(floors) != 1 {
+		t.Fatalf("unexpected floors: %v", floors)
+	}
+}
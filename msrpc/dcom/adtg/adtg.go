@@ -161,6 +161,9 @@ func (o *DataFactory2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -254,6 +257,9 @@ func (o *DataFactory3) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -347,5 +353,8 @@ func (o *DataFactory) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
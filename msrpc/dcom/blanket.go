@@ -0,0 +1,89 @@
+package dcom
+
+import (
+	"fmt"
+
+	dcerpc "github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+// ProxyBlanket structure represents the security blanket of the object
+// proxy (see CoSetProxyBlanket).
+type ProxyBlanket struct {
+	// The authentication service. (default is inherited from the
+	// activation connection)
+	AuthnSvc dcerpc.AuthType
+	// The server principal name. (default is inherited from the
+	// activation connection)
+	ServerPrincipalName string
+	// The authentication level. (default is inherited from the
+	// activation connection)
+	AuthnLevel dcerpc.AuthLevel
+	// The impersonation level. (default is impersonate)
+	ImpLevel dcerpc.ImpersonationLevel
+	// The identity (credentials). (default is the identity of the
+	// activation connection)
+	AuthInfo any
+	// The additional security options.
+	Options []dcerpc.Option
+}
+
+// options function returns the bind options for the blanket.
+func (b *ProxyBlanket) options() []dcerpc.Option {
+
+	opts := []dcerpc.Option{}
+
+	if b.AuthnSvc != dcerpc.AuthTypeNone {
+		opts = append(opts, dcerpc.WithSecurtyProvider(b.AuthnSvc))
+	}
+	if b.ServerPrincipalName != "" {
+		opts = append(opts, dcerpc.WithTargetName(b.ServerPrincipalName))
+	}
+	if b.AuthnLevel != dcerpc.AuthLevelDefault {
+		opts = append(opts, dcerpc.WithSecurityLevel(b.AuthnLevel))
+	}
+	if b.ImpLevel != 0 {
+		opts = append(opts, dcerpc.WithImpersonationLevel(b.ImpLevel))
+	}
+
+	return append(opts, b.Options...)
+}
+
+// SetProxyBlanket function sets the security blanket for the object proxy
+// identified by the connection and the interface pointer identifier, so that
+// the proxy calls are performed with the authentication level, impersonation
+// level and identity distinct from the activation connection. The security
+// context is negotiated with alter_context when the first call on the proxy
+// is issued:
+//
+//	svcs, err := iwbemservices.NewServicesClient(ctx, cc, dcom.WithIPID(ipid))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	err = dcom.SetProxyBlanket(svcs.Conn(), ipid, &dcom.ProxyBlanket{
+//		AuthnLevel: dcerpc.AuthLevelPktPrivacy,
+//		AuthInfo:   admin,
+//	})
+//
+// The nil (or empty) blanket resets the proxy security to the activation
+// connection security.
+func SetProxyBlanket(cc dcerpc.Conn, ipid *IPID, blanket *ProxyBlanket) error {
+
+	sc, ok := cc.(dcerpc.ObjectSecurityConn)
+	if !ok {
+		return fmt.Errorf("set proxy blanket: %w", dcerpc.ErrObjectSecurityNotSupported)
+	}
+
+	if ipid == nil {
+		return fmt.Errorf("set proxy blanket: ipid is missing")
+	}
+
+	if blanket == nil {
+		sc.SetObjectSecurity(ipid.UUID(), nil)
+		return nil
+	}
+
+	sc.SetObjectSecurity(ipid.UUID(), blanket.AuthInfo, blanket.options()...)
+
+	return nil
+}
@@ -139,5 +139,8 @@ func (o *TransactionStream) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
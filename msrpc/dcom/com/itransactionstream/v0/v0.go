@@ -358,6 +358,9 @@ func (o *xxx_GetSeqAndTxViaExportOperation) UnmarshalNDRRequest(ctx context.Cont
 			}
 		}
 	}
+	if err := ndr.CheckSize("Whereabouts", len(o.Whereabouts), o.WhereaboutsLength, "WhereaboutsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -514,6 +517,9 @@ func (o *xxx_GetSeqAndTxViaExportOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ExportCookie", len(o.ExportCookie), o.ExportCookieLength, "ExportCookieLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -843,6 +849,9 @@ func (o *xxx_GetSeqAndTxViaTransmitterOperation) UnmarshalNDRResponse(ctx contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TransmitterBuffer", len(o.TransmitterBuffer), o.TransmitterBufferLength, "TransmitterBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1064,6 +1073,9 @@ func (o *xxx_GetTxViaExportOperation) UnmarshalNDRRequest(ctx context.Context, w
 			}
 		}
 	}
+	if err := ndr.CheckSize("Whereabouts", len(o.Whereabouts), o.WhereaboutsLength, "WhereaboutsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1208,6 +1220,9 @@ func (o *xxx_GetTxViaExportOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ExportCookie", len(o.ExportCookie), o.ExportCookieLength, "ExportCookieLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1519,6 +1534,9 @@ func (o *xxx_GetTxViaTransmitterOperation) UnmarshalNDRResponse(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TransmitterBuffer", len(o.TransmitterBuffer), o.TransmitterBufferLength, "TransmitterBufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
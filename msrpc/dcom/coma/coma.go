@@ -574,6 +574,9 @@ func (o *Import) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -709,6 +712,9 @@ func (o *CapabilitySupport) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -825,6 +831,9 @@ func (o *Export2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -961,6 +970,9 @@ func (o *CatalogUtils2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1094,6 +1106,9 @@ func (o *CatalogSession) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1224,6 +1239,9 @@ func (o *ReplicationUtil) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1348,6 +1366,9 @@ func (o *Import2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1468,6 +1489,9 @@ func (o *CatalogUtils) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1590,6 +1614,9 @@ func (o *Catalog64BitSupport) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1708,6 +1735,9 @@ func (o *CatalogTableInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1827,6 +1857,9 @@ func (o *Register) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1945,6 +1978,9 @@ func (o *AlternateLaunch) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2067,6 +2103,9 @@ func (o *ContainerControl) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2201,6 +2240,9 @@ func (o *ContainerControl2) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2334,6 +2376,9 @@ func (o *Register2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2460,6 +2505,9 @@ func (o *Export) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2577,6 +2625,9 @@ func (o *CatalogTableRead) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2694,5 +2745,8 @@ func (o *CatalogTableWrite) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
@@ -453,6 +453,12 @@ func (o *xxx_GetClientTableInfoOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("QueryCellArray", len(o.QueryCellArray), o.QueryCellArrayLength, "QueryCellArrayLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("QueryComparison", len(o.QueryComparison), o.QueryComparisonLength, "QueryComparisonLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -891,6 +897,12 @@ func (o *xxx_GetClientTableInfoOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AuxiliaryGUID", len(o.AuxiliaryGUID), o.AuxiliaryGUIDCount, "AuxiliaryGUIDCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("PropertyMeta", len(o.PropertyMeta), o.PropertiesCount, "PropertiesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -448,6 +448,12 @@ func (o *xxx_ReadTableOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("QueryCellArray", len(o.QueryCellArray), o.QueryCellArrayLength, "QueryCellArrayLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("QueryComparison", len(o.QueryComparison), o.QueryComparisonLength, "QueryComparisonLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -878,6 +884,15 @@ func (o *xxx_ReadTableOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TableDataFixed", len(o.TableDataFixed), o.TableDataFixedLength, "TableDataFixedLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("TableDataVariable", len(o.TableDataVariable), o.TableDataVariableLength, "TableDataVariableLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("TableDetailedErrors", len(o.TableDetailedErrors), o.TableDetailedErrorsLength, "TableDetailedErrorsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
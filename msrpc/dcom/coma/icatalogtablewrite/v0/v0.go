@@ -731,6 +731,18 @@ func (o *xxx_WriteTableOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("QueryCellArray", len(o.QueryCellArray), o.QueryCellArrayLength, "QueryCellArrayLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("QueryComparison", len(o.QueryComparison), o.QueryComparisonLength, "QueryComparisonLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("TableDataFixedWrite", len(o.TableDataFixedWrite), o.TableDataFixedWriteLength, "TableDataFixedWriteLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("TableDataVariable", len(o.TableDataVariable), o.TableDataVariableLength, "TableDataVariableLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -875,6 +887,9 @@ func (o *xxx_WriteTableOperation) UnmarshalNDRResponse(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TableDetailedErrors", len(o.TableDetailedErrors), o.TableDetailedErrorsLength, "TableDetailedErrorsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1128,6 +1128,15 @@ func (o *xxx_GetEventClassesForIIDOperation) UnmarshalNDRResponse(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ClassIDs", len(o.ClassIDs), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ProgIDs", len(o.ProgIDs), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Descriptions", len(o.Descriptions), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -553,6 +553,9 @@ func (o *xxx_CopyConglomerationsOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ConglomerationNamesOrIDs", len(o.ConglomerationNamesOrIDs), o.ConglomerationsCount, "ConglomerationsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2121,6 +2124,21 @@ func (o *xxx_GetEventClassesForIid2Operation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ClassIDs", len(o.ClassIDs), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ProgIDs", len(o.ProgIDs), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Descriptions", len(o.Descriptions), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ConglomerationIDs", len(o.ConglomerationIDs), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("IsPrivate", len(o.IsPrivate), o.ClassesCount, "ClassesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2907,6 +2925,9 @@ func (o *xxx_EnumerateSRPLevelsOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SRPLevels", len(o.SRPLevels), o.LevelsCount, "LevelsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3460,6 +3481,18 @@ func (o *xxx_GetComponentVersionsOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PartitionIDs", len(o.PartitionIDs), o.Versions, "Versions"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ConglomerationIDs", len(o.ConglomerationIDs), o.Versions, "Versions"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("IsPrivate", len(o.IsPrivate), o.Versions, "Versions"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Bitness", len(o.Bitness), o.Versions, "Versions"); err != nil {
+		return err
+	}
 	return nil
 }
 
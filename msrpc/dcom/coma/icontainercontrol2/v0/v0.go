@@ -1473,6 +1473,9 @@ func (o *xxx_GetRunningContainersOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Containers", len(o.Containers), o.ContainersLength, "ContainersLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
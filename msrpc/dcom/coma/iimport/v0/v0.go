@@ -1040,6 +1040,24 @@ func (o *xxx_ImportFromFileOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ModuleFlags", len(o.ModuleFlags), o.ModulesCount, "ModulesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Modules", len(o.Modules), o.ModulesCount, "ModulesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultClassIDs", len(o.ResultClassIDs), o.ComponentsCount, "ComponentsCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultNames", len(o.ResultNames), o.ComponentsCount, "ComponentsCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultFlags", len(o.ResultFlags), o.ComponentsCount, "ComponentsCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultHRs", len(o.ResultHRs), o.ComponentsCount, "ComponentsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1668,6 +1686,15 @@ func (o *xxx_QueryFileOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.Conglomerations, "Conglomerations"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Descriptions", len(o.Descriptions), o.Conglomerations, "Conglomerations"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Modules", len(o.Modules), o.ModulesCount, "ModulesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -426,6 +426,12 @@ func (o *xxx_RegisterModuleOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Modules", len(o.Modules), o.ModulesCount, "ModulesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("RequestedClassIDs", len(o.RequestedClassIDs), o.RequestedCount, "RequestedCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -887,6 +893,18 @@ func (o *xxx_RegisterModuleOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ResultClassIDs", len(o.ResultClassIDs), o.ResultsCount, "ResultsCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultNames", len(o.ResultNames), o.ResultsCount, "ResultsCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultFlags", len(o.ResultFlags), o.ResultsCount, "ResultsCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultHRs", len(o.ResultHRs), o.ResultsCount, "ResultsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1419,6 +1419,9 @@ func (o *xxx_QueryConglomerationPasswordOperation) UnmarshalNDRResponse(ctx cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordLength, "PasswordLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -155,6 +155,9 @@ func (o *EventSystemInitialize) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -299,6 +302,9 @@ func (o *EventClass) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -422,6 +428,9 @@ func (o *EnumEventObject) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -553,6 +562,9 @@ func (o *EventClass2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -676,6 +688,9 @@ func (o *EventClass3) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -806,6 +821,9 @@ func (o *EventSubscription3) UnmarshalNDR(ctx context.Context, w ndr.Reader) err
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -928,6 +946,9 @@ func (o *EventSubscription2) UnmarshalNDR(ctx context.Context, w ndr.Reader) err
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1068,6 +1089,9 @@ func (o *EventObjectCollection) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1185,6 +1209,9 @@ func (o *EventSystem2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1373,6 +1400,9 @@ func (o *EventSubscription) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1501,5 +1531,8 @@ func (o *EventSystem) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
@@ -463,6 +463,9 @@ func (o *GetTrackingData) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -577,6 +580,9 @@ func (o *COMTrackingInfoEvents) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -696,5 +702,8 @@ func (o *ProcessDump) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
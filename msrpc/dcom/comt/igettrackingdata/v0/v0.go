@@ -412,6 +412,9 @@ func (o *xxx_GetContainerDataOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ContainerData", len(o.ContainerData), o.Containers, "Containers"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -721,6 +724,9 @@ func (o *xxx_GetComponentDataByContainerOperation) UnmarshalNDRResponse(ctx cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ComponentData", len(o.ComponentData), o.Components, "Components"); err != nil {
+		return err
+	}
 	return nil
 }
 
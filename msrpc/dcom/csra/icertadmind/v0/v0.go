@@ -4566,6 +4566,12 @@ func (o *xxx_OpenViewOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("CertViewRestrictions", len(o.CertViewRestrictions), o.CertViewRestrictionCount, "CertViewRestrictionCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ColumnsOut", len(o.ColumnsOut), o.ColumnsCountOut, "ColumnsCountOut"); err != nil {
+		return err
+	}
 	return nil
 }
 
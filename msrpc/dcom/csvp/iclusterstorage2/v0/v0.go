@@ -2069,6 +2069,9 @@ func (o *xxx_RawWriteOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			}
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3790,6 +3793,9 @@ func (o *xxx_WriteFileDataOperation) UnmarshalNDRRequest(ctx context.Context, w
 			}
 		}
 	}
+	if err := ndr.CheckSize("DataIn", len(o.DataIn), o.DataInLength, "DataInLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4111,6 +4117,9 @@ func (o *xxx_VerifyFileDataOperation) UnmarshalNDRRequest(ctx context.Context, w
 			}
 		}
 	}
+	if err := ndr.CheckSize("DataIn", len(o.DataIn), o.DataInLength, "DataInLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1175,6 +1175,12 @@ func (o *xxx_GetUniqueIDs3Operation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DeviceIDHeader", len(o.DeviceIDHeader), o.DeviceIDHeaderLength, "DeviceIDHeaderLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DeviceDescriptor", len(o.DeviceDescriptor), o.DeviceDescriptorLength, "DeviceDescriptorLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2127,6 +2133,9 @@ func (o *xxx_CreateNewSMBShares3Operation) UnmarshalNDRResponse(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SharePaths", len(o.SharePaths), o.NumberOfPaths, "NumberOfPaths"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2354,6 +2363,9 @@ func (o *xxx_ConnectToNewSMBShares3Operation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SharePaths", len(o.SharePaths), o.NumberOfPaths, "NumberOfPaths"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1015,6 +1015,9 @@ func (o *DualStringArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("StringArray", len(o.StringArray), o.EntriesLength, "EntriesLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1114,6 +1117,9 @@ func (o *InterfacePointer) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3496,6 +3502,9 @@ func (o *CustomRemoteRequestSCMInfo) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadPointer(&o.RequestedProtocolSequences, _s_pRequestedProtseqs, _ptr_pRequestedProtseqs); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RequestedProtocolSequencesCount", o.RequestedProtocolSequencesCount, 0, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3836,6 +3845,9 @@ func (o *InstantiationInfoData) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := o.ClientCOMVersion.UnmarshalNDR(ctx, w); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IIDCount", o.IIDCount, 1, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4338,6 +4350,9 @@ func (o *CustomHeader) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&_pdwReserved, _s_pdwReserved, _ptr_pdwReserved); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("InterfacesCount", o.InterfacesCount, 1, 10); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4630,6 +4645,9 @@ func (o *PropertiesOutInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadPointer(&o.InterfaceData, _s_ppIntfData, _ptr_ppIntfData); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("InterfacesCount", o.InterfacesCount, 1, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5455,6 +5473,9 @@ func (o *RemoteUnknown2) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5548,6 +5569,9 @@ func (o *Unknown) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5641,5 +5665,8 @@ func (o *RemoteUnknown) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
@@ -252,6 +252,9 @@ func (o *xxx_ObjectsChangedOperation) UnmarshalNDRRequest(ctx context.Context, w
 			}
 		}
 	}
+	if err := ndr.CheckSize("ByteStream", len(o.ByteStream), o.ByteCount, "ByteCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
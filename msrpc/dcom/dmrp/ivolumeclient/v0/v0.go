@@ -1866,6 +1866,9 @@ func (o *xxx_EnumDisksOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2182,6 +2185,9 @@ func (o *xxx_EnumDiskRegionsOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RegionList", len(o.RegionList), o.RegionsLength, "RegionsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4447,6 +4453,9 @@ func (o *xxx_FTEnumVolumesOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("VolumeList", len(o.VolumeList), o.VolumeCount, "VolumeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4757,6 +4766,9 @@ func (o *xxx_FTEnumLogicalDiskMembersOperation) UnmarshalNDRResponse(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MemberList", len(o.MemberList), o.MemberCount, "MemberCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6726,6 +6738,9 @@ func (o *xxx_EnumDriveLettersOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DriveLetterList", len(o.DriveLetterList), o.DriveLetterCount, "DriveLetterCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7606,6 +7621,9 @@ func (o *xxx_EnumLocalFileSystemsOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FileSystemList", len(o.FileSystemList), o.FileSystemCount, "FileSystemCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7897,6 +7915,9 @@ func (o *xxx_GetInstalledFileSystemsOperation) UnmarshalNDRResponse(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FSList", len(o.FSList), o.FSCount, "FSCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8505,6 +8526,9 @@ func (o *xxx_EnumVolumesOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("LDMVolumeList", len(o.LDMVolumeList), o.VolumeCount, "VolumeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8815,6 +8839,9 @@ func (o *xxx_EnumVolumeMembersOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MemberList", len(o.MemberList), o.MemberCount, "MemberCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9049,6 +9076,9 @@ func (o *xxx_CreateVolumeOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9432,6 +9462,9 @@ func (o *xxx_CreateVolumeAssignAndFormatOperation) UnmarshalNDRRequest(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9908,6 +9941,9 @@ func (o *xxx_CreateVolumeAssignAndFormatExOperation) UnmarshalNDRRequest(ctx con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10573,6 +10609,9 @@ func (o *xxx_GrowVolumeOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12766,6 +12805,9 @@ func (o *xxx_ImportDiskGroupOperation) UnmarshalNDRRequest(ctx context.Context,
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskGroupID", len(o.DiskGroupID), o.DiskGroupIDLength, "DiskGroupIDLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13126,6 +13168,12 @@ func (o *xxx_DiskMergeQueryOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskGroupID", len(o.DiskGroupID), o.DiskGroupIDLength, "DiskGroupIDLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DisksLength, "DisksLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13409,6 +13457,12 @@ func (o *xxx_DiskMergeQueryOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MergeDMRIDs", len(o.MergeDMRIDs), o.RIDsLength, "RIDsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("MergeObjectInfo", len(o.MergeObjectInfo), o.ObjectsLength, "ObjectsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13773,6 +13827,15 @@ func (o *xxx_DiskMergeOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskGroupID", len(o.DiskGroupID), o.DiskGroupIDLength, "DiskGroupIDLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DisksLength, "DisksLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("MergeDMRIDs", len(o.MergeDMRIDs), o.RIDsLength, "RIDsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14853,6 +14916,9 @@ func (o *xxx_GetEncapsulateDiskInfoOperation) UnmarshalNDRRequest(ctx context.Co
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskSpecList", len(o.DiskSpecList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15300,6 +15366,18 @@ func (o *xxx_GetEncapsulateDiskInfoOperation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AffectedDiskList", len(o.AffectedDiskList), o.AffectedDiskCount, "AffectedDiskCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedDiskFlags", len(o.AffectedDiskFlags), o.AffectedDiskCount, "AffectedDiskCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedVolumeList", len(o.AffectedVolumeList), o.AffectedVolumeCount, "AffectedVolumeCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedRegionList", len(o.AffectedRegionList), o.AffectedRegionCount, "AffectedRegionCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15774,6 +15852,15 @@ func (o *xxx_EncapsulateDiskOperation) UnmarshalNDRRequest(ctx context.Context,
 			}
 		}
 	}
+	if err := ndr.CheckSize("AffectedDiskList", len(o.AffectedDiskList), o.AffectedDiskCount, "AffectedDiskCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedVolumeList", len(o.AffectedVolumeList), o.AffectedVolumeCount, "AffectedVolumeCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedRegionList", len(o.AffectedRegionList), o.AffectedRegionCount, "AffectedRegionCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17053,6 +17140,9 @@ func (o *xxx_EnumTasksOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TaskList", len(o.TaskList), o.TaskCount, "TaskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17849,6 +17939,9 @@ func (o *xxx_HResultGetErrorDataOperation) UnmarshalNDRResponse(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Size, "Size"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19584,6 +19677,9 @@ func (o *xxx_EnumAccessPathOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Paths", len(o.Paths), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19903,6 +19999,9 @@ func (o *xxx_EnumAccessPathForVolumeOperation) UnmarshalNDRResponse(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Paths", len(o.Paths), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
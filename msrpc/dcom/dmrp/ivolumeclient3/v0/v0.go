@@ -1928,6 +1928,9 @@ func (o *xxx_EnumDisksExOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2246,6 +2249,9 @@ func (o *xxx_EnumDiskRegionsExOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RegionList", len(o.RegionList), o.RegionsLength, "RegionsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4531,6 +4537,9 @@ func (o *xxx_FTEnumVolumesOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("VolumeList", len(o.VolumeList), o.VolumeCount, "VolumeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4841,6 +4850,9 @@ func (o *xxx_FTEnumLogicalDiskMembersOperation) UnmarshalNDRResponse(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MemberList", len(o.MemberList), o.MemberCount, "MemberCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6810,6 +6822,9 @@ func (o *xxx_EnumDriveLettersOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DriveLetterList", len(o.DriveLetterList), o.DriveLetterCount, "DriveLetterCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7690,6 +7705,9 @@ func (o *xxx_EnumLocalFileSystemsOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FileSystemList", len(o.FileSystemList), o.FileSystemCount, "FileSystemCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7981,6 +7999,9 @@ func (o *xxx_GetInstalledFileSystemsOperation) UnmarshalNDRResponse(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FSList", len(o.FSList), o.FSCount, "FSCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8589,6 +8610,9 @@ func (o *xxx_EnumVolumesOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("LDMVolumeList", len(o.LDMVolumeList), o.VolumeCount, "VolumeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8899,6 +8923,9 @@ func (o *xxx_EnumVolumeMembersOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MemberList", len(o.MemberList), o.MemberCount, "MemberCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9133,6 +9160,9 @@ func (o *xxx_CreateVolumeOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9516,6 +9546,9 @@ func (o *xxx_CreateVolumeAssignAndFormatOperation) UnmarshalNDRRequest(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9992,6 +10025,9 @@ func (o *xxx_CreateVolumeAssignAndFormatExOperation) UnmarshalNDRRequest(ctx con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10657,6 +10693,9 @@ func (o *xxx_GrowVolumeOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13619,6 +13658,9 @@ func (o *xxx_ImportDiskGroupOperation) UnmarshalNDRRequest(ctx context.Context,
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskGroupID", len(o.DiskGroupID), o.DiskGroupIDLength, "DiskGroupIDLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13979,6 +14021,12 @@ func (o *xxx_DiskMergeQueryOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskGroupID", len(o.DiskGroupID), o.DiskGroupIDLength, "DiskGroupIDLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DisksLength, "DisksLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14262,6 +14310,12 @@ func (o *xxx_DiskMergeQueryOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MergeDMRIDs", len(o.MergeDMRIDs), o.RIDsLength, "RIDsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("MergeObjectInfo", len(o.MergeObjectInfo), o.ObjectsLength, "ObjectsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14626,6 +14680,15 @@ func (o *xxx_DiskMergeOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskGroupID", len(o.DiskGroupID), o.DiskGroupIDLength, "DiskGroupIDLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DiskList", len(o.DiskList), o.DisksLength, "DisksLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("MergeDMRIDs", len(o.MergeDMRIDs), o.RIDsLength, "RIDsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15706,6 +15769,9 @@ func (o *xxx_GetEncapsulateDiskInfoExOperation) UnmarshalNDRRequest(ctx context.
 			}
 		}
 	}
+	if err := ndr.CheckSize("DiskSpecList", len(o.DiskSpecList), o.DiskCount, "DiskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16153,6 +16219,18 @@ func (o *xxx_GetEncapsulateDiskInfoExOperation) UnmarshalNDRResponse(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AffectedDiskList", len(o.AffectedDiskList), o.AffectedDiskCount, "AffectedDiskCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedDiskFlags", len(o.AffectedDiskFlags), o.AffectedDiskCount, "AffectedDiskCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedVolumeList", len(o.AffectedVolumeList), o.AffectedVolumeCount, "AffectedVolumeCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedRegionList", len(o.AffectedRegionList), o.AffectedRegionCount, "AffectedRegionCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16637,6 +16715,15 @@ func (o *xxx_EncapsulateDiskExOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AffectedDiskList", len(o.AffectedDiskList), o.AffectedDiskCount, "AffectedDiskCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedVolumeList", len(o.AffectedVolumeList), o.AffectedVolumeCount, "AffectedVolumeCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AffectedRegionList", len(o.AffectedRegionList), o.AffectedRegionCount, "AffectedRegionCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17917,6 +18004,9 @@ func (o *xxx_EnumTasksOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TaskList", len(o.TaskList), o.TaskCount, "TaskCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18715,6 +18805,9 @@ func (o *xxx_HResultGetErrorDataOperation) UnmarshalNDRResponse(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Size, "Size"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20450,6 +20543,9 @@ func (o *xxx_EnumAccessPathOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Paths", len(o.Paths), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20769,6 +20865,9 @@ func (o *xxx_EnumAccessPathForVolumeOperation) UnmarshalNDRResponse(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Paths", len(o.Paths), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
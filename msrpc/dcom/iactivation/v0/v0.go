@@ -453,6 +453,18 @@ func (o *xxx_RemoteActivationOperation) UnmarshalNDRRequest(ctx context.Context,
 			}
 		}
 	}
+	if err := ndr.CheckRange("Interfaces", o.Interfaces, 1, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("IIDs", len(o.IIDs), o.Interfaces, "Interfaces"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("RequestedProtocolSequencesCount", o.RequestedProtocolSequencesCount, 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("RequestedProtocolSequences", len(o.RequestedProtocolSequences), o.RequestedProtocolSequencesCount, "RequestedProtocolSequencesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -123,6 +123,9 @@ func (o *AppHostPropertySchema) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -218,6 +221,9 @@ func (o *AppHostPropertySchemaCollection) UnmarshalNDR(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -313,6 +319,9 @@ func (o *AppHostSectionDefinition) UnmarshalNDR(ctx context.Context, w ndr.Reade
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -408,6 +417,9 @@ func (o *AppHostConfigLocation) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -503,6 +515,9 @@ func (o *AppHostProperty) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -596,6 +611,9 @@ func (o *AppHostMethod) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -691,6 +709,9 @@ func (o *AppHostMethodCollection) UnmarshalNDR(ctx context.Context, w ndr.Reader
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -786,6 +807,9 @@ func (o *AppHostElementCollection) UnmarshalNDR(ctx context.Context, w ndr.Reade
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -881,6 +905,9 @@ func (o *AppHostSectionDefinitionCollection) UnmarshalNDR(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -976,6 +1003,9 @@ func (o *AppHostElement) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1071,6 +1101,9 @@ func (o *AppHostConstantValueCollection) UnmarshalNDR(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1166,6 +1199,9 @@ func (o *AppHostConfigException) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1261,6 +1297,9 @@ func (o *AppHostMappingExtension) UnmarshalNDR(ctx context.Context, w ndr.Reader
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1356,6 +1395,9 @@ func (o *AppHostPathMapper) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1451,6 +1493,9 @@ func (o *AppHostPropertyException) UnmarshalNDR(ctx context.Context, w ndr.Reade
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1546,6 +1591,9 @@ func (o *AppHostConstantValue) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1641,6 +1689,9 @@ func (o *AppHostConfigLocationCollection) UnmarshalNDR(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1736,6 +1787,9 @@ func (o *AppHostElementSchemaCollection) UnmarshalNDR(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1831,6 +1885,9 @@ func (o *AppHostMethodInstance) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1926,6 +1983,9 @@ func (o *AppHostConfigManager) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2021,6 +2081,9 @@ func (o *AppHostElementSchema) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2116,6 +2179,9 @@ func (o *AppHostAdminManager) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2211,6 +2277,9 @@ func (o *AppHostConfigFile) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2306,6 +2375,9 @@ func (o *AppHostPropertyCollection) UnmarshalNDR(ctx context.Context, w ndr.Read
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2401,6 +2473,9 @@ func (o *AppHostChangeHandler) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2496,6 +2571,9 @@ func (o *AppHostMethodSchema) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2591,6 +2669,9 @@ func (o *AppHostCollectionSchema) UnmarshalNDR(ctx context.Context, w ndr.Reader
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2686,6 +2767,9 @@ func (o *AppHostWritableAdminManager) UnmarshalNDR(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2781,6 +2865,9 @@ func (o *AppHostSectionGroup) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2876,5 +2963,8 @@ func (o *AppHostChildElementCollection) UnmarshalNDR(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataCount, "DataCount"); err != nil {
+		return err
+	}
 	return nil
 }
@@ -277,6 +277,9 @@ func (o *xxx_ResolveOXIDOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			}
 		}
 	}
+	if err := ndr.CheckSize("RequestedProtocolSequences", len(o.RequestedProtocolSequences), o.RequestedProtocolSequencesCount, "RequestedProtocolSequencesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -802,6 +805,12 @@ func (o *xxx_ComplexPingOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			}
 		}
 	}
+	if err := ndr.CheckSize("AddToSet", len(o.AddToSet), o.AddToSetCount, "AddToSetCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DeleteFromSet", len(o.DeleteFromSet), o.DeleteFromSetCount, "DeleteFromSetCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1191,6 +1200,9 @@ func (o *xxx_ResolveOxid2Operation) UnmarshalNDRRequest(ctx context.Context, w n
 			}
 		}
 	}
+	if err := ndr.CheckSize("RequestedProtocolSequences", len(o.RequestedProtocolSequences), o.RequestedProtocolSequencesCount, "RequestedProtocolSequencesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
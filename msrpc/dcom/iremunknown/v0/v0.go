@@ -347,6 +347,9 @@ func (o *xxx_RemoteQueryInterfaceOperation) UnmarshalNDRRequest(ctx context.Cont
 			}
 		}
 	}
+	if err := ndr.CheckSize("IIDs", len(o.IIDs), o.IIDsCount, "IIDsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -709,6 +712,9 @@ func (o *xxx_RemoteAddReferenceOperation) UnmarshalNDRRequest(ctx context.Contex
 			}
 		}
 	}
+	if err := ndr.CheckSize("InterfaceReferences", len(o.InterfaceReferences), o.InterfaceReferencesCount, "InterfaceReferencesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1028,6 +1034,9 @@ func (o *xxx_RemoteReleaseOperation) UnmarshalNDRRequest(ctx context.Context, w
 			}
 		}
 	}
+	if err := ndr.CheckSize("InterfaceReferences", len(o.InterfaceReferences), o.InterfaceReferencesCount, "InterfaceReferencesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -288,6 +288,9 @@ func (o *xxx_RemoteQueryInterface2Operation) UnmarshalNDRRequest(ctx context.Con
 			}
 		}
 	}
+	if err := ndr.CheckSize("IIDs", len(o.IIDs), o.IIDsCount, "IIDsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1083,6 +1083,12 @@ func (o *xxx_GetIDsOfNamesOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.NamesCount, "NamesCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("NamesCount", o.NamesCount, 0, 16384); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1577,6 +1583,12 @@ func (o *xxx_InvokeOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("VarReferenceIndex", len(o.VarReferenceIndex), o.VarReferenceCount, "VarReferenceCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("VarReference", len(o.VarReference), o.VarReferenceCount, "VarReferenceCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3085,6 +3085,12 @@ func (o *xxx_FindNameOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TypeInfo", len(o.TypeInfo), o.FoundCount, "FoundCount"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("MemberIDs", len(o.MemberIDs), o.FoundCount, "FoundCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
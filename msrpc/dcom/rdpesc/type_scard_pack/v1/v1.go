@@ -154,6 +154,9 @@ func (o *ReaderSmartCardContext) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Context, _s_pbContext, _ptr_pbContext); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ContextLength", o.ContextLength, 0, 16); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -282,6 +285,9 @@ func (o *ReaderSmartCardHandle) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Handle, _s_pbHandle, _ptr_pbHandle); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("HandleLength", o.HandleLength, 0, 16); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -452,6 +458,9 @@ func (o *ListReaderGroupsReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Multistring, _s_msz, _ptr_msz); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BytesCount", o.BytesCount, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -563,6 +572,9 @@ func (o *ListReadersReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadPointer(&o.Multistring, _s_msz, _ptr_msz); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BytesCount", o.BytesCount, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1406,6 +1418,9 @@ func (o *ListReadersCall) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadData(&o.ReadersLength); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BytesCount", o.BytesCount, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1491,6 +1506,9 @@ func (o *ReaderStateCommonCall) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckRange("AttributeLength", o.AttributeLength, 0, 36); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1727,6 +1745,9 @@ func (o *ReaderStateReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckRange("AttributeLength", o.AttributeLength, 0, 36); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1872,6 +1893,9 @@ func (o *GetStatusChangeACall) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 11); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2088,6 +2112,12 @@ func (o *LocateCardsACall) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BytesCount", o.BytesCount, 0, 65536); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 10); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2304,6 +2334,12 @@ func (o *LocateCardsWCall) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BytesCount", o.BytesCount, 0, 65536); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 10); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2395,6 +2431,9 @@ func (o *LocateCardsAttributeMask) UnmarshalNDR(ctx context.Context, w ndr.Reade
 			return err
 		}
 	}
+	if err := ndr.CheckRange("AttributeLength", o.AttributeLength, 0, 36); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2619,6 +2658,12 @@ func (o *LocateCardsByAtraCall) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AttributesCount", o.AttributesCount, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 10); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2843,6 +2888,12 @@ func (o *LocateCardsByAtrwCall) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AttributesCount", o.AttributesCount, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 10); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2971,6 +3022,9 @@ func (o *LocateCardsReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 10); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3091,6 +3145,9 @@ func (o *GetStatusChangeReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 10); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3235,6 +3292,9 @@ func (o *GetStatusChangeWCall) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.ReaderStates, _s_rgReaderStates, _ptr_rgReaderStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ReadersCount", o.ReadersCount, 0, 11); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3951,6 +4011,9 @@ func (o *StateReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Attribute, _s_rgAtr, _ptr_rgAtr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AttributeLength", o.AttributeLength, 0, 36); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4228,6 +4291,12 @@ func (o *StatusReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.AttributeLength); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BytesCount", o.BytesCount, 0, 65536); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("AttributeLength", o.AttributeLength, 0, 32); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4345,6 +4414,9 @@ func (o *SmartCardIORequest) UnmarshalNDR(ctx context.Context, w ndr.Reader) err
 	if err := w.ReadPointer(&o.ExtraBytes, _s_pbExtraBytes, _ptr_pbExtraBytes); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ExtraBytesLength", o.ExtraBytesLength, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4560,6 +4632,9 @@ func (o *TransmitCall) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.RecvLength); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("SendLength", o.SendLength, 0, 66560); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4716,6 +4791,9 @@ func (o *TransmitReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.RecvBuffer, _s_pbRecvBuffer, _ptr_pbRecvBuffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RecvLength", o.RecvLength, 0, 66560); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4983,6 +5061,9 @@ func (o *ControlCall) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.OutBufferLength); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("InBufferLength", o.InBufferLength, 0, 66560); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5102,6 +5183,9 @@ func (o *ControlReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.OutBuffer, _s_pvOutBuffer, _ptr_pvOutBuffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("OutBufferLength", o.OutBufferLength, 0, 66560); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5314,6 +5398,9 @@ func (o *GetAttributeReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) err
 	if err := w.ReadPointer(&o.Attribute, _s_pbAttr, _ptr_pbAttr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AttributeLength", o.AttributeLength, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5449,6 +5536,9 @@ func (o *SetAttributeCall) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Attribute, _s_pbAttr, _ptr_pbAttr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AttributeLength", o.AttributeLength, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5835,6 +5925,9 @@ func (o *ReadCacheReturn) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Data, _s_pbData, _ptr_pbData); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("DataLength", o.DataLength, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6006,6 +6099,9 @@ func (o *WriteCacheCommon) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Data, _s_pbData, _ptr_pbData); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("DataLength", o.DataLength, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
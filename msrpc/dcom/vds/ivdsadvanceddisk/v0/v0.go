@@ -851,6 +851,9 @@ func (o *xxx_QueryPartitionsOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PartitionPropertyArray", len(o.PartitionPropertyArray), o.NumberOfPartitions, "NumberOfPartitions"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -268,6 +268,12 @@ func (o *xxx_OnNotifyOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			}
 		}
 	}
+	if err := ndr.CheckRange("NumberOfNotifications", o.NumberOfNotifications, 1, 100); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("NotificationArray", len(o.NotificationArray), o.NumberOfNotifications, "NumberOfNotifications"); err != nil {
+		return err
+	}
 	return nil
 }
 
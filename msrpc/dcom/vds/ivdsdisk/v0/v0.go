@@ -1170,6 +1170,9 @@ func (o *xxx_QueryExtentsOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ExtentArray", len(o.ExtentArray), o.NumberOfExtents, "NumberOfExtents"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -609,6 +609,9 @@ func (o *xxx_QueryFreeExtentsOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FreeExtentArray", len(o.FreeExtentArray), o.NumberOfFreeExtents, "NumberOfFreeExtents"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -954,6 +954,9 @@ func (o *xxx_QueryPartitionFileSystemFormatSupportOperation) UnmarshalNDRRespons
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FileSystemSupportProperties", len(o.FileSystemSupportProperties), o.NumberOfFileSystems, "NumberOfFileSystems"); err != nil {
+		return err
+	}
 	return nil
 }
 
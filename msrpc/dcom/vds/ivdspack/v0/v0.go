@@ -1464,6 +1464,9 @@ func (o *xxx_CreateVolumeOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InputDiskArray", len(o.InputDiskArray), o.NumberOfDisks, "NumberOfDisks"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2094,6 +2097,9 @@ func (o *xxx_MigrateDisksOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskArray", len(o.DiskArray), o.NumberOfDisks, "NumberOfDisks"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -309,6 +309,9 @@ func (o *xxx_CreateVolume2Operation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InputDiskArray", len(o.InputDiskArray), o.NumberOfDisks, "NumberOfDisks"); err != nil {
+		return err
+	}
 	return nil
 }
 
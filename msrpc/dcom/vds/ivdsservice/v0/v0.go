@@ -2443,6 +2443,9 @@ func (o *xxx_QueryFileSystemTypesOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FileSystemTypeProperties", len(o.FileSystemTypeProperties), o.NumberOfFileSystems, "NumberOfFileSystems"); err != nil {
+		return err
+	}
 	return nil
 }
 
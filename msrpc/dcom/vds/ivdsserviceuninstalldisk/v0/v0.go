@@ -563,6 +563,9 @@ func (o *xxx_UninstallDisksOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DiskIDArray", len(o.DiskIDArray), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1249,6 +1249,9 @@ func (o *xxx_ExtendOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InputDiskArray", len(o.InputDiskArray), o.NumberOfDisks, "NumberOfDisks"); err != nil {
+		return err
+	}
 	return nil
 }
 
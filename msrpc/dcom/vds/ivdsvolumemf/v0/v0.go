@@ -1454,6 +1454,9 @@ func (o *xxx_QueryAccessPathsOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PathArray", len(o.PathArray), o.NumberOfAccessPaths, "NumberOfAccessPaths"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1749,6 +1752,9 @@ func (o *xxx_QueryReparsePointsOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ReparsePointProperties", len(o.ReparsePointProperties), o.NumberOfReparsePointProperties, "NumberOfReparsePointProperties"); err != nil {
+		return err
+	}
 	return nil
 }
 
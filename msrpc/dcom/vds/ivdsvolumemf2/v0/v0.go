@@ -640,6 +640,9 @@ func (o *xxx_QueryFileSystemFormatSupportOperation) UnmarshalNDRResponse(ctx con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FileSystemSupportProperties", len(o.FileSystemSupportProperties), o.NumberOfFileSystems, "NumberOfFileSystems"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -420,6 +420,9 @@ func (o *xxx_QueryVolumeGUIDPathnamesOperation) UnmarshalNDRResponse(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PathArray", len(o.PathArray), o.NumberOfPaths, "NumberOfPaths"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -879,6 +879,9 @@ func (o *xxx_QueryExtentsOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ExtentArray", len(o.ExtentArray), o.NumberOfExtents, "NumberOfExtents"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1085,6 +1088,9 @@ func (o *xxx_RepairOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InputDiskArray", len(o.InputDiskArray), o.NumberOfDisks, "NumberOfDisks"); err != nil {
+		return err
+	}
 	return nil
 }
 
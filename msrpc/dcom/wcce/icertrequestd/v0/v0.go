@@ -216,10 +216,10 @@ func (o *xxx_RequestOperation) OpNum() int { return 3 }
 func (o *xxx_RequestOperation) OpName() string { return "/ICertRequestD/v0/Request" }
 
 func (o *xxx_RequestOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Authority) > int(1536) {
+	if ndr.UTF16NLen(o.Authority) > uint64(1536) {
 		return fmt.Errorf("Authority is out of range")
 	}
-	if len(o.Attributes) > int(1536) {
+	if ndr.UTF16NLen(o.Attributes) > uint64(1536) {
 		return fmt.Errorf("Attributes is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -390,6 +390,12 @@ func (o *xxx_RequestOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Authority", ndr.UTF16NLen(o.Authority), 0, 1536); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Attributes", ndr.UTF16NLen(o.Attributes), 0, 1536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -711,7 +717,7 @@ func (o *xxx_GetCACertOperation) OpNum() int { return 4 }
 func (o *xxx_GetCACertOperation) OpName() string { return "/ICertRequestD/v0/GetCACert" }
 
 func (o *xxx_GetCACertOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Authority) > int(1536) {
+	if ndr.UTF16NLen(o.Authority) > uint64(1536) {
 		return fmt.Errorf("Authority is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -806,6 +812,9 @@ func (o *xxx_GetCACertOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Authority", ndr.UTF16NLen(o.Authority), 0, 1536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -991,7 +1000,7 @@ func (o *xxx_PingOperation) OpNum() int { return 5 }
 func (o *xxx_PingOperation) OpName() string { return "/ICertRequestD/v0/Ping" }
 
 func (o *xxx_PingOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Authority) > int(1536) {
+	if ndr.UTF16NLen(o.Authority) > uint64(1536) {
 		return fmt.Errorf("Authority is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -1074,6 +1083,9 @@ func (o *xxx_PingOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reade
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Authority", ndr.UTF16NLen(o.Authority), 0, 1536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -239,13 +239,13 @@ func (o *xxx_Request2Operation) OpNum() int { return 6 }
 func (o *xxx_Request2Operation) OpName() string { return "/ICertRequestD2/v0/Request2" }
 
 func (o *xxx_Request2Operation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Authority) > int(1536) {
+	if ndr.UTF16NLen(o.Authority) > uint64(1536) {
 		return fmt.Errorf("Authority is out of range")
 	}
-	if len(o.SerialNumber) > int(64) {
+	if ndr.UTF16NLen(o.SerialNumber) > uint64(64) {
 		return fmt.Errorf("SerialNumber is out of range")
 	}
-	if len(o.Attributes) > int(1536) {
+	if ndr.UTF16NLen(o.Attributes) > uint64(1536) {
 		return fmt.Errorf("Attributes is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -453,6 +453,15 @@ func (o *xxx_Request2Operation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Authority", ndr.UTF16NLen(o.Authority), 0, 1536); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SerialNumber", ndr.UTF16NLen(o.SerialNumber), 0, 64); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Attributes", ndr.UTF16NLen(o.Attributes), 0, 1536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -764,7 +773,7 @@ func (o *xxx_GetCAPropertyOperation) OpNum() int { return 7 }
 func (o *xxx_GetCAPropertyOperation) OpName() string { return "/ICertRequestD2/v0/GetCAProperty" }
 
 func (o *xxx_GetCAPropertyOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Authority) > int(1536) {
+	if ndr.UTF16NLen(o.Authority) > uint64(1536) {
 		return fmt.Errorf("Authority is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -883,6 +892,9 @@ func (o *xxx_GetCAPropertyOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Authority", ndr.UTF16NLen(o.Authority), 0, 1536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1236,7 +1248,7 @@ func (o *xxx_GetCAPropertyInfoOperation) OpName() string {
 }
 
 func (o *xxx_GetCAPropertyInfoOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Authority) > int(1536) {
+	if ndr.UTF16NLen(o.Authority) > uint64(1536) {
 		return fmt.Errorf("Authority is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -1319,6 +1331,9 @@ func (o *xxx_GetCAPropertyInfoOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Authority", ndr.UTF16NLen(o.Authority), 0, 1536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1517,7 +1532,7 @@ func (o *xxx_Ping2Operation) OpNum() int { return 9 }
 func (o *xxx_Ping2Operation) OpName() string { return "/ICertRequestD2/v0/Ping2" }
 
 func (o *xxx_Ping2Operation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Authority) > int(1536) {
+	if ndr.UTF16NLen(o.Authority) > uint64(1536) {
 		return fmt.Errorf("Authority is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -1600,6 +1615,9 @@ func (o *xxx_Ping2Operation) UnmarshalNDRRequest(ctx context.Context, w ndr.Read
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Authority", ndr.UTF16NLen(o.Authority), 0, 1536); err != nil {
+		return err
+	}
 	return nil
 }
 
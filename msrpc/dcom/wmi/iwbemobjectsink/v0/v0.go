@@ -331,6 +331,9 @@ func (o *xxx_IndicateOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ObjectArray", len(o.ObjectArray), o.ObjectCount, "ObjectCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
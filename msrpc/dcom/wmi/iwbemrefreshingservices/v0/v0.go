@@ -2362,6 +2362,12 @@ func (o *xxx_ReconnectRemoteRefresherOperation) UnmarshalNDRRequest(ctx context.
 			}
 		}
 	}
+	if err := ndr.CheckSize("ReconnectInfo", len(o.ReconnectInfo), o.ObjectsLength, "ObjectsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ReconnectResults", len(o.ReconnectResults), o.ObjectsLength, "ObjectsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
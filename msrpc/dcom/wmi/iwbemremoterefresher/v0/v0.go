@@ -414,6 +414,9 @@ func (o *xxx_RemoteRefreshOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Objects", len(o.Objects), o.ObjectsLength, "ObjectsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -634,6 +637,9 @@ func (o *xxx_StopRefreshingOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("IDs", len(o.IDs), o.IDsLength, "IDsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
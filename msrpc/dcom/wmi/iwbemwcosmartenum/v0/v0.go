@@ -396,6 +396,9 @@ func (o *xxx_NextOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Read
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
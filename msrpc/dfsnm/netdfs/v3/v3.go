@@ -1385,6 +1385,9 @@ func (o *RootList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Entry", len(o.Entry), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,29 @@
+package dhcpm
+
+import (
+	"time"
+)
+
+// IsNever function returns `true` if the time represents the infinite
+// lease.
+func (o *DateTime) IsNever() bool {
+	return o.LowDateTime == 0xFFFFFFFF && o.HighDateTime == 0x7FFFFFFF
+}
+
+// IsZero function returns `true` if the time is not set.
+func (o *DateTime) IsZero() bool {
+	return o.LowDateTime == 0 && o.HighDateTime == 0
+}
+
+// AsTime function returns the time.Time, the zero time is returned for
+// the zero or infinite value.
+func (o *DateTime) AsTime() time.Time {
+	if o == nil || o.IsZero() || o.IsNever() {
+		return time.Time{}
+	}
+	// 100-nanosecond intervals since January 1, 1601.
+	nsec := (int64(o.HighDateTime) << 32) + int64(o.LowDateTime)
+	// change starting time to the Epoch (00:00:00 UTC, January 1, 1970).
+	nsec -= 116444736000000000
+	return time.Unix(nsec/10000000, (nsec%10000000)*100).UTC()
+}
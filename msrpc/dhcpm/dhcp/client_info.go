@@ -0,0 +1,370 @@
+package dhcp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+)
+
+// ErrUnsupportedClientInfo is returned when the value is not one of the
+// DHCP_CLIENT_INFO generations.
+var ErrUnsupportedClientInfo = errors.New("dhcp: unsupported client info")
+
+// ClientInfo is the normalized DHCPv4 client information. It represents all
+// of the DHCP_CLIENT_INFO generations (V, V4, V5, VQ, PB and EX): the fields
+// which are not present in the generation returned by the server are nil.
+type ClientInfo struct {
+	// The client IPv4 address.
+	IPAddress net.IP
+	// The client subnet mask.
+	SubnetMask net.IPMask
+	// The client unique identifier as returned by the server, see
+	// HardwareAddress method to get the hardware address.
+	ClientUID []byte
+	// The client name.
+	Name string
+	// The client comment.
+	Comment string
+	// The lease expiration time, zero if not set.
+	LeaseExpires time.Time
+	// The DHCP server that owns the lease.
+	OwnerHost *dhcpm.HostInfo
+	// The client type (V4 and later).
+	ClientType *uint8
+	// The address state (V5 and later).
+	AddressState *uint8
+	// The quarantine status (VQ and later).
+	Status *dhcpm.QuarantineStatus
+	// The probation end time (VQ and later).
+	ProbationEnds *time.Time
+	// Whether the client is quarantine capable (VQ and later).
+	QuarantineCapable *bool
+	// The link-layer filter status (PB and later).
+	FilterStatus *uint32
+	// The policy that resulted in the address assignment (PB and later).
+	PolicyName *string
+	// The client properties (EX).
+	Properties *dhcpm.PropertyArray
+}
+
+// HardwareAddress function returns the client hardware address, see
+// HardwareAddress function.
+func (c *ClientInfo) HardwareAddress() net.HardwareAddr {
+	return HardwareAddress(c.ClientUID, ipv4(c.IPAddress.Mask(c.SubnetMask)))
+}
+
+// NewClientInfo function returns the normalized client information for the
+// *dhcpm.ClientInfo, *dhcpm.ClientInfoV4, *dhcpm.ClientInfoV5, *dhcpm.ClientInfoVQ,
+// *dhcpm.ClientInfoPB or *dhcpm.ClientInfoEx value.
+func NewClientInfo(v any) (*ClientInfo, error) {
+
+	var c *ClientInfo
+
+	switch v := v.(type) {
+	case *dhcpm.ClientInfo:
+		if v == nil {
+			return nil, nil
+		}
+		c = newClientInfo(v.ClientIPAddress, v.SubnetMask, v.ClientHardwareAddress,
+			v.ClientName, v.ClientComment, v.ClientLeaseExpires, v.OwnerHost)
+	case *dhcpm.ClientInfoV4:
+		if v == nil {
+			return nil, nil
+		}
+		c = newClientInfo(v.ClientIPAddress, v.SubnetMask, v.ClientHardwareAddress,
+			v.ClientName, v.ClientComment, v.ClientLeaseExpires, v.OwnerHost)
+		c.ClientType = &v.ClientType
+	case *dhcpm.ClientInfoV5:
+		if v == nil {
+			return nil, nil
+		}
+		c = newClientInfo(v.ClientIPAddress, v.SubnetMask, v.ClientHardwareAddress,
+			v.ClientName, v.ClientComment, v.ClientLeaseExpires, v.OwnerHost)
+		c.ClientType, c.AddressState = &v.ClientType, &v.AddressState
+	case *dhcpm.ClientInfoVQ:
+		if v == nil {
+			return nil, nil
+		}
+		c = newClientInfo(v.ClientIPAddress, v.SubnetMask, v.ClientHardwareAddress,
+			v.ClientName, v.ClientComment, v.ClientLeaseExpires, v.OwnerHost)
+		c.ClientType, c.AddressState = &v.ClientType, &v.AddressState
+		c.setQuarantine(v.Status, v.ProbationEnds, v.QuarantineCapable)
+	case *dhcpm.ClientInfoPB:
+		if v == nil {
+			return nil, nil
+		}
+		c = newClientInfo(v.ClientIPAddress, v.SubnetMask, v.ClientHardwareAddress,
+			v.ClientName, v.ClientComment, v.ClientLeaseExpires, v.OwnerHost)
+		c.ClientType, c.AddressState = &v.ClientType, &v.AddressState
+		c.setQuarantine(v.Status, v.ProbationEnds, v.QuarantineCapable)
+		c.FilterStatus, c.PolicyName = &v.FilterStatus, &v.PolicyName
+	case *dhcpm.ClientInfoEx:
+		if v == nil {
+			return nil, nil
+		}
+		c = newClientInfo(v.ClientIPAddress, v.SubnetMask, v.ClientHardwareAddress,
+			v.ClientName, v.ClientComment, v.ClientLeaseExpires, v.OwnerHost)
+		c.ClientType, c.AddressState = &v.ClientType, &v.AddressState
+		c.setQuarantine(v.Status, v.ProbationEnds, v.QuarantineCapable)
+		c.FilterStatus, c.PolicyName = &v.FilterStatus, &v.PolicyName
+		c.Properties = v.Properties
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedClientInfo, v)
+	}
+
+	// detach the optional values from the source structure.
+	c.ClientType, c.AddressState = clone(c.ClientType), clone(c.AddressState)
+	c.FilterStatus, c.PolicyName = clone(c.FilterStatus), clone(c.PolicyName)
+
+	return c, nil
+}
+
+// NewClientInfos function returns the normalized client information list for
+// the *dhcpm.ClientInfoArray, *dhcpm.ClientInfoArrayV4, *dhcpm.ClientInfoArrayV5,
+// *dhcpm.ClientInfoArrayVQ, *dhcpm.ClientInfoPBArray or *dhcpm.ClientInfoExArray
+// value. The nil elements are skipped.
+func NewClientInfos(v any) ([]*ClientInfo, error) {
+	switch v := v.(type) {
+	case *dhcpm.ClientInfoArray:
+		if v == nil {
+			return nil, nil
+		}
+		return newClientInfos(v.Clients)
+	case *dhcpm.ClientInfoArrayV4:
+		if v == nil {
+			return nil, nil
+		}
+		return newClientInfos(v.Clients)
+	case *dhcpm.ClientInfoArrayV5:
+		if v == nil {
+			return nil, nil
+		}
+		return newClientInfos(v.Clients)
+	case *dhcpm.ClientInfoArrayVQ:
+		if v == nil {
+			return nil, nil
+		}
+		return newClientInfos(v.Clients)
+	case *dhcpm.ClientInfoPBArray:
+		if v == nil {
+			return nil, nil
+		}
+		return newClientInfos(v.Clients)
+	case *dhcpm.ClientInfoExArray:
+		if v == nil {
+			return nil, nil
+		}
+		return newClientInfos(v.Clients)
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedClientInfo, v)
+}
+
+func newClientInfos[T any](clients []*T) ([]*ClientInfo, error) {
+	ret := make([]*ClientInfo, 0, len(clients))
+	for _, client := range clients {
+		c, err := NewClientInfo(client)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			ret = append(ret, c)
+		}
+	}
+	return ret, nil
+}
+
+func newClientInfo(ip, mask uint32, uid *dhcpm.ClientUID, name, comment string, expires *dhcpm.DateTime, owner *dhcpm.HostInfo) *ClientInfo {
+	c := &ClientInfo{
+		IPAddress:    IPv4(ip),
+		SubnetMask:   net.IPMask(IPv4(mask)),
+		Name:         name,
+		Comment:      comment,
+		LeaseExpires: dateTime(expires),
+		OwnerHost:    owner,
+	}
+	if uid != nil && uid.Data != nil {
+		c.ClientUID = append([]byte{}, uid.Data...)
+	}
+	return c
+}
+
+func (c *ClientInfo) setQuarantine(status dhcpm.QuarantineStatus, probation *dhcpm.DateTime, capable bool) {
+	c.Status, c.QuarantineCapable = &status, &capable
+	if probation != nil {
+		t := dateTime(probation)
+		c.ProbationEnds = &t
+	}
+}
+
+// AsV function returns the DHCP_CLIENT_INFO structure.
+func (c *ClientInfo) AsV() *dhcpm.ClientInfo {
+	return &dhcpm.ClientInfo{
+		ClientIPAddress:       ipv4(c.IPAddress),
+		SubnetMask:            ipv4(net.IP(c.SubnetMask)),
+		ClientHardwareAddress: c.uid(),
+		ClientName:            c.Name,
+		ClientComment:         c.Comment,
+		ClientLeaseExpires:    fromDateTime(c.LeaseExpires),
+		OwnerHost:             c.OwnerHost,
+	}
+}
+
+// AsV4 function returns the DHCP_CLIENT_INFO_V4 structure. The missing
+// optional fields are set to zero.
+func (c *ClientInfo) AsV4() *dhcpm.ClientInfoV4 {
+	v := c.AsV()
+	return &dhcpm.ClientInfoV4{
+		ClientIPAddress:       v.ClientIPAddress,
+		SubnetMask:            v.SubnetMask,
+		ClientHardwareAddress: v.ClientHardwareAddress,
+		ClientName:            v.ClientName,
+		ClientComment:         v.ClientComment,
+		ClientLeaseExpires:    v.ClientLeaseExpires,
+		OwnerHost:             v.OwnerHost,
+		ClientType:            value(c.ClientType),
+	}
+}
+
+// AsV5 function returns the DHCP_CLIENT_INFO_V5 structure. The missing
+// optional fields are set to zero.
+func (c *ClientInfo) AsV5() *dhcpm.ClientInfoV5 {
+	v := c.AsV4()
+	return &dhcpm.ClientInfoV5{
+		ClientIPAddress:       v.ClientIPAddress,
+		SubnetMask:            v.SubnetMask,
+		ClientHardwareAddress: v.ClientHardwareAddress,
+		ClientName:            v.ClientName,
+		ClientComment:         v.ClientComment,
+		ClientLeaseExpires:    v.ClientLeaseExpires,
+		OwnerHost:             v.OwnerHost,
+		ClientType:            v.ClientType,
+		AddressState:          value(c.AddressState),
+	}
+}
+
+// AsVQ function returns the DHCP_CLIENT_INFO_VQ structure. The missing
+// optional fields are set to zero.
+func (c *ClientInfo) AsVQ() *dhcpm.ClientInfoVQ {
+	v := c.AsV5()
+	return &dhcpm.ClientInfoVQ{
+		ClientIPAddress:       v.ClientIPAddress,
+		SubnetMask:            v.SubnetMask,
+		ClientHardwareAddress: v.ClientHardwareAddress,
+		ClientName:            v.ClientName,
+		ClientComment:         v.ClientComment,
+		ClientLeaseExpires:    v.ClientLeaseExpires,
+		OwnerHost:             v.OwnerHost,
+		ClientType:            v.ClientType,
+		AddressState:          v.AddressState,
+		Status:                value(c.Status),
+		ProbationEnds:         c.probationEnds(),
+		QuarantineCapable:     value(c.QuarantineCapable),
+	}
+}
+
+// AsPB function returns the DHCP_CLIENT_INFO_PB structure. The missing
+// optional fields are set to zero.
+func (c *ClientInfo) AsPB() *dhcpm.ClientInfoPB {
+	v := c.AsVQ()
+	return &dhcpm.ClientInfoPB{
+		ClientIPAddress:       v.ClientIPAddress,
+		SubnetMask:            v.SubnetMask,
+		ClientHardwareAddress: v.ClientHardwareAddress,
+		ClientName:            v.ClientName,
+		ClientComment:         v.ClientComment,
+		ClientLeaseExpires:    v.ClientLeaseExpires,
+		OwnerHost:             v.OwnerHost,
+		ClientType:            v.ClientType,
+		AddressState:          v.AddressState,
+		Status:                v.Status,
+		ProbationEnds:         v.ProbationEnds,
+		QuarantineCapable:     v.QuarantineCapable,
+		FilterStatus:          value(c.FilterStatus),
+		PolicyName:            value(c.PolicyName),
+	}
+}
+
+// AsEx function returns the DHCP_CLIENT_INFO_EX structure. The missing
+// optional fields are set to zero.
+func (c *ClientInfo) AsEx() *dhcpm.ClientInfoEx {
+	v := c.AsPB()
+	return &dhcpm.ClientInfoEx{
+		ClientIPAddress:       v.ClientIPAddress,
+		SubnetMask:            v.SubnetMask,
+		ClientHardwareAddress: v.ClientHardwareAddress,
+		ClientName:            v.ClientName,
+		ClientComment:         v.ClientComment,
+		ClientLeaseExpires:    v.ClientLeaseExpires,
+		OwnerHost:             v.OwnerHost,
+		ClientType:            v.ClientType,
+		AddressState:          v.AddressState,
+		Status:                v.Status,
+		ProbationEnds:         v.ProbationEnds,
+		QuarantineCapable:     v.QuarantineCapable,
+		FilterStatus:          v.FilterStatus,
+		PolicyName:            v.PolicyName,
+		Properties:            c.Properties,
+	}
+}
+
+func (c *ClientInfo) uid() *dhcpm.ClientUID {
+	return &dhcpm.ClientUID{Data: append([]byte{}, c.ClientUID...), DataLength: uint32(len(c.ClientUID))}
+}
+
+func (c *ClientInfo) probationEnds() *dhcpm.DateTime {
+	if c.ProbationEnds == nil {
+		return &dhcpm.DateTime{}
+	}
+	return fromDateTime(*c.ProbationEnds)
+}
+
+// ipv4 function returns the DHCP_IP_ADDRESS value for the IPv4 address.
+func ipv4(ip net.IP) uint32 {
+	if ip = ip.To4(); ip == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(ip)
+}
+
+// The number of 100-nanosecond intervals between January 1, 1601 and
+// January 1, 1970.
+const epochDelta = 116444736000000000
+
+// dateTime function returns the time for the DATE_TIME (FILETIME) value.
+func dateTime(dt *dhcpm.DateTime) time.Time {
+	if dt == nil || (dt.LowDateTime == 0 && dt.HighDateTime == 0) {
+		return time.Time{}
+	}
+	ft := int64(dt.HighDateTime)<<32 | int64(dt.LowDateTime)
+	ft -= epochDelta
+	return time.Unix(ft/1e7, (ft%1e7)*100).UTC()
+}
+
+// fromDateTime function returns the DATE_TIME (FILETIME) value for the time.
+func fromDateTime(t time.Time) *dhcpm.DateTime {
+	if t.IsZero() {
+		return &dhcpm.DateTime{}
+	}
+	ft := t.Unix()*1e7 + int64(t.Nanosecond())/100 + epochDelta
+	return &dhcpm.DateTime{LowDateTime: uint32(ft), HighDateTime: uint32(ft >> 32)}
+}
+
+func clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
+
+func value[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
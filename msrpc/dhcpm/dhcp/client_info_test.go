@@ -0,0 +1,76 @@
+package dhcp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+)
+
+func TestClientInfo(t *testing.T) {
+
+	expires := time.Date(2024, 5, 1, 10, 30, 0, 500, time.UTC)
+
+	pb := &dhcpm.ClientInfoPB{
+		ClientIPAddress:       0x0a000105,
+		SubnetMask:            0xffffff00,
+		ClientHardwareAddress: &dhcpm.ClientUID{Data: []byte{0x0a, 0x00, 0x01, 0x00, 0x01, 0x00, 0x11, 0x22, 0x33, 0x44, 0x55}, DataLength: 11},
+		ClientName:            "pc.corp.local",
+		ClientLeaseExpires:    fromDateTime(expires),
+		OwnerHost:             &dhcpm.HostInfo{IPAddress: 0x0a000101},
+		ClientType:            1,
+		AddressState:          2,
+		Status:                dhcpm.QuarantineStatusProbation,
+		ProbationEnds:         &dhcpm.DateTime{},
+		QuarantineCapable:     true,
+		FilterStatus:          3,
+		PolicyName:            "policy",
+	}
+
+	c, err := NewClientInfo(pb)
+	if err != nil {
+		t.Fatalf("new client info: %v", err)
+	}
+
+	if c.IPAddress.String() != "10.0.1.5" || c.HardwareAddress().String() != "00:11:22:33:44:55" ||
+		!c.LeaseExpires.Equal(expires) || *c.ClientType != 1 || *c.AddressState != 2 ||
+		*c.Status != dhcpm.QuarantineStatusProbation || !c.ProbationEnds.IsZero() ||
+		!*c.QuarantineCapable || *c.FilterStatus != 3 || *c.PolicyName != "policy" || c.Properties != nil {
+		t.Fatalf("unexpected client info: %+v", c)
+	}
+
+	// the client info is detached from the source.
+	pb.ClientType, pb.ClientHardwareAddress.Data[6] = 7, 0xff
+	if *c.ClientType != 1 || c.ClientUID[6] != 0x11 {
+		t.Fatalf("client info is not detached")
+	}
+	pb.ClientType, pb.ClientHardwareAddress.Data[6] = 1, 0x11
+
+	// round trip.
+	if v := c.AsPB(); !reflect.DeepEqual(v, pb) {
+		t.Fatalf("expected %+v, got %+v", pb, v)
+	}
+
+	// older generation has no optional fields.
+	v4, err := NewClientInfo(c.AsV4())
+	if err != nil {
+		t.Fatalf("new client info: %v", err)
+	}
+	if *v4.ClientType != 1 || v4.AddressState != nil || v4.Status != nil || v4.PolicyName != nil {
+		t.Fatalf("unexpected client info: %+v", v4)
+	}
+	if ex := v4.AsEx(); ex.AddressState != 0 || ex.PolicyName != "" || ex.ClientName != pb.ClientName {
+		t.Fatalf("unexpected client info: %+v", ex)
+	}
+
+	cs, err := NewClientInfos(&dhcpm.ClientInfoArrayV5{Clients: []*dhcpm.ClientInfoV5{c.AsV5(), nil}})
+	if err != nil || len(cs) != 1 || *cs[0].AddressState != 2 || cs[0].Status != nil {
+		t.Fatalf("unexpected client infos: %+v: %v", cs, err)
+	}
+
+	if _, err := NewClientInfo(&dhcpm.ClientInfoV6{}); !errors.Is(err, ErrUnsupportedClientInfo) {
+		t.Fatalf("expected unsupported client info, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+// The dhcp package implements the high-level DHCPv4 scope management helpers
+// on top of the dhcpsrv and dhcpsrv2 interfaces:
+//
+//	srv, err := dhcpsrv.NewDHCPServerClient(ctx, conn, dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	srv2, err := dhcpsrv2.NewDhcpsrv2Client(ctx, conn, dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	issues, err := dhcp.NewClient(srv, srv2).CheckReservations(ctx, scope)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	for _, issue := range issues {
+//		fmt.Println(issue)
+//	}
+package dhcp
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	dhcpsrv "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv/v1"
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+const (
+	errorMoreData    = 234
+	errorNoMoreItems = 259
+)
+
+// The default preferred maximum size of the page.
+const DefaultPreferredMaximum = 65536
+
+// Client is the DHCP server management client.
+type Client struct {
+	srv  dhcpsrv.DHCPServerClient
+	srv2 dhcpsrv2.Dhcpsrv2Client
+}
+
+// NewClient function returns the management client. The `srv2` client can
+// be nil, in this case only the dhcpsrv methods are used.
+func NewClient(srv dhcpsrv.DHCPServerClient, srv2 dhcpsrv2.Dhcpsrv2Client) *Client {
+	return &Client{srv: srv, srv2: srv2}
+}
+
+// elements function returns all subnet elements of the type `typ`.
+func (c *Client) elements(ctx context.Context, scope uint32, typ dhcpm.SubnetElementType) ([]*dhcpm.SubnetElementDataV4, error) {
+
+	var (
+		ret    []*dhcpm.SubnetElementDataV4
+		resume uint32
+	)
+
+	for {
+		resp, err := c.srv.EnumSubnetElementsV4(ctx, &dhcpsrv.EnumSubnetElementsV4Request{
+			SubnetAddress:    scope,
+			EnumElementType:  typ,
+			Resume:           resume,
+			PreferredMaximum: DefaultPreferredMaximum,
+		})
+		if resp == nil {
+			return nil, fmt.Errorf("dhcp: enum subnet elements: %w", err)
+		}
+
+		switch resp.Return {
+		case 0, errorMoreData, errorNoMoreItems:
+		default:
+			return nil, fmt.Errorf("dhcp: enum subnet elements: %w", err)
+		}
+
+		if resp.EnumElementInfo != nil {
+			ret = append(ret, resp.EnumElementInfo.Elements...)
+		}
+
+		if resp.Return != errorMoreData {
+			return ret, nil
+		}
+
+		resume = resp.Resume
+	}
+}
+
+// IPv4 function returns the IPv4 address for the DHCP_IP_ADDRESS value.
+func IPv4(v uint32) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, v)
+	return ip
+}
+
+// HardwareAddress function returns the hardware address from the client
+// unique identifier. The identifier returned by the server is prefixed with
+// the subnet address (4 bytes) and hardware type (1 byte) for the clients
+// within the scope `scope`.
+func HardwareAddress(uid []byte, scope uint32) net.HardwareAddr {
+	if len(uid) > 5 && uid[4] == 0x01 {
+		if binary.LittleEndian.Uint32(uid) == scope || binary.BigEndian.Uint32(uid) == scope {
+			uid = uid[5:]
+		}
+	}
+	return net.HardwareAddr(uid)
+}
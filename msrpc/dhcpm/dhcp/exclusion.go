@@ -0,0 +1,329 @@
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+// ErrInvalidRange is returned when the exclusion range change will be
+// rejected by the server with ERROR_DHCP_INVALID_RANGE.
+var ErrInvalidRange = errors.New("dhcp: invalid range")
+
+// ConflictKind is the exclusion range conflict kind.
+type ConflictKind int
+
+const (
+	// The range start address is greater than the end address.
+	InvalidRange ConflictKind = iota + 1
+	// The range is not within the scope IP range.
+	OutOfScope
+	// The range overlaps the existing exclusion range.
+	ExclusionOverlap
+	// The range to remove does not match the existing exclusion range.
+	ExclusionNotFound
+	// The reserved address is within the range.
+	ReservationExcluded
+	// The range overlaps the policy IP range.
+	PolicyRangeOverlap
+)
+
+func (k ConflictKind) String() string {
+	switch k {
+	case InvalidRange:
+		return "invalid_range"
+	case OutOfScope:
+		return "out_of_scope"
+	case ExclusionOverlap:
+		return "exclusion_overlap"
+	case ExclusionNotFound:
+		return "exclusion_not_found"
+	case ReservationExcluded:
+		return "reservation_excluded"
+	case PolicyRangeOverlap:
+		return "policy_range_overlap"
+	}
+	return "unknown"
+}
+
+// Conflict is the exclusion range conflict.
+type Conflict struct {
+	// The conflict kind.
+	Kind ConflictKind `json:"kind"`
+	// Fatal is `true` if the server rejects the change.
+	Fatal bool `json:"fatal"`
+	// The conflicting range (scope, exclusion or policy range).
+	Range *dhcpm.IPRange `json:"range,omitempty"`
+	// The conflicting reserved address.
+	Reservation uint32 `json:"reservation,omitempty"`
+	// The conflicting policy name.
+	Policy string `json:"policy,omitempty"`
+}
+
+func (c *Conflict) String() string {
+	switch {
+	case c.Policy != "":
+		return fmt.Sprintf("%s: policy %q %s", c.Kind, c.Policy, rangeString(c.Range))
+	case c.Reservation != 0:
+		return fmt.Sprintf("%s: %s", c.Kind, IPv4(c.Reservation))
+	case c.Range != nil:
+		return fmt.Sprintf("%s: %s", c.Kind, rangeString(c.Range))
+	}
+	return c.Kind.String()
+}
+
+// ExclusionPlan is the effect of the exclusion range change computed
+// locally before the change is applied.
+type ExclusionPlan struct {
+	// The exclusion range.
+	Range *dhcpm.IPRange `json:"range"`
+	// Remove is `true` if the range is removed.
+	Remove bool `json:"remove"`
+	// The number of addresses removed from (or returned to) the
+	// scope address pool.
+	Addresses uint32 `json:"addresses"`
+	// The conflicts.
+	Conflicts []*Conflict `json:"conflicts,omitempty"`
+}
+
+// Err function returns the error if the change will be rejected by the
+// server (the error wraps ErrInvalidRange).
+func (p *ExclusionPlan) Err() error {
+	for _, c := range p.Conflicts {
+		if c.Fatal {
+			return fmt.Errorf("%w: %s", ErrInvalidRange, c)
+		}
+	}
+	return nil
+}
+
+// Scope is the scope configuration snapshot used to validate the exclusion
+// range changes.
+type Scope struct {
+	// The scope address.
+	Address uint32 `json:"address"`
+	// The scope IP ranges.
+	Ranges []*dhcpm.IPRange `json:"ranges"`
+	// The exclusion ranges.
+	Exclusions []*dhcpm.IPRange `json:"exclusions"`
+	// The reserved addresses.
+	Reservations []uint32 `json:"reservations"`
+	// The scope policies.
+	Policies []*dhcpm.Policy `json:"policies"`
+}
+
+// AddExclusion function computes the effect of adding the exclusion range `r`.
+func (s *Scope) AddExclusion(r *dhcpm.IPRange) *ExclusionPlan {
+
+	p := &ExclusionPlan{Range: r}
+
+	if r.StartAddress > r.EndAddress {
+		p.Conflicts = append(p.Conflicts, &Conflict{Kind: InvalidRange, Fatal: true, Range: r})
+		return p
+	}
+
+	// the range must be within the single scope range.
+	var in bool
+	for _, sr := range s.Ranges {
+		if sr.StartAddress <= r.StartAddress && r.EndAddress <= sr.EndAddress {
+			in = true
+			break
+		}
+	}
+
+	if !in {
+		p.Conflicts = append(p.Conflicts, &Conflict{Kind: OutOfScope, Fatal: true, Range: r})
+	}
+
+	for _, e := range s.Exclusions {
+		if overlaps(e, r) {
+			p.Conflicts = append(p.Conflicts, &Conflict{Kind: ExclusionOverlap, Fatal: true, Range: e})
+		}
+	}
+
+	p.Conflicts = append(p.Conflicts, s.conflicts(r)...)
+
+	if p.Err() == nil {
+		p.Addresses = r.EndAddress - r.StartAddress + 1
+	}
+
+	return p
+}
+
+// RemoveExclusion function computes the effect of removing the exclusion
+// range `r`.
+func (s *Scope) RemoveExclusion(r *dhcpm.IPRange) *ExclusionPlan {
+
+	p := &ExclusionPlan{Range: r, Remove: true}
+
+	for _, e := range s.Exclusions {
+		if e.StartAddress == r.StartAddress && e.EndAddress == r.EndAddress {
+			// the reservations and policies are not affected by
+			// the removal.
+			p.Addresses = r.EndAddress - r.StartAddress + 1
+			return p
+		}
+	}
+
+	p.Conflicts = append(p.Conflicts, &Conflict{Kind: ExclusionNotFound, Fatal: true, Range: r})
+
+	return p
+}
+
+// conflicts function returns the non-fatal conflicts of the range `r` with
+// the reservations and policy ranges.
+func (s *Scope) conflicts(r *dhcpm.IPRange) []*Conflict {
+
+	var ret []*Conflict
+
+	for _, ip := range s.Reservations {
+		if r.StartAddress <= ip && ip <= r.EndAddress {
+			ret = append(ret, &Conflict{Kind: ReservationExcluded, Reservation: ip})
+		}
+	}
+
+	for _, policy := range s.Policies {
+		if policy == nil || policy.Ranges == nil {
+			continue
+		}
+		for _, pr := range policy.Ranges.Elements {
+			if pr != nil && overlaps(pr, r) {
+				ret = append(ret, &Conflict{Kind: PolicyRangeOverlap, Range: pr, Policy: policy.PolicyName})
+			}
+		}
+	}
+
+	return ret
+}
+
+// Scope function returns the scope configuration snapshot. The policies are
+// not returned if the dhcpsrv2 client is not set or the server does not
+// support the policies.
+func (c *Client) Scope(ctx context.Context, scope uint32) (*Scope, error) {
+
+	s := &Scope{Address: scope}
+
+	ranges, err := c.elements(ctx, scope, dhcpm.SubnetElementTypeIPRanges)
+	if err != nil {
+		return nil, fmt.Errorf("dhcp: scope: %w", err)
+	}
+
+	for _, e := range ranges {
+		if r, ok := e.Element.GetValue().(*dhcpm.IPRange); ok && r != nil {
+			s.Ranges = append(s.Ranges, r)
+		}
+	}
+
+	exclusions, err := c.elements(ctx, scope, dhcpm.SubnetElementTypeExcludedIPRanges)
+	if err != nil {
+		return nil, fmt.Errorf("dhcp: scope: %w", err)
+	}
+
+	for _, e := range exclusions {
+		if r, ok := e.Element.GetValue().(*dhcpm.IPRange); ok && r != nil {
+			s.Exclusions = append(s.Exclusions, r)
+		}
+	}
+
+	reservations, err := c.elements(ctx, scope, dhcpm.SubnetElementTypeReservedIPs)
+	if err != nil {
+		return nil, fmt.Errorf("dhcp: scope: %w", err)
+	}
+
+	for _, e := range reservations {
+		if v, ok := e.Element.GetValue().(*dhcpm.IPReservationV4); ok && v != nil {
+			s.Reservations = append(s.Reservations, v.ReservedIPAddress)
+		}
+	}
+
+	if s.Policies, err = c.policies(ctx, scope); err != nil {
+		return nil, fmt.Errorf("dhcp: scope: %w", err)
+	}
+
+	return s, nil
+}
+
+// PlanAddExclusion function fetches the scope configuration and computes the
+// effect of adding the exclusion range `r`.
+func (c *Client) PlanAddExclusion(ctx context.Context, scope uint32, r *dhcpm.IPRange) (*ExclusionPlan, error) {
+	s, err := c.Scope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return s.AddExclusion(r), nil
+}
+
+// PlanRemoveExclusion function fetches the scope configuration and computes
+// the effect of removing the exclusion range `r`.
+func (c *Client) PlanRemoveExclusion(ctx context.Context, scope uint32, r *dhcpm.IPRange) (*ExclusionPlan, error) {
+	s, err := c.Scope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return s.RemoveExclusion(r), nil
+}
+
+// policies function returns all scope policies.
+func (c *Client) policies(ctx context.Context, scope uint32) ([]*dhcpm.Policy, error) {
+
+	if c.srv2 == nil {
+		return nil, nil
+	}
+
+	var (
+		ret    []*dhcpm.Policy
+		resume uint32
+	)
+
+	for {
+		resp, err := c.srv2.EnumPoliciesV4(ctx, &dhcpsrv2.EnumPoliciesV4Request{
+			SubnetAddress:    scope,
+			Resume:           resume,
+			PreferredMaximum: DefaultPreferredMaximum,
+		})
+		if resp == nil {
+			if isNotSupported(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("enum policies: %w", err)
+		}
+
+		switch resp.Return {
+		case 0, errorMoreData, errorNoMoreItems:
+		default:
+			return nil, fmt.Errorf("enum policies: %w", err)
+		}
+
+		if resp.EnumInfo != nil {
+			ret = append(ret, resp.EnumInfo.Elements...)
+		}
+
+		if resp.Return != errorMoreData {
+			return ret, nil
+		}
+
+		resume = resp.Resume
+	}
+}
+
+// isNotSupported function returns `true` if the error indicates that
+// the method is not implemented by the server.
+func isNotSupported(err error) bool {
+	return errors.Is(err, rpcerrors.OperationRangeError) || errors.Is(err, rpcerrors.UnknownInterface)
+}
+
+// overlaps function returns `true` if the ranges `a` and `b` overlap.
+func overlaps(a, b *dhcpm.IPRange) bool {
+	return a.StartAddress <= b.EndAddress && b.StartAddress <= a.EndAddress
+}
+
+func rangeString(r *dhcpm.IPRange) string {
+	if r == nil {
+		return ""
+	}
+	return IPv4(r.StartAddress).String() + "-" + IPv4(r.EndAddress).String()
+}
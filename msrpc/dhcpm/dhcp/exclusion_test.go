@@ -0,0 +1,54 @@
+package dhcp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+)
+
+func TestExclusionPlan(t *testing.T) {
+
+	s := &Scope{
+		Ranges:       []*dhcpm.IPRange{{StartAddress: 0x0a000001, EndAddress: 0x0a0000fe}},
+		Exclusions:   []*dhcpm.IPRange{{StartAddress: 0x0a000064, EndAddress: 0x0a0000c8}},
+		Reservations: []uint32{0x0a00000a},
+		Policies: []*dhcpm.Policy{{
+			PolicyName: "printers",
+			Ranges:     &dhcpm.IPRangeArray{Elements: []*dhcpm.IPRange{{StartAddress: 0x0a000005, EndAddress: 0x0a000006}}},
+		}},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		plan      *ExclusionPlan
+		kinds     []ConflictKind
+		fatal     bool
+		addresses uint32
+	}{
+		{"add", s.AddExclusion(&dhcpm.IPRange{StartAddress: 0x0a0000d0, EndAddress: 0x0a0000d9}), nil, false, 10},
+		{"add warnings", s.AddExclusion(&dhcpm.IPRange{StartAddress: 0x0a000001, EndAddress: 0x0a000010}), []ConflictKind{ReservationExcluded, PolicyRangeOverlap}, false, 16},
+		{"add overlap", s.AddExclusion(&dhcpm.IPRange{StartAddress: 0x0a0000c8, EndAddress: 0x0a0000d0}), []ConflictKind{ExclusionOverlap}, true, 0},
+		{"add out of scope", s.AddExclusion(&dhcpm.IPRange{StartAddress: 0x0a0000f0, EndAddress: 0x0a0000ff}), []ConflictKind{OutOfScope}, true, 0},
+		{"add invalid", s.AddExclusion(&dhcpm.IPRange{StartAddress: 0x0a000002, EndAddress: 0x0a000001}), []ConflictKind{InvalidRange}, true, 0},
+		{"remove", s.RemoveExclusion(&dhcpm.IPRange{StartAddress: 0x0a000064, EndAddress: 0x0a0000c8}), nil, false, 101},
+		{"remove not found", s.RemoveExclusion(&dhcpm.IPRange{StartAddress: 0x0a000064, EndAddress: 0x0a000065}), []ConflictKind{ExclusionNotFound}, true, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(tc.plan.Conflicts) != len(tc.kinds) {
+				t.Fatalf("unexpected conflicts: %v", tc.plan.Conflicts)
+			}
+			for i, k := range tc.kinds {
+				if tc.plan.Conflicts[i].Kind != k {
+					t.Errorf("conflict %d: expected %s, got %s", i, k, tc.plan.Conflicts[i])
+				}
+			}
+			if err := tc.plan.Err(); errors.Is(err, ErrInvalidRange) != tc.fatal {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.plan.Addresses != tc.addresses {
+				t.Errorf("unexpected number of addresses: %d", tc.plan.Addresses)
+			}
+		})
+	}
+}
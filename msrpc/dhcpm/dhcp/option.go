@@ -0,0 +1,351 @@
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+// DHCP_FLAGS_OPTION_IS_VENDOR.
+const flagsOptionIsVendor = 0x00000003
+
+var (
+	// ErrNotSupported is returned when the method requires the dhcpsrv2
+	// client, but it was not provided.
+	ErrNotSupported = errors.New("dhcp: dhcpsrv2 client is required")
+	// ErrOptionConflict is returned when the option definition differs on
+	// the source and the destination servers and the conflict strategy is
+	// FailOnConflict.
+	ErrOptionConflict = errors.New("dhcp: option definition conflict")
+)
+
+// Class is the user and vendor class scope of the option definitions.
+// The zero value is the default (non-vendor, no user class) scope.
+type Class struct {
+	// The user class name.
+	User string `json:"user,omitempty"`
+	// The vendor class name.
+	Vendor string `json:"vendor,omitempty"`
+}
+
+func (c Class) String() string {
+	switch {
+	case c.User != "" && c.Vendor != "":
+		return c.Vendor + "/" + c.User
+	case c.Vendor != "":
+		return c.Vendor
+	case c.User != "":
+		return c.User
+	}
+	return "default"
+}
+
+// flags function returns the option flags for the class.
+func (c Class) flags() uint32 {
+	if c.Vendor != "" {
+		return flagsOptionIsVendor
+	}
+	return 0
+}
+
+// ConflictStrategy is the option definition conflict resolution strategy.
+type ConflictStrategy int
+
+const (
+	// The destination option definition is kept.
+	KeepDestination ConflictStrategy = iota
+	// The destination option definition is overwritten with the source
+	// option definition.
+	OverwriteDestination
+	// The synchronization fails without any changes applied.
+	FailOnConflict
+)
+
+// SyncAction is the option definition synchronization action.
+type SyncAction int
+
+const (
+	// The option definition is equal on both servers.
+	Unchanged SyncAction = iota
+	// The option definition is created on the destination server.
+	Created
+	// The option definition is updated on the destination server.
+	Updated
+	// The option definition differs, but is kept on the destination server.
+	Skipped
+)
+
+func (a SyncAction) String() string {
+	switch a {
+	case Unchanged:
+		return "unchanged"
+	case Created:
+		return "created"
+	case Updated:
+		return "updated"
+	case Skipped:
+		return "skipped"
+	}
+	return "unknown"
+}
+
+// OptionChange is the option definition synchronization result.
+type OptionChange struct {
+	// The class scope.
+	Class Class `json:"class"`
+	// The option identifier.
+	OptionID uint32 `json:"option_id"`
+	// The action.
+	Action SyncAction `json:"action"`
+	// The source option definition.
+	Source *dhcpm.Option `json:"source"`
+	// The destination option definition (nil if not present).
+	Destination *dhcpm.Option `json:"destination,omitempty"`
+}
+
+// OptionSync is the option definitions synchronization.
+type OptionSync struct {
+	// The conflict resolution strategy.
+	Strategy ConflictStrategy
+	// IPv6 is `true` if the DHCPv6 option definitions are synchronized.
+	IPv6 bool
+	// DryRun is `true` if the changes are computed but not applied.
+	DryRun bool
+}
+
+// SyncOptionDefinitions function copies the DHCPv4 option definitions of the
+// classes `classes` from the server `src` to the server `dst`, the option
+// definitions that differ are kept on the destination server. If no classes
+// are provided, the default scope and all vendor classes of the source server
+// are synchronized.
+func SyncOptionDefinitions(ctx context.Context, src, dst *Client, classes ...Class) ([]*OptionChange, error) {
+	return (&OptionSync{}).Sync(ctx, src, dst, classes...)
+}
+
+// Sync function copies the option definitions of the classes `classes` from
+// the server `src` to the server `dst`. If no classes are provided, the default
+// scope and all vendor classes of the source server are synchronized.
+func (s *OptionSync) Sync(ctx context.Context, src, dst *Client, classes ...Class) ([]*OptionChange, error) {
+
+	if src.srv2 == nil || dst.srv2 == nil {
+		return nil, ErrNotSupported
+	}
+
+	if len(classes) == 0 {
+		vendors, err := src.vendorClasses(ctx, s.IPv6)
+		if err != nil {
+			return nil, fmt.Errorf("dhcp: sync options: %w", err)
+		}
+		classes = append([]Class{{}}, vendors...)
+	}
+
+	var changes []*OptionChange
+
+	for _, class := range classes {
+
+		from, err := src.options(ctx, class, s.IPv6)
+		if err != nil {
+			return nil, fmt.Errorf("dhcp: sync options: %s: source: %w", class, err)
+		}
+
+		to, err := dst.options(ctx, class, s.IPv6)
+		if err != nil {
+			return nil, fmt.Errorf("dhcp: sync options: %s: destination: %w", class, err)
+		}
+
+		existing := make(map[uint32]*dhcpm.Option, len(to))
+		for _, o := range to {
+			existing[o.OptionID] = o
+		}
+
+		for _, o := range from {
+			change := &OptionChange{Class: class, OptionID: o.OptionID, Source: o, Destination: existing[o.OptionID]}
+			switch {
+			case change.Destination == nil:
+				change.Action = Created
+			case equalOption(o, change.Destination):
+				change.Action = Unchanged
+			case s.Strategy == OverwriteDestination:
+				change.Action = Updated
+			case s.Strategy == FailOnConflict:
+				return nil, fmt.Errorf("%w: %s: option %d", ErrOptionConflict, class, o.OptionID)
+			default:
+				change.Action = Skipped
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	if s.DryRun {
+		return changes, nil
+	}
+
+	for _, change := range changes {
+		if err := dst.applyOption(ctx, change, s.IPv6); err != nil {
+			return changes, fmt.Errorf("dhcp: sync options: %s: option %d: %w", change.Class, change.OptionID, err)
+		}
+	}
+
+	return changes, nil
+}
+
+// applyOption function applies the option definition change.
+func (c *Client) applyOption(ctx context.Context, change *OptionChange, ipv6 bool) error {
+
+	class, o := change.Class, change.Source
+
+	switch change.Action {
+	case Created:
+		if ipv6 {
+			_, err := c.srv2.CreateOptionV6(ctx, &dhcpsrv2.CreateOptionV6Request{
+				Flags: class.flags(), OptionID: o.OptionID, ClassName: class.User, VendorName: class.Vendor, OptionInfo: o,
+			})
+			return err
+		}
+		_, err := c.srv2.CreateOptionV5(ctx, &dhcpsrv2.CreateOptionV5Request{
+			Flags: class.flags(), OptionID: o.OptionID, ClassName: class.User, VendorName: class.Vendor, OptionInfo: o,
+		})
+		return err
+	case Updated:
+		if ipv6 {
+			_, err := c.srv2.SetOptionInfoV6(ctx, &dhcpsrv2.SetOptionInfoV6Request{
+				Flags: class.flags(), OptionID: o.OptionID, ClassName: class.User, VendorName: class.Vendor, OptionInfo: o,
+			})
+			return err
+		}
+		_, err := c.srv2.SetOptionInfoV5(ctx, &dhcpsrv2.SetOptionInfoV5Request{
+			Flags: class.flags(), OptionID: o.OptionID, ClassName: class.User, VendorName: class.Vendor, OptionInfo: o,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// options function returns all option definitions of the class.
+func (c *Client) options(ctx context.Context, class Class, ipv6 bool) ([]*dhcpm.Option, error) {
+
+	var (
+		ret    []*dhcpm.Option
+		resume uint32
+	)
+
+	for {
+
+		var (
+			ret0, next uint32
+			opts       *dhcpm.OptionArray
+			err        error
+		)
+
+		if ipv6 {
+			resp, err0 := c.srv2.EnumOptionsV6(ctx, &dhcpsrv2.EnumOptionsV6Request{
+				Flags: class.flags(), ClassName: class.User, VendorName: class.Vendor,
+				Resume: resume, PreferredMaximum: DefaultPreferredMaximum,
+			})
+			if resp == nil {
+				return nil, fmt.Errorf("enum options: %w", err0)
+			}
+			ret0, next, opts, err = resp.Return, resp.Resume, resp.Options, err0
+		} else {
+			resp, err0 := c.srv2.EnumOptionsV5(ctx, &dhcpsrv2.EnumOptionsV5Request{
+				Flags: class.flags(), ClassName: class.User, VendorName: class.Vendor,
+				Resume: resume, PreferredMaximum: DefaultPreferredMaximum,
+			})
+			if resp == nil {
+				return nil, fmt.Errorf("enum options: %w", err0)
+			}
+			ret0, next, opts, err = resp.Return, resp.Resume, resp.Options, err0
+		}
+
+		switch ret0 {
+		case 0, errorMoreData, errorNoMoreItems:
+		default:
+			return nil, fmt.Errorf("enum options: %w", err)
+		}
+
+		if opts != nil {
+			ret = append(ret, opts.Options...)
+		}
+
+		if ret0 != errorMoreData {
+			return ret, nil
+		}
+
+		resume = next
+	}
+}
+
+// vendorClasses function returns all vendor classes.
+func (c *Client) vendorClasses(ctx context.Context, ipv6 bool) ([]Class, error) {
+
+	var (
+		ret    []Class
+		resume uint32
+	)
+
+	for {
+
+		var (
+			ret0, next uint32
+			err        error
+		)
+
+		if ipv6 {
+			resp, err0 := c.srv2.EnumClassesV6(ctx, &dhcpsrv2.EnumClassesV6Request{
+				Resume: resume, PreferredMaximum: DefaultPreferredMaximum,
+			})
+			if resp == nil {
+				return nil, fmt.Errorf("enum classes: %w", err0)
+			}
+			if resp.ClassInfoArray != nil {
+				for _, cls := range resp.ClassInfoArray.Classes {
+					if cls != nil && cls.IsVendor {
+						ret = append(ret, Class{Vendor: cls.ClassName})
+					}
+				}
+			}
+			ret0, next, err = resp.Return, resp.Resume, err0
+		} else {
+			resp, err0 := c.srv2.EnumClasses(ctx, &dhcpsrv2.EnumClassesRequest{
+				Resume: resume, PreferredMaximum: DefaultPreferredMaximum,
+			})
+			if resp == nil {
+				return nil, fmt.Errorf("enum classes: %w", err0)
+			}
+			if resp.ClassInfoArray != nil {
+				for _, cls := range resp.ClassInfoArray.Classes {
+					if cls != nil && cls.IsVendor {
+						ret = append(ret, Class{Vendor: cls.ClassName})
+					}
+				}
+			}
+			ret0, next, err = resp.Return, resp.Resume, err0
+		}
+
+		switch ret0 {
+		case 0, errorMoreData, errorNoMoreItems:
+		default:
+			return nil, fmt.Errorf("enum classes: %w", err)
+		}
+
+		if ret0 != errorMoreData {
+			return ret, nil
+		}
+
+		resume = next
+	}
+}
+
+// equalOption function returns `true` if the option definitions are equal.
+func equalOption(a, b *dhcpm.Option) bool {
+	return a.OptionID == b.OptionID &&
+		a.OptionName == b.OptionName &&
+		a.OptionComment == b.OptionComment &&
+		a.OptionType == b.OptionType &&
+		reflect.DeepEqual(a.DefaultValue, b.DefaultValue)
+}
@@ -0,0 +1,117 @@
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+// optionServer is the in-memory option definitions store.
+type optionServer struct {
+	dhcpsrv2.Dhcpsrv2Client
+	// option definitions per vendor class.
+	options map[string][]*dhcpm.Option
+	vendors []string
+	created int
+	updated int
+}
+
+func (s *optionServer) EnumClasses(ctx context.Context, in *dhcpsrv2.EnumClassesRequest, opts ...dcerpc.CallOption) (*dhcpsrv2.EnumClassesResponse, error) {
+	resp := &dhcpsrv2.EnumClassesResponse{ClassInfoArray: &dhcpm.ClassInfoArray{}}
+	for _, v := range s.vendors {
+		resp.ClassInfoArray.Classes = append(resp.ClassInfoArray.Classes, &dhcpm.ClassInfo{ClassName: v, IsVendor: true})
+	}
+	return resp, nil
+}
+
+func (s *optionServer) EnumOptionsV5(ctx context.Context, in *dhcpsrv2.EnumOptionsV5Request, opts ...dcerpc.CallOption) (*dhcpsrv2.EnumOptionsV5Response, error) {
+	return &dhcpsrv2.EnumOptionsV5Response{Options: &dhcpm.OptionArray{Options: s.options[in.VendorName]}}, nil
+}
+
+func (s *optionServer) CreateOptionV5(ctx context.Context, in *dhcpsrv2.CreateOptionV5Request, opts ...dcerpc.CallOption) (*dhcpsrv2.CreateOptionV5Response, error) {
+	if (in.VendorName != "") != (in.Flags == flagsOptionIsVendor) {
+		return nil, errors.New("invalid flags")
+	}
+	s.options[in.VendorName] = append(s.options[in.VendorName], in.OptionInfo)
+	s.created++
+	return &dhcpsrv2.CreateOptionV5Response{}, nil
+}
+
+func (s *optionServer) SetOptionInfoV5(ctx context.Context, in *dhcpsrv2.SetOptionInfoV5Request, opts ...dcerpc.CallOption) (*dhcpsrv2.SetOptionInfoV5Response, error) {
+	for i, o := range s.options[in.VendorName] {
+		if o.OptionID == in.OptionID {
+			s.options[in.VendorName][i] = in.OptionInfo
+		}
+	}
+	s.updated++
+	return &dhcpsrv2.SetOptionInfoV5Response{}, nil
+}
+
+func TestSyncOptionDefinitions(t *testing.T) {
+
+	ctx := context.Background()
+
+	newSource := func() *optionServer {
+		return &optionServer{
+			options: map[string][]*dhcpm.Option{
+				"":       {{OptionID: 252, OptionName: "WPAD"}, {OptionID: 15, OptionName: "DNS Domain Name"}},
+				"Vendor": {{OptionID: 1, OptionName: "Vendor Option"}},
+			},
+			vendors: []string{"Vendor"},
+		}
+	}
+
+	newDestination := func() *optionServer {
+		return &optionServer{
+			options: map[string][]*dhcpm.Option{
+				"": {{OptionID: 252, OptionName: "Proxy"}, {OptionID: 15, OptionName: "DNS Domain Name"}},
+			},
+		}
+	}
+
+	src, dst := newSource(), newDestination()
+
+	changes, err := SyncOptionDefinitions(ctx, NewClient(nil, src), NewClient(nil, dst))
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	actions := map[SyncAction]int{}
+	for _, c := range changes {
+		actions[c.Action]++
+	}
+
+	if actions[Skipped] != 1 || actions[Unchanged] != 1 || actions[Created] != 1 {
+		t.Fatalf("unexpected changes: %v", actions)
+	}
+
+	if dst.created != 1 || dst.updated != 0 || len(dst.options["Vendor"]) != 1 {
+		t.Fatalf("unexpected destination state: %d created, %d updated", dst.created, dst.updated)
+	}
+
+	// overwrite the conflicting definitions.
+	src, dst = newSource(), newDestination()
+
+	if _, err := (&OptionSync{Strategy: OverwriteDestination}).Sync(ctx, NewClient(nil, src), NewClient(nil, dst)); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	if dst.updated != 1 || dst.options[""][0].OptionName != "WPAD" {
+		t.Fatalf("expected overwritten option definition")
+	}
+
+	// fail without changes.
+	src, dst = newSource(), newDestination()
+
+	if _, err := (&OptionSync{Strategy: FailOnConflict}).Sync(ctx, NewClient(nil, src), NewClient(nil, dst)); !errors.Is(err, ErrOptionConflict) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+
+	if dst.created != 0 || dst.updated != 0 {
+		t.Fatalf("unexpected changes on conflict")
+	}
+}
@@ -0,0 +1,587 @@
+package dhcp
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+)
+
+// ErrInvalidExpr is returned when the policy expression cannot be parsed or
+// the policy conditions and expressions arrays are malformed.
+var ErrInvalidExpr = errors.New("dhcp: invalid policy expression")
+
+// The well-known options used in the policy conditions.
+const (
+	optionVendorClass = 60
+	optionClientID    = 61
+	optionUserClass   = 77
+	optionRelayAgent  = 82
+)
+
+// Expr is the policy expression: the condition or the logical expression.
+//
+// The string syntax is the conditions combined with AND and OR operators
+// (AND takes precedence) and parentheses:
+//
+//	vendorClass BEGINS_WITH 'MSFT' AND (mac EQ 00:11:* OR fqdn ENDS_WITH '.corp.local')
+//
+// The condition is the attribute, comparison operator and value. The attributes
+// are mac (hwaddr), vendorClass, userClass, clientId, relayAgent, fqdn,
+// fqdnSingleLabel, option<id> and option<id>.<suboption id>. The operators
+// are EQ (==), NE (!=), BEGINS_WITH, NOT_BEGINS_WITH, ENDS_WITH, NOT_ENDS_WITH.
+// The value is the quoted string ('MSFT' or "MSFT") or the hex bytes (00:11:22,
+// 00-11-22 or 0x001122); the hex value for EQ (NE) operator may start or end with
+// the wildcard (00:11:*), which selects the (NOT_)ENDS_WITH or (NOT_)BEGINS_WITH
+// operator.
+type Expr interface {
+	String() string
+	isExpr()
+}
+
+// Condition is the policy condition.
+type Condition struct {
+	// The attribute type.
+	Type dhcpm.PolicyAttributeType `json:"type"`
+	// The option identifier (for option and suboption attributes).
+	OptionID uint32 `json:"option_id,omitempty"`
+	// The suboption identifier (for suboption attributes).
+	SubOptionID uint32 `json:"sub_option_id,omitempty"`
+	// The comparison operator.
+	Operator dhcpm.PolicyComparator `json:"operator"`
+	// The value to compare with.
+	Value []byte `json:"value"`
+}
+
+func (*Condition) isExpr() {}
+
+func (c *Condition) String() string {
+	return c.attribute() + " " + comparatorString(c.Operator) + " " + c.value()
+}
+
+// attribute function returns the attribute name.
+func (c *Condition) attribute() string {
+	switch c.Type {
+	case dhcpm.PolicyAttributeTypeHwAddr:
+		return "mac"
+	case dhcpm.PolicyAttributeTypeFQDN:
+		return "fqdn"
+	case dhcpm.PolicyAttributeTypeFQDNSingleLabel:
+		return "fqdnSingleLabel"
+	case dhcpm.PolicyAttributeTypeSubOption:
+		return "option" + strconv.FormatUint(uint64(c.OptionID), 10) + "." + strconv.FormatUint(uint64(c.SubOptionID), 10)
+	}
+	for name, id := range optionAttributes {
+		if id == c.OptionID {
+			return name
+		}
+	}
+	return "option" + strconv.FormatUint(uint64(c.OptionID), 10)
+}
+
+// value function returns the quoted string value if it is printable, or
+// the hex value.
+func (c *Condition) value() string {
+	if c.Type != dhcpm.PolicyAttributeTypeHwAddr && c.Type != dhcpm.PolicyAttributeTypeSubOption && c.OptionID != optionClientID && printable(c.Value) {
+		return quote(string(c.Value))
+	}
+	if len(c.Value) == 0 {
+		return "''"
+	}
+	b := make([]string, len(c.Value))
+	for i := range c.Value {
+		b[i] = hex.EncodeToString(c.Value[i : i+1])
+	}
+	return strings.Join(b, ":")
+}
+
+// Logical is the logical expression.
+type Logical struct {
+	// The logical operator.
+	Operator dhcpm.PolicyLogicOperator `json:"operator"`
+	// The operands.
+	Operands []Expr `json:"operands"`
+}
+
+func (*Logical) isExpr() {}
+
+func (l *Logical) String() string {
+	ops := make([]string, len(l.Operands))
+	for i, op := range l.Operands {
+		if _, ok := op.(*Logical); ok && len(l.Operands) > 1 {
+			ops[i] = "(" + op.String() + ")"
+		} else {
+			ops[i] = op.String()
+		}
+	}
+	if l.Operator == dhcpm.PolicyLogicOperatorLogicalAnd {
+		return strings.Join(ops, " AND ")
+	}
+	return strings.Join(ops, " OR ")
+}
+
+// optionAttributes is the option attribute names.
+var optionAttributes = map[string]uint32{
+	"vendorClass": optionVendorClass,
+	"clientId":    optionClientID,
+	"userClass":   optionUserClass,
+	"relayAgent":  optionRelayAgent,
+}
+
+// comparators is the comparison operator names, the first one is canonical.
+var comparators = []struct {
+	names []string
+	op    dhcpm.PolicyComparator
+}{
+	{[]string{"EQ", "EQUAL", "=="}, dhcpm.PolicyComparatorEqual},
+	{[]string{"NE", "NOT_EQUAL", "!="}, dhcpm.PolicyComparatorNotEqual},
+	{[]string{"BEGINS_WITH"}, dhcpm.PolicyComparatorBeginsWith},
+	{[]string{"NOT_BEGINS_WITH", "NOT_BEGIN_WITH"}, dhcpm.PolicyComparatorNotBeginWith},
+	{[]string{"ENDS_WITH"}, dhcpm.PolicyComparatorEndsWith},
+	{[]string{"NOT_ENDS_WITH", "NOT_END_WITH"}, dhcpm.PolicyComparatorNotEndWith},
+}
+
+func comparatorString(op dhcpm.PolicyComparator) string {
+	for _, c := range comparators {
+		if c.op == op {
+			return c.names[0]
+		}
+	}
+	return "comparator(" + strconv.Itoa(int(op)) + ")"
+}
+
+// ParseExpr function parses the policy expression string (see Expr).
+func ParseExpr(s string) (Expr, error) {
+
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{toks: toks}
+
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.toks) {
+		return nil, p.errorf("unexpected %q", p.toks[p.pos].s)
+	}
+
+	return e, nil
+}
+
+// token is the expression token.
+type token struct {
+	// The token text (unquoted for the string literals).
+	s string
+	// The token is the quoted string literal.
+	quoted bool
+	// The token offset.
+	off int
+}
+
+// tokenize function splits the expression into the parentheses, quoted
+// strings and bare words.
+func tokenize(s string) ([]token, error) {
+
+	var toks []token
+
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '(' || c == ')':
+			toks = append(toks, token{s: s[i : i+1], off: i})
+			i++
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(s) && s[j] != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				b.WriteByte(s[j])
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("%w: offset %d: unterminated string", ErrInvalidExpr, i)
+			}
+			toks = append(toks, token{s: b.String(), quoted: true, off: i})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(s) && !unicode.IsSpace(rune(s[j])) && !strings.ContainsRune("()'\"", rune(s[j])); j++ {
+			}
+			toks = append(toks, token{s: s[i:j], off: i})
+			i = j
+		}
+	}
+
+	return toks, nil
+}
+
+// parser is the recursive descent expression parser.
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	off := -1
+	if p.pos < len(p.toks) {
+		off = p.toks[p.pos].off
+	}
+	if off < 0 {
+		return fmt.Errorf("%w: end of expression: %s", ErrInvalidExpr, fmt.Sprintf(format, args...))
+	}
+	return fmt.Errorf("%w: offset %d: %s", ErrInvalidExpr, off, fmt.Sprintf(format, args...))
+}
+
+// keyword function consumes the bare keyword `kw` (case-insensitive).
+func (p *parser) keyword(kw string) bool {
+	if p.pos < len(p.toks) && !p.toks[p.pos].quoted && strings.EqualFold(p.toks[p.pos].s, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// or function parses the OR expression.
+func (p *parser) or() (Expr, error) {
+	return p.logical(dhcpm.PolicyLogicOperatorLogicalOr, "OR", p.and)
+}
+
+// and function parses the AND expression.
+func (p *parser) and() (Expr, error) {
+	return p.logical(dhcpm.PolicyLogicOperatorLogicalAnd, "AND", p.primary)
+}
+
+// logical function parses the operands separated by the keyword `kw`, the
+// nested expressions with the same operator are merged.
+func (p *parser) logical(op dhcpm.PolicyLogicOperator, kw string, next func() (Expr, error)) (Expr, error) {
+
+	l := &Logical{Operator: op}
+
+	for {
+		e, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if sub, ok := e.(*Logical); ok && sub.Operator == op {
+			l.Operands = append(l.Operands, sub.Operands...)
+		} else {
+			l.Operands = append(l.Operands, e)
+		}
+		if !p.keyword(kw) {
+			break
+		}
+	}
+
+	if len(l.Operands) == 1 {
+		return l.Operands[0], nil
+	}
+
+	return l, nil
+}
+
+// primary function parses the parenthesized expression or the condition.
+func (p *parser) primary() (Expr, error) {
+
+	if p.keyword("(") {
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.keyword(")") {
+			return nil, p.errorf("expected ')'")
+		}
+		return e, nil
+	}
+
+	return p.condition()
+}
+
+// condition function parses the condition.
+func (p *parser) condition() (Expr, error) {
+
+	if p.pos+3 > len(p.toks) {
+		return nil, p.errorf("expected condition")
+	}
+
+	c := &Condition{}
+
+	attr, op, val := p.toks[p.pos], p.toks[p.pos+1], p.toks[p.pos+2]
+
+	if err := c.parseAttribute(attr); err != nil {
+		return nil, p.errorf("%v", err)
+	}
+
+	p.pos++
+
+	found := false
+	for _, cmp := range comparators {
+		for _, name := range cmp.names {
+			if !op.quoted && strings.EqualFold(op.s, name) {
+				c.Operator, found = cmp.op, true
+			}
+		}
+	}
+
+	if !found {
+		return nil, p.errorf("unknown operator %q", op.s)
+	}
+
+	p.pos++
+
+	if err := c.parseValue(val); err != nil {
+		return nil, p.errorf("%v", err)
+	}
+
+	p.pos++
+
+	return c, nil
+}
+
+// parseAttribute function parses the attribute name.
+func (c *Condition) parseAttribute(tok token) error {
+
+	if tok.quoted {
+		return fmt.Errorf("expected attribute, got %q", tok.s)
+	}
+
+	switch name := strings.ToLower(tok.s); name {
+	case "mac", "hwaddr":
+		c.Type = dhcpm.PolicyAttributeTypeHwAddr
+		return nil
+	case "fqdn":
+		c.Type = dhcpm.PolicyAttributeTypeFQDN
+		return nil
+	case "fqdnsinglelabel":
+		c.Type = dhcpm.PolicyAttributeTypeFQDNSingleLabel
+		return nil
+	}
+
+	for name, id := range optionAttributes {
+		if strings.EqualFold(tok.s, name) {
+			c.Type, c.OptionID = dhcpm.PolicyAttributeTypeOption, id
+			return nil
+		}
+	}
+
+	id, ok := strings.CutPrefix(strings.ToLower(tok.s), "option")
+	if !ok {
+		return fmt.Errorf("unknown attribute %q", tok.s)
+	}
+
+	id, sub, isSub := strings.Cut(id, ".")
+
+	optID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid option %q", tok.s)
+	}
+
+	c.Type, c.OptionID = dhcpm.PolicyAttributeTypeOption, uint32(optID)
+
+	if isSub {
+		subID, err := strconv.ParseUint(sub, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid suboption %q", tok.s)
+		}
+		c.Type, c.SubOptionID = dhcpm.PolicyAttributeTypeSubOption, uint32(subID)
+	}
+
+	return nil
+}
+
+// parseValue function parses the quoted string or the hex value with the
+// optional wildcard.
+func (c *Condition) parseValue(tok token) error {
+
+	if tok.quoted {
+		c.Value = []byte(tok.s)
+		return nil
+	}
+
+	s := tok.s
+
+	prefix, suffix := false, false
+	if strings.HasSuffix(s, "*") {
+		s, prefix = strings.TrimRight(strings.TrimSuffix(s, "*"), ":-"), true
+	} else if strings.HasPrefix(s, "*") {
+		s, suffix = strings.TrimLeft(strings.TrimPrefix(s, "*"), ":-"), true
+	}
+
+	switch {
+	case !prefix && !suffix:
+	case prefix && c.Operator == dhcpm.PolicyComparatorEqual:
+		c.Operator = dhcpm.PolicyComparatorBeginsWith
+	case prefix && c.Operator == dhcpm.PolicyComparatorNotEqual:
+		c.Operator = dhcpm.PolicyComparatorNotBeginWith
+	case suffix && c.Operator == dhcpm.PolicyComparatorEqual:
+		c.Operator = dhcpm.PolicyComparatorEndsWith
+	case suffix && c.Operator == dhcpm.PolicyComparatorNotEqual:
+		c.Operator = dhcpm.PolicyComparatorNotEndWith
+	default:
+		return fmt.Errorf("wildcard is not allowed with %s operator", comparatorString(c.Operator))
+	}
+
+	if h, ok := strings.CutPrefix(strings.ToLower(s), "0x"); ok {
+		s = h
+	} else {
+		s = strings.NewReplacer(":", "", "-", "").Replace(s)
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid value %q", tok.s)
+	}
+
+	c.Value = b
+
+	return nil
+}
+
+// Flatten function returns the policy conditions and expressions arrays for
+// the expression. The root expression is the first element of the expressions
+// array, the condition expression is wrapped into the OR expression.
+func Flatten(e Expr) (*dhcpm.PolicyConditionArray, *dhcpm.PolicyExprArray) {
+
+	conds, exprs := &dhcpm.PolicyConditionArray{}, &dhcpm.PolicyExprArray{}
+
+	root, ok := e.(*Logical)
+	if !ok {
+		root = &Logical{Operator: dhcpm.PolicyLogicOperatorLogicalOr}
+		if e != nil {
+			root.Operands = []Expr{e}
+		}
+	}
+
+	var flatten func(l *Logical, parent uint32)
+	flatten = func(l *Logical, parent uint32) {
+		idx := uint32(len(exprs.Elements))
+		exprs.Elements = append(exprs.Elements, &dhcpm.PolicyExpr{ParentExpr: parent, Operator: l.Operator})
+		for _, op := range l.Operands {
+			switch op := op.(type) {
+			case *Condition:
+				conds.Elements = append(conds.Elements, &dhcpm.PolicyCondition{
+					ParentExpr:  idx,
+					Type:        op.Type,
+					OptionID:    op.OptionID,
+					SubOptionID: op.SubOptionID,
+					Operator:    op.Operator,
+					Value:       op.Value,
+					ValueLength: uint32(len(op.Value)),
+				})
+			case *Logical:
+				flatten(op, idx)
+			}
+		}
+	}
+
+	flatten(root, 0)
+
+	conds.ElementsLength, exprs.ElementsLength = uint32(len(conds.Elements)), uint32(len(exprs.Elements))
+
+	return conds, exprs
+}
+
+// NewExpr function returns the expression for the policy conditions and
+// expressions arrays. The conditions of the expression precede the nested
+// expressions.
+func NewExpr(conds *dhcpm.PolicyConditionArray, exprs *dhcpm.PolicyExprArray) (Expr, error) {
+
+	if exprs == nil || len(exprs.Elements) == 0 {
+		if conds != nil && len(conds.Elements) > 0 {
+			return nil, fmt.Errorf("%w: conditions without expression", ErrInvalidExpr)
+		}
+		return nil, nil
+	}
+
+	nodes := make([]*Logical, len(exprs.Elements))
+	for i, e := range exprs.Elements {
+		if e == nil {
+			return nil, fmt.Errorf("%w: expression %d is nil", ErrInvalidExpr, i)
+		}
+		nodes[i] = &Logical{Operator: e.Operator}
+	}
+
+	if conds != nil {
+		for i, c := range conds.Elements {
+			if c == nil {
+				continue
+			}
+			if int(c.ParentExpr) >= len(nodes) {
+				return nil, fmt.Errorf("%w: condition %d: parent expression %d is out of range", ErrInvalidExpr, i, c.ParentExpr)
+			}
+			nodes[c.ParentExpr].Operands = append(nodes[c.ParentExpr].Operands, &Condition{
+				Type:        c.Type,
+				OptionID:    c.OptionID,
+				SubOptionID: c.SubOptionID,
+				Operator:    c.Operator,
+				Value:       c.Value,
+			})
+		}
+	}
+
+	for i, e := range exprs.Elements[1:] {
+		// the parent must precede the expression, so that the
+		// expressions form the tree.
+		if int(e.ParentExpr) > i {
+			return nil, fmt.Errorf("%w: expression %d: invalid parent expression %d", ErrInvalidExpr, i+1, e.ParentExpr)
+		}
+		nodes[e.ParentExpr].Operands = append(nodes[e.ParentExpr].Operands, nodes[i+1])
+	}
+
+	if len(nodes[0].Operands) == 1 {
+		if c, ok := nodes[0].Operands[0].(*Condition); ok {
+			return c, nil
+		}
+	}
+
+	return nodes[0], nil
+}
+
+// PolicyExpr function returns the expression of the policy.
+func PolicyExpr(p *dhcpm.Policy) (Expr, error) {
+	return NewExpr(p.Conditions, p.Expressions)
+}
+
+// SetPolicyExpr function sets the policy conditions and expressions to the
+// expression `e`.
+func SetPolicyExpr(p *dhcpm.Policy, e Expr) {
+	p.Conditions, p.Expressions = Flatten(e)
+}
+
+// PolicyString function returns the string representation of the policy
+// expression.
+func PolicyString(p *dhcpm.Policy) string {
+	e, err := PolicyExpr(p)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	if e == nil {
+		return ""
+	}
+	return e.String()
+}
+
+// printable function returns `true` if the value is the printable ASCII
+// string.
+func printable(b []byte) bool {
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return len(b) > 0
+}
+
+// quote function returns the single-quoted string.
+func quote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
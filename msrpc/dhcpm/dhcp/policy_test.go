@@ -0,0 +1,101 @@
+package dhcp
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+)
+
+func TestParseExpr(t *testing.T) {
+
+	for _, tc := range []struct {
+		expr string
+		str  string
+	}{
+		{"vendorClass BEGINS_WITH 'MSFT' AND mac EQ 00:11:*", "vendorClass BEGINS_WITH 'MSFT' AND mac BEGINS_WITH 00:11"},
+		{"mac == 00-11-22 or fqdn ends_with \".corp.local\"", "mac EQ 00:11:22 OR fqdn ENDS_WITH '.corp.local'"},
+		{"option82.1 NE *0xff AND (userClass EQ 'a' OR userClass EQ 'b')", "option82.1 NOT_ENDS_WITH ff AND (userClass EQ 'a' OR userClass EQ 'b')"},
+		{"a1 == 'x' OR (option12 EQ 'it\\'s' AND clientId EQ 01:02 AND fqdnSingleLabel NOT_BEGINS_WITH 'pc')", ""},
+		{"option12 EQ 'x' OR (option12 EQ 'it\\'s' AND (clientId EQ 01:02 AND fqdnSingleLabel NOT_BEGINS_WITH 'pc'))", "option12 EQ 'x' OR (option12 EQ 'it\\'s' AND clientId EQ 01:02 AND fqdnSingleLabel NOT_BEGINS_WITH 'pc')"},
+		{"mac BEGINS_WITH 00:11:*", ""},
+		{"mac EQ 00:11", "mac EQ 00:11"},
+		{"mac EQ", ""},
+		{"mac EQ 0g", ""},
+		{"(mac EQ 00", ""},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+
+			e, err := ParseExpr(tc.expr)
+			if tc.str == "" {
+				if !errors.Is(err, ErrInvalidExpr) {
+					t.Fatalf("expected invalid expression, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+
+			if s := e.String(); s != tc.str {
+				t.Fatalf("expected %q, got %q", tc.str, s)
+			}
+
+			// the canonical string and the flattened arrays must
+			// produce the same expression.
+			e2, err := ParseExpr(e.String())
+			if err != nil || e2.String() != tc.str {
+				t.Fatalf("reparse: %v: %v", e2, err)
+			}
+
+			p := &dhcpm.Policy{}
+			SetPolicyExpr(p, e)
+
+			if s := PolicyString(p); s != tc.str {
+				t.Fatalf("expected policy %q, got %q", tc.str, s)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+
+	e, err := ParseExpr("vendorClass BEGINS_WITH 'MSFT' AND (mac EQ 00:11:* OR fqdn EQ 'pc.corp.local')")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	conds, exprs := Flatten(e)
+
+	if exprs.ElementsLength != 2 || exprs.Elements[0].Operator != dhcpm.PolicyLogicOperatorLogicalAnd ||
+		exprs.Elements[1].ParentExpr != 0 || exprs.Elements[1].Operator != dhcpm.PolicyLogicOperatorLogicalOr {
+		t.Fatalf("unexpected expressions: %+v", exprs.Elements)
+	}
+
+	if conds.ElementsLength != 3 {
+		t.Fatalf("unexpected conditions: %+v", conds.Elements)
+	}
+
+	c := conds.Elements[0]
+	if c.ParentExpr != 0 || c.Type != dhcpm.PolicyAttributeTypeOption || c.OptionID != 60 ||
+		c.Operator != dhcpm.PolicyComparatorBeginsWith || string(c.Value) != "MSFT" || c.ValueLength != 4 {
+		t.Fatalf("unexpected condition: %+v", c)
+	}
+
+	c = conds.Elements[1]
+	if c.ParentExpr != 1 || c.Type != dhcpm.PolicyAttributeTypeHwAddr ||
+		c.Operator != dhcpm.PolicyComparatorBeginsWith || !bytes.Equal(c.Value, []byte{0x00, 0x11}) {
+		t.Fatalf("unexpected condition: %+v", c)
+	}
+
+	if _, err := NewExpr(conds, &dhcpm.PolicyExprArray{Elements: []*dhcpm.PolicyExpr{{}, {ParentExpr: 1}}}); !errors.Is(err, ErrInvalidExpr) {
+		t.Fatalf("expected invalid expression, got %v", err)
+	}
+
+	// single condition is wrapped into the root expression.
+	conds, exprs = Flatten(&Condition{Type: dhcpm.PolicyAttributeTypeFQDN, Value: []byte("pc")})
+	if exprs.ElementsLength != 1 || conds.ElementsLength != 1 || conds.Elements[0].ParentExpr != 0 {
+		t.Fatalf("unexpected arrays: %+v, %+v", conds.Elements, exprs.Elements)
+	}
+}
@@ -0,0 +1,168 @@
+package dhcp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm/lease"
+)
+
+// IssueKind is the reservation consistency issue kind.
+type IssueKind int
+
+const (
+	// The reserved address is leased to the client with the different
+	// hardware address.
+	MACMismatch IssueKind = iota + 1
+	// The reserved address is not leased, and the reserved client holds
+	// the lease for the different address (or no lease at all).
+	StaleReservation
+	// The leased address is within the exclusion range.
+	ExcludedLease
+)
+
+func (k IssueKind) String() string {
+	switch k {
+	case MACMismatch:
+		return "mac_mismatch"
+	case StaleReservation:
+		return "stale_reservation"
+	case ExcludedLease:
+		return "excluded_lease"
+	}
+	return "unknown"
+}
+
+// Issue is the reservation consistency issue.
+type Issue struct {
+	// The issue kind.
+	Kind IssueKind `json:"kind"`
+	// The IP address the issue is reported for.
+	IPAddress net.IP `json:"ip_address"`
+	// The hardware address of the reservation (if any).
+	ReservedMAC net.HardwareAddr `json:"reserved_mac,omitempty"`
+	// The hardware address of the lease (if any).
+	LeaseMAC net.HardwareAddr `json:"lease_mac,omitempty"`
+	// The lease (if any). For the StaleReservation issue, the lease
+	// held by the reserved client for the other address.
+	Lease *lease.Lease `json:"lease,omitempty"`
+	// The exclusion range (for ExcludedLease issue).
+	ExcludedRange *dhcpm.IPRange `json:"excluded_range,omitempty"`
+}
+
+func (i *Issue) String() string {
+	switch i.Kind {
+	case MACMismatch:
+		return fmt.Sprintf("%s: %s reserved for %s, leased to %s", i.Kind, i.IPAddress, i.ReservedMAC, i.LeaseMAC)
+	case StaleReservation:
+		if i.Lease != nil {
+			return fmt.Sprintf("%s: %s reserved for %s, client holds %s", i.Kind, i.IPAddress, i.ReservedMAC, i.Lease.IPAddress)
+		}
+		return fmt.Sprintf("%s: %s reserved for %s, not leased", i.Kind, i.IPAddress, i.ReservedMAC)
+	case ExcludedLease:
+		return fmt.Sprintf("%s: %s leased to %s within %s", i.Kind, i.IPAddress, i.LeaseMAC, rangeString(i.ExcludedRange))
+	}
+	return fmt.Sprintf("%s: %s", i.Kind, i.IPAddress)
+}
+
+// CheckReservations function cross-references the reservations of the scope
+// `scope` against the active leases and returns the list of issues found:
+// the reserved addresses leased to the other clients, the reservations that
+// are not used by the reserved clients, and the leases within the exclusion
+// ranges.
+func (c *Client) CheckReservations(ctx context.Context, scope uint32) ([]*Issue, error) {
+
+	reservations, err := c.elements(ctx, scope, dhcpm.SubnetElementTypeReservedIPs)
+	if err != nil {
+		return nil, fmt.Errorf("dhcp: check reservations: %w", err)
+	}
+
+	exclusions, err := c.elements(ctx, scope, dhcpm.SubnetElementTypeExcludedIPRanges)
+	if err != nil {
+		return nil, fmt.Errorf("dhcp: check reservations: %w", err)
+	}
+
+	leases, err := lease.NewStream(c.srv, c.srv2, scope).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("dhcp: check reservations: %w", err)
+	}
+
+	var (
+		byIP  = make(map[string]*lease.Lease, len(leases))
+		byMAC = make(map[string]*lease.Lease, len(leases))
+	)
+
+	for _, l := range leases {
+		byIP[l.IPAddress.String()] = l
+		byMAC[HardwareAddress(l.HardwareAddress, scope).String()] = l
+	}
+
+	var issues []*Issue
+
+	for _, e := range reservations {
+
+		v, ok := e.Element.GetValue().(*dhcpm.IPReservationV4)
+		if !ok || v == nil {
+			continue
+		}
+
+		ip := IPv4(v.ReservedIPAddress)
+
+		var mac net.HardwareAddr
+		if v.ReservedForClient != nil {
+			mac = HardwareAddress(v.ReservedForClient.Data, scope)
+		}
+
+		if l, ok := byIP[ip.String()]; ok {
+			if lmac := HardwareAddress(l.HardwareAddress, scope); !bytes.Equal(lmac, mac) {
+				issues = append(issues, &Issue{
+					Kind:        MACMismatch,
+					IPAddress:   ip,
+					ReservedMAC: mac,
+					LeaseMAC:    lmac,
+					Lease:       l,
+				})
+			}
+			continue
+		}
+
+		// the reserved client lease for the other address (if any).
+		issue := &Issue{Kind: StaleReservation, IPAddress: ip, ReservedMAC: mac}
+		if l, ok := byMAC[mac.String()]; ok && len(mac) > 0 {
+			issue.Lease, issue.LeaseMAC = l, mac
+		}
+
+		issues = append(issues, issue)
+	}
+
+	for _, e := range exclusions {
+
+		r, ok := e.Element.GetValue().(*dhcpm.IPRange)
+		if !ok || r == nil {
+			continue
+		}
+
+		for _, l := range leases {
+			if ip := l.IPAddress.To4(); ip != nil && inRange(r, ip) {
+				issues = append(issues, &Issue{
+					Kind:          ExcludedLease,
+					IPAddress:     l.IPAddress,
+					LeaseMAC:      HardwareAddress(l.HardwareAddress, scope),
+					Lease:         l,
+					ExcludedRange: r,
+				})
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+// inRange function returns `true` if the address `ip` is within the range `r`.
+func inRange(r *dhcpm.IPRange, ip net.IP) bool {
+	v := uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3])
+	return r.StartAddress <= v && v <= r.EndAddress
+}
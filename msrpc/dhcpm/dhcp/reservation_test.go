@@ -0,0 +1,115 @@
+package dhcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	dhcpsrv "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv/v1"
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+type srv2 struct{ dhcpsrv2.Dhcpsrv2Client }
+
+func (srv2) EnumSubnetClientsFilterStatusInfo(context.Context, *dhcpsrv2.EnumSubnetClientsFilterStatusInfoRequest, ...dcerpc.CallOption) (*dhcpsrv2.EnumSubnetClientsFilterStatusInfoResponse, error) {
+	return nil, rpcerrors.OperationRangeError
+}
+
+type srv struct{ dhcpsrv.DHCPServerClient }
+
+func reservation(ip uint32, mac ...byte) *dhcpm.SubnetElementDataV4 {
+	return &dhcpm.SubnetElementDataV4{
+		ElementType: dhcpm.SubnetElementTypeReservedIPs,
+		Element: &dhcpm.SubnetElementDataV4_Element{
+			Value: &dhcpm.SubnetElementDataV4_ReservedIP{
+				ReservedIP: &dhcpm.IPReservationV4{
+					ReservedIPAddress: ip,
+					ReservedForClient: &dhcpm.ClientUID{DataLength: uint32(len(mac)), Data: mac},
+				},
+			},
+		},
+	}
+}
+
+func (srv) EnumSubnetElementsV4(ctx context.Context, in *dhcpsrv.EnumSubnetElementsV4Request, opts ...dcerpc.CallOption) (*dhcpsrv.EnumSubnetElementsV4Response, error) {
+
+	resp := &dhcpsrv.EnumSubnetElementsV4Response{EnumElementInfo: &dhcpm.SubnetElementInfoArrayV4{}}
+
+	switch in.EnumElementType {
+	case dhcpm.SubnetElementTypeReservedIPs:
+		// the reservations are returned in two pages.
+		if in.Resume == 0 {
+			resp.EnumElementInfo.Elements = []*dhcpm.SubnetElementDataV4{
+				reservation(0x0a00000a, 1, 1, 1, 1, 1, 1),
+				reservation(0x0a00000b, 2, 2, 2, 2, 2, 2),
+			}
+			resp.Resume, resp.Return = 2, 234
+		} else {
+			resp.EnumElementInfo.Elements = []*dhcpm.SubnetElementDataV4{
+				reservation(0x0a00000c, 3, 3, 3, 3, 3, 3),
+			}
+		}
+	case dhcpm.SubnetElementTypeExcludedIPRanges:
+		resp.EnumElementInfo.Elements = []*dhcpm.SubnetElementDataV4{{
+			ElementType: dhcpm.SubnetElementTypeExcludedIPRanges,
+			Element: &dhcpm.SubnetElementDataV4_Element{
+				Value: &dhcpm.SubnetElementDataV4_ExcludeIPRange{
+					ExcludeIPRange: &dhcpm.IPRange{StartAddress: 0x0a000064, EndAddress: 0x0a0000c8},
+				},
+			},
+		}}
+	}
+
+	return resp, nil
+}
+
+func (srv) EnumSubnetClientsVQ(ctx context.Context, in *dhcpsrv.EnumSubnetClientsVQRequest, opts ...dcerpc.CallOption) (*dhcpsrv.EnumSubnetClientsVQResponse, error) {
+	return &dhcpsrv.EnumSubnetClientsVQResponse{
+		ClientInfo: &dhcpm.ClientInfoArrayVQ{
+			Clients: []*dhcpm.ClientInfoVQ{
+				// matches the reservation.
+				{ClientIPAddress: 0x0a00000a, ClientHardwareAddress: &dhcpm.ClientUID{Data: []byte{1, 1, 1, 1, 1, 1}}},
+				// leased to the other client.
+				{ClientIPAddress: 0x0a00000b, ClientHardwareAddress: &dhcpm.ClientUID{Data: []byte{9, 9, 9, 9, 9, 9}}},
+				// the reserved client holds the other address (with subnet prefix).
+				{ClientIPAddress: 0x0a000014, ClientHardwareAddress: &dhcpm.ClientUID{Data: []byte{0x00, 0x00, 0x00, 0x0a, 1, 3, 3, 3, 3, 3, 3}}},
+				// within the exclusion range.
+				{ClientIPAddress: 0x0a000065, ClientHardwareAddress: &dhcpm.ClientUID{Data: []byte{4, 4, 4, 4, 4, 4}}},
+			},
+		},
+	}, nil
+}
+
+func TestCheckReservations(t *testing.T) {
+
+	issues, err := NewClient(srv{}, srv2{}).CheckReservations(context.Background(), 0x0a000000)
+	if err != nil {
+		t.Fatalf("check reservations: %v", err)
+	}
+
+	expected := []struct {
+		kind IssueKind
+		ip   string
+		mac  string
+	}{
+		{MACMismatch, "10.0.0.11", "09:09:09:09:09:09"},
+		{StaleReservation, "10.0.0.12", "03:03:03:03:03:03"},
+		{ExcludedLease, "10.0.0.101", "04:04:04:04:04:04"},
+	}
+
+	if len(issues) != len(expected) {
+		t.Fatalf("unexpected number of issues: %v", issues)
+	}
+
+	for i, e := range expected {
+		if issues[i].Kind != e.kind || issues[i].IPAddress.String() != e.ip || issues[i].LeaseMAC.String() != e.mac {
+			t.Errorf("issue %d: unexpected %s", i, issues[i])
+		}
+	}
+
+	if l := issues[1].Lease; l == nil || l.IPAddress.String() != "10.0.0.20" {
+		t.Errorf("stale reservation: unexpected lease %v", l)
+	}
+}
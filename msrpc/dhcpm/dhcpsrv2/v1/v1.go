@@ -16210,6 +16210,12 @@ func (o *xxx_ServerQueryAttributesOperation) UnmarshalNDRRequest(ctx context.Con
 			}
 		}
 	}
+	if err := ndr.CheckRange("AttributeCount", o.AttributeCount, 0, 6); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DHCPAttributes", len(o.DHCPAttributes), o.AttributeCount, "AttributeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17936,6 +17942,12 @@ func (o *xxx_QueryDNSRegCredentialsOperation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckRange("UserNameSize", o.UserNameSize, 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DomainSize", o.DomainSize, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
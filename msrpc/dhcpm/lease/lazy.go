@@ -0,0 +1,133 @@
+package lease
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// enumOp structure represents the R_DhcpEnumSubnetClients* operation with the
+// lazily decoded client array (see Stream.Lazy). The operations share the
+// response layout: the resume handle, the pointer to the client array
+// structure, the number of clients read, the total number of clients and
+// the return code.
+type enumOp[T any, P interface {
+	*T
+	ndr.Unmarshaler
+}] struct {
+	// The operation number and name.
+	opNum  int
+	opName string
+	// The request parameters (generated request structure).
+	req ndr.Marshaler
+	// The resume handle.
+	Resume uint32
+	// The clients.
+	Clients *ndr.LazyArray[P]
+	// The number of clients read and the total number of clients.
+	ClientsRead, ClientsTotal uint32
+	// The return code.
+	Return uint32
+}
+
+func newEnumOp[T any, P interface {
+	*T
+	ndr.Unmarshaler
+}](opNum int, opName string, req ndr.Marshaler) *enumOp[T, P] {
+	return &enumOp[T, P]{opNum: opNum, opName: opName, req: req}
+}
+
+func (o *enumOp[T, P]) OpNum() int { return o.opNum }
+
+func (o *enumOp[T, P]) OpName() string { return o.opName }
+
+func (o *enumOp[T, P]) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	return o.req.MarshalNDR(ctx, w)
+}
+
+func (o *enumOp[T, P]) UnmarshalNDRRequest(ctx context.Context, w ndr.Reader) error {
+	return fmt.Errorf("%s: request decoding is not supported", o.opName)
+}
+
+func (o *enumOp[T, P]) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return fmt.Errorf("%s: response encoding is not supported", o.opName)
+}
+
+func (o *enumOp[T, P]) UnmarshalNDRResponse(ctx context.Context, w ndr.Reader) error {
+
+	if err := w.ReadData(&o.Resume); err != nil {
+		return err
+	}
+
+	o.Clients = ndr.NewLazyArray(ndr.ReadPointerElem[T, P])
+
+	// the client array structure.
+	var n uint32
+	info := ndr.UnmarshalNDRFunc(func(ctx context.Context, w ndr.Reader) error {
+		if err := w.ReadAlign(9); err != nil {
+			return err
+		}
+		if err := w.ReadData(&n); err != nil {
+			return err
+		}
+		return w.ReadPointer(&o.Clients, func(p any) { o.Clients = *p.(**ndr.LazyArray[P]) }, o.Clients)
+	})
+
+	if err := w.ReadPointer(&n, nil, info); err != nil {
+		return err
+	}
+
+	if err := w.ReadDeferred(); err != nil {
+		return err
+	}
+
+	if err := w.ReadData(&o.ClientsRead); err != nil {
+		return err
+	}
+
+	if err := w.ReadData(&o.ClientsTotal); err != nil {
+		return err
+	}
+
+	return w.ReadData(&o.Return)
+}
+
+// fetchLazy function invokes the operation `op` and sets the page iterator
+// that converts the clients with `lease`.
+func fetchLazy[T any, P interface {
+	*T
+	ndr.Unmarshaler
+}](ctx context.Context, s *Stream, cc dcerpc.Conn, op *enumOp[T, P], lease func(P) *Lease) error {
+
+	if err := cc.Invoke(ctx, op); err != nil {
+		return err
+	}
+
+	var err error
+	if op.Return != 0 {
+		err = fmt.Errorf("%s: %w", op.opName, rpcerrors.New(ctx, op.Return))
+	}
+
+	if err := s.result(op.Return, op.Resume, err); err != nil {
+		return err
+	}
+
+	it := op.Clients.Iterator()
+
+	s.next = func(ctx context.Context) (*Lease, error) {
+		for {
+			c, err := it.Next(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if l := lease(c); l != nil {
+				return l, nil
+			}
+		}
+	}
+
+	return nil
+}
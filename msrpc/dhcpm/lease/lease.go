@@ -0,0 +1,480 @@
+// The lease package implements the unified DHCPv4 client lease enumeration
+// on top of the R_DhcpEnumSubnetClientsV4, R_DhcpEnumSubnetClientsVQ,
+// R_DhcpEnumSubnetClientsV5 and R_DhcpEnumSubnetClientsFilterStatusInfo
+// methods.
+//
+// The stream selects the richest variant supported by the server and
+// normalizes the records into the single Lease structure:
+//
+//	srv, err := dhcpsrv.NewDHCPServerClient(ctx, conn, dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	srv2, err := dhcpsrv2.NewDhcpsrv2Client(ctx, conn, dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	stream := lease.NewStream(srv, srv2, subnet)
+//
+//	for {
+//		l, err := stream.Next(ctx)
+//		if err != nil {
+//			if err == io.EOF {
+//				break
+//			}
+//			// handle error.
+//		}
+//		fmt.Println(l.IPAddress, l.Name, l.Expires)
+//	}
+//
+// The Lazy mode keeps the page raw and decodes the leases one at a time
+// (see ndr.LazyArray), which bounds the memory for the large pages:
+//
+//	stream := lease.NewStream(srv, srv2, subnet)
+//	stream.Lazy, stream.PreferredMaximum = true, 0xFFFFFFFF
+//
+// The netbox and phpipam subpackages map the leases to the IPAM API payloads.
+package lease
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	dhcpsrv "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv/v1"
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+// Variant is the enumeration method variant.
+type Variant int
+
+const (
+	// Unknown variant (not selected yet).
+	Unknown Variant = iota
+	// R_DhcpEnumSubnetClientsV4.
+	V4
+	// R_DhcpEnumSubnetClientsV5 (address state).
+	V5
+	// R_DhcpEnumSubnetClientsVQ (address state, quarantine).
+	VQ
+	// R_DhcpEnumSubnetClientsFilterStatusInfo (address state, quarantine,
+	// filter status).
+	FilterStatus
+)
+
+func (v Variant) String() string {
+	switch v {
+	case V4:
+		return "R_DhcpEnumSubnetClientsV4"
+	case V5:
+		return "R_DhcpEnumSubnetClientsV5"
+	case VQ:
+		return "R_DhcpEnumSubnetClientsVQ"
+	case FilterStatus:
+		return "R_DhcpEnumSubnetClientsFilterStatusInfo"
+	}
+	return "unknown"
+}
+
+// Lease is the normalized DHCPv4 client lease record. The optional fields
+// are nil if not provided by the selected variant.
+type Lease struct {
+	// The client IP address.
+	IPAddress net.IP `json:"ip_address"`
+	// The subnet mask.
+	SubnetMask net.IPMask `json:"subnet_mask"`
+	// The client hardware address (client identifier).
+	HardwareAddress []byte `json:"hardware_address"`
+	// The client name.
+	Name string `json:"name"`
+	// The client comment.
+	Comment string `json:"comment"`
+	// The lease expiration time, zero if the lease never expires (see Never).
+	Expires time.Time `json:"expires"`
+	// Never is `true` if the lease never expires (reservation).
+	Never bool `json:"never"`
+	// The owner DHCP server.
+	OwnerHost *dhcpm.HostInfo `json:"owner_host,omitempty"`
+	// The client type (DHCP, BOOTP).
+	ClientType uint8 `json:"client_type"`
+	// The address state.
+	AddressState *uint8 `json:"address_state,omitempty"`
+	// The quarantine status.
+	QuarantineStatus *dhcpm.QuarantineStatus `json:"quarantine_status,omitempty"`
+	// The end of the probation period.
+	ProbationEnds *time.Time `json:"probation_ends,omitempty"`
+	// The client is quarantine capable.
+	QuarantineCapable *bool `json:"quarantine_capable,omitempty"`
+	// The link-layer filter status.
+	FilterStatus *uint32 `json:"filter_status,omitempty"`
+	// The variant that produced the record.
+	Variant Variant `json:"variant"`
+}
+
+// MAC function returns the client hardware address. The client identifier is
+// either the hardware address, or the subnet address followed by the hardware
+// type (Ethernet) and the hardware address. The function returns nil if the
+// client identifier is not the Ethernet address.
+func (l *Lease) MAC() net.HardwareAddr {
+	switch hw := l.HardwareAddress; {
+	case len(hw) == 6:
+		return net.HardwareAddr(hw)
+	case len(hw) == 11 && hw[4] == 0x01:
+		return net.HardwareAddr(hw[5:])
+	}
+	return nil
+}
+
+// Prefix function returns the client address with the prefix length in
+// CIDR notation ("10.0.0.1/24"), the prefix length is 32 if the subnet mask
+// is not set (or is not canonical).
+func (l *Lease) Prefix() string {
+	ones, bits := l.SubnetMask.Size()
+	if bits == 0 {
+		ones = 32
+	}
+	return fmt.Sprintf("%s/%d", l.IPAddress, ones)
+}
+
+// Expired function returns `true` if the lease expired at the time `now`.
+func (l *Lease) Expired(now time.Time) bool {
+	return !l.Never && !l.Expires.IsZero() && l.Expires.Before(now)
+}
+
+const (
+	errorMoreData    = 234
+	errorNoMoreItems = 259
+)
+
+// The default preferred maximum size of the page.
+const DefaultPreferredMaximum = 65536
+
+// Stream is the lease enumeration stream.
+type Stream struct {
+	srv    dhcpsrv.DHCPServerClient
+	srv2   dhcpsrv2.Dhcpsrv2Client
+	subnet uint32
+	// PreferredMaximum is the preferred page size in bytes.
+	PreferredMaximum uint32
+	// The variant, if set before the first Next call, only the
+	// variant is used.
+	Variant Variant
+	// Lazy is `true` to decode the client arrays lazily: the page is kept
+	// raw and the leases are decoded by Next one at a time (see
+	// ndr.LazyArray), which bounds the memory for the large pages (for
+	// example, when PreferredMaximum is 0xFFFFFFFF). The clients must be
+	// the generated clients (the Conn method is used).
+	Lazy   bool
+	resume uint32
+	page   []*Lease
+	next   func(context.Context) (*Lease, error)
+	done   bool
+}
+
+// NewStream function returns the lease stream for the subnet (0 for all
+// subnets). Any of the clients can be nil, in this case the variants of
+// the interface are not used.
+func NewStream(srv dhcpsrv.DHCPServerClient, srv2 dhcpsrv2.Dhcpsrv2Client, subnet uint32) *Stream {
+	return &Stream{
+		srv:              srv,
+		srv2:             srv2,
+		subnet:           subnet,
+		PreferredMaximum: DefaultPreferredMaximum,
+	}
+}
+
+// Next function returns the next lease, or io.EOF when there are no
+// more leases.
+func (s *Stream) Next(ctx context.Context) (*Lease, error) {
+
+	for len(s.page) == 0 {
+		if s.next != nil {
+			l, err := s.next(ctx)
+			if err != io.EOF {
+				return l, err
+			}
+			s.next = nil
+		}
+		if s.done {
+			return nil, io.EOF
+		}
+		if err := s.fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	l := s.page[0]
+	s.page = s.page[1:]
+
+	return l, nil
+}
+
+// All function returns all remaining leases.
+func (s *Stream) All(ctx context.Context) ([]*Lease, error) {
+	var ret []*Lease
+	for {
+		l, err := s.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return ret, nil
+			}
+			return ret, err
+		}
+		ret = append(ret, l)
+	}
+}
+
+// fetch function fetches the next page, selecting the variant on the
+// first call.
+func (s *Stream) fetch(ctx context.Context) error {
+
+	if s.Variant != Unknown {
+		return s.fetchPage(ctx, s.Variant)
+	}
+
+	var errs []error
+
+	for _, v := range []Variant{FilterStatus, VQ, V5, V4} {
+		if !s.available(v) {
+			continue
+		}
+		err := s.fetchPage(ctx, v)
+		if err == nil {
+			s.Variant = v
+			return nil
+		}
+		if !isNotSupported(err) {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("lease: no client is provided")
+	}
+
+	return fmt.Errorf("lease: no supported variant: %w", errors.Join(errs...))
+}
+
+func (s *Stream) available(v Variant) bool {
+	switch v {
+	case V4, VQ:
+		return s.srv != nil
+	case V5, FilterStatus:
+		return s.srv2 != nil
+	}
+	return false
+}
+
+// isNotSupported function returns `true` if the error indicates that
+// the method is not implemented by the server.
+func isNotSupported(err error) bool {
+	return errors.Is(err, rpcerrors.OperationRangeError) || errors.Is(err, rpcerrors.UnknownInterface)
+}
+
+// result function handles the page return code.
+func (s *Stream) result(ret uint32, resume uint32, err error) error {
+	switch ret {
+	case 0:
+		s.done = true
+	case errorMoreData:
+		s.resume = resume
+	case errorNoMoreItems:
+		s.done = true
+	default:
+		return err
+	}
+	return nil
+}
+
+func (s *Stream) fetchPage(ctx context.Context, v Variant) error {
+
+	if s.Lazy {
+		return s.fetchLazyPage(ctx, v)
+	}
+
+	switch v {
+	case V4:
+		resp, err := s.srv.EnumSubnetClientsV4(ctx, &dhcpsrv.EnumSubnetClientsV4Request{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		})
+		if resp == nil {
+			return err
+		}
+		if err := s.result(resp.Return, resp.Resume, err); err != nil {
+			return err
+		}
+		if resp.ClientInfo != nil {
+			for _, c := range resp.ClientInfo.Clients {
+				s.add(leaseV4(c))
+			}
+		}
+	case V5:
+		resp, err := s.srv2.EnumSubnetClientsV5(ctx, &dhcpsrv2.EnumSubnetClientsV5Request{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		})
+		if resp == nil {
+			return err
+		}
+		if err := s.result(resp.Return, resp.Resume, err); err != nil {
+			return err
+		}
+		if resp.ClientInfo != nil {
+			for _, c := range resp.ClientInfo.Clients {
+				s.add(leaseV5(c))
+			}
+		}
+	case VQ:
+		resp, err := s.srv.EnumSubnetClientsVQ(ctx, &dhcpsrv.EnumSubnetClientsVQRequest{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		})
+		if resp == nil {
+			return err
+		}
+		if err := s.result(resp.Return, resp.Resume, err); err != nil {
+			return err
+		}
+		if resp.ClientInfo != nil {
+			for _, c := range resp.ClientInfo.Clients {
+				s.add(leaseVQ(c))
+			}
+		}
+	case FilterStatus:
+		resp, err := s.srv2.EnumSubnetClientsFilterStatusInfo(ctx, &dhcpsrv2.EnumSubnetClientsFilterStatusInfoRequest{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		})
+		if resp == nil {
+			return err
+		}
+		if err := s.result(resp.Return, resp.Resume, err); err != nil {
+			return err
+		}
+		if resp.ClientInfo != nil {
+			for _, c := range resp.ClientInfo.Clients {
+				s.add(leaseFilterStatus(c))
+			}
+		}
+	default:
+		return fmt.Errorf("lease: unknown variant %d", v)
+	}
+
+	return nil
+}
+
+// fetchLazyPage function fetches the next page with the lazily decoded
+// client array.
+func (s *Stream) fetchLazyPage(ctx context.Context, v Variant) error {
+
+	switch v {
+	case V4:
+		return fetchLazy(ctx, s, s.srv.Conn(), newEnumOp[dhcpm.ClientInfoV4](35, "/dhcpsrv/v1/R_DhcpEnumSubnetClientsV4", &dhcpsrv.EnumSubnetClientsV4Request{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		}), leaseV4)
+	case V5:
+		return fetchLazy(ctx, s, s.srv2.Conn(), newEnumOp[dhcpm.ClientInfoV5](0, "/dhcpsrv2/v1/R_DhcpEnumSubnetClientsV5", &dhcpsrv2.EnumSubnetClientsV5Request{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		}), leaseV5)
+	case VQ:
+		return fetchLazy(ctx, s, s.srv.Conn(), newEnumOp[dhcpm.ClientInfoVQ](47, "/dhcpsrv/v1/R_DhcpEnumSubnetClientsVQ", &dhcpsrv.EnumSubnetClientsVQRequest{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		}), leaseVQ)
+	case FilterStatus:
+		return fetchLazy(ctx, s, s.srv2.Conn(), newEnumOp[dhcpm.ClientFilterStatusInfo](88, "/dhcpsrv2/v1/R_DhcpEnumSubnetClientsFilterStatusInfo", &dhcpsrv2.EnumSubnetClientsFilterStatusInfoRequest{
+			SubnetAddress: s.subnet, Resume: s.resume, PreferredMaximum: s.PreferredMaximum,
+		}), leaseFilterStatus)
+	}
+
+	return fmt.Errorf("lease: unknown variant %d", v)
+}
+
+// add function appends the lease to the page.
+func (s *Stream) add(l *Lease) {
+	if l != nil {
+		s.page = append(s.page, l)
+	}
+}
+
+func leaseV4(c *dhcpm.ClientInfoV4) *Lease {
+	if c == nil {
+		return nil
+	}
+	return newLease(V4, c.ClientIPAddress, c.SubnetMask, c.ClientHardwareAddress,
+		c.ClientName, c.ClientComment, c.ClientLeaseExpires, c.OwnerHost, c.ClientType)
+}
+
+func leaseV5(c *dhcpm.ClientInfoV5) *Lease {
+	if c == nil {
+		return nil
+	}
+	l := newLease(V5, c.ClientIPAddress, c.SubnetMask, c.ClientHardwareAddress,
+		c.ClientName, c.ClientComment, c.ClientLeaseExpires, c.OwnerHost, c.ClientType)
+	l.AddressState = &c.AddressState
+	return l
+}
+
+func leaseVQ(c *dhcpm.ClientInfoVQ) *Lease {
+	if c == nil {
+		return nil
+	}
+	l := newLease(VQ, c.ClientIPAddress, c.SubnetMask, c.ClientHardwareAddress,
+		c.ClientName, c.ClientComment, c.ClientLeaseExpires, c.OwnerHost, c.ClientType)
+	l.AddressState, l.QuarantineStatus, l.QuarantineCapable = &c.AddressState, &c.Status, &c.QuarantineCapable
+	l.ProbationEnds = timePtr(c.ProbationEnds)
+	return l
+}
+
+func leaseFilterStatus(c *dhcpm.ClientFilterStatusInfo) *Lease {
+	if c == nil {
+		return nil
+	}
+	l := newLease(FilterStatus, c.ClientIPAddress, c.SubnetMask, c.ClientHardwareAddress,
+		c.ClientName, c.ClientComment, c.ClientLeaseExpires, c.OwnerHost, c.ClientType)
+	l.AddressState, l.QuarantineStatus, l.QuarantineCapable = &c.AddressState, &c.Status, &c.QuarantineCapable
+	l.ProbationEnds, l.FilterStatus = timePtr(c.ProbationEnds), &c.FilterStatus
+	return l
+}
+
+func newLease(v Variant, ip, mask uint32, hw *dhcpm.ClientUID, name, comment string, expires *dhcpm.DateTime, owner *dhcpm.HostInfo, typ uint8) *Lease {
+
+	l := &Lease{
+		IPAddress:  ipv4(ip),
+		SubnetMask: net.IPMask(ipv4(mask)),
+		Name:       name,
+		Comment:    comment,
+		OwnerHost:  owner,
+		ClientType: typ,
+		Variant:    v,
+	}
+
+	if hw != nil {
+		l.HardwareAddress = hw.Data
+	}
+
+	if expires != nil {
+		l.Expires, l.Never = expires.AsTime(), expires.IsNever()
+	}
+
+	return l
+}
+
+func ipv4(v uint32) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, v)
+	return ip
+}
+
+func timePtr(t *dhcpm.DateTime) *time.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	tm := t.AsTime()
+	return &tm
+}
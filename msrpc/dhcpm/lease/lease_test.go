@@ -0,0 +1,151 @@
+package lease
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+	dhcpsrv "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv/v1"
+	dhcpsrv2 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+)
+
+type srv2 struct{ dhcpsrv2.Dhcpsrv2Client }
+
+func (srv2) EnumSubnetClientsFilterStatusInfo(context.Context, *dhcpsrv2.EnumSubnetClientsFilterStatusInfoRequest, ...dcerpc.CallOption) (*dhcpsrv2.EnumSubnetClientsFilterStatusInfoResponse, error) {
+	return nil, rpcerrors.OperationRangeError
+}
+
+type srv struct{ dhcpsrv.DHCPServerClient }
+
+func (srv) EnumSubnetClientsVQ(ctx context.Context, in *dhcpsrv.EnumSubnetClientsVQRequest, opts ...dcerpc.CallOption) (*dhcpsrv.EnumSubnetClientsVQResponse, error) {
+
+	resp := &dhcpsrv.EnumSubnetClientsVQResponse{
+		ClientInfo: &dhcpm.ClientInfoArrayVQ{
+			Clients: []*dhcpm.ClientInfoVQ{{
+				ClientIPAddress:    0x0a000001 + in.Resume,
+				ClientName:         "host",
+				ClientLeaseExpires: &dhcpm.DateTime{LowDateTime: 0xFFFFFFFF, HighDateTime: 0x7FFFFFFF},
+				Status:             dhcpm.QuarantineStatusProbation,
+			}},
+		},
+		Resume: in.Resume + 1,
+	}
+
+	if in.Resume == 0 {
+		// ERROR_MORE_DATA.
+		resp.Return = 234
+	}
+
+	return resp, nil
+}
+
+func TestStream(t *testing.T) {
+
+	leases, err := NewStream(srv{}, srv2{}, 0).All(context.Background())
+	if err != nil {
+		t.Fatalf("all: %v", err)
+	}
+
+	if len(leases) != 2 {
+		t.Fatalf("unexpected number of leases: %d", len(leases))
+	}
+
+	for i, l := range leases {
+		if l.Variant != VQ {
+			t.Errorf("lease %d: unexpected variant %s", i, l.Variant)
+		}
+		if l.IPAddress.String() != []string{"10.0.0.1", "10.0.0.2"}[i] {
+			t.Errorf("lease %d: unexpected ip address %s", i, l.IPAddress)
+		}
+		if !l.Never || !l.Expires.IsZero() {
+			t.Errorf("lease %d: expected infinite lease", i)
+		}
+		if l.QuarantineStatus == nil || *l.QuarantineStatus != dhcpm.QuarantineStatusProbation {
+			t.Errorf("lease %d: unexpected quarantine status", i)
+		}
+		if l.FilterStatus != nil {
+			t.Errorf("lease %d: unexpected filter status", i)
+		}
+	}
+}
+
+// conn structure represents the connection that serves the
+// R_DhcpEnumSubnetClientsV5 method.
+type conn struct{ dcerpc.Conn }
+
+func (conn) Invoke(ctx context.Context, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+
+	if op.OpNum() != 0 {
+		return rpcerrors.OperationRangeError
+	}
+
+	var req dhcpsrv2.EnumSubnetClientsV5Request
+	b, err := dcerpc.EncodeRequest(ctx, op)
+	if err != nil {
+		return err
+	}
+	if err := dcerpc.DecodeStub(ctx, b, &req); err != nil {
+		return err
+	}
+
+	resp := &dhcpsrv2.EnumSubnetClientsV5Response{
+		ClientInfo: &dhcpm.ClientInfoArrayV5{
+			Clients: []*dhcpm.ClientInfoV5{
+				{ClientIPAddress: 0x0a000001 + req.Resume, ClientName: "host", AddressState: 1},
+				nil,
+				{ClientIPAddress: 0x0a000101 + req.Resume, ClientHardwareAddress: &dhcpm.ClientUID{Data: []byte{1, 2, 3, 4, 5, 6}}},
+			},
+		},
+		Resume: req.Resume + 1,
+	}
+
+	if req.Resume == 0 {
+		// ERROR_MORE_DATA.
+		resp.Return = 234
+	}
+
+	if b, err = dcerpc.EncodeStub(ctx, resp); err != nil {
+		return err
+	}
+
+	return dcerpc.DecodeResponse(ctx, b, op)
+}
+
+type lazySrv2 struct{ dhcpsrv2.Dhcpsrv2Client }
+
+func (lazySrv2) Conn() dcerpc.Conn { return conn{} }
+
+func TestStreamLazy(t *testing.T) {
+
+	s := NewStream(nil, lazySrv2{}, 0)
+	s.Lazy = true
+
+	leases, err := s.All(context.Background())
+	if err != nil {
+		t.Fatalf("all: %v", err)
+	}
+
+	if s.Variant != V5 {
+		t.Errorf("unexpected variant %s", s.Variant)
+	}
+
+	expected := []string{"10.0.0.1", "10.0.1.1", "10.0.0.2", "10.0.1.2"}
+	if len(leases) != len(expected) {
+		t.Fatalf("unexpected number of leases: %d", len(leases))
+	}
+
+	for i, l := range leases {
+		if l.IPAddress.String() != expected[i] {
+			t.Errorf("lease %d: unexpected ip address %s", i, l.IPAddress)
+		}
+		if l.AddressState == nil {
+			t.Errorf("lease %d: expected address state", i)
+		}
+	}
+
+	if leases[0].Name != "host" || leases[1].MAC().String() != "01:02:03:04:05:06" {
+		t.Errorf("unexpected leases %+v %+v", leases[0], leases[1])
+	}
+}
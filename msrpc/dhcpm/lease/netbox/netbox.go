@@ -0,0 +1,208 @@
+// The netbox package maps the DHCP leases (see lease.Stream) to the NetBox
+// IP address payloads (POST/PATCH /api/ipam/ip-addresses/):
+//
+//	m := netbox.New(netbox.WithDomain("contoso.net"), netbox.WithTags("dhcp"))
+//
+//	leases, err := lease.NewStream(srv, srv2, subnet).All(ctx)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	addrs, err := m.MapAll(leases)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	b, err := json.Marshal(addrs) // bulk create.
+//
+// The mapping can be adjusted with the hooks, for example, to populate the
+// custom fields defined in NetBox:
+//
+//	m := netbox.New(netbox.WithHook(func(l *lease.Lease, addr *netbox.IPAddress) error {
+//		addr.CustomFields["mac_address"] = l.MAC().String()
+//		return nil
+//	}))
+package netbox
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm/lease"
+)
+
+// The IP address status values.
+const (
+	StatusActive     = "active"
+	StatusReserved   = "reserved"
+	StatusDeprecated = "deprecated"
+	StatusDHCP       = "dhcp"
+)
+
+// Tag is the nested tag reference.
+type Tag struct {
+	// The tag name.
+	Name string `json:"name,omitempty"`
+	// The tag slug.
+	Slug string `json:"slug,omitempty"`
+}
+
+// IPAddress is the NetBox IP address payload.
+type IPAddress struct {
+	// The address with the prefix length ("10.0.0.1/24").
+	Address string `json:"address"`
+	// The VRF identifier.
+	VRF *int `json:"vrf,omitempty"`
+	// The tenant identifier.
+	Tenant *int `json:"tenant,omitempty"`
+	// The status (active, reserved, deprecated, dhcp).
+	Status string `json:"status,omitempty"`
+	// The DNS name.
+	DNSName string `json:"dns_name,omitempty"`
+	// The description.
+	Description string `json:"description,omitempty"`
+	// The comments.
+	Comments string `json:"comments,omitempty"`
+	// The tags.
+	Tags []*Tag `json:"tags,omitempty"`
+	// The custom fields (the fields must be defined in NetBox).
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+}
+
+// Hook is the function that adjusts the IP address payload mapped from
+// the lease.
+type Hook func(*lease.Lease, *IPAddress) error
+
+// Mapper maps the leases to the IP address payloads.
+type Mapper struct {
+	// The DNS domain appended to the short client names.
+	domain string
+	// The VRF identifier.
+	vrf *int
+	// The tenant identifier.
+	tenant *int
+	// The tags.
+	tags []string
+	// The mapping hooks.
+	hooks []Hook
+	// The current time function.
+	now func() time.Time
+}
+
+// Option is the mapper option.
+type Option func(*Mapper)
+
+// WithDomain option sets the DNS domain appended to the client names that
+// are not fully-qualified.
+func WithDomain(domain string) Option {
+	return func(m *Mapper) { m.domain = strings.Trim(domain, ".") }
+}
+
+// WithVRF option sets the VRF identifier of the IP addresses.
+func WithVRF(id int) Option {
+	return func(m *Mapper) { m.vrf = &id }
+}
+
+// WithTenant option sets the tenant identifier of the IP addresses.
+func WithTenant(id int) Option {
+	return func(m *Mapper) { m.tenant = &id }
+}
+
+// WithTags option sets the tags (by name) of the IP addresses.
+func WithTags(tags ...string) Option {
+	return func(m *Mapper) { m.tags = append(m.tags, tags...) }
+}
+
+// WithHook option adds the mapping hook, the hooks are called in order
+// after the default mapping.
+func WithHook(hook Hook) Option {
+	return func(m *Mapper) { m.hooks = append(m.hooks, hook) }
+}
+
+// WithTime option sets the function that returns the current time used to
+// determine the expired leases.
+func WithTime(now func() time.Time) Option {
+	return func(m *Mapper) { m.now = now }
+}
+
+// New function returns the lease mapper.
+func New(opts ...Option) *Mapper {
+
+	m := &Mapper{now: time.Now}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Map function returns the IP address payload for the lease. The status of
+// the reservation (the lease that never expires) is "reserved", the status of
+// the expired lease is "deprecated", otherwise the status is "dhcp".
+func (m *Mapper) Map(l *lease.Lease) (*IPAddress, error) {
+
+	if l == nil || l.IPAddress == nil {
+		return nil, fmt.Errorf("netbox: lease address is empty")
+	}
+
+	addr := &IPAddress{
+		Address:      l.Prefix(),
+		VRF:          m.vrf,
+		Tenant:       m.tenant,
+		Status:       StatusDHCP,
+		DNSName:      m.dnsName(l.Name),
+		Description:  l.Comment,
+		CustomFields: map[string]any{},
+	}
+
+	switch {
+	case l.Never:
+		addr.Status = StatusReserved
+	case l.Expired(m.now()):
+		addr.Status = StatusDeprecated
+	}
+
+	for _, tag := range m.tags {
+		addr.Tags = append(addr.Tags, &Tag{Name: tag})
+	}
+
+	for _, hook := range m.hooks {
+		if err := hook(l, addr); err != nil {
+			return nil, fmt.Errorf("netbox: map %s: %w", l.IPAddress, err)
+		}
+	}
+
+	return addr, nil
+}
+
+// MapAll function returns the IP address payloads for the leases.
+func (m *Mapper) MapAll(leases []*lease.Lease) ([]*IPAddress, error) {
+
+	addrs := make([]*IPAddress, 0, len(leases))
+
+	for _, l := range leases {
+		addr, err := m.Map(l)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// dnsName function returns the lower-case DNS name for the client name.
+func (m *Mapper) dnsName(name string) string {
+
+	if name = strings.ToLower(strings.TrimSuffix(name, ".")); name == "" {
+		return ""
+	}
+
+	if m.domain != "" && !strings.Contains(name, ".") {
+		name += "." + strings.ToLower(m.domain)
+	}
+
+	return name
+}
@@ -0,0 +1,51 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm/lease"
+)
+
+func TestMap(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := New(WithDomain("contoso.net"), WithTags("dhcp"), WithTime(func() time.Time { return now }),
+		WithHook(func(l *lease.Lease, addr *IPAddress) error {
+			addr.CustomFields["mac_address"] = l.MAC().String()
+			return nil
+		}))
+
+	addrs, err := m.MapAll([]*lease.Lease{
+		{IPAddress: net.IPv4(10, 0, 0, 1).To4(), SubnetMask: net.CIDRMask(24, 32), Name: "WS01", Expires: now.Add(time.Hour), HardwareAddress: []byte{0, 1, 2, 3, 4, 5}},
+		{IPAddress: net.IPv4(10, 0, 0, 2).To4(), SubnetMask: net.CIDRMask(24, 32), Name: "printer.contoso.net", Never: true},
+		{IPAddress: net.IPv4(10, 0, 0, 3).To4(), Expires: now.Add(-time.Hour)},
+	})
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+
+	for i, expected := range []struct {
+		Address, Status, DNSName string
+	}{
+		{"10.0.0.1/24", StatusDHCP, "ws01.contoso.net"},
+		{"10.0.0.2/24", StatusReserved, "printer.contoso.net"},
+		{"10.0.0.3/32", StatusDeprecated, ""},
+	} {
+		if addrs[i].Address != expected.Address || addrs[i].Status != expected.Status || addrs[i].DNSName != expected.DNSName {
+			t.Errorf("address %d: unexpected %+v", i, addrs[i])
+		}
+	}
+
+	b, err := json.Marshal(addrs[0])
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if expected := `{"address":"10.0.0.1/24","status":"dhcp","dns_name":"ws01.contoso.net","tags":[{"name":"dhcp"}],"custom_fields":{"mac_address":"00:01:02:03:04:05"}}`; string(b) != expected {
+		t.Errorf("unexpected payload: %s", b)
+	}
+}
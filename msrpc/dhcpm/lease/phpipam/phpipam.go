@@ -0,0 +1,200 @@
+// The phpipam package maps the DHCP leases (see lease.Stream) to the phpIPAM
+// address payloads (POST/PATCH /api/{app}/addresses/):
+//
+//	m := phpipam.New(phpipam.WithSubnetID("7"))
+//
+//	leases, err := lease.NewStream(srv, srv2, subnet).All(ctx)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	for _, l := range leases {
+//		addr, err := m.Map(l)
+//		if err != nil {
+//			// handle error.
+//		}
+//		b, err := json.Marshal(addr)
+//		// ...
+//	}
+//
+// The mapping can be adjusted with the hooks, for example, to resolve the
+// subnet identifier or to populate the custom fields:
+//
+//	m := phpipam.New(phpipam.WithHook(func(l *lease.Lease, addr *phpipam.Address) error {
+//		addr.CustomFields["custom_Expires"] = l.Expires.Format(phpipam.TimeFormat)
+//		return nil
+//	}))
+package phpipam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm/lease"
+)
+
+// The default address tag identifiers.
+const (
+	TagOffline  = "1"
+	TagUsed     = "2"
+	TagReserved = "3"
+	TagDHCP     = "4"
+)
+
+// The time format of the phpIPAM date-time fields.
+const TimeFormat = "2006-01-02 15:04:05"
+
+// Address is the phpIPAM address payload.
+type Address struct {
+	// The subnet identifier.
+	SubnetID string `json:"subnetId,omitempty"`
+	// The IP address.
+	IP string `json:"ip"`
+	// The hostname.
+	Hostname string `json:"hostname,omitempty"`
+	// The description.
+	Description string `json:"description,omitempty"`
+	// The MAC address.
+	MAC string `json:"mac,omitempty"`
+	// The owner.
+	Owner string `json:"owner,omitempty"`
+	// The tag identifier (see TagOffline, TagUsed, TagReserved, TagDHCP).
+	Tag string `json:"tag,omitempty"`
+	// The note.
+	Note string `json:"note,omitempty"`
+	// The custom fields (the fields must be defined in phpIPAM, the
+	// fields are marshaled as the top-level fields of the payload).
+	CustomFields map[string]string `json:"-"`
+}
+
+// MarshalJSON function returns the payload with the custom fields.
+func (a *Address) MarshalJSON() ([]byte, error) {
+
+	type address Address
+
+	b, err := json.Marshal((*address)(a))
+	if err != nil || len(a.CustomFields) == 0 {
+		return b, err
+	}
+
+	fields := map[string]any{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for k, v := range a.CustomFields {
+		if _, ok := fields[k]; ok {
+			return nil, fmt.Errorf("phpipam: custom field %q conflicts with the address field", k)
+		}
+		fields[k] = v
+	}
+
+	return json.Marshal(fields)
+}
+
+// Hook is the function that adjusts the address payload mapped from the
+// lease.
+type Hook func(*lease.Lease, *Address) error
+
+// Mapper maps the leases to the address payloads.
+type Mapper struct {
+	// The subnet identifier.
+	subnetID string
+	// The mapping hooks.
+	hooks []Hook
+	// The current time function.
+	now func() time.Time
+}
+
+// Option is the mapper option.
+type Option func(*Mapper)
+
+// WithSubnetID option sets the subnet identifier of the addresses.
+func WithSubnetID(id string) Option {
+	return func(m *Mapper) { m.subnetID = id }
+}
+
+// WithHook option adds the mapping hook, the hooks are called in order
+// after the default mapping.
+func WithHook(hook Hook) Option {
+	return func(m *Mapper) { m.hooks = append(m.hooks, hook) }
+}
+
+// WithTime option sets the function that returns the current time used to
+// determine the expired leases.
+func WithTime(now func() time.Time) Option {
+	return func(m *Mapper) { m.now = now }
+}
+
+// New function returns the lease mapper.
+func New(opts ...Option) *Mapper {
+
+	m := &Mapper{now: time.Now}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Map function returns the address payload for the lease. The reservation
+// (the lease that never expires) is tagged as reserved, the expired lease is
+// tagged as offline, otherwise the address is tagged as DHCP. The lease
+// expiration time is stored in the note.
+func (m *Mapper) Map(l *lease.Lease) (*Address, error) {
+
+	if l == nil || l.IPAddress == nil {
+		return nil, fmt.Errorf("phpipam: lease address is empty")
+	}
+
+	addr := &Address{
+		SubnetID:     m.subnetID,
+		IP:           l.IPAddress.String(),
+		Hostname:     strings.TrimSuffix(l.Name, "."),
+		Description:  l.Comment,
+		Tag:          TagDHCP,
+		CustomFields: map[string]string{},
+	}
+
+	if mac := l.MAC(); mac != nil {
+		addr.MAC = mac.String()
+	}
+
+	switch {
+	case l.Never:
+		addr.Tag = TagReserved
+	case l.Expired(m.now()):
+		addr.Tag = TagOffline
+	}
+
+	if !l.Never && !l.Expires.IsZero() {
+		addr.Note = "lease expires " + l.Expires.UTC().Format(TimeFormat) + " UTC"
+	}
+
+	for _, hook := range m.hooks {
+		if err := hook(l, addr); err != nil {
+			return nil, fmt.Errorf("phpipam: map %s: %w", l.IPAddress, err)
+		}
+	}
+
+	return addr, nil
+}
+
+// MapAll function returns the address payloads for the leases.
+func (m *Mapper) MapAll(leases []*lease.Lease) ([]*Address, error) {
+
+	addrs := make([]*Address, 0, len(leases))
+
+	for _, l := range leases {
+		addr, err := m.Map(l)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
@@ -0,0 +1,46 @@
+package phpipam
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm/lease"
+)
+
+func TestMap(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := New(WithSubnetID("7"), WithTime(func() time.Time { return now }),
+		WithHook(func(l *lease.Lease, addr *Address) error {
+			addr.CustomFields["custom_Source"] = "dhcp01"
+			return nil
+		}))
+
+	addrs, err := m.MapAll([]*lease.Lease{
+		// the client identifier in the subnet, hardware type, address format.
+		{IPAddress: net.IPv4(10, 0, 0, 1).To4(), Name: "ws01", Expires: now.Add(time.Hour), HardwareAddress: []byte{10, 0, 0, 0, 1, 0, 1, 2, 3, 4, 5}},
+		{IPAddress: net.IPv4(10, 0, 0, 2).To4(), Never: true},
+		{IPAddress: net.IPv4(10, 0, 0, 3).To4(), Expires: now.Add(-time.Hour)},
+	})
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+
+	for i, tag := range []string{TagDHCP, TagReserved, TagOffline} {
+		if addrs[i].Tag != tag {
+			t.Errorf("address %d: unexpected tag %s", i, addrs[i].Tag)
+		}
+	}
+
+	b, err := json.Marshal(addrs[0])
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if expected := `{"custom_Source":"dhcp01","hostname":"ws01","ip":"10.0.0.1","mac":"00:01:02:03:04:05","note":"lease expires 2024-01-01 01:00:00 UTC","subnetId":"7","tag":"4"}`; string(b) != expected {
+		t.Errorf("unexpected payload: %s", b)
+	}
+}
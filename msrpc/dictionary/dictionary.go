@@ -0,0 +1,326 @@
+//go:generate go run gen.go -d ../ -o ./data/dictionary.json
+
+// The dictionary package contains the machine-readable call dictionary
+// that maps interface UUID and operation number to the protocol name,
+// method name and sensitivity tags.
+//
+// The dictionary is generated from the client stubs and can be used to
+// enrich the DCE/RPC logs produced by network sensors (ie, Zeek dce_rpc.log
+// or ETW RPC events):
+//
+//	if entry, ok := dictionary.Lookup(ifUUID, opNum); ok {
+//		fmt.Println(entry.Protocol, entry.Method, entry.Sensitivity, entry.Tags)
+//	}
+//
+// The raw JSON artifact is available via JSON function, or in the
+// data/dictionary.json file.
+package dictionary
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+//go:embed data/dictionary.json
+var dictionaryJSON []byte
+
+// The sensitivity tags.
+const (
+	// The method reads the data.
+	TagRead = "read"
+	// The method modifies the data.
+	TagWrite = "write"
+	// The method enumerates the objects.
+	TagEnumeration = "enumeration"
+	// The method deals with credentials or secret material.
+	TagCredential = "credential"
+	// The method can be used to execute code.
+	TagExecution = "execution"
+	// The method deals with directory or data replication.
+	TagReplication = "replication"
+	// The method deals with privileges, rights or security descriptors.
+	TagPrivilege = "privilege"
+)
+
+// The sensitivity levels.
+const (
+	SensitivityLow    = "low"
+	SensitivityMedium = "medium"
+	SensitivityHigh   = "high"
+)
+
+// Entry represents the single call dictionary entry.
+type Entry struct {
+	// The interface UUID.
+	InterfaceUUID string `json:"interface_uuid"`
+	// The interface version (major.minor).
+	Version string `json:"version"`
+	// The protocol name (go package name).
+	Protocol string `json:"protocol"`
+	// The interface name.
+	Interface string `json:"interface"`
+	// The go package import path.
+	Package string `json:"package"`
+	// The operation number.
+	OpNum int `json:"opnum"`
+	// The method name.
+	Method string `json:"method"`
+	// The sensitivity level.
+	Sensitivity string `json:"sensitivity"`
+	// The sensitivity tags.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type key struct {
+	ifUUID string
+	opNum  int
+}
+
+var (
+	once    sync.Once
+	entries []*Entry
+	index   map[key]*Entry
+)
+
+// load function decodes the embedded dictionary.
+func load() {
+	once.Do(func() {
+		if err := json.Unmarshal(dictionaryJSON, &entries); err != nil {
+			panic("dictionary: " + err.Error())
+		}
+		index = make(map[key]*Entry, len(entries))
+		for _, entry := range entries {
+			k := key{strings.ToLower(entry.InterfaceUUID), entry.OpNum}
+			// prefer the latest interface version.
+			if prev, ok := index[k]; !ok || versionLess(prev.Version, entry.Version) {
+				index[k] = entry
+			}
+		}
+	})
+}
+
+// parseVersion function parses the interface version (major.minor).
+func parseVersion(v string) (int, int) {
+	major, minor, _ := strings.Cut(v, ".")
+	ma, _ := strconv.Atoi(major)
+	mi, _ := strconv.Atoi(minor)
+	return ma, mi
+}
+
+// versionLess function returns `true` if the interface version `a` is
+// lower than the version `b` ("3.0" < "10.0").
+func versionLess(a, b string) bool {
+	aMajor, aMinor := parseVersion(a)
+	bMajor, bMinor := parseVersion(b)
+	if aMajor != bMajor {
+		return aMajor < bMajor
+	}
+	return aMinor < bMinor
+}
+
+// JSON function returns the raw call dictionary JSON document.
+func JSON() []byte {
+	return dictionaryJSON
+}
+
+// Entries function returns all call dictionary entries.
+func Entries() []*Entry {
+	load()
+	return entries
+}
+
+// Lookup function returns the call dictionary entry for the interface
+// UUID and operation number.
+func Lookup(ifUUID *uuid.UUID, opNum int) (*Entry, bool) {
+	if ifUUID == nil {
+		return nil, false
+	}
+	return LookupString(ifUUID.String(), opNum)
+}
+
+// LookupString function returns the call dictionary entry for the interface
+// UUID string and operation number.
+func LookupString(ifUUID string, opNum int) (*Entry, bool) {
+	load()
+	entry, ok := index[key{strings.ToLower(ifUUID), opNum}]
+	return entry, ok
+}
+
+// words function splits the method name into lower-case words.
+// ("RCreateServiceW" -> "r", "create", "service", "w").
+func words(s string) []string {
+
+	var (
+		ret []string
+		cur []rune
+	)
+
+	rs := []rune(s)
+
+	flush := func() {
+		if len(cur) > 0 {
+			ret = append(ret, strings.ToLower(string(cur)))
+			cur = cur[:0]
+		}
+	}
+
+	for i, r := range rs {
+		switch {
+		case r == '_' || unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(cur) > 0:
+			prev := cur[len(cur)-1]
+			if unicode.IsLower(prev) || (i+1 < len(rs) && unicode.IsLower(rs[i+1])) {
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+
+	flush()
+
+	return ret
+}
+
+var (
+	readWords = map[string]bool{
+		"get": true, "query": true, "enum": true, "enumerate": true, "lookup": true, "open": true,
+		"read": true, "retrieve": true, "inq": true, "list": true, "find": true, "check": true,
+		"test": true, "is": true, "validate": true, "lookups": true,
+	}
+	enumWords = map[string]bool{
+		"enum": true, "enumerate": true, "lookup": true, "list": true, "lookups": true,
+	}
+	writeWords = map[string]bool{
+		"set": true, "create": true, "add": true, "delete": true, "remove": true, "modify": true,
+		"change": true, "update": true, "write": true, "insert": true, "reset": true, "enable": true,
+		"disable": true, "start": true, "stop": true, "register": true, "unregister": true, "put": true,
+		"move": true, "rename": true, "restore": true, "import": true, "shutdown": true, "abort": true,
+		"control": true, "replace": true, "install": true, "uninstall": true, "unjoin": true, "join": true,
+	}
+	credentialWords = map[string]bool{
+		"password": true, "passwords": true, "secret": true, "secrets": true, "credential": true,
+		"credentials": true, "cred": true, "creds": true, "hash": true,
+		"logon": true, "authenticate": true, "trust": true, "certificate": true,
+	}
+	executionWords = map[string]bool{
+		"exec": true, "execute": true, "process": true, "job": true, "task": true, "run": true,
+		"command": true, "script": true,
+	}
+	privilegeWords = map[string]bool{
+		"privilege": true, "privileges": true, "rights": true, "security": true, "acl": true,
+		"owner": true, "permission": true, "permissions": true,
+	}
+)
+
+// overrides is the curated list of the methods whose sensitivity cannot be
+// derived from the method name (the names are unique across the protocols).
+var overrides = map[string][]string{
+	// bkrp: the domain backup key (dpapi) retrieval.
+	"BackuprKey": {TagRead, TagCredential},
+	// csra: the archived private keys.
+	"GetArchivedKey": {TagRead, TagCredential},
+	"ImportKey":      {TagWrite, TagCredential},
+	// drsr: the windows hello for business keys.
+	"IDL_DRSReadNgcKey":  {TagRead, TagCredential},
+	"IDL_DRSWriteNgcKey": {TagWrite, TagCredential},
+	// gkdi: the group key distribution (gmsa, laps passwords).
+	"GetKey": {TagRead, TagCredential},
+	// lsad: the lsa secrets and the trust authentication information.
+	"LsarRetrievePrivateData":          {TagRead, TagCredential},
+	"LsarStorePrivateData":             {TagWrite, TagCredential},
+	"LsarQueryTrustedDomainInfo":       {TagRead, TagCredential},
+	"LsarQueryTrustedDomainInfoByName": {TagRead, TagCredential},
+	"LsarQueryInfoTrustedDomain":       {TagRead, TagCredential},
+	// rrp: the registry hive dump (sam, security).
+	"BaseRegSaveKey":   {TagRead, TagCredential},
+	"BaseRegSaveKeyEx": {TagRead, TagCredential},
+	// samr: the user information (incl. the password hashes).
+	"SamrQueryInformationUser":  {TagRead, TagCredential},
+	"SamrQueryInformationUser2": {TagRead, TagCredential},
+	// scmr: the service binary path change.
+	"RChangeServiceConfigW":  {TagWrite, TagExecution},
+	"RChangeServiceConfigA":  {TagWrite, TagExecution},
+	"RChangeServiceConfig2W": {TagWrite, TagExecution},
+	"RChangeServiceConfig2A": {TagWrite, TagExecution},
+}
+
+// Classify function returns the sensitivity tags for the method name.
+// The tags are taken from the curated list of the overrides if the method
+// is listed there, otherwise, the classification is heuristic and based on
+// the words found in the method name.
+func Classify(method string) []string {
+
+	if tags, ok := overrides[method]; ok {
+		return append([]string(nil), tags...)
+	}
+
+	var (
+		ws   = words(method)
+		tags []string
+		has  = func(set map[string]bool) bool {
+			for _, w := range ws {
+				if set[w] {
+					return true
+				}
+			}
+			return false
+		}
+		lower = strings.ToLower(method)
+	)
+
+	if has(readWords) {
+		tags = append(tags, TagRead)
+	}
+
+	if has(enumWords) {
+		tags = append(tags, TagEnumeration)
+	}
+
+	if has(writeWords) {
+		tags = append(tags, TagWrite)
+	}
+
+	if has(credentialWords) {
+		tags = append(tags, TagCredential)
+	}
+
+	if has(executionWords) || ((strings.Contains(lower, "create") || strings.Contains(lower, "start")) && strings.Contains(lower, "service")) {
+		tags = append(tags, TagExecution)
+	}
+
+	if strings.Contains(lower, "ncchanges") || strings.Contains(lower, "replica") || strings.Contains(lower, "replicate") {
+		tags = append(tags, TagReplication)
+	}
+
+	if has(privilegeWords) {
+		tags = append(tags, TagPrivilege)
+	}
+
+	return tags
+}
+
+// SensitivityFromTags function returns the sensitivity level derived from
+// the set of tags.
+func SensitivityFromTags(tags []string) string {
+
+	level := SensitivityLow
+
+	for _, tag := range tags {
+		switch tag {
+		case TagCredential, TagExecution, TagReplication, TagPrivilege:
+			return SensitivityHigh
+		case TagWrite:
+			level = SensitivityMedium
+		}
+	}
+
+	return level
+}
@@ -0,0 +1,87 @@
+package dictionary
+
+import (
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+
+	entry, ok := LookupString("E1AF8308-5D1F-11C9-91A4-08002B14A0FA", 2)
+	if !ok {
+		t.Fatalf("lookup: ept_lookup not found")
+	}
+
+	if entry.Protocol != "epm" || entry.Method != "ept_lookup" {
+		t.Errorf("lookup: unexpected entry: %+v", entry)
+	}
+
+	if _, ok := LookupString("E1AF8308-5D1F-11C9-91A4-08002B14A0FA", 1000); ok {
+		t.Errorf("lookup: unexpected entry for unknown opnum")
+	}
+
+	if entry, ok := LookupString("AFA8BD80-7D8A-11C9-BEF4-08002B102989", 0); !ok || entry.Method != "rpc__mgmt_inq_if_ids" {
+		t.Errorf("lookup: rpc__mgmt_inq_if_ids not found")
+	}
+}
+
+func TestClassify(t *testing.T) {
+
+	for _, testCase := range []struct {
+		Method      string
+		Sensitivity string
+	}{
+		{"RCreateServiceW", SensitivityHigh},
+		{"SamrSetInformationUser2", SensitivityMedium},
+		{"NetrShareEnum", SensitivityLow},
+		{"IDL_DRSGetNCChanges", SensitivityHigh},
+		// registry keys are not the credentials.
+		{"BaseRegOpenKey", SensitivityLow},
+		{"BaseRegCreateKey", SensitivityMedium},
+		// overrides.
+		{"BaseRegSaveKey", SensitivityHigh},
+		{"LsarRetrievePrivateData", SensitivityHigh},
+		{"SamrQueryInformationUser", SensitivityHigh},
+		{"RChangeServiceConfigW", SensitivityHigh},
+	} {
+		if s := SensitivityFromTags(Classify(testCase.Method)); s != testCase.Sensitivity {
+			t.Errorf("classify %s: expected %s, got %s", testCase.Method, testCase.Sensitivity, s)
+		}
+	}
+}
+
+func TestOverrides(t *testing.T) {
+
+	methods := map[string]int{}
+	for _, entry := range Entries() {
+		methods[entry.Method]++
+	}
+
+	for method := range overrides {
+		if methods[method] == 0 {
+			t.Errorf("override %s: method not found", method)
+		}
+	}
+
+	entry, ok := LookupString("12345778-1234-ABCD-EF00-0123456789AB", 43)
+	if !ok || entry.Method != "LsarRetrievePrivateData" || entry.Sensitivity != SensitivityHigh {
+		t.Errorf("lookup: unexpected entry: %+v", entry)
+	}
+}
+
+func TestVersionLess(t *testing.T) {
+
+	for _, testCase := range []struct {
+		A, B string
+		Less bool
+	}{
+		{"1.0", "2.0", true},
+		{"3.0", "10.0", true},
+		{"10.0", "3.0", false},
+		{"1.2", "1.10", true},
+		{"1.10", "1.10", false},
+	} {
+		if less := versionLess(testCase.A, testCase.B); less != testCase.Less {
+			t.Errorf("version less %s < %s: expected %v", testCase.A, testCase.B, testCase.Less)
+		}
+	}
+}
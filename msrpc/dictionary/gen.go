@@ -0,0 +1,245 @@
+//go:build exclude
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/msrpc/dictionary"
+)
+
+var (
+	dir string
+	out string
+)
+
+func init() {
+	flag.StringVar(&dir, "d", "../", "generated stubs directory")
+	flag.StringVar(&out, "o", "./data/dictionary.json", "json output file")
+	flag.Parse()
+}
+
+// literal function returns the basic literal value of the expression.
+func literal(e ast.Expr) string {
+	if lit, ok := e.(*ast.BasicLit); ok {
+		if s, err := strconv.Unquote(lit.Value); err == nil {
+			return s
+		}
+		return lit.Value
+	}
+	return ""
+}
+
+// integer function returns the integer value of the expression.
+func integer(e ast.Expr) uint64 {
+	n, _ := strconv.ParseUint(literal(e), 0, 64)
+	return n
+}
+
+// returnValue function returns the single return statement value of the
+// function body.
+func returnValue(fn *ast.FuncDecl) ast.Expr {
+	if fn.Body == nil || len(fn.Body.List) != 1 {
+		return nil
+	}
+	if ret, ok := fn.Body.List[0].(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+		return ret.Results[0]
+	}
+	return nil
+}
+
+// parseUUID function parses the uuid.UUID composite literal.
+func parseUUID(lit *ast.CompositeLit) *uuid.UUID {
+
+	u := &uuid.UUID{}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		switch kv.Key.(*ast.Ident).Name {
+		case "TimeLow":
+			u.TimeLow = uint32(integer(kv.Value))
+		case "TimeMid":
+			u.TimeMid = uint16(integer(kv.Value))
+		case "TimeHiAndVersion":
+			u.TimeHiAndVersion = uint16(integer(kv.Value))
+		case "ClockSeqHiAndReserved":
+			u.ClockSeqHiAndReserved = uint8(integer(kv.Value))
+		case "ClockSeqLow":
+			u.ClockSeqLow = uint8(integer(kv.Value))
+		case "Node":
+			for i, v := range kv.Value.(*ast.CompositeLit).Elts {
+				u.Node[i] = uint8(integer(v))
+			}
+		}
+	}
+
+	return u
+}
+
+func parseFile(p string) ([]*dictionary.Entry, error) {
+
+	f, err := parser.ParseFile(token.NewFileSet(), p, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pkg             string
+		uuids           = map[string]*uuid.UUID{}
+		ifUUID          *uuid.UUID
+		vMajor, vMinor  uint16
+		opNums, opNames = map[string]int{}, map[string]string{}
+	)
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok || len(vs.Names) != 1 || len(vs.Values) != 1 {
+					continue
+				}
+				name, val := vs.Names[0].Name, vs.Values[0]
+				if name == "GoPackage" {
+					pkg = literal(val)
+					continue
+				}
+				if u, ok := val.(*ast.UnaryExpr); ok {
+					val = u.X
+				}
+				lit, ok := val.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				switch {
+				case strings.HasSuffix(name, "SyntaxUUID"):
+					uuids[name] = parseUUID(lit)
+				case strings.Contains(name, "SyntaxV") && ifUUID == nil:
+					for _, elt := range lit.Elts {
+						kv := elt.(*ast.KeyValueExpr)
+						switch kv.Key.(*ast.Ident).Name {
+						case "IfUUID":
+							if id, ok := kv.Value.(*ast.Ident); ok {
+								ifUUID = uuids[id.Name]
+							}
+						case "IfVersionMajor":
+							vMajor = uint16(integer(kv.Value))
+						case "IfVersionMinor":
+							vMinor = uint16(integer(kv.Value))
+						}
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) != 1 {
+				continue
+			}
+			star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv, ok := star.X.(*ast.Ident)
+			if !ok || !strings.HasPrefix(recv.Name, "xxx_") || !strings.HasSuffix(recv.Name, "Operation") {
+				continue
+			}
+			switch ret := returnValue(decl); decl.Name.Name {
+			case "OpNum":
+				opNums[recv.Name] = int(integer(ret))
+			case "OpName":
+				opNames[recv.Name] = literal(ret)
+			}
+		}
+	}
+
+	if ifUUID == nil || len(opNames) == 0 {
+		return nil, nil
+	}
+
+	rel, _ := filepath.Rel(dir, filepath.Dir(p))
+
+	var entries []*dictionary.Entry
+
+	for op, opName := range opNames {
+		// opName is of format /interface/vN/method.
+		parts := strings.Split(strings.TrimPrefix(opName, "/"), "/")
+		entry := &dictionary.Entry{
+			InterfaceUUID: ifUUID.String(),
+			Version:       fmt.Sprintf("%d.%d", vMajor, vMinor),
+			Protocol:      pkg,
+			Interface:     parts[0],
+			Package:       "github.com/oiweiwei/go-msrpc/msrpc/" + filepath.ToSlash(rel),
+			OpNum:         opNums[op],
+			Method:        parts[len(parts)-1],
+		}
+		entry.Tags = dictionary.Classify(entry.Method)
+		entry.Sensitivity = dictionary.SensitivityFromTags(entry.Tags)
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func main() {
+
+	var entries []*dictionary.Entry
+
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+			return nil
+		}
+		e, err := parseFile(p)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		entries = append(entries, e...)
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Package != entries[j].Package {
+			return entries[i].Package < entries[j].Package
+		}
+		return entries[i].OpNum < entries[j].OpNum
+	})
+
+	// write one entry per line to keep the artifact diff-friendly.
+	b := []byte("[\n")
+	for i, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if b = append(b, line...); i < len(entries)-1 {
+			b = append(b, ',')
+		}
+		b = append(b, '\n')
+	}
+	b = append(b, "]\n"...)
+
+	if err := os.WriteFile(out, b, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
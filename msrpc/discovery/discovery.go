@@ -0,0 +1,390 @@
+// The discovery package implements the interface discovery for the host: the
+// registrations of the endpoint mapper, the well-known named pipes and the
+// interfaces reported by the remote management interface are merged into the
+// normalized inventory.
+//
+//	inv, err := discovery.Discover(ctx, cfg.ServerAddr(),
+//		append(cfg.DialOptions(ctx), cfg.ClientOptions(ctx)...)...)
+//	if err != nil {
+//		// some discovery steps failed, the partial inventory is returned.
+//	}
+//
+//	for _, iface := range inv.Interfaces {
+//		fmt.Println(iface.UUID, iface.VersionMajor, iface.VersionMinor, iface.Name, iface.Transports)
+//	}
+//
+// The discovery consists of the following steps:
+//
+//   - the endpoint mapper database is enumerated with ept_lookup (see
+//     epm.LookupIterator).
+//
+//   - the remote management interface (rpc__mgmt_inq_if_ids) is queried on
+//     every TCP endpoint registered with the endpoint mapper: the interface
+//     is served on every endpoint of the RPC server, so that the interfaces
+//     that are not registered with the endpoint mapper are discovered.
+//
+//   - the well-known named pipes are probed with the remote management
+//     interface (see DefaultPipes).
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/epm/epm/v3"
+	"github.com/oiweiwei/go-msrpc/msrpc/mgmt/mgmt/v1"
+	"github.com/oiweiwei/go-msrpc/msrpc/well_known"
+)
+
+// Source is the discovery source.
+type Source string
+
+const (
+	// The interface is registered with the endpoint mapper.
+	SourceEndpointMapper Source = "epm"
+	// The interface is reported by the remote management interface on the
+	// endpoint registered with the endpoint mapper.
+	SourceManagement Source = "mgmt"
+	// The interface is reported by the remote management interface on the
+	// well-known named pipe.
+	SourcePipe Source = "pipe"
+)
+
+// DefaultPipes is the list of the well-known named pipes probed by default.
+var DefaultPipes = []string{
+	"epmapper",
+	"lsass",
+	"lsarpc",
+	"samr",
+	"netlogon",
+	"protected_storage",
+	"srvsvc",
+	"wkssvc",
+	"winreg",
+	"svcctl",
+	"atsvc",
+	"eventlog",
+	"spoolss",
+	"efsrpc",
+	"ntsvcs",
+	"browser",
+	"netdfs",
+	"InitShutdown",
+	"cert",
+	"DNSSERVER",
+	"DHCPSERVER",
+	"W32TIME_ALT",
+}
+
+// The default timeout of the single discovery probe.
+var DefaultProbeTimeout = 10 * time.Second
+
+// Interface structure represents the discovered interface.
+type Interface struct {
+	// The interface UUID.
+	UUID *uuid.UUID `json:"uuid"`
+	// The interface version.
+	VersionMajor uint16 `json:"version_major"`
+	VersionMinor uint16 `json:"version_minor"`
+	// The interface name (for the well-known interfaces).
+	Name string `json:"name,omitempty"`
+	// The interface description (for the well-known interfaces).
+	Description string `json:"description,omitempty"`
+	// The endpoint mapper annotation.
+	Annotation string `json:"annotation,omitempty"`
+	// The string bindings the interface is reachable on.
+	Transports []string `json:"transports,omitempty"`
+	// The discovery sources.
+	Sources []Source `json:"sources"`
+}
+
+// SyntaxID function returns the interface syntax identifier.
+func (i *Interface) SyntaxID() *dcerpc.SyntaxID {
+	return &dcerpc.SyntaxID{IfUUID: i.UUID, IfVersionMajor: i.VersionMajor, IfVersionMinor: i.VersionMinor}
+}
+
+// Endpoint structure represents the probed endpoint.
+type Endpoint struct {
+	// The string binding.
+	Binding string `json:"binding"`
+	// The discovery source.
+	Source Source `json:"source"`
+	// The probe error (empty if the endpoint was reachable).
+	Error string `json:"error,omitempty"`
+}
+
+// Inventory structure represents the interfaces discovered on the host.
+type Inventory struct {
+	// The host.
+	Host string `json:"host"`
+	// The discovered interfaces ordered by name, UUID and version.
+	Interfaces []*Interface `json:"interfaces"`
+	// The probed endpoints.
+	Endpoints []*Endpoint `json:"endpoints,omitempty"`
+
+	index map[interfaceKey]*Interface
+}
+
+// interfaceKey is the inventory index key.
+type interfaceKey struct {
+	uuid         uuid.UUID
+	versionMajor uint16
+	versionMinor uint16
+}
+
+// Lookup function returns the discovered versions of the interface.
+func (inv *Inventory) Lookup(u *uuid.UUID) []*Interface {
+
+	var ret []*Interface
+
+	for _, iface := range inv.Interfaces {
+		if *iface.UUID == *u {
+			ret = append(ret, iface)
+		}
+	}
+
+	return ret
+}
+
+// add function adds the interface reported by the source on the transport.
+func (inv *Inventory) add(syntax *dcerpc.SyntaxID, src Source, transport, annotation string) {
+
+	if syntax == nil || syntax.IfUUID == nil {
+		return
+	}
+
+	key := interfaceKey{*syntax.IfUUID, syntax.IfVersionMajor, syntax.IfVersionMinor}
+
+	iface, ok := inv.index[key]
+	if !ok {
+		u := *syntax.IfUUID
+		iface = &Interface{
+			UUID:         &u,
+			VersionMajor: syntax.IfVersionMajor,
+			VersionMinor: syntax.IfVersionMinor,
+			Name:         (*well_known.UUID)(&u).Name(),
+			Description:  (*well_known.UUID)(&u).Describe(),
+		}
+		inv.index[key] = iface
+		inv.Interfaces = append(inv.Interfaces, iface)
+	}
+
+	if iface.Annotation == "" {
+		iface.Annotation = annotation
+	}
+
+	if transport != "" && !contains(iface.Transports, transport) {
+		iface.Transports = append(iface.Transports, transport)
+	}
+
+	if !contains(iface.Sources, src) {
+		iface.Sources = append(iface.Sources, src)
+	}
+}
+
+// sort function orders the interfaces and the transports.
+func (inv *Inventory) sort() {
+
+	for _, iface := range inv.Interfaces {
+		sort.Strings(iface.Transports)
+	}
+
+	sort.SliceStable(inv.Interfaces, func(i, j int) bool {
+		a, b := inv.Interfaces[i], inv.Interfaces[j]
+		if a.Name != b.Name {
+			// the well-known interfaces go first.
+			return a.Name != "" && (b.Name == "" || a.Name < b.Name)
+		}
+		if a.UUID.String() != b.UUID.String() {
+			return a.UUID.String() < b.UUID.String()
+		}
+		if a.VersionMajor != b.VersionMajor {
+			return a.VersionMajor < b.VersionMajor
+		}
+		return a.VersionMinor < b.VersionMinor
+	})
+}
+
+// Discoverer is the interface discovery configuration.
+type Discoverer struct {
+	// The connection and security options (credentials, dialer, and so on).
+	Options []dcerpc.Option
+	// The named pipes to probe (DefaultPipes if empty).
+	Pipes []string
+	// The timeout of the single probe (DefaultProbeTimeout if zero).
+	Timeout time.Duration
+	// Skip the endpoint mapper database enumeration.
+	NoEndpointMapper bool
+	// Skip the remote management interface queries on the endpoints
+	// registered with the endpoint mapper.
+	NoManagement bool
+	// Skip the named pipe probes.
+	NoPipes bool
+}
+
+// Discover function discovers the interfaces on the host with the options
+// `opts` (see Discoverer).
+func Discover(ctx context.Context, host string, opts ...dcerpc.Option) (*Inventory, error) {
+	return (&Discoverer{Options: opts}).Discover(ctx, host)
+}
+
+// Discover function discovers the interfaces on the host. The discovery steps
+// that failed are skipped, and the inventory is returned along with the error.
+func (d *Discoverer) Discover(ctx context.Context, host string) (*Inventory, error) {
+
+	inv := &Inventory{Host: host, index: make(map[interfaceKey]*Interface)}
+
+	opts := d.Options
+
+	o, err := dcerpc.ParseOptions(ctx, opts...)
+	if errors.Is(err, dcerpc.ErrNoSecurityContext) {
+		// the endpoint mapper and the remote management interface
+		// usually accept the unauthenticated calls.
+		opts = append(opts, dcerpc.WithInsecure())
+	} else if err != nil {
+		return nil, fmt.Errorf("discovery: parse options: %w", err)
+	}
+
+	dialOpts := []dcerpc.Option{}
+
+	for _, opt := range opts {
+		if opt, ok := opt.(dcerpc.ConnectOption); ok {
+			dialOpts = append(dialOpts, opt)
+		}
+	}
+
+	conn, err := dcerpc.Dial(ctx, host, append(dialOpts, well_known.EndpointMapper())...)
+	if err != nil {
+		return nil, fmt.Errorf("discovery: dial: %w", err)
+	}
+
+	defer conn.Close(ctx)
+
+	var errs []error
+
+	// the endpoints registered with the endpoint mapper.
+	var endpoints []string
+
+	if !d.NoEndpointMapper {
+		if endpoints, err = d.lookup(ctx, conn, inv, opts); err != nil {
+			o.Logger.Debug().Err(err).Msg("discovery: endpoint mapper")
+			errs = append(errs, err)
+		}
+	}
+
+	if !d.NoManagement {
+		for _, endpoint := range endpoints {
+			d.query(ctx, conn, inv, SourceManagement, "ncacn_ip_tcp:"+host+"["+endpoint+"]", opts)
+		}
+	}
+
+	if !d.NoPipes {
+		pipes := d.Pipes
+		if len(pipes) == 0 {
+			pipes = DefaultPipes
+		}
+		for _, pipe := range pipes {
+			d.query(ctx, conn, inv, SourcePipe, "ncacn_np:"+host+"["+pipe+"]", opts)
+		}
+	}
+
+	inv.sort()
+
+	return inv, errors.Join(errs...)
+}
+
+// probe function returns the context for the single probe.
+func (d *Discoverer) probe(ctx context.Context) (context.Context, context.CancelFunc) {
+
+	timeout := d.Timeout
+	if timeout <= 0 {
+		timeout = DefaultProbeTimeout
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// lookup function enumerates the endpoint mapper database, and returns the
+// registered TCP endpoints.
+func (d *Discoverer) lookup(ctx context.Context, conn dcerpc.Conn, inv *Inventory, opts []dcerpc.Option) ([]string, error) {
+
+	ctx, cancel := d.probe(ctx)
+	defer cancel()
+
+	cli, err := epm.NewEpmClient(ctx, conn, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("discovery: endpoint mapper: %w", err)
+	}
+
+	entries, err := epm.LookupAll(ctx, cli, &epm.LookupFilter{InquiryType: epm.InquiryAll})
+
+	var endpoints []string
+
+	for _, entry := range entries {
+
+		sb := entry.Binding.StringBinding
+
+		inv.add(&entry.Binding.SyntaxID, SourceEndpointMapper, sb.String(), entry.Annotation)
+
+		if sb.ProtocolSequence == dcerpc.ProtocolSequenceIPTCP && sb.Endpoint != "" && !contains(endpoints, sb.Endpoint) {
+			endpoints = append(endpoints, sb.Endpoint)
+		}
+	}
+
+	if err != nil {
+		return endpoints, fmt.Errorf("discovery: endpoint mapper: %w", err)
+	}
+
+	return endpoints, nil
+}
+
+// query function queries the remote management interface on the endpoint, and
+// records the endpoint probe result.
+func (d *Discoverer) query(ctx context.Context, conn dcerpc.Conn, inv *Inventory, src Source, binding string, opts []dcerpc.Option) {
+
+	endpoint := &Endpoint{Binding: binding, Source: src}
+
+	inv.Endpoints = append(inv.Endpoints, endpoint)
+
+	syntaxes, err := d.inquire(ctx, conn, binding, opts)
+	if err != nil {
+		endpoint.Error = err.Error()
+		return
+	}
+
+	for _, syntax := range syntaxes {
+		inv.add(syntax, src, binding, "")
+	}
+}
+
+// inquire function returns the interfaces reported by the remote management
+// interface on the endpoint.
+func (d *Discoverer) inquire(ctx context.Context, conn dcerpc.Conn, binding string, opts []dcerpc.Option) ([]*dcerpc.SyntaxID, error) {
+
+	ctx, cancel := d.probe(ctx)
+	defer cancel()
+
+	cli, err := mgmt.NewManagementClient(ctx, conn, append(opts, dcerpc.WithEndpoint(binding))...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mgmt.InquireInterfaceIDs(ctx, cli)
+}
+
+// contains function returns `true` if the value is in the list.
+func contains[T ~string](list []T, v T) bool {
+	for i := range list {
+		if strings.EqualFold(string(list[i]), string(v)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/epm/epm/v3"
+	"github.com/oiweiwei/go-msrpc/msrpc/mgmt/mgmt/v1"
+	"github.com/oiweiwei/go-msrpc/msrpc/well_known"
+)
+
+var (
+	samrSyntax   = &dcerpc.SyntaxID{IfUUID: &well_known.MSSAMRSamr, IfVersionMajor: 1}
+	customSyntax = &dcerpc.SyntaxID{IfUUID: uuid.New(0x12345678, 0x1234, 0x1234, 0x12, 0x34, [6]byte{1, 2, 3, 4, 5, 6}), IfVersionMajor: 2, IfVersionMinor: 1}
+)
+
+// testEpmServer is the endpoint mapper that returns the samr registration.
+type testEpmServer struct {
+	epm.EpmServer
+}
+
+func (s *testEpmServer) Lookup(ctx context.Context, in *epm.LookupRequest) (*epm.LookupResponse, error) {
+
+	tower, err := dcetypes.NewTower(&dcerpc.Binding{
+		SyntaxID:      *samrSyntax,
+		StringBinding: dcerpc.StringBinding{ProtocolSequence: dcerpc.ProtocolSequenceIPTCP, NetworkAddress: "10.0.0.1", Endpoint: "49664"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &epm.LookupResponse{
+		EntryHandle:   &epm.LookupHandle{},
+		EntriesLength: 1,
+		Entries:       []*epm.Entry{{Object: &dtyp.GUID{}, Tower: tower, Annotation: "SAM access\x00"}},
+	}, nil
+}
+
+// testMgmtServer is the management interface that reports the samr and the
+// interface not registered with the endpoint mapper.
+type testMgmtServer struct {
+	mgmt.ManagementServer
+}
+
+func (s *testMgmtServer) ManagementInquireInterfaceIDs(ctx context.Context, in *mgmt.ManagementInquireInterfaceIDsRequest) (*mgmt.ManagementInquireInterfaceIDsResponse, error) {
+
+	var ids []*dcetypes.InterfaceID
+
+	for _, syntax := range []*dcerpc.SyntaxID{samrSyntax, customSyntax} {
+		ids = append(ids, &dcetypes.InterfaceID{
+			UUID:      dtyp.GUIDFromUUID(syntax.IfUUID),
+			VersMajor: syntax.IfVersionMajor,
+			VersMinor: syntax.IfVersionMinor,
+		})
+	}
+
+	return &mgmt.ManagementInquireInterfaceIDsResponse{
+		InterfaceIDVector: &mgmt.InterfaceIDVector{Count: uint32(len(ids)), InterfaceID: ids},
+	}, nil
+}
+
+func TestDiscover(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(epm.EpmSyntaxV3_0, epm.NewEpmServerHandle(&testEpmServer{}))
+	srv.Register(mgmt.ManagementSyntaxV1_0, mgmt.NewManagementServerHandle(&testMgmtServer{}))
+
+	go srv.Serve(ln)
+
+	d := &Discoverer{Options: []dcerpc.Option{dcerpc.WithDialer(ln)}, NoPipes: true}
+
+	inv, err := d.Discover(ctx, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("discover: %v", err)
+	}
+
+	if len(inv.Interfaces) != 2 || len(inv.Endpoints) != 1 || inv.Endpoints[0].Error != "" {
+		t.Fatalf("unexpected inventory: %d interfaces, %d endpoints", len(inv.Interfaces), len(inv.Endpoints))
+	}
+
+	samr := inv.Lookup(samrSyntax.IfUUID)
+	if len(samr) != 1 || samr[0].Name != "samr" || samr[0].Annotation != "SAM access" || len(samr[0].Sources) != 2 || len(samr[0].Transports) != 2 {
+		t.Fatalf("unexpected samr: %+v", samr)
+	}
+
+	custom := inv.Interfaces[1]
+	if !custom.SyntaxID().Is(customSyntax) || custom.VersionMinor != 1 || custom.Sources[0] != SourceManagement {
+		t.Fatalf("unexpected interface: %+v", custom)
+	}
+}
@@ -602,6 +602,9 @@ func (o *IPv4Array) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AddrArray", len(o.AddrArray), o.AddrCount, "AddrCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -899,6 +902,9 @@ func (o *AddrArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AddrArray", len(o.AddrArray), o.AddrCount, "AddrCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1003,6 +1009,9 @@ func (o *Buffer) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.Length, "Length"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4775,6 +4784,12 @@ func (o *ZoneListW2K) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ZoneCount", o.ZoneCount, 0, 500000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ZoneArray", len(o.ZoneArray), o.ZoneCount, "ZoneCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4951,6 +4966,12 @@ func (o *ZoneList_NET) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ZoneCount", o.ZoneCount, 0, 500000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ZoneArray", len(o.ZoneArray), o.ZoneCount, "ZoneCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5112,6 +5133,12 @@ func (o *ZoneList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ZoneCount", o.ZoneCount, 0, 500000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ZoneArray", len(o.ZoneArray), o.ZoneCount, "ZoneCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5533,6 +5560,12 @@ func (o *TrustPointList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("TrustPointCount", o.TrustPointCount, 0, 500000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("TrustPointArray", len(o.TrustPointArray), o.TrustPointCount, "TrustPointCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7156,6 +7189,9 @@ func (o *ZoneDNSSECSettings) UnmarshalNDR(ctx context.Context, w ndr.Reader) err
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ZoneSKDArray", len(o.ZoneSKDArray), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7407,6 +7443,9 @@ func (o *TrustAnchor) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RRData", len(o.RRData), o.RRLength, "RRLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7557,6 +7596,12 @@ func (o *TrustAnchorList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckRange("TrustAnchorCount", o.TrustAnchorCount, 0, 500000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("TrustAnchorArray", len(o.TrustAnchorArray), o.TrustAnchorCount, "TrustAnchorCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7801,6 +7846,12 @@ func (o *DPList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("DPCount", o.DPCount, 0, 5000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("DPArray", len(o.DPArray), o.DPCount, "DPCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8193,6 +8244,12 @@ func (o *DPInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ReplicaCount", o.ReplicaCount, 0, 10000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ArrayReplica", len(o.ArrayReplica), o.ReplicaCount, "ReplicaCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13173,6 +13230,12 @@ func (o *SKDList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SKDArray", len(o.SKDArray), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13809,6 +13872,9 @@ func (o *Record) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13948,6 +14014,9 @@ func (o *FlatRecord) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14315,6 +14384,9 @@ func (o *UTF8StringList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Strings, _s_pszStrings, _ptr_pszStrings); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14451,6 +14523,9 @@ func (o *UnicodeStringList) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadPointer(&o.Strings, _s_pwszStrings, _ptr_pwszStrings); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15021,6 +15096,12 @@ func (o *PolicyContentList) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ContentCount", o.ContentCount, 0, 50000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Content", len(o.Content), o.ContentCount, "ContentCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15393,6 +15474,12 @@ func (o *Policy) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CriteriaCount", o.CriteriaCount, 0, 50000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("CriteriaList", len(o.CriteriaList), o.CriteriaCount, "CriteriaCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15618,6 +15705,12 @@ func (o *EnumeratePolicyList) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PolicyCount", o.PolicyCount, 0, 50000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("PolicyArray", len(o.PolicyArray), o.PolicyCount, "PolicyCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16244,6 +16337,9 @@ func (o *EnumVirtualizationInstanceList) UnmarshalNDR(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("VirtualizationInstanceArray", len(o.VirtualizationInstanceArray), o.VirtualizationInstanceCount, "VirtualizationInstanceCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17027,6 +17123,9 @@ func (o *EnumZoneScopeList) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ZoneScopeArray", len(o.ZoneScopeArray), o.ZoneScopeCount, "ZoneScopeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18213,6 +18312,9 @@ func (o *EnumScopeList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ScopeArray", len(o.ScopeArray), o.ScopeCount, "ScopeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
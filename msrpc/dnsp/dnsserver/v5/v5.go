@@ -2625,6 +2625,9 @@ func (o *xxx_EnumRecordsOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4613,6 +4616,9 @@ func (o *xxx_EnumRecords2Operation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5937,6 +5943,9 @@ func (o *xxx_EnumRecords3Operation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9092,6 +9101,9 @@ func (o *xxx_EnumRecords4Operation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
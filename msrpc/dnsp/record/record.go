@@ -527,6 +527,9 @@ func (o *Record) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1052,7 +1052,7 @@ func (o *DSName) xxx_PreparePayload(ctx context.Context) error {
 			o.NameLength = uint32(0)
 		}
 	}
-	if len(o.StringName) > int(10485761) {
+	if ndr.UTF16NLen(o.StringName) > uint64(10485761) {
 		return fmt.Errorf("StringName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -1184,6 +1184,9 @@ func (o *DSName) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 		}
 	}
 	o.StringName = strings.TrimRight(string(utf16.Decode(_StringName_buf)), ndr.ZeroString)
+	if err := ndr.CheckRange("StringName", ndr.UTF16NLen(o.StringName), 0, 10485761); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1447,6 +1450,12 @@ func (o *UpToDateVectorV1Ext) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CursorsCount", o.CursorsCount, 0, 1048576); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Cursors", len(o.Cursors), o.CursorsCount, "CursorsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1556,6 +1565,9 @@ func (o *OID) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Elements, _s_elements, _ptr_elements); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1730,6 +1742,9 @@ func (o *SchemaPrefixTable) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadPointer(&o.PrefixEntry, _s_pPrefixEntry, _ptr_pPrefixEntry); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("PrefixCount", o.PrefixCount, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1851,6 +1866,12 @@ func (o *PartialAttributeVectorV1Ext) UnmarshalNDR(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckRange("AttrsCount", o.AttrsCount, 1, 1048576); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("PartialAttribute", len(o.PartialAttribute), o.AttrsCount, "AttrsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1965,6 +1986,12 @@ func (o *MTXAddr) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("MTXNameLength", o.MTXNameLength, 1, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("MTXName", len(o.MTXName), o.MTXNameLength, "MTXNameLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2074,6 +2101,9 @@ func (o *AttributeValue) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Value, _s_pVal, _ptr_pVal); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValueLength", o.ValueLength, 0, 26214400); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2191,6 +2221,9 @@ func (o *AttributeValueBlock) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 	if err := w.ReadPointer(&o.Values, _s_pAVal, _ptr_pAVal); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValueCount", o.ValueCount, 0, 10485760); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2364,6 +2397,9 @@ func (o *AttributeBlock) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Attribute, _s_pAttr, _ptr_pAttr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AttributeCount", o.AttributeCount, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2656,6 +2692,12 @@ func (o *PropertyMetadataExtVector) UnmarshalNDR(ctx context.Context, w ndr.Read
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PropertiesCount", o.PropertiesCount, 0, 1048576); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Metadata", len(o.Metadata), o.PropertiesCount, "PropertiesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3041,6 +3083,12 @@ func (o *UpToDateVectorV2Ext) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CursorsCount", o.CursorsCount, 0, 1048576); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Cursors", len(o.Cursors), o.CursorsCount, "CursorsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6754,6 +6802,9 @@ func (o *DSReplicationNeighbors) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Neighbor", len(o.Neighbor), o.NeighborsCount, "NeighborsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6931,6 +6982,9 @@ func (o *DSReplicationCursors) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Cursor", len(o.Cursor), o.CursorsCount, "CursorsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7280,6 +7334,9 @@ func (o *DSReplicationKCCDSAFailures) UnmarshalNDR(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DSAFailure", len(o.DSAFailure), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7400,6 +7457,9 @@ func (o *DSReplicationObjectMetadata) UnmarshalNDR(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Metadata", len(o.Metadata), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7757,6 +7817,9 @@ func (o *DSReplicationPendingOperations) UnmarshalNDR(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PendingOperation", len(o.PendingOperation), o.PendingOperationsCount, "PendingOperationsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8134,6 +8197,9 @@ func (o *DSReplicationAttributeValueMetadata) UnmarshalNDR(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Metadata", len(o.Metadata), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8329,6 +8395,9 @@ func (o *DSReplicationCursors2) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Cursor", len(o.Cursor), o.CursorsCount, "CursorsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8551,6 +8620,9 @@ func (o *DSReplicationCursorsV3) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Cursor", len(o.Cursor), o.CursorsCount, "CursorsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8818,6 +8890,9 @@ func (o *DSReplicationObjectMetadata2) UnmarshalNDR(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Metadata", len(o.Metadata), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9223,6 +9298,9 @@ func (o *DSReplicationAttributeValueMetadata2) UnmarshalNDR(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Metadata", len(o.Metadata), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9623,6 +9701,12 @@ func (o *Extensions) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Length", o.Length, 1, 10000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Length, "Length"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10807,6 +10891,9 @@ func (o *MessageGetNCChangesReplyV6) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadData(&o.DRSError); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11188,6 +11275,9 @@ func (o *MessageGetNCChangesReplyV9) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadData(&o.DRSError); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12214,6 +12304,9 @@ func (o *VarSizeBufferWithVersion) UnmarshalNDR(ctx context.Context, w ndr.Reade
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15842,6 +15935,9 @@ func (o *MessageVerifyRequestV1) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := o.PrefixTable.UnmarshalNDR(ctx, w); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("NamesCount", o.NamesCount, 1, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16111,6 +16207,9 @@ func (o *MessageVerifyReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := o.PrefixTable.UnmarshalNDR(ctx, w); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("NamesCount", o.NamesCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16491,6 +16590,9 @@ func (o *MessageReverseMembershipRequestV1) UnmarshalNDR(ctx context.Context, w
 	if err := w.ReadPointer(&o.LimitingDomain, _s_pLimitingDomain, _ptr_pLimitingDomain); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("DSNamesCount", o.DSNamesCount, 1, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16947,6 +17049,12 @@ func (o *MessageReverseMembershipReplyV1) UnmarshalNDR(ctx context.Context, w nd
 	if err := w.ReadPointer(&o.SIDHistory, _s_ppSidHistory, _ptr_ppSidHistory); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("DSNamesCount", o.DSNamesCount, 0, 10000); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SIDHistoryCount", o.SIDHistoryCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17385,6 +17493,9 @@ func (o *SecurityBuffer) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Buffer, _s_pvBuffer, _ptr_pvBuffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17511,6 +17622,9 @@ func (o *SecurityBufferDesc) UnmarshalNDR(ctx context.Context, w ndr.Reader) err
 	if err := w.ReadPointer(&o.Buffers, _s_Buffers, _ptr_Buffers); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("BuffersCount", o.BuffersCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18546,6 +18660,9 @@ func (o *MessageCrackNamesRequestV1) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadPointer(&o.Names, _s_rpNames, _ptr_rpNames); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("NamesCount", o.NamesCount, 1, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19001,6 +19118,9 @@ func (o *MessageNT4ChangeLogRequestV1) UnmarshalNDR(ctx context.Context, w ndr.R
 	if err := w.ReadPointer(&o.Restart, _s_pRestart, _ptr_pRestart); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RestartLength", o.RestartLength, 0, 10485760); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19476,6 +19596,12 @@ func (o *MessageNT4ChangeLogReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadPointer(&o.Log, _s_pLog, _ptr_pLog); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RestartLength", o.RestartLength, 0, 10485760); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LogLength", o.LogLength, 0, 10485760); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19771,6 +19897,9 @@ func (o *MessageWriteSPNRequestV1) UnmarshalNDR(ctx context.Context, w ndr.Reade
 	if err := w.ReadPointer(&o.SPN, _s_rpwszSPN, _ptr_rpwszSPN); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("SPNCount", o.SPNCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21116,6 +21245,9 @@ func (o *MessageDCInfoReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.Items, _s_rItems, _ptr_rItems); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ItemsCount", o.ItemsCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21234,6 +21366,9 @@ func (o *MessageDCInfoReplyV2) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.Items, _s_rItems, _ptr_rItems); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ItemsCount", o.ItemsCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21352,6 +21487,9 @@ func (o *MessageDCInfoReplyV3) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.Items, _s_rItems, _ptr_rItems); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ItemsCount", o.ItemsCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21471,6 +21609,9 @@ func (o *MessageDCInfoReplyVQ) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.Items, _s_rItems, _ptr_rItems); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ItemsCount", o.ItemsCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22575,6 +22716,9 @@ func (o *MessageAddEntryReplyV2) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.InfoList, _s_infoList, _ptr_infoList); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ObjectsAddedCount", o.ObjectsAddedCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22985,6 +23129,9 @@ func (o *MessageAddEntryReplyV3) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.InfoList, _s_infoList, _ptr_infoList); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ObjectsAddedCount", o.ObjectsAddedCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23630,6 +23777,12 @@ func (o *DSReplicationClientContexts) UnmarshalNDR(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ContextsCount", o.ContextsCount, 0, 10000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Context", len(o.Context), o.ContextsCount, "ContextsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23935,6 +24088,12 @@ func (o *DSReplicationServerOutgoingCalls) UnmarshalNDR(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CallsCount", o.CallsCount, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Call", len(o.Call), o.CallsCount, "CallsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25986,6 +26145,15 @@ func (o *MessageAddSIDHistoryRequestV1) UnmarshalNDR(ctx context.Context, w ndr.
 	if err := w.ReadPointer(&o.DestinationPrincipal, _s_DstPrincipal, _ptr_DstPrincipal); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("SourceCredsUserLength", o.SourceCredsUserLength, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SourceCredsDomainLength", o.SourceCredsDomainLength, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SourceCredsPasswordLength", o.SourceCredsPasswordLength, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26389,6 +26557,9 @@ func (o *MessageGetMemberships2RequestV1) UnmarshalNDR(ctx context.Context, w nd
 	if err := w.ReadPointer(&o.Requests, _s_Requests, _ptr_Requests); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 1, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26631,6 +26802,9 @@ func (o *MessageGetMemberships2ReplyV1) UnmarshalNDR(ctx context.Context, w ndr.
 	if err := w.ReadPointer(&o.Replies, _s_Replies, _ptr_Replies); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27373,6 +27547,9 @@ func (o *MessageExistReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 	if err := w.ReadPointer(&o.GUIDs, _s_rgGuids, _ptr_rgGuids); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("GUIDsCount", o.GUIDsCount, 0, 10485760); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27660,6 +27837,9 @@ func (o *MessageQuerySitesRequestV1) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadData(&o.Flags); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ToSitesCount", o.ToSitesCount, 1, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27960,6 +28140,9 @@ func (o *MessageQuerySitesReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Reade
 	if err := w.ReadData(&o.Flags); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ToSitesCount", o.ToSitesCount, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29601,6 +29784,9 @@ func (o *MessageAddCloneDCReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Reade
 	if err := w.ReadPointer(&o.NewDCAccountPassword, _s_pwsNewDCAccountPassword, _ptr_pwsNewDCAccountPassword); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("PasswordLengthCount", o.PasswordLengthCount, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29854,6 +30040,9 @@ func (o *MessageWriteNGCKeyRequestV1) UnmarshalNDR(ctx context.Context, w ndr.Re
 	if err := w.ReadPointer(&o.NGCKey, _s_pNgcKey, _ptr_pNgcKey); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("NGCKeyCount", o.NGCKeyCount, 0, 65535); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -30411,6 +30600,9 @@ func (o *MessageReadNGCKeyReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Reade
 	if err := w.ReadPointer(&o.NGCKey, _s_pNgcKey, _ptr_pNgcKey); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("NGCKeyCount", o.NGCKeyCount, 0, 65535); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -169,6 +169,9 @@ func (o *MessageExecuteScriptRequestV1) UnmarshalNDR(ctx context.Context, w ndr.
 	if err := w.ReadPointer(&o.Password, _s_pbPassword, _ptr_pbPassword); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("PasswordLength", o.PasswordLength, 1, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -948,6 +951,15 @@ func (o *MessagePrepareScriptReplyV1) UnmarshalNDR(ctx context.Context, w ndr.Re
 	if err := w.ReadPointer(&o.HashSignature, _s_pbHashSignature, _ptr_pbHashSignature); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("PasswordLength", o.PasswordLength, 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("HashBodyLength", o.HashBodyLength, 0, 10485760); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("HashSignatureLength", o.HashSignatureLength, 0, 10485760); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,105 @@
+package dtyp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AccessRight is the named access right (or the named set of the access
+// rights, like the generic access mapping).
+type AccessRight struct {
+	// The access right name, for example, USER_READ_GENERAL.
+	Name string
+	// The access mask.
+	Mask uint32
+}
+
+// AccessRights is the list of the named access rights for the object type.
+// The combined access rights must precede the access rights they consist of.
+type AccessRights []AccessRight
+
+// StandardAccessRights is the list of the standard and generic access rights
+// that are common for all object types.
+var StandardAccessRights = AccessRights{
+	{Name: "GENERIC_READ", Mask: AccessMaskGenericRead},
+	{Name: "GENERIC_WRITE", Mask: AccessMaskGenericWrite},
+	{Name: "GENERIC_EXECUTE", Mask: AccessMaskGenericExecute},
+	{Name: "GENERIC_ALL", Mask: AccessMaskGenericAll},
+	{Name: "MAXIMUM_ALLOWED", Mask: AccessMaskMaximumAllowed},
+	{Name: "ACCESS_SYSTEM_SECURITY", Mask: AccessMaskAccessSystemSecurity},
+	{Name: "SYNCHRONIZE", Mask: AccessMaskSynchronize},
+	{Name: "WRITE_OWNER", Mask: AccessMaskWriteOwner},
+	{Name: "WRITE_DAC", Mask: AccessMaskWriteDACL},
+	{Name: "READ_CONTROL", Mask: AccessMaskReadControl},
+	{Name: "DELETE", Mask: AccessMaskDelete},
+}
+
+// Names function returns the names of the access rights set in the access
+// mask `m`, followed by the standard access rights. The bits that have no
+// name are returned as the hex value.
+func (r AccessRights) Names(m uint32) []string {
+
+	var names []string
+
+	for _, rights := range []AccessRights{r, StandardAccessRights} {
+		for _, right := range rights {
+			if right.Mask != 0 && m&right.Mask == right.Mask {
+				names, m = append(names, right.Name), m&^right.Mask
+			}
+		}
+	}
+
+	if m != 0 {
+		names = append(names, fmt.Sprintf("0x%08x", m))
+	}
+
+	return names
+}
+
+// Describe function returns the access mask `m` description, for example,
+// USER_READ_GENERAL|USER_FORCE_PASSWORD_CHANGE|DELETE.
+func (r AccessRights) Describe(m uint32) string {
+	if m == 0 {
+		return "0"
+	}
+	return strings.Join(r.Names(m), "|")
+}
+
+// Parse function parses the access mask description (see Describe). The
+// names are case-insensitive and can be separated by '|' or ','.
+func (r AccessRights) Parse(s string) (uint32, error) {
+
+	var m uint32
+
+	for _, name := range strings.FieldsFunc(s, func(c rune) bool { return c == '|' || c == ',' }) {
+
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+
+		v, ok := r.lookup(name)
+		if !ok {
+			u, err := strconv.ParseUint(name, 0, 32)
+			if err != nil {
+				return 0, fmt.Errorf("parse access mask: unknown access right %q", name)
+			}
+			v = uint32(u)
+		}
+
+		m |= v
+	}
+
+	return m, nil
+}
+
+func (r AccessRights) lookup(name string) (uint32, bool) {
+	for _, rights := range []AccessRights{r, StandardAccessRights} {
+		for _, right := range rights {
+			if strings.EqualFold(right.Name, name) {
+				return right.Mask, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,40 @@
+package dtyp_test
+
+import (
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/samr"
+)
+
+func TestAccessRights(t *testing.T) {
+
+	for _, tc := range []struct {
+		m uint32
+		s string
+	}{
+		{0, "0"},
+		{uint32(samr.UserReadGeneric | samr.UserForcePasswordChange), "USER_READ|USER_FORCE_PASSWORD_CHANGE"},
+		{uint32(samr.UserAllAccess), "USER_ALL_ACCESS"},
+		{uint32(samr.UserReadGeneral) | dtyp.AccessMaskDelete | dtyp.AccessMaskMaximumAllowed, "USER_READ_GENERAL|MAXIMUM_ALLOWED|DELETE"},
+		{uint32(samr.UserChangePassword) | 0x00008000, "USER_CHANGE_PASSWORD|0x00008000"},
+	} {
+
+		if s := samr.UserAccessRights.Describe(tc.m); s != tc.s {
+			t.Fatalf("expected %q, got %q", tc.s, s)
+		}
+
+		m, err := samr.UserAccessRights.Parse(tc.s)
+		if err != nil || m != tc.m {
+			t.Fatalf("parse %q: expected 0x%08x, got 0x%08x: %v", tc.s, tc.m, m, err)
+		}
+	}
+
+	if m, err := samr.UserAccessRights.Parse("user_read_general, read_control"); err != nil || m != 0x00020001 {
+		t.Fatalf("unexpected access mask 0x%08x: %v", m, err)
+	}
+
+	if _, err := samr.UserAccessRights.Parse("USER_FLY"); err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -6767,6 +6767,9 @@ func (o *SID) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SubAuthority", len(o.SubAuthority), o.SubAuthorityCount, "SubAuthorityCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7018,6 +7021,9 @@ func (o *ACL) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ACEEntries", len(o.ACEEntries), o.ACECount, "ACECount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20,6 +20,28 @@ func (g *GUID) MarshalJSON() ([]byte, error) {
 	return json.Marshal(g.String())
 }
 
+// UnmarshalJSON function decodes the GUID string (see MarshalJSON).
+func (g *GUID) UnmarshalJSON(b []byte) error {
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*g = GUID{}
+		return nil
+	}
+
+	u, err := uuid.Parse(s)
+	if err != nil {
+		return fmt.Errorf("guid: %w", err)
+	}
+
+	*g = *GUIDFromUUID(u)
+	return nil
+}
+
 func GUIDFromBytes(b []byte) (*GUID, error) {
 
 	u := &uuid.UUID{}
@@ -0,0 +1,40 @@
+package dtyp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+
+	sid, err := dtyp.ParseSID("S-1-5-21-1004336348-1177238915-682003330-512")
+	if err != nil {
+		t.Fatalf("parse sid: %v", err)
+	}
+
+	v := struct {
+		GUID *dtyp.GUID `json:"guid"`
+		SID  *dtyp.SID  `json:"sid"`
+	}{
+		GUID: &dtyp.GUID{Data1: 0x12345678, Data2: 0x9abc, Data3: 0xdef0, Data4: []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+		SID:  sid,
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	out := v
+	out.GUID, out.SID = nil, nil
+
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if out.GUID.String() != v.GUID.String() || out.SID.String() != v.SID.String() {
+		t.Fatalf("expected %s %s, got %s %s", v.GUID, v.SID, out.GUID, out.SID)
+	}
+}
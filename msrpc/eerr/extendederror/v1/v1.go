@@ -1323,6 +1323,9 @@ func (o *ExtendedErrorInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Params", len(o.Params), o.Length, "Length"); err != nil {
+		return err
+	}
 	return nil
 }
 
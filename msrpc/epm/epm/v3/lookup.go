@@ -0,0 +1,312 @@
+package epm
+
+// lookup.go contains the enumeration of the endpoint mapper database with
+// the ept_lookup handle continuation.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/well_known"
+)
+
+// The ept_lookup inquiry types.
+const (
+	// RPC_C_EP_ALL_ELTS.
+	InquiryAll uint32 = 0x00000000
+	// RPC_C_EP_MATCH_BY_IF.
+	InquiryByInterface uint32 = 0x00000001
+	// RPC_C_EP_MATCH_BY_OBJ.
+	InquiryByObject uint32 = 0x00000002
+	// RPC_C_EP_MATCH_BY_BOTH.
+	InquiryByBoth uint32 = 0x00000003
+)
+
+// The ept_lookup interface version options.
+const (
+	// RPC_C_VERS_ALL.
+	VersionAll uint32 = 0x00000001
+	// RPC_C_VERS_COMPATIBLE.
+	VersionCompatible uint32 = 0x00000002
+	// RPC_C_VERS_EXACT.
+	VersionExact uint32 = 0x00000003
+	// RPC_C_VERS_MAJOR_ONLY.
+	VersionMajorOnly uint32 = 0x00000004
+	// RPC_C_VERS_UPTO.
+	VersionUpTo uint32 = 0x00000005
+)
+
+// The default number of the entries requested with the single ept_lookup call.
+var DefaultLookupBatchSize uint32 = 100
+
+// LookupFilter structure represents the ept_lookup filter.
+type LookupFilter struct {
+	// The inquiry type (InquiryAll if zero).
+	InquiryType uint32
+	// The object UUID (for InquiryByObject and InquiryByBoth).
+	Object *uuid.UUID
+	// The interface syntax identifier (for InquiryByInterface and
+	// InquiryByBoth).
+	Interface *dcerpc.SyntaxID
+	// The interface version option (VersionAll if zero).
+	VersionOption uint32
+	// The number of the entries requested with the single call
+	// (DefaultLookupBatchSize if zero).
+	BatchSize uint32
+}
+
+// LookupEntry structure represents the endpoint mapper database entry.
+type LookupEntry struct {
+	// The object UUID (nil if not set).
+	Object *uuid.UUID `json:"object,omitempty"`
+	// The annotation.
+	Annotation string `json:"annotation,omitempty"`
+	// The interface name (for the well-known interfaces).
+	Name string `json:"name,omitempty"`
+	// The binding (the interface and transfer syntax identifiers, and the
+	// string binding) decoded from the tower.
+	Binding *dcerpc.Binding `json:"binding"`
+	// The tower.
+	Tower *dcetypes.Tower `json:"tower"`
+}
+
+// String function returns the string representation of the entry.
+func (e *LookupEntry) String() string {
+
+	var b strings.Builder
+
+	b.WriteString(e.Binding.StringBinding.String())
+
+	if e.Binding.SyntaxID.IfUUID != nil {
+		fmt.Fprintf(&b, " %s v%d.%d", e.Binding.SyntaxID.IfUUID, e.Binding.SyntaxID.IfVersionMajor, e.Binding.SyntaxID.IfVersionMinor)
+	}
+
+	if e.Name != "" {
+		fmt.Fprintf(&b, " (%s)", e.Name)
+	}
+
+	if e.Object != nil {
+		fmt.Fprintf(&b, " object %s", e.Object)
+	}
+
+	if e.Annotation != "" {
+		fmt.Fprintf(&b, " %q", e.Annotation)
+	}
+
+	return b.String()
+}
+
+// LookupIterator is the iterator over the endpoint mapper database entries.
+// The entries are requested in batches, the ept_lookup calls are chained with
+// the lookup handle returned by the previous call:
+//
+//	it := epm.NewLookupIterator(cli, &epm.LookupFilter{})
+//	defer it.Close(ctx)
+//
+//	for it.Next(ctx) {
+//		fmt.Println(it.Entry())
+//	}
+//
+//	if err := it.Err(); err != nil {
+//		// handle error.
+//	}
+type LookupIterator struct {
+	cli    EpmClient
+	filter LookupFilter
+	opts   []dcerpc.CallOption
+	// The lookup handle.
+	handle *LookupHandle
+	// The current batch of the entries.
+	batch []*LookupEntry
+	entry *LookupEntry
+	done  bool
+	err   error
+}
+
+// NewLookupIterator function returns the iterator over the endpoint mapper
+// database entries that match the filter.
+func NewLookupIterator(cli EpmClient, filter *LookupFilter, opts ...dcerpc.CallOption) *LookupIterator {
+
+	it := &LookupIterator{cli: cli, opts: opts}
+
+	if filter != nil {
+		it.filter = *filter
+	}
+
+	return it
+}
+
+// Next function advances the iterator to the next entry, and returns `false`
+// when the entries are exhausted or the error occurred (see Err).
+func (it *LookupIterator) Next(ctx context.Context) bool {
+
+	for len(it.batch) == 0 {
+		if it.done || it.err != nil {
+			it.entry = nil
+			return false
+		}
+		it.err = it.lookup(ctx)
+	}
+
+	it.entry, it.batch = it.batch[0], it.batch[1:]
+
+	return true
+}
+
+// Entry function returns the current entry.
+func (it *LookupIterator) Entry() *LookupEntry {
+	return it.entry
+}
+
+// Err function returns the error that stopped the iteration.
+func (it *LookupIterator) Err() error {
+	return it.err
+}
+
+// Close function releases the lookup handle if the iteration was not
+// completed.
+func (it *LookupIterator) Close(ctx context.Context) error {
+
+	it.done, it.batch = true, nil
+
+	if it.handle == nil || it.handle.ContextHandle().IsZero() {
+		return nil
+	}
+
+	handle := it.handle
+	it.handle = nil
+
+	resp, err := it.cli.LookupHandleFree(ctx, &LookupHandleFreeRequest{EntryHandle: handle}, it.opts...)
+	if err != nil {
+		return fmt.Errorf("epm: lookup handle free: %w", err)
+	}
+
+	if resp.Status != 0 {
+		return fmt.Errorf("epm: lookup handle free: status 0x%08x", resp.Status)
+	}
+
+	return nil
+}
+
+// lookup function requests the next batch of the entries.
+func (it *LookupIterator) lookup(ctx context.Context) error {
+
+	req := &LookupRequest{
+		InquiryType: it.filter.InquiryType,
+		VersOption:  it.filter.VersionOption,
+		EntryHandle: it.handle,
+		MaxEntries:  it.filter.BatchSize,
+	}
+
+	if req.VersOption == 0 {
+		req.VersOption = VersionAll
+	}
+
+	if req.MaxEntries == 0 {
+		req.MaxEntries = DefaultLookupBatchSize
+	}
+
+	if req.EntryHandle == nil {
+		req.EntryHandle = &LookupHandle{}
+	}
+
+	if it.filter.Object != nil {
+		req.Object = dtyp.GUIDFromUUID(it.filter.Object)
+	}
+
+	if it.filter.Interface != nil && it.filter.Interface.IfUUID != nil {
+		req.InterfaceID = &dcetypes.InterfaceID{
+			UUID:      dtyp.GUIDFromUUID(it.filter.Interface.IfUUID),
+			VersMajor: it.filter.Interface.IfVersionMajor,
+			VersMinor: it.filter.Interface.IfVersionMinor,
+		}
+	}
+
+	resp, err := it.cli.Lookup(ctx, req, it.opts...)
+	if err != nil {
+		return fmt.Errorf("epm: lookup: %w", err)
+	}
+
+	switch resp.Status {
+	case 0:
+	case dcerpc.StatusEptNotRegistered, dcerpc.StatusDCEEptNotRegistered:
+		// no more entries.
+		it.done = true
+	default:
+		return fmt.Errorf("epm: lookup: status 0x%08x", resp.Status)
+	}
+
+	for i := 0; i < int(resp.EntriesLength) && i < len(resp.Entries); i++ {
+		if entry := newLookupEntry(resp.Entries[i]); entry != nil {
+			it.batch = append(it.batch, entry)
+		}
+	}
+
+	if it.handle = resp.EntryHandle; it.handle == nil || it.handle.ContextHandle().IsZero() {
+		// the server has released the lookup handle.
+		it.done, it.handle = true, nil
+	}
+
+	if !it.done && resp.EntriesLength == 0 {
+		return errors.New("epm: lookup: no entries returned for the active lookup handle")
+	}
+
+	return nil
+}
+
+// newLookupEntry function converts the ept_lookup entry.
+func newLookupEntry(e *Entry) *LookupEntry {
+
+	if e == nil || e.Tower == nil {
+		return nil
+	}
+
+	entry := &LookupEntry{
+		Annotation: strings.TrimRight(e.Annotation, "\x00"),
+		Binding:    e.Tower.Binding(),
+		Tower:      e.Tower,
+	}
+
+	if !e.Object.IsZero() {
+		entry.Object = e.Object.UUID()
+	}
+
+	if u := entry.Binding.SyntaxID.IfUUID; u != nil {
+		entry.Name = (*well_known.UUID)(u).Name()
+	}
+
+	return entry
+}
+
+// LookupAll function returns all endpoint mapper database entries that match
+// the filter.
+func LookupAll(ctx context.Context, cli EpmClient, filter *LookupFilter, opts ...dcerpc.CallOption) ([]*LookupEntry, error) {
+
+	it := NewLookupIterator(cli, filter, opts...)
+	defer it.Close(ctx)
+
+	var entries []*LookupEntry
+
+	for it.Next(ctx) {
+		entries = append(entries, it.Entry())
+	}
+
+	return entries, it.Err()
+}
+
+// AfterPrepareResponsePayload function sets the conformant size of the entries
+// array, since the max_ents is the request parameter and is not known to the
+// server handler that marshals the response.
+func (o *xxx_LookupOperation) AfterPrepareResponsePayload(ctx context.Context) error {
+	if o.MaxEntries < o.EntriesLength {
+		o.MaxEntries = o.EntriesLength
+	}
+	return nil
+}
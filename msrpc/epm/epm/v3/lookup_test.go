@@ -0,0 +1,104 @@
+package epm
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// testLookupClient is the endpoint mapper client that returns `entries`
+// in batches.
+type testLookupClient struct {
+	EpmClient
+	entries []*Entry
+	calls   int
+	freed   int
+}
+
+func (c *testLookupClient) Lookup(ctx context.Context, in *LookupRequest, opts ...dcerpc.CallOption) (*LookupResponse, error) {
+
+	c.calls++
+
+	off := 0
+	if !in.EntryHandle.ContextHandle().IsZero() {
+		off = int(in.EntryHandle.Attributes)
+	}
+
+	n := min(int(in.MaxEntries), len(c.entries)-off)
+	if n == 0 {
+		return &LookupResponse{EntryHandle: &LookupHandle{}, Status: dcerpc.StatusEptNotRegistered}, nil
+	}
+
+	resp := &LookupResponse{
+		EntryHandle:   &LookupHandle{Attributes: uint32(off + n), UUID: &dtyp.GUID{Data1: 1}},
+		EntriesLength: uint32(n),
+		Entries:       c.entries[off : off+n],
+	}
+
+	return resp, nil
+}
+
+func (c *testLookupClient) LookupHandleFree(ctx context.Context, in *LookupHandleFreeRequest, opts ...dcerpc.CallOption) (*LookupHandleFreeResponse, error) {
+	c.freed++
+	return &LookupHandleFreeResponse{EntryHandle: &LookupHandle{}}, nil
+}
+
+func testEntry(port uint16) *Entry {
+
+	ifUUID := uuid.New(0x12345778, 0x1234, 0xabcd, 0xef, 0x00, [6]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab})
+
+	portData := binary.BigEndian.AppendUint16(nil, port)
+
+	return &Entry{
+		Object:     &dtyp.GUID{},
+		Annotation: "test\x00",
+		Tower: dcetypes.FloorsToTower([]*dcetypes.Floor{
+			{Protocol: uint8(dcetypes.ProtocolUUID), UUID: ifUUID, VersionMajor: 1, Data: []byte{0, 0}},
+			{Protocol: uint8(dcetypes.ProtocolRPC_CO), Data: []byte{0, 0}},
+			{Protocol: uint8(dcetypes.ProtocolTCP), Data: portData},
+			{Protocol: uint8(dcetypes.ProtocolIP), Data: []byte{10, 0, 0, 1}},
+		}),
+	}
+}
+
+func TestLookupIterator(t *testing.T) {
+
+	ctx := context.Background()
+
+	cli := &testLookupClient{}
+	for i := 0; i < 5; i++ {
+		cli.entries = append(cli.entries, testEntry(uint16(49664+i)))
+	}
+
+	entries, err := LookupAll(ctx, cli, &LookupFilter{BatchSize: 2})
+	if err != nil {
+		t.Fatalf("lookup all: %v", err)
+	}
+
+	if len(entries) != 5 || cli.calls != 4 || cli.freed != 0 {
+		t.Fatalf("unexpected lookup: %d entries, %d calls, %d freed", len(entries), cli.calls, cli.freed)
+	}
+
+	e := entries[4]
+
+	if e.Object != nil || e.Annotation != "test" || e.Binding.StringBinding.Endpoint != "49668" || e.Binding.StringBinding.NetworkAddress != "10.0.0.1" {
+		t.Fatalf("unexpected entry: %s", e)
+	}
+
+	// the interrupted iteration releases the lookup handle.
+	it := NewLookupIterator(cli, &LookupFilter{BatchSize: 2})
+
+	if !it.Next(ctx) || it.Entry() == nil {
+		t.Fatalf("next: %v", it.Err())
+	}
+
+	if err := it.Close(ctx); err != nil || cli.freed != 1 || it.Next(ctx) {
+		t.Fatalf("close: %v (freed %d)", err, cli.freed)
+	}
+}
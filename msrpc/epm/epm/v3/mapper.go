@@ -3,6 +3,8 @@ package epm
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/oiweiwei/go-msrpc/dcerpc"
 
@@ -130,3 +132,118 @@ func (m *Mapper) Map(ctx context.Context, in *dcerpc.Binding) ([]dcerpc.StringBi
 
 	return ret, nil
 }
+
+// AutoEndpointMapper function returns the option that sets the endpoint mapper
+// that queries the endpoint mapper of the host the binding is resolved for (see
+// AutoMapper):
+//
+//	conn, err := dcerpc.Dial(ctx, "dc01.contoso.net", epm.AutoEndpointMapper(dcerpc.WithSign()))
+func AutoEndpointMapper(opts ...dcerpc.Option) dcerpc.Option {
+	return dcerpc.WithEndpointMapper(NewAutoMapper(opts...))
+}
+
+// FallbackEndpointMapper function returns the option that sets the endpoint
+// mapper that queries the endpoint mapper of the host the binding is resolved for
+// (see AutoMapper), and falls back to the well-known endpoints if the endpoint
+// mapper cannot be reached (see well_known.FallbackMapper):
+//
+//	conn, err := dcerpc.Dial(ctx, "dc01.contoso.net", epm.FallbackEndpointMapper(dcerpc.WithSign()))
+func FallbackEndpointMapper(opts ...dcerpc.Option) dcerpc.Option {
+	return well_known.FallbackEndpointMapper(NewAutoMapper(opts...))
+}
+
+// AutoMapper is the endpoint mapper that queries the endpoint mapper (port 135
+// or \pipe\epmapper) of the host the binding is resolved for. The endpoint mapper
+// connection is established with the first lookup for the host and is reused for
+// the later lookups, the connection is re-established if the lookup fails.
+type AutoMapper struct {
+	// The endpoint mapper connection options.
+	opts    []dcerpc.Option
+	mu      sync.Mutex
+	mappers map[string]*Mapper
+}
+
+// NewAutoMapper function returns the new endpoint mapper that queries the
+// endpoint mapper of the target host with the options `opts`.
+func NewAutoMapper(opts ...dcerpc.Option) *AutoMapper {
+	return &AutoMapper{opts: opts, mappers: make(map[string]*Mapper)}
+}
+
+// mapper function returns the endpoint mapper for the host.
+func (m *AutoMapper) mapper(ctx context.Context, host string) *Mapper {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := strings.ToLower(host)
+
+	if mapper, ok := m.mappers[key]; ok {
+		return mapper
+	}
+
+	mapper := NewMapper(ctx, host, m.opts...).(*Mapper)
+	if mapper.err == nil {
+		m.mappers[key] = mapper
+	}
+
+	return mapper
+}
+
+// drop function closes and drops the endpoint mapper for the host.
+func (m *AutoMapper) drop(ctx context.Context, host string, mapper *Mapper) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if key := strings.ToLower(host); m.mappers[key] == mapper {
+		delete(m.mappers, key)
+	}
+
+	if mapper.cli != nil {
+		mapper.cli.Conn().Close(ctx)
+	}
+}
+
+// Map function maps the binding with the endpoint mapper of the binding host.
+func (m *AutoMapper) Map(ctx context.Context, in *dcerpc.Binding) ([]dcerpc.StringBinding, error) {
+
+	host := in.StringBinding.NetworkAddress
+	if host == "" {
+		host = in.StringBinding.ComputerName
+	}
+
+	if host == "" {
+		return nil, fmt.Errorf("endpoint mapper: host is not set")
+	}
+
+	mapper := m.mapper(ctx, host)
+
+	ret, err := mapper.Map(ctx, in)
+	if err != nil && mapper.err == nil {
+		// re-establish the endpoint mapper connection with the next lookup.
+		m.drop(ctx, host, mapper)
+	}
+
+	return ret, err
+}
+
+// Close function closes the endpoint mapper connections.
+func (m *AutoMapper) Close(ctx context.Context) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, mapper := range m.mappers {
+		if mapper.cli != nil {
+			mapper.cli.Conn().Close(ctx)
+		}
+		delete(m.mappers, key)
+	}
+
+	return nil
+}
+
+var (
+	// interface guard.
+	_ dcerpc.EndpointMapper = (*AutoMapper)(nil)
+)
@@ -467,6 +467,9 @@ func (o *xxx_InsertOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 		}
 		o.Replace = _bReplace != 0
 	}
+	if err := ndr.CheckSize("Entries", len(o.Entries), o.EntriesLength, "EntriesLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -675,6 +678,9 @@ func (o *xxx_DeleteOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Entries", len(o.Entries), o.EntriesLength, "EntriesLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
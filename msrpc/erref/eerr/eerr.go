@@ -0,0 +1,222 @@
+// The eerr package decodes the extended error information (MS-EERR) attached
+// by the server to the fault. Import the package to attach the decoded error
+// records to the fault errors:
+//
+//	import _ "github.com/oiweiwei/go-msrpc/msrpc/erref/eerr"
+//
+//	// ...
+//	if _, err := cli.NetrShareEnum(ctx, req); err != nil {
+//		var eeInfo *eerr.Error
+//		if errors.As(err, &eeInfo) {
+//			for _, rec := range eeInfo.Records {
+//				fmt.Println(rec.GeneratingComponent, rec.DetectionLocation, rec.Status, rec.Params)
+//			}
+//		}
+//	}
+package eerr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+
+	extendederror "github.com/oiweiwei/go-msrpc/msrpc/eerr/extendederror/v1"
+)
+
+func init() {
+	errors.SetExtendedErrorDecoder(Decode)
+}
+
+// The maximum number of the error records decoded from the chain.
+const MaxRecords = 64
+
+// Component is the generating component identifier.
+type Component uint32
+
+// The generating components.
+const (
+	ComponentApplication      Component = 1
+	ComponentRuntime          Component = 2
+	ComponentSecurityProvider Component = 3
+	ComponentNPFS             Component = 4
+	ComponentRDR              Component = 5
+	ComponentNMP              Component = 6
+	ComponentIO               Component = 7
+	ComponentWinsock          Component = 8
+	ComponentAuthz            Component = 9
+	ComponentLPC              Component = 10
+)
+
+func (c Component) String() string {
+	switch c {
+	case ComponentApplication:
+		return "application"
+	case ComponentRuntime:
+		return "rpc runtime"
+	case ComponentSecurityProvider:
+		return "security provider"
+	case ComponentNPFS:
+		return "npfs"
+	case ComponentRDR:
+		return "rdr"
+	case ComponentNMP:
+		return "nmp"
+	case ComponentIO:
+		return "io"
+	case ComponentWinsock:
+		return "winsock"
+	case ComponentAuthz:
+		return "authz"
+	case ComponentLPC:
+		return "lpc"
+	}
+	return fmt.Sprintf("component(%d)", uint32(c))
+}
+
+// Record is the extended error record.
+type Record struct {
+	// The network node on which the error occurred (empty if the
+	// record was generated on the server).
+	ComputerName string `json:"computer_name,omitempty"`
+	// The ID of the process in which the error occurred.
+	ProcessID uint32 `json:"process_id"`
+	// The time at which the error occurred.
+	Time time.Time `json:"time"`
+	// The component where the error occurred.
+	GeneratingComponent Component `json:"generating_component"`
+	// The error code.
+	Status uint32 `json:"status"`
+	// The error for the error code (see "github.com/oiweiwei/go-msrpc/msrpc/erref"
+	// packages).
+	Err error `json:"-"`
+	// The location where the error occurred.
+	DetectionLocation uint16 `json:"detection_location"`
+	// The flags (see extendederror.ExtendedErrorInfo).
+	Flags uint16 `json:"flags"`
+	// The parameters (string, int32, int16, int64 or []byte values).
+	Params []any `json:"params,omitempty"`
+}
+
+// String function returns the string representation of the record.
+func (r *Record) String() string {
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s: location %d: %v", r.GeneratingComponent, r.DetectionLocation, r.Err)
+	if len(r.Params) > 0 {
+		fmt.Fprintf(&b, ": params %v", r.Params)
+	}
+	if r.ComputerName != "" {
+		fmt.Fprintf(&b, " (computer %s, pid %d)", r.ComputerName, r.ProcessID)
+	} else {
+		fmt.Fprintf(&b, " (pid %d)", r.ProcessID)
+	}
+
+	return b.String()
+}
+
+// Error is the extended error information. The first record is the error
+// reported by the server, each next record is the cause of the previous one.
+type Error struct {
+	// The error records.
+	Records []*Record `json:"records"`
+	// The decoded extended error information.
+	Info *extendederror.ExtendedErrorInfo `json:"-"`
+}
+
+func (e *Error) Error() string {
+	recs := make([]string, len(e.Records))
+	for i := range e.Records {
+		recs[i] = e.Records[i].String()
+	}
+	return "eerr: " + strings.Join(recs, ": caused by: ")
+}
+
+// Decode function decodes the extended error information (the type serialized
+// ExtendedErrorInfoPtr).
+func Decode(ctx context.Context, b []byte) (error, error) {
+
+	var info *extendederror.ExtendedErrorInfo
+
+	if err := ndr.UnmarshalWithTypeSerializationV1(b, ndr.UnmarshalNDRFunc(func(ctx context.Context, r ndr.Reader) error {
+		return r.ReadPointer(&info, func(ptr any) { info = *ptr.(**extendederror.ExtendedErrorInfo) },
+			ndr.UnmarshalNDRFunc(func(ctx context.Context, r ndr.Reader) error {
+				info = &extendederror.ExtendedErrorInfo{}
+				return info.UnmarshalNDR(ctx, r)
+			}))
+	})); err != nil {
+		return nil, fmt.Errorf("eerr: decode: %w", err)
+	}
+
+	if info == nil {
+		return nil, fmt.Errorf("eerr: decode: empty extended error information")
+	}
+
+	eeInfo := &Error{Info: info}
+
+	for rec := info; rec != nil && len(eeInfo.Records) < MaxRecords; rec = rec.Next {
+		eeInfo.Records = append(eeInfo.Records, NewRecord(ctx, rec))
+	}
+
+	return eeInfo, nil
+}
+
+// NewRecord function returns the record for the extended error information
+// entry.
+func NewRecord(ctx context.Context, info *extendederror.ExtendedErrorInfo) *Record {
+
+	rec := &Record{
+		ProcessID:           info.ProcessID,
+		GeneratingComponent: Component(info.GeneratingComponent),
+		Status:              info.Status,
+		Err:                 errors.New(ctx, info.Status),
+		DetectionLocation:   info.DetectionLocation,
+		Flags:               info.Flags,
+	}
+
+	if info.Timestamp > 0 {
+		rec.Time = time.Unix(0, (info.Timestamp-116444736000000000)*100).UTC()
+	}
+
+	if info.ComputerName != nil && info.ComputerName.ComputerName != nil {
+		if name, ok := info.ComputerName.ComputerName.Value.(*extendederror.ComputerName_Name); ok {
+			rec.ComputerName = unicodeString(name.Name)
+		}
+	}
+
+	for _, param := range info.Params {
+		if param == nil {
+			continue
+		}
+		switch v := param.ExtendedErrorParam.GetValue().(type) {
+		case *extendederror.ANSIString:
+			if v != nil {
+				rec.Params = append(rec.Params, strings.TrimRight(string(v.String), "\x00"))
+			}
+		case *extendederror.UnicodeString:
+			rec.Params = append(rec.Params, unicodeString(v))
+		case *extendederror.BinaryInfo:
+			if v != nil {
+				rec.Params = append(rec.Params, v.Blob)
+			}
+		case nil:
+		default:
+			rec.Params = append(rec.Params, v)
+		}
+	}
+
+	return rec
+}
+
+// unicodeString function returns the string without the terminating NULL.
+func unicodeString(s *extendederror.UnicodeString) string {
+	if s == nil {
+		return ""
+	}
+	return strings.TrimRight(string(utf16.Decode(s.String)), "\x00")
+}
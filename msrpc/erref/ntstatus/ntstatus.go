@@ -33,3 +33,8 @@ func (Mapper) MapValue(ctx context.Context, value any) error {
 func (e *Error) Error() string {
 	return fmt.Sprintf("ntstatus: %s (0x%08x): %s", e.Name, e.Code, e.Details)
 }
+
+// StatusCode function returns the error code.
+func (e *Error) StatusCode() uint32 {
+	return e.Code
+}
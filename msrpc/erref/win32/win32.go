@@ -33,3 +33,8 @@ type Error struct {
 func (e *Error) Error() string {
 	return fmt.Sprintf("win32: %s (0x%08x): %s", e.Name, e.Code, e.Details)
 }
+
+// StatusCode function returns the error code.
+func (e *Error) StatusCode() uint32 {
+	return e.Code
+}
@@ -40,3 +40,8 @@ type Error struct {
 func (e *Error) Error() string {
 	return fmt.Sprintf("wmi: %s (0x%08x)", e.Name, e.Code)
 }
+
+// StatusCode function returns the error code.
+func (e *Error) StatusCode() uint32 {
+	return e.Code
+}
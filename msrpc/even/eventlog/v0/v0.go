@@ -3743,6 +3743,18 @@ func (o *xxx_ReportEventWOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("StringsLength", o.StringsLength, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataSize", o.DataSize, 0, 61440); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Strings", len(o.Strings), o.StringsLength, "StringsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6006,6 +6018,18 @@ func (o *xxx_ReportEventAOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("StringsLength", o.StringsLength, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataSize", o.DataSize, 0, 61440); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Strings", len(o.Strings), o.StringsLength, "StringsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6326,6 +6350,9 @@ func (o *xxx_GetLogInformationOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6974,6 +7001,18 @@ func (o *xxx_ReportEventAndSourceWOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("StringsLength", o.StringsLength, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataSize", o.DataSize, 0, 61440); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Strings", len(o.Strings), o.StringsLength, "StringsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7638,6 +7677,18 @@ func (o *xxx_ReportEventExWOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("StringsLength", o.StringsLength, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataSize", o.DataSize, 0, 61440); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Strings", len(o.Strings), o.StringsLength, "StringsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8260,6 +8311,18 @@ func (o *xxx_ReportEventExAOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("StringsLength", o.StringsLength, 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataSize", o.DataSize, 0, 61440); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Strings", len(o.Strings), o.StringsLength, "StringsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
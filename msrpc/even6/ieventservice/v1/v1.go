@@ -800,6 +800,9 @@ func (o *BooleanArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Array, _s_ptr, _ptr_ptr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -909,6 +912,9 @@ func (o *Uint32Array) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Array, _s_ptr, _ptr_ptr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1018,6 +1024,9 @@ func (o *Uint64Array) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Array, _s_ptr, _ptr_ptr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1147,6 +1156,9 @@ func (o *StringArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Array, _s_ptr, _ptr_ptr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 4096); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1265,6 +1277,9 @@ func (o *GUIDArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Array, _s_ptr, _ptr_ptr); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 131072); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2198,6 +2213,9 @@ func (o *VariantList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Properties, _s_props, _ptr_props); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2686,13 +2704,13 @@ func (o *xxx_RegisterRemoteSubscriptionOperation) OpName() string {
 }
 
 func (o *xxx_RegisterRemoteSubscriptionOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ChannelPath) > int(512) {
+	if ndr.UTF16NLen(o.ChannelPath) > uint64(512) {
 		return fmt.Errorf("ChannelPath is out of range")
 	}
-	if len(o.Query) > int(1048576) {
+	if ndr.UTF16NLen(o.Query) > uint64(1048576) {
 		return fmt.Errorf("Query is out of range")
 	}
-	if len(o.BookmarkXML) > int(1048576) {
+	if ndr.UTF16NLen(o.BookmarkXML) > uint64(1048576) {
 		return fmt.Errorf("BookmarkXML is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -2809,6 +2827,15 @@ func (o *xxx_RegisterRemoteSubscriptionOperation) UnmarshalNDRRequest(ctx contex
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ChannelPath", ndr.UTF16NLen(o.ChannelPath), 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Query", ndr.UTF16NLen(o.Query), 0, 1048576); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BookmarkXML", ndr.UTF16NLen(o.BookmarkXML), 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3001,6 +3028,12 @@ func (o *xxx_RegisterRemoteSubscriptionOperation) UnmarshalNDRResponse(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("QueryChannelInfo", len(o.QueryChannelInfo), 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("QueryChannelInfo", len(o.QueryChannelInfo), o.QueryChannelInfoSize, "QueryChannelInfoSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3516,6 +3549,24 @@ func (o *xxx_RemoteSubscriptionNextAsyncOperation) UnmarshalNDRResponse(ctx cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("EventDataIndices", len(o.EventDataIndices), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventDataIndices", len(o.EventDataIndices), o.ActualRecordsLength, "ActualRecordsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("EventDataSizes", len(o.EventDataSizes), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventDataSizes", len(o.EventDataSizes), o.ActualRecordsLength, "ActualRecordsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ResultBuffer", len(o.ResultBuffer), 0, 2097152); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultBuffer", len(o.ResultBuffer), o.ResultBufferSize, "ResultBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4002,6 +4053,24 @@ func (o *xxx_RemoteSubscriptionNextOperation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("EventDataIndices", len(o.EventDataIndices), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventDataIndices", len(o.EventDataIndices), o.ActualRecordsLength, "ActualRecordsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("EventDataSizes", len(o.EventDataSizes), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventDataSizes", len(o.EventDataSizes), o.ActualRecordsLength, "ActualRecordsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ResultBuffer", len(o.ResultBuffer), 0, 2097152); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultBuffer", len(o.ResultBuffer), o.ResultBufferSize, "ResultBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4441,10 +4510,10 @@ func (o *xxx_RegisterLogQueryOperation) OpName() string {
 }
 
 func (o *xxx_RegisterLogQueryOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Path) > int(32768) {
+	if ndr.UTF16NLen(o.Path) > uint64(32768) {
 		return fmt.Errorf("Path is out of range")
 	}
-	if len(o.Query) > int(1048576) {
+	if ndr.UTF16NLen(o.Query) > uint64(1048576) {
 		return fmt.Errorf("Query is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -4524,6 +4593,12 @@ func (o *xxx_RegisterLogQueryOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Path", ndr.UTF16NLen(o.Path), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Query", ndr.UTF16NLen(o.Query), 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4716,6 +4791,12 @@ func (o *xxx_RegisterLogQueryOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("QueryChannelInfo", len(o.QueryChannelInfo), 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("QueryChannelInfo", len(o.QueryChannelInfo), o.QueryChannelInfoSize, "QueryChannelInfoSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4882,10 +4963,10 @@ func (o *xxx_ClearLogOperation) OpNum() int { return 6 }
 func (o *xxx_ClearLogOperation) OpName() string { return "/IEventService/v1/EvtRpcClearLog" }
 
 func (o *xxx_ClearLogOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ChannelPath) > int(512) {
+	if ndr.UTF16NLen(o.ChannelPath) > uint64(512) {
 		return fmt.Errorf("ChannelPath is out of range")
 	}
-	if len(o.BackupPath) > int(32768) {
+	if ndr.UTF16NLen(o.BackupPath) > uint64(32768) {
 		return fmt.Errorf("BackupPath is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -4986,6 +5067,12 @@ func (o *xxx_ClearLogOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ChannelPath", ndr.UTF16NLen(o.ChannelPath), 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BackupPath", ndr.UTF16NLen(o.BackupPath), 0, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5146,13 +5233,13 @@ func (o *xxx_ExportLogOperation) OpNum() int { return 7 }
 func (o *xxx_ExportLogOperation) OpName() string { return "/IEventService/v1/EvtRpcExportLog" }
 
 func (o *xxx_ExportLogOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ChannelPath) > int(512) {
+	if ndr.UTF16NLen(o.ChannelPath) > uint64(512) {
 		return fmt.Errorf("ChannelPath is out of range")
 	}
-	if len(o.Query) > int(1048576) {
+	if ndr.UTF16NLen(o.Query) > uint64(1048576) {
 		return fmt.Errorf("Query is out of range")
 	}
-	if len(o.BackupPath) > int(32768) {
+	if ndr.UTF16NLen(o.BackupPath) > uint64(32768) {
 		return fmt.Errorf("BackupPath is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -5265,6 +5352,15 @@ func (o *xxx_ExportLogOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ChannelPath", ndr.UTF16NLen(o.ChannelPath), 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Query", ndr.UTF16NLen(o.Query), 0, 1048576); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BackupPath", ndr.UTF16NLen(o.BackupPath), 0, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5458,7 +5554,7 @@ func (o *xxx_LocalizeExportLogOperation) OpName() string {
 }
 
 func (o *xxx_LocalizeExportLogOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.LogFilePath) > int(32768) {
+	if ndr.UTF16NLen(o.LogFilePath) > uint64(32768) {
 		return fmt.Errorf("LogFilePath is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -5534,6 +5630,9 @@ func (o *xxx_LocalizeExportLogOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("LogFilePath", ndr.UTF16NLen(o.LogFilePath), 0, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5864,6 +5963,12 @@ func (o *xxx_MessageRenderOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("SizeEventID", o.SizeEventID, 1, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventID", len(o.EventID), o.SizeEventID, "SizeEventID"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6017,6 +6122,12 @@ func (o *xxx_MessageRenderOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("String", len(o.String), 0, 2097152); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("String", len(o.String), o.ActualSizeString, "ActualSizeString"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6346,6 +6457,12 @@ func (o *xxx_MessageRenderDefaultOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("SizeEventID", o.SizeEventID, 1, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventID", len(o.EventID), o.SizeEventID, "SizeEventID"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6499,6 +6616,12 @@ func (o *xxx_MessageRenderDefaultOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("String", len(o.String), 0, 2097152); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("String", len(o.String), o.ActualSizeString, "ActualSizeString"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7029,6 +7152,24 @@ func (o *xxx_QueryNextOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckRange("EventDataIndices", len(o.EventDataIndices), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventDataIndices", len(o.EventDataIndices), o.ActualRecordsLength, "ActualRecordsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("EventDataSizes", len(o.EventDataSizes), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventDataSizes", len(o.EventDataSizes), o.ActualRecordsLength, "ActualRecordsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ResultBuffer", len(o.ResultBuffer), 0, 2097152); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ResultBuffer", len(o.ResultBuffer), o.ResultBufferSize, "ResultBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7158,7 +7299,7 @@ func (o *xxx_QuerySeekOperation) OpNum() int { return 12 }
 func (o *xxx_QuerySeekOperation) OpName() string { return "/IEventService/v1/EvtRpcQuerySeek" }
 
 func (o *xxx_QuerySeekOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.BookmarkXML) > int(1048576) {
+	if ndr.UTF16NLen(o.BookmarkXML) > uint64(1048576) {
 		return fmt.Errorf("BookmarkXML is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -7271,6 +7412,9 @@ func (o *xxx_QuerySeekOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BookmarkXML", ndr.UTF16NLen(o.BookmarkXML), 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7798,7 +7942,7 @@ func (o *xxx_AssertConfigOperation) OpNum() int { return 15 }
 func (o *xxx_AssertConfigOperation) OpName() string { return "/IEventService/v1/EvtRpcAssertConfig" }
 
 func (o *xxx_AssertConfigOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Path) > int(512) {
+	if ndr.UTF16NLen(o.Path) > uint64(512) {
 		return fmt.Errorf("Path is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -7841,6 +7985,9 @@ func (o *xxx_AssertConfigOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Path", ndr.UTF16NLen(o.Path), 0, 512); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7969,7 +8116,7 @@ func (o *xxx_RetractConfigOperation) OpNum() int { return 16 }
 func (o *xxx_RetractConfigOperation) OpName() string { return "/IEventService/v1/EvtRpcRetractConfig" }
 
 func (o *xxx_RetractConfigOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Path) > int(512) {
+	if ndr.UTF16NLen(o.Path) > uint64(512) {
 		return fmt.Errorf("Path is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -8012,6 +8159,9 @@ func (o *xxx_RetractConfigOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Path", ndr.UTF16NLen(o.Path), 0, 512); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8143,7 +8293,7 @@ func (o *xxx_OpenLogOperation) OpNum() int { return 17 }
 func (o *xxx_OpenLogOperation) OpName() string { return "/IEventService/v1/EvtRpcOpenLogHandle" }
 
 func (o *xxx_OpenLogOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.Channel) > int(512) {
+	if ndr.UTF16NLen(o.Channel) > uint64(512) {
 		return fmt.Errorf("Channel is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -8186,6 +8336,9 @@ func (o *xxx_OpenLogOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Channel", ndr.UTF16NLen(o.Channel), 0, 512); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8437,6 +8590,9 @@ func (o *xxx_GetLogFileInfoOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PropertyValueBufferSize", o.PropertyValueBufferSize, 0, 2097152); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8835,6 +8991,12 @@ func (o *xxx_GetChannelListOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ChannelPaths", len(o.ChannelPaths), 0, 8192); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ChannelPaths", len(o.ChannelPaths), o.ChannelPathsLength, "ChannelPathsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8929,7 +9091,7 @@ func (o *xxx_GetChannelConfigOperation) OpName() string {
 }
 
 func (o *xxx_GetChannelConfigOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ChannelPath) > int(512) {
+	if ndr.UTF16NLen(o.ChannelPath) > uint64(512) {
 		return fmt.Errorf("ChannelPath is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -8972,6 +9134,9 @@ func (o *xxx_GetChannelConfigOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ChannelPath", ndr.UTF16NLen(o.ChannelPath), 0, 512); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9259,7 +9424,7 @@ func (o *xxx_PutChannelConfigOperation) OpName() string {
 }
 
 func (o *xxx_PutChannelConfigOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ChannelPath) > int(512) {
+	if ndr.UTF16NLen(o.ChannelPath) > uint64(512) {
 		return fmt.Errorf("ChannelPath is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -9329,6 +9494,9 @@ func (o *xxx_PutChannelConfigOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ChannelPath", ndr.UTF16NLen(o.ChannelPath), 0, 512); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9751,6 +9919,12 @@ func (o *xxx_GetPublisherListOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PublisherIDs", len(o.PublisherIDs), 0, 8192); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("PublisherIDs", len(o.PublisherIDs), o.PublisherIDsLength, "PublisherIDsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10025,6 +10199,12 @@ func (o *xxx_GetPublisherListForChannelOperation) UnmarshalNDRResponse(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PublisherIDs", len(o.PublisherIDs), 0, 8192); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("PublisherIDs", len(o.PublisherIDs), o.PublisherIDsLength, "PublisherIDsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10127,10 +10307,10 @@ func (o *xxx_GetPublisherMetadataOperation) OpName() string {
 }
 
 func (o *xxx_GetPublisherMetadataOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.PublisherID) > int(2048) {
+	if ndr.UTF16NLen(o.PublisherID) > uint64(2048) {
 		return fmt.Errorf("PublisherID is out of range")
 	}
-	if len(o.LogFilePath) > int(32768) {
+	if ndr.UTF16NLen(o.LogFilePath) > uint64(32768) {
 		return fmt.Errorf("LogFilePath is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -10247,6 +10427,12 @@ func (o *xxx_GetPublisherMetadataOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PublisherID", ndr.UTF16NLen(o.PublisherID), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LogFilePath", ndr.UTF16NLen(o.LogFilePath), 0, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10678,7 +10864,7 @@ func (o *xxx_GetEventMetadataEnumOperation) OpName() string {
 }
 
 func (o *xxx_GetEventMetadataEnumOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ReservedForFilter) > int(1048576) {
+	if ndr.UTF16NLen(o.ReservedForFilter) > uint64(1048576) {
 		return fmt.Errorf("ReservedForFilter is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -10767,6 +10953,9 @@ func (o *xxx_GetEventMetadataEnumOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ReservedForFilter", ndr.UTF16NLen(o.ReservedForFilter), 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11112,6 +11301,12 @@ func (o *xxx_GetNextEventMetadataOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("EventMetadataInstances", len(o.EventMetadataInstances), 0, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("EventMetadataInstances", len(o.EventMetadataInstances), o.ReturnedLength, "ReturnedLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11221,7 +11416,7 @@ func (o *xxx_GetClassicLogDisplayNameOperation) OpName() string {
 }
 
 func (o *xxx_GetClassicLogDisplayNameOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.LogName) > int(512) {
+	if ndr.UTF16NLen(o.LogName) > uint64(512) {
 		return fmt.Errorf("LogName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -11276,6 +11471,9 @@ func (o *xxx_GetClassicLogDisplayNameOperation) UnmarshalNDRRequest(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckRange("LogName", ndr.UTF16NLen(o.LogName), 0, 512); err != nil {
+		return err
+	}
 	return nil
 }
 
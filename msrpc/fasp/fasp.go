@@ -538,6 +538,9 @@ func (o *IPv4SubnetList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Subnets, _s_pSubNets, _ptr_pSubNets); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -603,6 +606,9 @@ func (o *IPv6Subnet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.PrefixBitsLength); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("PrefixBitsLength", o.PrefixBitsLength, 0, 128); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -721,6 +727,9 @@ func (o *IPv6SubnetList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Subnets, _s_pSubNets, _ptr_pSubNets); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -970,6 +979,9 @@ func (o *IPv4RangeList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Ranges, _s_pRanges, _ptr_pRanges); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1089,6 +1101,9 @@ func (o *IPv6RangeList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Ranges, _s_pRanges, _ptr_pRanges); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1258,6 +1273,9 @@ func (o *PortRangeList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Ports, _s_pPorts, _ptr_pPorts); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1496,6 +1514,9 @@ func (o *ICMPTypeCode) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.Code); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Code", o.Code, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1615,6 +1636,9 @@ func (o *ICMPTypeCodeList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Entries, _s_pEntries, _ptr_pEntries); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1735,6 +1759,9 @@ func (o *InterfaceLUIDs) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.LUIDs, _s_pLUIDs, _ptr_pLUIDs); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("LUIDsLength", o.LUIDsLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3550,6 +3577,9 @@ func (o *ObjectMetadata) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.EnforcementStates, _s_pEnforcementStates, _ptr_pEnforcementStates); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 100); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3784,6 +3814,9 @@ func (o *OSPlatformList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Platforms, _s_pPlatforms, _ptr_pPlatforms); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("EntriesLength", o.EntriesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4334,13 +4367,13 @@ type Rule20 struct {
 }
 
 func (o *Rule20) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.RuleID) > int(10001) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(10001) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -4349,28 +4382,28 @@ func (o *Rule20) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.RemoteUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteUserAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -4789,6 +4822,9 @@ func (o *Rule20) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.MetadataReserved); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5084,13 +5120,13 @@ func (o *Rule210) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -5099,28 +5135,28 @@ func (o *Rule210) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.RemoteUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteUserAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -5628,6 +5664,9 @@ func (o *Rule210) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Metadata, _s_pMetaData, _ptr_pMetaData); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5923,13 +5962,13 @@ func (o *Rule220) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -5938,37 +5977,37 @@ func (o *Rule220) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.RemoteUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteUserAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.LocalUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("LocalUserAuthorizationList is out of range")
 	}
-	if len(o.PackageID) > int(10001) {
+	if ndr.UTF16NLen(o.PackageID) > uint64(10001) {
 		return fmt.Errorf("PackageID is out of range")
 	}
-	if len(o.LocalUserOwner) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserOwner) > uint64(10001) {
 		return fmt.Errorf("LocalUserOwner is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -6557,6 +6596,9 @@ func (o *Rule220) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.TrustTupleKeywords); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6854,13 +6896,13 @@ func (o *Rule224) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -6869,40 +6911,40 @@ func (o *Rule224) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.RemoteUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteUserAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.LocalUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("LocalUserAuthorizationList is out of range")
 	}
-	if len(o.PackageID) > int(10001) {
+	if ndr.UTF16NLen(o.PackageID) > uint64(10001) {
 		return fmt.Errorf("PackageID is out of range")
 	}
-	if len(o.LocalUserOwner) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserOwner) > uint64(10001) {
 		return fmt.Errorf("LocalUserOwner is out of range")
 	}
-	if len(o.SecurityRealmID) > int(10001) {
+	if ndr.UTF16NLen(o.SecurityRealmID) > uint64(10001) {
 		return fmt.Errorf("SecurityRealmID is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -7531,6 +7573,9 @@ func (o *Rule224) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.SecurityRealmID, _s_wszSecurityRealmId, _ptr_wszSecurityRealmId); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7829,13 +7874,13 @@ func (o *Rule225) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -7844,40 +7889,40 @@ func (o *Rule225) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.RemoteUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteUserAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.LocalUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("LocalUserAuthorizationList is out of range")
 	}
-	if len(o.PackageID) > int(10001) {
+	if ndr.UTF16NLen(o.PackageID) > uint64(10001) {
 		return fmt.Errorf("PackageID is out of range")
 	}
-	if len(o.LocalUserOwner) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserOwner) > uint64(10001) {
 		return fmt.Errorf("LocalUserOwner is out of range")
 	}
-	if len(o.SecurityRealmID) > int(10001) {
+	if ndr.UTF16NLen(o.SecurityRealmID) > uint64(10001) {
 		return fmt.Errorf("SecurityRealmID is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -8512,6 +8557,9 @@ func (o *Rule225) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.Flags2); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8811,13 +8859,13 @@ func (o *Rule226) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -8826,37 +8874,37 @@ func (o *Rule226) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.LocalUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("LocalUserAuthorizationList is out of range")
 	}
-	if len(o.PackageID) > int(10001) {
+	if ndr.UTF16NLen(o.PackageID) > uint64(10001) {
 		return fmt.Errorf("PackageID is out of range")
 	}
-	if len(o.LocalUserOwner) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserOwner) > uint64(10001) {
 		return fmt.Errorf("LocalUserOwner is out of range")
 	}
-	if len(o.SecurityRealmID) > int(10001) {
+	if ndr.UTF16NLen(o.SecurityRealmID) > uint64(10001) {
 		return fmt.Errorf("SecurityRealmID is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -9506,6 +9554,9 @@ func (o *Rule226) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := o.RemoteOutServerNames.UnmarshalNDR(ctx, w); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9807,13 +9858,13 @@ func (o *Rule227) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -9822,43 +9873,43 @@ func (o *Rule227) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.RemoteUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteUserAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.LocalUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("LocalUserAuthorizationList is out of range")
 	}
-	if len(o.PackageID) > int(10001) {
+	if ndr.UTF16NLen(o.PackageID) > uint64(10001) {
 		return fmt.Errorf("PackageID is out of range")
 	}
-	if len(o.LocalUserOwner) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserOwner) > uint64(10001) {
 		return fmt.Errorf("LocalUserOwner is out of range")
 	}
-	if len(o.SecurityRealmID) > int(10001) {
+	if ndr.UTF16NLen(o.SecurityRealmID) > uint64(10001) {
 		return fmt.Errorf("SecurityRealmID is out of range")
 	}
-	if len(o.FQBN) > int(10001) {
+	if ndr.UTF16NLen(o.FQBN) > uint64(10001) {
 		return fmt.Errorf("FQBN is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -10539,6 +10590,9 @@ func (o *Rule227) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.CompartmentID); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11034,13 +11088,13 @@ func (o *Rule) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.Direction > Direction(2) {
@@ -11049,43 +11103,43 @@ func (o *Rule) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.LocalApplication) > int(10001) {
+	if ndr.UTF16NLen(o.LocalApplication) > uint64(10001) {
 		return fmt.Errorf("LocalApplication is out of range")
 	}
-	if len(o.LocalService) > int(10001) {
+	if ndr.UTF16NLen(o.LocalService) > uint64(10001) {
 		return fmt.Errorf("LocalService is out of range")
 	}
 	if o.Action > RuleAction(4) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.RemoteMachineAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteMachineAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteMachineAuthorizationList is out of range")
 	}
-	if len(o.RemoteUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.RemoteUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("RemoteUserAuthorizationList is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(6) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.LocalUserAuthorizationList) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserAuthorizationList) > uint64(10001) {
 		return fmt.Errorf("LocalUserAuthorizationList is out of range")
 	}
-	if len(o.PackageID) > int(10001) {
+	if ndr.UTF16NLen(o.PackageID) > uint64(10001) {
 		return fmt.Errorf("PackageID is out of range")
 	}
-	if len(o.LocalUserOwner) > int(10001) {
+	if ndr.UTF16NLen(o.LocalUserOwner) > uint64(10001) {
 		return fmt.Errorf("LocalUserOwner is out of range")
 	}
-	if len(o.SecurityRealmID) > int(10001) {
+	if ndr.UTF16NLen(o.SecurityRealmID) > uint64(10001) {
 		return fmt.Errorf("SecurityRealmID is out of range")
 	}
-	if len(o.FQBN) > int(10001) {
+	if ndr.UTF16NLen(o.FQBN) > uint64(10001) {
 		return fmt.Errorf("FQBN is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -11796,6 +11850,9 @@ func (o *Rule) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := o.RemoteDynamicKeywordAddresses.UnmarshalNDR(ctx, w); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13310,37 +13367,37 @@ type CSRule20 struct {
 }
 
 func (o *CSRule20) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.Phase1AuthSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase1AuthSet) > uint64(255) {
 		return fmt.Errorf("Phase1AuthSet is out of range")
 	}
-	if len(o.Phase2CryptoSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase2CryptoSet) > uint64(255) {
 		return fmt.Errorf("Phase2CryptoSet is out of range")
 	}
-	if len(o.Phase2AuthSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase2AuthSet) > uint64(255) {
 		return fmt.Errorf("Phase2AuthSet is out of range")
 	}
 	if o.Action < CSRuleAction(1) || o.Action > CSRuleAction(5) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(5) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -13789,6 +13846,9 @@ func (o *CSRule20) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadEnum((*uint32)(&o.Status)); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13871,40 +13931,40 @@ func (o *CSRule210) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.Phase1AuthSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase1AuthSet) > uint64(255) {
 		return fmt.Errorf("Phase1AuthSet is out of range")
 	}
-	if len(o.Phase2CryptoSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase2CryptoSet) > uint64(255) {
 		return fmt.Errorf("Phase2CryptoSet is out of range")
 	}
-	if len(o.Phase2AuthSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase2AuthSet) > uint64(255) {
 		return fmt.Errorf("Phase2AuthSet is out of range")
 	}
 	if o.Action < CSRuleAction(1) || o.Action > CSRuleAction(5) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(5) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.MMParentRuleID) > int(512) {
+	if ndr.UTF16NLen(o.MMParentRuleID) > uint64(512) {
 		return fmt.Errorf("MMParentRuleID is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -14473,6 +14533,9 @@ func (o *CSRule210) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Metadata, _s_pMetaData, _ptr_pMetaData); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14617,49 +14680,49 @@ func (o *CSRule) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if o.IPProtocol > uint16(256) {
 		return fmt.Errorf("IPProtocol is out of range")
 	}
-	if len(o.Phase1AuthSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase1AuthSet) > uint64(255) {
 		return fmt.Errorf("Phase1AuthSet is out of range")
 	}
-	if len(o.Phase2CryptoSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase2CryptoSet) > uint64(255) {
 		return fmt.Errorf("Phase2CryptoSet is out of range")
 	}
-	if len(o.Phase2AuthSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase2AuthSet) > uint64(255) {
 		return fmt.Errorf("Phase2AuthSet is out of range")
 	}
 	if o.Action < CSRuleAction(1) || o.Action > CSRuleAction(5) {
 		return fmt.Errorf("Action is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(5) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
-	if len(o.MMParentRuleID) > int(512) {
+	if ndr.UTF16NLen(o.MMParentRuleID) > uint64(512) {
 		return fmt.Errorf("MMParentRuleID is out of range")
 	}
-	if len(o.RemoteTunnelEndpointFQDN) > int(512) {
+	if ndr.UTF16NLen(o.RemoteTunnelEndpointFQDN) > uint64(512) {
 		return fmt.Errorf("RemoteTunnelEndpointFQDN is out of range")
 	}
-	if len(o.TransportMachineAuthzSDDL) > int(10001) {
+	if ndr.UTF16NLen(o.TransportMachineAuthzSDDL) > uint64(10001) {
 		return fmt.Errorf("TransportMachineAuthzSDDL is out of range")
 	}
-	if len(o.TransportUserAuthzSDDL) > int(10001) {
+	if ndr.UTF16NLen(o.TransportUserAuthzSDDL) > uint64(10001) {
 		return fmt.Errorf("TransportUserAuthzSDDL is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -15330,6 +15393,9 @@ func (o *CSRule) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.TransportUserAuthzSDDL, _s_wszTransportUserAuthzSDDL, _ptr_wszTransportUserAuthzSDDL); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IPProtocol", o.IPProtocol, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16831,16 +16897,16 @@ func (o *AuthSet210) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPsecPhase < IPsecPhase(1) || o.IPsecPhase > IPsecPhase(2) {
 		return fmt.Errorf("IPsecPhase is out of range")
 	}
-	if len(o.SetID) > int(255) {
+	if ndr.UTF16NLen(o.SetID) > uint64(255) {
 		return fmt.Errorf("SetID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.SuitesLength > uint32(10000) {
@@ -16849,7 +16915,7 @@ func (o *AuthSet210) xxx_PreparePayload(ctx context.Context) error {
 	if o.Origin > RuleOriginType(5) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -17138,6 +17204,9 @@ func (o *AuthSet210) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.AuthSetFlags); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("SuitesLength", o.SuitesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17199,16 +17268,16 @@ func (o *AuthSet) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPsecPhase < IPsecPhase(1) || o.IPsecPhase > IPsecPhase(2) {
 		return fmt.Errorf("IPsecPhase is out of range")
 	}
-	if len(o.SetID) > int(255) {
+	if ndr.UTF16NLen(o.SetID) > uint64(255) {
 		return fmt.Errorf("SetID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.SuitesLength > uint32(10000) {
@@ -17217,7 +17286,7 @@ func (o *AuthSet) xxx_PreparePayload(ctx context.Context) error {
 	if o.Origin > RuleOriginType(5) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -17506,6 +17575,9 @@ func (o *AuthSet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.AuthSetFlags); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("SuitesLength", o.SuitesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18150,22 +18222,22 @@ func (o *CryptoSet) xxx_PreparePayload(ctx context.Context) error {
 	if o.IPsecPhase < IPsecPhase(1) || o.IPsecPhase > IPsecPhase(2) {
 		return fmt.Errorf("IPsecPhase is out of range")
 	}
-	if len(o.SetID) > int(255) {
+	if ndr.UTF16NLen(o.SetID) > uint64(255) {
 		return fmt.Errorf("SetID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(5) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -18683,6 +18755,9 @@ func (o *CryptoSet_CryptoSet_Phase1) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadData(&o.TimeoutSessions); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Phase1SuitesLength", o.Phase1SuitesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18844,6 +18919,9 @@ func (o *CryptoSet_CryptoSet_Phase2) UnmarshalNDR(ctx context.Context, w ndr.Rea
 	if err := w.ReadPointer(&o.Phase2Suites, _s_pPhase2Suites, _ptr_pPhase2Suites); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Phase2SuitesLength", o.Phase2SuitesLength, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18954,6 +19032,9 @@ func (o *ByteBlob) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Blob, _s_Blob, _ptr_Blob); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Size", o.Size, 0, 10000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19103,6 +19184,9 @@ func (o *CertInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.CertFlags); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("CertFlags", o.CertFlags, 0, 127); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19453,10 +19537,10 @@ type AuthInfo_AuthInfo_Kerberos struct {
 }
 
 func (o *AuthInfo_AuthInfo_Kerberos) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.MyID) > int(10001) {
+	if ndr.UTF16NLen(o.MyID) > uint64(10001) {
 		return fmt.Errorf("MyID is out of range")
 	}
-	if len(o.PeerID) > int(10001) {
+	if ndr.UTF16NLen(o.PeerID) > uint64(10001) {
 		return fmt.Errorf("PeerID is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -20424,28 +20508,28 @@ func (o *MMRule) xxx_PreparePayload(ctx context.Context) error {
 	if o.Metadata != nil && o.MetadataReserved == 0 {
 		o.MetadataReserved = uint32(len(o.Metadata))
 	}
-	if len(o.RuleID) > int(512) {
+	if ndr.UTF16NLen(o.RuleID) > uint64(512) {
 		return fmt.Errorf("RuleID is out of range")
 	}
-	if len(o.Name) > int(10001) {
+	if ndr.UTF16NLen(o.Name) > uint64(10001) {
 		return fmt.Errorf("Name is out of range")
 	}
-	if len(o.Description) > int(10001) {
+	if ndr.UTF16NLen(o.Description) > uint64(10001) {
 		return fmt.Errorf("Description is out of range")
 	}
-	if len(o.Phase1AuthSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase1AuthSet) > uint64(255) {
 		return fmt.Errorf("Phase1AuthSet is out of range")
 	}
-	if len(o.Phase1CryptoSet) > int(255) {
+	if ndr.UTF16NLen(o.Phase1CryptoSet) > uint64(255) {
 		return fmt.Errorf("Phase1CryptoSet is out of range")
 	}
-	if len(o.EmbeddedContext) > int(10001) {
+	if ndr.UTF16NLen(o.EmbeddedContext) > uint64(10001) {
 		return fmt.Errorf("EmbeddedContext is out of range")
 	}
 	if o.Origin > RuleOriginType(5) {
 		return fmt.Errorf("Origin is out of range")
 	}
-	if len(o.GPOName) > int(10001) {
+	if ndr.UTF16NLen(o.GPOName) > uint64(10001) {
 		return fmt.Errorf("GPOName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -21370,7 +21454,7 @@ type MatchValue_MatchValue_UnicodeString struct {
 }
 
 func (o *MatchValue_MatchValue_UnicodeString) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.String) > int(10001) {
+	if ndr.UTF16NLen(o.String) > uint64(10001) {
 		return fmt.Errorf("String is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
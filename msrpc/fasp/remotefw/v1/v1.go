@@ -5053,6 +5053,9 @@ func (o *xxx_GetGlobalConfigOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5480,6 +5483,12 @@ func (o *xxx_SetGlobalConfigOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 10240); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6796,6 +6805,9 @@ func (o *xxx_GetConfigOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7189,6 +7201,9 @@ func (o *xxx_SetConfigOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 10240); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10509,6 +10524,9 @@ func (o *xxx_EnumPhase1SAsOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SAs", len(o.SAs), o.SAsLength, "SAsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10817,6 +10835,9 @@ func (o *xxx_EnumPhase2SAsOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SAs", len(o.SAs), o.SAsLength, "SAsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11485,6 +11506,9 @@ func (o *xxx_EnumProductsOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Products", len(o.Products), o.ProductsLength, "ProductsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14129,6 +14153,9 @@ func (o *xxx_EnumNetworksOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Networks", len(o.Networks), o.NetworksLength, "NetworksLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14383,6 +14410,9 @@ func (o *xxx_EnumAdaptersOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Adapters", len(o.Adapters), o.AdaptersLength, "AdaptersLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14669,6 +14699,9 @@ func (o *xxx_GetGlobalConfig210Operation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15169,6 +15202,9 @@ func (o *xxx_GetConfig210Operation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2475,6 +2475,9 @@ func (o *OutboundRoutingGroupw) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadEnum((*uint16)(&o.Status)); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("DevicesLength", o.DevicesLength, 0, 1000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5641,6 +5641,9 @@ func (o *xxx_GetServicePrintersOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6541,6 +6544,9 @@ func (o *xxx_EnumJobsOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6777,6 +6783,9 @@ func (o *xxx_GetJobOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7241,6 +7250,9 @@ func (o *xxx_GetPageDataOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7508,6 +7520,9 @@ func (o *xxx_GetDeviceStatusOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("StatusBuffer", len(o.StatusBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7888,6 +7903,9 @@ func (o *xxx_EnumPortsOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PortBuffer", len(o.PortBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8133,6 +8151,9 @@ func (o *xxx_GetPortOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PortBuffer", len(o.PortBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8576,6 +8597,9 @@ func (o *xxx_EnumRoutingMethodsOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9092,6 +9116,9 @@ func (o *xxx_GetRoutingInfoOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9359,6 +9386,12 @@ func (o *xxx_SetRoutingInfoOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("RoutingInfoBufferSize", o.RoutingInfoBufferSize, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9643,6 +9676,9 @@ func (o *xxx_EnumGlobalRoutingInfoOperation) UnmarshalNDRResponse(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10028,6 +10064,9 @@ func (o *xxx_GetConfigurationOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10439,6 +10478,9 @@ func (o *xxx_GetLoggingCategoriesOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10651,6 +10693,12 @@ func (o *xxx_SetLoggingCategoriesOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10912,6 +10960,9 @@ func (o *xxx_GetSecurityOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11115,6 +11166,12 @@ func (o *xxx_SetSecurityOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12068,6 +12125,12 @@ func (o *xxx_SendDocumentExOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("RecipientsLength", o.RecipientsLength, 0, 10000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("RecipientListCount", len(o.RecipientListCount), o.RecipientsLength, "RecipientsLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12501,6 +12564,9 @@ func (o *xxx_EnumJobsExOperation) UnmarshalNDRResponse(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12786,6 +12852,9 @@ func (o *xxx_GetJobExOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13015,6 +13084,9 @@ func (o *xxx_GetCountryListOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13275,6 +13347,9 @@ func (o *xxx_GetPersonalProfileInfoOperation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13825,6 +13900,9 @@ func (o *xxx_GetReceiptsConfigurationOperation) UnmarshalNDRResponse(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14530,6 +14608,9 @@ func (o *xxx_GetOutboxConfigurationOperation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15066,6 +15147,9 @@ func (o *xxx_GetArchiveConfigurationOperation) UnmarshalNDRResponse(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15464,6 +15548,9 @@ func (o *xxx_GetActivityLoggingConfigurationOperation) UnmarshalNDRResponse(ctx
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15854,6 +15941,9 @@ func (o *xxx_EnumerateProvidersOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16093,6 +16183,9 @@ func (o *xxx_GetPortExOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16502,6 +16595,9 @@ func (o *xxx_EnumPortsExOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16754,6 +16850,9 @@ func (o *xxx_GetExtensionDataOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16979,6 +17078,12 @@ func (o *xxx_SetExtensionDataOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataSize", o.DataSize, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17708,6 +17813,9 @@ func (o *xxx_EnumOutboundGroupsOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18688,6 +18796,9 @@ func (o *xxx_EnumOutboundRulesOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19831,6 +19942,9 @@ func (o *xxx_EnumMessagesOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20092,6 +20206,9 @@ func (o *xxx_GetMessageOperation) UnmarshalNDRResponse(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20849,6 +20966,12 @@ func (o *xxx_WriteFileOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataSize", o.DataSize, 0, 16384); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21127,6 +21250,9 @@ func (o *xxx_ReadFileOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22606,6 +22732,9 @@ func (o *xxx_EnumRoutingExtensionsOperation) UnmarshalNDRResponse(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23055,6 +23184,9 @@ func (o *xxx_GetSecurityExOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24019,6 +24151,9 @@ func (o *xxx_GetJobEx2Operation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24347,6 +24482,9 @@ func (o *xxx_EnumJobsEx2Operation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24636,6 +24774,9 @@ func (o *xxx_GetMessageExOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25191,6 +25332,9 @@ func (o *xxx_EnumMessagesExOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25700,6 +25844,12 @@ func (o *xxx_CreateAccountOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26154,6 +26304,9 @@ func (o *xxx_EnumAccountsOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26435,6 +26588,9 @@ func (o *xxx_GetAccountInfoOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26680,6 +26836,9 @@ func (o *xxx_GetGeneralConfigurationOperation) UnmarshalNDRResponse(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26872,6 +27031,12 @@ func (o *xxx_SetGeneralConfigurationOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27143,6 +27308,9 @@ func (o *xxx_GetSecurityEx2Operation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27369,6 +27537,12 @@ func (o *xxx_SetSecurityEx2Operation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 1048576); err != nil {
+		return err
+	}
 	return nil
 }
 
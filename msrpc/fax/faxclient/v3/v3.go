@@ -852,6 +852,9 @@ func (o *xxx_ClientEventQueueExOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataSize, "DataSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3169,6 +3169,9 @@ func (o *xxx_EnumJobsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3317,6 +3320,9 @@ func (o *xxx_EnumJobsOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3550,6 +3556,9 @@ func (o *xxx_GetJobOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3686,6 +3695,9 @@ func (o *xxx_GetJobOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4157,6 +4169,9 @@ func (o *xxx_GetPageDataOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4317,6 +4332,9 @@ func (o *xxx_GetPageDataOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4581,6 +4599,9 @@ func (o *xxx_GetDeviceStatusOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("StatusBuffer", len(o.StatusBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4717,6 +4738,9 @@ func (o *xxx_GetDeviceStatusOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("StatusBuffer", len(o.StatusBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5079,6 +5103,9 @@ func (o *xxx_EnumPortsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PortBuffer", len(o.PortBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5227,6 +5254,9 @@ func (o *xxx_EnumPortsOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PortBuffer", len(o.PortBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5471,6 +5501,9 @@ func (o *xxx_GetPortOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PortBuffer", len(o.PortBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5607,6 +5640,9 @@ func (o *xxx_GetPortOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PortBuffer", len(o.PortBuffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6034,6 +6070,9 @@ func (o *xxx_EnumRoutingMethodsOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6182,6 +6221,9 @@ func (o *xxx_EnumRoutingMethodsOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6694,6 +6736,9 @@ func (o *xxx_GetRoutingInfoOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6830,6 +6875,9 @@ func (o *xxx_GetRoutingInfoOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7104,6 +7152,9 @@ func (o *xxx_SetRoutingInfoOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7360,6 +7411,9 @@ func (o *xxx_EnumGlobalRoutingInfoOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7508,6 +7562,9 @@ func (o *xxx_EnumGlobalRoutingInfoOperation) UnmarshalNDRResponse(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("RoutingInfoBuffer", len(o.RoutingInfoBuffer), o.RoutingInfoBufferSize, "RoutingInfoBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7892,6 +7949,9 @@ func (o *xxx_GetConfigurationOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8028,6 +8088,9 @@ func (o *xxx_GetConfigurationOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8427,6 +8490,9 @@ func (o *xxx_GetLoggingCategoriesOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8575,6 +8641,9 @@ func (o *xxx_GetLoggingCategoriesOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8800,6 +8869,9 @@ func (o *xxx_SetLoggingCategoriesOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9046,6 +9118,9 @@ func (o *xxx_GetTAPILocationsOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9182,6 +9257,9 @@ func (o *xxx_GetTAPILocationsOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9390,6 +9468,9 @@ func (o *xxx_SetTAPILocationsOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9632,6 +9713,9 @@ func (o *xxx_GetMAPIProfilesOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MAPIProfiles", len(o.MAPIProfiles), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9768,6 +9852,9 @@ func (o *xxx_GetMAPIProfilesOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("MAPIProfiles", len(o.MAPIProfiles), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10240,6 +10327,9 @@ func (o *xxx_GetSecurityDescriptorOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FaxSecurityDescriptor", len(o.FaxSecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10376,6 +10466,9 @@ func (o *xxx_GetSecurityDescriptorOperation) UnmarshalNDRResponse(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FaxSecurityDescriptor", len(o.FaxSecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10581,6 +10674,9 @@ func (o *xxx_SetSecurityDescriptorOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("FaxSecurityDescriptor", len(o.FaxSecurityDescriptor), o.BufferSize, "BufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
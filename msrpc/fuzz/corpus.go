@@ -0,0 +1,268 @@
+package fuzz
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// Seeds is the set of the stub data seeds: empty, zeroes, the large sizes
+// and the non-null pointers.
+var Seeds = [][]byte{
+	{},
+	make([]byte, 64),
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	{0x00, 0x00, 0x02, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x41, 0x00, 0x42, 0x00, 0x43, 0x00, 0x00, 0x00},
+}
+
+// TypeCorpus function returns the deterministic corpus for the type `typ`:
+// the seeds and the NDR2.0 encoding of the zero value (if the type implements
+// ndr.Marshaler).
+func TypeCorpus(typ Type) [][]byte {
+
+	ret := append([][]byte{}, Seeds...)
+
+	if b, ok := encodeZero(typ); ok {
+		ret = append(ret, b)
+	}
+
+	return ret
+}
+
+// encodeZero function returns the NDR2.0 encoding of the zero value of
+// the type `typ`.
+func encodeZero(typ Type) (b []byte, ok bool) {
+
+	// the zero value of some types cannot be encoded.
+	defer func() {
+		if r := recover(); r != nil {
+			b, ok = nil, false
+		}
+	}()
+
+	m, ok := typ.New().(ndr.Marshaler)
+	if !ok {
+		return nil, false
+	}
+
+	b, err := ndr.Marshal(m)
+	if err != nil {
+		return nil, false
+	}
+
+	return b, true
+}
+
+// Corpus function returns the deterministic corpus for Fuzz: the TypeCorpus
+// entries of every type prefixed with the type index (little-endian).
+func Corpus() [][]byte {
+
+	var ret [][]byte
+
+	for i, typ := range Types {
+		for _, b := range TypeCorpus(typ) {
+			ret = append(ret, append(binary.LittleEndian.AppendUint16(nil, uint16(i)), b...))
+		}
+	}
+
+	return ret
+}
+
+var (
+	corpusIfUUID     = uuid.New(0x12345778, 0x1234, 0xabcd, 0xef, 0x00, [6]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab})
+	corpusObjectUUID = uuid.New(0x00000001, 0x0002, 0x0003, 0x04, 0x05, [6]byte{0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b})
+	corpusSyntax     = &dcerpc.SyntaxID{IfUUID: corpusIfUUID, IfVersionMajor: 1}
+	corpusAuthData   = []byte{0x4e, 0x54, 0x4c, 0x4d, 0x53, 0x53, 0x50, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+)
+
+// PacketCorpus function returns the deterministic corpus for FuzzPacket: the
+// well-formed PDUs of every connection-oriented packet type, with and without
+// the security trailer.
+func PacketCorpus() [][]byte {
+
+	stub := Seeds[len(Seeds)-1]
+
+	vt := &dcerpc.VerificationTrailer{Commands: []*dcerpc.VerificationCommand{
+		{Command: dcerpc.VerifyBitMaskSupportHeaderSign, Required: true},
+		{Command: &dcerpc.VerifyPresentation{InterfaceID: corpusSyntax, TransferSyntax: dcerpc.TransferNDRSyntaxV2_0}},
+	}}
+
+	vtb, err := ndr.NDR20(nil).Marshal(context.Background(), ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+		return vt.WriteTo(ctx, w)
+	}))
+	if err != nil {
+		panic(err)
+	}
+
+	bind := &dcerpc.Bind{MaxXmitFrag: 4280, MaxRecvFrag: 4280, ContextList: []*dcerpc.Context{
+		{ContextID: 0, AbstractSyntax: corpusSyntax, TransferSyntaxes: []*dcerpc.SyntaxID{dcerpc.TransferNDRSyntaxV2_0}},
+	}}
+
+	results := []*dcerpc.Result{
+		{DefResult: dcerpc.Acceptance, TransferSyntax: dcerpc.TransferNDRSyntaxV2_0},
+		{DefResult: dcerpc.ProviderRejection, ProviderReason: dcerpc.ProposedTransferSyntaxesNotSupported, TransferSyntax: &dcerpc.SyntaxID{IfUUID: &uuid.UUID{}}},
+	}
+
+	pkts := []struct {
+		PDU  dcerpc.PDU
+		Stub []byte
+		Auth bool
+		DRep ndr.DataRepresentation
+	}{
+		{PDU: bind},
+		{PDU: bind, Auth: true},
+		{PDU: &dcerpc.BindAck{MaxXmitFrag: 4280, MaxRecvFrag: 4280, AssocGroupID: 1, PortSpec: "\\PIPE\\svcctl", ResultList: results}},
+		{PDU: &dcerpc.BindNak{ProviderRejectReason: dcerpc.ReasonNotSpecified, VersionList: []*dcerpc.Version{{Major: 5}}}},
+		{PDU: &dcerpc.AlterContext{MaxXmitFrag: 4280, MaxRecvFrag: 4280, AssocGroupID: 1, ContextList: bind.ContextList}, Auth: true},
+		{PDU: &dcerpc.AlterContextResponse{MaxXmitFrag: 4280, MaxRecvFrag: 4280, AssocGroupID: 1, ResultList: results}, Auth: true},
+		{PDU: &dcerpc.Auth3{}, Auth: true},
+		{PDU: &dcerpc.Request{AllocHint: uint32(len(stub)), OpNum: 1}, Stub: stub},
+		{PDU: &dcerpc.Request{AllocHint: uint32(len(stub)), OpNum: 1}, Stub: stub, DRep: ndr.BigEndianDataRepresentation},
+		{PDU: &dcerpc.Request{AllocHint: uint32(len(stub)), OpNum: 1, ObjectUUID: corpusObjectUUID}, Stub: stub, Auth: true},
+		{PDU: &dcerpc.Request{AllocHint: uint32(len(stub) + len(vtb)), OpNum: 1}, Stub: append(append([]byte{}, stub...), vtb...), Auth: true},
+		{PDU: &dcerpc.Response{AllocHint: uint32(len(stub))}, Stub: stub},
+		{PDU: &dcerpc.Response{AllocHint: uint32(len(stub))}, Stub: stub, Auth: true},
+		{PDU: &dcerpc.Fault{Status: 0x000006f7}},
+		{PDU: &dcerpc.Fault{Flags: dcerpc.FaultFlagExtendedErrorInfo, Status: 0x000006f7}, Stub: Seeds[1]},
+		{PDU: &dcerpc.Shutdown{}},
+		{PDU: &dcerpc.Cancel{}},
+		{PDU: &dcerpc.Orphaned{}},
+	}
+
+	ret := make([][]byte, 0, len(pkts))
+
+	for i, pkt := range pkts {
+
+		hdr := dcerpc.Header{
+			RPCVersion:  5,
+			PacketType:  dcerpc.PDUToPacketType(pkt.PDU),
+			PacketFlags: dcerpc.PacketFlagFirstFrag | dcerpc.PacketFlagLastFrag,
+			PacketDRep:  pkt.DRep,
+			CallID:      uint32(i + 1),
+		}
+
+		if hdr.PacketDRep == 0 {
+			hdr.PacketDRep = ndr.DefaultDataRepresentation
+		}
+
+		if req, ok := pkt.PDU.(*dcerpc.Request); ok && req.ObjectUUID != nil {
+			hdr.PacketFlags |= dcerpc.PacketFlagObjectUUID
+		}
+
+		var auth []byte
+		if pkt.Auth {
+			auth = corpusAuthData
+		}
+
+		ret = append(ret, encodePacket(hdr, pkt.PDU, pkt.Stub, auth))
+	}
+
+	return ret
+}
+
+// encodePacket function encodes the PDU with the stub data `stub` and the
+// auth data `auth`.
+func encodePacket(hdr dcerpc.Header, pdu dcerpc.PDU, stub, auth []byte) []byte {
+
+	hdr.AuthLength = uint16(len(auth))
+
+	encode := ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+		if err := hdr.WriteTo(ctx, w); err != nil {
+			return err
+		}
+		if err := pdu.WriteTo(ctx, w); err != nil {
+			return err
+		}
+		if _, err := w.Write(stub); err != nil {
+			return err
+		}
+		if len(auth) == 0 {
+			return nil
+		}
+		// the security trailer is 16-byte aligned.
+		pad := (16 - w.Offset()%16) % 16
+		if _, err := w.Write(make([]byte, pad)); err != nil {
+			return err
+		}
+		st := &dcerpc.SecurityTrailer{AuthType: dcerpc.AuthTypeWinNT, AuthLevel: dcerpc.AuthLevelPktPrivacy, AuthPadLength: uint8(pad)}
+		if err := st.WriteTo(ctx, w); err != nil {
+			return err
+		}
+		_, err := w.Write(auth)
+		return err
+	})
+
+	// the first pass determines the fragment length.
+	for i := 0; i < 2; i++ {
+		b, err := ndr.NDR20(nil, hdr.PacketDRep).Marshal(context.Background(), encode)
+		if err != nil {
+			panic(err)
+		}
+		if int(hdr.FragLength) == len(b) {
+			return b
+		}
+		hdr.FragLength = uint16(len(b))
+	}
+
+	panic("fuzz: unstable packet encoding")
+}
+
+// DatagramCorpus function returns the deterministic corpus for FuzzDatagram:
+// the connectionless request, response and fault datagrams.
+func DatagramCorpus() [][]byte {
+
+	stub := Seeds[len(Seeds)-1]
+
+	var ret [][]byte
+
+	for i, typ := range []dcerpc.PacketType{dcerpc.PacketTypeRequest, dcerpc.PacketTypeResponse, dcerpc.PacketTypeFault} {
+		for _, drep := range []ndr.DataRepresentation{ndr.DefaultDataRepresentation, ndr.BigEndianDataRepresentation} {
+			hdr := &dcerpc.DatagramHeader{
+				RPCVersion:  dcerpc.DatagramRPCVersion,
+				PacketType:  typ,
+				Flags:       dcerpc.DatagramFlagIdempotent,
+				PacketDRep:  drep,
+				ObjectUUID:  &uuid.UUID{},
+				InterfaceID: corpusIfUUID,
+				ActivityID:  corpusObjectUUID,
+				IfVersion:   1,
+				SequenceNum: uint32(i),
+				OpNum:       1,
+				BodyLength:  uint16(len(stub)),
+			}
+			ret = append(ret, append(hdr.Bytes(), stub...))
+		}
+	}
+
+	return ret
+}
+
+// WriteCorpus function writes the corpus entries into the directory `dir`,
+// one file per entry named by the SHA-1 of the entry (the go-fuzz and
+// libFuzzer corpus layout):
+//
+//	if err := fuzz.WriteCorpus("corpus/packet", fuzz.PacketCorpus()); err != nil {
+//		// handle error.
+//	}
+func WriteCorpus(dir string, corpus [][]byte) error {
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, b := range corpus {
+		sum := sha1.Sum(b)
+		if err := os.WriteFile(filepath.Join(dir, hex.EncodeToString(sum[:])), b, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
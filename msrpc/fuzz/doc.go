@@ -0,0 +1,31 @@
+// The fuzz package contains the fuzzers for the generated packages and the
+// PDU parsers that verify that the malformed input never panics the process
+// (the panics raised by the generated unmarshaling code are returned as
+// ndr.PanicError).
+//
+// The request and response types list (types.go) is generated with
+// codegen/fuzzgen (make fuzz-types):
+//
+//	go test ./msrpc/fuzz -run=^$ -fuzz=FuzzUnmarshal -fuzztime=10m
+//	go test ./msrpc/fuzz -run=^$ -fuzz=FuzzParsePacket -fuzztime=10m
+//
+// Use -strict flag to fail on the recovered panics (to find the generator bugs):
+//
+//	go test ./msrpc/fuzz -run=^$ -fuzz=FuzzUnmarshal -args -strict
+//
+// # External Fuzzers
+//
+// The package exports the go-fuzz (and libFuzzer) entrypoints: Fuzz (the
+// generated types, the type is selected by the first two bytes), FuzzPacket
+// (dcerpc.ParsePacket), FuzzDatagram (dcerpc.ParseDatagramHeader) and
+// FuzzType (the single generated type). The entrypoints raise the recovered
+// panics again, so that the fuzzer records them as crashes:
+//
+//	go-fuzz-build -func FuzzPacket github.com/oiweiwei/go-msrpc/msrpc/fuzz
+//	go-fuzz -bin fuzz-fuzz.zip -workdir packet
+//
+// The deterministic corpus (Corpus, PacketCorpus, DatagramCorpus) is written
+// with WriteCorpus, or with the -corpus flag (make fuzz-corpus):
+//
+//	go test ./msrpc/fuzz -run=TestCorpus -args -corpus=corpus
+package fuzz
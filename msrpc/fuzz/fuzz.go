@@ -0,0 +1,133 @@
+package fuzz
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	rpcerrors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// Type structure represents the generated request or response type.
+type Type struct {
+	// The type name (<package path>.<type name>, for example,
+	// "srvs/srvsvc/v3.ShareEnumResponse").
+	Name string
+	// The type constructor.
+	New func() ndr.Unmarshaler
+}
+
+// Lookup function returns the generated type with the name `name`.
+func Lookup(name string) (Type, bool) {
+	for _, typ := range Types {
+		if typ.Name == name {
+			return typ, true
+		}
+	}
+	return Type{}, false
+}
+
+// Unmarshal function unmarshals the stub data `b` into the new value of the
+// type `typ` with the NDR2.0 and NDR64 transfer syntaxes. The function returns
+// the ndr.PanicError if any of the decoders panicked, `nil` if any of the
+// decoders succeeded, or the NDR2.0 decoding error.
+func Unmarshal(typ Type, b []byte) error {
+
+	var errs []error
+
+	for _, fn := range []func([]byte, ndr.Unmarshaler, ...any) error{ndr.Unmarshal, ndr.Unmarshal64} {
+		err := fn(b, typ.New())
+		if errors.Is(err, ndr.ErrPanic) {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+	}
+
+	return errs[0]
+}
+
+// result function returns the fuzzer result for the decoding error `err`: the
+// recovered panic is raised again (so that the fuzzer records the crash), the
+// successfully decoded input is prioritized.
+func result(err error) int {
+	if errors.Is(err, ndr.ErrPanic) {
+		panic(err)
+	}
+	if err != nil {
+		return 0
+	}
+	return 1
+}
+
+// Fuzz function is the go-fuzz (and libFuzzer) entrypoint for the generated
+// types: the first two bytes of `data` (little-endian) select the type (the
+// index in Types modulo the number of types), the rest is the stub data
+// (see Corpus).
+func Fuzz(data []byte) int {
+	if len(data) < 2 {
+		return -1
+	}
+	return result(Unmarshal(Types[int(binary.LittleEndian.Uint16(data))%len(Types)], data[2:]))
+}
+
+// FuzzType function returns the go-fuzz (and libFuzzer) entrypoint for the
+// single type: the `data` is the stub data:
+//
+//	var fuzzShareEnum = fuzz.FuzzType(func() ndr.Unmarshaler { return new(srvsvc.ShareEnumResponse) })
+//
+//	func Fuzz(data []byte) int { return fuzzShareEnum(data) }
+func FuzzType(new func() ndr.Unmarshaler) func([]byte) int {
+	typ := Type{New: new}
+	return func(data []byte) int {
+		return result(Unmarshal(typ, data))
+	}
+}
+
+// FuzzPacket function is the go-fuzz (and libFuzzer) entrypoint for the
+// connection-oriented PDU parser (dcerpc.ParsePacket): the `data` is the
+// PDU (see PacketCorpus). The extended error information of the fault PDU
+// and the verification trailer of the request PDU are decoded as well.
+func FuzzPacket(data []byte) int {
+
+	ctx := context.Background()
+
+	pkt, err := dcerpc.ParsePacket(ctx, data)
+	if err != nil {
+		return result(err)
+	}
+
+	switch pdu := pkt.PDU.(type) {
+	case *dcerpc.Fault:
+		if pdu.Flags&dcerpc.FaultFlagExtendedErrorInfo != 0 {
+			_ = rpcerrors.NewExtended(ctx, pdu.Status, pkt.StubDataBytes())
+		}
+	case *dcerpc.Request:
+		stub := pkt.StubDataBytes()
+		if idx := bytes.LastIndex(stub, dcerpc.VerificationTrailerSignature[:]); idx >= 0 {
+			// always little-endian.
+			var vt dcerpc.VerificationTrailer
+			if err := ndr.NDR20(stub[idx:]).Unmarshal(ctx, ndr.UnmarshalNDRFunc(vt.ReadFrom)); err != nil {
+				return result(err)
+			}
+		}
+	}
+
+	return 1
+}
+
+// FuzzDatagram function is the go-fuzz (and libFuzzer) entrypoint for the
+// connectionless header parser (dcerpc.ParseDatagramHeader): the `data` is
+// the datagram (see DatagramCorpus).
+func FuzzDatagram(data []byte) int {
+	_, err := dcerpc.ParseDatagramHeader(data)
+	return result(err)
+}
@@ -0,0 +1,171 @@
+package fuzz
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+var (
+	strict = flag.Bool("strict", false, "fail on the recovered panics")
+	corpus = flag.String("corpus", "", "write the corpus into the directory")
+)
+
+// unmarshal function unmarshals the stub data `b` to the type with NDR2.0 and
+// NDR64 transfer syntaxes, the panic escaping the unmarshaler fails the test.
+func unmarshal(t *testing.T, typ Type, b []byte) {
+	if err := Unmarshal(typ, b); errors.Is(err, ndr.ErrPanic) && *strict {
+		t.Fatalf("%s: %v", typ.Name, err)
+	}
+}
+
+func TestUnmarshalSeeds(t *testing.T) {
+	for _, typ := range Types {
+		for _, seed := range Seeds {
+			unmarshal(t, typ, seed)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+
+	typ, ok := Lookup("srvs/srvsvc/v3.ShareEnumResponse")
+	if !ok {
+		t.Fatalf("lookup: type not found")
+	}
+
+	// the encoded zero value is decoded.
+	b, ok := encodeZero(typ)
+	if !ok {
+		t.Fatalf("encode: zero value not encoded")
+	}
+
+	if err := ndr.Unmarshal(b, typ.New()); err != nil {
+		t.Errorf("unmarshal: %v", err)
+	}
+
+	if FuzzType(typ.New)(b) != 1 {
+		t.Errorf("fuzz: zero value rejected")
+	}
+
+	if _, ok := Lookup("unknown"); ok {
+		t.Errorf("lookup: unexpected type")
+	}
+}
+
+func TestPacketCorpus(t *testing.T) {
+
+	ctx := context.Background()
+
+	for i, b := range PacketCorpus() {
+
+		pkt, err := dcerpc.ParsePacket(ctx, b)
+		if err != nil {
+			t.Fatalf("corpus %d: parse: %v", i, err)
+		}
+
+		if pkt.Header.AuthLength != 0 && !bytes.Equal(pkt.AuthData, corpusAuthData) {
+			t.Errorf("corpus %d: unexpected auth data %x", i, pkt.AuthData)
+		}
+
+		if _, ok := pkt.PDU.(*dcerpc.Response); ok && !bytes.Equal(pkt.StubDataBytes(), Seeds[len(Seeds)-1]) {
+			t.Errorf("corpus %d: unexpected stub data %x", i, pkt.StubDataBytes())
+		}
+
+		if FuzzPacket(b) != 1 {
+			t.Errorf("corpus %d: packet rejected", i)
+		}
+
+		// the truncated packet is rejected.
+		if _, err := dcerpc.ParsePacket(ctx, b[:len(b)-1]); err == nil {
+			t.Errorf("corpus %d: expected error for truncated packet", i)
+		}
+	}
+
+	for i, b := range DatagramCorpus() {
+		if FuzzDatagram(b) != 1 {
+			t.Errorf("datagram corpus %d: datagram rejected", i)
+		}
+	}
+}
+
+func TestCorpus(t *testing.T) {
+
+	// the corpus is deterministic.
+	if a, b := PacketCorpus(), PacketCorpus(); !equal(a, b) {
+		t.Errorf("corpus: packet corpus is not deterministic")
+	}
+
+	typ := Types[0]
+	if a, b := TypeCorpus(typ), TypeCorpus(typ); !equal(a, b) {
+		t.Errorf("corpus: type corpus is not deterministic")
+	}
+
+	dir := *corpus
+	if dir == "" {
+		dir = t.TempDir()
+	}
+
+	for name, entries := range map[string][][]byte{
+		"unmarshal": Corpus(),
+		"packet":    PacketCorpus(),
+		"datagram":  DatagramCorpus(),
+	} {
+		if err := WriteCorpus(filepath.Join(dir, name), entries); err != nil {
+			t.Fatalf("write corpus: %v", err)
+		}
+		if files, _ := os.ReadDir(filepath.Join(dir, name)); len(files) == 0 {
+			t.Errorf("write corpus: %s: no entries", name)
+		}
+	}
+}
+
+// equal function returns `true` if the corpora are equal.
+func equal(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func FuzzUnmarshal(f *testing.F) {
+
+	for i := range Types {
+		for _, seed := range Seeds {
+			f.Add(binary.LittleEndian.AppendUint16(nil, uint16(i)), seed)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, idx []byte, b []byte) {
+		if len(idx) < 2 {
+			return
+		}
+		unmarshal(t, Types[int(binary.LittleEndian.Uint16(idx))%len(Types)], b)
+	})
+}
+
+func FuzzParsePacket(f *testing.F) {
+
+	for _, b := range PacketCorpus() {
+		f.Add(b)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		if _, err := dcerpc.ParsePacket(context.Background(), b); errors.Is(err, ndr.ErrPanic) && *strict {
+			t.Fatalf("parse: %v", err)
+		}
+	})
+}
@@ -0,0 +1,7275 @@
+// Code generated by codegen/fuzzgen; DO NOT EDIT.
+
+package fuzz
+
+import (
+	ndr "github.com/oiweiwei/go-msrpc/ndr"
+
+	bkrp_backupkey_v1 "github.com/oiweiwei/go-msrpc/msrpc/bkrp/backupkey/v1"
+	bpau_bitspeerauth_v1 "github.com/oiweiwei/go-msrpc/msrpc/bpau/bitspeerauth/v1"
+	brwsa_browser_v0 "github.com/oiweiwei/go-msrpc/msrpc/brwsa/browser/v0"
+	capr_lsacap_v1 "github.com/oiweiwei/go-msrpc/msrpc/capr/lsacap/v1"
+	cmpo_ixnremote_v1 "github.com/oiweiwei/go-msrpc/msrpc/cmpo/ixnremote/v1"
+	cmrp_clusapi2_v2 "github.com/oiweiwei/go-msrpc/msrpc/cmrp/clusapi2/v2"
+	cmrp_clusapi3_v3 "github.com/oiweiwei/go-msrpc/msrpc/cmrp/clusapi3/v3"
+	dcom_adtg_idatafactory_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/adtg/idatafactory/v0"
+	dcom_adtg_idatafactory2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/adtg/idatafactory2/v0"
+	dcom_adtg_idatafactory3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/adtg/idatafactory3/v0"
+	dcom_ccfg_icluscfgasyncevictcleanup_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/ccfg/icluscfgasyncevictcleanup/v0"
+	dcom_com_itransactionstream_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/com/itransactionstream/v0"
+	dcom_coma_ialternatelaunch_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/ialternatelaunch/v0"
+	dcom_coma_icapabilitysupport_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icapabilitysupport/v0"
+	dcom_coma_icatalog64bitsupport_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icatalog64bitsupport/v0"
+	dcom_coma_icatalogsession_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icatalogsession/v0"
+	dcom_coma_icatalogtableinfo_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icatalogtableinfo/v0"
+	dcom_coma_icatalogtableread_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icatalogtableread/v0"
+	dcom_coma_icatalogtablewrite_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icatalogtablewrite/v0"
+	dcom_coma_icatalogutils_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icatalogutils/v0"
+	dcom_coma_icatalogutils2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icatalogutils2/v0"
+	dcom_coma_icontainercontrol_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icontainercontrol/v0"
+	dcom_coma_icontainercontrol2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/icontainercontrol2/v0"
+	dcom_coma_iexport_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/iexport/v0"
+	dcom_coma_iexport2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/iexport2/v0"
+	dcom_coma_iimport_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/iimport/v0"
+	dcom_coma_iimport2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/iimport2/v0"
+	dcom_coma_iregister_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/iregister/v0"
+	dcom_coma_iregister2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/iregister2/v0"
+	dcom_coma_ireplicationutil_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/coma/ireplicationutil/v0"
+	dcom_comev_ienumeventobject_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ienumeventobject/v0"
+	dcom_comev_ieventclass_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventclass/v0"
+	dcom_comev_ieventclass2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventclass2/v0"
+	dcom_comev_ieventclass3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventclass3/v0"
+	dcom_comev_ieventobjectcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventobjectcollection/v0"
+	dcom_comev_ieventsubscription_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventsubscription/v0"
+	dcom_comev_ieventsubscription2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventsubscription2/v0"
+	dcom_comev_ieventsubscription3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventsubscription3/v0"
+	dcom_comev_ieventsystem_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventsystem/v0"
+	dcom_comev_ieventsystem2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventsystem2/v0"
+	dcom_comev_ieventsysteminitialize_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comev/ieventsysteminitialize/v0"
+	dcom_comt_icomtrackinginfoevents_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comt/icomtrackinginfoevents/v0"
+	dcom_comt_igettrackingdata_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comt/igettrackingdata/v0"
+	dcom_comt_iprocessdump_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/comt/iprocessdump/v0"
+	dcom_csra_icertadmind_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csra/icertadmind/v0"
+	dcom_csra_icertadmind2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csra/icertadmind2/v0"
+	dcom_csvp "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp"
+	dcom_csvp_iclustercleanup_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclustercleanup/v0"
+	dcom_csvp_iclusterfirewall_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclusterfirewall/v0"
+	dcom_csvp_iclusterlog_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclusterlog/v0"
+	dcom_csvp_iclusterlogex_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclusterlogex/v0"
+	dcom_csvp_iclusternetwork2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclusternetwork2/v0"
+	dcom_csvp_iclustersetup_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclustersetup/v0"
+	dcom_csvp_iclusterstorage2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclusterstorage2/v0"
+	dcom_csvp_iclusterstorage3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclusterstorage3/v0"
+	dcom_csvp_iclusterupdate_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/csvp/iclusterupdate/v0"
+	dcom_dfsrh_iadproxy_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dfsrh/iadproxy/v0"
+	dcom_dfsrh_iadproxy2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dfsrh/iadproxy2/v0"
+	dcom_dfsrh_iserverhealthreport_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dfsrh/iserverhealthreport/v0"
+	dcom_dfsrh_iserverhealthreport2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dfsrh/iserverhealthreport2/v0"
+	dcom_dmrp_idmnotify_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dmrp/idmnotify/v0"
+	dcom_dmrp_idmremoteserver_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dmrp/idmremoteserver/v0"
+	dcom_dmrp_ivolumeclient_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dmrp/ivolumeclient/v0"
+	dcom_dmrp_ivolumeclient2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dmrp/ivolumeclient2/v0"
+	dcom_dmrp_ivolumeclient3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dmrp/ivolumeclient3/v0"
+	dcom_dmrp_ivolumeclient4_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/dmrp/ivolumeclient4/v0"
+	dcom_fsrm_ifsrmaction_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmaction/v0"
+	dcom_fsrm_ifsrmactioncommand_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmactioncommand/v0"
+	dcom_fsrm_ifsrmactionemail_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmactionemail/v0"
+	dcom_fsrm_ifsrmactionemail2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmactionemail2/v0"
+	dcom_fsrm_ifsrmactioneventlog_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmactioneventlog/v0"
+	dcom_fsrm_ifsrmactionreport_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmactionreport/v0"
+	dcom_fsrm_ifsrmautoapplyquota_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmautoapplyquota/v0"
+	dcom_fsrm_ifsrmclassificationmanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmclassificationmanager/v0"
+	dcom_fsrm_ifsrmclassificationrule_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmclassificationrule/v0"
+	dcom_fsrm_ifsrmclassifiermoduledefinition_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmclassifiermoduledefinition/v0"
+	dcom_fsrm_ifsrmcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmcollection/v0"
+	dcom_fsrm_ifsrmcommittablecollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmcommittablecollection/v0"
+	dcom_fsrm_ifsrmderivedobjectsresult_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmderivedobjectsresult/v0"
+	dcom_fsrm_ifsrmfilegroup_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilegroup/v0"
+	dcom_fsrm_ifsrmfilegroupimported_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilegroupimported/v0"
+	dcom_fsrm_ifsrmfilegroupmanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilegroupmanager/v0"
+	dcom_fsrm_ifsrmfilemanagementjob_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilemanagementjob/v1"
+	dcom_fsrm_ifsrmfilemanagementjobmanager_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilemanagementjobmanager/v1"
+	dcom_fsrm_ifsrmfilescreen_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilescreen/v0"
+	dcom_fsrm_ifsrmfilescreenbase_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilescreenbase/v0"
+	dcom_fsrm_ifsrmfilescreenexception_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilescreenexception/v0"
+	dcom_fsrm_ifsrmfilescreenmanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilescreenmanager/v0"
+	dcom_fsrm_ifsrmfilescreentemplate_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilescreentemplate/v0"
+	dcom_fsrm_ifsrmfilescreentemplateimported_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilescreentemplateimported/v0"
+	dcom_fsrm_ifsrmfilescreentemplatemanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmfilescreentemplatemanager/v0"
+	dcom_fsrm_ifsrmmutablecollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmmutablecollection/v0"
+	dcom_fsrm_ifsrmobject_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmobject/v0"
+	dcom_fsrm_ifsrmpathmapper_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmpathmapper/v0"
+	dcom_fsrm_ifsrmpipelinemoduledefinition_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmpipelinemoduledefinition/v0"
+	dcom_fsrm_ifsrmproperty_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmproperty/v0"
+	dcom_fsrm_ifsrmpropertycondition_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmpropertycondition/v1"
+	dcom_fsrm_ifsrmpropertydefinition_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmpropertydefinition/v0"
+	dcom_fsrm_ifsrmpropertydefinition2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmpropertydefinition2/v0"
+	dcom_fsrm_ifsrmpropertydefinitionvalue_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmpropertydefinitionvalue/v0"
+	dcom_fsrm_ifsrmquota_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquota/v0"
+	dcom_fsrm_ifsrmquotabase_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquotabase/v0"
+	dcom_fsrm_ifsrmquotamanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquotamanager/v0"
+	dcom_fsrm_ifsrmquotamanagerex_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquotamanagerex/v0"
+	dcom_fsrm_ifsrmquotaobject_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquotaobject/v0"
+	dcom_fsrm_ifsrmquotatemplate_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquotatemplate/v0"
+	dcom_fsrm_ifsrmquotatemplateimported_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquotatemplateimported/v0"
+	dcom_fsrm_ifsrmquotatemplatemanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmquotatemplatemanager/v0"
+	dcom_fsrm_ifsrmreport_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmreport/v1"
+	dcom_fsrm_ifsrmreportjob_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmreportjob/v1"
+	dcom_fsrm_ifsrmreportmanager_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmreportmanager/v1"
+	dcom_fsrm_ifsrmreportscheduler_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmreportscheduler/v1"
+	dcom_fsrm_ifsrmrule_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmrule/v0"
+	dcom_fsrm_ifsrmsetting_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmsetting/v0"
+	dcom_fsrm_ifsrmstoragemoduledefinition_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/fsrm/ifsrmstoragemoduledefinition/v0"
+	dcom_iactivation_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iactivation/v0"
+	dcom_iisa_iapphostadminmanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostadminmanager/v0"
+	dcom_iisa_iapphostchangehandler_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostchangehandler/v0"
+	dcom_iisa_iapphostchildelementcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostchildelementcollection/v0"
+	dcom_iisa_iapphostcollectionschema_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostcollectionschema/v0"
+	dcom_iisa_iapphostconfigexception_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostconfigexception/v0"
+	dcom_iisa_iapphostconfigfile_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostconfigfile/v0"
+	dcom_iisa_iapphostconfiglocation_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostconfiglocation/v0"
+	dcom_iisa_iapphostconfiglocationcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostconfiglocationcollection/v0"
+	dcom_iisa_iapphostconfigmanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostconfigmanager/v0"
+	dcom_iisa_iapphostconstantvalue_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostconstantvalue/v0"
+	dcom_iisa_iapphostconstantvaluecollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostconstantvaluecollection/v0"
+	dcom_iisa_iapphostelement_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostelement/v0"
+	dcom_iisa_iapphostelementcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostelementcollection/v0"
+	dcom_iisa_iapphostelementschema_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostelementschema/v0"
+	dcom_iisa_iapphostelementschemacollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostelementschemacollection/v0"
+	dcom_iisa_iapphostmappingextension_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostmappingextension/v0"
+	dcom_iisa_iapphostmethod_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostmethod/v0"
+	dcom_iisa_iapphostmethodcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostmethodcollection/v0"
+	dcom_iisa_iapphostmethodinstance_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostmethodinstance/v0"
+	dcom_iisa_iapphostmethodschema_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostmethodschema/v0"
+	dcom_iisa_iapphostpathmapper_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostpathmapper/v0"
+	dcom_iisa_iapphostproperty_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostproperty/v0"
+	dcom_iisa_iapphostpropertycollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostpropertycollection/v0"
+	dcom_iisa_iapphostpropertyexception_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostpropertyexception/v0"
+	dcom_iisa_iapphostpropertyschema_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostpropertyschema/v0"
+	dcom_iisa_iapphostpropertyschemacollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostpropertyschemacollection/v0"
+	dcom_iisa_iapphostsectiondefinition_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostsectiondefinition/v0"
+	dcom_iisa_iapphostsectiondefinitioncollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostsectiondefinitioncollection/v0"
+	dcom_iisa_iapphostsectiongroup_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostsectiongroup/v0"
+	dcom_iisa_iapphostwritableadminmanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iisa/iapphostwritableadminmanager/v0"
+	dcom_iiss_iiisservicecontrol_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iiss/iiisservicecontrol/v0"
+	dcom_imsa_iiisapplicationadmin_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/imsa/iiisapplicationadmin/v0"
+	dcom_imsa_iiiscertobj_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/imsa/iiiscertobj/v0"
+	dcom_imsa_imsadminbase2w_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/imsa/imsadminbase2w/v0"
+	dcom_imsa_imsadminbase3w_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/imsa/imsadminbase3w/v0"
+	dcom_imsa_imsadminbasew_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/imsa/imsadminbasew/v0"
+	dcom_imsa_iwamadmin_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/imsa/iwamadmin/v0"
+	dcom_imsa_iwamadmin2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/imsa/iwamadmin2/v0"
+	dcom_iobjectexporter_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iobjectexporter/v0"
+	dcom_ioi_imanagedobject_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/ioi/imanagedobject/v0"
+	dcom_ioi_iremotedispatch_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/ioi/iremotedispatch/v0"
+	dcom_ioi_iservicedcomponentinfo_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/ioi/iservicedcomponentinfo/v0"
+	dcom_iremotescmactivator_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iremotescmactivator/v0"
+	dcom_iremunknown_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iremunknown/v0"
+	dcom_iremunknown2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/iremunknown2/v0"
+	dcom_oaut_idispatch_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut/idispatch/v0"
+	dcom_oaut_ienumvariant_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut/ienumvariant/v0"
+	dcom_oaut_itypecomp_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut/itypecomp/v0"
+	dcom_oaut_itypeinfo_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut/itypeinfo/v0"
+	dcom_oaut_itypeinfo2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut/itypeinfo2/v0"
+	dcom_oaut_itypelib_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut/itypelib/v0"
+	dcom_oaut_itypelib2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut/itypelib2/v0"
+	dcom_ocspa_iocspadmind_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/ocspa/iocspadmind/v0"
+	dcom_pla_ialertdatacollector_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/ialertdatacollector/v0"
+	dcom_pla_iapitracingdatacollector_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/iapitracingdatacollector/v0"
+	dcom_pla_iconfigurationdatacollector_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/iconfigurationdatacollector/v0"
+	dcom_pla_idatacollector_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/idatacollector/v0"
+	dcom_pla_idatacollectorcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/idatacollectorcollection/v0"
+	dcom_pla_idatacollectorset_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/idatacollectorset/v0"
+	dcom_pla_idatacollectorsetcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/idatacollectorsetcollection/v0"
+	dcom_pla_idatamanager_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/idatamanager/v0"
+	dcom_pla_ifolderaction_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/ifolderaction/v0"
+	dcom_pla_ifolderactioncollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/ifolderactioncollection/v0"
+	dcom_pla_iperformancecounterdatacollector_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/iperformancecounterdatacollector/v0"
+	dcom_pla_ischedule_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/ischedule/v0"
+	dcom_pla_ischedulecollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/ischedulecollection/v0"
+	dcom_pla_itracedatacollector_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/itracedatacollector/v0"
+	dcom_pla_itracedataprovider_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/itracedataprovider/v0"
+	dcom_pla_itracedataprovidercollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/itracedataprovidercollection/v0"
+	dcom_pla_ivaluemap_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/ivaluemap/v0"
+	dcom_pla_ivaluemapitem_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/pla/ivaluemapitem/v0"
+	dcom_rai_ipchcollection_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/rai/ipchcollection/v0"
+	dcom_rai_ipchservice_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/rai/ipchservice/v0"
+	dcom_rai_irasrv_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/rai/irasrv/v0"
+	dcom_rai_isafsession_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/rai/isafsession/v0"
+	dcom_rdpesc_type_scard_pack_v1 "github.com/oiweiwei/go-msrpc/msrpc/dcom/rdpesc/type_scard_pack/v1"
+	dcom_vds_ienumvdsobject_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ienumvdsobject/v0"
+	dcom_vds_ivdsadvanceddisk_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsadvanceddisk/v0"
+	dcom_vds_ivdsadvanceddisk2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsadvanceddisk2/v0"
+	dcom_vds_ivdsadvanceddisk3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsadvanceddisk3/v0"
+	dcom_vds_ivdsadvisesink_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsadvisesink/v0"
+	dcom_vds_ivdsasync_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsasync/v0"
+	dcom_vds_ivdscreatepartitionex_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdscreatepartitionex/v0"
+	dcom_vds_ivdsdisk_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsdisk/v0"
+	dcom_vds_ivdsdisk2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsdisk2/v0"
+	dcom_vds_ivdsdisk3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsdisk3/v0"
+	dcom_vds_ivdsdiskonline_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsdiskonline/v0"
+	dcom_vds_ivdsdiskpartitionmf_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsdiskpartitionmf/v0"
+	dcom_vds_ivdsdiskpartitionmf2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsdiskpartitionmf2/v0"
+	dcom_vds_ivdshbaport_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdshbaport/v0"
+	dcom_vds_ivdshwprovider_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdshwprovider/v0"
+	dcom_vds_ivdsiscsiinitiatoradapter_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsiscsiinitiatoradapter/v0"
+	dcom_vds_ivdsiscsiinitiatorportal_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsiscsiinitiatorportal/v0"
+	dcom_vds_ivdsopenvdisk_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsopenvdisk/v0"
+	dcom_vds_ivdspack_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdspack/v0"
+	dcom_vds_ivdspack2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdspack2/v0"
+	dcom_vds_ivdsprovider_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsprovider/v0"
+	dcom_vds_ivdsremovable_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsremovable/v0"
+	dcom_vds_ivdsservice_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsservice/v0"
+	dcom_vds_ivdsservicehba_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsservicehba/v0"
+	dcom_vds_ivdsserviceinitialization_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsserviceinitialization/v0"
+	dcom_vds_ivdsserviceiscsi_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsserviceiscsi/v0"
+	dcom_vds_ivdsserviceloader_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsserviceloader/v0"
+	dcom_vds_ivdsservicesan_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsservicesan/v0"
+	dcom_vds_ivdsservicesw_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsservicesw/v0"
+	dcom_vds_ivdsserviceuninstalldisk_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsserviceuninstalldisk/v0"
+	dcom_vds_ivdssubsystemimporttarget_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdssubsystemimporttarget/v0"
+	dcom_vds_ivdsswprovider_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsswprovider/v0"
+	dcom_vds_ivdsvdisk_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvdisk/v0"
+	dcom_vds_ivdsvdprovider_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvdprovider/v0"
+	dcom_vds_ivdsvolume_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolume/v0"
+	dcom_vds_ivdsvolume2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolume2/v0"
+	dcom_vds_ivdsvolumemf_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolumemf/v0"
+	dcom_vds_ivdsvolumemf2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolumemf2/v0"
+	dcom_vds_ivdsvolumemf3_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolumemf3/v0"
+	dcom_vds_ivdsvolumeonline_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolumeonline/v0"
+	dcom_vds_ivdsvolumeplex_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolumeplex/v0"
+	dcom_vds_ivdsvolumeshrink_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/vds/ivdsvolumeshrink/v0"
+	dcom_wcce_icertrequestd_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wcce/icertrequestd/v0"
+	dcom_wcce_icertrequestd2_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wcce/icertrequestd2/v0"
+	dcom_wmi_ienumwbemclassobject_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/ienumwbemclassobject/v0"
+	dcom_wmi_iwbembackuprestore_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbembackuprestore/v0"
+	dcom_wmi_iwbembackuprestoreex_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbembackuprestoreex/v0"
+	dcom_wmi_iwbemcallresult_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemcallresult/v0"
+	dcom_wmi_iwbemfetchsmartenum_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemfetchsmartenum/v0"
+	dcom_wmi_iwbemlevel1login_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemlevel1login/v0"
+	dcom_wmi_iwbemloginclientid_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemloginclientid/v0"
+	dcom_wmi_iwbemloginhelper_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemloginhelper/v0"
+	dcom_wmi_iwbemobjectsink_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemobjectsink/v0"
+	dcom_wmi_iwbemrefreshingservices_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemrefreshingservices/v0"
+	dcom_wmi_iwbemremoterefresher_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemremoterefresher/v0"
+	dcom_wmi_iwbemservices_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemservices/v0"
+	dcom_wmi_iwbemwcosmartenum_v0 "github.com/oiweiwei/go-msrpc/msrpc/dcom/wmi/iwbemwcosmartenum/v0"
+	dfsnm_netdfs_v3 "github.com/oiweiwei/go-msrpc/msrpc/dfsnm/netdfs/v3"
+	dhcpm_dhcpsrv_v1 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv/v1"
+	dhcpm_dhcpsrv2_v1 "github.com/oiweiwei/go-msrpc/msrpc/dhcpm/dhcpsrv2/v1"
+	dltm_trksvr_v1 "github.com/oiweiwei/go-msrpc/msrpc/dltm/trksvr/v1"
+	dltw_trkwks_v1 "github.com/oiweiwei/go-msrpc/msrpc/dltw/trkwks/v1"
+	dnsp_dnsserver_v5 "github.com/oiweiwei/go-msrpc/msrpc/dnsp/dnsserver/v5"
+	drsr_drsuapi_v4 "github.com/oiweiwei/go-msrpc/msrpc/drsr/drsuapi/v4"
+	drsr_dsaop_v1 "github.com/oiweiwei/go-msrpc/msrpc/drsr/dsaop/v1"
+	dssp_dssetup_v0 "github.com/oiweiwei/go-msrpc/msrpc/dssp/dssetup/v0"
+	epm_epm_v3 "github.com/oiweiwei/go-msrpc/msrpc/epm/epm/v3"
+	even_eventlog_v0 "github.com/oiweiwei/go-msrpc/msrpc/even/eventlog/v0"
+	even6_ieventservice_v1 "github.com/oiweiwei/go-msrpc/msrpc/even6/ieventservice/v1"
+	fasp_remotefw_v1 "github.com/oiweiwei/go-msrpc/msrpc/fasp/remotefw/v1"
+	fax_fax_v4 "github.com/oiweiwei/go-msrpc/msrpc/fax/fax/v4"
+	fax_faxclient_v3 "github.com/oiweiwei/go-msrpc/msrpc/fax/faxclient/v3"
+	fax_faxobs_v4 "github.com/oiweiwei/go-msrpc/msrpc/fax/faxobs/v4"
+	fsrvp_fileservervssagent_v1 "github.com/oiweiwei/go-msrpc/msrpc/fsrvp/fileservervssagent/v1"
+	gkdi_isdkey_v1 "github.com/oiweiwei/go-msrpc/msrpc/gkdi/isdkey/v1"
+	icpr_icertpassage_v0 "github.com/oiweiwei/go-msrpc/msrpc/icpr/icertpassage/v0"
+	irp_inetinfo_v2 "github.com/oiweiwei/go-msrpc/msrpc/irp/inetinfo/v2"
+	lrec_neteventforwarder_v1 "github.com/oiweiwei/go-msrpc/msrpc/lrec/neteventforwarder/v1"
+	lsad_lsarpc_v0 "github.com/oiweiwei/go-msrpc/msrpc/lsad/lsarpc/v0"
+	lsat_lsarpc_v0 "github.com/oiweiwei/go-msrpc/msrpc/lsat/lsarpc/v0"
+	mqds_dscomm_v1 "github.com/oiweiwei/go-msrpc/msrpc/mqds/dscomm/v1"
+	mqds_dscomm2_v1 "github.com/oiweiwei/go-msrpc/msrpc/mqds/dscomm2/v1"
+	mqmp_qmcomm_v1 "github.com/oiweiwei/go-msrpc/msrpc/mqmp/qmcomm/v1"
+	mqmp_qmcomm2_v1 "github.com/oiweiwei/go-msrpc/msrpc/mqmp/qmcomm2/v1"
+	mqmr_qmmgmt_v1 "github.com/oiweiwei/go-msrpc/msrpc/mqmr/qmmgmt/v1"
+	mqqp_qm2qm_v1 "github.com/oiweiwei/go-msrpc/msrpc/mqqp/qm2qm/v1"
+	mqrr_remoteread_v1 "github.com/oiweiwei/go-msrpc/msrpc/mqrr/remoteread/v1"
+	msrp_msgsvc_v1 "github.com/oiweiwei/go-msrpc/msrpc/msrp/msgsvc/v1"
+	msrp_msgsvcsend_v1 "github.com/oiweiwei/go-msrpc/msrpc/msrp/msgsvcsend/v1"
+	nrpc_logon_v1 "github.com/oiweiwei/go-msrpc/msrpc/nrpc/logon/v1"
+	nspi_nspi_v56 "github.com/oiweiwei/go-msrpc/msrpc/nspi/nspi/v56"
+	oxabref_rfri_v1 "github.com/oiweiwei/go-msrpc/msrpc/oxabref/rfri/v1"
+	oxcrpc_asyncemsmdb_v0 "github.com/oiweiwei/go-msrpc/msrpc/oxcrpc/asyncemsmdb/v0"
+	oxcrpc_emsmdb_v0 "github.com/oiweiwei/go-msrpc/msrpc/oxcrpc/emsmdb/v0"
+	pan_irpcasyncnotify_v1 "github.com/oiweiwei/go-msrpc/msrpc/pan/irpcasyncnotify/v1"
+	pan_irpcremoteobject_v1 "github.com/oiweiwei/go-msrpc/msrpc/pan/irpcremoteobject/v1"
+	par_iremotewinspool_v1 "github.com/oiweiwei/go-msrpc/msrpc/par/iremotewinspool/v1"
+	pcq_perflibv2_v1 "github.com/oiweiwei/go-msrpc/msrpc/pcq/perflibv2/v1"
+	raa_authzr_v0 "github.com/oiweiwei/go-msrpc/msrpc/raa/authzr/v0"
+	raiw "github.com/oiweiwei/go-msrpc/msrpc/raiw"
+	raiw_winsi2_v1 "github.com/oiweiwei/go-msrpc/msrpc/raiw/winsi2/v1"
+	raiw_winsif_v1 "github.com/oiweiwei/go-msrpc/msrpc/raiw/winsif/v1"
+	rpcl_loctoloc_v1 "github.com/oiweiwei/go-msrpc/msrpc/rpcl/loctoloc/v1"
+	rprn_winspool_v1 "github.com/oiweiwei/go-msrpc/msrpc/rprn/winspool/v1"
+	rrp_winreg_v1 "github.com/oiweiwei/go-msrpc/msrpc/rrp/winreg/v1"
+	rsp_initshutdown_v1 "github.com/oiweiwei/go-msrpc/msrpc/rsp/initshutdown/v1"
+	rsp_windowsshutdown_v1 "github.com/oiweiwei/go-msrpc/msrpc/rsp/windowsshutdown/v1"
+	rsp_winreg_v1 "github.com/oiweiwei/go-msrpc/msrpc/rsp/winreg/v1"
+	samr_samr_v1 "github.com/oiweiwei/go-msrpc/msrpc/samr/samr/v1"
+	sch_itaskschedulerservice_v1 "github.com/oiweiwei/go-msrpc/msrpc/sch/itaskschedulerservice/v1"
+	scmr_svcctl_v2 "github.com/oiweiwei/go-msrpc/msrpc/scmr/svcctl/v2"
+	srvs_srvsvc_v3 "github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+	ssp_isinglesignonremotemastersecret_v1 "github.com/oiweiwei/go-msrpc/msrpc/ssp/isinglesignonremotemastersecret/v1"
+	swn_witness_v1 "github.com/oiweiwei/go-msrpc/msrpc/swn/witness/v1"
+	trp_remotesp_v1 "github.com/oiweiwei/go-msrpc/msrpc/trp/remotesp/v1"
+	trp_tapsrv_v1 "github.com/oiweiwei/go-msrpc/msrpc/trp/tapsrv/v1"
+	tsch_atsvc_v1 "github.com/oiweiwei/go-msrpc/msrpc/tsch/atsvc/v1"
+	tsch_itaskschedulerservice_v1 "github.com/oiweiwei/go-msrpc/msrpc/tsch/itaskschedulerservice/v1"
+	tsch_sasec_v1 "github.com/oiweiwei/go-msrpc/msrpc/tsch/sasec/v1"
+	tsgu_tsproxyrpcinterface_v1 "github.com/oiweiwei/go-msrpc/msrpc/tsgu/tsproxyrpcinterface/v1"
+	w32t_w32time_v4 "github.com/oiweiwei/go-msrpc/msrpc/w32t/w32time/v4"
+	wdsc_wdsrpc_v1 "github.com/oiweiwei/go-msrpc/msrpc/wdsc/wdsrpc/v1"
+	wkst_wkssvc_v1 "github.com/oiweiwei/go-msrpc/msrpc/wkst/wkssvc/v1"
+)
+
+// Types is the list of the request and response types of the generated packages.
+var Types = []Type{
+	{"bkrp/backupkey/v1.BackupKeyRequest", func() ndr.Unmarshaler { return new(bkrp_backupkey_v1.BackupKeyRequest) }},
+	{"bkrp/backupkey/v1.BackupKeyResponse", func() ndr.Unmarshaler { return new(bkrp_backupkey_v1.BackupKeyResponse) }},
+	{"bpau/bitspeerauth/v1.ExchangePublicKeysRequest", func() ndr.Unmarshaler { return new(bpau_bitspeerauth_v1.ExchangePublicKeysRequest) }},
+	{"bpau/bitspeerauth/v1.ExchangePublicKeysResponse", func() ndr.Unmarshaler { return new(bpau_bitspeerauth_v1.ExchangePublicKeysResponse) }},
+	{"brwsa/browser/v0.QueryOtherDomainsRequest", func() ndr.Unmarshaler { return new(brwsa_browser_v0.QueryOtherDomainsRequest) }},
+	{"brwsa/browser/v0.QueryOtherDomainsResponse", func() ndr.Unmarshaler { return new(brwsa_browser_v0.QueryOtherDomainsResponse) }},
+	{"capr/lsacap/v1.GetAvailableCapIDsRequest", func() ndr.Unmarshaler { return new(capr_lsacap_v1.GetAvailableCapIDsRequest) }},
+	{"capr/lsacap/v1.GetAvailableCapIDsResponse", func() ndr.Unmarshaler { return new(capr_lsacap_v1.GetAvailableCapIDsResponse) }},
+	{"cmpo/ixnremote/v1.BeginTearDownRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.BeginTearDownRequest) }},
+	{"cmpo/ixnremote/v1.BeginTearDownResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.BeginTearDownResponse) }},
+	{"cmpo/ixnremote/v1.BuildContextRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.BuildContextRequest) }},
+	{"cmpo/ixnremote/v1.BuildContextResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.BuildContextResponse) }},
+	{"cmpo/ixnremote/v1.BuildContextWRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.BuildContextWRequest) }},
+	{"cmpo/ixnremote/v1.BuildContextWResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.BuildContextWResponse) }},
+	{"cmpo/ixnremote/v1.NegotiateResourcesRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.NegotiateResourcesRequest) }},
+	{"cmpo/ixnremote/v1.NegotiateResourcesResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.NegotiateResourcesResponse) }},
+	{"cmpo/ixnremote/v1.PokeRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.PokeRequest) }},
+	{"cmpo/ixnremote/v1.PokeResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.PokeResponse) }},
+	{"cmpo/ixnremote/v1.PokeWRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.PokeWRequest) }},
+	{"cmpo/ixnremote/v1.PokeWResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.PokeWResponse) }},
+	{"cmpo/ixnremote/v1.SendReceiveRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.SendReceiveRequest) }},
+	{"cmpo/ixnremote/v1.SendReceiveResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.SendReceiveResponse) }},
+	{"cmpo/ixnremote/v1.TearDownContextRequest", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.TearDownContextRequest) }},
+	{"cmpo/ixnremote/v1.TearDownContextResponse", func() ndr.Unmarshaler { return new(cmpo_ixnremote_v1.TearDownContextResponse) }},
+	{"cmrp/clusapi2/v2.AddNotifyClusterRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyClusterRequest) }},
+	{"cmrp/clusapi2/v2.AddNotifyClusterResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyClusterResponse) }},
+	{"cmrp/clusapi2/v2.AddNotifyGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyGroupRequest) }},
+	{"cmrp/clusapi2/v2.AddNotifyGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyGroupResponse) }},
+	{"cmrp/clusapi2/v2.AddNotifyKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyKeyRequest) }},
+	{"cmrp/clusapi2/v2.AddNotifyKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyKeyResponse) }},
+	{"cmrp/clusapi2/v2.AddNotifyNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyNetInterfaceRequest) }},
+	{"cmrp/clusapi2/v2.AddNotifyNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyNetInterfaceResponse) }},
+	{"cmrp/clusapi2/v2.AddNotifyNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyNetworkRequest) }},
+	{"cmrp/clusapi2/v2.AddNotifyNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyNetworkResponse) }},
+	{"cmrp/clusapi2/v2.AddNotifyNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyNodeRequest) }},
+	{"cmrp/clusapi2/v2.AddNotifyNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyNodeResponse) }},
+	{"cmrp/clusapi2/v2.AddNotifyResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyResourceRequest) }},
+	{"cmrp/clusapi2/v2.AddNotifyResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddNotifyResourceResponse) }},
+	{"cmrp/clusapi2/v2.AddResourceDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddResourceDependencyRequest) }},
+	{"cmrp/clusapi2/v2.AddResourceDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddResourceDependencyResponse) }},
+	{"cmrp/clusapi2/v2.AddResourceNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddResourceNodeRequest) }},
+	{"cmrp/clusapi2/v2.AddResourceNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.AddResourceNodeResponse) }},
+	{"cmrp/clusapi2/v2.BackupClusterDatabaseRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.BackupClusterDatabaseRequest) }},
+	{"cmrp/clusapi2/v2.BackupClusterDatabaseResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.BackupClusterDatabaseResponse) }},
+	{"cmrp/clusapi2/v2.CanResourceBeDependentRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CanResourceBeDependentRequest) }},
+	{"cmrp/clusapi2/v2.CanResourceBeDependentResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CanResourceBeDependentResponse) }},
+	{"cmrp/clusapi2/v2.ChangeResourceGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ChangeResourceGroupRequest) }},
+	{"cmrp/clusapi2/v2.ChangeResourceGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ChangeResourceGroupResponse) }},
+	{"cmrp/clusapi2/v2.CloseClusterRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseClusterRequest) }},
+	{"cmrp/clusapi2/v2.CloseClusterResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseClusterResponse) }},
+	{"cmrp/clusapi2/v2.CloseGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseGroupRequest) }},
+	{"cmrp/clusapi2/v2.CloseGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseGroupResponse) }},
+	{"cmrp/clusapi2/v2.CloseKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseKeyRequest) }},
+	{"cmrp/clusapi2/v2.CloseKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseKeyResponse) }},
+	{"cmrp/clusapi2/v2.CloseNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNetInterfaceRequest) }},
+	{"cmrp/clusapi2/v2.CloseNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNetInterfaceResponse) }},
+	{"cmrp/clusapi2/v2.CloseNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNetworkRequest) }},
+	{"cmrp/clusapi2/v2.CloseNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNetworkResponse) }},
+	{"cmrp/clusapi2/v2.CloseNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNodeRequest) }},
+	{"cmrp/clusapi2/v2.CloseNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNodeResponse) }},
+	{"cmrp/clusapi2/v2.CloseNotifyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNotifyRequest) }},
+	{"cmrp/clusapi2/v2.CloseNotifyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseNotifyResponse) }},
+	{"cmrp/clusapi2/v2.CloseResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseResourceRequest) }},
+	{"cmrp/clusapi2/v2.CloseResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CloseResourceResponse) }},
+	{"cmrp/clusapi2/v2.ClusterControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ClusterControlRequest) }},
+	{"cmrp/clusapi2/v2.ClusterControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ClusterControlResponse) }},
+	{"cmrp/clusapi2/v2.CreateEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateEnumRequest) }},
+	{"cmrp/clusapi2/v2.CreateEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateEnumResponse) }},
+	{"cmrp/clusapi2/v2.CreateGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateGroupRequest) }},
+	{"cmrp/clusapi2/v2.CreateGroupResourceEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateGroupResourceEnumRequest) }},
+	{"cmrp/clusapi2/v2.CreateGroupResourceEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateGroupResourceEnumResponse) }},
+	{"cmrp/clusapi2/v2.CreateGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateGroupResponse) }},
+	{"cmrp/clusapi2/v2.CreateKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateKeyRequest) }},
+	{"cmrp/clusapi2/v2.CreateKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateKeyResponse) }},
+	{"cmrp/clusapi2/v2.CreateNetworkEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateNetworkEnumRequest) }},
+	{"cmrp/clusapi2/v2.CreateNetworkEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateNetworkEnumResponse) }},
+	{"cmrp/clusapi2/v2.CreateNodeEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateNodeEnumRequest) }},
+	{"cmrp/clusapi2/v2.CreateNodeEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateNodeEnumResponse) }},
+	{"cmrp/clusapi2/v2.CreateNotifyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateNotifyRequest) }},
+	{"cmrp/clusapi2/v2.CreateNotifyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateNotifyResponse) }},
+	{"cmrp/clusapi2/v2.CreateResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateResourceRequest) }},
+	{"cmrp/clusapi2/v2.CreateResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateResourceResponse) }},
+	{"cmrp/clusapi2/v2.CreateResourceTypeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateResourceTypeRequest) }},
+	{"cmrp/clusapi2/v2.CreateResourceTypeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateResourceTypeResponse) }},
+	{"cmrp/clusapi2/v2.CreateRestrictionEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateRestrictionEnumRequest) }},
+	{"cmrp/clusapi2/v2.CreateRestrictionEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateRestrictionEnumResponse) }},
+	{"cmrp/clusapi2/v2.CreateRestrictionTypeEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateRestrictionTypeEnumRequest) }},
+	{"cmrp/clusapi2/v2.CreateRestrictionTypeEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.CreateRestrictionTypeEnumResponse) }},
+	{"cmrp/clusapi2/v2.DeleteGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteGroupRequest) }},
+	{"cmrp/clusapi2/v2.DeleteGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteGroupResponse) }},
+	{"cmrp/clusapi2/v2.DeleteKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteKeyRequest) }},
+	{"cmrp/clusapi2/v2.DeleteKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteKeyResponse) }},
+	{"cmrp/clusapi2/v2.DeleteResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteResourceRequest) }},
+	{"cmrp/clusapi2/v2.DeleteResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteResourceResponse) }},
+	{"cmrp/clusapi2/v2.DeleteResourceTypeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteResourceTypeRequest) }},
+	{"cmrp/clusapi2/v2.DeleteResourceTypeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteResourceTypeResponse) }},
+	{"cmrp/clusapi2/v2.DeleteValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteValueRequest) }},
+	{"cmrp/clusapi2/v2.DeleteValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.DeleteValueResponse) }},
+	{"cmrp/clusapi2/v2.EnumKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.EnumKeyRequest) }},
+	{"cmrp/clusapi2/v2.EnumKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.EnumKeyResponse) }},
+	{"cmrp/clusapi2/v2.EnumValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.EnumValueRequest) }},
+	{"cmrp/clusapi2/v2.EnumValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.EnumValueResponse) }},
+	{"cmrp/clusapi2/v2.EvictNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.EvictNodeRequest) }},
+	{"cmrp/clusapi2/v2.EvictNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.EvictNodeResponse) }},
+	{"cmrp/clusapi2/v2.FailResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.FailResourceRequest) }},
+	{"cmrp/clusapi2/v2.FailResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.FailResourceResponse) }},
+	{"cmrp/clusapi2/v2.GetClusterNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetClusterNameRequest) }},
+	{"cmrp/clusapi2/v2.GetClusterNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetClusterNameResponse) }},
+	{"cmrp/clusapi2/v2.GetClusterVersion2Request", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetClusterVersion2Request) }},
+	{"cmrp/clusapi2/v2.GetClusterVersion2Response", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetClusterVersion2Response) }},
+	{"cmrp/clusapi2/v2.GetClusterVersionRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetClusterVersionRequest) }},
+	{"cmrp/clusapi2/v2.GetClusterVersionResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetClusterVersionResponse) }},
+	{"cmrp/clusapi2/v2.GetGroupIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetGroupIDRequest) }},
+	{"cmrp/clusapi2/v2.GetGroupIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetGroupIDResponse) }},
+	{"cmrp/clusapi2/v2.GetGroupStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetGroupStateRequest) }},
+	{"cmrp/clusapi2/v2.GetGroupStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetGroupStateResponse) }},
+	{"cmrp/clusapi2/v2.GetKeySecurityRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetKeySecurityRequest) }},
+	{"cmrp/clusapi2/v2.GetKeySecurityResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetKeySecurityResponse) }},
+	{"cmrp/clusapi2/v2.GetNetInterfaceIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetInterfaceIDRequest) }},
+	{"cmrp/clusapi2/v2.GetNetInterfaceIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetInterfaceIDResponse) }},
+	{"cmrp/clusapi2/v2.GetNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetInterfaceRequest) }},
+	{"cmrp/clusapi2/v2.GetNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetInterfaceResponse) }},
+	{"cmrp/clusapi2/v2.GetNetInterfaceStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetInterfaceStateRequest) }},
+	{"cmrp/clusapi2/v2.GetNetInterfaceStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetInterfaceStateResponse) }},
+	{"cmrp/clusapi2/v2.GetNetworkIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetworkIDRequest) }},
+	{"cmrp/clusapi2/v2.GetNetworkIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetworkIDResponse) }},
+	{"cmrp/clusapi2/v2.GetNetworkStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetworkStateRequest) }},
+	{"cmrp/clusapi2/v2.GetNetworkStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNetworkStateResponse) }},
+	{"cmrp/clusapi2/v2.GetNodeIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNodeIDRequest) }},
+	{"cmrp/clusapi2/v2.GetNodeIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNodeIDResponse) }},
+	{"cmrp/clusapi2/v2.GetNodeStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNodeStateRequest) }},
+	{"cmrp/clusapi2/v2.GetNodeStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNodeStateResponse) }},
+	{"cmrp/clusapi2/v2.GetNotifyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNotifyRequest) }},
+	{"cmrp/clusapi2/v2.GetNotifyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetNotifyResponse) }},
+	{"cmrp/clusapi2/v2.GetQuorumResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetQuorumResourceRequest) }},
+	{"cmrp/clusapi2/v2.GetQuorumResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetQuorumResourceResponse) }},
+	{"cmrp/clusapi2/v2.GetResourceIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetResourceIDRequest) }},
+	{"cmrp/clusapi2/v2.GetResourceIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetResourceIDResponse) }},
+	{"cmrp/clusapi2/v2.GetResourceStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetResourceStateRequest) }},
+	{"cmrp/clusapi2/v2.GetResourceStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetResourceStateResponse) }},
+	{"cmrp/clusapi2/v2.GetResourceTypeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetResourceTypeRequest) }},
+	{"cmrp/clusapi2/v2.GetResourceTypeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetResourceTypeResponse) }},
+	{"cmrp/clusapi2/v2.GetRootKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetRootKeyRequest) }},
+	{"cmrp/clusapi2/v2.GetRootKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GetRootKeyResponse) }},
+	{"cmrp/clusapi2/v2.GroupControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GroupControlRequest) }},
+	{"cmrp/clusapi2/v2.GroupControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.GroupControlResponse) }},
+	{"cmrp/clusapi2/v2.MoveGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.MoveGroupRequest) }},
+	{"cmrp/clusapi2/v2.MoveGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.MoveGroupResponse) }},
+	{"cmrp/clusapi2/v2.MoveGroupToNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.MoveGroupToNodeRequest) }},
+	{"cmrp/clusapi2/v2.MoveGroupToNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.MoveGroupToNodeResponse) }},
+	{"cmrp/clusapi2/v2.NetInterfaceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NetInterfaceControlRequest) }},
+	{"cmrp/clusapi2/v2.NetInterfaceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NetInterfaceControlResponse) }},
+	{"cmrp/clusapi2/v2.NetworkControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NetworkControlRequest) }},
+	{"cmrp/clusapi2/v2.NetworkControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NetworkControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeClusterControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeClusterControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeClusterControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeClusterControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeGroupControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeGroupControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeGroupControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeGroupControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeNetInterfaceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeNetInterfaceControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeNetInterfaceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeNetInterfaceControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeNetworkControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeNetworkControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeNetworkControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeNetworkControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeNodeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeNodeControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeNodeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeNodeControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeResourceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeResourceControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeResourceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeResourceControlResponse) }},
+	{"cmrp/clusapi2/v2.NodeResourceTypeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeResourceTypeControlRequest) }},
+	{"cmrp/clusapi2/v2.NodeResourceTypeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.NodeResourceTypeControlResponse) }},
+	{"cmrp/clusapi2/v2.OfflineGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OfflineGroupRequest) }},
+	{"cmrp/clusapi2/v2.OfflineGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OfflineGroupResponse) }},
+	{"cmrp/clusapi2/v2.OfflineResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OfflineResourceRequest) }},
+	{"cmrp/clusapi2/v2.OfflineResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OfflineResourceResponse) }},
+	{"cmrp/clusapi2/v2.OnlineGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OnlineGroupRequest) }},
+	{"cmrp/clusapi2/v2.OnlineGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OnlineGroupResponse) }},
+	{"cmrp/clusapi2/v2.OnlineResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OnlineResourceRequest) }},
+	{"cmrp/clusapi2/v2.OnlineResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OnlineResourceResponse) }},
+	{"cmrp/clusapi2/v2.OpenClusterRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenClusterRequest) }},
+	{"cmrp/clusapi2/v2.OpenClusterResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenClusterResponse) }},
+	{"cmrp/clusapi2/v2.OpenGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenGroupRequest) }},
+	{"cmrp/clusapi2/v2.OpenGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenGroupResponse) }},
+	{"cmrp/clusapi2/v2.OpenKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenKeyRequest) }},
+	{"cmrp/clusapi2/v2.OpenKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenKeyResponse) }},
+	{"cmrp/clusapi2/v2.OpenNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenNetInterfaceRequest) }},
+	{"cmrp/clusapi2/v2.OpenNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenNetInterfaceResponse) }},
+	{"cmrp/clusapi2/v2.OpenNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenNetworkRequest) }},
+	{"cmrp/clusapi2/v2.OpenNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenNetworkResponse) }},
+	{"cmrp/clusapi2/v2.OpenNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenNodeRequest) }},
+	{"cmrp/clusapi2/v2.OpenNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenNodeResponse) }},
+	{"cmrp/clusapi2/v2.OpenResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenResourceRequest) }},
+	{"cmrp/clusapi2/v2.OpenResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.OpenResourceResponse) }},
+	{"cmrp/clusapi2/v2.PauseNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.PauseNodeRequest) }},
+	{"cmrp/clusapi2/v2.PauseNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.PauseNodeResponse) }},
+	{"cmrp/clusapi2/v2.QueryInfoKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.QueryInfoKeyRequest) }},
+	{"cmrp/clusapi2/v2.QueryInfoKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.QueryInfoKeyResponse) }},
+	{"cmrp/clusapi2/v2.QueryValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.QueryValueRequest) }},
+	{"cmrp/clusapi2/v2.QueryValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.QueryValueResponse) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyGroupRequest) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyGroupResponse) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyNetInterfaceRequest) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyNetInterfaceResponse) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyNetworkRequest) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyNetworkResponse) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyNodeRequest) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyNodeResponse) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyResourceRequest) }},
+	{"cmrp/clusapi2/v2.ReAddNotifyResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ReAddNotifyResourceResponse) }},
+	{"cmrp/clusapi2/v2.RemoveResourceDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.RemoveResourceDependencyRequest) }},
+	{"cmrp/clusapi2/v2.RemoveResourceDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.RemoveResourceDependencyResponse) }},
+	{"cmrp/clusapi2/v2.RemoveResourceNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.RemoveResourceNodeRequest) }},
+	{"cmrp/clusapi2/v2.RemoveResourceNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.RemoveResourceNodeResponse) }},
+	{"cmrp/clusapi2/v2.ResourceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ResourceControlRequest) }},
+	{"cmrp/clusapi2/v2.ResourceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ResourceControlResponse) }},
+	{"cmrp/clusapi2/v2.ResourceTypeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ResourceTypeControlRequest) }},
+	{"cmrp/clusapi2/v2.ResourceTypeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ResourceTypeControlResponse) }},
+	{"cmrp/clusapi2/v2.ResumeNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ResumeNodeRequest) }},
+	{"cmrp/clusapi2/v2.ResumeNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.ResumeNodeResponse) }},
+	{"cmrp/clusapi2/v2.SetClusterNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetClusterNameRequest) }},
+	{"cmrp/clusapi2/v2.SetClusterNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetClusterNameResponse) }},
+	{"cmrp/clusapi2/v2.SetGroupNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetGroupNameRequest) }},
+	{"cmrp/clusapi2/v2.SetGroupNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetGroupNameResponse) }},
+	{"cmrp/clusapi2/v2.SetGroupNodeListRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetGroupNodeListRequest) }},
+	{"cmrp/clusapi2/v2.SetGroupNodeListResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetGroupNodeListResponse) }},
+	{"cmrp/clusapi2/v2.SetKeySecurityRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetKeySecurityRequest) }},
+	{"cmrp/clusapi2/v2.SetKeySecurityResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetKeySecurityResponse) }},
+	{"cmrp/clusapi2/v2.SetNetworkNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetNetworkNameRequest) }},
+	{"cmrp/clusapi2/v2.SetNetworkNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetNetworkNameResponse) }},
+	{"cmrp/clusapi2/v2.SetNetworkPriorityOrderRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetNetworkPriorityOrderRequest) }},
+	{"cmrp/clusapi2/v2.SetNetworkPriorityOrderResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetNetworkPriorityOrderResponse) }},
+	{"cmrp/clusapi2/v2.SetQuorumResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetQuorumResourceRequest) }},
+	{"cmrp/clusapi2/v2.SetQuorumResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetQuorumResourceResponse) }},
+	{"cmrp/clusapi2/v2.SetResourceNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetResourceNameRequest) }},
+	{"cmrp/clusapi2/v2.SetResourceNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetResourceNameResponse) }},
+	{"cmrp/clusapi2/v2.SetServiceAccountPasswordRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetServiceAccountPasswordRequest) }},
+	{"cmrp/clusapi2/v2.SetServiceAccountPasswordResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetServiceAccountPasswordResponse) }},
+	{"cmrp/clusapi2/v2.SetValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetValueRequest) }},
+	{"cmrp/clusapi2/v2.SetValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.SetValueResponse) }},
+	{"cmrp/clusapi2/v2.UnblockGetNotifyCallRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.UnblockGetNotifyCallRequest) }},
+	{"cmrp/clusapi2/v2.UnblockGetNotifyCallResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi2_v2.UnblockGetNotifyCallResponse) }},
+	{"cmrp/clusapi3/v3.AddGroupSetDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupSetDependencyRequest) }},
+	{"cmrp/clusapi3/v3.AddGroupSetDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupSetDependencyResponse) }},
+	{"cmrp/clusapi3/v3.AddGroupToGroupSetDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupToGroupSetDependencyRequest) }},
+	{"cmrp/clusapi3/v3.AddGroupToGroupSetDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupToGroupSetDependencyResponse) }},
+	{"cmrp/clusapi3/v3.AddGroupToGroupSetExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupToGroupSetExRequest) }},
+	{"cmrp/clusapi3/v3.AddGroupToGroupSetExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupToGroupSetExResponse) }},
+	{"cmrp/clusapi3/v3.AddGroupToGroupSetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupToGroupSetRequest) }},
+	{"cmrp/clusapi3/v3.AddGroupToGroupSetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddGroupToGroupSetResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyClusterRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyClusterRequest) }},
+	{"cmrp/clusapi3/v3.AddNotifyClusterResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyClusterResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyGroupRequest) }},
+	{"cmrp/clusapi3/v3.AddNotifyGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyGroupResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyKeyRequest) }},
+	{"cmrp/clusapi3/v3.AddNotifyKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyKeyResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyNetInterfaceRequest) }},
+	{"cmrp/clusapi3/v3.AddNotifyNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyNetInterfaceResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyNetworkRequest) }},
+	{"cmrp/clusapi3/v3.AddNotifyNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyNetworkResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyNodeRequest) }},
+	{"cmrp/clusapi3/v3.AddNotifyNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyNodeResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyResourceRequest) }},
+	{"cmrp/clusapi3/v3.AddNotifyResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyResourceResponse) }},
+	{"cmrp/clusapi3/v3.AddNotifyResourceTypeV2Request", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyResourceTypeV2Request) }},
+	{"cmrp/clusapi3/v3.AddNotifyResourceTypeV2Response", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyResourceTypeV2Response) }},
+	{"cmrp/clusapi3/v3.AddNotifyV2Request", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyV2Request) }},
+	{"cmrp/clusapi3/v3.AddNotifyV2Response", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddNotifyV2Response) }},
+	{"cmrp/clusapi3/v3.AddResourceDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddResourceDependencyRequest) }},
+	{"cmrp/clusapi3/v3.AddResourceDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddResourceDependencyResponse) }},
+	{"cmrp/clusapi3/v3.AddResourceNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddResourceNodeRequest) }},
+	{"cmrp/clusapi3/v3.AddResourceNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.AddResourceNodeResponse) }},
+	{"cmrp/clusapi3/v3.BackupClusterDatabaseRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.BackupClusterDatabaseRequest) }},
+	{"cmrp/clusapi3/v3.BackupClusterDatabaseResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.BackupClusterDatabaseResponse) }},
+	{"cmrp/clusapi3/v3.CanResourceBeDependentRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CanResourceBeDependentRequest) }},
+	{"cmrp/clusapi3/v3.CanResourceBeDependentResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CanResourceBeDependentResponse) }},
+	{"cmrp/clusapi3/v3.CancelClusterGroupOperationRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CancelClusterGroupOperationRequest) }},
+	{"cmrp/clusapi3/v3.CancelClusterGroupOperationResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CancelClusterGroupOperationResponse) }},
+	{"cmrp/clusapi3/v3.ChangeCSVStateExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeCSVStateExRequest) }},
+	{"cmrp/clusapi3/v3.ChangeCSVStateExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeCSVStateExResponse) }},
+	{"cmrp/clusapi3/v3.ChangeCSVStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeCSVStateRequest) }},
+	{"cmrp/clusapi3/v3.ChangeCSVStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeCSVStateResponse) }},
+	{"cmrp/clusapi3/v3.ChangeResourceGroupExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeResourceGroupExRequest) }},
+	{"cmrp/clusapi3/v3.ChangeResourceGroupExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeResourceGroupExResponse) }},
+	{"cmrp/clusapi3/v3.ChangeResourceGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeResourceGroupRequest) }},
+	{"cmrp/clusapi3/v3.ChangeResourceGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ChangeResourceGroupResponse) }},
+	{"cmrp/clusapi3/v3.CloseBatchPortRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseBatchPortRequest) }},
+	{"cmrp/clusapi3/v3.CloseBatchPortResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseBatchPortResponse) }},
+	{"cmrp/clusapi3/v3.CloseClusterRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseClusterRequest) }},
+	{"cmrp/clusapi3/v3.CloseClusterResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseClusterResponse) }},
+	{"cmrp/clusapi3/v3.CloseGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseGroupRequest) }},
+	{"cmrp/clusapi3/v3.CloseGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseGroupResponse) }},
+	{"cmrp/clusapi3/v3.CloseGroupSetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseGroupSetRequest) }},
+	{"cmrp/clusapi3/v3.CloseGroupSetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseGroupSetResponse) }},
+	{"cmrp/clusapi3/v3.CloseKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseKeyRequest) }},
+	{"cmrp/clusapi3/v3.CloseKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseKeyResponse) }},
+	{"cmrp/clusapi3/v3.CloseNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNetInterfaceRequest) }},
+	{"cmrp/clusapi3/v3.CloseNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNetInterfaceResponse) }},
+	{"cmrp/clusapi3/v3.CloseNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNetworkRequest) }},
+	{"cmrp/clusapi3/v3.CloseNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNetworkResponse) }},
+	{"cmrp/clusapi3/v3.CloseNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNodeRequest) }},
+	{"cmrp/clusapi3/v3.CloseNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNodeResponse) }},
+	{"cmrp/clusapi3/v3.CloseNotifyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNotifyRequest) }},
+	{"cmrp/clusapi3/v3.CloseNotifyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseNotifyResponse) }},
+	{"cmrp/clusapi3/v3.CloseResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseResourceRequest) }},
+	{"cmrp/clusapi3/v3.CloseResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CloseResourceResponse) }},
+	{"cmrp/clusapi3/v3.ClusterControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ClusterControlRequest) }},
+	{"cmrp/clusapi3/v3.ClusterControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ClusterControlResponse) }},
+	{"cmrp/clusapi3/v3.CreateBatchPortRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateBatchPortRequest) }},
+	{"cmrp/clusapi3/v3.CreateBatchPortResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateBatchPortResponse) }},
+	{"cmrp/clusapi3/v3.CreateEnumExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateEnumExRequest) }},
+	{"cmrp/clusapi3/v3.CreateEnumExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateEnumExResponse) }},
+	{"cmrp/clusapi3/v3.CreateEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateGroupEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateGroupEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateGroupExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupExRequest) }},
+	{"cmrp/clusapi3/v3.CreateGroupExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupExResponse) }},
+	{"cmrp/clusapi3/v3.CreateGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupRequest) }},
+	{"cmrp/clusapi3/v3.CreateGroupResourceEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupResourceEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateGroupResourceEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupResourceEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupResponse) }},
+	{"cmrp/clusapi3/v3.CreateGroupSetEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupSetEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateGroupSetEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupSetEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateGroupSetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupSetRequest) }},
+	{"cmrp/clusapi3/v3.CreateGroupSetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateGroupSetResponse) }},
+	{"cmrp/clusapi3/v3.CreateKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateKeyRequest) }},
+	{"cmrp/clusapi3/v3.CreateKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateKeyResponse) }},
+	{"cmrp/clusapi3/v3.CreateNetInterfaceEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNetInterfaceEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateNetInterfaceEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNetInterfaceEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateNetworkEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNetworkEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateNetworkEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNetworkEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateNodeEnumExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNodeEnumExRequest) }},
+	{"cmrp/clusapi3/v3.CreateNodeEnumExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNodeEnumExResponse) }},
+	{"cmrp/clusapi3/v3.CreateNodeEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNodeEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateNodeEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNodeEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateNotifyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNotifyRequest) }},
+	{"cmrp/clusapi3/v3.CreateNotifyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNotifyResponse) }},
+	{"cmrp/clusapi3/v3.CreateNotifyV2Request", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNotifyV2Request) }},
+	{"cmrp/clusapi3/v3.CreateNotifyV2Response", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateNotifyV2Response) }},
+	{"cmrp/clusapi3/v3.CreateResourceEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateResourceEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateResourceEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateResourceEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateResourceRequest) }},
+	{"cmrp/clusapi3/v3.CreateResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateResourceResponse) }},
+	{"cmrp/clusapi3/v3.CreateResourceTypeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateResourceTypeRequest) }},
+	{"cmrp/clusapi3/v3.CreateResourceTypeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateResourceTypeResponse) }},
+	{"cmrp/clusapi3/v3.CreateRestrictionEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateRestrictionEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateRestrictionEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateRestrictionEnumResponse) }},
+	{"cmrp/clusapi3/v3.CreateRestrictionTypeEnumRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateRestrictionTypeEnumRequest) }},
+	{"cmrp/clusapi3/v3.CreateRestrictionTypeEnumResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.CreateRestrictionTypeEnumResponse) }},
+	{"cmrp/clusapi3/v3.DeleteGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteGroupRequest) }},
+	{"cmrp/clusapi3/v3.DeleteGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteGroupResponse) }},
+	{"cmrp/clusapi3/v3.DeleteGroupSetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteGroupSetRequest) }},
+	{"cmrp/clusapi3/v3.DeleteGroupSetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteGroupSetResponse) }},
+	{"cmrp/clusapi3/v3.DeleteKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteKeyRequest) }},
+	{"cmrp/clusapi3/v3.DeleteKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteKeyResponse) }},
+	{"cmrp/clusapi3/v3.DeleteResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteResourceRequest) }},
+	{"cmrp/clusapi3/v3.DeleteResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteResourceResponse) }},
+	{"cmrp/clusapi3/v3.DeleteResourceTypeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteResourceTypeRequest) }},
+	{"cmrp/clusapi3/v3.DeleteResourceTypeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteResourceTypeResponse) }},
+	{"cmrp/clusapi3/v3.DeleteValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteValueRequest) }},
+	{"cmrp/clusapi3/v3.DeleteValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.DeleteValueResponse) }},
+	{"cmrp/clusapi3/v3.EnumKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.EnumKeyRequest) }},
+	{"cmrp/clusapi3/v3.EnumKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.EnumKeyResponse) }},
+	{"cmrp/clusapi3/v3.EnumValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.EnumValueRequest) }},
+	{"cmrp/clusapi3/v3.EnumValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.EnumValueResponse) }},
+	{"cmrp/clusapi3/v3.EvictNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.EvictNodeRequest) }},
+	{"cmrp/clusapi3/v3.EvictNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.EvictNodeResponse) }},
+	{"cmrp/clusapi3/v3.ExecuteBatchRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ExecuteBatchRequest) }},
+	{"cmrp/clusapi3/v3.ExecuteBatchResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ExecuteBatchResponse) }},
+	{"cmrp/clusapi3/v3.ExecuteReadBatchExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ExecuteReadBatchExRequest) }},
+	{"cmrp/clusapi3/v3.ExecuteReadBatchExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ExecuteReadBatchExResponse) }},
+	{"cmrp/clusapi3/v3.ExecuteReadBatchRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ExecuteReadBatchRequest) }},
+	{"cmrp/clusapi3/v3.ExecuteReadBatchResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ExecuteReadBatchResponse) }},
+	{"cmrp/clusapi3/v3.FailResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.FailResourceRequest) }},
+	{"cmrp/clusapi3/v3.FailResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.FailResourceResponse) }},
+	{"cmrp/clusapi3/v3.GetBatchNotificationRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetBatchNotificationRequest) }},
+	{"cmrp/clusapi3/v3.GetBatchNotificationResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetBatchNotificationResponse) }},
+	{"cmrp/clusapi3/v3.GetClusterNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetClusterNameRequest) }},
+	{"cmrp/clusapi3/v3.GetClusterNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetClusterNameResponse) }},
+	{"cmrp/clusapi3/v3.GetClusterVersion2Request", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetClusterVersion2Request) }},
+	{"cmrp/clusapi3/v3.GetClusterVersion2Response", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetClusterVersion2Response) }},
+	{"cmrp/clusapi3/v3.GetClusterVersionRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetClusterVersionRequest) }},
+	{"cmrp/clusapi3/v3.GetClusterVersionResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetClusterVersionResponse) }},
+	{"cmrp/clusapi3/v3.GetGroupIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetGroupIDRequest) }},
+	{"cmrp/clusapi3/v3.GetGroupIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetGroupIDResponse) }},
+	{"cmrp/clusapi3/v3.GetGroupStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetGroupStateRequest) }},
+	{"cmrp/clusapi3/v3.GetGroupStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetGroupStateResponse) }},
+	{"cmrp/clusapi3/v3.GetKeySecurityRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetKeySecurityRequest) }},
+	{"cmrp/clusapi3/v3.GetKeySecurityResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetKeySecurityResponse) }},
+	{"cmrp/clusapi3/v3.GetNetInterfaceIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetInterfaceIDRequest) }},
+	{"cmrp/clusapi3/v3.GetNetInterfaceIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetInterfaceIDResponse) }},
+	{"cmrp/clusapi3/v3.GetNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetInterfaceRequest) }},
+	{"cmrp/clusapi3/v3.GetNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetInterfaceResponse) }},
+	{"cmrp/clusapi3/v3.GetNetInterfaceStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetInterfaceStateRequest) }},
+	{"cmrp/clusapi3/v3.GetNetInterfaceStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetInterfaceStateResponse) }},
+	{"cmrp/clusapi3/v3.GetNetworkIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetworkIDRequest) }},
+	{"cmrp/clusapi3/v3.GetNetworkIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetworkIDResponse) }},
+	{"cmrp/clusapi3/v3.GetNetworkStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetworkStateRequest) }},
+	{"cmrp/clusapi3/v3.GetNetworkStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNetworkStateResponse) }},
+	{"cmrp/clusapi3/v3.GetNodeIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNodeIDRequest) }},
+	{"cmrp/clusapi3/v3.GetNodeIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNodeIDResponse) }},
+	{"cmrp/clusapi3/v3.GetNodeStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNodeStateRequest) }},
+	{"cmrp/clusapi3/v3.GetNodeStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNodeStateResponse) }},
+	{"cmrp/clusapi3/v3.GetNotifyAsyncRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNotifyAsyncRequest) }},
+	{"cmrp/clusapi3/v3.GetNotifyAsyncResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNotifyAsyncResponse) }},
+	{"cmrp/clusapi3/v3.GetNotifyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNotifyRequest) }},
+	{"cmrp/clusapi3/v3.GetNotifyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNotifyResponse) }},
+	{"cmrp/clusapi3/v3.GetNotifyV2Request", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNotifyV2Request) }},
+	{"cmrp/clusapi3/v3.GetNotifyV2Response", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetNotifyV2Response) }},
+	{"cmrp/clusapi3/v3.GetQuorumResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetQuorumResourceRequest) }},
+	{"cmrp/clusapi3/v3.GetQuorumResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetQuorumResourceResponse) }},
+	{"cmrp/clusapi3/v3.GetResourceDependencyExpressionRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceDependencyExpressionRequest) }},
+	{"cmrp/clusapi3/v3.GetResourceDependencyExpressionResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceDependencyExpressionResponse) }},
+	{"cmrp/clusapi3/v3.GetResourceIDRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceIDRequest) }},
+	{"cmrp/clusapi3/v3.GetResourceIDResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceIDResponse) }},
+	{"cmrp/clusapi3/v3.GetResourceNetworkNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceNetworkNameRequest) }},
+	{"cmrp/clusapi3/v3.GetResourceNetworkNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceNetworkNameResponse) }},
+	{"cmrp/clusapi3/v3.GetResourceStateRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceStateRequest) }},
+	{"cmrp/clusapi3/v3.GetResourceStateResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceStateResponse) }},
+	{"cmrp/clusapi3/v3.GetResourceTypeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceTypeRequest) }},
+	{"cmrp/clusapi3/v3.GetResourceTypeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetResourceTypeResponse) }},
+	{"cmrp/clusapi3/v3.GetRootKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetRootKeyRequest) }},
+	{"cmrp/clusapi3/v3.GetRootKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GetRootKeyResponse) }},
+	{"cmrp/clusapi3/v3.GroupControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GroupControlRequest) }},
+	{"cmrp/clusapi3/v3.GroupControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GroupControlResponse) }},
+	{"cmrp/clusapi3/v3.GroupSetControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GroupSetControlRequest) }},
+	{"cmrp/clusapi3/v3.GroupSetControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.GroupSetControlResponse) }},
+	{"cmrp/clusapi3/v3.MoveGroupExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupExRequest) }},
+	{"cmrp/clusapi3/v3.MoveGroupExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupExResponse) }},
+	{"cmrp/clusapi3/v3.MoveGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupRequest) }},
+	{"cmrp/clusapi3/v3.MoveGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupResponse) }},
+	{"cmrp/clusapi3/v3.MoveGroupToGroupSetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupToGroupSetRequest) }},
+	{"cmrp/clusapi3/v3.MoveGroupToGroupSetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupToGroupSetResponse) }},
+	{"cmrp/clusapi3/v3.MoveGroupToNodeExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupToNodeExRequest) }},
+	{"cmrp/clusapi3/v3.MoveGroupToNodeExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupToNodeExResponse) }},
+	{"cmrp/clusapi3/v3.MoveGroupToNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupToNodeRequest) }},
+	{"cmrp/clusapi3/v3.MoveGroupToNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.MoveGroupToNodeResponse) }},
+	{"cmrp/clusapi3/v3.NetInterfaceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NetInterfaceControlRequest) }},
+	{"cmrp/clusapi3/v3.NetInterfaceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NetInterfaceControlResponse) }},
+	{"cmrp/clusapi3/v3.NetworkControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NetworkControlRequest) }},
+	{"cmrp/clusapi3/v3.NetworkControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NetworkControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeClusterControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeClusterControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeClusterControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeClusterControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeGroupControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeGroupControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeGroupControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeGroupControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeGroupSetControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeGroupSetControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeGroupSetControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeGroupSetControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeNetInterfaceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeNetInterfaceControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeNetInterfaceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeNetInterfaceControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeNetworkControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeNetworkControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeNetworkControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeNetworkControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeNodeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeNodeControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeNodeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeNodeControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeResourceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeResourceControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeResourceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeResourceControlResponse) }},
+	{"cmrp/clusapi3/v3.NodeResourceTypeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeResourceTypeControlRequest) }},
+	{"cmrp/clusapi3/v3.NodeResourceTypeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.NodeResourceTypeControlResponse) }},
+	{"cmrp/clusapi3/v3.OfflineGroupExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineGroupExRequest) }},
+	{"cmrp/clusapi3/v3.OfflineGroupExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineGroupExResponse) }},
+	{"cmrp/clusapi3/v3.OfflineGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineGroupRequest) }},
+	{"cmrp/clusapi3/v3.OfflineGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineGroupResponse) }},
+	{"cmrp/clusapi3/v3.OfflineResourceExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineResourceExRequest) }},
+	{"cmrp/clusapi3/v3.OfflineResourceExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineResourceExResponse) }},
+	{"cmrp/clusapi3/v3.OfflineResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineResourceRequest) }},
+	{"cmrp/clusapi3/v3.OfflineResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OfflineResourceResponse) }},
+	{"cmrp/clusapi3/v3.OnlineGroupExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineGroupExRequest) }},
+	{"cmrp/clusapi3/v3.OnlineGroupExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineGroupExResponse) }},
+	{"cmrp/clusapi3/v3.OnlineGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineGroupRequest) }},
+	{"cmrp/clusapi3/v3.OnlineGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineGroupResponse) }},
+	{"cmrp/clusapi3/v3.OnlineResourceExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineResourceExRequest) }},
+	{"cmrp/clusapi3/v3.OnlineResourceExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineResourceExResponse) }},
+	{"cmrp/clusapi3/v3.OnlineResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineResourceRequest) }},
+	{"cmrp/clusapi3/v3.OnlineResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OnlineResourceResponse) }},
+	{"cmrp/clusapi3/v3.OpenClusterExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenClusterExRequest) }},
+	{"cmrp/clusapi3/v3.OpenClusterExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenClusterExResponse) }},
+	{"cmrp/clusapi3/v3.OpenClusterRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenClusterRequest) }},
+	{"cmrp/clusapi3/v3.OpenClusterResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenClusterResponse) }},
+	{"cmrp/clusapi3/v3.OpenGroupExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenGroupExRequest) }},
+	{"cmrp/clusapi3/v3.OpenGroupExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenGroupExResponse) }},
+	{"cmrp/clusapi3/v3.OpenGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenGroupRequest) }},
+	{"cmrp/clusapi3/v3.OpenGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenGroupResponse) }},
+	{"cmrp/clusapi3/v3.OpenGroupSetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenGroupSetRequest) }},
+	{"cmrp/clusapi3/v3.OpenGroupSetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenGroupSetResponse) }},
+	{"cmrp/clusapi3/v3.OpenKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenKeyRequest) }},
+	{"cmrp/clusapi3/v3.OpenKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenKeyResponse) }},
+	{"cmrp/clusapi3/v3.OpenNetInterfaceExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetInterfaceExRequest) }},
+	{"cmrp/clusapi3/v3.OpenNetInterfaceExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetInterfaceExResponse) }},
+	{"cmrp/clusapi3/v3.OpenNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetInterfaceRequest) }},
+	{"cmrp/clusapi3/v3.OpenNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetInterfaceResponse) }},
+	{"cmrp/clusapi3/v3.OpenNetworkExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetworkExRequest) }},
+	{"cmrp/clusapi3/v3.OpenNetworkExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetworkExResponse) }},
+	{"cmrp/clusapi3/v3.OpenNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetworkRequest) }},
+	{"cmrp/clusapi3/v3.OpenNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNetworkResponse) }},
+	{"cmrp/clusapi3/v3.OpenNodeExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNodeExRequest) }},
+	{"cmrp/clusapi3/v3.OpenNodeExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNodeExResponse) }},
+	{"cmrp/clusapi3/v3.OpenNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNodeRequest) }},
+	{"cmrp/clusapi3/v3.OpenNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenNodeResponse) }},
+	{"cmrp/clusapi3/v3.OpenResourceExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenResourceExRequest) }},
+	{"cmrp/clusapi3/v3.OpenResourceExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenResourceExResponse) }},
+	{"cmrp/clusapi3/v3.OpenResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenResourceRequest) }},
+	{"cmrp/clusapi3/v3.OpenResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.OpenResourceResponse) }},
+	{"cmrp/clusapi3/v3.Opnum149otUsedOnWireRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.Opnum149otUsedOnWireRequest) }},
+	{"cmrp/clusapi3/v3.Opnum149otUsedOnWireResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.Opnum149otUsedOnWireResponse) }},
+	{"cmrp/clusapi3/v3.PauseNodeExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.PauseNodeExRequest) }},
+	{"cmrp/clusapi3/v3.PauseNodeExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.PauseNodeExResponse) }},
+	{"cmrp/clusapi3/v3.PauseNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.PauseNodeRequest) }},
+	{"cmrp/clusapi3/v3.PauseNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.PauseNodeResponse) }},
+	{"cmrp/clusapi3/v3.PauseNodeWithDrainTargetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.PauseNodeWithDrainTargetRequest) }},
+	{"cmrp/clusapi3/v3.PauseNodeWithDrainTargetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.PauseNodeWithDrainTargetResponse) }},
+	{"cmrp/clusapi3/v3.QueryInfoKeyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.QueryInfoKeyRequest) }},
+	{"cmrp/clusapi3/v3.QueryInfoKeyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.QueryInfoKeyResponse) }},
+	{"cmrp/clusapi3/v3.QueryValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.QueryValueRequest) }},
+	{"cmrp/clusapi3/v3.QueryValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.QueryValueResponse) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyGroupRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyGroupRequest) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyGroupResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyGroupResponse) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyNetInterfaceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyNetInterfaceRequest) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyNetInterfaceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyNetInterfaceResponse) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyNetworkRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyNetworkRequest) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyNetworkResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyNetworkResponse) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyNodeRequest) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyNodeResponse) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyResourceRequest) }},
+	{"cmrp/clusapi3/v3.ReAddNotifyResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ReAddNotifyResourceResponse) }},
+	{"cmrp/clusapi3/v3.RemoveClusterGroupDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveClusterGroupDependencyRequest) }},
+	{"cmrp/clusapi3/v3.RemoveClusterGroupDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveClusterGroupDependencyResponse) }},
+	{"cmrp/clusapi3/v3.RemoveClusterGroupToGroupSetDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveClusterGroupToGroupSetDependencyRequest) }},
+	{"cmrp/clusapi3/v3.RemoveClusterGroupToGroupSetDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveClusterGroupToGroupSetDependencyResponse) }},
+	{"cmrp/clusapi3/v3.RemoveGroupFromGroupSetRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveGroupFromGroupSetRequest) }},
+	{"cmrp/clusapi3/v3.RemoveGroupFromGroupSetResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveGroupFromGroupSetResponse) }},
+	{"cmrp/clusapi3/v3.RemoveGroupSetDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveGroupSetDependencyRequest) }},
+	{"cmrp/clusapi3/v3.RemoveGroupSetDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveGroupSetDependencyResponse) }},
+	{"cmrp/clusapi3/v3.RemoveResourceDependencyRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveResourceDependencyRequest) }},
+	{"cmrp/clusapi3/v3.RemoveResourceDependencyResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveResourceDependencyResponse) }},
+	{"cmrp/clusapi3/v3.RemoveResourceNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveResourceNodeRequest) }},
+	{"cmrp/clusapi3/v3.RemoveResourceNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RemoveResourceNodeResponse) }},
+	{"cmrp/clusapi3/v3.ResourceControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResourceControlRequest) }},
+	{"cmrp/clusapi3/v3.ResourceControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResourceControlResponse) }},
+	{"cmrp/clusapi3/v3.ResourceTypeControlRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResourceTypeControlRequest) }},
+	{"cmrp/clusapi3/v3.ResourceTypeControlResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResourceTypeControlResponse) }},
+	{"cmrp/clusapi3/v3.RestartResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RestartResourceRequest) }},
+	{"cmrp/clusapi3/v3.RestartResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.RestartResourceResponse) }},
+	{"cmrp/clusapi3/v3.ResumeNodeExRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResumeNodeExRequest) }},
+	{"cmrp/clusapi3/v3.ResumeNodeExResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResumeNodeExResponse) }},
+	{"cmrp/clusapi3/v3.ResumeNodeRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResumeNodeRequest) }},
+	{"cmrp/clusapi3/v3.ResumeNodeResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.ResumeNodeResponse) }},
+	{"cmrp/clusapi3/v3.SetClusterNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetClusterNameRequest) }},
+	{"cmrp/clusapi3/v3.SetClusterNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetClusterNameResponse) }},
+	{"cmrp/clusapi3/v3.SetGroupDependencyExpressionRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupDependencyExpressionRequest) }},
+	{"cmrp/clusapi3/v3.SetGroupDependencyExpressionResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupDependencyExpressionResponse) }},
+	{"cmrp/clusapi3/v3.SetGroupNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupNameRequest) }},
+	{"cmrp/clusapi3/v3.SetGroupNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupNameResponse) }},
+	{"cmrp/clusapi3/v3.SetGroupNodeListRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupNodeListRequest) }},
+	{"cmrp/clusapi3/v3.SetGroupNodeListResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupNodeListResponse) }},
+	{"cmrp/clusapi3/v3.SetGroupSetDependencyExpressionRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupSetDependencyExpressionRequest) }},
+	{"cmrp/clusapi3/v3.SetGroupSetDependencyExpressionResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetGroupSetDependencyExpressionResponse) }},
+	{"cmrp/clusapi3/v3.SetKeySecurityRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetKeySecurityRequest) }},
+	{"cmrp/clusapi3/v3.SetKeySecurityResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetKeySecurityResponse) }},
+	{"cmrp/clusapi3/v3.SetNetworkNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetNetworkNameRequest) }},
+	{"cmrp/clusapi3/v3.SetNetworkNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetNetworkNameResponse) }},
+	{"cmrp/clusapi3/v3.SetNetworkPriorityOrderRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetNetworkPriorityOrderRequest) }},
+	{"cmrp/clusapi3/v3.SetNetworkPriorityOrderResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetNetworkPriorityOrderResponse) }},
+	{"cmrp/clusapi3/v3.SetQuorumResourceRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetQuorumResourceRequest) }},
+	{"cmrp/clusapi3/v3.SetQuorumResourceResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetQuorumResourceResponse) }},
+	{"cmrp/clusapi3/v3.SetResourceDependencyExpressionRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetResourceDependencyExpressionRequest) }},
+	{"cmrp/clusapi3/v3.SetResourceDependencyExpressionResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetResourceDependencyExpressionResponse) }},
+	{"cmrp/clusapi3/v3.SetResourceNameRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetResourceNameRequest) }},
+	{"cmrp/clusapi3/v3.SetResourceNameResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetResourceNameResponse) }},
+	{"cmrp/clusapi3/v3.SetServiceAccountPasswordRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetServiceAccountPasswordRequest) }},
+	{"cmrp/clusapi3/v3.SetServiceAccountPasswordResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetServiceAccountPasswordResponse) }},
+	{"cmrp/clusapi3/v3.SetValueRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetValueRequest) }},
+	{"cmrp/clusapi3/v3.SetValueResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.SetValueResponse) }},
+	{"cmrp/clusapi3/v3.UnblockGetNotifyCallRequest", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.UnblockGetNotifyCallRequest) }},
+	{"cmrp/clusapi3/v3.UnblockGetNotifyCallResponse", func() ndr.Unmarshaler { return new(cmrp_clusapi3_v3.UnblockGetNotifyCallResponse) }},
+	{"dcom/adtg/idatafactory/v0.ConvertToStringRequest", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.ConvertToStringRequest) }},
+	{"dcom/adtg/idatafactory/v0.ConvertToStringResponse", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.ConvertToStringResponse) }},
+	{"dcom/adtg/idatafactory/v0.CreateRecordSetRequest", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.CreateRecordSetRequest) }},
+	{"dcom/adtg/idatafactory/v0.CreateRecordSetResponse", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.CreateRecordSetResponse) }},
+	{"dcom/adtg/idatafactory/v0.QueryRequest", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.QueryRequest) }},
+	{"dcom/adtg/idatafactory/v0.QueryResponse", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.QueryResponse) }},
+	{"dcom/adtg/idatafactory/v0.SubmitChangesRequest", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.SubmitChangesRequest) }},
+	{"dcom/adtg/idatafactory/v0.SubmitChangesResponse", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory_v0.SubmitChangesResponse) }},
+	{"dcom/adtg/idatafactory2/v0.Execute21Request", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory2_v0.Execute21Request) }},
+	{"dcom/adtg/idatafactory2/v0.Execute21Response", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory2_v0.Execute21Response) }},
+	{"dcom/adtg/idatafactory2/v0.Synchronize21Request", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory2_v0.Synchronize21Request) }},
+	{"dcom/adtg/idatafactory2/v0.Synchronize21Response", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory2_v0.Synchronize21Response) }},
+	{"dcom/adtg/idatafactory3/v0.ExecuteRequest", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory3_v0.ExecuteRequest) }},
+	{"dcom/adtg/idatafactory3/v0.ExecuteResponse", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory3_v0.ExecuteResponse) }},
+	{"dcom/adtg/idatafactory3/v0.SynchronizeRequest", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory3_v0.SynchronizeRequest) }},
+	{"dcom/adtg/idatafactory3/v0.SynchronizeResponse", func() ndr.Unmarshaler { return new(dcom_adtg_idatafactory3_v0.SynchronizeResponse) }},
+	{"dcom/ccfg/icluscfgasyncevictcleanup/v0.CleanupNodeRequest", func() ndr.Unmarshaler { return new(dcom_ccfg_icluscfgasyncevictcleanup_v0.CleanupNodeRequest) }},
+	{"dcom/ccfg/icluscfgasyncevictcleanup/v0.CleanupNodeResponse", func() ndr.Unmarshaler { return new(dcom_ccfg_icluscfgasyncevictcleanup_v0.CleanupNodeResponse) }},
+	{"dcom/com/itransactionstream/v0.GetSeqAndTxViaExportRequest", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetSeqAndTxViaExportRequest) }},
+	{"dcom/com/itransactionstream/v0.GetSeqAndTxViaExportResponse", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetSeqAndTxViaExportResponse) }},
+	{"dcom/com/itransactionstream/v0.GetSeqAndTxViaTransmitterRequest", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetSeqAndTxViaTransmitterRequest) }},
+	{"dcom/com/itransactionstream/v0.GetSeqAndTxViaTransmitterResponse", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetSeqAndTxViaTransmitterResponse) }},
+	{"dcom/com/itransactionstream/v0.GetTxViaExportRequest", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetTxViaExportRequest) }},
+	{"dcom/com/itransactionstream/v0.GetTxViaExportResponse", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetTxViaExportResponse) }},
+	{"dcom/com/itransactionstream/v0.GetTxViaTransmitterRequest", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetTxViaTransmitterRequest) }},
+	{"dcom/com/itransactionstream/v0.GetTxViaTransmitterResponse", func() ndr.Unmarshaler { return new(dcom_com_itransactionstream_v0.GetTxViaTransmitterResponse) }},
+	{"dcom/coma/ialternatelaunch/v0.CreateConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_coma_ialternatelaunch_v0.CreateConfigurationRequest) }},
+	{"dcom/coma/ialternatelaunch/v0.CreateConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_coma_ialternatelaunch_v0.CreateConfigurationResponse) }},
+	{"dcom/coma/ialternatelaunch/v0.DeleteConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_coma_ialternatelaunch_v0.DeleteConfigurationRequest) }},
+	{"dcom/coma/ialternatelaunch/v0.DeleteConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_coma_ialternatelaunch_v0.DeleteConfigurationResponse) }},
+	{"dcom/coma/icapabilitysupport/v0.IsInstalledRequest", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.IsInstalledRequest) }},
+	{"dcom/coma/icapabilitysupport/v0.IsInstalledResponse", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.IsInstalledResponse) }},
+	{"dcom/coma/icapabilitysupport/v0.IsRunningRequest", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.IsRunningRequest) }},
+	{"dcom/coma/icapabilitysupport/v0.IsRunningResponse", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.IsRunningResponse) }},
+	{"dcom/coma/icapabilitysupport/v0.StartRequest", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.StartRequest) }},
+	{"dcom/coma/icapabilitysupport/v0.StartResponse", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.StartResponse) }},
+	{"dcom/coma/icapabilitysupport/v0.StopRequest", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.StopRequest) }},
+	{"dcom/coma/icapabilitysupport/v0.StopResponse", func() ndr.Unmarshaler { return new(dcom_coma_icapabilitysupport_v0.StopResponse) }},
+	{"dcom/coma/icatalog64bitsupport/v0.Initialize64BitQueryCellSupportRequest", func() ndr.Unmarshaler {
+		return new(dcom_coma_icatalog64bitsupport_v0.Initialize64BitQueryCellSupportRequest)
+	}},
+	{"dcom/coma/icatalog64bitsupport/v0.Initialize64BitQueryCellSupportResponse", func() ndr.Unmarshaler {
+		return new(dcom_coma_icatalog64bitsupport_v0.Initialize64BitQueryCellSupportResponse)
+	}},
+	{"dcom/coma/icatalog64bitsupport/v0.SupportsMultipleBitnessRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalog64bitsupport_v0.SupportsMultipleBitnessRequest) }},
+	{"dcom/coma/icatalog64bitsupport/v0.SupportsMultipleBitnessResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalog64bitsupport_v0.SupportsMultipleBitnessResponse) }},
+	{"dcom/coma/icatalogsession/v0.GetServerInformationRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogsession_v0.GetServerInformationRequest) }},
+	{"dcom/coma/icatalogsession/v0.GetServerInformationResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogsession_v0.GetServerInformationResponse) }},
+	{"dcom/coma/icatalogsession/v0.InitializeSessionRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogsession_v0.InitializeSessionRequest) }},
+	{"dcom/coma/icatalogsession/v0.InitializeSessionResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogsession_v0.InitializeSessionResponse) }},
+	{"dcom/coma/icatalogtableinfo/v0.GetClientTableInfoRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogtableinfo_v0.GetClientTableInfoRequest) }},
+	{"dcom/coma/icatalogtableinfo/v0.GetClientTableInfoResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogtableinfo_v0.GetClientTableInfoResponse) }},
+	{"dcom/coma/icatalogtableread/v0.ReadTableRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogtableread_v0.ReadTableRequest) }},
+	{"dcom/coma/icatalogtableread/v0.ReadTableResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogtableread_v0.ReadTableResponse) }},
+	{"dcom/coma/icatalogtablewrite/v0.WriteTableRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogtablewrite_v0.WriteTableRequest) }},
+	{"dcom/coma/icatalogtablewrite/v0.WriteTableResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogtablewrite_v0.WriteTableResponse) }},
+	{"dcom/coma/icatalogutils/v0.GetEventClassesForIIDRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils_v0.GetEventClassesForIIDRequest) }},
+	{"dcom/coma/icatalogutils/v0.GetEventClassesForIIDResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils_v0.GetEventClassesForIIDResponse) }},
+	{"dcom/coma/icatalogutils/v0.ValidateUserRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils_v0.ValidateUserRequest) }},
+	{"dcom/coma/icatalogutils/v0.ValidateUserResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils_v0.ValidateUserResponse) }},
+	{"dcom/coma/icatalogutils/v0.WaitForEndWritesRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils_v0.WaitForEndWritesRequest) }},
+	{"dcom/coma/icatalogutils/v0.WaitForEndWritesResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils_v0.WaitForEndWritesResponse) }},
+	{"dcom/coma/icatalogutils2/v0.AliasComponentRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.AliasComponentRequest) }},
+	{"dcom/coma/icatalogutils2/v0.AliasComponentResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.AliasComponentResponse) }},
+	{"dcom/coma/icatalogutils2/v0.CopyComponentConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.CopyComponentConfigurationRequest) }},
+	{"dcom/coma/icatalogutils2/v0.CopyComponentConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.CopyComponentConfigurationResponse) }},
+	{"dcom/coma/icatalogutils2/v0.CopyConglomerationsRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.CopyConglomerationsRequest) }},
+	{"dcom/coma/icatalogutils2/v0.CopyConglomerationsResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.CopyConglomerationsResponse) }},
+	{"dcom/coma/icatalogutils2/v0.EnumerateSRPLevelsRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.EnumerateSRPLevelsRequest) }},
+	{"dcom/coma/icatalogutils2/v0.EnumerateSRPLevelsResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.EnumerateSRPLevelsResponse) }},
+	{"dcom/coma/icatalogutils2/v0.FlushPartitionCacheRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.FlushPartitionCacheRequest) }},
+	{"dcom/coma/icatalogutils2/v0.FlushPartitionCacheResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.FlushPartitionCacheResponse) }},
+	{"dcom/coma/icatalogutils2/v0.GetComponentVersionsRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.GetComponentVersionsRequest) }},
+	{"dcom/coma/icatalogutils2/v0.GetComponentVersionsResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.GetComponentVersionsResponse) }},
+	{"dcom/coma/icatalogutils2/v0.GetEventClassesForIid2Request", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.GetEventClassesForIid2Request) }},
+	{"dcom/coma/icatalogutils2/v0.GetEventClassesForIid2Response", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.GetEventClassesForIid2Response) }},
+	{"dcom/coma/icatalogutils2/v0.IsSafeToDeleteRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.IsSafeToDeleteRequest) }},
+	{"dcom/coma/icatalogutils2/v0.IsSafeToDeleteResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.IsSafeToDeleteResponse) }},
+	{"dcom/coma/icatalogutils2/v0.MoveComponentConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.MoveComponentConfigurationRequest) }},
+	{"dcom/coma/icatalogutils2/v0.MoveComponentConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_coma_icatalogutils2_v0.MoveComponentConfigurationResponse) }},
+	{"dcom/coma/icontainercontrol/v0.CreateContainerRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol_v0.CreateContainerRequest) }},
+	{"dcom/coma/icontainercontrol/v0.CreateContainerResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol_v0.CreateContainerResponse) }},
+	{"dcom/coma/icontainercontrol/v0.RefreshComponentsRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol_v0.RefreshComponentsRequest) }},
+	{"dcom/coma/icontainercontrol/v0.RefreshComponentsResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol_v0.RefreshComponentsResponse) }},
+	{"dcom/coma/icontainercontrol/v0.ShutdownContainersRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol_v0.ShutdownContainersRequest) }},
+	{"dcom/coma/icontainercontrol/v0.ShutdownContainersResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol_v0.ShutdownContainersResponse) }},
+	{"dcom/coma/icontainercontrol2/v0.GetContainerIDFromConglomerationIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_coma_icontainercontrol2_v0.GetContainerIDFromConglomerationIDRequest)
+	}},
+	{"dcom/coma/icontainercontrol2/v0.GetContainerIDFromConglomerationIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_coma_icontainercontrol2_v0.GetContainerIDFromConglomerationIDResponse)
+	}},
+	{"dcom/coma/icontainercontrol2/v0.GetContainerIDFromProcessIDRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.GetContainerIDFromProcessIDRequest) }},
+	{"dcom/coma/icontainercontrol2/v0.GetContainerIDFromProcessIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_coma_icontainercontrol2_v0.GetContainerIDFromProcessIDResponse)
+	}},
+	{"dcom/coma/icontainercontrol2/v0.GetRunningContainersRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.GetRunningContainersRequest) }},
+	{"dcom/coma/icontainercontrol2/v0.GetRunningContainersResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.GetRunningContainersResponse) }},
+	{"dcom/coma/icontainercontrol2/v0.IsContainerPausedRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.IsContainerPausedRequest) }},
+	{"dcom/coma/icontainercontrol2/v0.IsContainerPausedResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.IsContainerPausedResponse) }},
+	{"dcom/coma/icontainercontrol2/v0.PauseContainerRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.PauseContainerRequest) }},
+	{"dcom/coma/icontainercontrol2/v0.PauseContainerResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.PauseContainerResponse) }},
+	{"dcom/coma/icontainercontrol2/v0.RecycleContainerRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.RecycleContainerRequest) }},
+	{"dcom/coma/icontainercontrol2/v0.RecycleContainerResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.RecycleContainerResponse) }},
+	{"dcom/coma/icontainercontrol2/v0.ResumeContainerRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.ResumeContainerRequest) }},
+	{"dcom/coma/icontainercontrol2/v0.ResumeContainerResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.ResumeContainerResponse) }},
+	{"dcom/coma/icontainercontrol2/v0.ShutdownContainerRequest", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.ShutdownContainerRequest) }},
+	{"dcom/coma/icontainercontrol2/v0.ShutdownContainerResponse", func() ndr.Unmarshaler { return new(dcom_coma_icontainercontrol2_v0.ShutdownContainerResponse) }},
+	{"dcom/coma/iexport/v0.ExportConglomerationRequest", func() ndr.Unmarshaler { return new(dcom_coma_iexport_v0.ExportConglomerationRequest) }},
+	{"dcom/coma/iexport/v0.ExportConglomerationResponse", func() ndr.Unmarshaler { return new(dcom_coma_iexport_v0.ExportConglomerationResponse) }},
+	{"dcom/coma/iexport2/v0.ExportPartitionRequest", func() ndr.Unmarshaler { return new(dcom_coma_iexport2_v0.ExportPartitionRequest) }},
+	{"dcom/coma/iexport2/v0.ExportPartitionResponse", func() ndr.Unmarshaler { return new(dcom_coma_iexport2_v0.ExportPartitionResponse) }},
+	{"dcom/coma/iimport/v0.ImportFromFileRequest", func() ndr.Unmarshaler { return new(dcom_coma_iimport_v0.ImportFromFileRequest) }},
+	{"dcom/coma/iimport/v0.ImportFromFileResponse", func() ndr.Unmarshaler { return new(dcom_coma_iimport_v0.ImportFromFileResponse) }},
+	{"dcom/coma/iimport/v0.QueryFileRequest", func() ndr.Unmarshaler { return new(dcom_coma_iimport_v0.QueryFileRequest) }},
+	{"dcom/coma/iimport/v0.QueryFileResponse", func() ndr.Unmarshaler { return new(dcom_coma_iimport_v0.QueryFileResponse) }},
+	{"dcom/coma/iimport2/v0.SetPartitionRequest", func() ndr.Unmarshaler { return new(dcom_coma_iimport2_v0.SetPartitionRequest) }},
+	{"dcom/coma/iimport2/v0.SetPartitionResponse", func() ndr.Unmarshaler { return new(dcom_coma_iimport2_v0.SetPartitionResponse) }},
+	{"dcom/coma/iregister/v0.RegisterModuleRequest", func() ndr.Unmarshaler { return new(dcom_coma_iregister_v0.RegisterModuleRequest) }},
+	{"dcom/coma/iregister/v0.RegisterModuleResponse", func() ndr.Unmarshaler { return new(dcom_coma_iregister_v0.RegisterModuleResponse) }},
+	{"dcom/coma/iregister2/v0.CreateFullConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.CreateFullConfigurationRequest) }},
+	{"dcom/coma/iregister2/v0.CreateFullConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.CreateFullConfigurationResponse) }},
+	{"dcom/coma/iregister2/v0.CreateLegacyConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.CreateLegacyConfigurationRequest) }},
+	{"dcom/coma/iregister2/v0.CreateLegacyConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.CreateLegacyConfigurationResponse) }},
+	{"dcom/coma/iregister2/v0.PromoteLegacyConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.PromoteLegacyConfigurationRequest) }},
+	{"dcom/coma/iregister2/v0.PromoteLegacyConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.PromoteLegacyConfigurationResponse) }},
+	{"dcom/coma/iregister2/v0.RegisterModule2Request", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.RegisterModule2Request) }},
+	{"dcom/coma/iregister2/v0.RegisterModule2Response", func() ndr.Unmarshaler { return new(dcom_coma_iregister2_v0.RegisterModule2Response) }},
+	{"dcom/coma/ireplicationutil/v0.BeginReplicationAsTargetRequest", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.BeginReplicationAsTargetRequest) }},
+	{"dcom/coma/ireplicationutil/v0.BeginReplicationAsTargetResponse", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.BeginReplicationAsTargetResponse) }},
+	{"dcom/coma/ireplicationutil/v0.CreateEmptyDirRequest", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.CreateEmptyDirRequest) }},
+	{"dcom/coma/ireplicationutil/v0.CreateEmptyDirResponse", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.CreateEmptyDirResponse) }},
+	{"dcom/coma/ireplicationutil/v0.CreateReplicationDirRequest", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.CreateReplicationDirRequest) }},
+	{"dcom/coma/ireplicationutil/v0.CreateReplicationDirResponse", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.CreateReplicationDirResponse) }},
+	{"dcom/coma/ireplicationutil/v0.CreateShareRequest", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.CreateShareRequest) }},
+	{"dcom/coma/ireplicationutil/v0.CreateShareResponse", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.CreateShareResponse) }},
+	{"dcom/coma/ireplicationutil/v0.QueryConglomerationPasswordRequest", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.QueryConglomerationPasswordRequest) }},
+	{"dcom/coma/ireplicationutil/v0.QueryConglomerationPasswordResponse", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.QueryConglomerationPasswordResponse) }},
+	{"dcom/coma/ireplicationutil/v0.RemoveShareRequest", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.RemoveShareRequest) }},
+	{"dcom/coma/ireplicationutil/v0.RemoveShareResponse", func() ndr.Unmarshaler { return new(dcom_coma_ireplicationutil_v0.RemoveShareResponse) }},
+	{"dcom/comev/ienumeventobject/v0.CloneRequest", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.CloneRequest) }},
+	{"dcom/comev/ienumeventobject/v0.CloneResponse", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.CloneResponse) }},
+	{"dcom/comev/ienumeventobject/v0.NextRequest", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.NextRequest) }},
+	{"dcom/comev/ienumeventobject/v0.NextResponse", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.NextResponse) }},
+	{"dcom/comev/ienumeventobject/v0.ResetRequest", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.ResetRequest) }},
+	{"dcom/comev/ienumeventobject/v0.ResetResponse", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.ResetResponse) }},
+	{"dcom/comev/ienumeventobject/v0.SkipRequest", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.SkipRequest) }},
+	{"dcom/comev/ienumeventobject/v0.SkipResponse", func() ndr.Unmarshaler { return new(dcom_comev_ienumeventobject_v0.SkipResponse) }},
+	{"dcom/comev/ieventclass/v0.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetDescriptionRequest) }},
+	{"dcom/comev/ieventclass/v0.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetDescriptionResponse) }},
+	{"dcom/comev/ieventclass/v0.GetEventClassIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetEventClassIDRequest) }},
+	{"dcom/comev/ieventclass/v0.GetEventClassIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetEventClassIDResponse) }},
+	{"dcom/comev/ieventclass/v0.GetEventClassNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetEventClassNameRequest) }},
+	{"dcom/comev/ieventclass/v0.GetEventClassNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetEventClassNameResponse) }},
+	{"dcom/comev/ieventclass/v0.GetFiringInterfaceIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetFiringInterfaceIDRequest) }},
+	{"dcom/comev/ieventclass/v0.GetFiringInterfaceIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetFiringInterfaceIDResponse) }},
+	{"dcom/comev/ieventclass/v0.GetOwnerSIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetOwnerSIDRequest) }},
+	{"dcom/comev/ieventclass/v0.GetOwnerSIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetOwnerSIDResponse) }},
+	{"dcom/comev/ieventclass/v0.GetTypeLibRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetTypeLibRequest) }},
+	{"dcom/comev/ieventclass/v0.GetTypeLibResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.GetTypeLibResponse) }},
+	{"dcom/comev/ieventclass/v0.SetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetDescriptionRequest) }},
+	{"dcom/comev/ieventclass/v0.SetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetDescriptionResponse) }},
+	{"dcom/comev/ieventclass/v0.SetEventClassIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetEventClassIDRequest) }},
+	{"dcom/comev/ieventclass/v0.SetEventClassIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetEventClassIDResponse) }},
+	{"dcom/comev/ieventclass/v0.SetEventClassNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetEventClassNameRequest) }},
+	{"dcom/comev/ieventclass/v0.SetEventClassNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetEventClassNameResponse) }},
+	{"dcom/comev/ieventclass/v0.SetFiringInterfaceIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetFiringInterfaceIDRequest) }},
+	{"dcom/comev/ieventclass/v0.SetFiringInterfaceIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetFiringInterfaceIDResponse) }},
+	{"dcom/comev/ieventclass/v0.SetOwnerSIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetOwnerSIDRequest) }},
+	{"dcom/comev/ieventclass/v0.SetOwnerSIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetOwnerSIDResponse) }},
+	{"dcom/comev/ieventclass/v0.SetTypeLibRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetTypeLibRequest) }},
+	{"dcom/comev/ieventclass/v0.SetTypeLibResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass_v0.SetTypeLibResponse) }},
+	{"dcom/comev/ieventclass2/v0.GetAllowInProcessActivationRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.GetAllowInProcessActivationRequest) }},
+	{"dcom/comev/ieventclass2/v0.GetAllowInProcessActivationResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.GetAllowInProcessActivationResponse) }},
+	{"dcom/comev/ieventclass2/v0.GetFireInParallelRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.GetFireInParallelRequest) }},
+	{"dcom/comev/ieventclass2/v0.GetFireInParallelResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.GetFireInParallelResponse) }},
+	{"dcom/comev/ieventclass2/v0.GetMultiInterfacePublisherFilterClassIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventclass2_v0.GetMultiInterfacePublisherFilterClassIDRequest)
+	}},
+	{"dcom/comev/ieventclass2/v0.GetMultiInterfacePublisherFilterClassIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventclass2_v0.GetMultiInterfacePublisherFilterClassIDResponse)
+	}},
+	{"dcom/comev/ieventclass2/v0.GetPublisherIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.GetPublisherIDRequest) }},
+	{"dcom/comev/ieventclass2/v0.GetPublisherIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.GetPublisherIDResponse) }},
+	{"dcom/comev/ieventclass2/v0.SetAllowInProcessActivationRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.SetAllowInProcessActivationRequest) }},
+	{"dcom/comev/ieventclass2/v0.SetAllowInProcessActivationResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.SetAllowInProcessActivationResponse) }},
+	{"dcom/comev/ieventclass2/v0.SetFireInParallelRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.SetFireInParallelRequest) }},
+	{"dcom/comev/ieventclass2/v0.SetFireInParallelResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.SetFireInParallelResponse) }},
+	{"dcom/comev/ieventclass2/v0.SetMultiInterfacePublisherFilterClassIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventclass2_v0.SetMultiInterfacePublisherFilterClassIDRequest)
+	}},
+	{"dcom/comev/ieventclass2/v0.SetMultiInterfacePublisherFilterClassIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventclass2_v0.SetMultiInterfacePublisherFilterClassIDResponse)
+	}},
+	{"dcom/comev/ieventclass2/v0.SetPublisherIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.SetPublisherIDRequest) }},
+	{"dcom/comev/ieventclass2/v0.SetPublisherIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass2_v0.SetPublisherIDResponse) }},
+	{"dcom/comev/ieventclass3/v0.GetEventClassApplicationIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.GetEventClassApplicationIDRequest) }},
+	{"dcom/comev/ieventclass3/v0.GetEventClassApplicationIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.GetEventClassApplicationIDResponse) }},
+	{"dcom/comev/ieventclass3/v0.GetEventClassPartitionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.GetEventClassPartitionIDRequest) }},
+	{"dcom/comev/ieventclass3/v0.GetEventClassPartitionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.GetEventClassPartitionIDResponse) }},
+	{"dcom/comev/ieventclass3/v0.SetEventClassApplicationIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.SetEventClassApplicationIDRequest) }},
+	{"dcom/comev/ieventclass3/v0.SetEventClassApplicationIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.SetEventClassApplicationIDResponse) }},
+	{"dcom/comev/ieventclass3/v0.SetEventClassPartitionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.SetEventClassPartitionIDRequest) }},
+	{"dcom/comev/ieventclass3/v0.SetEventClassPartitionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventclass3_v0.SetEventClassPartitionIDResponse) }},
+	{"dcom/comev/ieventobjectcollection/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.AddRequest) }},
+	{"dcom/comev/ieventobjectcollection/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.AddResponse) }},
+	{"dcom/comev/ieventobjectcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.GetCountRequest) }},
+	{"dcom/comev/ieventobjectcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.GetCountResponse) }},
+	{"dcom/comev/ieventobjectcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.GetItemRequest) }},
+	{"dcom/comev/ieventobjectcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.GetItemResponse) }},
+	{"dcom/comev/ieventobjectcollection/v0.GetNewEnumRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.GetNewEnumRequest) }},
+	{"dcom/comev/ieventobjectcollection/v0.GetNewEnumResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.GetNewEnumResponse) }},
+	{"dcom/comev/ieventobjectcollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.Get_NewEnumRequest) }},
+	{"dcom/comev/ieventobjectcollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.Get_NewEnumResponse) }},
+	{"dcom/comev/ieventobjectcollection/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.RemoveRequest) }},
+	{"dcom/comev/ieventobjectcollection/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventobjectcollection_v0.RemoveResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetDescriptionRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetDescriptionResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetEnabledRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetEnabledResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetEventClassIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetEventClassIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetEventClassIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetEventClassIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetInterfaceIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetInterfaceIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetInterfaceIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetInterfaceIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetMachineNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetMachineNameRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetMachineNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetMachineNameResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetMethodNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetMethodNameRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetMethodNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetMethodNameResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetOwnerSIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetOwnerSIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetOwnerSIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetOwnerSIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetPerUserRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetPerUserRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetPerUserResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetPerUserResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetPublisherIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetPublisherIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetPublisherIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetPublisherIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetPublisherPropertyCollectionRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription_v0.GetPublisherPropertyCollectionRequest)
+	}},
+	{"dcom/comev/ieventsubscription/v0.GetPublisherPropertyCollectionResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription_v0.GetPublisherPropertyCollectionResponse)
+	}},
+	{"dcom/comev/ieventsubscription/v0.GetPublisherPropertyRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetPublisherPropertyRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetPublisherPropertyResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetPublisherPropertyResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberClassIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriberClassIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberClassIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriberClassIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberInterfaceRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriberInterfaceRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberInterfaceResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriberInterfaceResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberPropertyCollectionRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription_v0.GetSubscriberPropertyCollectionRequest)
+	}},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberPropertyCollectionResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription_v0.GetSubscriberPropertyCollectionResponse)
+	}},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberPropertyRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriberPropertyRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriberPropertyResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriberPropertyResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriptionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriptionIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriptionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriptionIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriptionNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriptionNameRequest) }},
+	{"dcom/comev/ieventsubscription/v0.GetSubscriptionNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.GetSubscriptionNameResponse) }},
+	{"dcom/comev/ieventsubscription/v0.PutPublisherPropertyRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.PutPublisherPropertyRequest) }},
+	{"dcom/comev/ieventsubscription/v0.PutPublisherPropertyResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.PutPublisherPropertyResponse) }},
+	{"dcom/comev/ieventsubscription/v0.PutSubscriberPropertyRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.PutSubscriberPropertyRequest) }},
+	{"dcom/comev/ieventsubscription/v0.PutSubscriberPropertyResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.PutSubscriberPropertyResponse) }},
+	{"dcom/comev/ieventsubscription/v0.RemovePublisherPropertyRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.RemovePublisherPropertyRequest) }},
+	{"dcom/comev/ieventsubscription/v0.RemovePublisherPropertyResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.RemovePublisherPropertyResponse) }},
+	{"dcom/comev/ieventsubscription/v0.RemoveSubscriberPropertyRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.RemoveSubscriberPropertyRequest) }},
+	{"dcom/comev/ieventsubscription/v0.RemoveSubscriberPropertyResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.RemoveSubscriberPropertyResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetDescriptionRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetDescriptionResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetEnabledRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetEnabledResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetEventClassIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetEventClassIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetEventClassIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetEventClassIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetInterfaceIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetInterfaceIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetInterfaceIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetInterfaceIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetMachineNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetMachineNameRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetMachineNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetMachineNameResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetMethodNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetMethodNameRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetMethodNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetMethodNameResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetOwnerSIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetOwnerSIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetOwnerSIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetOwnerSIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetPerUserRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetPerUserRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetPerUserResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetPerUserResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetPublisherIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetPublisherIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetPublisherIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetPublisherIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriberClassIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriberClassIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriberClassIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriberClassIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriberInterfaceRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriberInterfaceRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriberInterfaceResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriberInterfaceResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriptionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriptionIDRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriptionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriptionIDResponse) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriptionNameRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriptionNameRequest) }},
+	{"dcom/comev/ieventsubscription/v0.SetSubscriptionNameResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription_v0.SetSubscriptionNameResponse) }},
+	{"dcom/comev/ieventsubscription2/v0.GetFilterCriteriaRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.GetFilterCriteriaRequest) }},
+	{"dcom/comev/ieventsubscription2/v0.GetFilterCriteriaResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.GetFilterCriteriaResponse) }},
+	{"dcom/comev/ieventsubscription2/v0.GetSubscriberMonikerRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.GetSubscriberMonikerRequest) }},
+	{"dcom/comev/ieventsubscription2/v0.GetSubscriberMonikerResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.GetSubscriberMonikerResponse) }},
+	{"dcom/comev/ieventsubscription2/v0.SetFilterCriteriaRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.SetFilterCriteriaRequest) }},
+	{"dcom/comev/ieventsubscription2/v0.SetFilterCriteriaResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.SetFilterCriteriaResponse) }},
+	{"dcom/comev/ieventsubscription2/v0.SetSubscriberMonikerRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.SetSubscriberMonikerRequest) }},
+	{"dcom/comev/ieventsubscription2/v0.SetSubscriberMonikerResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription2_v0.SetSubscriberMonikerResponse) }},
+	{"dcom/comev/ieventsubscription3/v0.GetEventClassApplicationIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.GetEventClassApplicationIDRequest)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.GetEventClassApplicationIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.GetEventClassApplicationIDResponse)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.GetEventClassPartitionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.GetEventClassPartitionIDRequest) }},
+	{"dcom/comev/ieventsubscription3/v0.GetEventClassPartitionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.GetEventClassPartitionIDResponse) }},
+	{"dcom/comev/ieventsubscription3/v0.GetSubscriberApplicationIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.GetSubscriberApplicationIDRequest)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.GetSubscriberApplicationIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.GetSubscriberApplicationIDResponse)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.GetSubscriberPartitionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.GetSubscriberPartitionIDRequest) }},
+	{"dcom/comev/ieventsubscription3/v0.GetSubscriberPartitionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.GetSubscriberPartitionIDResponse) }},
+	{"dcom/comev/ieventsubscription3/v0.SetEventClassApplicationIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.SetEventClassApplicationIDRequest)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.SetEventClassApplicationIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.SetEventClassApplicationIDResponse)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.SetEventClassPartitionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.SetEventClassPartitionIDRequest) }},
+	{"dcom/comev/ieventsubscription3/v0.SetEventClassPartitionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.SetEventClassPartitionIDResponse) }},
+	{"dcom/comev/ieventsubscription3/v0.SetSubscriberApplicationIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.SetSubscriberApplicationIDRequest)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.SetSubscriberApplicationIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsubscription3_v0.SetSubscriberApplicationIDResponse)
+	}},
+	{"dcom/comev/ieventsubscription3/v0.SetSubscriberPartitionIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.SetSubscriberPartitionIDRequest) }},
+	{"dcom/comev/ieventsubscription3/v0.SetSubscriberPartitionIDResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsubscription3_v0.SetSubscriberPartitionIDResponse) }},
+	{"dcom/comev/ieventsystem/v0.GetEventObjectChangeEventClassIDRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.GetEventObjectChangeEventClassIDRequest) }},
+	{"dcom/comev/ieventsystem/v0.GetEventObjectChangeEventClassIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsystem_v0.GetEventObjectChangeEventClassIDResponse)
+	}},
+	{"dcom/comev/ieventsystem/v0.QueryRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.QueryRequest) }},
+	{"dcom/comev/ieventsystem/v0.QueryResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.QueryResponse) }},
+	{"dcom/comev/ieventsystem/v0.QuerySRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.QuerySRequest) }},
+	{"dcom/comev/ieventsystem/v0.QuerySResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.QuerySResponse) }},
+	{"dcom/comev/ieventsystem/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.RemoveRequest) }},
+	{"dcom/comev/ieventsystem/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.RemoveResponse) }},
+	{"dcom/comev/ieventsystem/v0.RemoveSRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.RemoveSRequest) }},
+	{"dcom/comev/ieventsystem/v0.RemoveSResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.RemoveSResponse) }},
+	{"dcom/comev/ieventsystem/v0.StoreRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.StoreRequest) }},
+	{"dcom/comev/ieventsystem/v0.StoreResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem_v0.StoreResponse) }},
+	{"dcom/comev/ieventsystem2/v0.GetVersionRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem2_v0.GetVersionRequest) }},
+	{"dcom/comev/ieventsystem2/v0.GetVersionResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem2_v0.GetVersionResponse) }},
+	{"dcom/comev/ieventsystem2/v0.VerifyTransientSubscribersRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem2_v0.VerifyTransientSubscribersRequest) }},
+	{"dcom/comev/ieventsystem2/v0.VerifyTransientSubscribersResponse", func() ndr.Unmarshaler { return new(dcom_comev_ieventsystem2_v0.VerifyTransientSubscribersResponse) }},
+	{"dcom/comev/ieventsysteminitialize/v0.SetCOMCatalogBehaviourRequest", func() ndr.Unmarshaler { return new(dcom_comev_ieventsysteminitialize_v0.SetCOMCatalogBehaviourRequest) }},
+	{"dcom/comev/ieventsysteminitialize/v0.SetCOMCatalogBehaviourResponse", func() ndr.Unmarshaler {
+		return new(dcom_comev_ieventsysteminitialize_v0.SetCOMCatalogBehaviourResponse)
+	}},
+	{"dcom/comt/icomtrackinginfoevents/v0.OnNewTrackingInfoRequest", func() ndr.Unmarshaler { return new(dcom_comt_icomtrackinginfoevents_v0.OnNewTrackingInfoRequest) }},
+	{"dcom/comt/icomtrackinginfoevents/v0.OnNewTrackingInfoResponse", func() ndr.Unmarshaler { return new(dcom_comt_icomtrackinginfoevents_v0.OnNewTrackingInfoResponse) }},
+	{"dcom/comt/igettrackingdata/v0.GetComponentDataByContainerAndClassIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_comt_igettrackingdata_v0.GetComponentDataByContainerAndClassIDRequest)
+	}},
+	{"dcom/comt/igettrackingdata/v0.GetComponentDataByContainerAndClassIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_comt_igettrackingdata_v0.GetComponentDataByContainerAndClassIDResponse)
+	}},
+	{"dcom/comt/igettrackingdata/v0.GetComponentDataByContainerRequest", func() ndr.Unmarshaler { return new(dcom_comt_igettrackingdata_v0.GetComponentDataByContainerRequest) }},
+	{"dcom/comt/igettrackingdata/v0.GetComponentDataByContainerResponse", func() ndr.Unmarshaler { return new(dcom_comt_igettrackingdata_v0.GetComponentDataByContainerResponse) }},
+	{"dcom/comt/igettrackingdata/v0.GetContainerDataRequest", func() ndr.Unmarshaler { return new(dcom_comt_igettrackingdata_v0.GetContainerDataRequest) }},
+	{"dcom/comt/igettrackingdata/v0.GetContainerDataResponse", func() ndr.Unmarshaler { return new(dcom_comt_igettrackingdata_v0.GetContainerDataResponse) }},
+	{"dcom/comt/iprocessdump/v0.DumpProcessRequest", func() ndr.Unmarshaler { return new(dcom_comt_iprocessdump_v0.DumpProcessRequest) }},
+	{"dcom/comt/iprocessdump/v0.DumpProcessResponse", func() ndr.Unmarshaler { return new(dcom_comt_iprocessdump_v0.DumpProcessResponse) }},
+	{"dcom/comt/iprocessdump/v0.IsSupportedRequest", func() ndr.Unmarshaler { return new(dcom_comt_iprocessdump_v0.IsSupportedRequest) }},
+	{"dcom/comt/iprocessdump/v0.IsSupportedResponse", func() ndr.Unmarshaler { return new(dcom_comt_iprocessdump_v0.IsSupportedResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupCloseFileRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupCloseFileRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupCloseFileResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupCloseFileResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupEndRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupEndRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupEndResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupEndResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupGetAttachmentInformationRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupGetAttachmentInformationRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupGetAttachmentInformationResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupGetAttachmentInformationResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupGetBackupLogsRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupGetBackupLogsRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupGetBackupLogsResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupGetBackupLogsResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupGetDynamicFilesRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupGetDynamicFilesRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupGetDynamicFilesResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupGetDynamicFilesResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupOpenFileRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupOpenFileRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupOpenFileResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupOpenFileResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupPrepareRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupPrepareRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupPrepareResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupPrepareResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupReadFileRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupReadFileRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupReadFileResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupReadFileResponse) }},
+	{"dcom/csra/icertadmind/v0.BackupTruncateLogsRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupTruncateLogsRequest) }},
+	{"dcom/csra/icertadmind/v0.BackupTruncateLogsResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.BackupTruncateLogsResponse) }},
+	{"dcom/csra/icertadmind/v0.CloseViewRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.CloseViewRequest) }},
+	{"dcom/csra/icertadmind/v0.CloseViewResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.CloseViewResponse) }},
+	{"dcom/csra/icertadmind/v0.DenyRequestRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.DenyRequestRequest) }},
+	{"dcom/csra/icertadmind/v0.DenyRequestResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.DenyRequestResponse) }},
+	{"dcom/csra/icertadmind/v0.EnumAttributesOrExtensionsRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.EnumAttributesOrExtensionsRequest) }},
+	{"dcom/csra/icertadmind/v0.EnumAttributesOrExtensionsResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.EnumAttributesOrExtensionsResponse) }},
+	{"dcom/csra/icertadmind/v0.EnumViewColumnRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.EnumViewColumnRequest) }},
+	{"dcom/csra/icertadmind/v0.EnumViewColumnResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.EnumViewColumnResponse) }},
+	{"dcom/csra/icertadmind/v0.EnumViewRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.EnumViewRequest) }},
+	{"dcom/csra/icertadmind/v0.EnumViewResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.EnumViewResponse) }},
+	{"dcom/csra/icertadmind/v0.GetCRLRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.GetCRLRequest) }},
+	{"dcom/csra/icertadmind/v0.GetCRLResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.GetCRLResponse) }},
+	{"dcom/csra/icertadmind/v0.GetServerStateRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.GetServerStateRequest) }},
+	{"dcom/csra/icertadmind/v0.GetServerStateResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.GetServerStateResponse) }},
+	{"dcom/csra/icertadmind/v0.GetViewDefaultColumnSetRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.GetViewDefaultColumnSetRequest) }},
+	{"dcom/csra/icertadmind/v0.GetViewDefaultColumnSetResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.GetViewDefaultColumnSetResponse) }},
+	{"dcom/csra/icertadmind/v0.ImportCertificateRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.ImportCertificateRequest) }},
+	{"dcom/csra/icertadmind/v0.ImportCertificateResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.ImportCertificateResponse) }},
+	{"dcom/csra/icertadmind/v0.IsValidCertificateRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.IsValidCertificateRequest) }},
+	{"dcom/csra/icertadmind/v0.IsValidCertificateResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.IsValidCertificateResponse) }},
+	{"dcom/csra/icertadmind/v0.OpenViewRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.OpenViewRequest) }},
+	{"dcom/csra/icertadmind/v0.OpenViewResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.OpenViewResponse) }},
+	{"dcom/csra/icertadmind/v0.PingRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.PingRequest) }},
+	{"dcom/csra/icertadmind/v0.PingResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.PingResponse) }},
+	{"dcom/csra/icertadmind/v0.PublishCRLRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.PublishCRLRequest) }},
+	{"dcom/csra/icertadmind/v0.PublishCRLResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.PublishCRLResponse) }},
+	{"dcom/csra/icertadmind/v0.RestoreGetDatabaseLocationsRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.RestoreGetDatabaseLocationsRequest) }},
+	{"dcom/csra/icertadmind/v0.RestoreGetDatabaseLocationsResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.RestoreGetDatabaseLocationsResponse) }},
+	{"dcom/csra/icertadmind/v0.ResubmitRequestRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.ResubmitRequestRequest) }},
+	{"dcom/csra/icertadmind/v0.ResubmitRequestResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.ResubmitRequestResponse) }},
+	{"dcom/csra/icertadmind/v0.RevokeCertificateRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.RevokeCertificateRequest) }},
+	{"dcom/csra/icertadmind/v0.RevokeCertificateResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.RevokeCertificateResponse) }},
+	{"dcom/csra/icertadmind/v0.ServerControlRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.ServerControlRequest) }},
+	{"dcom/csra/icertadmind/v0.ServerControlResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.ServerControlResponse) }},
+	{"dcom/csra/icertadmind/v0.SetAttributesRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.SetAttributesRequest) }},
+	{"dcom/csra/icertadmind/v0.SetAttributesResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.SetAttributesResponse) }},
+	{"dcom/csra/icertadmind/v0.SetExtensionRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.SetExtensionRequest) }},
+	{"dcom/csra/icertadmind/v0.SetExtensionResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind_v0.SetExtensionResponse) }},
+	{"dcom/csra/icertadmind2/v0.DeleteRowRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.DeleteRowRequest) }},
+	{"dcom/csra/icertadmind2/v0.DeleteRowResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.DeleteRowResponse) }},
+	{"dcom/csra/icertadmind2/v0.EnumViewColumnTableRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.EnumViewColumnTableRequest) }},
+	{"dcom/csra/icertadmind2/v0.EnumViewColumnTableResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.EnumViewColumnTableResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetArchivedKeyRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetArchivedKeyRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetArchivedKeyResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetArchivedKeyResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetAuditFilterRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetAuditFilterRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetAuditFilterResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetAuditFilterResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetCAPropertyInfoRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetCAPropertyInfoRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetCAPropertyInfoResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetCAPropertyInfoResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetCAPropertyRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetCAPropertyRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetCAPropertyResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetCAPropertyResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetCASecurityRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetCASecurityRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetCASecurityResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetCASecurityResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetConfigEntryRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetConfigEntryRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetConfigEntryResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetConfigEntryResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetMyRolesRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetMyRolesRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetMyRolesResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetMyRolesResponse) }},
+	{"dcom/csra/icertadmind2/v0.GetOfficerRightsRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetOfficerRightsRequest) }},
+	{"dcom/csra/icertadmind2/v0.GetOfficerRightsResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.GetOfficerRightsResponse) }},
+	{"dcom/csra/icertadmind2/v0.ImportKeyRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.ImportKeyRequest) }},
+	{"dcom/csra/icertadmind2/v0.ImportKeyResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.ImportKeyResponse) }},
+	{"dcom/csra/icertadmind2/v0.Ping2Request", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.Ping2Request) }},
+	{"dcom/csra/icertadmind2/v0.Ping2Response", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.Ping2Response) }},
+	{"dcom/csra/icertadmind2/v0.PublishCRLsRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.PublishCRLsRequest) }},
+	{"dcom/csra/icertadmind2/v0.PublishCRLsResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.PublishCRLsResponse) }},
+	{"dcom/csra/icertadmind2/v0.SetAuditFilterRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetAuditFilterRequest) }},
+	{"dcom/csra/icertadmind2/v0.SetAuditFilterResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetAuditFilterResponse) }},
+	{"dcom/csra/icertadmind2/v0.SetCAPropertyRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetCAPropertyRequest) }},
+	{"dcom/csra/icertadmind2/v0.SetCAPropertyResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetCAPropertyResponse) }},
+	{"dcom/csra/icertadmind2/v0.SetCASecurityRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetCASecurityRequest) }},
+	{"dcom/csra/icertadmind2/v0.SetCASecurityResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetCASecurityResponse) }},
+	{"dcom/csra/icertadmind2/v0.SetConfigEntryRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetConfigEntryRequest) }},
+	{"dcom/csra/icertadmind2/v0.SetConfigEntryResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetConfigEntryResponse) }},
+	{"dcom/csra/icertadmind2/v0.SetOfficerRightsRequest", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetOfficerRightsRequest) }},
+	{"dcom/csra/icertadmind2/v0.SetOfficerRightsResponse", func() ndr.Unmarshaler { return new(dcom_csra_icertadmind2_v0.SetOfficerRightsResponse) }},
+	{"dcom/csvp.AddRoutesRequest", func() ndr.Unmarshaler { return new(dcom_csvp.AddRoutesRequest) }},
+	{"dcom/csvp/iclustercleanup/v0.CleanupEvictedNodeRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustercleanup_v0.CleanupEvictedNodeRequest) }},
+	{"dcom/csvp/iclustercleanup/v0.CleanupEvictedNodeResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustercleanup_v0.CleanupEvictedNodeResponse) }},
+	{"dcom/csvp/iclustercleanup/v0.ClearPRRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustercleanup_v0.ClearPRRequest) }},
+	{"dcom/csvp/iclustercleanup/v0.ClearPRResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustercleanup_v0.ClearPRResponse) }},
+	{"dcom/csvp/iclusterfirewall/v0.GetNextAdapterFirewallConfigurationRequest", func() ndr.Unmarshaler {
+		return new(dcom_csvp_iclusterfirewall_v0.GetNextAdapterFirewallConfigurationRequest)
+	}},
+	{"dcom/csvp/iclusterfirewall/v0.GetNextAdapterFirewallConfigurationResponse", func() ndr.Unmarshaler {
+		return new(dcom_csvp_iclusterfirewall_v0.GetNextAdapterFirewallConfigurationResponse)
+	}},
+	{"dcom/csvp/iclusterfirewall/v0.InitializeAdapterConfigurationRequest", func() ndr.Unmarshaler {
+		return new(dcom_csvp_iclusterfirewall_v0.InitializeAdapterConfigurationRequest)
+	}},
+	{"dcom/csvp/iclusterfirewall/v0.InitializeAdapterConfigurationResponse", func() ndr.Unmarshaler {
+		return new(dcom_csvp_iclusterfirewall_v0.InitializeAdapterConfigurationResponse)
+	}},
+	{"dcom/csvp/iclusterlog/v0.GenerateClusterLogInLocalTimeRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateClusterLogInLocalTimeRequest) }},
+	{"dcom/csvp/iclusterlog/v0.GenerateClusterLogInLocalTimeResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateClusterLogInLocalTimeResponse) }},
+	{"dcom/csvp/iclusterlog/v0.GenerateClusterLogRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateClusterLogRequest) }},
+	{"dcom/csvp/iclusterlog/v0.GenerateClusterLogResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateClusterLogResponse) }},
+	{"dcom/csvp/iclusterlog/v0.GenerateTimeSpanLogInLocalTimeRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateTimeSpanLogInLocalTimeRequest) }},
+	{"dcom/csvp/iclusterlog/v0.GenerateTimeSpanLogInLocalTimeResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateTimeSpanLogInLocalTimeResponse) }},
+	{"dcom/csvp/iclusterlog/v0.GenerateTimeSpanLogRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateTimeSpanLogRequest) }},
+	{"dcom/csvp/iclusterlog/v0.GenerateTimeSpanLogResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlog_v0.GenerateTimeSpanLogResponse) }},
+	{"dcom/csvp/iclusterlogex/v0.GenerateClusterHealthLogRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlogex_v0.GenerateClusterHealthLogRequest) }},
+	{"dcom/csvp/iclusterlogex/v0.GenerateClusterHealthLogResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlogex_v0.GenerateClusterHealthLogResponse) }},
+	{"dcom/csvp/iclusterlogex/v0.GenerateClusterLogRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlogex_v0.GenerateClusterLogRequest) }},
+	{"dcom/csvp/iclusterlogex/v0.GenerateClusterLogResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterlogex_v0.GenerateClusterLogResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.CancelAddRoutesRequestRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.CancelAddRoutesRequestRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.CancelAddRoutesRequestResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.CancelAddRoutesRequestResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.CleanupNodeRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.CleanupNodeRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.CleanupNodeResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.CleanupNodeResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.GetAddRoutesStatusRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.GetAddRoutesStatusRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.GetAddRoutesStatusResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.GetAddRoutesStatusResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.GetIPConfigSerializedRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.GetIPConfigSerializedRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.GetIPConfigSerializedResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.GetIPConfigSerializedResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.InitializeNodeRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.InitializeNodeRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.InitializeNodeResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.InitializeNodeResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.ProcessAddRoutesRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.ProcessAddRoutesRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.ProcessAddRoutesResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.ProcessAddRoutesResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.QueryFirewallConfigurationRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.QueryFirewallConfigurationRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.QueryFirewallConfigurationResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.QueryFirewallConfigurationResponse) }},
+	{"dcom/csvp/iclusternetwork2/v0.SendRTMessageRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.SendRTMessageRequest) }},
+	{"dcom/csvp/iclusternetwork2/v0.SendRTMessageResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusternetwork2_v0.SendRTMessageResponse) }},
+	{"dcom/csvp/iclustersetup/v0.ConfigClusterCerV2Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.ConfigClusterCerV2Request) }},
+	{"dcom/csvp/iclustersetup/v0.ConfigClusterCerV2Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.ConfigClusterCerV2Response) }},
+	{"dcom/csvp/iclustersetup/v0.ConfigClusterCertRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.ConfigClusterCertRequest) }},
+	{"dcom/csvp/iclustersetup/v0.ConfigClusterCertResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.ConfigClusterCertResponse) }},
+	{"dcom/csvp/iclustersetup/v0.ConfigServiceSecretRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.ConfigServiceSecretRequest) }},
+	{"dcom/csvp/iclustersetup/v0.ConfigServiceSecretResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.ConfigServiceSecretResponse) }},
+	{"dcom/csvp/iclustersetup/v0.GenerateClusterCertRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GenerateClusterCertRequest) }},
+	{"dcom/csvp/iclustersetup/v0.GenerateClusterCertResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GenerateClusterCertResponse) }},
+	{"dcom/csvp/iclustersetup/v0.GenerateClusterCertV2Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GenerateClusterCertV2Request) }},
+	{"dcom/csvp/iclustersetup/v0.GenerateClusterCertV2Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GenerateClusterCertV2Response) }},
+	{"dcom/csvp/iclustersetup/v0.GetFunctionalLevelRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GetFunctionalLevelRequest) }},
+	{"dcom/csvp/iclustersetup/v0.GetFunctionalLevelResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GetFunctionalLevelResponse) }},
+	{"dcom/csvp/iclustersetup/v0.GetUpgradeVersionRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GetUpgradeVersionRequest) }},
+	{"dcom/csvp/iclustersetup/v0.GetUpgradeVersionResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.GetUpgradeVersionResponse) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveClusterCertRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveClusterCertRequest) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveClusterCertResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveClusterCertResponse) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveClusterCertV2Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveClusterCertV2Request) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveClusterCertV2Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveClusterCertV2Response) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveHostLabelRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveHostLabelRequest) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveHostLabelResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveHostLabelResponse) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveServiceSecretRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveServiceSecretRequest) }},
+	{"dcom/csvp/iclustersetup/v0.RetrieveServiceSecretResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclustersetup_v0.RetrieveServiceSecretResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.AttachRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.AttachRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.AttachResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.AttachResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.DeleteFileRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.DeleteFileRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.DeleteFileResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.DeleteFileResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.DiskPartitionIsNTFSRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.DiskPartitionIsNTFSRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.DiskPartitionIsNTFSResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.DiskPartitionIsNTFSResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetArbSectorsRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetArbSectorsRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetArbSectorsResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetArbSectorsResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetDSMsRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetDSMsRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetDSMsResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetDSMsResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetFSNameRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetFSNameRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetFSNameResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetFSNameResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetPropertiesRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetPropertiesResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetUniqueIDsRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetUniqueIDsRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.GetUniqueIDsResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.GetUniqueIDsResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.IsOnlineRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.IsOnlineRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.IsOnlineResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.IsOnlineResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.IsPRPresentRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.IsPRPresentRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.IsPRPresentResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.IsPRPresentResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.IsReadableRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.IsReadableRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.IsReadableResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.IsReadableResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.OfflineRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.OfflineRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.OfflineResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.OfflineResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.OnlineRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.OnlineRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.OnlineResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.OnlineResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRArbitrateRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRArbitrateRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRArbitrateResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRArbitrateResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRClearRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRClearRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRClearResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRClearResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRPreemptRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRPreemptRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRPreemptResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRPreemptResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRRegisterRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRRegisterRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRRegisterResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRRegisterResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRReleaseRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRReleaseRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRReleaseResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRReleaseResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRReserveRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRReserveRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRReserveResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRReserveResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRUnregisterRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRUnregisterRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PRUnregisterResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PRUnregisterResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.PrepareNodePhase2Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PrepareNodePhase2Request) }},
+	{"dcom/csvp/iclusterstorage2/v0.PrepareNodePhase2Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PrepareNodePhase2Response) }},
+	{"dcom/csvp/iclusterstorage2/v0.PrepareNodeRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PrepareNodeRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.PrepareNodeResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.PrepareNodeResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.RawReadRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.RawReadRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.RawReadResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.RawReadResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.RawWriteRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.RawWriteRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.RawWriteResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.RawWriteResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.SetOnlineRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.SetOnlineRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.SetOnlineResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.SetOnlineResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.StopDefenseRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.StopDefenseRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.StopDefenseResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.StopDefenseResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.VerifyFileDataRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.VerifyFileDataRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.VerifyFileDataResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.VerifyFileDataResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.VerifyUniqueRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.VerifyUniqueRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.VerifyUniqueResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.VerifyUniqueResponse) }},
+	{"dcom/csvp/iclusterstorage2/v0.WriteFileDataRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.WriteFileDataRequest) }},
+	{"dcom/csvp/iclusterstorage2/v0.WriteFileDataResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage2_v0.WriteFileDataResponse) }},
+	{"dcom/csvp/iclusterstorage3/v0.CSVTestSetup3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.CSVTestSetup3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.CSVTestSetup3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.CSVTestSetup3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.CheckNetFTBindings3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.CheckNetFTBindings3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.CheckNetFTBindings3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.CheckNetFTBindings3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.ConnectToNewSMBShares3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.ConnectToNewSMBShares3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.ConnectToNewSMBShares3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.ConnectToNewSMBShares3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.CreateNewSMBShares3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.CreateNewSMBShares3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.CreateNewSMBShares3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.CreateNewSMBShares3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.FindKey3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.FindKey3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.FindKey3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.FindKey3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.GetProperties3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.GetProperties3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.GetProperties3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.GetProperties3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.GetUniqueIDs3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.GetUniqueIDs3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.GetUniqueIDs3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.GetUniqueIDs3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.IsNodeClustered3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.IsNodeClustered3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.IsNodeClustered3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.IsNodeClustered3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.IsPRPresent3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.IsPRPresent3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.IsPRPresent3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.IsPRPresent3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.IsReadOnly3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.IsReadOnly3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.IsReadOnly3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.IsReadOnly3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRClear3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRClear3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRClear3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRClear3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRPreempt3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRPreempt3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRPreempt3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRPreempt3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRRegister3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRRegister3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRRegister3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRRegister3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRRelease3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRRelease3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRRelease3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRRelease3Response) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRReserve3Request", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRReserve3Request) }},
+	{"dcom/csvp/iclusterstorage3/v0.PRReserve3Response", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterstorage3_v0.PRReserve3Response) }},
+	{"dcom/csvp/iclusterupdate/v0.CountRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterupdate_v0.CountRequest) }},
+	{"dcom/csvp/iclusterupdate/v0.CountResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterupdate_v0.CountResponse) }},
+	{"dcom/csvp/iclusterupdate/v0.GetUpdatesRequest", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterupdate_v0.GetUpdatesRequest) }},
+	{"dcom/csvp/iclusterupdate/v0.GetUpdatesResponse", func() ndr.Unmarshaler { return new(dcom_csvp_iclusterupdate_v0.GetUpdatesResponse) }},
+	{"dcom/dfsrh/iadproxy/v0.CreateObjectRequest", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy_v0.CreateObjectRequest) }},
+	{"dcom/dfsrh/iadproxy/v0.CreateObjectResponse", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy_v0.CreateObjectResponse) }},
+	{"dcom/dfsrh/iadproxy/v0.DeleteObjectRequest", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy_v0.DeleteObjectRequest) }},
+	{"dcom/dfsrh/iadproxy/v0.DeleteObjectResponse", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy_v0.DeleteObjectResponse) }},
+	{"dcom/dfsrh/iadproxy/v0.ModifyObjectRequest", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy_v0.ModifyObjectRequest) }},
+	{"dcom/dfsrh/iadproxy/v0.ModifyObjectResponse", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy_v0.ModifyObjectResponse) }},
+	{"dcom/dfsrh/iadproxy2/v0.CreateObject2Request", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy2_v0.CreateObject2Request) }},
+	{"dcom/dfsrh/iadproxy2/v0.CreateObject2Response", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy2_v0.CreateObject2Response) }},
+	{"dcom/dfsrh/iadproxy2/v0.DeleteObject2Request", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy2_v0.DeleteObject2Request) }},
+	{"dcom/dfsrh/iadproxy2/v0.DeleteObject2Response", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy2_v0.DeleteObject2Response) }},
+	{"dcom/dfsrh/iadproxy2/v0.ModifyObject2Request", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy2_v0.ModifyObject2Request) }},
+	{"dcom/dfsrh/iadproxy2/v0.ModifyObject2Response", func() ndr.Unmarshaler { return new(dcom_dfsrh_iadproxy2_v0.ModifyObject2Response) }},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetCompressedReportRequest", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport_v0.GetCompressedReportRequest) }},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetCompressedReportResponse", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport_v0.GetCompressedReportResponse) }},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetRawReportExRequest", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport_v0.GetRawReportExRequest) }},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetRawReportExResponse", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport_v0.GetRawReportExResponse) }},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetReferenceBacklogCountsRequest", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport_v0.GetReferenceBacklogCountsRequest) }},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetReferenceBacklogCountsResponse", func() ndr.Unmarshaler {
+		return new(dcom_dfsrh_iserverhealthreport_v0.GetReferenceBacklogCountsResponse)
+	}},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetReferenceVersionVectorsRequest", func() ndr.Unmarshaler {
+		return new(dcom_dfsrh_iserverhealthreport_v0.GetReferenceVersionVectorsRequest)
+	}},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetReferenceVersionVectorsResponse", func() ndr.Unmarshaler {
+		return new(dcom_dfsrh_iserverhealthreport_v0.GetReferenceVersionVectorsResponse)
+	}},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetReportRequest", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport_v0.GetReportRequest) }},
+	{"dcom/dfsrh/iserverhealthreport/v0.GetReportResponse", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport_v0.GetReportResponse) }},
+	{"dcom/dfsrh/iserverhealthreport2/v0.GetCompressedReport2Request", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport2_v0.GetCompressedReport2Request) }},
+	{"dcom/dfsrh/iserverhealthreport2/v0.GetCompressedReport2Response", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport2_v0.GetCompressedReport2Response) }},
+	{"dcom/dfsrh/iserverhealthreport2/v0.GetReport2Request", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport2_v0.GetReport2Request) }},
+	{"dcom/dfsrh/iserverhealthreport2/v0.GetReport2Response", func() ndr.Unmarshaler { return new(dcom_dfsrh_iserverhealthreport2_v0.GetReport2Response) }},
+	{"dcom/dmrp/idmnotify/v0.ObjectsChangedRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_idmnotify_v0.ObjectsChangedRequest) }},
+	{"dcom/dmrp/idmnotify/v0.ObjectsChangedResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_idmnotify_v0.ObjectsChangedResponse) }},
+	{"dcom/dmrp/idmremoteserver/v0.CreateRemoteObjectRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_idmremoteserver_v0.CreateRemoteObjectRequest) }},
+	{"dcom/dmrp/idmremoteserver/v0.CreateRemoteObjectResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_idmremoteserver_v0.CreateRemoteObjectResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.AbortTaskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AbortTaskRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.AbortTaskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AbortTaskResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.AddAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AddAccessPathRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.AddAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AddAccessPathResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.AddMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AddMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.AddMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AddMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.AssignDriveLetterRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AssignDriveLetterRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.AssignDriveLetterResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.AssignDriveLetterResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreatePartitionAssignAndFormatExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreatePartitionAssignAndFormatExRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreatePartitionAssignAndFormatExResponse", func() ndr.Unmarshaler {
+		return new(dcom_dmrp_ivolumeclient_v0.CreatePartitionAssignAndFormatExResponse)
+	}},
+	{"dcom/dmrp/ivolumeclient/v0.CreatePartitionAssignAndFormatRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreatePartitionAssignAndFormatRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreatePartitionAssignAndFormatResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreatePartitionAssignAndFormatResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreatePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreatePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreatePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreatePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreateVolumeAssignAndFormatExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreateVolumeAssignAndFormatExRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreateVolumeAssignAndFormatExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreateVolumeAssignAndFormatExResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreateVolumeAssignAndFormatRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreateVolumeAssignAndFormatRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreateVolumeAssignAndFormatResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreateVolumeAssignAndFormatResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreateVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreateVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.CreateVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.CreateVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.DeleteAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DeleteAccessPathRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.DeleteAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DeleteAccessPathResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.DeletePartitionNumberInfoFromRegistryRequest", func() ndr.Unmarshaler {
+		return new(dcom_dmrp_ivolumeclient_v0.DeletePartitionNumberInfoFromRegistryRequest)
+	}},
+	{"dcom/dmrp/ivolumeclient/v0.DeletePartitionNumberInfoFromRegistryResponse", func() ndr.Unmarshaler {
+		return new(dcom_dmrp_ivolumeclient_v0.DeletePartitionNumberInfoFromRegistryResponse)
+	}},
+	{"dcom/dmrp/ivolumeclient/v0.DeletePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DeletePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.DeletePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DeletePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.DeleteVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DeleteVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.DeleteVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DeleteVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.DiskMergeQueryRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DiskMergeQueryRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.DiskMergeQueryResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DiskMergeQueryResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.DiskMergeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DiskMergeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.DiskMergeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.DiskMergeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EjectRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EjectRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EjectResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EjectResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EncapsulateDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EncapsulateDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EncapsulateDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EncapsulateDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumAccessPathForVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumAccessPathForVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumAccessPathForVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumAccessPathForVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumAccessPathRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumAccessPathResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumDiskRegionsRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumDiskRegionsRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumDiskRegionsResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumDiskRegionsResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumDisksRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumDisksRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumDisksResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumDisksResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumDriveLettersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumDriveLettersRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumDriveLettersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumDriveLettersResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumLocalFileSystemsRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumLocalFileSystemsRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumLocalFileSystemsResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumLocalFileSystemsResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumTasksRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumTasksRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumTasksResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumTasksResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumVolumeMembersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumVolumeMembersRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumVolumeMembersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumVolumeMembersResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumVolumesRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumVolumesRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.EnumVolumesResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.EnumVolumesResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTBreakMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTBreakMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTBreakMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTBreakMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTDeleteVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTDeleteVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTDeleteVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTDeleteVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTEnumLogicalDiskMembersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTEnumLogicalDiskMembersRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTEnumLogicalDiskMembersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTEnumLogicalDiskMembersResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTEnumVolumesRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTEnumVolumesRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTEnumVolumesResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTEnumVolumesResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTRegenerateParityStripeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTRegenerateParityStripeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTRegenerateParityStripeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTRegenerateParityStripeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTReplaceMirrorPartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTReplaceMirrorPartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTReplaceMirrorPartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTReplaceMirrorPartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTReplaceParityStripePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTReplaceParityStripePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTReplaceParityStripePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTReplaceParityStripePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTResyncMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTResyncMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FTResyncMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FTResyncMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FormatRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FormatRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FormatResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FormatResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.FreeDriveLetterRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FreeDriveLetterRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.FreeDriveLetterResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.FreeDriveLetterResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetDontShowRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetDontShowRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetDontShowResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetDontShowResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetEncapsulateDiskInfoRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetEncapsulateDiskInfoRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetEncapsulateDiskInfoResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetEncapsulateDiskInfoResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetInstalledFileSystemsRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetInstalledFileSystemsRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetInstalledFileSystemsResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetInstalledFileSystemsResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetTaskDetailRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetTaskDetailRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetTaskDetailResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetTaskDetailResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetVolumeMountNameRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetVolumeMountNameRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.GetVolumeMountNameResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GetVolumeMountNameResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.GrowVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GrowVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.GrowVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.GrowVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.HResultGetErrorDataRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.HResultGetErrorDataRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.HResultGetErrorDataResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.HResultGetErrorDataResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.ImportDiskGroupRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ImportDiskGroupRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.ImportDiskGroupResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ImportDiskGroupResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.InitializeDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.InitializeDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.InitializeDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.InitializeDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.InitializeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.InitializeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.InitializeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.InitializeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.MarkActivePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.MarkActivePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.MarkActivePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.MarkActivePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.QueryChangePartitionNumbersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.QueryChangePartitionNumbersRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.QueryChangePartitionNumbersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.QueryChangePartitionNumbersResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.ReAttachDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ReAttachDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.ReAttachDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ReAttachDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.ReConnectDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ReConnectDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.ReConnectDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ReConnectDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.RefreshFileSystemRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RefreshFileSystemRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.RefreshFileSystemResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RefreshFileSystemResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.RefreshRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RefreshRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.RefreshResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RefreshResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.RemoveMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RemoveMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.RemoveMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RemoveMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.ReplaceRAID5ColumnRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ReplaceRAID5ColumnRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.ReplaceRAID5ColumnResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ReplaceRAID5ColumnResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.RescanDisksRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RescanDisksRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.RescanDisksResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RescanDisksResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.RestartVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RestartVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.RestartVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.RestartVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.SecureSystemPartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.SecureSystemPartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.SecureSystemPartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.SecureSystemPartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.SetDontShowRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.SetDontShowRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.SetDontShowResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.SetDontShowResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.ShutDownSystemRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ShutDownSystemRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.ShutDownSystemResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.ShutDownSystemResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.SplitMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.SplitMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.SplitMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.SplitMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.UninitializeDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.UninitializeDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.UninitializeDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.UninitializeDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.UninitializeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.UninitializeRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.UninitializeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.UninitializeResponse) }},
+	{"dcom/dmrp/ivolumeclient/v0.WriteSignatureRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.WriteSignatureRequest) }},
+	{"dcom/dmrp/ivolumeclient/v0.WriteSignatureResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient_v0.WriteSignatureResponse) }},
+	{"dcom/dmrp/ivolumeclient2/v0.GetMaxAdjustedFreeSpaceRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient2_v0.GetMaxAdjustedFreeSpaceRequest) }},
+	{"dcom/dmrp/ivolumeclient2/v0.GetMaxAdjustedFreeSpaceResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient2_v0.GetMaxAdjustedFreeSpaceResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AbortTaskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AbortTaskRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AbortTaskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AbortTaskResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AddAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AddAccessPathRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AddAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AddAccessPathResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AddMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AddMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AddMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AddMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AssignDriveLetterRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AssignDriveLetterRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.AssignDriveLetterResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.AssignDriveLetterResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionAssignAndFormatExRequest", func() ndr.Unmarshaler {
+		return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionAssignAndFormatExRequest)
+	}},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionAssignAndFormatExResponse", func() ndr.Unmarshaler {
+		return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionAssignAndFormatExResponse)
+	}},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionAssignAndFormatRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionAssignAndFormatRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionAssignAndFormatResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionAssignAndFormatResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionsForVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionsForVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreatePartitionsForVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreatePartitionsForVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreateVolumeAssignAndFormatExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreateVolumeAssignAndFormatExRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreateVolumeAssignAndFormatExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreateVolumeAssignAndFormatExResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreateVolumeAssignAndFormatRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreateVolumeAssignAndFormatRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreateVolumeAssignAndFormatResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreateVolumeAssignAndFormatResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreateVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreateVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.CreateVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.CreateVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeleteAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeleteAccessPathRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeleteAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeleteAccessPathResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeletePartitionNumberInfoFromRegistryRequest", func() ndr.Unmarshaler {
+		return new(dcom_dmrp_ivolumeclient3_v0.DeletePartitionNumberInfoFromRegistryRequest)
+	}},
+	{"dcom/dmrp/ivolumeclient3/v0.DeletePartitionNumberInfoFromRegistryResponse", func() ndr.Unmarshaler {
+		return new(dcom_dmrp_ivolumeclient3_v0.DeletePartitionNumberInfoFromRegistryResponse)
+	}},
+	{"dcom/dmrp/ivolumeclient3/v0.DeletePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeletePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeletePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeletePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeletePartitionsForVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeletePartitionsForVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeletePartitionsForVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeletePartitionsForVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeleteVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeleteVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DeleteVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DeleteVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DiskMergeQueryRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DiskMergeQueryRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DiskMergeQueryResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DiskMergeQueryResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DiskMergeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DiskMergeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.DiskMergeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.DiskMergeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EjectRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EjectRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EjectResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EjectResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EncapsulateDiskExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EncapsulateDiskExRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EncapsulateDiskExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EncapsulateDiskExResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumAccessPathForVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumAccessPathForVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumAccessPathForVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumAccessPathForVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumAccessPathRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumAccessPathResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumDiskRegionsExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumDiskRegionsExRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumDiskRegionsExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumDiskRegionsExResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumDisksExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumDisksExRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumDisksExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumDisksExResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumDriveLettersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumDriveLettersRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumDriveLettersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumDriveLettersResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumLocalFileSystemsRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumLocalFileSystemsRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumLocalFileSystemsResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumLocalFileSystemsResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumTasksRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumTasksRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumTasksResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumTasksResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumVolumeMembersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumVolumeMembersRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumVolumeMembersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumVolumeMembersResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumVolumesRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumVolumesRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.EnumVolumesResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.EnumVolumesResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTBreakMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTBreakMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTBreakMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTBreakMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTDeleteVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTDeleteVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTDeleteVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTDeleteVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTEnumLogicalDiskMembersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTEnumLogicalDiskMembersRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTEnumLogicalDiskMembersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTEnumLogicalDiskMembersResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTEnumVolumesRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTEnumVolumesRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTEnumVolumesResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTEnumVolumesResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTRegenerateParityStripeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTRegenerateParityStripeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTRegenerateParityStripeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTRegenerateParityStripeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTReplaceMirrorPartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTReplaceMirrorPartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTReplaceMirrorPartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTReplaceMirrorPartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTReplaceParityStripePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTReplaceParityStripePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTReplaceParityStripePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTReplaceParityStripePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTResyncMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTResyncMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FTResyncMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FTResyncMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FormatRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FormatRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FormatResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FormatResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FreeDriveLetterRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FreeDriveLetterRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.FreeDriveLetterResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.FreeDriveLetterResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetDontShowRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetDontShowRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetDontShowResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetDontShowResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetEncapsulateDiskInfoExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetEncapsulateDiskInfoExRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetEncapsulateDiskInfoExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetEncapsulateDiskInfoExResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetInstalledFileSystemsRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetInstalledFileSystemsRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetInstalledFileSystemsResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetInstalledFileSystemsResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetMaxAdjustedFreeSpaceRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetMaxAdjustedFreeSpaceRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetMaxAdjustedFreeSpaceResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetMaxAdjustedFreeSpaceResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetTaskDetailRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetTaskDetailRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetTaskDetailResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetTaskDetailResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetVolumeMountNameRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetVolumeMountNameRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GetVolumeMountNameResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GetVolumeMountNameResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GrowVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GrowVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.GrowVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.GrowVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.HResultGetErrorDataRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.HResultGetErrorDataRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.HResultGetErrorDataResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.HResultGetErrorDataResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ImportDiskGroupRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ImportDiskGroupRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ImportDiskGroupResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ImportDiskGroupResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.InitializeDiskExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.InitializeDiskExRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.InitializeDiskExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.InitializeDiskExResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.InitializeDiskStyleRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.InitializeDiskStyleRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.InitializeDiskStyleResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.InitializeDiskStyleResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.InitializeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.InitializeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.InitializeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.InitializeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.MarkActivePartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.MarkActivePartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.MarkActivePartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.MarkActivePartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.QueryChangePartitionNumbersRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.QueryChangePartitionNumbersRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.QueryChangePartitionNumbersResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.QueryChangePartitionNumbersResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ReAttachDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ReAttachDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ReAttachDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ReAttachDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ReConnectDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ReConnectDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ReConnectDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ReConnectDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RefreshFileSystemRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RefreshFileSystemRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RefreshFileSystemResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RefreshFileSystemResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RefreshRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RefreshRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RefreshResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RefreshResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RemoveMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RemoveMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RemoveMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RemoveMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ReplaceRAID5ColumnRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ReplaceRAID5ColumnRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ReplaceRAID5ColumnResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ReplaceRAID5ColumnResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RescanDisksRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RescanDisksRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RescanDisksResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RescanDisksResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RestartVolumeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RestartVolumeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.RestartVolumeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.RestartVolumeResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.SecureSystemPartitionRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.SecureSystemPartitionRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.SecureSystemPartitionResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.SecureSystemPartitionResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.SetDontShowRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.SetDontShowRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.SetDontShowResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.SetDontShowResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ShutDownSystemRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ShutDownSystemRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.ShutDownSystemResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.ShutDownSystemResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.SplitMirrorRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.SplitMirrorRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.SplitMirrorResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.SplitMirrorResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.UninitializeDiskRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.UninitializeDiskRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.UninitializeDiskResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.UninitializeDiskResponse) }},
+	{"dcom/dmrp/ivolumeclient3/v0.UninitializeRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.UninitializeRequest) }},
+	{"dcom/dmrp/ivolumeclient3/v0.UninitializeResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient3_v0.UninitializeResponse) }},
+	{"dcom/dmrp/ivolumeclient4/v0.GetVolumeDeviceNameRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient4_v0.GetVolumeDeviceNameRequest) }},
+	{"dcom/dmrp/ivolumeclient4/v0.GetVolumeDeviceNameResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient4_v0.GetVolumeDeviceNameResponse) }},
+	{"dcom/dmrp/ivolumeclient4/v0.RefreshExRequest", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient4_v0.RefreshExRequest) }},
+	{"dcom/dmrp/ivolumeclient4/v0.RefreshExResponse", func() ndr.Unmarshaler { return new(dcom_dmrp_ivolumeclient4_v0.RefreshExResponse) }},
+	{"dcom/fsrm/ifsrmaction/v0.DeleteRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.DeleteRequest) }},
+	{"dcom/fsrm/ifsrmaction/v0.DeleteResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.DeleteResponse) }},
+	{"dcom/fsrm/ifsrmaction/v0.GetActionTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.GetActionTypeRequest) }},
+	{"dcom/fsrm/ifsrmaction/v0.GetActionTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.GetActionTypeResponse) }},
+	{"dcom/fsrm/ifsrmaction/v0.GetIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.GetIDRequest) }},
+	{"dcom/fsrm/ifsrmaction/v0.GetIDResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.GetIDResponse) }},
+	{"dcom/fsrm/ifsrmaction/v0.GetRunLimitIntervalRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.GetRunLimitIntervalRequest) }},
+	{"dcom/fsrm/ifsrmaction/v0.GetRunLimitIntervalResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.GetRunLimitIntervalResponse) }},
+	{"dcom/fsrm/ifsrmaction/v0.SetRunLimitIntervalRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.SetRunLimitIntervalRequest) }},
+	{"dcom/fsrm/ifsrmaction/v0.SetRunLimitIntervalResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmaction_v0.SetRunLimitIntervalResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetAccountRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetAccountRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetAccountResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetAccountResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetArgumentsRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetArgumentsResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetExecutablePathRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetExecutablePathRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetExecutablePathResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetExecutablePathResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetKillTimeoutRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetKillTimeoutRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetKillTimeoutResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetKillTimeoutResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetLogResultRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetLogResultRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetLogResultResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetLogResultResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetMonitorCommandRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetMonitorCommandRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetMonitorCommandResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetMonitorCommandResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetWorkingDirectoryRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetWorkingDirectoryRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.GetWorkingDirectoryResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.GetWorkingDirectoryResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetAccountRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetAccountRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetAccountResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetAccountResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetArgumentsRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetArgumentsResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetExecutablePathRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetExecutablePathRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetExecutablePathResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetExecutablePathResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetKillTimeoutRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetKillTimeoutRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetKillTimeoutResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetKillTimeoutResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetLogResultRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetLogResultRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetLogResultResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetLogResultResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetMonitorCommandRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetMonitorCommandRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetMonitorCommandResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetMonitorCommandResponse) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetWorkingDirectoryRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetWorkingDirectoryRequest) }},
+	{"dcom/fsrm/ifsrmactioncommand/v0.SetWorkingDirectoryResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioncommand_v0.SetWorkingDirectoryResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailBCCRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailBCCRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailBCCResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailBCCResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailCCRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailCCRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailCCResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailCCResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailFromRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailFromRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailFromResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailFromResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailReplyToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailReplyToRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailReplyToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailReplyToResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailSubjectRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailSubjectRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailSubjectResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailSubjectResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailToRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMailToResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMessageTextRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMessageTextRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.GetMessageTextResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.GetMessageTextResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailBCCRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailBCCRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailBCCResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailBCCResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailCCRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailCCRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailCCResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailCCResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailFromRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailFromRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailFromResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailFromResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailReplyToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailReplyToRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailReplyToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailReplyToResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailSubjectRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailSubjectRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailSubjectResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailSubjectResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailToRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMailToResponse) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMessageTextRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMessageTextRequest) }},
+	{"dcom/fsrm/ifsrmactionemail/v0.SetMessageTextResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail_v0.SetMessageTextResponse) }},
+	{"dcom/fsrm/ifsrmactionemail2/v0.GetAttachmentFileListSizeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail2_v0.GetAttachmentFileListSizeRequest) }},
+	{"dcom/fsrm/ifsrmactionemail2/v0.GetAttachmentFileListSizeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail2_v0.GetAttachmentFileListSizeResponse) }},
+	{"dcom/fsrm/ifsrmactionemail2/v0.SetAttachmentFileListSizeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail2_v0.SetAttachmentFileListSizeRequest) }},
+	{"dcom/fsrm/ifsrmactionemail2/v0.SetAttachmentFileListSizeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionemail2_v0.SetAttachmentFileListSizeResponse) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.GetEventTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.GetEventTypeRequest) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.GetEventTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.GetEventTypeResponse) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.GetMessageTextRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.GetMessageTextRequest) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.GetMessageTextResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.GetMessageTextResponse) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.SetEventTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.SetEventTypeRequest) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.SetEventTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.SetEventTypeResponse) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.SetMessageTextRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.SetMessageTextRequest) }},
+	{"dcom/fsrm/ifsrmactioneventlog/v0.SetMessageTextResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactioneventlog_v0.SetMessageTextResponse) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.GetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.GetMailToRequest) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.GetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.GetMailToResponse) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.GetReportTypesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.GetReportTypesRequest) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.GetReportTypesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.GetReportTypesResponse) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.SetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.SetMailToRequest) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.SetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.SetMailToResponse) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.SetReportTypesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.SetReportTypesRequest) }},
+	{"dcom/fsrm/ifsrmactionreport/v0.SetReportTypesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmactionreport_v0.SetReportTypesResponse) }},
+	{"dcom/fsrm/ifsrmautoapplyquota/v0.CommitAndUpdateDerivedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmautoapplyquota_v0.CommitAndUpdateDerivedRequest) }},
+	{"dcom/fsrm/ifsrmautoapplyquota/v0.CommitAndUpdateDerivedResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmautoapplyquota_v0.CommitAndUpdateDerivedResponse) }},
+	{"dcom/fsrm/ifsrmautoapplyquota/v0.GetExcludeFoldersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmautoapplyquota_v0.GetExcludeFoldersRequest) }},
+	{"dcom/fsrm/ifsrmautoapplyquota/v0.GetExcludeFoldersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmautoapplyquota_v0.GetExcludeFoldersResponse) }},
+	{"dcom/fsrm/ifsrmautoapplyquota/v0.SetExcludeFoldersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmautoapplyquota_v0.SetExcludeFoldersRequest) }},
+	{"dcom/fsrm/ifsrmautoapplyquota/v0.SetExcludeFoldersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmautoapplyquota_v0.SetExcludeFoldersResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CancelClassificationRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.CancelClassificationRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CancelClassificationResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.CancelClassificationResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.ClearFilePropertyRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.ClearFilePropertyRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.ClearFilePropertyResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.ClearFilePropertyResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CreateModuleDefinitionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.CreateModuleDefinitionRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CreateModuleDefinitionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.CreateModuleDefinitionResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CreatePropertyDefinitionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.CreatePropertyDefinitionRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CreatePropertyDefinitionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.CreatePropertyDefinitionResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CreateRuleRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.CreateRuleRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.CreateRuleResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.CreateRuleResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumFilePropertiesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumFilePropertiesRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumFilePropertiesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumFilePropertiesResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumModuleDefinitionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumModuleDefinitionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumModuleDefinitionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumModuleDefinitionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumPropertyDefinitionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumPropertyDefinitionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumPropertyDefinitionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumPropertyDefinitionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumRulesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumRulesRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.EnumRulesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.EnumRulesResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationLastErrorRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationLastErrorRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationLastErrorResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationLastErrorResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationLastReportPathWithoutExtensionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationLastReportPathWithoutExtensionRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationLastReportPathWithoutExtensionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationLastReportPathWithoutExtensionResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationReportEnabledRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationReportEnabledRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationReportEnabledResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationReportEnabledResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationReportFormatsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationReportFormatsRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationReportFormatsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationReportFormatsResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationReportMailToRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationReportMailToRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationReportMailToResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationReportMailToResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationRunningStatusRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationRunningStatusRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetClassificationRunningStatusResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetClassificationRunningStatusResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetFilePropertyRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetFilePropertyRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetFilePropertyResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetFilePropertyResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetLoggingRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetLoggingRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetLoggingResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetLoggingResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetModuleDefinitionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetModuleDefinitionRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetModuleDefinitionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetModuleDefinitionResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetPropertyDefinitionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetPropertyDefinitionRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetPropertyDefinitionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetPropertyDefinitionResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetRuleRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetRuleRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.GetRuleResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.GetRuleResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.RunClassificationRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.RunClassificationRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.RunClassificationResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.RunClassificationResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetClassificationReportEnabledRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetClassificationReportEnabledRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetClassificationReportEnabledResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetClassificationReportEnabledResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetClassificationReportFormatsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetClassificationReportFormatsRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetClassificationReportFormatsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetClassificationReportFormatsResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetClassificationReportMailToRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetClassificationReportMailToRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetClassificationReportMailToResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetClassificationReportMailToResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetFilePropertyRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetFilePropertyRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetFilePropertyResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetFilePropertyResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetLoggingRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetLoggingRequest) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.SetLoggingResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationmanager_v0.SetLoggingResponse) }},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.WaitForClassificationCompletionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.WaitForClassificationCompletionRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassificationmanager/v0.WaitForClassificationCompletionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassificationmanager_v0.WaitForClassificationCompletionResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.GetExecutionOptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.GetExecutionOptionRequest) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.GetExecutionOptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.GetExecutionOptionResponse) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.GetPropertyAffectedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.GetPropertyAffectedRequest) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.GetPropertyAffectedResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.GetPropertyAffectedResponse) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.GetValueRequest) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.GetValueResponse) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.SetExecutionOptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.SetExecutionOptionRequest) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.SetExecutionOptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.SetExecutionOptionResponse) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.SetPropertyAffectedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.SetPropertyAffectedRequest) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.SetPropertyAffectedResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.SetPropertyAffectedResponse) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.SetValueRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.SetValueRequest) }},
+	{"dcom/fsrm/ifsrmclassificationrule/v0.SetValueResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmclassificationrule_v0.SetValueResponse) }},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.GetNeedsExplicitValueRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.GetNeedsExplicitValueRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.GetNeedsExplicitValueResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.GetNeedsExplicitValueResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.GetPropertiesAffectedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.GetPropertiesAffectedRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.GetPropertiesAffectedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.GetPropertiesAffectedResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.GetPropertiesUsedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.GetPropertiesUsedRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.GetPropertiesUsedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.GetPropertiesUsedResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.SetNeedsExplicitValueRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.SetNeedsExplicitValueRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.SetNeedsExplicitValueResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.SetNeedsExplicitValueResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.SetPropertiesAffectedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.SetPropertiesAffectedRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.SetPropertiesAffectedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.SetPropertiesAffectedResponse)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.SetPropertiesUsedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.SetPropertiesUsedRequest)
+	}},
+	{"dcom/fsrm/ifsrmclassifiermoduledefinition/v0.SetPropertiesUsedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmclassifiermoduledefinition_v0.SetPropertiesUsedResponse)
+	}},
+	{"dcom/fsrm/ifsrmcollection/v0.CancelRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.CancelRequest) }},
+	{"dcom/fsrm/ifsrmcollection/v0.CancelResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.CancelResponse) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetByIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetByIDRequest) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetByIDResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetByIDResponse) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetCountRequest) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetCountResponse) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetItemRequest) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetItemResponse) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetStateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetStateRequest) }},
+	{"dcom/fsrm/ifsrmcollection/v0.GetStateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.GetStateResponse) }},
+	{"dcom/fsrm/ifsrmcollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.Get_NewEnumRequest) }},
+	{"dcom/fsrm/ifsrmcollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.Get_NewEnumResponse) }},
+	{"dcom/fsrm/ifsrmcollection/v0.WaitForCompletionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.WaitForCompletionRequest) }},
+	{"dcom/fsrm/ifsrmcollection/v0.WaitForCompletionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcollection_v0.WaitForCompletionResponse) }},
+	{"dcom/fsrm/ifsrmcommittablecollection/v0.CommitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcommittablecollection_v0.CommitRequest) }},
+	{"dcom/fsrm/ifsrmcommittablecollection/v0.CommitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmcommittablecollection_v0.CommitResponse) }},
+	{"dcom/fsrm/ifsrmderivedobjectsresult/v0.GetDerivedObjectsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmderivedobjectsresult_v0.GetDerivedObjectsRequest) }},
+	{"dcom/fsrm/ifsrmderivedobjectsresult/v0.GetDerivedObjectsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmderivedobjectsresult_v0.GetDerivedObjectsResponse) }},
+	{"dcom/fsrm/ifsrmderivedobjectsresult/v0.GetResultsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmderivedobjectsresult_v0.GetResultsRequest) }},
+	{"dcom/fsrm/ifsrmderivedobjectsresult/v0.GetResultsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmderivedobjectsresult_v0.GetResultsResponse) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.GetMembersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.GetMembersRequest) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.GetMembersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.GetMembersResponse) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.GetNonMembersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.GetNonMembersRequest) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.GetNonMembersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.GetNonMembersResponse) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.SetMembersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.SetMembersRequest) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.SetMembersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.SetMembersResponse) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.SetNonMembersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.SetNonMembersRequest) }},
+	{"dcom/fsrm/ifsrmfilegroup/v0.SetNonMembersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroup_v0.SetNonMembersResponse) }},
+	{"dcom/fsrm/ifsrmfilegroupimported/v0.GetOverwriteOnCommitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupimported_v0.GetOverwriteOnCommitRequest) }},
+	{"dcom/fsrm/ifsrmfilegroupimported/v0.GetOverwriteOnCommitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupimported_v0.GetOverwriteOnCommitResponse) }},
+	{"dcom/fsrm/ifsrmfilegroupimported/v0.SetOverwriteOnCommitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupimported_v0.SetOverwriteOnCommitRequest) }},
+	{"dcom/fsrm/ifsrmfilegroupimported/v0.SetOverwriteOnCommitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupimported_v0.SetOverwriteOnCommitResponse) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.CreateFileGroupRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.CreateFileGroupRequest) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.CreateFileGroupResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.CreateFileGroupResponse) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.EnumFileGroupsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.EnumFileGroupsRequest) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.EnumFileGroupsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.EnumFileGroupsResponse) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.ExportFileGroupsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.ExportFileGroupsRequest) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.ExportFileGroupsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.ExportFileGroupsResponse) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.GetFileGroupRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.GetFileGroupRequest) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.GetFileGroupResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.GetFileGroupResponse) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.ImportFileGroupsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.ImportFileGroupsRequest) }},
+	{"dcom/fsrm/ifsrmfilegroupmanager/v0.ImportFileGroupsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilegroupmanager_v0.ImportFileGroupsResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.AddNotificationRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.AddNotificationRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.AddNotificationResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.AddNotificationResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CancelRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CancelRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CancelResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CancelResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CreateCustomActionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CreateCustomActionRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CreateCustomActionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CreateCustomActionResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CreateNotificationActionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CreateNotificationActionRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CreateNotificationActionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CreateNotificationActionResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CreatePropertyConditionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CreatePropertyConditionRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.CreatePropertyConditionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.CreatePropertyConditionResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.DeleteNotificationRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.DeleteNotificationRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.DeleteNotificationResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.DeleteNotificationResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.EnumNotificationActionsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.EnumNotificationActionsRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.EnumNotificationActionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.EnumNotificationActionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetCustomActionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetCustomActionRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetCustomActionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetCustomActionResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetDaysSinceFileCreatedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetDaysSinceFileCreatedRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetDaysSinceFileCreatedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetDaysSinceFileCreatedResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetDaysSinceFileLastAccessedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetDaysSinceFileLastAccessedRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetDaysSinceFileLastAccessedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetDaysSinceFileLastAccessedResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetDaysSinceFileLastModifiedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetDaysSinceFileLastModifiedRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetDaysSinceFileLastModifiedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetDaysSinceFileLastModifiedResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetEnabledRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetEnabledResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetExpirationDirectoryRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetExpirationDirectoryRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetExpirationDirectoryResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetExpirationDirectoryResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetFileNamePatternRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetFileNamePatternRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetFileNamePatternResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetFileNamePatternResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetFormatsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetFormatsRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetFormatsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetFormatsResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetFromDateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetFromDateRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetFromDateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetFromDateResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLastErrorRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLastErrorRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLastErrorResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLastErrorResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLastReportPathWithoutExtensionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLastReportPathWithoutExtensionRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLastReportPathWithoutExtensionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLastReportPathWithoutExtensionResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLastRunRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLastRunRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLastRunResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLastRunResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLoggingRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLoggingRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetLoggingResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetLoggingResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetMailToRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetMailToResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetNamespaceRootsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetNamespaceRootsRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetNamespaceRootsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetNamespaceRootsResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetNotificationsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetNotificationsRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetNotificationsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetNotificationsResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetOperationTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetOperationTypeRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetOperationTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetOperationTypeResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetParametersRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetParametersResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetPropertyConditionsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetPropertyConditionsRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetPropertyConditionsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetPropertyConditionsResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetReportEnabledRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetReportEnabledRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetReportEnabledResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetReportEnabledResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetRunningStatusRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetRunningStatusRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetRunningStatusResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetRunningStatusResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetTaskRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetTaskRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.GetTaskResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.GetTaskResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.ModifyNotificationRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.ModifyNotificationRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.ModifyNotificationResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.ModifyNotificationResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.RunRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.RunRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.RunResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.RunResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetDaysSinceFileCreatedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetDaysSinceFileCreatedRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetDaysSinceFileCreatedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetDaysSinceFileCreatedResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetDaysSinceFileLastAccessedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetDaysSinceFileLastAccessedRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetDaysSinceFileLastAccessedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetDaysSinceFileLastAccessedResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetDaysSinceFileLastModifiedRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetDaysSinceFileLastModifiedRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetDaysSinceFileLastModifiedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetDaysSinceFileLastModifiedResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetEnabledRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetEnabledResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetExpirationDirectoryRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetExpirationDirectoryRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetExpirationDirectoryResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetExpirationDirectoryResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetFileNamePatternRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetFileNamePatternRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetFileNamePatternResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetFileNamePatternResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetFormatsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetFormatsRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetFormatsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetFormatsResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetFromDateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetFromDateRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetFromDateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetFromDateResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetLoggingRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetLoggingRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetLoggingResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetLoggingResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetMailToRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetMailToResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetNamespaceRootsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetNamespaceRootsRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetNamespaceRootsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetNamespaceRootsResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetOperationTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetOperationTypeRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetOperationTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetOperationTypeResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetParametersRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetParametersResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetReportEnabledRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetReportEnabledRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetReportEnabledResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetReportEnabledResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetTaskRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetTaskRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.SetTaskResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.SetTaskResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.WaitForCompletionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.WaitForCompletionRequest) }},
+	{"dcom/fsrm/ifsrmfilemanagementjob/v1.WaitForCompletionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilemanagementjob_v1.WaitForCompletionResponse) }},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.CreateFileManagementJobRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.CreateFileManagementJobRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.CreateFileManagementJobResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.CreateFileManagementJobResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.EnumFileManagementJobsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.EnumFileManagementJobsRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.EnumFileManagementJobsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.EnumFileManagementJobsResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.GetActionVariableDescriptionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.GetActionVariableDescriptionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.GetActionVariableDescriptionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.GetActionVariableDescriptionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.GetActionVariablesRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.GetActionVariablesRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.GetActionVariablesResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.GetActionVariablesResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.GetFileManagementJobRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.GetFileManagementJobRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilemanagementjobmanager/v1.GetFileManagementJobResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilemanagementjobmanager_v1.GetFileManagementJobResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreen/v0.ApplyTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.ApplyTemplateRequest) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.ApplyTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.ApplyTemplateResponse) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetMatchesSourceTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetMatchesSourceTemplateRequest) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetMatchesSourceTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetMatchesSourceTemplateResponse) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetPathRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetPathRequest) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetPathResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetPathResponse) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetSourceTemplateNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetSourceTemplateNameRequest) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetSourceTemplateNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetSourceTemplateNameResponse) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetUserAccountRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetUserAccountRequest) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetUserAccountResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetUserAccountResponse) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetUserSIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetUserSIDRequest) }},
+	{"dcom/fsrm/ifsrmfilescreen/v0.GetUserSIDResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreen_v0.GetUserSIDResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.CreateActionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.CreateActionRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.CreateActionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.CreateActionResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.EnumActionsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.EnumActionsRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.EnumActionsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.EnumActionsResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.GetBlockedFileGroupsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.GetBlockedFileGroupsRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.GetBlockedFileGroupsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.GetBlockedFileGroupsResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.GetFileScreenFlagsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.GetFileScreenFlagsRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.GetFileScreenFlagsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.GetFileScreenFlagsResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.SetBlockedFileGroupsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.SetBlockedFileGroupsRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.SetBlockedFileGroupsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.SetBlockedFileGroupsResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.SetFileScreenFlagsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.SetFileScreenFlagsRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenbase/v0.SetFileScreenFlagsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenbase_v0.SetFileScreenFlagsResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenexception/v0.GetAllowedFileGroupsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenexception_v0.GetAllowedFileGroupsRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenexception/v0.GetAllowedFileGroupsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenexception_v0.GetAllowedFileGroupsResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenexception/v0.GetPathRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenexception_v0.GetPathRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenexception/v0.GetPathResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenexception_v0.GetPathResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenexception/v0.SetAllowedFileGroupsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenexception_v0.SetAllowedFileGroupsRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenexception/v0.SetAllowedFileGroupsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenexception_v0.SetAllowedFileGroupsResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.CreateFileScreenCollectionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.CreateFileScreenCollectionRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.CreateFileScreenCollectionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.CreateFileScreenCollectionResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.CreateFileScreenExceptionRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.CreateFileScreenExceptionRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.CreateFileScreenExceptionResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.CreateFileScreenExceptionResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.CreateFileScreenRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.CreateFileScreenRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.CreateFileScreenResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.CreateFileScreenResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.EnumFileScreenExceptionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.EnumFileScreenExceptionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.EnumFileScreenExceptionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.EnumFileScreenExceptionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.EnumFileScreensRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.EnumFileScreensRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.EnumFileScreensResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.EnumFileScreensResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetActionVariableDescriptionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetActionVariableDescriptionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetActionVariableDescriptionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetActionVariableDescriptionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetActionVariablesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetActionVariablesRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetActionVariablesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetActionVariablesResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetFileScreenExceptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetFileScreenExceptionRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetFileScreenExceptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetFileScreenExceptionResponse) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetFileScreenRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetFileScreenRequest) }},
+	{"dcom/fsrm/ifsrmfilescreenmanager/v0.GetFileScreenResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreenmanager_v0.GetFileScreenResponse) }},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.CommitAndUpdateDerivedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplate_v0.CommitAndUpdateDerivedRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.CommitAndUpdateDerivedResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreentemplate_v0.CommitAndUpdateDerivedResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.CopyTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplate_v0.CopyTemplateRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.CopyTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplate_v0.CopyTemplateResponse) }},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplate_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplate_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplate_v0.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplate/v0.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplate_v0.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmfilescreentemplateimported/v0.GetOverwriteOnCommitRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreentemplateimported_v0.GetOverwriteOnCommitRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilescreentemplateimported/v0.GetOverwriteOnCommitResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreentemplateimported_v0.GetOverwriteOnCommitResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreentemplateimported/v0.SetOverwriteOnCommitRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreentemplateimported_v0.SetOverwriteOnCommitRequest)
+	}},
+	{"dcom/fsrm/ifsrmfilescreentemplateimported/v0.SetOverwriteOnCommitResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreentemplateimported_v0.SetOverwriteOnCommitResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.CreateTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.CreateTemplateRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.CreateTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.CreateTemplateResponse) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.EnumTemplatesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.EnumTemplatesRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.EnumTemplatesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.EnumTemplatesResponse) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.ExportTemplatesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.ExportTemplatesRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.ExportTemplatesResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.ExportTemplatesResponse)
+	}},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.GetTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.GetTemplateRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.GetTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.GetTemplateResponse) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.ImportTemplatesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.ImportTemplatesRequest) }},
+	{"dcom/fsrm/ifsrmfilescreentemplatemanager/v0.ImportTemplatesResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmfilescreentemplatemanager_v0.ImportTemplatesResponse)
+	}},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.AddRequest) }},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.AddResponse) }},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.CloneRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.CloneRequest) }},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.CloneResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.CloneResponse) }},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.RemoveByIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.RemoveByIDRequest) }},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.RemoveByIDResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.RemoveByIDResponse) }},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.RemoveRequest) }},
+	{"dcom/fsrm/ifsrmmutablecollection/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmmutablecollection_v0.RemoveResponse) }},
+	{"dcom/fsrm/ifsrmobject/v0.CommitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.CommitRequest) }},
+	{"dcom/fsrm/ifsrmobject/v0.CommitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.CommitResponse) }},
+	{"dcom/fsrm/ifsrmobject/v0.DeleteRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.DeleteRequest) }},
+	{"dcom/fsrm/ifsrmobject/v0.DeleteResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.DeleteResponse) }},
+	{"dcom/fsrm/ifsrmobject/v0.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.GetDescriptionRequest) }},
+	{"dcom/fsrm/ifsrmobject/v0.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.GetDescriptionResponse) }},
+	{"dcom/fsrm/ifsrmobject/v0.GetIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.GetIDRequest) }},
+	{"dcom/fsrm/ifsrmobject/v0.GetIDResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.GetIDResponse) }},
+	{"dcom/fsrm/ifsrmobject/v0.SetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.SetDescriptionRequest) }},
+	{"dcom/fsrm/ifsrmobject/v0.SetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmobject_v0.SetDescriptionResponse) }},
+	{"dcom/fsrm/ifsrmpathmapper/v0.GetSharePathsForLocalPathRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpathmapper_v0.GetSharePathsForLocalPathRequest) }},
+	{"dcom/fsrm/ifsrmpathmapper/v0.GetSharePathsForLocalPathResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpathmapper_v0.GetSharePathsForLocalPathResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetAccountRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetAccountRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetAccountResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetAccountResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetCompanyRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetCompanyRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetCompanyResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetCompanyResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetEnabledRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetEnabledResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetModuleClassIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetModuleClassIDRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetModuleClassIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetModuleClassIDResponse)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetModuleTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetModuleTypeRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetModuleTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetModuleTypeResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetNeedsFileContentRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetNeedsFileContentRequest)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetNeedsFileContentResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetNeedsFileContentResponse)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetParametersRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetParametersResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetSupportedExtensionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetSupportedExtensionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetSupportedExtensionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetSupportedExtensionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetVersionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetVersionRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.GetVersionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.GetVersionResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetAccountRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetAccountRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetAccountResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetAccountResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetCompanyRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetCompanyRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetCompanyResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetCompanyResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetEnabledRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetEnabledResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetModuleClassIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetModuleClassIDRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetModuleClassIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetModuleClassIDResponse)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetNeedsFileContentRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetNeedsFileContentRequest)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetNeedsFileContentResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetNeedsFileContentResponse)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetParametersRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetParametersResponse) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetSupportedExtensionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetSupportedExtensionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetSupportedExtensionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetSupportedExtensionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetVersionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetVersionRequest) }},
+	{"dcom/fsrm/ifsrmpipelinemoduledefinition/v0.SetVersionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpipelinemoduledefinition_v0.SetVersionResponse) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetPropertyFlagsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetPropertyFlagsRequest) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetPropertyFlagsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetPropertyFlagsResponse) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetSourcesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetSourcesRequest) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetSourcesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetSourcesResponse) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetValueRequest) }},
+	{"dcom/fsrm/ifsrmproperty/v0.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmproperty_v0.GetValueResponse) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.DeleteRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.DeleteRequest) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.DeleteResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.DeleteResponse) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.GetTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.GetTypeRequest) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.GetTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.GetTypeResponse) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.GetValueRequest) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.GetValueResponse) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.SetTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.SetTypeRequest) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.SetTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.SetTypeResponse) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.SetValueRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.SetValueRequest) }},
+	{"dcom/fsrm/ifsrmpropertycondition/v1.SetValueResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertycondition_v1.SetValueResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetParametersRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetParametersResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetPossibleValuesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetPossibleValuesRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetPossibleValuesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetPossibleValuesResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetTypeRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetTypeResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetValueDescriptionsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetValueDescriptionsRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.GetValueDescriptionsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.GetValueDescriptionsResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetParametersRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetParametersResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetPossibleValuesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetPossibleValuesRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetPossibleValuesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetPossibleValuesResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetTypeRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetTypeResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetValueDescriptionsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetValueDescriptionsRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition/v0.SetValueDescriptionsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition_v0.SetValueDescriptionsResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetAppliesToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetAppliesToRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetAppliesToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetAppliesToResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetDisplayNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetDisplayNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetDisplayNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetDisplayNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetPropertyDefinitionFlagsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetPropertyDefinitionFlagsRequest)
+	}},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetPropertyDefinitionFlagsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetPropertyDefinitionFlagsResponse)
+	}},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetValueDefinitionsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetValueDefinitionsRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.GetValueDefinitionsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.GetValueDefinitionsResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.SetDisplayNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.SetDisplayNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinition2/v0.SetDisplayNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinition2_v0.SetDisplayNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetDescriptionRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetDescriptionResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetDisplayNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetDisplayNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetDisplayNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetDisplayNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetUniqueIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetUniqueIDRequest) }},
+	{"dcom/fsrm/ifsrmpropertydefinitionvalue/v0.GetUniqueIDResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmpropertydefinitionvalue_v0.GetUniqueIDResponse) }},
+	{"dcom/fsrm/ifsrmquota/v0.GetQuotaPeakUsageRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.GetQuotaPeakUsageRequest) }},
+	{"dcom/fsrm/ifsrmquota/v0.GetQuotaPeakUsageResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.GetQuotaPeakUsageResponse) }},
+	{"dcom/fsrm/ifsrmquota/v0.GetQuotaPeakUsageTimeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.GetQuotaPeakUsageTimeRequest) }},
+	{"dcom/fsrm/ifsrmquota/v0.GetQuotaPeakUsageTimeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.GetQuotaPeakUsageTimeResponse) }},
+	{"dcom/fsrm/ifsrmquota/v0.GetQuotaUsedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.GetQuotaUsedRequest) }},
+	{"dcom/fsrm/ifsrmquota/v0.GetQuotaUsedResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.GetQuotaUsedResponse) }},
+	{"dcom/fsrm/ifsrmquota/v0.RefreshUsagePropertiesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.RefreshUsagePropertiesRequest) }},
+	{"dcom/fsrm/ifsrmquota/v0.RefreshUsagePropertiesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.RefreshUsagePropertiesResponse) }},
+	{"dcom/fsrm/ifsrmquota/v0.ResetPeakUsageRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.ResetPeakUsageRequest) }},
+	{"dcom/fsrm/ifsrmquota/v0.ResetPeakUsageResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquota_v0.ResetPeakUsageResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.AddThresholdRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.AddThresholdRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.AddThresholdResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.AddThresholdResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.CreateThresholdActionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.CreateThresholdActionRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.CreateThresholdActionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.CreateThresholdActionResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.DeleteThresholdRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.DeleteThresholdRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.DeleteThresholdResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.DeleteThresholdResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.EnumThresholdActionsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.EnumThresholdActionsRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.EnumThresholdActionsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.EnumThresholdActionsResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.GetQuotaFlagsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.GetQuotaFlagsRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.GetQuotaFlagsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.GetQuotaFlagsResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.GetQuotaLimitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.GetQuotaLimitRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.GetQuotaLimitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.GetQuotaLimitResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.GetThresholdsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.GetThresholdsRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.GetThresholdsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.GetThresholdsResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.ModifyThresholdRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.ModifyThresholdRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.ModifyThresholdResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.ModifyThresholdResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.SetQuotaFlagsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.SetQuotaFlagsRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.SetQuotaFlagsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.SetQuotaFlagsResponse) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.SetQuotaLimitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.SetQuotaLimitRequest) }},
+	{"dcom/fsrm/ifsrmquotabase/v0.SetQuotaLimitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotabase_v0.SetQuotaLimitResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.CreateAutoApplyQuotaRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.CreateAutoApplyQuotaRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.CreateAutoApplyQuotaResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.CreateAutoApplyQuotaResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.CreateQuotaCollectionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.CreateQuotaCollectionRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.CreateQuotaCollectionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.CreateQuotaCollectionResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.CreateQuotaRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.CreateQuotaRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.CreateQuotaResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.CreateQuotaResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.EnumAutoApplyQuotasRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.EnumAutoApplyQuotasRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.EnumAutoApplyQuotasResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.EnumAutoApplyQuotasResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.EnumEffectiveQuotasRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.EnumEffectiveQuotasRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.EnumEffectiveQuotasResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.EnumEffectiveQuotasResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.EnumQuotasRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.EnumQuotasRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.EnumQuotasResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.EnumQuotasResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetActionVariableDescriptionsRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmquotamanager_v0.GetActionVariableDescriptionsRequest)
+	}},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetActionVariableDescriptionsResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmquotamanager_v0.GetActionVariableDescriptionsResponse)
+	}},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetActionVariablesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetActionVariablesRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetActionVariablesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetActionVariablesResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetAutoApplyQuotaRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetAutoApplyQuotaRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetAutoApplyQuotaResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetAutoApplyQuotaResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetQuotaRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetQuotaRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetQuotaResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetQuotaResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetRestrictiveQuotaRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetRestrictiveQuotaRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.GetRestrictiveQuotaResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.GetRestrictiveQuotaResponse) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.ScanRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.ScanRequest) }},
+	{"dcom/fsrm/ifsrmquotamanager/v0.ScanResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanager_v0.ScanResponse) }},
+	{"dcom/fsrm/ifsrmquotamanagerex/v0.IsAffectedByQuotaRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanagerex_v0.IsAffectedByQuotaRequest) }},
+	{"dcom/fsrm/ifsrmquotamanagerex/v0.IsAffectedByQuotaResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotamanagerex_v0.IsAffectedByQuotaResponse) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.ApplyTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.ApplyTemplateRequest) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.ApplyTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.ApplyTemplateResponse) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetMatchesSourceTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetMatchesSourceTemplateRequest) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetMatchesSourceTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetMatchesSourceTemplateResponse) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetPathRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetPathRequest) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetPathResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetPathResponse) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetSourceTemplateNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetSourceTemplateNameRequest) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetSourceTemplateNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetSourceTemplateNameResponse) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetUserAccountRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetUserAccountRequest) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetUserAccountResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetUserAccountResponse) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetUserSIDRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetUserSIDRequest) }},
+	{"dcom/fsrm/ifsrmquotaobject/v0.GetUserSIDResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotaobject_v0.GetUserSIDResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.CommitAndUpdateDerivedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.CommitAndUpdateDerivedRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.CommitAndUpdateDerivedResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.CommitAndUpdateDerivedResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.CopyTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.CopyTemplateRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.CopyTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.CopyTemplateResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplate/v0.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplate_v0.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplateimported/v0.GetOverwriteOnCommitRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmquotatemplateimported_v0.GetOverwriteOnCommitRequest)
+	}},
+	{"dcom/fsrm/ifsrmquotatemplateimported/v0.GetOverwriteOnCommitResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmquotatemplateimported_v0.GetOverwriteOnCommitResponse)
+	}},
+	{"dcom/fsrm/ifsrmquotatemplateimported/v0.SetOverwriteOnCommitRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmquotatemplateimported_v0.SetOverwriteOnCommitRequest)
+	}},
+	{"dcom/fsrm/ifsrmquotatemplateimported/v0.SetOverwriteOnCommitResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmquotatemplateimported_v0.SetOverwriteOnCommitResponse)
+	}},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.CreateTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.CreateTemplateRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.CreateTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.CreateTemplateResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.EnumTemplatesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.EnumTemplatesRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.EnumTemplatesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.EnumTemplatesResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.ExportTemplatesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.ExportTemplatesRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.ExportTemplatesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.ExportTemplatesResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.GetTemplateRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.GetTemplateRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.GetTemplateResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.GetTemplateResponse) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.ImportTemplatesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.ImportTemplatesRequest) }},
+	{"dcom/fsrm/ifsrmquotatemplatemanager/v0.ImportTemplatesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmquotatemplatemanager_v0.ImportTemplatesResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.DeleteRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.DeleteRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.DeleteResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.DeleteResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetDescriptionRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetDescriptionResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetFilterRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetFilterRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetFilterResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetFilterResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetLastGeneratedFileNamePrefixRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetLastGeneratedFileNamePrefixRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetLastGeneratedFileNamePrefixResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetLastGeneratedFileNamePrefixResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetTypeRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.GetTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.GetTypeResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.SetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.SetDescriptionRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.SetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.SetDescriptionResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.SetFilterRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.SetFilterRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.SetFilterResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.SetFilterResponse) }},
+	{"dcom/fsrm/ifsrmreport/v1.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmreport/v1.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreport_v1.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.CancelRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.CancelRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.CancelResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.CancelResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.CreateReportRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.CreateReportRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.CreateReportResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.CreateReportResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.EnumReportsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.EnumReportsRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.EnumReportsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.EnumReportsResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetFormatsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetFormatsRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetFormatsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetFormatsResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetLastErrorRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetLastErrorRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetLastErrorResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetLastErrorResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetLastGeneratedInDirectoryRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetLastGeneratedInDirectoryRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetLastGeneratedInDirectoryResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetLastGeneratedInDirectoryResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetLastRunRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetLastRunRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetLastRunResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetLastRunResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetMailToRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetMailToResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetNamespaceRootsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetNamespaceRootsRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetNamespaceRootsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetNamespaceRootsResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetRunningStatusRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetRunningStatusRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetRunningStatusResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetRunningStatusResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetTaskRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetTaskRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.GetTaskResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.GetTaskResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.RunRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.RunRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.RunResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.RunResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetFormatsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetFormatsRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetFormatsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetFormatsResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetMailToRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetMailToRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetMailToResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetMailToResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetNamespaceRootsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetNamespaceRootsRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetNamespaceRootsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetNamespaceRootsResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetTaskRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetTaskRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.SetTaskResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.SetTaskResponse) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.WaitForCompletionRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.WaitForCompletionRequest) }},
+	{"dcom/fsrm/ifsrmreportjob/v1.WaitForCompletionResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportjob_v1.WaitForCompletionResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.CreateReportJobRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.CreateReportJobRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.CreateReportJobResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.CreateReportJobResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.EnumReportJobsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.EnumReportJobsRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.EnumReportJobsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.EnumReportJobsResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetDefaultFilterRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetDefaultFilterRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetDefaultFilterResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetDefaultFilterResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetOutputDirectoryRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetOutputDirectoryRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetOutputDirectoryResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetOutputDirectoryResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetReportJobRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetReportJobRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetReportJobResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetReportJobResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetReportSizeLimitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetReportSizeLimitRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.GetReportSizeLimitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.GetReportSizeLimitResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.IsFilterValidForReportTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.IsFilterValidForReportTypeRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.IsFilterValidForReportTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.IsFilterValidForReportTypeResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.SetDefaultFilterRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.SetDefaultFilterRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.SetDefaultFilterResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.SetDefaultFilterResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.SetOutputDirectoryRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.SetOutputDirectoryRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.SetOutputDirectoryResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.SetOutputDirectoryResponse) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.SetReportSizeLimitRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.SetReportSizeLimitRequest) }},
+	{"dcom/fsrm/ifsrmreportmanager/v1.SetReportSizeLimitResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportmanager_v1.SetReportSizeLimitResponse) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.CreateScheduleTaskRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.CreateScheduleTaskRequest) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.CreateScheduleTaskResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.CreateScheduleTaskResponse) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.DeleteScheduleTaskRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.DeleteScheduleTaskRequest) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.DeleteScheduleTaskResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.DeleteScheduleTaskResponse) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.ModifyScheduleTaskRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.ModifyScheduleTaskRequest) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.ModifyScheduleTaskResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.ModifyScheduleTaskResponse) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.VerifyNamespacesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.VerifyNamespacesRequest) }},
+	{"dcom/fsrm/ifsrmreportscheduler/v1.VerifyNamespacesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmreportscheduler_v1.VerifyNamespacesResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetLastModifiedRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetLastModifiedRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetLastModifiedResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetLastModifiedResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetModuleDefinitionNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetModuleDefinitionNameRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetModuleDefinitionNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetModuleDefinitionNameResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetNameRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetNameResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetNamespaceRootsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetNamespaceRootsRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetNamespaceRootsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetNamespaceRootsResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetParametersRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetParametersResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetRuleFlagsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetRuleFlagsRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetRuleFlagsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetRuleFlagsResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetRuleTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetRuleTypeRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.GetRuleTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.GetRuleTypeResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetModuleDefinitionNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetModuleDefinitionNameRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetModuleDefinitionNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetModuleDefinitionNameResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetNameRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetNameResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetNamespaceRootsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetNamespaceRootsRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetNamespaceRootsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetNamespaceRootsResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetParametersRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetParametersRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetParametersResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetParametersResponse) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetRuleFlagsRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetRuleFlagsRequest) }},
+	{"dcom/fsrm/ifsrmrule/v0.SetRuleFlagsResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmrule_v0.SetRuleFlagsResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.EmailTestRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.EmailTestRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.EmailTestResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.EmailTestResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetActionRunLimitIntervalRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetActionRunLimitIntervalRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetActionRunLimitIntervalResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetActionRunLimitIntervalResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetAdminEmailRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetAdminEmailRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetAdminEmailResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetAdminEmailResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetDisableCommandLineRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetDisableCommandLineRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetDisableCommandLineResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetDisableCommandLineResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetEnableScreeningAuditRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetEnableScreeningAuditRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetEnableScreeningAuditResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetEnableScreeningAuditResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetMailFromRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetMailFromRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetMailFromResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetMailFromResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetSMTPServerRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetSMTPServerRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.GetSMTPServerResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.GetSMTPServerResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetActionRunLimitIntervalRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetActionRunLimitIntervalRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetActionRunLimitIntervalResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetActionRunLimitIntervalResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetAdminEmailRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetAdminEmailRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetAdminEmailResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetAdminEmailResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetDisableCommandLineRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetDisableCommandLineRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetDisableCommandLineResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetDisableCommandLineResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetEnableScreeningAuditRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetEnableScreeningAuditRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetEnableScreeningAuditResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetEnableScreeningAuditResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetMailFromRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetMailFromRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetMailFromResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetMailFromResponse) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetSMTPServerRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetSMTPServerRequest) }},
+	{"dcom/fsrm/ifsrmsetting/v0.SetSMTPServerResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmsetting_v0.SetSMTPServerResponse) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.GetCapabilitiesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.GetCapabilitiesRequest) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.GetCapabilitiesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.GetCapabilitiesResponse) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.GetStorageTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.GetStorageTypeRequest) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.GetStorageTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.GetStorageTypeResponse) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.GetUpdatesFileContentRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.GetUpdatesFileContentRequest)
+	}},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.GetUpdatesFileContentResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.GetUpdatesFileContentResponse)
+	}},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.SetCapabilitiesRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.SetCapabilitiesRequest) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.SetCapabilitiesResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.SetCapabilitiesResponse) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.SetStorageTypeRequest", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.SetStorageTypeRequest) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.SetStorageTypeResponse", func() ndr.Unmarshaler { return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.SetStorageTypeResponse) }},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.SetUpdatesFileContentRequest", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.SetUpdatesFileContentRequest)
+	}},
+	{"dcom/fsrm/ifsrmstoragemoduledefinition/v0.SetUpdatesFileContentResponse", func() ndr.Unmarshaler {
+		return new(dcom_fsrm_ifsrmstoragemoduledefinition_v0.SetUpdatesFileContentResponse)
+	}},
+	{"dcom/iactivation/v0.RemoteActivationRequest", func() ndr.Unmarshaler { return new(dcom_iactivation_v0.RemoteActivationRequest) }},
+	{"dcom/iactivation/v0.RemoteActivationResponse", func() ndr.Unmarshaler { return new(dcom_iactivation_v0.RemoteActivationResponse) }},
+	{"dcom/iisa/iapphostadminmanager/v0.GetAdminSectionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.GetAdminSectionRequest) }},
+	{"dcom/iisa/iapphostadminmanager/v0.GetAdminSectionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.GetAdminSectionResponse) }},
+	{"dcom/iisa/iapphostadminmanager/v0.GetConfigManagerRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.GetConfigManagerRequest) }},
+	{"dcom/iisa/iapphostadminmanager/v0.GetConfigManagerResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.GetConfigManagerResponse) }},
+	{"dcom/iisa/iapphostadminmanager/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostadminmanager/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostadminmanager/v0.SetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.SetMetadataRequest) }},
+	{"dcom/iisa/iapphostadminmanager/v0.SetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostadminmanager_v0.SetMetadataResponse) }},
+	{"dcom/iisa/iapphostchangehandler/v0.OnSectionChangesRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostchangehandler_v0.OnSectionChangesRequest) }},
+	{"dcom/iisa/iapphostchangehandler/v0.OnSectionChangesResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostchangehandler_v0.OnSectionChangesResponse) }},
+	{"dcom/iisa/iapphostchildelementcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostchildelementcollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostchildelementcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostchildelementcollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostchildelementcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostchildelementcollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostchildelementcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostchildelementcollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetAddElementNamesRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetAddElementNamesRequest) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetAddElementNamesResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetAddElementNamesResponse) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetAddElementSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetAddElementSchemaRequest) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetAddElementSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetAddElementSchemaResponse) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetClearElementSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetClearElementSchemaRequest) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetClearElementSchemaResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostcollectionschema_v0.GetClearElementSchemaResponse)
+	}},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetDoesAllowDuplicatesRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostcollectionschema_v0.GetDoesAllowDuplicatesRequest)
+	}},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetDoesAllowDuplicatesResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostcollectionschema_v0.GetDoesAllowDuplicatesResponse)
+	}},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetIsMergeAppendRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetIsMergeAppendRequest) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetIsMergeAppendResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetIsMergeAppendResponse) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostcollectionschema_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetRemoveElementSchemaRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostcollectionschema_v0.GetRemoveElementSchemaRequest)
+	}},
+	{"dcom/iisa/iapphostcollectionschema/v0.GetRemoveElementSchemaResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostcollectionschema_v0.GetRemoveElementSchemaResponse)
+	}},
+	{"dcom/iisa/iapphostconfigexception/v0.GetConfigPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetConfigPathRequest) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetConfigPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetConfigPathResponse) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetErrorLineRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetErrorLineRequest) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetErrorLineResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetErrorLineResponse) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetErrorStringRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetErrorStringRequest) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetErrorStringResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetErrorStringResponse) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetFileNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetFileNameRequest) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetFileNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetFileNameResponse) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetLineNumberRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetLineNumberRequest) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetLineNumberResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetLineNumberResponse) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetPostErrorLineRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetPostErrorLineRequest) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetPostErrorLineResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetPostErrorLineResponse) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetPreErrorLineRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetPreErrorLineRequest) }},
+	{"dcom/iisa/iapphostconfigexception/v0.GetPreErrorLineResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigexception_v0.GetPreErrorLineResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.ClearInvalidSectionsRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.ClearInvalidSectionsRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.ClearInvalidSectionsResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.ClearInvalidSectionsResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetAdminSectionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetAdminSectionRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetAdminSectionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetAdminSectionResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetConfigPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetConfigPathRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetConfigPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetConfigPathResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetFilePathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetFilePathRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetFilePathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetFilePathResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetLocationsRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetLocationsRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetLocationsResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetLocationsResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetRootSectionGroupRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetRootSectionGroupRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.GetRootSectionGroupResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.GetRootSectionGroupResponse) }},
+	{"dcom/iisa/iapphostconfigfile/v0.SetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.SetMetadataRequest) }},
+	{"dcom/iisa/iapphostconfigfile/v0.SetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigfile_v0.SetMetadataResponse) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.AddConfigSectionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.AddConfigSectionRequest) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.AddConfigSectionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.AddConfigSectionResponse) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.DeleteConfigSectionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.DeleteConfigSectionRequest) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.DeleteConfigSectionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.DeleteConfigSectionResponse) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.GetPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.GetPathRequest) }},
+	{"dcom/iisa/iapphostconfiglocation/v0.GetPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocation_v0.GetPathResponse) }},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.AddLocationRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocationcollection_v0.AddLocationRequest) }},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.AddLocationResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocationcollection_v0.AddLocationResponse) }},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.DeleteLocationRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostconfiglocationcollection_v0.DeleteLocationRequest)
+	}},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.DeleteLocationResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostconfiglocationcollection_v0.DeleteLocationResponse)
+	}},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocationcollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocationcollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocationcollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostconfiglocationcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfiglocationcollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostconfigmanager/v0.GetConfigFileRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigmanager_v0.GetConfigFileRequest) }},
+	{"dcom/iisa/iapphostconfigmanager/v0.GetConfigFileResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigmanager_v0.GetConfigFileResponse) }},
+	{"dcom/iisa/iapphostconfigmanager/v0.GetUniqueConfigPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigmanager_v0.GetUniqueConfigPathRequest) }},
+	{"dcom/iisa/iapphostconfigmanager/v0.GetUniqueConfigPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconfigmanager_v0.GetUniqueConfigPathResponse) }},
+	{"dcom/iisa/iapphostconstantvalue/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvalue_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostconstantvalue/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvalue_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostconstantvalue/v0.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvalue_v0.GetValueRequest) }},
+	{"dcom/iisa/iapphostconstantvalue/v0.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvalue_v0.GetValueResponse) }},
+	{"dcom/iisa/iapphostconstantvaluecollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvaluecollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostconstantvaluecollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvaluecollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostconstantvaluecollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvaluecollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostconstantvaluecollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostconstantvaluecollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostelement/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.ClearRequest) }},
+	{"dcom/iisa/iapphostelement/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.ClearResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetChildElementsRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetChildElementsRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetChildElementsResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetChildElementsResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetCollectionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetCollectionRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetCollectionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetCollectionResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetElementByNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetElementByNameRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetElementByNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetElementByNameResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetMethodsRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetMethodsRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetMethodsResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetMethodsResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetPropertiesRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetPropertiesResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetPropertyByNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetPropertyByNameRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetPropertyByNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetPropertyByNameResponse) }},
+	{"dcom/iisa/iapphostelement/v0.GetSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetSchemaRequest) }},
+	{"dcom/iisa/iapphostelement/v0.GetSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.GetSchemaResponse) }},
+	{"dcom/iisa/iapphostelement/v0.SetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.SetMetadataRequest) }},
+	{"dcom/iisa/iapphostelement/v0.SetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelement_v0.SetMetadataResponse) }},
+	{"dcom/iisa/iapphostelementcollection/v0.AddElementRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.AddElementRequest) }},
+	{"dcom/iisa/iapphostelementcollection/v0.AddElementResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.AddElementResponse) }},
+	{"dcom/iisa/iapphostelementcollection/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.ClearRequest) }},
+	{"dcom/iisa/iapphostelementcollection/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.ClearResponse) }},
+	{"dcom/iisa/iapphostelementcollection/v0.CreateNewElementRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.CreateNewElementRequest) }},
+	{"dcom/iisa/iapphostelementcollection/v0.CreateNewElementResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.CreateNewElementResponse) }},
+	{"dcom/iisa/iapphostelementcollection/v0.DeleteElementRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.DeleteElementRequest) }},
+	{"dcom/iisa/iapphostelementcollection/v0.DeleteElementResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.DeleteElementResponse) }},
+	{"dcom/iisa/iapphostelementcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostelementcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostelementcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostelementcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostelementcollection/v0.GetSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.GetSchemaRequest) }},
+	{"dcom/iisa/iapphostelementcollection/v0.GetSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementcollection_v0.GetSchemaResponse) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetChildElementSchemasRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetChildElementSchemasRequest) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetChildElementSchemasResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetChildElementSchemasResponse) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetCollectionSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetCollectionSchemaRequest) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetCollectionSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetCollectionSchemaResponse) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetDoesAllowUnschematizedPropertiesRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostelementschema_v0.GetDoesAllowUnschematizedPropertiesRequest)
+	}},
+	{"dcom/iisa/iapphostelementschema/v0.GetDoesAllowUnschematizedPropertiesResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostelementschema_v0.GetDoesAllowUnschematizedPropertiesResponse)
+	}},
+	{"dcom/iisa/iapphostelementschema/v0.GetIsCollectionDefaultRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetIsCollectionDefaultRequest) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetIsCollectionDefaultResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetIsCollectionDefaultResponse) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetPropertySchemasRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetPropertySchemasRequest) }},
+	{"dcom/iisa/iapphostelementschema/v0.GetPropertySchemasResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschema_v0.GetPropertySchemasResponse) }},
+	{"dcom/iisa/iapphostelementschemacollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschemacollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostelementschemacollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschemacollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostelementschemacollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschemacollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostelementschemacollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostelementschemacollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostmappingextension/v0.GetSiteElementFromSiteIDRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostmappingextension_v0.GetSiteElementFromSiteIDRequest)
+	}},
+	{"dcom/iisa/iapphostmappingextension/v0.GetSiteElementFromSiteIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostmappingextension_v0.GetSiteElementFromSiteIDResponse)
+	}},
+	{"dcom/iisa/iapphostmappingextension/v0.GetSiteIDFromSiteNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmappingextension_v0.GetSiteIDFromSiteNameRequest) }},
+	{"dcom/iisa/iapphostmappingextension/v0.GetSiteIDFromSiteNameResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostmappingextension_v0.GetSiteIDFromSiteNameResponse)
+	}},
+	{"dcom/iisa/iapphostmappingextension/v0.GetSiteNameFromSiteIDRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmappingextension_v0.GetSiteNameFromSiteIDRequest) }},
+	{"dcom/iisa/iapphostmappingextension/v0.GetSiteNameFromSiteIDResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostmappingextension_v0.GetSiteNameFromSiteIDResponse)
+	}},
+	{"dcom/iisa/iapphostmappingextension/v0.MapPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmappingextension_v0.MapPathRequest) }},
+	{"dcom/iisa/iapphostmappingextension/v0.MapPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmappingextension_v0.MapPathResponse) }},
+	{"dcom/iisa/iapphostmethod/v0.CreateInstanceRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethod_v0.CreateInstanceRequest) }},
+	{"dcom/iisa/iapphostmethod/v0.CreateInstanceResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethod_v0.CreateInstanceResponse) }},
+	{"dcom/iisa/iapphostmethod/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethod_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostmethod/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethod_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostmethod/v0.GetSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethod_v0.GetSchemaRequest) }},
+	{"dcom/iisa/iapphostmethod/v0.GetSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethod_v0.GetSchemaResponse) }},
+	{"dcom/iisa/iapphostmethodcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodcollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostmethodcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodcollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostmethodcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodcollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostmethodcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodcollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.ExecuteRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.ExecuteRequest) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.ExecuteResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.ExecuteResponse) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.GetInputRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.GetInputRequest) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.GetInputResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.GetInputResponse) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.GetOutputRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.GetOutputRequest) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.GetOutputResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.GetOutputResponse) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.SetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.SetMetadataRequest) }},
+	{"dcom/iisa/iapphostmethodinstance/v0.SetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodinstance_v0.SetMetadataResponse) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetInputSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetInputSchemaRequest) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetInputSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetInputSchemaResponse) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetOutputSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetOutputSchemaRequest) }},
+	{"dcom/iisa/iapphostmethodschema/v0.GetOutputSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostmethodschema_v0.GetOutputSchemaResponse) }},
+	{"dcom/iisa/iapphostpathmapper/v0.MapPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpathmapper_v0.MapPathRequest) }},
+	{"dcom/iisa/iapphostpathmapper/v0.MapPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpathmapper_v0.MapPathResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.ClearRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.ClearResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.GetExceptionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetExceptionRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.GetExceptionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetExceptionResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.GetSchemaRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetSchemaRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.GetSchemaResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetSchemaResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.GetStringValueRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetStringValueRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.GetStringValueResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetStringValueResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetValueRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.GetValueResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.SetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.SetMetadataRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.SetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.SetMetadataResponse) }},
+	{"dcom/iisa/iapphostproperty/v0.SetValueRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.SetValueRequest) }},
+	{"dcom/iisa/iapphostproperty/v0.SetValueResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostproperty_v0.SetValueResponse) }},
+	{"dcom/iisa/iapphostpropertycollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertycollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostpropertycollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertycollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostpropertycollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertycollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostpropertycollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertycollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostpropertyexception/v0.GetInvalidValueRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyexception_v0.GetInvalidValueRequest) }},
+	{"dcom/iisa/iapphostpropertyexception/v0.GetInvalidValueResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyexception_v0.GetInvalidValueResponse) }},
+	{"dcom/iisa/iapphostpropertyexception/v0.GetValidationFailureParametersRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostpropertyexception_v0.GetValidationFailureParametersRequest)
+	}},
+	{"dcom/iisa/iapphostpropertyexception/v0.GetValidationFailureParametersResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostpropertyexception_v0.GetValidationFailureParametersResponse)
+	}},
+	{"dcom/iisa/iapphostpropertyexception/v0.GetValidationFailureReasonRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostpropertyexception_v0.GetValidationFailureReasonRequest)
+	}},
+	{"dcom/iisa/iapphostpropertyexception/v0.GetValidationFailureReasonResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostpropertyexception_v0.GetValidationFailureReasonResponse)
+	}},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetDefaultValueRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetDefaultValueRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetDefaultValueResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetDefaultValueResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetDoesAllowInfiniteRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetDoesAllowInfiniteRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetDoesAllowInfiniteResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetDoesAllowInfiniteResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsCaseSensitiveRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsCaseSensitiveRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsCaseSensitiveResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsCaseSensitiveResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsCombinedKeyRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsCombinedKeyRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsCombinedKeyResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsCombinedKeyResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsEncryptedRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsEncryptedRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsEncryptedResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsEncryptedResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsExpandedRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsExpandedRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsExpandedResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsExpandedResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsRequiredRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsRequiredRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsRequiredResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsRequiredResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsUniqueKeyRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsUniqueKeyRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetIsUniqueKeyResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetIsUniqueKeyResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetMetadataRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetMetadataRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetMetadataResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetMetadataResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetPossibleValuesRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetPossibleValuesRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetPossibleValuesResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetPossibleValuesResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetTimeSpanFormatRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetTimeSpanFormatRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetTimeSpanFormatResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetTimeSpanFormatResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetTypeRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetTypeRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetTypeResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetTypeResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetValidationParameterRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetValidationParameterRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetValidationParameterResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetValidationParameterResponse) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetValidationTypeRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetValidationTypeRequest) }},
+	{"dcom/iisa/iapphostpropertyschema/v0.GetValidationTypeResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschema_v0.GetValidationTypeResponse) }},
+	{"dcom/iisa/iapphostpropertyschemacollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschemacollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostpropertyschemacollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschemacollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostpropertyschemacollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschemacollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostpropertyschemacollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostpropertyschemacollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetAllowDefinitionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetAllowDefinitionRequest) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetAllowDefinitionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetAllowDefinitionResponse) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetAllowLocationRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetAllowLocationRequest) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetAllowLocationResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetAllowLocationResponse) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetOverrideModeDefaultRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostsectiondefinition_v0.GetOverrideModeDefaultRequest)
+	}},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetOverrideModeDefaultResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostsectiondefinition_v0.GetOverrideModeDefaultResponse)
+	}},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetTypeRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetTypeRequest) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.GetTypeResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.GetTypeResponse) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetAllowDefinitionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.SetAllowDefinitionRequest) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetAllowDefinitionResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.SetAllowDefinitionResponse) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetAllowLocationRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.SetAllowLocationRequest) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetAllowLocationResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.SetAllowLocationResponse) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetOverrideModeDefaultRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostsectiondefinition_v0.SetOverrideModeDefaultRequest)
+	}},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetOverrideModeDefaultResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostsectiondefinition_v0.SetOverrideModeDefaultResponse)
+	}},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetTypeRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.SetTypeRequest) }},
+	{"dcom/iisa/iapphostsectiondefinition/v0.SetTypeResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinition_v0.SetTypeResponse) }},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.AddSectionRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.AddSectionRequest) }},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.AddSectionResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.AddSectionResponse)
+	}},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.DeleteSectionRequest", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.DeleteSectionRequest)
+	}},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.DeleteSectionResponse", func() ndr.Unmarshaler {
+		return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.DeleteSectionResponse)
+	}},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostsectiondefinitioncollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiondefinitioncollection_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.AddSectionGroupRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.AddSectionGroupRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.AddSectionGroupResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.AddSectionGroupResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.DeleteSectionGroupRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.DeleteSectionGroupRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.DeleteSectionGroupResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.DeleteSectionGroupResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetCountRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetCountResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetItemRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetItemResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetNameRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetNameResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetSectionsRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetSectionsRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetSectionsResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetSectionsResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetTypeRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetTypeRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.GetTypeResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.GetTypeResponse) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.SetTypeRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.SetTypeRequest) }},
+	{"dcom/iisa/iapphostsectiongroup/v0.SetTypeResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostsectiongroup_v0.SetTypeResponse) }},
+	{"dcom/iisa/iapphostwritableadminmanager/v0.CommitChangesRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostwritableadminmanager_v0.CommitChangesRequest) }},
+	{"dcom/iisa/iapphostwritableadminmanager/v0.CommitChangesResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostwritableadminmanager_v0.CommitChangesResponse) }},
+	{"dcom/iisa/iapphostwritableadminmanager/v0.GetCommitPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostwritableadminmanager_v0.GetCommitPathRequest) }},
+	{"dcom/iisa/iapphostwritableadminmanager/v0.GetCommitPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostwritableadminmanager_v0.GetCommitPathResponse) }},
+	{"dcom/iisa/iapphostwritableadminmanager/v0.SetCommitPathRequest", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostwritableadminmanager_v0.SetCommitPathRequest) }},
+	{"dcom/iisa/iapphostwritableadminmanager/v0.SetCommitPathResponse", func() ndr.Unmarshaler { return new(dcom_iisa_iapphostwritableadminmanager_v0.SetCommitPathResponse) }},
+	{"dcom/iiss/iiisservicecontrol/v0.KillRequest", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.KillRequest) }},
+	{"dcom/iiss/iiisservicecontrol/v0.KillResponse", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.KillResponse) }},
+	{"dcom/iiss/iiisservicecontrol/v0.RebootRequest", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.RebootRequest) }},
+	{"dcom/iiss/iiisservicecontrol/v0.RebootResponse", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.RebootResponse) }},
+	{"dcom/iiss/iiisservicecontrol/v0.StartRequest", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.StartRequest) }},
+	{"dcom/iiss/iiisservicecontrol/v0.StartResponse", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.StartResponse) }},
+	{"dcom/iiss/iiisservicecontrol/v0.StatusRequest", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.StatusRequest) }},
+	{"dcom/iiss/iiisservicecontrol/v0.StatusResponse", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.StatusResponse) }},
+	{"dcom/iiss/iiisservicecontrol/v0.StopRequest", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.StopRequest) }},
+	{"dcom/iiss/iiisservicecontrol/v0.StopResponse", func() ndr.Unmarshaler { return new(dcom_iiss_iiisservicecontrol_v0.StopResponse) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.CreateApplicationPoolRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.CreateApplicationPoolRequest) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.CreateApplicationPoolResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.CreateApplicationPoolResponse) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.CreateApplicationRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.CreateApplicationRequest) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.CreateApplicationResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.CreateApplicationResponse) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.DeleteApplicationPoolRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.DeleteApplicationPoolRequest) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.DeleteApplicationPoolResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.DeleteApplicationPoolResponse) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.DeleteApplicationRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.DeleteApplicationRequest) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.DeleteApplicationResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.DeleteApplicationResponse) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.EnumerateApplicationsInPoolRequest", func() ndr.Unmarshaler {
+		return new(dcom_imsa_iiisapplicationadmin_v0.EnumerateApplicationsInPoolRequest)
+	}},
+	{"dcom/imsa/iiisapplicationadmin/v0.EnumerateApplicationsInPoolResponse", func() ndr.Unmarshaler {
+		return new(dcom_imsa_iiisapplicationadmin_v0.EnumerateApplicationsInPoolResponse)
+	}},
+	{"dcom/imsa/iiisapplicationadmin/v0.GetProcessModeRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.GetProcessModeRequest) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.GetProcessModeResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.GetProcessModeResponse) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.RecycleApplicationPoolRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.RecycleApplicationPoolRequest) }},
+	{"dcom/imsa/iiisapplicationadmin/v0.RecycleApplicationPoolResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiisapplicationadmin_v0.RecycleApplicationPoolResponse) }},
+	{"dcom/imsa/iiiscertobj/v0.ExportToBlobRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.ExportToBlobRequest) }},
+	{"dcom/imsa/iiiscertobj/v0.ExportToBlobResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.ExportToBlobResponse) }},
+	{"dcom/imsa/iiiscertobj/v0.GetCertInfoRemoteRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.GetCertInfoRemoteRequest) }},
+	{"dcom/imsa/iiiscertobj/v0.GetCertInfoRemoteResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.GetCertInfoRemoteResponse) }},
+	{"dcom/imsa/iiiscertobj/v0.ImportFromBlobGetHashRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.ImportFromBlobGetHashRequest) }},
+	{"dcom/imsa/iiiscertobj/v0.ImportFromBlobGetHashResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.ImportFromBlobGetHashResponse) }},
+	{"dcom/imsa/iiiscertobj/v0.ImportFromBlobRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.ImportFromBlobRequest) }},
+	{"dcom/imsa/iiiscertobj/v0.ImportFromBlobResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.ImportFromBlobResponse) }},
+	{"dcom/imsa/iiiscertobj/v0.IsExportableRemoteRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.IsExportableRemoteRequest) }},
+	{"dcom/imsa/iiiscertobj/v0.IsExportableRemoteResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.IsExportableRemoteResponse) }},
+	{"dcom/imsa/iiiscertobj/v0.IsInstalledRemoteRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.IsInstalledRemoteRequest) }},
+	{"dcom/imsa/iiiscertobj/v0.IsInstalledRemoteResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.IsInstalledRemoteResponse) }},
+	{"dcom/imsa/iiiscertobj/v0.SetInstanceNameRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.SetInstanceNameRequest) }},
+	{"dcom/imsa/iiiscertobj/v0.SetInstanceNameResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iiiscertobj_v0.SetInstanceNameResponse) }},
+	{"dcom/imsa/imsadminbase2w/v0.BackupWithPasswordRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.BackupWithPasswordRequest) }},
+	{"dcom/imsa/imsadminbase2w/v0.BackupWithPasswordResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.BackupWithPasswordResponse) }},
+	{"dcom/imsa/imsadminbase2w/v0.EnumHistoryRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.EnumHistoryRequest) }},
+	{"dcom/imsa/imsadminbase2w/v0.EnumHistoryResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.EnumHistoryResponse) }},
+	{"dcom/imsa/imsadminbase2w/v0.ExportRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.ExportRequest) }},
+	{"dcom/imsa/imsadminbase2w/v0.ExportResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.ExportResponse) }},
+	{"dcom/imsa/imsadminbase2w/v0.ImportRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.ImportRequest) }},
+	{"dcom/imsa/imsadminbase2w/v0.ImportResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.ImportResponse) }},
+	{"dcom/imsa/imsadminbase2w/v0.RestoreHistoryRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.RestoreHistoryRequest) }},
+	{"dcom/imsa/imsadminbase2w/v0.RestoreHistoryResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.RestoreHistoryResponse) }},
+	{"dcom/imsa/imsadminbase2w/v0.RestoreWithPasswordRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.RestoreWithPasswordRequest) }},
+	{"dcom/imsa/imsadminbase2w/v0.RestoreWithPasswordResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase2w_v0.RestoreWithPasswordResponse) }},
+	{"dcom/imsa/imsadminbase3w/v0.GetChildPathsRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase3w_v0.GetChildPathsRequest) }},
+	{"dcom/imsa/imsadminbase3w/v0.GetChildPathsResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbase3w_v0.GetChildPathsResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.AddKeyRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.AddKeyRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.AddKeyResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.AddKeyResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.BackupRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.BackupRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.BackupResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.BackupResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.ChangePermissionsRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.ChangePermissionsRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.ChangePermissionsResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.ChangePermissionsResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.CloseKeyRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.CloseKeyRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.CloseKeyResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.CloseKeyResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.CopyDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.CopyDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.CopyDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.CopyDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.CopyKeyRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.CopyKeyRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.CopyKeyResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.CopyKeyResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteAllDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteAllDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteAllDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteAllDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteBackupRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteBackupRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteBackupResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteBackupResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteChildKeysRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteChildKeysRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteChildKeysResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteChildKeysResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteKeyRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteKeyRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.DeleteKeyResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.DeleteKeyResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.EnumBackupsRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.EnumBackupsRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.EnumBackupsResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.EnumBackupsResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.EnumDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.EnumDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.EnumDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.EnumDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.EnumKeysRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.EnumKeysRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.EnumKeysResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.EnumKeysResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetAllDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetAllDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetAllDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetAllDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetDataPathsRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetDataPathsRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetDataPathsResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetDataPathsResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetDataSetNumberRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetDataSetNumberRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetDataSetNumberResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetDataSetNumberResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetHandleInfoRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetHandleInfoRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetHandleInfoResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetHandleInfoResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetLastChangeTimeRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetLastChangeTimeRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetLastChangeTimeResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetLastChangeTimeResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetServerGUIDRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetServerGUIDRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetServerGUIDResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetServerGUIDResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.GetSystemChangeNumberRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetSystemChangeNumberRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.GetSystemChangeNumberResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.GetSystemChangeNumberResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.KeyExchangePhase1Request", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.KeyExchangePhase1Request) }},
+	{"dcom/imsa/imsadminbasew/v0.KeyExchangePhase1Response", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.KeyExchangePhase1Response) }},
+	{"dcom/imsa/imsadminbasew/v0.KeyExchangePhase2Request", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.KeyExchangePhase2Request) }},
+	{"dcom/imsa/imsadminbasew/v0.KeyExchangePhase2Response", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.KeyExchangePhase2Response) }},
+	{"dcom/imsa/imsadminbasew/v0.OpenKeyRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.OpenKeyRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.OpenKeyResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.OpenKeyResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.RenameKeyRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.RenameKeyRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.RenameKeyResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.RenameKeyResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.RestoreRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.RestoreRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.RestoreResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.RestoreResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.SaveDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.SaveDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.SaveDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.SaveDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.SetDataRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.SetDataRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.SetDataResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.SetDataResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.SetLastChangeTimeRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.SetLastChangeTimeRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.SetLastChangeTimeResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.SetLastChangeTimeResponse) }},
+	{"dcom/imsa/imsadminbasew/v0.UnmarshalInterfaceRequest", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.UnmarshalInterfaceRequest) }},
+	{"dcom/imsa/imsadminbasew/v0.UnmarshalInterfaceResponse", func() ndr.Unmarshaler { return new(dcom_imsa_imsadminbasew_v0.UnmarshalInterfaceResponse) }},
+	{"dcom/imsa/iwamadmin/v0.AppCreateRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppCreateRequest) }},
+	{"dcom/imsa/iwamadmin/v0.AppCreateResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppCreateResponse) }},
+	{"dcom/imsa/iwamadmin/v0.AppDeleteRecoverableRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppDeleteRecoverableRequest) }},
+	{"dcom/imsa/iwamadmin/v0.AppDeleteRecoverableResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppDeleteRecoverableResponse) }},
+	{"dcom/imsa/iwamadmin/v0.AppDeleteRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppDeleteRequest) }},
+	{"dcom/imsa/iwamadmin/v0.AppDeleteResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppDeleteResponse) }},
+	{"dcom/imsa/iwamadmin/v0.AppGetStatusRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppGetStatusRequest) }},
+	{"dcom/imsa/iwamadmin/v0.AppGetStatusResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppGetStatusResponse) }},
+	{"dcom/imsa/iwamadmin/v0.AppRecoverRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppRecoverRequest) }},
+	{"dcom/imsa/iwamadmin/v0.AppRecoverResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppRecoverResponse) }},
+	{"dcom/imsa/iwamadmin/v0.AppUnloadRequest", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppUnloadRequest) }},
+	{"dcom/imsa/iwamadmin/v0.AppUnloadResponse", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin_v0.AppUnloadResponse) }},
+	{"dcom/imsa/iwamadmin2/v0.AppCreate2Request", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin2_v0.AppCreate2Request) }},
+	{"dcom/imsa/iwamadmin2/v0.AppCreate2Response", func() ndr.Unmarshaler { return new(dcom_imsa_iwamadmin2_v0.AppCreate2Response) }},
+	{"dcom/iobjectexporter/v0.ComplexPingRequest", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ComplexPingRequest) }},
+	{"dcom/iobjectexporter/v0.ComplexPingResponse", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ComplexPingResponse) }},
+	{"dcom/iobjectexporter/v0.ResolveOXIDRequest", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ResolveOXIDRequest) }},
+	{"dcom/iobjectexporter/v0.ResolveOXIDResponse", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ResolveOXIDResponse) }},
+	{"dcom/iobjectexporter/v0.ResolveOxid2Request", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ResolveOxid2Request) }},
+	{"dcom/iobjectexporter/v0.ResolveOxid2Response", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ResolveOxid2Response) }},
+	{"dcom/iobjectexporter/v0.ServerAlive2Request", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ServerAlive2Request) }},
+	{"dcom/iobjectexporter/v0.ServerAlive2Response", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ServerAlive2Response) }},
+	{"dcom/iobjectexporter/v0.ServerAliveRequest", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ServerAliveRequest) }},
+	{"dcom/iobjectexporter/v0.ServerAliveResponse", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.ServerAliveResponse) }},
+	{"dcom/iobjectexporter/v0.SimplePingRequest", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.SimplePingRequest) }},
+	{"dcom/iobjectexporter/v0.SimplePingResponse", func() ndr.Unmarshaler { return new(dcom_iobjectexporter_v0.SimplePingResponse) }},
+	{"dcom/ioi/imanagedobject/v0.GetObjectIdentityRequest", func() ndr.Unmarshaler { return new(dcom_ioi_imanagedobject_v0.GetObjectIdentityRequest) }},
+	{"dcom/ioi/imanagedobject/v0.GetObjectIdentityResponse", func() ndr.Unmarshaler { return new(dcom_ioi_imanagedobject_v0.GetObjectIdentityResponse) }},
+	{"dcom/ioi/imanagedobject/v0.GetSerializedBufferRequest", func() ndr.Unmarshaler { return new(dcom_ioi_imanagedobject_v0.GetSerializedBufferRequest) }},
+	{"dcom/ioi/imanagedobject/v0.GetSerializedBufferResponse", func() ndr.Unmarshaler { return new(dcom_ioi_imanagedobject_v0.GetSerializedBufferResponse) }},
+	{"dcom/ioi/iremotedispatch/v0.RemoteDispatchAutoDoneRequest", func() ndr.Unmarshaler { return new(dcom_ioi_iremotedispatch_v0.RemoteDispatchAutoDoneRequest) }},
+	{"dcom/ioi/iremotedispatch/v0.RemoteDispatchAutoDoneResponse", func() ndr.Unmarshaler { return new(dcom_ioi_iremotedispatch_v0.RemoteDispatchAutoDoneResponse) }},
+	{"dcom/ioi/iremotedispatch/v0.RemoteDispatchNotAutoDoneRequest", func() ndr.Unmarshaler { return new(dcom_ioi_iremotedispatch_v0.RemoteDispatchNotAutoDoneRequest) }},
+	{"dcom/ioi/iremotedispatch/v0.RemoteDispatchNotAutoDoneResponse", func() ndr.Unmarshaler { return new(dcom_ioi_iremotedispatch_v0.RemoteDispatchNotAutoDoneResponse) }},
+	{"dcom/ioi/iservicedcomponentinfo/v0.GetComponentInfoRequest", func() ndr.Unmarshaler { return new(dcom_ioi_iservicedcomponentinfo_v0.GetComponentInfoRequest) }},
+	{"dcom/ioi/iservicedcomponentinfo/v0.GetComponentInfoResponse", func() ndr.Unmarshaler { return new(dcom_ioi_iservicedcomponentinfo_v0.GetComponentInfoResponse) }},
+	{"dcom/iremotescmactivator/v0.RemoteCreateInstanceRequest", func() ndr.Unmarshaler { return new(dcom_iremotescmactivator_v0.RemoteCreateInstanceRequest) }},
+	{"dcom/iremotescmactivator/v0.RemoteCreateInstanceResponse", func() ndr.Unmarshaler { return new(dcom_iremotescmactivator_v0.RemoteCreateInstanceResponse) }},
+	{"dcom/iremotescmactivator/v0.RemoteGetClassObjectRequest", func() ndr.Unmarshaler { return new(dcom_iremotescmactivator_v0.RemoteGetClassObjectRequest) }},
+	{"dcom/iremotescmactivator/v0.RemoteGetClassObjectResponse", func() ndr.Unmarshaler { return new(dcom_iremotescmactivator_v0.RemoteGetClassObjectResponse) }},
+	{"dcom/iremunknown/v0.RemoteAddReferenceRequest", func() ndr.Unmarshaler { return new(dcom_iremunknown_v0.RemoteAddReferenceRequest) }},
+	{"dcom/iremunknown/v0.RemoteAddReferenceResponse", func() ndr.Unmarshaler { return new(dcom_iremunknown_v0.RemoteAddReferenceResponse) }},
+	{"dcom/iremunknown/v0.RemoteQueryInterfaceRequest", func() ndr.Unmarshaler { return new(dcom_iremunknown_v0.RemoteQueryInterfaceRequest) }},
+	{"dcom/iremunknown/v0.RemoteQueryInterfaceResponse", func() ndr.Unmarshaler { return new(dcom_iremunknown_v0.RemoteQueryInterfaceResponse) }},
+	{"dcom/iremunknown/v0.RemoteReleaseRequest", func() ndr.Unmarshaler { return new(dcom_iremunknown_v0.RemoteReleaseRequest) }},
+	{"dcom/iremunknown/v0.RemoteReleaseResponse", func() ndr.Unmarshaler { return new(dcom_iremunknown_v0.RemoteReleaseResponse) }},
+	{"dcom/iremunknown2/v0.RemoteQueryInterface2Request", func() ndr.Unmarshaler { return new(dcom_iremunknown2_v0.RemoteQueryInterface2Request) }},
+	{"dcom/iremunknown2/v0.RemoteQueryInterface2Response", func() ndr.Unmarshaler { return new(dcom_iremunknown2_v0.RemoteQueryInterface2Response) }},
+	{"dcom/oaut/idispatch/v0.GetIDsOfNamesRequest", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.GetIDsOfNamesRequest) }},
+	{"dcom/oaut/idispatch/v0.GetIDsOfNamesResponse", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.GetIDsOfNamesResponse) }},
+	{"dcom/oaut/idispatch/v0.GetTypeInfoCountRequest", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.GetTypeInfoCountRequest) }},
+	{"dcom/oaut/idispatch/v0.GetTypeInfoCountResponse", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.GetTypeInfoCountResponse) }},
+	{"dcom/oaut/idispatch/v0.GetTypeInfoRequest", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.GetTypeInfoRequest) }},
+	{"dcom/oaut/idispatch/v0.GetTypeInfoResponse", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.GetTypeInfoResponse) }},
+	{"dcom/oaut/idispatch/v0.InvokeRequest", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.InvokeRequest) }},
+	{"dcom/oaut/idispatch/v0.InvokeResponse", func() ndr.Unmarshaler { return new(dcom_oaut_idispatch_v0.InvokeResponse) }},
+	{"dcom/oaut/ienumvariant/v0.CloneRequest", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.CloneRequest) }},
+	{"dcom/oaut/ienumvariant/v0.CloneResponse", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.CloneResponse) }},
+	{"dcom/oaut/ienumvariant/v0.NextRequest", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.NextRequest) }},
+	{"dcom/oaut/ienumvariant/v0.NextResponse", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.NextResponse) }},
+	{"dcom/oaut/ienumvariant/v0.ResetRequest", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.ResetRequest) }},
+	{"dcom/oaut/ienumvariant/v0.ResetResponse", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.ResetResponse) }},
+	{"dcom/oaut/ienumvariant/v0.SkipRequest", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.SkipRequest) }},
+	{"dcom/oaut/ienumvariant/v0.SkipResponse", func() ndr.Unmarshaler { return new(dcom_oaut_ienumvariant_v0.SkipResponse) }},
+	{"dcom/oaut/itypecomp/v0.BindRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypecomp_v0.BindRequest) }},
+	{"dcom/oaut/itypecomp/v0.BindResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypecomp_v0.BindResponse) }},
+	{"dcom/oaut/itypecomp/v0.BindTypeRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypecomp_v0.BindTypeRequest) }},
+	{"dcom/oaut/itypecomp/v0.BindTypeResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypecomp_v0.BindTypeResponse) }},
+	{"dcom/oaut/itypeinfo/v0.CreateInstanceRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.CreateInstanceRequest) }},
+	{"dcom/oaut/itypeinfo/v0.CreateInstanceResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.CreateInstanceResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetContainingTypeLibRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetContainingTypeLibRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetContainingTypeLibResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetContainingTypeLibResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetDLLEntryRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetDLLEntryRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetDLLEntryResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetDLLEntryResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetDocumentationRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetDocumentationRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetDocumentationResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetDocumentationResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetFuncDescRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetFuncDescRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetFuncDescResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetFuncDescResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetImplTypeFlagsRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetImplTypeFlagsRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetImplTypeFlagsResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetImplTypeFlagsResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetMopsRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetMopsRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetMopsResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetMopsResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetNamesRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetNamesRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetNamesResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetNamesResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetReferenceTypeInfoRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetReferenceTypeInfoRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetReferenceTypeInfoResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetReferenceTypeInfoResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetReferenceTypeOfImplTypeRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetReferenceTypeOfImplTypeRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetReferenceTypeOfImplTypeResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetReferenceTypeOfImplTypeResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetTypeAttributeRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetTypeAttributeRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetTypeAttributeResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetTypeAttributeResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetTypeCompRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetTypeCompRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetTypeCompResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetTypeCompResponse) }},
+	{"dcom/oaut/itypeinfo/v0.GetVarDescRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetVarDescRequest) }},
+	{"dcom/oaut/itypeinfo/v0.GetVarDescResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo_v0.GetVarDescResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllFuncCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllFuncCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllFuncCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllFuncCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllImplTypeCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllImplTypeCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllImplTypeCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllImplTypeCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllParamCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllParamCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllParamCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllParamCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllVarCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllVarCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetAllVarCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetAllVarCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetDocumentation2Request", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetDocumentation2Request) }},
+	{"dcom/oaut/itypeinfo2/v0.GetDocumentation2Response", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetDocumentation2Response) }},
+	{"dcom/oaut/itypeinfo2/v0.GetFuncCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetFuncCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetFuncCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetFuncCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetFuncIndexOfMemberIDsRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetFuncIndexOfMemberIDsRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetFuncIndexOfMemberIDsResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetFuncIndexOfMemberIDsResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetImplTypeCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetImplTypeCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetImplTypeCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetImplTypeCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetParamCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetParamCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetParamCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetParamCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetTypeFlagsRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetTypeFlagsRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetTypeFlagsResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetTypeFlagsResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetTypeKindRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetTypeKindRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetTypeKindResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetTypeKindResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetVarCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetVarCustomDataRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetVarCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetVarCustomDataResponse) }},
+	{"dcom/oaut/itypeinfo2/v0.GetVarIndexOfMemberIDsRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetVarIndexOfMemberIDsRequest) }},
+	{"dcom/oaut/itypeinfo2/v0.GetVarIndexOfMemberIDsResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypeinfo2_v0.GetVarIndexOfMemberIDsResponse) }},
+	{"dcom/oaut/itypelib/v0.FindNameRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.FindNameRequest) }},
+	{"dcom/oaut/itypelib/v0.FindNameResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.FindNameResponse) }},
+	{"dcom/oaut/itypelib/v0.GetDocumentationRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetDocumentationRequest) }},
+	{"dcom/oaut/itypelib/v0.GetDocumentationResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetDocumentationResponse) }},
+	{"dcom/oaut/itypelib/v0.GetLibAttributeRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetLibAttributeRequest) }},
+	{"dcom/oaut/itypelib/v0.GetLibAttributeResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetLibAttributeResponse) }},
+	{"dcom/oaut/itypelib/v0.GetTypeCompRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeCompRequest) }},
+	{"dcom/oaut/itypelib/v0.GetTypeCompResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeCompResponse) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoCountRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoCountRequest) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoCountResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoCountResponse) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoOfGUIDRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoOfGUIDRequest) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoOfGUIDResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoOfGUIDResponse) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoRequest) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoResponse) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoTypeRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoTypeRequest) }},
+	{"dcom/oaut/itypelib/v0.GetTypeInfoTypeResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.GetTypeInfoTypeResponse) }},
+	{"dcom/oaut/itypelib/v0.IsNameRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.IsNameRequest) }},
+	{"dcom/oaut/itypelib/v0.IsNameResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib_v0.IsNameResponse) }},
+	{"dcom/oaut/itypelib2/v0.GetAllCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetAllCustomDataRequest) }},
+	{"dcom/oaut/itypelib2/v0.GetAllCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetAllCustomDataResponse) }},
+	{"dcom/oaut/itypelib2/v0.GetCustomDataRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetCustomDataRequest) }},
+	{"dcom/oaut/itypelib2/v0.GetCustomDataResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetCustomDataResponse) }},
+	{"dcom/oaut/itypelib2/v0.GetDocumentation2Request", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetDocumentation2Request) }},
+	{"dcom/oaut/itypelib2/v0.GetDocumentation2Response", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetDocumentation2Response) }},
+	{"dcom/oaut/itypelib2/v0.GetLibStatisticsRequest", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetLibStatisticsRequest) }},
+	{"dcom/oaut/itypelib2/v0.GetLibStatisticsResponse", func() ndr.Unmarshaler { return new(dcom_oaut_itypelib2_v0.GetLibStatisticsResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.GetCAConfigInformationRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetCAConfigInformationRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.GetCAConfigInformationResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetCAConfigInformationResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.GetHashAlgorithmsRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetHashAlgorithmsRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.GetHashAlgorithmsResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetHashAlgorithmsResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.GetMyRolesRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetMyRolesRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.GetMyRolesResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetMyRolesResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.GetOCSPPropertyRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetOCSPPropertyRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.GetOCSPPropertyResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetOCSPPropertyResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.GetSecurityRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetSecurityRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.GetSecurityResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetSecurityResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.GetSigningCertificatesRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetSigningCertificatesRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.GetSigningCertificatesResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.GetSigningCertificatesResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.PingRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.PingRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.PingResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.PingResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.SetCAConfigInformationRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.SetCAConfigInformationRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.SetCAConfigInformationResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.SetCAConfigInformationResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.SetOCSPPropertyRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.SetOCSPPropertyRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.SetOCSPPropertyResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.SetOCSPPropertyResponse) }},
+	{"dcom/ocspa/iocspadmind/v0.SetSecurityRequest", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.SetSecurityRequest) }},
+	{"dcom/ocspa/iocspadmind/v0.SetSecurityResponse", func() ndr.Unmarshaler { return new(dcom_ocspa_iocspadmind_v0.SetSecurityResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetAlertThresholdsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetAlertThresholdsRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetAlertThresholdsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetAlertThresholdsResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetEventLogRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetEventLogRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetEventLogResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetEventLogResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetSampleIntervalRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetSampleIntervalRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetSampleIntervalResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetSampleIntervalResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskArgumentsRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskArgumentsResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskRunAsSelfRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskRunAsSelfRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskRunAsSelfResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskRunAsSelfResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskUserTextArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskUserTextArgumentsRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTaskUserTextArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTaskUserTextArgumentsResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTriggerDataCollectorSetRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTriggerDataCollectorSetRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.GetTriggerDataCollectorSetResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.GetTriggerDataCollectorSetResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetAlertThresholdsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetAlertThresholdsRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetAlertThresholdsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetAlertThresholdsResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetEventLogRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetEventLogRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetEventLogResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetEventLogResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetSampleIntervalRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetSampleIntervalRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetSampleIntervalResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetSampleIntervalResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskArgumentsRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskArgumentsResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskRunAsSelfRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskRunAsSelfRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskRunAsSelfResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskRunAsSelfResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskUserTextArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskUserTextArgumentsRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTaskUserTextArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTaskUserTextArgumentsResponse) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTriggerDataCollectorSetRequest", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTriggerDataCollectorSetRequest) }},
+	{"dcom/pla/ialertdatacollector/v0.SetTriggerDataCollectorSetResponse", func() ndr.Unmarshaler { return new(dcom_pla_ialertdatacollector_v0.SetTriggerDataCollectorSetResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetExcludeAPIsRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetExcludeAPIsRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetExcludeAPIsResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetExcludeAPIsResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetExePathRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetExePathRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetExePathResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetExePathResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetIncludeAPIsRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetIncludeAPIsRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetIncludeAPIsResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetIncludeAPIsResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetIncludeModulesRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetIncludeModulesRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetIncludeModulesResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetIncludeModulesResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetLogAPINamesOnlyRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetLogAPINamesOnlyRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetLogAPINamesOnlyResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetLogAPINamesOnlyResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetLogAPIsRecursivelyRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetLogAPIsRecursivelyRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetLogAPIsRecursivelyResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetLogAPIsRecursivelyResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetLogFilePathRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetLogFilePathRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.GetLogFilePathResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.GetLogFilePathResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetExcludeAPIsRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetExcludeAPIsRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetExcludeAPIsResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetExcludeAPIsResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetExePathRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetExePathRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetExePathResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetExePathResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetIncludeAPIsRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetIncludeAPIsRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetIncludeAPIsResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetIncludeAPIsResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetIncludeModulesRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetIncludeModulesRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetIncludeModulesResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetIncludeModulesResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetLogAPINamesOnlyRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetLogAPINamesOnlyRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetLogAPINamesOnlyResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetLogAPINamesOnlyResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetLogAPIsRecursivelyRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetLogAPIsRecursivelyRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetLogAPIsRecursivelyResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetLogAPIsRecursivelyResponse) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetLogFilePathRequest", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetLogFilePathRequest) }},
+	{"dcom/pla/iapitracingdatacollector/v0.SetLogFilePathResponse", func() ndr.Unmarshaler { return new(dcom_pla_iapitracingdatacollector_v0.SetLogFilePathResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFileMaxCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetFileMaxCountRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFileMaxCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetFileMaxCountResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFileMaxRecursiveDepthRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetFileMaxRecursiveDepthRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFileMaxRecursiveDepthResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetFileMaxRecursiveDepthResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFileMaxTotalSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetFileMaxTotalSizeRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFileMaxTotalSizeResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetFileMaxTotalSizeResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFilesRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetFilesRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetFilesResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetFilesResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetManagementQueriesRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetManagementQueriesRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetManagementQueriesResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetManagementQueriesResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetQueryNetworkAdaptersRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetQueryNetworkAdaptersRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetQueryNetworkAdaptersResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetQueryNetworkAdaptersResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetRegistryKeysRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetRegistryKeysRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetRegistryKeysResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetRegistryKeysResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetRegistryMaxRecursiveDepthRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetRegistryMaxRecursiveDepthRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetRegistryMaxRecursiveDepthResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.GetRegistryMaxRecursiveDepthResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetSystemStateFileRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetSystemStateFileRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.GetSystemStateFileResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.GetSystemStateFileResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFileMaxCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetFileMaxCountRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFileMaxCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetFileMaxCountResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFileMaxRecursiveDepthRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetFileMaxRecursiveDepthRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFileMaxRecursiveDepthResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetFileMaxRecursiveDepthResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFileMaxTotalSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetFileMaxTotalSizeRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFileMaxTotalSizeResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetFileMaxTotalSizeResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFilesRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetFilesRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetFilesResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetFilesResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetManagementQueriesRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetManagementQueriesRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetManagementQueriesResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetManagementQueriesResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetQueryNetworkAdaptersRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetQueryNetworkAdaptersRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetQueryNetworkAdaptersResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetQueryNetworkAdaptersResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetRegistryKeysRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetRegistryKeysRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetRegistryKeysResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetRegistryKeysResponse) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetRegistryMaxRecursiveDepthRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetRegistryMaxRecursiveDepthRequest)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetRegistryMaxRecursiveDepthResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iconfigurationdatacollector_v0.SetRegistryMaxRecursiveDepthResponse)
+	}},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetSystemStateFileRequest", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetSystemStateFileRequest) }},
+	{"dcom/pla/iconfigurationdatacollector/v0.SetSystemStateFileResponse", func() ndr.Unmarshaler { return new(dcom_pla_iconfigurationdatacollector_v0.SetSystemStateFileResponse) }},
+	{"dcom/pla/idatacollector/v0.GetDataCollectorSetRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetDataCollectorSetRequest) }},
+	{"dcom/pla/idatacollector/v0.GetDataCollectorSetResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetDataCollectorSetResponse) }},
+	{"dcom/pla/idatacollector/v0.GetDataCollectorTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetDataCollectorTypeRequest) }},
+	{"dcom/pla/idatacollector/v0.GetDataCollectorTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetDataCollectorTypeResponse) }},
+	{"dcom/pla/idatacollector/v0.GetFileNameFormatPatternRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetFileNameFormatPatternRequest) }},
+	{"dcom/pla/idatacollector/v0.GetFileNameFormatPatternResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetFileNameFormatPatternResponse) }},
+	{"dcom/pla/idatacollector/v0.GetFileNameFormatRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetFileNameFormatRequest) }},
+	{"dcom/pla/idatacollector/v0.GetFileNameFormatResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetFileNameFormatResponse) }},
+	{"dcom/pla/idatacollector/v0.GetFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetFileNameRequest) }},
+	{"dcom/pla/idatacollector/v0.GetFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetFileNameResponse) }},
+	{"dcom/pla/idatacollector/v0.GetIndexRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetIndexRequest) }},
+	{"dcom/pla/idatacollector/v0.GetIndexResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetIndexResponse) }},
+	{"dcom/pla/idatacollector/v0.GetLatestOutputLocationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLatestOutputLocationRequest) }},
+	{"dcom/pla/idatacollector/v0.GetLatestOutputLocationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLatestOutputLocationResponse) }},
+	{"dcom/pla/idatacollector/v0.GetLogAppendRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLogAppendRequest) }},
+	{"dcom/pla/idatacollector/v0.GetLogAppendResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLogAppendResponse) }},
+	{"dcom/pla/idatacollector/v0.GetLogCircularRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLogCircularRequest) }},
+	{"dcom/pla/idatacollector/v0.GetLogCircularResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLogCircularResponse) }},
+	{"dcom/pla/idatacollector/v0.GetLogOverwriteRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLogOverwriteRequest) }},
+	{"dcom/pla/idatacollector/v0.GetLogOverwriteResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetLogOverwriteResponse) }},
+	{"dcom/pla/idatacollector/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetNameRequest) }},
+	{"dcom/pla/idatacollector/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetNameResponse) }},
+	{"dcom/pla/idatacollector/v0.GetOutputLocationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetOutputLocationRequest) }},
+	{"dcom/pla/idatacollector/v0.GetOutputLocationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetOutputLocationResponse) }},
+	{"dcom/pla/idatacollector/v0.GetXMLRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetXMLRequest) }},
+	{"dcom/pla/idatacollector/v0.GetXMLResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.GetXMLResponse) }},
+	{"dcom/pla/idatacollector/v0.SetFileNameFormatPatternRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetFileNameFormatPatternRequest) }},
+	{"dcom/pla/idatacollector/v0.SetFileNameFormatPatternResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetFileNameFormatPatternResponse) }},
+	{"dcom/pla/idatacollector/v0.SetFileNameFormatRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetFileNameFormatRequest) }},
+	{"dcom/pla/idatacollector/v0.SetFileNameFormatResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetFileNameFormatResponse) }},
+	{"dcom/pla/idatacollector/v0.SetFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetFileNameRequest) }},
+	{"dcom/pla/idatacollector/v0.SetFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetFileNameResponse) }},
+	{"dcom/pla/idatacollector/v0.SetLatestOutputLocationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLatestOutputLocationRequest) }},
+	{"dcom/pla/idatacollector/v0.SetLatestOutputLocationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLatestOutputLocationResponse) }},
+	{"dcom/pla/idatacollector/v0.SetLogAppendRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLogAppendRequest) }},
+	{"dcom/pla/idatacollector/v0.SetLogAppendResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLogAppendResponse) }},
+	{"dcom/pla/idatacollector/v0.SetLogCircularRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLogCircularRequest) }},
+	{"dcom/pla/idatacollector/v0.SetLogCircularResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLogCircularResponse) }},
+	{"dcom/pla/idatacollector/v0.SetLogOverwriteRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLogOverwriteRequest) }},
+	{"dcom/pla/idatacollector/v0.SetLogOverwriteResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetLogOverwriteResponse) }},
+	{"dcom/pla/idatacollector/v0.SetNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetNameRequest) }},
+	{"dcom/pla/idatacollector/v0.SetNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetNameResponse) }},
+	{"dcom/pla/idatacollector/v0.SetXMLRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetXMLRequest) }},
+	{"dcom/pla/idatacollector/v0.SetXMLResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollector_v0.SetXMLResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.AddRangeRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.AddRangeRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.AddRangeResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.AddRangeResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.AddRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.AddResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.ClearRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.ClearResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.CreateDataCollectorFromXMLRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_idatacollectorcollection_v0.CreateDataCollectorFromXMLRequest)
+	}},
+	{"dcom/pla/idatacollectorcollection/v0.CreateDataCollectorFromXMLResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_idatacollectorcollection_v0.CreateDataCollectorFromXMLResponse)
+	}},
+	{"dcom/pla/idatacollectorcollection/v0.CreateDataCollectorRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.CreateDataCollectorRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.CreateDataCollectorResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.CreateDataCollectorResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.GetCountRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.GetCountResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.GetItemRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.GetItemResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.Get_NewEnumRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.Get_NewEnumResponse) }},
+	{"dcom/pla/idatacollectorcollection/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.RemoveRequest) }},
+	{"dcom/pla/idatacollectorcollection/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorcollection_v0.RemoveResponse) }},
+	{"dcom/pla/idatacollectorset/v0.CommitRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.CommitRequest) }},
+	{"dcom/pla/idatacollectorset/v0.CommitResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.CommitResponse) }},
+	{"dcom/pla/idatacollectorset/v0.DeleteRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.DeleteRequest) }},
+	{"dcom/pla/idatacollectorset/v0.DeleteResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.DeleteResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetDataCollectorsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDataCollectorsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetDataCollectorsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDataCollectorsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetDataManagerRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDataManagerRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetDataManagerResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDataManagerResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDescriptionRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDescriptionResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetDescriptionUnresolvedRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDescriptionUnresolvedRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetDescriptionUnresolvedResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDescriptionUnresolvedResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetDisplayNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDisplayNameRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetDisplayNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDisplayNameResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetDisplayNameUnresolvedRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDisplayNameUnresolvedRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetDisplayNameUnresolvedResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDisplayNameUnresolvedResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetDurationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDurationRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetDurationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetDurationResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetKeywordsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetKeywordsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetKeywordsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetKeywordsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetLatestOutputLocationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetLatestOutputLocationRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetLatestOutputLocationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetLatestOutputLocationResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetNameRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetNameResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetOutputLocationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetOutputLocationRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetOutputLocationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetOutputLocationResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetRootPathRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetRootPathRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetRootPathResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetRootPathResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSchedulesEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSchedulesEnabledRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSchedulesEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSchedulesEnabledResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSchedulesRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSchedulesRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSchedulesResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSchedulesResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSecurityRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSecurityRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSecurityResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSecurityResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSegmentMaxDurationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSegmentMaxDurationRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSegmentMaxDurationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSegmentMaxDurationResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSegmentMaxSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSegmentMaxSizeRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSegmentMaxSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSegmentMaxSizeResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSegmentRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSegmentRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSegmentResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSegmentResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSerialNumberRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSerialNumberRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSerialNumberResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSerialNumberResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetServerRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetServerRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetServerResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetServerResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetStatusRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetStatusRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetStatusResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetStatusResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetStopOnCompletionRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetStopOnCompletionRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetStopOnCompletionResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetStopOnCompletionResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSubdirectoryFormatPatternRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSubdirectoryFormatPatternRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSubdirectoryFormatPatternResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSubdirectoryFormatPatternResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSubdirectoryFormatRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSubdirectoryFormatRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSubdirectoryFormatResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSubdirectoryFormatResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetSubdirectoryRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSubdirectoryRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetSubdirectoryResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetSubdirectoryResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskArgumentsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskArgumentsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskRunAsSelfRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskRunAsSelfRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskRunAsSelfResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskRunAsSelfResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskUserTextArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskUserTextArgumentsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetTaskUserTextArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetTaskUserTextArgumentsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetUserAccountRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetUserAccountRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetUserAccountResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetUserAccountResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetValueRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetValueResponse) }},
+	{"dcom/pla/idatacollectorset/v0.GetXMLRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetXMLRequest) }},
+	{"dcom/pla/idatacollectorset/v0.GetXMLResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.GetXMLResponse) }},
+	{"dcom/pla/idatacollectorset/v0.QueryRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.QueryRequest) }},
+	{"dcom/pla/idatacollectorset/v0.QueryResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.QueryResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetCredentialsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetCredentialsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetCredentialsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetCredentialsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetDescriptionRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetDescriptionResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetDisplayNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetDisplayNameRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetDisplayNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetDisplayNameResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetDurationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetDurationRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetDurationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetDurationResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetKeywordsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetKeywordsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetKeywordsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetKeywordsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetLatestOutputLocationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetLatestOutputLocationRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetLatestOutputLocationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetLatestOutputLocationResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetRootPathRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetRootPathRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetRootPathResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetRootPathResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSchedulesEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSchedulesEnabledRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSchedulesEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSchedulesEnabledResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSecurityRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSecurityRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSecurityResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSecurityResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSegmentMaxDurationRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSegmentMaxDurationRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSegmentMaxDurationResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSegmentMaxDurationResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSegmentMaxSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSegmentMaxSizeRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSegmentMaxSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSegmentMaxSizeResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSegmentRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSegmentRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSegmentResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSegmentResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSerialNumberRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSerialNumberRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSerialNumberResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSerialNumberResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetStopOnCompletionRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetStopOnCompletionRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetStopOnCompletionResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetStopOnCompletionResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSubdirectoryFormatPatternRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSubdirectoryFormatPatternRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSubdirectoryFormatPatternResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSubdirectoryFormatPatternResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSubdirectoryFormatRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSubdirectoryFormatRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSubdirectoryFormatResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSubdirectoryFormatResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetSubdirectoryRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSubdirectoryRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetSubdirectoryResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetSubdirectoryResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskArgumentsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskArgumentsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskRunAsSelfRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskRunAsSelfRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskRunAsSelfResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskRunAsSelfResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskUserTextArgumentsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskUserTextArgumentsRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetTaskUserTextArgumentsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetTaskUserTextArgumentsResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetValueRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetValueRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetValueResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetValueResponse) }},
+	{"dcom/pla/idatacollectorset/v0.SetXMLRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetXMLRequest) }},
+	{"dcom/pla/idatacollectorset/v0.SetXMLResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.SetXMLResponse) }},
+	{"dcom/pla/idatacollectorset/v0.StartRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.StartRequest) }},
+	{"dcom/pla/idatacollectorset/v0.StartResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.StartResponse) }},
+	{"dcom/pla/idatacollectorset/v0.StopRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.StopRequest) }},
+	{"dcom/pla/idatacollectorset/v0.StopResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorset_v0.StopResponse) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.AddRangeRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.AddRangeRequest) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.AddRangeResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.AddRangeResponse) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.AddRequest) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.AddResponse) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.ClearRequest) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.ClearResponse) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.GetCountRequest) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.GetCountResponse) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.GetDataCollectorSetsRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_idatacollectorsetcollection_v0.GetDataCollectorSetsRequest)
+	}},
+	{"dcom/pla/idatacollectorsetcollection/v0.GetDataCollectorSetsResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_idatacollectorsetcollection_v0.GetDataCollectorSetsResponse)
+	}},
+	{"dcom/pla/idatacollectorsetcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.GetItemRequest) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.GetItemResponse) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.Get_NewEnumRequest) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.Get_NewEnumResponse) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.RemoveRequest) }},
+	{"dcom/pla/idatacollectorsetcollection/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatacollectorsetcollection_v0.RemoveResponse) }},
+	{"dcom/pla/idatamanager/v0.ExtractRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.ExtractRequest) }},
+	{"dcom/pla/idatamanager/v0.ExtractResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.ExtractResponse) }},
+	{"dcom/pla/idatamanager/v0.GetCheckBeforeRunningRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetCheckBeforeRunningRequest) }},
+	{"dcom/pla/idatamanager/v0.GetCheckBeforeRunningResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetCheckBeforeRunningResponse) }},
+	{"dcom/pla/idatamanager/v0.GetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetEnabledRequest) }},
+	{"dcom/pla/idatamanager/v0.GetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetEnabledResponse) }},
+	{"dcom/pla/idatamanager/v0.GetEventsFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetEventsFileNameRequest) }},
+	{"dcom/pla/idatamanager/v0.GetEventsFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetEventsFileNameResponse) }},
+	{"dcom/pla/idatamanager/v0.GetFolderActionsRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetFolderActionsRequest) }},
+	{"dcom/pla/idatamanager/v0.GetFolderActionsResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetFolderActionsResponse) }},
+	{"dcom/pla/idatamanager/v0.GetMaxFolderCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetMaxFolderCountRequest) }},
+	{"dcom/pla/idatamanager/v0.GetMaxFolderCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetMaxFolderCountResponse) }},
+	{"dcom/pla/idatamanager/v0.GetMaxSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetMaxSizeRequest) }},
+	{"dcom/pla/idatamanager/v0.GetMaxSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetMaxSizeResponse) }},
+	{"dcom/pla/idatamanager/v0.GetMinFreeDiskRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetMinFreeDiskRequest) }},
+	{"dcom/pla/idatamanager/v0.GetMinFreeDiskResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetMinFreeDiskResponse) }},
+	{"dcom/pla/idatamanager/v0.GetReportFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetReportFileNameRequest) }},
+	{"dcom/pla/idatamanager/v0.GetReportFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetReportFileNameResponse) }},
+	{"dcom/pla/idatamanager/v0.GetReportSchemaRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetReportSchemaRequest) }},
+	{"dcom/pla/idatamanager/v0.GetReportSchemaResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetReportSchemaResponse) }},
+	{"dcom/pla/idatamanager/v0.GetResourcePolicyRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetResourcePolicyRequest) }},
+	{"dcom/pla/idatamanager/v0.GetResourcePolicyResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetResourcePolicyResponse) }},
+	{"dcom/pla/idatamanager/v0.GetRuleTargetFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetRuleTargetFileNameRequest) }},
+	{"dcom/pla/idatamanager/v0.GetRuleTargetFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetRuleTargetFileNameResponse) }},
+	{"dcom/pla/idatamanager/v0.GetRulesRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetRulesRequest) }},
+	{"dcom/pla/idatamanager/v0.GetRulesResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.GetRulesResponse) }},
+	{"dcom/pla/idatamanager/v0.RunRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.RunRequest) }},
+	{"dcom/pla/idatamanager/v0.RunResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.RunResponse) }},
+	{"dcom/pla/idatamanager/v0.SetCheckBeforeRunningRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetCheckBeforeRunningRequest) }},
+	{"dcom/pla/idatamanager/v0.SetCheckBeforeRunningResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetCheckBeforeRunningResponse) }},
+	{"dcom/pla/idatamanager/v0.SetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetEnabledRequest) }},
+	{"dcom/pla/idatamanager/v0.SetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetEnabledResponse) }},
+	{"dcom/pla/idatamanager/v0.SetEventsFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetEventsFileNameRequest) }},
+	{"dcom/pla/idatamanager/v0.SetEventsFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetEventsFileNameResponse) }},
+	{"dcom/pla/idatamanager/v0.SetMaxFolderCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetMaxFolderCountRequest) }},
+	{"dcom/pla/idatamanager/v0.SetMaxFolderCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetMaxFolderCountResponse) }},
+	{"dcom/pla/idatamanager/v0.SetMaxSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetMaxSizeRequest) }},
+	{"dcom/pla/idatamanager/v0.SetMaxSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetMaxSizeResponse) }},
+	{"dcom/pla/idatamanager/v0.SetMinFreeDiskRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetMinFreeDiskRequest) }},
+	{"dcom/pla/idatamanager/v0.SetMinFreeDiskResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetMinFreeDiskResponse) }},
+	{"dcom/pla/idatamanager/v0.SetReportFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetReportFileNameRequest) }},
+	{"dcom/pla/idatamanager/v0.SetReportFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetReportFileNameResponse) }},
+	{"dcom/pla/idatamanager/v0.SetReportSchemaRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetReportSchemaRequest) }},
+	{"dcom/pla/idatamanager/v0.SetReportSchemaResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetReportSchemaResponse) }},
+	{"dcom/pla/idatamanager/v0.SetResourcePolicyRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetResourcePolicyRequest) }},
+	{"dcom/pla/idatamanager/v0.SetResourcePolicyResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetResourcePolicyResponse) }},
+	{"dcom/pla/idatamanager/v0.SetRuleTargetFileNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetRuleTargetFileNameRequest) }},
+	{"dcom/pla/idatamanager/v0.SetRuleTargetFileNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetRuleTargetFileNameResponse) }},
+	{"dcom/pla/idatamanager/v0.SetRulesRequest", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetRulesRequest) }},
+	{"dcom/pla/idatamanager/v0.SetRulesResponse", func() ndr.Unmarshaler { return new(dcom_pla_idatamanager_v0.SetRulesResponse) }},
+	{"dcom/pla/ifolderaction/v0.GetActionsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetActionsRequest) }},
+	{"dcom/pla/ifolderaction/v0.GetActionsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetActionsResponse) }},
+	{"dcom/pla/ifolderaction/v0.GetAgeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetAgeRequest) }},
+	{"dcom/pla/ifolderaction/v0.GetAgeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetAgeResponse) }},
+	{"dcom/pla/ifolderaction/v0.GetSendCabToRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetSendCabToRequest) }},
+	{"dcom/pla/ifolderaction/v0.GetSendCabToResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetSendCabToResponse) }},
+	{"dcom/pla/ifolderaction/v0.GetSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetSizeRequest) }},
+	{"dcom/pla/ifolderaction/v0.GetSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.GetSizeResponse) }},
+	{"dcom/pla/ifolderaction/v0.SetActionsRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetActionsRequest) }},
+	{"dcom/pla/ifolderaction/v0.SetActionsResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetActionsResponse) }},
+	{"dcom/pla/ifolderaction/v0.SetAgeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetAgeRequest) }},
+	{"dcom/pla/ifolderaction/v0.SetAgeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetAgeResponse) }},
+	{"dcom/pla/ifolderaction/v0.SetSendCabToRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetSendCabToRequest) }},
+	{"dcom/pla/ifolderaction/v0.SetSendCabToResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetSendCabToResponse) }},
+	{"dcom/pla/ifolderaction/v0.SetSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetSizeRequest) }},
+	{"dcom/pla/ifolderaction/v0.SetSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderaction_v0.SetSizeResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.AddRangeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.AddRangeRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.AddRangeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.AddRangeResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.AddRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.AddResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.ClearRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.ClearResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.CreateFolderActionRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.CreateFolderActionRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.CreateFolderActionResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.CreateFolderActionResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.GetCountRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.GetCountResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.GetItemRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.GetItemResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.Get_NewEnumRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.Get_NewEnumResponse) }},
+	{"dcom/pla/ifolderactioncollection/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.RemoveRequest) }},
+	{"dcom/pla/ifolderactioncollection/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_pla_ifolderactioncollection_v0.RemoveResponse) }},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetDataSourceNameRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetDataSourceNameRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetDataSourceNameResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetDataSourceNameResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetLogFileFormatRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetLogFileFormatRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetLogFileFormatResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetLogFileFormatResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetPerformanceCountersRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetPerformanceCountersRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetPerformanceCountersResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetPerformanceCountersResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetSampleIntervalRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetSampleIntervalRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetSampleIntervalResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetSampleIntervalResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetSegmentMaxRecordsRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetSegmentMaxRecordsRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.GetSegmentMaxRecordsResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.GetSegmentMaxRecordsResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetDataSourceNameRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetDataSourceNameRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetDataSourceNameResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetDataSourceNameResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetLogFileFormatRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetLogFileFormatRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetLogFileFormatResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetLogFileFormatResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetPerformanceCountersRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetPerformanceCountersRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetPerformanceCountersResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetPerformanceCountersResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetSampleIntervalRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetSampleIntervalRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetSampleIntervalResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetSampleIntervalResponse)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetSegmentMaxRecordsRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetSegmentMaxRecordsRequest)
+	}},
+	{"dcom/pla/iperformancecounterdatacollector/v0.SetSegmentMaxRecordsResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_iperformancecounterdatacollector_v0.SetSegmentMaxRecordsResponse)
+	}},
+	{"dcom/pla/ischedule/v0.GetDaysRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetDaysRequest) }},
+	{"dcom/pla/ischedule/v0.GetDaysResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetDaysResponse) }},
+	{"dcom/pla/ischedule/v0.GetEndDateRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetEndDateRequest) }},
+	{"dcom/pla/ischedule/v0.GetEndDateResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetEndDateResponse) }},
+	{"dcom/pla/ischedule/v0.GetStartDateRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetStartDateRequest) }},
+	{"dcom/pla/ischedule/v0.GetStartDateResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetStartDateResponse) }},
+	{"dcom/pla/ischedule/v0.GetStartTimeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetStartTimeRequest) }},
+	{"dcom/pla/ischedule/v0.GetStartTimeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.GetStartTimeResponse) }},
+	{"dcom/pla/ischedule/v0.SetDaysRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetDaysRequest) }},
+	{"dcom/pla/ischedule/v0.SetDaysResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetDaysResponse) }},
+	{"dcom/pla/ischedule/v0.SetEndDateRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetEndDateRequest) }},
+	{"dcom/pla/ischedule/v0.SetEndDateResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetEndDateResponse) }},
+	{"dcom/pla/ischedule/v0.SetStartDateRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetStartDateRequest) }},
+	{"dcom/pla/ischedule/v0.SetStartDateResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetStartDateResponse) }},
+	{"dcom/pla/ischedule/v0.SetStartTimeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetStartTimeRequest) }},
+	{"dcom/pla/ischedule/v0.SetStartTimeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedule_v0.SetStartTimeResponse) }},
+	{"dcom/pla/ischedulecollection/v0.AddRangeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.AddRangeRequest) }},
+	{"dcom/pla/ischedulecollection/v0.AddRangeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.AddRangeResponse) }},
+	{"dcom/pla/ischedulecollection/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.AddRequest) }},
+	{"dcom/pla/ischedulecollection/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.AddResponse) }},
+	{"dcom/pla/ischedulecollection/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.ClearRequest) }},
+	{"dcom/pla/ischedulecollection/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.ClearResponse) }},
+	{"dcom/pla/ischedulecollection/v0.CreateScheduleRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.CreateScheduleRequest) }},
+	{"dcom/pla/ischedulecollection/v0.CreateScheduleResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.CreateScheduleResponse) }},
+	{"dcom/pla/ischedulecollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.GetCountRequest) }},
+	{"dcom/pla/ischedulecollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.GetCountResponse) }},
+	{"dcom/pla/ischedulecollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.GetItemRequest) }},
+	{"dcom/pla/ischedulecollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.GetItemResponse) }},
+	{"dcom/pla/ischedulecollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.Get_NewEnumRequest) }},
+	{"dcom/pla/ischedulecollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.Get_NewEnumResponse) }},
+	{"dcom/pla/ischedulecollection/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.RemoveRequest) }},
+	{"dcom/pla/ischedulecollection/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_pla_ischedulecollection_v0.RemoveResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetBufferSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetBufferSizeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetBufferSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetBufferSizeResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetBuffersLostRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetBuffersLostRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetBuffersLostResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetBuffersLostResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetBuffersWrittenRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetBuffersWrittenRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetBuffersWrittenResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetBuffersWrittenResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetClockTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetClockTypeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetClockTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetClockTypeResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetEventsLostRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetEventsLostRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetEventsLostResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetEventsLostResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetExtendedModesRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetExtendedModesRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetExtendedModesResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetExtendedModesResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetFlushTimerRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetFlushTimerRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetFlushTimerResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetFlushTimerResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetFreeBuffersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetFreeBuffersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetFreeBuffersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetFreeBuffersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetGUIDRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetGUIDRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetGUIDResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetGUIDResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetIsKernelTraceRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetIsKernelTraceRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetIsKernelTraceResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetIsKernelTraceResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetMaximumBuffersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetMaximumBuffersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetMaximumBuffersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetMaximumBuffersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetMinimumBuffersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetMinimumBuffersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetMinimumBuffersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetMinimumBuffersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetNumberOfBuffersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetNumberOfBuffersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetNumberOfBuffersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetNumberOfBuffersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetPreallocateFileRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetPreallocateFileRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetPreallocateFileResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetPreallocateFileResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetProcessModeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetProcessModeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetProcessModeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetProcessModeResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetRealTimeBuffersLostRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetRealTimeBuffersLostRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetRealTimeBuffersLostResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetRealTimeBuffersLostResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetSessionIDRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetSessionIDRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetSessionIDResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetSessionIDResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetSessionNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetSessionNameRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetSessionNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetSessionNameResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetSessionThreadIDRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetSessionThreadIDRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetSessionThreadIDResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetSessionThreadIDResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetStreamModeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetStreamModeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetStreamModeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetStreamModeResponse) }},
+	{"dcom/pla/itracedatacollector/v0.GetTraceDataProvidersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetTraceDataProvidersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.GetTraceDataProvidersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.GetTraceDataProvidersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetBufferSizeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetBufferSizeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetBufferSizeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetBufferSizeResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetClockTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetClockTypeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetClockTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetClockTypeResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetExtendedModesRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetExtendedModesRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetExtendedModesResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetExtendedModesResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetFlushTimerRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetFlushTimerRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetFlushTimerResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetFlushTimerResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetGUIDRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetGUIDRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetGUIDResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetGUIDResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetMaximumBuffersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetMaximumBuffersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetMaximumBuffersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetMaximumBuffersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetMinimumBuffersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetMinimumBuffersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetMinimumBuffersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetMinimumBuffersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetNumberOfBuffersRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetNumberOfBuffersRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetNumberOfBuffersResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetNumberOfBuffersResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetPreallocateFileRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetPreallocateFileRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetPreallocateFileResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetPreallocateFileResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetProcessModeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetProcessModeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetProcessModeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetProcessModeResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetSessionNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetSessionNameRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetSessionNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetSessionNameResponse) }},
+	{"dcom/pla/itracedatacollector/v0.SetStreamModeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetStreamModeRequest) }},
+	{"dcom/pla/itracedatacollector/v0.SetStreamModeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedatacollector_v0.SetStreamModeResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetDisplayNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetDisplayNameRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetDisplayNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetDisplayNameResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetFilterDataRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetFilterDataRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetFilterDataResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetFilterDataResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetFilterEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetFilterEnabledRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetFilterEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetFilterEnabledResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetFilterTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetFilterTypeRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetFilterTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetFilterTypeResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetGUIDRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetGUIDRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetGUIDResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetGUIDResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetKeywordsAllRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetKeywordsAllRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetKeywordsAllResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetKeywordsAllResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetKeywordsAnyRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetKeywordsAnyRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetKeywordsAnyResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetKeywordsAnyResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetLevelRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetLevelRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetLevelResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetLevelResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetPropertiesRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetPropertiesResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetRegisteredProcessesRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetRegisteredProcessesRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetRegisteredProcessesResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetRegisteredProcessesResponse) }},
+	{"dcom/pla/itracedataprovider/v0.GetSecurityRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetSecurityRequest) }},
+	{"dcom/pla/itracedataprovider/v0.GetSecurityResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.GetSecurityResponse) }},
+	{"dcom/pla/itracedataprovider/v0.QueryRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.QueryRequest) }},
+	{"dcom/pla/itracedataprovider/v0.QueryResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.QueryResponse) }},
+	{"dcom/pla/itracedataprovider/v0.ResolveRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.ResolveRequest) }},
+	{"dcom/pla/itracedataprovider/v0.ResolveResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.ResolveResponse) }},
+	{"dcom/pla/itracedataprovider/v0.SetDisplayNameRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetDisplayNameRequest) }},
+	{"dcom/pla/itracedataprovider/v0.SetDisplayNameResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetDisplayNameResponse) }},
+	{"dcom/pla/itracedataprovider/v0.SetFilterDataRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetFilterDataRequest) }},
+	{"dcom/pla/itracedataprovider/v0.SetFilterDataResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetFilterDataResponse) }},
+	{"dcom/pla/itracedataprovider/v0.SetFilterEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetFilterEnabledRequest) }},
+	{"dcom/pla/itracedataprovider/v0.SetFilterEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetFilterEnabledResponse) }},
+	{"dcom/pla/itracedataprovider/v0.SetFilterTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetFilterTypeRequest) }},
+	{"dcom/pla/itracedataprovider/v0.SetFilterTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetFilterTypeResponse) }},
+	{"dcom/pla/itracedataprovider/v0.SetGUIDRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetGUIDRequest) }},
+	{"dcom/pla/itracedataprovider/v0.SetGUIDResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetGUIDResponse) }},
+	{"dcom/pla/itracedataprovider/v0.SetSecurityRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetSecurityRequest) }},
+	{"dcom/pla/itracedataprovider/v0.SetSecurityResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovider_v0.SetSecurityResponse) }},
+	{"dcom/pla/itracedataprovidercollection/v0.AddRangeRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.AddRangeRequest) }},
+	{"dcom/pla/itracedataprovidercollection/v0.AddRangeResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.AddRangeResponse) }},
+	{"dcom/pla/itracedataprovidercollection/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.AddRequest) }},
+	{"dcom/pla/itracedataprovidercollection/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.AddResponse) }},
+	{"dcom/pla/itracedataprovidercollection/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.ClearRequest) }},
+	{"dcom/pla/itracedataprovidercollection/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.ClearResponse) }},
+	{"dcom/pla/itracedataprovidercollection/v0.CreateTraceDataProviderRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_itracedataprovidercollection_v0.CreateTraceDataProviderRequest)
+	}},
+	{"dcom/pla/itracedataprovidercollection/v0.CreateTraceDataProviderResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_itracedataprovidercollection_v0.CreateTraceDataProviderResponse)
+	}},
+	{"dcom/pla/itracedataprovidercollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.GetCountRequest) }},
+	{"dcom/pla/itracedataprovidercollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.GetCountResponse) }},
+	{"dcom/pla/itracedataprovidercollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.GetItemRequest) }},
+	{"dcom/pla/itracedataprovidercollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.GetItemResponse) }},
+	{"dcom/pla/itracedataprovidercollection/v0.GetTraceDataProvidersByProcessRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_itracedataprovidercollection_v0.GetTraceDataProvidersByProcessRequest)
+	}},
+	{"dcom/pla/itracedataprovidercollection/v0.GetTraceDataProvidersByProcessResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_itracedataprovidercollection_v0.GetTraceDataProvidersByProcessResponse)
+	}},
+	{"dcom/pla/itracedataprovidercollection/v0.GetTraceDataProvidersRequest", func() ndr.Unmarshaler {
+		return new(dcom_pla_itracedataprovidercollection_v0.GetTraceDataProvidersRequest)
+	}},
+	{"dcom/pla/itracedataprovidercollection/v0.GetTraceDataProvidersResponse", func() ndr.Unmarshaler {
+		return new(dcom_pla_itracedataprovidercollection_v0.GetTraceDataProvidersResponse)
+	}},
+	{"dcom/pla/itracedataprovidercollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.Get_NewEnumRequest) }},
+	{"dcom/pla/itracedataprovidercollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.Get_NewEnumResponse) }},
+	{"dcom/pla/itracedataprovidercollection/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.RemoveRequest) }},
+	{"dcom/pla/itracedataprovidercollection/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_pla_itracedataprovidercollection_v0.RemoveResponse) }},
+	{"dcom/pla/ivaluemap/v0.AddRangeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.AddRangeRequest) }},
+	{"dcom/pla/ivaluemap/v0.AddRangeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.AddRangeResponse) }},
+	{"dcom/pla/ivaluemap/v0.AddRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.AddRequest) }},
+	{"dcom/pla/ivaluemap/v0.AddResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.AddResponse) }},
+	{"dcom/pla/ivaluemap/v0.ClearRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.ClearRequest) }},
+	{"dcom/pla/ivaluemap/v0.ClearResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.ClearResponse) }},
+	{"dcom/pla/ivaluemap/v0.CreateValueMapItemRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.CreateValueMapItemRequest) }},
+	{"dcom/pla/ivaluemap/v0.CreateValueMapItemResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.CreateValueMapItemResponse) }},
+	{"dcom/pla/ivaluemap/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetCountRequest) }},
+	{"dcom/pla/ivaluemap/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetCountResponse) }},
+	{"dcom/pla/ivaluemap/v0.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetDescriptionRequest) }},
+	{"dcom/pla/ivaluemap/v0.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetDescriptionResponse) }},
+	{"dcom/pla/ivaluemap/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetItemRequest) }},
+	{"dcom/pla/ivaluemap/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetItemResponse) }},
+	{"dcom/pla/ivaluemap/v0.GetValueMapTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetValueMapTypeRequest) }},
+	{"dcom/pla/ivaluemap/v0.GetValueMapTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetValueMapTypeResponse) }},
+	{"dcom/pla/ivaluemap/v0.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetValueRequest) }},
+	{"dcom/pla/ivaluemap/v0.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.GetValueResponse) }},
+	{"dcom/pla/ivaluemap/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.Get_NewEnumRequest) }},
+	{"dcom/pla/ivaluemap/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.Get_NewEnumResponse) }},
+	{"dcom/pla/ivaluemap/v0.RemoveRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.RemoveRequest) }},
+	{"dcom/pla/ivaluemap/v0.RemoveResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.RemoveResponse) }},
+	{"dcom/pla/ivaluemap/v0.SetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.SetDescriptionRequest) }},
+	{"dcom/pla/ivaluemap/v0.SetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.SetDescriptionResponse) }},
+	{"dcom/pla/ivaluemap/v0.SetValueMapTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.SetValueMapTypeRequest) }},
+	{"dcom/pla/ivaluemap/v0.SetValueMapTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.SetValueMapTypeResponse) }},
+	{"dcom/pla/ivaluemap/v0.SetValueRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.SetValueRequest) }},
+	{"dcom/pla/ivaluemap/v0.SetValueResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemap_v0.SetValueResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.GetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetDescriptionRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.GetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetDescriptionResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.GetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetEnabledRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.GetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetEnabledResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.GetKeyRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetKeyRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.GetKeyResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetKeyResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.GetValueMapTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetValueMapTypeRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.GetValueMapTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetValueMapTypeResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.GetValueRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetValueRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.GetValueResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.GetValueResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.SetDescriptionRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetDescriptionRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.SetDescriptionResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetDescriptionResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.SetEnabledRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetEnabledRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.SetEnabledResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetEnabledResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.SetKeyRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetKeyRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.SetKeyResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetKeyResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.SetValueMapTypeRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetValueMapTypeRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.SetValueMapTypeResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetValueMapTypeResponse) }},
+	{"dcom/pla/ivaluemapitem/v0.SetValueRequest", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetValueRequest) }},
+	{"dcom/pla/ivaluemapitem/v0.SetValueResponse", func() ndr.Unmarshaler { return new(dcom_pla_ivaluemapitem_v0.SetValueResponse) }},
+	{"dcom/rai/ipchcollection/v0.GetCountRequest", func() ndr.Unmarshaler { return new(dcom_rai_ipchcollection_v0.GetCountRequest) }},
+	{"dcom/rai/ipchcollection/v0.GetCountResponse", func() ndr.Unmarshaler { return new(dcom_rai_ipchcollection_v0.GetCountResponse) }},
+	{"dcom/rai/ipchcollection/v0.GetItemRequest", func() ndr.Unmarshaler { return new(dcom_rai_ipchcollection_v0.GetItemRequest) }},
+	{"dcom/rai/ipchcollection/v0.GetItemResponse", func() ndr.Unmarshaler { return new(dcom_rai_ipchcollection_v0.GetItemResponse) }},
+	{"dcom/rai/ipchcollection/v0.Get_NewEnumRequest", func() ndr.Unmarshaler { return new(dcom_rai_ipchcollection_v0.Get_NewEnumRequest) }},
+	{"dcom/rai/ipchcollection/v0.Get_NewEnumResponse", func() ndr.Unmarshaler { return new(dcom_rai_ipchcollection_v0.Get_NewEnumResponse) }},
+	{"dcom/rai/ipchservice/v0.RemoteConnectionParametersRequest", func() ndr.Unmarshaler { return new(dcom_rai_ipchservice_v0.RemoteConnectionParametersRequest) }},
+	{"dcom/rai/ipchservice/v0.RemoteConnectionParametersResponse", func() ndr.Unmarshaler { return new(dcom_rai_ipchservice_v0.RemoteConnectionParametersResponse) }},
+	{"dcom/rai/ipchservice/v0.RemoteUserSessionInfoRequest", func() ndr.Unmarshaler { return new(dcom_rai_ipchservice_v0.RemoteUserSessionInfoRequest) }},
+	{"dcom/rai/ipchservice/v0.RemoteUserSessionInfoResponse", func() ndr.Unmarshaler { return new(dcom_rai_ipchservice_v0.RemoteUserSessionInfoResponse) }},
+	{"dcom/rai/irasrv/v0.GetNoviceUserInfoRequest", func() ndr.Unmarshaler { return new(dcom_rai_irasrv_v0.GetNoviceUserInfoRequest) }},
+	{"dcom/rai/irasrv/v0.GetNoviceUserInfoResponse", func() ndr.Unmarshaler { return new(dcom_rai_irasrv_v0.GetNoviceUserInfoResponse) }},
+	{"dcom/rai/irasrv/v0.GetSessionInfoRequest", func() ndr.Unmarshaler { return new(dcom_rai_irasrv_v0.GetSessionInfoRequest) }},
+	{"dcom/rai/irasrv/v0.GetSessionInfoResponse", func() ndr.Unmarshaler { return new(dcom_rai_irasrv_v0.GetSessionInfoResponse) }},
+	{"dcom/rai/isafsession/v0.GetDomainNameRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetDomainNameRequest) }},
+	{"dcom/rai/isafsession/v0.GetDomainNameResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetDomainNameResponse) }},
+	{"dcom/rai/isafsession/v0.GetSessionIDRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetSessionIDRequest) }},
+	{"dcom/rai/isafsession/v0.GetSessionIDResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetSessionIDResponse) }},
+	{"dcom/rai/isafsession/v0.GetSessionStateRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetSessionStateRequest) }},
+	{"dcom/rai/isafsession/v0.GetSessionStateResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetSessionStateResponse) }},
+	{"dcom/rai/isafsession/v0.GetUserNameRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetUserNameRequest) }},
+	{"dcom/rai/isafsession/v0.GetUserNameResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.GetUserNameResponse) }},
+	{"dcom/rai/isafsession/v0.SetDomainNameRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetDomainNameRequest) }},
+	{"dcom/rai/isafsession/v0.SetDomainNameResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetDomainNameResponse) }},
+	{"dcom/rai/isafsession/v0.SetSessionIDRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetSessionIDRequest) }},
+	{"dcom/rai/isafsession/v0.SetSessionIDResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetSessionIDResponse) }},
+	{"dcom/rai/isafsession/v0.SetSessionStateRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetSessionStateRequest) }},
+	{"dcom/rai/isafsession/v0.SetSessionStateResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetSessionStateResponse) }},
+	{"dcom/rai/isafsession/v0.SetUserNameRequest", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetUserNameRequest) }},
+	{"dcom/rai/isafsession/v0.SetUserNameResponse", func() ndr.Unmarshaler { return new(dcom_rai_isafsession_v0.SetUserNameResponse) }},
+	{"dcom/rdpesc/type_scard_pack/v1.SmartCardIORequest", func() ndr.Unmarshaler { return new(dcom_rdpesc_type_scard_pack_v1.SmartCardIORequest) }},
+	{"dcom/vds/ienumvdsobject/v0.CloneRequest", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.CloneRequest) }},
+	{"dcom/vds/ienumvdsobject/v0.CloneResponse", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.CloneResponse) }},
+	{"dcom/vds/ienumvdsobject/v0.NextRequest", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.NextRequest) }},
+	{"dcom/vds/ienumvdsobject/v0.NextResponse", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.NextResponse) }},
+	{"dcom/vds/ienumvdsobject/v0.ResetRequest", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.ResetRequest) }},
+	{"dcom/vds/ienumvdsobject/v0.ResetResponse", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.ResetResponse) }},
+	{"dcom/vds/ienumvdsobject/v0.SkipRequest", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.SkipRequest) }},
+	{"dcom/vds/ienumvdsobject/v0.SkipResponse", func() ndr.Unmarshaler { return new(dcom_vds_ienumvdsobject_v0.SkipResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.AssignDriveLetterRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.AssignDriveLetterRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.AssignDriveLetterResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.AssignDriveLetterResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.ChangeAttributesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.ChangeAttributesRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.ChangeAttributesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.ChangeAttributesResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.CleanRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.CleanRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.CleanResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.CleanResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.CreatePartitionRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.CreatePartitionRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.CreatePartitionResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.CreatePartitionResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.DeleteDriveLetterRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.DeleteDriveLetterRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.DeleteDriveLetterResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.DeleteDriveLetterResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.DeletePartitionRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.DeletePartitionRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.DeletePartitionResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.DeletePartitionResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.FormatPartitionRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.FormatPartitionRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.FormatPartitionResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.FormatPartitionResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.GetDriveLetterRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.GetDriveLetterRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.GetDriveLetterResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.GetDriveLetterResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.GetPartitionPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.GetPartitionPropertiesRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.GetPartitionPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.GetPartitionPropertiesResponse) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.QueryPartitionsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.QueryPartitionsRequest) }},
+	{"dcom/vds/ivdsadvanceddisk/v0.QueryPartitionsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk_v0.QueryPartitionsResponse) }},
+	{"dcom/vds/ivdsadvanceddisk2/v0.ChangePartitionTypeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk2_v0.ChangePartitionTypeRequest) }},
+	{"dcom/vds/ivdsadvanceddisk2/v0.ChangePartitionTypeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk2_v0.ChangePartitionTypeResponse) }},
+	{"dcom/vds/ivdsadvanceddisk3/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk3_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsadvanceddisk3/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk3_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsadvanceddisk3/v0.GetUniqueIDRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk3_v0.GetUniqueIDRequest) }},
+	{"dcom/vds/ivdsadvanceddisk3/v0.GetUniqueIDResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvanceddisk3_v0.GetUniqueIDResponse) }},
+	{"dcom/vds/ivdsadvisesink/v0.OnNotifyRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvisesink_v0.OnNotifyRequest) }},
+	{"dcom/vds/ivdsadvisesink/v0.OnNotifyResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsadvisesink_v0.OnNotifyResponse) }},
+	{"dcom/vds/ivdsasync/v0.CancelRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsasync_v0.CancelRequest) }},
+	{"dcom/vds/ivdsasync/v0.CancelResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsasync_v0.CancelResponse) }},
+	{"dcom/vds/ivdsasync/v0.QueryStatusRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsasync_v0.QueryStatusRequest) }},
+	{"dcom/vds/ivdsasync/v0.QueryStatusResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsasync_v0.QueryStatusResponse) }},
+	{"dcom/vds/ivdsasync/v0.WaitRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsasync_v0.WaitRequest) }},
+	{"dcom/vds/ivdsasync/v0.WaitResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsasync_v0.WaitResponse) }},
+	{"dcom/vds/ivdscreatepartitionex/v0.CreatePartitionExRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdscreatepartitionex_v0.CreatePartitionExRequest) }},
+	{"dcom/vds/ivdscreatepartitionex/v0.CreatePartitionExResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdscreatepartitionex_v0.CreatePartitionExResponse) }},
+	{"dcom/vds/ivdsdisk/v0.ClearFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.ClearFlagsRequest) }},
+	{"dcom/vds/ivdsdisk/v0.ClearFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.ClearFlagsResponse) }},
+	{"dcom/vds/ivdsdisk/v0.ConvertStyleRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.ConvertStyleRequest) }},
+	{"dcom/vds/ivdsdisk/v0.ConvertStyleResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.ConvertStyleResponse) }},
+	{"dcom/vds/ivdsdisk/v0.GetIdentificationDataRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.GetIdentificationDataRequest) }},
+	{"dcom/vds/ivdsdisk/v0.GetIdentificationDataResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.GetIdentificationDataResponse) }},
+	{"dcom/vds/ivdsdisk/v0.GetPackRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.GetPackRequest) }},
+	{"dcom/vds/ivdsdisk/v0.GetPackResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.GetPackResponse) }},
+	{"dcom/vds/ivdsdisk/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsdisk/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsdisk/v0.QueryExtentsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.QueryExtentsRequest) }},
+	{"dcom/vds/ivdsdisk/v0.QueryExtentsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.QueryExtentsResponse) }},
+	{"dcom/vds/ivdsdisk/v0.SetFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.SetFlagsRequest) }},
+	{"dcom/vds/ivdsdisk/v0.SetFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk_v0.SetFlagsResponse) }},
+	{"dcom/vds/ivdsdisk2/v0.SetSANModeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk2_v0.SetSANModeRequest) }},
+	{"dcom/vds/ivdsdisk2/v0.SetSANModeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk2_v0.SetSANModeResponse) }},
+	{"dcom/vds/ivdsdisk3/v0.GetProperties2Request", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk3_v0.GetProperties2Request) }},
+	{"dcom/vds/ivdsdisk3/v0.GetProperties2Response", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk3_v0.GetProperties2Response) }},
+	{"dcom/vds/ivdsdisk3/v0.QueryFreeExtentsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk3_v0.QueryFreeExtentsRequest) }},
+	{"dcom/vds/ivdsdisk3/v0.QueryFreeExtentsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdisk3_v0.QueryFreeExtentsResponse) }},
+	{"dcom/vds/ivdsdiskonline/v0.OfflineRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskonline_v0.OfflineRequest) }},
+	{"dcom/vds/ivdsdiskonline/v0.OfflineResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskonline_v0.OfflineResponse) }},
+	{"dcom/vds/ivdsdiskonline/v0.OnlineRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskonline_v0.OnlineRequest) }},
+	{"dcom/vds/ivdsdiskonline/v0.OnlineResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskonline_v0.OnlineResponse) }},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.FormatPartitionExRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskpartitionmf_v0.FormatPartitionExRequest) }},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.FormatPartitionExResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskpartitionmf_v0.FormatPartitionExResponse) }},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.GetPartitionFileSystemPropertiesRequest", func() ndr.Unmarshaler {
+		return new(dcom_vds_ivdsdiskpartitionmf_v0.GetPartitionFileSystemPropertiesRequest)
+	}},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.GetPartitionFileSystemPropertiesResponse", func() ndr.Unmarshaler {
+		return new(dcom_vds_ivdsdiskpartitionmf_v0.GetPartitionFileSystemPropertiesResponse)
+	}},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.GetPartitionFileSystemTypeNameRequest", func() ndr.Unmarshaler {
+		return new(dcom_vds_ivdsdiskpartitionmf_v0.GetPartitionFileSystemTypeNameRequest)
+	}},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.GetPartitionFileSystemTypeNameResponse", func() ndr.Unmarshaler {
+		return new(dcom_vds_ivdsdiskpartitionmf_v0.GetPartitionFileSystemTypeNameResponse)
+	}},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.QueryPartitionFileSystemFormatSupportRequest", func() ndr.Unmarshaler {
+		return new(dcom_vds_ivdsdiskpartitionmf_v0.QueryPartitionFileSystemFormatSupportRequest)
+	}},
+	{"dcom/vds/ivdsdiskpartitionmf/v0.QueryPartitionFileSystemFormatSupportResponse", func() ndr.Unmarshaler {
+		return new(dcom_vds_ivdsdiskpartitionmf_v0.QueryPartitionFileSystemFormatSupportResponse)
+	}},
+	{"dcom/vds/ivdsdiskpartitionmf2/v0.FormatPartitionEx2Request", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskpartitionmf2_v0.FormatPartitionEx2Request) }},
+	{"dcom/vds/ivdsdiskpartitionmf2/v0.FormatPartitionEx2Response", func() ndr.Unmarshaler { return new(dcom_vds_ivdsdiskpartitionmf2_v0.FormatPartitionEx2Response) }},
+	{"dcom/vds/ivdshbaport/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdshbaport_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdshbaport/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdshbaport_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdshbaport/v0.SetAllPathStatusesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdshbaport_v0.SetAllPathStatusesRequest) }},
+	{"dcom/vds/ivdshbaport/v0.SetAllPathStatusesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdshbaport_v0.SetAllPathStatusesResponse) }},
+	{"dcom/vds/ivdshwprovider/v0.QuerySubSystemsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdshwprovider_v0.QuerySubSystemsRequest) }},
+	{"dcom/vds/ivdshwprovider/v0.QuerySubSystemsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdshwprovider_v0.QuerySubSystemsResponse) }},
+	{"dcom/vds/ivdsiscsiinitiatoradapter/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsiscsiinitiatoradapter_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsiscsiinitiatoradapter/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsiscsiinitiatoradapter_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsiscsiinitiatoradapter/v0.QueryInitiatorPortalsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsiscsiinitiatoradapter_v0.QueryInitiatorPortalsRequest) }},
+	{"dcom/vds/ivdsiscsiinitiatoradapter/v0.QueryInitiatorPortalsResponse", func() ndr.Unmarshaler {
+		return new(dcom_vds_ivdsiscsiinitiatoradapter_v0.QueryInitiatorPortalsResponse)
+	}},
+	{"dcom/vds/ivdsiscsiinitiatorportal/v0.GetInitiatorAdapterRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsiscsiinitiatorportal_v0.GetInitiatorAdapterRequest) }},
+	{"dcom/vds/ivdsiscsiinitiatorportal/v0.GetInitiatorAdapterResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsiscsiinitiatorportal_v0.GetInitiatorAdapterResponse) }},
+	{"dcom/vds/ivdsiscsiinitiatorportal/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsiscsiinitiatorportal_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsiscsiinitiatorportal/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsiscsiinitiatorportal_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsopenvdisk/v0.AttachRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.AttachRequest) }},
+	{"dcom/vds/ivdsopenvdisk/v0.AttachResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.AttachResponse) }},
+	{"dcom/vds/ivdsopenvdisk/v0.CompactRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.CompactRequest) }},
+	{"dcom/vds/ivdsopenvdisk/v0.CompactResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.CompactResponse) }},
+	{"dcom/vds/ivdsopenvdisk/v0.DetachAndDeleteRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.DetachAndDeleteRequest) }},
+	{"dcom/vds/ivdsopenvdisk/v0.DetachAndDeleteResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.DetachAndDeleteResponse) }},
+	{"dcom/vds/ivdsopenvdisk/v0.DetachRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.DetachRequest) }},
+	{"dcom/vds/ivdsopenvdisk/v0.DetachResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.DetachResponse) }},
+	{"dcom/vds/ivdsopenvdisk/v0.ExpandRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.ExpandRequest) }},
+	{"dcom/vds/ivdsopenvdisk/v0.ExpandResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.ExpandResponse) }},
+	{"dcom/vds/ivdsopenvdisk/v0.MergeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.MergeRequest) }},
+	{"dcom/vds/ivdsopenvdisk/v0.MergeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsopenvdisk_v0.MergeResponse) }},
+	{"dcom/vds/ivdspack/v0.AddDiskRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.AddDiskRequest) }},
+	{"dcom/vds/ivdspack/v0.AddDiskResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.AddDiskResponse) }},
+	{"dcom/vds/ivdspack/v0.CreateVolumeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.CreateVolumeRequest) }},
+	{"dcom/vds/ivdspack/v0.CreateVolumeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.CreateVolumeResponse) }},
+	{"dcom/vds/ivdspack/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdspack/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdspack/v0.GetProviderRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.GetProviderRequest) }},
+	{"dcom/vds/ivdspack/v0.GetProviderResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.GetProviderResponse) }},
+	{"dcom/vds/ivdspack/v0.MigrateDisksRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.MigrateDisksRequest) }},
+	{"dcom/vds/ivdspack/v0.MigrateDisksResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.MigrateDisksResponse) }},
+	{"dcom/vds/ivdspack/v0.QueryDisksRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.QueryDisksRequest) }},
+	{"dcom/vds/ivdspack/v0.QueryDisksResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.QueryDisksResponse) }},
+	{"dcom/vds/ivdspack/v0.QueryVolumesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.QueryVolumesRequest) }},
+	{"dcom/vds/ivdspack/v0.QueryVolumesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.QueryVolumesResponse) }},
+	{"dcom/vds/ivdspack/v0.RecoverRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.RecoverRequest) }},
+	{"dcom/vds/ivdspack/v0.RecoverResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.RecoverResponse) }},
+	{"dcom/vds/ivdspack/v0.RemoveMissingDiskRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.RemoveMissingDiskRequest) }},
+	{"dcom/vds/ivdspack/v0.RemoveMissingDiskResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack_v0.RemoveMissingDiskResponse) }},
+	{"dcom/vds/ivdspack2/v0.CreateVolume2Request", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack2_v0.CreateVolume2Request) }},
+	{"dcom/vds/ivdspack2/v0.CreateVolume2Response", func() ndr.Unmarshaler { return new(dcom_vds_ivdspack2_v0.CreateVolume2Response) }},
+	{"dcom/vds/ivdsprovider/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsprovider_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsprovider/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsprovider_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsremovable/v0.EjectRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsremovable_v0.EjectRequest) }},
+	{"dcom/vds/ivdsremovable/v0.EjectResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsremovable_v0.EjectResponse) }},
+	{"dcom/vds/ivdsremovable/v0.QueryMediaRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsremovable_v0.QueryMediaRequest) }},
+	{"dcom/vds/ivdsremovable/v0.QueryMediaResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsremovable_v0.QueryMediaResponse) }},
+	{"dcom/vds/ivdsservice/v0.AdviseRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.AdviseRequest) }},
+	{"dcom/vds/ivdsservice/v0.AdviseResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.AdviseResponse) }},
+	{"dcom/vds/ivdsservice/v0.CleanupObsoleteMountPointsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.CleanupObsoleteMountPointsRequest) }},
+	{"dcom/vds/ivdsservice/v0.CleanupObsoleteMountPointsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.CleanupObsoleteMountPointsResponse) }},
+	{"dcom/vds/ivdsservice/v0.ClearFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.ClearFlagsRequest) }},
+	{"dcom/vds/ivdsservice/v0.ClearFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.ClearFlagsResponse) }},
+	{"dcom/vds/ivdsservice/v0.GetObjectRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.GetObjectRequest) }},
+	{"dcom/vds/ivdsservice/v0.GetObjectResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.GetObjectResponse) }},
+	{"dcom/vds/ivdsservice/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsservice/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsservice/v0.IsServiceReadyRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.IsServiceReadyRequest) }},
+	{"dcom/vds/ivdsservice/v0.IsServiceReadyResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.IsServiceReadyResponse) }},
+	{"dcom/vds/ivdsservice/v0.QueryDriveLettersRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryDriveLettersRequest) }},
+	{"dcom/vds/ivdsservice/v0.QueryDriveLettersResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryDriveLettersResponse) }},
+	{"dcom/vds/ivdsservice/v0.QueryFileSystemTypesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryFileSystemTypesRequest) }},
+	{"dcom/vds/ivdsservice/v0.QueryFileSystemTypesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryFileSystemTypesResponse) }},
+	{"dcom/vds/ivdsservice/v0.QueryProvidersRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryProvidersRequest) }},
+	{"dcom/vds/ivdsservice/v0.QueryProvidersResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryProvidersResponse) }},
+	{"dcom/vds/ivdsservice/v0.QueryUnallocatedDisksRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryUnallocatedDisksRequest) }},
+	{"dcom/vds/ivdsservice/v0.QueryUnallocatedDisksResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.QueryUnallocatedDisksResponse) }},
+	{"dcom/vds/ivdsservice/v0.RebootRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.RebootRequest) }},
+	{"dcom/vds/ivdsservice/v0.RebootResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.RebootResponse) }},
+	{"dcom/vds/ivdsservice/v0.ReenumerateRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.ReenumerateRequest) }},
+	{"dcom/vds/ivdsservice/v0.ReenumerateResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.ReenumerateResponse) }},
+	{"dcom/vds/ivdsservice/v0.RefreshRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.RefreshRequest) }},
+	{"dcom/vds/ivdsservice/v0.RefreshResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.RefreshResponse) }},
+	{"dcom/vds/ivdsservice/v0.SetFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.SetFlagsRequest) }},
+	{"dcom/vds/ivdsservice/v0.SetFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.SetFlagsResponse) }},
+	{"dcom/vds/ivdsservice/v0.UnadviseRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.UnadviseRequest) }},
+	{"dcom/vds/ivdsservice/v0.UnadviseResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.UnadviseResponse) }},
+	{"dcom/vds/ivdsservice/v0.WaitForServiceReadyRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.WaitForServiceReadyRequest) }},
+	{"dcom/vds/ivdsservice/v0.WaitForServiceReadyResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservice_v0.WaitForServiceReadyResponse) }},
+	{"dcom/vds/ivdsservicehba/v0.QueryHBAPortsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicehba_v0.QueryHBAPortsRequest) }},
+	{"dcom/vds/ivdsservicehba/v0.QueryHBAPortsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicehba_v0.QueryHBAPortsResponse) }},
+	{"dcom/vds/ivdsserviceinitialization/v0.InitializeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceinitialization_v0.InitializeRequest) }},
+	{"dcom/vds/ivdsserviceinitialization/v0.InitializeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceinitialization_v0.InitializeResponse) }},
+	{"dcom/vds/ivdsserviceiscsi/v0.GetInitiatorNameRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceiscsi_v0.GetInitiatorNameRequest) }},
+	{"dcom/vds/ivdsserviceiscsi/v0.GetInitiatorNameResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceiscsi_v0.GetInitiatorNameResponse) }},
+	{"dcom/vds/ivdsserviceiscsi/v0.QueryInitiatorAdaptersRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceiscsi_v0.QueryInitiatorAdaptersRequest) }},
+	{"dcom/vds/ivdsserviceiscsi/v0.QueryInitiatorAdaptersResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceiscsi_v0.QueryInitiatorAdaptersResponse) }},
+	{"dcom/vds/ivdsserviceiscsi/v0.SetInitiatorSharedSecretRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceiscsi_v0.SetInitiatorSharedSecretRequest) }},
+	{"dcom/vds/ivdsserviceiscsi/v0.SetInitiatorSharedSecretResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceiscsi_v0.SetInitiatorSharedSecretResponse) }},
+	{"dcom/vds/ivdsserviceloader/v0.LoadServiceRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceloader_v0.LoadServiceRequest) }},
+	{"dcom/vds/ivdsserviceloader/v0.LoadServiceResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceloader_v0.LoadServiceResponse) }},
+	{"dcom/vds/ivdsservicesan/v0.GetSANPolicyRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicesan_v0.GetSANPolicyRequest) }},
+	{"dcom/vds/ivdsservicesan/v0.GetSANPolicyResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicesan_v0.GetSANPolicyResponse) }},
+	{"dcom/vds/ivdsservicesan/v0.SetSANPolicyRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicesan_v0.SetSANPolicyRequest) }},
+	{"dcom/vds/ivdsservicesan/v0.SetSANPolicyResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicesan_v0.SetSANPolicyResponse) }},
+	{"dcom/vds/ivdsservicesw/v0.GetDiskObjectRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicesw_v0.GetDiskObjectRequest) }},
+	{"dcom/vds/ivdsservicesw/v0.GetDiskObjectResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsservicesw_v0.GetDiskObjectResponse) }},
+	{"dcom/vds/ivdsserviceuninstalldisk/v0.GetDiskIDFromLUNInfoRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceuninstalldisk_v0.GetDiskIDFromLUNInfoRequest) }},
+	{"dcom/vds/ivdsserviceuninstalldisk/v0.GetDiskIDFromLUNInfoResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceuninstalldisk_v0.GetDiskIDFromLUNInfoResponse) }},
+	{"dcom/vds/ivdsserviceuninstalldisk/v0.UninstallDisksRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceuninstalldisk_v0.UninstallDisksRequest) }},
+	{"dcom/vds/ivdsserviceuninstalldisk/v0.UninstallDisksResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsserviceuninstalldisk_v0.UninstallDisksResponse) }},
+	{"dcom/vds/ivdssubsystemimporttarget/v0.GetImportTargetRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdssubsystemimporttarget_v0.GetImportTargetRequest) }},
+	{"dcom/vds/ivdssubsystemimporttarget/v0.GetImportTargetResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdssubsystemimporttarget_v0.GetImportTargetResponse) }},
+	{"dcom/vds/ivdssubsystemimporttarget/v0.SetImportTargetRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdssubsystemimporttarget_v0.SetImportTargetRequest) }},
+	{"dcom/vds/ivdssubsystemimporttarget/v0.SetImportTargetResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdssubsystemimporttarget_v0.SetImportTargetResponse) }},
+	{"dcom/vds/ivdsswprovider/v0.CreatePackRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsswprovider_v0.CreatePackRequest) }},
+	{"dcom/vds/ivdsswprovider/v0.CreatePackResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsswprovider_v0.CreatePackResponse) }},
+	{"dcom/vds/ivdsswprovider/v0.QueryPacksRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsswprovider_v0.QueryPacksRequest) }},
+	{"dcom/vds/ivdsswprovider/v0.QueryPacksResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsswprovider_v0.QueryPacksResponse) }},
+	{"dcom/vds/ivdsvdisk/v0.GetDeviceNameRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.GetDeviceNameRequest) }},
+	{"dcom/vds/ivdsvdisk/v0.GetDeviceNameResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.GetDeviceNameResponse) }},
+	{"dcom/vds/ivdsvdisk/v0.GetHostVolumeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.GetHostVolumeRequest) }},
+	{"dcom/vds/ivdsvdisk/v0.GetHostVolumeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.GetHostVolumeResponse) }},
+	{"dcom/vds/ivdsvdisk/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsvdisk/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsvdisk/v0.OpenRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.OpenRequest) }},
+	{"dcom/vds/ivdsvdisk/v0.OpenResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdisk_v0.OpenResponse) }},
+	{"dcom/vds/ivdsvdprovider/v0.AddVDiskRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.AddVDiskRequest) }},
+	{"dcom/vds/ivdsvdprovider/v0.AddVDiskResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.AddVDiskResponse) }},
+	{"dcom/vds/ivdsvdprovider/v0.CreateVDiskRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.CreateVDiskRequest) }},
+	{"dcom/vds/ivdsvdprovider/v0.CreateVDiskResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.CreateVDiskResponse) }},
+	{"dcom/vds/ivdsvdprovider/v0.GetDiskFromVDiskRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.GetDiskFromVDiskRequest) }},
+	{"dcom/vds/ivdsvdprovider/v0.GetDiskFromVDiskResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.GetDiskFromVDiskResponse) }},
+	{"dcom/vds/ivdsvdprovider/v0.GetVDiskFromDiskRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.GetVDiskFromDiskRequest) }},
+	{"dcom/vds/ivdsvdprovider/v0.GetVDiskFromDiskResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.GetVDiskFromDiskResponse) }},
+	{"dcom/vds/ivdsvdprovider/v0.QueryVDisksRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.QueryVDisksRequest) }},
+	{"dcom/vds/ivdsvdprovider/v0.QueryVDisksResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvdprovider_v0.QueryVDisksResponse) }},
+	{"dcom/vds/ivdsvolume/v0.AddPlexRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.AddPlexRequest) }},
+	{"dcom/vds/ivdsvolume/v0.AddPlexResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.AddPlexResponse) }},
+	{"dcom/vds/ivdsvolume/v0.BreakPlexRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.BreakPlexRequest) }},
+	{"dcom/vds/ivdsvolume/v0.BreakPlexResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.BreakPlexResponse) }},
+	{"dcom/vds/ivdsvolume/v0.ClearFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.ClearFlagsRequest) }},
+	{"dcom/vds/ivdsvolume/v0.ClearFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.ClearFlagsResponse) }},
+	{"dcom/vds/ivdsvolume/v0.DeleteRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.DeleteRequest) }},
+	{"dcom/vds/ivdsvolume/v0.DeleteResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.DeleteResponse) }},
+	{"dcom/vds/ivdsvolume/v0.ExtendRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.ExtendRequest) }},
+	{"dcom/vds/ivdsvolume/v0.ExtendResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.ExtendResponse) }},
+	{"dcom/vds/ivdsvolume/v0.GetPackRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.GetPackRequest) }},
+	{"dcom/vds/ivdsvolume/v0.GetPackResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.GetPackResponse) }},
+	{"dcom/vds/ivdsvolume/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsvolume/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsvolume/v0.QueryPlexesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.QueryPlexesRequest) }},
+	{"dcom/vds/ivdsvolume/v0.QueryPlexesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.QueryPlexesResponse) }},
+	{"dcom/vds/ivdsvolume/v0.RemovePlexRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.RemovePlexRequest) }},
+	{"dcom/vds/ivdsvolume/v0.RemovePlexResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.RemovePlexResponse) }},
+	{"dcom/vds/ivdsvolume/v0.SetFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.SetFlagsRequest) }},
+	{"dcom/vds/ivdsvolume/v0.SetFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.SetFlagsResponse) }},
+	{"dcom/vds/ivdsvolume/v0.ShrinkRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.ShrinkRequest) }},
+	{"dcom/vds/ivdsvolume/v0.ShrinkResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume_v0.ShrinkResponse) }},
+	{"dcom/vds/ivdsvolume2/v0.GetProperties2Request", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume2_v0.GetProperties2Request) }},
+	{"dcom/vds/ivdsvolume2/v0.GetProperties2Response", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolume2_v0.GetProperties2Response) }},
+	{"dcom/vds/ivdsvolumemf/v0.AddAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.AddAccessPathRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.AddAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.AddAccessPathResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.ClearFileSystemFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.ClearFileSystemFlagsRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.ClearFileSystemFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.ClearFileSystemFlagsResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.DeleteAccessPathRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.DeleteAccessPathRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.DeleteAccessPathResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.DeleteAccessPathResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.DismountRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.DismountRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.DismountResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.DismountResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.FormatRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.FormatRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.FormatResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.FormatResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.GetFileSystemPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.GetFileSystemPropertiesRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.GetFileSystemPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.GetFileSystemPropertiesResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.MountRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.MountRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.MountResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.MountResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.QueryAccessPathsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.QueryAccessPathsRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.QueryAccessPathsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.QueryAccessPathsResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.QueryReparsePointsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.QueryReparsePointsRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.QueryReparsePointsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.QueryReparsePointsResponse) }},
+	{"dcom/vds/ivdsvolumemf/v0.SetFileSystemFlagsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.SetFileSystemFlagsRequest) }},
+	{"dcom/vds/ivdsvolumemf/v0.SetFileSystemFlagsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf_v0.SetFileSystemFlagsResponse) }},
+	{"dcom/vds/ivdsvolumemf2/v0.FormatExRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf2_v0.FormatExRequest) }},
+	{"dcom/vds/ivdsvolumemf2/v0.FormatExResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf2_v0.FormatExResponse) }},
+	{"dcom/vds/ivdsvolumemf2/v0.GetFileSystemTypeNameRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf2_v0.GetFileSystemTypeNameRequest) }},
+	{"dcom/vds/ivdsvolumemf2/v0.GetFileSystemTypeNameResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf2_v0.GetFileSystemTypeNameResponse) }},
+	{"dcom/vds/ivdsvolumemf2/v0.QueryFileSystemFormatSupportRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf2_v0.QueryFileSystemFormatSupportRequest) }},
+	{"dcom/vds/ivdsvolumemf2/v0.QueryFileSystemFormatSupportResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf2_v0.QueryFileSystemFormatSupportResponse) }},
+	{"dcom/vds/ivdsvolumemf3/v0.FormatEx2Request", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf3_v0.FormatEx2Request) }},
+	{"dcom/vds/ivdsvolumemf3/v0.FormatEx2Response", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf3_v0.FormatEx2Response) }},
+	{"dcom/vds/ivdsvolumemf3/v0.OfflineVolumeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf3_v0.OfflineVolumeRequest) }},
+	{"dcom/vds/ivdsvolumemf3/v0.OfflineVolumeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf3_v0.OfflineVolumeResponse) }},
+	{"dcom/vds/ivdsvolumemf3/v0.QueryVolumeGUIDPathnamesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf3_v0.QueryVolumeGUIDPathnamesRequest) }},
+	{"dcom/vds/ivdsvolumemf3/v0.QueryVolumeGUIDPathnamesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumemf3_v0.QueryVolumeGUIDPathnamesResponse) }},
+	{"dcom/vds/ivdsvolumeonline/v0.OnlineRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeonline_v0.OnlineRequest) }},
+	{"dcom/vds/ivdsvolumeonline/v0.OnlineResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeonline_v0.OnlineResponse) }},
+	{"dcom/vds/ivdsvolumeplex/v0.GetPropertiesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.GetPropertiesRequest) }},
+	{"dcom/vds/ivdsvolumeplex/v0.GetPropertiesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.GetPropertiesResponse) }},
+	{"dcom/vds/ivdsvolumeplex/v0.GetVolumeRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.GetVolumeRequest) }},
+	{"dcom/vds/ivdsvolumeplex/v0.GetVolumeResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.GetVolumeResponse) }},
+	{"dcom/vds/ivdsvolumeplex/v0.QueryExtentsRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.QueryExtentsRequest) }},
+	{"dcom/vds/ivdsvolumeplex/v0.QueryExtentsResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.QueryExtentsResponse) }},
+	{"dcom/vds/ivdsvolumeplex/v0.RepairRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.RepairRequest) }},
+	{"dcom/vds/ivdsvolumeplex/v0.RepairResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeplex_v0.RepairResponse) }},
+	{"dcom/vds/ivdsvolumeshrink/v0.QueryMaxReclaimableBytesRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeshrink_v0.QueryMaxReclaimableBytesRequest) }},
+	{"dcom/vds/ivdsvolumeshrink/v0.QueryMaxReclaimableBytesResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeshrink_v0.QueryMaxReclaimableBytesResponse) }},
+	{"dcom/vds/ivdsvolumeshrink/v0.ShrinkRequest", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeshrink_v0.ShrinkRequest) }},
+	{"dcom/vds/ivdsvolumeshrink/v0.ShrinkResponse", func() ndr.Unmarshaler { return new(dcom_vds_ivdsvolumeshrink_v0.ShrinkResponse) }},
+	{"dcom/wcce/icertrequestd/v0.GetCACertRequest", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd_v0.GetCACertRequest) }},
+	{"dcom/wcce/icertrequestd/v0.GetCACertResponse", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd_v0.GetCACertResponse) }},
+	{"dcom/wcce/icertrequestd/v0.PingRequest", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd_v0.PingRequest) }},
+	{"dcom/wcce/icertrequestd/v0.PingResponse", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd_v0.PingResponse) }},
+	{"dcom/wcce/icertrequestd/v0.RequestRequest", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd_v0.RequestRequest) }},
+	{"dcom/wcce/icertrequestd/v0.RequestResponse", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd_v0.RequestResponse) }},
+	{"dcom/wcce/icertrequestd2/v0.GetCAPropertyInfoRequest", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.GetCAPropertyInfoRequest) }},
+	{"dcom/wcce/icertrequestd2/v0.GetCAPropertyInfoResponse", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.GetCAPropertyInfoResponse) }},
+	{"dcom/wcce/icertrequestd2/v0.GetCAPropertyRequest", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.GetCAPropertyRequest) }},
+	{"dcom/wcce/icertrequestd2/v0.GetCAPropertyResponse", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.GetCAPropertyResponse) }},
+	{"dcom/wcce/icertrequestd2/v0.Ping2Request", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.Ping2Request) }},
+	{"dcom/wcce/icertrequestd2/v0.Ping2Response", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.Ping2Response) }},
+	{"dcom/wcce/icertrequestd2/v0.Request2Request", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.Request2Request) }},
+	{"dcom/wcce/icertrequestd2/v0.Request2Response", func() ndr.Unmarshaler { return new(dcom_wcce_icertrequestd2_v0.Request2Response) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.CloneRequest", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.CloneRequest) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.CloneResponse", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.CloneResponse) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.NextAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.NextAsyncRequest) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.NextAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.NextAsyncResponse) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.NextRequest", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.NextRequest) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.NextResponse", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.NextResponse) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.ResetRequest", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.ResetRequest) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.ResetResponse", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.ResetResponse) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.SkipRequest", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.SkipRequest) }},
+	{"dcom/wmi/ienumwbemclassobject/v0.SkipResponse", func() ndr.Unmarshaler { return new(dcom_wmi_ienumwbemclassobject_v0.SkipResponse) }},
+	{"dcom/wmi/iwbembackuprestore/v0.BackupRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestore_v0.BackupRequest) }},
+	{"dcom/wmi/iwbembackuprestore/v0.BackupResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestore_v0.BackupResponse) }},
+	{"dcom/wmi/iwbembackuprestore/v0.RestoreRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestore_v0.RestoreRequest) }},
+	{"dcom/wmi/iwbembackuprestore/v0.RestoreResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestore_v0.RestoreResponse) }},
+	{"dcom/wmi/iwbembackuprestoreex/v0.PauseRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestoreex_v0.PauseRequest) }},
+	{"dcom/wmi/iwbembackuprestoreex/v0.PauseResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestoreex_v0.PauseResponse) }},
+	{"dcom/wmi/iwbembackuprestoreex/v0.ResumeRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestoreex_v0.ResumeRequest) }},
+	{"dcom/wmi/iwbembackuprestoreex/v0.ResumeResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbembackuprestoreex_v0.ResumeResponse) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetCallStatusRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetCallStatusRequest) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetCallStatusResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetCallStatusResponse) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetResultObjectRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetResultObjectRequest) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetResultObjectResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetResultObjectResponse) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetResultServicesRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetResultServicesRequest) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetResultServicesResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetResultServicesResponse) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetResultStringRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetResultStringRequest) }},
+	{"dcom/wmi/iwbemcallresult/v0.GetResultStringResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemcallresult_v0.GetResultStringResponse) }},
+	{"dcom/wmi/iwbemfetchsmartenum/v0.GetSmartEnumRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemfetchsmartenum_v0.GetSmartEnumRequest) }},
+	{"dcom/wmi/iwbemfetchsmartenum/v0.GetSmartEnumResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemfetchsmartenum_v0.GetSmartEnumResponse) }},
+	{"dcom/wmi/iwbemlevel1login/v0.EstablishPositionRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.EstablishPositionRequest) }},
+	{"dcom/wmi/iwbemlevel1login/v0.EstablishPositionResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.EstablishPositionResponse) }},
+	{"dcom/wmi/iwbemlevel1login/v0.NTLMLoginRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.NTLMLoginRequest) }},
+	{"dcom/wmi/iwbemlevel1login/v0.NTLMLoginResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.NTLMLoginResponse) }},
+	{"dcom/wmi/iwbemlevel1login/v0.RequestChallengeRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.RequestChallengeRequest) }},
+	{"dcom/wmi/iwbemlevel1login/v0.RequestChallengeResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.RequestChallengeResponse) }},
+	{"dcom/wmi/iwbemlevel1login/v0.WBEMLoginRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.WBEMLoginRequest) }},
+	{"dcom/wmi/iwbemlevel1login/v0.WBEMLoginResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemlevel1login_v0.WBEMLoginResponse) }},
+	{"dcom/wmi/iwbemloginclientid/v0.SetClientInfoRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemloginclientid_v0.SetClientInfoRequest) }},
+	{"dcom/wmi/iwbemloginclientid/v0.SetClientInfoResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemloginclientid_v0.SetClientInfoResponse) }},
+	{"dcom/wmi/iwbemloginhelper/v0.SetEventRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemloginhelper_v0.SetEventRequest) }},
+	{"dcom/wmi/iwbemloginhelper/v0.SetEventResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemloginhelper_v0.SetEventResponse) }},
+	{"dcom/wmi/iwbemobjectsink/v0.IndicateRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemobjectsink_v0.IndicateRequest) }},
+	{"dcom/wmi/iwbemobjectsink/v0.IndicateResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemobjectsink_v0.IndicateResponse) }},
+	{"dcom/wmi/iwbemobjectsink/v0.SetStatusRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemobjectsink_v0.SetStatusRequest) }},
+	{"dcom/wmi/iwbemobjectsink/v0.SetStatusResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemobjectsink_v0.SetStatusResponse) }},
+	{"dcom/wmi/iwbemrefreshingservices/v0.AddEnumToRefresherRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemrefreshingservices_v0.AddEnumToRefresherRequest) }},
+	{"dcom/wmi/iwbemrefreshingservices/v0.AddEnumToRefresherResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemrefreshingservices_v0.AddEnumToRefresherResponse) }},
+	{"dcom/wmi/iwbemrefreshingservices/v0.AddObjectToRefresherByTemplateRequest", func() ndr.Unmarshaler {
+		return new(dcom_wmi_iwbemrefreshingservices_v0.AddObjectToRefresherByTemplateRequest)
+	}},
+	{"dcom/wmi/iwbemrefreshingservices/v0.AddObjectToRefresherByTemplateResponse", func() ndr.Unmarshaler {
+		return new(dcom_wmi_iwbemrefreshingservices_v0.AddObjectToRefresherByTemplateResponse)
+	}},
+	{"dcom/wmi/iwbemrefreshingservices/v0.AddObjectToRefresherRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemrefreshingservices_v0.AddObjectToRefresherRequest) }},
+	{"dcom/wmi/iwbemrefreshingservices/v0.AddObjectToRefresherResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemrefreshingservices_v0.AddObjectToRefresherResponse) }},
+	{"dcom/wmi/iwbemrefreshingservices/v0.GetRemoteRefresherRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemrefreshingservices_v0.GetRemoteRefresherRequest) }},
+	{"dcom/wmi/iwbemrefreshingservices/v0.GetRemoteRefresherResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemrefreshingservices_v0.GetRemoteRefresherResponse) }},
+	{"dcom/wmi/iwbemrefreshingservices/v0.ReconnectRemoteRefresherRequest", func() ndr.Unmarshaler {
+		return new(dcom_wmi_iwbemrefreshingservices_v0.ReconnectRemoteRefresherRequest)
+	}},
+	{"dcom/wmi/iwbemrefreshingservices/v0.ReconnectRemoteRefresherResponse", func() ndr.Unmarshaler {
+		return new(dcom_wmi_iwbemrefreshingservices_v0.ReconnectRemoteRefresherResponse)
+	}},
+	{"dcom/wmi/iwbemrefreshingservices/v0.RemoveObjectFromRefresherRequest", func() ndr.Unmarshaler {
+		return new(dcom_wmi_iwbemrefreshingservices_v0.RemoveObjectFromRefresherRequest)
+	}},
+	{"dcom/wmi/iwbemrefreshingservices/v0.RemoveObjectFromRefresherResponse", func() ndr.Unmarshaler {
+		return new(dcom_wmi_iwbemrefreshingservices_v0.RemoveObjectFromRefresherResponse)
+	}},
+	{"dcom/wmi/iwbemremoterefresher/v0.RemoteRefreshRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemremoterefresher_v0.RemoteRefreshRequest) }},
+	{"dcom/wmi/iwbemremoterefresher/v0.RemoteRefreshResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemremoterefresher_v0.RemoteRefreshResponse) }},
+	{"dcom/wmi/iwbemremoterefresher/v0.StopRefreshingRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemremoterefresher_v0.StopRefreshingRequest) }},
+	{"dcom/wmi/iwbemremoterefresher/v0.StopRefreshingResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemremoterefresher_v0.StopRefreshingResponse) }},
+	{"dcom/wmi/iwbemservices/v0.CancelAsyncCallRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CancelAsyncCallRequest) }},
+	{"dcom/wmi/iwbemservices/v0.CancelAsyncCallResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CancelAsyncCallResponse) }},
+	{"dcom/wmi/iwbemservices/v0.CreateClassEnumAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateClassEnumAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.CreateClassEnumAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateClassEnumAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.CreateClassEnumRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateClassEnumRequest) }},
+	{"dcom/wmi/iwbemservices/v0.CreateClassEnumResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateClassEnumResponse) }},
+	{"dcom/wmi/iwbemservices/v0.CreateInstanceEnumAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateInstanceEnumAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.CreateInstanceEnumAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateInstanceEnumAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.CreateInstanceEnumRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateInstanceEnumRequest) }},
+	{"dcom/wmi/iwbemservices/v0.CreateInstanceEnumResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.CreateInstanceEnumResponse) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteClassAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteClassAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteClassAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteClassAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteClassRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteClassRequest) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteClassResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteClassResponse) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteInstanceAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteInstanceAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteInstanceAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteInstanceAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteInstanceRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteInstanceRequest) }},
+	{"dcom/wmi/iwbemservices/v0.DeleteInstanceResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.DeleteInstanceResponse) }},
+	{"dcom/wmi/iwbemservices/v0.ExecMethodAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecMethodAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.ExecMethodAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecMethodAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.ExecMethodRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecMethodRequest) }},
+	{"dcom/wmi/iwbemservices/v0.ExecMethodResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecMethodResponse) }},
+	{"dcom/wmi/iwbemservices/v0.ExecNotificationQueryAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecNotificationQueryAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.ExecNotificationQueryAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecNotificationQueryAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.ExecNotificationQueryRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecNotificationQueryRequest) }},
+	{"dcom/wmi/iwbemservices/v0.ExecNotificationQueryResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecNotificationQueryResponse) }},
+	{"dcom/wmi/iwbemservices/v0.ExecQueryAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecQueryAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.ExecQueryAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecQueryAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.ExecQueryRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecQueryRequest) }},
+	{"dcom/wmi/iwbemservices/v0.ExecQueryResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.ExecQueryResponse) }},
+	{"dcom/wmi/iwbemservices/v0.GetObjectAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.GetObjectAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.GetObjectAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.GetObjectAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.GetObjectRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.GetObjectRequest) }},
+	{"dcom/wmi/iwbemservices/v0.GetObjectResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.GetObjectResponse) }},
+	{"dcom/wmi/iwbemservices/v0.OpenNamespaceRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.OpenNamespaceRequest) }},
+	{"dcom/wmi/iwbemservices/v0.OpenNamespaceResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.OpenNamespaceResponse) }},
+	{"dcom/wmi/iwbemservices/v0.PutClassAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutClassAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.PutClassAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutClassAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.PutClassRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutClassRequest) }},
+	{"dcom/wmi/iwbemservices/v0.PutClassResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutClassResponse) }},
+	{"dcom/wmi/iwbemservices/v0.PutInstanceAsyncRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutInstanceAsyncRequest) }},
+	{"dcom/wmi/iwbemservices/v0.PutInstanceAsyncResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutInstanceAsyncResponse) }},
+	{"dcom/wmi/iwbemservices/v0.PutInstanceRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutInstanceRequest) }},
+	{"dcom/wmi/iwbemservices/v0.PutInstanceResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.PutInstanceResponse) }},
+	{"dcom/wmi/iwbemservices/v0.QueryObjectSinkRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.QueryObjectSinkRequest) }},
+	{"dcom/wmi/iwbemservices/v0.QueryObjectSinkResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemservices_v0.QueryObjectSinkResponse) }},
+	{"dcom/wmi/iwbemwcosmartenum/v0.NextRequest", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemwcosmartenum_v0.NextRequest) }},
+	{"dcom/wmi/iwbemwcosmartenum/v0.NextResponse", func() ndr.Unmarshaler { return new(dcom_wmi_iwbemwcosmartenum_v0.NextResponse) }},
+	{"dfsnm/netdfs/v3.Add2Request", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.Add2Request) }},
+	{"dfsnm/netdfs/v3.Add2Response", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.Add2Response) }},
+	{"dfsnm/netdfs/v3.AddFTRootRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddFTRootRequest) }},
+	{"dfsnm/netdfs/v3.AddFTRootResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddFTRootResponse) }},
+	{"dfsnm/netdfs/v3.AddRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddRequest) }},
+	{"dfsnm/netdfs/v3.AddResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddResponse) }},
+	{"dfsnm/netdfs/v3.AddRootTargetRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddRootTargetRequest) }},
+	{"dfsnm/netdfs/v3.AddRootTargetResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddRootTargetResponse) }},
+	{"dfsnm/netdfs/v3.AddStdRootForcedRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddStdRootForcedRequest) }},
+	{"dfsnm/netdfs/v3.AddStdRootForcedResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddStdRootForcedResponse) }},
+	{"dfsnm/netdfs/v3.AddStdRootRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddStdRootRequest) }},
+	{"dfsnm/netdfs/v3.AddStdRootResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.AddStdRootResponse) }},
+	{"dfsnm/netdfs/v3.EnumExRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.EnumExRequest) }},
+	{"dfsnm/netdfs/v3.EnumExResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.EnumExResponse) }},
+	{"dfsnm/netdfs/v3.EnumRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.EnumRequest) }},
+	{"dfsnm/netdfs/v3.EnumResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.EnumResponse) }},
+	{"dfsnm/netdfs/v3.FlushFTTableRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.FlushFTTableRequest) }},
+	{"dfsnm/netdfs/v3.FlushFTTableResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.FlushFTTableResponse) }},
+	{"dfsnm/netdfs/v3.GetDCAddressRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.GetDCAddressRequest) }},
+	{"dfsnm/netdfs/v3.GetDCAddressResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.GetDCAddressResponse) }},
+	{"dfsnm/netdfs/v3.GetInfoRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.GetInfoRequest) }},
+	{"dfsnm/netdfs/v3.GetInfoResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.GetInfoResponse) }},
+	{"dfsnm/netdfs/v3.GetSupportedNamespaceVersionRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.GetSupportedNamespaceVersionRequest) }},
+	{"dfsnm/netdfs/v3.GetSupportedNamespaceVersionResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.GetSupportedNamespaceVersionResponse) }},
+	{"dfsnm/netdfs/v3.ManagerGetVersionRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.ManagerGetVersionRequest) }},
+	{"dfsnm/netdfs/v3.ManagerGetVersionResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.ManagerGetVersionResponse) }},
+	{"dfsnm/netdfs/v3.ManagerInitializeRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.ManagerInitializeRequest) }},
+	{"dfsnm/netdfs/v3.ManagerInitializeResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.ManagerInitializeResponse) }},
+	{"dfsnm/netdfs/v3.MoveRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.MoveRequest) }},
+	{"dfsnm/netdfs/v3.MoveResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.MoveResponse) }},
+	{"dfsnm/netdfs/v3.Remove2Request", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.Remove2Request) }},
+	{"dfsnm/netdfs/v3.Remove2Response", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.Remove2Response) }},
+	{"dfsnm/netdfs/v3.RemoveFTRootRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveFTRootRequest) }},
+	{"dfsnm/netdfs/v3.RemoveFTRootResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveFTRootResponse) }},
+	{"dfsnm/netdfs/v3.RemoveRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveRequest) }},
+	{"dfsnm/netdfs/v3.RemoveResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveResponse) }},
+	{"dfsnm/netdfs/v3.RemoveRootTargetRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveRootTargetRequest) }},
+	{"dfsnm/netdfs/v3.RemoveRootTargetResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveRootTargetResponse) }},
+	{"dfsnm/netdfs/v3.RemoveStdRootRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveStdRootRequest) }},
+	{"dfsnm/netdfs/v3.RemoveStdRootResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.RemoveStdRootResponse) }},
+	{"dfsnm/netdfs/v3.SetDCAddressRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.SetDCAddressRequest) }},
+	{"dfsnm/netdfs/v3.SetDCAddressResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.SetDCAddressResponse) }},
+	{"dfsnm/netdfs/v3.SetInfo2Request", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.SetInfo2Request) }},
+	{"dfsnm/netdfs/v3.SetInfo2Response", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.SetInfo2Response) }},
+	{"dfsnm/netdfs/v3.SetInfoRequest", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.SetInfoRequest) }},
+	{"dfsnm/netdfs/v3.SetInfoResponse", func() ndr.Unmarshaler { return new(dfsnm_netdfs_v3.SetInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.AddSubnetElementRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.AddSubnetElementRequest) }},
+	{"dhcpm/dhcpsrv/v1.AddSubnetElementResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.AddSubnetElementResponse) }},
+	{"dhcpm/dhcpsrv/v1.AddSubnetElementV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.AddSubnetElementV4Request) }},
+	{"dhcpm/dhcpsrv/v1.AddSubnetElementV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.AddSubnetElementV4Response) }},
+	{"dhcpm/dhcpsrv/v1.CreateClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateClientInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.CreateClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateClientInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.CreateClientInfoV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateClientInfoV4Request) }},
+	{"dhcpm/dhcpsrv/v1.CreateClientInfoV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateClientInfoV4Response) }},
+	{"dhcpm/dhcpsrv/v1.CreateClientInfoVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateClientInfoVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.CreateClientInfoVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateClientInfoVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.CreateOptionRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateOptionRequest) }},
+	{"dhcpm/dhcpsrv/v1.CreateOptionResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateOptionResponse) }},
+	{"dhcpm/dhcpsrv/v1.CreateSubnetRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateSubnetRequest) }},
+	{"dhcpm/dhcpsrv/v1.CreateSubnetResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateSubnetResponse) }},
+	{"dhcpm/dhcpsrv/v1.CreateSubnetVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateSubnetVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.CreateSubnetVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.CreateSubnetVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.DeleteClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.DeleteClientInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.DeleteClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.DeleteClientInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.DeleteSubnetRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.DeleteSubnetRequest) }},
+	{"dhcpm/dhcpsrv/v1.DeleteSubnetResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.DeleteSubnetResponse) }},
+	{"dhcpm/dhcpsrv/v1.DeleteSuperScopeV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.DeleteSuperScopeV4Request) }},
+	{"dhcpm/dhcpsrv/v1.DeleteSuperScopeV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.DeleteSuperScopeV4Response) }},
+	{"dhcpm/dhcpsrv/v1.EnumOptionValuesRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumOptionValuesRequest) }},
+	{"dhcpm/dhcpsrv/v1.EnumOptionValuesResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumOptionValuesResponse) }},
+	{"dhcpm/dhcpsrv/v1.EnumOptionsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumOptionsRequest) }},
+	{"dhcpm/dhcpsrv/v1.EnumOptionsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumOptionsResponse) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetClientsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetClientsRequest) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetClientsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetClientsResponse) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetClientsV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetClientsV4Request) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetClientsV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetClientsV4Response) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetClientsVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetClientsVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetClientsVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetClientsVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetElementsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetElementsRequest) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetElementsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetElementsResponse) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetElementsV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetElementsV4Request) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetElementsV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetElementsV4Response) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetsRequest) }},
+	{"dhcpm/dhcpsrv/v1.EnumSubnetsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.EnumSubnetsResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetClientInfoV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientInfoV4Request) }},
+	{"dhcpm/dhcpsrv/v1.GetClientInfoV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientInfoV4Response) }},
+	{"dhcpm/dhcpsrv/v1.GetClientInfoVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientInfoVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetClientInfoVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientInfoVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetClientOptionsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientOptionsRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetClientOptionsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetClientOptionsResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetMIBInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetMIBInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetMIBInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetMIBInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetMIBInfoVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetMIBInfoVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetMIBInfoVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetMIBInfoVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetOptionInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetOptionInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetOptionInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetOptionInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetOptionValueRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetOptionValueRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetOptionValueResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetOptionValueResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetSubnetInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetSubnetInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetSubnetInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetSubnetInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetSubnetInfoVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetSubnetInfoVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetSubnetInfoVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetSubnetInfoVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.GetSuperScopeInfoV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetSuperScopeInfoV4Request) }},
+	{"dhcpm/dhcpsrv/v1.GetSuperScopeInfoV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetSuperScopeInfoV4Response) }},
+	{"dhcpm/dhcpsrv/v1.GetVersionRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetVersionRequest) }},
+	{"dhcpm/dhcpsrv/v1.GetVersionResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.GetVersionResponse) }},
+	{"dhcpm/dhcpsrv/v1.RemoveOptionRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveOptionRequest) }},
+	{"dhcpm/dhcpsrv/v1.RemoveOptionResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveOptionResponse) }},
+	{"dhcpm/dhcpsrv/v1.RemoveOptionValueRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveOptionValueRequest) }},
+	{"dhcpm/dhcpsrv/v1.RemoveOptionValueResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveOptionValueResponse) }},
+	{"dhcpm/dhcpsrv/v1.RemoveSubnetElementRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveSubnetElementRequest) }},
+	{"dhcpm/dhcpsrv/v1.RemoveSubnetElementResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveSubnetElementResponse) }},
+	{"dhcpm/dhcpsrv/v1.RemoveSubnetElementV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveSubnetElementV4Request) }},
+	{"dhcpm/dhcpsrv/v1.RemoveSubnetElementV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.RemoveSubnetElementV4Response) }},
+	{"dhcpm/dhcpsrv/v1.ScanDatabaseRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ScanDatabaseRequest) }},
+	{"dhcpm/dhcpsrv/v1.ScanDatabaseResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ScanDatabaseResponse) }},
+	{"dhcpm/dhcpsrv/v1.ServerGetConfigRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerGetConfigRequest) }},
+	{"dhcpm/dhcpsrv/v1.ServerGetConfigResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerGetConfigResponse) }},
+	{"dhcpm/dhcpsrv/v1.ServerGetConfigV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerGetConfigV4Request) }},
+	{"dhcpm/dhcpsrv/v1.ServerGetConfigV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerGetConfigV4Response) }},
+	{"dhcpm/dhcpsrv/v1.ServerGetConfigVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerGetConfigVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.ServerGetConfigVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerGetConfigVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.ServerSetConfigRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerSetConfigRequest) }},
+	{"dhcpm/dhcpsrv/v1.ServerSetConfigResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerSetConfigResponse) }},
+	{"dhcpm/dhcpsrv/v1.ServerSetConfigV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerSetConfigV4Request) }},
+	{"dhcpm/dhcpsrv/v1.ServerSetConfigV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerSetConfigV4Response) }},
+	{"dhcpm/dhcpsrv/v1.ServerSetConfigVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerSetConfigVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.ServerSetConfigVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.ServerSetConfigVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetClientInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.SetClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetClientInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetClientInfoV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetClientInfoV4Request) }},
+	{"dhcpm/dhcpsrv/v1.SetClientInfoV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetClientInfoV4Response) }},
+	{"dhcpm/dhcpsrv/v1.SetClientInfoVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetClientInfoVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.SetClientInfoVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetClientInfoVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetOptionInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetOptionInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.SetOptionInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetOptionInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetOptionValueRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetOptionValueRequest) }},
+	{"dhcpm/dhcpsrv/v1.SetOptionValueResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetOptionValueResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetOptionValuesRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetOptionValuesRequest) }},
+	{"dhcpm/dhcpsrv/v1.SetOptionValuesResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetOptionValuesResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetSubnetInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetSubnetInfoRequest) }},
+	{"dhcpm/dhcpsrv/v1.SetSubnetInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetSubnetInfoResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetSubnetInfoVQRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetSubnetInfoVQRequest) }},
+	{"dhcpm/dhcpsrv/v1.SetSubnetInfoVQResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetSubnetInfoVQResponse) }},
+	{"dhcpm/dhcpsrv/v1.SetSuperScopeV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetSuperScopeV4Request) }},
+	{"dhcpm/dhcpsrv/v1.SetSuperScopeV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv_v1.SetSuperScopeV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.AddFilterV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddFilterV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.AddFilterV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddFilterV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.AddMScopeElementRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddMScopeElementRequest) }},
+	{"dhcpm/dhcpsrv2/v1.AddMScopeElementResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddMScopeElementResponse) }},
+	{"dhcpm/dhcpsrv2/v1.AddPolicyRangeV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddPolicyRangeV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.AddPolicyRangeV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddPolicyRangeV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.AddSubnetElementV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddSubnetElementV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.AddSubnetElementV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddSubnetElementV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.AddSubnetElementV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddSubnetElementV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.AddSubnetElementV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AddSubnetElementV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.AuditLogGetParamsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AuditLogGetParamsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.AuditLogGetParamsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AuditLogGetParamsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.AuditLogSetParamsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AuditLogSetParamsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.AuditLogSetParamsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.AuditLogSetParamsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.BackupDatabaseRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.BackupDatabaseRequest) }},
+	{"dhcpm/dhcpsrv2/v1.BackupDatabaseResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.BackupDatabaseResponse) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClassRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClassRequest) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClassResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClassResponse) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClassV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClassV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClassV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClassV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClientInfoExV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClientInfoExV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClientInfoExV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClientInfoExV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClientInfoV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClientInfoV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClientInfoV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClientInfoV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClientInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClientInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreateClientInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateClientInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreateMClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateMClientInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.CreateMClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateMClientInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.CreateOptionV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateOptionV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreateOptionV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateOptionV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreateOptionV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateOptionV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreateOptionV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateOptionV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreatePolicyExV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreatePolicyExV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreatePolicyExV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreatePolicyExV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreatePolicyV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreatePolicyV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreatePolicyV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreatePolicyV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.CreateSubnetV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateSubnetV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.CreateSubnetV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.CreateSubnetV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteClassRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteClassRequest) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteClassResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteClassResponse) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteClassV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteClassV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteClassV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteClassV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteClientInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteClientInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteClientInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteClientInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteFilterV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteFilterV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteFilterV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteFilterV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteMClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteMClientInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteMClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteMClientInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteMScopeRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteMScopeRequest) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteMScopeResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteMScopeResponse) }},
+	{"dhcpm/dhcpsrv2/v1.DeletePolicyV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeletePolicyV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.DeletePolicyV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeletePolicyV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteSubnetV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteSubnetV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.DeleteSubnetV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.DeleteSubnetV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumClassesRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumClassesRequest) }},
+	{"dhcpm/dhcpsrv2/v1.EnumClassesResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumClassesResponse) }},
+	{"dhcpm/dhcpsrv2/v1.EnumClassesV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumClassesV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumClassesV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumClassesV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumFilterV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumFilterV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumFilterV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumFilterV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumMScopeClientsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumMScopeClientsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.EnumMScopeClientsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumMScopeClientsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.EnumMScopeElementsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumMScopeElementsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.EnumMScopeElementsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumMScopeElementsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.EnumMScopesRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumMScopesRequest) }},
+	{"dhcpm/dhcpsrv2/v1.EnumMScopesResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumMScopesResponse) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionValuesV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionValuesV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionValuesV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionValuesV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionValuesV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionValuesV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionValuesV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionValuesV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionsV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionsV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionsV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionsV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumOptionsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumOptionsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumPoliciesExV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumPoliciesExV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumPoliciesExV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumPoliciesExV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumPoliciesV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumPoliciesV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumPoliciesV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumPoliciesV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsExV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsExV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsExV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsExV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsFilterStatusInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsFilterStatusInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsFilterStatusInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsFilterStatusInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetClientsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetClientsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetElementsV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetElementsV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetElementsV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetElementsV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetElementsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetElementsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetElementsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetElementsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetReservationsV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetReservationsV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetReservationsV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetReservationsV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.EnumSubnetsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.EnumSubnetsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverAddScopeToRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverAddScopeToRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverAddScopeToRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverAddScopeToRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverCreateRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverCreateRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverCreateRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverCreateRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverDeleteRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverDeleteRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverDeleteRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverDeleteRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverDeleteScopeFromRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverDeleteScopeFromRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverDeleteScopeFromRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverDeleteScopeFromRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverEnumRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverEnumRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverEnumRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverEnumRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetAddressStatusV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetAddressStatusV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetAddressStatusV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetAddressStatusV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetClientInfoV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetClientInfoV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetClientInfoV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetClientInfoV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetScopeRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetScopeRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetScopeRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetScopeRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetScopeStatisticsV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetScopeStatisticsV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetScopeStatisticsV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetScopeStatisticsV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetSystemTimeV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetSystemTimeV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverGetSystemTimeV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverGetSystemTimeV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverSetRelationshipV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverSetRelationshipV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverSetRelationshipV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverSetRelationshipV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverTriggerAddrAllocationV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverTriggerAddrAllocationV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.FailoverTriggerAddrAllocationV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.FailoverTriggerAddrAllocationV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionValuesRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionValuesRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionValuesResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionValuesResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionValuesV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionValuesV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionValuesV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionValuesV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionValuesV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionValuesV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionValuesV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionValuesV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetAllOptionsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetAllOptionsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetClassInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClassInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetClassInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClassInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetClientInfoExV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClientInfoExV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetClientInfoExV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClientInfoExV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetClientInfoV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClientInfoV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetClientInfoV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClientInfoV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetClientInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClientInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetClientInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetClientInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetFilterV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetFilterV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetFilterV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetFilterV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetFreeIPAddressV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetFreeIPAddressV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetFreeIPAddressV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetFreeIPAddressV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetFreeIPAddressV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetFreeIPAddressV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetFreeIPAddressV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetFreeIPAddressV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetMCastMIBInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMCastMIBInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetMCastMIBInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMCastMIBInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetMClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMClientInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetMClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMClientInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetMIBInfoV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMIBInfoV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetMIBInfoV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMIBInfoV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetMIBInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMIBInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetMIBInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMIBInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetMScopeInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMScopeInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetMScopeInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetMScopeInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionInfoV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionInfoV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionInfoV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionInfoV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionValueV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionValueV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionValueV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionValueV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionValueV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionValueV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionValueV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionValueV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionValueV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionValueV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetOptionValueV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetOptionValueV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetPolicyExV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetPolicyExV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetPolicyExV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetPolicyExV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetPolicyV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetPolicyV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetPolicyV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetPolicyV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetServerBindingInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetServerBindingInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetServerBindingInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetServerBindingInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetServerBindingInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetServerBindingInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetServerBindingInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetServerBindingInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetServerSpecificStringsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetServerSpecificStringsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetServerSpecificStringsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetServerSpecificStringsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetStatelessStatisticsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetStatelessStatisticsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetStatelessStatisticsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetStatelessStatisticsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetStatelessStoreParamsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetStatelessStoreParamsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetStatelessStoreParamsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetStatelessStoreParamsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.GetSubnetDelayOfferRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetSubnetDelayOfferRequest) }},
+	{"dhcpm/dhcpsrv2/v1.GetSubnetDelayOfferResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetSubnetDelayOfferResponse) }},
+	{"dhcpm/dhcpsrv2/v1.GetSubnetInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetSubnetInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.GetSubnetInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.GetSubnetInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.ModifyClassRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ModifyClassRequest) }},
+	{"dhcpm/dhcpsrv2/v1.ModifyClassResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ModifyClassResponse) }},
+	{"dhcpm/dhcpsrv2/v1.ModifyClassV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ModifyClassV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.ModifyClassV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ModifyClassV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.QueryDNSRegCredentialsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.QueryDNSRegCredentialsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.QueryDNSRegCredentialsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.QueryDNSRegCredentialsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.QueryPolicyEnforcementV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.QueryPolicyEnforcementV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.QueryPolicyEnforcementV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.QueryPolicyEnforcementV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveMScopeElementRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveMScopeElementRequest) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveMScopeElementResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveMScopeElementResponse) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionValueV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionValueV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionValueV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionValueV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionValueV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionValueV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionValueV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionValueV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionValueV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionValueV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveOptionValueV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveOptionValueV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemovePolicyRangeV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemovePolicyRangeV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemovePolicyRangeV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemovePolicyRangeV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveSubnetElementV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveSubnetElementV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveSubnetElementV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveSubnetElementV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveSubnetElementV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveSubnetElementV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.RemoveSubnetElementV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RemoveSubnetElementV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.RestoreDatabaseRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RestoreDatabaseRequest) }},
+	{"dhcpm/dhcpsrv2/v1.RestoreDatabaseResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.RestoreDatabaseResponse) }},
+	{"dhcpm/dhcpsrv2/v1.ScanMDatabaseRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ScanMDatabaseRequest) }},
+	{"dhcpm/dhcpsrv2/v1.ScanMDatabaseResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ScanMDatabaseResponse) }},
+	{"dhcpm/dhcpsrv2/v1.ServerGetConfigV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerGetConfigV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.ServerGetConfigV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerGetConfigV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.ServerQueryAttributeRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerQueryAttributeRequest) }},
+	{"dhcpm/dhcpsrv2/v1.ServerQueryAttributeResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerQueryAttributeResponse) }},
+	{"dhcpm/dhcpsrv2/v1.ServerQueryAttributesRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerQueryAttributesRequest) }},
+	{"dhcpm/dhcpsrv2/v1.ServerQueryAttributesResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerQueryAttributesResponse) }},
+	{"dhcpm/dhcpsrv2/v1.ServerRedoAuthorizationRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerRedoAuthorizationRequest) }},
+	{"dhcpm/dhcpsrv2/v1.ServerRedoAuthorizationResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerRedoAuthorizationResponse) }},
+	{"dhcpm/dhcpsrv2/v1.ServerSetConfigV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerSetConfigV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.ServerSetConfigV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.ServerSetConfigV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetClientInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetClientInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetClientInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetClientInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetDNSRegCredentialsRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetDNSRegCredentialsRequest) }},
+	{"dhcpm/dhcpsrv2/v1.SetDNSRegCredentialsResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetDNSRegCredentialsResponse) }},
+	{"dhcpm/dhcpsrv2/v1.SetDNSRegCredentialsV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetDNSRegCredentialsV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetDNSRegCredentialsV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetDNSRegCredentialsV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetFilterV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetFilterV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetFilterV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetFilterV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetMClientInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetMClientInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.SetMClientInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetMClientInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.SetMScopeInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetMScopeInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.SetMScopeInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetMScopeInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionInfoV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionInfoV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionInfoV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionInfoV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValueV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValueV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValueV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValueV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValueV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValueV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValueV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValueV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValueV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValueV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValueV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValueV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValuesV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValuesV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValuesV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValuesV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValuesV5Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValuesV5Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetOptionValuesV5Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetOptionValuesV5Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetPolicyEnforcementV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetPolicyEnforcementV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetPolicyEnforcementV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetPolicyEnforcementV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetPolicyExV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetPolicyExV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetPolicyExV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetPolicyExV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetPolicyV4Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetPolicyV4Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetPolicyV4Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetPolicyV4Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetServerBindingInfoRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetServerBindingInfoRequest) }},
+	{"dhcpm/dhcpsrv2/v1.SetServerBindingInfoResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetServerBindingInfoResponse) }},
+	{"dhcpm/dhcpsrv2/v1.SetServerBindingInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetServerBindingInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetServerBindingInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetServerBindingInfoV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetStatelessStoreParamsV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetStatelessStoreParamsV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetStatelessStoreParamsV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetStatelessStoreParamsV6Response) }},
+	{"dhcpm/dhcpsrv2/v1.SetSubnetDelayOfferRequest", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetSubnetDelayOfferRequest) }},
+	{"dhcpm/dhcpsrv2/v1.SetSubnetDelayOfferResponse", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetSubnetDelayOfferResponse) }},
+	{"dhcpm/dhcpsrv2/v1.SetSubnetInfoV6Request", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetSubnetInfoV6Request) }},
+	{"dhcpm/dhcpsrv2/v1.SetSubnetInfoV6Response", func() ndr.Unmarshaler { return new(dhcpm_dhcpsrv2_v1.SetSubnetInfoV6Response) }},
+	{"dltm/trksvr/v1.ServerMessageCallbackRequest", func() ndr.Unmarshaler { return new(dltm_trksvr_v1.ServerMessageCallbackRequest) }},
+	{"dltm/trksvr/v1.ServerMessageCallbackResponse", func() ndr.Unmarshaler { return new(dltm_trksvr_v1.ServerMessageCallbackResponse) }},
+	{"dltm/trksvr/v1.ServerMessageRequest", func() ndr.Unmarshaler { return new(dltm_trksvr_v1.ServerMessageRequest) }},
+	{"dltm/trksvr/v1.ServerMessageResponse", func() ndr.Unmarshaler { return new(dltm_trksvr_v1.ServerMessageResponse) }},
+	{"dltw/trkwks/v1.SearchMachineRequest", func() ndr.Unmarshaler { return new(dltw_trkwks_v1.SearchMachineRequest) }},
+	{"dltw/trkwks/v1.SearchMachineResponse", func() ndr.Unmarshaler { return new(dltw_trkwks_v1.SearchMachineResponse) }},
+	{"dnsp/dnsserver/v5.ComplexOperation2Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.ComplexOperation2Request) }},
+	{"dnsp/dnsserver/v5.ComplexOperation2Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.ComplexOperation2Response) }},
+	{"dnsp/dnsserver/v5.ComplexOperation3Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.ComplexOperation3Request) }},
+	{"dnsp/dnsserver/v5.ComplexOperation3Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.ComplexOperation3Response) }},
+	{"dnsp/dnsserver/v5.ComplexOperationRequest", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.ComplexOperationRequest) }},
+	{"dnsp/dnsserver/v5.ComplexOperationResponse", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.ComplexOperationResponse) }},
+	{"dnsp/dnsserver/v5.EnumRecords2Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecords2Request) }},
+	{"dnsp/dnsserver/v5.EnumRecords2Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecords2Response) }},
+	{"dnsp/dnsserver/v5.EnumRecords3Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecords3Request) }},
+	{"dnsp/dnsserver/v5.EnumRecords3Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecords3Response) }},
+	{"dnsp/dnsserver/v5.EnumRecords4Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecords4Request) }},
+	{"dnsp/dnsserver/v5.EnumRecords4Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecords4Response) }},
+	{"dnsp/dnsserver/v5.EnumRecordsRequest", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecordsRequest) }},
+	{"dnsp/dnsserver/v5.EnumRecordsResponse", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.EnumRecordsResponse) }},
+	{"dnsp/dnsserver/v5.Operation2Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Operation2Request) }},
+	{"dnsp/dnsserver/v5.Operation2Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Operation2Response) }},
+	{"dnsp/dnsserver/v5.Operation3Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Operation3Request) }},
+	{"dnsp/dnsserver/v5.Operation3Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Operation3Response) }},
+	{"dnsp/dnsserver/v5.Operation4Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Operation4Request) }},
+	{"dnsp/dnsserver/v5.Operation4Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Operation4Response) }},
+	{"dnsp/dnsserver/v5.OperationRequest", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.OperationRequest) }},
+	{"dnsp/dnsserver/v5.OperationResponse", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.OperationResponse) }},
+	{"dnsp/dnsserver/v5.Query2Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Query2Request) }},
+	{"dnsp/dnsserver/v5.Query2Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Query2Response) }},
+	{"dnsp/dnsserver/v5.Query3Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Query3Request) }},
+	{"dnsp/dnsserver/v5.Query3Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Query3Response) }},
+	{"dnsp/dnsserver/v5.Query4Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Query4Request) }},
+	{"dnsp/dnsserver/v5.Query4Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.Query4Response) }},
+	{"dnsp/dnsserver/v5.QueryRequest", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.QueryRequest) }},
+	{"dnsp/dnsserver/v5.QueryResponse", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.QueryResponse) }},
+	{"dnsp/dnsserver/v5.UpdateRecord2Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecord2Request) }},
+	{"dnsp/dnsserver/v5.UpdateRecord2Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecord2Response) }},
+	{"dnsp/dnsserver/v5.UpdateRecord3Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecord3Request) }},
+	{"dnsp/dnsserver/v5.UpdateRecord3Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecord3Response) }},
+	{"dnsp/dnsserver/v5.UpdateRecord4Request", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecord4Request) }},
+	{"dnsp/dnsserver/v5.UpdateRecord4Response", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecord4Response) }},
+	{"dnsp/dnsserver/v5.UpdateRecordRequest", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecordRequest) }},
+	{"dnsp/dnsserver/v5.UpdateRecordResponse", func() ndr.Unmarshaler { return new(dnsp_dnsserver_v5.UpdateRecordResponse) }},
+	{"drsr/drsuapi/v4.AddCloneDCRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddCloneDCRequest) }},
+	{"drsr/drsuapi/v4.AddCloneDCResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddCloneDCResponse) }},
+	{"drsr/drsuapi/v4.AddEntryRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddEntryRequest) }},
+	{"drsr/drsuapi/v4.AddEntryResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddEntryResponse) }},
+	{"drsr/drsuapi/v4.AddReplicaRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddReplicaRequest) }},
+	{"drsr/drsuapi/v4.AddReplicaResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddReplicaResponse) }},
+	{"drsr/drsuapi/v4.AddSIDHistoryRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddSIDHistoryRequest) }},
+	{"drsr/drsuapi/v4.AddSIDHistoryResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.AddSIDHistoryResponse) }},
+	{"drsr/drsuapi/v4.BindRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.BindRequest) }},
+	{"drsr/drsuapi/v4.BindResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.BindResponse) }},
+	{"drsr/drsuapi/v4.CrackNamesRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.CrackNamesRequest) }},
+	{"drsr/drsuapi/v4.CrackNamesResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.CrackNamesResponse) }},
+	{"drsr/drsuapi/v4.DeleteReplicaRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.DeleteReplicaRequest) }},
+	{"drsr/drsuapi/v4.DeleteReplicaResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.DeleteReplicaResponse) }},
+	{"drsr/drsuapi/v4.DemotionReplicaRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.DemotionReplicaRequest) }},
+	{"drsr/drsuapi/v4.DemotionReplicaResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.DemotionReplicaResponse) }},
+	{"drsr/drsuapi/v4.DomainControllerInfoRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.DomainControllerInfoRequest) }},
+	{"drsr/drsuapi/v4.DomainControllerInfoResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.DomainControllerInfoResponse) }},
+	{"drsr/drsuapi/v4.ExecuteKCCRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.ExecuteKCCRequest) }},
+	{"drsr/drsuapi/v4.ExecuteKCCResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.ExecuteKCCResponse) }},
+	{"drsr/drsuapi/v4.FinishDemotionRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.FinishDemotionRequest) }},
+	{"drsr/drsuapi/v4.FinishDemotionResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.FinishDemotionResponse) }},
+	{"drsr/drsuapi/v4.GetMemberships2Request", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetMemberships2Request) }},
+	{"drsr/drsuapi/v4.GetMemberships2Response", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetMemberships2Response) }},
+	{"drsr/drsuapi/v4.GetMembershipsRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetMembershipsRequest) }},
+	{"drsr/drsuapi/v4.GetMembershipsResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetMembershipsResponse) }},
+	{"drsr/drsuapi/v4.GetNCChangesRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetNCChangesRequest) }},
+	{"drsr/drsuapi/v4.GetNCChangesResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetNCChangesResponse) }},
+	{"drsr/drsuapi/v4.GetNT4ChangeLogRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetNT4ChangeLogRequest) }},
+	{"drsr/drsuapi/v4.GetNT4ChangeLogResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetNT4ChangeLogResponse) }},
+	{"drsr/drsuapi/v4.GetObjectExistenceRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetObjectExistenceRequest) }},
+	{"drsr/drsuapi/v4.GetObjectExistenceResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetObjectExistenceResponse) }},
+	{"drsr/drsuapi/v4.GetReplicationInfoRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetReplicationInfoRequest) }},
+	{"drsr/drsuapi/v4.GetReplicationInfoResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.GetReplicationInfoResponse) }},
+	{"drsr/drsuapi/v4.InitDemotionRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.InitDemotionRequest) }},
+	{"drsr/drsuapi/v4.InitDemotionResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.InitDemotionResponse) }},
+	{"drsr/drsuapi/v4.InterdomainMoveRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.InterdomainMoveRequest) }},
+	{"drsr/drsuapi/v4.InterdomainMoveResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.InterdomainMoveResponse) }},
+	{"drsr/drsuapi/v4.ModifyReplicaRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.ModifyReplicaRequest) }},
+	{"drsr/drsuapi/v4.ModifyReplicaResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.ModifyReplicaResponse) }},
+	{"drsr/drsuapi/v4.QuerySitesByCostRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.QuerySitesByCostRequest) }},
+	{"drsr/drsuapi/v4.QuerySitesByCostResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.QuerySitesByCostResponse) }},
+	{"drsr/drsuapi/v4.ReadNGCKeyRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.ReadNGCKeyRequest) }},
+	{"drsr/drsuapi/v4.ReadNGCKeyResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.ReadNGCKeyResponse) }},
+	{"drsr/drsuapi/v4.RemoveDSDomainRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.RemoveDSDomainRequest) }},
+	{"drsr/drsuapi/v4.RemoveDSDomainResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.RemoveDSDomainResponse) }},
+	{"drsr/drsuapi/v4.RemoveDSServerRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.RemoveDSServerRequest) }},
+	{"drsr/drsuapi/v4.RemoveDSServerResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.RemoveDSServerResponse) }},
+	{"drsr/drsuapi/v4.SyncReplicaRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.SyncReplicaRequest) }},
+	{"drsr/drsuapi/v4.SyncReplicaResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.SyncReplicaResponse) }},
+	{"drsr/drsuapi/v4.UnbindRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.UnbindRequest) }},
+	{"drsr/drsuapi/v4.UnbindResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.UnbindResponse) }},
+	{"drsr/drsuapi/v4.UpdateReferencesRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.UpdateReferencesRequest) }},
+	{"drsr/drsuapi/v4.UpdateReferencesResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.UpdateReferencesResponse) }},
+	{"drsr/drsuapi/v4.VerifyNamesRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.VerifyNamesRequest) }},
+	{"drsr/drsuapi/v4.VerifyNamesResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.VerifyNamesResponse) }},
+	{"drsr/drsuapi/v4.VerifyObjectsReplicaRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.VerifyObjectsReplicaRequest) }},
+	{"drsr/drsuapi/v4.VerifyObjectsReplicaResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.VerifyObjectsReplicaResponse) }},
+	{"drsr/drsuapi/v4.WriteNGCKeyRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.WriteNGCKeyRequest) }},
+	{"drsr/drsuapi/v4.WriteNGCKeyResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.WriteNGCKeyResponse) }},
+	{"drsr/drsuapi/v4.WriteSPNRequest", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.WriteSPNRequest) }},
+	{"drsr/drsuapi/v4.WriteSPNResponse", func() ndr.Unmarshaler { return new(drsr_drsuapi_v4.WriteSPNResponse) }},
+	{"drsr/dsaop/v1.ExecuteScriptRequest", func() ndr.Unmarshaler { return new(drsr_dsaop_v1.ExecuteScriptRequest) }},
+	{"drsr/dsaop/v1.ExecuteScriptResponse", func() ndr.Unmarshaler { return new(drsr_dsaop_v1.ExecuteScriptResponse) }},
+	{"drsr/dsaop/v1.PrepareScriptRequest", func() ndr.Unmarshaler { return new(drsr_dsaop_v1.PrepareScriptRequest) }},
+	{"drsr/dsaop/v1.PrepareScriptResponse", func() ndr.Unmarshaler { return new(drsr_dsaop_v1.PrepareScriptResponse) }},
+	{"dssp/dssetup/v0.GetPrimaryDomainInformationRequest", func() ndr.Unmarshaler { return new(dssp_dssetup_v0.GetPrimaryDomainInformationRequest) }},
+	{"dssp/dssetup/v0.GetPrimaryDomainInformationResponse", func() ndr.Unmarshaler { return new(dssp_dssetup_v0.GetPrimaryDomainInformationResponse) }},
+	{"epm/epm/v3.DeleteRequest", func() ndr.Unmarshaler { return new(epm_epm_v3.DeleteRequest) }},
+	{"epm/epm/v3.DeleteResponse", func() ndr.Unmarshaler { return new(epm_epm_v3.DeleteResponse) }},
+	{"epm/epm/v3.InquireObjectRequest", func() ndr.Unmarshaler { return new(epm_epm_v3.InquireObjectRequest) }},
+	{"epm/epm/v3.InquireObjectResponse", func() ndr.Unmarshaler { return new(epm_epm_v3.InquireObjectResponse) }},
+	{"epm/epm/v3.InsertRequest", func() ndr.Unmarshaler { return new(epm_epm_v3.InsertRequest) }},
+	{"epm/epm/v3.InsertResponse", func() ndr.Unmarshaler { return new(epm_epm_v3.InsertResponse) }},
+	{"epm/epm/v3.LookupHandleFreeRequest", func() ndr.Unmarshaler { return new(epm_epm_v3.LookupHandleFreeRequest) }},
+	{"epm/epm/v3.LookupHandleFreeResponse", func() ndr.Unmarshaler { return new(epm_epm_v3.LookupHandleFreeResponse) }},
+	{"epm/epm/v3.LookupRequest", func() ndr.Unmarshaler { return new(epm_epm_v3.LookupRequest) }},
+	{"epm/epm/v3.LookupResponse", func() ndr.Unmarshaler { return new(epm_epm_v3.LookupResponse) }},
+	{"epm/epm/v3.ManagementDeleteRequest", func() ndr.Unmarshaler { return new(epm_epm_v3.ManagementDeleteRequest) }},
+	{"epm/epm/v3.ManagementDeleteResponse", func() ndr.Unmarshaler { return new(epm_epm_v3.ManagementDeleteResponse) }},
+	{"epm/epm/v3.MapRequest", func() ndr.Unmarshaler { return new(epm_epm_v3.MapRequest) }},
+	{"epm/epm/v3.MapResponse", func() ndr.Unmarshaler { return new(epm_epm_v3.MapResponse) }},
+	{"even/eventlog/v0.BackupEventLogARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.BackupEventLogARequest) }},
+	{"even/eventlog/v0.BackupEventLogAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.BackupEventLogAResponse) }},
+	{"even/eventlog/v0.BackupEventLogWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.BackupEventLogWRequest) }},
+	{"even/eventlog/v0.BackupEventLogWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.BackupEventLogWResponse) }},
+	{"even/eventlog/v0.ChangeNotifyRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ChangeNotifyRequest) }},
+	{"even/eventlog/v0.ChangeNotifyResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ChangeNotifyResponse) }},
+	{"even/eventlog/v0.ClearEventLogARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ClearEventLogARequest) }},
+	{"even/eventlog/v0.ClearEventLogAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ClearEventLogAResponse) }},
+	{"even/eventlog/v0.ClearEventLogWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ClearEventLogWRequest) }},
+	{"even/eventlog/v0.ClearEventLogWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ClearEventLogWResponse) }},
+	{"even/eventlog/v0.CloseEventLogRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.CloseEventLogRequest) }},
+	{"even/eventlog/v0.CloseEventLogResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.CloseEventLogResponse) }},
+	{"even/eventlog/v0.DeregisterEventSourceRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.DeregisterEventSourceRequest) }},
+	{"even/eventlog/v0.DeregisterEventSourceResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.DeregisterEventSourceResponse) }},
+	{"even/eventlog/v0.GetLogInformationRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.GetLogInformationRequest) }},
+	{"even/eventlog/v0.GetLogInformationResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.GetLogInformationResponse) }},
+	{"even/eventlog/v0.NumberOfRecordsRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.NumberOfRecordsRequest) }},
+	{"even/eventlog/v0.NumberOfRecordsResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.NumberOfRecordsResponse) }},
+	{"even/eventlog/v0.OldestRecordRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.OldestRecordRequest) }},
+	{"even/eventlog/v0.OldestRecordResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.OldestRecordResponse) }},
+	{"even/eventlog/v0.OpenBackupEventLogARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenBackupEventLogARequest) }},
+	{"even/eventlog/v0.OpenBackupEventLogAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenBackupEventLogAResponse) }},
+	{"even/eventlog/v0.OpenBackupEventLogWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenBackupEventLogWRequest) }},
+	{"even/eventlog/v0.OpenBackupEventLogWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenBackupEventLogWResponse) }},
+	{"even/eventlog/v0.OpenEventLogARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenEventLogARequest) }},
+	{"even/eventlog/v0.OpenEventLogAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenEventLogAResponse) }},
+	{"even/eventlog/v0.OpenEventLogWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenEventLogWRequest) }},
+	{"even/eventlog/v0.OpenEventLogWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.OpenEventLogWResponse) }},
+	{"even/eventlog/v0.ReadEventLogARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReadEventLogARequest) }},
+	{"even/eventlog/v0.ReadEventLogAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReadEventLogAResponse) }},
+	{"even/eventlog/v0.ReadEventLogWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReadEventLogWRequest) }},
+	{"even/eventlog/v0.ReadEventLogWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReadEventLogWResponse) }},
+	{"even/eventlog/v0.RegisterEventSourceARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.RegisterEventSourceARequest) }},
+	{"even/eventlog/v0.RegisterEventSourceAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.RegisterEventSourceAResponse) }},
+	{"even/eventlog/v0.RegisterEventSourceWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.RegisterEventSourceWRequest) }},
+	{"even/eventlog/v0.RegisterEventSourceWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.RegisterEventSourceWResponse) }},
+	{"even/eventlog/v0.ReportEventARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventARequest) }},
+	{"even/eventlog/v0.ReportEventAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventAResponse) }},
+	{"even/eventlog/v0.ReportEventAndSourceWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventAndSourceWRequest) }},
+	{"even/eventlog/v0.ReportEventAndSourceWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventAndSourceWResponse) }},
+	{"even/eventlog/v0.ReportEventExARequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventExARequest) }},
+	{"even/eventlog/v0.ReportEventExAResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventExAResponse) }},
+	{"even/eventlog/v0.ReportEventExWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventExWRequest) }},
+	{"even/eventlog/v0.ReportEventExWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventExWResponse) }},
+	{"even/eventlog/v0.ReportEventWRequest", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventWRequest) }},
+	{"even/eventlog/v0.ReportEventWResponse", func() ndr.Unmarshaler { return new(even_eventlog_v0.ReportEventWResponse) }},
+	{"even6/ieventservice/v1.AssertConfigRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.AssertConfigRequest) }},
+	{"even6/ieventservice/v1.AssertConfigResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.AssertConfigResponse) }},
+	{"even6/ieventservice/v1.CancelRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.CancelRequest) }},
+	{"even6/ieventservice/v1.CancelResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.CancelResponse) }},
+	{"even6/ieventservice/v1.ClearLogRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.ClearLogRequest) }},
+	{"even6/ieventservice/v1.ClearLogResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.ClearLogResponse) }},
+	{"even6/ieventservice/v1.CloseRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.CloseRequest) }},
+	{"even6/ieventservice/v1.CloseResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.CloseResponse) }},
+	{"even6/ieventservice/v1.ExportLogRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.ExportLogRequest) }},
+	{"even6/ieventservice/v1.ExportLogResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.ExportLogResponse) }},
+	{"even6/ieventservice/v1.GetChannelConfigRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetChannelConfigRequest) }},
+	{"even6/ieventservice/v1.GetChannelConfigResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetChannelConfigResponse) }},
+	{"even6/ieventservice/v1.GetChannelListRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetChannelListRequest) }},
+	{"even6/ieventservice/v1.GetChannelListResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetChannelListResponse) }},
+	{"even6/ieventservice/v1.GetClassicLogDisplayNameRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetClassicLogDisplayNameRequest) }},
+	{"even6/ieventservice/v1.GetClassicLogDisplayNameResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetClassicLogDisplayNameResponse) }},
+	{"even6/ieventservice/v1.GetEventMetadataEnumRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetEventMetadataEnumRequest) }},
+	{"even6/ieventservice/v1.GetEventMetadataEnumResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetEventMetadataEnumResponse) }},
+	{"even6/ieventservice/v1.GetLogFileInfoRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetLogFileInfoRequest) }},
+	{"even6/ieventservice/v1.GetLogFileInfoResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetLogFileInfoResponse) }},
+	{"even6/ieventservice/v1.GetNextEventMetadataRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetNextEventMetadataRequest) }},
+	{"even6/ieventservice/v1.GetNextEventMetadataResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetNextEventMetadataResponse) }},
+	{"even6/ieventservice/v1.GetPublisherListForChannelRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherListForChannelRequest) }},
+	{"even6/ieventservice/v1.GetPublisherListForChannelResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherListForChannelResponse) }},
+	{"even6/ieventservice/v1.GetPublisherListRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherListRequest) }},
+	{"even6/ieventservice/v1.GetPublisherListResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherListResponse) }},
+	{"even6/ieventservice/v1.GetPublisherMetadataRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherMetadataRequest) }},
+	{"even6/ieventservice/v1.GetPublisherMetadataResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherMetadataResponse) }},
+	{"even6/ieventservice/v1.GetPublisherResourceMetadataRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherResourceMetadataRequest) }},
+	{"even6/ieventservice/v1.GetPublisherResourceMetadataResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.GetPublisherResourceMetadataResponse) }},
+	{"even6/ieventservice/v1.LocalizeExportLogRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.LocalizeExportLogRequest) }},
+	{"even6/ieventservice/v1.LocalizeExportLogResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.LocalizeExportLogResponse) }},
+	{"even6/ieventservice/v1.MessageRenderDefaultRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.MessageRenderDefaultRequest) }},
+	{"even6/ieventservice/v1.MessageRenderDefaultResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.MessageRenderDefaultResponse) }},
+	{"even6/ieventservice/v1.MessageRenderRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.MessageRenderRequest) }},
+	{"even6/ieventservice/v1.MessageRenderResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.MessageRenderResponse) }},
+	{"even6/ieventservice/v1.OpenLogRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.OpenLogRequest) }},
+	{"even6/ieventservice/v1.OpenLogResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.OpenLogResponse) }},
+	{"even6/ieventservice/v1.PutChannelConfigRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.PutChannelConfigRequest) }},
+	{"even6/ieventservice/v1.PutChannelConfigResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.PutChannelConfigResponse) }},
+	{"even6/ieventservice/v1.QueryNextRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.QueryNextRequest) }},
+	{"even6/ieventservice/v1.QueryNextResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.QueryNextResponse) }},
+	{"even6/ieventservice/v1.QuerySeekRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.QuerySeekRequest) }},
+	{"even6/ieventservice/v1.QuerySeekResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.QuerySeekResponse) }},
+	{"even6/ieventservice/v1.RegisterControllableOperationRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RegisterControllableOperationRequest) }},
+	{"even6/ieventservice/v1.RegisterControllableOperationResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RegisterControllableOperationResponse) }},
+	{"even6/ieventservice/v1.RegisterLogQueryRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RegisterLogQueryRequest) }},
+	{"even6/ieventservice/v1.RegisterLogQueryResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RegisterLogQueryResponse) }},
+	{"even6/ieventservice/v1.RegisterRemoteSubscriptionRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RegisterRemoteSubscriptionRequest) }},
+	{"even6/ieventservice/v1.RegisterRemoteSubscriptionResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RegisterRemoteSubscriptionResponse) }},
+	{"even6/ieventservice/v1.RemoteSubscriptionNextAsyncRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RemoteSubscriptionNextAsyncRequest) }},
+	{"even6/ieventservice/v1.RemoteSubscriptionNextAsyncResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RemoteSubscriptionNextAsyncResponse) }},
+	{"even6/ieventservice/v1.RemoteSubscriptionNextRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RemoteSubscriptionNextRequest) }},
+	{"even6/ieventservice/v1.RemoteSubscriptionNextResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RemoteSubscriptionNextResponse) }},
+	{"even6/ieventservice/v1.RemoteSubscriptionWaitAsyncRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RemoteSubscriptionWaitAsyncRequest) }},
+	{"even6/ieventservice/v1.RemoteSubscriptionWaitAsyncResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RemoteSubscriptionWaitAsyncResponse) }},
+	{"even6/ieventservice/v1.RetractConfigRequest", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RetractConfigRequest) }},
+	{"even6/ieventservice/v1.RetractConfigResponse", func() ndr.Unmarshaler { return new(even6_ieventservice_v1.RetractConfigResponse) }},
+	{"fasp/remotefw/v1.AddAuthenticationSet210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddAuthenticationSet210Request) }},
+	{"fasp/remotefw/v1.AddAuthenticationSet210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddAuthenticationSet210Response) }},
+	{"fasp/remotefw/v1.AddAuthenticationSet220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddAuthenticationSet220Request) }},
+	{"fasp/remotefw/v1.AddAuthenticationSet220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddAuthenticationSet220Response) }},
+	{"fasp/remotefw/v1.AddAuthenticationSetRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddAuthenticationSetRequest) }},
+	{"fasp/remotefw/v1.AddAuthenticationSetResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddAuthenticationSetResponse) }},
+	{"fasp/remotefw/v1.AddConnectionSecurityRule210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddConnectionSecurityRule210Request) }},
+	{"fasp/remotefw/v1.AddConnectionSecurityRule210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddConnectionSecurityRule210Response) }},
+	{"fasp/remotefw/v1.AddConnectionSecurityRule220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddConnectionSecurityRule220Request) }},
+	{"fasp/remotefw/v1.AddConnectionSecurityRule220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddConnectionSecurityRule220Response) }},
+	{"fasp/remotefw/v1.AddConnectionSecurityRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddConnectionSecurityRuleRequest) }},
+	{"fasp/remotefw/v1.AddConnectionSecurityRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddConnectionSecurityRuleResponse) }},
+	{"fasp/remotefw/v1.AddCryptoSet210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddCryptoSet210Request) }},
+	{"fasp/remotefw/v1.AddCryptoSet210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddCryptoSet210Response) }},
+	{"fasp/remotefw/v1.AddCryptoSetRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddCryptoSetRequest) }},
+	{"fasp/remotefw/v1.AddCryptoSetResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddCryptoSetResponse) }},
+	{"fasp/remotefw/v1.AddFirewallRule210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule210Request) }},
+	{"fasp/remotefw/v1.AddFirewallRule210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule210Response) }},
+	{"fasp/remotefw/v1.AddFirewallRule220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule220Request) }},
+	{"fasp/remotefw/v1.AddFirewallRule220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule220Response) }},
+	{"fasp/remotefw/v1.AddFirewallRule224Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule224Request) }},
+	{"fasp/remotefw/v1.AddFirewallRule224Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule224Response) }},
+	{"fasp/remotefw/v1.AddFirewallRule225Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule225Request) }},
+	{"fasp/remotefw/v1.AddFirewallRule225Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule225Response) }},
+	{"fasp/remotefw/v1.AddFirewallRule226Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule226Request) }},
+	{"fasp/remotefw/v1.AddFirewallRule226Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule226Response) }},
+	{"fasp/remotefw/v1.AddFirewallRule227Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule227Request) }},
+	{"fasp/remotefw/v1.AddFirewallRule227Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule227Response) }},
+	{"fasp/remotefw/v1.AddFirewallRule231Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule231Request) }},
+	{"fasp/remotefw/v1.AddFirewallRule231Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRule231Response) }},
+	{"fasp/remotefw/v1.AddFirewallRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRuleRequest) }},
+	{"fasp/remotefw/v1.AddFirewallRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddFirewallRuleResponse) }},
+	{"fasp/remotefw/v1.AddMainModeRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddMainModeRuleRequest) }},
+	{"fasp/remotefw/v1.AddMainModeRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.AddMainModeRuleResponse) }},
+	{"fasp/remotefw/v1.ClosePolicyStoreRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.ClosePolicyStoreRequest) }},
+	{"fasp/remotefw/v1.ClosePolicyStoreResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.ClosePolicyStoreResponse) }},
+	{"fasp/remotefw/v1.DeleteAllAuthenticationSetsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllAuthenticationSetsRequest) }},
+	{"fasp/remotefw/v1.DeleteAllAuthenticationSetsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllAuthenticationSetsResponse) }},
+	{"fasp/remotefw/v1.DeleteAllConnectionSecurityRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllConnectionSecurityRulesRequest) }},
+	{"fasp/remotefw/v1.DeleteAllConnectionSecurityRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllConnectionSecurityRulesResponse) }},
+	{"fasp/remotefw/v1.DeleteAllCryptoSetsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllCryptoSetsRequest) }},
+	{"fasp/remotefw/v1.DeleteAllCryptoSetsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllCryptoSetsResponse) }},
+	{"fasp/remotefw/v1.DeleteAllFirewallRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllFirewallRulesRequest) }},
+	{"fasp/remotefw/v1.DeleteAllFirewallRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllFirewallRulesResponse) }},
+	{"fasp/remotefw/v1.DeleteAllMainModeRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllMainModeRulesRequest) }},
+	{"fasp/remotefw/v1.DeleteAllMainModeRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAllMainModeRulesResponse) }},
+	{"fasp/remotefw/v1.DeleteAuthenticationSetRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAuthenticationSetRequest) }},
+	{"fasp/remotefw/v1.DeleteAuthenticationSetResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteAuthenticationSetResponse) }},
+	{"fasp/remotefw/v1.DeleteConnectionSecurityRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteConnectionSecurityRuleRequest) }},
+	{"fasp/remotefw/v1.DeleteConnectionSecurityRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteConnectionSecurityRuleResponse) }},
+	{"fasp/remotefw/v1.DeleteCryptoSetRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteCryptoSetRequest) }},
+	{"fasp/remotefw/v1.DeleteCryptoSetResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteCryptoSetResponse) }},
+	{"fasp/remotefw/v1.DeleteFirewallRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteFirewallRuleRequest) }},
+	{"fasp/remotefw/v1.DeleteFirewallRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteFirewallRuleResponse) }},
+	{"fasp/remotefw/v1.DeleteMainModeRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteMainModeRuleRequest) }},
+	{"fasp/remotefw/v1.DeleteMainModeRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeleteMainModeRuleResponse) }},
+	{"fasp/remotefw/v1.DeletePhase1SAsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeletePhase1SAsRequest) }},
+	{"fasp/remotefw/v1.DeletePhase1SAsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeletePhase1SAsResponse) }},
+	{"fasp/remotefw/v1.DeletePhase2SAsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeletePhase2SAsRequest) }},
+	{"fasp/remotefw/v1.DeletePhase2SAsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.DeletePhase2SAsResponse) }},
+	{"fasp/remotefw/v1.EnumAdaptersRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAdaptersRequest) }},
+	{"fasp/remotefw/v1.EnumAdaptersResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAdaptersResponse) }},
+	{"fasp/remotefw/v1.EnumAuthenticationSets210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAuthenticationSets210Request) }},
+	{"fasp/remotefw/v1.EnumAuthenticationSets210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAuthenticationSets210Response) }},
+	{"fasp/remotefw/v1.EnumAuthenticationSets220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAuthenticationSets220Request) }},
+	{"fasp/remotefw/v1.EnumAuthenticationSets220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAuthenticationSets220Response) }},
+	{"fasp/remotefw/v1.EnumAuthenticationSetsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAuthenticationSetsRequest) }},
+	{"fasp/remotefw/v1.EnumAuthenticationSetsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumAuthenticationSetsResponse) }},
+	{"fasp/remotefw/v1.EnumConnectionSecurityRules210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumConnectionSecurityRules210Request) }},
+	{"fasp/remotefw/v1.EnumConnectionSecurityRules210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumConnectionSecurityRules210Response) }},
+	{"fasp/remotefw/v1.EnumConnectionSecurityRules220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumConnectionSecurityRules220Request) }},
+	{"fasp/remotefw/v1.EnumConnectionSecurityRules220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumConnectionSecurityRules220Response) }},
+	{"fasp/remotefw/v1.EnumConnectionSecurityRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumConnectionSecurityRulesRequest) }},
+	{"fasp/remotefw/v1.EnumConnectionSecurityRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumConnectionSecurityRulesResponse) }},
+	{"fasp/remotefw/v1.EnumCryptoSets210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumCryptoSets210Request) }},
+	{"fasp/remotefw/v1.EnumCryptoSets210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumCryptoSets210Response) }},
+	{"fasp/remotefw/v1.EnumCryptoSetsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumCryptoSetsRequest) }},
+	{"fasp/remotefw/v1.EnumCryptoSetsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumCryptoSetsResponse) }},
+	{"fasp/remotefw/v1.EnumFirewallRules210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules210Request) }},
+	{"fasp/remotefw/v1.EnumFirewallRules210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules210Response) }},
+	{"fasp/remotefw/v1.EnumFirewallRules220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules220Request) }},
+	{"fasp/remotefw/v1.EnumFirewallRules220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules220Response) }},
+	{"fasp/remotefw/v1.EnumFirewallRules224Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules224Request) }},
+	{"fasp/remotefw/v1.EnumFirewallRules224Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules224Response) }},
+	{"fasp/remotefw/v1.EnumFirewallRules225Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules225Request) }},
+	{"fasp/remotefw/v1.EnumFirewallRules225Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules225Response) }},
+	{"fasp/remotefw/v1.EnumFirewallRules226Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules226Request) }},
+	{"fasp/remotefw/v1.EnumFirewallRules226Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules226Response) }},
+	{"fasp/remotefw/v1.EnumFirewallRules227Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules227Request) }},
+	{"fasp/remotefw/v1.EnumFirewallRules227Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules227Response) }},
+	{"fasp/remotefw/v1.EnumFirewallRules231Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules231Request) }},
+	{"fasp/remotefw/v1.EnumFirewallRules231Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRules231Response) }},
+	{"fasp/remotefw/v1.EnumFirewallRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRulesRequest) }},
+	{"fasp/remotefw/v1.EnumFirewallRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumFirewallRulesResponse) }},
+	{"fasp/remotefw/v1.EnumMainModeRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumMainModeRulesRequest) }},
+	{"fasp/remotefw/v1.EnumMainModeRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumMainModeRulesResponse) }},
+	{"fasp/remotefw/v1.EnumNetworksRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumNetworksRequest) }},
+	{"fasp/remotefw/v1.EnumNetworksResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumNetworksResponse) }},
+	{"fasp/remotefw/v1.EnumPhase1SAsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumPhase1SAsRequest) }},
+	{"fasp/remotefw/v1.EnumPhase1SAsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumPhase1SAsResponse) }},
+	{"fasp/remotefw/v1.EnumPhase2SAsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumPhase2SAsRequest) }},
+	{"fasp/remotefw/v1.EnumPhase2SAsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumPhase2SAsResponse) }},
+	{"fasp/remotefw/v1.EnumProductsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumProductsRequest) }},
+	{"fasp/remotefw/v1.EnumProductsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.EnumProductsResponse) }},
+	{"fasp/remotefw/v1.GetConfig210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetConfig210Request) }},
+	{"fasp/remotefw/v1.GetConfig210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetConfig210Response) }},
+	{"fasp/remotefw/v1.GetConfigRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetConfigRequest) }},
+	{"fasp/remotefw/v1.GetConfigResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetConfigResponse) }},
+	{"fasp/remotefw/v1.GetGlobalConfig210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetGlobalConfig210Request) }},
+	{"fasp/remotefw/v1.GetGlobalConfig210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetGlobalConfig210Response) }},
+	{"fasp/remotefw/v1.GetGlobalConfigRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetGlobalConfigRequest) }},
+	{"fasp/remotefw/v1.GetGlobalConfigResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.GetGlobalConfigResponse) }},
+	{"fasp/remotefw/v1.OpenPolicyStoreRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.OpenPolicyStoreRequest) }},
+	{"fasp/remotefw/v1.OpenPolicyStoreResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.OpenPolicyStoreResponse) }},
+	{"fasp/remotefw/v1.QueryAuthenticationSets220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryAuthenticationSets220Request) }},
+	{"fasp/remotefw/v1.QueryAuthenticationSets220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryAuthenticationSets220Response) }},
+	{"fasp/remotefw/v1.QueryAuthenticationSetsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryAuthenticationSetsRequest) }},
+	{"fasp/remotefw/v1.QueryAuthenticationSetsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryAuthenticationSetsResponse) }},
+	{"fasp/remotefw/v1.QueryConnectionSecurityRules210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryConnectionSecurityRules210Request) }},
+	{"fasp/remotefw/v1.QueryConnectionSecurityRules210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryConnectionSecurityRules210Response) }},
+	{"fasp/remotefw/v1.QueryConnectionSecurityRules220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryConnectionSecurityRules220Request) }},
+	{"fasp/remotefw/v1.QueryConnectionSecurityRules220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryConnectionSecurityRules220Response) }},
+	{"fasp/remotefw/v1.QueryCryptoSetsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryCryptoSetsRequest) }},
+	{"fasp/remotefw/v1.QueryCryptoSetsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryCryptoSetsResponse) }},
+	{"fasp/remotefw/v1.QueryFirewallRules220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules220Request) }},
+	{"fasp/remotefw/v1.QueryFirewallRules220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules220Response) }},
+	{"fasp/remotefw/v1.QueryFirewallRules224Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules224Request) }},
+	{"fasp/remotefw/v1.QueryFirewallRules224Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules224Response) }},
+	{"fasp/remotefw/v1.QueryFirewallRules225Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules225Request) }},
+	{"fasp/remotefw/v1.QueryFirewallRules225Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules225Response) }},
+	{"fasp/remotefw/v1.QueryFirewallRules226Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules226Request) }},
+	{"fasp/remotefw/v1.QueryFirewallRules226Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules226Response) }},
+	{"fasp/remotefw/v1.QueryFirewallRules227Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules227Request) }},
+	{"fasp/remotefw/v1.QueryFirewallRules227Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules227Response) }},
+	{"fasp/remotefw/v1.QueryFirewallRules231Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules231Request) }},
+	{"fasp/remotefw/v1.QueryFirewallRules231Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRules231Response) }},
+	{"fasp/remotefw/v1.QueryFirewallRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRulesRequest) }},
+	{"fasp/remotefw/v1.QueryFirewallRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryFirewallRulesResponse) }},
+	{"fasp/remotefw/v1.QueryMainModeRulesRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryMainModeRulesRequest) }},
+	{"fasp/remotefw/v1.QueryMainModeRulesResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.QueryMainModeRulesResponse) }},
+	{"fasp/remotefw/v1.RestoreDefaultsRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.RestoreDefaultsRequest) }},
+	{"fasp/remotefw/v1.RestoreDefaultsResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.RestoreDefaultsResponse) }},
+	{"fasp/remotefw/v1.SetAuthenticationSet210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetAuthenticationSet210Request) }},
+	{"fasp/remotefw/v1.SetAuthenticationSet210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetAuthenticationSet210Response) }},
+	{"fasp/remotefw/v1.SetAuthenticationSet220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetAuthenticationSet220Request) }},
+	{"fasp/remotefw/v1.SetAuthenticationSet220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetAuthenticationSet220Response) }},
+	{"fasp/remotefw/v1.SetAuthenticationSetRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetAuthenticationSetRequest) }},
+	{"fasp/remotefw/v1.SetAuthenticationSetResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetAuthenticationSetResponse) }},
+	{"fasp/remotefw/v1.SetConfigRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConfigRequest) }},
+	{"fasp/remotefw/v1.SetConfigResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConfigResponse) }},
+	{"fasp/remotefw/v1.SetConnectionSecurityRule210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConnectionSecurityRule210Request) }},
+	{"fasp/remotefw/v1.SetConnectionSecurityRule210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConnectionSecurityRule210Response) }},
+	{"fasp/remotefw/v1.SetConnectionSecurityRule220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConnectionSecurityRule220Request) }},
+	{"fasp/remotefw/v1.SetConnectionSecurityRule220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConnectionSecurityRule220Response) }},
+	{"fasp/remotefw/v1.SetConnectionSecurityRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConnectionSecurityRuleRequest) }},
+	{"fasp/remotefw/v1.SetConnectionSecurityRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetConnectionSecurityRuleResponse) }},
+	{"fasp/remotefw/v1.SetCryptoSet210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetCryptoSet210Request) }},
+	{"fasp/remotefw/v1.SetCryptoSet210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetCryptoSet210Response) }},
+	{"fasp/remotefw/v1.SetCryptoSetRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetCryptoSetRequest) }},
+	{"fasp/remotefw/v1.SetCryptoSetResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetCryptoSetResponse) }},
+	{"fasp/remotefw/v1.SetFirewallRule210Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule210Request) }},
+	{"fasp/remotefw/v1.SetFirewallRule210Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule210Response) }},
+	{"fasp/remotefw/v1.SetFirewallRule220Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule220Request) }},
+	{"fasp/remotefw/v1.SetFirewallRule220Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule220Response) }},
+	{"fasp/remotefw/v1.SetFirewallRule224Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule224Request) }},
+	{"fasp/remotefw/v1.SetFirewallRule224Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule224Response) }},
+	{"fasp/remotefw/v1.SetFirewallRule225Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule225Request) }},
+	{"fasp/remotefw/v1.SetFirewallRule225Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule225Response) }},
+	{"fasp/remotefw/v1.SetFirewallRule226Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule226Request) }},
+	{"fasp/remotefw/v1.SetFirewallRule226Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule226Response) }},
+	{"fasp/remotefw/v1.SetFirewallRule227Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule227Request) }},
+	{"fasp/remotefw/v1.SetFirewallRule227Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule227Response) }},
+	{"fasp/remotefw/v1.SetFirewallRule231Request", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule231Request) }},
+	{"fasp/remotefw/v1.SetFirewallRule231Response", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRule231Response) }},
+	{"fasp/remotefw/v1.SetFirewallRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRuleRequest) }},
+	{"fasp/remotefw/v1.SetFirewallRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetFirewallRuleResponse) }},
+	{"fasp/remotefw/v1.SetGlobalConfigRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetGlobalConfigRequest) }},
+	{"fasp/remotefw/v1.SetGlobalConfigResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetGlobalConfigResponse) }},
+	{"fasp/remotefw/v1.SetMainModeRuleRequest", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetMainModeRuleRequest) }},
+	{"fasp/remotefw/v1.SetMainModeRuleResponse", func() ndr.Unmarshaler { return new(fasp_remotefw_v1.SetMainModeRuleResponse) }},
+	{"fax/fax/v4.AbortRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.AbortRequest) }},
+	{"fax/fax/v4.AbortResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.AbortResponse) }},
+	{"fax/fax/v4.AccessCheckEx2Request", func() ndr.Unmarshaler { return new(fax_fax_v4.AccessCheckEx2Request) }},
+	{"fax/fax/v4.AccessCheckEx2Response", func() ndr.Unmarshaler { return new(fax_fax_v4.AccessCheckEx2Response) }},
+	{"fax/fax/v4.AccessCheckRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.AccessCheckRequest) }},
+	{"fax/fax/v4.AccessCheckResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.AccessCheckResponse) }},
+	{"fax/fax/v4.AddOutboundGroupRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.AddOutboundGroupRequest) }},
+	{"fax/fax/v4.AddOutboundGroupResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.AddOutboundGroupResponse) }},
+	{"fax/fax/v4.AddOutboundRuleRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.AddOutboundRuleRequest) }},
+	{"fax/fax/v4.AddOutboundRuleResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.AddOutboundRuleResponse) }},
+	{"fax/fax/v4.CheckServerProtocolSeqRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.CheckServerProtocolSeqRequest) }},
+	{"fax/fax/v4.CheckServerProtocolSeqResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.CheckServerProtocolSeqResponse) }},
+	{"fax/fax/v4.CheckValidFaxFolderRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.CheckValidFaxFolderRequest) }},
+	{"fax/fax/v4.CheckValidFaxFolderResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.CheckValidFaxFolderResponse) }},
+	{"fax/fax/v4.ClosePortRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.ClosePortRequest) }},
+	{"fax/fax/v4.ClosePortResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.ClosePortResponse) }},
+	{"fax/fax/v4.ConnectFaxServerRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.ConnectFaxServerRequest) }},
+	{"fax/fax/v4.ConnectFaxServerResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.ConnectFaxServerResponse) }},
+	{"fax/fax/v4.ConnectionReferenceCountRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.ConnectionReferenceCountRequest) }},
+	{"fax/fax/v4.ConnectionReferenceCountResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.ConnectionReferenceCountResponse) }},
+	{"fax/fax/v4.CreateAccountRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.CreateAccountRequest) }},
+	{"fax/fax/v4.CreateAccountResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.CreateAccountResponse) }},
+	{"fax/fax/v4.DeleteAccountRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.DeleteAccountRequest) }},
+	{"fax/fax/v4.DeleteAccountResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.DeleteAccountResponse) }},
+	{"fax/fax/v4.EnableRoutingMethodRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnableRoutingMethodRequest) }},
+	{"fax/fax/v4.EnableRoutingMethodResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnableRoutingMethodResponse) }},
+	{"fax/fax/v4.EndCopyRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EndCopyRequest) }},
+	{"fax/fax/v4.EndCopyResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EndCopyResponse) }},
+	{"fax/fax/v4.EndMessagesEnumRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EndMessagesEnumRequest) }},
+	{"fax/fax/v4.EndMessagesEnumResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EndMessagesEnumResponse) }},
+	{"fax/fax/v4.EndServerNotificationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EndServerNotificationRequest) }},
+	{"fax/fax/v4.EndServerNotificationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EndServerNotificationResponse) }},
+	{"fax/fax/v4.EnumAccountsRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumAccountsRequest) }},
+	{"fax/fax/v4.EnumAccountsResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumAccountsResponse) }},
+	{"fax/fax/v4.EnumGlobalRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumGlobalRoutingInfoRequest) }},
+	{"fax/fax/v4.EnumGlobalRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumGlobalRoutingInfoResponse) }},
+	{"fax/fax/v4.EnumJobsEx2Request", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumJobsEx2Request) }},
+	{"fax/fax/v4.EnumJobsEx2Response", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumJobsEx2Response) }},
+	{"fax/fax/v4.EnumJobsExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumJobsExRequest) }},
+	{"fax/fax/v4.EnumJobsExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumJobsExResponse) }},
+	{"fax/fax/v4.EnumJobsRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumJobsRequest) }},
+	{"fax/fax/v4.EnumJobsResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumJobsResponse) }},
+	{"fax/fax/v4.EnumMessagesExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumMessagesExRequest) }},
+	{"fax/fax/v4.EnumMessagesExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumMessagesExResponse) }},
+	{"fax/fax/v4.EnumMessagesRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumMessagesRequest) }},
+	{"fax/fax/v4.EnumMessagesResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumMessagesResponse) }},
+	{"fax/fax/v4.EnumOutboundGroupsRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumOutboundGroupsRequest) }},
+	{"fax/fax/v4.EnumOutboundGroupsResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumOutboundGroupsResponse) }},
+	{"fax/fax/v4.EnumOutboundRulesRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumOutboundRulesRequest) }},
+	{"fax/fax/v4.EnumOutboundRulesResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumOutboundRulesResponse) }},
+	{"fax/fax/v4.EnumPortsExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumPortsExRequest) }},
+	{"fax/fax/v4.EnumPortsExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumPortsExResponse) }},
+	{"fax/fax/v4.EnumPortsRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumPortsRequest) }},
+	{"fax/fax/v4.EnumPortsResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumPortsResponse) }},
+	{"fax/fax/v4.EnumRoutingExtensionsRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumRoutingExtensionsRequest) }},
+	{"fax/fax/v4.EnumRoutingExtensionsResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumRoutingExtensionsResponse) }},
+	{"fax/fax/v4.EnumRoutingMethodsRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumRoutingMethodsRequest) }},
+	{"fax/fax/v4.EnumRoutingMethodsResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumRoutingMethodsResponse) }},
+	{"fax/fax/v4.EnumerateProvidersRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumerateProvidersRequest) }},
+	{"fax/fax/v4.EnumerateProvidersResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.EnumerateProvidersResponse) }},
+	{"fax/fax/v4.GetAccountInfoRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetAccountInfoRequest) }},
+	{"fax/fax/v4.GetAccountInfoResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetAccountInfoResponse) }},
+	{"fax/fax/v4.GetActivityLoggingConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetActivityLoggingConfigurationRequest) }},
+	{"fax/fax/v4.GetActivityLoggingConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetActivityLoggingConfigurationResponse) }},
+	{"fax/fax/v4.GetArchiveConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetArchiveConfigurationRequest) }},
+	{"fax/fax/v4.GetArchiveConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetArchiveConfigurationResponse) }},
+	{"fax/fax/v4.GetConfigOptionRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetConfigOptionRequest) }},
+	{"fax/fax/v4.GetConfigOptionResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetConfigOptionResponse) }},
+	{"fax/fax/v4.GetConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetConfigurationRequest) }},
+	{"fax/fax/v4.GetConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetConfigurationResponse) }},
+	{"fax/fax/v4.GetCountryListRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetCountryListRequest) }},
+	{"fax/fax/v4.GetCountryListResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetCountryListResponse) }},
+	{"fax/fax/v4.GetDeviceStatusRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetDeviceStatusRequest) }},
+	{"fax/fax/v4.GetDeviceStatusResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetDeviceStatusResponse) }},
+	{"fax/fax/v4.GetExtensionDataRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetExtensionDataRequest) }},
+	{"fax/fax/v4.GetExtensionDataResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetExtensionDataResponse) }},
+	{"fax/fax/v4.GetGeneralConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetGeneralConfigurationRequest) }},
+	{"fax/fax/v4.GetGeneralConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetGeneralConfigurationResponse) }},
+	{"fax/fax/v4.GetJobEx2Request", func() ndr.Unmarshaler { return new(fax_fax_v4.GetJobEx2Request) }},
+	{"fax/fax/v4.GetJobEx2Response", func() ndr.Unmarshaler { return new(fax_fax_v4.GetJobEx2Response) }},
+	{"fax/fax/v4.GetJobExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetJobExRequest) }},
+	{"fax/fax/v4.GetJobExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetJobExResponse) }},
+	{"fax/fax/v4.GetJobRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetJobRequest) }},
+	{"fax/fax/v4.GetJobResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetJobResponse) }},
+	{"fax/fax/v4.GetLoggingCategoriesRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetLoggingCategoriesRequest) }},
+	{"fax/fax/v4.GetLoggingCategoriesResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetLoggingCategoriesResponse) }},
+	{"fax/fax/v4.GetMessageExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetMessageExRequest) }},
+	{"fax/fax/v4.GetMessageExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetMessageExResponse) }},
+	{"fax/fax/v4.GetMessageRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetMessageRequest) }},
+	{"fax/fax/v4.GetMessageResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetMessageResponse) }},
+	{"fax/fax/v4.GetOutboxConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetOutboxConfigurationRequest) }},
+	{"fax/fax/v4.GetOutboxConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetOutboxConfigurationResponse) }},
+	{"fax/fax/v4.GetPageDataRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPageDataRequest) }},
+	{"fax/fax/v4.GetPageDataResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPageDataResponse) }},
+	{"fax/fax/v4.GetPersonalCoverPagesOptionRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPersonalCoverPagesOptionRequest) }},
+	{"fax/fax/v4.GetPersonalCoverPagesOptionResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPersonalCoverPagesOptionResponse) }},
+	{"fax/fax/v4.GetPersonalProfileInfoRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPersonalProfileInfoRequest) }},
+	{"fax/fax/v4.GetPersonalProfileInfoResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPersonalProfileInfoResponse) }},
+	{"fax/fax/v4.GetPortExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPortExRequest) }},
+	{"fax/fax/v4.GetPortExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPortExResponse) }},
+	{"fax/fax/v4.GetPortRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPortRequest) }},
+	{"fax/fax/v4.GetPortResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetPortResponse) }},
+	{"fax/fax/v4.GetQueueStatesRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetQueueStatesRequest) }},
+	{"fax/fax/v4.GetQueueStatesResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetQueueStatesResponse) }},
+	{"fax/fax/v4.GetReceiptsConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetReceiptsConfigurationRequest) }},
+	{"fax/fax/v4.GetReceiptsConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetReceiptsConfigurationResponse) }},
+	{"fax/fax/v4.GetReceiptsOptionsRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetReceiptsOptionsRequest) }},
+	{"fax/fax/v4.GetReceiptsOptionsResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetReceiptsOptionsResponse) }},
+	{"fax/fax/v4.GetRecipientsLimitRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetRecipientsLimitRequest) }},
+	{"fax/fax/v4.GetRecipientsLimitResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetRecipientsLimitResponse) }},
+	{"fax/fax/v4.GetRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetRoutingInfoRequest) }},
+	{"fax/fax/v4.GetRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetRoutingInfoResponse) }},
+	{"fax/fax/v4.GetSecurityEx2Request", func() ndr.Unmarshaler { return new(fax_fax_v4.GetSecurityEx2Request) }},
+	{"fax/fax/v4.GetSecurityEx2Response", func() ndr.Unmarshaler { return new(fax_fax_v4.GetSecurityEx2Response) }},
+	{"fax/fax/v4.GetSecurityExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetSecurityExRequest) }},
+	{"fax/fax/v4.GetSecurityExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetSecurityExResponse) }},
+	{"fax/fax/v4.GetSecurityRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetSecurityRequest) }},
+	{"fax/fax/v4.GetSecurityResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetSecurityResponse) }},
+	{"fax/fax/v4.GetServerActivityRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetServerActivityRequest) }},
+	{"fax/fax/v4.GetServerActivityResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetServerActivityResponse) }},
+	{"fax/fax/v4.GetServerSKURequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetServerSKURequest) }},
+	{"fax/fax/v4.GetServerSKUResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetServerSKUResponse) }},
+	{"fax/fax/v4.GetServicePrintersRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetServicePrintersRequest) }},
+	{"fax/fax/v4.GetServicePrintersResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetServicePrintersResponse) }},
+	{"fax/fax/v4.GetVersionRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.GetVersionRequest) }},
+	{"fax/fax/v4.GetVersionResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.GetVersionResponse) }},
+	{"fax/fax/v4.OpenPortRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.OpenPortRequest) }},
+	{"fax/fax/v4.OpenPortResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.OpenPortResponse) }},
+	{"fax/fax/v4.ReadFileRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.ReadFileRequest) }},
+	{"fax/fax/v4.ReadFileResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.ReadFileResponse) }},
+	{"fax/fax/v4.ReassignMessageRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.ReassignMessageRequest) }},
+	{"fax/fax/v4.ReassignMessageResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.ReassignMessageResponse) }},
+	{"fax/fax/v4.RefreshArchiveRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.RefreshArchiveRequest) }},
+	{"fax/fax/v4.RefreshArchiveResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.RefreshArchiveResponse) }},
+	{"fax/fax/v4.RegisterServiceProviderExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.RegisterServiceProviderExRequest) }},
+	{"fax/fax/v4.RegisterServiceProviderExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.RegisterServiceProviderExResponse) }},
+	{"fax/fax/v4.RemoveMessageRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.RemoveMessageRequest) }},
+	{"fax/fax/v4.RemoveMessageResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.RemoveMessageResponse) }},
+	{"fax/fax/v4.RemoveOutboundGroupRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.RemoveOutboundGroupRequest) }},
+	{"fax/fax/v4.RemoveOutboundGroupResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.RemoveOutboundGroupResponse) }},
+	{"fax/fax/v4.RemoveOutboundRuleRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.RemoveOutboundRuleRequest) }},
+	{"fax/fax/v4.RemoveOutboundRuleResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.RemoveOutboundRuleResponse) }},
+	{"fax/fax/v4.SendDocumentExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SendDocumentExRequest) }},
+	{"fax/fax/v4.SendDocumentExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SendDocumentExResponse) }},
+	{"fax/fax/v4.SetActivityLoggingConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetActivityLoggingConfigurationRequest) }},
+	{"fax/fax/v4.SetActivityLoggingConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetActivityLoggingConfigurationResponse) }},
+	{"fax/fax/v4.SetArchiveConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetArchiveConfigurationRequest) }},
+	{"fax/fax/v4.SetArchiveConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetArchiveConfigurationResponse) }},
+	{"fax/fax/v4.SetConfigWizardUsedRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetConfigWizardUsedRequest) }},
+	{"fax/fax/v4.SetConfigWizardUsedResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetConfigWizardUsedResponse) }},
+	{"fax/fax/v4.SetConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetConfigurationRequest) }},
+	{"fax/fax/v4.SetConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetConfigurationResponse) }},
+	{"fax/fax/v4.SetDeviceOrderInGroupRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetDeviceOrderInGroupRequest) }},
+	{"fax/fax/v4.SetDeviceOrderInGroupResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetDeviceOrderInGroupResponse) }},
+	{"fax/fax/v4.SetExtensionDataRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetExtensionDataRequest) }},
+	{"fax/fax/v4.SetExtensionDataResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetExtensionDataResponse) }},
+	{"fax/fax/v4.SetGeneralConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetGeneralConfigurationRequest) }},
+	{"fax/fax/v4.SetGeneralConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetGeneralConfigurationResponse) }},
+	{"fax/fax/v4.SetGlobalRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetGlobalRoutingInfoRequest) }},
+	{"fax/fax/v4.SetGlobalRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetGlobalRoutingInfoResponse) }},
+	{"fax/fax/v4.SetJobRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetJobRequest) }},
+	{"fax/fax/v4.SetJobResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetJobResponse) }},
+	{"fax/fax/v4.SetLoggingCategoriesRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetLoggingCategoriesRequest) }},
+	{"fax/fax/v4.SetLoggingCategoriesResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetLoggingCategoriesResponse) }},
+	{"fax/fax/v4.SetMessageRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetMessageRequest) }},
+	{"fax/fax/v4.SetMessageResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetMessageResponse) }},
+	{"fax/fax/v4.SetOutboundGroupRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetOutboundGroupRequest) }},
+	{"fax/fax/v4.SetOutboundGroupResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetOutboundGroupResponse) }},
+	{"fax/fax/v4.SetOutboundRuleRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetOutboundRuleRequest) }},
+	{"fax/fax/v4.SetOutboundRuleResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetOutboundRuleResponse) }},
+	{"fax/fax/v4.SetOutboxConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetOutboxConfigurationRequest) }},
+	{"fax/fax/v4.SetOutboxConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetOutboxConfigurationResponse) }},
+	{"fax/fax/v4.SetPortExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetPortExRequest) }},
+	{"fax/fax/v4.SetPortExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetPortExResponse) }},
+	{"fax/fax/v4.SetPortRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetPortRequest) }},
+	{"fax/fax/v4.SetPortResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetPortResponse) }},
+	{"fax/fax/v4.SetQueueRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetQueueRequest) }},
+	{"fax/fax/v4.SetQueueResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetQueueResponse) }},
+	{"fax/fax/v4.SetReceiptsConfigurationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetReceiptsConfigurationRequest) }},
+	{"fax/fax/v4.SetReceiptsConfigurationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetReceiptsConfigurationResponse) }},
+	{"fax/fax/v4.SetRecipientsLimitRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetRecipientsLimitRequest) }},
+	{"fax/fax/v4.SetRecipientsLimitResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetRecipientsLimitResponse) }},
+	{"fax/fax/v4.SetRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetRoutingInfoRequest) }},
+	{"fax/fax/v4.SetRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetRoutingInfoResponse) }},
+	{"fax/fax/v4.SetSecurityEx2Request", func() ndr.Unmarshaler { return new(fax_fax_v4.SetSecurityEx2Request) }},
+	{"fax/fax/v4.SetSecurityEx2Response", func() ndr.Unmarshaler { return new(fax_fax_v4.SetSecurityEx2Response) }},
+	{"fax/fax/v4.SetSecurityRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.SetSecurityRequest) }},
+	{"fax/fax/v4.SetSecurityResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.SetSecurityResponse) }},
+	{"fax/fax/v4.StartCopyMessageFromServerRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.StartCopyMessageFromServerRequest) }},
+	{"fax/fax/v4.StartCopyMessageFromServerResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.StartCopyMessageFromServerResponse) }},
+	{"fax/fax/v4.StartCopyToServerRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.StartCopyToServerRequest) }},
+	{"fax/fax/v4.StartCopyToServerResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.StartCopyToServerResponse) }},
+	{"fax/fax/v4.StartMessagesEnumExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.StartMessagesEnumExRequest) }},
+	{"fax/fax/v4.StartMessagesEnumExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.StartMessagesEnumExResponse) }},
+	{"fax/fax/v4.StartMessagesEnumRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.StartMessagesEnumRequest) }},
+	{"fax/fax/v4.StartMessagesEnumResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.StartMessagesEnumResponse) }},
+	{"fax/fax/v4.StartServerNotificationEx2Request", func() ndr.Unmarshaler { return new(fax_fax_v4.StartServerNotificationEx2Request) }},
+	{"fax/fax/v4.StartServerNotificationEx2Response", func() ndr.Unmarshaler { return new(fax_fax_v4.StartServerNotificationEx2Response) }},
+	{"fax/fax/v4.StartServerNotificationExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.StartServerNotificationExRequest) }},
+	{"fax/fax/v4.StartServerNotificationExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.StartServerNotificationExResponse) }},
+	{"fax/fax/v4.StartServerNotificationRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.StartServerNotificationRequest) }},
+	{"fax/fax/v4.StartServerNotificationResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.StartServerNotificationResponse) }},
+	{"fax/fax/v4.UnregisterRoutingExtensionRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.UnregisterRoutingExtensionRequest) }},
+	{"fax/fax/v4.UnregisterRoutingExtensionResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.UnregisterRoutingExtensionResponse) }},
+	{"fax/fax/v4.UnregisterServiceProviderExRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.UnregisterServiceProviderExRequest) }},
+	{"fax/fax/v4.UnregisterServiceProviderExResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.UnregisterServiceProviderExResponse) }},
+	{"fax/fax/v4.WriteFileRequest", func() ndr.Unmarshaler { return new(fax_fax_v4.WriteFileRequest) }},
+	{"fax/fax/v4.WriteFileResponse", func() ndr.Unmarshaler { return new(fax_fax_v4.WriteFileResponse) }},
+	{"fax/faxclient/v3.ClientEventQueueExRequest", func() ndr.Unmarshaler { return new(fax_faxclient_v3.ClientEventQueueExRequest) }},
+	{"fax/faxclient/v3.ClientEventQueueExResponse", func() ndr.Unmarshaler { return new(fax_faxclient_v3.ClientEventQueueExResponse) }},
+	{"fax/faxclient/v3.ClientEventQueueRequest", func() ndr.Unmarshaler { return new(fax_faxclient_v3.ClientEventQueueRequest) }},
+	{"fax/faxclient/v3.ClientEventQueueResponse", func() ndr.Unmarshaler { return new(fax_faxclient_v3.ClientEventQueueResponse) }},
+	{"fax/faxclient/v3.CloseConnectionRequest", func() ndr.Unmarshaler { return new(fax_faxclient_v3.CloseConnectionRequest) }},
+	{"fax/faxclient/v3.CloseConnectionResponse", func() ndr.Unmarshaler { return new(fax_faxclient_v3.CloseConnectionResponse) }},
+	{"fax/faxclient/v3.OpenConnectionRequest", func() ndr.Unmarshaler { return new(fax_faxclient_v3.OpenConnectionRequest) }},
+	{"fax/faxclient/v3.OpenConnectionResponse", func() ndr.Unmarshaler { return new(fax_faxclient_v3.OpenConnectionResponse) }},
+	{"fax/faxobs/v4.AbortRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.AbortRequest) }},
+	{"fax/faxobs/v4.AbortResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.AbortResponse) }},
+	{"fax/faxobs/v4.AccessCheckRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.AccessCheckRequest) }},
+	{"fax/faxobs/v4.AccessCheckResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.AccessCheckResponse) }},
+	{"fax/faxobs/v4.ClosePortRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.ClosePortRequest) }},
+	{"fax/faxobs/v4.ClosePortResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.ClosePortResponse) }},
+	{"fax/faxobs/v4.ConnectionReferenceCountRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.ConnectionReferenceCountRequest) }},
+	{"fax/faxobs/v4.ConnectionReferenceCountResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.ConnectionReferenceCountResponse) }},
+	{"fax/faxobs/v4.EnableRoutingMethodRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnableRoutingMethodRequest) }},
+	{"fax/faxobs/v4.EnableRoutingMethodResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnableRoutingMethodResponse) }},
+	{"fax/faxobs/v4.EnumGlobalRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumGlobalRoutingInfoRequest) }},
+	{"fax/faxobs/v4.EnumGlobalRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumGlobalRoutingInfoResponse) }},
+	{"fax/faxobs/v4.EnumJobsRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumJobsRequest) }},
+	{"fax/faxobs/v4.EnumJobsResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumJobsResponse) }},
+	{"fax/faxobs/v4.EnumPortsRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumPortsRequest) }},
+	{"fax/faxobs/v4.EnumPortsResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumPortsResponse) }},
+	{"fax/faxobs/v4.EnumRoutingMethodsRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumRoutingMethodsRequest) }},
+	{"fax/faxobs/v4.EnumRoutingMethodsResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.EnumRoutingMethodsResponse) }},
+	{"fax/faxobs/v4.GetConfigurationRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetConfigurationRequest) }},
+	{"fax/faxobs/v4.GetConfigurationResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetConfigurationResponse) }},
+	{"fax/faxobs/v4.GetDeviceStatusRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetDeviceStatusRequest) }},
+	{"fax/faxobs/v4.GetDeviceStatusResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetDeviceStatusResponse) }},
+	{"fax/faxobs/v4.GetInstallTypeRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetInstallTypeRequest) }},
+	{"fax/faxobs/v4.GetInstallTypeResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetInstallTypeResponse) }},
+	{"fax/faxobs/v4.GetJobRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetJobRequest) }},
+	{"fax/faxobs/v4.GetJobResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetJobResponse) }},
+	{"fax/faxobs/v4.GetLoggingCategoriesRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetLoggingCategoriesRequest) }},
+	{"fax/faxobs/v4.GetLoggingCategoriesResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetLoggingCategoriesResponse) }},
+	{"fax/faxobs/v4.GetMAPIProfilesRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetMAPIProfilesRequest) }},
+	{"fax/faxobs/v4.GetMAPIProfilesResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetMAPIProfilesResponse) }},
+	{"fax/faxobs/v4.GetPageDataRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetPageDataRequest) }},
+	{"fax/faxobs/v4.GetPageDataResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetPageDataResponse) }},
+	{"fax/faxobs/v4.GetPortRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetPortRequest) }},
+	{"fax/faxobs/v4.GetPortResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetPortResponse) }},
+	{"fax/faxobs/v4.GetQueueFileNameRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetQueueFileNameRequest) }},
+	{"fax/faxobs/v4.GetQueueFileNameResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetQueueFileNameResponse) }},
+	{"fax/faxobs/v4.GetRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetRoutingInfoRequest) }},
+	{"fax/faxobs/v4.GetRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetRoutingInfoResponse) }},
+	{"fax/faxobs/v4.GetSecurityDescriptorCountRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetSecurityDescriptorCountRequest) }},
+	{"fax/faxobs/v4.GetSecurityDescriptorCountResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetSecurityDescriptorCountResponse) }},
+	{"fax/faxobs/v4.GetSecurityDescriptorRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetSecurityDescriptorRequest) }},
+	{"fax/faxobs/v4.GetSecurityDescriptorResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetSecurityDescriptorResponse) }},
+	{"fax/faxobs/v4.GetTAPILocationsRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetTAPILocationsRequest) }},
+	{"fax/faxobs/v4.GetTAPILocationsResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetTAPILocationsResponse) }},
+	{"fax/faxobs/v4.GetVersionRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetVersionRequest) }},
+	{"fax/faxobs/v4.GetVersionResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.GetVersionResponse) }},
+	{"fax/faxobs/v4.OpenPortRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.OpenPortRequest) }},
+	{"fax/faxobs/v4.OpenPortResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.OpenPortResponse) }},
+	{"fax/faxobs/v4.SendDocumentRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SendDocumentRequest) }},
+	{"fax/faxobs/v4.SendDocumentResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SendDocumentResponse) }},
+	{"fax/faxobs/v4.SetConfigurationRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetConfigurationRequest) }},
+	{"fax/faxobs/v4.SetConfigurationResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetConfigurationResponse) }},
+	{"fax/faxobs/v4.SetGlobalRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetGlobalRoutingInfoRequest) }},
+	{"fax/faxobs/v4.SetGlobalRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetGlobalRoutingInfoResponse) }},
+	{"fax/faxobs/v4.SetJobRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetJobRequest) }},
+	{"fax/faxobs/v4.SetJobResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetJobResponse) }},
+	{"fax/faxobs/v4.SetLoggingCategoriesRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetLoggingCategoriesRequest) }},
+	{"fax/faxobs/v4.SetLoggingCategoriesResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetLoggingCategoriesResponse) }},
+	{"fax/faxobs/v4.SetPortRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetPortRequest) }},
+	{"fax/faxobs/v4.SetPortResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetPortResponse) }},
+	{"fax/faxobs/v4.SetRoutingInfoRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetRoutingInfoRequest) }},
+	{"fax/faxobs/v4.SetRoutingInfoResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetRoutingInfoResponse) }},
+	{"fax/faxobs/v4.SetSecurityDescriptorRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetSecurityDescriptorRequest) }},
+	{"fax/faxobs/v4.SetSecurityDescriptorResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetSecurityDescriptorResponse) }},
+	{"fax/faxobs/v4.SetTAPILocationsRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetTAPILocationsRequest) }},
+	{"fax/faxobs/v4.SetTAPILocationsResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.SetTAPILocationsResponse) }},
+	{"fax/faxobs/v4.StartClientServerRequest", func() ndr.Unmarshaler { return new(fax_faxobs_v4.StartClientServerRequest) }},
+	{"fax/faxobs/v4.StartClientServerResponse", func() ndr.Unmarshaler { return new(fax_faxobs_v4.StartClientServerResponse) }},
+	{"fsrvp/fileservervssagent/v1.AbortShadowCopySetRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.AbortShadowCopySetRequest) }},
+	{"fsrvp/fileservervssagent/v1.AbortShadowCopySetResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.AbortShadowCopySetResponse) }},
+	{"fsrvp/fileservervssagent/v1.AddToShadowCopySetRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.AddToShadowCopySetRequest) }},
+	{"fsrvp/fileservervssagent/v1.AddToShadowCopySetResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.AddToShadowCopySetResponse) }},
+	{"fsrvp/fileservervssagent/v1.CommitShadowCopySetRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.CommitShadowCopySetRequest) }},
+	{"fsrvp/fileservervssagent/v1.CommitShadowCopySetResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.CommitShadowCopySetResponse) }},
+	{"fsrvp/fileservervssagent/v1.DeleteShareMappingRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.DeleteShareMappingRequest) }},
+	{"fsrvp/fileservervssagent/v1.DeleteShareMappingResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.DeleteShareMappingResponse) }},
+	{"fsrvp/fileservervssagent/v1.ExposeShadowCopySetRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.ExposeShadowCopySetRequest) }},
+	{"fsrvp/fileservervssagent/v1.ExposeShadowCopySetResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.ExposeShadowCopySetResponse) }},
+	{"fsrvp/fileservervssagent/v1.GetShareMappingRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.GetShareMappingRequest) }},
+	{"fsrvp/fileservervssagent/v1.GetShareMappingResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.GetShareMappingResponse) }},
+	{"fsrvp/fileservervssagent/v1.GetSupportedVersionRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.GetSupportedVersionRequest) }},
+	{"fsrvp/fileservervssagent/v1.GetSupportedVersionResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.GetSupportedVersionResponse) }},
+	{"fsrvp/fileservervssagent/v1.IsPathShadowCopiedRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.IsPathShadowCopiedRequest) }},
+	{"fsrvp/fileservervssagent/v1.IsPathShadowCopiedResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.IsPathShadowCopiedResponse) }},
+	{"fsrvp/fileservervssagent/v1.IsPathSupportedRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.IsPathSupportedRequest) }},
+	{"fsrvp/fileservervssagent/v1.IsPathSupportedResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.IsPathSupportedResponse) }},
+	{"fsrvp/fileservervssagent/v1.PrepareShadowCopySetRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.PrepareShadowCopySetRequest) }},
+	{"fsrvp/fileservervssagent/v1.PrepareShadowCopySetResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.PrepareShadowCopySetResponse) }},
+	{"fsrvp/fileservervssagent/v1.RecoveryCompleteShadowCopySetRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.RecoveryCompleteShadowCopySetRequest) }},
+	{"fsrvp/fileservervssagent/v1.RecoveryCompleteShadowCopySetResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.RecoveryCompleteShadowCopySetResponse) }},
+	{"fsrvp/fileservervssagent/v1.SetContextRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.SetContextRequest) }},
+	{"fsrvp/fileservervssagent/v1.SetContextResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.SetContextResponse) }},
+	{"fsrvp/fileservervssagent/v1.StartShadowCopySetRequest", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.StartShadowCopySetRequest) }},
+	{"fsrvp/fileservervssagent/v1.StartShadowCopySetResponse", func() ndr.Unmarshaler { return new(fsrvp_fileservervssagent_v1.StartShadowCopySetResponse) }},
+	{"gkdi/isdkey/v1.GetKeyRequest", func() ndr.Unmarshaler { return new(gkdi_isdkey_v1.GetKeyRequest) }},
+	{"gkdi/isdkey/v1.GetKeyResponse", func() ndr.Unmarshaler { return new(gkdi_isdkey_v1.GetKeyResponse) }},
+	{"icpr/icertpassage/v0.CertServerRequestRequest", func() ndr.Unmarshaler { return new(icpr_icertpassage_v0.CertServerRequestRequest) }},
+	{"icpr/icertpassage/v0.CertServerRequestResponse", func() ndr.Unmarshaler { return new(icpr_icertpassage_v0.CertServerRequestResponse) }},
+	{"irp/inetinfo/v2.ClearStatisticsRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.ClearStatisticsRequest) }},
+	{"irp/inetinfo/v2.ClearStatisticsResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.ClearStatisticsResponse) }},
+	{"irp/inetinfo/v2.FTPClearStatistics2Request", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.FTPClearStatistics2Request) }},
+	{"irp/inetinfo/v2.FTPClearStatistics2Response", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.FTPClearStatistics2Response) }},
+	{"irp/inetinfo/v2.FTPQueryStatistics2Request", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.FTPQueryStatistics2Request) }},
+	{"irp/inetinfo/v2.FTPQueryStatistics2Response", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.FTPQueryStatistics2Response) }},
+	{"irp/inetinfo/v2.FlushMemoryCacheRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.FlushMemoryCacheRequest) }},
+	{"irp/inetinfo/v2.FlushMemoryCacheResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.FlushMemoryCacheResponse) }},
+	{"irp/inetinfo/v2.GetAdminInformationRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetAdminInformationRequest) }},
+	{"irp/inetinfo/v2.GetAdminInformationResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetAdminInformationResponse) }},
+	{"irp/inetinfo/v2.GetGlobalAdminInformationRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetGlobalAdminInformationRequest) }},
+	{"irp/inetinfo/v2.GetGlobalAdminInformationResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetGlobalAdminInformationResponse) }},
+	{"irp/inetinfo/v2.GetServerCapabilitiesRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetServerCapabilitiesRequest) }},
+	{"irp/inetinfo/v2.GetServerCapabilitiesResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetServerCapabilitiesResponse) }},
+	{"irp/inetinfo/v2.GetSitesRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetSitesRequest) }},
+	{"irp/inetinfo/v2.GetSitesResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetSitesResponse) }},
+	{"irp/inetinfo/v2.GetVersionRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetVersionRequest) }},
+	{"irp/inetinfo/v2.GetVersionResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.GetVersionResponse) }},
+	{"irp/inetinfo/v2.IISDisconnectUserRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.IISDisconnectUserRequest) }},
+	{"irp/inetinfo/v2.IISDisconnectUserResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.IISDisconnectUserResponse) }},
+	{"irp/inetinfo/v2.IISEnumerateUsersRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.IISEnumerateUsersRequest) }},
+	{"irp/inetinfo/v2.IISEnumerateUsersResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.IISEnumerateUsersResponse) }},
+	{"irp/inetinfo/v2.QueryStatisticsRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.QueryStatisticsRequest) }},
+	{"irp/inetinfo/v2.QueryStatisticsResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.QueryStatisticsResponse) }},
+	{"irp/inetinfo/v2.SetAdminInformationRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.SetAdminInformationRequest) }},
+	{"irp/inetinfo/v2.SetAdminInformationResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.SetAdminInformationResponse) }},
+	{"irp/inetinfo/v2.SetGlobalAdminInformationRequest", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.SetGlobalAdminInformationRequest) }},
+	{"irp/inetinfo/v2.SetGlobalAdminInformationResponse", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.SetGlobalAdminInformationResponse) }},
+	{"irp/inetinfo/v2.W3ClearStatistics2Request", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.W3ClearStatistics2Request) }},
+	{"irp/inetinfo/v2.W3ClearStatistics2Response", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.W3ClearStatistics2Response) }},
+	{"irp/inetinfo/v2.W3QueryStatistics2Request", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.W3QueryStatistics2Request) }},
+	{"irp/inetinfo/v2.W3QueryStatistics2Response", func() ndr.Unmarshaler { return new(irp_inetinfo_v2.W3QueryStatistics2Response) }},
+	{"lrec/neteventforwarder/v1.CloseSessionRequest", func() ndr.Unmarshaler { return new(lrec_neteventforwarder_v1.CloseSessionRequest) }},
+	{"lrec/neteventforwarder/v1.CloseSessionResponse", func() ndr.Unmarshaler { return new(lrec_neteventforwarder_v1.CloseSessionResponse) }},
+	{"lrec/neteventforwarder/v1.OpenSessionRequest", func() ndr.Unmarshaler { return new(lrec_neteventforwarder_v1.OpenSessionRequest) }},
+	{"lrec/neteventforwarder/v1.OpenSessionResponse", func() ndr.Unmarshaler { return new(lrec_neteventforwarder_v1.OpenSessionResponse) }},
+	{"lrec/neteventforwarder/v1.ReceiveDataRequest", func() ndr.Unmarshaler { return new(lrec_neteventforwarder_v1.ReceiveDataRequest) }},
+	{"lrec/neteventforwarder/v1.ReceiveDataResponse", func() ndr.Unmarshaler { return new(lrec_neteventforwarder_v1.ReceiveDataResponse) }},
+	{"lsad/lsarpc/v0.AddAccountRightsRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.AddAccountRightsRequest) }},
+	{"lsad/lsarpc/v0.AddAccountRightsResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.AddAccountRightsResponse) }},
+	{"lsad/lsarpc/v0.AddPrivilegesToAccountRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.AddPrivilegesToAccountRequest) }},
+	{"lsad/lsarpc/v0.AddPrivilegesToAccountResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.AddPrivilegesToAccountResponse) }},
+	{"lsad/lsarpc/v0.CloseRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CloseRequest) }},
+	{"lsad/lsarpc/v0.CloseResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CloseResponse) }},
+	{"lsad/lsarpc/v0.CreateAccountRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateAccountRequest) }},
+	{"lsad/lsarpc/v0.CreateAccountResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateAccountResponse) }},
+	{"lsad/lsarpc/v0.CreateSecretRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateSecretRequest) }},
+	{"lsad/lsarpc/v0.CreateSecretResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateSecretResponse) }},
+	{"lsad/lsarpc/v0.CreateTrustedDomainEx2Request", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateTrustedDomainEx2Request) }},
+	{"lsad/lsarpc/v0.CreateTrustedDomainEx2Response", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateTrustedDomainEx2Response) }},
+	{"lsad/lsarpc/v0.CreateTrustedDomainExRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateTrustedDomainExRequest) }},
+	{"lsad/lsarpc/v0.CreateTrustedDomainExResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateTrustedDomainExResponse) }},
+	{"lsad/lsarpc/v0.CreateTrustedDomainRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateTrustedDomainRequest) }},
+	{"lsad/lsarpc/v0.CreateTrustedDomainResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.CreateTrustedDomainResponse) }},
+	{"lsad/lsarpc/v0.DeleteObjectRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.DeleteObjectRequest) }},
+	{"lsad/lsarpc/v0.DeleteObjectResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.DeleteObjectResponse) }},
+	{"lsad/lsarpc/v0.DeleteTrustedDomainRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.DeleteTrustedDomainRequest) }},
+	{"lsad/lsarpc/v0.DeleteTrustedDomainResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.DeleteTrustedDomainResponse) }},
+	{"lsad/lsarpc/v0.EnumerateAccountRightsRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateAccountRightsRequest) }},
+	{"lsad/lsarpc/v0.EnumerateAccountRightsResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateAccountRightsResponse) }},
+	{"lsad/lsarpc/v0.EnumerateAccountsRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateAccountsRequest) }},
+	{"lsad/lsarpc/v0.EnumerateAccountsResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateAccountsResponse) }},
+	{"lsad/lsarpc/v0.EnumerateAccountsWithUserRightRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateAccountsWithUserRightRequest) }},
+	{"lsad/lsarpc/v0.EnumerateAccountsWithUserRightResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateAccountsWithUserRightResponse) }},
+	{"lsad/lsarpc/v0.EnumeratePrivilegesAccountRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumeratePrivilegesAccountRequest) }},
+	{"lsad/lsarpc/v0.EnumeratePrivilegesAccountResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumeratePrivilegesAccountResponse) }},
+	{"lsad/lsarpc/v0.EnumeratePrivilegesRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumeratePrivilegesRequest) }},
+	{"lsad/lsarpc/v0.EnumeratePrivilegesResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumeratePrivilegesResponse) }},
+	{"lsad/lsarpc/v0.EnumerateTrustedDomainsExRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateTrustedDomainsExRequest) }},
+	{"lsad/lsarpc/v0.EnumerateTrustedDomainsExResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateTrustedDomainsExResponse) }},
+	{"lsad/lsarpc/v0.EnumerateTrustedDomainsRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateTrustedDomainsRequest) }},
+	{"lsad/lsarpc/v0.EnumerateTrustedDomainsResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.EnumerateTrustedDomainsResponse) }},
+	{"lsad/lsarpc/v0.GetSystemAccessAccountRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.GetSystemAccessAccountRequest) }},
+	{"lsad/lsarpc/v0.GetSystemAccessAccountResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.GetSystemAccessAccountResponse) }},
+	{"lsad/lsarpc/v0.LookupPrivilegeDisplayNameRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.LookupPrivilegeDisplayNameRequest) }},
+	{"lsad/lsarpc/v0.LookupPrivilegeDisplayNameResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.LookupPrivilegeDisplayNameResponse) }},
+	{"lsad/lsarpc/v0.LookupPrivilegeNameRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.LookupPrivilegeNameRequest) }},
+	{"lsad/lsarpc/v0.LookupPrivilegeNameResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.LookupPrivilegeNameResponse) }},
+	{"lsad/lsarpc/v0.LookupPrivilegeValueRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.LookupPrivilegeValueRequest) }},
+	{"lsad/lsarpc/v0.LookupPrivilegeValueResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.LookupPrivilegeValueResponse) }},
+	{"lsad/lsarpc/v0.OpenAccountRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenAccountRequest) }},
+	{"lsad/lsarpc/v0.OpenAccountResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenAccountResponse) }},
+	{"lsad/lsarpc/v0.OpenPolicy2Request", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenPolicy2Request) }},
+	{"lsad/lsarpc/v0.OpenPolicy2Response", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenPolicy2Response) }},
+	{"lsad/lsarpc/v0.OpenPolicyRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenPolicyRequest) }},
+	{"lsad/lsarpc/v0.OpenPolicyResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenPolicyResponse) }},
+	{"lsad/lsarpc/v0.OpenSecretRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenSecretRequest) }},
+	{"lsad/lsarpc/v0.OpenSecretResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenSecretResponse) }},
+	{"lsad/lsarpc/v0.OpenTrustedDomainByNameRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenTrustedDomainByNameRequest) }},
+	{"lsad/lsarpc/v0.OpenTrustedDomainByNameResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenTrustedDomainByNameResponse) }},
+	{"lsad/lsarpc/v0.OpenTrustedDomainRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenTrustedDomainRequest) }},
+	{"lsad/lsarpc/v0.OpenTrustedDomainResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.OpenTrustedDomainResponse) }},
+	{"lsad/lsarpc/v0.QueryDomainInformationPolicyRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryDomainInformationPolicyRequest) }},
+	{"lsad/lsarpc/v0.QueryDomainInformationPolicyResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryDomainInformationPolicyResponse) }},
+	{"lsad/lsarpc/v0.QueryForestTrustInformationRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryForestTrustInformationRequest) }},
+	{"lsad/lsarpc/v0.QueryForestTrustInformationResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryForestTrustInformationResponse) }},
+	{"lsad/lsarpc/v0.QueryInfoTrustedDomainRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryInfoTrustedDomainRequest) }},
+	{"lsad/lsarpc/v0.QueryInfoTrustedDomainResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryInfoTrustedDomainResponse) }},
+	{"lsad/lsarpc/v0.QueryInformationPolicy2Request", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryInformationPolicy2Request) }},
+	{"lsad/lsarpc/v0.QueryInformationPolicy2Response", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryInformationPolicy2Response) }},
+	{"lsad/lsarpc/v0.QueryInformationPolicyRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryInformationPolicyRequest) }},
+	{"lsad/lsarpc/v0.QueryInformationPolicyResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryInformationPolicyResponse) }},
+	{"lsad/lsarpc/v0.QuerySecretRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QuerySecretRequest) }},
+	{"lsad/lsarpc/v0.QuerySecretResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QuerySecretResponse) }},
+	{"lsad/lsarpc/v0.QuerySecurityObjectRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QuerySecurityObjectRequest) }},
+	{"lsad/lsarpc/v0.QuerySecurityObjectResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QuerySecurityObjectResponse) }},
+	{"lsad/lsarpc/v0.QueryTrustedDomainInfoByNameRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryTrustedDomainInfoByNameRequest) }},
+	{"lsad/lsarpc/v0.QueryTrustedDomainInfoByNameResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryTrustedDomainInfoByNameResponse) }},
+	{"lsad/lsarpc/v0.QueryTrustedDomainInfoRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryTrustedDomainInfoRequest) }},
+	{"lsad/lsarpc/v0.QueryTrustedDomainInfoResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.QueryTrustedDomainInfoResponse) }},
+	{"lsad/lsarpc/v0.RemoveAccountRightsRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.RemoveAccountRightsRequest) }},
+	{"lsad/lsarpc/v0.RemoveAccountRightsResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.RemoveAccountRightsResponse) }},
+	{"lsad/lsarpc/v0.RemovePrivilegesFromAccountRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.RemovePrivilegesFromAccountRequest) }},
+	{"lsad/lsarpc/v0.RemovePrivilegesFromAccountResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.RemovePrivilegesFromAccountResponse) }},
+	{"lsad/lsarpc/v0.RetrievePrivateDataRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.RetrievePrivateDataRequest) }},
+	{"lsad/lsarpc/v0.RetrievePrivateDataResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.RetrievePrivateDataResponse) }},
+	{"lsad/lsarpc/v0.SetDomainInformationPolicyRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetDomainInformationPolicyRequest) }},
+	{"lsad/lsarpc/v0.SetDomainInformationPolicyResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetDomainInformationPolicyResponse) }},
+	{"lsad/lsarpc/v0.SetForestTrustInformationRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetForestTrustInformationRequest) }},
+	{"lsad/lsarpc/v0.SetForestTrustInformationResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetForestTrustInformationResponse) }},
+	{"lsad/lsarpc/v0.SetInformationPolicy2Request", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetInformationPolicy2Request) }},
+	{"lsad/lsarpc/v0.SetInformationPolicy2Response", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetInformationPolicy2Response) }},
+	{"lsad/lsarpc/v0.SetInformationPolicyRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetInformationPolicyRequest) }},
+	{"lsad/lsarpc/v0.SetInformationPolicyResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetInformationPolicyResponse) }},
+	{"lsad/lsarpc/v0.SetInformationTrustedDomainRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetInformationTrustedDomainRequest) }},
+	{"lsad/lsarpc/v0.SetInformationTrustedDomainResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetInformationTrustedDomainResponse) }},
+	{"lsad/lsarpc/v0.SetSecretRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetSecretRequest) }},
+	{"lsad/lsarpc/v0.SetSecretResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetSecretResponse) }},
+	{"lsad/lsarpc/v0.SetSecurityObjectRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetSecurityObjectRequest) }},
+	{"lsad/lsarpc/v0.SetSecurityObjectResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetSecurityObjectResponse) }},
+	{"lsad/lsarpc/v0.SetSystemAccessAccountRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetSystemAccessAccountRequest) }},
+	{"lsad/lsarpc/v0.SetSystemAccessAccountResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetSystemAccessAccountResponse) }},
+	{"lsad/lsarpc/v0.SetTrustedDomainInfoByNameRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetTrustedDomainInfoByNameRequest) }},
+	{"lsad/lsarpc/v0.SetTrustedDomainInfoByNameResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetTrustedDomainInfoByNameResponse) }},
+	{"lsad/lsarpc/v0.SetTrustedDomainInfoRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetTrustedDomainInfoRequest) }},
+	{"lsad/lsarpc/v0.SetTrustedDomainInfoResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.SetTrustedDomainInfoResponse) }},
+	{"lsad/lsarpc/v0.StorePrivateDataRequest", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.StorePrivateDataRequest) }},
+	{"lsad/lsarpc/v0.StorePrivateDataResponse", func() ndr.Unmarshaler { return new(lsad_lsarpc_v0.StorePrivateDataResponse) }},
+	{"lsat/lsarpc/v0.CloseRequest", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.CloseRequest) }},
+	{"lsat/lsarpc/v0.CloseResponse", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.CloseResponse) }},
+	{"lsat/lsarpc/v0.GetUserNameRequest", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.GetUserNameRequest) }},
+	{"lsat/lsarpc/v0.GetUserNameResponse", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.GetUserNameResponse) }},
+	{"lsat/lsarpc/v0.LookupNames2Request", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNames2Request) }},
+	{"lsat/lsarpc/v0.LookupNames2Response", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNames2Response) }},
+	{"lsat/lsarpc/v0.LookupNames3Request", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNames3Request) }},
+	{"lsat/lsarpc/v0.LookupNames3Response", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNames3Response) }},
+	{"lsat/lsarpc/v0.LookupNames4Request", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNames4Request) }},
+	{"lsat/lsarpc/v0.LookupNames4Response", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNames4Response) }},
+	{"lsat/lsarpc/v0.LookupNamesRequest", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNamesRequest) }},
+	{"lsat/lsarpc/v0.LookupNamesResponse", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupNamesResponse) }},
+	{"lsat/lsarpc/v0.LookupSIDsRequest", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupSIDsRequest) }},
+	{"lsat/lsarpc/v0.LookupSIDsResponse", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupSIDsResponse) }},
+	{"lsat/lsarpc/v0.LookupSids2Request", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupSids2Request) }},
+	{"lsat/lsarpc/v0.LookupSids2Response", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupSids2Response) }},
+	{"lsat/lsarpc/v0.LookupSids3Request", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupSids3Request) }},
+	{"lsat/lsarpc/v0.LookupSids3Response", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.LookupSids3Response) }},
+	{"lsat/lsarpc/v0.OpenPolicy2Request", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.OpenPolicy2Request) }},
+	{"lsat/lsarpc/v0.OpenPolicy2Response", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.OpenPolicy2Response) }},
+	{"lsat/lsarpc/v0.OpenPolicyRequest", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.OpenPolicyRequest) }},
+	{"lsat/lsarpc/v0.OpenPolicyResponse", func() ndr.Unmarshaler { return new(lsat_lsarpc_v0.OpenPolicyResponse) }},
+	{"mqds/dscomm/v1.CloseServerRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.CloseServerRequest) }},
+	{"mqds/dscomm/v1.CloseServerResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.CloseServerResponse) }},
+	{"mqds/dscomm/v1.CreateObjectRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.CreateObjectRequest) }},
+	{"mqds/dscomm/v1.CreateObjectResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.CreateObjectResponse) }},
+	{"mqds/dscomm/v1.CreateServersCacheRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.CreateServersCacheRequest) }},
+	{"mqds/dscomm/v1.CreateServersCacheResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.CreateServersCacheResponse) }},
+	{"mqds/dscomm/v1.DeleteObjectGUIDRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.DeleteObjectGUIDRequest) }},
+	{"mqds/dscomm/v1.DeleteObjectGUIDResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.DeleteObjectGUIDResponse) }},
+	{"mqds/dscomm/v1.DeleteObjectRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.DeleteObjectRequest) }},
+	{"mqds/dscomm/v1.DeleteObjectResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.DeleteObjectResponse) }},
+	{"mqds/dscomm/v1.GetObjectSecurityGUIDRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetObjectSecurityGUIDRequest) }},
+	{"mqds/dscomm/v1.GetObjectSecurityGUIDResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetObjectSecurityGUIDResponse) }},
+	{"mqds/dscomm/v1.GetObjectSecurityRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetObjectSecurityRequest) }},
+	{"mqds/dscomm/v1.GetObjectSecurityResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetObjectSecurityResponse) }},
+	{"mqds/dscomm/v1.GetPropertiesGUIDRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetPropertiesGUIDRequest) }},
+	{"mqds/dscomm/v1.GetPropertiesGUIDResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetPropertiesGUIDResponse) }},
+	{"mqds/dscomm/v1.GetPropertiesRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetPropertiesRequest) }},
+	{"mqds/dscomm/v1.GetPropertiesResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetPropertiesResponse) }},
+	{"mqds/dscomm/v1.GetServerPortRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetServerPortRequest) }},
+	{"mqds/dscomm/v1.GetServerPortResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.GetServerPortResponse) }},
+	{"mqds/dscomm/v1.InitSecurityContextRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.InitSecurityContextRequest) }},
+	{"mqds/dscomm/v1.InitSecurityContextResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.InitSecurityContextResponse) }},
+	{"mqds/dscomm/v1.LookupBeginRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.LookupBeginRequest) }},
+	{"mqds/dscomm/v1.LookupBeginResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.LookupBeginResponse) }},
+	{"mqds/dscomm/v1.LookupEndRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.LookupEndRequest) }},
+	{"mqds/dscomm/v1.LookupEndResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.LookupEndResponse) }},
+	{"mqds/dscomm/v1.LookupNextRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.LookupNextRequest) }},
+	{"mqds/dscomm/v1.LookupNextResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.LookupNextResponse) }},
+	{"mqds/dscomm/v1.QMGetObjectSecurityChallengeResponseProcRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMGetObjectSecurityChallengeResponseProcRequest) }},
+	{"mqds/dscomm/v1.QMGetObjectSecurityChallengeResponseProcResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMGetObjectSecurityChallengeResponseProcResponse) }},
+	{"mqds/dscomm/v1.QMGetObjectSecurityRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMGetObjectSecurityRequest) }},
+	{"mqds/dscomm/v1.QMGetObjectSecurityResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMGetObjectSecurityResponse) }},
+	{"mqds/dscomm/v1.QMSetMachinePropertiesRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMSetMachinePropertiesRequest) }},
+	{"mqds/dscomm/v1.QMSetMachinePropertiesResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMSetMachinePropertiesResponse) }},
+	{"mqds/dscomm/v1.QMSetMachinePropertiesSignProcRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMSetMachinePropertiesSignProcRequest) }},
+	{"mqds/dscomm/v1.QMSetMachinePropertiesSignProcResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.QMSetMachinePropertiesSignProcResponse) }},
+	{"mqds/dscomm/v1.SetObjectSecurityGUIDRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetObjectSecurityGUIDRequest) }},
+	{"mqds/dscomm/v1.SetObjectSecurityGUIDResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetObjectSecurityGUIDResponse) }},
+	{"mqds/dscomm/v1.SetObjectSecurityRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetObjectSecurityRequest) }},
+	{"mqds/dscomm/v1.SetObjectSecurityResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetObjectSecurityResponse) }},
+	{"mqds/dscomm/v1.SetPropertiesGUIDRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetPropertiesGUIDRequest) }},
+	{"mqds/dscomm/v1.SetPropertiesGUIDResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetPropertiesGUIDResponse) }},
+	{"mqds/dscomm/v1.SetPropertiesRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetPropertiesRequest) }},
+	{"mqds/dscomm/v1.SetPropertiesResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.SetPropertiesResponse) }},
+	{"mqds/dscomm/v1.ValidateServerRequest", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.ValidateServerRequest) }},
+	{"mqds/dscomm/v1.ValidateServerResponse", func() ndr.Unmarshaler { return new(mqds_dscomm_v1.ValidateServerResponse) }},
+	{"mqds/dscomm2/v1.BeginDeleteNotificationRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.BeginDeleteNotificationRequest) }},
+	{"mqds/dscomm2/v1.BeginDeleteNotificationResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.BeginDeleteNotificationResponse) }},
+	{"mqds/dscomm2/v1.EndDeleteNotificationRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.EndDeleteNotificationRequest) }},
+	{"mqds/dscomm2/v1.EndDeleteNotificationResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.EndDeleteNotificationResponse) }},
+	{"mqds/dscomm2/v1.GetComputerSitesRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetComputerSitesRequest) }},
+	{"mqds/dscomm2/v1.GetComputerSitesResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetComputerSitesResponse) }},
+	{"mqds/dscomm2/v1.GetGCListInDomainRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetGCListInDomainRequest) }},
+	{"mqds/dscomm2/v1.GetGCListInDomainResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetGCListInDomainResponse) }},
+	{"mqds/dscomm2/v1.GetPropertiesExRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetPropertiesExRequest) }},
+	{"mqds/dscomm2/v1.GetPropertiesExResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetPropertiesExResponse) }},
+	{"mqds/dscomm2/v1.GetPropertiesGUIDExRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetPropertiesGUIDExRequest) }},
+	{"mqds/dscomm2/v1.GetPropertiesGUIDExResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.GetPropertiesGUIDExResponse) }},
+	{"mqds/dscomm2/v1.IsServerGCRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.IsServerGCRequest) }},
+	{"mqds/dscomm2/v1.IsServerGCResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.IsServerGCResponse) }},
+	{"mqds/dscomm2/v1.NotifyDeleteRequest", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.NotifyDeleteRequest) }},
+	{"mqds/dscomm2/v1.NotifyDeleteResponse", func() ndr.Unmarshaler { return new(mqds_dscomm2_v1.NotifyDeleteResponse) }},
+	{"mqmp/qmcomm/v1.AbortTransactionRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.AbortTransactionRequest) }},
+	{"mqmp/qmcomm/v1.AbortTransactionResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.AbortTransactionResponse) }},
+	{"mqmp/qmcomm/v1.CloseCursorRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CloseCursorRequest) }},
+	{"mqmp/qmcomm/v1.CloseCursorResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CloseCursorResponse) }},
+	{"mqmp/qmcomm/v1.CloseHandleRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CloseHandleRequest) }},
+	{"mqmp/qmcomm/v1.CloseHandleResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CloseHandleResponse) }},
+	{"mqmp/qmcomm/v1.CloseRemoteQueueContextRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CloseRemoteQueueContextRequest) }},
+	{"mqmp/qmcomm/v1.CloseRemoteQueueContextResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CloseRemoteQueueContextResponse) }},
+	{"mqmp/qmcomm/v1.CommitTransactionRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CommitTransactionRequest) }},
+	{"mqmp/qmcomm/v1.CommitTransactionResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CommitTransactionResponse) }},
+	{"mqmp/qmcomm/v1.CreateObjectInternalRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CreateObjectInternalRequest) }},
+	{"mqmp/qmcomm/v1.CreateObjectInternalResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CreateObjectInternalResponse) }},
+	{"mqmp/qmcomm/v1.CreateRemoteCursorRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CreateRemoteCursorRequest) }},
+	{"mqmp/qmcomm/v1.CreateRemoteCursorResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.CreateRemoteCursorResponse) }},
+	{"mqmp/qmcomm/v1.DeleteObjectRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.DeleteObjectRequest) }},
+	{"mqmp/qmcomm/v1.DeleteObjectResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.DeleteObjectResponse) }},
+	{"mqmp/qmcomm/v1.EnlistInternalTransactionRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.EnlistInternalTransactionRequest) }},
+	{"mqmp/qmcomm/v1.EnlistInternalTransactionResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.EnlistInternalTransactionResponse) }},
+	{"mqmp/qmcomm/v1.EnlistTransactionRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.EnlistTransactionRequest) }},
+	{"mqmp/qmcomm/v1.EnlistTransactionResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.EnlistTransactionResponse) }},
+	{"mqmp/qmcomm/v1.GetObjectPropertiesRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetObjectPropertiesRequest) }},
+	{"mqmp/qmcomm/v1.GetObjectPropertiesResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetObjectPropertiesResponse) }},
+	{"mqmp/qmcomm/v1.GetObjectSecurityInternalRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetObjectSecurityInternalRequest) }},
+	{"mqmp/qmcomm/v1.GetObjectSecurityInternalResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetObjectSecurityInternalResponse) }},
+	{"mqmp/qmcomm/v1.GetRTQMServerPortRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetRTQMServerPortRequest) }},
+	{"mqmp/qmcomm/v1.GetRTQMServerPortResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetRTQMServerPortResponse) }},
+	{"mqmp/qmcomm/v1.GetRemoteQueueNameRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetRemoteQueueNameRequest) }},
+	{"mqmp/qmcomm/v1.GetRemoteQueueNameResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetRemoteQueueNameResponse) }},
+	{"mqmp/qmcomm/v1.GetTMWhereaboutsRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetTMWhereaboutsRequest) }},
+	{"mqmp/qmcomm/v1.GetTMWhereaboutsResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.GetTMWhereaboutsResponse) }},
+	{"mqmp/qmcomm/v1.HandleToFormatNameRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.HandleToFormatNameRequest) }},
+	{"mqmp/qmcomm/v1.HandleToFormatNameResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.HandleToFormatNameResponse) }},
+	{"mqmp/qmcomm/v1.ObjectPathToObjectFormatRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.ObjectPathToObjectFormatRequest) }},
+	{"mqmp/qmcomm/v1.ObjectPathToObjectFormatResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.ObjectPathToObjectFormatResponse) }},
+	{"mqmp/qmcomm/v1.OpenQueueInternalRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.OpenQueueInternalRequest) }},
+	{"mqmp/qmcomm/v1.OpenQueueInternalResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.OpenQueueInternalResponse) }},
+	{"mqmp/qmcomm/v1.OpenRemoteQueueRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.OpenRemoteQueueRequest) }},
+	{"mqmp/qmcomm/v1.OpenRemoteQueueResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.OpenRemoteQueueResponse) }},
+	{"mqmp/qmcomm/v1.PurgeQueueRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.PurgeQueueRequest) }},
+	{"mqmp/qmcomm/v1.PurgeQueueResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.PurgeQueueResponse) }},
+	{"mqmp/qmcomm/v1.QueryQMRegistryInternalRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.QueryQMRegistryInternalRequest) }},
+	{"mqmp/qmcomm/v1.QueryQMRegistryInternalResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.QueryQMRegistryInternalResponse) }},
+	{"mqmp/qmcomm/v1.SetCursorPropertiesRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.SetCursorPropertiesRequest) }},
+	{"mqmp/qmcomm/v1.SetCursorPropertiesResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.SetCursorPropertiesResponse) }},
+	{"mqmp/qmcomm/v1.SetObjectPropertiesRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.SetObjectPropertiesRequest) }},
+	{"mqmp/qmcomm/v1.SetObjectPropertiesResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.SetObjectPropertiesResponse) }},
+	{"mqmp/qmcomm/v1.SetObjectSecurityInternalRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.SetObjectSecurityInternalRequest) }},
+	{"mqmp/qmcomm/v1.SetObjectSecurityInternalResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm_v1.SetObjectSecurityInternalResponse) }},
+	{"mqmp/qmcomm2/v1.CreateCursorExRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.CreateCursorExRequest) }},
+	{"mqmp/qmcomm2/v1.CreateCursorExResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.CreateCursorExResponse) }},
+	{"mqmp/qmcomm2/v1.QMSendMessageInternalExRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.QMSendMessageInternalExRequest) }},
+	{"mqmp/qmcomm2/v1.QMSendMessageInternalExResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.QMSendMessageInternalExResponse) }},
+	{"mqmp/qmcomm2/v1.ReceiveMessageExRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.ReceiveMessageExRequest) }},
+	{"mqmp/qmcomm2/v1.ReceiveMessageExResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.ReceiveMessageExResponse) }},
+	{"mqmp/qmcomm2/v1.SendMessageExRequest", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.SendMessageExRequest) }},
+	{"mqmp/qmcomm2/v1.SendMessageExResponse", func() ndr.Unmarshaler { return new(mqmp_qmcomm2_v1.SendMessageExResponse) }},
+	{"mqmr/qmmgmt/v1.ManagementActionRequest", func() ndr.Unmarshaler { return new(mqmr_qmmgmt_v1.ManagementActionRequest) }},
+	{"mqmr/qmmgmt/v1.ManagementActionResponse", func() ndr.Unmarshaler { return new(mqmr_qmmgmt_v1.ManagementActionResponse) }},
+	{"mqmr/qmmgmt/v1.ManagementGetInfoRequest", func() ndr.Unmarshaler { return new(mqmr_qmmgmt_v1.ManagementGetInfoRequest) }},
+	{"mqmr/qmmgmt/v1.ManagementGetInfoResponse", func() ndr.Unmarshaler { return new(mqmr_qmmgmt_v1.ManagementGetInfoResponse) }},
+	{"mqqp/qm2qm/v1.CancelReceiveRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.CancelReceiveRequest) }},
+	{"mqqp/qm2qm/v1.CancelReceiveResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.CancelReceiveResponse) }},
+	{"mqqp/qm2qm/v1.CloseCursorRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.CloseCursorRequest) }},
+	{"mqqp/qm2qm/v1.CloseCursorResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.CloseCursorResponse) }},
+	{"mqqp/qm2qm/v1.CloseQueueRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.CloseQueueRequest) }},
+	{"mqqp/qm2qm/v1.CloseQueueResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.CloseQueueResponse) }},
+	{"mqqp/qm2qm/v1.EndReceiveRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.EndReceiveRequest) }},
+	{"mqqp/qm2qm/v1.EndReceiveResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.EndReceiveResponse) }},
+	{"mqqp/qm2qm/v1.GetQMQMServerPortRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.GetQMQMServerPortRequest) }},
+	{"mqqp/qm2qm/v1.GetQMQMServerPortResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.GetQMQMServerPortResponse) }},
+	{"mqqp/qm2qm/v1.GetVersionRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.GetVersionRequest) }},
+	{"mqqp/qm2qm/v1.GetVersionResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.GetVersionResponse) }},
+	{"mqqp/qm2qm/v1.OpenQueueRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.OpenQueueRequest) }},
+	{"mqqp/qm2qm/v1.OpenQueueResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.OpenQueueResponse) }},
+	{"mqqp/qm2qm/v1.PurgeQueueRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.PurgeQueueRequest) }},
+	{"mqqp/qm2qm/v1.PurgeQueueResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.PurgeQueueResponse) }},
+	{"mqqp/qm2qm/v1.StartReceive2Request", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.StartReceive2Request) }},
+	{"mqqp/qm2qm/v1.StartReceive2Response", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.StartReceive2Response) }},
+	{"mqqp/qm2qm/v1.StartReceiveByLookupIDRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.StartReceiveByLookupIDRequest) }},
+	{"mqqp/qm2qm/v1.StartReceiveByLookupIDResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.StartReceiveByLookupIDResponse) }},
+	{"mqqp/qm2qm/v1.StartReceiveRequest", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.StartReceiveRequest) }},
+	{"mqqp/qm2qm/v1.StartReceiveResponse", func() ndr.Unmarshaler { return new(mqqp_qm2qm_v1.StartReceiveResponse) }},
+	{"mqrr/remoteread/v1.CancelReceiveRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CancelReceiveRequest) }},
+	{"mqrr/remoteread/v1.CancelReceiveResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CancelReceiveResponse) }},
+	{"mqrr/remoteread/v1.CloseCursorRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CloseCursorRequest) }},
+	{"mqrr/remoteread/v1.CloseCursorResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CloseCursorResponse) }},
+	{"mqrr/remoteread/v1.CloseQueueRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CloseQueueRequest) }},
+	{"mqrr/remoteread/v1.CloseQueueResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CloseQueueResponse) }},
+	{"mqrr/remoteread/v1.CreateCursorRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CreateCursorRequest) }},
+	{"mqrr/remoteread/v1.CreateCursorResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.CreateCursorResponse) }},
+	{"mqrr/remoteread/v1.EndReceiveRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.EndReceiveRequest) }},
+	{"mqrr/remoteread/v1.EndReceiveResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.EndReceiveResponse) }},
+	{"mqrr/remoteread/v1.EndTransactionalReceiveRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.EndTransactionalReceiveRequest) }},
+	{"mqrr/remoteread/v1.EndTransactionalReceiveResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.EndTransactionalReceiveResponse) }},
+	{"mqrr/remoteread/v1.EnlistRemoteTransactionRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.EnlistRemoteTransactionRequest) }},
+	{"mqrr/remoteread/v1.EnlistRemoteTransactionResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.EnlistRemoteTransactionResponse) }},
+	{"mqrr/remoteread/v1.GetServerPortRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.GetServerPortRequest) }},
+	{"mqrr/remoteread/v1.GetServerPortResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.GetServerPortResponse) }},
+	{"mqrr/remoteread/v1.MoveMessageRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.MoveMessageRequest) }},
+	{"mqrr/remoteread/v1.MoveMessageResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.MoveMessageResponse) }},
+	{"mqrr/remoteread/v1.OpenQueueForMoveRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.OpenQueueForMoveRequest) }},
+	{"mqrr/remoteread/v1.OpenQueueForMoveResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.OpenQueueForMoveResponse) }},
+	{"mqrr/remoteread/v1.OpenQueueRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.OpenQueueRequest) }},
+	{"mqrr/remoteread/v1.OpenQueueResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.OpenQueueResponse) }},
+	{"mqrr/remoteread/v1.PurgeQueueRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.PurgeQueueRequest) }},
+	{"mqrr/remoteread/v1.PurgeQueueResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.PurgeQueueResponse) }},
+	{"mqrr/remoteread/v1.SetUserAcknowledgementClassRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.SetUserAcknowledgementClassRequest) }},
+	{"mqrr/remoteread/v1.SetUserAcknowledgementClassResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.SetUserAcknowledgementClassResponse) }},
+	{"mqrr/remoteread/v1.StartReceiveRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.StartReceiveRequest) }},
+	{"mqrr/remoteread/v1.StartReceiveResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.StartReceiveResponse) }},
+	{"mqrr/remoteread/v1.StartTransactionalReceiveRequest", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.StartTransactionalReceiveRequest) }},
+	{"mqrr/remoteread/v1.StartTransactionalReceiveResponse", func() ndr.Unmarshaler { return new(mqrr_remoteread_v1.StartTransactionalReceiveResponse) }},
+	{"msrp/msgsvc/v1.MessageNameAddRequest", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameAddRequest) }},
+	{"msrp/msgsvc/v1.MessageNameAddResponse", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameAddResponse) }},
+	{"msrp/msgsvc/v1.MessageNameDeleteRequest", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameDeleteRequest) }},
+	{"msrp/msgsvc/v1.MessageNameDeleteResponse", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameDeleteResponse) }},
+	{"msrp/msgsvc/v1.MessageNameEnumRequest", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameEnumRequest) }},
+	{"msrp/msgsvc/v1.MessageNameEnumResponse", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameEnumResponse) }},
+	{"msrp/msgsvc/v1.MessageNameGetInfoRequest", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameGetInfoRequest) }},
+	{"msrp/msgsvc/v1.MessageNameGetInfoResponse", func() ndr.Unmarshaler { return new(msrp_msgsvc_v1.MessageNameGetInfoResponse) }},
+	{"msrp/msgsvcsend/v1.SendMessageRequest", func() ndr.Unmarshaler { return new(msrp_msgsvcsend_v1.SendMessageRequest) }},
+	{"msrp/msgsvcsend/v1.SendMessageResponse", func() ndr.Unmarshaler { return new(msrp_msgsvcsend_v1.SendMessageResponse) }},
+	{"nrpc/logon/v1.AccountDeltasRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AccountDeltasRequest) }},
+	{"nrpc/logon/v1.AccountDeltasResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AccountDeltasResponse) }},
+	{"nrpc/logon/v1.AccountSyncRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AccountSyncRequest) }},
+	{"nrpc/logon/v1.AccountSyncResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AccountSyncResponse) }},
+	{"nrpc/logon/v1.AddressToSiteNamesExWRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AddressToSiteNamesExWRequest) }},
+	{"nrpc/logon/v1.AddressToSiteNamesExWResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AddressToSiteNamesExWResponse) }},
+	{"nrpc/logon/v1.AddressToSiteNamesWRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AddressToSiteNamesWRequest) }},
+	{"nrpc/logon/v1.AddressToSiteNamesWResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AddressToSiteNamesWResponse) }},
+	{"nrpc/logon/v1.Authenticate2Request", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Authenticate2Request) }},
+	{"nrpc/logon/v1.Authenticate2Response", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Authenticate2Response) }},
+	{"nrpc/logon/v1.Authenticate3Request", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Authenticate3Request) }},
+	{"nrpc/logon/v1.Authenticate3Response", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Authenticate3Response) }},
+	{"nrpc/logon/v1.AuthenticateRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AuthenticateRequest) }},
+	{"nrpc/logon/v1.AuthenticateResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.AuthenticateResponse) }},
+	{"nrpc/logon/v1.ChainSetClientAttributesRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ChainSetClientAttributesRequest) }},
+	{"nrpc/logon/v1.ChainSetClientAttributesResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ChainSetClientAttributesResponse) }},
+	{"nrpc/logon/v1.ComputeClientDigestRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ComputeClientDigestRequest) }},
+	{"nrpc/logon/v1.ComputeClientDigestResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ComputeClientDigestResponse) }},
+	{"nrpc/logon/v1.ComputeServerDigestRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ComputeServerDigestRequest) }},
+	{"nrpc/logon/v1.ComputeServerDigestResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ComputeServerDigestResponse) }},
+	{"nrpc/logon/v1.Control2ExRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Control2ExRequest) }},
+	{"nrpc/logon/v1.Control2ExResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Control2ExResponse) }},
+	{"nrpc/logon/v1.Control2Request", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Control2Request) }},
+	{"nrpc/logon/v1.Control2Response", func() ndr.Unmarshaler { return new(nrpc_logon_v1.Control2Response) }},
+	{"nrpc/logon/v1.ControlRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ControlRequest) }},
+	{"nrpc/logon/v1.ControlResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.ControlResponse) }},
+	{"nrpc/logon/v1.DatabaseDeltasRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseDeltasRequest) }},
+	{"nrpc/logon/v1.DatabaseDeltasResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseDeltasResponse) }},
+	{"nrpc/logon/v1.DatabaseRedoRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseRedoRequest) }},
+	{"nrpc/logon/v1.DatabaseRedoResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseRedoResponse) }},
+	{"nrpc/logon/v1.DatabaseSync2Request", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseSync2Request) }},
+	{"nrpc/logon/v1.DatabaseSync2Response", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseSync2Response) }},
+	{"nrpc/logon/v1.DatabaseSyncRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseSyncRequest) }},
+	{"nrpc/logon/v1.DatabaseSyncResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DatabaseSyncResponse) }},
+	{"nrpc/logon/v1.DeregisterDNSHostRecordsRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DeregisterDNSHostRecordsRequest) }},
+	{"nrpc/logon/v1.DeregisterDNSHostRecordsResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.DeregisterDNSHostRecordsResponse) }},
+	{"nrpc/logon/v1.EnumerateDomainTrustsRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.EnumerateDomainTrustsRequest) }},
+	{"nrpc/logon/v1.EnumerateDomainTrustsResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.EnumerateDomainTrustsResponse) }},
+	{"nrpc/logon/v1.EnumerateTrustedDomainsExRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.EnumerateTrustedDomainsExRequest) }},
+	{"nrpc/logon/v1.EnumerateTrustedDomainsExResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.EnumerateTrustedDomainsExResponse) }},
+	{"nrpc/logon/v1.EnumerateTrustedDomainsRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.EnumerateTrustedDomainsRequest) }},
+	{"nrpc/logon/v1.EnumerateTrustedDomainsResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.EnumerateTrustedDomainsResponse) }},
+	{"nrpc/logon/v1.GetAnyDCNameRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetAnyDCNameRequest) }},
+	{"nrpc/logon/v1.GetAnyDCNameResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetAnyDCNameResponse) }},
+	{"nrpc/logon/v1.GetCapabilitiesRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetCapabilitiesRequest) }},
+	{"nrpc/logon/v1.GetCapabilitiesResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetCapabilitiesResponse) }},
+	{"nrpc/logon/v1.GetDCNameEx2Request", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCNameEx2Request) }},
+	{"nrpc/logon/v1.GetDCNameEx2Response", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCNameEx2Response) }},
+	{"nrpc/logon/v1.GetDCNameExRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCNameExRequest) }},
+	{"nrpc/logon/v1.GetDCNameExResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCNameExResponse) }},
+	{"nrpc/logon/v1.GetDCNameRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCNameRequest) }},
+	{"nrpc/logon/v1.GetDCNameResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCNameResponse) }},
+	{"nrpc/logon/v1.GetDCSiteCoverageWRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCSiteCoverageWRequest) }},
+	{"nrpc/logon/v1.GetDCSiteCoverageWResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDCSiteCoverageWResponse) }},
+	{"nrpc/logon/v1.GetDomainInfoRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDomainInfoRequest) }},
+	{"nrpc/logon/v1.GetDomainInfoResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetDomainInfoResponse) }},
+	{"nrpc/logon/v1.GetForestTrustInformationRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetForestTrustInformationRequest) }},
+	{"nrpc/logon/v1.GetForestTrustInformationResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetForestTrustInformationResponse) }},
+	{"nrpc/logon/v1.GetNetrDCNameRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetNetrDCNameRequest) }},
+	{"nrpc/logon/v1.GetNetrDCNameResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetNetrDCNameResponse) }},
+	{"nrpc/logon/v1.GetNetrForestTrustInformationRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetNetrForestTrustInformationRequest) }},
+	{"nrpc/logon/v1.GetNetrForestTrustInformationResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetNetrForestTrustInformationResponse) }},
+	{"nrpc/logon/v1.GetSiteNameRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetSiteNameRequest) }},
+	{"nrpc/logon/v1.GetSiteNameResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetSiteNameResponse) }},
+	{"nrpc/logon/v1.GetTimeServiceParentDomainRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetTimeServiceParentDomainRequest) }},
+	{"nrpc/logon/v1.GetTimeServiceParentDomainResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetTimeServiceParentDomainResponse) }},
+	{"nrpc/logon/v1.GetTrustInfoRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetTrustInfoRequest) }},
+	{"nrpc/logon/v1.GetTrustInfoResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetTrustInfoResponse) }},
+	{"nrpc/logon/v1.GetTrustRIDRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetTrustRIDRequest) }},
+	{"nrpc/logon/v1.GetTrustRIDResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.GetTrustRIDResponse) }},
+	{"nrpc/logon/v1.PasswordGetRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.PasswordGetRequest) }},
+	{"nrpc/logon/v1.PasswordGetResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.PasswordGetResponse) }},
+	{"nrpc/logon/v1.PasswordSet2Request", func() ndr.Unmarshaler { return new(nrpc_logon_v1.PasswordSet2Request) }},
+	{"nrpc/logon/v1.PasswordSet2Response", func() ndr.Unmarshaler { return new(nrpc_logon_v1.PasswordSet2Response) }},
+	{"nrpc/logon/v1.PasswordSetRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.PasswordSetRequest) }},
+	{"nrpc/logon/v1.PasswordSetResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.PasswordSetResponse) }},
+	{"nrpc/logon/v1.RequestChallengeRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.RequestChallengeRequest) }},
+	{"nrpc/logon/v1.RequestChallengeResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.RequestChallengeResponse) }},
+	{"nrpc/logon/v1.SAMLogoffRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogoffRequest) }},
+	{"nrpc/logon/v1.SAMLogoffResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogoffResponse) }},
+	{"nrpc/logon/v1.SAMLogonExRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogonExRequest) }},
+	{"nrpc/logon/v1.SAMLogonExResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogonExResponse) }},
+	{"nrpc/logon/v1.SAMLogonRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogonRequest) }},
+	{"nrpc/logon/v1.SAMLogonResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogonResponse) }},
+	{"nrpc/logon/v1.SAMLogonWithFlagsRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogonWithFlagsRequest) }},
+	{"nrpc/logon/v1.SAMLogonWithFlagsResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SAMLogonWithFlagsResponse) }},
+	{"nrpc/logon/v1.SendToSAMRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SendToSAMRequest) }},
+	{"nrpc/logon/v1.SendToSAMResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SendToSAMResponse) }},
+	{"nrpc/logon/v1.SetServiceBitsRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SetServiceBitsRequest) }},
+	{"nrpc/logon/v1.SetServiceBitsResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.SetServiceBitsResponse) }},
+	{"nrpc/logon/v1.TrustPasswordsGetRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.TrustPasswordsGetRequest) }},
+	{"nrpc/logon/v1.TrustPasswordsGetResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.TrustPasswordsGetResponse) }},
+	{"nrpc/logon/v1.UASLogoffRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.UASLogoffRequest) }},
+	{"nrpc/logon/v1.UASLogoffResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.UASLogoffResponse) }},
+	{"nrpc/logon/v1.UASLogonRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.UASLogonRequest) }},
+	{"nrpc/logon/v1.UASLogonResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.UASLogonResponse) }},
+	{"nrpc/logon/v1.UpdateReadOnlyServerDNSRecordsRequest", func() ndr.Unmarshaler { return new(nrpc_logon_v1.UpdateReadOnlyServerDNSRecordsRequest) }},
+	{"nrpc/logon/v1.UpdateReadOnlyServerDNSRecordsResponse", func() ndr.Unmarshaler { return new(nrpc_logon_v1.UpdateReadOnlyServerDNSRecordsResponse) }},
+	{"nspi/nspi/v56.BindRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.BindRequest) }},
+	{"nspi/nspi/v56.BindResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.BindResponse) }},
+	{"nspi/nspi/v56.CompareMIDsRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.CompareMIDsRequest) }},
+	{"nspi/nspi/v56.CompareMIDsResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.CompareMIDsResponse) }},
+	{"nspi/nspi/v56.DNToMIDRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.DNToMIDRequest) }},
+	{"nspi/nspi/v56.DNToMIDResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.DNToMIDResponse) }},
+	{"nspi/nspi/v56.GetIDsFromNamesRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetIDsFromNamesRequest) }},
+	{"nspi/nspi/v56.GetIDsFromNamesResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetIDsFromNamesResponse) }},
+	{"nspi/nspi/v56.GetMatchesRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetMatchesRequest) }},
+	{"nspi/nspi/v56.GetMatchesResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetMatchesResponse) }},
+	{"nspi/nspi/v56.GetNamesFromIDsRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetNamesFromIDsRequest) }},
+	{"nspi/nspi/v56.GetNamesFromIDsResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetNamesFromIDsResponse) }},
+	{"nspi/nspi/v56.GetPropertiesRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetPropertiesRequest) }},
+	{"nspi/nspi/v56.GetPropertiesResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetPropertiesResponse) }},
+	{"nspi/nspi/v56.GetPropertyListRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetPropertyListRequest) }},
+	{"nspi/nspi/v56.GetPropertyListResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetPropertyListResponse) }},
+	{"nspi/nspi/v56.GetSpecialTableRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetSpecialTableRequest) }},
+	{"nspi/nspi/v56.GetSpecialTableResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetSpecialTableResponse) }},
+	{"nspi/nspi/v56.GetTemplateInfoRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetTemplateInfoRequest) }},
+	{"nspi/nspi/v56.GetTemplateInfoResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.GetTemplateInfoResponse) }},
+	{"nspi/nspi/v56.ModifyLinkAttributeRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ModifyLinkAttributeRequest) }},
+	{"nspi/nspi/v56.ModifyLinkAttributeResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ModifyLinkAttributeResponse) }},
+	{"nspi/nspi/v56.ModifyPropertiesRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ModifyPropertiesRequest) }},
+	{"nspi/nspi/v56.ModifyPropertiesResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ModifyPropertiesResponse) }},
+	{"nspi/nspi/v56.QueryColumnsRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.QueryColumnsRequest) }},
+	{"nspi/nspi/v56.QueryColumnsResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.QueryColumnsResponse) }},
+	{"nspi/nspi/v56.QueryRowsRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.QueryRowsRequest) }},
+	{"nspi/nspi/v56.QueryRowsResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.QueryRowsResponse) }},
+	{"nspi/nspi/v56.ResolveNamesRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ResolveNamesRequest) }},
+	{"nspi/nspi/v56.ResolveNamesResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ResolveNamesResponse) }},
+	{"nspi/nspi/v56.ResolveNamesWRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ResolveNamesWRequest) }},
+	{"nspi/nspi/v56.ResolveNamesWResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ResolveNamesWResponse) }},
+	{"nspi/nspi/v56.ResortRestrictionRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ResortRestrictionRequest) }},
+	{"nspi/nspi/v56.ResortRestrictionResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.ResortRestrictionResponse) }},
+	{"nspi/nspi/v56.SeekEntriesRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.SeekEntriesRequest) }},
+	{"nspi/nspi/v56.SeekEntriesResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.SeekEntriesResponse) }},
+	{"nspi/nspi/v56.UnbindRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.UnbindRequest) }},
+	{"nspi/nspi/v56.UnbindResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.UnbindResponse) }},
+	{"nspi/nspi/v56.UpdateStatRequest", func() ndr.Unmarshaler { return new(nspi_nspi_v56.UpdateStatRequest) }},
+	{"nspi/nspi/v56.UpdateStatResponse", func() ndr.Unmarshaler { return new(nspi_nspi_v56.UpdateStatResponse) }},
+	{"oxabref/rfri/v1.GetFQDNFromServerDNRequest", func() ndr.Unmarshaler { return new(oxabref_rfri_v1.GetFQDNFromServerDNRequest) }},
+	{"oxabref/rfri/v1.GetFQDNFromServerDNResponse", func() ndr.Unmarshaler { return new(oxabref_rfri_v1.GetFQDNFromServerDNResponse) }},
+	{"oxabref/rfri/v1.GetNewDSARequest", func() ndr.Unmarshaler { return new(oxabref_rfri_v1.GetNewDSARequest) }},
+	{"oxabref/rfri/v1.GetNewDSAResponse", func() ndr.Unmarshaler { return new(oxabref_rfri_v1.GetNewDSAResponse) }},
+	{"oxcrpc/asyncemsmdb/v0.DoAsyncWaitExRequest", func() ndr.Unmarshaler { return new(oxcrpc_asyncemsmdb_v0.DoAsyncWaitExRequest) }},
+	{"oxcrpc/asyncemsmdb/v0.DoAsyncWaitExResponse", func() ndr.Unmarshaler { return new(oxcrpc_asyncemsmdb_v0.DoAsyncWaitExResponse) }},
+	{"oxcrpc/emsmdb/v0.DoAsyncConnectExRequest", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoAsyncConnectExRequest) }},
+	{"oxcrpc/emsmdb/v0.DoAsyncConnectExResponse", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoAsyncConnectExResponse) }},
+	{"oxcrpc/emsmdb/v0.DoConnectExRequest", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoConnectExRequest) }},
+	{"oxcrpc/emsmdb/v0.DoConnectExResponse", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoConnectExResponse) }},
+	{"oxcrpc/emsmdb/v0.DoDisconnectRequest", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoDisconnectRequest) }},
+	{"oxcrpc/emsmdb/v0.DoDisconnectResponse", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoDisconnectResponse) }},
+	{"oxcrpc/emsmdb/v0.DoRPCExt2Request", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoRPCExt2Request) }},
+	{"oxcrpc/emsmdb/v0.DoRPCExt2Response", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DoRPCExt2Response) }},
+	{"oxcrpc/emsmdb/v0.DummyRPCRequest", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DummyRPCRequest) }},
+	{"oxcrpc/emsmdb/v0.DummyRPCResponse", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.DummyRPCResponse) }},
+	{"oxcrpc/emsmdb/v0.RegisterPushNotificationRequest", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.RegisterPushNotificationRequest) }},
+	{"oxcrpc/emsmdb/v0.RegisterPushNotificationResponse", func() ndr.Unmarshaler { return new(oxcrpc_emsmdb_v0.RegisterPushNotificationResponse) }},
+	{"pan/irpcasyncnotify/v1.CloseChannelRequest", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.CloseChannelRequest) }},
+	{"pan/irpcasyncnotify/v1.CloseChannelResponse", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.CloseChannelResponse) }},
+	{"pan/irpcasyncnotify/v1.GetNewChannelRequest", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.GetNewChannelRequest) }},
+	{"pan/irpcasyncnotify/v1.GetNewChannelResponse", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.GetNewChannelResponse) }},
+	{"pan/irpcasyncnotify/v1.GetNotificationRequest", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.GetNotificationRequest) }},
+	{"pan/irpcasyncnotify/v1.GetNotificationResponse", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.GetNotificationResponse) }},
+	{"pan/irpcasyncnotify/v1.GetNotificationSendResponseRequest", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.GetNotificationSendResponseRequest) }},
+	{"pan/irpcasyncnotify/v1.GetNotificationSendResponseResponse", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.GetNotificationSendResponseResponse) }},
+	{"pan/irpcasyncnotify/v1.RegisterClientRequest", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.RegisterClientRequest) }},
+	{"pan/irpcasyncnotify/v1.RegisterClientResponse", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.RegisterClientResponse) }},
+	{"pan/irpcasyncnotify/v1.UnregisterClientRequest", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.UnregisterClientRequest) }},
+	{"pan/irpcasyncnotify/v1.UnregisterClientResponse", func() ndr.Unmarshaler { return new(pan_irpcasyncnotify_v1.UnregisterClientResponse) }},
+	{"pan/irpcremoteobject/v1.CreateRequest", func() ndr.Unmarshaler { return new(pan_irpcremoteobject_v1.CreateRequest) }},
+	{"pan/irpcremoteobject/v1.CreateResponse", func() ndr.Unmarshaler { return new(pan_irpcremoteobject_v1.CreateResponse) }},
+	{"pan/irpcremoteobject/v1.DeleteRequest", func() ndr.Unmarshaler { return new(pan_irpcremoteobject_v1.DeleteRequest) }},
+	{"pan/irpcremoteobject/v1.DeleteResponse", func() ndr.Unmarshaler { return new(pan_irpcremoteobject_v1.DeleteResponse) }},
+	{"par/iremotewinspool/v1.AbortPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AbortPrinterRequest) }},
+	{"par/iremotewinspool/v1.AbortPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AbortPrinterResponse) }},
+	{"par/iremotewinspool/v1.AddFormRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddFormRequest) }},
+	{"par/iremotewinspool/v1.AddFormResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddFormResponse) }},
+	{"par/iremotewinspool/v1.AddJobRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddJobRequest) }},
+	{"par/iremotewinspool/v1.AddJobResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddJobResponse) }},
+	{"par/iremotewinspool/v1.AddMonitorRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddMonitorRequest) }},
+	{"par/iremotewinspool/v1.AddMonitorResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddMonitorResponse) }},
+	{"par/iremotewinspool/v1.AddPerMachineConnectionRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPerMachineConnectionRequest) }},
+	{"par/iremotewinspool/v1.AddPerMachineConnectionResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPerMachineConnectionResponse) }},
+	{"par/iremotewinspool/v1.AddPortRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPortRequest) }},
+	{"par/iremotewinspool/v1.AddPortResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPortResponse) }},
+	{"par/iremotewinspool/v1.AddPrintProcessorRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPrintProcessorRequest) }},
+	{"par/iremotewinspool/v1.AddPrintProcessorResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPrintProcessorResponse) }},
+	{"par/iremotewinspool/v1.AddPrinterDriverRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPrinterDriverRequest) }},
+	{"par/iremotewinspool/v1.AddPrinterDriverResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPrinterDriverResponse) }},
+	{"par/iremotewinspool/v1.AddPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPrinterRequest) }},
+	{"par/iremotewinspool/v1.AddPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.AddPrinterResponse) }},
+	{"par/iremotewinspool/v1.ClosePrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ClosePrinterRequest) }},
+	{"par/iremotewinspool/v1.ClosePrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ClosePrinterResponse) }},
+	{"par/iremotewinspool/v1.CorePrinterDriverInstalledRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.CorePrinterDriverInstalledRequest) }},
+	{"par/iremotewinspool/v1.CorePrinterDriverInstalledResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.CorePrinterDriverInstalledResponse) }},
+	{"par/iremotewinspool/v1.CreatePrinterICRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.CreatePrinterICRequest) }},
+	{"par/iremotewinspool/v1.CreatePrinterICResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.CreatePrinterICResponse) }},
+	{"par/iremotewinspool/v1.DeleteFormRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeleteFormRequest) }},
+	{"par/iremotewinspool/v1.DeleteFormResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeleteFormResponse) }},
+	{"par/iremotewinspool/v1.DeleteJobNamedPropertyRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeleteJobNamedPropertyRequest) }},
+	{"par/iremotewinspool/v1.DeleteJobNamedPropertyResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeleteJobNamedPropertyResponse) }},
+	{"par/iremotewinspool/v1.DeleteMonitorRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeleteMonitorRequest) }},
+	{"par/iremotewinspool/v1.DeleteMonitorResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeleteMonitorResponse) }},
+	{"par/iremotewinspool/v1.DeletePerMachineConnectionRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePerMachineConnectionRequest) }},
+	{"par/iremotewinspool/v1.DeletePerMachineConnectionResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePerMachineConnectionResponse) }},
+	{"par/iremotewinspool/v1.DeletePrintProcessorRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrintProcessorRequest) }},
+	{"par/iremotewinspool/v1.DeletePrintProcessorResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrintProcessorResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterDataExRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDataExRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterDataExResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDataExResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterDataRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDataRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterDataResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDataResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterDriverExRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDriverExRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterDriverExResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDriverExResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterDriverPackageRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDriverPackageRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterDriverPackageResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDriverPackageResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterDriverRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDriverRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterDriverResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterDriverResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterICRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterICRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterICResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterICResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterKeyRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterKeyRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterKeyResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterKeyResponse) }},
+	{"par/iremotewinspool/v1.DeletePrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterRequest) }},
+	{"par/iremotewinspool/v1.DeletePrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.DeletePrinterResponse) }},
+	{"par/iremotewinspool/v1.EndDocPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EndDocPrinterRequest) }},
+	{"par/iremotewinspool/v1.EndDocPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EndDocPrinterResponse) }},
+	{"par/iremotewinspool/v1.EndPagePrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EndPagePrinterRequest) }},
+	{"par/iremotewinspool/v1.EndPagePrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EndPagePrinterResponse) }},
+	{"par/iremotewinspool/v1.EnumFormsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumFormsRequest) }},
+	{"par/iremotewinspool/v1.EnumFormsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumFormsResponse) }},
+	{"par/iremotewinspool/v1.EnumJobNamedPropertiesRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumJobNamedPropertiesRequest) }},
+	{"par/iremotewinspool/v1.EnumJobNamedPropertiesResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumJobNamedPropertiesResponse) }},
+	{"par/iremotewinspool/v1.EnumJobsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumJobsRequest) }},
+	{"par/iremotewinspool/v1.EnumJobsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumJobsResponse) }},
+	{"par/iremotewinspool/v1.EnumMonitorsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumMonitorsRequest) }},
+	{"par/iremotewinspool/v1.EnumMonitorsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumMonitorsResponse) }},
+	{"par/iremotewinspool/v1.EnumPerMachineConnectionsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPerMachineConnectionsRequest) }},
+	{"par/iremotewinspool/v1.EnumPerMachineConnectionsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPerMachineConnectionsResponse) }},
+	{"par/iremotewinspool/v1.EnumPortsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPortsRequest) }},
+	{"par/iremotewinspool/v1.EnumPortsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPortsResponse) }},
+	{"par/iremotewinspool/v1.EnumPrintProcessorDataTypesRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrintProcessorDataTypesRequest) }},
+	{"par/iremotewinspool/v1.EnumPrintProcessorDataTypesResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrintProcessorDataTypesResponse) }},
+	{"par/iremotewinspool/v1.EnumPrintProcessorsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrintProcessorsRequest) }},
+	{"par/iremotewinspool/v1.EnumPrintProcessorsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrintProcessorsResponse) }},
+	{"par/iremotewinspool/v1.EnumPrinterDataExRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterDataExRequest) }},
+	{"par/iremotewinspool/v1.EnumPrinterDataExResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterDataExResponse) }},
+	{"par/iremotewinspool/v1.EnumPrinterDataRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterDataRequest) }},
+	{"par/iremotewinspool/v1.EnumPrinterDataResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterDataResponse) }},
+	{"par/iremotewinspool/v1.EnumPrinterDriversRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterDriversRequest) }},
+	{"par/iremotewinspool/v1.EnumPrinterDriversResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterDriversResponse) }},
+	{"par/iremotewinspool/v1.EnumPrinterKeyRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterKeyRequest) }},
+	{"par/iremotewinspool/v1.EnumPrinterKeyResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrinterKeyResponse) }},
+	{"par/iremotewinspool/v1.EnumPrintersRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrintersRequest) }},
+	{"par/iremotewinspool/v1.EnumPrintersResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.EnumPrintersResponse) }},
+	{"par/iremotewinspool/v1.GetCorePrinterDriversRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetCorePrinterDriversRequest) }},
+	{"par/iremotewinspool/v1.GetCorePrinterDriversResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetCorePrinterDriversResponse) }},
+	{"par/iremotewinspool/v1.GetFormRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetFormRequest) }},
+	{"par/iremotewinspool/v1.GetFormResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetFormResponse) }},
+	{"par/iremotewinspool/v1.GetJobNamedPropertyValueRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetJobNamedPropertyValueRequest) }},
+	{"par/iremotewinspool/v1.GetJobNamedPropertyValueResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetJobNamedPropertyValueResponse) }},
+	{"par/iremotewinspool/v1.GetJobRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetJobRequest) }},
+	{"par/iremotewinspool/v1.GetJobResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetJobResponse) }},
+	{"par/iremotewinspool/v1.GetPrintProcessorDirectoryRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrintProcessorDirectoryRequest) }},
+	{"par/iremotewinspool/v1.GetPrintProcessorDirectoryResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrintProcessorDirectoryResponse) }},
+	{"par/iremotewinspool/v1.GetPrinterDataExRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDataExRequest) }},
+	{"par/iremotewinspool/v1.GetPrinterDataExResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDataExResponse) }},
+	{"par/iremotewinspool/v1.GetPrinterDataRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDataRequest) }},
+	{"par/iremotewinspool/v1.GetPrinterDataResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDataResponse) }},
+	{"par/iremotewinspool/v1.GetPrinterDriverDirectoryRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDriverDirectoryRequest) }},
+	{"par/iremotewinspool/v1.GetPrinterDriverDirectoryResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDriverDirectoryResponse) }},
+	{"par/iremotewinspool/v1.GetPrinterDriverPackagePathRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDriverPackagePathRequest) }},
+	{"par/iremotewinspool/v1.GetPrinterDriverPackagePathResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDriverPackagePathResponse) }},
+	{"par/iremotewinspool/v1.GetPrinterDriverRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDriverRequest) }},
+	{"par/iremotewinspool/v1.GetPrinterDriverResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterDriverResponse) }},
+	{"par/iremotewinspool/v1.GetPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterRequest) }},
+	{"par/iremotewinspool/v1.GetPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetPrinterResponse) }},
+	{"par/iremotewinspool/v1.GetRemoteNotificationsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetRemoteNotificationsRequest) }},
+	{"par/iremotewinspool/v1.GetRemoteNotificationsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.GetRemoteNotificationsResponse) }},
+	{"par/iremotewinspool/v1.InstallPrinterDriverFromPackageRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.InstallPrinterDriverFromPackageRequest) }},
+	{"par/iremotewinspool/v1.InstallPrinterDriverFromPackageResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.InstallPrinterDriverFromPackageResponse) }},
+	{"par/iremotewinspool/v1.LogJobInfoForBranchOfficeRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.LogJobInfoForBranchOfficeRequest) }},
+	{"par/iremotewinspool/v1.LogJobInfoForBranchOfficeResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.LogJobInfoForBranchOfficeResponse) }},
+	{"par/iremotewinspool/v1.OpenPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.OpenPrinterRequest) }},
+	{"par/iremotewinspool/v1.OpenPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.OpenPrinterResponse) }},
+	{"par/iremotewinspool/v1.PlayGDIScriptOnPrinterICRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.PlayGDIScriptOnPrinterICRequest) }},
+	{"par/iremotewinspool/v1.PlayGDIScriptOnPrinterICResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.PlayGDIScriptOnPrinterICResponse) }},
+	{"par/iremotewinspool/v1.ReadPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ReadPrinterRequest) }},
+	{"par/iremotewinspool/v1.ReadPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ReadPrinterResponse) }},
+	{"par/iremotewinspool/v1.ResetPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ResetPrinterRequest) }},
+	{"par/iremotewinspool/v1.ResetPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ResetPrinterResponse) }},
+	{"par/iremotewinspool/v1.ScheduleJobRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ScheduleJobRequest) }},
+	{"par/iremotewinspool/v1.ScheduleJobResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.ScheduleJobResponse) }},
+	{"par/iremotewinspool/v1.SendRecvBIDIDataRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SendRecvBIDIDataRequest) }},
+	{"par/iremotewinspool/v1.SendRecvBIDIDataResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SendRecvBIDIDataResponse) }},
+	{"par/iremotewinspool/v1.SetFormRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetFormRequest) }},
+	{"par/iremotewinspool/v1.SetFormResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetFormResponse) }},
+	{"par/iremotewinspool/v1.SetJobNamedPropertyRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetJobNamedPropertyRequest) }},
+	{"par/iremotewinspool/v1.SetJobNamedPropertyResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetJobNamedPropertyResponse) }},
+	{"par/iremotewinspool/v1.SetJobRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetJobRequest) }},
+	{"par/iremotewinspool/v1.SetJobResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetJobResponse) }},
+	{"par/iremotewinspool/v1.SetPortRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPortRequest) }},
+	{"par/iremotewinspool/v1.SetPortResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPortResponse) }},
+	{"par/iremotewinspool/v1.SetPrinterDataExRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPrinterDataExRequest) }},
+	{"par/iremotewinspool/v1.SetPrinterDataExResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPrinterDataExResponse) }},
+	{"par/iremotewinspool/v1.SetPrinterDataRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPrinterDataRequest) }},
+	{"par/iremotewinspool/v1.SetPrinterDataResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPrinterDataResponse) }},
+	{"par/iremotewinspool/v1.SetPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPrinterRequest) }},
+	{"par/iremotewinspool/v1.SetPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SetPrinterResponse) }},
+	{"par/iremotewinspool/v1.StartDocPrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.StartDocPrinterRequest) }},
+	{"par/iremotewinspool/v1.StartDocPrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.StartDocPrinterResponse) }},
+	{"par/iremotewinspool/v1.StartPagePrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.StartPagePrinterRequest) }},
+	{"par/iremotewinspool/v1.StartPagePrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.StartPagePrinterResponse) }},
+	{"par/iremotewinspool/v1.SyncRefreshRemoteNotificationsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SyncRefreshRemoteNotificationsRequest) }},
+	{"par/iremotewinspool/v1.SyncRefreshRemoteNotificationsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SyncRefreshRemoteNotificationsResponse) }},
+	{"par/iremotewinspool/v1.SyncRegisterForRemoteNotificationsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SyncRegisterForRemoteNotificationsRequest) }},
+	{"par/iremotewinspool/v1.SyncRegisterForRemoteNotificationsResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SyncRegisterForRemoteNotificationsResponse) }},
+	{"par/iremotewinspool/v1.SyncUnregisterForRemoteNotificationsRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.SyncUnregisterForRemoteNotificationsRequest) }},
+	{"par/iremotewinspool/v1.SyncUnregisterForRemoteNotificationsResponse", func() ndr.Unmarshaler {
+		return new(par_iremotewinspool_v1.SyncUnregisterForRemoteNotificationsResponse)
+	}},
+	{"par/iremotewinspool/v1.UploadPrinterDriverPackageRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.UploadPrinterDriverPackageRequest) }},
+	{"par/iremotewinspool/v1.UploadPrinterDriverPackageResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.UploadPrinterDriverPackageResponse) }},
+	{"par/iremotewinspool/v1.WritePrinterRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.WritePrinterRequest) }},
+	{"par/iremotewinspool/v1.WritePrinterResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.WritePrinterResponse) }},
+	{"par/iremotewinspool/v1.XcvDataRequest", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.XcvDataRequest) }},
+	{"par/iremotewinspool/v1.XcvDataResponse", func() ndr.Unmarshaler { return new(par_iremotewinspool_v1.XcvDataResponse) }},
+	{"pcq/perflibv2/v1.CloseQueryHandleRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.CloseQueryHandleRequest) }},
+	{"pcq/perflibv2/v1.CloseQueryHandleResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.CloseQueryHandleResponse) }},
+	{"pcq/perflibv2/v1.EnumerateCounterSetInstancesRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.EnumerateCounterSetInstancesRequest) }},
+	{"pcq/perflibv2/v1.EnumerateCounterSetInstancesResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.EnumerateCounterSetInstancesResponse) }},
+	{"pcq/perflibv2/v1.EnumerateCounterSetRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.EnumerateCounterSetRequest) }},
+	{"pcq/perflibv2/v1.EnumerateCounterSetResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.EnumerateCounterSetResponse) }},
+	{"pcq/perflibv2/v1.OpenQueryHandleRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.OpenQueryHandleRequest) }},
+	{"pcq/perflibv2/v1.OpenQueryHandleResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.OpenQueryHandleResponse) }},
+	{"pcq/perflibv2/v1.QueryCounterDataRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.QueryCounterDataRequest) }},
+	{"pcq/perflibv2/v1.QueryCounterDataResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.QueryCounterDataResponse) }},
+	{"pcq/perflibv2/v1.QueryCounterInfoRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.QueryCounterInfoRequest) }},
+	{"pcq/perflibv2/v1.QueryCounterInfoResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.QueryCounterInfoResponse) }},
+	{"pcq/perflibv2/v1.QueryCounterSetRegistrationInfoRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.QueryCounterSetRegistrationInfoRequest) }},
+	{"pcq/perflibv2/v1.QueryCounterSetRegistrationInfoResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.QueryCounterSetRegistrationInfoResponse) }},
+	{"pcq/perflibv2/v1.ValidateCountersRequest", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.ValidateCountersRequest) }},
+	{"pcq/perflibv2/v1.ValidateCountersResponse", func() ndr.Unmarshaler { return new(pcq_perflibv2_v1.ValidateCountersResponse) }},
+	{"raa/authzr/v0.AccessCheckRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.AccessCheckRequest) }},
+	{"raa/authzr/v0.AccessCheckResponse", func() ndr.Unmarshaler { return new(raa_authzr_v0.AccessCheckResponse) }},
+	{"raa/authzr/v0.AccessRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.AccessRequest) }},
+	{"raa/authzr/v0.FreeContextRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.FreeContextRequest) }},
+	{"raa/authzr/v0.FreeContextResponse", func() ndr.Unmarshaler { return new(raa_authzr_v0.FreeContextResponse) }},
+	{"raa/authzr/v0.GetInformationFromContextRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.GetInformationFromContextRequest) }},
+	{"raa/authzr/v0.GetInformationFromContextResponse", func() ndr.Unmarshaler { return new(raa_authzr_v0.GetInformationFromContextResponse) }},
+	{"raa/authzr/v0.InitializeCompoundContextRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.InitializeCompoundContextRequest) }},
+	{"raa/authzr/v0.InitializeCompoundContextResponse", func() ndr.Unmarshaler { return new(raa_authzr_v0.InitializeCompoundContextResponse) }},
+	{"raa/authzr/v0.InitializeContextFromSIDRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.InitializeContextFromSIDRequest) }},
+	{"raa/authzr/v0.InitializeContextFromSIDResponse", func() ndr.Unmarshaler { return new(raa_authzr_v0.InitializeContextFromSIDResponse) }},
+	{"raa/authzr/v0.ModifyClaimsRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.ModifyClaimsRequest) }},
+	{"raa/authzr/v0.ModifyClaimsResponse", func() ndr.Unmarshaler { return new(raa_authzr_v0.ModifyClaimsResponse) }},
+	{"raa/authzr/v0.ModifySIDsRequest", func() ndr.Unmarshaler { return new(raa_authzr_v0.ModifySIDsRequest) }},
+	{"raa/authzr/v0.ModifySIDsResponse", func() ndr.Unmarshaler { return new(raa_authzr_v0.ModifySIDsResponse) }},
+	{"raiw.ScavengingRequest", func() ndr.Unmarshaler { return new(raiw.ScavengingRequest) }},
+	{"raiw/winsi2/v1.CheckAccessRequest", func() ndr.Unmarshaler { return new(raiw_winsi2_v1.CheckAccessRequest) }},
+	{"raiw/winsi2/v1.CheckAccessResponse", func() ndr.Unmarshaler { return new(raiw_winsi2_v1.CheckAccessResponse) }},
+	{"raiw/winsi2/v1.TombstoneDBRecordsRequest", func() ndr.Unmarshaler { return new(raiw_winsi2_v1.TombstoneDBRecordsRequest) }},
+	{"raiw/winsi2/v1.TombstoneDBRecordsResponse", func() ndr.Unmarshaler { return new(raiw_winsi2_v1.TombstoneDBRecordsResponse) }},
+	{"raiw/winsif/v1.BackupRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.BackupRequest) }},
+	{"raiw/winsif/v1.BackupResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.BackupResponse) }},
+	{"raiw/winsif/v1.DeleteDBRecordsRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DeleteDBRecordsRequest) }},
+	{"raiw/winsif/v1.DeleteDBRecordsResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DeleteDBRecordsResponse) }},
+	{"raiw/winsif/v1.DeleteWINSRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DeleteWINSRequest) }},
+	{"raiw/winsif/v1.DeleteWINSResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DeleteWINSResponse) }},
+	{"raiw/winsif/v1.DoScavengingNewRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DoScavengingNewRequest) }},
+	{"raiw/winsif/v1.DoScavengingNewResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DoScavengingNewResponse) }},
+	{"raiw/winsif/v1.DoScavengingRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DoScavengingRequest) }},
+	{"raiw/winsif/v1.DoScavengingResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DoScavengingResponse) }},
+	{"raiw/winsif/v1.DoStaticInitRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DoStaticInitRequest) }},
+	{"raiw/winsif/v1.DoStaticInitResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.DoStaticInitResponse) }},
+	{"raiw/winsif/v1.GetBrowserNamesOldRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetBrowserNamesOldRequest) }},
+	{"raiw/winsif/v1.GetBrowserNamesOldResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetBrowserNamesOldResponse) }},
+	{"raiw/winsif/v1.GetBrowserNamesRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetBrowserNamesRequest) }},
+	{"raiw/winsif/v1.GetBrowserNamesResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetBrowserNamesResponse) }},
+	{"raiw/winsif/v1.GetDBRecordsByNameRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetDBRecordsByNameRequest) }},
+	{"raiw/winsif/v1.GetDBRecordsByNameResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetDBRecordsByNameResponse) }},
+	{"raiw/winsif/v1.GetDBRecordsRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetDBRecordsRequest) }},
+	{"raiw/winsif/v1.GetDBRecordsResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetDBRecordsResponse) }},
+	{"raiw/winsif/v1.GetNameAndAddrRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetNameAndAddrRequest) }},
+	{"raiw/winsif/v1.GetNameAndAddrResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.GetNameAndAddrResponse) }},
+	{"raiw/winsif/v1.PullRangeRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.PullRangeRequest) }},
+	{"raiw/winsif/v1.PullRangeResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.PullRangeResponse) }},
+	{"raiw/winsif/v1.RecordActionRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.RecordActionRequest) }},
+	{"raiw/winsif/v1.RecordActionResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.RecordActionResponse) }},
+	{"raiw/winsif/v1.ResetCountersRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.ResetCountersRequest) }},
+	{"raiw/winsif/v1.ResetCountersResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.ResetCountersResponse) }},
+	{"raiw/winsif/v1.SetFlagsRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.SetFlagsRequest) }},
+	{"raiw/winsif/v1.SetFlagsResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.SetFlagsResponse) }},
+	{"raiw/winsif/v1.SetPriorityClassRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.SetPriorityClassRequest) }},
+	{"raiw/winsif/v1.SetPriorityClassResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.SetPriorityClassResponse) }},
+	{"raiw/winsif/v1.StatusNewRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.StatusNewRequest) }},
+	{"raiw/winsif/v1.StatusNewResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.StatusNewResponse) }},
+	{"raiw/winsif/v1.StatusRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.StatusRequest) }},
+	{"raiw/winsif/v1.StatusResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.StatusResponse) }},
+	{"raiw/winsif/v1.StatusWRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.StatusWRequest) }},
+	{"raiw/winsif/v1.StatusWResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.StatusWResponse) }},
+	{"raiw/winsif/v1.TerminateRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.TerminateRequest) }},
+	{"raiw/winsif/v1.TerminateResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.TerminateResponse) }},
+	{"raiw/winsif/v1.TriggerRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.TriggerRequest) }},
+	{"raiw/winsif/v1.TriggerResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.TriggerResponse) }},
+	{"raiw/winsif/v1.WorkerThreadUpdateRequest", func() ndr.Unmarshaler { return new(raiw_winsif_v1.WorkerThreadUpdateRequest) }},
+	{"raiw/winsif/v1.WorkerThreadUpdateResponse", func() ndr.Unmarshaler { return new(raiw_winsif_v1.WorkerThreadUpdateResponse) }},
+	{"rpcl/loctoloc/v1.EntryObjectInquireBeginRequest", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.EntryObjectInquireBeginRequest) }},
+	{"rpcl/loctoloc/v1.EntryObjectInquireBeginResponse", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.EntryObjectInquireBeginResponse) }},
+	{"rpcl/loctoloc/v1.EntryObjectInquireDoneRequest", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.EntryObjectInquireDoneRequest) }},
+	{"rpcl/loctoloc/v1.EntryObjectInquireDoneResponse", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.EntryObjectInquireDoneResponse) }},
+	{"rpcl/loctoloc/v1.EntryObjectInquireNextRequest", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.EntryObjectInquireNextRequest) }},
+	{"rpcl/loctoloc/v1.EntryObjectInquireNextResponse", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.EntryObjectInquireNextResponse) }},
+	{"rpcl/loctoloc/v1.LookupBeginRequest", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.LookupBeginRequest) }},
+	{"rpcl/loctoloc/v1.LookupBeginResponse", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.LookupBeginResponse) }},
+	{"rpcl/loctoloc/v1.LookupDoneRequest", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.LookupDoneRequest) }},
+	{"rpcl/loctoloc/v1.LookupDoneResponse", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.LookupDoneResponse) }},
+	{"rpcl/loctoloc/v1.LookupNextRequest", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.LookupNextRequest) }},
+	{"rpcl/loctoloc/v1.LookupNextResponse", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.LookupNextResponse) }},
+	{"rpcl/loctoloc/v1.PingLocatorRequest", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.PingLocatorRequest) }},
+	{"rpcl/loctoloc/v1.PingLocatorResponse", func() ndr.Unmarshaler { return new(rpcl_loctoloc_v1.PingLocatorResponse) }},
+	{"rprn/winspool/v1.AbortPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AbortPrinterRequest) }},
+	{"rprn/winspool/v1.AbortPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AbortPrinterResponse) }},
+	{"rprn/winspool/v1.AddFormRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddFormRequest) }},
+	{"rprn/winspool/v1.AddFormResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddFormResponse) }},
+	{"rprn/winspool/v1.AddJobRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddJobRequest) }},
+	{"rprn/winspool/v1.AddJobResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddJobResponse) }},
+	{"rprn/winspool/v1.AddMonitorRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddMonitorRequest) }},
+	{"rprn/winspool/v1.AddMonitorResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddMonitorResponse) }},
+	{"rprn/winspool/v1.AddPerMachineConnectionRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPerMachineConnectionRequest) }},
+	{"rprn/winspool/v1.AddPerMachineConnectionResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPerMachineConnectionResponse) }},
+	{"rprn/winspool/v1.AddPortExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPortExRequest) }},
+	{"rprn/winspool/v1.AddPortExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPortExResponse) }},
+	{"rprn/winspool/v1.AddPrintProcessorRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrintProcessorRequest) }},
+	{"rprn/winspool/v1.AddPrintProcessorResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrintProcessorResponse) }},
+	{"rprn/winspool/v1.AddPrinterDriverExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterDriverExRequest) }},
+	{"rprn/winspool/v1.AddPrinterDriverExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterDriverExResponse) }},
+	{"rprn/winspool/v1.AddPrinterDriverRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterDriverRequest) }},
+	{"rprn/winspool/v1.AddPrinterDriverResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterDriverResponse) }},
+	{"rprn/winspool/v1.AddPrinterExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterExRequest) }},
+	{"rprn/winspool/v1.AddPrinterExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterExResponse) }},
+	{"rprn/winspool/v1.AddPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterRequest) }},
+	{"rprn/winspool/v1.AddPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.AddPrinterResponse) }},
+	{"rprn/winspool/v1.ClosePrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ClosePrinterRequest) }},
+	{"rprn/winspool/v1.ClosePrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ClosePrinterResponse) }},
+	{"rprn/winspool/v1.CreateJobOnPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.CreateJobOnPrinterRequest) }},
+	{"rprn/winspool/v1.CreateJobOnPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.CreateJobOnPrinterResponse) }},
+	{"rprn/winspool/v1.CreatePrinterICRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.CreatePrinterICRequest) }},
+	{"rprn/winspool/v1.CreatePrinterICResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.CreatePrinterICResponse) }},
+	{"rprn/winspool/v1.DeleteFormRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeleteFormRequest) }},
+	{"rprn/winspool/v1.DeleteFormResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeleteFormResponse) }},
+	{"rprn/winspool/v1.DeleteJobNamedPropertyRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeleteJobNamedPropertyRequest) }},
+	{"rprn/winspool/v1.DeleteJobNamedPropertyResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeleteJobNamedPropertyResponse) }},
+	{"rprn/winspool/v1.DeleteMonitorRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeleteMonitorRequest) }},
+	{"rprn/winspool/v1.DeleteMonitorResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeleteMonitorResponse) }},
+	{"rprn/winspool/v1.DeletePerMachineConnectionRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePerMachineConnectionRequest) }},
+	{"rprn/winspool/v1.DeletePerMachineConnectionResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePerMachineConnectionResponse) }},
+	{"rprn/winspool/v1.DeletePortRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePortRequest) }},
+	{"rprn/winspool/v1.DeletePortResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePortResponse) }},
+	{"rprn/winspool/v1.DeletePrintProcessorRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrintProcessorRequest) }},
+	{"rprn/winspool/v1.DeletePrintProcessorResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrintProcessorResponse) }},
+	{"rprn/winspool/v1.DeletePrinterDataExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDataExRequest) }},
+	{"rprn/winspool/v1.DeletePrinterDataExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDataExResponse) }},
+	{"rprn/winspool/v1.DeletePrinterDataRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDataRequest) }},
+	{"rprn/winspool/v1.DeletePrinterDataResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDataResponse) }},
+	{"rprn/winspool/v1.DeletePrinterDriverExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDriverExRequest) }},
+	{"rprn/winspool/v1.DeletePrinterDriverExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDriverExResponse) }},
+	{"rprn/winspool/v1.DeletePrinterDriverRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDriverRequest) }},
+	{"rprn/winspool/v1.DeletePrinterDriverResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterDriverResponse) }},
+	{"rprn/winspool/v1.DeletePrinterICRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterICRequest) }},
+	{"rprn/winspool/v1.DeletePrinterICResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterICResponse) }},
+	{"rprn/winspool/v1.DeletePrinterKeyRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterKeyRequest) }},
+	{"rprn/winspool/v1.DeletePrinterKeyResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterKeyResponse) }},
+	{"rprn/winspool/v1.DeletePrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterRequest) }},
+	{"rprn/winspool/v1.DeletePrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.DeletePrinterResponse) }},
+	{"rprn/winspool/v1.EndDocPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EndDocPrinterRequest) }},
+	{"rprn/winspool/v1.EndDocPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EndDocPrinterResponse) }},
+	{"rprn/winspool/v1.EndPagePrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EndPagePrinterRequest) }},
+	{"rprn/winspool/v1.EndPagePrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EndPagePrinterResponse) }},
+	{"rprn/winspool/v1.EnumFormsRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumFormsRequest) }},
+	{"rprn/winspool/v1.EnumFormsResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumFormsResponse) }},
+	{"rprn/winspool/v1.EnumJobNamedPropertiesRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumJobNamedPropertiesRequest) }},
+	{"rprn/winspool/v1.EnumJobNamedPropertiesResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumJobNamedPropertiesResponse) }},
+	{"rprn/winspool/v1.EnumJobsRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumJobsRequest) }},
+	{"rprn/winspool/v1.EnumJobsResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumJobsResponse) }},
+	{"rprn/winspool/v1.EnumMonitorsRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumMonitorsRequest) }},
+	{"rprn/winspool/v1.EnumMonitorsResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumMonitorsResponse) }},
+	{"rprn/winspool/v1.EnumPerMachineConnectionsRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPerMachineConnectionsRequest) }},
+	{"rprn/winspool/v1.EnumPerMachineConnectionsResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPerMachineConnectionsResponse) }},
+	{"rprn/winspool/v1.EnumPortsRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPortsRequest) }},
+	{"rprn/winspool/v1.EnumPortsResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPortsResponse) }},
+	{"rprn/winspool/v1.EnumPrintProcessorDataTypesRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrintProcessorDataTypesRequest) }},
+	{"rprn/winspool/v1.EnumPrintProcessorDataTypesResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrintProcessorDataTypesResponse) }},
+	{"rprn/winspool/v1.EnumPrintProcessorsRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrintProcessorsRequest) }},
+	{"rprn/winspool/v1.EnumPrintProcessorsResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrintProcessorsResponse) }},
+	{"rprn/winspool/v1.EnumPrinterDataExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterDataExRequest) }},
+	{"rprn/winspool/v1.EnumPrinterDataExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterDataExResponse) }},
+	{"rprn/winspool/v1.EnumPrinterDataRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterDataRequest) }},
+	{"rprn/winspool/v1.EnumPrinterDataResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterDataResponse) }},
+	{"rprn/winspool/v1.EnumPrinterDriversRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterDriversRequest) }},
+	{"rprn/winspool/v1.EnumPrinterDriversResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterDriversResponse) }},
+	{"rprn/winspool/v1.EnumPrinterKeyRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterKeyRequest) }},
+	{"rprn/winspool/v1.EnumPrinterKeyResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrinterKeyResponse) }},
+	{"rprn/winspool/v1.EnumPrintersRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrintersRequest) }},
+	{"rprn/winspool/v1.EnumPrintersResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.EnumPrintersResponse) }},
+	{"rprn/winspool/v1.FindClosePrinterChangeNotificationRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.FindClosePrinterChangeNotificationRequest) }},
+	{"rprn/winspool/v1.FindClosePrinterChangeNotificationResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.FindClosePrinterChangeNotificationResponse) }},
+	{"rprn/winspool/v1.FlushPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.FlushPrinterRequest) }},
+	{"rprn/winspool/v1.FlushPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.FlushPrinterResponse) }},
+	{"rprn/winspool/v1.GetCorePrinterDriversRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetCorePrinterDriversRequest) }},
+	{"rprn/winspool/v1.GetCorePrinterDriversResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetCorePrinterDriversResponse) }},
+	{"rprn/winspool/v1.GetFormRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetFormRequest) }},
+	{"rprn/winspool/v1.GetFormResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetFormResponse) }},
+	{"rprn/winspool/v1.GetJobAttributesRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetJobAttributesRequest) }},
+	{"rprn/winspool/v1.GetJobAttributesResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetJobAttributesResponse) }},
+	{"rprn/winspool/v1.GetJobNamedPropertyValueRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetJobNamedPropertyValueRequest) }},
+	{"rprn/winspool/v1.GetJobNamedPropertyValueResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetJobNamedPropertyValueResponse) }},
+	{"rprn/winspool/v1.GetJobRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetJobRequest) }},
+	{"rprn/winspool/v1.GetJobResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetJobResponse) }},
+	{"rprn/winspool/v1.GetPrintProcessorDirectoryRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrintProcessorDirectoryRequest) }},
+	{"rprn/winspool/v1.GetPrintProcessorDirectoryResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrintProcessorDirectoryResponse) }},
+	{"rprn/winspool/v1.GetPrinterAttributesRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterAttributesRequest) }},
+	{"rprn/winspool/v1.GetPrinterAttributesResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterAttributesResponse) }},
+	{"rprn/winspool/v1.GetPrinterDataExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDataExRequest) }},
+	{"rprn/winspool/v1.GetPrinterDataExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDataExResponse) }},
+	{"rprn/winspool/v1.GetPrinterDataRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDataRequest) }},
+	{"rprn/winspool/v1.GetPrinterDataResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDataResponse) }},
+	{"rprn/winspool/v1.GetPrinterDriver2Request", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriver2Request) }},
+	{"rprn/winspool/v1.GetPrinterDriver2Response", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriver2Response) }},
+	{"rprn/winspool/v1.GetPrinterDriverDirectoryRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriverDirectoryRequest) }},
+	{"rprn/winspool/v1.GetPrinterDriverDirectoryResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriverDirectoryResponse) }},
+	{"rprn/winspool/v1.GetPrinterDriverPackagePathRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriverPackagePathRequest) }},
+	{"rprn/winspool/v1.GetPrinterDriverPackagePathResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriverPackagePathResponse) }},
+	{"rprn/winspool/v1.GetPrinterDriverRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriverRequest) }},
+	{"rprn/winspool/v1.GetPrinterDriverResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterDriverResponse) }},
+	{"rprn/winspool/v1.GetPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterRequest) }},
+	{"rprn/winspool/v1.GetPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.GetPrinterResponse) }},
+	{"rprn/winspool/v1.LogJobInfoForBranchOfficeRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.LogJobInfoForBranchOfficeRequest) }},
+	{"rprn/winspool/v1.LogJobInfoForBranchOfficeResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.LogJobInfoForBranchOfficeResponse) }},
+	{"rprn/winspool/v1.OpenPrinterExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.OpenPrinterExRequest) }},
+	{"rprn/winspool/v1.OpenPrinterExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.OpenPrinterExResponse) }},
+	{"rprn/winspool/v1.OpenPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.OpenPrinterRequest) }},
+	{"rprn/winspool/v1.OpenPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.OpenPrinterResponse) }},
+	{"rprn/winspool/v1.PlayGDIScriptOnPrinterICRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.PlayGDIScriptOnPrinterICRequest) }},
+	{"rprn/winspool/v1.PlayGDIScriptOnPrinterICResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.PlayGDIScriptOnPrinterICResponse) }},
+	{"rprn/winspool/v1.ReadPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ReadPrinterRequest) }},
+	{"rprn/winspool/v1.ReadPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ReadPrinterResponse) }},
+	{"rprn/winspool/v1.RegeneratePrintDeviceCapabilitiesRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RegeneratePrintDeviceCapabilitiesRequest) }},
+	{"rprn/winspool/v1.RegeneratePrintDeviceCapabilitiesResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RegeneratePrintDeviceCapabilitiesResponse) }},
+	{"rprn/winspool/v1.RemoteFindFirstPrinterChangeNotificationExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RemoteFindFirstPrinterChangeNotificationExRequest) }},
+	{"rprn/winspool/v1.RemoteFindFirstPrinterChangeNotificationExResponse", func() ndr.Unmarshaler {
+		return new(rprn_winspool_v1.RemoteFindFirstPrinterChangeNotificationExResponse)
+	}},
+	{"rprn/winspool/v1.RemoteFindFirstPrinterChangeNotificationRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RemoteFindFirstPrinterChangeNotificationRequest) }},
+	{"rprn/winspool/v1.RemoteFindFirstPrinterChangeNotificationResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RemoteFindFirstPrinterChangeNotificationResponse) }},
+	{"rprn/winspool/v1.ReplyClosePrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ReplyClosePrinterRequest) }},
+	{"rprn/winspool/v1.ReplyClosePrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ReplyClosePrinterResponse) }},
+	{"rprn/winspool/v1.ReplyOpenPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ReplyOpenPrinterRequest) }},
+	{"rprn/winspool/v1.ReplyOpenPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ReplyOpenPrinterResponse) }},
+	{"rprn/winspool/v1.ResetPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ResetPrinterRequest) }},
+	{"rprn/winspool/v1.ResetPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ResetPrinterResponse) }},
+	{"rprn/winspool/v1.RouterRefreshPrinterChangeNotificationRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RouterRefreshPrinterChangeNotificationRequest) }},
+	{"rprn/winspool/v1.RouterRefreshPrinterChangeNotificationResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RouterRefreshPrinterChangeNotificationResponse) }},
+	{"rprn/winspool/v1.RouterReplyPrinterExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RouterReplyPrinterExRequest) }},
+	{"rprn/winspool/v1.RouterReplyPrinterExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RouterReplyPrinterExResponse) }},
+	{"rprn/winspool/v1.RouterReplyPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RouterReplyPrinterRequest) }},
+	{"rprn/winspool/v1.RouterReplyPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.RouterReplyPrinterResponse) }},
+	{"rprn/winspool/v1.ScheduleJobRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ScheduleJobRequest) }},
+	{"rprn/winspool/v1.ScheduleJobResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.ScheduleJobResponse) }},
+	{"rprn/winspool/v1.SendRecvBIDIDataRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SendRecvBIDIDataRequest) }},
+	{"rprn/winspool/v1.SendRecvBIDIDataResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SendRecvBIDIDataResponse) }},
+	{"rprn/winspool/v1.SetFormRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetFormRequest) }},
+	{"rprn/winspool/v1.SetFormResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetFormResponse) }},
+	{"rprn/winspool/v1.SetJobAttributesRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetJobAttributesRequest) }},
+	{"rprn/winspool/v1.SetJobAttributesResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetJobAttributesResponse) }},
+	{"rprn/winspool/v1.SetJobNamedPropertyRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetJobNamedPropertyRequest) }},
+	{"rprn/winspool/v1.SetJobNamedPropertyResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetJobNamedPropertyResponse) }},
+	{"rprn/winspool/v1.SetJobRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetJobRequest) }},
+	{"rprn/winspool/v1.SetJobResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetJobResponse) }},
+	{"rprn/winspool/v1.SetPortRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPortRequest) }},
+	{"rprn/winspool/v1.SetPortResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPortResponse) }},
+	{"rprn/winspool/v1.SetPrinterAttributesRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterAttributesRequest) }},
+	{"rprn/winspool/v1.SetPrinterAttributesResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterAttributesResponse) }},
+	{"rprn/winspool/v1.SetPrinterDataExRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterDataExRequest) }},
+	{"rprn/winspool/v1.SetPrinterDataExResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterDataExResponse) }},
+	{"rprn/winspool/v1.SetPrinterDataRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterDataRequest) }},
+	{"rprn/winspool/v1.SetPrinterDataResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterDataResponse) }},
+	{"rprn/winspool/v1.SetPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterRequest) }},
+	{"rprn/winspool/v1.SetPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.SetPrinterResponse) }},
+	{"rprn/winspool/v1.StartDocPrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.StartDocPrinterRequest) }},
+	{"rprn/winspool/v1.StartDocPrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.StartDocPrinterResponse) }},
+	{"rprn/winspool/v1.StartPagePrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.StartPagePrinterRequest) }},
+	{"rprn/winspool/v1.StartPagePrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.StartPagePrinterResponse) }},
+	{"rprn/winspool/v1.WaitForPrinterChangeRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.WaitForPrinterChangeRequest) }},
+	{"rprn/winspool/v1.WaitForPrinterChangeResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.WaitForPrinterChangeResponse) }},
+	{"rprn/winspool/v1.WritePrinterRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.WritePrinterRequest) }},
+	{"rprn/winspool/v1.WritePrinterResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.WritePrinterResponse) }},
+	{"rprn/winspool/v1.XcvDataRequest", func() ndr.Unmarshaler { return new(rprn_winspool_v1.XcvDataRequest) }},
+	{"rprn/winspool/v1.XcvDataResponse", func() ndr.Unmarshaler { return new(rprn_winspool_v1.XcvDataResponse) }},
+	{"rrp/winreg/v1.BaseRegCloseKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegCloseKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegCloseKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegCloseKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegCreateKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegCreateKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegCreateKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegCreateKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegDeleteKeyExRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegDeleteKeyExRequest) }},
+	{"rrp/winreg/v1.BaseRegDeleteKeyExResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegDeleteKeyExResponse) }},
+	{"rrp/winreg/v1.BaseRegDeleteKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegDeleteKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegDeleteKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegDeleteKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegDeleteValueRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegDeleteValueRequest) }},
+	{"rrp/winreg/v1.BaseRegDeleteValueResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegDeleteValueResponse) }},
+	{"rrp/winreg/v1.BaseRegEnumKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegEnumKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegEnumKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegEnumKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegEnumValueRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegEnumValueRequest) }},
+	{"rrp/winreg/v1.BaseRegEnumValueResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegEnumValueResponse) }},
+	{"rrp/winreg/v1.BaseRegFlushKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegFlushKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegFlushKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegFlushKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegGetKeySecurityRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegGetKeySecurityRequest) }},
+	{"rrp/winreg/v1.BaseRegGetKeySecurityResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegGetKeySecurityResponse) }},
+	{"rrp/winreg/v1.BaseRegGetVersionRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegGetVersionRequest) }},
+	{"rrp/winreg/v1.BaseRegGetVersionResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegGetVersionResponse) }},
+	{"rrp/winreg/v1.BaseRegLoadKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegLoadKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegLoadKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegLoadKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegOpenKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegOpenKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegOpenKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegOpenKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegQueryInfoKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryInfoKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegQueryInfoKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryInfoKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegQueryMultipleValues2Request", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryMultipleValues2Request) }},
+	{"rrp/winreg/v1.BaseRegQueryMultipleValues2Response", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryMultipleValues2Response) }},
+	{"rrp/winreg/v1.BaseRegQueryMultipleValuesRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryMultipleValuesRequest) }},
+	{"rrp/winreg/v1.BaseRegQueryMultipleValuesResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryMultipleValuesResponse) }},
+	{"rrp/winreg/v1.BaseRegQueryValueRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryValueRequest) }},
+	{"rrp/winreg/v1.BaseRegQueryValueResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegQueryValueResponse) }},
+	{"rrp/winreg/v1.BaseRegReplaceKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegReplaceKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegReplaceKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegReplaceKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegRestoreKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegRestoreKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegRestoreKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegRestoreKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegSaveKeyExRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSaveKeyExRequest) }},
+	{"rrp/winreg/v1.BaseRegSaveKeyExResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSaveKeyExResponse) }},
+	{"rrp/winreg/v1.BaseRegSaveKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSaveKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegSaveKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSaveKeyResponse) }},
+	{"rrp/winreg/v1.BaseRegSetKeySecurityRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSetKeySecurityRequest) }},
+	{"rrp/winreg/v1.BaseRegSetKeySecurityResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSetKeySecurityResponse) }},
+	{"rrp/winreg/v1.BaseRegSetValueRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSetValueRequest) }},
+	{"rrp/winreg/v1.BaseRegSetValueResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegSetValueResponse) }},
+	{"rrp/winreg/v1.BaseRegUnloadKeyRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegUnloadKeyRequest) }},
+	{"rrp/winreg/v1.BaseRegUnloadKeyResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.BaseRegUnloadKeyResponse) }},
+	{"rrp/winreg/v1.OpenClassesRootRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenClassesRootRequest) }},
+	{"rrp/winreg/v1.OpenClassesRootResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenClassesRootResponse) }},
+	{"rrp/winreg/v1.OpenCurrentConfigRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenCurrentConfigRequest) }},
+	{"rrp/winreg/v1.OpenCurrentConfigResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenCurrentConfigResponse) }},
+	{"rrp/winreg/v1.OpenCurrentUserRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenCurrentUserRequest) }},
+	{"rrp/winreg/v1.OpenCurrentUserResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenCurrentUserResponse) }},
+	{"rrp/winreg/v1.OpenLocalMachineRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenLocalMachineRequest) }},
+	{"rrp/winreg/v1.OpenLocalMachineResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenLocalMachineResponse) }},
+	{"rrp/winreg/v1.OpenPerformanceDataRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenPerformanceDataRequest) }},
+	{"rrp/winreg/v1.OpenPerformanceDataResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenPerformanceDataResponse) }},
+	{"rrp/winreg/v1.OpenPerformanceNlsTextRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenPerformanceNlsTextRequest) }},
+	{"rrp/winreg/v1.OpenPerformanceNlsTextResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenPerformanceNlsTextResponse) }},
+	{"rrp/winreg/v1.OpenPerformanceTextRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenPerformanceTextRequest) }},
+	{"rrp/winreg/v1.OpenPerformanceTextResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenPerformanceTextResponse) }},
+	{"rrp/winreg/v1.OpenUsersRequest", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenUsersRequest) }},
+	{"rrp/winreg/v1.OpenUsersResponse", func() ndr.Unmarshaler { return new(rrp_winreg_v1.OpenUsersResponse) }},
+	{"rsp/initshutdown/v1.BaseAbortShutdownRequest", func() ndr.Unmarshaler { return new(rsp_initshutdown_v1.BaseAbortShutdownRequest) }},
+	{"rsp/initshutdown/v1.BaseAbortShutdownResponse", func() ndr.Unmarshaler { return new(rsp_initshutdown_v1.BaseAbortShutdownResponse) }},
+	{"rsp/initshutdown/v1.BaseInitiateShutdownExRequest", func() ndr.Unmarshaler { return new(rsp_initshutdown_v1.BaseInitiateShutdownExRequest) }},
+	{"rsp/initshutdown/v1.BaseInitiateShutdownExResponse", func() ndr.Unmarshaler { return new(rsp_initshutdown_v1.BaseInitiateShutdownExResponse) }},
+	{"rsp/initshutdown/v1.BaseInitiateShutdownRequest", func() ndr.Unmarshaler { return new(rsp_initshutdown_v1.BaseInitiateShutdownRequest) }},
+	{"rsp/initshutdown/v1.BaseInitiateShutdownResponse", func() ndr.Unmarshaler { return new(rsp_initshutdown_v1.BaseInitiateShutdownResponse) }},
+	{"rsp/windowsshutdown/v1.AbortShutdownRequest", func() ndr.Unmarshaler { return new(rsp_windowsshutdown_v1.AbortShutdownRequest) }},
+	{"rsp/windowsshutdown/v1.AbortShutdownResponse", func() ndr.Unmarshaler { return new(rsp_windowsshutdown_v1.AbortShutdownResponse) }},
+	{"rsp/windowsshutdown/v1.InitiateShutdownRequest", func() ndr.Unmarshaler { return new(rsp_windowsshutdown_v1.InitiateShutdownRequest) }},
+	{"rsp/windowsshutdown/v1.InitiateShutdownResponse", func() ndr.Unmarshaler { return new(rsp_windowsshutdown_v1.InitiateShutdownResponse) }},
+	{"rsp/winreg/v1.BaseAbortSystemShutdownRequest", func() ndr.Unmarshaler { return new(rsp_winreg_v1.BaseAbortSystemShutdownRequest) }},
+	{"rsp/winreg/v1.BaseAbortSystemShutdownResponse", func() ndr.Unmarshaler { return new(rsp_winreg_v1.BaseAbortSystemShutdownResponse) }},
+	{"rsp/winreg/v1.BaseInitiateSystemShutdownExRequest", func() ndr.Unmarshaler { return new(rsp_winreg_v1.BaseInitiateSystemShutdownExRequest) }},
+	{"rsp/winreg/v1.BaseInitiateSystemShutdownExResponse", func() ndr.Unmarshaler { return new(rsp_winreg_v1.BaseInitiateSystemShutdownExResponse) }},
+	{"rsp/winreg/v1.BaseInitiateSystemShutdownRequest", func() ndr.Unmarshaler { return new(rsp_winreg_v1.BaseInitiateSystemShutdownRequest) }},
+	{"rsp/winreg/v1.BaseInitiateSystemShutdownResponse", func() ndr.Unmarshaler { return new(rsp_winreg_v1.BaseInitiateSystemShutdownResponse) }},
+	{"samr/samr/v1.AddMemberToAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.AddMemberToAliasRequest) }},
+	{"samr/samr/v1.AddMemberToAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.AddMemberToAliasResponse) }},
+	{"samr/samr/v1.AddMemberToGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.AddMemberToGroupRequest) }},
+	{"samr/samr/v1.AddMemberToGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.AddMemberToGroupResponse) }},
+	{"samr/samr/v1.AddMultipleMembersToAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.AddMultipleMembersToAliasRequest) }},
+	{"samr/samr/v1.AddMultipleMembersToAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.AddMultipleMembersToAliasResponse) }},
+	{"samr/samr/v1.ChangePasswordUserRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.ChangePasswordUserRequest) }},
+	{"samr/samr/v1.ChangePasswordUserResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.ChangePasswordUserResponse) }},
+	{"samr/samr/v1.CloseHandleRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.CloseHandleRequest) }},
+	{"samr/samr/v1.CloseHandleResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.CloseHandleResponse) }},
+	{"samr/samr/v1.Connect2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.Connect2Request) }},
+	{"samr/samr/v1.Connect2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.Connect2Response) }},
+	{"samr/samr/v1.Connect4Request", func() ndr.Unmarshaler { return new(samr_samr_v1.Connect4Request) }},
+	{"samr/samr/v1.Connect4Response", func() ndr.Unmarshaler { return new(samr_samr_v1.Connect4Response) }},
+	{"samr/samr/v1.Connect5Request", func() ndr.Unmarshaler { return new(samr_samr_v1.Connect5Request) }},
+	{"samr/samr/v1.Connect5Response", func() ndr.Unmarshaler { return new(samr_samr_v1.Connect5Response) }},
+	{"samr/samr/v1.ConnectRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.ConnectRequest) }},
+	{"samr/samr/v1.ConnectResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.ConnectResponse) }},
+	{"samr/samr/v1.CreateAliasInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateAliasInDomainRequest) }},
+	{"samr/samr/v1.CreateAliasInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateAliasInDomainResponse) }},
+	{"samr/samr/v1.CreateGroupInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateGroupInDomainRequest) }},
+	{"samr/samr/v1.CreateGroupInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateGroupInDomainResponse) }},
+	{"samr/samr/v1.CreateUser2InDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateUser2InDomainRequest) }},
+	{"samr/samr/v1.CreateUser2InDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateUser2InDomainResponse) }},
+	{"samr/samr/v1.CreateUserInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateUserInDomainRequest) }},
+	{"samr/samr/v1.CreateUserInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.CreateUserInDomainResponse) }},
+	{"samr/samr/v1.DeleteAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.DeleteAliasRequest) }},
+	{"samr/samr/v1.DeleteAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.DeleteAliasResponse) }},
+	{"samr/samr/v1.DeleteGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.DeleteGroupRequest) }},
+	{"samr/samr/v1.DeleteGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.DeleteGroupResponse) }},
+	{"samr/samr/v1.DeleteUserRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.DeleteUserRequest) }},
+	{"samr/samr/v1.DeleteUserResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.DeleteUserResponse) }},
+	{"samr/samr/v1.EnumerateAliasesInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateAliasesInDomainRequest) }},
+	{"samr/samr/v1.EnumerateAliasesInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateAliasesInDomainResponse) }},
+	{"samr/samr/v1.EnumerateDomainsInSAMServerRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateDomainsInSAMServerRequest) }},
+	{"samr/samr/v1.EnumerateDomainsInSAMServerResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateDomainsInSAMServerResponse) }},
+	{"samr/samr/v1.EnumerateGroupsInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateGroupsInDomainRequest) }},
+	{"samr/samr/v1.EnumerateGroupsInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateGroupsInDomainResponse) }},
+	{"samr/samr/v1.EnumerateUsersInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateUsersInDomainRequest) }},
+	{"samr/samr/v1.EnumerateUsersInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.EnumerateUsersInDomainResponse) }},
+	{"samr/samr/v1.GetAliasMembershipRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.GetAliasMembershipRequest) }},
+	{"samr/samr/v1.GetAliasMembershipResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.GetAliasMembershipResponse) }},
+	{"samr/samr/v1.GetDisplayEnumerationIndex2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.GetDisplayEnumerationIndex2Request) }},
+	{"samr/samr/v1.GetDisplayEnumerationIndex2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.GetDisplayEnumerationIndex2Response) }},
+	{"samr/samr/v1.GetDisplayEnumerationIndexRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.GetDisplayEnumerationIndexRequest) }},
+	{"samr/samr/v1.GetDisplayEnumerationIndexResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.GetDisplayEnumerationIndexResponse) }},
+	{"samr/samr/v1.GetDomainPasswordInformationRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.GetDomainPasswordInformationRequest) }},
+	{"samr/samr/v1.GetDomainPasswordInformationResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.GetDomainPasswordInformationResponse) }},
+	{"samr/samr/v1.GetGroupsForUserRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.GetGroupsForUserRequest) }},
+	{"samr/samr/v1.GetGroupsForUserResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.GetGroupsForUserResponse) }},
+	{"samr/samr/v1.GetMembersInAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.GetMembersInAliasRequest) }},
+	{"samr/samr/v1.GetMembersInAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.GetMembersInAliasResponse) }},
+	{"samr/samr/v1.GetMembersInGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.GetMembersInGroupRequest) }},
+	{"samr/samr/v1.GetMembersInGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.GetMembersInGroupResponse) }},
+	{"samr/samr/v1.GetUserDomainPasswordInformationRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.GetUserDomainPasswordInformationRequest) }},
+	{"samr/samr/v1.GetUserDomainPasswordInformationResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.GetUserDomainPasswordInformationResponse) }},
+	{"samr/samr/v1.LookupDomainInSAMServerRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.LookupDomainInSAMServerRequest) }},
+	{"samr/samr/v1.LookupDomainInSAMServerResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.LookupDomainInSAMServerResponse) }},
+	{"samr/samr/v1.LookupIDsInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.LookupIDsInDomainRequest) }},
+	{"samr/samr/v1.LookupIDsInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.LookupIDsInDomainResponse) }},
+	{"samr/samr/v1.LookupNamesInDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.LookupNamesInDomainRequest) }},
+	{"samr/samr/v1.LookupNamesInDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.LookupNamesInDomainResponse) }},
+	{"samr/samr/v1.OEMChangePasswordUser2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.OEMChangePasswordUser2Request) }},
+	{"samr/samr/v1.OEMChangePasswordUser2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.OEMChangePasswordUser2Response) }},
+	{"samr/samr/v1.OpenAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenAliasRequest) }},
+	{"samr/samr/v1.OpenAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenAliasResponse) }},
+	{"samr/samr/v1.OpenDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenDomainRequest) }},
+	{"samr/samr/v1.OpenDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenDomainResponse) }},
+	{"samr/samr/v1.OpenGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenGroupRequest) }},
+	{"samr/samr/v1.OpenGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenGroupResponse) }},
+	{"samr/samr/v1.OpenUserRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenUserRequest) }},
+	{"samr/samr/v1.OpenUserResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.OpenUserResponse) }},
+	{"samr/samr/v1.QueryDisplayInformation2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryDisplayInformation2Request) }},
+	{"samr/samr/v1.QueryDisplayInformation2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryDisplayInformation2Response) }},
+	{"samr/samr/v1.QueryDisplayInformation3Request", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryDisplayInformation3Request) }},
+	{"samr/samr/v1.QueryDisplayInformation3Response", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryDisplayInformation3Response) }},
+	{"samr/samr/v1.QueryDisplayInformationRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryDisplayInformationRequest) }},
+	{"samr/samr/v1.QueryDisplayInformationResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryDisplayInformationResponse) }},
+	{"samr/samr/v1.QueryInformationAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationAliasRequest) }},
+	{"samr/samr/v1.QueryInformationAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationAliasResponse) }},
+	{"samr/samr/v1.QueryInformationDomain2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationDomain2Request) }},
+	{"samr/samr/v1.QueryInformationDomain2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationDomain2Response) }},
+	{"samr/samr/v1.QueryInformationDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationDomainRequest) }},
+	{"samr/samr/v1.QueryInformationDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationDomainResponse) }},
+	{"samr/samr/v1.QueryInformationGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationGroupRequest) }},
+	{"samr/samr/v1.QueryInformationGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationGroupResponse) }},
+	{"samr/samr/v1.QueryInformationUser2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationUser2Request) }},
+	{"samr/samr/v1.QueryInformationUser2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationUser2Response) }},
+	{"samr/samr/v1.QueryInformationUserRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationUserRequest) }},
+	{"samr/samr/v1.QueryInformationUserResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.QueryInformationUserResponse) }},
+	{"samr/samr/v1.QuerySecurityObjectRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.QuerySecurityObjectRequest) }},
+	{"samr/samr/v1.QuerySecurityObjectResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.QuerySecurityObjectResponse) }},
+	{"samr/samr/v1.RIDToSIDRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.RIDToSIDRequest) }},
+	{"samr/samr/v1.RIDToSIDResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.RIDToSIDResponse) }},
+	{"samr/samr/v1.RemoveMemberFromAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMemberFromAliasRequest) }},
+	{"samr/samr/v1.RemoveMemberFromAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMemberFromAliasResponse) }},
+	{"samr/samr/v1.RemoveMemberFromForeignDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMemberFromForeignDomainRequest) }},
+	{"samr/samr/v1.RemoveMemberFromForeignDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMemberFromForeignDomainResponse) }},
+	{"samr/samr/v1.RemoveMemberFromGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMemberFromGroupRequest) }},
+	{"samr/samr/v1.RemoveMemberFromGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMemberFromGroupResponse) }},
+	{"samr/samr/v1.RemoveMultipleMembersFromAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMultipleMembersFromAliasRequest) }},
+	{"samr/samr/v1.RemoveMultipleMembersFromAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.RemoveMultipleMembersFromAliasResponse) }},
+	{"samr/samr/v1.SetDSRMPasswordRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.SetDSRMPasswordRequest) }},
+	{"samr/samr/v1.SetDSRMPasswordResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.SetDSRMPasswordResponse) }},
+	{"samr/samr/v1.SetInformationAliasRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationAliasRequest) }},
+	{"samr/samr/v1.SetInformationAliasResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationAliasResponse) }},
+	{"samr/samr/v1.SetInformationDomainRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationDomainRequest) }},
+	{"samr/samr/v1.SetInformationDomainResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationDomainResponse) }},
+	{"samr/samr/v1.SetInformationGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationGroupRequest) }},
+	{"samr/samr/v1.SetInformationGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationGroupResponse) }},
+	{"samr/samr/v1.SetInformationUser2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationUser2Request) }},
+	{"samr/samr/v1.SetInformationUser2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationUser2Response) }},
+	{"samr/samr/v1.SetInformationUserRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationUserRequest) }},
+	{"samr/samr/v1.SetInformationUserResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.SetInformationUserResponse) }},
+	{"samr/samr/v1.SetMemberAttributesOfGroupRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.SetMemberAttributesOfGroupRequest) }},
+	{"samr/samr/v1.SetMemberAttributesOfGroupResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.SetMemberAttributesOfGroupResponse) }},
+	{"samr/samr/v1.SetSecurityObjectRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.SetSecurityObjectRequest) }},
+	{"samr/samr/v1.SetSecurityObjectResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.SetSecurityObjectResponse) }},
+	{"samr/samr/v1.UnicodeChangePasswordUser2Request", func() ndr.Unmarshaler { return new(samr_samr_v1.UnicodeChangePasswordUser2Request) }},
+	{"samr/samr/v1.UnicodeChangePasswordUser2Response", func() ndr.Unmarshaler { return new(samr_samr_v1.UnicodeChangePasswordUser2Response) }},
+	{"samr/samr/v1.ValidatePasswordRequest", func() ndr.Unmarshaler { return new(samr_samr_v1.ValidatePasswordRequest) }},
+	{"samr/samr/v1.ValidatePasswordResponse", func() ndr.Unmarshaler { return new(samr_samr_v1.ValidatePasswordResponse) }},
+	{"sch/itaskschedulerservice/v1.CreateFolderRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.CreateFolderRequest) }},
+	{"sch/itaskschedulerservice/v1.CreateFolderResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.CreateFolderResponse) }},
+	{"sch/itaskschedulerservice/v1.DeleteRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.DeleteRequest) }},
+	{"sch/itaskschedulerservice/v1.DeleteResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.DeleteResponse) }},
+	{"sch/itaskschedulerservice/v1.EnableTaskRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnableTaskRequest) }},
+	{"sch/itaskschedulerservice/v1.EnableTaskResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnableTaskResponse) }},
+	{"sch/itaskschedulerservice/v1.EnumFoldersRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnumFoldersRequest) }},
+	{"sch/itaskschedulerservice/v1.EnumFoldersResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnumFoldersResponse) }},
+	{"sch/itaskschedulerservice/v1.EnumInstancesRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnumInstancesRequest) }},
+	{"sch/itaskschedulerservice/v1.EnumInstancesResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnumInstancesResponse) }},
+	{"sch/itaskschedulerservice/v1.EnumTasksRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnumTasksRequest) }},
+	{"sch/itaskschedulerservice/v1.EnumTasksResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.EnumTasksResponse) }},
+	{"sch/itaskschedulerservice/v1.GetInstanceInfoRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetInstanceInfoRequest) }},
+	{"sch/itaskschedulerservice/v1.GetInstanceInfoResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetInstanceInfoResponse) }},
+	{"sch/itaskschedulerservice/v1.GetLastRunInfoRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetLastRunInfoRequest) }},
+	{"sch/itaskschedulerservice/v1.GetLastRunInfoResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetLastRunInfoResponse) }},
+	{"sch/itaskschedulerservice/v1.GetNumberOfMissedRunsRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetNumberOfMissedRunsRequest) }},
+	{"sch/itaskschedulerservice/v1.GetNumberOfMissedRunsResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetNumberOfMissedRunsResponse) }},
+	{"sch/itaskschedulerservice/v1.GetSecurityRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetSecurityRequest) }},
+	{"sch/itaskschedulerservice/v1.GetSecurityResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetSecurityResponse) }},
+	{"sch/itaskschedulerservice/v1.GetTaskInfoRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetTaskInfoRequest) }},
+	{"sch/itaskschedulerservice/v1.GetTaskInfoResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.GetTaskInfoResponse) }},
+	{"sch/itaskschedulerservice/v1.HighestVersionRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.HighestVersionRequest) }},
+	{"sch/itaskschedulerservice/v1.HighestVersionResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.HighestVersionResponse) }},
+	{"sch/itaskschedulerservice/v1.RegisterTaskRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RegisterTaskRequest) }},
+	{"sch/itaskschedulerservice/v1.RegisterTaskResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RegisterTaskResponse) }},
+	{"sch/itaskschedulerservice/v1.RenameRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RenameRequest) }},
+	{"sch/itaskschedulerservice/v1.RenameResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RenameResponse) }},
+	{"sch/itaskschedulerservice/v1.RetrieveTaskRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RetrieveTaskRequest) }},
+	{"sch/itaskschedulerservice/v1.RetrieveTaskResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RetrieveTaskResponse) }},
+	{"sch/itaskschedulerservice/v1.RunRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RunRequest) }},
+	{"sch/itaskschedulerservice/v1.RunResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.RunResponse) }},
+	{"sch/itaskschedulerservice/v1.ScheduledRuntimesRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.ScheduledRuntimesRequest) }},
+	{"sch/itaskschedulerservice/v1.ScheduledRuntimesResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.ScheduledRuntimesResponse) }},
+	{"sch/itaskschedulerservice/v1.SetSecurityRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.SetSecurityRequest) }},
+	{"sch/itaskschedulerservice/v1.SetSecurityResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.SetSecurityResponse) }},
+	{"sch/itaskschedulerservice/v1.StopInstanceRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.StopInstanceRequest) }},
+	{"sch/itaskschedulerservice/v1.StopInstanceResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.StopInstanceResponse) }},
+	{"sch/itaskschedulerservice/v1.StopRequest", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.StopRequest) }},
+	{"sch/itaskschedulerservice/v1.StopResponse", func() ndr.Unmarshaler { return new(sch_itaskschedulerservice_v1.StopResponse) }},
+	{"scmr/svcctl/v2.ChangeServiceConfig2ARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfig2ARequest) }},
+	{"scmr/svcctl/v2.ChangeServiceConfig2AResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfig2AResponse) }},
+	{"scmr/svcctl/v2.ChangeServiceConfig2WRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfig2WRequest) }},
+	{"scmr/svcctl/v2.ChangeServiceConfig2WResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfig2WResponse) }},
+	{"scmr/svcctl/v2.ChangeServiceConfigARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfigARequest) }},
+	{"scmr/svcctl/v2.ChangeServiceConfigAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfigAResponse) }},
+	{"scmr/svcctl/v2.ChangeServiceConfigWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfigWRequest) }},
+	{"scmr/svcctl/v2.ChangeServiceConfigWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ChangeServiceConfigWResponse) }},
+	{"scmr/svcctl/v2.CloseNotifyRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CloseNotifyRequest) }},
+	{"scmr/svcctl/v2.CloseNotifyResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CloseNotifyResponse) }},
+	{"scmr/svcctl/v2.CloseServiceRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CloseServiceRequest) }},
+	{"scmr/svcctl/v2.CloseServiceResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CloseServiceResponse) }},
+	{"scmr/svcctl/v2.ControlServiceExARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ControlServiceExARequest) }},
+	{"scmr/svcctl/v2.ControlServiceExAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ControlServiceExAResponse) }},
+	{"scmr/svcctl/v2.ControlServiceExWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ControlServiceExWRequest) }},
+	{"scmr/svcctl/v2.ControlServiceExWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ControlServiceExWResponse) }},
+	{"scmr/svcctl/v2.ControlServiceRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ControlServiceRequest) }},
+	{"scmr/svcctl/v2.ControlServiceResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.ControlServiceResponse) }},
+	{"scmr/svcctl/v2.CreateServiceARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceARequest) }},
+	{"scmr/svcctl/v2.CreateServiceAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceAResponse) }},
+	{"scmr/svcctl/v2.CreateServiceWOW64ARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceWOW64ARequest) }},
+	{"scmr/svcctl/v2.CreateServiceWOW64AResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceWOW64AResponse) }},
+	{"scmr/svcctl/v2.CreateServiceWOW64WRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceWOW64WRequest) }},
+	{"scmr/svcctl/v2.CreateServiceWOW64WResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceWOW64WResponse) }},
+	{"scmr/svcctl/v2.CreateServiceWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceWRequest) }},
+	{"scmr/svcctl/v2.CreateServiceWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateServiceWResponse) }},
+	{"scmr/svcctl/v2.CreateWOWServiceRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateWOWServiceRequest) }},
+	{"scmr/svcctl/v2.CreateWOWServiceResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.CreateWOWServiceResponse) }},
+	{"scmr/svcctl/v2.DeleteServiceRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.DeleteServiceRequest) }},
+	{"scmr/svcctl/v2.DeleteServiceResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.DeleteServiceResponse) }},
+	{"scmr/svcctl/v2.EnumDependentServicesARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumDependentServicesARequest) }},
+	{"scmr/svcctl/v2.EnumDependentServicesAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumDependentServicesAResponse) }},
+	{"scmr/svcctl/v2.EnumDependentServicesWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumDependentServicesWRequest) }},
+	{"scmr/svcctl/v2.EnumDependentServicesWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumDependentServicesWResponse) }},
+	{"scmr/svcctl/v2.EnumServiceGroupWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServiceGroupWRequest) }},
+	{"scmr/svcctl/v2.EnumServiceGroupWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServiceGroupWResponse) }},
+	{"scmr/svcctl/v2.EnumServicesStatusARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusARequest) }},
+	{"scmr/svcctl/v2.EnumServicesStatusAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusAResponse) }},
+	{"scmr/svcctl/v2.EnumServicesStatusExARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusExARequest) }},
+	{"scmr/svcctl/v2.EnumServicesStatusExAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusExAResponse) }},
+	{"scmr/svcctl/v2.EnumServicesStatusExWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusExWRequest) }},
+	{"scmr/svcctl/v2.EnumServicesStatusExWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusExWResponse) }},
+	{"scmr/svcctl/v2.EnumServicesStatusWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusWRequest) }},
+	{"scmr/svcctl/v2.EnumServicesStatusWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.EnumServicesStatusWResponse) }},
+	{"scmr/svcctl/v2.GetNotifyResultsRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetNotifyResultsRequest) }},
+	{"scmr/svcctl/v2.GetNotifyResultsResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetNotifyResultsResponse) }},
+	{"scmr/svcctl/v2.GetServiceDisplayNameARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceDisplayNameARequest) }},
+	{"scmr/svcctl/v2.GetServiceDisplayNameAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceDisplayNameAResponse) }},
+	{"scmr/svcctl/v2.GetServiceDisplayNameWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceDisplayNameWRequest) }},
+	{"scmr/svcctl/v2.GetServiceDisplayNameWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceDisplayNameWResponse) }},
+	{"scmr/svcctl/v2.GetServiceKeyNameARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceKeyNameARequest) }},
+	{"scmr/svcctl/v2.GetServiceKeyNameAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceKeyNameAResponse) }},
+	{"scmr/svcctl/v2.GetServiceKeyNameWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceKeyNameWRequest) }},
+	{"scmr/svcctl/v2.GetServiceKeyNameWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.GetServiceKeyNameWResponse) }},
+	{"scmr/svcctl/v2.LockServiceDatabaseRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.LockServiceDatabaseRequest) }},
+	{"scmr/svcctl/v2.LockServiceDatabaseResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.LockServiceDatabaseResponse) }},
+	{"scmr/svcctl/v2.NotifyBootConfigStatusRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.NotifyBootConfigStatusRequest) }},
+	{"scmr/svcctl/v2.NotifyBootConfigStatusResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.NotifyBootConfigStatusResponse) }},
+	{"scmr/svcctl/v2.NotifyServiceStatusChangeRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.NotifyServiceStatusChangeRequest) }},
+	{"scmr/svcctl/v2.NotifyServiceStatusChangeResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.NotifyServiceStatusChangeResponse) }},
+	{"scmr/svcctl/v2.OpenSCM2Request", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenSCM2Request) }},
+	{"scmr/svcctl/v2.OpenSCM2Response", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenSCM2Response) }},
+	{"scmr/svcctl/v2.OpenSCMARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenSCMARequest) }},
+	{"scmr/svcctl/v2.OpenSCMAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenSCMAResponse) }},
+	{"scmr/svcctl/v2.OpenSCMWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenSCMWRequest) }},
+	{"scmr/svcctl/v2.OpenSCMWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenSCMWResponse) }},
+	{"scmr/svcctl/v2.OpenServiceARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenServiceARequest) }},
+	{"scmr/svcctl/v2.OpenServiceAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenServiceAResponse) }},
+	{"scmr/svcctl/v2.OpenServiceWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenServiceWRequest) }},
+	{"scmr/svcctl/v2.OpenServiceWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.OpenServiceWResponse) }},
+	{"scmr/svcctl/v2.QueryServiceConfig2ARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfig2ARequest) }},
+	{"scmr/svcctl/v2.QueryServiceConfig2AResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfig2AResponse) }},
+	{"scmr/svcctl/v2.QueryServiceConfig2WRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfig2WRequest) }},
+	{"scmr/svcctl/v2.QueryServiceConfig2WResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfig2WResponse) }},
+	{"scmr/svcctl/v2.QueryServiceConfigARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfigARequest) }},
+	{"scmr/svcctl/v2.QueryServiceConfigAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfigAResponse) }},
+	{"scmr/svcctl/v2.QueryServiceConfigExRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfigExRequest) }},
+	{"scmr/svcctl/v2.QueryServiceConfigExResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfigExResponse) }},
+	{"scmr/svcctl/v2.QueryServiceConfigWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfigWRequest) }},
+	{"scmr/svcctl/v2.QueryServiceConfigWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceConfigWResponse) }},
+	{"scmr/svcctl/v2.QueryServiceLockStatusARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceLockStatusARequest) }},
+	{"scmr/svcctl/v2.QueryServiceLockStatusAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceLockStatusAResponse) }},
+	{"scmr/svcctl/v2.QueryServiceLockStatusWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceLockStatusWRequest) }},
+	{"scmr/svcctl/v2.QueryServiceLockStatusWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceLockStatusWResponse) }},
+	{"scmr/svcctl/v2.QueryServiceObjectSecurityRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceObjectSecurityRequest) }},
+	{"scmr/svcctl/v2.QueryServiceObjectSecurityResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceObjectSecurityResponse) }},
+	{"scmr/svcctl/v2.QueryServiceStatusExRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceStatusExRequest) }},
+	{"scmr/svcctl/v2.QueryServiceStatusExResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceStatusExResponse) }},
+	{"scmr/svcctl/v2.QueryServiceStatusRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceStatusRequest) }},
+	{"scmr/svcctl/v2.QueryServiceStatusResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.QueryServiceStatusResponse) }},
+	{"scmr/svcctl/v2.SetServiceObjectSecurityRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.SetServiceObjectSecurityRequest) }},
+	{"scmr/svcctl/v2.SetServiceObjectSecurityResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.SetServiceObjectSecurityResponse) }},
+	{"scmr/svcctl/v2.SetServiceStatusRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.SetServiceStatusRequest) }},
+	{"scmr/svcctl/v2.SetServiceStatusResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.SetServiceStatusResponse) }},
+	{"scmr/svcctl/v2.StartServiceARequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.StartServiceARequest) }},
+	{"scmr/svcctl/v2.StartServiceAResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.StartServiceAResponse) }},
+	{"scmr/svcctl/v2.StartServiceWRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.StartServiceWRequest) }},
+	{"scmr/svcctl/v2.StartServiceWResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.StartServiceWResponse) }},
+	{"scmr/svcctl/v2.UnlockServiceDatabaseRequest", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.UnlockServiceDatabaseRequest) }},
+	{"scmr/svcctl/v2.UnlockServiceDatabaseResponse", func() ndr.Unmarshaler { return new(scmr_svcctl_v2.UnlockServiceDatabaseResponse) }},
+	{"srvs/srvsvc/v3.AliasAddRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.AliasAddRequest) }},
+	{"srvs/srvsvc/v3.AliasAddResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.AliasAddResponse) }},
+	{"srvs/srvsvc/v3.AliasDeleteRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.AliasDeleteRequest) }},
+	{"srvs/srvsvc/v3.AliasDeleteResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.AliasDeleteResponse) }},
+	{"srvs/srvsvc/v3.AliasEnumRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.AliasEnumRequest) }},
+	{"srvs/srvsvc/v3.AliasEnumResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.AliasEnumResponse) }},
+	{"srvs/srvsvc/v3.ConnectionEnumRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ConnectionEnumRequest) }},
+	{"srvs/srvsvc/v3.ConnectionEnumResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ConnectionEnumResponse) }},
+	{"srvs/srvsvc/v3.CreateExitPointRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.CreateExitPointRequest) }},
+	{"srvs/srvsvc/v3.CreateExitPointResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.CreateExitPointResponse) }},
+	{"srvs/srvsvc/v3.CreateLocalPartitionRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.CreateLocalPartitionRequest) }},
+	{"srvs/srvsvc/v3.CreateLocalPartitionResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.CreateLocalPartitionResponse) }},
+	{"srvs/srvsvc/v3.DeleteExitPointRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.DeleteExitPointRequest) }},
+	{"srvs/srvsvc/v3.DeleteExitPointResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.DeleteExitPointResponse) }},
+	{"srvs/srvsvc/v3.DeleteLocalPartitionRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.DeleteLocalPartitionRequest) }},
+	{"srvs/srvsvc/v3.DeleteLocalPartitionResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.DeleteLocalPartitionResponse) }},
+	{"srvs/srvsvc/v3.DiskEnumRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.DiskEnumRequest) }},
+	{"srvs/srvsvc/v3.DiskEnumResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.DiskEnumResponse) }},
+	{"srvs/srvsvc/v3.FileCloseRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FileCloseRequest) }},
+	{"srvs/srvsvc/v3.FileCloseResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FileCloseResponse) }},
+	{"srvs/srvsvc/v3.FileEnumRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FileEnumRequest) }},
+	{"srvs/srvsvc/v3.FileEnumResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FileEnumResponse) }},
+	{"srvs/srvsvc/v3.FileGetInfoRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FileGetInfoRequest) }},
+	{"srvs/srvsvc/v3.FileGetInfoResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FileGetInfoResponse) }},
+	{"srvs/srvsvc/v3.FixLocalVolumeRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FixLocalVolumeRequest) }},
+	{"srvs/srvsvc/v3.FixLocalVolumeResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.FixLocalVolumeResponse) }},
+	{"srvs/srvsvc/v3.GetFileSecurityRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.GetFileSecurityRequest) }},
+	{"srvs/srvsvc/v3.GetFileSecurityResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.GetFileSecurityResponse) }},
+	{"srvs/srvsvc/v3.GetInfoRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.GetInfoRequest) }},
+	{"srvs/srvsvc/v3.GetInfoResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.GetInfoResponse) }},
+	{"srvs/srvsvc/v3.GetVersionRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.GetVersionRequest) }},
+	{"srvs/srvsvc/v3.GetVersionResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.GetVersionResponse) }},
+	{"srvs/srvsvc/v3.ManagerReportSiteInfoRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ManagerReportSiteInfoRequest) }},
+	{"srvs/srvsvc/v3.ManagerReportSiteInfoResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ManagerReportSiteInfoResponse) }},
+	{"srvs/srvsvc/v3.ModifyPrefixRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ModifyPrefixRequest) }},
+	{"srvs/srvsvc/v3.ModifyPrefixResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ModifyPrefixResponse) }},
+	{"srvs/srvsvc/v3.NameCanonicalizeRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.NameCanonicalizeRequest) }},
+	{"srvs/srvsvc/v3.NameCanonicalizeResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.NameCanonicalizeResponse) }},
+	{"srvs/srvsvc/v3.NameCompareRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.NameCompareRequest) }},
+	{"srvs/srvsvc/v3.NameCompareResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.NameCompareResponse) }},
+	{"srvs/srvsvc/v3.NameValidateRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.NameValidateRequest) }},
+	{"srvs/srvsvc/v3.NameValidateResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.NameValidateResponse) }},
+	{"srvs/srvsvc/v3.PathCanonicalizeRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.PathCanonicalizeRequest) }},
+	{"srvs/srvsvc/v3.PathCanonicalizeResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.PathCanonicalizeResponse) }},
+	{"srvs/srvsvc/v3.PathCompareRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.PathCompareRequest) }},
+	{"srvs/srvsvc/v3.PathCompareResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.PathCompareResponse) }},
+	{"srvs/srvsvc/v3.PathTypeRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.PathTypeRequest) }},
+	{"srvs/srvsvc/v3.PathTypeResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.PathTypeResponse) }},
+	{"srvs/srvsvc/v3.RemoteToDRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.RemoteToDRequest) }},
+	{"srvs/srvsvc/v3.RemoteToDResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.RemoteToDResponse) }},
+	{"srvs/srvsvc/v3.SessionDeleteRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SessionDeleteRequest) }},
+	{"srvs/srvsvc/v3.SessionDeleteResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SessionDeleteResponse) }},
+	{"srvs/srvsvc/v3.SessionEnumRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SessionEnumRequest) }},
+	{"srvs/srvsvc/v3.SessionEnumResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SessionEnumResponse) }},
+	{"srvs/srvsvc/v3.SetFileSecurityRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SetFileSecurityRequest) }},
+	{"srvs/srvsvc/v3.SetFileSecurityResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SetFileSecurityResponse) }},
+	{"srvs/srvsvc/v3.SetInfoRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SetInfoRequest) }},
+	{"srvs/srvsvc/v3.SetInfoResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SetInfoResponse) }},
+	{"srvs/srvsvc/v3.SetLocalVolumeStateRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SetLocalVolumeStateRequest) }},
+	{"srvs/srvsvc/v3.SetLocalVolumeStateResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.SetLocalVolumeStateResponse) }},
+	{"srvs/srvsvc/v3.ShareAddRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareAddRequest) }},
+	{"srvs/srvsvc/v3.ShareAddResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareAddResponse) }},
+	{"srvs/srvsvc/v3.ShareCheckRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareCheckRequest) }},
+	{"srvs/srvsvc/v3.ShareCheckResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareCheckResponse) }},
+	{"srvs/srvsvc/v3.ShareDeleteCommitRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteCommitRequest) }},
+	{"srvs/srvsvc/v3.ShareDeleteCommitResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteCommitResponse) }},
+	{"srvs/srvsvc/v3.ShareDeleteExRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteExRequest) }},
+	{"srvs/srvsvc/v3.ShareDeleteExResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteExResponse) }},
+	{"srvs/srvsvc/v3.ShareDeleteRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteRequest) }},
+	{"srvs/srvsvc/v3.ShareDeleteResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteResponse) }},
+	{"srvs/srvsvc/v3.ShareDeleteStartRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteStartRequest) }},
+	{"srvs/srvsvc/v3.ShareDeleteStartResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteStartResponse) }},
+	{"srvs/srvsvc/v3.ShareDeleteStickyRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteStickyRequest) }},
+	{"srvs/srvsvc/v3.ShareDeleteStickyResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareDeleteStickyResponse) }},
+	{"srvs/srvsvc/v3.ShareEnumRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareEnumRequest) }},
+	{"srvs/srvsvc/v3.ShareEnumResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareEnumResponse) }},
+	{"srvs/srvsvc/v3.ShareEnumStickyRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareEnumStickyRequest) }},
+	{"srvs/srvsvc/v3.ShareEnumStickyResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareEnumStickyResponse) }},
+	{"srvs/srvsvc/v3.ShareGetInfoRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareGetInfoRequest) }},
+	{"srvs/srvsvc/v3.ShareGetInfoResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareGetInfoResponse) }},
+	{"srvs/srvsvc/v3.ShareSetInfoRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareSetInfoRequest) }},
+	{"srvs/srvsvc/v3.ShareSetInfoResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.ShareSetInfoResponse) }},
+	{"srvs/srvsvc/v3.StatisticsGetRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.StatisticsGetRequest) }},
+	{"srvs/srvsvc/v3.StatisticsGetResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.StatisticsGetResponse) }},
+	{"srvs/srvsvc/v3.TransportAddExRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportAddExRequest) }},
+	{"srvs/srvsvc/v3.TransportAddExResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportAddExResponse) }},
+	{"srvs/srvsvc/v3.TransportAddRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportAddRequest) }},
+	{"srvs/srvsvc/v3.TransportAddResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportAddResponse) }},
+	{"srvs/srvsvc/v3.TransportDeleteExRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportDeleteExRequest) }},
+	{"srvs/srvsvc/v3.TransportDeleteExResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportDeleteExResponse) }},
+	{"srvs/srvsvc/v3.TransportDeleteRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportDeleteRequest) }},
+	{"srvs/srvsvc/v3.TransportDeleteResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportDeleteResponse) }},
+	{"srvs/srvsvc/v3.TransportEnumRequest", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportEnumRequest) }},
+	{"srvs/srvsvc/v3.TransportEnumResponse", func() ndr.Unmarshaler { return new(srvs_srvsvc_v3.TransportEnumResponse) }},
+	{"ssp/isinglesignonremotemastersecret/v1.RemoteGetMasterSecretRequest", func() ndr.Unmarshaler {
+		return new(ssp_isinglesignonremotemastersecret_v1.RemoteGetMasterSecretRequest)
+	}},
+	{"ssp/isinglesignonremotemastersecret/v1.RemoteGetMasterSecretResponse", func() ndr.Unmarshaler {
+		return new(ssp_isinglesignonremotemastersecret_v1.RemoteGetMasterSecretResponse)
+	}},
+	{"swn/witness/v1.AsyncNotifyRequest", func() ndr.Unmarshaler { return new(swn_witness_v1.AsyncNotifyRequest) }},
+	{"swn/witness/v1.AsyncNotifyResponse", func() ndr.Unmarshaler { return new(swn_witness_v1.AsyncNotifyResponse) }},
+	{"swn/witness/v1.GetInterfaceListRequest", func() ndr.Unmarshaler { return new(swn_witness_v1.GetInterfaceListRequest) }},
+	{"swn/witness/v1.GetInterfaceListResponse", func() ndr.Unmarshaler { return new(swn_witness_v1.GetInterfaceListResponse) }},
+	{"swn/witness/v1.RegisterExRequest", func() ndr.Unmarshaler { return new(swn_witness_v1.RegisterExRequest) }},
+	{"swn/witness/v1.RegisterExResponse", func() ndr.Unmarshaler { return new(swn_witness_v1.RegisterExResponse) }},
+	{"swn/witness/v1.RegisterRequest", func() ndr.Unmarshaler { return new(swn_witness_v1.RegisterRequest) }},
+	{"swn/witness/v1.RegisterResponse", func() ndr.Unmarshaler { return new(swn_witness_v1.RegisterResponse) }},
+	{"swn/witness/v1.UnregisterRequest", func() ndr.Unmarshaler { return new(swn_witness_v1.UnregisterRequest) }},
+	{"swn/witness/v1.UnregisterResponse", func() ndr.Unmarshaler { return new(swn_witness_v1.UnregisterResponse) }},
+	{"trp/remotesp/v1.AttachRequest", func() ndr.Unmarshaler { return new(trp_remotesp_v1.AttachRequest) }},
+	{"trp/remotesp/v1.AttachResponse", func() ndr.Unmarshaler { return new(trp_remotesp_v1.AttachResponse) }},
+	{"trp/remotesp/v1.DetachRequest", func() ndr.Unmarshaler { return new(trp_remotesp_v1.DetachRequest) }},
+	{"trp/remotesp/v1.DetachResponse", func() ndr.Unmarshaler { return new(trp_remotesp_v1.DetachResponse) }},
+	{"trp/remotesp/v1.EventProcRequest", func() ndr.Unmarshaler { return new(trp_remotesp_v1.EventProcRequest) }},
+	{"trp/remotesp/v1.EventProcResponse", func() ndr.Unmarshaler { return new(trp_remotesp_v1.EventProcResponse) }},
+	{"trp/tapsrv/v1.ClientAttachRequest", func() ndr.Unmarshaler { return new(trp_tapsrv_v1.ClientAttachRequest) }},
+	{"trp/tapsrv/v1.ClientAttachResponse", func() ndr.Unmarshaler { return new(trp_tapsrv_v1.ClientAttachResponse) }},
+	{"trp/tapsrv/v1.ClientDetachRequest", func() ndr.Unmarshaler { return new(trp_tapsrv_v1.ClientDetachRequest) }},
+	{"trp/tapsrv/v1.ClientDetachResponse", func() ndr.Unmarshaler { return new(trp_tapsrv_v1.ClientDetachResponse) }},
+	{"trp/tapsrv/v1.ClientRequestRequest", func() ndr.Unmarshaler { return new(trp_tapsrv_v1.ClientRequestRequest) }},
+	{"trp/tapsrv/v1.ClientRequestResponse", func() ndr.Unmarshaler { return new(trp_tapsrv_v1.ClientRequestResponse) }},
+	{"tsch/atsvc/v1.JobAddRequest", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobAddRequest) }},
+	{"tsch/atsvc/v1.JobAddResponse", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobAddResponse) }},
+	{"tsch/atsvc/v1.JobDeleteRequest", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobDeleteRequest) }},
+	{"tsch/atsvc/v1.JobDeleteResponse", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobDeleteResponse) }},
+	{"tsch/atsvc/v1.JobEnumRequest", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobEnumRequest) }},
+	{"tsch/atsvc/v1.JobEnumResponse", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobEnumResponse) }},
+	{"tsch/atsvc/v1.JobGetInfoRequest", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobGetInfoRequest) }},
+	{"tsch/atsvc/v1.JobGetInfoResponse", func() ndr.Unmarshaler { return new(tsch_atsvc_v1.JobGetInfoResponse) }},
+	{"tsch/itaskschedulerservice/v1.CreateFolderRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.CreateFolderRequest) }},
+	{"tsch/itaskschedulerservice/v1.CreateFolderResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.CreateFolderResponse) }},
+	{"tsch/itaskschedulerservice/v1.DeleteRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.DeleteRequest) }},
+	{"tsch/itaskschedulerservice/v1.DeleteResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.DeleteResponse) }},
+	{"tsch/itaskschedulerservice/v1.EnableTaskRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnableTaskRequest) }},
+	{"tsch/itaskschedulerservice/v1.EnableTaskResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnableTaskResponse) }},
+	{"tsch/itaskschedulerservice/v1.EnumFoldersRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnumFoldersRequest) }},
+	{"tsch/itaskschedulerservice/v1.EnumFoldersResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnumFoldersResponse) }},
+	{"tsch/itaskschedulerservice/v1.EnumInstancesRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnumInstancesRequest) }},
+	{"tsch/itaskschedulerservice/v1.EnumInstancesResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnumInstancesResponse) }},
+	{"tsch/itaskschedulerservice/v1.EnumTasksRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnumTasksRequest) }},
+	{"tsch/itaskschedulerservice/v1.EnumTasksResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.EnumTasksResponse) }},
+	{"tsch/itaskschedulerservice/v1.GetInstanceInfoRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetInstanceInfoRequest) }},
+	{"tsch/itaskschedulerservice/v1.GetInstanceInfoResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetInstanceInfoResponse) }},
+	{"tsch/itaskschedulerservice/v1.GetLastRunInfoRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetLastRunInfoRequest) }},
+	{"tsch/itaskschedulerservice/v1.GetLastRunInfoResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetLastRunInfoResponse) }},
+	{"tsch/itaskschedulerservice/v1.GetNumberOfMissedRunsRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetNumberOfMissedRunsRequest) }},
+	{"tsch/itaskschedulerservice/v1.GetNumberOfMissedRunsResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetNumberOfMissedRunsResponse) }},
+	{"tsch/itaskschedulerservice/v1.GetSecurityRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetSecurityRequest) }},
+	{"tsch/itaskschedulerservice/v1.GetSecurityResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetSecurityResponse) }},
+	{"tsch/itaskschedulerservice/v1.GetTaskInfoRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetTaskInfoRequest) }},
+	{"tsch/itaskschedulerservice/v1.GetTaskInfoResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.GetTaskInfoResponse) }},
+	{"tsch/itaskschedulerservice/v1.HighestVersionRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.HighestVersionRequest) }},
+	{"tsch/itaskschedulerservice/v1.HighestVersionResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.HighestVersionResponse) }},
+	{"tsch/itaskschedulerservice/v1.RegisterTaskRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RegisterTaskRequest) }},
+	{"tsch/itaskschedulerservice/v1.RegisterTaskResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RegisterTaskResponse) }},
+	{"tsch/itaskschedulerservice/v1.RenameRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RenameRequest) }},
+	{"tsch/itaskschedulerservice/v1.RenameResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RenameResponse) }},
+	{"tsch/itaskschedulerservice/v1.RetrieveTaskRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RetrieveTaskRequest) }},
+	{"tsch/itaskschedulerservice/v1.RetrieveTaskResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RetrieveTaskResponse) }},
+	{"tsch/itaskschedulerservice/v1.RunRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RunRequest) }},
+	{"tsch/itaskschedulerservice/v1.RunResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.RunResponse) }},
+	{"tsch/itaskschedulerservice/v1.ScheduledRuntimesRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.ScheduledRuntimesRequest) }},
+	{"tsch/itaskschedulerservice/v1.ScheduledRuntimesResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.ScheduledRuntimesResponse) }},
+	{"tsch/itaskschedulerservice/v1.SetSecurityRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.SetSecurityRequest) }},
+	{"tsch/itaskschedulerservice/v1.SetSecurityResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.SetSecurityResponse) }},
+	{"tsch/itaskschedulerservice/v1.StopInstanceRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.StopInstanceRequest) }},
+	{"tsch/itaskschedulerservice/v1.StopInstanceResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.StopInstanceResponse) }},
+	{"tsch/itaskschedulerservice/v1.StopRequest", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.StopRequest) }},
+	{"tsch/itaskschedulerservice/v1.StopResponse", func() ndr.Unmarshaler { return new(tsch_itaskschedulerservice_v1.StopResponse) }},
+	{"tsch/sasec/v1.GetAccountInformationRequest", func() ndr.Unmarshaler { return new(tsch_sasec_v1.GetAccountInformationRequest) }},
+	{"tsch/sasec/v1.GetAccountInformationResponse", func() ndr.Unmarshaler { return new(tsch_sasec_v1.GetAccountInformationResponse) }},
+	{"tsch/sasec/v1.GetNSAccountInformationRequest", func() ndr.Unmarshaler { return new(tsch_sasec_v1.GetNSAccountInformationRequest) }},
+	{"tsch/sasec/v1.GetNSAccountInformationResponse", func() ndr.Unmarshaler { return new(tsch_sasec_v1.GetNSAccountInformationResponse) }},
+	{"tsch/sasec/v1.SetAccountInformationRequest", func() ndr.Unmarshaler { return new(tsch_sasec_v1.SetAccountInformationRequest) }},
+	{"tsch/sasec/v1.SetAccountInformationResponse", func() ndr.Unmarshaler { return new(tsch_sasec_v1.SetAccountInformationResponse) }},
+	{"tsch/sasec/v1.SetNSAccountInformationRequest", func() ndr.Unmarshaler { return new(tsch_sasec_v1.SetNSAccountInformationRequest) }},
+	{"tsch/sasec/v1.SetNSAccountInformationResponse", func() ndr.Unmarshaler { return new(tsch_sasec_v1.SetNSAccountInformationResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.AuthorizeTunnelRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.AuthorizeTunnelRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.AuthorizeTunnelResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.AuthorizeTunnelResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.CloseChannelRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CloseChannelRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.CloseChannelResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CloseChannelResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.CloseTunnelRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CloseTunnelRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.CloseTunnelResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CloseTunnelResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.CreateChannelRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CreateChannelRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.CreateChannelResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CreateChannelResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.CreateTunnelRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CreateTunnelRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.CreateTunnelResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.CreateTunnelResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.MakeTunnelCallRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.MakeTunnelCallRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.MakeTunnelCallResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.MakeTunnelCallResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketCapsResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketCapsResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketMessageRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketMessageRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketMessageResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketMessageResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketQuarantineConfigRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketQuarantineConfigRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketQuarantineEncResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketQuarantineEncResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketQuarantineRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketQuarantineRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketTypeUnion_PacketCapsResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketTypeUnion_PacketCapsResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketTypeUnion_PacketMessageRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketTypeUnion_PacketMessageRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketTypeUnion_PacketMessageResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketTypeUnion_PacketMessageResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.PacketTypeUnion_PacketQuarantineConfigRequest", func() ndr.Unmarshaler {
+		return new(tsgu_tsproxyrpcinterface_v1.PacketTypeUnion_PacketQuarantineConfigRequest)
+	}},
+	{"tsgu/tsproxyrpcinterface/v1.PacketTypeUnion_PacketQuarantineEncResponse", func() ndr.Unmarshaler {
+		return new(tsgu_tsproxyrpcinterface_v1.PacketTypeUnion_PacketQuarantineEncResponse)
+	}},
+	{"tsgu/tsproxyrpcinterface/v1.PacketTypeUnion_PacketQuarantineRequest", func() ndr.Unmarshaler {
+		return new(tsgu_tsproxyrpcinterface_v1.PacketTypeUnion_PacketQuarantineRequest)
+	}},
+	{"tsgu/tsproxyrpcinterface/v1.PacketTypeUnion_PacketResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.PacketTypeUnion_PacketResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.SendToServerRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.SendToServerRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.SendToServerResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.SendToServerResponse) }},
+	{"tsgu/tsproxyrpcinterface/v1.SetupReceivePipeRequest", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.SetupReceivePipeRequest) }},
+	{"tsgu/tsproxyrpcinterface/v1.SetupReceivePipeResponse", func() ndr.Unmarshaler { return new(tsgu_tsproxyrpcinterface_v1.SetupReceivePipeResponse) }},
+	{"w32t/w32time/v4.GetNetlogonServiceBitsRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.GetNetlogonServiceBitsRequest) }},
+	{"w32t/w32time/v4.GetNetlogonServiceBitsResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.GetNetlogonServiceBitsResponse) }},
+	{"w32t/w32time/v4.LogRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.LogRequest) }},
+	{"w32t/w32time/v4.LogResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.LogResponse) }},
+	{"w32t/w32time/v4.QueryConfigurationRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryConfigurationRequest) }},
+	{"w32t/w32time/v4.QueryConfigurationResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryConfigurationResponse) }},
+	{"w32t/w32time/v4.QueryProviderConfigurationRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryProviderConfigurationRequest) }},
+	{"w32t/w32time/v4.QueryProviderConfigurationResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryProviderConfigurationResponse) }},
+	{"w32t/w32time/v4.QueryProviderStatusRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryProviderStatusRequest) }},
+	{"w32t/w32time/v4.QueryProviderStatusResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryProviderStatusResponse) }},
+	{"w32t/w32time/v4.QuerySourceRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QuerySourceRequest) }},
+	{"w32t/w32time/v4.QuerySourceResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QuerySourceResponse) }},
+	{"w32t/w32time/v4.QueryStatusRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryStatusRequest) }},
+	{"w32t/w32time/v4.QueryStatusResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.QueryStatusResponse) }},
+	{"w32t/w32time/v4.SyncRequest", func() ndr.Unmarshaler { return new(w32t_w32time_v4.SyncRequest) }},
+	{"w32t/w32time/v4.SyncResponse", func() ndr.Unmarshaler { return new(w32t_w32time_v4.SyncResponse) }},
+	{"wdsc/wdsrpc/v1.MessageRequest", func() ndr.Unmarshaler { return new(wdsc_wdsrpc_v1.MessageRequest) }},
+	{"wdsc/wdsrpc/v1.MessageResponse", func() ndr.Unmarshaler { return new(wdsc_wdsrpc_v1.MessageResponse) }},
+	{"wkst/wkssvc/v1.AddAlternateComputerNameRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.AddAlternateComputerNameRequest) }},
+	{"wkst/wkssvc/v1.AddAlternateComputerNameResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.AddAlternateComputerNameResponse) }},
+	{"wkst/wkssvc/v1.EnumerateComputerNamesRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.EnumerateComputerNamesRequest) }},
+	{"wkst/wkssvc/v1.EnumerateComputerNamesResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.EnumerateComputerNamesResponse) }},
+	{"wkst/wkssvc/v1.GetInfoRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.GetInfoRequest) }},
+	{"wkst/wkssvc/v1.GetInfoResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.GetInfoResponse) }},
+	{"wkst/wkssvc/v1.GetJoinInformationRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.GetJoinInformationRequest) }},
+	{"wkst/wkssvc/v1.GetJoinInformationResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.GetJoinInformationResponse) }},
+	{"wkst/wkssvc/v1.GetJoinableOUs2Request", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.GetJoinableOUs2Request) }},
+	{"wkst/wkssvc/v1.GetJoinableOUs2Response", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.GetJoinableOUs2Response) }},
+	{"wkst/wkssvc/v1.JoinDomain2Request", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.JoinDomain2Request) }},
+	{"wkst/wkssvc/v1.JoinDomain2Response", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.JoinDomain2Response) }},
+	{"wkst/wkssvc/v1.RemoveAlternateComputerNameRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.RemoveAlternateComputerNameRequest) }},
+	{"wkst/wkssvc/v1.RemoveAlternateComputerNameResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.RemoveAlternateComputerNameResponse) }},
+	{"wkst/wkssvc/v1.RenameMachineInDomain2Request", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.RenameMachineInDomain2Request) }},
+	{"wkst/wkssvc/v1.RenameMachineInDomain2Response", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.RenameMachineInDomain2Response) }},
+	{"wkst/wkssvc/v1.SetInfoRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.SetInfoRequest) }},
+	{"wkst/wkssvc/v1.SetInfoResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.SetInfoResponse) }},
+	{"wkst/wkssvc/v1.SetPrimaryComputerNameRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.SetPrimaryComputerNameRequest) }},
+	{"wkst/wkssvc/v1.SetPrimaryComputerNameResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.SetPrimaryComputerNameResponse) }},
+	{"wkst/wkssvc/v1.TransportAddRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.TransportAddRequest) }},
+	{"wkst/wkssvc/v1.TransportAddResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.TransportAddResponse) }},
+	{"wkst/wkssvc/v1.TransportDeleteRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.TransportDeleteRequest) }},
+	{"wkst/wkssvc/v1.TransportDeleteResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.TransportDeleteResponse) }},
+	{"wkst/wkssvc/v1.TransportEnumRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.TransportEnumRequest) }},
+	{"wkst/wkssvc/v1.TransportEnumResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.TransportEnumResponse) }},
+	{"wkst/wkssvc/v1.UnjoinDomain2Request", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UnjoinDomain2Request) }},
+	{"wkst/wkssvc/v1.UnjoinDomain2Response", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UnjoinDomain2Response) }},
+	{"wkst/wkssvc/v1.UseAddRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseAddRequest) }},
+	{"wkst/wkssvc/v1.UseAddResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseAddResponse) }},
+	{"wkst/wkssvc/v1.UseDeleteRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseDeleteRequest) }},
+	{"wkst/wkssvc/v1.UseDeleteResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseDeleteResponse) }},
+	{"wkst/wkssvc/v1.UseEnumRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseEnumRequest) }},
+	{"wkst/wkssvc/v1.UseEnumResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseEnumResponse) }},
+	{"wkst/wkssvc/v1.UseGetInfoRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseGetInfoRequest) }},
+	{"wkst/wkssvc/v1.UseGetInfoResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UseGetInfoResponse) }},
+	{"wkst/wkssvc/v1.UserEnumRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UserEnumRequest) }},
+	{"wkst/wkssvc/v1.UserEnumResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.UserEnumResponse) }},
+	{"wkst/wkssvc/v1.ValidateName2Request", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.ValidateName2Request) }},
+	{"wkst/wkssvc/v1.ValidateName2Response", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.ValidateName2Response) }},
+	{"wkst/wkssvc/v1.WorkstationStatisticsGetRequest", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.WorkstationStatisticsGetRequest) }},
+	{"wkst/wkssvc/v1.WorkstationStatisticsGetResponse", func() ndr.Unmarshaler { return new(wkst_wkssvc_v1.WorkstationStatisticsGetResponse) }},
+}
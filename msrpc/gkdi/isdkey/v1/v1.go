@@ -265,6 +265,9 @@ func (o *xxx_GetKeyOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("TargetSD", len(o.TargetSD), o.TargetSDLength, "TargetSDLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -382,6 +385,9 @@ func (o *xxx_GetKeyOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Out", len(o.Out), o.OutLength, "OutLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
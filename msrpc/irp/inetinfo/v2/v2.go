@@ -1092,6 +1092,9 @@ func (o *IPsecList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("IPsecEntry", len(o.IPsecEntry), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1389,6 +1392,9 @@ func (o *VirtualRootList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("VirtRootEntry", len(o.VirtRootEntry), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2065,6 +2071,9 @@ func (o *SiteList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SiteEntry", len(o.SiteEntry), o.EntriesCount, "EntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
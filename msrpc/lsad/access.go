@@ -0,0 +1,144 @@
+package lsad
+
+import (
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// PolicyAccess is the policy object access mask (MS-LSAD 2.2.1.1.2):
+//
+//	resp, err := cli.OpenPolicy2(ctx, &lsarpc.OpenPolicy2Request{
+//		ObjectAttributes: &lsarpc.ObjectAttributes{},
+//		DesiredAccess:    uint32(lsad.PolicyViewLocalInformation | lsad.PolicyLookupNames),
+//	})
+type PolicyAccess uint32
+
+const (
+	PolicyViewLocalInformation  PolicyAccess = 0x00000001
+	PolicyViewAuditInformation  PolicyAccess = 0x00000002
+	PolicyGetPrivateInformation PolicyAccess = 0x00000004
+	PolicyTrustAdmin            PolicyAccess = 0x00000008
+	PolicyCreateAccount         PolicyAccess = 0x00000010
+	PolicyCreateSecret          PolicyAccess = 0x00000020
+	PolicyCreatePrivilege       PolicyAccess = 0x00000040
+	PolicySetDefaultQuotaLimits PolicyAccess = 0x00000080
+	PolicySetAuditRequirements  PolicyAccess = 0x00000100
+	PolicyAuditLogAdmin         PolicyAccess = 0x00000200
+	PolicyServerAdmin           PolicyAccess = 0x00000400
+	PolicyLookupNames           PolicyAccess = 0x00000800
+	PolicyNotification          PolicyAccess = 0x00001000
+	PolicyAllAccess             PolicyAccess = 0x000F0FFF
+	PolicyReadGeneric           PolicyAccess = 0x00020006
+	PolicyWriteGeneric          PolicyAccess = 0x000207F8
+	PolicyExecuteGeneric        PolicyAccess = 0x00020801
+)
+
+// PolicyAccessRights is the policy object access rights.
+var PolicyAccessRights = dtyp.AccessRights{
+	{Name: "POLICY_ALL_ACCESS", Mask: uint32(PolicyAllAccess)},
+	{Name: "POLICY_READ", Mask: uint32(PolicyReadGeneric)},
+	{Name: "POLICY_WRITE", Mask: uint32(PolicyWriteGeneric)},
+	{Name: "POLICY_EXECUTE", Mask: uint32(PolicyExecuteGeneric)},
+	{Name: "POLICY_VIEW_LOCAL_INFORMATION", Mask: uint32(PolicyViewLocalInformation)},
+	{Name: "POLICY_VIEW_AUDIT_INFORMATION", Mask: uint32(PolicyViewAuditInformation)},
+	{Name: "POLICY_GET_PRIVATE_INFORMATION", Mask: uint32(PolicyGetPrivateInformation)},
+	{Name: "POLICY_TRUST_ADMIN", Mask: uint32(PolicyTrustAdmin)},
+	{Name: "POLICY_CREATE_ACCOUNT", Mask: uint32(PolicyCreateAccount)},
+	{Name: "POLICY_CREATE_SECRET", Mask: uint32(PolicyCreateSecret)},
+	{Name: "POLICY_CREATE_PRIVILEGE", Mask: uint32(PolicyCreatePrivilege)},
+	{Name: "POLICY_SET_DEFAULT_QUOTA_LIMITS", Mask: uint32(PolicySetDefaultQuotaLimits)},
+	{Name: "POLICY_SET_AUDIT_REQUIREMENTS", Mask: uint32(PolicySetAuditRequirements)},
+	{Name: "POLICY_AUDIT_LOG_ADMIN", Mask: uint32(PolicyAuditLogAdmin)},
+	{Name: "POLICY_SERVER_ADMIN", Mask: uint32(PolicyServerAdmin)},
+	{Name: "POLICY_LOOKUP_NAMES", Mask: uint32(PolicyLookupNames)},
+	{Name: "POLICY_NOTIFICATION", Mask: uint32(PolicyNotification)},
+}
+
+// Describe function returns the access mask description.
+func (m PolicyAccess) Describe() string { return PolicyAccessRights.Describe(uint32(m)) }
+
+// AccountAccess is the account object access mask (MS-LSAD 2.2.1.1.3).
+type AccountAccess uint32
+
+const (
+	AccountView               AccountAccess = 0x00000001
+	AccountAdjustPrivileges   AccountAccess = 0x00000002
+	AccountAdjustQuotas       AccountAccess = 0x00000004
+	AccountAdjustSystemAccess AccountAccess = 0x00000008
+	AccountAllAccess          AccountAccess = 0x000F000F
+	AccountReadGeneric        AccountAccess = 0x00020001
+	AccountWriteGeneric       AccountAccess = 0x0002000E
+	AccountExecuteGeneric     AccountAccess = 0x00020000
+)
+
+// AccountAccessRights is the account object access rights.
+var AccountAccessRights = dtyp.AccessRights{
+	{Name: "ACCOUNT_ALL_ACCESS", Mask: uint32(AccountAllAccess)},
+	{Name: "ACCOUNT_READ", Mask: uint32(AccountReadGeneric)},
+	{Name: "ACCOUNT_WRITE", Mask: uint32(AccountWriteGeneric)},
+	{Name: "ACCOUNT_VIEW", Mask: uint32(AccountView)},
+	{Name: "ACCOUNT_ADJUST_PRIVILEGES", Mask: uint32(AccountAdjustPrivileges)},
+	{Name: "ACCOUNT_ADJUST_QUOTAS", Mask: uint32(AccountAdjustQuotas)},
+	{Name: "ACCOUNT_ADJUST_SYSTEM_ACCESS", Mask: uint32(AccountAdjustSystemAccess)},
+}
+
+// Describe function returns the access mask description.
+func (m AccountAccess) Describe() string { return AccountAccessRights.Describe(uint32(m)) }
+
+// SecretAccess is the secret object access mask (MS-LSAD 2.2.1.1.4).
+type SecretAccess uint32
+
+const (
+	SecretSetValue       SecretAccess = 0x00000001
+	SecretQueryValue     SecretAccess = 0x00000002
+	SecretAllAccess      SecretAccess = 0x000F0003
+	SecretReadGeneric    SecretAccess = 0x00020002
+	SecretWriteGeneric   SecretAccess = 0x00020001
+	SecretExecuteGeneric SecretAccess = 0x00020000
+)
+
+// SecretAccessRights is the secret object access rights.
+var SecretAccessRights = dtyp.AccessRights{
+	{Name: "SECRET_ALL_ACCESS", Mask: uint32(SecretAllAccess)},
+	{Name: "SECRET_READ", Mask: uint32(SecretReadGeneric)},
+	{Name: "SECRET_WRITE", Mask: uint32(SecretWriteGeneric)},
+	{Name: "SECRET_SET_VALUE", Mask: uint32(SecretSetValue)},
+	{Name: "SECRET_QUERY_VALUE", Mask: uint32(SecretQueryValue)},
+}
+
+// Describe function returns the access mask description.
+func (m SecretAccess) Describe() string { return SecretAccessRights.Describe(uint32(m)) }
+
+// TrustedDomainAccess is the trusted domain object access mask (MS-LSAD 2.2.1.1.5).
+type TrustedDomainAccess uint32
+
+const (
+	TrustedQueryDomainName  TrustedDomainAccess = 0x00000001
+	TrustedQueryControllers TrustedDomainAccess = 0x00000002
+	TrustedSetControllers   TrustedDomainAccess = 0x00000004
+	TrustedQueryPOSIX       TrustedDomainAccess = 0x00000008
+	TrustedSetPOSIX         TrustedDomainAccess = 0x00000010
+	TrustedSetAuth          TrustedDomainAccess = 0x00000020
+	TrustedQueryAuth        TrustedDomainAccess = 0x00000040
+	TrustedAllAccess        TrustedDomainAccess = 0x000F007F
+	TrustedReadGeneric      TrustedDomainAccess = 0x00020001
+	TrustedWriteGeneric     TrustedDomainAccess = 0x00020034
+	TrustedExecuteGeneric   TrustedDomainAccess = 0x0002000A
+)
+
+// TrustedDomainAccessRights is the trusted domain object access rights.
+var TrustedDomainAccessRights = dtyp.AccessRights{
+	{Name: "TRUSTED_ALL_ACCESS", Mask: uint32(TrustedAllAccess)},
+	{Name: "TRUSTED_WRITE", Mask: uint32(TrustedWriteGeneric)},
+	{Name: "TRUSTED_EXECUTE", Mask: uint32(TrustedExecuteGeneric)},
+	{Name: "TRUSTED_READ", Mask: uint32(TrustedReadGeneric)},
+	{Name: "TRUSTED_QUERY_DOMAIN_NAME", Mask: uint32(TrustedQueryDomainName)},
+	{Name: "TRUSTED_QUERY_CONTROLLERS", Mask: uint32(TrustedQueryControllers)},
+	{Name: "TRUSTED_SET_CONTROLLERS", Mask: uint32(TrustedSetControllers)},
+	{Name: "TRUSTED_QUERY_POSIX", Mask: uint32(TrustedQueryPOSIX)},
+	{Name: "TRUSTED_SET_POSIX", Mask: uint32(TrustedSetPOSIX)},
+	{Name: "TRUSTED_SET_AUTH", Mask: uint32(TrustedSetAuth)},
+	{Name: "TRUSTED_QUERY_AUTH", Mask: uint32(TrustedQueryAuth)},
+}
+
+// Describe function returns the access mask description.
+func (m TrustedDomainAccess) Describe() string { return TrustedDomainAccessRights.Describe(uint32(m)) }
@@ -3348,6 +3348,9 @@ func (o *ForestTrustBinaryData) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Buffer, _s_Buffer, _ptr_Buffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 131072); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3907,6 +3910,9 @@ func (o *ForestTrustInformation) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Entries, _s_Entries, _ptr_Entries); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RecordCount", o.RecordCount, 0, 4000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4447,6 +4453,9 @@ func (o *SrSecurityDescriptor) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.SecurityDescriptor, _s_SecurityDescriptor, _ptr_SecurityDescriptor); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4647,6 +4656,12 @@ func (o *PrivilegeSet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PrivilegeCount", o.PrivilegeCount, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Privilege", len(o.Privilege), o.PrivilegeCount, "PrivilegeCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4971,6 +4986,12 @@ func (o *CRCipherValue) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Buffer, _s_Buffer, _ptr_Buffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 131088); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("MaximumLength", o.MaximumLength, 0, 131088); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5665,6 +5686,9 @@ func (o *PolicyAuditEventsInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadData(&o.MaximumAuditEventCount); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("MaximumAuditEventCount", o.MaximumAuditEventCount, 0, 1000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7109,6 +7133,9 @@ func (o *TrustedControllersInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Names, _s_Names, _ptr_Names); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 5); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7598,6 +7625,9 @@ func (o *AuthInformation) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.AuthInfo, _s_AuthInfo, _ptr_AuthInfo); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AuthInfoLength", o.AuthInfoLength, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7806,6 +7836,12 @@ func (o *TrustedDomainAuthInformation) UnmarshalNDR(ctx context.Context, w ndr.R
 	if err := w.ReadPointer(&o.OutgoingPreviousAuthenticationInformation, _s_OutgoingPreviousAuthenticationInformation, _ptr_OutgoingPreviousAuthenticationInformation); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("IncomingAuthInfos", o.IncomingAuthInfos, 0, 1); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("OutgoingAuthInfos", o.OutgoingAuthInfos, 0, 1); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8193,6 +8229,9 @@ func (o *TrustedDomainAuthBlob) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.AuthBlob, _s_AuthBlob, _ptr_AuthBlob); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AuthSize", o.AuthSize, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9572,6 +9611,9 @@ func (o *UserRightSet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.UserRights, _s_UserRights, _ptr_UserRights); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1689,6 +1689,9 @@ func (o *TranslatedSIDs) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.SIDs, _s_Sids, _ptr_Sids); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 1000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1988,6 +1991,9 @@ func (o *SIDEnumBuffer) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.SIDInfo, _s_SidInfo, _ptr_SidInfo); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 20480); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2178,6 +2184,9 @@ func (o *TranslatedNames) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Names, _s_Names, _ptr_Names); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 20480); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2391,6 +2400,9 @@ func (o *TranslatedNamesEx) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadPointer(&o.Names, _s_Names, _ptr_Names); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 20480); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2598,6 +2610,9 @@ func (o *TranslatedSIDsEx) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.SIDs, _s_Sids, _ptr_Sids); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 1000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2832,6 +2847,9 @@ func (o *TranslatedSIDsEx2) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadPointer(&o.SIDs, _s_Sids, _ptr_Sids); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Entries", o.Entries, 0, 1000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3589,6 +3607,12 @@ func (o *xxx_LookupNamesOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5420,6 +5444,12 @@ func (o *xxx_LookupNames2Operation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5867,6 +5897,12 @@ func (o *xxx_LookupNames3Operation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6656,6 +6692,12 @@ func (o *xxx_LookupNames4Operation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,319 @@
+// The resolver package implements the resolution of the security identifiers
+// (and the relative identifiers of the domain accounts) found in the decoded
+// structures to the account names with the cached LSAT lookups:
+//
+//	lsa, err := lsarpc.NewLsarpcClient(ctx, conn, dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	policy, err := lsa.OpenPolicy2(ctx, &lsarpc.OpenPolicy2Request{
+//		DesiredAccess: 0x00000800, // POLICY_LOOKUP_NAMES
+//	})
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	r := resolver.New(lsa, policy.Policy)
+//
+//	// the SIDs of every decoded response are resolved.
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", dcerpc.WithAnnotator(r))
+//
+//	...
+//
+//	var names dcerpc.Annotations
+//
+//	resp, err := cli.QuerySecurityObject(ctx, req, dcerpc.WithAnnotations(&names))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	fmt.Println(names[resp.SecurityDescriptor.Owner.String()]) // CONTOSO\Administrator
+//
+// The LSAT client must not use the annotated connection (or must be created
+// on the connection without the annotator).
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	lsarpc "github.com/oiweiwei/go-msrpc/msrpc/lsat/lsarpc/v0"
+)
+
+// The maximum number of the SIDs per lookup request.
+const maxLookup = 20480
+
+// Name is the resolved account name.
+type Name struct {
+	// The domain name.
+	Domain string `json:"domain,omitempty"`
+	// The account name.
+	Name string `json:"name,omitempty"`
+	// The account type.
+	Use lsarpc.SIDNameUse `json:"use"`
+}
+
+// String function returns the account name in "DOMAIN\Name" format.
+func (n *Name) String() string {
+	if n.Domain == "" {
+		return n.Name
+	}
+	return n.Domain + `\` + n.Name
+}
+
+// Mapped function returns `true` if the SID was translated.
+func (n *Name) Mapped() bool {
+	return n.Use != lsarpc.SIDNameUseTypeUnknown && n.Use != lsarpc.SIDNameUseTypeInvalid && n.Name != ""
+}
+
+// Resolver resolves the SIDs to the account names with LsarLookupSids method.
+// The results (including the unmapped SIDs) are cached.
+type Resolver struct {
+	// The LSAT client.
+	cli lsarpc.LsarpcClient
+	// The policy handle.
+	policy *lsarpc.Handle
+	// The domain SID used to resolve the relative identifiers.
+	domain *dtyp.SID
+	// The lookup level.
+	level lsarpc.LookupLevel
+	// The resolved names cache.
+	mu    sync.RWMutex
+	cache map[string]*Name
+}
+
+// Option is the resolver option.
+type Option func(*Resolver)
+
+// WithDomainSID option sets the domain SID used to resolve the relative
+// identifiers (RelativeID and RID fields of the SAMR structures).
+func WithDomainSID(sid *dtyp.SID) Option {
+	return func(r *Resolver) { r.domain = sid }
+}
+
+// WithLookupLevel option sets the lookup level (default is LookupLevelWorkstation).
+func WithLookupLevel(level lsarpc.LookupLevel) Option {
+	return func(r *Resolver) { r.level = level }
+}
+
+// New function returns the resolver that uses the LSAT client `cli` and the
+// policy handle `policy` (opened with POLICY_LOOKUP_NAMES access).
+func New(cli lsarpc.LsarpcClient, policy *lsarpc.Handle, opts ...Option) *Resolver {
+
+	r := &Resolver{
+		cli:    cli,
+		policy: policy,
+		level:  lsarpc.LookupLevelWorkstation,
+		cache:  map[string]*Name{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Lookup function returns the cached name for the SID.
+func (r *Resolver) Lookup(sid *dtyp.SID) (*Name, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	n, ok := r.cache[sid.String()]
+	return n, ok
+}
+
+// Resolve function returns the names for the SIDs (the SID string is mapped
+// to the name), only the SIDs that are not cached are looked up.
+func (r *Resolver) Resolve(ctx context.Context, sids ...*dtyp.SID) (map[string]*Name, error) {
+
+	ret, lookup := map[string]*Name{}, []*dtyp.SID{}
+
+	r.mu.RLock()
+	for _, sid := range sids {
+		s := sid.String()
+		if _, ok := ret[s]; ok {
+			continue
+		}
+		if n, ok := r.cache[s]; ok {
+			ret[s] = n
+			continue
+		}
+		ret[s] = nil
+		lookup = append(lookup, sid)
+	}
+	r.mu.RUnlock()
+
+	for len(lookup) > 0 {
+
+		batch := lookup[:min(len(lookup), maxLookup)]
+		lookup = lookup[len(batch):]
+
+		names, err := r.lookup(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		for i := range batch {
+			r.cache[batch[i].String()], ret[batch[i].String()] = names[i], names[i]
+		}
+		r.mu.Unlock()
+	}
+
+	return ret, nil
+}
+
+// lookup function translates the SIDs with LsarLookupSids method.
+func (r *Resolver) lookup(ctx context.Context, sids []*dtyp.SID) ([]*Name, error) {
+
+	req := &lsarpc.LookupSIDsRequest{
+		Policy:          r.policy,
+		SIDEnumBuffer:   &lsarpc.SIDEnumBuffer{},
+		TranslatedNames: &lsarpc.TranslatedNames{},
+		LookupLevel:     r.level,
+	}
+
+	for _, sid := range sids {
+		req.SIDEnumBuffer.SIDInfo = append(req.SIDEnumBuffer.SIDInfo, &lsarpc.SIDInformation{SID: sid})
+	}
+
+	resp, err := r.cli.LookupSIDs(ctx, req)
+	if resp == nil || resp.TranslatedNames == nil {
+		// the partially mapped (STATUS_SOME_NOT_MAPPED) and not mapped
+		// (STATUS_NONE_MAPPED) responses carry the translated names.
+		return nil, fmt.Errorf("resolver: lookup sids: %w", err)
+	}
+
+	names := make([]*Name, len(sids))
+
+	for i := range names {
+		names[i] = &Name{Use: lsarpc.SIDNameUseTypeUnknown}
+		if i >= len(resp.TranslatedNames.Names) || resp.TranslatedNames.Names[i] == nil {
+			continue
+		}
+		tn := resp.TranslatedNames.Names[i]
+		names[i].Use, names[i].Name = tn.Use, buffer(tn.Name)
+		if resp.ReferencedDomains != nil && tn.DomainIndex >= 0 && int(tn.DomainIndex) < len(resp.ReferencedDomains.Domains) {
+			if d := resp.ReferencedDomains.Domains[tn.DomainIndex]; d != nil {
+				names[i].Domain = buffer(d.Name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// buffer function returns the unicode string buffer.
+func buffer(s *dtyp.UnicodeString) string {
+	if s == nil {
+		return ""
+	}
+	return s.Buffer
+}
+
+// Annotate function resolves the SIDs (and RIDs, if domain SID is set) found in the
+// operation parameters and maps the SID strings to the account names.
+// The unmapped SIDs are skipped.
+func (r *Resolver) Annotate(ctx context.Context, op dcerpc.Operation, a dcerpc.Annotations) error {
+
+	sids := Collect(op, r.domain)
+	if len(sids) == 0 {
+		return nil
+	}
+
+	names, err := r.Resolve(ctx, sids...)
+	if err != nil {
+		return err
+	}
+
+	for sid, name := range names {
+		if name != nil && name.Mapped() {
+			a[sid] = name.String()
+		}
+	}
+
+	return nil
+}
+
+var sidType = reflect.TypeOf(dtyp.SID{})
+
+// Collect function returns the SIDs found in the structure `v`. If `domain`
+// is set, the relative identifiers (uint32 fields named RelativeID or RID)
+// are converted to the SIDs within the domain.
+func Collect(v any, domain *dtyp.SID) []*dtyp.SID {
+	sids := []*dtyp.SID{}
+	collect(reflect.ValueOf(v), domain, &sids, map[visit]struct{}{})
+	return sids
+}
+
+// visit is the visited pointer (the struct and its first field share the
+// address).
+type visit struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+func collect(v reflect.Value, domain *dtyp.SID, sids *[]*dtyp.SID, seen map[visit]struct{}) {
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		if _, ok := seen[visit{v.Type(), v.Pointer()}]; ok {
+			return
+		}
+		seen[visit{v.Type(), v.Pointer()}] = struct{}{}
+		if v.Type().Elem() == sidType {
+			if sid := v.Interface().(*dtyp.SID); sid.IDAuthority != nil {
+				*sids = append(*sids, sid)
+			}
+			return
+		}
+		collect(v.Elem(), domain, sids, seen)
+	case reflect.Interface:
+		if !v.IsNil() {
+			collect(v.Elem(), domain, sids, seen)
+		}
+	case reflect.Struct:
+		if v.Type() == sidType {
+			if v.CanAddr() {
+				collect(v.Addr(), domain, sids, seen)
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if domain != nil && f.Type.Kind() == reflect.Uint32 && isRelativeID(f.Name) {
+				if rid := uint32(v.Field(i).Uint()); rid != 0 {
+					*sids = append(*sids, domain.AddRelativeID(rid))
+				}
+				continue
+			}
+			collect(v.Field(i), domain, sids, seen)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			// skip the byte buffers.
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			collect(v.Index(i), domain, sids, seen)
+		}
+	}
+}
+
+// isRelativeID function returns `true` if the field name denotes the relative
+// identifier.
+func isRelativeID(name string) bool {
+	return name == "RID" || strings.HasSuffix(name, "RelativeID")
+}
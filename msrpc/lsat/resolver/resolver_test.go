@@ -0,0 +1,103 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	lsarpc "github.com/oiweiwei/go-msrpc/msrpc/lsat/lsarpc/v0"
+)
+
+// testLsarpcClient translates the SIDs from the names table.
+type testLsarpcClient struct {
+	lsarpc.LsarpcClient
+	names   map[string]string
+	lookups int
+}
+
+func (c *testLsarpcClient) LookupSIDs(ctx context.Context, req *lsarpc.LookupSIDsRequest, opts ...dcerpc.CallOption) (*lsarpc.LookupSIDsResponse, error) {
+
+	c.lookups++
+
+	resp := &lsarpc.LookupSIDsResponse{
+		ReferencedDomains: &lsarpc.ReferencedDomainList{
+			Domains: []*lsarpc.TrustInformation{{Name: &dtyp.UnicodeString{Buffer: "CONTOSO"}}},
+		},
+		TranslatedNames: &lsarpc.TranslatedNames{},
+	}
+
+	for _, info := range req.SIDEnumBuffer.SIDInfo {
+		name, ok := c.names[info.SID.String()]
+		if !ok {
+			resp.TranslatedNames.Names = append(resp.TranslatedNames.Names, &lsarpc.TranslatedName{Use: lsarpc.SIDNameUseTypeUnknown, DomainIndex: -1})
+			continue
+		}
+		resp.TranslatedNames.Names = append(resp.TranslatedNames.Names, &lsarpc.TranslatedName{
+			Use: lsarpc.SIDNameUseTypeUser, Name: &dtyp.UnicodeString{Buffer: name},
+		})
+	}
+
+	return resp, nil
+}
+
+// testOperation is the operation with the SIDs and RIDs.
+type testOperation struct {
+	dcerpc.Operation
+	Owner *dtyp.SID
+	Users []*testUser
+	Data  []byte
+}
+
+type testUser struct {
+	RelativeID uint32
+	Name       string
+}
+
+func mustParseSID(t *testing.T, s string) *dtyp.SID {
+	sid, err := dtyp.ParseSID(s)
+	if err != nil {
+		t.Fatalf("parse sid: %v", err)
+	}
+	return sid
+}
+
+func TestAnnotate(t *testing.T) {
+
+	domain := mustParseSID(t, "S-1-5-21-1-2-3")
+
+	cli := &testLsarpcClient{names: map[string]string{
+		"S-1-5-21-1-2-3-500":  "Administrator",
+		"S-1-5-21-1-2-3-1105": "jdoe",
+	}}
+
+	r := New(cli, &lsarpc.Handle{}, WithDomainSID(domain))
+
+	op := &testOperation{
+		Owner: mustParseSID(t, "S-1-5-21-1-2-3-500"),
+		Users: []*testUser{{RelativeID: 1105}, {RelativeID: 1106}},
+		Data:  make([]byte, 16),
+	}
+
+	a := dcerpc.Annotations{}
+	if err := r.Annotate(context.Background(), op, a); err != nil {
+		t.Fatalf("annotate: %v", err)
+	}
+
+	if len(a) != 2 || a["S-1-5-21-1-2-3-500"] != `CONTOSO\Administrator` || a["S-1-5-21-1-2-3-1105"] != `CONTOSO\jdoe` {
+		t.Fatalf("unexpected annotations: %v", a)
+	}
+
+	// the names (including unmapped) are cached.
+	if err := r.Annotate(context.Background(), op, dcerpc.Annotations{}); err != nil {
+		t.Fatalf("annotate: %v", err)
+	}
+
+	if cli.lookups != 1 {
+		t.Fatalf("expected single lookup, got %d", cli.lookups)
+	}
+
+	if n, ok := r.Lookup(mustParseSID(t, "S-1-5-21-1-2-3-1106")); !ok || n.Mapped() {
+		t.Fatalf("expected cached unmapped name, got %v", n)
+	}
+}
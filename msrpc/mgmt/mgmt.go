@@ -0,0 +1,30 @@
+// The mgmt package implements the MGMT client protocol.
+package mgmt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	dcerpc "github.com/oiweiwei/go-msrpc/dcerpc"
+	errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	uuid "github.com/oiweiwei/go-msrpc/midl/uuid"
+	ndr "github.com/oiweiwei/go-msrpc/ndr"
+)
+
+var (
+	_ = context.Background
+	_ = fmt.Errorf
+	_ = utf16.Encode
+	_ = strings.TrimPrefix
+	_ = ndr.ZeroString
+	_ = (*uuid.UUID)(nil)
+	_ = (*dcerpc.SyntaxID)(nil)
+	_ = (*errors.Error)(nil)
+)
+
+var (
+	// import guard
+	GoPackage = "mgmt"
+)
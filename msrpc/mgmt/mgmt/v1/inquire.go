@@ -0,0 +1,147 @@
+package mgmt
+
+// inquire.go contains the helpers for the remote management interface
+// inquiry operations.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+// The server statistics vector indices.
+const (
+	// rpc_c_stats_calls_in: the number of the remote calls received.
+	StatsCallsIn = 0
+	// rpc_c_stats_calls_out: the number of the remote calls initiated.
+	StatsCallsOut = 1
+	// rpc_c_stats_pkts_in: the number of the packets received.
+	StatsPacketsIn = 2
+	// rpc_c_stats_pkts_out: the number of the packets sent.
+	StatsPacketsOut = 3
+	// rpc_c_stats_array_max_size: the size of the statistics vector.
+	StatsArrayMaxSize = 4
+)
+
+// The default size of the principal name buffer.
+var DefaultPrincipalNameSize uint32 = 1024
+
+// Stats structure represents the server statistics.
+type Stats struct {
+	// The number of the remote calls received by the server.
+	CallsIn uint32 `json:"calls_in"`
+	// The number of the remote calls initiated by the server.
+	CallsOut uint32 `json:"calls_out"`
+	// The number of the packets received by the server.
+	PacketsIn uint32 `json:"packets_in"`
+	// The number of the packets sent by the server.
+	PacketsOut uint32 `json:"packets_out"`
+}
+
+// InquireInterfaceIDs function returns the interfaces exported by the server:
+//
+//	cli, err := mgmt.NewManagementClient(ctx, conn, dcerpc.WithEndpoint("ncacn_np:[lsass]"))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	syntaxes, err := mgmt.InquireInterfaceIDs(ctx, cli)
+func InquireInterfaceIDs(ctx context.Context, cli ManagementClient, opts ...dcerpc.CallOption) ([]*dcerpc.SyntaxID, error) {
+
+	resp, err := cli.ManagementInquireInterfaceIDs(ctx, &ManagementInquireInterfaceIDsRequest{}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("mgmt: inquire interface ids: %w", err)
+	}
+
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("mgmt: inquire interface ids: status 0x%08x", resp.Status)
+	}
+
+	if resp.InterfaceIDVector == nil {
+		return nil, nil
+	}
+
+	var ret []*dcerpc.SyntaxID
+
+	for _, id := range resp.InterfaceIDVector.InterfaceID {
+		if id == nil || id.UUID == nil {
+			continue
+		}
+		ret = append(ret, &dcerpc.SyntaxID{
+			IfUUID:         id.UUID.UUID(),
+			IfVersionMajor: id.VersMajor,
+			IfVersionMinor: id.VersMinor,
+		})
+	}
+
+	return ret, nil
+}
+
+// InquireStats function returns the server statistics.
+func InquireStats(ctx context.Context, cli ManagementClient, opts ...dcerpc.CallOption) (*Stats, error) {
+
+	resp, err := cli.ManagementInquireStats(ctx, &ManagementInquireStatsRequest{Count: StatsArrayMaxSize}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("mgmt: inquire stats: %w", err)
+	}
+
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("mgmt: inquire stats: status 0x%08x", resp.Status)
+	}
+
+	stat := func(i int) uint32 {
+		if i < len(resp.Statistics) && i < int(resp.Count) {
+			return resp.Statistics[i]
+		}
+		return 0
+	}
+
+	return &Stats{
+		CallsIn:    stat(StatsCallsIn),
+		CallsOut:   stat(StatsCallsOut),
+		PacketsIn:  stat(StatsPacketsIn),
+		PacketsOut: stat(StatsPacketsOut),
+	}, nil
+}
+
+// IsServerListening function returns `true` if the server is listening for
+// the remote calls.
+func IsServerListening(ctx context.Context, cli ManagementClient, opts ...dcerpc.CallOption) (bool, error) {
+
+	resp, err := cli.ManagementIsServerListening(ctx, &ManagementIsServerListeningRequest{}, opts...)
+	if err != nil && resp != nil && resp.Return {
+		// the client reports the non-zero return value as the error,
+		// the boolean32 return value is not the error status.
+		err = nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("mgmt: is server listening: %w", err)
+	}
+
+	if resp.Status != 0 {
+		return false, fmt.Errorf("mgmt: is server listening: status 0x%08x", resp.Status)
+	}
+
+	return resp.Return, nil
+}
+
+// InquirePrincipalName function returns the server principal name for the
+// authentication type.
+func InquirePrincipalName(ctx context.Context, cli ManagementClient, authType dcerpc.AuthType, opts ...dcerpc.CallOption) (string, error) {
+
+	resp, err := cli.ManagementInquirePrincName(ctx, &ManagementInquirePrincNameRequest{
+		AuthnProto:    uint32(authType),
+		PrincNameSize: DefaultPrincipalNameSize,
+	}, opts...)
+	if err != nil {
+		return "", fmt.Errorf("mgmt: inquire principal name: %w", err)
+	}
+
+	if resp.Status != 0 {
+		return "", fmt.Errorf("mgmt: inquire principal name: status 0x%08x", resp.Status)
+	}
+
+	return resp.PrincName, nil
+}
@@ -0,0 +1,71 @@
+package mgmt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// testServer is the management interface that reports itself.
+type testServer struct {
+	ManagementServer
+}
+
+func (s *testServer) ManagementInquireInterfaceIDs(ctx context.Context, in *ManagementInquireInterfaceIDsRequest) (*ManagementInquireInterfaceIDsResponse, error) {
+	return &ManagementInquireInterfaceIDsResponse{
+		InterfaceIDVector: &InterfaceIDVector{InterfaceID: []*dcetypes.InterfaceID{
+			{UUID: dtyp.GUIDFromUUID(ManagementSyntaxUUID), VersMajor: 1},
+		}},
+	}, nil
+}
+
+func (s *testServer) ManagementInquireStats(ctx context.Context, in *ManagementInquireStatsRequest) (*ManagementInquireStatsResponse, error) {
+	return &ManagementInquireStatsResponse{Count: StatsArrayMaxSize, Statistics: []uint32{10, 0, 20, 30}}, nil
+}
+
+func (s *testServer) ManagementIsServerListening(ctx context.Context, in *ManagementIsServerListeningRequest) (*ManagementIsServerListeningResponse, error) {
+	return &ManagementIsServerListeningResponse{Return: true}, nil
+}
+
+func TestInquire(t *testing.T) {
+
+	ctx := context.Background()
+
+	ln := dcerpc.NewMemoryListener()
+	t.Cleanup(func() { ln.Close() })
+
+	srv := dcerpc.NewServer()
+	srv.Register(ManagementSyntaxV1_0, NewManagementServerHandle(&testServer{}))
+
+	go srv.Serve(ln)
+
+	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(ln))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	defer conn.Close(ctx)
+
+	cli, err := NewManagementClient(ctx, conn, dcerpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	syntaxes, err := InquireInterfaceIDs(ctx, cli)
+	if err != nil || len(syntaxes) != 1 || !syntaxes[0].Is(ManagementSyntaxV1_0) {
+		t.Fatalf("inquire interface ids: %v (%v)", syntaxes, err)
+	}
+
+	stats, err := InquireStats(ctx, cli)
+	if err != nil || stats.CallsIn != 10 || stats.PacketsOut != 30 {
+		t.Fatalf("inquire stats: %+v (%v)", stats, err)
+	}
+
+	if ok, err := IsServerListening(ctx, cli); err != nil || !ok {
+		t.Fatalf("is server listening: %v (%v)", ok, err)
+	}
+}
@@ -0,0 +1,94 @@
+package mgmt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	dcerpc "github.com/oiweiwei/go-msrpc/dcerpc"
+	errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	uuid "github.com/oiweiwei/go-msrpc/midl/uuid"
+	ndr "github.com/oiweiwei/go-msrpc/ndr"
+)
+
+var (
+	_ = context.Background
+	_ = fmt.Errorf
+	_ = utf16.Encode
+	_ = strings.TrimPrefix
+	_ = ndr.ZeroString
+	_ = (*uuid.UUID)(nil)
+	_ = (*dcerpc.SyntaxID)(nil)
+	_ = (*errors.Error)(nil)
+)
+
+// mgmt server interface.
+type ManagementServer interface {
+
+	// rpc__mgmt_inq_if_ids operation.
+	ManagementInquireInterfaceIDs(context.Context, *ManagementInquireInterfaceIDsRequest) (*ManagementInquireInterfaceIDsResponse, error)
+
+	// rpc__mgmt_inq_stats operation.
+	ManagementInquireStats(context.Context, *ManagementInquireStatsRequest) (*ManagementInquireStatsResponse, error)
+
+	// rpc__mgmt_is_server_listening operation.
+	ManagementIsServerListening(context.Context, *ManagementIsServerListeningRequest) (*ManagementIsServerListeningResponse, error)
+
+	// rpc__mgmt_stop_server_listening operation.
+	ManagementStopServerListening(context.Context, *ManagementStopServerListeningRequest) (*ManagementStopServerListeningResponse, error)
+
+	// rpc__mgmt_inq_princ_name operation.
+	ManagementInquirePrincName(context.Context, *ManagementInquirePrincNameRequest) (*ManagementInquirePrincNameResponse, error)
+}
+
+func RegisterManagementServer(conn dcerpc.Conn, o ManagementServer, opts ...dcerpc.Option) {
+	conn.RegisterServer(NewManagementServerHandle(o), append(opts, dcerpc.WithAbstractSyntax(ManagementSyntaxV1_0))...)
+}
+
+func NewManagementServerHandle(o ManagementServer) dcerpc.ServerHandle {
+	return func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		return ManagementServerHandle(ctx, o, opNum, r)
+	}
+}
+
+func ManagementServerHandle(ctx context.Context, o ManagementServer, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+	switch opNum {
+	case 0: // rpc__mgmt_inq_if_ids
+		in := &ManagementInquireInterfaceIDsRequest{}
+		if err := in.UnmarshalNDR(ctx, r); err != nil {
+			return nil, err
+		}
+		resp, err := o.ManagementInquireInterfaceIDs(ctx, in)
+		return resp.xxx_ToOp(ctx), err
+	case 1: // rpc__mgmt_inq_stats
+		in := &ManagementInquireStatsRequest{}
+		if err := in.UnmarshalNDR(ctx, r); err != nil {
+			return nil, err
+		}
+		resp, err := o.ManagementInquireStats(ctx, in)
+		return resp.xxx_ToOp(ctx), err
+	case 2: // rpc__mgmt_is_server_listening
+		in := &ManagementIsServerListeningRequest{}
+		if err := in.UnmarshalNDR(ctx, r); err != nil {
+			return nil, err
+		}
+		resp, err := o.ManagementIsServerListening(ctx, in)
+		return resp.xxx_ToOp(ctx), err
+	case 3: // rpc__mgmt_stop_server_listening
+		in := &ManagementStopServerListeningRequest{}
+		if err := in.UnmarshalNDR(ctx, r); err != nil {
+			return nil, err
+		}
+		resp, err := o.ManagementStopServerListening(ctx, in)
+		return resp.xxx_ToOp(ctx), err
+	case 4: // rpc__mgmt_inq_princ_name
+		in := &ManagementInquirePrincNameRequest{}
+		if err := in.UnmarshalNDR(ctx, r); err != nil {
+			return nil, err
+		}
+		resp, err := o.ManagementInquirePrincName(ctx, in)
+		return resp.xxx_ToOp(ctx), err
+	}
+	return nil, nil
+}
@@ -0,0 +1,1147 @@
+package mgmt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	dcerpc "github.com/oiweiwei/go-msrpc/dcerpc"
+	errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	uuid "github.com/oiweiwei/go-msrpc/midl/uuid"
+	dcetypes "github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	ndr "github.com/oiweiwei/go-msrpc/ndr"
+)
+
+var (
+	_ = context.Background
+	_ = fmt.Errorf
+	_ = utf16.Encode
+	_ = strings.TrimPrefix
+	_ = ndr.ZeroString
+	_ = (*uuid.UUID)(nil)
+	_ = (*dcerpc.SyntaxID)(nil)
+	_ = (*errors.Error)(nil)
+	_ = dcetypes.GoPackage
+)
+
+var (
+	// import guard
+	GoPackage = "mgmt"
+)
+
+var (
+	// Syntax UUID
+	ManagementSyntaxUUID = &uuid.UUID{TimeLow: 0xafa8bd80, TimeMid: 0x7d8a, TimeHiAndVersion: 0x11c9, ClockSeqHiAndReserved: 0xbe, ClockSeqLow: 0xf4, Node: [6]uint8{0x8, 0x0, 0x2b, 0x10, 0x29, 0x89}}
+	// Syntax ID
+	ManagementSyntaxV1_0 = &dcerpc.SyntaxID{IfUUID: ManagementSyntaxUUID, IfVersionMajor: 1, IfVersionMinor: 0}
+)
+
+// mgmt interface.
+type ManagementClient interface {
+
+	// rpc__mgmt_inq_if_ids operation.
+	ManagementInquireInterfaceIDs(context.Context, *ManagementInquireInterfaceIDsRequest, ...dcerpc.CallOption) (*ManagementInquireInterfaceIDsResponse, error)
+
+	// rpc__mgmt_inq_stats operation.
+	ManagementInquireStats(context.Context, *ManagementInquireStatsRequest, ...dcerpc.CallOption) (*ManagementInquireStatsResponse, error)
+
+	// rpc__mgmt_is_server_listening operation.
+	ManagementIsServerListening(context.Context, *ManagementIsServerListeningRequest, ...dcerpc.CallOption) (*ManagementIsServerListeningResponse, error)
+
+	// rpc__mgmt_stop_server_listening operation.
+	ManagementStopServerListening(context.Context, *ManagementStopServerListeningRequest, ...dcerpc.CallOption) (*ManagementStopServerListeningResponse, error)
+
+	// rpc__mgmt_inq_princ_name operation.
+	ManagementInquirePrincName(context.Context, *ManagementInquirePrincNameRequest, ...dcerpc.CallOption) (*ManagementInquirePrincNameResponse, error)
+
+	// AlterContext alters the client context.
+	AlterContext(context.Context, ...dcerpc.Option) error
+
+	// Conn returns the client connection (unsafe)
+	Conn() dcerpc.Conn
+}
+
+// InterfaceIDVector structure represents rpc_if_id_vector_t RPC structure.
+type InterfaceIDVector struct {
+	Count       uint32                  `idl:"name:count" json:"count"`
+	InterfaceID []*dcetypes.InterfaceID `idl:"name:if_id;size_is:(count)" json:"interface_id"`
+}
+
+func (o *InterfaceIDVector) xxx_PreparePayload(ctx context.Context) error {
+	if o.InterfaceID != nil && o.Count == 0 {
+		o.Count = uint32(len(o.InterfaceID))
+	}
+	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
+		if err := hook.AfterPreparePayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *InterfaceIDVector) NDRSizeInfo() []uint64 {
+	dimSize1 := uint64(o.Count)
+	return []uint64{
+		dimSize1,
+	}
+}
+func (o *InterfaceIDVector) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PreparePayload(ctx); err != nil {
+		return err
+	}
+	sizeInfo, ok := ctx.Value(ndr.SizeInfo).([]uint64)
+	if !ok {
+		sizeInfo = o.NDRSizeInfo()
+		for sz1 := range sizeInfo {
+			if err := w.WriteSize(sizeInfo[sz1]); err != nil {
+				return err
+			}
+		}
+		ctx = context.WithValue(ctx, ndr.SizeInfo, sizeInfo)
+	}
+	if err := w.WriteAlign(9); err != nil {
+		return err
+	}
+	if err := w.WriteData(o.Count); err != nil {
+		return err
+	}
+	for i1 := range o.InterfaceID {
+		i1 := i1
+		if uint64(i1) >= sizeInfo[0] {
+			break
+		}
+		if o.InterfaceID[i1] != nil {
+			_ptr_if_id := ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+				if o.InterfaceID[i1] != nil {
+					if err := o.InterfaceID[i1].MarshalNDR(ctx, w); err != nil {
+						return err
+					}
+				} else {
+					if err := (&dcetypes.InterfaceID{}).MarshalNDR(ctx, w); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err := w.WritePointer(&o.InterfaceID[i1], _ptr_if_id); err != nil {
+				return err
+			}
+		} else {
+			if err := w.WritePointer(nil); err != nil {
+				return err
+			}
+		}
+	}
+	for i1 := len(o.InterfaceID); uint64(i1) < sizeInfo[0]; i1++ {
+		if err := w.WritePointer(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (o *InterfaceIDVector) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
+	sizeInfo, ok := ctx.Value(ndr.SizeInfo).([]uint64)
+	if !ok {
+		sizeInfo = o.NDRSizeInfo()
+		for i1 := range sizeInfo {
+			if err := w.ReadSize(&sizeInfo[i1]); err != nil {
+				return err
+			}
+		}
+		ctx = context.WithValue(ctx, ndr.SizeInfo, sizeInfo)
+	}
+	if err := w.ReadAlign(9); err != nil {
+		return err
+	}
+	if err := w.ReadData(&o.Count); err != nil {
+		return err
+	}
+	// XXX: for opaque unmarshaling
+	if o.Count > 0 && sizeInfo[0] == 0 {
+		sizeInfo[0] = uint64(o.Count)
+	}
+	if sizeInfo[0] > uint64(w.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for size %d of array o.InterfaceID", sizeInfo[0])
+	}
+	o.InterfaceID = make([]*dcetypes.InterfaceID, sizeInfo[0])
+	for i1 := range o.InterfaceID {
+		i1 := i1
+		_ptr_if_id := ndr.UnmarshalNDRFunc(func(ctx context.Context, w ndr.Reader) error {
+			if o.InterfaceID[i1] == nil {
+				o.InterfaceID[i1] = &dcetypes.InterfaceID{}
+			}
+			if err := o.InterfaceID[i1].UnmarshalNDR(ctx, w); err != nil {
+				return err
+			}
+			return nil
+		})
+		_s_if_id := func(ptr interface{}) { o.InterfaceID[i1] = *ptr.(**dcetypes.InterfaceID) }
+		if err := w.ReadPointer(&o.InterfaceID[i1], _s_if_id, _ptr_if_id); err != nil {
+			return err
+		}
+	}
+	if err := ndr.CheckSize("InterfaceID", len(o.InterfaceID), o.Count, "Count"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type xxx_DefaultManagementClient struct {
+	cc dcerpc.Conn
+}
+
+func (o *xxx_DefaultManagementClient) ManagementInquireInterfaceIDs(ctx context.Context, in *ManagementInquireInterfaceIDsRequest, opts ...dcerpc.CallOption) (*ManagementInquireInterfaceIDsResponse, error) {
+	op := in.xxx_ToOp(ctx)
+	if err := o.cc.Invoke(ctx, op, opts...); err != nil {
+		return nil, err
+	}
+	out := &ManagementInquireInterfaceIDsResponse{}
+	out.xxx_FromOp(ctx, op)
+	return out, nil
+}
+
+func (o *xxx_DefaultManagementClient) ManagementInquireStats(ctx context.Context, in *ManagementInquireStatsRequest, opts ...dcerpc.CallOption) (*ManagementInquireStatsResponse, error) {
+	op := in.xxx_ToOp(ctx)
+	if err := o.cc.Invoke(ctx, op, opts...); err != nil {
+		return nil, err
+	}
+	out := &ManagementInquireStatsResponse{}
+	out.xxx_FromOp(ctx, op)
+	return out, nil
+}
+
+func (o *xxx_DefaultManagementClient) ManagementIsServerListening(ctx context.Context, in *ManagementIsServerListeningRequest, opts ...dcerpc.CallOption) (*ManagementIsServerListeningResponse, error) {
+	op := in.xxx_ToOp(ctx)
+	if err := o.cc.Invoke(ctx, op, opts...); err != nil {
+		return nil, err
+	}
+	out := &ManagementIsServerListeningResponse{}
+	out.xxx_FromOp(ctx, op)
+	if op.Return != false {
+		return out, fmt.Errorf("%s: %w", op.OpName(), errors.New(ctx, op.Return))
+	}
+	return out, nil
+}
+
+func (o *xxx_DefaultManagementClient) ManagementStopServerListening(ctx context.Context, in *ManagementStopServerListeningRequest, opts ...dcerpc.CallOption) (*ManagementStopServerListeningResponse, error) {
+	op := in.xxx_ToOp(ctx)
+	if err := o.cc.Invoke(ctx, op, opts...); err != nil {
+		return nil, err
+	}
+	out := &ManagementStopServerListeningResponse{}
+	out.xxx_FromOp(ctx, op)
+	return out, nil
+}
+
+func (o *xxx_DefaultManagementClient) ManagementInquirePrincName(ctx context.Context, in *ManagementInquirePrincNameRequest, opts ...dcerpc.CallOption) (*ManagementInquirePrincNameResponse, error) {
+	op := in.xxx_ToOp(ctx)
+	if err := o.cc.Invoke(ctx, op, opts...); err != nil {
+		return nil, err
+	}
+	out := &ManagementInquirePrincNameResponse{}
+	out.xxx_FromOp(ctx, op)
+	return out, nil
+}
+
+func (o *xxx_DefaultManagementClient) AlterContext(ctx context.Context, opts ...dcerpc.Option) error {
+	return o.cc.AlterContext(ctx, opts...)
+}
+
+func (o *xxx_DefaultManagementClient) Conn() dcerpc.Conn {
+	return o.cc
+}
+
+func NewManagementClient(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.Option) (ManagementClient, error) {
+	cc, err := cc.Bind(ctx, append(opts, dcerpc.WithAbstractSyntax(ManagementSyntaxV1_0))...)
+	if err != nil {
+		return nil, err
+	}
+	return &xxx_DefaultManagementClient{cc: cc}, nil
+}
+
+// xxx_ManagementInquireInterfaceIDsOperation structure represents the rpc__mgmt_inq_if_ids operation
+type xxx_ManagementInquireInterfaceIDsOperation struct {
+	InterfaceIDVector *InterfaceIDVector `idl:"name:if_id_vector" json:"interface_id_vector"`
+	Status            uint32             `idl:"name:status" json:"status"`
+}
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) OpNum() int { return 0 }
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) OpName() string {
+	return "/mgmt/v1/rpc__mgmt_inq_if_ids"
+}
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareRequestPayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareRequestPayload(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reader) error {
+	return nil
+}
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) xxx_PrepareResponsePayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareResponsePayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareResponsePayload(ctx); err != nil {
+		return err
+	}
+	// if_id_vector {out} (1:{pointer=ref}*(2))(2:{pointer=unique, alias=rpc_if_id_vector_p_t}*(1))(3:{alias=rpc_if_id_vector_t}(struct))
+	{
+		if o.InterfaceIDVector != nil {
+			_ptr_if_id_vector := ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+				if o.InterfaceIDVector != nil {
+					if err := o.InterfaceIDVector.MarshalNDR(ctx, w); err != nil {
+						return err
+					}
+				} else {
+					if err := (&InterfaceIDVector{}).MarshalNDR(ctx, w); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err := w.WritePointer(&o.InterfaceIDVector, _ptr_if_id_vector); err != nil {
+				return err
+			}
+		} else {
+			if err := w.WritePointer(nil); err != nil {
+				return err
+			}
+		}
+		if err := w.WriteDeferred(); err != nil {
+			return err
+		}
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.WriteData(o.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireInterfaceIDsOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Reader) error {
+	// if_id_vector {out} (1:{pointer=ref}*(2))(2:{pointer=unique, alias=rpc_if_id_vector_p_t}*(1))(3:{alias=rpc_if_id_vector_t}(struct))
+	{
+		_ptr_if_id_vector := ndr.UnmarshalNDRFunc(func(ctx context.Context, w ndr.Reader) error {
+			if o.InterfaceIDVector == nil {
+				o.InterfaceIDVector = &InterfaceIDVector{}
+			}
+			if err := o.InterfaceIDVector.UnmarshalNDR(ctx, w); err != nil {
+				return err
+			}
+			return nil
+		})
+		_s_if_id_vector := func(ptr interface{}) { o.InterfaceIDVector = *ptr.(**InterfaceIDVector) }
+		if err := w.ReadPointer(&o.InterfaceIDVector, _s_if_id_vector, _ptr_if_id_vector); err != nil {
+			return err
+		}
+		if err := w.ReadDeferred(); err != nil {
+			return err
+		}
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.ReadData(&o.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ManagementInquireInterfaceIDsRequest structure represents the rpc__mgmt_inq_if_ids operation request
+type ManagementInquireInterfaceIDsRequest struct {
+}
+
+func (o *ManagementInquireInterfaceIDsRequest) xxx_ToOp(ctx context.Context) *xxx_ManagementInquireInterfaceIDsOperation {
+	if o == nil {
+		return &xxx_ManagementInquireInterfaceIDsOperation{}
+	}
+	return &xxx_ManagementInquireInterfaceIDsOperation{}
+}
+
+func (o *ManagementInquireInterfaceIDsRequest) xxx_FromOp(ctx context.Context, op *xxx_ManagementInquireInterfaceIDsOperation) {
+	if o == nil {
+		return
+	}
+}
+func (o *ManagementInquireInterfaceIDsRequest) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRRequest(ctx, w)
+}
+func (o *ManagementInquireInterfaceIDsRequest) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementInquireInterfaceIDsOperation{}
+	if err := _o.UnmarshalNDRRequest(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// ManagementInquireInterfaceIDsResponse structure represents the rpc__mgmt_inq_if_ids operation response
+type ManagementInquireInterfaceIDsResponse struct {
+	InterfaceIDVector *InterfaceIDVector `idl:"name:if_id_vector" json:"interface_id_vector"`
+	Status            uint32             `idl:"name:status" json:"status"`
+}
+
+func (o *ManagementInquireInterfaceIDsResponse) xxx_ToOp(ctx context.Context) *xxx_ManagementInquireInterfaceIDsOperation {
+	if o == nil {
+		return &xxx_ManagementInquireInterfaceIDsOperation{}
+	}
+	return &xxx_ManagementInquireInterfaceIDsOperation{
+		InterfaceIDVector: o.InterfaceIDVector,
+		Status:            o.Status,
+	}
+}
+
+func (o *ManagementInquireInterfaceIDsResponse) xxx_FromOp(ctx context.Context, op *xxx_ManagementInquireInterfaceIDsOperation) {
+	if o == nil {
+		return
+	}
+	o.InterfaceIDVector = op.InterfaceIDVector
+	o.Status = op.Status
+}
+func (o *ManagementInquireInterfaceIDsResponse) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRResponse(ctx, w)
+}
+func (o *ManagementInquireInterfaceIDsResponse) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementInquireInterfaceIDsOperation{}
+	if err := _o.UnmarshalNDRResponse(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// xxx_ManagementInquireStatsOperation structure represents the rpc__mgmt_inq_stats operation
+type xxx_ManagementInquireStatsOperation struct {
+	Count      uint32   `idl:"name:count" json:"count"`
+	Statistics []uint32 `idl:"name:statistics;size_is:(count)" json:"statistics"`
+	Status     uint32   `idl:"name:status" json:"status"`
+}
+
+func (o *xxx_ManagementInquireStatsOperation) OpNum() int { return 1 }
+
+func (o *xxx_ManagementInquireStatsOperation) OpName() string { return "/mgmt/v1/rpc__mgmt_inq_stats" }
+
+func (o *xxx_ManagementInquireStatsOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareRequestPayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireStatsOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareRequestPayload(ctx); err != nil {
+		return err
+	}
+	// count {in, out} (1:{pointer=ref}*(1))(2:{alias=unsigned32}(uint32))
+	{
+		if err := w.WriteData(o.Count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireStatsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reader) error {
+	// count {in, out} (1:{pointer=ref}*(1))(2:{alias=unsigned32}(uint32))
+	{
+		if err := w.ReadData(&o.Count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireStatsOperation) xxx_PrepareResponsePayload(ctx context.Context) error {
+	if o.Statistics != nil && o.Count == 0 {
+		o.Count = uint32(len(o.Statistics))
+	}
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareResponsePayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireStatsOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareResponsePayload(ctx); err != nil {
+		return err
+	}
+	// count {in, out} (1:{pointer=ref}*(1))(2:{alias=unsigned32}(uint32))
+	{
+		if err := w.WriteData(o.Count); err != nil {
+			return err
+		}
+	}
+	// statistics {out} (1:[dim:0,size_is=count])(2:{alias=unsigned32}(uint32))
+	{
+		dimSize1 := uint64(o.Count)
+		if err := w.WriteSize(dimSize1); err != nil {
+			return err
+		}
+		sizeInfo := []uint64{
+			dimSize1,
+		}
+		for i1 := range o.Statistics {
+			i1 := i1
+			if uint64(i1) >= sizeInfo[0] {
+				break
+			}
+			if err := w.WriteData(o.Statistics[i1]); err != nil {
+				return err
+			}
+		}
+		for i1 := len(o.Statistics); uint64(i1) < sizeInfo[0]; i1++ {
+			if err := w.WriteData(uint32(0)); err != nil {
+				return err
+			}
+		}
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.WriteData(o.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquireStatsOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Reader) error {
+	// count {in, out} (1:{pointer=ref}*(1))(2:{alias=unsigned32}(uint32))
+	{
+		if err := w.ReadData(&o.Count); err != nil {
+			return err
+		}
+	}
+	// statistics {out} (1:[dim:0,size_is=count])(2:{alias=unsigned32}(uint32))
+	{
+		sizeInfo := []uint64{
+			0,
+		}
+		for sz1 := range sizeInfo {
+			if err := w.ReadSize(&sizeInfo[sz1]); err != nil {
+				return err
+			}
+		}
+		if sizeInfo[0] > uint64(w.Len()) /* sanity-check */ {
+			return fmt.Errorf("buffer overflow for size %d of array o.Statistics", sizeInfo[0])
+		}
+		o.Statistics = make([]uint32, sizeInfo[0])
+		for i1 := range o.Statistics {
+			i1 := i1
+			if err := w.ReadData(&o.Statistics[i1]); err != nil {
+				return err
+			}
+		}
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.ReadData(&o.Status); err != nil {
+			return err
+		}
+	}
+	if err := ndr.CheckSize("Statistics", len(o.Statistics), o.Count, "Count"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ManagementInquireStatsRequest structure represents the rpc__mgmt_inq_stats operation request
+type ManagementInquireStatsRequest struct {
+	Count uint32 `idl:"name:count" json:"count"`
+}
+
+func (o *ManagementInquireStatsRequest) xxx_ToOp(ctx context.Context) *xxx_ManagementInquireStatsOperation {
+	if o == nil {
+		return &xxx_ManagementInquireStatsOperation{}
+	}
+	return &xxx_ManagementInquireStatsOperation{
+		Count: o.Count,
+	}
+}
+
+func (o *ManagementInquireStatsRequest) xxx_FromOp(ctx context.Context, op *xxx_ManagementInquireStatsOperation) {
+	if o == nil {
+		return
+	}
+	o.Count = op.Count
+}
+func (o *ManagementInquireStatsRequest) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRRequest(ctx, w)
+}
+func (o *ManagementInquireStatsRequest) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementInquireStatsOperation{}
+	if err := _o.UnmarshalNDRRequest(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// ManagementInquireStatsResponse structure represents the rpc__mgmt_inq_stats operation response
+type ManagementInquireStatsResponse struct {
+	Count      uint32   `idl:"name:count" json:"count"`
+	Statistics []uint32 `idl:"name:statistics;size_is:(count)" json:"statistics"`
+	Status     uint32   `idl:"name:status" json:"status"`
+}
+
+func (o *ManagementInquireStatsResponse) xxx_ToOp(ctx context.Context) *xxx_ManagementInquireStatsOperation {
+	if o == nil {
+		return &xxx_ManagementInquireStatsOperation{}
+	}
+	return &xxx_ManagementInquireStatsOperation{
+		Count:      o.Count,
+		Statistics: o.Statistics,
+		Status:     o.Status,
+	}
+}
+
+func (o *ManagementInquireStatsResponse) xxx_FromOp(ctx context.Context, op *xxx_ManagementInquireStatsOperation) {
+	if o == nil {
+		return
+	}
+	o.Count = op.Count
+	o.Statistics = op.Statistics
+	o.Status = op.Status
+}
+func (o *ManagementInquireStatsResponse) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRResponse(ctx, w)
+}
+func (o *ManagementInquireStatsResponse) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementInquireStatsOperation{}
+	if err := _o.UnmarshalNDRResponse(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// xxx_ManagementIsServerListeningOperation structure represents the rpc__mgmt_is_server_listening operation
+type xxx_ManagementIsServerListeningOperation struct {
+	Status uint32 `idl:"name:status" json:"status"`
+	Return bool   `idl:"name:Return" json:"return"`
+}
+
+func (o *xxx_ManagementIsServerListeningOperation) OpNum() int { return 2 }
+
+func (o *xxx_ManagementIsServerListeningOperation) OpName() string {
+	return "/mgmt/v1/rpc__mgmt_is_server_listening"
+}
+
+func (o *xxx_ManagementIsServerListeningOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareRequestPayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementIsServerListeningOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareRequestPayload(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *xxx_ManagementIsServerListeningOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reader) error {
+	return nil
+}
+
+func (o *xxx_ManagementIsServerListeningOperation) xxx_PrepareResponsePayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareResponsePayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementIsServerListeningOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareResponsePayload(ctx); err != nil {
+		return err
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.WriteData(o.Status); err != nil {
+			return err
+		}
+	}
+	// Return {out} (1:{alias=boolean32, names=unsigned32}(uint32))
+	{
+		if !o.Return {
+			if err := w.WriteData(uint32(0)); err != nil {
+				return err
+			}
+		} else {
+			if err := w.WriteData(uint32(1)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementIsServerListeningOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Reader) error {
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.ReadData(&o.Status); err != nil {
+			return err
+		}
+	}
+	// Return {out} (1:{alias=boolean32, names=unsigned32}(uint32))
+	{
+		var _bReturn uint32
+		if err := w.ReadData(&_bReturn); err != nil {
+			return err
+		}
+		o.Return = _bReturn != 0
+	}
+	return nil
+}
+
+// ManagementIsServerListeningRequest structure represents the rpc__mgmt_is_server_listening operation request
+type ManagementIsServerListeningRequest struct {
+}
+
+func (o *ManagementIsServerListeningRequest) xxx_ToOp(ctx context.Context) *xxx_ManagementIsServerListeningOperation {
+	if o == nil {
+		return &xxx_ManagementIsServerListeningOperation{}
+	}
+	return &xxx_ManagementIsServerListeningOperation{}
+}
+
+func (o *ManagementIsServerListeningRequest) xxx_FromOp(ctx context.Context, op *xxx_ManagementIsServerListeningOperation) {
+	if o == nil {
+		return
+	}
+}
+func (o *ManagementIsServerListeningRequest) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRRequest(ctx, w)
+}
+func (o *ManagementIsServerListeningRequest) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementIsServerListeningOperation{}
+	if err := _o.UnmarshalNDRRequest(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// ManagementIsServerListeningResponse structure represents the rpc__mgmt_is_server_listening operation response
+type ManagementIsServerListeningResponse struct {
+	Status uint32 `idl:"name:status" json:"status"`
+	// Return: The rpc__mgmt_is_server_listening return value.
+	Return bool `idl:"name:Return" json:"return"`
+}
+
+func (o *ManagementIsServerListeningResponse) xxx_ToOp(ctx context.Context) *xxx_ManagementIsServerListeningOperation {
+	if o == nil {
+		return &xxx_ManagementIsServerListeningOperation{}
+	}
+	return &xxx_ManagementIsServerListeningOperation{
+		Status: o.Status,
+		Return: o.Return,
+	}
+}
+
+func (o *ManagementIsServerListeningResponse) xxx_FromOp(ctx context.Context, op *xxx_ManagementIsServerListeningOperation) {
+	if o == nil {
+		return
+	}
+	o.Status = op.Status
+	o.Return = op.Return
+}
+func (o *ManagementIsServerListeningResponse) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRResponse(ctx, w)
+}
+func (o *ManagementIsServerListeningResponse) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementIsServerListeningOperation{}
+	if err := _o.UnmarshalNDRResponse(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// xxx_ManagementStopServerListeningOperation structure represents the rpc__mgmt_stop_server_listening operation
+type xxx_ManagementStopServerListeningOperation struct {
+	Status uint32 `idl:"name:status" json:"status"`
+}
+
+func (o *xxx_ManagementStopServerListeningOperation) OpNum() int { return 3 }
+
+func (o *xxx_ManagementStopServerListeningOperation) OpName() string {
+	return "/mgmt/v1/rpc__mgmt_stop_server_listening"
+}
+
+func (o *xxx_ManagementStopServerListeningOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareRequestPayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementStopServerListeningOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareRequestPayload(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *xxx_ManagementStopServerListeningOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reader) error {
+	return nil
+}
+
+func (o *xxx_ManagementStopServerListeningOperation) xxx_PrepareResponsePayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareResponsePayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementStopServerListeningOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareResponsePayload(ctx); err != nil {
+		return err
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.WriteData(o.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementStopServerListeningOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Reader) error {
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.ReadData(&o.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ManagementStopServerListeningRequest structure represents the rpc__mgmt_stop_server_listening operation request
+type ManagementStopServerListeningRequest struct {
+}
+
+func (o *ManagementStopServerListeningRequest) xxx_ToOp(ctx context.Context) *xxx_ManagementStopServerListeningOperation {
+	if o == nil {
+		return &xxx_ManagementStopServerListeningOperation{}
+	}
+	return &xxx_ManagementStopServerListeningOperation{}
+}
+
+func (o *ManagementStopServerListeningRequest) xxx_FromOp(ctx context.Context, op *xxx_ManagementStopServerListeningOperation) {
+	if o == nil {
+		return
+	}
+}
+func (o *ManagementStopServerListeningRequest) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRRequest(ctx, w)
+}
+func (o *ManagementStopServerListeningRequest) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementStopServerListeningOperation{}
+	if err := _o.UnmarshalNDRRequest(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// ManagementStopServerListeningResponse structure represents the rpc__mgmt_stop_server_listening operation response
+type ManagementStopServerListeningResponse struct {
+	Status uint32 `idl:"name:status" json:"status"`
+}
+
+func (o *ManagementStopServerListeningResponse) xxx_ToOp(ctx context.Context) *xxx_ManagementStopServerListeningOperation {
+	if o == nil {
+		return &xxx_ManagementStopServerListeningOperation{}
+	}
+	return &xxx_ManagementStopServerListeningOperation{
+		Status: o.Status,
+	}
+}
+
+func (o *ManagementStopServerListeningResponse) xxx_FromOp(ctx context.Context, op *xxx_ManagementStopServerListeningOperation) {
+	if o == nil {
+		return
+	}
+	o.Status = op.Status
+}
+func (o *ManagementStopServerListeningResponse) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRResponse(ctx, w)
+}
+func (o *ManagementStopServerListeningResponse) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementStopServerListeningOperation{}
+	if err := _o.UnmarshalNDRResponse(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// xxx_ManagementInquirePrincNameOperation structure represents the rpc__mgmt_inq_princ_name operation
+type xxx_ManagementInquirePrincNameOperation struct {
+	AuthnProto    uint32 `idl:"name:authn_proto" json:"authn_proto"`
+	PrincNameSize uint32 `idl:"name:princ_name_size" json:"princ_name_size"`
+	PrincName     string `idl:"name:princ_name;size_is:(princ_name_size);string" json:"princ_name"`
+	Status        uint32 `idl:"name:status" json:"status"`
+}
+
+func (o *xxx_ManagementInquirePrincNameOperation) OpNum() int { return 4 }
+
+func (o *xxx_ManagementInquirePrincNameOperation) OpName() string {
+	return "/mgmt/v1/rpc__mgmt_inq_princ_name"
+}
+
+func (o *xxx_ManagementInquirePrincNameOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareRequestPayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquirePrincNameOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareRequestPayload(ctx); err != nil {
+		return err
+	}
+	// authn_proto {in} (1:{alias=unsigned32}(uint32))
+	{
+		if err := w.WriteData(o.AuthnProto); err != nil {
+			return err
+		}
+	}
+	// princ_name_size {in} (1:{alias=unsigned32}(uint32))
+	{
+		if err := w.WriteData(o.PrincNameSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquirePrincNameOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reader) error {
+	// authn_proto {in} (1:{alias=unsigned32}(uint32))
+	{
+		if err := w.ReadData(&o.AuthnProto); err != nil {
+			return err
+		}
+	}
+	// princ_name_size {in} (1:{alias=unsigned32}(uint32))
+	{
+		if err := w.ReadData(&o.PrincNameSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquirePrincNameOperation) xxx_PrepareResponsePayload(ctx context.Context) error {
+	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
+		if err := hook.AfterPrepareResponsePayload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquirePrincNameOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	if err := o.xxx_PrepareResponsePayload(ctx); err != nil {
+		return err
+	}
+	// princ_name {out} (1:{string}[dim:0,size_is=princ_name_size,string,null](char))
+	{
+		dimSize1 := uint64(o.PrincNameSize)
+		if err := w.WriteSize(dimSize1); err != nil {
+			return err
+		}
+		sizeInfo := []uint64{
+			dimSize1,
+		}
+		dimLength1 := ndr.CharNLen(o.PrincName)
+		if dimLength1 > sizeInfo[0] {
+			dimLength1 = sizeInfo[0]
+		} else {
+			sizeInfo[0] = dimLength1
+		}
+		if err := w.WriteSize(0); err != nil {
+			return err
+		}
+		if err := w.WriteSize(dimLength1); err != nil {
+			return err
+		}
+		_PrincName_buf := []byte(o.PrincName)
+		if uint64(len(_PrincName_buf)) > sizeInfo[0]-1 {
+			_PrincName_buf = _PrincName_buf[:sizeInfo[0]-1]
+		}
+		if o.PrincName != ndr.ZeroString {
+			_PrincName_buf = append(_PrincName_buf, byte(0))
+		}
+		for i1 := range _PrincName_buf {
+			i1 := i1
+			if uint64(i1) >= sizeInfo[0] {
+				break
+			}
+			if err := w.WriteData(_PrincName_buf[i1]); err != nil {
+				return err
+			}
+		}
+		for i1 := len(_PrincName_buf); uint64(i1) < sizeInfo[0]; i1++ {
+			if err := w.WriteData(uint8(0)); err != nil {
+				return err
+			}
+		}
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.WriteData(o.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *xxx_ManagementInquirePrincNameOperation) UnmarshalNDRResponse(ctx context.Context, w ndr.Reader) error {
+	// princ_name {out} (1:{string}[dim:0,size_is=princ_name_size,string,null](char))
+	{
+		sizeInfo := []uint64{
+			0,
+		}
+		for sz1 := range sizeInfo {
+			if err := w.ReadSize(&sizeInfo[sz1]); err != nil {
+				return err
+			}
+		}
+		for sz1 := range sizeInfo {
+			if err := w.ReadSize(&sizeInfo[sz1]); err != nil {
+				return err
+			}
+			if err := w.ReadSize(&sizeInfo[sz1]); err != nil {
+				return err
+			}
+		}
+		var _PrincName_buf []byte
+		if sizeInfo[0] > uint64(w.Len()) /* sanity-check */ {
+			return fmt.Errorf("buffer overflow for size %d of array _PrincName_buf", sizeInfo[0])
+		}
+		_PrincName_buf = make([]byte, sizeInfo[0])
+		for i1 := range _PrincName_buf {
+			i1 := i1
+			if err := w.ReadData(&_PrincName_buf[i1]); err != nil {
+				return err
+			}
+		}
+		o.PrincName = strings.TrimRight(string(_PrincName_buf), ndr.ZeroString)
+	}
+	// status {out} (1:{pointer=ref}*(1)(error_status_t))
+	{
+		if err := w.ReadData(&o.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ManagementInquirePrincNameRequest structure represents the rpc__mgmt_inq_princ_name operation request
+type ManagementInquirePrincNameRequest struct {
+	AuthnProto    uint32 `idl:"name:authn_proto" json:"authn_proto"`
+	PrincNameSize uint32 `idl:"name:princ_name_size" json:"princ_name_size"`
+}
+
+func (o *ManagementInquirePrincNameRequest) xxx_ToOp(ctx context.Context) *xxx_ManagementInquirePrincNameOperation {
+	if o == nil {
+		return &xxx_ManagementInquirePrincNameOperation{}
+	}
+	return &xxx_ManagementInquirePrincNameOperation{
+		AuthnProto:    o.AuthnProto,
+		PrincNameSize: o.PrincNameSize,
+	}
+}
+
+func (o *ManagementInquirePrincNameRequest) xxx_FromOp(ctx context.Context, op *xxx_ManagementInquirePrincNameOperation) {
+	if o == nil {
+		return
+	}
+	o.AuthnProto = op.AuthnProto
+	o.PrincNameSize = op.PrincNameSize
+}
+func (o *ManagementInquirePrincNameRequest) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRRequest(ctx, w)
+}
+func (o *ManagementInquirePrincNameRequest) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementInquirePrincNameOperation{}
+	if err := _o.UnmarshalNDRRequest(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
+
+// ManagementInquirePrincNameResponse structure represents the rpc__mgmt_inq_princ_name operation response
+type ManagementInquirePrincNameResponse struct {
+	PrincName string `idl:"name:princ_name;size_is:(princ_name_size);string" json:"princ_name"`
+	Status    uint32 `idl:"name:status" json:"status"`
+}
+
+func (o *ManagementInquirePrincNameResponse) xxx_ToOp(ctx context.Context) *xxx_ManagementInquirePrincNameOperation {
+	if o == nil {
+		return &xxx_ManagementInquirePrincNameOperation{}
+	}
+	return &xxx_ManagementInquirePrincNameOperation{
+		PrincName: o.PrincName,
+		Status:    o.Status,
+	}
+}
+
+func (o *ManagementInquirePrincNameResponse) xxx_FromOp(ctx context.Context, op *xxx_ManagementInquirePrincNameOperation) {
+	if o == nil {
+		return
+	}
+	o.PrincName = op.PrincName
+	o.Status = op.Status
+}
+func (o *ManagementInquirePrincNameResponse) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+	return o.xxx_ToOp(ctx).MarshalNDRResponse(ctx, w)
+}
+func (o *ManagementInquirePrincNameResponse) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+	_o := &xxx_ManagementInquirePrincNameOperation{}
+	if err := _o.UnmarshalNDRResponse(ctx, r); err != nil {
+		return err
+	}
+	o.xxx_FromOp(ctx, _o)
+	return nil
+}
@@ -1385,6 +1385,24 @@ func (o *xxx_CreateObjectOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SecurityDescriptorLength", o.SecurityDescriptorLength, 0, 524288); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.SecurityDescriptorLength, "SecurityDescriptorLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1634,6 +1652,9 @@ func (o *xxx_DeleteObjectOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1962,6 +1983,18 @@ func (o *xxx_GetPropertiesOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2114,6 +2147,9 @@ func (o *xxx_GetPropertiesOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2425,6 +2461,18 @@ func (o *xxx_SetPropertiesOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2675,6 +2723,12 @@ func (o *xxx_GetObjectSecurityOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2824,6 +2878,9 @@ func (o *xxx_GetObjectSecurityOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3140,6 +3197,15 @@ func (o *xxx_SetObjectSecurityOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.Length, "Length"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3919,6 +3985,9 @@ func (o *xxx_LookupNextOperation) UnmarshalNDRResponse(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4281,6 +4350,9 @@ func (o *xxx_DeleteObjectGUIDOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4642,6 +4714,18 @@ func (o *xxx_GetPropertiesGUIDOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4794,6 +4878,9 @@ func (o *xxx_GetPropertiesGUIDOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5114,6 +5201,18 @@ func (o *xxx_SetPropertiesGUIDOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5374,6 +5473,12 @@ func (o *xxx_GetObjectSecurityGUIDOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5523,6 +5628,9 @@ func (o *xxx_GetObjectSecurityGUIDOperation) UnmarshalNDRResponse(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5814,6 +5922,15 @@ func (o *xxx_SetObjectSecurityGUIDOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.Length, "Length"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6124,6 +6241,15 @@ func (o *xxx_QMSetMachinePropertiesOperation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6547,6 +6673,9 @@ func (o *xxx_CreateServersCacheOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6873,6 +7002,18 @@ func (o *xxx_QMSetMachinePropertiesSignProcOperation) UnmarshalNDRRequest(ctx co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Challenge", len(o.Challenge), o.ChallengeSize, "ChallengeSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ChallengeSize", o.ChallengeSize, 0, 32); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Signature", len(o.Signature), o.SignatureMaxSize, "SignatureMaxSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("SignatureMaxSize", o.SignatureMaxSize, 0, 128); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7243,6 +7384,12 @@ func (o *xxx_QMGetObjectSecurityOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7392,6 +7539,9 @@ func (o *xxx_QMGetObjectSecurityOperation) UnmarshalNDRResponse(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7728,6 +7878,18 @@ func (o *xxx_QMGetObjectSecurityChallengeResponseProcOperation) UnmarshalNDRRequ
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Challenge", len(o.Challenge), o.ChallengeSize, "ChallengeSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ChallengeSize", o.ChallengeSize, 0, 32); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ChallengeResponse", len(o.ChallengeResponse), o.ChallengeResponseMaxSize, "ChallengeResponseMaxSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ChallengeResponseMaxSize", o.ChallengeResponseMaxSize, 0, 128); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8077,6 +8239,15 @@ func (o *xxx_InitSecurityContextOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerBuffer", len(o.ServerBuffer), o.ServerBufferSize, "ServerBufferSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServerBufferSize", o.ServerBufferSize, 0, 524288); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ClientBufferMaxSize", o.ClientBufferMaxSize, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8476,6 +8647,15 @@ func (o *xxx_ValidateServerOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ClientBufferMaxSize", o.ClientBufferMaxSize, 0, 524288); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ClientBuffer", len(o.ClientBuffer), o.ClientBufferMaxSize, "ClientBufferMaxSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ClientBufferSize", o.ClientBufferSize, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8854,6 +9034,9 @@ func (o *xxx_GetServerPortOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("IP", o.IP, 0, 1); err != nil {
+		return err
+	}
 	return nil
 }
 
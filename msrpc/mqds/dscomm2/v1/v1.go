@@ -583,6 +583,12 @@ func (o *xxx_GetComputerSitesOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Sites", len(o.Sites), o.NumberOfSites, "NumberOfSites"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -910,6 +916,18 @@ func (o *xxx_GetPropertiesExOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1062,6 +1080,9 @@ func (o *xxx_GetPropertiesExOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1442,6 +1463,18 @@ func (o *xxx_GetPropertiesGUIDExOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 58); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1594,6 +1627,9 @@ func (o *xxx_GetPropertiesGUIDExOperation) UnmarshalNDRResponse(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2626,6 +2662,9 @@ func (o *xxx_GetGCListInDomainOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ServerSignature", len(o.ServerSignature), o.ServerSignatureSize, "ServerSignatureSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -365,6 +365,9 @@ func (o *Restriction) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.PropertyRestriction, _s_paPropRes, _ptr_paPropRes); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RestrictionCount", o.RestrictionCount, 0, 128); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -477,6 +480,9 @@ func (o *ColumnSet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Columns, _s_aCol, _ptr_aCol); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ColumnCount", o.ColumnCount, 0, 128); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -657,5 +663,8 @@ func (o *SortSet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Columns, _s_aCol, _ptr_aCol); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ColumnCount", o.ColumnCount, 0, 128); err != nil {
+		return err
+	}
 	return nil
 }
@@ -2873,6 +2873,9 @@ func (o *TransferBufferV1) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Version, _s_pulVersion, _ptr_pulVersion); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("TransferType", o.TransferType, 0, 2); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3801,6 +3804,18 @@ func (o *TransferBufferV1_TransferBufferV1_Receive) UnmarshalNDR(ctx context.Con
 	if err := w.ReadPointer(&o.OrderingFormatNameLengthProperty, _s_pulOrderingFormatNameLenProp, _ptr_pulOrderingFormatNameLenProp); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ResponseFormatNameLength", o.ResponseFormatNameLength, 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("AdminFormatNameLength", o.AdminFormatNameLength, 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DestinationFormatNameLength", o.DestinationFormatNameLength, 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("OrderingFormatNameLength", o.OrderingFormatNameLength, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4048,6 +4063,9 @@ func (o *ObjectFormat) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := o.ObjectFormat.UnmarshalUnionNDR(ctx, w, _swObjectFormat); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ObjectType", o.ObjectType, 1, 2); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5971,6 +5989,21 @@ func (o *xxx_CreateObjectInternalOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("SecurityDescriptorSize", o.SecurityDescriptorSize, 0, 524288); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.SecurityDescriptorSize, "SecurityDescriptorSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6274,6 +6307,12 @@ func (o *xxx_SetObjectSecurityInternalOperation) UnmarshalNDRRequest(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("SecurityDescriptorSize", o.SecurityDescriptorSize, 0, 524288); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.SecurityDescriptorSize, "SecurityDescriptorSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6481,6 +6520,9 @@ func (o *xxx_GetObjectSecurityInternalOperation) UnmarshalNDRRequest(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7012,6 +7054,15 @@ func (o *xxx_GetObjectPropertiesOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7392,6 +7443,15 @@ func (o *xxx_SetObjectPropertiesOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7778,6 +7838,9 @@ func (o *xxx_GetTMWhereaboutsOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 131072); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8062,6 +8125,12 @@ func (o *xxx_EnlistTransactionOperation) UnmarshalNDRRequest(ctx context.Context
 			}
 		}
 	}
+	if err := ndr.CheckRange("CookieLength", o.CookieLength, 0, 131072); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Cookie", len(o.Cookie), o.CookieLength, "CookieLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9985,6 +10054,9 @@ func (o *xxx_HandleToFormatNameOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckRange("FormatNameRPCBufferLength", o.FormatNameRPCBufferLength, 0, 524288); err != nil {
+		return err
+	}
 	return nil
 }
 
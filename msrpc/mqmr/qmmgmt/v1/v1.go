@@ -665,6 +665,15 @@ func (o *xxx_ManagementGetInfoOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("CreatePartition", o.CreatePartition, 1, 128); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Property", len(o.Property), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Var", len(o.Var), o.CreatePartition, "CreatePartition"); err != nil {
+		return err
+	}
 	return nil
 }
 
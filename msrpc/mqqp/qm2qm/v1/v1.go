@@ -707,6 +707,9 @@ func (o *RemoteReadDesc) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Buffer, _s_lpBuffer, _ptr_lpBuffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Size", o.Size, 0, 4325376); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1289,6 +1292,9 @@ func (o *xxx_EndReceiveOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Ack", o.Ack, 1, 2); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1539,6 +1545,9 @@ func (o *xxx_OpenQueueOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckRange("MQS", o.MQS, 0, 16); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2378,6 +2387,9 @@ func (o *xxx_GetQMQMServerPortOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PortType", o.PortType, 0, 3); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2474,6 +2474,9 @@ func (o *xxx_StartReceiveOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PacketSections", len(o.PacketSections), o.NumberOfSections, "NumberOfSections"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3018,6 +3021,9 @@ func (o *xxx_EndReceiveOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Ack", o.Ack, 1, 2); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3892,6 +3898,12 @@ func (o *xxx_EnlistRemoteTransactionOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PropagationTokenLength", o.PropagationTokenLength, 0, 131072); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("PropagationToken", len(o.PropagationToken), o.PropagationTokenLength, "PropagationTokenLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4327,6 +4339,9 @@ func (o *xxx_StartTransactionalReceiveOperation) UnmarshalNDRResponse(ctx contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PacketSections", len(o.PacketSections), o.NumberOfSections, "NumberOfSections"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4908,6 +4923,9 @@ func (o *xxx_EndTransactionalReceiveOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Ack", o.Ack, 1, 2); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,376 @@
+// The ndrdiff package implements the differential testing harness that
+// marshals the identical request structures with the generated NDR encoder
+// and with the reference implementation, and reports the byte divergences.
+//
+// The reference stub data is provided either by the corpus of the test
+// fixtures (the stub data captured from the Windows traffic, or produced by
+// Impacket), or by the live Reference (for example, the Impacket-based script
+// started with the CommandReference):
+//
+//	h := &ndrdiff.Harness{}
+//	h.RegisterClient("svcctl", (*svcctl.SvcctlClient)(nil))
+//
+//	cases, err := ndrdiff.ReadCorpus(f)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	for _, diff := range h.Run(cases) {
+//		fmt.Println(diff)
+//	}
+//
+// The corpus is the JSON lines file, each line is the Case:
+//
+//	{"name":"create-service","method":"svcctl.CreateServiceW","request":{"service_name":"svc"},"stub":"0000..."}
+//
+// The request is the JSON representation of the generated request structure
+// (the `json` tags of the generated types). The Fuzz function generates the
+// random request structures, sends them to the reference and compares the
+// results:
+//
+//	ref, err := ndrdiff.StartCommand(ctx, "python3", "impacket_ref.py")
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	defer ref.Close()
+//
+//	diffs, err := h.Fuzz(ctx, ref, "svcctl.CreateServiceW", 1, 1000)
+package ndrdiff
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// Hex is the byte slice encoded as the hex string in JSON.
+type Hex []byte
+
+// MarshalJSON function encodes the bytes as the hex string.
+func (h Hex) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(h))
+}
+
+// UnmarshalJSON function decodes the hex string (the whitespaces are
+// ignored).
+func (h *Hex) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := hex.DecodeString(strings.Join(strings.Fields(s), ""))
+	if err != nil {
+		return err
+	}
+	*h = v
+	return nil
+}
+
+// Case is the differential test case.
+type Case struct {
+	// The test case name.
+	Name string `json:"name"`
+	// The method name (<interface>.<method>, see Harness.RegisterClient).
+	Method string `json:"method"`
+	// The request structure (JSON).
+	Request json.RawMessage `json:"request"`
+	// The reference stub data.
+	Stub Hex `json:"stub"`
+	// The flag that indicates whether the stub data is encoded with
+	// NDR64 transfer syntax.
+	NDR64 bool `json:"ndr64,omitempty"`
+}
+
+// ReadCorpus function reads the test cases from the JSON lines corpus. The
+// empty lines and the lines starting with '#' are ignored.
+func ReadCorpus(r io.Reader) ([]*Case, error) {
+
+	var cases []*Case
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		c := &Case{}
+		if err := json.Unmarshal(line, c); err != nil {
+			return nil, fmt.Errorf("ndrdiff: read corpus: line %d: %w", n, err)
+		}
+		if c.Name == "" {
+			c.Name = fmt.Sprintf("line-%d", n)
+		}
+		cases = append(cases, c)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("ndrdiff: read corpus: %w", err)
+	}
+
+	return cases, nil
+}
+
+// Diff is the divergence between the generated encoder and the reference.
+type Diff struct {
+	// The test case name.
+	Name string `json:"name"`
+	// The method name.
+	Method string `json:"method"`
+	// The random seed the request was generated with (Fuzz).
+	Seed int64 `json:"seed,omitempty"`
+	// The request structure (JSON).
+	Request json.RawMessage `json:"request,omitempty"`
+	// The offset of the first differing byte (-1 if the stub data
+	// was not produced).
+	Offset int `json:"offset"`
+	// The stub data produced by the generated encoder.
+	Got Hex `json:"got,omitempty"`
+	// The reference stub data.
+	Want Hex `json:"want,omitempty"`
+	// The error (the request cannot be decoded or marshaled).
+	Err error `json:"-"`
+}
+
+func (d *Diff) Error() string {
+	if d.Err != nil {
+		return fmt.Sprintf("ndrdiff: %s: %s: %v", d.Name, d.Method, d.Err)
+	}
+	return fmt.Sprintf("ndrdiff: %s: %s: stub data differs at offset %d (got %d bytes, want %d bytes)\ngot:  %s\nwant: %s",
+		d.Name, d.Method, d.Offset, len(d.Got), len(d.Want), window(d.Got, d.Offset), window(d.Want, d.Offset))
+}
+
+func (d *Diff) Unwrap() error { return d.Err }
+
+// window function returns the hex of the bytes around the offset.
+func window(b []byte, off int) string {
+	start, end := max(off-8, 0), min(off+8, len(b))
+	if start >= end {
+		return "(end)"
+	}
+	return fmt.Sprintf("[%d:%d] %s", start, end, hex.EncodeToString(b[start:end]))
+}
+
+// Harness is the differential testing harness.
+type Harness struct {
+	types map[string]reflect.Type
+}
+
+// Register function registers the request structure type for the method
+// name. The request must be the pointer to the generated request structure.
+func (h *Harness) Register(method string, req ndr.Marshaler) {
+	if h.types == nil {
+		h.types = make(map[string]reflect.Type)
+	}
+	h.types[method] = reflect.TypeOf(req)
+}
+
+// RegisterClient function registers the request types of all methods of the
+// generated client interface as `<name>.<method>`. The `client` is the nil
+// pointer to the client interface, for example, (*svcctl.SvcctlClient)(nil).
+func (h *Harness) RegisterClient(name string, client any) {
+
+	typ := reflect.TypeOf(client)
+	if typ == nil || typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Interface {
+		panic("ndrdiff: client must be the pointer to the client interface")
+	}
+
+	for i := 0; i < typ.Elem().NumMethod(); i++ {
+		m := typ.Elem().Method(i)
+		if req, ok := requestType(m.Type); ok {
+			if req, ok := reflect.New(req.Elem()).Interface().(ndr.Marshaler); ok {
+				h.Register(name+"."+m.Name, req)
+			}
+		}
+	}
+}
+
+// requestType function returns the request structure type for the client
+// interface method `func(ctx, *Request, ...CallOption) (*Response, error)`.
+func requestType(m reflect.Type) (reflect.Type, bool) {
+	if m.NumIn() != 3 || !m.IsVariadic() || m.NumOut() != 2 {
+		return nil, false
+	}
+	if req := m.In(1); req.Kind() == reflect.Ptr && req.Elem().Kind() == reflect.Struct {
+		return req, true
+	}
+	return nil, false
+}
+
+// Methods function returns the sorted list of the registered methods.
+func (h *Harness) Methods() []string {
+	methods := make([]string, 0, len(h.types))
+	for method := range h.types {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// New function returns the new request structure for the method.
+func (h *Harness) New(method string) (ndr.Marshaler, error) {
+	typ, ok := h.types[method]
+	if !ok {
+		return nil, fmt.Errorf("ndrdiff: method %q is not registered", method)
+	}
+	return reflect.New(typ.Elem()).Interface().(ndr.Marshaler), nil
+}
+
+// Marshal function decodes the JSON request for the method and marshals it
+// with the generated encoder.
+func (h *Harness) Marshal(method string, request []byte, ndr64 bool) ([]byte, error) {
+
+	req, err := h.New(method)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(request) > 0 {
+		if err := json.Unmarshal(request, req); err != nil {
+			return nil, fmt.Errorf("decode request: %w", err)
+		}
+	}
+
+	return marshal(req, ndr64)
+}
+
+func marshal(req ndr.Marshaler, ndr64 bool) ([]byte, error) {
+	if ndr64 {
+		return ndr.Marshal64(req)
+	}
+	return ndr.Marshal(req)
+}
+
+// Compare function marshals the test case request and compares it with the
+// reference stub data. The function returns nil if the stub data is equal.
+func (h *Harness) Compare(c *Case) *Diff {
+
+	got, err := h.Marshal(c.Method, c.Request, c.NDR64)
+	if err != nil {
+		return &Diff{Name: c.Name, Method: c.Method, Request: c.Request, Offset: -1, Want: c.Stub, Err: err}
+	}
+
+	return compare(&Diff{Name: c.Name, Method: c.Method, Request: c.Request}, got, c.Stub)
+}
+
+// compare function returns the diff if `got` differs from `want`.
+func compare(d *Diff, got, want []byte) *Diff {
+
+	if bytes.Equal(got, want) {
+		return nil
+	}
+
+	d.Got, d.Want, d.Offset = got, want, min(len(got), len(want))
+
+	for i := 0; i < min(len(got), len(want)); i++ {
+		if got[i] != want[i] {
+			d.Offset = i
+			break
+		}
+	}
+
+	return d
+}
+
+// normalize function returns the request decoded from the marshaled request
+// `req`, so that the values that are not representable in NDR (for example,
+// the fixed-size arrays of the different length) are normalized.
+func normalize(h *Harness, method string, req ndr.Marshaler) (ndr.Marshaler, error) {
+
+	b, err := marshal(req, false)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.New(method)
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := out.(ndr.Unmarshaler)
+	if !ok {
+		return req, nil
+	}
+
+	if err := ndr.Unmarshal(b, u); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Run function compares all test cases and returns the divergences.
+func (h *Harness) Run(cases []*Case) []*Diff {
+	var diffs []*Diff
+	for _, c := range cases {
+		if d := h.Compare(c); d != nil {
+			diffs = append(diffs, d)
+		}
+	}
+	return diffs
+}
+
+// Fuzz function generates `n` random requests for the method (starting with
+// the `seed`), marshals them with the generated encoder and the reference, and
+// returns the divergences. The random request is normalized with the NDR
+// round-trip. The requests that cannot be marshaled by the generated encoder
+// (for example, due to the union discriminant) are skipped.
+// The requests rejected by the reference are reported with the error.
+func (h *Harness) Fuzz(ctx context.Context, ref Reference, method string, seed int64, n int) ([]*Diff, error) {
+
+	var diffs []*Diff
+
+	for i := 0; i < n; i++ {
+
+		if err := ctx.Err(); err != nil {
+			return diffs, err
+		}
+
+		req, err := h.New(method)
+		if err != nil {
+			return diffs, err
+		}
+
+		Random(req, seed+int64(i))
+
+		if req, err = normalize(h, method, req); err != nil {
+			continue
+		}
+
+		got, err := marshal(req, false)
+		if err != nil {
+			continue
+		}
+
+		b, err := json.Marshal(req)
+		if err != nil {
+			continue
+		}
+
+		d := &Diff{Name: fmt.Sprintf("fuzz-%d", seed+int64(i)), Method: method, Seed: seed + int64(i), Request: b}
+
+		want, err := ref.Marshal(ctx, method, b)
+		if err != nil {
+			d.Offset, d.Got, d.Err = -1, got, err
+			diffs = append(diffs, d)
+			continue
+		}
+
+		if d = compare(d, got, want); d != nil {
+			diffs = append(diffs, d)
+		}
+	}
+
+	return diffs, nil
+}
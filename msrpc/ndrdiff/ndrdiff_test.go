@@ -0,0 +1,109 @@
+package ndrdiff
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/scmr/svcctl/v2"
+)
+
+func TestHarness(t *testing.T) {
+
+	h := &Harness{}
+	h.RegisterClient("svcctl", (*svcctl.SvcctlClient)(nil))
+
+	if _, err := h.New("svcctl.CreateServiceW"); err != nil {
+		t.Fatalf("expected CreateServiceW to be registered: %v (%v)", err, h.Methods())
+	}
+
+	req := `{"service_name":"svc","binary_path_name":"cmd.exe","start_type":3}`
+
+	stub, err := h.Marshal("svcctl.CreateServiceW", []byte(req), false)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	tampered := append([]byte{}, stub...)
+	tampered[len(tampered)-1] ^= 0xff
+
+	corpus := `# svcctl fixtures.
+{"name":"equal","method":"svcctl.CreateServiceW","request":` + req + `,"stub":"` + Hex(stub).hex() + `"}
+{"name":"tampered","method":"svcctl.CreateServiceW","request":` + req + `,"stub":"` + Hex(tampered).hex() + `"}
+{"name":"unknown","method":"svcctl.Unknown","request":{}}
+`
+
+	cases, err := ReadCorpus(strings.NewReader(corpus))
+	if err != nil {
+		t.Fatalf("read corpus: %v", err)
+	}
+
+	diffs := h.Run(cases)
+	if len(diffs) != 2 {
+		t.Fatalf("expected 2 diffs, got %v", diffs)
+	}
+
+	if diffs[0].Name != "tampered" || diffs[0].Offset != len(stub)-1 || diffs[0].Err != nil {
+		t.Fatalf("unexpected diff: %v", diffs[0])
+	}
+
+	if diffs[1].Name != "unknown" || diffs[1].Err == nil {
+		t.Fatalf("unexpected diff: %v", diffs[1])
+	}
+
+	// the generated encoder is the reference for itself.
+	self := ReferenceFunc(func(ctx context.Context, method string, request []byte) ([]byte, error) {
+		return h.Marshal(method, request, false)
+	})
+
+	ctx := context.Background()
+
+	if diffs, err := h.Fuzz(ctx, self, "svcctl.CreateServiceW", 1, 50); err != nil || len(diffs) != 0 {
+		t.Fatalf("expected no diffs, got %v %v", diffs, err)
+	}
+
+	// the reference that drops the last byte.
+	short := ReferenceFunc(func(ctx context.Context, method string, request []byte) ([]byte, error) {
+		b, err := h.Marshal(method, request, false)
+		return b[:len(b)-1], err
+	})
+
+	diffs, err = h.Fuzz(ctx, short, "svcctl.CreateServiceW", 1, 20)
+	if err != nil || len(diffs) == 0 {
+		t.Fatalf("expected diffs, got %v %v", diffs, err)
+	}
+
+	for _, d := range diffs {
+		if d.Err != nil || d.Offset != len(d.Want) || len(d.Got) != len(d.Want)+1 {
+			t.Fatalf("unexpected diff: %v", d)
+		}
+	}
+
+	// the fuzz diff is reproducible with the seed.
+	var fuzzed svcctl.CreateServiceWRequest
+	if err := json.Unmarshal(diffs[0].Request, &fuzzed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	var seeded svcctl.CreateServiceWRequest
+	Random(&seeded, diffs[0].Seed)
+
+	if fuzzed.ServiceName != seeded.ServiceName || fuzzed.DesiredAccess != seeded.DesiredAccess {
+		t.Fatalf("expected the seeded request to be equal to the fuzzed request")
+	}
+
+	failing := ReferenceFunc(func(ctx context.Context, method string, request []byte) ([]byte, error) {
+		return nil, errors.New("unsupported")
+	})
+
+	if diffs, _ := h.Fuzz(ctx, failing, "svcctl.CreateServiceW", 1, 1); len(diffs) != 1 || diffs[0].Err == nil {
+		t.Fatalf("expected reference error, got %v", diffs)
+	}
+}
+
+func (h Hex) hex() string {
+	b, _ := h.MarshalJSON()
+	return strings.Trim(string(b), `"`)
+}
@@ -0,0 +1,79 @@
+package ndrdiff
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// maxDepth is the maximum depth of the generated pointers and slices.
+const maxDepth = 4
+
+// Random function fills the structure `v` (the pointer) with the random
+// values derived from the seed. The interface fields (unions) are not
+// filled.
+func Random(v any, seed int64) {
+	fill(reflect.ValueOf(v), rand.New(rand.NewSource(seed)), 0)
+}
+
+func fill(v reflect.Value, r *rand.Rand, depth int) {
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			if depth >= maxDepth || (depth > 0 && r.Intn(4) == 0) {
+				return
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		fill(v.Elem(), r, depth+1)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i), r, depth)
+			}
+		}
+	case reflect.Slice:
+		if depth >= maxDepth {
+			return
+		}
+		n := r.Intn(4)
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			n = r.Intn(16)
+		}
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := 0; i < n; i++ {
+			fill(v.Index(i), r, depth+1)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fill(v.Index(i), r, depth)
+		}
+	case reflect.String:
+		b := make([]byte, r.Intn(12))
+		for i := range b {
+			b[i] = byte('a' + r.Intn(26))
+		}
+		v.SetString(string(b))
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(integer(r)))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(integer(r))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(r.Float64())
+	}
+}
+
+// integer function returns the random integer. The zero values (the sizes
+// and the lengths are computed by the generated encoder) and the small
+// values are preferred, so that the most of the requests can be marshaled.
+func integer(r *rand.Rand) uint64 {
+	switch r.Intn(4) {
+	case 0, 1:
+		return 0
+	case 2:
+		return uint64(r.Intn(256))
+	}
+	return r.Uint64()
+}
@@ -0,0 +1,117 @@
+package ndrdiff
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+)
+
+// Reference is the reference NDR encoder.
+type Reference interface {
+	// Marshal function marshals the JSON request for the method and
+	// returns the stub data.
+	Marshal(ctx context.Context, method string, request []byte) ([]byte, error)
+}
+
+// ReferenceFunc is the function implementing the Reference interface.
+type ReferenceFunc func(ctx context.Context, method string, request []byte) ([]byte, error)
+
+// Marshal function calls f(ctx, method, request).
+func (f ReferenceFunc) Marshal(ctx context.Context, method string, request []byte) ([]byte, error) {
+	return f(ctx, method, request)
+}
+
+// CommandReference is the reference encoder implemented by the external
+// process (for example, the Impacket-based script). The process reads the
+// JSON requests from the standard input, one per line:
+//
+//	{"method":"svcctl.CreateServiceW","request":{"service_name":"svc"}}
+//
+// and writes the responses to the standard output, one per line:
+//
+//	{"stub":"0000..."}
+//	{"error":"unsupported method"}
+type CommandReference struct {
+	mu  sync.Mutex
+	cmd *exec.Cmd
+	in  io.WriteCloser
+	out *bufio.Reader
+}
+
+type commandRequest struct {
+	Method  string          `json:"method"`
+	Request json.RawMessage `json:"request"`
+}
+
+type commandResponse struct {
+	Stub  Hex    `json:"stub"`
+	Error string `json:"error,omitempty"`
+}
+
+// StartCommand function starts the reference process.
+func StartCommand(ctx context.Context, name string, args ...string) (*CommandReference, error) {
+
+	cmd := exec.CommandContext(ctx, name, args...)
+
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("ndrdiff: start reference: %w", err)
+	}
+
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("ndrdiff: start reference: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("ndrdiff: start reference: %w", err)
+	}
+
+	return &CommandReference{cmd: cmd, in: in, out: bufio.NewReader(out)}, nil
+}
+
+// Marshal function sends the request to the reference process and returns
+// the stub data.
+func (r *CommandReference) Marshal(ctx context.Context, method string, request []byte) ([]byte, error) {
+
+	b, err := json.Marshal(&commandRequest{Method: method, Request: request})
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.in.Write(append(b, '\n')); err != nil {
+		return nil, fmt.Errorf("reference: %w", err)
+	}
+
+	line, err := r.out.ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("reference: %w", err)
+	}
+
+	resp := &commandResponse{}
+	if err := json.Unmarshal(line, resp); err != nil {
+		return nil, fmt.Errorf("reference: %w", err)
+	}
+
+	if resp.Error != "" {
+		return nil, fmt.Errorf("reference: %w", errors.New(resp.Error))
+	}
+
+	return resp.Stub, nil
+}
+
+// Close function terminates the reference process.
+func (r *CommandReference) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.in.Close()
+	return r.cmd.Wait()
+}
@@ -0,0 +1,270 @@
+// The ndrdump package implements the diagnostic pretty printer for the raw
+// stub data (similar to the samba ndrdump): the stub is decoded with the
+// generated request or response decoder of the operation, and the decoded
+// structure tree is printed with the stub offsets and the referent identifiers:
+//
+//	d := &ndrdump.Dumper{}
+//	if err := d.RegisterClient("svcctl", svcctl.NewSvcctlClient); err != nil {
+//		// handle error.
+//	}
+//
+//	// the partial tree is printed for the malformed stub along with the error.
+//	err := d.Dump(os.Stdout, "svcctl", 12, ndrdump.Request, stub)
+//
+// The output contains the offset of every decoded field (the offset of the
+// first element for arrays and structures), the referent identifier and the
+// offset of the pointee for pointers:
+//
+//	@0000  CreateServiceRequest /svcctl/v2/RCreateServiceW
+//	@0000    ServiceManager: *svcctl.Handle
+//	@0000      Attributes: uint32 = 0 (0x0)
+//	@0004      UUID: *dtyp.GUID
+//	...
+//	@0018    ServiceName: string = "svc"
+//	@0030    DisplayName: string = NULL (ref 0x00000000)
+//
+// The NDR options (for example, ndr.DataRepresentation) are passed to the
+// decoder, the NDR64 option selects the NDR64 transfer syntax.
+package ndrdump
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// Direction is the stub data direction.
+type Direction int
+
+const (
+	// The request stub data (in parameters).
+	Request Direction = iota
+	// The response stub data (out parameters).
+	Response
+)
+
+func (d Direction) String() string {
+	if d == Response {
+		return "Response"
+	}
+	return "Request"
+}
+
+type ndr64 struct{}
+
+// NDR64 is the option that selects the NDR64 transfer syntax.
+var NDR64 ndr64
+
+// method structure represents the registered operation.
+type method struct {
+	// The client method name.
+	name string
+	// The operation type.
+	op reflect.Type
+	// The request and response types.
+	in, out reflect.Type
+}
+
+// Dumper structure represents the registry of the operations that can
+// be decoded.
+type Dumper struct {
+	ifaces map[string]map[int]*method
+}
+
+var (
+	errCaptured = errors.New("ndrdump: operation captured")
+
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	connType    = reflect.TypeOf((*dcerpc.Conn)(nil)).Elem()
+)
+
+// RegisterClient function registers the operations of the client created by
+// the generated constructor `newClient` (for example, svcctl.NewSvcctlClient)
+// under the interface `name`.
+func (d *Dumper) RegisterClient(name string, newClient any) error {
+
+	fn := reflect.ValueOf(newClient)
+	if fn.Kind() != reflect.Func || fn.Type().NumIn() < 2 || fn.Type().In(0) != contextType || fn.Type().In(1) != connType || fn.Type().NumOut() != 2 {
+		return fmt.Errorf("ndrdump: %T is not the client constructor", newClient)
+	}
+
+	ctx, cc := context.Background(), &captureConn{}
+
+	args := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(cc)}
+	if fn.Type().IsVariadic() {
+		// the object uuid is required by the DCOM clients (IPID).
+		args = append(args, reflect.ValueOf(dcerpc.WithObjectUUID(&uuid.UUID{})))
+	}
+
+	out := fn.Call(args)
+	if err, _ := out[1].Interface().(error); err != nil {
+		return fmt.Errorf("ndrdump: create client: %w", err)
+	}
+
+	if d.ifaces == nil {
+		d.ifaces = make(map[string]map[int]*method)
+	}
+
+	if d.ifaces[name] == nil {
+		d.ifaces[name] = make(map[int]*method)
+	}
+
+	cli := out[0]
+	for i := 0; i < cli.NumMethod(); i++ {
+
+		m, typ := cli.Method(i), cli.Type().Method(i)
+		if mt := m.Type(); mt.NumIn() < 2 || mt.In(0) != contextType || mt.NumOut() != 2 ||
+			mt.In(1).Kind() != reflect.Ptr || mt.In(1).Elem().Kind() != reflect.Struct ||
+			mt.Out(0).Kind() != reflect.Ptr || mt.Out(0).Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		cc.op = nil
+		if err := call(ctx, m); !errors.Is(err, errCaptured) || cc.op == nil {
+			continue
+		}
+
+		d.ifaces[name][cc.op.OpNum()] = &method{
+			name: typ.Name,
+			op:   reflect.TypeOf(cc.op).Elem(),
+			in:   m.Type().In(1).Elem(),
+			out:  m.Type().Out(0).Elem(),
+		}
+	}
+
+	return nil
+}
+
+// call function calls the client method with the empty request.
+func call(ctx context.Context, m reflect.Value) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("ndrdump: %v", r)
+		}
+	}()
+	out := m.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.New(m.Type().In(1).Elem())})
+	err, _ = out[1].Interface().(error)
+	return err
+}
+
+// Interfaces function returns the registered interface names.
+func (d *Dumper) Interfaces() []string {
+	ret := make([]string, 0, len(d.ifaces))
+	for name := range d.ifaces {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Methods function returns the registered operation numbers and the client
+// method names for the interface `name`.
+func (d *Dumper) Methods(name string) map[int]string {
+	ret := make(map[int]string)
+	for opNum, m := range d.ifaces[name] {
+		ret[opNum] = m.name
+	}
+	return ret
+}
+
+// Decode function decodes the stub data `stub` of the operation `opNum` of
+// the interface `name` and returns the decoded structure tree. On the decoding
+// error, the partially decoded tree is returned along with the error.
+func (d *Dumper) Decode(name string, opNum int, dir Direction, stub []byte, opts ...any) (*Node, error) {
+
+	m, ok := d.ifaces[name][opNum]
+	if !ok {
+		return nil, fmt.Errorf("ndrdump: unknown operation %s/%d", name, opNum)
+	}
+
+	var (
+		codecOpts []any
+		is64      bool
+		drep      = ndr.DefaultDataRepresentation
+	)
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case ndr64:
+			is64 = true
+			continue
+		case ndr.DataRepresentation:
+			drep = opt
+		}
+		codecOpts = append(codecOpts, opt)
+	}
+
+	op := reflect.New(m.op).Interface().(ndr.Operation)
+
+	var r ndr.Reader = ndr.NDR20(stub, codecOpts...)
+	if is64 {
+		r = ndr.NDR64(stub, codecOpts...)
+	}
+
+	t := newTracer(r, stub, drep, is64)
+
+	unmarshal, fields := op.UnmarshalNDRRequest, m.in
+	if dir == Response {
+		unmarshal, fields = op.UnmarshalNDRResponse, m.out
+	}
+
+	err := t.Unmarshal(context.Background(), ndr.UnmarshalNDRFunc(unmarshal))
+
+	root := t.root(op, fields)
+	root.Name, root.Type = fields.Name(), op.OpName()
+
+	if err != nil {
+		return root, fmt.Errorf("ndrdump: %s: decode %s at offset %d: %w", op.OpName(), strings.ToLower(dir.String()), r.Offset(), err)
+	}
+
+	if n := len(stub) - r.Offset(); n > 0 {
+		root.Trailing = n
+	}
+
+	return root, nil
+}
+
+// Dump function decodes the stub data and writes the decoded structure tree
+// into `w` (see Decode).
+func (d *Dumper) Dump(w io.Writer, name string, opNum int, dir Direction, stub []byte, opts ...any) error {
+
+	root, err := d.Decode(name, opNum, dir, stub, opts...)
+	if root != nil {
+		if _, werr := io.WriteString(w, root.String()); werr != nil {
+			return werr
+		}
+	}
+
+	return err
+}
+
+// captureConn structure represents the connection that captures the invoked
+// operation instead of sending it.
+type captureConn struct {
+	op ndr.Operation
+}
+
+func (c *captureConn) Bind(context.Context, ...dcerpc.Option) (dcerpc.Conn, error) { return c, nil }
+func (c *captureConn) AlterContext(context.Context, ...dcerpc.Option) error        { return nil }
+func (c *captureConn) Context() context.Context                                    { return context.Background() }
+func (c *captureConn) Close(context.Context) error                                 { return nil }
+func (c *captureConn) RegisterServer(dcerpc.ServerHandle, ...dcerpc.Option)        {}
+func (c *captureConn) Info() *dcerpc.ConnInfo                                      { return &dcerpc.ConnInfo{} }
+
+func (c *captureConn) Invoke(ctx context.Context, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+	c.op = op
+	return errCaptured
+}
+
+func (c *captureConn) InvokeObject(ctx context.Context, _ *uuid.UUID, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+	c.op = op
+	return errCaptured
+}
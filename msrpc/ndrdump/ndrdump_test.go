@@ -0,0 +1,93 @@
+package ndrdump
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/scmr/svcctl/v2"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// find function returns the child node with the name `name`.
+func find(n *Node, name string) *Node {
+	for _, child := range n.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+func TestDump(t *testing.T) {
+
+	d := &Dumper{}
+	if err := d.RegisterClient("svcctl", svcctl.NewSvcctlClient); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	if m := d.Methods("svcctl"); m[12] != "CreateServiceW" {
+		t.Fatalf("methods: opnum 12 is %q, expected CreateServiceW", m[12])
+	}
+
+	req := &svcctl.CreateServiceWRequest{
+		ServiceManager: &svcctl.Handle{},
+		ServiceName:    "svc",
+		BinaryPathName: "cmd.exe /c whoami",
+		StartType:      3,
+		Dependencies:   []byte{1, 2, 3},
+	}
+
+	for _, codec := range []struct {
+		Name    string
+		Marshal func(ndr.Marshaler, ...any) ([]byte, error)
+		Opts    []any
+	}{
+		{"NDR20", ndr.Marshal, nil},
+		{"NDR64", ndr.Marshal64, []any{NDR64}},
+	} {
+
+		t.Run(codec.Name, func(t *testing.T) {
+
+			stub, err := codec.Marshal(req)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			root, err := d.Decode("svcctl", 12, Request, stub, codec.Opts...)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+
+			if n := find(root, "ServiceName"); n == nil || n.Value != `"svc"` || n.Offset <= 0 {
+				t.Errorf("decode: unexpected ServiceName %+v", n)
+			}
+
+			if n := find(root, "DisplayName"); n == nil || !n.Pointer || n.ReferentID != 0 || n.Value != "NULL" {
+				t.Errorf("decode: unexpected DisplayName %+v", n)
+			}
+
+			if n := find(root, "Dependencies"); n == nil || !n.Pointer || n.ReferentID == 0 || n.PointeeOffset <= n.Offset || n.Value != "010203" {
+				t.Errorf("decode: unexpected Dependencies %+v", n)
+			}
+
+			if n := find(root, "Return"); n != nil {
+				t.Errorf("decode: unexpected response field in request %+v", n)
+			}
+
+			// the truncated stub.
+			var buf bytes.Buffer
+			if err := d.Dump(&buf, "svcctl", 12, Request, stub[:len(stub)-8], codec.Opts...); err == nil {
+				t.Fatalf("dump: expected error for truncated stub")
+			}
+
+			if !strings.Contains(buf.String(), `ServiceName: string = "svc"`) {
+				t.Errorf("dump: expected partial tree, got\n%s", buf.String())
+			}
+		})
+	}
+
+	if _, err := d.Decode("svcctl", 1000, Request, nil); err == nil {
+		t.Errorf("decode: expected error for unknown operation")
+	}
+}
@@ -0,0 +1,320 @@
+package ndrdump
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// maxBytes is the maximum number of bytes printed for the byte array.
+const maxBytes = 32
+
+// Node structure represents the decoded value.
+type Node struct {
+	// The field name (or the element index).
+	Name string `json:"name"`
+	// The value type.
+	Type string `json:"type"`
+	// The formatted value (for the primitive types, strings, byte arrays
+	// and null pointers).
+	Value string `json:"value,omitempty"`
+	// The stub offset of the value, for the pointers the offset of the
+	// referent identifier (-1 if unknown).
+	Offset int `json:"offset"`
+	// The pointer flag.
+	Pointer bool `json:"pointer,omitempty"`
+	// The referent identifier.
+	ReferentID uint64 `json:"referent_id,omitempty"`
+	// The stub offset of the pointee (-1 if unknown).
+	PointeeOffset int `json:"pointee_offset"`
+	// The structure fields or the array elements.
+	Children []*Node `json:"children,omitempty"`
+	// The number of the trailing (not decoded) bytes for the root node.
+	Trailing int `json:"trailing,omitempty"`
+}
+
+// String function returns the decoded tree as the text, one value per line.
+func (n *Node) String() string {
+	var b strings.Builder
+	n.format(&b, 0)
+	if n.Trailing > 0 {
+		fmt.Fprintf(&b, "trailing %d bytes\n", n.Trailing)
+	}
+	return b.String()
+}
+
+func (n *Node) format(b *strings.Builder, depth int) {
+
+	if n.Offset >= 0 {
+		fmt.Fprintf(b, "@%04x  ", n.Offset)
+	} else {
+		b.WriteString("       ")
+	}
+
+	b.WriteString(strings.Repeat("  ", depth))
+
+	if depth == 0 {
+		b.WriteString(n.Name + " " + n.Type)
+	} else {
+		b.WriteString(n.Name + ": " + n.Type)
+	}
+
+	if n.Value != "" {
+		b.WriteString(" = " + n.Value)
+	}
+
+	if n.Pointer {
+		if fmt.Fprintf(b, " (ref 0x%08x", n.ReferentID); n.PointeeOffset >= 0 {
+			fmt.Fprintf(b, " @%04x", n.PointeeOffset)
+		}
+		b.WriteString(")")
+	}
+
+	b.WriteString("\n")
+
+	for _, child := range n.Children {
+		child.format(b, depth+1)
+	}
+}
+
+// walker structure builds the tree of the decoded value.
+type walker struct {
+	*tracer
+	// The pointers being walked (to detect the cycles).
+	stack map[uintptr]bool
+	// The addresses of the values with the known offsets.
+	claimed map[uintptr]bool
+	// The string nodes and values.
+	strs []*Node
+	vals []string
+}
+
+// root function returns the tree for the operation `op` fields that are
+// present in `fields` structure (the request or the response).
+func (t *tracer) root(op ndr.Operation, fields reflect.Type) *Node {
+
+	w := &walker{tracer: t, stack: make(map[uintptr]bool), claimed: make(map[uintptr]bool)}
+
+	root := &Node{Offset: -1, PointeeOffset: -1}
+
+	v := reflect.ValueOf(op).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Type().Field(i); f.IsExported() {
+			if _, ok := fields.FieldByName(f.Name); ok {
+				root.Children = append(root.Children, w.walk(v.Field(i), f.Name))
+			}
+		}
+	}
+
+	if len(root.Children) > 0 {
+		root.Offset = firstOffset(root.Children)
+	}
+
+	w.matchStrings()
+
+	return root
+}
+
+// firstOffset function returns the first known offset of the nodes.
+func firstOffset(nodes []*Node) int {
+	for _, n := range nodes {
+		if n.Offset >= 0 {
+			return n.Offset
+		}
+	}
+	return -1
+}
+
+func (w *walker) walk(v reflect.Value, name string) *Node {
+
+	n := &Node{Name: name, Type: v.Type().String(), Offset: -1, PointeeOffset: -1}
+
+	var addr uintptr
+	if v.CanAddr() {
+		addr = v.Addr().Pointer()
+		if ev, ok := w.ptrs[addr]; ok && ev.typ == v.Type() {
+			n.Pointer, n.ReferentID, n.Offset, n.PointeeOffset = true, ev.value, ev.off, ev.pointee
+			if ev.value == 0 {
+				n.Value = "NULL"
+				return n
+			}
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			n.Value = "NULL"
+			return n
+		}
+		if v.Kind() == reflect.Ptr {
+			if p := v.Pointer(); w.stack[p] {
+				n.Value = "(cycle)"
+				return n
+			} else {
+				w.stack[p] = true
+				defer delete(w.stack, p)
+			}
+		}
+		elem := w.walk(v.Elem(), name)
+		if v.Kind() == reflect.Interface {
+			n.Type = elem.Type
+		}
+		n.Value, n.Children = elem.Value, elem.Children
+		if n.Offset < 0 {
+			n.Offset = elem.Offset
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.IsExported() {
+				n.Children = append(n.Children, w.walk(v.Field(i), f.Name))
+			}
+		}
+		if n.Offset < 0 {
+			n.Offset = firstOffset(n.Children)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			for i := range b {
+				b[i] = byte(v.Index(i).Uint())
+				if v.Index(i).CanAddr() {
+					w.claimed[v.Index(i).Addr().Pointer()] = true
+				}
+			}
+			if n.Value = hex.EncodeToString(b[:min(len(b), maxBytes)]); len(b) > maxBytes {
+				n.Value += fmt.Sprintf("... (%d bytes)", len(b))
+			}
+			if len(b) > 0 && v.Index(0).CanAddr() {
+				if ev, ok := w.data[v.Index(0).Addr().Pointer()]; ok {
+					n.setOffset(ev.off)
+				}
+			}
+			if n.Value == "" {
+				n.Value = "[]"
+			}
+			break
+		}
+		for i := 0; i < v.Len(); i++ {
+			n.Children = append(n.Children, w.walk(v.Index(i), fmt.Sprintf("[%d]", i)))
+		}
+		if off := firstOffset(n.Children); off >= 0 {
+			n.setOffset(off)
+		}
+		if v.Len() == 0 {
+			n.Value = "[]"
+		}
+	case reflect.String:
+		n.Value = fmt.Sprintf("%q", v.String())
+		w.strs, w.vals = append(w.strs, n), append(w.vals, v.String())
+	case reflect.Bool:
+		n.Value = fmt.Sprintf("%t", v.Bool())
+		w.setDataOffset(n, addr)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		n.Value = fmt.Sprintf("%d", v.Int())
+		w.setDataOffset(n, addr)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		n.Value = fmt.Sprintf("%d (0x%x)", v.Uint(), v.Uint())
+		w.setDataOffset(n, addr)
+	case reflect.Float32, reflect.Float64:
+		n.Value = fmt.Sprintf("%g", v.Float())
+		w.setDataOffset(n, addr)
+	default:
+		n.Value = fmt.Sprintf("%v", v.Interface())
+	}
+
+	return n
+}
+
+// setOffset function sets the offset of the value, or of the pointee if the
+// node is the pointer.
+func (n *Node) setOffset(off int) {
+	if n.Pointer {
+		n.PointeeOffset = off
+	} else if n.Offset < 0 {
+		n.Offset = off
+	}
+}
+
+// setDataOffset function sets the offset of the primitive value at the
+// address `addr`.
+func (w *walker) setDataOffset(n *Node, addr uintptr) {
+	if addr == 0 {
+		return
+	}
+	if ev, ok := w.data[addr]; ok {
+		n.setOffset(ev.off)
+		w.claimed[addr] = true
+	}
+}
+
+// run structure represents the string decoded into the temporary buffer:
+// the conformance and variance followed by the characters.
+type run struct {
+	// The offset of the conformance.
+	off int
+	// The characters.
+	chars []uint16
+	// The wide characters flag.
+	wide bool
+	// The flag that indicates that the run was matched.
+	used bool
+}
+
+func (r *run) String() string {
+	var s string
+	if r.wide {
+		s = string(utf16.Decode(r.chars))
+	} else {
+		b := make([]byte, len(r.chars))
+		for i := range r.chars {
+			b[i] = byte(r.chars[i])
+		}
+		s = string(b)
+	}
+	return strings.TrimRight(s, ndr.ZeroString)
+}
+
+// matchStrings function assigns the offsets to the string nodes: the string
+// helpers decode the characters into the temporary buffer, so the strings are
+// matched by the value with the character runs in the decoding order.
+func (w *walker) matchStrings() {
+
+	var (
+		runs []*run
+		cur  *run
+	)
+
+	for _, ev := range w.events {
+		switch {
+		case ev.kind == eventSize:
+			if cur == nil || len(cur.chars) > 0 {
+				cur = &run{off: ev.off}
+				runs = append(runs, cur)
+			}
+		case cur != nil && ev.kind == eventData && !w.claimed[ev.addr] &&
+			(ev.typ.Kind() == reflect.Uint16 || ev.typ.Kind() == reflect.Uint8):
+			if len(cur.chars) == 0 {
+				cur.wide = ev.typ.Kind() == reflect.Uint16
+			}
+			cur.chars = append(cur.chars, uint16(ev.value))
+		default:
+			cur = nil
+		}
+	}
+
+	for i, n := range w.strs {
+		for _, r := range runs {
+			if r.used || r.off < n.PointeeOffset || r.String() != strings.TrimRight(w.vals[i], ndr.ZeroString) {
+				continue
+			}
+			r.used = true
+			n.setOffset(r.off)
+			break
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package ndrdump
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// eventKind is the kind of the decoding event.
+type eventKind int
+
+const (
+	// The primitive value (data, enum, union switch).
+	eventData eventKind = iota
+	// The conformance or variance value.
+	eventSize
+	// The pointer (referent identifier).
+	eventPointer
+)
+
+// event structure represents the single decoding step.
+type event struct {
+	// The event kind.
+	kind eventKind
+	// The stub offset.
+	off int
+	// The address and the type of the decoded value.
+	addr uintptr
+	typ  reflect.Type
+	// The decoded value (for primitive types).
+	value uint64
+	// The offset of the pointee (-1 if the pointee was not decoded).
+	pointee int
+}
+
+// tracer structure represents the NDR reader that records the decoding
+// events.
+type tracer struct {
+	ndr.Reader
+	// The stub data.
+	stub []byte
+	// The byte order.
+	order binary.ByteOrder
+	// The NDR64 flag.
+	is64 bool
+	// The decoding events in the decoding order.
+	events []*event
+	// The primitive value and pointer events by the value address.
+	data, ptrs map[uintptr]*event
+}
+
+func newTracer(r ndr.Reader, stub []byte, drep ndr.DataRepresentation, is64 bool) *tracer {
+	return &tracer{
+		Reader: r,
+		stub:   stub,
+		order:  drep.ByteOrder(),
+		is64:   is64,
+		data:   make(map[uintptr]*event),
+		ptrs:   make(map[uintptr]*event),
+	}
+}
+
+var (
+	int3264Type  = reflect.TypeOf(ndr.Int3264(0))
+	uint3264Type = reflect.TypeOf(ndr.Uint3264(0))
+)
+
+// target function returns the address and the type of the pointer `d`.
+func target(d any) (uintptr, reflect.Type, bool) {
+	v := reflect.ValueOf(d)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return 0, nil, false
+	}
+	return v.Pointer(), v.Type().Elem(), true
+}
+
+// size function returns the wire size of the primitive type `typ`.
+func (t *tracer) size(typ reflect.Type) int {
+	if typ == int3264Type || typ == uint3264Type {
+		if t.is64 {
+			return 8
+		}
+		return 4
+	}
+	switch typ.Kind() {
+	case reflect.Bool, reflect.Int8, reflect.Uint8, reflect.Int16, reflect.Uint16,
+		reflect.Int32, reflect.Uint32, reflect.Int64, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return int(typ.Size())
+	}
+	return 0
+}
+
+// value function returns the primitive value as uint64.
+func value(d any) uint64 {
+	v := reflect.ValueOf(d).Elem()
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	}
+	return 0
+}
+
+// record function records the primitive value read at offset `off`.
+func (t *tracer) record(kind eventKind, off int, d any) {
+
+	addr, typ, ok := target(d)
+	if !ok {
+		return
+	}
+
+	// skip the alignment padding.
+	if sz := t.size(typ); sz > 0 && t.Offset()-sz > off {
+		off = t.Offset() - sz
+	}
+
+	ev := &event{kind: kind, off: off, addr: addr, typ: typ, value: value(d), pointee: -1}
+	if t.events = append(t.events, ev); kind == eventData {
+		if _, ok := t.data[addr]; !ok {
+			t.data[addr] = ev
+		}
+	}
+}
+
+func (t *tracer) Unmarshal(ctx context.Context, d ndr.Unmarshaler) error {
+	return t.Reader.Unmarshal(ctx, ndr.UnmarshalNDRFunc(func(ctx context.Context, _ ndr.Reader) error {
+		return d.UnmarshalNDR(ctx, t)
+	}))
+}
+
+func (t *tracer) ReadData(d any) error {
+	off := t.Offset()
+	if err := t.Reader.ReadData(d); err != nil {
+		return err
+	}
+	t.record(eventData, off, d)
+	return nil
+}
+
+func (t *tracer) ReadSize(sz *uint64) error {
+	off := t.Offset()
+	if err := t.Reader.ReadSize(sz); err != nil {
+		return err
+	}
+	t.record(eventSize, off, sz)
+	return nil
+}
+
+func (t *tracer) ReadSwitch(d any) error {
+	off := t.Offset()
+	if err := t.Reader.ReadSwitch(d); err != nil {
+		return err
+	}
+	t.record(eventData, off, d)
+	return nil
+}
+
+func (t *tracer) ReadEnum(d any) error {
+	off := t.Offset()
+	if err := t.Reader.ReadEnum(d); err != nil {
+		return err
+	}
+	t.record(eventData, off, d)
+	return nil
+}
+
+func (t *tracer) ReadPointer(ptr ndr.Pointer, setter func(any), mrs ...ndr.Unmarshaler) error {
+
+	ev := &event{kind: eventPointer, off: t.Offset(), pointee: -1}
+
+	// the pointee is decoded with the tracer.
+	wrapped := make([]ndr.Unmarshaler, len(mrs))
+	for i := range mrs {
+		mr := mrs[i]
+		wrapped[i] = ndr.UnmarshalNDRFunc(func(ctx context.Context, _ ndr.Reader) error {
+			if ev.pointee < 0 {
+				ev.pointee = t.Offset()
+			}
+			return mr.UnmarshalNDR(ctx, t)
+		})
+	}
+
+	if err := t.Reader.ReadPointer(ptr, setter, wrapped...); err != nil {
+		return err
+	}
+
+	sz := 4
+	if t.is64 {
+		sz = 8
+	}
+
+	if end := t.Offset(); end-sz >= ev.off && end <= len(t.stub) {
+		if ev.off = end - sz; t.is64 {
+			ev.value = t.order.Uint64(t.stub[ev.off:end])
+		} else {
+			ev.value = uint64(t.order.Uint32(t.stub[ev.off:end]))
+		}
+	}
+
+	if addr, typ, ok := target(ptr); ok {
+		ev.addr, ev.typ = addr, typ
+		t.ptrs[addr] = ev
+	}
+
+	t.events = append(t.events, ev)
+
+	return nil
+}
@@ -87,12 +87,20 @@ func (o *xxx_SecureChannelClient) {{ $m }}(ctx context.Context, in *{{ $m }}Requ
 		in = &{{ $m }}Request{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.{{ $m }}(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -17267,6 +17267,9 @@ func (o *ForestTrustBinaryData) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Buffer, _s_Buffer, _ptr_Buffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 131072); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17703,6 +17706,9 @@ func (o *ForestTrustInformation) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Entries, _s_Entries, _ptr_Entries); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RecordCount", o.RecordCount, 0, 4000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24981,6 +24987,9 @@ func (o *xxx_DatabaseRedoOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ChangeLogEntry", len(o.ChangeLogEntry), o.ChangeLogEntrySize, "ChangeLogEntrySize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27036,6 +27045,9 @@ func (o *xxx_ComputeServerDigestOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Message", len(o.Message), o.MessageSize, "MessageSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27384,6 +27396,9 @@ func (o *xxx_ComputeClientDigestOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Message", len(o.Message), o.MessageSize, "MessageSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29597,6 +29612,9 @@ func (o *xxx_SendToSAMOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("OpaqueBuffer", len(o.OpaqueBuffer), o.OpaqueBufferSize, "OpaqueBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29888,6 +29906,12 @@ func (o *xxx_AddressToSiteNamesWOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("EntryCount", o.EntryCount, 0, 32000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SocketAddresses", len(o.SocketAddresses), o.EntryCount, "EntryCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -31194,6 +31218,12 @@ func (o *xxx_AddressToSiteNamesExWOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("EntryCount", o.EntryCount, 0, 32000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SocketAddresses", len(o.SocketAddresses), o.EntryCount, "EntryCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/oiweiwei/go-msrpc/dcerpc"
@@ -21,6 +22,8 @@ type LogonSecureChannelClient interface {
 
 type xxx_SecureChannelClient struct {
 	LogonClient
+	// the authenticator computation must be serialized.
+	mu    sync.Mutex
 	sCred *netlogon.SecureCredential
 }
 
@@ -44,13 +47,8 @@ func NewSecureChannelClient(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.
 	}
 
 	cfg := &netlogon.Config{
-		Capabilities:    netlogon.CapAES_SHA2 | netlogon.CapStrongKey | netlogon.CapSecureRPC | netlogon.CapRC4,
-		Credential:      creds,
-		ClientChallenge: make([]byte, 8),
-	}
-
-	if _, err := rand.Read(cfg.ClientChallenge); err != nil {
-		return nil, fmt.Errorf("secure_channel: %v", err)
+		Capabilities: netlogon.CapAES_SHA2 | netlogon.CapStrongKey | netlogon.CapSecureRPC | netlogon.CapRC4,
+		Credential:   creds,
 	}
 
 	if creds.Workstation() == "" {
@@ -65,9 +63,42 @@ func NewSecureChannelClient(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.
 		return nil, fmt.Errorf("secure_channel: dc_name: %v", err)
 	}
 
+	sCred, err := authenticate(ctx, cli, dc.DomainControllerInfo.DomainControllerName, cfg)
+	if err != nil && cfg.Capabilities != 0 {
+		// the server rejected the proposed capabilities, retry with the
+		// negotiated ones (the session key depends on the capabilities).
+		sCred, err = authenticate(ctx, cli, dc.DomainControllerInfo.DomainControllerName, cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// upgrade to secure channel.
+	if err := cli.AlterContext(ctx, append(opts, dcerpc.WithSecurityConfig(cfg))...); err != nil {
+		return nil, fmt.Errorf("secure_channel: %v", err)
+	}
+
+	return &xxx_SecureChannelClient{
+		LogonClient: cli,
+		sCred:       sCred,
+	}, nil
+}
+
+// authenticate function performs the challenge/response authentication with
+// the client capabilities `cfg.Capabilities` and returns the secure credential.
+// On failure, the `cfg.Capabilities` are set to the capabilities negotiated by
+// the server (if they differ from the proposed ones, otherwise to zero).
+func authenticate(ctx context.Context, cli LogonClient, dcName string, cfg *netlogon.Config) (*netlogon.SecureCredential, error) {
+
+	cfg.ClientChallenge = make([]byte, 8)
+
+	if _, err := rand.Read(cfg.ClientChallenge); err != nil {
+		return nil, fmt.Errorf("secure_channel: %v", err)
+	}
+
 	chal, err := cli.RequestChallenge(ctx, &RequestChallengeRequest{
-		PrimaryName:     dc.DomainControllerInfo.DomainControllerName,
-		ComputerName:    creds.Workstation(),
+		PrimaryName:     dcName,
+		ComputerName:    cfg.Credential.Workstation(),
 		ClientChallenge: &Credential{Data: cfg.ClientChallenge},
 	})
 	if err != nil {
@@ -87,14 +118,19 @@ func NewSecureChannelClient(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.
 	}
 
 	auth3, err := cli.Authenticate3(ctx, &Authenticate3Request{
-		PrimaryName:       dc.DomainControllerInfo.DomainControllerName,
-		AccountName:       creds.UserName(),
+		PrimaryName:       dcName,
+		AccountName:       cfg.Credential.UserName(),
 		SecureChannelType: SecureChannelTypeWorkstationSecureChannel,
-		ComputerName:      creds.Workstation(),
+		ComputerName:      cfg.Credential.Workstation(),
 		ClientCredential:  &Credential{Data: clientCred},
 		NegotiateFlags:    uint32(cfg.Capabilities),
 	})
 	if err != nil {
+		if auth3 != nil && cfg.Capabilities&netlogon.Cap(auth3.NegotiateFlags) != cfg.Capabilities {
+			cfg.Capabilities &= netlogon.Cap(auth3.NegotiateFlags)
+		} else {
+			cfg.Capabilities = 0
+		}
 		return nil, fmt.Errorf("secure_channel: auth3: %v", err)
 	}
 
@@ -103,19 +139,19 @@ func NewSecureChannelClient(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.
 		return nil, fmt.Errorf("secure_channel: auth3: server_credentials: %v", err)
 	}
 
-	if !bytes.Equal(expServerCred, auth3.ServerCredential.Data) {
+	if auth3.ServerCredential == nil || !bytes.Equal(expServerCred, auth3.ServerCredential.Data) {
 		return nil, fmt.Errorf("secure_channel: auth3: invalid server credentials")
 	}
 
-	// upgrade to secure channel.
-	if err := cli.AlterContext(ctx, append(opts, dcerpc.WithSecurityConfig(cfg))...); err != nil {
-		return nil, fmt.Errorf("secure_channel: %v", err)
+	keyCaps := netlogon.Cap(netlogon.CapAES_SHA2 | netlogon.CapStrongKey)
+
+	if negotiated := cfg.Capabilities & netlogon.Cap(auth3.NegotiateFlags); negotiated&keyCaps == cfg.Capabilities&keyCaps {
+		// use the negotiated capabilities for the secure channel (the
+		// session key algorithm must not change).
+		cfg.Capabilities = negotiated
 	}
 
-	return &xxx_SecureChannelClient{
-		LogonClient: cli,
-		sCred:       sCred,
-	}, nil
+	return sCred, nil
 }
 
 func (o *xxx_SecureChannelClient) Encrypt(ctx context.Context, b []byte) ([]byte, error) {
@@ -123,9 +159,27 @@ func (o *xxx_SecureChannelClient) Encrypt(ctx context.Context, b []byte) ([]byte
 }
 
 func (o *xxx_SecureChannelClient) VerifyAuthenticator(ctx context.Context, ra *Authenticator) error {
+	if !o.IsAuthenticatorSet(ra) {
+		return fmt.Errorf("return authenticator is missing")
+	}
 	return o.sCred.Verify(ctx, 1, ra.Credential.Data)
 }
 
+// IsAuthenticatorSet function returns true if the return authenticator
+// contains the credential. (The server does not set the return authenticator
+// if the authenticator verification fails).
+func (o *xxx_SecureChannelClient) IsAuthenticatorSet(ra *Authenticator) bool {
+	if ra == nil || ra.Credential == nil {
+		return false
+	}
+	for _, b := range ra.Credential.Data {
+		if b != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *xxx_SecureChannelClient) SetAuthenticators(ctx context.Context, a, ra **Authenticator) error {
 
 	var err error
@@ -13,12 +13,20 @@ func (o *xxx_SecureChannelClient) AccountDeltas(ctx context.Context, in *Account
 		in = &AccountDeltasRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.AccountDeltas(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -35,12 +43,20 @@ func (o *xxx_SecureChannelClient) AccountSync(ctx context.Context, in *AccountSy
 		in = &AccountSyncRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.AccountSync(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -57,12 +73,20 @@ func (o *xxx_SecureChannelClient) ChainSetClientAttributes(ctx context.Context,
 		in = &ChainSetClientAttributesRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.ChainSetClientAttributes(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -79,12 +103,20 @@ func (o *xxx_SecureChannelClient) DatabaseDeltas(ctx context.Context, in *Databa
 		in = &DatabaseDeltasRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.DatabaseDeltas(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -101,12 +133,20 @@ func (o *xxx_SecureChannelClient) DatabaseRedo(ctx context.Context, in *Database
 		in = &DatabaseRedoRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.DatabaseRedo(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -123,12 +163,20 @@ func (o *xxx_SecureChannelClient) DatabaseSync(ctx context.Context, in *Database
 		in = &DatabaseSyncRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.DatabaseSync(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -145,12 +193,20 @@ func (o *xxx_SecureChannelClient) DatabaseSync2(ctx context.Context, in *Databas
 		in = &DatabaseSync2Request{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.DatabaseSync2(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -167,12 +223,20 @@ func (o *xxx_SecureChannelClient) GetCapabilities(ctx context.Context, in *GetCa
 		in = &GetCapabilitiesRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.GetCapabilities(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -189,12 +253,20 @@ func (o *xxx_SecureChannelClient) GetDomainInfo(ctx context.Context, in *GetDoma
 		in = &GetDomainInfoRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.GetDomainInfo(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -211,12 +283,20 @@ func (o *xxx_SecureChannelClient) SAMLogoff(ctx context.Context, in *SAMLogoffRe
 		in = &SAMLogoffRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.SAMLogoff(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -233,12 +313,20 @@ func (o *xxx_SecureChannelClient) SAMLogon(ctx context.Context, in *SAMLogonRequ
 		in = &SAMLogonRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.SAMLogon(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -255,12 +343,20 @@ func (o *xxx_SecureChannelClient) SAMLogonWithFlags(ctx context.Context, in *SAM
 		in = &SAMLogonWithFlagsRequest{}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if err := o.SetAuthenticators(ctx, &in.Authenticator, &in.ReturnAuthenticator); err != nil {
 		return nil, fmt.Errorf("%s: %v", in.xxx_ToOp(ctx).OpName(), err)
 	}
 
 	ret, err := o.LogonClient.SAMLogonWithFlags(ctx, in, opts...)
 	if err != nil {
+		if ret != nil && o.IsAuthenticatorSet(ret.ReturnAuthenticator) {
+			// the server has updated the stored credential, keep the client
+			// credential in sync.
+			o.VerifyAuthenticator(ctx, ret.ReturnAuthenticator)
+		}
 		return nil, err
 	}
 
@@ -468,6 +468,9 @@ func (o *PropertyTagArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PropertyTag", len(o.PropertyTag), 0, 100001); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -577,6 +580,9 @@ func (o *Binary) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Value, _s_lpb, _ptr_lpb); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 2097152); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -686,6 +692,9 @@ func (o *ShortArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Values, _s_lpi, _ptr_lpi); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -795,6 +804,9 @@ func (o *LongArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Values, _s_lpl, _ptr_lpl); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -923,6 +935,9 @@ func (o *CharStringArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Values, _s_lppszA, _ptr_lppszA); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1041,6 +1056,9 @@ func (o *BinaryArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Values, _s_lpbin, _ptr_lpbin); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1178,6 +1196,9 @@ func (o *FlatUIDArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.GUID, _s_lpguid, _ptr_lpguid); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1306,6 +1327,9 @@ func (o *StringArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Values, _s_lppszW, _ptr_lppszW); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1424,6 +1448,9 @@ func (o *DateTimeArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Values, _s_lpft, _ptr_lpft); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1635,6 +1662,9 @@ func (o *PropertyRow) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Properties, _s_lpProps, _ptr_lpProps); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ValuesCount", o.ValuesCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1751,6 +1781,12 @@ func (o *PropertyRowSet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckRange("RowsCount", o.RowsCount, 0, 100000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Row", len(o.Row), o.RowsCount, "RowsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1944,6 +1980,9 @@ func (o *AndRestriction) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Restriction, _s_lpRes, _ptr_lpRes); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RestrictionCount", o.RestrictionCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2057,6 +2096,9 @@ func (o *OrRestriction) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Restriction, _s_lpRes, _ptr_lpRes); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RestrictionCount", o.RestrictionCount, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3415,6 +3457,12 @@ func (o *PropertyNameSet) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckRange("NamesCount", o.NamesCount, 0, 100000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.NamesCount, "NamesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3551,6 +3599,9 @@ func (o *CharStringsArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 	if err := w.ReadPointer(&o.Strings, _s_Strings, _ptr_Strings); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3687,6 +3738,9 @@ func (o *StringsArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Strings, _s_Strings, _ptr_Strings); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 100000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6198,6 +6252,12 @@ func (o *xxx_QueryRowsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ETableCount", o.ETableCount, 0, 100000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ETable", len(o.ETable), o.ETableCount, "ETableCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10651,6 +10711,12 @@ func (o *xxx_GetIDsFromNamesOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("PropertyNamesCount", o.PropertyNamesCount, 0, 100000); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.PropertyNamesCount, "PropertyNamesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
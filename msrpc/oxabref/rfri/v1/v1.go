@@ -598,6 +598,9 @@ func (o *xxx_GetFQDNFromServerDNOperation) UnmarshalNDRRequest(ctx context.Conte
 		}
 		o.MailboxServerDN = strings.TrimRight(string(_MailboxServerDN_buf), ndr.ZeroString)
 	}
+	if err := ndr.CheckRange("MailboxServerDNLength", o.MailboxServerDNLength, 10, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -719,6 +719,12 @@ func (o *xxx_RegisterPushNotificationOperation) UnmarshalNDRRequest(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Context", len(o.Context), o.ContextLength, "ContextLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("CallbackAddress", len(o.CallbackAddress), o.CallbackAddressLength, "CallbackAddressLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1322,6 +1328,9 @@ func (o *xxx_DoConnectExOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AuxIn", len(o.AuxIn), o.AuxInLength, "AuxInLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1646,6 +1655,9 @@ func (o *xxx_DoConnectExOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AuxOut", len(o.AuxOut), o.AuxOutLength, "AuxOutLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2190,6 +2202,12 @@ func (o *xxx_DoRPCExt2Operation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("In", len(o.In), o.InLength, "InLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AuxIn", len(o.AuxIn), o.AuxInLength, "AuxInLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2433,6 +2451,12 @@ func (o *xxx_DoRPCExt2Operation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Out", len(o.Out), o.OutLength, "OutLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("AuxOut", len(o.AuxOut), o.AuxOutLength, "AuxOutLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
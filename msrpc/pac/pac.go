@@ -1963,6 +1963,9 @@ func (o *PACCredentialData) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Credentials", len(o.Credentials), o.CredentialCount, "CredentialCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
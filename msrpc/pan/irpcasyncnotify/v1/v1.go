@@ -1410,6 +1410,9 @@ func (o *xxx_GetNewChannelOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ChannelContext", len(o.ChannelContext), o.NumberOfChannels, "NumberOfChannels"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1680,6 +1683,9 @@ func (o *xxx_GetNotificationSendResponseOperation) UnmarshalNDRRequest(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InNotificationData", len(o.InNotificationData), o.InSize, "InSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1864,6 +1870,9 @@ func (o *xxx_GetNotificationSendResponseOperation) UnmarshalNDRResponse(ctx cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("OutNotificationData", len(o.OutNotificationData), o.OutSize, "OutSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2213,6 +2222,9 @@ func (o *xxx_GetNotificationOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("OutNotificationData", len(o.OutNotificationData), o.OutSize, "OutSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2462,6 +2474,9 @@ func (o *xxx_CloseChannelOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Reason", len(o.Reason), o.InSize, "InSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
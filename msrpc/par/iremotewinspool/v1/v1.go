@@ -12013,6 +12013,9 @@ func (o *BIDIRequestContainer) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12130,6 +12133,9 @@ func (o *BIDIResponseContainer) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13546,6 +13552,9 @@ func (o *V2NotifyInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14847,6 +14856,9 @@ func (o *BranchOfficeJobDataContainer) UnmarshalNDR(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("JobData", len(o.JobData), o.JobDataEntriesCount, "JobDataEntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15951,6 +15963,9 @@ func (o *PrintPropertiesCollection) UnmarshalNDR(ctx context.Context, w ndr.Read
 	if err := w.ReadPointer(&o.PropertiesCollection, _s_propertiesCollection, _ptr_propertiesCollection); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("NumberOfProperties", o.NumberOfProperties, 0, 50); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18612,6 +18627,9 @@ func (o *xxx_GetJobOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Job", len(o.Job), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18990,6 +19008,9 @@ func (o *xxx_EnumJobsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Job", len(o.Job), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19359,6 +19380,9 @@ func (o *xxx_AddJobOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AddJob", len(o.AddJob), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20284,6 +20308,9 @@ func (o *xxx_GetPrinterOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrinterBuffer", len(o.PrinterBuffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20947,6 +20974,9 @@ func (o *xxx_WritePrinterOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22210,6 +22240,9 @@ func (o *xxx_SetPrinterDataOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22475,6 +22508,9 @@ func (o *xxx_SetPrinterDataExOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23279,6 +23315,9 @@ func (o *xxx_GetFormOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Form", len(o.Form), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23828,6 +23867,9 @@ func (o *xxx_EnumFormsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Form", len(o.Form), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24259,6 +24301,9 @@ func (o *xxx_GetPrinterDriverOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Driver", len(o.Driver), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26040,6 +26085,9 @@ func (o *xxx_XcvDataOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InputData", len(o.InputData), o.InputDataLength, "InputDataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26857,6 +26905,9 @@ func (o *xxx_PlayGDIScriptOnPrinterICOperation) UnmarshalNDRRequest(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("In", len(o.In), o.InCount, "InCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27378,6 +27429,9 @@ func (o *xxx_EnumPrintersOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrinterEnum", len(o.PrinterEnum), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -28012,6 +28066,9 @@ func (o *xxx_EnumPrinterDriversOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Drivers", len(o.Drivers), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -28432,6 +28489,9 @@ func (o *xxx_GetPrinterDriverDirectoryOperation) UnmarshalNDRRequest(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DriverDirectory", len(o.DriverDirectory), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29480,6 +29540,9 @@ func (o *xxx_EnumPrintProcessorsOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrintProcessorInfo", len(o.PrintProcessorInfo), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29900,6 +29963,9 @@ func (o *xxx_GetPrintProcessorDirectoryOperation) UnmarshalNDRRequest(ctx contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrintProcessorDirectory", len(o.PrintProcessorDirectory), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -30266,6 +30332,9 @@ func (o *xxx_EnumPortsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Port", len(o.Port), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -30646,6 +30715,9 @@ func (o *xxx_EnumMonitorsOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Monitor", len(o.Monitor), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -32183,6 +32255,9 @@ func (o *xxx_EnumPrintProcessorDataTypesOperation) UnmarshalNDRRequest(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DataTypes", len(o.DataTypes), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -32949,6 +33024,9 @@ func (o *xxx_EnumPerMachineConnectionsOperation) UnmarshalNDRRequest(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrinterEnum", len(o.PrinterEnum), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -37281,6 +37359,9 @@ func (o *xxx_EnumJobNamedPropertiesOperation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Properties", len(o.Properties), o.PropertiesCount, "PropertiesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
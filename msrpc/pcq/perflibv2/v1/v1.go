@@ -547,6 +547,9 @@ func (o *xxx_EnumerateCounterSetOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("InSize", o.InSize, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -876,6 +879,9 @@ func (o *xxx_QueryCounterSetRegistrationInfoOperation) UnmarshalNDRRequest(ctx c
 			return err
 		}
 	}
+	if err := ndr.CheckRange("InSize", o.InSize, 0, 134217728); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1214,6 +1220,9 @@ func (o *xxx_EnumerateCounterSetInstancesOperation) UnmarshalNDRRequest(ctx cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("InSize", o.InSize, 0, 67108864); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1881,6 +1890,9 @@ func (o *xxx_QueryCounterInfoOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("InSize", o.InSize, 0, 67108864); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2161,6 +2173,9 @@ func (o *xxx_QueryCounterDataOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("InSize", o.InSize, 0, 1073741824); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2500,6 +2515,12 @@ func (o *xxx_ValidateCountersOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("InSize", o.InSize, 0, 67108864); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.InSize, "InSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -335,6 +335,9 @@ func (o *AccessRequest) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.ObjectTypeList, _s_ObjectTypeList, _ptr_ObjectTypeList); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ObjectTypeListLength", o.ObjectTypeListLength, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -445,6 +448,9 @@ func (o *SelfRelativeSecurityDescriptor) UnmarshalNDR(ctx context.Context, w ndr
 	if err := w.ReadPointer(&o.SelfRelativeSecurityDescriptor, _s_pSrSd, _ptr_pSrSd); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 20, 131228); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -624,6 +630,9 @@ func (o *AccessReply) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Error, _s_Error, _ptr_Error); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ResultListLength", o.ResultListLength, 0, 256); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -940,6 +949,9 @@ func (o *TokenGroups) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Groups", len(o.Groups), o.GroupCount, "GroupCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1074,6 +1086,9 @@ func (o *SecurityAttributeStringValue) UnmarshalNDR(ctx context.Context, w ndr.R
 	if err := w.ReadPointer(&o.Value, _s_Value, _ptr_Value); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 2, 32768); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1631,6 +1646,12 @@ func (o *SecurityAttributeV1) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 	if err := w.ReadPointer(&o.Values, _s_Values, _ptr_Values); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 2, 256); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ValueCount", o.ValueCount, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1771,6 +1792,9 @@ func (o *SecurityAttributesInformation) UnmarshalNDR(ctx context.Context, w ndr.
 	if err := w.ReadPointer(&o.Attributes, _s_Attributes, _ptr_Attributes); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("AttributeCount", o.AttributeCount, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3212,6 +3236,12 @@ func (o *xxx_AccessCheckOperation) UnmarshalNDRRequest(ctx context.Context, w nd
 			return err
 		}
 	}
+	if err := ndr.CheckRange("SecurityDescriptorCount", o.SecurityDescriptorCount, 1, 16); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SecurityDescriptors", len(o.SecurityDescriptors), o.SecurityDescriptorCount, "SecurityDescriptorCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3766,6 +3796,12 @@ func (o *xxx_ModifyClaimsOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("OperationCount", o.OperationCount, 1, 65535); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("ClaimOperations", len(o.ClaimOperations), o.OperationCount, "OperationCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4060,6 +4096,12 @@ func (o *xxx_ModifySIDsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckRange("OperationCount", o.OperationCount, 1, 65535); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("SIDOperations", len(o.SIDOperations), o.OperationCount, "OperationCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -470,6 +470,9 @@ func (o *BindingVector) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Binding", len(o.Binding), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -596,5 +599,8 @@ func (o *UUIDVector) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("UUID", len(o.UUID), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
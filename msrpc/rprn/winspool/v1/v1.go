@@ -12657,6 +12657,9 @@ func (o *BIDIRequestContainer) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12785,6 +12788,9 @@ func (o *BIDIResponseContainer) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14329,6 +14335,9 @@ func (o *V2NotifyInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.Count, "Count"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16507,6 +16516,9 @@ func (o *BranchOfficeJobDataContainer) UnmarshalNDR(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("JobData", len(o.JobData), o.JobDataEntriesCount, "JobDataEntriesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17853,6 +17865,9 @@ func (o *xxx_EnumPrintersOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrinterEnum", len(o.PrinterEnum), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18835,6 +18850,9 @@ func (o *xxx_GetJobOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Job", len(o.Job), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19223,6 +19241,9 @@ func (o *xxx_EnumJobsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Job", len(o.Job), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20330,6 +20351,9 @@ func (o *xxx_GetPrinterOperation) UnmarshalNDRRequest(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrinterBuffer", len(o.PrinterBuffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20967,6 +20991,9 @@ func (o *xxx_EnumPrinterDriversOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Drivers", len(o.Drivers), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21400,6 +21427,9 @@ func (o *xxx_GetPrinterDriverOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Driver", len(o.Driver), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21835,6 +21865,9 @@ func (o *xxx_GetPrinterDriverDirectoryOperation) UnmarshalNDRRequest(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DriverDirectory", len(o.DriverDirectory), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22672,6 +22705,9 @@ func (o *xxx_EnumPrintProcessorsOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrintProcessorInfo", len(o.PrintProcessorInfo), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23102,6 +23138,9 @@ func (o *xxx_GetPrintProcessorDirectoryOperation) UnmarshalNDRRequest(ctx contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrintProcessorDirectory", len(o.PrintProcessorDirectory), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -23780,6 +23819,9 @@ func (o *xxx_WritePrinterOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24749,6 +24791,9 @@ func (o *xxx_AddJobOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Rea
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AddJob", len(o.AddJob), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25533,6 +25578,9 @@ func (o *xxx_SetPrinterDataOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26541,6 +26589,9 @@ func (o *xxx_GetFormOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Form", len(o.Form), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27115,6 +27166,9 @@ func (o *xxx_EnumFormsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Form", len(o.Form), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27518,6 +27572,9 @@ func (o *xxx_EnumPortsOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Port", len(o.Port), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -27916,6 +27973,9 @@ func (o *xxx_EnumMonitorsOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Monitor", len(o.Monitor), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -28704,6 +28764,9 @@ func (o *xxx_PlayGDIScriptOnPrinterICOperation) UnmarshalNDRRequest(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("In", len(o.In), o.InCount, "InCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29909,6 +29972,9 @@ func (o *xxx_EnumPrintProcessorDataTypesOperation) UnmarshalNDRRequest(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("DataTypes", len(o.DataTypes), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -30580,6 +30646,9 @@ func (o *xxx_GetPrinterDriver2Operation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Driver", len(o.Driver), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -31167,6 +31236,12 @@ func (o *xxx_ReplyOpenPrinterOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -31468,6 +31543,12 @@ func (o *xxx_RouterReplyPrinterOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -32226,6 +32307,12 @@ func (o *xxx_RemoteFindFirstPrinterChangeNotificationOperation) UnmarshalNDRRequ
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 512); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -34789,6 +34876,9 @@ func (o *xxx_SetPrinterDataExOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -36933,6 +37023,9 @@ func (o *xxx_EnumPerMachineConnectionsOperation) UnmarshalNDRRequest(ctx context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("PrinterEnum", len(o.PrinterEnum), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -37301,6 +37394,9 @@ func (o *xxx_XcvDataOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Re
 			return err
 		}
 	}
+	if err := ndr.CheckSize("InputData", len(o.InputData), o.InputDataLength, "InputDataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -37938,6 +38034,9 @@ func (o *xxx_FlushPrinterOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -40008,6 +40107,9 @@ func (o *xxx_EnumJobNamedPropertiesOperation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Properties", len(o.Properties), o.PropertiesCount, "PropertiesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -40592,6 +40694,9 @@ func (o *xxx_CreateJobOnPrinterOperation) UnmarshalNDRRequest(ctx context.Contex
 			}
 		}
 	}
+	if err := ndr.CheckSize("JobAttributeGroupBuffer", len(o.JobAttributeGroupBuffer), o.JobAttributeGroupBufferSize, "JobAttributeGroupBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -40709,6 +40814,9 @@ func (o *xxx_CreateJobOnPrinterOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ResponseBuffer", len(o.ResponseBuffer), o.ResponseBufferSize, "ResponseBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -40945,6 +41053,9 @@ func (o *xxx_GetJobAttributesOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AttributeNames", len(o.AttributeNames), o.AttributeNameCount, "AttributeNameCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41062,6 +41173,9 @@ func (o *xxx_GetJobAttributesOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ResponseBuffer", len(o.ResponseBuffer), o.ResponseBufferSize, "ResponseBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41270,6 +41384,9 @@ func (o *xxx_SetJobAttributesOperation) UnmarshalNDRRequest(ctx context.Context,
 			}
 		}
 	}
+	if err := ndr.CheckSize("JobAttributeGroupBuffer", len(o.JobAttributeGroupBuffer), o.JobAttributeGroupBufferSize, "JobAttributeGroupBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41387,6 +41504,9 @@ func (o *xxx_SetJobAttributesOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ResponseBuffer", len(o.ResponseBuffer), o.ResponseBufferSize, "ResponseBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41609,6 +41729,9 @@ func (o *xxx_GetPrinterAttributesOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckSize("AttributeNames", len(o.AttributeNames), o.AttributeNameCount, "AttributeNameCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41726,6 +41849,9 @@ func (o *xxx_GetPrinterAttributesOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ResponseBuffer", len(o.ResponseBuffer), o.ResponseBufferSize, "ResponseBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41920,6 +42046,9 @@ func (o *xxx_SetPrinterAttributesOperation) UnmarshalNDRRequest(ctx context.Cont
 			}
 		}
 	}
+	if err := ndr.CheckSize("JobAttributeGroupBuffer", len(o.JobAttributeGroupBuffer), o.JobAttributeGroupBufferSize, "JobAttributeGroupBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -42037,6 +42166,9 @@ func (o *xxx_SetPrinterAttributesOperation) UnmarshalNDRResponse(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ResponseBuffer", len(o.ResponseBuffer), o.ResponseBufferSize, "ResponseBufferSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,41 @@
+package rrp
+
+import (
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// KeyAccess is the registry key access mask (MS-RRP 2.2.3).
+type KeyAccess uint32
+
+const (
+	KeyQueryValue       KeyAccess = 0x00000001
+	KeySetValue         KeyAccess = 0x00000002
+	KeyCreateSubKey     KeyAccess = 0x00000004
+	KeyEnumerateSubKeys KeyAccess = 0x00000008
+	KeyNotify           KeyAccess = 0x00000010
+	KeyCreateLink       KeyAccess = 0x00000020
+	KeyWOW6464Key       KeyAccess = 0x00000100
+	KeyWOW6432Key       KeyAccess = 0x00000200
+	KeyAllAccess        KeyAccess = 0x000F003F
+	KeyRead             KeyAccess = 0x00020019
+	KeyWrite            KeyAccess = 0x00020006
+	KeyExecute          KeyAccess = KeyRead
+)
+
+// KeyAccessRights is the registry key access rights.
+var KeyAccessRights = dtyp.AccessRights{
+	{Name: "KEY_ALL_ACCESS", Mask: uint32(KeyAllAccess)},
+	{Name: "KEY_READ", Mask: uint32(KeyRead)},
+	{Name: "KEY_WRITE", Mask: uint32(KeyWrite)},
+	{Name: "KEY_QUERY_VALUE", Mask: uint32(KeyQueryValue)},
+	{Name: "KEY_SET_VALUE", Mask: uint32(KeySetValue)},
+	{Name: "KEY_CREATE_SUB_KEY", Mask: uint32(KeyCreateSubKey)},
+	{Name: "KEY_ENUMERATE_SUB_KEYS", Mask: uint32(KeyEnumerateSubKeys)},
+	{Name: "KEY_NOTIFY", Mask: uint32(KeyNotify)},
+	{Name: "KEY_CREATE_LINK", Mask: uint32(KeyCreateLink)},
+	{Name: "KEY_WOW64_64KEY", Mask: uint32(KeyWOW6464Key)},
+	{Name: "KEY_WOW64_32KEY", Mask: uint32(KeyWOW6432Key)},
+}
+
+// Describe function returns the access mask description.
+func (m KeyAccess) Describe() string { return KeyAccessRights.Describe(uint32(m)) }
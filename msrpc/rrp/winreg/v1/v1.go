@@ -4609,6 +4609,9 @@ func (o *xxx_BaseRegEnumValueOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Data", len(o.Data), 0, 67108864); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4860,6 +4863,9 @@ func (o *xxx_BaseRegEnumValueOperation) UnmarshalNDRResponse(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Data", len(o.Data), 0, 67108864); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6513,6 +6519,9 @@ func (o *xxx_BaseRegQueryValueOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Data", len(o.Data), 0, 67108864); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6737,6 +6746,9 @@ func (o *xxx_BaseRegQueryValueOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Data", len(o.Data), 0, 67108864); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7948,6 +7960,9 @@ func (o *xxx_BaseRegSetValueOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Data", len(o.Data), o.DataLength, "DataLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -8896,6 +8911,12 @@ func (o *xxx_BaseRegQueryMultipleValuesOperation) UnmarshalNDRRequest(ctx contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ValueListIn", len(o.ValueListIn), o.ValsLength, "ValsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.TotalSize, "TotalSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -9116,6 +9137,9 @@ func (o *xxx_BaseRegQueryMultipleValuesOperation) UnmarshalNDRResponse(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.TotalSize, "TotalSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10174,6 +10198,12 @@ func (o *xxx_BaseRegQueryMultipleValues2Operation) UnmarshalNDRRequest(ctx conte
 			return err
 		}
 	}
+	if err := ndr.CheckSize("ValueListIn", len(o.ValueListIn), o.ValsLength, "ValsLength"); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.TotalSize, "TotalSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,192 @@
+package samr
+
+import (
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// ServerAccess is the server object access mask (MS-SAMR 2.2.1.3).
+type ServerAccess uint32
+
+const (
+	ServerConnect          ServerAccess = 0x00000001
+	ServerShutdown         ServerAccess = 0x00000002
+	ServerInitialize       ServerAccess = 0x00000004
+	ServerCreateDomain     ServerAccess = 0x00000008
+	ServerEnumerateDomains ServerAccess = 0x00000010
+	ServerLookupDomain     ServerAccess = 0x00000020
+	ServerAllAccess        ServerAccess = 0x000F003F
+	ServerReadGeneric      ServerAccess = 0x00020010
+	ServerWriteGeneric     ServerAccess = 0x0002000E
+	ServerExecuteGeneric   ServerAccess = 0x00020021
+)
+
+// ServerAccessRights is the server object access rights.
+var ServerAccessRights = dtyp.AccessRights{
+	{Name: "SAM_SERVER_ALL_ACCESS", Mask: uint32(ServerAllAccess)},
+	{Name: "SAM_SERVER_READ", Mask: uint32(ServerReadGeneric)},
+	{Name: "SAM_SERVER_WRITE", Mask: uint32(ServerWriteGeneric)},
+	{Name: "SAM_SERVER_EXECUTE", Mask: uint32(ServerExecuteGeneric)},
+	{Name: "SAM_SERVER_CONNECT", Mask: uint32(ServerConnect)},
+	{Name: "SAM_SERVER_SHUTDOWN", Mask: uint32(ServerShutdown)},
+	{Name: "SAM_SERVER_INITIALIZE", Mask: uint32(ServerInitialize)},
+	{Name: "SAM_SERVER_CREATE_DOMAIN", Mask: uint32(ServerCreateDomain)},
+	{Name: "SAM_SERVER_ENUMERATE_DOMAINS", Mask: uint32(ServerEnumerateDomains)},
+	{Name: "SAM_SERVER_LOOKUP_DOMAIN", Mask: uint32(ServerLookupDomain)},
+}
+
+// Describe function returns the access mask description.
+func (m ServerAccess) Describe() string { return ServerAccessRights.Describe(uint32(m)) }
+
+// DomainAccess is the domain object access mask (MS-SAMR 2.2.1.4).
+type DomainAccess uint32
+
+const (
+	DomainReadPasswordParameters  DomainAccess = 0x00000001
+	DomainWritePasswordParameters DomainAccess = 0x00000002
+	DomainReadOtherParameters     DomainAccess = 0x00000004
+	DomainWriteOtherParameters    DomainAccess = 0x00000008
+	DomainCreateUser              DomainAccess = 0x00000010
+	DomainCreateGroup             DomainAccess = 0x00000020
+	DomainCreateAlias             DomainAccess = 0x00000040
+	DomainGetAliasMembership      DomainAccess = 0x00000080
+	DomainListAccounts            DomainAccess = 0x00000100
+	DomainLookup                  DomainAccess = 0x00000200
+	DomainAdministerServer        DomainAccess = 0x00000400
+	DomainAllAccess               DomainAccess = 0x000F07FF
+	DomainReadGeneric             DomainAccess = 0x00020084
+	DomainWriteGeneric            DomainAccess = 0x0002047A
+	DomainExecuteGeneric          DomainAccess = 0x00020301
+)
+
+// DomainAccessRights is the domain object access rights.
+var DomainAccessRights = dtyp.AccessRights{
+	{Name: "DOMAIN_ALL_ACCESS", Mask: uint32(DomainAllAccess)},
+	{Name: "DOMAIN_READ", Mask: uint32(DomainReadGeneric)},
+	{Name: "DOMAIN_WRITE", Mask: uint32(DomainWriteGeneric)},
+	{Name: "DOMAIN_EXECUTE", Mask: uint32(DomainExecuteGeneric)},
+	{Name: "DOMAIN_READ_PASSWORD_PARAMETERS", Mask: uint32(DomainReadPasswordParameters)},
+	{Name: "DOMAIN_WRITE_PASSWORD_PARAMS", Mask: uint32(DomainWritePasswordParameters)},
+	{Name: "DOMAIN_READ_OTHER_PARAMETERS", Mask: uint32(DomainReadOtherParameters)},
+	{Name: "DOMAIN_WRITE_OTHER_PARAMETERS", Mask: uint32(DomainWriteOtherParameters)},
+	{Name: "DOMAIN_CREATE_USER", Mask: uint32(DomainCreateUser)},
+	{Name: "DOMAIN_CREATE_GROUP", Mask: uint32(DomainCreateGroup)},
+	{Name: "DOMAIN_CREATE_ALIAS", Mask: uint32(DomainCreateAlias)},
+	{Name: "DOMAIN_GET_ALIAS_MEMBERSHIP", Mask: uint32(DomainGetAliasMembership)},
+	{Name: "DOMAIN_LIST_ACCOUNTS", Mask: uint32(DomainListAccounts)},
+	{Name: "DOMAIN_LOOKUP", Mask: uint32(DomainLookup)},
+	{Name: "DOMAIN_ADMINISTER_SERVER", Mask: uint32(DomainAdministerServer)},
+}
+
+// Describe function returns the access mask description.
+func (m DomainAccess) Describe() string { return DomainAccessRights.Describe(uint32(m)) }
+
+// GroupAccess is the group object access mask (MS-SAMR 2.2.1.5).
+type GroupAccess uint32
+
+const (
+	GroupReadInformation GroupAccess = 0x00000001
+	GroupWriteAccount    GroupAccess = 0x00000002
+	GroupAddMember       GroupAccess = 0x00000004
+	GroupRemoveMember    GroupAccess = 0x00000008
+	GroupListMembers     GroupAccess = 0x00000010
+	GroupAllAccess       GroupAccess = 0x000F001F
+	GroupReadGeneric     GroupAccess = 0x00020010
+	GroupWriteGeneric    GroupAccess = 0x0002000E
+	GroupExecuteGeneric  GroupAccess = 0x00020001
+)
+
+// GroupAccessRights is the group object access rights.
+var GroupAccessRights = dtyp.AccessRights{
+	{Name: "GROUP_ALL_ACCESS", Mask: uint32(GroupAllAccess)},
+	{Name: "GROUP_READ", Mask: uint32(GroupReadGeneric)},
+	{Name: "GROUP_WRITE", Mask: uint32(GroupWriteGeneric)},
+	{Name: "GROUP_EXECUTE", Mask: uint32(GroupExecuteGeneric)},
+	{Name: "GROUP_READ_INFORMATION", Mask: uint32(GroupReadInformation)},
+	{Name: "GROUP_WRITE_ACCOUNT", Mask: uint32(GroupWriteAccount)},
+	{Name: "GROUP_ADD_MEMBER", Mask: uint32(GroupAddMember)},
+	{Name: "GROUP_REMOVE_MEMBER", Mask: uint32(GroupRemoveMember)},
+	{Name: "GROUP_LIST_MEMBERS", Mask: uint32(GroupListMembers)},
+}
+
+// Describe function returns the access mask description.
+func (m GroupAccess) Describe() string { return GroupAccessRights.Describe(uint32(m)) }
+
+// AliasAccess is the alias object access mask (MS-SAMR 2.2.1.6).
+type AliasAccess uint32
+
+const (
+	AliasAddMember       AliasAccess = 0x00000001
+	AliasRemoveMember    AliasAccess = 0x00000002
+	AliasListMembers     AliasAccess = 0x00000004
+	AliasReadInformation AliasAccess = 0x00000008
+	AliasWriteAccount    AliasAccess = 0x00000010
+	AliasAllAccess       AliasAccess = 0x000F001F
+	AliasReadGeneric     AliasAccess = 0x00020004
+	AliasWriteGeneric    AliasAccess = 0x00020013
+	AliasExecuteGeneric  AliasAccess = 0x00020008
+)
+
+// AliasAccessRights is the alias object access rights.
+var AliasAccessRights = dtyp.AccessRights{
+	{Name: "ALIAS_ALL_ACCESS", Mask: uint32(AliasAllAccess)},
+	{Name: "ALIAS_READ", Mask: uint32(AliasReadGeneric)},
+	{Name: "ALIAS_WRITE", Mask: uint32(AliasWriteGeneric)},
+	{Name: "ALIAS_EXECUTE", Mask: uint32(AliasExecuteGeneric)},
+	{Name: "ALIAS_ADD_MEMBER", Mask: uint32(AliasAddMember)},
+	{Name: "ALIAS_REMOVE_MEMBER", Mask: uint32(AliasRemoveMember)},
+	{Name: "ALIAS_LIST_MEMBERS", Mask: uint32(AliasListMembers)},
+	{Name: "ALIAS_READ_INFORMATION", Mask: uint32(AliasReadInformation)},
+	{Name: "ALIAS_WRITE_ACCOUNT", Mask: uint32(AliasWriteAccount)},
+}
+
+// Describe function returns the access mask description.
+func (m AliasAccess) Describe() string { return AliasAccessRights.Describe(uint32(m)) }
+
+// UserAccess is the user object access mask (MS-SAMR 2.2.1.7):
+//
+//	access := samr.UserReadGeneric | samr.UserForcePasswordChange
+//
+//	fmt.Println(access.Describe()) // USER_READ|USER_FORCE_PASSWORD_CHANGE
+//
+//	req.DesiredAccess = uint32(access)
+type UserAccess uint32
+
+const (
+	UserReadGeneral           UserAccess = 0x00000001
+	UserReadPreferences       UserAccess = 0x00000002
+	UserWritePreferences      UserAccess = 0x00000004
+	UserReadLogon             UserAccess = 0x00000008
+	UserReadAccount           UserAccess = 0x00000010
+	UserWriteAccount          UserAccess = 0x00000020
+	UserChangePassword        UserAccess = 0x00000040
+	UserForcePasswordChange   UserAccess = 0x00000080
+	UserListGroups            UserAccess = 0x00000100
+	UserReadGroupInformation  UserAccess = 0x00000200
+	UserWriteGroupInformation UserAccess = 0x00000400
+	UserAllAccess             UserAccess = 0x000F07FF
+	UserReadGeneric           UserAccess = 0x0002031A
+	UserWriteGeneric          UserAccess = 0x00020044
+	UserExecuteGeneric        UserAccess = 0x00020041
+)
+
+// UserAccessRights is the user object access rights.
+var UserAccessRights = dtyp.AccessRights{
+	{Name: "USER_ALL_ACCESS", Mask: uint32(UserAllAccess)},
+	{Name: "USER_READ", Mask: uint32(UserReadGeneric)},
+	{Name: "USER_WRITE", Mask: uint32(UserWriteGeneric)},
+	{Name: "USER_EXECUTE", Mask: uint32(UserExecuteGeneric)},
+	{Name: "USER_READ_GENERAL", Mask: uint32(UserReadGeneral)},
+	{Name: "USER_READ_PREFERENCES", Mask: uint32(UserReadPreferences)},
+	{Name: "USER_WRITE_PREFERENCES", Mask: uint32(UserWritePreferences)},
+	{Name: "USER_READ_LOGON", Mask: uint32(UserReadLogon)},
+	{Name: "USER_READ_ACCOUNT", Mask: uint32(UserReadAccount)},
+	{Name: "USER_WRITE_ACCOUNT", Mask: uint32(UserWriteAccount)},
+	{Name: "USER_CHANGE_PASSWORD", Mask: uint32(UserChangePassword)},
+	{Name: "USER_FORCE_PASSWORD_CHANGE", Mask: uint32(UserForcePasswordChange)},
+	{Name: "USER_LIST_GROUPS", Mask: uint32(UserListGroups)},
+	{Name: "USER_READ_GROUP_INFORMATION", Mask: uint32(UserReadGroupInformation)},
+	{Name: "USER_WRITE_GROUP_INFORMATION", Mask: uint32(UserWriteGroupInformation)},
+}
+
+// Describe function returns the access mask description.
+func (m UserAccess) Describe() string { return UserAccessRights.Describe(uint32(m)) }
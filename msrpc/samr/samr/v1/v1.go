@@ -3959,6 +3959,9 @@ func (o *SIDArray) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.SIDs, _s_Sids, _ptr_Sids); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4660,6 +4663,9 @@ func (o *SrSecurityDescriptor) UnmarshalNDR(ctx context.Context, w ndr.Reader) e
 	if err := w.ReadPointer(&o.SecurityDescriptor, _s_SecurityDescriptor, _ptr_SecurityDescriptor); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("Length", o.Length, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19317,6 +19323,9 @@ func (o *xxx_LookupNamesInDomainOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19631,6 +19640,9 @@ func (o *xxx_LookupIDsInDomainOperation) UnmarshalNDRRequest(ctx context.Context
 			}
 		}
 	}
+	if err := ndr.CheckRange("Count", o.Count, 0, 1000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,71 @@
+package scmr
+
+import (
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// SCManagerAccess is the service control manager access mask (MS-SCMR 3.1.4).
+type SCManagerAccess uint32
+
+const (
+	SCManagerConnect          SCManagerAccess = 0x00000001
+	SCManagerCreateService    SCManagerAccess = 0x00000002
+	SCManagerEnumerateService SCManagerAccess = 0x00000004
+	SCManagerLock             SCManagerAccess = 0x00000008
+	SCManagerQueryLockStatus  SCManagerAccess = 0x00000010
+	SCManagerModifyBootConfig SCManagerAccess = 0x00000020
+	SCManagerAllAccess        SCManagerAccess = 0x000F003F
+)
+
+// SCManagerAccessRights is the service control manager access rights.
+var SCManagerAccessRights = dtyp.AccessRights{
+	{Name: "SC_MANAGER_ALL_ACCESS", Mask: uint32(SCManagerAllAccess)},
+	{Name: "SC_MANAGER_CONNECT", Mask: uint32(SCManagerConnect)},
+	{Name: "SC_MANAGER_CREATE_SERVICE", Mask: uint32(SCManagerCreateService)},
+	{Name: "SC_MANAGER_ENUMERATE_SERVICE", Mask: uint32(SCManagerEnumerateService)},
+	{Name: "SC_MANAGER_LOCK", Mask: uint32(SCManagerLock)},
+	{Name: "SC_MANAGER_QUERY_LOCK_STATUS", Mask: uint32(SCManagerQueryLockStatus)},
+	{Name: "SC_MANAGER_MODIFY_BOOT_CONFIG", Mask: uint32(SCManagerModifyBootConfig)},
+}
+
+// Describe function returns the access mask description.
+func (m SCManagerAccess) Describe() string { return SCManagerAccessRights.Describe(uint32(m)) }
+
+// ServiceAccess is the service object access mask (MS-SCMR 3.1.4):
+//
+//	resp, err := cli.OpenServiceW(ctx, &svcctl.OpenServiceWRequest{
+//		ServiceManager: scm,
+//		ServiceName:    "Spooler",
+//		DesiredAccess:  uint32(scmr.ServiceQueryStatus | scmr.ServiceStart),
+//	})
+type ServiceAccess uint32
+
+const (
+	ServiceQueryConfig         ServiceAccess = 0x00000001
+	ServiceChangeConfig        ServiceAccess = 0x00000002
+	ServiceQueryStatus         ServiceAccess = 0x00000004
+	ServiceEnumerateDependents ServiceAccess = 0x00000008
+	ServiceStart               ServiceAccess = 0x00000010
+	ServiceStop                ServiceAccess = 0x00000020
+	ServicePauseContinue       ServiceAccess = 0x00000040
+	ServiceInterrogate         ServiceAccess = 0x00000080
+	ServiceUserDefinedControl  ServiceAccess = 0x00000100
+	ServiceAllAccess           ServiceAccess = 0x000F01FF
+)
+
+// ServiceAccessRights is the service object access rights.
+var ServiceAccessRights = dtyp.AccessRights{
+	{Name: "SERVICE_ALL_ACCESS", Mask: uint32(ServiceAllAccess)},
+	{Name: "SERVICE_QUERY_CONFIG", Mask: uint32(ServiceQueryConfig)},
+	{Name: "SERVICE_CHANGE_CONFIG", Mask: uint32(ServiceChangeConfig)},
+	{Name: "SERVICE_QUERY_STATUS", Mask: uint32(ServiceQueryStatus)},
+	{Name: "SERVICE_ENUMERATE_DEPENDENTS", Mask: uint32(ServiceEnumerateDependents)},
+	{Name: "SERVICE_START", Mask: uint32(ServiceStart)},
+	{Name: "SERVICE_STOP", Mask: uint32(ServiceStop)},
+	{Name: "SERVICE_PAUSE_CONTINUE", Mask: uint32(ServicePauseContinue)},
+	{Name: "SERVICE_INTERROGATE", Mask: uint32(ServiceInterrogate)},
+	{Name: "SERVICE_USER_DEFINED_CONTROL", Mask: uint32(ServiceUserDefinedControl)},
+}
+
+// Describe function returns the access mask description.
+func (m ServiceAccess) Describe() string { return ServiceAccessRights.Describe(uint32(m)) }
@@ -1699,7 +1699,7 @@ type String struct {
 }
 
 func (o *String) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.StringPointer) > int(1024) {
+	if ndr.CharNLen(o.StringPointer) > uint64(1024) {
 		return fmt.Errorf("StringPointer is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -1759,7 +1759,7 @@ type UnicodeString struct {
 }
 
 func (o *UnicodeString) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.StringPointer) > int(1024) {
+	if ndr.UTF16NLen(o.StringPointer) > uint64(1024) {
 		return fmt.Errorf("StringPointer is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -2295,19 +2295,19 @@ type QueryServiceConfigW struct {
 }
 
 func (o *QueryServiceConfigW) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.BinaryPathName) > int(8192) {
+	if ndr.UTF16NLen(o.BinaryPathName) > uint64(8192) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(8192) {
+	if ndr.UTF16NLen(o.LoadOrderGroup) > uint64(8192) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
-	if len(o.Dependencies) > int(8192) {
+	if ndr.UTF16NLen(o.Dependencies) > uint64(8192) {
 		return fmt.Errorf("Dependencies is out of range")
 	}
-	if len(o.ServiceStartName) > int(8192) {
+	if ndr.UTF16NLen(o.ServiceStartName) > uint64(8192) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
-	if len(o.DisplayName) > int(8192) {
+	if ndr.UTF16NLen(o.DisplayName) > uint64(8192) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -2498,7 +2498,7 @@ type QueryServiceLockStatusW struct {
 }
 
 func (o *QueryServiceLockStatusW) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.LockOwner) > int(8192) {
+	if ndr.UTF16NLen(o.LockOwner) > uint64(8192) {
 		return fmt.Errorf("LockOwner is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -2662,19 +2662,19 @@ type QueryServiceConfigA struct {
 }
 
 func (o *QueryServiceConfigA) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.BinaryPathName) > int(8192) {
+	if ndr.CharNLen(o.BinaryPathName) > uint64(8192) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(8192) {
+	if ndr.CharNLen(o.LoadOrderGroup) > uint64(8192) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
-	if len(o.Dependencies) > int(8192) {
+	if ndr.CharNLen(o.Dependencies) > uint64(8192) {
 		return fmt.Errorf("Dependencies is out of range")
 	}
-	if len(o.ServiceStartName) > int(8192) {
+	if ndr.CharNLen(o.ServiceStartName) > uint64(8192) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
-	if len(o.DisplayName) > int(8192) {
+	if ndr.CharNLen(o.DisplayName) > uint64(8192) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -2865,7 +2865,7 @@ type QueryServiceLockStatusA struct {
 }
 
 func (o *QueryServiceLockStatusA) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.LockOwner) > int(8192) {
+	if ndr.CharNLen(o.LockOwner) > uint64(8192) {
 		return fmt.Errorf("LockOwner is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -2939,7 +2939,7 @@ type ServiceDescriptionA struct {
 }
 
 func (o *ServiceDescriptionA) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.Description) > int(8192) {
+	if ndr.CharNLen(o.Description) > uint64(8192) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -3093,10 +3093,10 @@ func (o *ServiceFailureActionsA) xxx_PreparePayload(ctx context.Context) error {
 	if o.Actions != nil && o.ActionsCount == 0 {
 		o.ActionsCount = uint32(len(o.Actions))
 	}
-	if len(o.RebootMessage) > int(8192) {
+	if ndr.CharNLen(o.RebootMessage) > uint64(8192) {
 		return fmt.Errorf("RebootMessage is out of range")
 	}
-	if len(o.Command) > int(8192) {
+	if ndr.CharNLen(o.Command) > uint64(8192) {
 		return fmt.Errorf("Command is out of range")
 	}
 	if o.ActionsCount > uint32(1024) {
@@ -3255,6 +3255,9 @@ func (o *ServiceFailureActionsA) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Actions, _s_lpsaActions, _ptr_lpsaActions); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ActionsCount", o.ActionsCount, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3478,7 +3481,7 @@ type ServiceDescriptionW struct {
 }
 
 func (o *ServiceDescriptionW) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.Description) > int(8192) {
+	if ndr.UTF16NLen(o.Description) > uint64(8192) {
 		return fmt.Errorf("Description is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -3554,10 +3557,10 @@ func (o *ServiceFailureActionsW) xxx_PreparePayload(ctx context.Context) error {
 	if o.Actions != nil && o.ActionsCount == 0 {
 		o.ActionsCount = uint32(len(o.Actions))
 	}
-	if len(o.RebootMessage) > int(8192) {
+	if ndr.UTF16NLen(o.RebootMessage) > uint64(8192) {
 		return fmt.Errorf("RebootMessage is out of range")
 	}
-	if len(o.Command) > int(8192) {
+	if ndr.UTF16NLen(o.Command) > uint64(8192) {
 		return fmt.Errorf("Command is out of range")
 	}
 	if o.ActionsCount > uint32(1024) {
@@ -3716,6 +3719,9 @@ func (o *ServiceFailureActionsW) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.Actions, _s_lpsaActions, _ptr_lpsaActions); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ActionsCount", o.ActionsCount, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3930,6 +3936,9 @@ func (o *ServiceTriggerSpecificDataItem) UnmarshalNDR(ctx context.Context, w ndr
 	if err := w.ReadPointer(&o.Data, _s_pData, _ptr_pData); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("DataLength", o.DataLength, 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4212,6 +4221,9 @@ func (o *ServiceTrigger) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.DataItems, _s_pDataItems, _ptr_pDataItems); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("DataItemsCount", o.DataItemsCount, 0, 64); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4347,6 +4359,9 @@ func (o *ServiceTriggerInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) err
 	if err := w.ReadPointer(&_pReserved, _s_pReserved, _ptr_pReserved); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("TriggersCount", o.TriggersCount, 0, 64); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5039,6 +5054,9 @@ func (o *RequiredPrivilegesInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader)
 	if err := w.ReadPointer(&o.RequiredPrivileges, _s_pRequiredPrivileges, _ptr_pRequiredPrivileges); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("RequiredPrivilegesLength", o.RequiredPrivilegesLength, 0, 4096); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6878,7 +6896,7 @@ type ServiceNotifyStatusChangeParams2 struct {
 }
 
 func (o *ServiceNotifyStatusChangeParams2) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.ServiceNames) > int(65536) {
+	if ndr.UTF16NLen(o.ServiceNames) > uint64(65536) {
 		return fmt.Errorf("ServiceNames is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -7024,7 +7042,7 @@ func (o *ServiceNotifyStatusChangeParams) ServiceNotifyStatusChangeParams2() *Se
 }
 
 func (o *ServiceNotifyStatusChangeParams) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.ServiceNames) > int(65536) {
+	if ndr.UTF16NLen(o.ServiceNames) > uint64(65536) {
 		return fmt.Errorf("ServiceNames is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -7545,6 +7563,9 @@ func (o *NotifyParamsList) UnmarshalNDR(ctx context.Context, w ndr.Reader) error
 			return err
 		}
 	}
+	if err := ndr.CheckSize("NotifyParamsArray", len(o.NotifyParamsArray), o.ElementsCount, "ElementsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -7660,7 +7681,7 @@ type ServiceControlStatusReasonInParamsA struct {
 }
 
 func (o *ServiceControlStatusReasonInParamsA) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.Comment) > int(128) {
+	if ndr.CharNLen(o.Comment) > uint64(128) {
 		return fmt.Errorf("Comment is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -8158,7 +8179,7 @@ type ServiceControlStatusReasonInParamsW struct {
 }
 
 func (o *ServiceControlStatusReasonInParamsW) xxx_PreparePayload(ctx context.Context) error {
-	if len(o.Comment) > int(128) {
+	if ndr.UTF16NLen(o.Comment) > uint64(128) {
 		return fmt.Errorf("Comment is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPreparePayload(context.Context) error }); ok {
@@ -10009,6 +10030,9 @@ func (o *xxx_QueryServiceObjectSecurityOperation) UnmarshalNDRRequest(ctx contex
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10315,6 +10339,9 @@ func (o *xxx_SetServiceObjectSecurityOperation) UnmarshalNDRRequest(ctx context.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("SecurityDescriptor", len(o.SecurityDescriptor), o.BufferLength, "BufferLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10982,7 +11009,7 @@ func (o *xxx_NotifyBootConfigStatusOperation) OpName() string {
 }
 
 func (o *xxx_NotifyBootConfigStatusOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.MachineName) > int(1024) {
+	if ndr.UTF16NLen(o.MachineName) > uint64(1024) {
 		return fmt.Errorf("MachineName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -11050,6 +11077,9 @@ func (o *xxx_NotifyBootConfigStatusOperation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckRange("MachineName", ndr.UTF16NLen(o.MachineName), 0, 1024); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11200,22 +11230,22 @@ func (o *xxx_ChangeServiceConfigWOperation) xxx_PrepareRequestPayload(ctx contex
 	if o.Password != nil && o.PasswordSize == 0 {
 		o.PasswordSize = uint32(len(o.Password))
 	}
-	if len(o.BinaryPathName) > int(32768) {
+	if ndr.UTF16NLen(o.BinaryPathName) > uint64(32768) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(257) {
+	if ndr.UTF16NLen(o.LoadOrderGroup) > uint64(257) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
 	if o.DependSize > uint32(4096) {
 		return fmt.Errorf("DependSize is out of range")
 	}
-	if len(o.ServiceStartName) > int(2048) {
+	if ndr.UTF16NLen(o.ServiceStartName) > uint64(2048) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
 	if o.PasswordSize > uint32(514) {
 		return fmt.Errorf("PasswordSize is out of range")
 	}
-	if len(o.DisplayName) > int(257) {
+	if ndr.UTF16NLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -11634,6 +11664,30 @@ func (o *xxx_ChangeServiceConfigWOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BinaryPathName", ndr.UTF16NLen(o.BinaryPathName), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LoadOrderGroup", ndr.UTF16NLen(o.LoadOrderGroup), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Dependencies", len(o.Dependencies), o.DependSize, "DependSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DependSize", o.DependSize, 0, 4096); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServiceStartName", ndr.UTF16NLen(o.ServiceStartName), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordSize, "PasswordSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("PasswordSize", o.PasswordSize, 0, 514); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DisplayName", ndr.UTF16NLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -11955,22 +12009,22 @@ func (o *xxx_CreateServiceWOperation) xxx_PrepareRequestPayload(ctx context.Cont
 	if o.Password != nil && o.PasswordSize == 0 {
 		o.PasswordSize = uint32(len(o.Password))
 	}
-	if len(o.ServiceName) > int(257) {
+	if ndr.UTF16NLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
-	if len(o.DisplayName) > int(257) {
+	if ndr.UTF16NLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
-	if len(o.BinaryPathName) > int(32768) {
+	if ndr.UTF16NLen(o.BinaryPathName) > uint64(32768) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(257) {
+	if ndr.UTF16NLen(o.LoadOrderGroup) > uint64(257) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
 	if o.DependSize > uint32(4096) {
 		return fmt.Errorf("DependSize is out of range")
 	}
-	if len(o.ServiceStartName) > int(2048) {
+	if ndr.UTF16NLen(o.ServiceStartName) > uint64(2048) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
 	if o.PasswordSize > uint32(514) {
@@ -12391,6 +12445,33 @@ func (o *xxx_CreateServiceWOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.UTF16NLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DisplayName", ndr.UTF16NLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BinaryPathName", ndr.UTF16NLen(o.BinaryPathName), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LoadOrderGroup", ndr.UTF16NLen(o.LoadOrderGroup), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Dependencies", len(o.Dependencies), o.DependSize, "DependSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DependSize", o.DependSize, 0, 4096); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServiceStartName", ndr.UTF16NLen(o.ServiceStartName), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordSize, "PasswordSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("PasswordSize", o.PasswordSize, 0, 514); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12774,6 +12855,9 @@ func (o *xxx_EnumDependentServicesWOperation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13117,6 +13201,9 @@ func (o *xxx_EnumServicesStatusWOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13419,10 +13506,10 @@ func (o *xxx_OpenSCMWOperation) OpNum() int { return 15 }
 func (o *xxx_OpenSCMWOperation) OpName() string { return "/svcctl/v2/ROpenSCManagerW" }
 
 func (o *xxx_OpenSCMWOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.MachineName) > int(1024) {
+	if ndr.UTF16NLen(o.MachineName) > uint64(1024) {
 		return fmt.Errorf("MachineName is out of range")
 	}
-	if len(o.DatabaseName) > int(257) {
+	if ndr.UTF16NLen(o.DatabaseName) > uint64(257) {
 		return fmt.Errorf("DatabaseName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -13527,6 +13614,12 @@ func (o *xxx_OpenSCMWOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckRange("MachineName", ndr.UTF16NLen(o.MachineName), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DatabaseName", ndr.UTF16NLen(o.DatabaseName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13680,7 +13773,7 @@ func (o *xxx_OpenServiceWOperation) OpNum() int { return 16 }
 func (o *xxx_OpenServiceWOperation) OpName() string { return "/svcctl/v2/ROpenServiceW" }
 
 func (o *xxx_OpenServiceWOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ServiceName) > int(257) {
+	if ndr.UTF16NLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -13744,6 +13837,9 @@ func (o *xxx_OpenServiceWOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.UTF16NLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -13951,6 +14047,9 @@ func (o *xxx_QueryServiceConfigWOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 8192); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14177,6 +14276,9 @@ func (o *xxx_QueryServiceLockStatusWOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 4096); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14482,6 +14584,12 @@ func (o *xxx_StartServiceWOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Argc", o.Argc, 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Argv", len(o.Argv), o.Argc, "Argc"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14612,7 +14720,7 @@ func (o *xxx_GetServiceDisplayNameWOperation) OpName() string {
 }
 
 func (o *xxx_GetServiceDisplayNameWOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ServiceName) > int(257) {
+	if ndr.UTF16NLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -14676,6 +14784,9 @@ func (o *xxx_GetServiceDisplayNameWOperation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.UTF16NLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14683,7 +14794,7 @@ func (o *xxx_GetServiceDisplayNameWOperation) xxx_PrepareResponsePayload(ctx con
 	if o.DisplayName != "" && o.BufferLength == 0 {
 		o.BufferLength = uint32(len(o.DisplayName))
 	}
-	if len(o.DisplayName) > int(4097) {
+	if ndr.UTF16NLen(o.DisplayName) > uint64(4097) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
@@ -14800,6 +14911,9 @@ func (o *xxx_GetServiceDisplayNameWOperation) UnmarshalNDRResponse(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("DisplayName", ndr.UTF16NLen(o.DisplayName), 0, 4097); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14906,7 +15020,7 @@ func (o *xxx_GetServiceKeyNameWOperation) OpNum() int { return 21 }
 func (o *xxx_GetServiceKeyNameWOperation) OpName() string { return "/svcctl/v2/RGetServiceKeyNameW" }
 
 func (o *xxx_GetServiceKeyNameWOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.DisplayName) > int(257) {
+	if ndr.UTF16NLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -14970,6 +15084,9 @@ func (o *xxx_GetServiceKeyNameWOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckRange("DisplayName", ndr.UTF16NLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -14977,7 +15094,7 @@ func (o *xxx_GetServiceKeyNameWOperation) xxx_PrepareResponsePayload(ctx context
 	if o.ServiceName != "" && o.BufferLength == 0 {
 		o.BufferLength = uint32(len(o.ServiceName))
 	}
-	if len(o.ServiceName) > int(4097) {
+	if ndr.UTF16NLen(o.ServiceName) > uint64(4097) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareResponsePayload(context.Context) error }); ok {
@@ -15094,6 +15211,9 @@ func (o *xxx_GetServiceKeyNameWOperation) UnmarshalNDRResponse(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.UTF16NLen(o.ServiceName), 0, 4097); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15217,22 +15337,22 @@ func (o *xxx_ChangeServiceConfigAOperation) xxx_PrepareRequestPayload(ctx contex
 	if o.Password != nil && o.PasswordSize == 0 {
 		o.PasswordSize = uint32(len(o.Password))
 	}
-	if len(o.BinaryPathName) > int(32768) {
+	if ndr.CharNLen(o.BinaryPathName) > uint64(32768) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(257) {
+	if ndr.CharNLen(o.LoadOrderGroup) > uint64(257) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
 	if o.DependSize > uint32(4096) {
 		return fmt.Errorf("DependSize is out of range")
 	}
-	if len(o.ServiceStartName) > int(2048) {
+	if ndr.CharNLen(o.ServiceStartName) > uint64(2048) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
 	if o.PasswordSize > uint32(514) {
 		return fmt.Errorf("PasswordSize is out of range")
 	}
-	if len(o.DisplayName) > int(257) {
+	if ndr.CharNLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -15651,6 +15771,30 @@ func (o *xxx_ChangeServiceConfigAOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BinaryPathName", ndr.CharNLen(o.BinaryPathName), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LoadOrderGroup", ndr.CharNLen(o.LoadOrderGroup), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Dependencies", len(o.Dependencies), o.DependSize, "DependSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DependSize", o.DependSize, 0, 4096); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServiceStartName", ndr.CharNLen(o.ServiceStartName), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordSize, "PasswordSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("PasswordSize", o.PasswordSize, 0, 514); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DisplayName", ndr.CharNLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -15971,22 +16115,22 @@ func (o *xxx_CreateServiceAOperation) xxx_PrepareRequestPayload(ctx context.Cont
 	if o.Password != nil && o.PasswordSize == 0 {
 		o.PasswordSize = uint32(len(o.Password))
 	}
-	if len(o.ServiceName) > int(257) {
+	if ndr.CharNLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
-	if len(o.DisplayName) > int(257) {
+	if ndr.CharNLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
-	if len(o.BinaryPathName) > int(32768) {
+	if ndr.CharNLen(o.BinaryPathName) > uint64(32768) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(257) {
+	if ndr.CharNLen(o.LoadOrderGroup) > uint64(257) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
 	if o.DependSize > uint32(4096) {
 		return fmt.Errorf("DependSize is out of range")
 	}
-	if len(o.ServiceStartName) > int(2048) {
+	if ndr.CharNLen(o.ServiceStartName) > uint64(2048) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
 	if o.PasswordSize > uint32(514) {
@@ -16407,6 +16551,33 @@ func (o *xxx_CreateServiceAOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.CharNLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DisplayName", ndr.CharNLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BinaryPathName", ndr.CharNLen(o.BinaryPathName), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LoadOrderGroup", ndr.CharNLen(o.LoadOrderGroup), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Dependencies", len(o.Dependencies), o.DependSize, "DependSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DependSize", o.DependSize, 0, 4096); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServiceStartName", ndr.CharNLen(o.ServiceStartName), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordSize, "PasswordSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("PasswordSize", o.PasswordSize, 0, 514); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -16791,6 +16962,9 @@ func (o *xxx_EnumDependentServicesAOperation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17135,6 +17309,9 @@ func (o *xxx_EnumServicesStatusAOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17437,10 +17614,10 @@ func (o *xxx_OpenSCMAOperation) OpNum() int { return 27 }
 func (o *xxx_OpenSCMAOperation) OpName() string { return "/svcctl/v2/ROpenSCManagerA" }
 
 func (o *xxx_OpenSCMAOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.MachineName) > int(1024) {
+	if ndr.CharNLen(o.MachineName) > uint64(1024) {
 		return fmt.Errorf("MachineName is out of range")
 	}
-	if len(o.DatabaseName) > int(257) {
+	if ndr.CharNLen(o.DatabaseName) > uint64(257) {
 		return fmt.Errorf("DatabaseName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -17545,6 +17722,12 @@ func (o *xxx_OpenSCMAOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckRange("MachineName", ndr.CharNLen(o.MachineName), 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DatabaseName", ndr.CharNLen(o.DatabaseName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17698,7 +17881,7 @@ func (o *xxx_OpenServiceAOperation) OpNum() int { return 28 }
 func (o *xxx_OpenServiceAOperation) OpName() string { return "/svcctl/v2/ROpenServiceA" }
 
 func (o *xxx_OpenServiceAOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ServiceName) > int(257) {
+	if ndr.CharNLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -17762,6 +17945,9 @@ func (o *xxx_OpenServiceAOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.CharNLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -17966,6 +18152,9 @@ func (o *xxx_QueryServiceConfigAOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 8192); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18191,6 +18380,9 @@ func (o *xxx_QueryServiceLockStatusAOperation) UnmarshalNDRRequest(ctx context.C
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 4096); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18496,6 +18688,12 @@ func (o *xxx_StartServiceAOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckRange("Argc", o.Argc, 0, 1024); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Argv", len(o.Argv), o.Argc, "Argc"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18626,7 +18824,7 @@ func (o *xxx_GetServiceDisplayNameAOperation) OpName() string {
 }
 
 func (o *xxx_GetServiceDisplayNameAOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.ServiceName) > int(257) {
+	if ndr.CharNLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -18690,6 +18888,9 @@ func (o *xxx_GetServiceDisplayNameAOperation) UnmarshalNDRRequest(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.CharNLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -18918,7 +19119,7 @@ func (o *xxx_GetServiceKeyNameAOperation) OpNum() int { return 33 }
 func (o *xxx_GetServiceKeyNameAOperation) OpName() string { return "/svcctl/v2/RGetServiceKeyNameA" }
 
 func (o *xxx_GetServiceKeyNameAOperation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.DisplayName) > int(257) {
+	if ndr.CharNLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -18982,6 +19183,9 @@ func (o *xxx_GetServiceKeyNameAOperation) UnmarshalNDRRequest(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckRange("DisplayName", ndr.CharNLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -19218,7 +19422,7 @@ func (o *xxx_EnumServiceGroupWOperation) xxx_PrepareRequestPayload(ctx context.C
 	if o.BufferLength > uint32(262144) {
 		return fmt.Errorf("BufferLength is out of range")
 	}
-	if len(o.GroupName) > int(257) {
+	if ndr.UTF16NLen(o.GroupName) > uint64(257) {
 		return fmt.Errorf("GroupName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -19362,6 +19566,12 @@ func (o *xxx_EnumServiceGroupWOperation) UnmarshalNDRRequest(ctx context.Context
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("GroupName", ndr.UTF16NLen(o.GroupName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20109,6 +20319,9 @@ func (o *xxx_QueryServiceConfig2AOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 8192); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20397,6 +20610,9 @@ func (o *xxx_QueryServiceConfig2WOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 8192); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20687,6 +20903,9 @@ func (o *xxx_QueryServiceStatusExOperation) UnmarshalNDRRequest(ctx context.Cont
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 8192); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20893,7 +21112,7 @@ func (o *xxx_EnumServicesStatusExAOperation) xxx_PrepareRequestPayload(ctx conte
 	if o.BufferLength > uint32(262144) {
 		return fmt.Errorf("BufferLength is out of range")
 	}
-	if len(o.GroupName) > int(257) {
+	if ndr.CharNLen(o.GroupName) > uint64(257) {
 		return fmt.Errorf("GroupName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -21049,6 +21268,12 @@ func (o *xxx_EnumServicesStatusExAOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("GroupName", ndr.CharNLen(o.GroupName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21369,7 +21594,7 @@ func (o *xxx_EnumServicesStatusExWOperation) xxx_PrepareRequestPayload(ctx conte
 	if o.BufferLength > uint32(262144) {
 		return fmt.Errorf("BufferLength is out of range")
 	}
-	if len(o.GroupName) > int(257) {
+	if ndr.UTF16NLen(o.GroupName) > uint64(257) {
 		return fmt.Errorf("GroupName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -21525,6 +21750,12 @@ func (o *xxx_EnumServicesStatusExWOperation) UnmarshalNDRRequest(ctx context.Con
 			return err
 		}
 	}
+	if err := ndr.CheckRange("BufferLength", o.BufferLength, 0, 262144); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("GroupName", ndr.UTF16NLen(o.GroupName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21853,22 +22084,22 @@ func (o *xxx_CreateServiceWOW64AOperation) xxx_PrepareRequestPayload(ctx context
 	if o.Password != nil && o.PasswordSize == 0 {
 		o.PasswordSize = uint32(len(o.Password))
 	}
-	if len(o.ServiceName) > int(257) {
+	if ndr.CharNLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
-	if len(o.DisplayName) > int(257) {
+	if ndr.CharNLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
-	if len(o.BinaryPathName) > int(32768) {
+	if ndr.CharNLen(o.BinaryPathName) > uint64(32768) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(257) {
+	if ndr.CharNLen(o.LoadOrderGroup) > uint64(257) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
 	if o.DependSize > uint32(4096) {
 		return fmt.Errorf("DependSize is out of range")
 	}
-	if len(o.ServiceStartName) > int(2048) {
+	if ndr.CharNLen(o.ServiceStartName) > uint64(2048) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
 	if o.PasswordSize > uint32(514) {
@@ -22289,6 +22520,33 @@ func (o *xxx_CreateServiceWOW64AOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.CharNLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DisplayName", ndr.CharNLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BinaryPathName", ndr.CharNLen(o.BinaryPathName), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LoadOrderGroup", ndr.CharNLen(o.LoadOrderGroup), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Dependencies", len(o.Dependencies), o.DependSize, "DependSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DependSize", o.DependSize, 0, 4096); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServiceStartName", ndr.CharNLen(o.ServiceStartName), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordSize, "PasswordSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("PasswordSize", o.PasswordSize, 0, 514); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -22622,22 +22880,22 @@ func (o *xxx_CreateServiceWOW64WOperation) xxx_PrepareRequestPayload(ctx context
 	if o.Password != nil && o.PasswordSize == 0 {
 		o.PasswordSize = uint32(len(o.Password))
 	}
-	if len(o.ServiceName) > int(257) {
+	if ndr.UTF16NLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
-	if len(o.DisplayName) > int(257) {
+	if ndr.UTF16NLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
-	if len(o.BinaryPathName) > int(32768) {
+	if ndr.UTF16NLen(o.BinaryPathName) > uint64(32768) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(257) {
+	if ndr.UTF16NLen(o.LoadOrderGroup) > uint64(257) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
 	if o.DependSize > uint32(4096) {
 		return fmt.Errorf("DependSize is out of range")
 	}
-	if len(o.ServiceStartName) > int(2048) {
+	if ndr.UTF16NLen(o.ServiceStartName) > uint64(2048) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
 	if o.PasswordSize > uint32(514) {
@@ -23058,6 +23316,33 @@ func (o *xxx_CreateServiceWOW64WOperation) UnmarshalNDRRequest(ctx context.Conte
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.UTF16NLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DisplayName", ndr.UTF16NLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BinaryPathName", ndr.UTF16NLen(o.BinaryPathName), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LoadOrderGroup", ndr.UTF16NLen(o.LoadOrderGroup), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Dependencies", len(o.Dependencies), o.DependSize, "DependSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DependSize", o.DependSize, 0, 4096); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServiceStartName", ndr.UTF16NLen(o.ServiceStartName), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordSize, "PasswordSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("PasswordSize", o.PasswordSize, 0, 514); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -24925,22 +25210,22 @@ func (o *xxx_CreateWOWServiceOperation) xxx_PrepareRequestPayload(ctx context.Co
 	if o.Password != nil && o.PasswordSize == 0 {
 		o.PasswordSize = uint32(len(o.Password))
 	}
-	if len(o.ServiceName) > int(257) {
+	if ndr.UTF16NLen(o.ServiceName) > uint64(257) {
 		return fmt.Errorf("ServiceName is out of range")
 	}
-	if len(o.DisplayName) > int(257) {
+	if ndr.UTF16NLen(o.DisplayName) > uint64(257) {
 		return fmt.Errorf("DisplayName is out of range")
 	}
-	if len(o.BinaryPathName) > int(32768) {
+	if ndr.UTF16NLen(o.BinaryPathName) > uint64(32768) {
 		return fmt.Errorf("BinaryPathName is out of range")
 	}
-	if len(o.LoadOrderGroup) > int(257) {
+	if ndr.UTF16NLen(o.LoadOrderGroup) > uint64(257) {
 		return fmt.Errorf("LoadOrderGroup is out of range")
 	}
 	if o.DependSize > uint32(4096) {
 		return fmt.Errorf("DependSize is out of range")
 	}
-	if len(o.ServiceStartName) > int(2048) {
+	if ndr.UTF16NLen(o.ServiceStartName) > uint64(2048) {
 		return fmt.Errorf("ServiceStartName is out of range")
 	}
 	if o.PasswordSize > uint32(514) {
@@ -25373,6 +25658,33 @@ func (o *xxx_CreateWOWServiceOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ServiceName", ndr.UTF16NLen(o.ServiceName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DisplayName", ndr.UTF16NLen(o.DisplayName), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("BinaryPathName", ndr.UTF16NLen(o.BinaryPathName), 0, 32768); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("LoadOrderGroup", ndr.UTF16NLen(o.LoadOrderGroup), 0, 257); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Dependencies", len(o.Dependencies), o.DependSize, "DependSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DependSize", o.DependSize, 0, 4096); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("ServiceStartName", ndr.UTF16NLen(o.ServiceStartName), 0, 2048); err != nil {
+		return err
+	}
+	if err := ndr.CheckSize("Password", len(o.Password), o.PasswordSize, "PasswordSize"); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("PasswordSize", o.PasswordSize, 0, 514); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25765,7 +26077,7 @@ func (o *xxx_OpenSCM2Operation) OpNum() int { return 61 }
 func (o *xxx_OpenSCM2Operation) OpName() string { return "/svcctl/v2/ROpenSCManager2" }
 
 func (o *xxx_OpenSCM2Operation) xxx_PrepareRequestPayload(ctx context.Context) error {
-	if len(o.DatabaseName) > int(257) {
+	if ndr.UTF16NLen(o.DatabaseName) > uint64(257) {
 		return fmt.Errorf("DatabaseName is out of range")
 	}
 	if hook, ok := (interface{})(o).(interface{ AfterPrepareRequestPayload(context.Context) error }); ok {
@@ -25833,6 +26145,9 @@ func (o *xxx_OpenSCM2Operation) UnmarshalNDRRequest(ctx context.Context, w ndr.R
 			return err
 		}
 	}
+	if err := ndr.CheckRange("DatabaseName", ndr.UTF16NLen(o.DatabaseName), 0, 257); err != nil {
+		return err
+	}
 	return nil
 }
 
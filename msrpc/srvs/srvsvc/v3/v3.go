@@ -18085,6 +18085,9 @@ func (o *SiteListInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Site", len(o.Site), o.SitesCount, "SitesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -25835,6 +25838,9 @@ func (o *xxx_PathCanonicalizeOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("OutputBufferLength", o.OutputBufferLength, 0, 64000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -26617,6 +26623,9 @@ func (o *xxx_NameCanonicalizeOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("OutputBufferLength", o.OutputBufferLength, 0, 64000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -29689,6 +29698,9 @@ func (o *xxx_CreateExitPointOperation) UnmarshalNDRRequest(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckRange("ShortPrefixLength", o.ShortPrefixLength, 0, 32); err != nil {
+		return err
+	}
 	return nil
 }
 
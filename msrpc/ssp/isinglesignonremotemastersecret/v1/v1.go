@@ -215,6 +215,9 @@ func (o *xxx_RemoteGetMasterSecretOperation) UnmarshalNDRResponse(ctx context.Co
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Secret", len(o.Secret), o.SecretLength, "SecretLength"); err != nil {
+		return err
+	}
 	return nil
 }
 
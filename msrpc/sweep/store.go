@@ -0,0 +1,307 @@
+package sweep
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// ErrCorruptJournal is returned when the journal record (other than the
+// last, partially written one) cannot be decoded.
+var ErrCorruptJournal = errors.New("sweep: corrupt journal")
+
+// record is the journal record.
+type record struct {
+	*Result
+	// The completion marker.
+	Complete *complete `json:"complete,omitempty"`
+}
+
+// complete is the host completion marker.
+type complete struct {
+	Host        string `json:"host"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// host is the host state.
+type host struct {
+	results  map[string]*Result
+	complete *complete
+}
+
+// FileStore is the crash-safe file-backed Store. The store is the
+// append-only journal of JSON records, every record is synced to the
+// disk before Put or Complete returns. The partially written record (the
+// store was interrupted in the middle of the write) is discarded when
+// the store is opened.
+type FileStore struct {
+	mu    sync.RWMutex
+	path  string
+	f     *os.File
+	hosts map[string]*host
+}
+
+// OpenFileStore function opens (or creates) the journal file at `path`
+// and replays the recorded results.
+func OpenFileStore(path string) (*FileStore, error) {
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("sweep: open store: %w", err)
+	}
+
+	s := &FileStore{path: path, f: f, hosts: make(map[string]*host)}
+
+	if err := s.replay(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("sweep: open store: %w", err)
+	}
+
+	return s, nil
+}
+
+// replay function loads the journal records and truncates the partially
+// written tail record.
+func (s *FileStore) replay() error {
+
+	var (
+		r   = bufio.NewReader(s.f)
+		off int64
+	)
+
+	for {
+
+		line, err := r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if len(line) > 0 && line[len(line)-1] == '\n' {
+			rec := &record{}
+			if err := json.Unmarshal(line, rec); err != nil {
+				return fmt.Errorf("%w: offset %d: %v", ErrCorruptJournal, off, err)
+			}
+			s.apply(rec)
+			off += int64(len(line))
+			continue
+		}
+
+		// end of the journal, or the partially written record.
+		if len(line) > 0 {
+			if err := s.f.Truncate(off); err != nil {
+				return err
+			}
+		}
+
+		_, err = s.f.Seek(off, io.SeekStart)
+		return err
+	}
+}
+
+// apply function applies the record to the in-memory state.
+func (s *FileStore) apply(rec *record) {
+
+	var name string
+	switch {
+	case rec.Complete != nil:
+		name = rec.Complete.Host
+	case rec.Result != nil:
+		name = rec.Result.Host
+	default:
+		return
+	}
+
+	h, ok := s.hosts[name]
+	if !ok {
+		h = &host{results: make(map[string]*Result)}
+		s.hosts[name] = h
+	}
+
+	if rec.Complete != nil {
+		h.complete = rec.Complete
+		return
+	}
+
+	if h.complete != nil && h.complete.Fingerprint != rec.Result.Fingerprint {
+		// the host is being re-scanned.
+		h.complete = nil
+	}
+
+	h.results[rec.Result.Query] = rec.Result
+}
+
+// write function appends the record to the journal and syncs it to
+// the disk.
+func (s *FileStore) write(rec *record) error {
+
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.f == nil {
+		return os.ErrClosed
+	}
+
+	if _, err := s.f.Write(append(b, '\n')); err != nil {
+		return err
+	}
+
+	if err := s.f.Sync(); err != nil {
+		return err
+	}
+
+	s.apply(rec)
+
+	return nil
+}
+
+// Get function returns the query result for the host.
+func (s *FileStore) Get(host, query string) (*Result, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if h, ok := s.hosts[host]; ok {
+		res, ok := h.results[query]
+		return res, ok
+	}
+	return nil, false
+}
+
+// Put function records the query result.
+func (s *FileStore) Put(res *Result) error {
+	return s.write(&record{Result: res})
+}
+
+// Completed function returns the fingerprint of the host and `true` if the
+// host sweep was completed.
+func (s *FileStore) Completed(host string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if h, ok := s.hosts[host]; ok && h.complete != nil {
+		return h.complete.Fingerprint, true
+	}
+	return "", false
+}
+
+// Complete function records the host completion marker.
+func (s *FileStore) Complete(host, fingerprint string) error {
+	return s.write(&record{Complete: &complete{Host: host, Fingerprint: fingerprint}})
+}
+
+// Hosts function returns the sorted list of the hosts with the recorded
+// results.
+func (s *FileStore) Hosts() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	hosts := make([]string, 0, len(s.hosts))
+	for h := range s.hosts {
+		hosts = append(hosts, h)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// Compact function rewrites the journal with the latest records only. The
+// journal is replaced atomically.
+func (s *FileStore) Compact() error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.f == nil {
+		return os.ErrClosed
+	}
+
+	var buf bytes.Buffer
+
+	for _, name := range sortedKeys(s.hosts) {
+		h := s.hosts[name]
+		for _, query := range sortedKeys(h.results) {
+			if err := writeRecord(&buf, &record{Result: h.results[query]}); err != nil {
+				return fmt.Errorf("sweep: compact: %w", err)
+			}
+		}
+		if h.complete != nil {
+			if err := writeRecord(&buf, &record{Complete: h.complete}); err != nil {
+				return fmt.Errorf("sweep: compact: %w", err)
+			}
+		}
+	}
+
+	tmp := s.path + ".tmp"
+
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("sweep: compact: %w", err)
+	}
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return fmt.Errorf("sweep: compact: %w", err)
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sweep: compact: %w", err)
+	}
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		f.Close()
+		return fmt.Errorf("sweep: compact: %w", err)
+	}
+
+	syncDir(filepath.Dir(s.path))
+
+	s.f.Close()
+	s.f = f
+
+	return nil
+}
+
+// Close function closes the journal.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+func writeRecord(w io.Writer, rec *record) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// syncDir function syncs the directory entry (best effort).
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
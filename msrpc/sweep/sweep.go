@@ -0,0 +1,227 @@
+// The sweep package implements the resumable multi-host query sweep for the
+// fleet scans.
+//
+// The results of each query for each host and the host completion markers
+// are recorded in the Store, so that the sweep interrupted by the crash
+// resumes where it stopped (the completed queries are not repeated), and the
+// re-scan only touches the hosts whose fingerprint (for example, the server
+// boot time, or the directory USN) has changed since the last sweep:
+//
+//	store, err := sweep.OpenFileStore("sweep.journal")
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	defer store.Close()
+//
+//	s := &sweep.Sweep{
+//		Store:       store,
+//		Concurrency: 16,
+//		Queries: []*sweep.Query{
+//			{Name: "shares", Run: func(ctx context.Context, host string) (any, error) {
+//				return enumShares(ctx, host)
+//			}},
+//		},
+//	}
+//
+//	if err := s.Run(ctx, hosts...); err != nil {
+//		// some queries failed and will be retried on the next run.
+//	}
+//
+//	res, ok := store.Get("dc01.contoso.net", "shares")
+//
+// The FileStore is the append-only journal of JSON records, each record is
+// synced to the disk before the query is considered completed. The Store
+// interface can be implemented on top of the embedded database (like bbolt
+// or sqlite).
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Result is the query result for the host.
+type Result struct {
+	// The host.
+	Host string `json:"host"`
+	// The query name.
+	Query string `json:"query"`
+	// The host fingerprint at the time of the query.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// The query completion time.
+	Time time.Time `json:"time"`
+	// The query result (JSON).
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Unmarshal function decodes the query result into `v`.
+func (r *Result) Unmarshal(v any) error {
+	return json.Unmarshal(r.Data, v)
+}
+
+// Store is the sweep results store.
+type Store interface {
+	// Get function returns the query result for the host.
+	Get(host, query string) (*Result, bool)
+	// Put function records the query result. The result must be
+	// persisted when the function returns.
+	Put(*Result) error
+	// Completed function returns the fingerprint of the host and `true`
+	// if the host sweep was completed.
+	Completed(host string) (string, bool)
+	// Complete function records the host completion marker.
+	Complete(host, fingerprint string) error
+}
+
+// Query is the sweep query.
+type Query struct {
+	// The query name (unique within the sweep).
+	Name string
+	// The query function, the result must be JSON-serializable.
+	Run func(ctx context.Context, host string) (any, error)
+}
+
+// Sweep is the resumable multi-host query sweep.
+type Sweep struct {
+	// The results store.
+	Store Store
+	// The queries performed for each host.
+	Queries []*Query
+	// The host fingerprint function (optional). The host which was
+	// completed with the same fingerprint is skipped, the results of the
+	// host with the changed fingerprint are discarded.
+	Fingerprint func(ctx context.Context, host string) (string, error)
+	// The number of hosts swept concurrently (default is 1).
+	Concurrency int
+	// Rescan is `true` if the completed hosts must be swept again (when
+	// the fingerprint function is not set).
+	Rescan bool
+}
+
+// Error is the host sweep error.
+type Error struct {
+	// The host.
+	Host string
+	// The query name (empty for the fingerprint error).
+	Query string
+	// The error.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Query == "" {
+		return fmt.Sprintf("sweep: %s: fingerprint: %v", e.Host, e.Err)
+	}
+	return fmt.Sprintf("sweep: %s: %s: %v", e.Host, e.Query, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Run function sweeps the hosts. The failed queries are reported as the
+// joined *Error values, and the hosts with failed queries are not marked
+// as completed, so that the failed queries are retried on the next run.
+func (s *Sweep) Run(ctx context.Context, hosts ...string) error {
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	hostC := make(chan string)
+
+	for i := 0; i < max(s.Concurrency, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for host := range hostC {
+				if err := s.sweep(ctx, host); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, host := range hosts {
+		select {
+		case hostC <- host:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+
+	close(hostC)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweep function performs the queries for the host.
+func (s *Sweep) sweep(ctx context.Context, host string) error {
+
+	var fp string
+
+	if s.Fingerprint != nil {
+		var err error
+		if fp, err = s.Fingerprint(ctx, host); err != nil {
+			return &Error{Host: host, Err: err}
+		}
+	}
+
+	if done, ok := s.Store.Completed(host); ok && done == fp && (s.Fingerprint != nil || !s.Rescan) {
+		// the host has not changed since the last sweep.
+		return nil
+	}
+
+	var errs []error
+
+	for _, q := range s.Queries {
+
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+
+		if res, ok := s.Store.Get(host, q.Name); ok && res.Fingerprint == fp && !s.Rescan {
+			// the query was completed by the interrupted sweep.
+			continue
+		}
+
+		v, err := q.Run(ctx, host)
+		if err != nil {
+			errs = append(errs, &Error{Host: host, Query: q.Name, Err: err})
+			continue
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			errs = append(errs, &Error{Host: host, Query: q.Name, Err: err})
+			continue
+		}
+
+		if err := s.Store.Put(&Result{Host: host, Query: q.Name, Fingerprint: fp, Time: time.Now().UTC(), Data: b}); err != nil {
+			return errors.Join(append(errs, &Error{Host: host, Query: q.Name, Err: err})...)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if err := s.Store.Complete(host, fp); err != nil {
+		return fmt.Errorf("sweep: %s: complete: %w", host, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestSweep(t *testing.T) {
+
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "sweep.journal")
+
+	var (
+		mu    sync.Mutex
+		calls = map[string]int{}
+		fail  = map[string]bool{"b/users": true}
+		fps   = map[string]string{"a": "1", "b": "1"}
+	)
+
+	query := func(name string) *Query {
+		return &Query{Name: name, Run: func(ctx context.Context, host string) (any, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls[host+"/"+name]++
+			if fail[host+"/"+name] {
+				return nil, errors.New("interrupted")
+			}
+			return map[string]string{"host": host, "query": name}, nil
+		}}
+	}
+
+	run := func() error {
+		t.Helper()
+		store, err := OpenFileStore(path)
+		if err != nil {
+			t.Fatalf("open: %v", err)
+		}
+		defer store.Close()
+		s := &Sweep{
+			Store:       store,
+			Queries:     []*Query{query("shares"), query("users")},
+			Concurrency: 2,
+			Fingerprint: func(ctx context.Context, host string) (string, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				return fps[host], nil
+			},
+		}
+		return s.Run(ctx, "a", "b")
+	}
+
+	expect := func(expected map[string]int) {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		for k, v := range expected {
+			if calls[k] != v {
+				t.Fatalf("%s: expected %d calls, got %d", k, v, calls[k])
+			}
+		}
+	}
+
+	err := run()
+	var serr *Error
+	if !errors.As(err, &serr) || serr.Host != "b" || serr.Query != "users" {
+		t.Fatalf("expected b/users error, got %v", err)
+	}
+
+	expect(map[string]int{"a/shares": 1, "a/users": 1, "b/shares": 1, "b/users": 1})
+
+	// resume: only the failed query is repeated.
+	fail["b/users"] = false
+	if err := run(); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	expect(map[string]int{"a/shares": 1, "a/users": 1, "b/shares": 1, "b/users": 2})
+
+	// re-scan: only the changed host is swept.
+	fps["a"] = "2"
+	if err := run(); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	expect(map[string]int{"a/shares": 2, "a/users": 2, "b/shares": 1, "b/users": 2})
+
+	// partially written record is discarded.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	f.WriteString(`{"host":"c","query":"sha`)
+	f.Close()
+
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	res, ok := store.Get("a", "users")
+	if !ok || res.Fingerprint != "2" {
+		t.Fatalf("expected a/users result with fingerprint 2, got %v", res)
+	}
+
+	var v map[string]string
+	if err := res.Unmarshal(&v); err != nil || v["query"] != "users" {
+		t.Fatalf("unmarshal: %v %v", v, err)
+	}
+
+	if fp, ok := store.Completed("b"); !ok || fp != "1" {
+		t.Fatalf("expected b completed with fingerprint 1, got %q %v", fp, ok)
+	}
+
+	if err := store.Compact(); err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+
+	if err := store.Complete("c", ""); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	store.Close()
+
+	if store, err = OpenFileStore(path); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer store.Close()
+
+	if hosts := store.Hosts(); len(hosts) != 3 {
+		t.Fatalf("expected 3 hosts, got %v", hosts)
+	}
+
+	if _, ok := store.Get("a", "shares"); !ok {
+		t.Fatalf("expected a/shares result after compaction")
+	}
+}
@@ -479,6 +479,9 @@ func (o *xxx_EventProcOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.Size, "Size"); err != nil {
+		return err
+	}
 	return nil
 }
 
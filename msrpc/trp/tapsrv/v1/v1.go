@@ -604,6 +604,9 @@ func (o *xxx_ClientRequestOperation) UnmarshalNDRRequest(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Buffer", len(o.Buffer), o.NeededSize, "NeededSize"); err != nil {
+		return err
+	}
 	return nil
 }
 
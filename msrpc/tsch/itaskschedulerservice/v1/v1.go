@@ -1081,6 +1081,9 @@ func (o *xxx_RegisterTaskOperation) UnmarshalNDRRequest(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Creds", len(o.Creds), o.CredsCount, "CredsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2402,6 +2405,9 @@ func (o *xxx_EnumFoldersOperation) UnmarshalNDRResponse(ctx context.Context, w n
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.NamesCount, "NamesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2751,6 +2757,9 @@ func (o *xxx_EnumTasksOperation) UnmarshalNDRResponse(ctx context.Context, w ndr
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Names", len(o.Names), o.NamesCount, "NamesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3078,6 +3087,9 @@ func (o *xxx_EnumInstancesOperation) UnmarshalNDRResponse(ctx context.Context, w
 			return err
 		}
 	}
+	if err := ndr.CheckSize("GUIDs", len(o.GUIDs), o.GUIDsCount, "GUIDsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3497,6 +3509,9 @@ func (o *xxx_GetInstanceInfoOperation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("GroupInstances", len(o.GroupInstances), o.GroupInstancesCount, "GroupInstancesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4161,6 +4176,9 @@ func (o *xxx_RunOperation) UnmarshalNDRRequest(ctx context.Context, w ndr.Reader
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Args", len(o.Args), o.ArgsCount, "ArgsCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4963,6 +4981,9 @@ func (o *xxx_ScheduledRuntimesOperation) UnmarshalNDRResponse(ctx context.Contex
 			return err
 		}
 	}
+	if err := ndr.CheckSize("Runtimes", len(o.Runtimes), o.RuntimesCount, "RuntimesCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
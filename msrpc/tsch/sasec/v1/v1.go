@@ -829,6 +829,9 @@ func (o *xxx_GetNSAccountInformationOperation) UnmarshalNDRRequest(ctx context.C
 		}
 		o.Buffer = strings.TrimRight(string(utf16.Decode(_Buffer_buf)), ndr.ZeroString)
 	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 273); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1152,6 +1155,9 @@ func (o *xxx_GetAccountInformationOperation) UnmarshalNDRRequest(ctx context.Con
 		}
 		o.Buffer = strings.TrimRight(string(utf16.Decode(_Buffer_buf)), ndr.ZeroString)
 	}
+	if err := ndr.CheckRange("BufferSize", o.BufferSize, 0, 273); err != nil {
+		return err
+	}
 	return nil
 }
 
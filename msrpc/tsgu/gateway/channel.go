@@ -0,0 +1,155 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	tsproxy "github.com/oiweiwei/go-msrpc/msrpc/tsgu/tsproxyrpcinterface/v1"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// The maximum size of the data sent with single TsProxySendToServer call.
+const maxSendSize = 16384
+
+// channel is the connection to the target server over the tunnel.
+type channel struct {
+	t   *Tunnel
+	rcv tsproxy.InterfaceClient
+	// the channel context.
+	ctxHandle *dcetypes.ContextHandle
+	// the serialized channel context.
+	handle []byte
+	id     uint32
+	addr   string
+
+	r *io.PipeReader
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	once sync.Once
+}
+
+func newChannel(t *Tunnel, rcv tsproxy.InterfaceClient, handle *dcetypes.ContextHandle, id uint32, addr string) (*channel, error) {
+
+	b, err := ndr.Marshal(handle)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: marshal channel context: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r, w := io.Pipe()
+
+	c := &channel{
+		t:         t,
+		rcv:       rcv,
+		ctxHandle: handle,
+		handle:    b,
+		id:        id,
+		addr:      addr,
+		r:         r,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+
+	go func() {
+		defer close(c.done)
+		if err := rcv.Conn().Invoke(ctx, &receivePipeOperation{handle: c.handle, w: w}); err != nil {
+			// the writer is not closed if the final response is not received.
+			w.CloseWithError(fmt.Errorf("gateway: receive pipe: %w", err))
+		}
+	}()
+
+	return c, nil
+}
+
+// Read function reads the data received from the target server.
+func (c *channel) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// Write function sends the data to the target server.
+func (c *channel) Write(b []byte) (int, error) {
+
+	for n := 0; n < len(b); {
+
+		sz := len(b) - n
+		if sz > maxSendSize {
+			sz = maxSendSize
+		}
+
+		op := &sendToServerOperation{handle: c.handle, data: b[n : n+sz]}
+
+		if err := c.t.cli.Conn().Invoke(context.Background(), op); err != nil {
+			return n, fmt.Errorf("gateway: send to server: %w", err)
+		}
+
+		if op.Return != 0 {
+			return n, fmt.Errorf("gateway: send to server: return code 0x%08x", op.Return)
+		}
+
+		n += sz
+	}
+
+	return len(b), nil
+}
+
+// Close function closes the channel.
+func (c *channel) Close() error {
+
+	var err error
+
+	c.once.Do(func() {
+
+		ctx := context.Background()
+
+		if _, err = c.t.cli.CloseChannel(ctx, &tsproxy.CloseChannelRequest{
+			Context: (*tsproxy.ChannelNoSerialize)(c.ctxHandle),
+		}); err != nil {
+			err = fmt.Errorf("gateway: close channel: %w", err)
+		}
+
+		// the receive pipe is completed by the gateway once the channel
+		// is closed.
+		select {
+		case <-c.done:
+		case <-time.After(5 * time.Second):
+			c.cancel()
+			<-c.done
+		}
+
+		c.cancel()
+		c.r.Close()
+		c.rcv.Conn().Close(ctx)
+	})
+
+	return err
+}
+
+func (c *channel) LocalAddr() net.Addr  { return addr("gateway") }
+func (c *channel) RemoteAddr() net.Addr { return addr(c.addr) }
+
+var errDeadline = errors.New("gateway: deadlines are not supported")
+
+func (c *channel) SetDeadline(t time.Time) error      { return errDeadline }
+func (c *channel) SetReadDeadline(t time.Time) error  { return errDeadline }
+func (c *channel) SetWriteDeadline(t time.Time) error { return errDeadline }
+
+type addr string
+
+func (a addr) Network() string { return "tsgu" }
+func (a addr) String() string  { return string(a) }
+
+var (
+	_ net.Conn         = (*channel)(nil)
+	_ dcerpc.Operation = (*sendToServerOperation)(nil)
+	_ dcerpc.Operation = (*receivePipeOperation)(nil)
+)
@@ -0,0 +1,228 @@
+// The gateway package implements the Remote Desktop Gateway (MS-TSGU) tunnel
+// over RPC over HTTP, that can be used as the dialer for the dcerpc connections
+// to the machines that are reachable only through the gateway.
+//
+// The gateway connection must be established with the WithNewTransport option,
+// since every channel uses the dedicated connection for the receive pipe:
+//
+//	gw, err := dcerpc.Dial(ctx, "ncacn_http:rdg.contoso.net[3388,RpcProxy=rdg.contoso.net:443]",
+//		dcerpc.WithNewTransport(), dcerpc.WithTimeout(time.Hour), dcerpc.WithCredentials(creds))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	tunnel, err := gateway.New(ctx, gw, dcerpc.WithSeal())
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer tunnel.Close(ctx)
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:10.0.0.5[49667]", dcerpc.WithDialer(tunnel))
+//
+// Note, that the channel carries the connection-oriented DCE/RPC PDUs (the data
+// received from the target server is framed by the PDU header), other protocols
+// are not supported.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"unicode/utf16"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	tsproxy "github.com/oiweiwei/go-msrpc/msrpc/tsgu/tsproxyrpcinterface/v1"
+)
+
+const (
+	// TS_GATEWAY_TRANSPORT.
+	componentID = 0x5452
+	// TSG_PACKET_TYPE_VERSIONCAPS.
+	packetTypeVersionCaps = 0x5643
+	// TSG_PACKET_TYPE_QUARREQUEST.
+	packetTypeQuarRequest = 0x5152
+	// TSG_CAPABILITY_TYPE_NAP.
+	capabilityTypeNAP = 0x00000001
+	// TSG_NAP_CAPABILITY_IDLE_TIMEOUT.
+	napCapabilityIdleTimeout = 0x00000002
+)
+
+// ErrClosed is returned when the tunnel or the channel is closed.
+var ErrClosed = errors.New("gateway: closed")
+
+// Tunnel is the RDG tunnel.
+type Tunnel struct {
+	mu sync.Mutex
+	// the gateway connection.
+	cc dcerpc.Conn
+	// the bind options.
+	opts []dcerpc.Option
+	// the control client.
+	cli tsproxy.InterfaceClient
+	// the tunnel context.
+	tunnel *dcetypes.ContextHandle
+	// the client machine name.
+	machineName string
+	closed      bool
+}
+
+// Option is the tunnel option.
+type Option func(*Tunnel)
+
+// WithMachineName option sets the client machine name reported to the
+// gateway. (default is the host name).
+func WithMachineName(name string) Option {
+	return func(t *Tunnel) { t.machineName = name }
+}
+
+// New function creates and authorizes the tunnel over the gateway connection
+// `cc`. The options `opts` are used to bind the interface. (dcerpc.Option and
+// Option are accepted).
+func New(ctx context.Context, cc dcerpc.Conn, opts ...any) (*Tunnel, error) {
+
+	t := &Tunnel{cc: cc}
+
+	for _, o := range opts {
+		switch o := o.(type) {
+		case Option:
+			o(t)
+		case dcerpc.Option:
+			t.opts = append(t.opts, o)
+		}
+	}
+
+	if t.machineName == "" {
+		t.machineName, _ = os.Hostname()
+	}
+
+	cli, err := tsproxy.NewInterfaceClient(ctx, cc, t.opts...)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: bind: %w", err)
+	}
+
+	t.cli = cli
+
+	resp, err := cli.CreateTunnel(ctx, &tsproxy.CreateTunnelRequest{
+		Packet: &tsproxy.Packet{
+			PacketID: packetTypeVersionCaps,
+			Packet: &tsproxy.PacketTypeUnion{
+				Value: &tsproxy.PacketTypeUnion_PacketVersionCaps{
+					PacketVersionCaps: &tsproxy.PacketVersionCaps{
+						Header: &tsproxy.PacketHeader{ComponentID: componentID, PacketID: packetTypeVersionCaps},
+						Caps: []*tsproxy.PacketCapabilities{{
+							CapabilityType: capabilityTypeNAP,
+							Packet: &tsproxy.CapabilitiesUnion{
+								Value: &tsproxy.CapabilitiesUnion_CapNap{
+									CapNap: &tsproxy.CapabilityNap{Capabilities: napCapabilityIdleTimeout},
+								},
+							},
+						}},
+						MajorVersion: 1,
+						MinorVersion: 1,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gateway: create tunnel: %w", err)
+	}
+
+	t.tunnel = resp.TunnelContext.ContextHandle()
+
+	if _, err := cli.AuthorizeTunnel(ctx, &tsproxy.AuthorizeTunnelRequest{
+		TunnelContext: (*tsproxy.TunnelNoSerialize)(t.tunnel),
+		Packet: &tsproxy.Packet{
+			PacketID: packetTypeQuarRequest,
+			Packet: &tsproxy.PacketTypeUnion{
+				Value: &tsproxy.PacketTypeUnion_PacketQuarantineRequest{
+					PacketQuarantineRequest: &tsproxy.PacketQuarantineRequest{
+						MachineName: t.machineName,
+						// the length includes the terminating null character.
+						NameLength: uint32(len(utf16.Encode([]rune(t.machineName))) + 1),
+					},
+				},
+			},
+		},
+	}); err != nil {
+		t.closeTunnel(ctx)
+		return nil, fmt.Errorf("gateway: authorize tunnel: %w", err)
+	}
+
+	return t, nil
+}
+
+// DialContext function creates the channel to the target server `addr`
+// (host:port) and returns the connection. Only "tcp" network is supported.
+func (t *Tunnel) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+	default:
+		return nil, fmt.Errorf("gateway: network %q is not supported", network)
+	}
+
+	host, p, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: %w", err)
+	}
+
+	port, err := strconv.ParseUint(p, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("gateway: invalid port %q", p)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return nil, ErrClosed
+	}
+
+	resp, err := t.cli.CreateChannel(ctx, &tsproxy.CreateChannelRequest{
+		TunnelContext: (*tsproxy.TunnelNoSerialize)(t.tunnel),
+		EndpointInfo: &tsproxy.EndpointInfo{
+			ResourceName: []string{host},
+			Port:         uint32(port),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gateway: create channel %s: %w", addr, err)
+	}
+
+	// the receive pipe is served by the dedicated connection.
+	rcv, err := tsproxy.NewInterfaceClient(ctx, t.cc, t.opts...)
+	if err != nil {
+		t.cli.CloseChannel(ctx, &tsproxy.CloseChannelRequest{Context: (*tsproxy.ChannelNoSerialize)(resp.ChannelContext)})
+		return nil, fmt.Errorf("gateway: bind receive pipe: %w", err)
+	}
+
+	return newChannel(t, rcv, resp.ChannelContext.ContextHandle(), resp.ChannelID, addr)
+}
+
+// Close function closes the tunnel. The channels must be closed before.
+func (t *Tunnel) Close(ctx context.Context) error {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.closeTunnel(ctx)
+}
+
+func (t *Tunnel) closeTunnel(ctx context.Context) error {
+	if _, err := t.cli.CloseTunnel(ctx, &tsproxy.CloseTunnelRequest{Context: (*tsproxy.TunnelSerialize)(t.tunnel)}); err != nil {
+		return fmt.Errorf("gateway: close tunnel: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	tsproxy "github.com/oiweiwei/go-msrpc/msrpc/tsgu/tsproxyrpcinterface/v1"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// fakeGateway is the gateway that echoes the data sent to the channel.
+type fakeGateway struct {
+	tsproxy.InterfaceServer
+	echo chan []byte
+}
+
+func (s *fakeGateway) CreateTunnel(ctx context.Context, req *tsproxy.CreateTunnelRequest) (*tsproxy.CreateTunnelResponse, error) {
+	return &tsproxy.CreateTunnelResponse{
+		PacketResponse: &tsproxy.Packet{
+			PacketID: packetTypeVersionCaps,
+			Packet:   &tsproxy.PacketTypeUnion{Value: &tsproxy.PacketTypeUnion_PacketVersionCaps{PacketVersionCaps: req.Packet.Packet.GetValue().(*tsproxy.PacketVersionCaps)}},
+		},
+		TunnelContext: &tsproxy.TunnelSerialize{UUID: &dtyp.GUID{Data1: 1}},
+	}, nil
+}
+
+func (s *fakeGateway) AuthorizeTunnel(ctx context.Context, req *tsproxy.AuthorizeTunnelRequest) (*tsproxy.AuthorizeTunnelResponse, error) {
+	return &tsproxy.AuthorizeTunnelResponse{
+		PacketResponse: &tsproxy.Packet{
+			PacketID: 20562,
+			Packet:   &tsproxy.PacketTypeUnion{Value: &tsproxy.PacketTypeUnion_PacketResponse{PacketResponse: &tsproxy.PacketResponse{Flags: packetTypeQuarRequest}}},
+		},
+	}, nil
+}
+
+func (s *fakeGateway) CreateChannel(ctx context.Context, req *tsproxy.CreateChannelRequest) (*tsproxy.CreateChannelResponse, error) {
+	return &tsproxy.CreateChannelResponse{ChannelContext: &tsproxy.ChannelSerialize{UUID: &dtyp.GUID{Data1: 2}}, ChannelID: 1}, nil
+}
+
+func (s *fakeGateway) CloseChannel(ctx context.Context, req *tsproxy.CloseChannelRequest) (*tsproxy.CloseChannelResponse, error) {
+	return &tsproxy.CloseChannelResponse{Context: &tsproxy.ChannelNoSerialize{UUID: &dtyp.GUID{}}}, nil
+}
+
+func (s *fakeGateway) CloseTunnel(ctx context.Context, req *tsproxy.CloseTunnelRequest) (*tsproxy.CloseTunnelResponse, error) {
+	return &tsproxy.CloseTunnelResponse{Context: &tsproxy.TunnelSerialize{UUID: &dtyp.GUID{}}}, nil
+}
+
+// handle function serves the raw send and receive pipe operations.
+func (s *fakeGateway) handle(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+	switch opNum {
+	case opSendToServer:
+		b := make([]byte, r.Len())
+		r.Read(b)
+		// skip the context handle and the message header.
+		s.echo <- b[20+12:]
+		return &rawResponse{}, nil
+	case opSetupReceivePipe:
+		// the single echo response with the return code.
+		return &rawResponse{data: <-s.echo}, nil
+	}
+	return tsproxy.NewInterfaceServerHandle(s)(ctx, opNum, r)
+}
+
+type rawResponse struct {
+	sendToServerOperation
+	data []byte
+}
+
+func (o *rawResponse) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	w.Write(o.data)
+	return w.WriteData(uint32(0))
+}
+
+func TestTunnel(t *testing.T) {
+
+	ctx := context.Background()
+
+	gw := &fakeGateway{echo: make(chan []byte, 1)}
+
+	srv := dcerpc.NewServer()
+	srv.Register(tsproxy.InterfaceSyntaxV1_3, gw.handle)
+
+	ln := dcerpc.NewMemoryListener()
+	defer ln.Close()
+
+	go srv.Serve(ln)
+
+	cc, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[3388]", dcerpc.WithDialer(ln), dcerpc.WithNewTransport())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer cc.Close(ctx)
+
+	tunnel, err := New(ctx, cc, dcerpc.WithInsecure(), WithMachineName("client"))
+	if err != nil {
+		t.Fatalf("new tunnel: %v", err)
+	}
+
+	conn, err := tunnel.DialContext(ctx, "tcp", "10.0.0.5:135")
+	if err != nil {
+		t.Fatalf("dial channel: %v", err)
+	}
+
+	// the pdu header with the fragment length and 4 bytes of data.
+	pdu := make([]byte, dcerpc.HeaderSize+4)
+	pdu[0], pdu[2], pdu[4] = 5, byte(dcerpc.PacketTypeRequest), 0x10
+	binary.LittleEndian.PutUint16(pdu[8:], uint16(len(pdu)))
+	copy(pdu[dcerpc.HeaderSize:], "ping")
+
+	if _, err := conn.Write(pdu); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	b := make([]byte, len(pdu))
+	if _, err := io.ReadFull(conn, b); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	if !bytes.Equal(b, pdu) {
+		t.Fatalf("unexpected echo: %x", b)
+	}
+
+	// the receive pipe is completed.
+	if _, err := conn.Read(b); err != io.EOF {
+		t.Fatalf("expected eof, got %v", err)
+	}
+
+	if err := conn.Close(); err != nil {
+		t.Fatalf("close channel: %v", err)
+	}
+
+	if err := tunnel.Close(ctx); err != nil {
+		t.Fatalf("close tunnel: %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package gateway
+
+// message.go contains the TsProxySendToServer and TsProxySetupReceivePipe
+// operations. The stub data of these operations is not NDR-encoded (see
+// MS-TSGU 2.2.9.3 and 2.2.9.4), so the generated stubs cannot be used.
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+const (
+	// TsProxySetupReceivePipe.
+	opSetupReceivePipe = 8
+	// TsProxySendToServer.
+	opSendToServer = 9
+)
+
+// sendToServerOperation is the TsProxySendToServer generic send data
+// message packet with the single buffer.
+type sendToServerOperation struct {
+	handle []byte
+	data   []byte
+	Return uint32
+}
+
+func (o *sendToServerOperation) OpNum() int     { return opSendToServer }
+func (o *sendToServerOperation) OpName() string { return "/TsProxyRpcInterface/v1/TsProxySendToServer" }
+
+func (o *sendToServerOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	b := make([]byte, 12, 12+len(o.data))
+	// totalDataBytes (includes the buffer length fields).
+	binary.BigEndian.PutUint32(b[0:], uint32(len(o.data)+4))
+	// numBuffers.
+	binary.BigEndian.PutUint32(b[4:], 1)
+	// buffer1Length.
+	binary.BigEndian.PutUint32(b[8:], uint32(len(o.data)))
+	if _, err := w.Write(o.handle); err != nil {
+		return err
+	}
+	_, err := w.Write(append(b, o.data...))
+	return err
+}
+
+func (o *sendToServerOperation) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	return r.ReadData(&o.Return)
+}
+
+func (o *sendToServerOperation) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	return nil
+}
+
+func (o *sendToServerOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return nil
+}
+
+// receivePipeOperation is the TsProxySetupReceivePipe operation. The gateway
+// streams the data received from the target server in the response PDUs of
+// the call, the call is completed with the return code once the channel is
+// closed. The writer is closed when the final response is received.
+type receivePipeOperation struct {
+	handle []byte
+	w      *io.PipeWriter
+}
+
+func (o *receivePipeOperation) OpNum() int { return opSetupReceivePipe }
+func (o *receivePipeOperation) OpName() string {
+	return "/TsProxyRpcInterface/v1/TsProxySetupReceivePipe"
+}
+
+func (o *receivePipeOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	_, err := w.Write(o.handle)
+	return err
+}
+
+// UnmarshalNDRResponse function reads the response stub as the sequence of the
+// connection-oriented PDUs. The read blocks until the requested number of bytes
+// is received (or the call is completed), so the stream is split by the PDU
+// header to deliver each PDU as soon as it is received.
+func (o *receivePipeOperation) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	err := o.unmarshalStream(ctx, r)
+	o.w.CloseWithError(err)
+	return err
+}
+
+func (o *receivePipeOperation) unmarshalStream(ctx context.Context, r ndr.Reader) error {
+
+	hdr := make([]byte, dcerpc.HeaderSize)
+
+	for {
+		n, err := r.Read(hdr)
+		if err != nil && (err != io.ErrUnexpectedEOF || n == len(hdr)) {
+			return err
+		}
+
+		if n < len(hdr) {
+			// the final response contains the return code only.
+			if n < 4 {
+				return io.ErrUnexpectedEOF
+			}
+			if ret := binary.LittleEndian.Uint32(hdr[n-4:]); ret != 0 {
+				return fmt.Errorf("gateway: receive pipe: return code 0x%08x", ret)
+			}
+			return nil
+		}
+
+		// the fragment length.
+		sz := int(binary.LittleEndian.Uint16(hdr[8:]))
+		if hdr[4]&0x10 == 0 {
+			// big-endian data representation.
+			sz = int(binary.BigEndian.Uint16(hdr[8:]))
+		}
+
+		if sz < len(hdr) {
+			return io.ErrUnexpectedEOF
+		}
+
+		pdu := make([]byte, sz)
+		copy(pdu, hdr)
+
+		if _, err = r.Read(pdu[len(hdr):]); err != nil {
+			return err
+		}
+
+		if _, err := o.w.Write(pdu); err != nil {
+			return err
+		}
+	}
+}
+
+func (o *receivePipeOperation) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	return nil
+}
+
+func (o *receivePipeOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return nil
+}
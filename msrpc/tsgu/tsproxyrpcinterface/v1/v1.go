@@ -980,6 +980,12 @@ func (o *EndpointInfo) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadData(&o.Port); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ResourceNamesLength", o.ResourceNamesLength, 0, 50); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("AlternateResourceNamesLength", o.AlternateResourceNamesLength, 0, 3); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1489,6 +1495,9 @@ func (o *PacketVersionCaps) UnmarshalNDR(ctx context.Context, w ndr.Reader) erro
 	if err := w.ReadData(&o.QuarantineCapabilities); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("CapabilitiesLength", o.CapabilitiesLength, 0, 32); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1759,6 +1768,12 @@ func (o *PacketQuarantineRequest) UnmarshalNDR(ctx context.Context, w ndr.Reader
 	if err := w.ReadData(&o.DataLength); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("NameLength", o.NameLength, 0, 513); err != nil {
+		return err
+	}
+	if err := ndr.CheckRange("DataLength", o.DataLength, 0, 8000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2176,6 +2191,9 @@ func (o *PacketResponse) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := o.RedirectionFlags.UnmarshalNDR(ctx, w); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("ResponseDataLength", o.ResponseDataLength, 0, 24000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2382,6 +2400,9 @@ func (o *PacketQuarantineEncResponse) UnmarshalNDR(ctx context.Context, w ndr.Re
 	if err := w.ReadPointer(&o.VersionCaps, _s_versionCaps, _ptr_versionCaps); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("CertChainLength", o.CertChainLength, 0, 24000); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2563,6 +2584,9 @@ func (o *PacketStringMessage) UnmarshalNDR(ctx context.Context, w ndr.Reader) er
 	if err := w.ReadPointer(&o.MessageBuffer, _s_msgBuffer, _ptr_msgBuffer); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("MessageBytes", o.MessageBytes, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3178,6 +3202,9 @@ func (o *PacketAuth) UnmarshalNDR(ctx context.Context, w ndr.Reader) error {
 	if err := w.ReadPointer(&o.Cookie, _s_cookie, _ptr_cookie); err != nil {
 		return err
 	}
+	if err := ndr.CheckRange("CookieLength", o.CookieLength, 0, 65536); err != nil {
+		return err
+	}
 	return nil
 }
 
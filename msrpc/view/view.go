@@ -0,0 +1,257 @@
+// The view package contains the field filters that are used to export
+// the RPC responses containing the personal data (host names, hardware
+// addresses, user names in the lease records, sessions, etc) with the
+// configurable masking.
+//
+// The view operates on the JSON representation of the value (the generated
+// structures carry the `json` tags), so the rule paths are the JSON field
+// names separated by dots:
+//
+//	resp, err := cli.EnumSubnetClientsV4(ctx, req)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	b, err := view.New(view.PersonalData...).WithSalt(salt).Marshal(resp)
+//
+// The path segment can be the glob pattern (see path.Match), the "**" segment
+// matches any number of segments, the array indices are not the part of the
+// path:
+//
+//	"clients.client_name"          // exact path.
+//	"**.client_name"               // client_name field at any depth.
+//	"**.*_host_name"               // any field ending with _host_name.
+package view
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Action is the masking action applied to the field.
+type Action int
+
+const (
+	// Keep the field as-is. (Can be used to exclude the
+	// field from the broader rule).
+	Keep Action = iota
+	// Omit the field from the output.
+	Omit
+	// Redact replaces the field value with the placeholder.
+	Redact
+	// Hash replaces the field value with the keyed hash of the value,
+	// so that the records can be correlated without disclosing the value.
+	Hash
+	// Partial keeps the last `Rule.Keep` characters of the string
+	// value and masks the rest. (The value that is not longer than
+	// `Rule.Keep` is masked entirely, non-string value is redacted).
+	Partial
+)
+
+func (a Action) String() string {
+	switch a {
+	case Keep:
+		return "keep"
+	case Omit:
+		return "omit"
+	case Redact:
+		return "redact"
+	case Hash:
+		return "hash"
+	case Partial:
+		return "partial"
+	}
+	return "unknown"
+}
+
+// Rule is the field masking rule.
+type Rule struct {
+	// The field path.
+	Path string `json:"path"`
+	// The masking action.
+	Action Action `json:"action"`
+	// The number of characters to keep for Partial action.
+	Keep int `json:"keep,omitempty"`
+}
+
+// PersonalData is the set of rules that hashes the fields that commonly
+// contain the personal data.
+var PersonalData = []Rule{
+	{Path: "**.*host_name*", Action: Hash},
+	{Path: "**.*computer_name*", Action: Hash},
+	{Path: "**.*client_name*", Action: Hash},
+	{Path: "**.*user_name*", Action: Hash},
+	{Path: "**.*account_name*", Action: Hash},
+	{Path: "**.full_name", Action: Hash},
+	{Path: "**.*email*", Action: Hash},
+	{Path: "**.*hardware_address*", Action: Hash},
+	{Path: "**.*mac_address*", Action: Hash},
+	{Path: "**.client_comment", Action: Redact},
+	{Path: "**.comment", Action: Redact},
+}
+
+// The default placeholder.
+const DefaultPlaceholder = "[REDACTED]"
+
+// View is the set of masking rules.
+type View struct {
+	// The masking rules, the last matching rule wins.
+	Rules []Rule
+	// The key for Hash action.
+	Salt []byte
+	// The placeholder for Redact action.
+	Placeholder string
+}
+
+// New function returns the new view with the set of rules.
+func New(rules ...Rule) *View {
+	return &View{Rules: rules, Placeholder: DefaultPlaceholder}
+}
+
+// WithSalt function sets the key for the Hash action. The key must be
+// kept secret, otherwise the hashed values can be recovered by brute force.
+func (v *View) WithSalt(salt []byte) *View {
+	v.Salt = salt
+	return v
+}
+
+// With function appends the rules to the view.
+func (v *View) With(rules ...Rule) *View {
+	v.Rules = append(v.Rules, rules...)
+	return v
+}
+
+// Apply function returns the generic (maps, slices and scalars) representation
+// of value `val` with masking applied.
+func (v *View) Apply(val any) (any, error) {
+
+	b, err := json.Marshal(val)
+	if err != nil {
+		return nil, fmt.Errorf("view: marshal: %w", err)
+	}
+
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("view: unmarshal: %w", err)
+	}
+
+	out, _ = v.apply(nil, out)
+
+	return out, nil
+}
+
+// Marshal function returns the JSON representation of value `val` with
+// masking applied.
+func (v *View) Marshal(val any) ([]byte, error) {
+
+	out, err := v.Apply(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(out)
+}
+
+// apply function applies the masking to the value located at `path`,
+// returns `false` if the value must be omitted.
+func (v *View) apply(path []string, val any) (any, bool) {
+
+	if len(path) > 0 {
+		if rule, ok := v.match(path); ok && rule.Action != Keep {
+			return v.mask(rule, val)
+		}
+	}
+
+	switch val := val.(type) {
+	case map[string]any:
+		for k := range val {
+			if masked, ok := v.apply(append(path, k), val[k]); ok {
+				val[k] = masked
+			} else {
+				delete(val, k)
+			}
+		}
+	case []any:
+		for i := range val {
+			// array elements share the path of the array.
+			val[i], _ = v.apply(path, val[i])
+		}
+	}
+
+	return val, true
+}
+
+// match function returns the last matching rule for the path.
+func (v *View) match(path []string) (Rule, bool) {
+	for i := len(v.Rules) - 1; i >= 0; i-- {
+		if matchPath(strings.Split(v.Rules[i].Path, "."), path) {
+			return v.Rules[i], true
+		}
+	}
+	return Rule{}, false
+}
+
+// matchPath function matches the pattern segments against the path.
+func matchPath(pattern, p []string) bool {
+
+	if len(pattern) == 0 {
+		return len(p) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(p); i++ {
+			if matchPath(pattern[1:], p[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(p) == 0 {
+		return false
+	}
+
+	if ok, _ := path.Match(pattern[0], p[0]); !ok {
+		return false
+	}
+
+	return matchPath(pattern[1:], p[1:])
+}
+
+// mask function applies the rule to the value.
+func (v *View) mask(rule Rule, val any) (any, bool) {
+
+	if val == nil {
+		// nothing to disclose.
+		return nil, rule.Action != Omit
+	}
+
+	switch rule.Action {
+	case Omit:
+		return nil, false
+	case Redact:
+		return v.Placeholder, true
+	case Hash:
+		b, _ := json.Marshal(val)
+		mac := hmac.New(sha256.New, v.Salt)
+		mac.Write(b)
+		return "sha256:" + hex.EncodeToString(mac.Sum(nil))[:16], true
+	case Partial:
+		s, ok := val.(string)
+		if !ok {
+			return v.Placeholder, true
+		}
+		r := []rune(s)
+		if rule.Keep >= len(r) {
+			return strings.Repeat("*", len(r)), true
+		}
+		return strings.Repeat("*", len(r)-rule.Keep) + string(r[len(r)-rule.Keep:]), true
+	}
+
+	return val, true
+}
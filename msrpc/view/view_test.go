@@ -0,0 +1,79 @@
+package view
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dhcpm"
+)
+
+func TestView(t *testing.T) {
+
+	clients := &dhcpm.ClientInfoArrayV4{
+		ElementsLength: 1,
+		Clients: []*dhcpm.ClientInfoV4{{
+			ClientIPAddress:       0x0a000001,
+			ClientName:            "laptop-jdoe.contoso.net",
+			ClientComment:         "John's laptop",
+			ClientHardwareAddress: &dhcpm.ClientUID{DataLength: 6, Data: []byte{0, 1, 2, 3, 4, 5}},
+			OwnerHost:             &dhcpm.HostInfo{IPAddress: 0x0a0000fe, HostName: "dhcp01"},
+		}},
+	}
+
+	v := New(PersonalData...).WithSalt([]byte("secret")).With(
+		Rule{Path: "**.owner_host.host_name", Action: Keep},
+		Rule{Path: "**.client_ip_address", Action: Omit},
+	)
+
+	b, err := v.Marshal(clients)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	for _, leak := range []string{"jdoe", "John", "AAECAwQF", "client_ip_address"} {
+		if strings.Contains(string(b), leak) {
+			t.Errorf("view: %q is disclosed: %s", leak, b)
+		}
+	}
+
+	var out struct {
+		Clients []struct {
+			ClientName            string          `json:"client_name"`
+			ClientComment         string          `json:"client_comment"`
+			ClientHardwareAddress string          `json:"client_hardware_address"`
+			OwnerHost             *dhcpm.HostInfo `json:"owner_host"`
+		} `json:"clients"`
+	}
+
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if len(out.Clients) != 1 {
+		t.Fatalf("view: unexpected output: %s", b)
+	}
+
+	c := out.Clients[0]
+
+	if !strings.HasPrefix(c.ClientName, "sha256:") || !strings.HasPrefix(c.ClientHardwareAddress, "sha256:") {
+		t.Errorf("view: expected hashed values: %s", b)
+	}
+
+	if c.ClientComment != DefaultPlaceholder {
+		t.Errorf("view: expected redacted comment: %s", b)
+	}
+
+	if c.OwnerHost == nil || c.OwnerHost.HostName != "dhcp01" {
+		t.Errorf("view: expected kept owner host name: %s", b)
+	}
+
+	// hash must be stable for correlation.
+	if b2, _ := v.Marshal(clients); string(b) != string(b2) {
+		t.Errorf("view: output is not deterministic")
+	}
+
+	if s, _ := New(Rule{Path: "name", Action: Partial, Keep: 4}).Apply(map[string]string{"name": "0123456789"}); s.(map[string]any)["name"] != "******6789" {
+		t.Errorf("view: unexpected partial mask: %v", s)
+	}
+}
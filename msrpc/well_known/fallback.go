@@ -0,0 +1,247 @@
+package well_known
+
+// fallback.go contains the well-known endpoint fallback table and the endpoint
+// mapper that falls back to the well-known endpoints when the endpoint mapper
+// of the server cannot be reached.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+// The dynamic port range (RFC 6335) the Windows RPC services are registered on
+// when the port is not pinned with the service configuration.
+const (
+	DynamicPortFirst = 49152
+	DynamicPortLast  = 65535
+)
+
+var (
+	// ErrNoFallbackEndpoint is returned when the endpoint mapper failed and
+	// the interface has no well-known endpoints.
+	ErrNoFallbackEndpoint = errors.New("well_known: no fallback endpoint")
+)
+
+// The default duration the endpoint mapper of the host is not queried after
+// the failure.
+var DefaultFallbackRetryAfter = 5 * time.Minute
+
+// fallbackEndpoints is the curated table of the additional endpoints the
+// interfaces are reachable on besides the ones from the specification.
+var fallbackEndpoints = map[uuid.UUID][]string{
+	// the lsass pipe aliases.
+	MSSAMRSamr:    {"ncacn_np:lsass"},
+	MSLSADLsarpc:  {"ncacn_np:lsass"},
+	MSLSATLsarpc:  {"ncacn_np:lsass"},
+	MSNRPCLogon:   {"ncacn_np:lsass"},
+	MSDSSPDssetup: {"ncacn_np:lsass"},
+	MSEFSRLsarpc:  {"ncacn_np:lsass", "ncacn_np:netlogon", "ncacn_np:samr"},
+	// the task scheduler shares the atsvc pipe.
+	MSTSCHITaskSchedulerService: {"ncacn_np:atsvc"},
+	MSTSCHSASec:                 {"ncacn_np:atsvc"},
+	// the endpoint mapper is also reachable over rpc over http.
+	MSRPCEEndpointMapper: {"ncacn_http:593"},
+}
+
+// dynamicEndpoints is the set of the interfaces that are registered only on
+// the dynamic ports (see DynamicPortFirst, DynamicPortLast).
+var dynamicEndpoints = map[uuid.UUID]bool{
+	MSDRSRDrsuapi:        true,
+	MSDRSRDsaop:          true,
+	MSEVEN6Eventlog:      true,
+	MSPARIRemoteWinspool: true,
+}
+
+// FallbackEndpoint function returns the well-known endpoints (the endpoints from
+// the specification followed by the curated aliases) for the interface.
+func (u UUID) FallbackEndpoint() []string {
+
+	ret := u.WellKnownEndpoint()
+
+	for _, endpoint := range fallbackEndpoints[(uuid.UUID)(u)] {
+		if !contains(ret, endpoint) {
+			ret = append(ret, endpoint)
+		}
+	}
+
+	return ret
+}
+
+// DynamicEndpoint function returns `true` if the interface is registered only
+// on the dynamic port, and thus cannot be reached without the endpoint mapper
+// unless the port hint is provided (see FallbackMapper).
+func (u UUID) DynamicEndpoint() bool {
+	return dynamicEndpoints[(uuid.UUID)(u)]
+}
+
+// FallbackEndpointMapper function returns the option that sets the endpoint
+// mapper that falls back to the well-known endpoints when the endpoint mapper
+// `m` fails (for example, when the port 135 is firewalled):
+//
+//	conn, err := dcerpc.Dial(ctx, "dc01.contoso.net", well_known.FallbackEndpointMapper(epm.NewAutoMapper(dcerpc.WithSign())))
+func FallbackEndpointMapper(m dcerpc.EndpointMapper) dcerpc.Option {
+	return dcerpc.WithEndpointMapper(NewFallbackMapper(m))
+}
+
+// FallbackMapper is the endpoint mapper that falls back to the port hints and
+// the well-known endpoints (see FallbackEndpoint) when the endpoint mapper fails.
+// The endpoint mapper of the host that failed is not queried for RetryAfter, so
+// that the firewalled endpoint mapper does not delay every bind.
+type FallbackMapper struct {
+	// The endpoint mapper (if nil, only the fallback endpoints are used).
+	Mapper dcerpc.EndpointMapper
+	// The port hints: the string bindings ("ncacn_ip_tcp:49670") for the
+	// interfaces registered on the ports pinned with the service configuration.
+	// The hints are tried before the well-known endpoints.
+	Hints map[uuid.UUID][]string
+	// The duration the endpoint mapper of the host is not queried after the
+	// failure (DefaultFallbackRetryAfter if zero).
+	RetryAfter time.Duration
+
+	mu     sync.Mutex
+	failed map[string]*fallbackFailure
+}
+
+// fallbackFailure is the endpoint mapper failure.
+type fallbackFailure struct {
+	// The endpoint mapper error.
+	err error
+	// The time the endpoint mapper is queried again.
+	until time.Time
+}
+
+// NewFallbackMapper function returns the endpoint mapper that falls back to the
+// well-known endpoints when the endpoint mapper `m` fails.
+func NewFallbackMapper(m dcerpc.EndpointMapper) *FallbackMapper {
+	return &FallbackMapper{Mapper: m}
+}
+
+// WithHint function adds the port hint for the interface and returns the mapper.
+func (m *FallbackMapper) WithHint(u *uuid.UUID, bindings ...string) *FallbackMapper {
+
+	if m.Hints == nil {
+		m.Hints = make(map[uuid.UUID][]string)
+	}
+
+	m.Hints[*u] = append(m.Hints[*u], bindings...)
+
+	return m
+}
+
+// Map function maps the binding with the endpoint mapper, and if the endpoint
+// mapper fails, with the port hints and the well-known endpoints.
+func (m *FallbackMapper) Map(ctx context.Context, in *dcerpc.Binding) ([]dcerpc.StringBinding, error) {
+
+	host := strings.ToLower(in.StringBinding.NetworkAddress)
+	if host == "" {
+		host = strings.ToLower(in.StringBinding.ComputerName)
+	}
+
+	err := errors.New("endpoint mapper is not set")
+
+	if m.Mapper != nil {
+		if err = m.lastErr(host); err == nil {
+			var ret []dcerpc.StringBinding
+			if ret, err = m.Mapper.Map(ctx, in); err == nil {
+				return ret, nil
+			}
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			m.setFailed(host, err)
+		}
+	}
+
+	var ret []dcerpc.StringBinding
+
+	if in.SyntaxID.IfUUID != nil {
+		ret = append(ret, parseEndpoints(m.Hints[*in.SyntaxID.IfUUID], in)...)
+		ret = append(ret, parseEndpoints((*UUID)(in.SyntaxID.IfUUID).FallbackEndpoint(), in)...)
+	}
+
+	if len(ret) == 0 {
+		if in.SyntaxID.IfUUID != nil && (*UUID)(in.SyntaxID.IfUUID).DynamicEndpoint() {
+			return nil, fmt.Errorf("%w: %s is registered on the dynamic port (%d-%d), provide the port hint: %w",
+				ErrNoFallbackEndpoint, in.SyntaxID.IfUUID, DynamicPortFirst, DynamicPortLast, err)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrNoFallbackEndpoint, err)
+	}
+
+	return ret, nil
+}
+
+// Reset function clears the endpoint mapper failures, so that the endpoint
+// mapper is queried with the next lookup.
+func (m *FallbackMapper) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failed = nil
+}
+
+// lastErr function returns the error if the endpoint mapper of the host
+// has recently failed.
+func (m *FallbackMapper) lastErr(host string) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, ok := m.failed[host]
+	if !ok {
+		return nil
+	}
+
+	if time.Now().After(f.until) {
+		delete(m.failed, host)
+		return nil
+	}
+
+	return f.err
+}
+
+// setFailed function records the endpoint mapper failure for the host.
+func (m *FallbackMapper) setFailed(host string, err error) {
+
+	retryAfter := m.RetryAfter
+	if retryAfter <= 0 {
+		retryAfter = DefaultFallbackRetryAfter
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.failed == nil {
+		m.failed = make(map[string]*fallbackFailure)
+	}
+
+	m.failed[host] = &fallbackFailure{err: err, until: time.Now().Add(retryAfter)}
+}
+
+// Close function closes the endpoint mapper (if it implements the Close method).
+func (m *FallbackMapper) Close(ctx context.Context) error {
+	if closer, ok := m.Mapper.(interface{ Close(context.Context) error }); ok {
+		return closer.Close(ctx)
+	}
+	return nil
+}
+
+// contains function returns `true` if the endpoint is in the list.
+func contains(endpoints []string, endpoint string) bool {
+	for i := range endpoints {
+		if strings.EqualFold(endpoints[i], endpoint) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// XXX: interface guard.
+	_ dcerpc.EndpointMapper = (*FallbackMapper)(nil)
+)
@@ -0,0 +1,65 @@
+package well_known
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+)
+
+// testMapper is the endpoint mapper that fails with `err`.
+type testMapper struct {
+	err   error
+	calls int
+}
+
+func (m *testMapper) Map(ctx context.Context, in *dcerpc.Binding) ([]dcerpc.StringBinding, error) {
+	m.calls++
+	return nil, m.err
+}
+
+func TestFallbackMapper(t *testing.T) {
+
+	ctx := context.Background()
+
+	errRefused := errors.New("connection refused")
+
+	epm := &testMapper{err: errRefused}
+
+	m := NewFallbackMapper(epm).WithHint(&MSDRSRDrsuapi, "ncacn_ip_tcp:49670")
+
+	samr := &dcerpc.Binding{
+		SyntaxID:      dcerpc.SyntaxID{IfUUID: &MSSAMRSamr, IfVersionMajor: 1},
+		StringBinding: dcerpc.StringBinding{NetworkAddress: "10.0.0.1"},
+	}
+
+	bindings, err := m.Map(ctx, samr)
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+
+	if len(bindings) != 2 || bindings[0].Endpoint != "samr" || bindings[1].Endpoint != "lsass" {
+		t.Fatalf("unexpected bindings: %v", bindings)
+	}
+
+	drsr := &dcerpc.Binding{
+		SyntaxID:      dcerpc.SyntaxID{IfUUID: &MSDRSRDrsuapi, IfVersionMajor: 4},
+		StringBinding: dcerpc.StringBinding{NetworkAddress: "10.0.0.1"},
+	}
+
+	// the failed endpoint mapper is not queried again.
+	if bindings, err = m.Map(ctx, drsr); err != nil || epm.calls != 1 {
+		t.Fatalf("map: %v (calls %d)", err, epm.calls)
+	}
+
+	if len(bindings) != 1 || bindings[0].ProtocolSequence != dcerpc.ProtocolSequenceIPTCP || bindings[0].Endpoint != "49670" {
+		t.Fatalf("unexpected bindings: %v", bindings)
+	}
+
+	m.Hints = nil
+
+	if _, err = m.Map(ctx, drsr); !errors.Is(err, ErrNoFallbackEndpoint) || !errors.Is(err, errRefused) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -17,10 +17,16 @@ func EndpointMapper() dcerpc.Option {
 
 // Map function maps the provided syntax identifier to the string binding.
 func (m *WellKnownMapper) Map(ctx context.Context, in *dcerpc.Binding) ([]dcerpc.StringBinding, error) {
+	return parseEndpoints((*UUID)(in.SyntaxID.IfUUID).WellKnownEndpoint(), in), nil
+}
+
+// parseEndpoints function parses the well-known endpoints that match the
+// binding protocol sequence.
+func parseEndpoints(endpoints []string, in *dcerpc.Binding) []dcerpc.StringBinding {
 
 	var ret []dcerpc.StringBinding
 
-	for _, binding := range (*UUID)(in.SyntaxID.IfUUID).WellKnownEndpoint() {
+	for _, binding := range endpoints {
 
 		before, after, ok := strings.Cut(binding, ":")
 		if !ok {
@@ -38,7 +44,7 @@ func (m *WellKnownMapper) Map(ctx context.Context, in *dcerpc.Binding) ([]dcerpc
 		}
 	}
 
-	return ret, nil
+	return ret
 }
 
 var (
@@ -11499,6 +11499,9 @@ func (o *xxx_GetJoinableOUs2Operation) UnmarshalNDRResponse(ctx context.Context,
 			return err
 		}
 	}
+	if err := ndr.CheckSize("OUs", len(o.OUs), o.OUCount, "OUCount"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package ndr
 
 import (
+	"bufio"
 	"encoding/binary"
 	"io"
 	go_math "math"
@@ -214,6 +215,9 @@ func (c *chunk) Bytes() []byte {
 	return c.b
 }
 
+// ReadRepresentation function reads the data representation label and
+// switches the chunk to it, so that the data that follows the label is
+// decoded with the sender byte order and floating-point format.
 func (c *chunk) ReadRepresentation(drep *DataRepresentation) error {
 	p := make([]byte, 4)
 	if n, err := c.Read(p); err != nil || n < 4 {
@@ -224,9 +228,12 @@ func (c *chunk) ReadRepresentation(drep *DataRepresentation) error {
 	return nil
 }
 
+// WriteRepresentation function writes the data representation label and
+// switches the chunk to it.
 func (c *chunk) WriteRepresentation(drep DataRepresentation) error {
 	p := make([]byte, 4)
-	binary.LittleEndian.PutUint32(p, uint32(c.drep))
+	// the data representation label is byte-order independent.
+	binary.LittleEndian.PutUint32(p, uint32(drep))
 	if n, err := c.Write(p); err != nil || n < 4 {
 		return io.ErrShortWrite
 	}
@@ -395,3 +402,83 @@ func (c *WaitChunk) Len() int {
 
 // EOF function indicates whether the WaitChunk is completed.
 func (c *WaitChunk) EOF() bool { panic("wait_chunk: eof not supported") }
+
+// ReaderChunk structure represents the buffer that reads the data
+// directly from the reader (for example, the fragment reassembly
+// pipeline), so that the stub data is decoded without being buffered
+// entirely.
+type ReaderChunk struct {
+	// The buffered reader.
+	r *bufio.Reader
+	// The data representation.
+	drep DataRepresentation
+	// The expected stub data length (zero if unknown).
+	size int
+	// The number of bytes read.
+	n int
+}
+
+// NewReaderChunk function returns the chunk that reads the data from `r`.
+// The `size` is the expected stub data length (used for sanity check),
+// zero if unknown.
+func NewReaderChunk(r io.Reader, drep DataRepresentation, size int) *ReaderChunk {
+	return &ReaderChunk{r: bufio.NewReader(r), drep: drep, size: size}
+}
+
+// Read function implements the io.Reader interface. The short read
+// indicates the end of the stream.
+func (c *ReaderChunk) Read(p []byte) (int, error) {
+	n, err := io.ReadFull(c.r, p)
+	if c.n += n; err == io.EOF || err == io.ErrUnexpectedEOF {
+		return n, nil
+	}
+	return n, err
+}
+
+// Write function implements the io.Writer interface (not supported).
+func (c *ReaderChunk) Write(p []byte) (int, error) {
+	return 0, io.ErrShortWrite
+}
+
+// Order function returns the byte order for the buffer chunk.
+func (c *ReaderChunk) Order() binary.ByteOrder { return c.drep.ByteOrder() }
+
+// Float function return the floating-point format for the buffer chunk.
+func (c *ReaderChunk) Float() math.FloatFormat { return c.drep.FloatFormat() }
+
+// Bytes function returns the bytes written/not-yet-read for the
+// chunk (not supported).
+func (c *ReaderChunk) Bytes() []byte { return nil }
+
+// ReadRepresentation function reads the data representation label and
+// switches the chunk to it.
+func (c *ReaderChunk) ReadRepresentation(drep *DataRepresentation) error {
+	p := make([]byte, 4)
+	if n, err := c.Read(p); err != nil || n < 4 {
+		return io.ErrUnexpectedEOF
+	}
+	c.drep = (DataRepresentation)(binary.LittleEndian.Uint32(p))
+	*drep = c.drep
+	return nil
+}
+
+// WriteRepresentation function writes the data representation label
+// (not supported).
+func (c *ReaderChunk) WriteRepresentation(drep DataRepresentation) error {
+	return io.ErrShortWrite
+}
+
+// Len function returns the remaining stub data length (used for sanity
+// check).
+func (c *ReaderChunk) Len() int {
+	if c.size > 0 {
+		return max(c.size-c.n, 0)
+	}
+	return go_math.MaxInt
+}
+
+// EOF function indicates whether the stream is completed.
+func (c *ReaderChunk) EOF() bool {
+	_, err := c.r.Peek(1)
+	return err != nil
+}
@@ -0,0 +1,179 @@
+// Package ndr implements the Network Data Representation (NDR2.0 and NDR64)
+// encoding used by DCE/RPC.
+//
+// The generated stubs (github.com/oiweiwei/go-msrpc/msrpc/...) are built on
+// top of the Reader and Writer interfaces of this package, the same interfaces
+// can be used to hand-write the codecs for the interfaces that were not
+// generated yet.
+//
+// # Writing Codecs
+//
+// The type must implement Marshaler and Unmarshaler interfaces. The primitive
+// values are written with WriteData (the value is aligned to its natural
+// alignment), the structures are aligned explicitly with WriteAlign (the
+// alignment of the largest member):
+//
+//	type Entry struct {
+//		ID   uint32
+//		Name string
+//		Data []byte
+//	}
+//
+//	func (o *Entry) MarshalNDR(ctx context.Context, w ndr.Writer) error {
+//		if err := w.WriteAlign(4); err != nil {
+//			return err
+//		}
+//		if err := w.WriteData(o.ID); err != nil {
+//			return err
+//		}
+//		// [string] wchar_t* Name.
+//		if err := w.WritePointer(&o.Name, ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+//			return ndr.WriteUTF16NString(ctx, w, o.Name)
+//		})); err != nil {
+//			return err
+//		}
+//		// [size_is(...)] byte* Data.
+//		return w.WritePointer(&o.Data, ndr.MarshalNDRFunc(func(ctx context.Context, w ndr.Writer) error {
+//			return ndr.WriteConformantArray(ctx, w, o.Data, ndr.WriteElem[byte])
+//		}))
+//	}
+//
+//	func (o *Entry) UnmarshalNDR(ctx context.Context, r ndr.Reader) error {
+//		if err := r.ReadAlign(4); err != nil {
+//			return err
+//		}
+//		if err := r.ReadData(&o.ID); err != nil {
+//			return err
+//		}
+//		if err := r.ReadPointer(&o.Name, func(p any) { o.Name = *p.(*string) }, ndr.UnmarshalNDRFunc(func(ctx context.Context, r ndr.Reader) error {
+//			return ndr.ReadUTF16NString(ctx, r, &o.Name)
+//		})); err != nil {
+//			return err
+//		}
+//		return r.ReadPointer(&o.Data, func(p any) { o.Data = *p.(*[]byte) }, ndr.UnmarshalNDRFunc(func(ctx context.Context, r ndr.Reader) error {
+//			return ndr.ReadConformantArray(ctx, r, &o.Data, ndr.ReadElem[byte])
+//		}))
+//	}
+//
+// # Pointers
+//
+// The pointer referent is written/read immediately with WritePointer/ReadPointer,
+// while the pointee is deferred: it is encoded when the top-level value is
+// complete (Marshal/Unmarshal), or when WriteDeferred/ReadDeferred is called
+// explicitly (after each operation parameter). The first argument of
+// WritePointer/ReadPointer must be the address of the field that holds the
+// pointer: it is used to alias the full pointers and to detect the cycles
+// (see FullPointer). The setter passed to ReadPointer is used to assign the
+// aliased value.
+//
+// The full pointer (ptr) referenced more than once is encoded once, and the
+// subsequent references carry the same referent ID. The FullPointer option
+// enables the full pointer semantics for all pointers, while Full marks the
+// single pointer:
+//
+//	w.WritePointer(ndr.Full(&o.Owner), ...)
+//	r.ReadPointer(ndr.Full(&o.Owner), func(p any) { o.Owner = *p.(**Node) }, ...)
+//
+// # Arrays
+//
+// The conformant (size_is), varying (length_is) and conformant-varying arrays
+// are encoded with WriteConformantArray, WriteVaryingArray, WriteConformantVaryingArray
+// and the corresponding Read* functions. Note, that the maximum count of the conformant
+// array embedded into the structure must be written at the beginning of the structure
+// with WriteSize.
+//
+// # Reflection
+//
+// The custom or undocumented structures can be encoded without the code generator
+// using the Reflect function, the structure layout is described with the `ndr` struct
+// tags (see Value):
+//
+//	type ConnectInfo struct {
+//		Level   uint32
+//		Count   uint32
+//		Name    string   `ndr:"pointer"`
+//		Entries []uint32 `ndr:"pointer,size_is=Count"`
+//	}
+//
+//	b, err := ndr.Marshal(ndr.Reflect(&ConnectInfo{...}))
+//
+// # Streaming
+//
+// The UnmarshalFrom and UnmarshalFrom64 functions decode the stub data directly
+// from the io.Reader (for example, the fragment reassembly pipeline), so the
+// peak memory does not depend on the stub data length (only the decoded values
+// are retained). The NewReaderChunk function returns the chunk that can be passed
+// to NDR20 or NDR64 to construct the streaming reader:
+//
+//	r := ndr.NDR20(nil, drep, ndr.NewReaderChunk(pipe, drep, 0))
+//
+// # Lazy Arrays
+//
+// The LazyArray decodes the conformant array lazily: the array payload (the
+// elements and their pointees) is kept raw, and the elements are decoded on
+// demand by the iterator, so that the very large arrays (for example, the
+// enumeration responses) do not have to be decoded at once. The payload is
+// decoded twice, trading the CPU for the lower peak memory:
+//
+//	clients := ndr.NewLazyArray(ndr.ReadPointerElem[dhcpm.ClientInfoV5])
+//	...
+//	for it := clients.Iterator(); ; {
+//		client, err := it.Next(ctx)
+//		...
+//	}
+//
+// # Pooling
+//
+// The Marshal, Unmarshal, Marshal64 and Unmarshal64 functions reuse the pooled
+// codec state (scratch buffer, pointer maps). The high-frequency callers can
+// keep the reusable Encoder and Decoder (not safe for concurrent use):
+//
+//	enc, dec := ndr.NewEncoder(), ndr.NewDecoder()
+//
+//	for {
+//		// the bytes are valid until the next Encode call.
+//		b, err := enc.Encode(ctx, req)
+//		...
+//		err = dec.Decode(ctx, resp, out)
+//	}
+//
+// The UTF-16 strings (ReadUTF16String, ReadUTF16NString) are decoded directly from
+// the input into the string memory, without the intermediate []uint16 and []rune
+// slices (the `purego` build tag avoids the unsafe package at the cost of the copy),
+// see BenchmarkReadUTF16String.
+//
+// # Malformed Input
+//
+// The Unmarshal method of NDR20 and NDR64 readers (as well as the dcerpc response
+// decoding) never panics on the malformed input: the panic raised by the unmarshaler
+// (for example, index out of range) is returned as PanicError with the path of the
+// types being decoded, errors.Is(err, ndr.ErrPanic) reports such errors. The fuzzers
+// for the generated packages are located in msrpc/fuzz (make fuzz).
+//
+// The well-formed input can still declare the huge conformant arrays or strings, or
+// the deep pointer chains. The Limits option bounds the decoder resources, the input
+// that exceeds the limits fails with LimitError (errors.Is(err, ndr.ErrLimitExceeded)):
+//
+//	err := ndr.Unmarshal(b, resp, &ndr.Limits{MaxArrayCount: 4096, MaxPointerDepth: 64})
+//
+// The generated decoders enforce the range() attributes and the size_is() bounds
+// declared in the IDL (the array that has more elements than its size_is field
+// declares is rejected), the violation fails with RangeError (errors.Is(err,
+// ndr.ErrOutOfRange)).
+//
+// # Stability
+//
+// The following API follows the semantic versioning of the module and is not
+// changed in the backward incompatible way within the major version:
+//
+//   - Marshaler, Unmarshaler, Operation, Reader, Writer, NDR interfaces;
+//   - MarshalNDRFunc, UnmarshalNDRFunc adapters;
+//   - NDR20, NDR64, Marshal, Unmarshal, Marshal64, Unmarshal64 functions;
+//   - Opaque, FullPointer options and DataRepresentation;
+//   - string and array helpers (Write*String, Read*String, Write*Array, Read*Array).
+//
+// The methods may be added to Reader and Writer interfaces, so the external
+// implementations of these interfaces are not covered. The encoding of the
+// type serialization (MarshalWithTypeSerializationV1) and the internal buffers
+// (ChunkedBuffer, AlignBuffer) are the subject to change.
+package ndr
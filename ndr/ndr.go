@@ -1,4 +1,3 @@
-// package ndr implements the NDR encoding.
 package ndr
 
 import (
@@ -22,12 +21,14 @@ type Operation interface {
 	UnmarshalNDRResponse(context.Context, Reader) error
 }
 
-// Marshaler interface ...
+// Marshaler interface is implemented by the types that can marshal
+// themselves into NDR.
 type Marshaler interface {
 	MarshalNDR(context.Context, Writer) error
 }
 
-// Unmarshaler interface ...
+// Unmarshaler interface is implemented by the types that can unmarshal
+// themselves from NDR.
 type Unmarshaler interface {
 	UnmarshalNDR(context.Context, Reader) error
 }
@@ -61,7 +62,7 @@ type Buffer interface {
 	WithBytes([]byte) NDR
 }
 
-// NDR interface ...
+// NDR interface is the NDR encoder and decoder.
 type NDR interface {
 	// Writer.
 	Writer
@@ -69,6 +70,7 @@ type NDR interface {
 	Reader
 }
 
+// Reader interface is the NDR decoder.
 type Reader interface {
 
 	// Reader is a generic I/O writer interface.
@@ -118,7 +120,7 @@ type Reader interface {
 	ReadDeferred() error
 }
 
-// Writer interface ...
+// Writer interface is the NDR encoder.
 type Writer interface {
 
 	// Writer is a generic I/O writer interface.
@@ -167,7 +169,7 @@ type Writer interface {
 	WriteDeferred() error
 }
 
-// MarshalNDRFunc function ...
+// MarshalNDRFunc function adapts the function to the Marshaler interface.
 type MarshalNDRFunc func(context.Context, Writer) error
 
 // MarshalNDR function implements the Marshaler interface.
@@ -175,7 +177,7 @@ func (f MarshalNDRFunc) MarshalNDR(ctx context.Context, w Writer) error {
 	return f(ctx, w)
 }
 
-// UnmarshalNDRFunc function ...
+// UnmarshalNDRFunc function adapts the function to the Unmarshaler interface.
 type UnmarshalNDRFunc func(context.Context, Reader) error
 
 // UnmarshalNDR function implements the Unmarshaler interface.
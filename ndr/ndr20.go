@@ -6,6 +6,7 @@ package ndr
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 type opaque struct{}
@@ -26,10 +27,25 @@ var NoLayout noLayout
 
 // NDR20 function returns the NDR2.0 Marshaler/Unmarshaler.
 func NDR20(buf []byte, opts ...any) NDR {
-
 	ndr := &ndr20{
-		ptrs: make(map[uint64]Pointer),
-		drep: DefaultDataRepresentation,
+		ptrs:  make(map[uint64]*readReferent),
+		wptrs: make(map[pointerKey]*writeReferent),
+	}
+	ndr.reset(buf, opts)
+	return ndr
+}
+
+// reset function resets the codec state to decode/encode the buffer `buf`
+// with options `opts`, the pointer maps are retained to be reused.
+func (w *ndr20) reset(buf []byte, opts []any) {
+
+	clear(w.ptrs)
+	clear(w.wptrs)
+
+	*w = ndr20{
+		ptrs:  w.ptrs,
+		wptrs: w.wptrs,
+		drep:  DefaultDataRepresentation,
 	}
 
 	var chnk ChunkedBuffer
@@ -37,22 +53,25 @@ func NDR20(buf []byte, opts ...any) NDR {
 	for i := range opts {
 		switch o := opts[i].(type) {
 		case DataRepresentation:
-			ndr.drep = o
+			w.drep = o
 		case opaque:
-			ndr.opaque = true
+			w.opaque = true
 		case noLayout:
-			ndr.noLayout = true
+			w.noLayout = true
 		case debug:
-			ndr.debug = true
+			w.debug = true
+		case fullPointer:
+			w.full = true
+		case *Limits:
+			w.lim = o
 		case ChunkedBuffer:
 			chnk = o
 		}
 	}
 	if chnk == nil {
-		chnk = NewChunk(buf, ndr.drep)
+		chnk = NewChunk(buf, w.drep)
 	}
-	ndr.buf = NewAlignBuffer(chnk)
-	return ndr
+	w.buf = NewAlignBuffer(chnk)
 }
 
 // WithBytes function sets the current buffer bytes to value `b`.
@@ -60,23 +79,56 @@ func (w *ndr20) WithBytes(b []byte) NDR {
 	return &ndr20{
 		drep:     w.drep,
 		buf:      NewAlignBuffer(NewChunk(b, w.drep)),
-		ptrs:     make(map[uint64]Pointer),
+		ptrs:     make(map[uint64]*readReferent),
+		wptrs:    make(map[pointerKey]*writeReferent),
 		opaque:   w.opaque,
+		full:     w.full,
 		noLayout: w.noLayout,
 		noop:     w.noLayout,
+		lim:      w.lim,
 		err:      w.err,
 	}
 }
 
 // Marshal function marshals the data `d` using NDR2.0 format.
 func Marshal(d Marshaler, opts ...any) ([]byte, error) {
-	return NDR20(nil, opts...).Marshal(context.Background(), d)
+	if !pooled(opts) {
+		return NDR20(nil, opts...).Marshal(context.Background(), d)
+	}
+	e := encoders.Get().(*Encoder)
+	defer e.release()
+	return e.marshal(context.Background(), d, opts)
 }
 
 // Unmarshal function unmarshals the bytes `b` to the data `d`
 // using NDR2.0 format.
 func Unmarshal(b []byte, d Unmarshaler, opts ...any) error {
-	return NDR20(b, opts...).Unmarshal(context.Background(), d)
+	if !pooled(opts) {
+		return NDR20(b, opts...).Unmarshal(context.Background(), d)
+	}
+	dec := decoders.Get().(*Decoder)
+	defer dec.release()
+	return dec.unmarshal(context.Background(), b, d, opts)
+}
+
+// UnmarshalFrom function unmarshals the data `d` using NDR2.0 format
+// reading the stub data directly from the reader `r`. The stub data is
+// not buffered, so the peak memory does not depend on the stub data length.
+// The type serialization (see UnmarshalWithTypeSerializationV1) requires
+// the buffered stub data.
+func UnmarshalFrom(r io.Reader, d Unmarshaler, opts ...any) error {
+	return NDR20(nil, append(opts, NewReaderChunk(r, readerDataRepresentation(opts), 0))...).Unmarshal(context.Background(), d)
+}
+
+// readerDataRepresentation function returns the data representation set
+// in the options.
+func readerDataRepresentation(opts []any) DataRepresentation {
+	for i := range opts {
+		if drep, ok := opts[i].(DataRepresentation); ok {
+			return drep
+		}
+	}
+	return DefaultDataRepresentation
 }
 
 // The NDR2.0 implementation.
@@ -89,15 +141,26 @@ type ndr20 struct {
 	buf AlignBuffer
 	// Temporary location to hold the data for read/write.
 	put [8]byte
+	// Temporary location to hold the UTF-16 code units.
+	units [utf16Chunk * 2]byte
 	// The list of deferred write pointer.
 	wdeferred []Marshaler
 	// The list of deferred read pointers.
 	rdeferred []Unmarshaler
-	// The pointers map.
-	ptrs map[uint64]Pointer
+	// The pointers map (referent identifier to the pointee).
+	ptrs map[uint64]*readReferent
+	// The written pointers map (pointee to the referent identifier).
+	wptrs map[pointerKey]*writeReferent
 	// The flag that indicates whether to include NDR-related
 	// labels into the marshaled/unmarshaled output.
 	opaque, debug, noLayout, noop bool
+	// The flag that indicates whether all pointers must be
+	// aliased (see FullPointer).
+	full bool
+	// The decoding limits.
+	lim *Limits
+	// The current deferred pointer depth.
+	depth int
 }
 
 // Err function returns the NDR error.
@@ -194,7 +257,7 @@ func (w *ndr20) ReadSize(sz *uint64) error {
 	}
 
 	*sz = uint64(sz20)
-	return nil
+	return w.checkSizeLimit(*sz)
 }
 
 // ReadSwitch function reads the non-encapsulated NDR switch
@@ -321,6 +384,10 @@ func (w *ndr20) ReadData(d any) error {
 		return w.SetErr(err)
 	}
 
+	if err := w.checkBytesLimit(); err != nil {
+		return err
+	}
+
 	order, float := w.buf.Order(), w.buf.Float()
 
 	switch d := d.(type) {
@@ -382,7 +449,7 @@ func (w *ndr20) Read(p []byte) (n int, err error) {
 		return n, w.SetErr(err)
 	}
 
-	return n, nil
+	return n, w.checkBytesLimit()
 }
 
 // WriteData function writes the data type into buffer.
@@ -477,12 +544,7 @@ func (w *ndr20) ReadPointer(ptr Pointer, setter func(interface{}), mrs ...Unmars
 		return nil
 	}
 
-	if ptr, ok := w.ptrs[uint64(pptr)]; ok {
-		setter(interface{}(ptr))
-		return nil
-	}
-
-	w.ptrs[uint64(pptr)], w.rdeferred = ptr, append(w.rdeferred, mrs...)
+	w.readReferentID(uint64(pptr), ptr, setter, mrs)
 	return nil
 }
 
@@ -508,13 +570,9 @@ func (w *ndr20) WritePointer(ptr Pointer, mrs ...Marshaler) error {
 		return w.SetErr(w.WriteData(uint32(0)))
 	}
 
-	if err := w.WriteData(uint32(w.buf.Pos() + 1)); err != nil {
-		return w.SetErr(err)
-	}
-
-	w.wdeferred = append(w.wdeferred, mrs...)
+	id := w.writeReferentID(ptr, mrs)
 
-	return nil
+	return w.SetErr(w.WriteData(uint32(id)))
 }
 
 // WriteDeferred function writes the deferred pointer values.
@@ -541,7 +599,17 @@ func (w *ndr20) ReadDeferred() error {
 		return w.err
 	}
 
-	for _, deferred := range w.rDeferred() {
+	rdeferred := w.rDeferred()
+	if len(rdeferred) == 0 {
+		return nil
+	}
+
+	defer func() { w.depth-- }()
+	if err := w.enterDeferred(); err != nil {
+		return err
+	}
+
+	for _, deferred := range rdeferred {
 		// start new execution context for the marshaler.
 		if err := w.Unmarshal(context.Background(), deferred); err != nil {
 			return w.SetErr(err)
@@ -570,13 +638,20 @@ func (w *ndr20) Marshal(ctx context.Context, mrs Marshaler) ([]byte, error) {
 	return w.buf.Bytes(), nil
 }
 
-// Unmarshal function unmarshals the `mrs` from the buffer.
-func (w *ndr20) Unmarshal(ctx context.Context, mrs Unmarshaler) error {
+// Unmarshal function unmarshals the `mrs` from the buffer. The panic raised
+// while unmarshaling the malformed input is returned as PanicError.
+func (w *ndr20) Unmarshal(ctx context.Context, mrs Unmarshaler) (err error) {
 
 	if w.err != nil || w.noop {
 		return w.err
 	}
 
+	defer func() {
+		if r := recover(); r != nil {
+			err = w.SetErr(NewPanicError(r))
+		}
+	}()
+
 	if err := mrs.UnmarshalNDR(ctx, w); err != nil {
 		return w.SetErr(err)
 	}
@@ -3,6 +3,7 @@ package ndr
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 // NDR64 function returns the NDR64 Marshaler/Unmarshaler.
@@ -24,13 +25,29 @@ func (w *ndr64) WithBytes(b []byte) NDR {
 
 // Marshal64 function marshals the data `d` using NDR64 format.
 func Marshal64(d Marshaler, opts ...any) ([]byte, error) {
-	return NDR64(nil, opts...).Marshal(context.Background(), d)
+	if !pooled(opts) {
+		return NDR64(nil, opts...).Marshal(context.Background(), d)
+	}
+	e := encoders64.Get().(*Encoder)
+	defer e.release()
+	return e.marshal(context.Background(), d, opts)
 }
 
 // Unmarshal64 function unmarshals the bytes `b` to the data `d`
 // using NDR64 format.
 func Unmarshal64(b []byte, d Unmarshaler, opts ...any) error {
-	return NDR64(b, opts...).Unmarshal(context.Background(), d)
+	if !pooled(opts) {
+		return NDR64(b, opts...).Unmarshal(context.Background(), d)
+	}
+	dec := decoders64.Get().(*Decoder)
+	defer dec.release()
+	return dec.unmarshal(context.Background(), b, d, opts)
+}
+
+// UnmarshalFrom64 function unmarshals the data `d` using NDR64 format
+// reading the stub data directly from the reader `r` (see UnmarshalFrom).
+func UnmarshalFrom64(r io.Reader, d Unmarshaler, opts ...any) error {
+	return NDR64(nil, append(opts, NewReaderChunk(r, readerDataRepresentation(opts), 0))...).Unmarshal(context.Background(), d)
 }
 
 // WriteAlign function writes the alignment required for the data
@@ -81,7 +98,11 @@ func (w *ndr64) ReadSize(sz *uint64) error {
 		return w.err
 	}
 
-	return w.ReadData(sz)
+	if err := w.ReadData(sz); err != nil {
+		return err
+	}
+
+	return w.checkSizeLimit(*sz)
 }
 
 // ReadSwitch function reads the switch value from the buffer.
@@ -205,16 +226,7 @@ func (w *ndr64) ReadPointer(ptr Pointer, setter func(interface{}), mrs ...Unmars
 		return nil
 	}
 
-	/* NDR64 doesn't care about pointers.
-	if ptr, ok := w.ptrs[uint64(pptr)]; ok {
-		setter(interface{}(ptr))
-		return nil
-	}
-	w.ptrs[pptr], w.rdeferred = ptr, append(w.rdeferred, mrs...)
-	*/
-
-	w.rdeferred = append(w.rdeferred, mrs...)
-
+	w.readReferentID(pptr, ptr, setter, mrs)
 	return nil
 }
 
@@ -240,13 +252,9 @@ func (w *ndr64) WritePointer(ptr Pointer, mrs ...Marshaler) error {
 		return w.SetErr(w.WriteData(uint64(0)))
 	}
 
-	if err := w.WriteData(uint64(w.buf.Pos() + 1)); err != nil {
-		return w.SetErr(err)
-	}
-
-	w.wdeferred = append(w.wdeferred, mrs...)
+	id := w.writeReferentID(ptr, mrs)
 
-	return nil
+	return w.SetErr(w.WriteData(id))
 }
 
 // WriteDeferred function writes the deferred pointer values.
@@ -273,7 +281,17 @@ func (w *ndr64) ReadDeferred() error {
 		return w.err
 	}
 
-	for _, deferred := range w.rDeferred() {
+	rdeferred := w.rDeferred()
+	if len(rdeferred) == 0 {
+		return nil
+	}
+
+	defer func() { w.depth-- }()
+	if err := w.enterDeferred(); err != nil {
+		return err
+	}
+
+	for _, deferred := range rdeferred {
 		// start new execution context for the marshaler.
 		if err := w.Unmarshal(context.Background(), deferred); err != nil {
 			return w.SetErr(err)
@@ -302,13 +320,20 @@ func (w *ndr64) Marshal(ctx context.Context, mrs Marshaler) ([]byte, error) {
 	return w.buf.Bytes(), nil
 }
 
-// Unmarshal function unmarshals the `mrs` from the buffer.
-func (w *ndr64) Unmarshal(ctx context.Context, mrs Unmarshaler) error {
+// Unmarshal function unmarshals the `mrs` from the buffer. The panic raised
+// while unmarshaling the malformed input is returned as PanicError.
+func (w *ndr64) Unmarshal(ctx context.Context, mrs Unmarshaler) (err error) {
 
 	if w.err != nil || w.noop {
 		return w.err
 	}
 
+	defer func() {
+		if r := recover(); r != nil {
+			err = w.SetErr(NewPanicError(r))
+		}
+	}()
+
 	if err := mrs.UnmarshalNDR(ctx, w); err != nil {
 		return w.SetErr(err)
 	}
@@ -0,0 +1,201 @@
+package ndr
+
+// ndr_arrays.go module contains the helpers to encode/decode the
+// conformant, varying and conformant-varying arrays for the hand-written
+// codecs.
+
+import (
+	"context"
+	"fmt"
+)
+
+// WriteElem function writes the primitive array element (integer, float,
+// character or the type that implements alignment).
+func WriteElem[T any](ctx context.Context, w Writer, v T) error {
+	return w.WriteData(v)
+}
+
+// ReadElem function reads the primitive array element (integer, float,
+// character or the type that implements alignment).
+func ReadElem[T any](ctx context.Context, r Reader, v *T) error {
+	return r.ReadData(v)
+}
+
+// WriteMarshalerElem function writes the array element that implements
+// the Marshaler interface.
+func WriteMarshalerElem[T Marshaler](ctx context.Context, w Writer, v T) error {
+	return v.MarshalNDR(ctx, w)
+}
+
+// WritePointerElem function writes the array element that is the pointer to
+// the type that implements the Marshaler interface (the unique pointer, the
+// pointee is deferred).
+func WritePointerElem[T any, P interface {
+	*T
+	Marshaler
+}](ctx context.Context, w Writer, v P) error {
+	if v == nil {
+		return w.WritePointer(nil)
+	}
+	return w.WritePointer(&v, v)
+}
+
+// ReadPointerElem function reads the array element that is the pointer to
+// the type that implements the Unmarshaler interface.
+func ReadPointerElem[T any, P interface {
+	*T
+	Unmarshaler
+}](ctx context.Context, r Reader, v *P) error {
+	return r.ReadPointer(v, func(ptr any) { *v = *ptr.(*P) }, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		if *v == nil {
+			*v = P(new(T))
+		}
+		return (*v).UnmarshalNDR(ctx, r)
+	}))
+}
+
+// writeElems function writes the array elements.
+func writeElems[T any](ctx context.Context, w Writer, items []T, elem func(context.Context, Writer, T) error) error {
+	for i := range items {
+		if err := elem(ctx, w, items[i]); err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// readElems function reads `sz` array elements.
+func readElems[T any](ctx context.Context, r Reader, sz uint64, items *[]T, elem func(context.Context, Reader, *T) error) error {
+
+	if sz > uint64(r.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for array size %d", sz)
+	}
+
+	*items = make([]T, sz)
+
+	for i := range *items {
+		if err := elem(ctx, r, &(*items)[i]); err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// WriteConformantArray function writes the conformant array (size_is):
+// the maximum count followed by the elements.
+//
+// Note, that for the conformant array embedded into the structure, the
+// maximum count must be written at the beginning of the structure, use
+// WriteSize and WriteFixedArray instead.
+func WriteConformantArray[T any](ctx context.Context, w Writer, items []T, elem func(context.Context, Writer, T) error) error {
+
+	if err := w.WriteSize(uint64(len(items))); err != nil {
+		return err
+	}
+
+	return writeElems(ctx, w, items, elem)
+}
+
+// ReadConformantArray function reads the conformant array (size_is).
+func ReadConformantArray[T any](ctx context.Context, r Reader, items *[]T, elem func(context.Context, Reader, *T) error) error {
+
+	var sz uint64
+
+	if err := r.ReadSize(&sz); err != nil {
+		return err
+	}
+
+	return readElems(ctx, r, sz, items, elem)
+}
+
+// WriteVaryingArray function writes the varying array (length_is):
+// the offset and actual count followed by the elements starting from
+// the `offset`.
+func WriteVaryingArray[T any](ctx context.Context, w Writer, items []T, offset uint64, elem func(context.Context, Writer, T) error) error {
+
+	if offset > uint64(len(items)) {
+		return fmt.Errorf("varying array: offset %d exceeds length %d", offset, len(items))
+	}
+
+	if err := w.WriteSize(offset); err != nil {
+		return err
+	}
+
+	if err := w.WriteSize(uint64(len(items)) - offset); err != nil {
+		return err
+	}
+
+	return writeElems(ctx, w, items[offset:], elem)
+}
+
+// ReadVaryingArray function reads the varying array (length_is) and
+// returns the offset of the first transmitted element.
+func ReadVaryingArray[T any](ctx context.Context, r Reader, items *[]T, elem func(context.Context, Reader, *T) error) (uint64, error) {
+
+	var offset, sz uint64
+
+	if err := r.ReadSize(&offset); err != nil {
+		return 0, err
+	}
+
+	if err := r.ReadSize(&sz); err != nil {
+		return 0, err
+	}
+
+	return offset, readElems(ctx, r, sz, items, elem)
+}
+
+// WriteConformantVaryingArray function writes the conformant-varying array
+// (size_is, length_is): the maximum count, offset (always zero) and actual count
+// followed by the elements. If `maxCount` is less than number of elements,
+// the number of elements is used.
+func WriteConformantVaryingArray[T any](ctx context.Context, w Writer, items []T, maxCount uint64, elem func(context.Context, Writer, T) error) error {
+
+	if maxCount < uint64(len(items)) {
+		maxCount = uint64(len(items))
+	}
+
+	if err := w.WriteSize(maxCount); err != nil {
+		return err
+	}
+
+	return WriteVaryingArray(ctx, w, items, 0, elem)
+}
+
+// ReadConformantVaryingArray function reads the conformant-varying array
+// (size_is, length_is) and returns the maximum count.
+func ReadConformantVaryingArray[T any](ctx context.Context, r Reader, items *[]T, elem func(context.Context, Reader, *T) error) (uint64, error) {
+
+	var maxCount, offset, sz uint64
+
+	if err := r.ReadSize(&maxCount); err != nil {
+		return 0, err
+	}
+
+	if err := r.ReadSize(&offset); err != nil {
+		return 0, err
+	}
+
+	if err := r.ReadSize(&sz); err != nil {
+		return 0, err
+	}
+
+	if offset+sz > maxCount {
+		return 0, fmt.Errorf("conformant varying array: offset %d and length %d exceed maximum count %d", offset, sz, maxCount)
+	}
+
+	return maxCount, readElems(ctx, r, sz, items, elem)
+}
+
+// WriteFixedArray function writes the fixed array elements (no size
+// information).
+func WriteFixedArray[T any](ctx context.Context, w Writer, items []T, elem func(context.Context, Writer, T) error) error {
+	return writeElems(ctx, w, items, elem)
+}
+
+// ReadFixedArray function reads `sz` fixed array elements (no size
+// information).
+func ReadFixedArray[T any](ctx context.Context, r Reader, sz int, items *[]T, elem func(context.Context, Reader, *T) error) error {
+	return readElems(ctx, r, uint64(sz), items, elem)
+}
@@ -0,0 +1,170 @@
+package ndr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"testing/iotest"
+)
+
+type entry struct {
+	ID    uint32
+	Name  string
+	Data  []byte
+	Words []uint16
+}
+
+func (o *entry) MarshalNDR(ctx context.Context, w Writer) error {
+	if err := w.WriteAlign(4); err != nil {
+		return err
+	}
+	if err := w.WriteData(o.ID); err != nil {
+		return err
+	}
+	if err := w.WritePointer(&o.Name, MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+		return WriteUTF16NString(ctx, w, o.Name)
+	})); err != nil {
+		return err
+	}
+	if err := w.WritePointer(&o.Data, MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+		return WriteConformantArray(ctx, w, o.Data, WriteElem[byte])
+	})); err != nil {
+		return err
+	}
+	return WriteConformantVaryingArray(ctx, w, o.Words, 8, WriteElem[uint16])
+}
+
+func (o *entry) UnmarshalNDR(ctx context.Context, r Reader) error {
+	if err := r.ReadAlign(4); err != nil {
+		return err
+	}
+	if err := r.ReadData(&o.ID); err != nil {
+		return err
+	}
+	if err := r.ReadPointer(&o.Name, func(p any) { o.Name = *p.(*string) }, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		return ReadUTF16NString(ctx, r, &o.Name)
+	})); err != nil {
+		return err
+	}
+	if err := r.ReadPointer(&o.Data, func(p any) { o.Data = *p.(*[]byte) }, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		return ReadConformantArray(ctx, r, &o.Data, ReadElem[byte])
+	})); err != nil {
+		return err
+	}
+	maxCount, err := ReadConformantVaryingArray(ctx, r, &o.Words, ReadElem[uint16])
+	if err != nil {
+		return err
+	}
+	if maxCount != 8 {
+		return fmt.Errorf("unexpected max count: %d", maxCount)
+	}
+	return nil
+}
+
+func TestArrays(t *testing.T) {
+
+	in := &entry{ID: 7, Name: "entry", Data: []byte{1, 2, 3}, Words: []uint16{4, 5}}
+
+	for _, codec := range []struct {
+		Marshal   func(Marshaler, ...any) ([]byte, error)
+		Unmarshal func([]byte, Unmarshaler, ...any) error
+	}{
+		{Marshal, Unmarshal},
+		{Marshal64, Unmarshal64},
+	} {
+
+		b, err := codec.Marshal(in)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+
+		out := &entry{}
+		if err := codec.Unmarshal(b, out); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+
+		if !reflect.DeepEqual(in, out) {
+			t.Errorf("unmarshal: got %+v, expected %+v", out, in)
+		}
+	}
+}
+
+func TestUnmarshalFrom(t *testing.T) {
+
+	in := &entry{ID: 7, Name: "entry", Data: []byte{1, 2, 3}, Words: []uint16{4, 5}}
+
+	for _, codec := range []struct {
+		Marshal       func(Marshaler, ...any) ([]byte, error)
+		UnmarshalFrom func(io.Reader, Unmarshaler, ...any) error
+	}{
+		{Marshal, UnmarshalFrom},
+		{Marshal64, UnmarshalFrom64},
+	} {
+
+		b, err := codec.Marshal(in)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+
+		// the stub data is delivered byte by byte.
+		out := &entry{}
+		if err := codec.UnmarshalFrom(iotest.OneByteReader(bytes.NewReader(b)), out); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+
+		if !reflect.DeepEqual(in, out) {
+			t.Errorf("unmarshal: got %+v, expected %+v", out, in)
+		}
+
+		// the truncated stub data.
+		if err := codec.UnmarshalFrom(bytes.NewReader(b[:len(b)-2]), &entry{}); err == nil {
+			t.Errorf("unmarshal: expected error for truncated stub data")
+		}
+	}
+}
+
+func TestEncoderReuse(t *testing.T) {
+
+	ctx := context.Background()
+
+	for _, codec := range []struct {
+		Marshal func(Marshaler, ...any) ([]byte, error)
+		Encoder *Encoder
+		Decoder *Decoder
+	}{
+		{Marshal, NewEncoder(), NewDecoder()},
+		{Marshal64, NewEncoder64(), NewDecoder64()},
+	} {
+
+		for i := 0; i < 3; i++ {
+
+			in := &entry{ID: uint32(i), Name: fmt.Sprintf("entry-%d", i), Data: bytes.Repeat([]byte{byte(i)}, i*100), Words: []uint16{4, 5}}
+
+			expected, err := codec.Marshal(in)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			b, err := codec.Encoder.Encode(ctx, in)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+
+			if !bytes.Equal(b, expected) {
+				t.Fatalf("encode: got %x, expected %x", b, expected)
+			}
+
+			out := &entry{}
+			if err := codec.Decoder.Decode(ctx, b, out); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+
+			if !reflect.DeepEqual(in, out) {
+				t.Errorf("decode: got %+v, expected %+v", out, in)
+			}
+		}
+	}
+}
@@ -12,6 +12,8 @@ type DataRepresentation uint32
 var (
 	// The default data representation (ASCII, FloatIEEE, LittleEndian).
 	DefaultDataRepresentation = DataRepresentation(CharASCII | FloatingPointIEEE | ByteOrderLittleEndian)
+	// The big-endian data representation (ASCII, FloatIEEE, BigEndian).
+	BigEndianDataRepresentation = DataRepresentation(CharASCII | FloatingPointIEEE | ByteOrderBigEndian)
 )
 
 // ByteOrder function returns the byte order for the data
@@ -24,18 +26,27 @@ func (d DataRepresentation) ByteOrder() binary.ByteOrder {
 	return binary.BigEndian
 }
 
+// The floating-point formats converted to the interface once, so that
+// the FloatFormat function does not allocate.
+var (
+	floatIEEE   math.FloatFormat = math.IEEE
+	floatVax    math.FloatFormat = math.Vax
+	floatCray   math.FloatFormat = math.Cray
+	floatIBMHex math.FloatFormat = math.IBMHex
+)
+
 // FloatFormat function returns the floating-point format for
 // the data representation.
 func (d DataRepresentation) FloatFormat() math.FloatFormat {
 	switch (uint32)(d) & FloatingPointMask {
 	case FloatingPointVAX:
-		return math.Vax
+		return floatVax
 	case FloatingPointCray:
-		return math.Cray
+		return floatCray
 	case FloatingPointIBM:
-		return math.IBMHex
+		return floatIBMHex
 	}
-	return math.IEEE
+	return floatIEEE
 }
 
 var (
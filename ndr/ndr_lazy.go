@@ -0,0 +1,328 @@
+package ndr
+
+// ndr_lazy.go module contains the lazily decoded conformant arrays: the
+// array payload is kept raw and the elements are decoded on demand.
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// LazyArray structure represents the conformant array (size_is) that is decoded
+// lazily: the array payload (the elements and their pointees) is kept raw, and
+// the elements are decoded on demand by the ArrayIterator.
+//
+// The payload is decoded twice (once to find the end of the payload, and once
+// by the iterator), but only the single element is retained at a time, so the
+// peak memory is bounded by the raw payload size instead of the size of the
+// decoded elements:
+//
+//	clients := ndr.NewLazyArray(ndr.ReadPointerElem[dhcpm.ClientInfoV5])
+//
+//	// decode the pointer to the array.
+//	err := r.ReadPointer(&clients, func(p any) { clients = *p.(**ndr.LazyArray[*dhcpm.ClientInfoV5]) }, clients)
+//	...
+//
+//	it := clients.Iterator()
+//	for {
+//		client, err := it.Next(ctx)
+//		if err != nil {
+//			if err == io.EOF {
+//				break
+//			}
+//			// handle error.
+//		}
+//	}
+//
+// The full pointers are aliased only within the single element. If the reader
+// is not the NDR20 or NDR64 reader (or the Opaque option is set), the elements
+// are decoded eagerly.
+type LazyArray[T any] struct {
+	// The element decoder.
+	elem func(context.Context, Reader, *T) error
+	// The number of elements.
+	n int
+	// The lazy decoding flag.
+	lazy bool
+	// The reader options (data representation, full pointers, limits).
+	drep DataRepresentation
+	full bool
+	lim  *Limits
+	// The NDR64 flag.
+	is64 bool
+	// The raw elements and the offset of the first element.
+	inline    []byte
+	inlineOff int
+	// The raw pointees and the offset of the first pointee.
+	deferred    []byte
+	deferredOff int
+	// The eagerly decoded elements.
+	items []T
+}
+
+// NewLazyArray function returns the lazy array with the element decoder `elem`
+// (for example, ReadElem or ReadPointerElem).
+func NewLazyArray[T any](elem func(context.Context, Reader, *T) error) *LazyArray[T] {
+	return &LazyArray[T]{elem: elem}
+}
+
+// Len function returns the number of elements.
+func (a *LazyArray[T]) Len() int {
+	if a == nil {
+		return 0
+	}
+	return a.n
+}
+
+// Size function returns the size of the raw payload retained by the array.
+func (a *LazyArray[T]) Size() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.inline) + len(a.deferred)
+}
+
+// lazyCodec function returns the NDR2.0 state of the reader `r`.
+func lazyCodec(r Reader) (*ndr20, bool, bool) {
+	switch r := r.(type) {
+	case *ndr20:
+		return r, false, !r.opaque
+	case *ndr64:
+		return r.ndr20, true, !r.opaque
+	}
+	return nil, false, false
+}
+
+// UnmarshalNDR function reads the maximum count and skips the elements, the
+// element pointees are skipped when the reader reads the deferred pointers.
+func (a *LazyArray[T]) UnmarshalNDR(ctx context.Context, r Reader) error {
+
+	var sz uint64
+
+	if err := r.ReadSize(&sz); err != nil {
+		return err
+	}
+
+	if sz > uint64(r.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for array size %d", sz)
+	}
+
+	a.n = int(sz)
+
+	w, is64, ok := lazyCodec(r)
+	if !ok {
+		return readElems(ctx, r, sz, &a.items, a.elem)
+	}
+
+	a.lazy, a.drep, a.full, a.lim, a.is64 = true, w.drep, w.full, w.lim, is64
+	a.inlineOff = w.Offset()
+
+	rec := w.record()
+	defer w.stopRecord(rec)
+
+	// the element pointers are registered in the scratch map, so that
+	// the decoded elements are not retained by the reader.
+	ptrs, n0, hasDeferred := w.ptrs, len(w.rdeferred), false
+	w.ptrs = make(map[uint64]*readReferent)
+	defer func() { w.ptrs = ptrs }()
+
+	for i := 0; i < a.n; i++ {
+		var v T
+		if err := a.elem(ctx, r, &v); err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+		// drop the element pointees, they are skipped below.
+		hasDeferred = hasDeferred || len(w.rdeferred) > n0
+		w.rdeferred = w.rdeferred[:n0]
+		clear(w.ptrs)
+	}
+
+	a.inline = rec.bytes()
+
+	if hasDeferred {
+		w.rdeferred = append(w.rdeferred, UnmarshalNDRFunc(a.skipDeferred))
+	}
+
+	return nil
+}
+
+// skipDeferred function skips the element pointees.
+func (a *LazyArray[T]) skipDeferred(ctx context.Context, r Reader) error {
+
+	w, _, _ := lazyCodec(r)
+
+	a.deferredOff = w.Offset()
+
+	rec := w.record()
+	defer w.stopRecord(rec)
+
+	ptrs := w.ptrs
+	w.ptrs = make(map[uint64]*readReferent)
+	defer func() { w.ptrs = ptrs }()
+
+	inline := a.reader(a.inline, a.inlineOff)
+	for i := 0; i < a.n; i++ {
+		if _, err := a.decode(ctx, inline, r); err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+
+	a.deferred = rec.bytes()
+
+	return nil
+}
+
+// reader function returns the reader for the raw payload `b` that starts
+// at the offset `off`.
+func (a *LazyArray[T]) reader(b []byte, off int) Reader {
+
+	w := &ndr20{
+		drep: a.drep,
+		buf:  &buffer{chk: NewChunk(b, a.drep), pos: off},
+		ptrs: make(map[uint64]*readReferent),
+		full: a.full,
+		lim:  a.lim,
+	}
+
+	if a.is64 {
+		return &ndr64{ndr20: w}
+	}
+
+	return w
+}
+
+// decode function decodes the element from the reader `r` and the element
+// pointees from the reader `d`.
+func (a *LazyArray[T]) decode(ctx context.Context, r, d Reader) (v T, err error) {
+
+	defer func() {
+		if rc := recover(); rc != nil {
+			err = r.SetErr(NewPanicError(rc))
+		}
+	}()
+
+	if err := a.elem(ctx, r, &v); err != nil {
+		return v, err
+	}
+
+	w, _, _ := lazyCodec(r)
+	deferred := w.rDeferred()
+	clear(w.ptrs)
+
+	for _, mr := range deferred {
+		if err := d.Unmarshal(ctx, mr); err != nil {
+			return v, err
+		}
+	}
+
+	if w, _, _ := lazyCodec(d); w != nil {
+		clear(w.ptrs)
+	}
+
+	return v, nil
+}
+
+// Iterator function returns the iterator over the array elements. The iterators
+// are independent and can be used concurrently.
+func (a *LazyArray[T]) Iterator() *ArrayIterator[T] {
+
+	it := &ArrayIterator[T]{a: a}
+	if a != nil && a.lazy {
+		it.inline, it.deferred = a.reader(a.inline, a.inlineOff), a.reader(a.deferred, a.deferredOff)
+	}
+
+	return it
+}
+
+// Slice function decodes and returns all elements.
+func (a *LazyArray[T]) Slice(ctx context.Context) ([]T, error) {
+
+	ret := make([]T, 0, a.Len())
+
+	for it := a.Iterator(); ; {
+		v, err := it.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return ret, nil
+			}
+			return ret, err
+		}
+		ret = append(ret, v)
+	}
+}
+
+// ArrayIterator structure represents the iterator over the LazyArray
+// elements.
+type ArrayIterator[T any] struct {
+	a *LazyArray[T]
+	// The index of the next element.
+	i int
+	// The element and the pointee readers.
+	inline, deferred Reader
+}
+
+// Next function decodes and returns the next element, or io.EOF when there
+// are no more elements.
+func (it *ArrayIterator[T]) Next(ctx context.Context) (T, error) {
+
+	var v T
+
+	if it.a == nil || it.i >= it.a.n {
+		return v, io.EOF
+	}
+
+	if it.inline == nil {
+		v, it.i = it.a.items[it.i], it.i+1
+		return v, nil
+	}
+
+	v, err := it.a.decode(ctx, it.inline, it.deferred)
+	if err != nil {
+		return v, fmt.Errorf("array element %d: %w", it.i, err)
+	}
+
+	it.i++
+
+	return v, nil
+}
+
+// recorder structure represents the buffer that records the bytes read.
+type recorder struct {
+	AlignBuffer
+	// The recorded bytes.
+	b []byte
+}
+
+// Read function implements the io.Reader interface.
+func (rec *recorder) Read(p []byte) (int, error) {
+	n, err := rec.AlignBuffer.Read(p)
+	rec.b = append(rec.b, p[:n]...)
+	return n, err
+}
+
+// SkipMod function records the alignment padding.
+func (rec *recorder) SkipMod(mod int) error {
+	pos := rec.Pos()
+	err := rec.AlignBuffer.SkipMod(mod)
+	rec.b = append(rec.b, make([]byte, rec.Pos()-pos)...)
+	return err
+}
+
+// bytes function returns the copy of the recorded bytes without the spare
+// capacity.
+func (rec *recorder) bytes() []byte {
+	return append(make([]byte, 0, len(rec.b)), rec.b...)
+}
+
+// record function starts recording the bytes read by the reader.
+func (w *ndr20) record() *recorder {
+	rec := &recorder{AlignBuffer: w.buf}
+	w.buf = rec
+	return rec
+}
+
+// stopRecord function stops recording the bytes.
+func (w *ndr20) stopRecord(rec *recorder) {
+	w.buf = rec.AlignBuffer
+}
@@ -0,0 +1,223 @@
+package ndr
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type lazyItem struct {
+	ID   uint32
+	Name string
+}
+
+func (o *lazyItem) MarshalNDR(ctx context.Context, w Writer) error {
+	if err := w.WriteAlign(9); err != nil {
+		return err
+	}
+	if err := w.WriteData(o.ID); err != nil {
+		return err
+	}
+	return w.WritePointer(&o.Name, MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+		return WriteUTF16String(ctx, w, o.Name)
+	}))
+}
+
+func (o *lazyItem) UnmarshalNDR(ctx context.Context, r Reader) error {
+	if err := r.ReadAlign(9); err != nil {
+		return err
+	}
+	if err := r.ReadData(&o.ID); err != nil {
+		return err
+	}
+	return r.ReadPointer(&o.Name, func(p any) { o.Name = *p.(*string) }, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		return ReadUTF16String(ctx, r, &o.Name)
+	}))
+}
+
+// lazyList structure represents the structure with the pointer to the
+// conformant array followed by the other pointer.
+type lazyList struct {
+	Items []*lazyItem
+	Tail  string
+}
+
+func (o *lazyList) MarshalNDR(ctx context.Context, w Writer) error {
+	if err := w.WritePointer(&o.Items, MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+		return WriteConformantArray(ctx, w, o.Items, WritePointerElem[lazyItem])
+	})); err != nil {
+		return err
+	}
+	return w.WritePointer(&o.Tail, MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+		return WriteUTF16String(ctx, w, o.Tail)
+	}))
+}
+
+func (o *lazyList) UnmarshalNDR(ctx context.Context, r Reader) error {
+	if err := r.ReadPointer(&o.Items, func(p any) { o.Items = *p.(*[]*lazyItem) }, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		return ReadConformantArray(ctx, r, &o.Items, ReadPointerElem[lazyItem])
+	})); err != nil {
+		return err
+	}
+	return r.ReadPointer(&o.Tail, func(p any) { o.Tail = *p.(*string) }, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		return ReadUTF16String(ctx, r, &o.Tail)
+	}))
+}
+
+// lazyListReader structure represents the lazyList with the lazy array.
+type lazyListReader struct {
+	Items *LazyArray[*lazyItem]
+	Tail  string
+}
+
+func (o *lazyListReader) UnmarshalNDR(ctx context.Context, r Reader) error {
+	o.Items = NewLazyArray(ReadPointerElem[lazyItem])
+	if err := r.ReadPointer(&o.Items, func(p any) { o.Items = *p.(**LazyArray[*lazyItem]) }, o.Items); err != nil {
+		return err
+	}
+	return r.ReadPointer(&o.Tail, func(p any) { o.Tail = *p.(*string) }, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		return ReadUTF16String(ctx, r, &o.Tail)
+	}))
+}
+
+// wrappedReader is the reader that does not support the lazy decoding.
+type wrappedReader struct{ Reader }
+
+func (r wrappedReader) Unmarshal(ctx context.Context, d Unmarshaler) error {
+	return r.Reader.Unmarshal(ctx, UnmarshalNDRFunc(func(ctx context.Context, _ Reader) error {
+		return d.UnmarshalNDR(ctx, r)
+	}))
+}
+
+func (r wrappedReader) ReadPointer(ptr Pointer, setter func(any), mrs ...Unmarshaler) error {
+	for i := range mrs {
+		mr := mrs[i]
+		mrs[i] = UnmarshalNDRFunc(func(ctx context.Context, _ Reader) error {
+			return mr.UnmarshalNDR(ctx, r)
+		})
+	}
+	return r.Reader.ReadPointer(ptr, setter, mrs...)
+}
+
+func TestLazyArray(t *testing.T) {
+
+	in := &lazyList{Tail: "tail"}
+	for i := 0; i < 100; i++ {
+		in.Items = append(in.Items, &lazyItem{ID: uint32(i), Name: fmt.Sprintf("item-%d", i)})
+	}
+	// the null element.
+	in.Items[10] = nil
+
+	for _, codec := range []struct {
+		Name string
+		New  func([]byte, ...any) NDR
+		Opts []any
+		Wrap bool
+		Lazy bool
+	}{
+		{Name: "NDR20", New: NDR20, Lazy: true},
+		{Name: "NDR20BigEndian", New: NDR20, Opts: []any{BigEndianDataRepresentation}, Lazy: true},
+		{Name: "NDR64", New: NDR64, Lazy: true},
+		{Name: "Wrapped", New: NDR20, Wrap: true},
+	} {
+
+		t.Run(codec.Name, func(t *testing.T) {
+
+			ctx := context.Background()
+
+			b, err := codec.New(nil, codec.Opts...).Marshal(ctx, in)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			var (
+				out = &lazyListReader{}
+				r   = Reader(codec.New(b, codec.Opts...))
+			)
+
+			if codec.Wrap {
+				r = wrappedReader{r}
+			}
+
+			if err := r.Unmarshal(ctx, out); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+
+			if out.Tail != in.Tail {
+				t.Errorf("unmarshal: tail %q, expected %q", out.Tail, in.Tail)
+			}
+
+			if out.Items.Len() != len(in.Items) {
+				t.Fatalf("unmarshal: length %d, expected %d", out.Items.Len(), len(in.Items))
+			}
+
+			if lazy := out.Items.Size() > 0; lazy != codec.Lazy {
+				t.Errorf("unmarshal: lazy %t, expected %t", lazy, codec.Lazy)
+			}
+
+			// the iterators are independent.
+			for i := 0; i < 2; i++ {
+				items, err := out.Items.Slice(ctx)
+				if err != nil {
+					t.Fatalf("slice: %v", err)
+				}
+				if !reflect.DeepEqual(items, in.Items) {
+					t.Errorf("slice: unexpected elements %v", items)
+				}
+			}
+		})
+	}
+}
+
+func TestLazyArrayMalformed(t *testing.T) {
+
+	in := &lazyList{Items: []*lazyItem{{ID: 1, Name: "one"}, {ID: 2, Name: "two"}}, Tail: "tail"}
+
+	b, err := Marshal(in)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	// the truncated pointees.
+	if err := Unmarshal(b[:len(b)-24], &lazyListReader{}); err == nil {
+		t.Errorf("unmarshal: expected error for truncated input")
+	}
+}
+
+func BenchmarkLazyArray(b *testing.B) {
+
+	in := &lazyList{}
+	for i := 0; i < 10000; i++ {
+		in.Items = append(in.Items, &lazyItem{ID: uint32(i), Name: fmt.Sprintf("client-%d.example.com", i)})
+	}
+
+	buf, err := Marshal(in)
+	if err != nil {
+		b.Fatalf("marshal: %v", err)
+	}
+
+	b.Run("Eager", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := Unmarshal(buf, &lazyList{}); err != nil {
+				b.Fatalf("unmarshal: %v", err)
+			}
+		}
+	})
+
+	b.Run("Lazy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			out := &lazyListReader{}
+			if err := Unmarshal(buf, out); err != nil {
+				b.Fatalf("unmarshal: %v", err)
+			}
+			for it := out.Items.Iterator(); ; {
+				if _, err := it.Next(context.Background()); err != nil {
+					break
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package ndr
+
+// ndr_limits.go module contains the decoding resource limits that protect
+// the decoder from the malicious input (huge conformant arrays, strings,
+// deep pointer chains).
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLimitExceeded is returned (wrapped into LimitError) when the decoded
+// data exceeds the configured Limits.
+var ErrLimitExceeded = errors.New("ndr: limit exceeded")
+
+// Limits structure represents the decoding resource limits. The limits are
+// passed as the NDR option (to NDR20, NDR64, Unmarshal and so on), the zero
+// value disables the corresponding limit:
+//
+//	err := ndr.Unmarshal(b, resp, ndr.DefaultLimits())
+type Limits struct {
+	// The maximum conformance or variance value (the array maximum count,
+	// offset and actual count, including the strings).
+	MaxArrayCount uint64 `json:"max_array_count,omitempty" yaml:"max_array_count,omitempty"`
+	// The maximum string length (in characters).
+	MaxStringLength uint64 `json:"max_string_length,omitempty" yaml:"max_string_length,omitempty"`
+	// The maximum embedded pointer depth (the pointee that contains
+	// the pointers is one level deeper).
+	MaxPointerDepth int `json:"max_pointer_depth,omitempty" yaml:"max_pointer_depth,omitempty"`
+	// The maximum total number of the decoded bytes.
+	MaxBytes int `json:"max_bytes,omitempty" yaml:"max_bytes,omitempty"`
+}
+
+// DefaultLimits function returns the limits suitable for decoding the
+// responses from the untrusted servers.
+func DefaultLimits() *Limits {
+	return &Limits{
+		MaxArrayCount:   1 << 20,
+		MaxStringLength: 1 << 16,
+		MaxPointerDepth: 1024,
+		MaxBytes:        64 << 20,
+	}
+}
+
+// LimitError is the error returned when the decoded data exceeds the limit.
+type LimitError struct {
+	// The limit name (for example, "max_array_count").
+	Limit string
+	// The decoded value.
+	Value uint64
+	// The limit value.
+	Max uint64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v: %s: %d exceeds %d", ErrLimitExceeded, e.Limit, e.Value, e.Max)
+}
+
+// Is function returns `true` for ErrLimitExceeded.
+func (e *LimitError) Is(err error) bool {
+	return err == ErrLimitExceeded
+}
+
+// limit function returns the LimitError if the value `v` exceeds the
+// limit `max` (zero disables the limit).
+func limit(name string, v, max uint64) error {
+	if max > 0 && v > max {
+		return &LimitError{Limit: name, Value: v, Max: max}
+	}
+	return nil
+}
+
+// checkStringLimit function checks the string length `sz` read by `r`
+// against the reader limits.
+func checkStringLimit(r Reader, sz uint64) error {
+	if l, ok := r.(interface{ limits() *Limits }); ok && l.limits() != nil {
+		return r.SetErr(limit("max_string_length", sz, l.limits().MaxStringLength))
+	}
+	return nil
+}
+
+// limits function returns the reader limits.
+func (w *ndr20) limits() *Limits {
+	return w.lim
+}
+
+// checkSizeLimit function checks the conformance or variance value `sz`
+// against the limits.
+func (w *ndr20) checkSizeLimit(sz uint64) error {
+	if w.lim == nil {
+		return nil
+	}
+	return w.SetErr(limit("max_array_count", sz, w.lim.MaxArrayCount))
+}
+
+// checkBytesLimit function checks the number of the decoded bytes against
+// the limits.
+func (w *ndr20) checkBytesLimit() error {
+	if w.lim == nil || w.lim.MaxBytes <= 0 {
+		return nil
+	}
+	return w.SetErr(limit("max_bytes", uint64(w.buf.Pos()), uint64(w.lim.MaxBytes)))
+}
+
+// enterDeferred function increments the pointer depth and checks it
+// against the limits.
+func (w *ndr20) enterDeferred() error {
+	if w.depth++; w.lim == nil || w.lim.MaxPointerDepth <= 0 {
+		return nil
+	}
+	return w.SetErr(limit("max_pointer_depth", uint64(w.depth), uint64(w.lim.MaxPointerDepth)))
+}
@@ -0,0 +1,190 @@
+package ndr
+
+// ndr_pointers.go module contains the full pointer (referent identifier)
+// bookkeeping used to encode/decode the pointer graphs with aliases and
+// cycles.
+
+import (
+	"context"
+	"reflect"
+)
+
+type fullPointer struct{}
+
+// FullPointer is an NDR option that is used to indicate that every
+// pointer must be treated as a full ([ptr]) pointer: the pointee that
+// is referenced more than once is encoded once and the subsequent
+// references reuse its referent identifier.
+//
+// Without this option, only the references that form a cycle (the
+// pointee which is being encoded is referenced again from within its
+// own subtree) are aliased, since they cannot be encoded otherwise.
+var FullPointer fullPointer
+
+// fullPtr is the pointer marked with Full.
+type fullPtr struct {
+	ptr Pointer
+}
+
+// Full function marks the pointer `ptr` passed to the WritePointer as the
+// full ([ptr]) pointer, so the pointee that was already encoded is referenced
+// by its referent identifier (see FullPointer), while the other pointers keep
+// the unique pointer semantics:
+//
+//	if err := w.WritePointer(ndr.Full(&o.Peer), _ptr_Peer); err != nil {
+//		return err
+//	}
+func Full(ptr Pointer) Pointer {
+	if ptr == nil {
+		return nil
+	}
+	return fullPtr{ptr: ptr}
+}
+
+// unwrapPointer function returns the pointer `ptr` and the flag that
+// indicates whether the pointer was marked with Full.
+func unwrapPointer(ptr Pointer) (Pointer, bool) {
+	if p, ok := ptr.(fullPtr); ok {
+		return p.ptr, true
+	}
+	return ptr, false
+}
+
+// pointerKey is the identity of the pointee.
+type pointerKey struct {
+	typ  reflect.Type
+	addr uintptr
+}
+
+// makePointerKey function returns the pointee identity for the pointer
+// passed to the WritePointer. The generated code passes the address of
+// the field, so for the pointer fields (**T), the identity is the field
+// value.
+func makePointerKey(ptr Pointer) (pointerKey, bool) {
+
+	ptr, _ = unwrapPointer(ptr)
+
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return pointerKey{}, false
+	}
+
+	if v = v.Elem(); v.Kind() != reflect.Ptr || v.IsNil() {
+		return pointerKey{}, false
+	}
+
+	return pointerKey{typ: v.Type(), addr: v.Pointer()}, true
+}
+
+// writeReferent is the marshaled pointee state.
+type writeReferent struct {
+	// The referent identifier.
+	id uint64
+	// The flag indicates that pointee is being marshaled.
+	active bool
+}
+
+// readReferent is the unmarshaled pointee state.
+type readReferent struct {
+	// The pointer to the pointee.
+	ptr Pointer
+	// The flag indicates that pointee unmarshaling has started (and
+	// the pointee was allocated).
+	started bool
+	// The setters for the aliases that were read before the pointee
+	// was allocated.
+	waiters []func(any)
+}
+
+// alias function sets the alias pointer using the setter, if the pointee
+// is not allocated yet, the setter is postponed.
+func (ref *readReferent) alias(setter func(any)) {
+	if setter == nil {
+		return
+	}
+	if ref.started {
+		setter(ref.ptr)
+		return
+	}
+	ref.waiters = append(ref.waiters, setter)
+}
+
+// unmarshaler function returns the unmarshaler that reads the pointee
+// and resolves the postponed aliases.
+func (ref *readReferent) unmarshaler(mrs []Unmarshaler) Unmarshaler {
+	return UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+		ref.started = true
+		for _, mr := range mrs {
+			if err := r.Unmarshal(ctx, mr); err != nil {
+				return err
+			}
+		}
+		for _, setter := range ref.waiters {
+			setter(ref.ptr)
+		}
+		ref.waiters = nil
+		return nil
+	})
+}
+
+// marshaler function returns the marshaler that writes the pointee
+// and tracks the pointee activity for cycle detection.
+func (ref *writeReferent) marshaler(mrs []Marshaler) Marshaler {
+	return MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+		ref.active = true
+		defer func() { ref.active = false }()
+		for _, mr := range mrs {
+			if _, err := w.Marshal(ctx, mr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// writeReferentID function returns the referent identifier for the pointer
+// `ptr` and defers the pointee marshaling, the referent identifier of the
+// pointee that was already encoded is returned for the cycles and the full
+// pointers.
+func (w *ndr20) writeReferentID(ptr Pointer, mrs []Marshaler) uint64 {
+
+	key, ok := makePointerKey(ptr)
+	if ok {
+		if ref, seen := w.wptrs[key]; seen {
+			if _, full := unwrapPointer(ptr); ref.active || w.full || full {
+				// the pointee is referenced from its own subtree (cycle), or
+				// full pointer semantics is requested: write the alias.
+				return ref.id
+			}
+		}
+	}
+
+	id := uint64(w.buf.Pos() + 1)
+
+	if !ok {
+		w.wdeferred = append(w.wdeferred, mrs...)
+		return id
+	}
+
+	ref := &writeReferent{id: id}
+	w.wptrs[key], w.wdeferred = ref, append(w.wdeferred, ref.marshaler(mrs))
+
+	return id
+}
+
+// readReferentID function defers the pointee unmarshaling for the referent
+// identifier `id`, the pointee that was (or will be) read once is aliased
+// using the `setter`.
+func (w *ndr20) readReferentID(id uint64, ptr Pointer, setter func(any), mrs []Unmarshaler) {
+
+	ptr, _ = unwrapPointer(ptr)
+
+	if ref, ok := w.ptrs[id]; ok {
+		// full pointer alias, the pointee was (or will be) read once.
+		ref.alias(setter)
+		return
+	}
+
+	ref := &readReferent{ptr: ptr}
+	w.ptrs[id], w.rdeferred = ref, append(w.rdeferred, ref.unmarshaler(mrs))
+}
@@ -0,0 +1,160 @@
+package ndr
+
+import (
+	"context"
+	"testing"
+)
+
+// node is the self-referential structure encoded the same way as the
+// generated code does.
+type node struct {
+	Value uint32
+	Next  *node
+	Peer  *node
+}
+
+func (o *node) MarshalNDR(ctx context.Context, w Writer) error {
+	if err := w.WriteAlign(4); err != nil {
+		return err
+	}
+	if err := w.WriteData(o.Value); err != nil {
+		return err
+	}
+	for _, p := range []**node{&o.Next, &o.Peer} {
+		p := p
+		if *p == nil {
+			if err := w.WritePointer(nil); err != nil {
+				return err
+			}
+			continue
+		}
+		_ptr := MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+			return (*p).MarshalNDR(ctx, w)
+		})
+		if err := w.WritePointer(p, _ptr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *node) UnmarshalNDR(ctx context.Context, w Reader) error {
+	if err := w.ReadAlign(4); err != nil {
+		return err
+	}
+	if err := w.ReadData(&o.Value); err != nil {
+		return err
+	}
+	for _, p := range []**node{&o.Next, &o.Peer} {
+		p := p
+		_ptr := UnmarshalNDRFunc(func(ctx context.Context, w Reader) error {
+			if *p == nil {
+				*p = &node{}
+			}
+			return (*p).UnmarshalNDR(ctx, w)
+		})
+		_s := func(ptr any) { *p = *ptr.(**node) }
+		if err := w.ReadPointer(p, _s, _ptr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestPointerCycle(t *testing.T) {
+
+	// 1 -> 2 -> 3 -> 1 (cycle), 1.Peer = 3 (alias), 2.Peer = 2 (self).
+	n1, n2, n3 := &node{Value: 1}, &node{Value: 2}, &node{Value: 3}
+	n1.Next, n2.Next, n3.Next = n2, n3, n1
+	n1.Peer, n2.Peer = n3, n2
+
+	for _, codec := range []struct {
+		Marshal   func(Marshaler, ...any) ([]byte, error)
+		Unmarshal func([]byte, Unmarshaler, ...any) error
+	}{
+		{Marshal, Unmarshal},
+		{Marshal64, Unmarshal64},
+	} {
+
+		for _, opts := range [][]any{nil, {FullPointer}} {
+
+			// the top-level value has no referent identifier, so it cannot
+			// be aliased: the list is referenced by the root node.
+			b, err := codec.Marshal(&node{Next: n1}, opts...)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			b2, err := codec.Marshal(&node{Next: n1}, opts...)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			if string(b) != string(b2) {
+				t.Errorf("marshal: encoding is not deterministic")
+			}
+
+			root := &node{}
+			if err := codec.Unmarshal(b, root, opts...); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+
+			out := root.Next
+
+			if out.Value != 1 || out.Next.Value != 2 || out.Next.Next.Value != 3 {
+				t.Fatalf("unmarshal: unexpected values")
+			}
+
+			if out.Next.Next.Next != out {
+				t.Errorf("unmarshal: cycle is not preserved")
+			}
+
+			if out.Next.Peer != out.Next {
+				t.Errorf("unmarshal: self reference is not preserved")
+			}
+
+			if opts != nil {
+				// full pointer semantics aliases the peer.
+				if out.Peer != out.Next.Next {
+					t.Errorf("unmarshal: full pointer alias is not preserved")
+				}
+			} else if out.Peer == nil || out.Peer.Value != 3 {
+				t.Errorf("unmarshal: unexpected peer")
+			}
+		}
+	}
+}
+
+func TestFullPointer(t *testing.T) {
+
+	// 1.Next = 2, 1.Peer = 2 (alias).
+	n1, n2 := &node{Value: 1}, &node{Value: 2}
+	n1.Next, n1.Peer = n2, n2
+
+	for _, ptr := range []Pointer{&n1.Peer, Full(&n1.Peer)} {
+
+		b, err := Marshal(MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+			if err := w.WritePointer(&n1.Next, n2); err != nil {
+				return err
+			}
+			return w.WritePointer(ptr, n2)
+		}))
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+
+		next, peer := &node{}, &node{}
+		if err := Unmarshal(b, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+			if err := r.ReadPointer(&next, func(p any) { next = *p.(**node) }, next); err != nil {
+				return err
+			}
+			return r.ReadPointer(&peer, func(p any) { peer = *p.(**node) }, peer)
+		})); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+
+		if _, full := ptr.(fullPtr); full != (next == peer) {
+			t.Errorf("unmarshal: full pointer %t, aliased %t", full, next == peer)
+		}
+	}
+}
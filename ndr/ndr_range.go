@@ -0,0 +1,62 @@
+package ndr
+
+// ndr_range.go module contains the helpers used by the generated decoders
+// to enforce the range() and size_is() constraints declared in the IDL.
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOutOfRange is returned (wrapped into RangeError) when the decoded
+// value violates the constraint declared in the IDL.
+var ErrOutOfRange = errors.New("ndr: value out of range")
+
+// RangeError is the error returned when the decoded value violates the
+// range() or size_is() constraint.
+type RangeError struct {
+	// The field name.
+	Field string
+	// The decoded value (the number of elements for arrays).
+	Value any
+	// The violated constraint (for example, "range(0,64000)").
+	Constraint string
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%v: %s: %v violates %s", ErrOutOfRange, e.Field, e.Value, e.Constraint)
+}
+
+// Is function returns `true` for ErrOutOfRange.
+func (e *RangeError) Is(err error) bool {
+	return err == ErrOutOfRange
+}
+
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// CheckRange function returns the RangeError if the value `v` of the field
+// `name` is not within the range [min, max].
+func CheckRange[T integer](name string, v T, min, max int64) error {
+	ok := false
+	if v < 0 {
+		ok = int64(v) >= min && int64(v) <= max
+	} else {
+		ok = (min <= 0 || uint64(v) >= uint64(min)) && max >= 0 && uint64(v) <= uint64(max)
+	}
+	if !ok {
+		return &RangeError{Field: name, Value: v, Constraint: fmt.Sprintf("range(%d,%d)", min, max)}
+	}
+	return nil
+}
+
+// CheckSize function returns the RangeError if the number of elements `n`
+// of the field `name` exceeds the size `sz` computed from the size_is
+// expression `is`.
+func CheckSize[T integer](name string, n int, sz T, is string) error {
+	if sz < 0 || uint64(n) > uint64(sz) {
+		return &RangeError{Field: name, Value: n, Constraint: fmt.Sprintf("size_is(%s) = %d", is, sz)}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package ndr_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/scmr/svcctl/v2"
+	"github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+func TestConstraints(t *testing.T) {
+
+	b, err := ndr.Marshal(&dtyp.SID{
+		Revision:          1,
+		SubAuthorityCount: 2,
+		IDAuthority:       &dtyp.SIDIDAuthority{Value: []byte{0, 0, 0, 0, 0, 5}},
+		SubAuthority:      []uint32{21, 512},
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	// size_is(SubAuthorityCount) is less than the conformance (the
+	// max count is followed by the revision and the count).
+	b[5] = 1
+
+	var rangeErr *ndr.RangeError
+	if err := ndr.Unmarshal(b, &dtyp.SID{}); !errors.Is(err, ndr.ErrOutOfRange) || !errors.As(err, &rangeErr) || rangeErr.Field != "SubAuthority" {
+		t.Errorf("unmarshal: got %v, want %v", err, ndr.ErrOutOfRange)
+	}
+
+	// range(0,64000) OutputBufferLength.
+	b, err = ndr.Marshal(&srvsvc.PathCanonicalizeRequest{PathName: "C:\\", OutputBufferLength: 64000})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if err := ndr.Unmarshal(b, &srvsvc.PathCanonicalizeRequest{}); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	i := bytes.Index(b, []byte{0x00, 0xfa, 0x00, 0x00})
+	if i < 0 {
+		t.Fatalf("marshal: OutputBufferLength not found")
+	}
+	b[i] = 0x01
+
+	if err := ndr.Unmarshal(b, &srvsvc.PathCanonicalizeRequest{}); !errors.Is(err, ndr.ErrOutOfRange) || !errors.As(err, &rangeErr) || rangeErr.Field != "OutputBufferLength" {
+		t.Errorf("unmarshal: got %v, want %v", err, ndr.ErrOutOfRange)
+	}
+}
+
+func TestConstraintsString(t *testing.T) {
+
+	// range(0, 32768) lpBinaryPathName is checked in UTF-16 code units (with
+	// the terminator), the UTF-8 length (65534 bytes) exceeds the range.
+	path := strings.Repeat("é", 32767)
+
+	b, err := ndr.Marshal(&svcctl.CreateServiceWRequest{ServiceName: "svc", BinaryPathName: path})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	out := &svcctl.CreateServiceWRequest{}
+	if err := ndr.Unmarshal(b, out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if out.BinaryPathName != path {
+		t.Errorf("unmarshal: binary path name mismatch")
+	}
+
+	// 32769 code units with the terminator.
+	if _, err = ndr.Marshal(&svcctl.CreateServiceWRequest{ServiceName: "svc", BinaryPathName: path + "é"}); err == nil {
+		t.Errorf("marshal: expected out of range error")
+	}
+}
@@ -0,0 +1,875 @@
+package ndr
+
+// ndr_reflect.go module contains the reflection-based codec for the Go
+// structures annotated with the `ndr` struct tags, so that the custom or
+// undocumented structures can be encoded without the code generator.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Value structure adapts the pointer to the Go structure described with
+// the `ndr` struct tags to the Marshaler and Unmarshaler interfaces.
+//
+// The following tags are supported:
+//
+//   - `ndr:"pointer"`: the unique pointer to the string, slice, or the
+//     value referenced by the Go pointer. The empty string and the nil
+//     slice or pointer are encoded as the null pointer.
+//   - `ndr:"ptr"`: the full pointer, the pointee referenced more than once
+//     is encoded once (see Full).
+//   - `ndr:"conformant"`: the conformant array (slice) embedded into the
+//     structure, it must be the last structure field (the maximum count
+//     is written at the beginning of the structure).
+//   - `ndr:"varying"`: the conformant array is also varying (the offset
+//     and the actual count are written before the elements).
+//   - `ndr:"size_is=Field"`: the array maximum count is taken from the
+//     integer field `Field` (the array is truncated or padded with zero
+//     values).
+//   - `ndr:"char"`: the string is the null-terminated 8-bit character
+//     string (UTF-16 string is used by default).
+//   - `ndr:"switch_is=Field"`: the field is the non-encapsulated union
+//     (the structure, which fields are the union arms) discriminated by
+//     the integer field `Field`. The union arms are tagged with
+//     `ndr:"case=N"` (can be repeated) or `ndr:"default"`.
+//   - `ndr:"-"`: the field is ignored.
+//
+// The Go arrays are encoded as the fixed arrays, the Go pointers without
+// the `pointer` tag, as well as the structures, are embedded. The values
+// that implement the Marshaler and Unmarshaler interfaces (for example,
+// the generated types) are encoded with these interfaces.
+type Value struct {
+	v any
+}
+
+// Reflect function returns the Marshaler and Unmarshaler for the pointer
+// to the Go structure `v`:
+//
+//	b, err := ndr.Marshal(ndr.Reflect(&s))
+//	...
+//	err = ndr.Unmarshal(b, ndr.Reflect(&s))
+func Reflect(v any) *Value {
+	return &Value{v: v}
+}
+
+// MarshalNDR function implements the Marshaler interface.
+func (o *Value) MarshalNDR(ctx context.Context, w Writer) error {
+	v, err := reflectStruct(o.v)
+	if err != nil {
+		return err
+	}
+	return marshalStruct(ctx, w, v, false)
+}
+
+// UnmarshalNDR function implements the Unmarshaler interface.
+func (o *Value) UnmarshalNDR(ctx context.Context, r Reader) error {
+	v, err := reflectStruct(o.v)
+	if err != nil {
+		return err
+	}
+	return unmarshalStruct(ctx, r, v, nil)
+}
+
+// reflectStruct function returns the structure referenced by `v`.
+func reflectStruct(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("reflect: %T is not a pointer to the structure", v)
+	}
+	return rv.Elem(), nil
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	uint3264Type    = reflect.TypeOf(Uint3264(0))
+	int3264Type     = reflect.TypeOf(Int3264(0))
+)
+
+// reflectField is the structure field with the parsed `ndr` tag.
+type reflectField struct {
+	// The field index and name.
+	index int
+	name  string
+	// The unique pointer and the full pointer.
+	pointer, full bool
+	// The conformant and the varying array.
+	conformant, varying bool
+	// The 8-bit character string.
+	char bool
+	// The index of the field that holds the array maximum count (size_is),
+	// or the union discriminant (switch_is), -1 if not set.
+	sizeIs, switchIs int
+	// The union arm labels.
+	cases []uint64
+	// The default union arm.
+	def bool
+}
+
+// reflectType is the parsed structure type.
+type reflectType struct {
+	// The structure fields.
+	fields []*reflectField
+	// The flag indicates that the last field is (or contains) the
+	// conformant array.
+	conformant bool
+	// The structure alignment.
+	align int
+}
+
+// arm function returns the union arm for the discriminant `sw`.
+func (t *reflectType) arm(sw uint64) *reflectField {
+	var def *reflectField
+	for _, f := range t.fields {
+		for _, c := range f.cases {
+			if c == sw {
+				return f
+			}
+		}
+		if f.def {
+			def = f
+		}
+	}
+	return def
+}
+
+// reflectTypes is the parsed structure types cache.
+var reflectTypes sync.Map
+
+// reflectTypeOf function parses the structure type `t`.
+func reflectTypeOf(t reflect.Type) (*reflectType, error) {
+
+	if typ, ok := reflectTypes.Load(t); ok {
+		return typ.(*reflectType), nil
+	}
+
+	typ := &reflectType{}
+
+	for i := 0; i < t.NumField(); i++ {
+		if sf := t.Field(i); sf.IsExported() && sf.Tag.Get("ndr") != "-" {
+			f, err := parseReflectField(t, sf)
+			if err != nil {
+				return nil, err
+			}
+			typ.fields = append(typ.fields, f)
+		}
+	}
+
+	for i, f := range typ.fields {
+
+		ft, last := t.Field(f.index).Type, i == len(typ.fields)-1
+
+		switch {
+		case f.switchIs >= 0:
+			if ft.Kind() != reflect.Struct {
+				return nil, fmt.Errorf("reflect: %s.%s: union must be a structure", t, f.name)
+			}
+		case f.pointer:
+			if k := ft.Kind(); k != reflect.String && k != reflect.Slice && k != reflect.Ptr {
+				return nil, fmt.Errorf("reflect: %s.%s: pointer must be a string, slice, or pointer", t, f.name)
+			}
+		case f.conformant:
+			if ft.Kind() != reflect.Slice || !last {
+				return nil, fmt.Errorf("reflect: %s.%s: conformant array must be the last slice field", t, f.name)
+			}
+			typ.conformant = true
+		case ft.Kind() == reflect.Slice:
+			return nil, fmt.Errorf("reflect: %s.%s: slice requires the conformant or pointer tag", t, f.name)
+		case ft.Kind() == reflect.String:
+			return nil, fmt.Errorf("reflect: %s.%s: string requires the pointer tag", t, f.name)
+		default:
+			conformant, err := isConformant(ft)
+			if err != nil {
+				return nil, err
+			}
+			if conformant && !last {
+				return nil, fmt.Errorf("reflect: %s.%s: conformant structure must be the last field", t, f.name)
+			}
+			typ.conformant = conformant
+		}
+	}
+
+	if typ.align = reflectAlignment(t, typ, false); typ.align == 5 {
+		typ.align += reflectAlignment(t, typ, true)
+	}
+
+	reflectTypes.Store(t, typ)
+
+	return typ, nil
+}
+
+// parseReflectField function parses the structure field tag.
+func parseReflectField(t reflect.Type, sf reflect.StructField) (*reflectField, error) {
+
+	f := &reflectField{index: sf.Index[0], name: sf.Name, sizeIs: -1, switchIs: -1}
+
+	fieldIndex := func(name string) (int, error) {
+		if ref, ok := t.FieldByName(name); ok && len(ref.Index) == 1 && isInteger(ref.Type) {
+			return ref.Index[0], nil
+		}
+		return -1, fmt.Errorf("reflect: %s.%s: %q is not an integer field", t, sf.Name, name)
+	}
+
+	for _, tag := range strings.Split(sf.Tag.Get("ndr"), ",") {
+
+		var err error
+
+		switch key, val, _ := strings.Cut(strings.TrimSpace(tag), "="); key {
+		case "":
+		case "pointer", "unique":
+			f.pointer = true
+		case "ptr":
+			f.pointer, f.full = true, true
+		case "conformant":
+			f.conformant = true
+		case "varying":
+			f.varying = true
+		case "char":
+			f.char = true
+		case "string":
+		case "size_is":
+			f.sizeIs, err = fieldIndex(val)
+		case "switch_is":
+			f.switchIs, err = fieldIndex(val)
+		case "case":
+			c, perr := strconv.ParseInt(val, 0, 64)
+			if perr != nil {
+				err = fmt.Errorf("reflect: %s.%s: invalid case %q", t, sf.Name, val)
+			}
+			f.cases = append(f.cases, uint64(c))
+		case "default":
+			f.def = true
+		default:
+			err = fmt.Errorf("reflect: %s.%s: unknown tag %q", t, sf.Name, key)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// isConformant function returns true if the embedded type `t` is the
+// conformant structure.
+func isConformant(t reflect.Type) (bool, error) {
+	if t.Kind() == reflect.Ptr && !t.Implements(marshalerType) {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || reflect.PointerTo(t).Implements(marshalerType) {
+		return false, nil
+	}
+	typ, err := reflectTypeOf(t)
+	if err != nil {
+		return false, err
+	}
+	return typ.conformant, nil
+}
+
+// isInteger function returns true if `t` is the integer type.
+func isInteger(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint, reflect.Bool:
+		return true
+	}
+	return false
+}
+
+// reflectAlignment function returns the structure alignment (see the
+// codegen Scopes.Alignment): the pointer and the varying array alignment
+// is 5 (4 for NDR2.0 and 8 for NDR64), unless the `opaque` is set.
+func reflectAlignment(t reflect.Type, typ *reflectType, opaque bool) int {
+
+	va := 5
+	if opaque {
+		va = 1
+	}
+
+	a := 0
+	for _, f := range typ.fields {
+
+		fa, ft := 0, t.Field(f.index).Type
+
+		switch {
+		case f.pointer:
+			fa = va
+		case f.switchIs >= 0:
+			if arms, err := reflectTypeOf(ft); err == nil {
+				fa = max(typeAlignment(t.Field(f.switchIs).Type, opaque), reflectAlignment(ft, arms, opaque))
+			}
+		case f.conformant:
+			if fa = typeAlignment(ft.Elem(), opaque); f.varying {
+				fa = max(fa, va)
+			}
+		default:
+			fa = typeAlignment(ft, opaque)
+		}
+
+		a = max(a, fa)
+	}
+
+	return a
+}
+
+// typeAlignment function returns the alignment of the embedded type `t`.
+func typeAlignment(t reflect.Type, opaque bool) int {
+
+	switch t {
+	case uint3264Type, int3264Type:
+		return 4
+	}
+
+	if t.Implements(marshalerType) || reflect.PointerTo(t).Implements(marshalerType) {
+		// the marshaler performs the alignment itself.
+		return 0
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int8, reflect.Uint8:
+		return 1
+	case reflect.Int16, reflect.Uint16:
+		return 2
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 4
+	case reflect.Int64, reflect.Uint64, reflect.Float64:
+		return 8
+	case reflect.Array, reflect.Ptr:
+		return typeAlignment(t.Elem(), opaque)
+	case reflect.Struct:
+		if typ, err := reflectTypeOf(t); err == nil {
+			return reflectAlignment(t, typ, opaque)
+		}
+	}
+
+	return 0
+}
+
+// unionAlignment function returns the alignment of the union `t` with the
+// discriminant type `sw`.
+func unionAlignment(sw, t reflect.Type, typ *reflectType) int {
+	a := max(typeAlignment(sw, false), reflectAlignment(t, typ, false))
+	if a == 5 {
+		a += max(typeAlignment(sw, true), reflectAlignment(t, typ, true))
+	}
+	return a
+}
+
+// intValue function returns the integer value `v` (as an array size, or
+// union discriminant).
+func intValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return uint64(v.Int())
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	}
+	return v.Uint()
+}
+
+// primitive function returns the primitive value `v` converted to the
+// type supported by WriteData.
+func primitive(v reflect.Value) (any, error) {
+
+	switch v.Type() {
+	case uint3264Type, int3264Type:
+		return v.Interface(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int8:
+		return int8(v.Int()), nil
+	case reflect.Int16:
+		return int16(v.Int()), nil
+	case reflect.Int32:
+		return int32(v.Int()), nil
+	case reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint8:
+		return uint8(v.Uint()), nil
+	case reflect.Uint16:
+		return uint16(v.Uint()), nil
+	case reflect.Uint32:
+		return uint32(v.Uint()), nil
+	case reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Float32:
+		return float32(v.Float()), nil
+	case reflect.Float64:
+		return v.Float(), nil
+	}
+
+	return nil, fmt.Errorf("reflect: unsupported type %s", v.Type())
+}
+
+// readPrimitive function reads the primitive value `v` using the function
+// `read` (ReadData or ReadSwitch).
+func readPrimitive(v reflect.Value, read func(any) error) error {
+
+	d, err := primitive(v)
+	if err != nil {
+		return err
+	}
+
+	p := reflect.New(reflect.TypeOf(d))
+	p.Elem().Set(reflect.ValueOf(d))
+
+	if err := read(p.Interface()); err != nil {
+		return err
+	}
+
+	v.Set(p.Elem().Convert(v.Type()))
+	return nil
+}
+
+// arraySize function returns the maximum count for the array field `f`.
+func arraySize(v reflect.Value, f *reflectField) uint64 {
+	if f.sizeIs >= 0 {
+		return intValue(v.Field(f.sizeIs))
+	}
+	return uint64(v.Field(f.index).Len())
+}
+
+// conformantSize function returns the maximum count of the conformant
+// array of the conformant structure `v`.
+func conformantSize(v reflect.Value, typ *reflectType) (uint64, error) {
+
+	f := typ.fields[len(typ.fields)-1]
+	if f.conformant {
+		return arraySize(v, f), nil
+	}
+
+	fv := v.Field(f.index)
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv = reflect.New(fv.Type().Elem())
+		}
+		fv = fv.Elem()
+	}
+
+	ftyp, err := reflectTypeOf(fv.Type())
+	if err != nil {
+		return 0, err
+	}
+
+	return conformantSize(fv, ftyp)
+}
+
+// marshalStruct function marshals the structure `v`, if `hoisted` is set,
+// the conformant array maximum count was written by the enclosing structure.
+func marshalStruct(ctx context.Context, w Writer, v reflect.Value, hoisted bool) error {
+
+	typ, err := reflectTypeOf(v.Type())
+	if err != nil {
+		return err
+	}
+
+	if typ.conformant && !hoisted {
+		sz, err := conformantSize(v, typ)
+		if err != nil {
+			return err
+		}
+		if err := w.WriteSize(sz); err != nil {
+			return err
+		}
+	}
+
+	if err := w.WriteAlign(typ.align); err != nil {
+		return err
+	}
+
+	for i, f := range typ.fields {
+		if err := marshalField(ctx, w, v, f, typ.conformant && i == len(typ.fields)-1); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+
+	return nil
+}
+
+// marshalField function marshals the field `f` of the structure `v`.
+func marshalField(ctx context.Context, w Writer, v reflect.Value, f *reflectField, hoisted bool) error {
+
+	fv := v.Field(f.index)
+
+	switch {
+	case f.switchIs >= 0:
+		return marshalUnion(ctx, w, v, f)
+	case f.pointer:
+		return marshalPointer(ctx, w, v, f)
+	case f.conformant:
+		if !hoisted {
+			return fmt.Errorf("conformant array must be embedded into the structure")
+		}
+		sz := arraySize(v, f)
+		if f.varying {
+			return marshalVarying(ctx, w, fv, sz)
+		}
+		return marshalElems(ctx, w, fv, sz)
+	}
+
+	return marshalValue(ctx, w, fv, hoisted)
+}
+
+// marshalVarying function writes the varying array offset and actual count
+// followed by the elements.
+func marshalVarying(ctx context.Context, w Writer, fv reflect.Value, sz uint64) error {
+
+	sz = min(sz, uint64(fv.Len()))
+
+	if err := w.WriteSize(0); err != nil {
+		return err
+	}
+	if err := w.WriteSize(sz); err != nil {
+		return err
+	}
+
+	return marshalElems(ctx, w, fv, sz)
+}
+
+// marshalElems function writes `sz` array elements, the missing elements
+// are written as zero values.
+func marshalElems(ctx context.Context, w Writer, fv reflect.Value, sz uint64) error {
+	for i := uint64(0); i < sz; i++ {
+		elem := reflect.New(fv.Type().Elem()).Elem()
+		if i < uint64(fv.Len()) {
+			elem = fv.Index(int(i))
+		}
+		if err := marshalValue(ctx, w, elem, false); err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// marshalValue function marshals the embedded value `v`.
+func marshalValue(ctx context.Context, w Writer, v reflect.Value, hoisted bool) error {
+
+	if v.Kind() == reflect.Ptr && v.Type().Implements(marshalerType) {
+		if v.IsNil() {
+			v = reflect.New(v.Type().Elem())
+		}
+		return v.Interface().(Marshaler).MarshalNDR(ctx, w)
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(marshalerType) {
+		return v.Addr().Interface().(Marshaler).MarshalNDR(ctx, w)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v = reflect.New(v.Type().Elem())
+		}
+		return marshalValue(ctx, w, v.Elem(), hoisted)
+	case reflect.Struct:
+		return marshalStruct(ctx, w, v, hoisted)
+	case reflect.Array:
+		return marshalElems(ctx, w, v, uint64(v.Len()))
+	}
+
+	d, err := primitive(v)
+	if err != nil {
+		return err
+	}
+
+	return w.WriteData(d)
+}
+
+// marshalPointer function marshals the pointer field `f` of the structure `v`.
+func marshalPointer(ctx context.Context, w Writer, v reflect.Value, f *reflectField) error {
+
+	fv := v.Field(f.index)
+
+	var mr MarshalNDRFunc
+
+	switch fv.Kind() {
+	case reflect.String:
+		if fv.Len() == 0 {
+			return w.WritePointer(nil)
+		}
+		s := fv.String()
+		mr = func(ctx context.Context, w Writer) error {
+			if f.char {
+				return WriteCharNString(ctx, w, s)
+			}
+			return WriteUTF16NString(ctx, w, s)
+		}
+	case reflect.Slice:
+		sz := arraySize(v, f)
+		if fv.IsNil() && sz == 0 {
+			return w.WritePointer(nil)
+		}
+		mr = func(ctx context.Context, w Writer) error {
+			if err := w.WriteSize(sz); err != nil {
+				return err
+			}
+			if f.varying {
+				return marshalVarying(ctx, w, fv, sz)
+			}
+			return marshalElems(ctx, w, fv, sz)
+		}
+	default:
+		if fv.IsNil() {
+			return w.WritePointer(nil)
+		}
+		mr = func(ctx context.Context, w Writer) error {
+			return marshalValue(ctx, w, fv, false)
+		}
+	}
+
+	if f.full {
+		return w.WritePointer(Full(fv.Addr().Interface()), mr)
+	}
+
+	return w.WritePointer(fv.Addr().Interface(), mr)
+}
+
+// marshalUnion function marshals the union field `f` of the structure `v`.
+func marshalUnion(ctx context.Context, w Writer, v reflect.Value, f *reflectField) error {
+
+	fv, sw := v.Field(f.index), v.Field(f.switchIs)
+
+	typ, err := reflectTypeOf(fv.Type())
+	if err != nil {
+		return err
+	}
+
+	align := unionAlignment(sw.Type(), fv.Type(), typ)
+
+	d, err := primitive(sw)
+	if err != nil {
+		return err
+	}
+
+	if err := w.WriteUnionAlign(align); err != nil {
+		return err
+	}
+	if err := w.WriteSwitch(d); err != nil {
+		return err
+	}
+	if err := w.WriteUnionAlign(align); err != nil {
+		return err
+	}
+
+	if arm := typ.arm(intValue(sw)); arm != nil {
+		return marshalField(ctx, w, fv, arm, false)
+	}
+
+	return nil
+}
+
+// unmarshalStruct function unmarshals the structure `v`, if `sz` is set,
+// the conformant array maximum count was read by the enclosing structure.
+func unmarshalStruct(ctx context.Context, r Reader, v reflect.Value, sz *uint64) error {
+
+	typ, err := reflectTypeOf(v.Type())
+	if err != nil {
+		return err
+	}
+
+	if typ.conformant && sz == nil {
+		sz = new(uint64)
+		if err := r.ReadSize(sz); err != nil {
+			return err
+		}
+	}
+
+	if err := r.ReadAlign(typ.align); err != nil {
+		return err
+	}
+
+	for i, f := range typ.fields {
+		var fsz *uint64
+		if typ.conformant && i == len(typ.fields)-1 {
+			fsz = sz
+		}
+		if err := unmarshalField(ctx, r, v, f, fsz); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalField function unmarshals the field `f` of the structure `v`.
+func unmarshalField(ctx context.Context, r Reader, v reflect.Value, f *reflectField, sz *uint64) error {
+
+	fv := v.Field(f.index)
+
+	switch {
+	case f.switchIs >= 0:
+		return unmarshalUnion(ctx, r, v, f)
+	case f.pointer:
+		return unmarshalPointer(ctx, r, v, f)
+	case f.conformant:
+		if sz == nil {
+			return fmt.Errorf("conformant array must be embedded into the structure")
+		}
+		n := *sz
+		if n == 0 && f.sizeIs >= 0 {
+			// opaque unmarshaling.
+			n = intValue(v.Field(f.sizeIs))
+		}
+		if f.varying {
+			return unmarshalVarying(ctx, r, fv)
+		}
+		return unmarshalElems(ctx, r, fv, n)
+	}
+
+	return unmarshalValue(ctx, r, fv, sz)
+}
+
+// unmarshalVarying function reads the varying array offset and actual count
+// followed by the elements.
+func unmarshalVarying(ctx context.Context, r Reader, fv reflect.Value) error {
+
+	var offset, sz uint64
+
+	if err := r.ReadSize(&offset); err != nil {
+		return err
+	}
+	if err := r.ReadSize(&sz); err != nil {
+		return err
+	}
+
+	return unmarshalElems(ctx, r, fv, sz)
+}
+
+// unmarshalElems function reads `sz` array elements.
+func unmarshalElems(ctx context.Context, r Reader, fv reflect.Value, sz uint64) error {
+
+	if sz > uint64(r.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for array size %d", sz)
+	}
+
+	fv.Set(reflect.MakeSlice(fv.Type(), int(sz), int(sz)))
+
+	for i := 0; i < fv.Len(); i++ {
+		if err := unmarshalValue(ctx, r, fv.Index(i), nil); err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalValue function unmarshals the embedded value `v`.
+func unmarshalValue(ctx context.Context, r Reader, v reflect.Value, sz *uint64) error {
+
+	if v.Kind() == reflect.Ptr && v.Type().Implements(unmarshalerType) {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return v.Interface().(Unmarshaler).UnmarshalNDR(ctx, r)
+	}
+
+	if v.Addr().Type().Implements(unmarshalerType) {
+		return v.Addr().Interface().(Unmarshaler).UnmarshalNDR(ctx, r)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshalValue(ctx, r, v.Elem(), sz)
+	case reflect.Struct:
+		return unmarshalStruct(ctx, r, v, sz)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := unmarshalValue(ctx, r, v.Index(i), nil); err != nil {
+				return fmt.Errorf("array element %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
+	return readPrimitive(v, r.ReadData)
+}
+
+// unmarshalPointer function unmarshals the pointer field `f` of the structure `v`.
+func unmarshalPointer(ctx context.Context, r Reader, v reflect.Value, f *reflectField) error {
+
+	fv := v.Field(f.index)
+
+	var mr UnmarshalNDRFunc
+
+	switch fv.Kind() {
+	case reflect.String:
+		mr = func(ctx context.Context, r Reader) error {
+			var s string
+			if f.char {
+				if err := ReadCharNString(ctx, r, &s); err != nil {
+					return err
+				}
+			} else {
+				if err := ReadUTF16NString(ctx, r, &s); err != nil {
+					return err
+				}
+			}
+			fv.SetString(s)
+			return nil
+		}
+	case reflect.Slice:
+		mr = func(ctx context.Context, r Reader) error {
+			var sz uint64
+			if err := r.ReadSize(&sz); err != nil {
+				return err
+			}
+			if f.varying {
+				return unmarshalVarying(ctx, r, fv)
+			}
+			return unmarshalElems(ctx, r, fv, sz)
+		}
+	default:
+		mr = func(ctx context.Context, r Reader) error {
+			return unmarshalValue(ctx, r, fv, nil)
+		}
+	}
+
+	return r.ReadPointer(fv.Addr().Interface(), func(p any) { fv.Set(reflect.ValueOf(p).Elem()) }, mr)
+}
+
+// unmarshalUnion function unmarshals the union field `f` of the structure `v`.
+func unmarshalUnion(ctx context.Context, r Reader, v reflect.Value, f *reflectField) error {
+
+	fv, sw := v.Field(f.index), reflect.New(v.Field(f.switchIs).Type()).Elem()
+
+	// the opaque unmarshaling does not read the discriminant.
+	sw.Set(v.Field(f.switchIs))
+
+	typ, err := reflectTypeOf(fv.Type())
+	if err != nil {
+		return err
+	}
+
+	align := unionAlignment(sw.Type(), fv.Type(), typ)
+
+	if err := r.ReadUnionAlign(align); err != nil {
+		return err
+	}
+	if err := readPrimitive(sw, r.ReadSwitch); err != nil {
+		return err
+	}
+	if err := r.ReadUnionAlign(align); err != nil {
+		return err
+	}
+
+	if arm := typ.arm(intValue(sw)); arm != nil {
+		return unmarshalField(ctx, r, fv, arm, nil)
+	}
+
+	return nil
+}
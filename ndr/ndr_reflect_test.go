@@ -0,0 +1,275 @@
+package ndr_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// the reflected mirror of the dtyp.SID.
+type sid struct {
+	Revision          uint8
+	SubAuthorityCount uint8
+	IDAuthority       *dtyp.SIDIDAuthority
+	SubAuthority      []uint32 `ndr:"conformant,size_is=SubAuthorityCount"`
+}
+
+// the reflected mirror of the srvsvc.ConnectEnum.
+type connectEnum struct {
+	Level       uint32
+	ConnectInfo connectEnumUnion `ndr:"switch_is=Level"`
+}
+
+type connectEnumUnion struct {
+	Level0 *connectInfo0Container `ndr:"case=0,pointer"`
+	Level1 *connectInfo1Container `ndr:"case=1,pointer"`
+}
+
+type connectInfo0Container struct {
+	EntriesRead uint32
+	Buffer      []uint32 `ndr:"pointer,size_is=EntriesRead"`
+}
+
+type connectInfo1Container struct {
+	EntriesRead uint32
+	Buffer      []*connectionInfo1 `ndr:"pointer,size_is=EntriesRead"`
+}
+
+type connectionInfo1 struct {
+	ID          uint32
+	Type        uint32
+	NumOpens    uint32
+	NumUsers    uint32
+	Time        uint32
+	UserName    string `ndr:"pointer"`
+	NetworkName string `ndr:"pointer"`
+}
+
+func TestReflect(t *testing.T) {
+
+	for _, tc := range []struct {
+		Name      string
+		Generated interface {
+			ndr.Marshaler
+			ndr.Unmarshaler
+		}
+		Reflected any
+	}{
+		{
+			Name: "SID",
+			Generated: &dtyp.SID{
+				Revision:          1,
+				SubAuthorityCount: 2,
+				IDAuthority:       &dtyp.SIDIDAuthority{Value: []byte{0, 0, 0, 0, 0, 5}},
+				SubAuthority:      []uint32{21, 512},
+			},
+			Reflected: &sid{
+				Revision:          1,
+				SubAuthorityCount: 2,
+				IDAuthority:       &dtyp.SIDIDAuthority{Value: []byte{0, 0, 0, 0, 0, 5}},
+				SubAuthority:      []uint32{21, 512},
+			},
+		},
+		{
+			Name: "Union",
+			Generated: &srvsvc.ConnectEnum{
+				Level: 1,
+				ConnectInfo: &srvsvc.ConnectEnumUnion{
+					Value: &srvsvc.ConnectEnumUnion_Level1{
+						Level1: &srvsvc.ConnectInfo1Container{
+							EntriesRead: 2,
+							Buffer: []*srvsvc.ConnectionInfo1{
+								{ID: 1, Type: 3, NumOpens: 2, UserName: "alice", NetworkName: "IPC$"},
+								{ID: 2, Time: 60, NetworkName: "C$"},
+							},
+						},
+					},
+				},
+			},
+			Reflected: &connectEnum{
+				Level: 1,
+				ConnectInfo: connectEnumUnion{
+					Level1: &connectInfo1Container{
+						EntriesRead: 2,
+						Buffer: []*connectionInfo1{
+							{ID: 1, Type: 3, NumOpens: 2, UserName: "alice", NetworkName: "IPC$"},
+							{ID: 2, Time: 60, NetworkName: "C$"},
+						},
+					},
+				},
+			},
+		},
+	} {
+
+		for _, codec := range []struct {
+			Name      string
+			Marshal   func(ndr.Marshaler, ...any) ([]byte, error)
+			Unmarshal func([]byte, ndr.Unmarshaler, ...any) error
+		}{
+			{"NDR20", ndr.Marshal, ndr.Unmarshal},
+			{"NDR64", ndr.Marshal64, ndr.Unmarshal64},
+		} {
+
+			t.Run(tc.Name+"/"+codec.Name, func(t *testing.T) {
+
+				expected, err := codec.Marshal(tc.Generated)
+				if err != nil {
+					t.Fatalf("marshal generated: %v", err)
+				}
+
+				b, err := codec.Marshal(ndr.Reflect(tc.Reflected))
+				if err != nil {
+					t.Fatalf("marshal: %v", err)
+				}
+
+				if !bytes.Equal(b, expected) {
+					t.Fatalf("marshal: got %x, expected %x", b, expected)
+				}
+
+				out := reflect.New(reflect.TypeOf(tc.Reflected).Elem()).Interface()
+				if err := codec.Unmarshal(expected, ndr.Reflect(out)); err != nil {
+					t.Fatalf("unmarshal: %v", err)
+				}
+
+				if !reflect.DeepEqual(out, tc.Reflected) {
+					t.Errorf("unmarshal: got %+v, expected %+v", out, tc.Reflected)
+				}
+			})
+		}
+	}
+}
+
+func TestReflectInvalid(t *testing.T) {
+
+	for _, v := range []any{
+		// the conformant array must be the last field.
+		&struct {
+			Data []byte `ndr:"conformant"`
+			ID   uint32
+		}{},
+		// the slice requires conformant or pointer tag.
+		&struct{ Data []byte }{},
+		// the size_is must refer to the integer field.
+		&struct {
+			Data []byte `ndr:"pointer,size_is=Missing"`
+		}{},
+		// the value must be the pointer to the structure.
+		new(uint32),
+	} {
+		if _, err := ndr.Marshal(ndr.Reflect(v)); err == nil {
+			t.Errorf("marshal %T: expected error", v)
+		}
+	}
+
+	// the null pointers.
+	in := &connectInfo0Container{}
+	b, err := ndr.Marshal(ndr.Reflect(in))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	out := &connectInfo0Container{EntriesRead: 1}
+	if err := ndr.Unmarshal(b, ndr.Reflect(out)); err != nil || !reflect.DeepEqual(in, out) {
+		t.Fatalf("unmarshal: got %+v (%v), expected %+v", out, err, in)
+	}
+}
+
+type node struct {
+	Value uint32
+	Next  *node `ndr:"pointer"`
+}
+
+func TestLimits(t *testing.T) {
+
+	in := &connectEnum{
+		Level: 1,
+		ConnectInfo: connectEnumUnion{
+			Level1: &connectInfo1Container{
+				EntriesRead: 3,
+				Buffer: []*connectionInfo1{
+					{ID: 1, UserName: "alice", NetworkName: "IPC$"},
+					{ID: 2, UserName: "bob"},
+					{ID: 3, NetworkName: "C$"},
+				},
+			},
+		},
+	}
+
+	list := &node{}
+	for i := 0; i < 10; i++ {
+		list = &node{Value: uint32(i), Next: list}
+	}
+
+	for _, tc := range []struct {
+		Name   string
+		In     any
+		Limits *ndr.Limits
+		Limit  string
+	}{
+		{"MaxArrayCount", in, &ndr.Limits{MaxArrayCount: 2}, "max_array_count"},
+		{"MaxStringLength", in, &ndr.Limits{MaxStringLength: 5}, "max_string_length"},
+		{"MaxBytes", in, &ndr.Limits{MaxBytes: 64}, "max_bytes"},
+		{"MaxPointerDepth", list, &ndr.Limits{MaxPointerDepth: 5}, "max_pointer_depth"},
+		{"Default", in, ndr.DefaultLimits(), ""},
+	} {
+
+		t.Run(tc.Name, func(t *testing.T) {
+
+			b, err := ndr.Marshal(ndr.Reflect(tc.In))
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			for _, unmarshal := range []func(ndr.Unmarshaler) error{
+				func(out ndr.Unmarshaler) error { return ndr.Unmarshal(b, out, tc.Limits) },
+				func(out ndr.Unmarshaler) error { return ndr.UnmarshalFrom(bytes.NewReader(b), out, tc.Limits) },
+			} {
+
+				err := unmarshal(ndr.Reflect(reflect.New(reflect.TypeOf(tc.In).Elem()).Interface()))
+				if tc.Limit == "" {
+					if err != nil {
+						t.Fatalf("unmarshal: %v", err)
+					}
+					continue
+				}
+
+				if !errors.Is(err, ndr.ErrLimitExceeded) {
+					t.Fatalf("unmarshal: got %v, want %v", err, ndr.ErrLimitExceeded)
+				}
+
+				var limitErr *ndr.LimitError
+				if !errors.As(err, &limitErr) || limitErr.Limit != tc.Limit {
+					t.Fatalf("unmarshal: got %v, want %s", err, tc.Limit)
+				}
+			}
+		})
+	}
+}
+
+func TestReflectFullPointer(t *testing.T) {
+
+	type pair struct {
+		First  *node `ndr:"pointer"`
+		Second *node `ndr:"ptr"`
+	}
+
+	n := &node{Value: 1}
+
+	b, err := ndr.Marshal64(ndr.Reflect(&pair{First: n, Second: n}))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	out := &pair{}
+	if err := ndr.Unmarshal64(b, ndr.Reflect(out)); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if out.First == nil || out.First != out.Second || out.First.Value != 1 {
+		t.Errorf("unmarshal: full pointer alias is not preserved: %+v", out)
+	}
+}
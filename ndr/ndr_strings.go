@@ -2,8 +2,8 @@ package ndr
 
 import (
 	"context"
+	"fmt"
 	"strings"
-	"unicode/utf16"
 )
 
 func MultiSzLen(s []string) uint64 {
@@ -14,26 +14,17 @@ func MultiSzLen(s []string) uint64 {
 	return l
 }
 
-// UTF16NLen function ...
+// UTF16NLen function returns the number of UTF-16 code units of the
+// null-terminated string (including the terminator).
 func UTF16NLen(s string) uint64 {
-	l := len(s)
-	for _, r := range s {
-		if r == 0x0000 {
-			l--
-			// null-terminated string.
-			break
-		}
-		if r >= 0x10000 && r <= '\U0010FFFF' {
-			l++
-		}
-	}
-	return uint64(l) + 1
+	return UTF16Len(strings.TrimRight(s, ZeroString)) + 1
 }
 
+// UTF16Len function returns the number of UTF-16 code units of the string.
 func UTF16Len(s string) uint64 {
-	l := len(s)
+	l := 0
 	for _, r := range s {
-		if r >= 0x10000 && r <= '\U0010FFFF' {
+		if l++; r >= 0x10000 && r <= '\U0010FFFF' {
 			l++
 		}
 	}
@@ -97,6 +88,14 @@ func ReadCharNString(ctx context.Context, r Reader, s *string) error {
 		return err
 	}
 
+	if err := checkStringLimit(r, sz); err != nil {
+		return err
+	}
+
+	if sz > uint64(r.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for string size %d", sz)
+	}
+
 	var buf = make([]byte, sz)
 
 	for i := range buf {
@@ -151,6 +150,14 @@ func ReadCharString(ctx context.Context, r Reader, s *string) error {
 		return err
 	}
 
+	if err := checkStringLimit(r, sz); err != nil {
+		return err
+	}
+
+	if sz > uint64(r.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for string size %d", sz)
+	}
+
 	var buf = make([]byte, sz)
 
 	for i := range buf {
@@ -178,10 +185,8 @@ func WriteUTF16String(ctx context.Context, w Writer, s string) error {
 		return err
 	}
 
-	for _, chr := range utf16.Encode([]rune(s)) {
-		if err := w.WriteData(chr); err != nil {
-			return err
-		}
+	if err := writeUTF16(w, s); err != nil {
+		return err
 	}
 
 	return nil
@@ -205,15 +210,20 @@ func ReadUTF16String(ctx context.Context, r Reader, s *string) error {
 		return err
 	}
 
-	var buf = make([]uint16, sz)
+	if err := checkStringLimit(r, sz); err != nil {
+		return err
+	}
 
-	for i := range buf {
-		if err := r.ReadData(&buf[i]); err != nil {
-			return err
-		}
+	if sz > uint64(r.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for string size %d", sz)
 	}
 
-	*s = string(utf16.Decode(buf))
+	str, err := readUTF16(r, sz, false)
+	if err != nil {
+		return err
+	}
+
+	*s = str
 
 	return nil
 }
@@ -235,10 +245,8 @@ func WriteUTF16NString(ctx context.Context, w Writer, s string) error {
 
 	s = strings.TrimRight(s, ZeroString)
 
-	for _, chr := range utf16.Encode([]rune(s)) {
-		if err := w.WriteData(chr); err != nil {
-			return err
-		}
+	if err := writeUTF16(w, s); err != nil {
+		return err
 	}
 
 	return w.WriteData(uint16(0))
@@ -262,15 +270,20 @@ func ReadUTF16NString(ctx context.Context, r Reader, s *string) error {
 		return err
 	}
 
-	var buf = make([]uint16, sz)
+	if err := checkStringLimit(r, sz); err != nil {
+		return err
+	}
 
-	for i := range buf {
-		if err := r.ReadData(&buf[i]); err != nil {
-			return err
-		}
+	if sz > uint64(r.Len()) /* sanity-check */ {
+		return fmt.Errorf("buffer overflow for string size %d", sz)
+	}
+
+	str, err := readUTF16(r, sz, true)
+	if err != nil {
+		return err
 	}
 
-	*s = strings.TrimRight(string(utf16.Decode(buf)), ZeroString)
+	*s = str
 
 	return nil
 }
@@ -0,0 +1,135 @@
+package ndr
+
+// ndr_utf16.go module contains the UTF-16 string conversion used by the
+// string helpers: the code units are decoded directly from the read buffer
+// into the UTF-8 bytes without the intermediate []uint16 and []rune slices.
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// utf16Chunk is the number of code units decoded at once.
+const utf16Chunk = 128
+
+// utf16Reader interface is implemented by the readers that can decode the
+// UTF-16 code units without the intermediate buffers.
+type utf16Reader interface {
+	readUTF16(uint64, bool) (string, error)
+}
+
+// readUTF16 function reads `n` UTF-16 code units from `r` and returns the
+// UTF-8 string, if `trim` is set, the trailing null characters are removed.
+func readUTF16(r Reader, n uint64, trim bool) (string, error) {
+
+	if r, ok := r.(utf16Reader); ok {
+		return r.readUTF16(n, trim)
+	}
+
+	// the generic reader.
+	var buf = make([]uint16, n)
+
+	for i := range buf {
+		if err := r.ReadData(&buf[i]); err != nil {
+			return "", err
+		}
+	}
+
+	s := string(utf16.Decode(buf))
+	if trim {
+		s = strings.TrimRight(s, ZeroString)
+	}
+
+	return s, nil
+}
+
+// readUTF16 function reads `n` UTF-16 code units from the buffer and
+// returns the UTF-8 string. The decoding is equivalent to utf16.Decode.
+func (w *ndr20) readUTF16(n uint64, trim bool) (string, error) {
+
+	if w.err != nil {
+		return "", w.err
+	}
+
+	if n == 0 {
+		return "", nil
+	}
+
+	if err := w.buf.SkipMod(2); err != nil {
+		return "", w.SetErr(err)
+	}
+
+	var (
+		tmp   = w.units[:]
+		order = w.buf.Order()
+		// the ASCII string is the most common case.
+		b = make([]byte, 0, n)
+		// the pending high surrogate.
+		hi rune
+	)
+
+	for n > 0 {
+
+		k := min(n, utf16Chunk)
+		if _, err := w.buf.Read(tmp[:k*2]); err != nil {
+			return "", w.SetErr(err)
+		}
+		n -= k
+
+		for i := uint64(0); i < k*2; i += 2 {
+			r := rune(order.Uint16(tmp[i:]))
+			if hi != 0 {
+				if utf16.IsSurrogate(r) && r >= 0xdc00 {
+					b, hi = utf8.AppendRune(b, utf16.DecodeRune(hi, r)), 0
+					continue
+				}
+				// unpaired high surrogate.
+				b, hi = utf8.AppendRune(b, utf8.RuneError), 0
+			}
+			switch {
+			case r < utf8.RuneSelf:
+				b = append(b, byte(r))
+			case utf16.IsSurrogate(r) && r < 0xdc00:
+				hi = r
+			default:
+				// utf8.AppendRune encodes the unpaired low surrogate
+				// as utf8.RuneError.
+				b = utf8.AppendRune(b, r)
+			}
+		}
+	}
+
+	if hi != 0 {
+		b = utf8.AppendRune(b, utf8.RuneError)
+	}
+
+	if err := w.checkBytesLimit(); err != nil {
+		return "", err
+	}
+
+	if trim {
+		b = bytes.TrimRight(b, ZeroString)
+	}
+
+	return bytesToString(b), nil
+}
+
+// writeUTF16 function writes the string `s` as UTF-16 code units. The encoding
+// is equivalent to utf16.Encode([]rune(s)).
+func writeUTF16(w Writer, s string) error {
+	for _, r := range s {
+		if r >= 0x10000 {
+			r1, r2 := utf16.EncodeRune(r)
+			if err := w.WriteData(uint16(r1)); err != nil {
+				return err
+			}
+			r = r2
+		}
+		if err := w.WriteData(uint16(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
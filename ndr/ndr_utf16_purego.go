@@ -0,0 +1,8 @@
+//go:build purego
+
+package ndr
+
+// bytesToString function returns the copy of the bytes `b` as string.
+func bytesToString(b []byte) string {
+	return string(b)
+}
@@ -0,0 +1,168 @@
+package ndr
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+// utf16String is the UTF-16 string with the raw code units.
+type utf16String []uint16
+
+func (o utf16String) MarshalNDR(ctx context.Context, w Writer) error {
+	for i := 0; i < 3; i++ {
+		if err := w.WriteSize(uint64(len(o))); err != nil {
+			return err
+		}
+	}
+	for i := range o {
+		if err := w.WriteData(o[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestUTF16(t *testing.T) {
+
+	for _, units := range []utf16String{
+		{},
+		utf16.Encode([]rune("ascii")),
+		utf16.Encode([]rune("ünïcödé 日本語 \U0001F600")),
+		utf16.Encode([]rune("trailing\x00\x00")),
+		// the surrogate pair on the chunk boundary.
+		utf16.Encode([]rune(strings.Repeat("a", utf16Chunk-1) + "\U0001F600")),
+		// the unpaired surrogates.
+		{0xd83d},
+		{0xd83d, 'a'},
+		{0xde00, 'a'},
+		{0xd83d, 0xd83d, 0xde00},
+	} {
+
+		for _, codec := range []struct {
+			Marshal   func(Marshaler, ...any) ([]byte, error)
+			Unmarshal func([]byte, Unmarshaler, ...any) error
+			Opts      []any
+		}{
+			{Marshal, Unmarshal, nil},
+			{Marshal, Unmarshal, []any{BigEndianDataRepresentation}},
+			{Marshal64, Unmarshal64, nil},
+		} {
+
+			marshal, unmarshal, opts := codec.Marshal, codec.Unmarshal, codec.Opts
+
+			b, err := marshal(units, opts...)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			expected := string(utf16.Decode(units))
+
+			var s, sn string
+			if err := unmarshal(b, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+				return ReadUTF16String(ctx, r, &s)
+			}), opts...); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+
+			if s != expected {
+				t.Errorf("unmarshal %x: got %q, expected %q", units, s, expected)
+			}
+
+			if err := unmarshal(b, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+				return ReadUTF16NString(ctx, r, &sn)
+			}), opts...); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+
+			if expected = strings.TrimRight(expected, ZeroString); sn != expected {
+				t.Errorf("unmarshal %x: got %q, expected %q", units, sn, expected)
+			}
+		}
+	}
+}
+
+func BenchmarkReadUTF16String(b *testing.B) {
+
+	s := strings.Repeat("ServerName-é日", 16)
+
+	buf, err := Marshal(MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+		return WriteUTF16String(ctx, w, s)
+	}))
+	if err != nil {
+		b.Fatalf("marshal: %v", err)
+	}
+
+	b.Run("Decode", func(b *testing.B) {
+		// the reference implementation: read the code units and decode them
+		// with utf16.Decode.
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var out string
+			if err := Unmarshal(buf, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+				sz := uint64(0)
+				for i := 0; i < 3; i++ {
+					if err := r.ReadSize(&sz); err != nil {
+						return err
+					}
+				}
+				units := make([]uint16, sz)
+				for i := range units {
+					if err := r.ReadData(&units[i]); err != nil {
+						return err
+					}
+				}
+				out = string(utf16.Decode(units))
+				return nil
+			})); err != nil || out != s {
+				b.Fatalf("unmarshal: %v", err)
+			}
+		}
+	})
+
+	b.Run("ReadUTF16String", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var out string
+			if err := Unmarshal(buf, UnmarshalNDRFunc(func(ctx context.Context, r Reader) error {
+				return ReadUTF16String(ctx, r, &out)
+			})); err != nil || out != s {
+				b.Fatalf("unmarshal: %v", err)
+			}
+		}
+	})
+}
+
+func BenchmarkWriteUTF16String(b *testing.B) {
+
+	s := strings.Repeat("ServerName-é日", 16)
+
+	b.Run("Encode", func(b *testing.B) {
+		// the reference implementation: encode the runes with utf16.Encode.
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := Marshal(MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+				for _, chr := range utf16.Encode([]rune(s)) {
+					if err := w.WriteData(chr); err != nil {
+						return err
+					}
+				}
+				return nil
+			})); err != nil {
+				b.Fatalf("marshal: %v", err)
+			}
+		}
+	})
+
+	b.Run("WriteUTF16String", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := Marshal(MarshalNDRFunc(func(ctx context.Context, w Writer) error {
+				return WriteUTF16String(ctx, w, s)
+			})); err != nil {
+				b.Fatalf("marshal: %v", err)
+			}
+		}
+	})
+}
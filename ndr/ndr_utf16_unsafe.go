@@ -0,0 +1,11 @@
+//go:build !purego
+
+package ndr
+
+import "unsafe"
+
+// bytesToString function returns the string that shares the memory with
+// the bytes `b`, the bytes must not be modified after the call.
+func bytesToString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
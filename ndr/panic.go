@@ -0,0 +1,151 @@
+package ndr
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// ErrPanic is returned (wrapped into PanicError) when the encoding or decoding
+// of the malformed input panics.
+var ErrPanic = errors.New("ndr: panic")
+
+// PanicError is the panic (for example, index out of range or nil pointer
+// dereference) raised by the marshaling code and converted to error.
+type PanicError struct {
+	// The path of the types being unmarshaled (marshaled) when the panic
+	// was raised, the outermost type first (for example, ["lsarpc/v0.LookupSIDsResponse",
+	// "lsarpc/v0.LookupSIDs", "lsarpc/v0.TranslatedNames", "dtyp.UnicodeString"]).
+	Path []string
+	// The panic value.
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("%v: %v", ErrPanic, e.Value)
+	}
+	return fmt.Sprintf("%v: %s: %v", ErrPanic, strings.Join(e.Path, " > "), e.Value)
+}
+
+// Is function returns `true` for ErrPanic.
+func (e *PanicError) Is(err error) bool {
+	return err == ErrPanic
+}
+
+// Unwrap function returns the panic value if it is an error (for example,
+// runtime.Error).
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Recover function converts the panic to the PanicError and stores it to
+// `err`. The function must be deferred directly:
+//
+//	func decode(b []byte, v ndr.Unmarshaler) (err error) {
+//		defer ndr.Recover(&err)
+//		// ...
+//	}
+func Recover(err *error) {
+	if r := recover(); r != nil {
+		*err = NewPanicError(r)
+	}
+}
+
+// NewPanicError function returns the panic error for the recovered value
+// `r`. The function must be called by the deferred function while the
+// panic is being recovered to capture the type path.
+func NewPanicError(r any) error {
+
+	pc := make([]uintptr, 128)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+
+	var path []string
+
+	for {
+		frame, more := frames.Next()
+		if typ, ok := marshalType(frame.Function); ok && (len(path) == 0 || path[len(path)-1] != typ) {
+			path = append(path, typ)
+		}
+		if !more {
+			break
+		}
+	}
+
+	// outermost type first.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return &PanicError{Path: path, Value: r}
+}
+
+// marshalType function returns the receiver type name ("pkg.Type") of the
+// (un)marshaling method (including the closures declared in the method).
+func marshalType(fn string) (string, bool) {
+
+	// github.com/oiweiwei/go-msrpc/msrpc/dtyp.(*SID).UnmarshalNDR.func1
+	var dir string
+	if i := strings.LastIndex(fn, "/"); i >= 0 {
+		dir, fn = fn[:i], fn[i+1:]
+	}
+
+	pkg, rest, ok := strings.Cut(fn, ".")
+	if !ok {
+		return "", false
+	}
+
+	if isVersion(pkg) {
+		// github.com/oiweiwei/go-msrpc/msrpc/lsat/lsarpc/v0.(*LookupSIDsResponse).UnmarshalNDR
+		pkg = dir[strings.LastIndex(dir, "/")+1:] + "/" + pkg
+	}
+
+	typ, method, ok := strings.Cut(rest, ".")
+	if !ok || !strings.HasPrefix(typ, "(") {
+		return "", false
+	}
+
+	if method, _, _ = strings.Cut(method, "."); !isMarshalMethod(method) {
+		return "", false
+	}
+
+	typ = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(typ, "("), "*"), ")")
+	if typ == "ndr20" || typ == "ndr64" {
+		return "", false
+	}
+
+	if strings.HasPrefix(typ, "xxx_") && strings.HasSuffix(typ, "Operation") {
+		// the operation name.
+		typ = strings.TrimSuffix(strings.TrimPrefix(typ, "xxx_"), "Operation")
+	}
+
+	return pkg + "." + typ, true
+}
+
+// isVersion function returns `true` if the package directory is the interface
+// version (v0, v1, ...).
+func isVersion(pkg string) bool {
+	if len(pkg) < 2 || pkg[0] != 'v' {
+		return false
+	}
+	for _, c := range pkg[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isMarshalMethod function returns `true` if the method is the NDR
+// (un)marshaling method.
+func isMarshalMethod(method string) bool {
+	switch method {
+	case "UnmarshalNDR", "MarshalNDR",
+		"UnmarshalNDRRequest", "UnmarshalNDRResponse",
+		"MarshalNDRRequest", "MarshalNDRResponse":
+		return true
+	}
+	return false
+}
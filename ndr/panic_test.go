@@ -0,0 +1,67 @@
+package ndr
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+// sized is the structure that trusts the decoded size as the generated
+// code does for the conformant arrays.
+type sized struct {
+	Values []uint32
+	Child  *sizedChild
+}
+
+type sizedChild struct {
+	Index  uint32
+	Values [4]uint32
+}
+
+func (o *sized) UnmarshalNDR(ctx context.Context, w Reader) error {
+	var sz uint32
+	if err := w.ReadData(&sz); err != nil {
+		return err
+	}
+	o.Values = make([]uint32, sz)
+	for i := range o.Values {
+		if err := w.ReadData(&o.Values[i]); err != nil {
+			return err
+		}
+	}
+	o.Child = &sizedChild{}
+	return o.Child.UnmarshalNDR(ctx, w)
+}
+
+func (o *sizedChild) UnmarshalNDR(ctx context.Context, w Reader) error {
+	if err := w.ReadData(&o.Index); err != nil {
+		return err
+	}
+	// index out of range for the malformed input.
+	return w.ReadData(&o.Values[o.Index])
+}
+
+func TestUnmarshalPanic(t *testing.T) {
+
+	err := Unmarshal([]byte{1, 0, 0, 0, 1, 0, 0, 0, 5, 0, 0, 0, 1, 0, 0, 0}, &sized{})
+	if !errors.Is(err, ErrPanic) {
+		t.Fatalf("expected panic error, got %v", err)
+	}
+
+	var perr *PanicError
+	if !errors.As(err, &perr) || !reflect.DeepEqual(perr.Path, []string{"ndr.sized", "ndr.sizedChild"}) {
+		t.Fatalf("unexpected panic path: %v", err)
+	}
+
+	var rerr runtime.Error
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected runtime error, got %v", err)
+	}
+
+	// the valid input.
+	if err := Unmarshal([]byte{1, 0, 0, 0, 1, 0, 0, 0, 3, 0, 0, 0, 1, 0, 0, 0}, &sized{}); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package ndr
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// The maximum scratch buffer capacity retained by the pooled encoder.
+	maxPooledBufferSize = 64 << 10
+	// The maximum number of referents retained by the pooled codec.
+	maxPooledReferents = 1024
+)
+
+var (
+	encoders   = sync.Pool{New: func() any { return NewEncoder() }}
+	encoders64 = sync.Pool{New: func() any { return NewEncoder64() }}
+	decoders   = sync.Pool{New: func() any { return NewDecoder() }}
+	decoders64 = sync.Pool{New: func() any { return NewDecoder64() }}
+)
+
+// pooled function returns true if the pooled codec can be used with the
+// options `opts` (the buffer is not provided by the caller).
+func pooled(opts []any) bool {
+	for i := range opts {
+		if _, ok := opts[i].(ChunkedBuffer); ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Encoder structure represents the reusable NDR encoder. The encoder retains
+// the scratch buffer and the pointer maps between the calls, so that the
+// high-frequency callers do not allocate them for every call.
+//
+// The Encoder is not safe for concurrent use.
+type Encoder struct {
+	// The codec state.
+	w *ndr20
+	// The codec (NDR2.0 or NDR64).
+	enc NDR
+	// The codec options.
+	opts []any
+	// The scratch buffer.
+	b []byte
+	// The number of referents written by the last call.
+	n int
+}
+
+// NewEncoder function returns the reusable NDR2.0 encoder.
+func NewEncoder(opts ...any) *Encoder {
+	w := NDR20(nil).(*ndr20)
+	return &Encoder{w: w, enc: w, opts: opts}
+}
+
+// NewEncoder64 function returns the reusable NDR64 encoder.
+func NewEncoder64(opts ...any) *Encoder {
+	w := NDR64(nil).(*ndr64)
+	return &Encoder{w: w.ndr20, enc: w, opts: opts}
+}
+
+// Encode function marshals the data `d` into the scratch buffer. The returned
+// bytes are valid until the next Encode call, use Marshal (or copy the bytes)
+// to retain the result.
+func (e *Encoder) Encode(ctx context.Context, d Marshaler) ([]byte, error) {
+
+	e.w.reset(e.b[:0], e.opts)
+	defer func() { e.n = len(e.w.wptrs); e.w.release() }()
+
+	b, err := e.enc.Marshal(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if pooled(e.opts) {
+		e.b = b
+	}
+
+	return b, nil
+}
+
+// Marshal function marshals the data `d` and returns the copy of the
+// encoded bytes.
+func (e *Encoder) Marshal(ctx context.Context, d Marshaler) ([]byte, error) {
+	b, err := e.Encode(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), b...), nil
+}
+
+// marshal function marshals the data `d` with the options `opts`.
+func (e *Encoder) marshal(ctx context.Context, d Marshaler, opts []any) ([]byte, error) {
+	e.opts = opts
+	return e.Marshal(ctx, d)
+}
+
+// release function returns the encoder to the pool.
+func (e *Encoder) release() {
+	if e.opts = nil; cap(e.b) > maxPooledBufferSize {
+		return
+	}
+	if e.n > maxPooledReferents {
+		return
+	}
+	if _, ok := e.enc.(*ndr64); ok {
+		encoders64.Put(e)
+	} else {
+		encoders.Put(e)
+	}
+}
+
+// Decoder structure represents the reusable NDR decoder. The decoder retains
+// the pointer maps between the calls.
+//
+// The Decoder is not safe for concurrent use.
+type Decoder struct {
+	// The codec state.
+	w *ndr20
+	// The codec (NDR2.0 or NDR64).
+	dec NDR
+	// The codec options.
+	opts []any
+	// The number of referents read by the last call.
+	n int
+}
+
+// NewDecoder function returns the reusable NDR2.0 decoder.
+func NewDecoder(opts ...any) *Decoder {
+	w := NDR20(nil).(*ndr20)
+	return &Decoder{w: w, dec: w, opts: opts}
+}
+
+// NewDecoder64 function returns the reusable NDR64 decoder.
+func NewDecoder64(opts ...any) *Decoder {
+	w := NDR64(nil).(*ndr64)
+	return &Decoder{w: w.ndr20, dec: w, opts: opts}
+}
+
+// Decode function unmarshals the bytes `b` to the data `d`.
+func (dec *Decoder) Decode(ctx context.Context, b []byte, d Unmarshaler) error {
+
+	dec.w.reset(b, dec.opts)
+	defer func() { dec.n = len(dec.w.ptrs); dec.w.release() }()
+
+	return dec.dec.Unmarshal(ctx, d)
+}
+
+// unmarshal function unmarshals the bytes `b` to the data `d` with the
+// options `opts`.
+func (dec *Decoder) unmarshal(ctx context.Context, b []byte, d Unmarshaler, opts []any) error {
+	dec.opts = opts
+	return dec.Decode(ctx, b, d)
+}
+
+// release function returns the decoder to the pool.
+func (dec *Decoder) release() {
+	if dec.opts = nil; dec.n > maxPooledReferents {
+		return
+	}
+	if _, ok := dec.dec.(*ndr64); ok {
+		decoders64.Put(dec)
+	} else {
+		decoders.Put(dec)
+	}
+}
+
+// release function drops the references to the buffer and to the
+// marshaled/unmarshaled data, so that they are not retained by the
+// pooled codec.
+func (w *ndr20) release() {
+	clear(w.ptrs)
+	clear(w.wptrs)
+	w.buf, w.wdeferred, w.rdeferred = nil, nil, nil
+}
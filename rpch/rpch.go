@@ -0,0 +1,503 @@
+// The rpch package implements the RPC over HTTP transport. (MS-RPCH)
+//
+// The RPC over HTTP v2 connection consists of two HTTP channels
+// established with the RPC proxy: the IN channel (RPC_IN_DATA) that
+// carries the client-to-server PDUs and the OUT channel (RPC_OUT_DATA)
+// that carries the server-to-client PDUs. The channels are negotiated
+// with the RTS PDUs (CONN/A1, CONN/B1, CONN/A3, CONN/C2) and are
+// flow-controlled by the receive windows of both peers.
+//
+// The RPC over HTTP v1 (the direct connection to the RPC server HTTP
+// endpoint, usually port 593) is available via DialDirect function.
+//
+// Known limitations: only Basic authentication is supported for the
+// RPC proxy (custom Authorization header can be set with Header), the
+// channel recycling is not implemented, hence the connection lifetime
+// is limited by the channel lifetime (1GB of data by default).
+package rpch
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// The RPC proxy rejected the channel.
+	ErrProxyRejected = errors.New("rpc proxy rejected the channel")
+	// The connection is closed.
+	ErrClosed = errors.New("rpc over http connection is closed")
+)
+
+const (
+	// The default RPC over HTTP v1 port.
+	DefaultPort = 593
+	// The default receive window size.
+	DefaultReceiveWindowSize = 65536
+	// The default channel lifetime.
+	DefaultChannelLifetime = 1073741824
+	// The default client keep-alive interval.
+	DefaultClientKeepalive = 300 * time.Second
+	// The default proxy URI path.
+	DefaultPath = "/rpc/rpcproxy.dll"
+
+	// The RPC over HTTP v1 server greeting.
+	legacyGreeting = "ncacn_http/1.0"
+)
+
+// Config represents the RPC over HTTP v2 proxy configuration.
+type Config struct {
+	// The RPC proxy address (host[:port]). Default port is 443, or
+	// 80 if Insecure is set.
+	Proxy string
+	// The proxy URI path. (default is /rpc/rpcproxy.dll).
+	Path string
+	// Use plain HTTP instead of HTTPS.
+	Insecure bool
+	// The TLS configuration.
+	TLSConfig *tls.Config
+	// The Basic authentication credentials.
+	Username, Password string
+	// The extra HTTP headers.
+	Header http.Header
+	// The receive window size advertised for the OUT channel.
+	ReceiveWindowSize uint32
+	// The IN channel lifetime in bytes.
+	ChannelLifetime uint32
+	// The client keep-alive interval.
+	ClientKeepalive time.Duration
+	// The network dial function.
+	Dial func(ctx context.Context, network, addr string) (net.Conn, error)
+	// The handshake timeout.
+	Timeout time.Duration
+}
+
+// ProxyAddr function returns the RPC proxy address with port.
+func (cfg *Config) ProxyAddr() string {
+	if _, _, err := net.SplitHostPort(cfg.Proxy); err == nil {
+		return cfg.Proxy
+	}
+	if cfg.Insecure {
+		return net.JoinHostPort(cfg.Proxy, "80")
+	}
+	return net.JoinHostPort(cfg.Proxy, "443")
+}
+
+func (cfg *Config) defaults() Config {
+
+	c := *cfg
+
+	if c.Path == "" {
+		c.Path = DefaultPath
+	}
+	if c.ReceiveWindowSize == 0 {
+		c.ReceiveWindowSize = DefaultReceiveWindowSize
+	}
+	if c.ChannelLifetime == 0 {
+		c.ChannelLifetime = DefaultChannelLifetime
+	}
+	if c.ClientKeepalive == 0 {
+		c.ClientKeepalive = DefaultClientKeepalive
+	}
+	if c.Dial == nil {
+		c.Dial = (&net.Dialer{Timeout: c.Timeout}).DialContext
+	}
+
+	return c
+}
+
+// Conn represents the RPC over HTTP v2 virtual connection.
+type Conn struct {
+	cfg Config
+
+	in, out net.Conn
+	// the OUT channel response body.
+	outR io.Reader
+
+	vcCookie, inCookie, outCookie Cookie
+
+	// the pending RPC PDU data.
+	rbuf []byte
+	// the number of bytes received on the OUT channel and the number
+	// of bytes at the last flow control acknowledgement.
+	received, acked uint32
+
+	wmu  sync.Mutex
+	cond *sync.Cond
+	// the number of bytes sent on the IN channel and the IN channel
+	// proxy window.
+	sent, inWindow, inAcked uint32
+	closed                  bool
+}
+
+// Dial function establishes the RPC over HTTP v2 virtual connection to the
+// RPC server `server` port `port` via the RPC proxy.
+func Dial(ctx context.Context, cfg *Config, server string, port int) (*Conn, error) {
+
+	c := &Conn{
+		cfg:       cfg.defaults(),
+		vcCookie:  NewCookie(),
+		inCookie:  NewCookie(),
+		outCookie: NewCookie(),
+	}
+
+	c.cond = sync.NewCond(&c.wmu)
+
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	uri := c.cfg.Path + "?" + server + ":" + strconv.Itoa(port)
+
+	var err error
+
+	// open OUT channel and send CONN/A1.
+	if c.out, err = c.dial(ctx); err != nil {
+		return nil, fmt.Errorf("rpch: out channel: %w", err)
+	}
+
+	a1 := (&RTS{Commands: []*Command{
+		{Type: CommandVersion, Value: 1},
+		{Type: CommandCookie, Cookie: c.vcCookie},
+		{Type: CommandCookie, Cookie: c.outCookie},
+		{Type: CommandReceiveWindowSize, Value: c.cfg.ReceiveWindowSize},
+	}}).Bytes()
+
+	if err := c.writeRequest(c.out, "RPC_OUT_DATA", uri, len(a1), a1); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("rpch: out channel: %w", err)
+	}
+
+	// open IN channel and send CONN/B1.
+	if c.in, err = c.dial(ctx); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("rpch: in channel: %w", err)
+	}
+
+	b1 := (&RTS{Commands: []*Command{
+		{Type: CommandVersion, Value: 1},
+		{Type: CommandCookie, Cookie: c.vcCookie},
+		{Type: CommandCookie, Cookie: c.inCookie},
+		{Type: CommandChannelLifetime, Value: c.cfg.ChannelLifetime},
+		{Type: CommandClientKeepalive, Value: uint32(c.cfg.ClientKeepalive / time.Millisecond)},
+		{Type: CommandAssociationGroupID, Cookie: NewCookie()},
+	}}).Bytes()
+
+	if err := c.writeRequest(c.in, "RPC_IN_DATA", uri, int(c.cfg.ChannelLifetime), b1); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("rpch: in channel: %w", err)
+	}
+
+	if err := c.handshake(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// dial function dials the RPC proxy.
+func (c *Conn) dial(ctx context.Context) (net.Conn, error) {
+
+	conn, err := c.cfg.Dial(ctx, "tcp", c.cfg.ProxyAddr())
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cfg.Insecure {
+		return conn, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if c.cfg.TLSConfig != nil {
+		tlsConfig = c.cfg.TLSConfig.Clone()
+	}
+
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName, _, _ = net.SplitHostPort(c.cfg.ProxyAddr())
+	}
+
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
+
+// writeRequest function writes the channel HTTP request headers and the
+// initial body.
+func (c *Conn) writeRequest(conn net.Conn, method, uri string, contentLength int, body []byte) error {
+
+	host, _, _ := net.SplitHostPort(c.cfg.ProxyAddr())
+
+	hdr := http.Header{}
+	hdr.Set("Accept", "application/rpc")
+	hdr.Set("User-Agent", "MSRPC")
+	hdr.Set("Host", host)
+	hdr.Set("Content-Length", strconv.Itoa(contentLength))
+	hdr.Set("Connection", "Keep-Alive")
+	hdr.Set("Cache-Control", "no-cache")
+	hdr.Set("Pragma", "no-cache")
+
+	if c.cfg.Username != "" {
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(c.cfg.Username, c.cfg.Password)
+		hdr.Set("Authorization", req.Header.Get("Authorization"))
+	}
+
+	for k, v := range c.cfg.Header {
+		hdr[k] = v
+	}
+
+	var b strings.Builder
+
+	b.WriteString(method + " " + uri + " HTTP/1.1\r\n")
+	hdr.Write(&b)
+	b.WriteString("\r\n")
+
+	if _, err := conn.Write(append([]byte(b.String()), body...)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// handshake function reads the OUT channel response and waits for the
+// CONN/A3 and CONN/C2 RTS PDUs.
+func (c *Conn) handshake(ctx context.Context) error {
+
+	if deadline, ok := ctx.Deadline(); ok {
+		c.out.SetReadDeadline(deadline)
+		defer c.out.SetReadDeadline(time.Time{})
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(c.out), nil)
+	if err != nil {
+		return fmt.Errorf("rpch: out channel: read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return fmt.Errorf("rpch: out channel: %w: %s", ErrProxyRejected, resp.Status)
+	}
+
+	c.outR = resp.Body
+
+	// CONN/A3.
+	if _, err := c.readRTS(); err != nil {
+		return fmt.Errorf("rpch: conn/a3: %w", err)
+	}
+
+	// CONN/C2.
+	c2, err := c.readRTS()
+	if err != nil {
+		return fmt.Errorf("rpch: conn/c2: %w", err)
+	}
+
+	if cmd, ok := c2.Command(CommandReceiveWindowSize); ok {
+		c.inWindow = cmd.Value
+	}
+
+	return nil
+}
+
+// readPDU function reads the single PDU from the OUT channel.
+func (c *Conn) readPDU() ([]byte, error) {
+
+	hdr := make([]byte, 16)
+	if _, err := io.ReadFull(c.outR, hdr); err != nil {
+		return nil, err
+	}
+
+	fragLen := int(binary.LittleEndian.Uint16(hdr[8:]))
+	if hdr[4]&0xF0 == 0 {
+		fragLen = int(binary.BigEndian.Uint16(hdr[8:]))
+	}
+
+	if fragLen < len(hdr) {
+		return nil, fmt.Errorf("invalid fragment length %d", fragLen)
+	}
+
+	b := make([]byte, fragLen)
+	copy(b, hdr)
+
+	if _, err := io.ReadFull(c.outR, b[len(hdr):]); err != nil {
+		return nil, err
+	}
+
+	c.received += uint32(fragLen)
+
+	return b, nil
+}
+
+// readRTS function reads the RTS PDU from the OUT channel.
+func (c *Conn) readRTS() (*RTS, error) {
+
+	b, err := c.readPDU()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseRTS(b)
+}
+
+// handleRTS function processes the RTS PDU received on the OUT channel.
+func (c *Conn) handleRTS(rts *RTS) {
+
+	cmd, ok := rts.Command(CommandFlowControlAck)
+	if !ok {
+		// ping and other notifications are ignored.
+		return
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	c.inAcked, c.inWindow = cmd.Ack.BytesReceived, cmd.Ack.AvailableWindow
+	c.cond.Broadcast()
+}
+
+// ack function sends the flow control acknowledgement for the OUT channel
+// if half of the receive window is consumed.
+func (c *Conn) ack() error {
+
+	if c.received-c.acked < c.cfg.ReceiveWindowSize/2 {
+		return nil
+	}
+
+	c.acked = c.received
+
+	ack := (&RTS{Flags: FlagOtherCmd, Commands: []*Command{
+		{Type: CommandDestination, Value: DestinationOutProxy},
+		{Type: CommandFlowControlAck, Ack: FlowControlAck{
+			BytesReceived:   c.received,
+			AvailableWindow: c.cfg.ReceiveWindowSize,
+			ChannelCookie:   c.outCookie,
+		}},
+	}}).Bytes()
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	_, err := c.in.Write(ack)
+	return err
+}
+
+// Read function reads the RPC PDU data received on the OUT channel.
+func (c *Conn) Read(b []byte) (int, error) {
+
+	for len(c.rbuf) == 0 {
+
+		pdu, err := c.readPDU()
+		if err != nil {
+			return 0, err
+		}
+
+		if pdu[2] == PacketTypeRTS {
+			rts, err := ParseRTS(pdu)
+			if err != nil {
+				return 0, err
+			}
+			c.handleRTS(rts)
+		} else {
+			c.rbuf = pdu
+		}
+
+		if err := c.ack(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, c.rbuf)
+	c.rbuf = c.rbuf[n:]
+
+	return n, nil
+}
+
+// Write function writes the RPC PDU data to the IN channel. The write
+// blocks until the IN channel proxy window has enough space.
+func (c *Conn) Write(b []byte) (int, error) {
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	for !c.closed && c.inWindow > 0 && c.sent+uint32(len(b))-c.inAcked > c.inWindow {
+		c.cond.Wait()
+	}
+
+	if c.closed {
+		return 0, ErrClosed
+	}
+
+	n, err := c.in.Write(b)
+	c.sent += uint32(n)
+
+	return n, err
+}
+
+// Close function closes both channels.
+func (c *Conn) Close() error {
+
+	c.wmu.Lock()
+	c.closed = true
+	c.cond.Broadcast()
+	c.wmu.Unlock()
+
+	var err error
+
+	for _, conn := range []net.Conn{c.in, c.out} {
+		if conn != nil {
+			if cerr := conn.Close(); err == nil {
+				err = cerr
+			}
+		}
+	}
+
+	return err
+}
+
+// DialDirect function establishes the RPC over HTTP v1 connection to the
+// RPC server HTTP endpoint (no RPC proxy) at address `addr`.
+func DialDirect(ctx context.Context, dial func(context.Context, string, string) (net.Conn, error), addr string) (net.Conn, error) {
+
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+
+	conn, err := dial(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("rpch: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetReadDeadline(deadline)
+		defer conn.SetReadDeadline(time.Time{})
+	}
+
+	greeting := make([]byte, len(legacyGreeting))
+	if _, err := io.ReadFull(conn, greeting); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("rpch: read greeting: %w", err)
+	}
+
+	if string(greeting) != legacyGreeting {
+		conn.Close()
+		return nil, fmt.Errorf("rpch: unexpected greeting %q", greeting)
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,140 @@
+package rpch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// readRTSFrom function reads the RTS PDU from the reader.
+func readRTSFrom(r io.Reader) (*RTS, error) {
+	hdr := make([]byte, 16)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	b := make([]byte, binary.LittleEndian.Uint16(hdr[8:]))
+	copy(b, hdr)
+	if _, err := io.ReadFull(r, b[16:]); err != nil {
+		return nil, err
+	}
+	return ParseRTS(b)
+}
+
+func TestDial(t *testing.T) {
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	pdu := []byte{5, 0, 0, 3, 0x10, 0, 0, 0, 24, 0, 0, 0, 1, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8}
+
+	go func() {
+
+		var out, in net.Conn
+		var outR, inR *bufio.Reader
+
+		for i := 0; i < 2; i++ {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			r := bufio.NewReader(conn)
+			req, err := http.ReadRequest(r)
+			if err != nil {
+				return
+			}
+			if req.URL.RawQuery != "srv:6001" {
+				conn.Close()
+				return
+			}
+			switch req.Method {
+			case "RPC_OUT_DATA":
+				out, outR = conn, r
+			case "RPC_IN_DATA":
+				in, inR = conn, r
+			}
+		}
+
+		if out == nil || in == nil {
+			return
+		}
+
+		// CONN/A1, CONN/B1.
+		if _, err := readRTSFrom(outR); err != nil {
+			return
+		}
+		if _, err := readRTSFrom(inR); err != nil {
+			return
+		}
+
+		out.Write([]byte("HTTP/1.1 200 Success\r\nContent-Length: 1073741824\r\n\r\n"))
+		out.Write((&RTS{Commands: []*Command{{Type: CommandConnectionTimeout, Value: 120000}}}).Bytes())
+		out.Write((&RTS{Commands: []*Command{
+			{Type: CommandVersion, Value: 1},
+			{Type: CommandReceiveWindowSize, Value: 65536},
+			{Type: CommandConnectionTimeout, Value: 120000},
+		}}).Bytes())
+
+		// echo the request.
+		b := make([]byte, len(pdu))
+		if _, err := io.ReadFull(inR, b); err != nil {
+			return
+		}
+		out.Write((&RTS{Flags: FlagPing}).Bytes())
+		out.Write(b)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := Dial(ctx, &Config{Proxy: l.Addr().String(), Insecure: true, Username: "user", Password: "pass"}, "srv", 6001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write(pdu); err != nil {
+		t.Fatal(err)
+	}
+
+	b := make([]byte, len(pdu))
+	if _, err := io.ReadFull(conn, b); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(b, pdu) {
+		t.Errorf("unexpected echo: %x", b)
+	}
+}
+
+func TestRTS(t *testing.T) {
+
+	a1 := (&RTS{Commands: []*Command{
+		{Type: CommandVersion, Value: 1},
+		{Type: CommandCookie, Cookie: NewCookie()},
+		{Type: CommandCookie, Cookie: NewCookie()},
+		{Type: CommandReceiveWindowSize, Value: 65536},
+	}}).Bytes()
+
+	// CONN/A1 is 76 bytes long. (MS-RPCH 2.2.4.2)
+	if len(a1) != 76 {
+		t.Fatalf("unexpected conn/a1 size: %d", len(a1))
+	}
+
+	rts, err := ParseRTS(a1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cmd, ok := rts.Command(CommandReceiveWindowSize); !ok || cmd.Value != 65536 {
+		t.Errorf("unexpected receive window size: %+v", cmd)
+	}
+}
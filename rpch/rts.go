@@ -0,0 +1,238 @@
+package rpch
+
+// rts.go contains the Request to Send (RTS) PDU definitions. (MS-RPCH 2.2.3)
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// PacketTypeRTS is the RTS PDU packet type.
+const PacketTypeRTS = 20
+
+// The RTS PDU header size (common header + flags + number of commands).
+const rtsHeaderSize = 20
+
+// RTS flags.
+type Flags uint16
+
+const (
+	FlagNone           Flags = 0x0000
+	FlagPing           Flags = 0x0001
+	FlagOtherCmd       Flags = 0x0002
+	FlagRecycleChannel Flags = 0x0004
+	FlagInChannel      Flags = 0x0008
+	FlagOutChannel     Flags = 0x0010
+	FlagEOF            Flags = 0x0020
+	FlagEcho           Flags = 0x0040
+)
+
+// CommandType represents the RTS command type.
+type CommandType uint32
+
+const (
+	CommandReceiveWindowSize     CommandType = 0x00000000
+	CommandFlowControlAck        CommandType = 0x00000001
+	CommandConnectionTimeout     CommandType = 0x00000002
+	CommandCookie                CommandType = 0x00000003
+	CommandChannelLifetime       CommandType = 0x00000004
+	CommandClientKeepalive       CommandType = 0x00000005
+	CommandVersion               CommandType = 0x00000006
+	CommandEmpty                 CommandType = 0x00000007
+	CommandPadding               CommandType = 0x00000008
+	CommandNegativeANCE          CommandType = 0x00000009
+	CommandANCE                  CommandType = 0x0000000A
+	CommandClientAddress         CommandType = 0x0000000B
+	CommandAssociationGroupID    CommandType = 0x0000000C
+	CommandDestination           CommandType = 0x0000000D
+	CommandPingTrafficSentNotify CommandType = 0x0000000E
+)
+
+// The forward destinations. (MS-RPCH 2.2.3.3)
+const (
+	DestinationClient   = 0x00000000
+	DestinationInProxy  = 0x00000001
+	DestinationServer   = 0x00000002
+	DestinationOutProxy = 0x00000003
+)
+
+// Cookie represents the RTS cookie (the opaque 16-byte identifier).
+type Cookie [16]byte
+
+// NewCookie function returns the new random cookie.
+func NewCookie() Cookie {
+	var c Cookie
+	rand.Read(c[:])
+	return c
+}
+
+func (c Cookie) String() string {
+	return hex.EncodeToString(c[:])
+}
+
+// FlowControlAck represents the flow control acknowledgement.
+type FlowControlAck struct {
+	// The number of bytes received.
+	BytesReceived uint32
+	// The available receive window.
+	AvailableWindow uint32
+	// The channel cookie.
+	ChannelCookie Cookie
+}
+
+// Command represents the single RTS command.
+type Command struct {
+	// The command type.
+	Type CommandType
+	// The value for ReceiveWindowSize, ConnectionTimeout,
+	// ChannelLifetime, ClientKeepalive, Version, Destination
+	// and PingTrafficSentNotify commands.
+	Value uint32
+	// The value for Cookie and AssociationGroupID commands.
+	Cookie Cookie
+	// The value for FlowControlAck command.
+	Ack FlowControlAck
+	// The raw value for Padding and ClientAddress commands.
+	Data []byte
+}
+
+// RTS represents the RTS PDU.
+type RTS struct {
+	// The RTS flags.
+	Flags Flags
+	// The commands.
+	Commands []*Command
+}
+
+// Command function returns the first command of type `typ`.
+func (p *RTS) Command(typ CommandType) (*Command, bool) {
+	for _, cmd := range p.Commands {
+		if cmd.Type == typ {
+			return cmd, true
+		}
+	}
+	return nil, false
+}
+
+// Bytes function returns the encoded RTS PDU.
+func (p *RTS) Bytes() []byte {
+
+	b := make([]byte, rtsHeaderSize, 128)
+
+	// common header: version 5.0, rts, first_frag|last_frag, little-endian.
+	b[0], b[1], b[2], b[3], b[4] = 5, 0, PacketTypeRTS, 0x03, 0x10
+	binary.LittleEndian.PutUint16(b[16:], uint16(p.Flags))
+	binary.LittleEndian.PutUint16(b[18:], uint16(len(p.Commands)))
+
+	for _, cmd := range p.Commands {
+		b = binary.LittleEndian.AppendUint32(b, uint32(cmd.Type))
+		switch cmd.Type {
+		case CommandFlowControlAck:
+			b = binary.LittleEndian.AppendUint32(b, cmd.Ack.BytesReceived)
+			b = binary.LittleEndian.AppendUint32(b, cmd.Ack.AvailableWindow)
+			b = append(b, cmd.Ack.ChannelCookie[:]...)
+		case CommandCookie, CommandAssociationGroupID:
+			b = append(b, cmd.Cookie[:]...)
+		case CommandPadding:
+			b = binary.LittleEndian.AppendUint32(b, uint32(len(cmd.Data)))
+			b = append(b, cmd.Data...)
+		case CommandClientAddress:
+			b = append(b, cmd.Data...)
+		case CommandEmpty, CommandANCE, CommandNegativeANCE:
+		default:
+			b = binary.LittleEndian.AppendUint32(b, cmd.Value)
+		}
+	}
+
+	binary.LittleEndian.PutUint16(b[8:], uint16(len(b)))
+
+	return b
+}
+
+// ParseRTS function parses the RTS PDU.
+func ParseRTS(b []byte) (*RTS, error) {
+
+	if len(b) < rtsHeaderSize || b[2] != PacketTypeRTS {
+		return nil, fmt.Errorf("parse rts: invalid rts pdu")
+	}
+
+	if b[4]&0xF0 != 0x10 {
+		return nil, fmt.Errorf("parse rts: big-endian rts pdu is not supported")
+	}
+
+	p := &RTS{
+		Flags:    Flags(binary.LittleEndian.Uint16(b[16:])),
+		Commands: make([]*Command, binary.LittleEndian.Uint16(b[18:])),
+	}
+
+	off := rtsHeaderSize
+
+	need := func(n int) error {
+		if off+n > len(b) {
+			return fmt.Errorf("parse rts: unexpected end of pdu")
+		}
+		return nil
+	}
+
+	for i := range p.Commands {
+
+		if err := need(4); err != nil {
+			return nil, err
+		}
+
+		cmd := &Command{Type: CommandType(binary.LittleEndian.Uint32(b[off:]))}
+		off += 4
+
+		switch cmd.Type {
+		case CommandFlowControlAck:
+			if err := need(24); err != nil {
+				return nil, err
+			}
+			cmd.Ack.BytesReceived = binary.LittleEndian.Uint32(b[off:])
+			cmd.Ack.AvailableWindow = binary.LittleEndian.Uint32(b[off+4:])
+			copy(cmd.Ack.ChannelCookie[:], b[off+8:])
+			off += 24
+		case CommandCookie, CommandAssociationGroupID:
+			if err := need(16); err != nil {
+				return nil, err
+			}
+			copy(cmd.Cookie[:], b[off:])
+			off += 16
+		case CommandPadding:
+			if err := need(4); err != nil {
+				return nil, err
+			}
+			n := int(binary.LittleEndian.Uint32(b[off:]))
+			if off += 4; need(n) != nil {
+				return nil, need(n)
+			}
+			cmd.Data, off = b[off:off+n], off+n
+		case CommandClientAddress:
+			if err := need(4); err != nil {
+				return nil, err
+			}
+			// address type + address (ipv4 or ipv6) + 12 bytes of padding.
+			n := 4 + 4 + 12
+			if binary.LittleEndian.Uint32(b[off:]) == 1 {
+				n = 4 + 16 + 12
+			}
+			if err := need(n); err != nil {
+				return nil, err
+			}
+			cmd.Data, off = b[off:off+n], off+n
+		case CommandEmpty, CommandANCE, CommandNegativeANCE:
+		default:
+			if err := need(4); err != nil {
+				return nil, err
+			}
+			cmd.Value = binary.LittleEndian.Uint32(b[off:])
+			off += 4
+		}
+
+		p.Commands[i] = cmd
+	}
+
+	return p, nil
+}
@@ -0,0 +1,118 @@
+package smb2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/oiweiwei/go-smb2.fork"
+)
+
+// Session is the authenticated SMB2/3 session with the set of mounted
+// shares (trees), that can carry multiple named pipes.
+type Session struct {
+	mu sync.Mutex
+	// The SMB2/3 session.
+	session *smb2.Session
+	// The mounted shares.
+	shares map[string]*smb2.Share
+	// The flag indicates whether the session was established
+	// by the external code (and must not be logged off).
+	external bool
+}
+
+// NewSession function wraps the already established session or mounted
+// share (tree) for the use by named pipe transport. The `s` parameter must
+// be *smb2.Session or *smb2.Share from github.com/oiweiwei/go-smb2.fork
+// package.
+//
+// Note, that if the share is provided, only the named pipes of this share
+// can be opened.
+func NewSession(s any) (*Session, error) {
+
+	switch s := s.(type) {
+	case *Session:
+		return s, nil
+	case *smb2.Session:
+		return &Session{session: s, shares: make(map[string]*smb2.Share), external: true}, nil
+	case *smb2.Share:
+		return &Session{shares: map[string]*smb2.Share{"": s}, external: true}, nil
+	default:
+		return nil, fmt.Errorf("unknown session type: %T", s)
+	}
+}
+
+// DialSession function establishes the new SMB2/3 session over the
+// network connection `conn`.
+func DialSession(ctx context.Context, dialer *smb2.Dialer, conn net.Conn) (*Session, error) {
+
+	session, err := dialer.DialContext(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{session: session, shares: make(map[string]*smb2.Share)}, nil
+}
+
+// Mount function returns the mounted share. The share is mounted once
+// and reused for the subsequent calls.
+func (s *Session) Mount(ctx context.Context, name string) (*smb2.Share, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if share, ok := s.shares[""]; ok && s.session == nil {
+		// the session is the single tree.
+		return share, nil
+	}
+
+	key := strings.ToUpper(name)
+
+	if share, ok := s.shares[key]; ok {
+		return share, nil
+	}
+
+	share, err := s.session.WithContext(ctx).Mount(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.shares[key] = share
+
+	return share, nil
+}
+
+// Forget function removes the share from the set of mounted shares,
+// so that the next Mount call will mount it again.
+func (s *Session) Forget(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.shares, strings.ToUpper(name))
+}
+
+// IsExternal function returns `true` if session was established by
+// the external code.
+func (s *Session) IsExternal() bool {
+	return s.external
+}
+
+// Logoff function unmounts the shares and logs off the session, if the
+// session is external, Logoff does nothing.
+func (s *Session) Logoff() error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.external || s.session == nil {
+		return nil
+	}
+
+	for key, share := range s.shares {
+		share.Umount()
+		delete(s.shares, key)
+	}
+
+	return s.session.Logoff()
+}
@@ -82,6 +82,10 @@ type NamedPipe struct {
 	NetworkDialFunc func(ctx context.Context, network, address string) (net.Conn, error)
 	ShareName       string
 	Name            string
+	// The SMB2/3 session to open the named pipe with. If not set, the new
+	// session is established during Connect and stored in this field, so
+	// that it can be reused by other named pipes.
+	Session *Session
 }
 
 const ErrNotActive = "An instance of a named pipe cannot be found in the listening state"
@@ -95,19 +99,21 @@ func (pipe *NamedPipe) dial(ctx context.Context, addr string) (net.Conn, error)
 
 func (pipe *NamedPipe) Connect(ctx context.Context) error {
 
-	addr := net.JoinHostPort(pipe.Address, strconv.Itoa(pipe.Port))
+	if pipe.Session == nil {
 
-	conn, err := pipe.dial(ctx, addr)
-	if err != nil {
-		return fmt.Errorf("dial smb server: %s: %w", addr, err)
-	}
+		addr := net.JoinHostPort(pipe.Address, strconv.Itoa(pipe.Port))
 
-	session, err := pipe.Dialer.DialContext(ctx, conn)
-	if err != nil {
-		return fmt.Errorf("open smb session: %w", err)
+		conn, err := pipe.dial(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("dial smb server: %s: %w", addr, err)
+		}
+
+		if pipe.Session, err = DialSession(ctx, pipe.Dialer, conn); err != nil {
+			return fmt.Errorf("open smb session: %w", err)
+		}
 	}
 
-	share, err := session.Mount(pipe.ShareName)
+	share, err := pipe.Session.Mount(ctx, pipe.ShareName)
 	if err != nil {
 		return fmt.Errorf("mount share: %w", err)
 	}
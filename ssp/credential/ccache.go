@@ -1,7 +1,11 @@
 package credential
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/messages"
 )
 
 type CCache interface {
@@ -50,3 +54,99 @@ func NewFromCCache(un string, ccache *credentials.CCache, opts ...Option) CCache
 		ccache:   ccache,
 	}
 }
+
+// LoadTicketFile function loads the ticket file (ccache file or KRB-CRED
+// (.kirbi) file) and returns the ccache credential (see NewFromTicket).
+func LoadTicketFile(un string, ticketFile string, opts ...Option) (CCache, error) {
+	b, err := os.ReadFile(ticketFile)
+	if err != nil {
+		return nil, fmt.Errorf("load ticket %s: %w", ticketFile, err)
+	}
+	return NewFromTicket(un, b, opts...)
+}
+
+// NewFromTicket function returns the ccache credential from the ticket blob,
+// that is the ccache file contents, or KRB-CRED (.kirbi) message with the
+// unencrypted credentials part. If the user name is empty, the principal of
+// the ticket is used.
+func NewFromTicket(un string, b []byte, opts ...Option) (CCache, error) {
+
+	var (
+		ccache *credentials.CCache
+		err    error
+	)
+
+	switch {
+	case len(b) > 0 && b[0] == 0x05:
+		ccache = new(credentials.CCache)
+		if err = ccache.Unmarshal(b); err != nil {
+			return nil, fmt.Errorf("ticket: unmarshal ccache: %w", err)
+		}
+	case len(b) > 0 && b[0] == 0x76: // [APPLICATION 22] KRB-CRED.
+		if ccache, err = krbCredToCCache(b); err != nil {
+			return nil, fmt.Errorf("ticket: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("ticket: unknown ticket format")
+	}
+
+	if un == "" {
+		un = ccache.DefaultPrincipal.PrincipalName.PrincipalNameString() + "@" + ccache.DefaultPrincipal.Realm
+	}
+
+	return NewFromCCache(un, ccache, opts...), nil
+}
+
+// krbCredToCCache function converts the KRB-CRED message into the ccache.
+func krbCredToCCache(b []byte) (*credentials.CCache, error) {
+
+	krbCred := new(messages.KRBCred)
+	if err := krbCred.Unmarshal(b); err != nil {
+		return nil, fmt.Errorf("unmarshal krb_cred: %w", err)
+	}
+
+	if krbCred.EncPart.EType != 0 {
+		return nil, fmt.Errorf("krb_cred: encrypted credentials part is not supported")
+	}
+
+	var part messages.EncKrbCredPart
+	if err := part.Unmarshal(krbCred.EncPart.Cipher); err != nil {
+		return nil, fmt.Errorf("unmarshal krb_cred enc_part: %w", err)
+	}
+
+	if len(part.TicketInfo) == 0 || len(part.TicketInfo) != len(krbCred.Tickets) {
+		return nil, fmt.Errorf("krb_cred: invalid ticket info")
+	}
+
+	ccache := &credentials.CCache{Version: 4}
+
+	for i, info := range part.TicketInfo {
+
+		tkt, err := krbCred.Tickets[i].Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("marshal ticket: %w", err)
+		}
+
+		cred := &credentials.Credential{
+			Key:         info.Key,
+			AuthTime:    info.AuthTime,
+			StartTime:   info.StartTime,
+			EndTime:     info.EndTime,
+			RenewTill:   info.RenewTill,
+			TicketFlags: info.Flags,
+			Addresses:   info.CAddr,
+			Ticket:      tkt,
+		}
+
+		cred.Client.Realm, cred.Client.PrincipalName = info.PRealm, info.PName
+		cred.Server.Realm, cred.Server.PrincipalName = info.SRealm, info.SName
+
+		if i == 0 {
+			ccache.DefaultPrincipal.Realm, ccache.DefaultPrincipal.PrincipalName = info.PRealm, info.PName
+		}
+
+		ccache.Credentials = append(ccache.Credentials, cred)
+	}
+
+	return ccache, nil
+}
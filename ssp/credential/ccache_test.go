@@ -0,0 +1,87 @@
+package credential
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jcmturner/gofork/encoding/asn1"
+	"github.com/jcmturner/gokrb5/v8/asn1tools"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+)
+
+// testKRBCred function returns the KRB-CRED message with the unencrypted
+// credentials part.
+func testKRBCred(t *testing.T) []byte {
+
+	tkts, err := messages.MarshalTicketSequence([]messages.Ticket{{
+		TktVNO:  5,
+		Realm:   "CONTOSO.NET",
+		SName:   types.NewPrincipalName(nametype.KRB_NT_SRV_INST, "krbtgt/CONTOSO.NET"),
+		EncPart: types.EncryptedData{EType: 18, KVNO: 2, Cipher: []byte("ticket")},
+	}})
+	if err != nil {
+		t.Fatalf("marshal tickets: %v", err)
+	}
+
+	part, err := asn1.Marshal(messages.EncKrbCredPart{
+		TicketInfo: []messages.KrbCredInfo{{
+			Key:      types.EncryptionKey{KeyType: 18, KeyValue: make([]byte, 32)},
+			PRealm:   "CONTOSO.NET",
+			PName:    types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, "Administrator"),
+			AuthTime: time.Now().UTC().Truncate(time.Second),
+			EndTime:  time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+			SRealm:   "CONTOSO.NET",
+			SName:    types.NewPrincipalName(nametype.KRB_NT_SRV_INST, "krbtgt/CONTOSO.NET"),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("marshal enc_krb_cred_part: %v", err)
+	}
+
+	part = asn1tools.AddASNAppTag(part, 29)
+
+	b, err := asn1.Marshal(struct {
+		PVNO    int                 `asn1:"explicit,tag:0"`
+		MsgType int                 `asn1:"explicit,tag:1"`
+		Tickets asn1.RawValue       `asn1:"explicit,tag:2"`
+		EncPart types.EncryptedData `asn1:"explicit,tag:3"`
+	}{
+		PVNO:    5,
+		MsgType: 22,
+		Tickets: tkts,
+		EncPart: types.EncryptedData{EType: 0, Cipher: part},
+	})
+	if err != nil {
+		t.Fatalf("marshal krb_cred: %v", err)
+	}
+
+	return asn1tools.AddASNAppTag(b, 22)
+}
+
+func TestNewFromTicket(t *testing.T) {
+
+	cred, err := NewFromTicket("", testKRBCred(t))
+	if err != nil {
+		t.Fatalf("new from ticket: %v", err)
+	}
+
+	if cred.UserName() != "Administrator" || cred.DomainName() != "CONTOSO.NET" {
+		t.Fatalf("new from ticket: unexpected principal %s@%s", cred.UserName(), cred.DomainName())
+	}
+
+	cc := cred.CCache()
+
+	if len(cc.Credentials) != 1 || cc.Credentials[0].Server.PrincipalName.PrincipalNameString() != "krbtgt/CONTOSO.NET" {
+		t.Fatalf("new from ticket: unexpected credentials")
+	}
+
+	if tgt, ok := cc.GetEntry(types.NewPrincipalName(nametype.KRB_NT_SRV_INST, "krbtgt/CONTOSO.NET")); !ok || len(tgt.Ticket) == 0 {
+		t.Fatalf("new from ticket: tgt is not found")
+	}
+
+	if _, err := NewFromTicket("", []byte("invalid")); err == nil {
+		t.Fatalf("new from ticket: invalid ticket accepted")
+	}
+}
@@ -0,0 +1,302 @@
+package credential
+
+import (
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// Certificate credential (Kerberos PKINIT).
+type Certificate interface {
+	// Credential. (UserName / DomainName).
+	Credential
+	// Certificate.
+	Certificate() *x509.Certificate
+	// Private key. (must implement crypto.Signer).
+	PrivateKey() crypto.PrivateKey
+}
+
+// Certificate implementation.
+type certificate struct {
+	userName    string
+	domainName  string
+	workstation string
+	cert        *x509.Certificate
+	key         crypto.PrivateKey
+}
+
+// User name.
+func (c *certificate) UserName() string {
+	if c != nil {
+		return c.userName
+	}
+	return ""
+}
+
+// Domain name.
+func (c *certificate) DomainName() string {
+	if c != nil {
+		return c.domainName
+	}
+	return ""
+}
+
+// Workstation.
+func (c *certificate) Workstation() string {
+	if c != nil {
+		return c.workstation
+	}
+	return ""
+}
+
+// Certificate.
+func (c *certificate) Certificate() *x509.Certificate {
+	if c != nil {
+		return c.cert
+	}
+	return nil
+}
+
+// Private key.
+func (c *certificate) PrivateKey() crypto.PrivateKey {
+	if c != nil {
+		return c.key
+	}
+	return nil
+}
+
+// NewFromCertificate function returns the certificate credential. If the
+// user name is empty, the user principal name (UPN) from the certificate
+// subject alternative name is used.
+func NewFromCertificate(un string, cert *x509.Certificate, key crypto.PrivateKey, opts ...Option) Certificate {
+
+	if un == "" && cert != nil {
+		un = CertificateUPN(cert)
+	}
+
+	dn, un, wkst := parseDomainUserWorkstation(un, opts...)
+
+	return &certificate{
+		userName:    un,
+		domainName:  dn,
+		workstation: wkst,
+		cert:        cert,
+		key:         key,
+	}
+}
+
+// LoadCertificateFile function loads the PEM-encoded certificate and private
+// key files and returns the certificate credential (see NewFromCertificate).
+func LoadCertificateFile(un string, certFile, keyFile string, opts ...Option) (Certificate, error) {
+
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate: %w", err)
+	}
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("load certificate: %w", err)
+	}
+
+	return NewFromCertificate(un, cert, pair.PrivateKey, opts...), nil
+}
+
+// NewFromSigner function returns the certificate credential with the private
+// key backed by the crypto.Signer (ie the PKCS#11 token, smart card or HSM
+// key that never leaves the hardware). The signer public key must match the
+// certificate public key.
+func NewFromSigner(un string, cert *x509.Certificate, signer crypto.Signer, opts ...Option) (Certificate, error) {
+
+	if cert == nil || signer == nil {
+		return nil, fmt.Errorf("new from signer: certificate and signer are required")
+	}
+
+	if !matchKey(cert, signer) {
+		return nil, fmt.Errorf("new from signer: certificate does not match the signer public key")
+	}
+
+	return NewFromCertificate(un, cert, signer, opts...), nil
+}
+
+// LoadCertificateFileWithSigner function loads the PEM-encoded certificate
+// file and returns the certificate credential with the private key backed by
+// the signer (see NewFromSigner).
+func LoadCertificateFileWithSigner(un string, certFile string, signer crypto.Signer, opts ...Option) (Certificate, error) {
+
+	b, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate: %w", err)
+	}
+
+	for block, rest := pem.Decode(b); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("load certificate: %w", err)
+		}
+		// select the certificate that matches the signer (the file
+		// may contain the certificate chain).
+		if matchKey(cert, signer) {
+			return NewFromSigner(un, cert, signer, opts...)
+		}
+	}
+
+	return nil, fmt.Errorf("load certificate: no certificate for the signer")
+}
+
+// TLSCertificate function returns the TLS client certificate for the
+// certificate credential, so that the same (hardware-backed) key can be
+// used for the TLS client authentication (see dcerpc.WithTLS):
+//
+//	cert, err := credential.TLSCertificate(cred)
+//	if err != nil {
+//		// handle error.
+//	}
+//	dcerpc.WithTLS(&tls.Config{Certificates: []tls.Certificate{cert}})
+func TLSCertificate(cred Certificate) (tls.Certificate, error) {
+
+	if cred == nil || cred.Certificate() == nil {
+		return tls.Certificate{}, fmt.Errorf("tls certificate: certificate is required")
+	}
+
+	signer, ok := cred.PrivateKey().(crypto.Signer)
+	if !ok {
+		return tls.Certificate{}, fmt.Errorf("tls certificate: private key must implement crypto.Signer")
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{cred.Certificate().Raw},
+		PrivateKey:  signer,
+		Leaf:        cred.Certificate(),
+	}, nil
+}
+
+// LoadPFXFile function loads the PKCS#12 (PFX) file protected with the
+// `password` and returns the certificate credential for the certificate
+// that matches the private key (see NewFromCertificate).
+func LoadPFXFile(un string, pfxFile, password string, opts ...Option) (Certificate, error) {
+
+	b, err := os.ReadFile(pfxFile)
+	if err != nil {
+		return nil, fmt.Errorf("load pfx: %w", err)
+	}
+
+	blocks, err := pkcs12.ToPEM(b, password)
+	if err != nil {
+		return nil, fmt.Errorf("load pfx: %w", err)
+	}
+
+	var (
+		certs []*x509.Certificate
+		key   crypto.PrivateKey
+	)
+
+	for _, block := range blocks {
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("load pfx: %w", err)
+			}
+			certs = append(certs, cert)
+		case "PRIVATE KEY":
+			if key, err = parsePrivateKey(block); err != nil {
+				return nil, fmt.Errorf("load pfx: %w", err)
+			}
+		}
+	}
+
+	if key == nil {
+		return nil, fmt.Errorf("load pfx: no private key")
+	}
+
+	for _, cert := range certs {
+		if matchKey(cert, key) {
+			return NewFromCertificate(un, cert, key, opts...), nil
+		}
+	}
+
+	return nil, fmt.Errorf("load pfx: no certificate for the private key")
+}
+
+// parsePrivateKey function parses the PKCS#1, PKCS#8 or SEC 1 private key.
+func parsePrivateKey(block *pem.Block) (crypto.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// matchKey function returns `true` if the certificate public key matches
+// the private key.
+func matchKey(cert *x509.Certificate, key crypto.PrivateKey) bool {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return false
+	}
+	pub, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	return ok && pub.Equal(cert.PublicKey)
+}
+
+var (
+	// The subject alternative name extension.
+	oidExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+	// The Microsoft user principal name (szOID_NT_PRINCIPAL_NAME).
+	oidUserPrincipalName = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+)
+
+// otherName structure represents the subject alternative name otherName.
+type otherName struct {
+	TypeID asn1.ObjectIdentifier
+	Value  asn1.RawValue `asn1:"tag:0,explicit"`
+}
+
+// CertificateUPN function returns the user principal name (UPN) from the
+// certificate subject alternative name, or an empty string.
+func CertificateUPN(cert *x509.Certificate) string {
+
+	for _, ext := range cert.Extensions {
+
+		if !ext.Id.Equal(oidExtensionSubjectAltName) {
+			continue
+		}
+
+		var names []asn1.RawValue
+		if _, err := asn1.Unmarshal(ext.Value, &names); err != nil {
+			return ""
+		}
+
+		for _, name := range names {
+
+			// otherName [0] IMPLICIT.
+			if name.Class != asn1.ClassContextSpecific || name.Tag != 0 {
+				continue
+			}
+
+			var on otherName
+			if _, err := asn1.UnmarshalWithParams(name.FullBytes, &on, "tag:0"); err != nil || !on.TypeID.Equal(oidUserPrincipalName) {
+				continue
+			}
+
+			var upn string
+			if _, err := asn1.UnmarshalWithParams(on.Value.Bytes, &upn, "utf8"); err == nil && strings.Contains(upn, "@") {
+				return upn
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,115 @@
+package credential
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testSigner hides the concrete private key type (like the hardware token
+// key).
+type testSigner struct {
+	key crypto.Signer
+}
+
+func (s *testSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s *testSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, digest, opts)
+}
+
+func testCertificate(t *testing.T) (*x509.Certificate, crypto.Signer) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Administrator"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(b)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+
+	return cert, &testSigner{key: key}
+}
+
+func TestNewFromSigner(t *testing.T) {
+
+	cert, signer := testCertificate(t)
+
+	cred, err := NewFromSigner("CONTOSO\\Administrator", cert, signer)
+	if err != nil {
+		t.Fatalf("new from signer: %v", err)
+	}
+
+	if cred.PrivateKey() != signer || cred.UserName() != "Administrator" || cred.DomainName() != "CONTOSO" {
+		t.Fatalf("unexpected credential: %+v", cred)
+	}
+
+	tlsCert, err := TLSCertificate(cred)
+	if err != nil {
+		t.Fatalf("tls certificate: %v", err)
+	}
+
+	if tlsCert.PrivateKey != signer || tlsCert.Leaf != cert {
+		t.Fatalf("unexpected tls certificate: %+v", tlsCert)
+	}
+
+	_, other := testCertificate(t)
+
+	if _, err := NewFromSigner("", cert, other); err == nil {
+		t.Fatalf("new from signer: expected mismatch error")
+	}
+
+	if _, err := TLSCertificate(NewFromCertificate("", cert, "key")); err == nil {
+		t.Fatalf("tls certificate: expected signer error")
+	}
+}
+
+func TestLoadCertificateFileWithSigner(t *testing.T) {
+
+	cert, signer := testCertificate(t)
+	ca, _ := testCertificate(t)
+
+	certFile := filepath.Join(t.TempDir(), "cert.pem")
+
+	b := append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+
+	if err := os.WriteFile(certFile, b, 0600); err != nil {
+		t.Fatalf("write certificate: %v", err)
+	}
+
+	cred, err := LoadCertificateFileWithSigner("Administrator@CONTOSO.NET", certFile, signer)
+	if err != nil {
+		t.Fatalf("load certificate: %v", err)
+	}
+
+	if !cred.Certificate().Equal(cert) {
+		t.Fatalf("unexpected certificate")
+	}
+}
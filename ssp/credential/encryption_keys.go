@@ -0,0 +1,186 @@
+package credential
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// The AES128-CTS-HMAC-SHA1-96 encryption type.
+	ETypeAES128 = 17
+	// The AES256-CTS-HMAC-SHA1-96 encryption type.
+	ETypeAES256 = 18
+	// The RC4-HMAC encryption type (the key is the NT hash).
+	ETypeRC4 = 23
+)
+
+// EncryptionKey structure represents the Kerberos long-term key.
+type EncryptionKey struct {
+	// The encryption type.
+	EType int32
+	// The key value.
+	Key []byte
+}
+
+// EncryptionKeys is the set of the Kerberos long-term keys (AES keys,
+// or RC4-HMAC key that is the NT hash). The Kerberos uses the keys of
+// all encryption types, the NTLM uses the RC4-HMAC key as the NT hash.
+type EncryptionKeys interface {
+	// Credential. (UserName / DomainName).
+	Credential
+	// Encryption keys.
+	EncryptionKeys() []EncryptionKey
+	// KVNO. (optional for KRB5).
+	KVNO() int
+}
+
+type encryptionKeys struct {
+	userName    string
+	domainName  string
+	keys        []EncryptionKey
+	kvno        int
+	workstation string
+	// The keys kept in the locked memory (see Secure).
+	secure []*SecureBuffer
+	// The flag that indicates whether the keys were destroyed.
+	destroyed bool
+}
+
+// User name.
+func (k *encryptionKeys) UserName() string {
+	if k != nil {
+		return k.userName
+	}
+	return ""
+}
+
+// Domain name.
+func (k *encryptionKeys) DomainName() string {
+	if k != nil {
+		return k.domainName
+	}
+	return ""
+}
+
+// Workstation.
+func (k *encryptionKeys) Workstation() string {
+	if k != nil {
+		return k.workstation
+	}
+	return ""
+}
+
+// Encryption keys.
+func (k *encryptionKeys) EncryptionKeys() []EncryptionKey {
+	if k != nil {
+		keys := make([]EncryptionKey, len(k.keys))
+		copy(keys, k.keys)
+		return keys
+	}
+	return nil
+}
+
+// Destroy function zeroizes the encryption keys.
+func (k *encryptionKeys) Destroy() {
+	if k != nil {
+		for i := range k.keys {
+			clear(k.keys[i].Key)
+		}
+		for _, s := range k.secure {
+			s.Destroy()
+		}
+		k.keys, k.secure, k.destroyed = nil, nil, true
+	}
+}
+
+// IsDestroyed function returns `true` if the encryption keys were destroyed.
+func (k *encryptionKeys) IsDestroyed() bool {
+	return k != nil && k.destroyed
+}
+
+// KVNO.
+func (k *encryptionKeys) KVNO() int {
+	if k != nil {
+		return k.kvno
+	}
+	return 0
+}
+
+// NewFromEncryptionKeys function returns the encryption keys credential.
+func NewFromEncryptionKeys(un string, keys []EncryptionKey, opts ...Option) EncryptionKeys {
+
+	dn, un, wkst := parseDomainUserWorkstation(un, opts...)
+	kvno := 1 // default is 1.
+
+	for _, opt := range opts {
+		switch v := opt.(type) {
+		case kvnoOpt:
+			kvno = int(v)
+		}
+	}
+
+	k := &encryptionKeys{
+		domainName:  dn,
+		userName:    un,
+		keys:        append([]EncryptionKey{}, keys...),
+		workstation: wkst,
+		kvno:        kvno,
+	}
+	if isSecure(opts...) {
+		for i := range k.keys {
+			s := NewSecureBuffer(k.keys[i].Key)
+			k.keys[i].Key, k.secure = s.Bytes(), append(k.secure, s)
+		}
+	}
+	return k
+}
+
+// NewFromAESKey function returns the encryption keys credential using the AES
+// key string (hex-encoded AES128 or AES256 key, the encryption type is selected
+// by the key length).
+func NewFromAESKey(un, key string, opts ...Option) (EncryptionKeys, error) {
+
+	k, err := ParseAESKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFromEncryptionKeys(un, []EncryptionKey{k}, opts...), nil
+}
+
+// ParseAESKey function parses the hex-encoded AES128 or AES256 key.
+func ParseAESKey(key string) (EncryptionKey, error) {
+
+	b, err := hex.DecodeString(key)
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("aes key: %w", err)
+	}
+
+	switch len(b) {
+	case 16:
+		return EncryptionKey{EType: ETypeAES128, Key: b}, nil
+	case 32:
+		return EncryptionKey{EType: ETypeAES256, Key: b}, nil
+	}
+
+	return EncryptionKey{}, fmt.Errorf("aes key: invalid key length %d", len(b))
+}
+
+// NTHashFromKeys function returns the NT hash credential for the encryption keys
+// credential that contains the RC4-HMAC key.
+func NTHashFromKeys(cred EncryptionKeys) (NTHash, bool) {
+
+	for _, k := range cred.EncryptionKeys() {
+		if k.EType == ETypeRC4 && len(k.Key) > 0 {
+			return &ntHash{
+				userName:    cred.UserName(),
+				domainName:  cred.DomainName(),
+				ntHash:      k.Key,
+				workstation: cred.Workstation(),
+				kvno:        cred.KVNO(),
+			}, true
+		}
+	}
+
+	return nil, false
+}
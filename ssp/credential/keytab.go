@@ -1,17 +1,25 @@
 package credential
 
-import "github.com/jcmturner/gokrb5/v8/keytab"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jcmturner/gokrb5/v8/keytab"
+)
 
 type Keytab interface {
 	Credential
 	// Keytab.
 	Keytab() *keytab.Keytab
+	// KVNO. (the key version number, 0 selects the highest version).
+	KVNO() int
 }
 
 type kt struct {
 	userName string
 	realm    string
 	kt       *keytab.Keytab
+	kvno     int
 	err      error
 }
 
@@ -41,18 +49,76 @@ func (kt *kt) Keytab() *keytab.Keytab {
 	return nil
 }
 
-// NewFromKeytabFile ...
+// KVNO.
+func (kt *kt) KVNO() int {
+	if kt != nil {
+		return kt.kvno
+	}
+	return 0
+}
+
+// Err function returns the keytab loading error.
+func (kt *kt) Err() error {
+	if kt != nil {
+		return kt.err
+	}
+	return nil
+}
+
+// LoadKeytabFile function loads the keytab file (MIT keytab format) and
+// returns the keytab credential (see NewFromKeytab).
+func LoadKeytabFile(un string, keytabFile string, opts ...Option) (Keytab, error) {
+	kt, err := keytab.Load(keytabFile)
+	if err != nil {
+		return nil, fmt.Errorf("load keytab %s: %w", keytabFile, err)
+	}
+	return NewFromKeytab(un, kt, opts...), nil
+}
+
+// NewFromKeytabFile function loads the keytab file and returns the keytab
+// credential (see NewFromKeytab). The loading error is reported when the
+// credential is used.
 func NewFromKeytabFile(un string, keytabFile string, opts ...Option) Keytab {
-	kt, _ := keytab.Load(keytabFile)
-	return NewFromKeytab(un, kt)
+	cred, err := LoadKeytabFile(un, keytabFile, opts...)
+	if err != nil {
+		realm, un, _ := parseDomainUserWorkstation(un, opts...)
+		return &kt{userName: un, realm: realm, err: err}
+	}
+	return cred
 }
 
-// NewFromKeytab ...
+// NewFromKeytab function returns the keytab credential. The keytab can contain
+// the keys of multiple encryption types and versions, the KVNO option selects
+// the key version (by default, the highest version is used). If the user name
+// is empty, the principal of the first keytab entry is used (if the realm is
+// empty, the realm of the principal entry is used).
 func NewFromKeytab(un string, keytab *keytab.Keytab, opts ...Option) Keytab {
+
 	realm, un, _ := parseDomainUserWorkstation(un, opts...)
+
+	if keytab != nil {
+		for _, e := range keytab.Entries {
+			// the principal (or the realm) is taken from the keytab.
+			if name := strings.Join(e.Principal.Components, "/"); un == "" || (realm == "" && strings.EqualFold(un, name)) {
+				un, realm = name, e.Principal.Realm
+				break
+			}
+		}
+	}
+
+	kvno := 0 // default is the highest version.
+
+	for _, opt := range opts {
+		switch v := opt.(type) {
+		case kvnoOpt:
+			kvno = int(v)
+		}
+	}
+
 	return &kt{
 		userName: un,
 		realm:    realm,
 		kt:       keytab,
+		kvno:     kvno,
 	}
 }
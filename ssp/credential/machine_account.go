@@ -0,0 +1,138 @@
+package credential
+
+// machine_account.go contains the helpers that build the credentials for
+// the computer (machine) account.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jcmturner/gokrb5/v8/crypto"
+
+	ssp_crypto "github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+type saltOpt string
+
+func (saltOpt) is_CredentialOption() {}
+
+// Salt option overrides the Kerberos salt used to derive the AES keys
+// from the password (see MachineAccountSalt).
+func Salt(s string) Option {
+	return saltOpt(s)
+}
+
+// MachineAccountName function returns the machine account name
+// (sAMAccountName) for the host name or FQDN ("ws01.contoso.net" -> "WS01$").
+func MachineAccountName(host string) string {
+	host, _, _ = strings.Cut(strings.TrimSuffix(host, "$"), ".")
+	return strings.ToUpper(host) + "$"
+}
+
+// MachineAccountSalt function returns the Kerberos salt for the machine
+// account ([MS-KILE] 3.1.1.2): the upper-case realm, "host", the lower-case
+// host name (without "$"), ".", and the lower-case DNS domain name:
+//
+//	MachineAccountSalt("CONTOSO.NET", "WS01$") // "CONTOSO.NEThostws01.contoso.net"
+func MachineAccountSalt(domain, host string) string {
+	host = strings.TrimSuffix(MachineAccountName(host), "$")
+	return strings.ToUpper(domain) + "host" + strings.ToLower(host) + "." + strings.ToLower(domain)
+}
+
+// NewFromMachinePassword function returns the encryption keys credential
+// for the machine account with the AES256, AES128 and RC4-HMAC (NT hash) keys
+// derived from the machine password. The user name is the machine account
+// ("CONTOSO.NET\WS01$", "WS01$@CONTOSO.NET") or the host FQDN
+// ("ws01.contoso.net"), the domain name must be the DNS domain name to compute
+// the salt (see MachineAccountSalt and Salt option).
+func NewFromMachinePassword(un string, password string, opts ...Option) (EncryptionKeys, error) {
+
+	pwd, err := utf16le.Encode(password)
+	if err != nil {
+		return nil, fmt.Errorf("machine account: encode password: %w", err)
+	}
+
+	defer clear(pwd)
+
+	return newFromMachinePassword(un, password, pwd, opts...)
+}
+
+// NewFromMachinePasswordUTF16 function returns the encryption keys credential
+// for the machine account password in the raw UTF-16LE form (ie the password
+// stored in the $MACHINE.ACC LSA secret that can contain the invalid surrogate
+// pairs). See NewFromMachinePassword.
+func NewFromMachinePasswordUTF16(un string, password []byte, opts ...Option) (EncryptionKeys, error) {
+
+	// the invalid characters are replaced with U+FFFD for the AES keys
+	// derivation, as windows does.
+	s, err := utf16le.Decode(password)
+	if err != nil {
+		return nil, fmt.Errorf("machine account: decode password: %w", err)
+	}
+
+	return newFromMachinePassword(un, s, password, opts...)
+}
+
+// newFromMachinePassword function derives the machine account keys from the
+// password string `s` (AES keys) and the raw UTF-16LE password `pwd` (NT hash).
+func newFromMachinePassword(un string, s string, pwd []byte, opts ...Option) (EncryptionKeys, error) {
+
+	dn, host := parseMachineAccount(un, opts...)
+	if dn == "" {
+		return nil, fmt.Errorf("machine account: domain name is required")
+	}
+
+	salt := MachineAccountSalt(dn, host)
+	for _, opt := range opts {
+		if v, ok := opt.(saltOpt); ok {
+			salt = string(v)
+		}
+	}
+
+	keys := []EncryptionKey{}
+
+	for _, etype := range []int32{ETypeAES256, ETypeAES128} {
+		et, err := crypto.GetEtype(etype)
+		if err != nil {
+			return nil, fmt.Errorf("machine account: %w", err)
+		}
+		k, err := et.StringToKey(s, salt, et.GetDefaultStringToKeyParams())
+		if err != nil {
+			return nil, fmt.Errorf("machine account: derive key: %w", err)
+		}
+		keys = append(keys, EncryptionKey{EType: etype, Key: k})
+	}
+
+	ntHash, err := ssp_crypto.MD4(pwd)
+	if err != nil {
+		return nil, fmt.Errorf("machine account: derive nt hash: %w", err)
+	}
+
+	keys = append(keys, EncryptionKey{EType: ETypeRC4, Key: ntHash})
+
+	cred := NewFromEncryptionKeys(dn+"\\"+MachineAccountName(host), keys, opts...)
+
+	if isSecure(opts...) {
+		// the secure credential holds the copy.
+		for i := range keys {
+			clear(keys[i].Key)
+		}
+	}
+
+	return cred, nil
+}
+
+// parseMachineAccount function returns the domain name and the host name
+// for the machine account name or host FQDN.
+func parseMachineAccount(un string, opts ...Option) (string, string) {
+
+	if strings.ContainsAny(un, "\\@") {
+		dn, host, _ := parseDomainUserWorkstation(un, opts...)
+		return dn, host
+	}
+
+	// host fqdn.
+	host, dn, _ := strings.Cut(un, ".")
+	return dn, host
+}
@@ -0,0 +1,70 @@
+package credential
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestMachineAccountSalt(t *testing.T) {
+
+	for _, tc := range []struct {
+		domain, host, exp string
+	}{
+		{"contoso.net", "WS01$", "CONTOSO.NEThostws01.contoso.net"},
+		{"CONTOSO.NET", "ws01.contoso.net", "CONTOSO.NEThostws01.contoso.net"},
+		{"CONTOSO.NET", "ws01", "CONTOSO.NEThostws01.contoso.net"},
+	} {
+		if salt := MachineAccountSalt(tc.domain, tc.host); salt != tc.exp {
+			t.Errorf("machine_account_salt(%q, %q): %q != %q", tc.domain, tc.host, salt, tc.exp)
+		}
+	}
+}
+
+func TestNewFromMachinePassword(t *testing.T) {
+
+	cred, err := NewFromMachinePassword("ws01.contoso.net", "password")
+	if err != nil {
+		t.Fatalf("new from machine password: %v", err)
+	}
+
+	if cred.UserName() != "WS01$" || cred.DomainName() != "contoso.net" {
+		t.Fatalf("unexpected machine account: %s\\%s", cred.DomainName(), cred.UserName())
+	}
+
+	keys := cred.EncryptionKeys()
+	if len(keys) != 3 || keys[0].EType != ETypeAES256 || len(keys[0].Key) != 32 || keys[1].EType != ETypeAES128 || len(keys[1].Key) != 16 {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+
+	ntHash, ok := NTHashFromKeys(cred)
+	if !ok || hex.EncodeToString(ntHash.NTHash()) != "8846f7eaee8fb117ad06bdd830b7586c" {
+		t.Fatalf("unexpected nt hash: %x", ntHash.NTHash())
+	}
+
+	// the same keys for the raw utf-16 password.
+	raw, err := NewFromMachinePasswordUTF16("CONTOSO.NET\\WS01$", []byte("p\x00a\x00s\x00s\x00w\x00o\x00r\x00d\x00"))
+	if err != nil {
+		t.Fatalf("new from machine password utf16: %v", err)
+	}
+
+	for i, k := range raw.EncryptionKeys() {
+		if !bytes.Equal(k.Key, keys[i].Key) {
+			t.Fatalf("key %d mismatch: %x != %x", k.EType, k.Key, keys[i].Key)
+		}
+	}
+
+	// the salt override.
+	other, err := NewFromMachinePassword("CONTOSO.NET\\WS01$", "password", Salt("CONTOSO.NEThostws02.contoso.net"))
+	if err != nil {
+		t.Fatalf("new from machine password: %v", err)
+	}
+
+	if bytes.Equal(other.EncryptionKeys()[0].Key, keys[0].Key) {
+		t.Fatalf("salt is not applied")
+	}
+
+	if _, err := NewFromMachinePassword("WS01$", "password"); err == nil {
+		t.Fatalf("expected domain name error")
+	}
+}
@@ -19,6 +19,10 @@ type ntHash struct {
 	ntHash      []byte
 	kvno        int
 	workstation string
+	// The NT hash kept in the locked memory (see Secure).
+	secure *SecureBuffer
+	// The flag that indicates whether the NT hash was destroyed.
+	destroyed bool
 }
 
 // User name.
@@ -45,6 +49,23 @@ func (n *ntHash) NTHash() []byte {
 	return nil
 }
 
+// Destroy function zeroizes the NT hash.
+func (n *ntHash) Destroy() {
+	if n != nil {
+		if n.secure != nil {
+			n.secure.Destroy()
+		} else {
+			clear(n.ntHash)
+		}
+		n.ntHash, n.destroyed = nil, true
+	}
+}
+
+// IsDestroyed function returns `true` if the NT hash was destroyed.
+func (n *ntHash) IsDestroyed() bool {
+	return n != nil && n.destroyed
+}
+
 // Workstation.
 func (p *ntHash) Workstation() string {
 	if p != nil {
@@ -80,11 +101,16 @@ func NewFromNTHashBytes(un string, hash []byte, opts ...Option) NTHash {
 			kvno = int(v)
 		}
 	}
-	return &ntHash{
+	n := &ntHash{
 		domainName:  dn,
 		userName:    un,
 		ntHash:      hash,
 		workstation: wkst,
 		kvno:        kvno,
 	}
+	if isSecure(opts...) {
+		n.secure = NewSecureBuffer(hash)
+		n.ntHash = n.secure.Bytes()
+	}
+	return n
 }
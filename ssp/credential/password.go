@@ -2,6 +2,8 @@ package credential
 
 import (
 	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
 )
 
 // Password credential.
@@ -18,6 +20,10 @@ type password struct {
 	domainName  string
 	password    string
 	workstation string
+	// The password kept in the locked memory (see Secure).
+	secure *SecureBuffer
+	// The flag that indicates whether the password was destroyed.
+	destroyed bool
 }
 
 // User name.
@@ -36,14 +42,57 @@ func (p *password) DomainName() string {
 	return ""
 }
 
-// Password.
+// Password function returns the password string. Note, that for the password
+// kept in the locked memory (see Secure), every call makes a copy of the password
+// that cannot be zeroized, use PasswordBytes or PasswordUTF16 instead.
 func (p *password) Password() string {
 	if p != nil {
+		if p.secure != nil {
+			return string(p.secure.Bytes())
+		}
 		return p.password
 	}
 	return ""
 }
 
+// PasswordBytes function returns the password (UTF-8). For the password kept in
+// the locked memory, the returned slice refers to the secure buffer memory, and
+// must not be modified or retained.
+func (p *password) PasswordBytes() []byte {
+	if p != nil {
+		if p.secure != nil {
+			return p.secure.Bytes()
+		}
+		return []byte(p.password)
+	}
+	return nil
+}
+
+// PasswordUTF16 function returns the UTF-16LE encoded password. The caller
+// should zeroize the returned slice once it is no longer used.
+func (p *password) PasswordUTF16() []byte {
+	return encodeUTF16(p.PasswordBytes())
+}
+
+// Destroy function zeroizes the password kept in the locked memory (the
+// password string is dropped otherwise).
+func (p *password) Destroy() {
+	if p != nil {
+		p.secure.Destroy()
+		p.password, p.destroyed = "", true
+	}
+}
+
+// IsDestroyed function returns `true` if the password was destroyed.
+func (p *password) IsDestroyed() bool {
+	return p != nil && p.destroyed
+}
+
+// IsSecure function returns `true` if the password is kept in the locked memory.
+func (p *password) IsSecure() bool {
+	return p != nil && p.secure != nil
+}
+
 func (p *password) Workstation() string {
 	if p != nil {
 		return p.workstation
@@ -62,6 +111,14 @@ func NewFromString(s string) Password {
 // NewFromPassword function returns the username/password credential.
 func NewFromPassword(un, passwd string, opts ...Option) Password {
 	dn, un, wkst := parseDomainUserWorkstation(un, opts...)
+	if isSecure(opts...) {
+		return &password{
+			domainName:  dn,
+			userName:    un,
+			secure:      NewSecureBuffer([]byte(passwd)),
+			workstation: wkst,
+		}
+	}
 	return &password{
 		domainName:  dn,
 		userName:    un,
@@ -70,6 +127,65 @@ func NewFromPassword(un, passwd string, opts ...Option) Password {
 	}
 }
 
+// NewFromPasswordBytes function returns the username/password credential with
+// the password kept in the locked, zeroizable memory (see Secure). The function
+// takes the ownership of the `passwd` and zeroizes it.
+//
+//	passwd, _ := term.ReadPassword(int(os.Stdin.Fd()))
+//
+//	creds := credential.NewFromPasswordBytes("CONTOSO\\Administrator", passwd)
+//	defer credential.Destroy(creds)
+func NewFromPasswordBytes(un string, passwd []byte, opts ...Option) Password {
+	dn, un, wkst := parseDomainUserWorkstation(un, opts...)
+	defer clear(passwd)
+	return &password{
+		domainName:  dn,
+		userName:    un,
+		secure:      NewSecureBuffer(passwd),
+		workstation: wkst,
+	}
+}
+
+// PasswordBytes function returns the password (UTF-8) without the string copy
+// if the credential supports it (see NewFromPasswordBytes, Secure). The returned
+// slice must not be modified or retained.
+func PasswordBytes(cred Password) []byte {
+	if cred, ok := cred.(interface{ PasswordBytes() []byte }); ok {
+		return cred.PasswordBytes()
+	}
+	return []byte(cred.Password())
+}
+
+// PasswordUTF16 function returns the UTF-16LE encoded password (used for the
+// key derivation). The caller should zeroize the returned slice once it is no
+// longer used.
+func PasswordUTF16(cred Password) []byte {
+	if cred, ok := cred.(interface{ PasswordUTF16() []byte }); ok {
+		return cred.PasswordUTF16()
+	}
+	return encodeUTF16([]byte(cred.Password()))
+}
+
+// encodeUTF16 function encodes the UTF-8 string `b` into the UTF-16LE without
+// the intermediate string copy.
+func encodeUTF16(b []byte) []byte {
+
+	ret := make([]byte, 0, 2*len(b))
+
+	for len(b) > 0 {
+		r, sz := utf8.DecodeRune(b)
+		if r >= 0x10000 {
+			r1, r2 := utf16.EncodeRune(r)
+			ret = append(ret, byte(r1), byte(r1>>8), byte(r2), byte(r2>>8))
+		} else {
+			ret = append(ret, byte(r), byte(r>>8))
+		}
+		b = b[sz:]
+	}
+
+	return ret
+}
+
 // DomainName function returns the domain name from the user name.
 func DomainName(un string) string {
 	dn, _, _ := parseDomainUserWorkstation(un)
@@ -0,0 +1,243 @@
+package credential
+
+// secure.go contains the locked, zeroizable memory for the credential
+// secrets (passwords and keys).
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"unsafe"
+)
+
+var (
+	// The credential secrets were destroyed (see Destroy).
+	ErrDestroyed = errors.New("credential is destroyed")
+)
+
+// SecureBuffer is the buffer for the secret (password or key) that is
+// locked in memory (is not swapped to disk, where supported, see
+// MemoryLock), and is zeroized with Destroy.
+type SecureBuffer struct {
+	mu sync.Mutex
+	// The secret.
+	b []byte
+	// The flag that indicates whether the memory is locked.
+	locked bool
+	// The flag that indicates whether the buffer is destroyed.
+	destroyed bool
+}
+
+// NewSecureBuffer function returns the secure buffer with the copy of
+// the secret `b`. The caller should zeroize the `b` if it is no longer
+// used.
+func NewSecureBuffer(b []byte) *SecureBuffer {
+
+	s := &SecureBuffer{b: make([]byte, len(b))}
+	copy(s.b, b)
+
+	if len(s.b) > 0 {
+		// the secret is kept in the swappable memory if the lock fails
+		// (ie RLIMIT_MEMLOCK is exceeded).
+		s.locked = lockPages(s.b) == nil
+	}
+
+	return s
+}
+
+// Bytes function returns the secret. The returned slice refers to the
+// secure buffer memory and is zeroized with Destroy.
+func (s *SecureBuffer) Bytes() []byte {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.b
+}
+
+// IsLocked function returns `true` if the secure buffer memory is locked.
+func (s *SecureBuffer) IsLocked() bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.locked
+}
+
+// Destroy function zeroizes and unlocks the secure buffer memory.
+func (s *SecureBuffer) Destroy() {
+
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.destroyed {
+		return
+	}
+
+	clear(s.b)
+
+	if s.locked {
+		unlockPages(s.b)
+	}
+
+	s.b, s.locked, s.destroyed = nil, false, true
+}
+
+// IsDestroyed function returns `true` if the secure buffer was destroyed.
+func (s *SecureBuffer) IsDestroyed() bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.destroyed
+}
+
+// pageLocks is the number of the secure buffers that locked the memory
+// page. The secure buffers are allocated on the heap and can share the page,
+// so the page is unlocked only when the last secure buffer is destroyed.
+var pageLocks = struct {
+	sync.Mutex
+	n map[uintptr]int
+}{n: make(map[uintptr]int)}
+
+// pageSize is the memory page size.
+var pageSize = uintptr(os.Getpagesize())
+
+// pages function returns the sub-slices of `b` for every memory page
+// the slice occupies, and the page addresses.
+func pages(b []byte) ([][]byte, []uintptr) {
+
+	var (
+		ret   [][]byte
+		addrs []uintptr
+	)
+
+	start := uintptr(unsafe.Pointer(&b[0]))
+
+	for off := uintptr(0); off < uintptr(len(b)); {
+		page := (start + off) &^ (pageSize - 1)
+		end := min(page+pageSize-start, uintptr(len(b)))
+		ret, addrs = append(ret, b[off:end]), append(addrs, page)
+		off = end
+	}
+
+	return ret, addrs
+}
+
+// lockPages function locks the memory pages of `b` that are not locked
+// by another secure buffer yet.
+func lockPages(b []byte) error {
+
+	pageLocks.Lock()
+	defer pageLocks.Unlock()
+
+	ps, addrs := pages(b)
+
+	for i := range ps {
+		if pageLocks.n[addrs[i]] > 0 {
+			continue
+		}
+		if err := lockMemory(ps[i]); err != nil {
+			// rollback the pages locked by this call.
+			for j := 0; j < i; j++ {
+				if pageLocks.n[addrs[j]] == 0 {
+					unlockMemory(ps[j])
+				}
+			}
+			return err
+		}
+	}
+
+	for i := range addrs {
+		pageLocks.n[addrs[i]]++
+	}
+
+	return nil
+}
+
+// unlockPages function unlocks the memory pages of `b` that are not locked
+// by another secure buffer.
+func unlockPages(b []byte) {
+
+	pageLocks.Lock()
+	defer pageLocks.Unlock()
+
+	ps, addrs := pages(b)
+
+	for i := range ps {
+		if pageLocks.n[addrs[i]]--; pageLocks.n[addrs[i]] > 0 {
+			continue
+		}
+		delete(pageLocks.n, addrs[i])
+		unlockMemory(ps[i])
+	}
+}
+
+// Destroyer interface is implemented by the credentials that can wipe the
+// secrets (see Secure).
+type Destroyer interface {
+	// Destroy function zeroizes the credential secrets.
+	Destroy()
+	// IsDestroyed function returns `true` if the credential was destroyed.
+	IsDestroyed() bool
+}
+
+// Destroy function zeroizes the credential secrets if the credential
+// implements the Destroyer interface.
+func Destroy(cred any) {
+	if cred, ok := cred.(Destroyer); ok {
+		cred.Destroy()
+	}
+}
+
+// IsDestroyed function returns `true` if the credential was destroyed.
+func IsDestroyed(cred any) bool {
+	if cred, ok := cred.(Destroyer); ok {
+		return cred.IsDestroyed()
+	}
+	return false
+}
+
+// IsSecure function returns `true` if the credential secrets are kept in the
+// locked, zeroizable memory (see Secure, NewFromPasswordBytes).
+func IsSecure(cred any) bool {
+	if cred, ok := cred.(interface{ IsSecure() bool }); ok {
+		return cred.IsSecure()
+	}
+	return false
+}
+
+type secureOpt struct{}
+
+func (secureOpt) is_CredentialOption() {}
+
+// Secure option indicates that the credential secrets must be kept in the
+// locked, zeroizable memory (see SecureBuffer). The credential can be wiped
+// with Destroy function once no longer needed:
+//
+//	creds := credential.NewFromPassword("CONTOSO\\Administrator", password, credential.Secure())
+//	defer credential.Destroy(creds)
+//
+// Note, that the password string passed to NewFromPassword cannot be zeroized,
+// use NewFromPasswordBytes to keep the password in the locked memory only.
+func Secure() Option {
+	return secureOpt{}
+}
+
+// isSecure function returns `true` if the set of options contains the
+// Secure option.
+func isSecure(opts ...Option) bool {
+	for _, opt := range opts {
+		if _, ok := opt.(secureOpt); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+//go:build !(unix || windows) || purego
+
+package credential
+
+import (
+	"errors"
+)
+
+// MemoryLock is `true` if the secure buffer memory can be locked.
+const MemoryLock = false
+
+// lockMemory function is not supported on this platform (and by the
+// `purego` build).
+func lockMemory(b []byte) error {
+	return errors.New("memory locking is not supported")
+}
+
+// unlockMemory function is not supported on this platform (and by the
+// `purego` build).
+func unlockMemory(b []byte) error {
+	return nil
+}
@@ -0,0 +1,123 @@
+package credential
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSecureBuffer(t *testing.T) {
+
+	secret := []byte("secret")
+
+	s := NewSecureBuffer(secret)
+	if !bytes.Equal(s.Bytes(), secret) || (!MemoryLock && s.IsLocked()) {
+		t.Fatalf("unexpected secure buffer: %q", s.Bytes())
+	}
+
+	b := s.Bytes()
+
+	if s.Destroy(); !s.IsDestroyed() || s.Bytes() != nil || s.IsLocked() {
+		t.Fatalf("secure buffer is not destroyed")
+	}
+
+	if !bytes.Equal(b, make([]byte, len(secret))) {
+		t.Fatalf("secure buffer is not zeroized: %q", b)
+	}
+
+	// the destroy is idempotent.
+	s.Destroy()
+}
+
+func TestSecureCredential(t *testing.T) {
+
+	pwd := NewFromPassword("CONTOSO\\Administrator", "P@ssw0rd", Secure())
+	if pwd.Password() != "P@ssw0rd" || IsDestroyed(pwd) {
+		t.Fatalf("unexpected password: %q", pwd.Password())
+	}
+
+	if Destroy(pwd); pwd.Password() != "" || !IsDestroyed(pwd) {
+		t.Fatalf("password is not destroyed")
+	}
+
+	hash := NewFromNTHashBytes("Administrator", []byte{1, 2, 3, 4}, Secure())
+	b := hash.NTHash()
+
+	if Destroy(hash); hash.NTHash() != nil || !bytes.Equal(b, make([]byte, 4)) {
+		t.Fatalf("nt hash is not destroyed")
+	}
+
+	key := []byte{5, 6, 7, 8}
+	keys := NewFromEncryptionKeys("Administrator", []EncryptionKey{{EType: ETypeAES128, Key: key}}, Secure())
+	b = keys.EncryptionKeys()[0].Key
+
+	if Destroy(keys); len(keys.EncryptionKeys()) != 0 || !bytes.Equal(b, make([]byte, 4)) {
+		t.Fatalf("encryption keys are not destroyed")
+	}
+
+	// the secure copy is destroyed, the caller key is intact.
+	if !bytes.Equal(key, []byte{5, 6, 7, 8}) {
+		t.Fatalf("caller key is zeroized")
+	}
+
+	if IsDestroyed(Anonymous()) {
+		t.Fatalf("unexpected destroyed state")
+	}
+}
+
+func TestSecureBufferSharedPage(t *testing.T) {
+
+	if !MemoryLock {
+		t.Skip("memory locking is not supported")
+	}
+
+	// the secure buffers share the memory page.
+	mem := make([]byte, 64)
+
+	s1, s2 := &SecureBuffer{b: mem[:32]}, &SecureBuffer{b: mem[32:]}
+	if s1.locked, s2.locked = lockPages(s1.b) == nil, lockPages(s2.b) == nil; !s1.locked || !s2.locked {
+		t.Skip("memory locking is not permitted")
+	}
+
+	_, addrs := pages(mem)
+
+	s1.Destroy()
+
+	// the page is kept locked for the second buffer.
+	if n := pageLocks.n[addrs[0]]; n != 1 {
+		t.Fatalf("unexpected page lock count: %d", n)
+	}
+
+	if s2.Destroy(); pageLocks.n[addrs[0]] != 0 {
+		t.Fatalf("page is not unlocked")
+	}
+}
+
+func TestPasswordBytes(t *testing.T) {
+
+	passwd := []byte("P@ssw0rdé\U0001f600")
+
+	pwd := NewFromPasswordBytes("CONTOSO\\Administrator", passwd)
+
+	// the input is owned and zeroized by the credential.
+	if !bytes.Equal(passwd, make([]byte, len(passwd))) {
+		t.Fatalf("password input is not zeroized: %q", passwd)
+	}
+
+	if !bytes.Equal(PasswordBytes(pwd), []byte("P@ssw0rdé\U0001f600")) || pwd.DomainName() != "CONTOSO" {
+		t.Fatalf("unexpected password: %q", PasswordBytes(pwd))
+	}
+
+	expected := []byte{'P', 0, '@', 0, 's', 0, 's', 0, 'w', 0, '0', 0, 'r', 0, 'd', 0, 0xe9, 0, 0x3d, 0xd8, 0x00, 0xde}
+
+	if b := PasswordUTF16(pwd); !bytes.Equal(b, expected) {
+		t.Fatalf("unexpected utf-16 password: %x", b)
+	}
+
+	if b := PasswordUTF16(NewFromPassword("Administrator", "P@ssw0rdé\U0001f600")); !bytes.Equal(b, expected) {
+		t.Fatalf("unexpected utf-16 password: %x", b)
+	}
+
+	if Destroy(pwd); PasswordBytes(pwd) != nil {
+		t.Fatalf("password is not destroyed")
+	}
+}
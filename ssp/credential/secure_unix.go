@@ -0,0 +1,20 @@
+//go:build unix && !purego
+
+package credential
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// MemoryLock is `true` if the secure buffer memory can be locked.
+const MemoryLock = true
+
+// lockMemory function locks the memory pages of `b` with mlock(2).
+func lockMemory(b []byte) error {
+	return unix.Mlock(b)
+}
+
+// unlockMemory function unlocks the memory pages of `b` with munlock(2).
+func unlockMemory(b []byte) error {
+	return unix.Munlock(b)
+}
@@ -0,0 +1,22 @@
+//go:build windows && !purego
+
+package credential
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// MemoryLock is `true` if the secure buffer memory can be locked.
+const MemoryLock = true
+
+// lockMemory function locks the memory pages of `b` with VirtualLock.
+func lockMemory(b []byte) error {
+	return windows.VirtualLock(uintptr(unsafe.Pointer(&b[0])), uintptr(len(b)))
+}
+
+// unlockMemory function unlocks the memory pages of `b` with VirtualUnlock.
+func unlockMemory(b []byte) error {
+	return windows.VirtualUnlock(uintptr(unsafe.Pointer(&b[0])), uintptr(len(b)))
+}
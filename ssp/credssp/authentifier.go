@@ -0,0 +1,319 @@
+package credssp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+	"github.com/oiweiwei/go-msrpc/ssp/spnego"
+)
+
+// The authentication state.
+type state int
+
+const (
+	// TLS handshake.
+	stateTLS state = iota
+	// SPNEGO authentication and public key binding.
+	stateNego
+	// Credentials delegated.
+	stateDone
+)
+
+const (
+	// The client nonce length.
+	nonceSize = 32
+)
+
+var (
+	ErrInvalidPubKeyAuth = errors.New("credssp: server public key binding mismatch")
+	ErrNoPubKeyAuth      = errors.New("credssp: server public key binding is missing")
+)
+
+type Authentifier struct {
+	// The authentifier configuration.
+	*Config
+	// The TLS channel.
+	TLS *TLSContext
+	// The inner SPNEGO authentifier.
+	SPNEGO *spnego.Authentifier
+	// The negotiated protocol version.
+	version int
+	// The client nonce.
+	nonce []byte
+	// The server public key.
+	pubKey []byte
+	// The flag that indicates that public key binding was sent.
+	pubKeyAuthSent bool
+	// The authentication state.
+	state state
+}
+
+// Negotiate function returns the TLS ClientHello message.
+func (a *Authentifier) Negotiate(ctx context.Context) ([]byte, error) {
+
+	a.TLS, a.version = NewTLSClient(a.Config.TLSConfig), a.Config.Version
+
+	if a.version == 0 {
+		a.version = Version
+	}
+
+	a.SPNEGO = &spnego.Authentifier{
+		Config: &spnego.Config{
+			Capabilities:            a.Config.Capabilities,
+			MechanismsList:          a.Config.MechanismsList,
+			RequireMechanismListMIC: a.Config.RequireMechanismListMIC,
+		},
+	}
+
+	b, err := a.TLS.Handshake(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("credssp: init: %w", err)
+	}
+
+	return b, nil
+}
+
+// Respond function processes the server token and returns the client token.
+// The returned flag is set when the credentials are delegated and security
+// context is established.
+func (a *Authentifier) Respond(ctx context.Context, b []byte) ([]byte, bool, error) {
+
+	switch a.state {
+	case stateTLS:
+
+		out, err := a.TLS.Handshake(ctx, b)
+		if err != nil {
+			return nil, false, fmt.Errorf("credssp: init: %w", err)
+		}
+
+		if !a.TLS.IsEstablished() {
+			return out, false, nil
+		}
+
+		if a.pubKey, err = a.TLS.PeerPublicKey(); err != nil {
+			return nil, false, fmt.Errorf("credssp: init: %w", err)
+		}
+
+		if a.nonce = make([]byte, nonceSize); a.version >= 5 {
+			if _, err := rand.Read(a.nonce); err != nil {
+				return nil, false, fmt.Errorf("credssp: init: make nonce: %w", err)
+			}
+		}
+
+		a.state = stateNego
+
+		tok, err := a.SPNEGO.Negotiate(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("credssp: init: %w", err)
+		}
+
+		req, err := a.makeRequest(ctx, tok)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return append(out, req...), false, nil
+
+	case stateNego:
+
+		resp, err := a.readResponse(ctx, b)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var tok []byte
+
+		if len(resp.NegoTokens) > 0 {
+			if tok, err = a.SPNEGO.Respond(ctx, resp.NegoTokens[0].NegoToken); err != nil {
+				return nil, false, fmt.Errorf("credssp: init: %w", err)
+			}
+		}
+
+		if !a.pubKeyAuthSent || len(resp.PubKeyAuth) == 0 {
+			if tok == nil && a.pubKeyAuthSent {
+				return nil, false, fmt.Errorf("credssp: init: %w", ErrNoPubKeyAuth)
+			}
+			req, err := a.makeRequest(ctx, tok)
+			if err != nil {
+				return nil, false, err
+			}
+			return req, false, nil
+		}
+
+		if err := a.verifyPubKeyAuth(ctx, resp.PubKeyAuth); err != nil {
+			return nil, false, err
+		}
+
+		a.state = stateDone
+
+		req, err := a.makeAuthInfo(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return req, true, nil
+	}
+
+	return nil, false, fmt.Errorf("credssp: init: unexpected token")
+}
+
+// makeRequest function returns the encrypted TSRequest with the SPNEGO token
+// and the public key binding (if SPNEGO authentication is complete).
+func (a *Authentifier) makeRequest(ctx context.Context, tok []byte) ([]byte, error) {
+
+	req := &TSRequest{Version: a.version}
+
+	if tok != nil {
+		req.NegoTokens = []NegoData{{NegoToken: tok}}
+	}
+
+	if gssapi.IsComplete(ctx) && !a.pubKeyAuthSent {
+
+		pubKeyAuth, err := a.wrap(ctx, ClientPubKeyAuth(a.version, a.nonce, a.pubKey))
+		if err != nil {
+			return nil, fmt.Errorf("credssp: init: wrap public key binding: %w", err)
+		}
+
+		if req.PubKeyAuth, a.pubKeyAuthSent = pubKeyAuth, true; a.version >= 5 {
+			req.ClientNonce = a.nonce
+		}
+	}
+
+	return a.writeRequest(ctx, req)
+}
+
+// makeAuthInfo function returns the encrypted TSRequest with the encrypted
+// delegated credentials.
+func (a *Authentifier) makeAuthInfo(ctx context.Context) ([]byte, error) {
+
+	cred, ok := a.Config.Credential.(credential.Password)
+	if !ok {
+		return nil, fmt.Errorf("credssp: init: %w", gssapi.ErrDefectiveCredential)
+	}
+
+	pass := credential.PasswordUTF16(cred)
+	defer clear(pass)
+
+	creds, err := NewPasswordCredentialsUTF16(cred.DomainName(), cred.UserName(), pass)
+	if err != nil {
+		return nil, fmt.Errorf("credssp: init: %w", err)
+	}
+
+	defer clear(creds)
+
+	authInfo, err := a.wrap(ctx, creds)
+	if err != nil {
+		return nil, fmt.Errorf("credssp: init: wrap credentials: %w", err)
+	}
+
+	return a.writeRequest(ctx, &TSRequest{Version: a.version, AuthInfo: authInfo})
+}
+
+// verifyPubKeyAuth function verifies the server public key binding.
+func (a *Authentifier) verifyPubKeyAuth(ctx context.Context, b []byte) error {
+
+	expected := ServerPubKeyAuth(a.version, a.nonce, a.pubKey)
+
+	pubKeyAuth, err := a.unwrap(ctx, b, len(expected))
+	if err != nil {
+		return fmt.Errorf("credssp: init: unwrap public key binding: %w", err)
+	}
+
+	if !bytes.Equal(pubKeyAuth, expected) {
+		return ErrInvalidPubKeyAuth
+	}
+
+	return nil
+}
+
+// writeRequest function marshals and encrypts the TSRequest.
+func (a *Authentifier) writeRequest(ctx context.Context, req *TSRequest) ([]byte, error) {
+
+	b, err := req.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("credssp: init: marshal ts_request: %w", err)
+	}
+
+	if b, err = a.TLS.Encrypt(b); err != nil {
+		return nil, fmt.Errorf("credssp: init: %w", err)
+	}
+
+	return b, nil
+}
+
+// readResponse function decrypts and unmarshals the TSRequest and checks
+// the server error code.
+func (a *Authentifier) readResponse(ctx context.Context, b []byte) (*TSRequest, error) {
+
+	b, err := a.TLS.Decrypt(b)
+	if err != nil {
+		return nil, fmt.Errorf("credssp: init: %w", err)
+	}
+
+	resp := &TSRequest{}
+	if err := resp.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	if resp.ErrorCode != 0 {
+		return nil, fmt.Errorf("credssp: init: server error: 0x%08x", uint32(resp.ErrorCode))
+	}
+
+	if resp.Version < a.version {
+		// downgrade to the server version.
+		a.version = resp.Version
+	}
+
+	return resp, nil
+}
+
+// wrap function encrypts the payload with the inner SPNEGO security context
+// and returns the signature and encrypted payload.
+func (a *Authentifier) wrap(ctx context.Context, b []byte) ([]byte, error) {
+
+	tok, err := a.SPNEGO.Mechanism.Wrap(ctx, &gssapi.MessageToken{
+		Capabilities: gssapi.Integrity | gssapi.Confidentiality,
+		Payload:      append([]byte{}, b...),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(tok.Signature, tok.Payload...), nil
+}
+
+// unwrap function decrypts the `sz`-bytes payload with the inner SPNEGO
+// security context.
+func (a *Authentifier) unwrap(ctx context.Context, b []byte, sz int) ([]byte, error) {
+
+	if len(b) < sz {
+		return nil, ErrInvalidPubKeyAuth
+	}
+
+	tok, err := a.SPNEGO.Mechanism.Unwrap(ctx, &gssapi.MessageToken{
+		Capabilities: gssapi.Integrity | gssapi.Confidentiality,
+		Signature:    b[:len(b)-sz],
+		Payload:      append([]byte{}, b[len(b)-sz:]...),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tok.Payload, nil
+}
+
+// Seal function encrypts the payloads in-place with the TLS channel and
+// returns the signature.
+func (a *Authentifier) Seal(ctx context.Context, payloads [][]byte) ([]byte, error) {
+	return a.TLS.Seal(payloads)
+}
+
+// Unseal function decrypts the payloads in-place with the TLS channel.
+func (a *Authentifier) Unseal(ctx context.Context, payloads [][]byte, sgn []byte) error {
+	return a.TLS.Unseal(payloads, sgn)
+}
@@ -0,0 +1,45 @@
+package credssp
+
+import (
+	"crypto/tls"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// The generic credential.
+type Credential = credential.Credential
+
+// IsValidCredential function returns true if the credential can be delegated
+// to the server. (Only password credentials are supported).
+func IsValidCredential(cred any) bool {
+	_, ok := cred.(credential.Password)
+	return ok
+}
+
+// The CredSSP configuration.
+type Config struct {
+	// The credential to authenticate and delegate.
+	Credential Credential
+	// The TLS configuration. The server certificate is not verified by
+	// default, since the server public key is bound to the SPNEGO
+	// authentication.
+	TLSConfig *tls.Config
+	// The CredSSP protocol version to advertise.
+	Version int
+	// The list of mechanisms for the inner SPNEGO authentication. If
+	// empty, all registered mechanisms except SPNEGO and CredSSP are used.
+	MechanismsList []gssapi.MechanismFactory
+	// Require mechanism list MIC for the inner SPNEGO authentication.
+	RequireMechanismListMIC bool
+	// The services available.
+	Capabilities gssapi.Cap
+}
+
+// NewConfig function returns the default configuration for the CredSSP.
+func NewConfig() *Config {
+	return &Config{
+		TLSConfig: &tls.Config{InsecureSkipVerify: true},
+		Version:   Version,
+	}
+}
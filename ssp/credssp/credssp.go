@@ -0,0 +1,173 @@
+// package credssp implements the Credential Security Support Provider (CredSSP)
+// client as described in
+// https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-cssp/85f57821-40bb-46aa-bfcb-ba9590b8fc30.
+//
+// The CredSSP establishes the TLS channel with the server, authenticates the
+// client with the SPNEGO (NTLM or Kerberos) over the TLS channel, binds the
+// TLS server public key to the authentication and finally delegates the client
+// password credentials to the server. The messages are protected with the TLS
+// channel.
+//
+// This package also contains client-side GSSAPI bindings (InitSecurityContext, Wrap, Unwrap and so on).
+package credssp
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+const (
+	// The maximum supported CredSSP protocol version.
+	Version = 6
+	// The password credentials type.
+	CredTypePassword = 1
+)
+
+var (
+	// The client-to-server public key binding hash magic (version 5+).
+	clientServerHashMagic = []byte("CredSSP Client-To-Server Binding Hash\x00")
+	// The server-to-client public key binding hash magic (version 5+).
+	serverClientHashMagic = []byte("CredSSP Server-To-Client Binding Hash\x00")
+)
+
+// TSRequest structure represents the top-most CredSSP message.
+type TSRequest struct {
+	// The supported version of the CredSSP protocol.
+	Version int `asn1:"explicit,tag:0"`
+	// The SPNEGO tokens.
+	NegoTokens []NegoData `asn1:"explicit,optional,tag:1"`
+	// The encrypted TSCredentials.
+	AuthInfo []byte `asn1:"explicit,optional,tag:2"`
+	// The encrypted public key binding.
+	PubKeyAuth []byte `asn1:"explicit,optional,tag:3"`
+	// The NTSTATUS error code (version 3+).
+	ErrorCode int64 `asn1:"explicit,optional,tag:4"`
+	// The client nonce (version 5+).
+	ClientNonce []byte `asn1:"explicit,optional,tag:5"`
+}
+
+// NegoData structure represents the SPNEGO token.
+type NegoData struct {
+	// The SPNEGO token.
+	NegoToken []byte `asn1:"explicit,tag:0"`
+}
+
+// Marshal function marshals the TSRequest.
+func (r *TSRequest) Marshal() ([]byte, error) {
+	return asn1.Marshal(*r)
+}
+
+// Unmarshal function unmarshals the TSRequest.
+func (r *TSRequest) Unmarshal(b []byte) error {
+	if _, err := asn1.Unmarshal(b, r); err != nil {
+		return fmt.Errorf("credssp: unmarshal ts_request: %w", err)
+	}
+	return nil
+}
+
+// TSCredentials structure represents the delegated credentials.
+type TSCredentials struct {
+	// The credentials type.
+	CredType int `asn1:"explicit,tag:0"`
+	// The credentials (TSPasswordCreds).
+	Credentials []byte `asn1:"explicit,tag:1"`
+}
+
+// TSPasswordCreds structure represents the password credentials.
+type TSPasswordCreds struct {
+	// The domain name (UTF-16LE).
+	DomainName []byte `asn1:"explicit,tag:0"`
+	// The user name (UTF-16LE).
+	UserName []byte `asn1:"explicit,tag:1"`
+	// The password (UTF-16LE).
+	Password []byte `asn1:"explicit,tag:2"`
+}
+
+// NewPasswordCredentials function returns the marshaled TSCredentials with
+// the password credentials.
+func NewPasswordCredentials(domain, user, password string) ([]byte, error) {
+
+	pass, err := utf16le.Encode(password)
+	if err != nil {
+		return nil, err
+	}
+
+	defer clear(pass)
+
+	return NewPasswordCredentialsUTF16(domain, user, pass)
+}
+
+// NewPasswordCredentialsUTF16 function returns the marshaled TSCredentials with
+// the password credentials for the UTF-16LE encoded password `password`. The
+// intermediate encodings of the password are zeroized, the caller should zeroize
+// the `password` and the returned credentials once they are no longer used.
+func NewPasswordCredentialsUTF16(domain, user string, password []byte) ([]byte, error) {
+
+	var (
+		creds TSPasswordCreds
+		err   error
+	)
+
+	if creds.DomainName, err = utf16le.Encode(domain); err != nil {
+		return nil, err
+	}
+
+	if creds.UserName, err = utf16le.Encode(user); err != nil {
+		return nil, err
+	}
+
+	creds.Password = password
+
+	b, err := asn1.Marshal(creds)
+	if err != nil {
+		return nil, fmt.Errorf("credssp: marshal password creds: %w", err)
+	}
+
+	defer clear(b)
+
+	ret, err := asn1.Marshal(TSCredentials{CredType: CredTypePassword, Credentials: b})
+	if err != nil {
+		return nil, fmt.Errorf("credssp: marshal ts_credentials: %w", err)
+	}
+
+	return ret, nil
+}
+
+// ClientPubKeyAuth function returns the client public key binding for the
+// server `pubKey` for the protocol `version`.
+func ClientPubKeyAuth(version int, nonce, pubKey []byte) []byte {
+
+	if version < 5 {
+		return append([]byte{}, pubKey...)
+	}
+
+	h := sha256.New()
+	h.Write(clientServerHashMagic)
+	h.Write(nonce)
+	h.Write(pubKey)
+
+	return h.Sum(nil)
+}
+
+// ServerPubKeyAuth function returns the expected server public key binding
+// for the server `pubKey` for the protocol `version`.
+func ServerPubKeyAuth(version int, nonce, pubKey []byte) []byte {
+
+	if version < 5 {
+		b := append([]byte{}, pubKey...)
+		if len(b) > 0 {
+			b[0]++
+		}
+		return b
+	}
+
+	h := sha256.New()
+	h.Write(serverClientHashMagic)
+	h.Write(nonce)
+	h.Write(pubKey)
+
+	return h.Sum(nil)
+}
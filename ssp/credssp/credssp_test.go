@@ -0,0 +1,176 @@
+package credssp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// testCertificate function returns the self-signed server certificate.
+func testCertificate(t *testing.T) tls.Certificate {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "dc01.contoso.net"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestTLS(t *testing.T) {
+
+	ctx := context.Background()
+
+	cert := testCertificate(t)
+
+	cli := NewTLSClient(NewConfig().TLSConfig)
+	srv := NewTLSServer(&tls.Config{Certificates: []tls.Certificate{cert}})
+
+	defer cli.Close()
+	defer srv.Close()
+
+	var (
+		in, out []byte
+		err     error
+	)
+
+	for i := 0; !cli.IsEstablished() || !srv.IsEstablished(); i++ {
+
+		if i > 10 {
+			t.Fatalf("handshake: too many legs")
+		}
+
+		if out, err = cli.Handshake(ctx, in); err != nil {
+			t.Fatalf("client handshake: %v", err)
+		}
+
+		if srv.IsEstablished() {
+			break
+		}
+
+		if in, err = srv.Handshake(ctx, out); err != nil {
+			t.Fatalf("server handshake: %v", err)
+		}
+	}
+
+	pubKey, err := cli.PeerPublicKey()
+	if err != nil {
+		t.Fatalf("peer public key: %v", err)
+	}
+
+	x509Cert, _ := x509.ParseCertificate(cert.Certificate[0])
+	expected := elliptic.Marshal(elliptic.P256(), x509Cert.PublicKey.(*ecdsa.PublicKey).X, x509Cert.PublicKey.(*ecdsa.PublicKey).Y)
+
+	if !bytes.Equal(pubKey, expected) {
+		t.Fatalf("peer public key: unexpected %x", pubKey)
+	}
+
+	// the stream encryption.
+	rec, err := cli.Encrypt([]byte("ts_request"))
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+
+	b, err := srv.Decrypt(rec)
+	if err != nil || string(b) != "ts_request" {
+		t.Fatalf("decrypt: %q, %v", b, err)
+	}
+
+	// the message protection.
+	hdr, body := []byte("header"), []byte("stub_data")
+	payloads := [][]byte{append([]byte{}, hdr...), append([]byte{}, body...)}
+
+	sgn, err := srv.Seal(payloads)
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+
+	if len(sgn) != signatureSize || bytes.Equal(payloads[1], body) {
+		t.Fatalf("seal: unexpected signature %x", sgn)
+	}
+
+	if err := cli.Unseal(payloads, sgn); err != nil {
+		t.Fatalf("unseal: %v", err)
+	}
+
+	if !bytes.Equal(payloads[0], hdr) || !bytes.Equal(payloads[1], body) {
+		t.Fatalf("unseal: unexpected payload %q", payloads)
+	}
+
+	// tampered payload.
+	if sgn, err = srv.Seal(payloads); err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+
+	payloads[1][0] ^= 0xFF
+
+	if err := cli.Unseal(payloads, sgn); err == nil {
+		t.Fatalf("unseal: tampered payload accepted")
+	}
+}
+
+func TestTSRequest(t *testing.T) {
+
+	creds, err := NewPasswordCredentials("CONTOSO", "Administrator", "P@ssw0rd")
+	if err != nil {
+		t.Fatalf("password credentials: %v", err)
+	}
+
+	req := &TSRequest{
+		Version:     Version,
+		NegoTokens:  []NegoData{{NegoToken: []byte("negotiate")}},
+		AuthInfo:    creds,
+		PubKeyAuth:  []byte("pub_key_auth"),
+		ErrorCode:   -1073741715, // STATUS_LOGON_FAILURE.
+		ClientNonce: bytes.Repeat([]byte{1}, nonceSize),
+	}
+
+	b, err := req.Marshal()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var resp TSRequest
+	if err := resp.Unmarshal(b); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if resp.Version != req.Version || resp.ErrorCode != req.ErrorCode || len(resp.NegoTokens) != 1 ||
+		!bytes.Equal(resp.AuthInfo, creds) || !bytes.Equal(resp.ClientNonce, req.ClientNonce) {
+		t.Fatalf("unmarshal: unexpected request %+v", resp)
+	}
+
+	pubKey := []byte{0x30, 0x82, 0x01, 0x0a}
+
+	if b := ServerPubKeyAuth(2, nil, pubKey); b[0] != 0x31 || !bytes.Equal(ClientPubKeyAuth(2, nil, pubKey), pubKey) {
+		t.Fatalf("pub key auth v2: unexpected %x", b)
+	}
+
+	nonce := bytes.Repeat([]byte{1}, nonceSize)
+
+	if bytes.Equal(ClientPubKeyAuth(6, nonce, pubKey), ServerPubKeyAuth(6, nonce, pubKey)) {
+		t.Fatalf("pub key auth v6: client and server hashes must differ")
+	}
+}
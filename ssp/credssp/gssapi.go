@@ -0,0 +1,239 @@
+package credssp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+	"github.com/oiweiwei/go-msrpc/ssp/spnego"
+)
+
+var (
+	// The mechanism type object identifier. CredSSP does not define the
+	// GSS-API mechanism OID, the identifier is local to this library and is
+	// never sent over the wire.
+	MechanismType = gssapi.OID{1, 3, 6, 1, 4, 1, 311, 2, 2, 22}
+)
+
+var (
+	ErrConfidentialityRequired = errors.New("credssp: confidentiality is required")
+	ErrIntegrityNotSupported   = errors.New("credssp: integrity-only protection is not supported")
+)
+
+// The CredSSP GSS API Mechanism.
+type Mechanism struct {
+	*Authentifier
+}
+
+// Config binding for GSS.
+func (Config) Type() gssapi.OID {
+	return MechanismType
+}
+
+// Copy function returns the copy of the configuration.
+func (c *Config) Copy() gssapi.MechanismConfig {
+
+	cp := *c
+
+	if c.TLSConfig != nil {
+		cp.TLSConfig = c.TLSConfig.Clone()
+	}
+
+	cp.MechanismsList = make([]gssapi.MechanismFactory, len(c.MechanismsList))
+	copy(cp.MechanismsList, c.MechanismsList)
+
+	return &cp
+}
+
+// The mechanism type object identifier.
+func (Mechanism) Type() gssapi.OID {
+	return MechanismType
+}
+
+// DefaultConfig function returns the default config.
+func (Mechanism) DefaultConfig(ctx context.Context) (gssapi.MechanismConfig, error) {
+	return NewConfig(), nil
+}
+
+// New function returns the new mechanism instance from the GSSAPI configuration.
+func (Mechanism) New(ctx context.Context) (gssapi.Mechanism, error) {
+
+	var (
+		ok bool
+	)
+
+	// extract the context.
+	cc := gssapi.FromContext(ctx)
+
+	// try get the mechanism config base.
+	c, ok := gssapi.GetMechanismConfig(ctx, MechanismType).(*Config)
+	if !ok || c == nil {
+		// config should have been populated.
+		return nil, gssapi.ContextError(ctx, gssapi.NoContext, gssapi.ErrNoContext)
+	}
+
+	if cc.Credential != nil {
+		if c.Credential, ok = cc.Credential.Value().(Credential); !ok || !IsValidCredential(c.Credential) {
+			return nil, gssapi.ContextError(ctx, gssapi.DefectiveCredential, gssapi.ErrDefectiveCredential)
+		}
+	} else {
+		return nil, gssapi.ContextError(ctx, gssapi.DefectiveCredential, gssapi.ErrDefectiveCredential)
+	}
+
+	if !cc.Capabilities.IsSet(gssapi.Confidentiality) {
+		// the messages are protected with the TLS channel that always
+		// encrypts the data.
+		return nil, gssapi.ContextError(ctx, gssapi.Unavailable, ErrConfidentialityRequired)
+	}
+
+	c.Capabilities = cc.Capabilities
+
+	if len(c.MechanismsList) == 0 {
+		for _, m := range gssapi.ListMechanisms(ctx) {
+			if m.Type().Equal(MechanismType) || m.Type().Equal((gssapi.OID)(spnego.MechanismTypeSPNEGO)) {
+				continue
+			}
+			c.MechanismsList = append(c.MechanismsList, m)
+		}
+	}
+
+	if len(c.MechanismsList) == 0 {
+		return nil, gssapi.ContextError(ctx, gssapi.Unavailable, gssapi.ErrUnavailable)
+	}
+
+	return &Mechanism{
+		Authentifier: &Authentifier{Config: c},
+	}, nil
+}
+
+var (
+	_ gssapi.MechanismFactory = (*Mechanism)(nil)
+	_ gssapi.Mechanism        = (*Mechanism)(nil)
+	_ gssapi.MechanismEx      = (*Mechanism)(nil)
+)
+
+// The security context init call.
+func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token, error) {
+
+	if m.Authentifier.TLS == nil {
+
+		b, err := m.Negotiate(ctx)
+		if err != nil {
+			return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+		}
+
+		return &gssapi.Token{Payload: b}, gssapi.ContextContinueNeeded(ctx)
+	}
+
+	b, done, err := m.Respond(ctx, tok.Payload)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	if done {
+		return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
+	}
+
+	return &gssapi.Token{Payload: b}, gssapi.ContextContinueNeeded(ctx)
+}
+
+// The security context accept call.
+func (m *Mechanism) Accept(ctx context.Context, tok *gssapi.Token) (*gssapi.Token, error) {
+	return nil, gssapi.ContextError(ctx, gssapi.Unavailable, gssapi.ErrUnavailable)
+}
+
+// The maximum message size for the given limit. (and flag determining if
+// conf is required).
+func (m *Mechanism) WrapSizeLimit(ctx context.Context, sz int, conf bool) int {
+	return sz - signatureSize
+}
+
+// Wrap token.
+func (m *Mechanism) Wrap(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+
+	sgn, err := m.Seal(ctx, [][]byte{tok.Payload})
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	return &gssapi.MessageToken{
+		QoP:          tok.QoP,
+		Capabilities: tok.Capabilities,
+		Payload:      tok.Payload,
+		Signature:    sgn,
+	}, nil
+}
+
+// WrapEx function accepts the list of unencrypted payloads and returns the
+// encrypted payload and signature. Only the payloads marked for
+// confidentiality are protected.
+func (m *Mechanism) WrapEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+
+	forSeal := [][]byte{}
+	for _, tok := range tokEx.Payloads {
+		if tok.Capabilities.IsSet(gssapi.Confidentiality) {
+			forSeal = append(forSeal, tok.Payload)
+		}
+	}
+
+	var err error
+
+	if tokEx.Signature, err = m.Seal(ctx, forSeal); err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	return tokEx, nil
+}
+
+// Unwrap token.
+func (m *Mechanism) Unwrap(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+
+	if err := m.Unseal(ctx, [][]byte{tok.Payload}, tok.Signature); err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.BadMIC, err)
+	}
+
+	return &gssapi.MessageToken{
+		QoP:          tok.QoP,
+		Capabilities: tok.Capabilities,
+		Payload:      tok.Payload,
+		Signature:    tok.Signature,
+	}, nil
+}
+
+// UnwrapEx function accepts the list of encrypted payloads and signature and
+// returns the unencrypted paylaod.
+func (m *Mechanism) UnwrapEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+
+	forSeal := [][]byte{}
+	for _, tok := range tokEx.Payloads {
+		if tok.Capabilities.IsSet(gssapi.Confidentiality) {
+			forSeal = append(forSeal, tok.Payload)
+		}
+	}
+
+	if err := m.Unseal(ctx, forSeal, tokEx.Signature); err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.BadMIC, err)
+	}
+
+	return tokEx, nil
+}
+
+// MakeSignature token.
+func (m *Mechanism) MakeSignature(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+	return nil, gssapi.ContextError(ctx, gssapi.Unavailable, ErrIntegrityNotSupported)
+}
+
+// MakeSignatureEx token.
+func (m *Mechanism) MakeSignatureEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+	return nil, gssapi.ContextError(ctx, gssapi.Unavailable, ErrIntegrityNotSupported)
+}
+
+// VerifySignature token.
+func (m *Mechanism) VerifySignature(ctx context.Context, tok *gssapi.MessageToken) error {
+	return gssapi.ContextError(ctx, gssapi.Unavailable, ErrIntegrityNotSupported)
+}
+
+// VerifySignatureEx token.
+func (m *Mechanism) VerifySignatureEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) error {
+	return gssapi.ContextError(ctx, gssapi.Unavailable, ErrIntegrityNotSupported)
+}
@@ -0,0 +1,459 @@
+package credssp
+
+// tls.go module contains the TLS driver that performs the TLS handshake and
+// message protection over the security context tokens instead of network
+// connection.
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// The TLS record header length.
+	recordHeaderSize = 5
+	// The AES-GCM explicit nonce length.
+	explicitNonceSize = 8
+	// The AES-GCM authentication tag length.
+	tagSize = 16
+	// The signature (record header, explicit nonce and tag) length.
+	signatureSize = recordHeaderSize + explicitNonceSize + tagSize
+	// The maximum TLS record plaintext length.
+	maxPlaintextSize = 16384
+)
+
+var (
+	// The cipher suites with the fixed-size (explicit nonce + tag) record
+	// overhead that allow to split the TLS record into the signature and
+	// encrypted payload.
+	cipherSuites = []uint16{
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+	}
+)
+
+var (
+	ErrHandshakeIncomplete = errors.New("credssp: tls: handshake is not complete")
+	ErrUnsupportedCipher   = errors.New("credssp: tls: unsupported cipher suite")
+	ErrInvalidRecord       = errors.New("credssp: tls: invalid record")
+)
+
+// errWouldBlock is a temporary error returned by the connection when no
+// input is available, crypto/tls does not treat temporary errors as fatal.
+var errWouldBlock net.Error = wouldBlock{}
+
+type wouldBlock struct{}
+
+func (wouldBlock) Error() string   { return "credssp: tls: no input available" }
+func (wouldBlock) Timeout() bool   { return true }
+func (wouldBlock) Temporary() bool { return true }
+
+// tokenConn is a net.Conn that reads the input from the security context
+// tokens and collects the output into the token.
+type tokenConn struct {
+	mu sync.Mutex
+	// The input buffer.
+	in bytes.Buffer
+	// The output buffer.
+	out bytes.Buffer
+	// The handshake flag, if set, Read blocks until the input is provided.
+	handshake bool
+	// The input notification channel.
+	inCh chan []byte
+	// The input request notification channel.
+	waitCh chan struct{}
+	// The close channel.
+	closeCh chan struct{}
+	// The close once.
+	closeOnce sync.Once
+}
+
+func newTokenConn() *tokenConn {
+	return &tokenConn{
+		handshake: true,
+		inCh:      make(chan []byte),
+		waitCh:    make(chan struct{}),
+		closeCh:   make(chan struct{}),
+	}
+}
+
+// Read function reads the input, during the handshake Read blocks and
+// requests the input from the handshake driver.
+func (c *tokenConn) Read(b []byte) (int, error) {
+
+	c.mu.Lock()
+	if c.in.Len() > 0 {
+		defer c.mu.Unlock()
+		return c.in.Read(b)
+	}
+	handshake := c.handshake
+	c.mu.Unlock()
+
+	if !handshake {
+		return 0, errWouldBlock
+	}
+
+	// request more input.
+	select {
+	case c.waitCh <- struct{}{}:
+	case <-c.closeCh:
+		return 0, io.EOF
+	}
+
+	select {
+	case in := <-c.inCh:
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.in.Write(in)
+		return c.in.Read(b)
+	case <-c.closeCh:
+		return 0, io.EOF
+	}
+}
+
+// Write function appends the data to the output buffer.
+func (c *tokenConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.out.Write(b)
+}
+
+// Close function unblocks the pending handshake.
+func (c *tokenConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closeCh) })
+	return nil
+}
+
+// feed function appends the input (post-handshake).
+func (c *tokenConn) feed(b []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.in.Write(b)
+}
+
+// drain function returns and resets the output.
+func (c *tokenConn) drain() []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.out.Len() == 0 {
+		return nil
+	}
+	b := append([]byte{}, c.out.Bytes()...)
+	c.out.Reset()
+	return b
+}
+
+// pending function returns the length of unread input.
+func (c *tokenConn) pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.in.Len()
+}
+
+func (c *tokenConn) LocalAddr() net.Addr                { return tokenAddr{} }
+func (c *tokenConn) RemoteAddr() net.Addr               { return tokenAddr{} }
+func (c *tokenConn) SetDeadline(t time.Time) error      { return nil }
+func (c *tokenConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *tokenConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type tokenAddr struct{}
+
+func (tokenAddr) Network() string { return "credssp" }
+func (tokenAddr) String() string  { return "credssp" }
+
+// TLSContext structure represents the TLS channel driven by the security
+// context tokens.
+type TLSContext struct {
+	// The TLS connection.
+	conn *tls.Conn
+	// The token connection.
+	tc *tokenConn
+	// The handshake result.
+	done chan error
+	// The flag that indicates that the handshake waits for the input.
+	waiting bool
+	// The handshake completion flag.
+	established bool
+}
+
+// tlsConfig function returns the TLS configuration restricted to the
+// TLS 1.2 and AES-GCM cipher suites.
+func tlsConfig(cfg *tls.Config) *tls.Config {
+
+	if cfg == nil {
+		cfg = &tls.Config{}
+	}
+
+	cfg = cfg.Clone()
+	cfg.MinVersion, cfg.MaxVersion = tls.VersionTLS12, tls.VersionTLS12
+	cfg.CipherSuites = cipherSuites
+	cfg.SessionTicketsDisabled = true
+	cfg.DynamicRecordSizingDisabled = true
+	cfg.Renegotiation = tls.RenegotiateNever
+
+	return cfg
+}
+
+// NewTLSClient function returns the client TLS context.
+func NewTLSClient(cfg *tls.Config) *TLSContext {
+	tc := newTokenConn()
+	return &TLSContext{conn: tls.Client(tc, tlsConfig(cfg)), tc: tc}
+}
+
+// NewTLSServer function returns the server TLS context.
+func NewTLSServer(cfg *tls.Config) *TLSContext {
+	tc := newTokenConn()
+	return &TLSContext{conn: tls.Server(tc, tlsConfig(cfg)), tc: tc}
+}
+
+// IsEstablished function returns true if the TLS handshake is complete.
+func (t *TLSContext) IsEstablished() bool {
+	return t.established
+}
+
+// Handshake function processes the handshake input token and returns the
+// output token. The handshake is complete when IsEstablished returns true.
+func (t *TLSContext) Handshake(ctx context.Context, in []byte) ([]byte, error) {
+
+	if t.established {
+		return nil, nil
+	}
+
+	if t.done == nil {
+		t.done = make(chan error, 1)
+		go func() {
+			t.done <- t.conn.HandshakeContext(context.Background())
+		}()
+	}
+
+	if in != nil {
+		if !t.waiting {
+			select {
+			case <-t.tc.waitCh:
+			case err := <-t.done:
+				return t.handshakeDone(err)
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		select {
+		case t.tc.inCh <- in:
+			t.waiting = false
+		case err := <-t.done:
+			return t.handshakeDone(err)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	select {
+	case <-t.tc.waitCh:
+		t.waiting = true
+		return t.tc.drain(), nil
+	case err := <-t.done:
+		return t.handshakeDone(err)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (t *TLSContext) handshakeDone(err error) ([]byte, error) {
+
+	if err != nil {
+		t.Close()
+		return nil, fmt.Errorf("credssp: tls: handshake: %w", err)
+	}
+
+	t.tc.mu.Lock()
+	t.tc.handshake = false
+	t.tc.mu.Unlock()
+
+	if err := t.checkCipherSuite(); err != nil {
+		t.Close()
+		return nil, err
+	}
+
+	t.established = true
+
+	return t.tc.drain(), nil
+}
+
+// Close function closes the TLS context.
+func (t *TLSContext) Close() error {
+	return t.tc.Close()
+}
+
+// ConnectionState function returns the TLS connection state.
+func (t *TLSContext) ConnectionState() tls.ConnectionState {
+	return t.conn.ConnectionState()
+}
+
+// PeerPublicKey function returns the peer certificate SubjectPublicKey
+// (without the algorithm identifier) used for the public key binding.
+func (t *TLSContext) PeerPublicKey() ([]byte, error) {
+
+	certs := t.conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("credssp: tls: no peer certificate")
+	}
+
+	return SubjectPublicKey(certs[0].RawSubjectPublicKeyInfo)
+}
+
+// SubjectPublicKey function returns the SubjectPublicKey bit string from the
+// DER-encoded SubjectPublicKeyInfo.
+func SubjectPublicKey(spki []byte) ([]byte, error) {
+
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+
+	if _, err := asn1.Unmarshal(spki, &info); err != nil {
+		return nil, fmt.Errorf("credssp: tls: parse subject public key info: %w", err)
+	}
+
+	return info.PublicKey.RightAlign(), nil
+}
+
+// Encrypt function returns the TLS records for the plaintext `b`.
+func (t *TLSContext) Encrypt(b []byte) ([]byte, error) {
+
+	if !t.established {
+		return nil, ErrHandshakeIncomplete
+	}
+
+	if _, err := t.conn.Write(b); err != nil {
+		return nil, fmt.Errorf("credssp: tls: encrypt: %w", err)
+	}
+
+	return t.tc.drain(), nil
+}
+
+// Decrypt function returns the plaintext for the TLS records `b`.
+func (t *TLSContext) Decrypt(b []byte) ([]byte, error) {
+
+	if !t.established {
+		return nil, ErrHandshakeIncomplete
+	}
+
+	t.tc.feed(b)
+
+	var (
+		out = make([]byte, 0, len(b))
+		buf = make([]byte, maxPlaintextSize)
+	)
+
+	for t.tc.pending() > 0 {
+		n, err := t.conn.Read(buf)
+		out = append(out, buf[:n]...)
+		if err != nil {
+			if errors.Is(err, errWouldBlock) {
+				if t.tc.pending() > 0 {
+					// partial record.
+					return nil, ErrInvalidRecord
+				}
+				break
+			}
+			return nil, fmt.Errorf("credssp: tls: decrypt: %w", err)
+		}
+	}
+
+	return out, nil
+}
+
+// checkCipherSuite function checks that the negotiated cipher suite has
+// the fixed record overhead.
+func (t *TLSContext) checkCipherSuite() error {
+
+	cs := t.conn.ConnectionState()
+	if cs.Version != tls.VersionTLS12 {
+		return ErrUnsupportedCipher
+	}
+
+	for _, id := range cipherSuites {
+		if id == cs.CipherSuite {
+			return nil
+		}
+	}
+
+	return ErrUnsupportedCipher
+}
+
+// Seal function encrypts the payloads in place as a single TLS record and
+// returns the record header, explicit nonce and authentication tag as the
+// signature.
+func (t *TLSContext) Seal(payloads [][]byte) ([]byte, error) {
+
+	var plain []byte
+	for _, p := range payloads {
+		plain = append(plain, p...)
+	}
+
+	if len(plain) > maxPlaintextSize {
+		return nil, fmt.Errorf("credssp: tls: seal: payload exceeds maximum record size")
+	}
+
+	rec, err := t.Encrypt(plain)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rec) != len(plain)+signatureSize {
+		return nil, ErrInvalidRecord
+	}
+
+	ct := rec[recordHeaderSize+explicitNonceSize : len(rec)-tagSize]
+	for _, p := range payloads {
+		ct = ct[copy(p, ct):]
+	}
+
+	sgn := make([]byte, 0, signatureSize)
+	sgn = append(sgn, rec[:recordHeaderSize+explicitNonceSize]...)
+	sgn = append(sgn, rec[len(rec)-tagSize:]...)
+
+	return sgn, nil
+}
+
+// Unseal function reconstructs the TLS record from the signature and
+// encrypted payloads and decrypts the payloads in place.
+func (t *TLSContext) Unseal(payloads [][]byte, sgn []byte) error {
+
+	if len(sgn) != signatureSize {
+		return ErrInvalidRecord
+	}
+
+	rec := append([]byte{}, sgn[:recordHeaderSize+explicitNonceSize]...)
+	for _, p := range payloads {
+		rec = append(rec, p...)
+	}
+	rec = append(rec, sgn[recordHeaderSize+explicitNonceSize:]...)
+
+	plain, err := t.Decrypt(rec)
+	if err != nil {
+		return err
+	}
+
+	if len(plain) != len(rec)-signatureSize {
+		return ErrInvalidRecord
+	}
+
+	for _, p := range payloads {
+		plain = plain[copy(p, plain):]
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// package external implements the adapter that plugs the external (custom
+// or platform) security providers into the GSS-API framework, so that the
+// provider can be used by the DCE/RPC and SMB2 clients as any built-in
+// mechanism.
+package external
+
+import (
+	"context"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// Provider interface is the external security provider.
+type Provider interface {
+	// The mechanism type object identifier. The provider can reuse the
+	// identifier of the built-in mechanism (ie Kerberos) if it implements
+	// the same protocol.
+	Type() gssapi.OID
+	// NewSecurityContext function returns the new security context for the
+	// configuration.
+	NewSecurityContext(context.Context, *ContextConfig) (SecurityContext, error)
+}
+
+// ContextConfig structure represents the parameters of the security context
+// passed to the provider.
+type ContextConfig struct {
+	// The credential value (see gssapi.Credential), nil if the credential
+	// was not set for the security context.
+	Credential any
+	// The target name (service principal name).
+	TargetName string
+	// The flag that indicates whether the target name was retrieved
+	// from the untrusted source.
+	TargetNameFromUntrustedSource bool
+	// The requested capabilities.
+	Capabilities gssapi.Cap
+	// The channel bindings (gss_channel_bindings_struct flat representation).
+	ChannelBindings []byte
+	// The flag that indicates whether the security context is the
+	// acceptor (server) security context.
+	IsServer bool
+}
+
+// SecurityContext interface is the security context established by the
+// external provider.
+type SecurityContext interface {
+	// InitSecurityContext function processes the input token and returns the
+	// output token for the server and the flag that indicates whether the
+	// security context is established.
+	InitSecurityContext(ctx context.Context, in []byte) ([]byte, bool, error)
+	// AcceptSecurityContext function processes the input token and returns the
+	// output token for the client and the flag that indicates whether the
+	// security context is established.
+	AcceptSecurityContext(ctx context.Context, in []byte) ([]byte, bool, error)
+	// SignatureSize function returns the size of the signature (with the
+	// confidentiality if `conf` is set).
+	SignatureSize(ctx context.Context, conf bool) int
+	// Wrap function encrypts in place the payloads with gssapi.Confidentiality
+	// capability and returns the signature for the payloads with gssapi.Integrity
+	// capability.
+	Wrap(ctx context.Context, payloads []*gssapi.PayloadEx) ([]byte, error)
+	// Unwrap function decrypts in place the payloads with gssapi.Confidentiality
+	// capability and verifies the signature for the payloads with gssapi.Integrity
+	// capability. The invalid signature must be reported with gssapi.ErrBadMIC.
+	Unwrap(ctx context.Context, payloads []*gssapi.PayloadEx, signature []byte) error
+	// MakeSignature function returns the signature for the payloads.
+	MakeSignature(ctx context.Context, payloads []*gssapi.PayloadEx) ([]byte, error)
+	// VerifySignature function verifies the signature for the payloads. The
+	// invalid signature must be reported with gssapi.ErrBadMIC.
+	VerifySignature(ctx context.Context, payloads []*gssapi.PayloadEx, signature []byte) error
+	// QueryAttributes function returns the security context attributes (ie
+	// gssapi.AttributeSessionKey, gssapi.AttributeServerPrincipal), the attributes
+	// are queried once the security context is established.
+	QueryAttributes(ctx context.Context) map[string]any
+}
+
+// The external provider configuration.
+type Config struct {
+	// The mechanism type object identifier.
+	MechanismType gssapi.OID
+	// The capabilities requested for the security context (in addition
+	// to the capabilities requested with the security context options).
+	Capabilities gssapi.Cap
+}
+
+// Config binding for GSS.
+func (c Config) Type() gssapi.OID {
+	return c.MechanismType
+}
+
+// Copy function returns the copy of the configuration.
+func (c *Config) Copy() gssapi.MechanismConfig {
+	cp := *c
+	return &cp
+}
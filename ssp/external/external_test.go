@@ -0,0 +1,145 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+var testMechanismType = gssapi.OID{1, 3, 6, 1, 4, 1, 99999, 1}
+
+type testProvider struct {
+	cfg *ContextConfig
+}
+
+func (p *testProvider) Type() gssapi.OID {
+	return testMechanismType
+}
+
+func (p *testProvider) NewSecurityContext(ctx context.Context, cfg *ContextConfig) (SecurityContext, error) {
+	p.cfg = cfg
+	return &testSecurityContext{key: []byte(cfg.TargetName)}, nil
+}
+
+type testSecurityContext struct {
+	key  []byte
+	legs int
+}
+
+func (sc *testSecurityContext) InitSecurityContext(ctx context.Context, in []byte) ([]byte, bool, error) {
+	if sc.legs++; sc.legs == 1 {
+		return []byte("negotiate"), false, nil
+	}
+	if !bytes.Equal(in, []byte("challenge")) {
+		return nil, false, errors.New("unexpected token")
+	}
+	return []byte("authenticate"), true, nil
+}
+
+func (sc *testSecurityContext) AcceptSecurityContext(ctx context.Context, in []byte) ([]byte, bool, error) {
+	return nil, false, errors.New("not supported")
+}
+
+func (sc *testSecurityContext) SignatureSize(ctx context.Context, conf bool) int {
+	return sha256.Size
+}
+
+func (sc *testSecurityContext) seal(payloads []*gssapi.PayloadEx) {
+	for _, p := range payloads {
+		if p.Capabilities.IsSet(gssapi.Confidentiality) {
+			for i := range p.Payload {
+				p.Payload[i] ^= 0x5a
+			}
+		}
+	}
+}
+
+func (sc *testSecurityContext) Wrap(ctx context.Context, payloads []*gssapi.PayloadEx) ([]byte, error) {
+	sgn, _ := sc.MakeSignature(ctx, payloads)
+	sc.seal(payloads)
+	return sgn, nil
+}
+
+func (sc *testSecurityContext) Unwrap(ctx context.Context, payloads []*gssapi.PayloadEx, sgn []byte) error {
+	sc.seal(payloads)
+	return sc.VerifySignature(ctx, payloads, sgn)
+}
+
+func (sc *testSecurityContext) MakeSignature(ctx context.Context, payloads []*gssapi.PayloadEx) ([]byte, error) {
+	h := hmac.New(sha256.New, sc.key)
+	for _, p := range payloads {
+		if p.Capabilities.IsSet(gssapi.Integrity) {
+			h.Write(p.Payload)
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+func (sc *testSecurityContext) VerifySignature(ctx context.Context, payloads []*gssapi.PayloadEx, sgn []byte) error {
+	if exp, _ := sc.MakeSignature(ctx, payloads); !hmac.Equal(exp, sgn) {
+		return gssapi.ErrBadMIC
+	}
+	return nil
+}
+
+func (sc *testSecurityContext) QueryAttributes(ctx context.Context) map[string]any {
+	return map[string]any{gssapi.AttributeSessionKey: sc.key}
+}
+
+func TestMechanism(t *testing.T) {
+
+	p := &testProvider{}
+
+	ctx := gssapi.NewSecurityContext(context.Background(), Mechanism{Provider: p}, gssapi.WithCredential("credential"))
+
+	opts := []gssapi.Option{gssapi.WithTargetName("host/server"), gssapi.WithRequest(gssapi.Integrity)}
+
+	tok, err := gssapi.InitSecurityContext(ctx, &gssapi.Token{}, opts...)
+	if err != nil || string(tok.Payload) != "negotiate" || gssapi.IsComplete(ctx) {
+		t.Fatalf("init: %v", err)
+	}
+
+	if p.cfg.TargetName != "host/server" || p.cfg.Credential != "credential" || !p.cfg.Capabilities.IsSet(gssapi.Integrity) {
+		t.Fatalf("unexpected context config: %+v", p.cfg)
+	}
+
+	if tok, err = gssapi.InitSecurityContext(ctx, &gssapi.Token{Payload: []byte("challenge")}, opts...); err != nil || !gssapi.IsComplete(ctx) {
+		t.Fatalf("init: %v", err)
+	}
+
+	if key, _ := gssapi.GetAttribute(ctx, gssapi.AttributeSessionKey); !bytes.Equal(key.([]byte), []byte("host/server")) {
+		t.Fatalf("unexpected session key: %v", key)
+	}
+
+	if sz := gssapi.WrapSizeLimit(ctx, 0); sz != -sha256.Size {
+		t.Fatalf("unexpected wrap size limit: %d", sz)
+	}
+
+	payload := []byte("payload")
+
+	tokEx, err := gssapi.WrapEx(ctx, &gssapi.MessageTokenEx{Payloads: []*gssapi.PayloadEx{
+		{Capabilities: gssapi.Integrity | gssapi.Confidentiality, Payload: payload},
+	}})
+	if err != nil {
+		t.Fatalf("wrap_ex: %v", err)
+	}
+
+	if bytes.Equal(payload, []byte("payload")) {
+		t.Fatalf("payload is not sealed")
+	}
+
+	if _, err = gssapi.UnwrapEx(ctx, tokEx); err != nil || !bytes.Equal(payload, []byte("payload")) {
+		t.Fatalf("unwrap_ex: %v", err)
+	}
+
+	tokEx.Signature[0] ^= 0xff
+
+	if err := gssapi.VerifySignatureEx(ctx, tokEx); !errors.Is(err, gssapi.ErrBadMIC) {
+		t.Fatalf("verify_signature_ex: unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package external
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+var (
+	ErrNoProvider = errors.New("external: provider is not set")
+)
+
+// The external provider GSS API Mechanism. The mechanism factory is
+// the mechanism with the provider set:
+//
+//	dcerpc.WithMechanism(external.Mechanism{Provider: provider})
+type Mechanism struct {
+	// The external provider.
+	Provider Provider
+	// The security context established by the provider.
+	SecurityContext SecurityContext
+}
+
+// The mechanism type object identifier.
+func (m Mechanism) Type() gssapi.OID {
+	if m.Provider == nil {
+		return nil
+	}
+	return m.Provider.Type()
+}
+
+// DefaultConfig function returns the default config.
+func (m Mechanism) DefaultConfig(ctx context.Context) (gssapi.MechanismConfig, error) {
+	return &Config{MechanismType: m.Type()}, nil
+}
+
+// New function returns the new mechanism instance from the GSSAPI configuration.
+func (m Mechanism) New(ctx context.Context) (gssapi.Mechanism, error) {
+
+	if m.Provider == nil {
+		return nil, gssapi.ContextError(ctx, gssapi.BadMech, ErrNoProvider)
+	}
+
+	// extract the context.
+	cc := gssapi.FromContext(ctx)
+
+	// try get the mechanism config base.
+	c, ok := gssapi.GetMechanismConfig(ctx, m.Type()).(*Config)
+	if !ok || c == nil {
+		c = &Config{MechanismType: m.Type()}
+	}
+
+	cfg := &ContextConfig{
+		TargetName:                    cc.TargetName,
+		TargetNameFromUntrustedSource: cc.TargetNameFromUntrustedSource,
+		Capabilities:                  cc.Capabilities | c.Capabilities,
+		IsServer:                      cc.IsServer,
+	}
+
+	if cc.Credential != nil {
+		cfg.Credential = cc.Credential.Value()
+	}
+
+	if cc.ChannelBindings != nil {
+		b, err := cc.ChannelBindings.Marshal()
+		if err != nil {
+			return nil, gssapi.ContextError(ctx, gssapi.BadBindings, gssapi.ErrBadBindings)
+		}
+		cfg.ChannelBindings = b
+	}
+
+	sc, err := m.Provider.NewSecurityContext(ctx, cfg)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	return &Mechanism{Provider: m.Provider, SecurityContext: sc}, nil
+}
+
+// The security context init call.
+func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token, error) {
+
+	b, ok, err := m.SecurityContext.InitSecurityContext(ctx, tok.Payload)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	return m.complete(ctx, b, ok)
+}
+
+// The security context accept call.
+func (m *Mechanism) Accept(ctx context.Context, tok *gssapi.Token) (*gssapi.Token, error) {
+
+	b, ok, err := m.SecurityContext.AcceptSecurityContext(ctx, tok.Payload)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	return m.complete(ctx, b, ok)
+}
+
+// complete function sets the security context status and saves the security
+// context attributes once the context is established.
+func (m *Mechanism) complete(ctx context.Context, b []byte, ok bool) (*gssapi.Token, error) {
+
+	if !ok {
+		return &gssapi.Token{Payload: b}, gssapi.ContextContinueNeeded(ctx)
+	}
+
+	for name, value := range m.SecurityContext.QueryAttributes(ctx) {
+		gssapi.SetAttribute(ctx, name, value)
+	}
+
+	return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
+}
+
+// The maximum message size for the given limit. (and flag determining if
+// conf is required).
+func (m *Mechanism) WrapSizeLimit(ctx context.Context, sz int, conf bool) int {
+	return sz - m.SecurityContext.SignatureSize(ctx, conf)
+}
+
+// WrapEx function accepts the list of unencrypted payloads and returns the
+// encrypted payload and signature.
+func (m *Mechanism) WrapEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+
+	sgn, err := m.SecurityContext.Wrap(ctx, tokEx.Payloads)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	tokEx.Signature = sgn
+
+	return tokEx, gssapi.ContextComplete(ctx)
+}
+
+// UnwrapEx function accepts the list of encrypted payloads and signature and
+// returns the unencrypted paylaod.
+func (m *Mechanism) UnwrapEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+
+	if err := m.SecurityContext.Unwrap(ctx, tokEx.Payloads, tokEx.Signature); err != nil {
+		return nil, contextError(ctx, err)
+	}
+
+	return tokEx, gssapi.ContextComplete(ctx)
+}
+
+// MakeSignatureEx function accepts the list of payloads and returns the
+// signature for the payload.
+func (m *Mechanism) MakeSignatureEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+
+	sgn, err := m.SecurityContext.MakeSignature(ctx, tokEx.Payloads)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	tokEx.Signature = sgn
+
+	return tokEx, gssapi.ContextComplete(ctx)
+}
+
+// VerifySignatureEx function accepts the list of payloads and signature
+// and returns nil if signature is valid.
+func (m *Mechanism) VerifySignatureEx(ctx context.Context, tokEx *gssapi.MessageTokenEx) error {
+
+	if err := m.SecurityContext.VerifySignature(ctx, tokEx.Payloads, tokEx.Signature); err != nil {
+		return contextError(ctx, err)
+	}
+
+	return gssapi.ContextComplete(ctx)
+}
+
+// Wrap token.
+func (m *Mechanism) Wrap(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+
+	tokEx, err := m.WrapEx(ctx, toMessageTokenEx(tok, gssapi.Integrity))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromMessageTokenEx(tokEx), nil
+}
+
+// Unwrap token.
+func (m *Mechanism) Unwrap(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+
+	tokEx, err := m.UnwrapEx(ctx, toMessageTokenEx(tok, gssapi.Integrity))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromMessageTokenEx(tokEx), nil
+}
+
+// MakeSignature token.
+func (m *Mechanism) MakeSignature(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+
+	tokEx, err := m.MakeSignatureEx(ctx, toMessageTokenEx(tok, gssapi.Integrity))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromMessageTokenEx(tokEx), nil
+}
+
+// VerifySignature token.
+func (m *Mechanism) VerifySignature(ctx context.Context, tok *gssapi.MessageToken) error {
+	return m.VerifySignatureEx(ctx, toMessageTokenEx(tok, gssapi.Integrity))
+}
+
+// toMessageTokenEx function converts the message token into the extended
+// message token with the single payload.
+func toMessageTokenEx(tok *gssapi.MessageToken, caps gssapi.Cap) *gssapi.MessageTokenEx {
+	return &gssapi.MessageTokenEx{
+		QoP: tok.QoP,
+		Payloads: []*gssapi.PayloadEx{
+			{Capabilities: tok.Capabilities | caps, Payload: tok.Payload},
+		},
+		Signature: tok.Signature,
+	}
+}
+
+// fromMessageTokenEx function converts the extended message token with the
+// single payload into the message token.
+func fromMessageTokenEx(tokEx *gssapi.MessageTokenEx) *gssapi.MessageToken {
+	return &gssapi.MessageToken{
+		QoP:          tokEx.QoP,
+		Capabilities: tokEx.Payloads[0].Capabilities,
+		Payload:      tokEx.Payloads[0].Payload,
+		Signature:    tokEx.Signature,
+	}
+}
+
+// contextError function sets the context error for the signature verification
+// failure.
+func contextError(ctx context.Context, err error) error {
+	if errors.Is(err, gssapi.ErrBadMIC) {
+		return gssapi.ContextError(ctx, gssapi.BadMIC, err)
+	}
+	return gssapi.ContextError(ctx, gssapi.Failure, err)
+}
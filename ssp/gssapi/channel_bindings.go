@@ -0,0 +1,104 @@
+package gssapi
+
+// channel_bindings.go module contains the GSS-API channel bindings structure
+// (RFC 2744 section 3.11) and the TLS channel binding types (RFC 5929).
+
+import (
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+var (
+	ErrNoChannelBindings = errors.New("gssapi: channel bindings are not available")
+)
+
+const (
+	// The tls-server-end-point channel binding type prefix.
+	TLSServerEndPointPrefix = "tls-server-end-point:"
+)
+
+// ChannelBindingsStruct structure represents the GSS-API channel bindings
+// (gss_channel_bindings_struct). The Microsoft SSPs use only the application
+// data, the addresses are left empty.
+type ChannelBindingsStruct struct {
+	// The initiator address type.
+	InitiatorAddrType uint32
+	// The initiator address.
+	InitiatorAddress []byte
+	// The acceptor address type.
+	AcceptorAddrType uint32
+	// The acceptor address.
+	AcceptorAddress []byte
+	// The application data (channel binding type prefix and the
+	// channel binding data).
+	ApplicationData []byte
+}
+
+// Marshal function returns the flat channel bindings representation, that
+// is the input for the channel bindings hash (NTLM MsvAvChannelBindings,
+// Kerberos authenticator checksum).
+func (cb *ChannelBindingsStruct) Marshal() ([]byte, error) {
+
+	b := make([]byte, 0, 20+len(cb.InitiatorAddress)+len(cb.AcceptorAddress)+len(cb.ApplicationData))
+
+	b = binary.LittleEndian.AppendUint32(b, cb.InitiatorAddrType)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(cb.InitiatorAddress)))
+	b = append(b, cb.InitiatorAddress...)
+	b = binary.LittleEndian.AppendUint32(b, cb.AcceptorAddrType)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(cb.AcceptorAddress)))
+	b = append(b, cb.AcceptorAddress...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(cb.ApplicationData)))
+	b = append(b, cb.ApplicationData...)
+
+	return b, nil
+}
+
+// NewApplicationChannelBindings function returns the channel bindings
+// with application data only.
+func NewApplicationChannelBindings(data []byte) *ChannelBindingsStruct {
+	return &ChannelBindingsStruct{ApplicationData: append([]byte{}, data...)}
+}
+
+// TLSServerEndPoint function returns the tls-server-end-point channel
+// bindings for the server certificate. (the certificate hash function is
+// the certificate signature hash function, or SHA-256 for MD5 and SHA-1).
+func TLSServerEndPoint(cert *x509.Certificate) *ChannelBindingsStruct {
+
+	var h crypto.Hash
+
+	switch cert.SignatureAlgorithm {
+	case x509.SHA384WithRSA, x509.ECDSAWithSHA384, x509.SHA384WithRSAPSS:
+		h = crypto.SHA384
+	case x509.SHA512WithRSA, x509.ECDSAWithSHA512, x509.SHA512WithRSAPSS:
+		h = crypto.SHA512
+	default:
+		h = crypto.SHA256
+	}
+
+	hh := h.New()
+	hh.Write(cert.Raw)
+
+	return NewApplicationChannelBindings(hh.Sum([]byte(TLSServerEndPointPrefix)))
+}
+
+// TLSChannelBindings function returns the tls-server-end-point channel
+// bindings for the established TLS connection.
+func TLSChannelBindings(cs tls.ConnectionState) (*ChannelBindingsStruct, error) {
+	if !cs.HandshakeComplete || len(cs.PeerCertificates) == 0 {
+		return nil, ErrNoChannelBindings
+	}
+	return TLSServerEndPoint(cs.PeerCertificates[0]), nil
+}
+
+// WithChannelBindings returns the option of the channel bindings.
+func WithChannelBindings(cb ChannelBindings) Option {
+	return func(o *Config) {
+		o.ChannelBindings = cb
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 )
 
+// ChannelBindings interface represents the channel bindings
+// (see ChannelBindingsStruct).
 type ChannelBindings interface {
 	Marshal() ([]byte, error)
 }
@@ -210,6 +212,7 @@ func InitSecurityContext(ctx context.Context, tok *Token, opts ...Option) (*Toke
 		cc.TargetName = cfg.TargetName
 		cc.TargetNameFromUntrustedSource = cfg.TargetNameFromUntrustedSource
 		cc.MechanismConfigs = cfg.MechanismConfigs
+		cc.ChannelBindings = cfg.ChannelBindings
 
 		f := GetMechanism(ctx, cfg.MechanismType)
 		if f == nil {
@@ -241,6 +244,11 @@ func InitSecurityContext(ctx context.Context, tok *Token, opts ...Option) (*Toke
 		cc.Status = Complete
 	}
 
+	if _, ok := cc.Attributes[AttributeMechanismType]; !ok && cc.Status == Complete {
+		// the mechanism that established the context.
+		SetAttribute(ctx, AttributeMechanismType, cc.Mechanism.Type())
+	}
+
 	if tok != nil {
 		return tok, nil
 	}
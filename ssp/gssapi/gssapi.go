@@ -104,6 +104,23 @@ var (
 	AttributeSessionKey = "session_key"
 	AttributeTarget     = "target"
 	AttributeRPCContext = "rpc_security_context"
+	// The server principal name the security context was established
+	// with (string).
+	AttributeServerPrincipal = "server_principal"
+	// The flag that indicates whether the server was authenticated by
+	// the security context (bool).
+	AttributeMutualAuthn = "mutual_authn"
+	// The expiration time of the security context (time.Time), for
+	// Kerberos, the service ticket end time.
+	AttributeExpiry = "expiry"
+	// The mechanism type of the established security context (OID), for
+	// SPNEGO, the negotiated mechanism type.
+	AttributeMechanismType = "mechanism_type"
+	// The flag that indicates whether the security context is anonymous
+	// (bool).
+	AttributeAnonymous = "anonymous"
+	// The NTLM version (1 or 2) of the established NTLM security context.
+	AttributeNTLMVersion = "ntlm_version"
 )
 
 // The GSSAPI call option.
@@ -125,6 +142,8 @@ type Config struct {
 	MechanismType OID
 	// The list of mechanism configs.
 	MechanismConfigs []MechanismConfig
+	// The channel bindings.
+	ChannelBindings ChannelBindings
 	// The flag that indicates whether it's a server
 	// handle.
 	IsServer bool
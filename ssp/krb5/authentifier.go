@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jcmturner/gokrb5/v8/client"
 	"github.com/jcmturner/gokrb5/v8/credentials"
@@ -37,6 +38,8 @@ type Authentifier struct {
 	SessionKey types.EncryptionKey
 	// Exported session key.
 	ExportedSessionKey []byte
+	// The service ticket end time.
+	Expiry time.Time
 	// key.
 	state *SecurityService
 
@@ -44,6 +47,10 @@ type Authentifier struct {
 	// are available, this variable keeps a reference to this ccache because the
 	// gokrb5 client will not accept such a ccache file.
 	ccacheWithoutTGT *credentials.CCache
+
+	// The ticket-granting ticket obtained with the FAST armored AS exchange,
+	// used for the armored TGS exchanges.
+	fastTGT *fastTGT
 }
 
 type SecurityService struct {
@@ -83,6 +90,10 @@ func (a *Authentifier) tryLoadCCache(ctx context.Context) (*credentials.CCache,
 func (a *Authentifier) makeClient(ctx context.Context) (*client.Client, error) {
 	var cli *client.Client
 
+	if credential.IsDestroyed(a.Config.Credential) {
+		return nil, credential.ErrDestroyed
+	}
+
 	// try load credentials cache.
 	cc, ok, err := a.tryLoadCCache(ctx)
 	if err != nil {
@@ -110,10 +121,45 @@ func (a *Authentifier) makeClient(ctx context.Context) (*client.Client, error) {
 			a.Config.KRB5Config, a.Config.ClientSettings()...)
 	}
 
-	if pwd, ok := a.Config.Credential.(credential.Password); ok {
+	if pwd, ok := a.Config.Credential.(credential.Password); ok && credential.IsSecure(pwd) {
+		// the keys are derived from the password kept in the locked memory
+		// (the kerberos client keeps the password string otherwise).
+		keys, err := passwordKeys(ctx, cli.Config, pwd, creds.CName(), creds.Realm(), cli.Config.LibDefaults.DefaultTktEnctypeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("password credential: %w", err)
+		}
+		cli.Credentials = WithEncryptionKeys(creds, keys, 0)
+	} else if pwd, ok := a.Config.Credential.(credential.Password); ok {
 		cli.Credentials = creds.WithPassword(pwd.Password())
 	} else if kt, ok := a.Config.Credential.(credential.Keytab); ok {
-		cli.Credentials = creds.WithKeytab(kt.Keytab())
+		if kt, ok := kt.(interface{ Err() error }); ok && kt.Err() != nil {
+			return nil, fmt.Errorf("keytab credential: %w", kt.Err())
+		}
+		ktab, err := SelectKeytab(kt.Keytab(), creds.CName(), creds.Realm(), kt.KVNO())
+		if err != nil {
+			return nil, fmt.Errorf("keytab credential: %w", err)
+		}
+		cli.Credentials = creds.WithKeytab(ktab)
+		// request the encryption types that have the key in the keytab.
+		if etypes := KeytabETypes(ktab, cli.Config.LibDefaults.DefaultTktEnctypeIDs); len(etypes) > 0 {
+			cfg := *cli.Config
+			cfg.LibDefaults.DefaultTktEnctypeIDs = etypes
+			cli.Config = &cfg
+		}
+	} else if keys, ok := a.Config.Credential.(credential.EncryptionKeys); ok {
+		cli.Credentials = WithEncryptionKeys(creds, keys.EncryptionKeys(), keys.KVNO())
+		// request the encryption types that have the key.
+		cfg := *cli.Config
+		if etypes := KeytabETypes(cli.Credentials.Keytab(), cfg.LibDefaults.DefaultTktEnctypeIDs); len(etypes) > 0 {
+			cfg.LibDefaults.DefaultTktEnctypeIDs = etypes
+		} else {
+			// the key encryption types are not enabled (ie, rc4-hmac).
+			etypes = KeytabETypes(cli.Credentials.Keytab(), []int32{etypeID.AES256_CTS_HMAC_SHA1_96, etypeID.AES128_CTS_HMAC_SHA1_96, etypeID.RC4_HMAC})
+			cfg.LibDefaults.DefaultTGSEnctypeIDs = etypes
+			cfg.LibDefaults.DefaultTktEnctypeIDs = etypes
+			cfg.LibDefaults.PermittedEnctypeIDs = etypes
+		}
+		cli.Config = &cfg
 	} else if ntHash, ok := a.Config.Credential.(credential.NTHash); ok {
 		cli.Credentials = WithNTHash(creds, ntHash.NTHash(), ntHash.KVNO())
 		// XXX: add rc4-hmac to allowed etypes.
@@ -125,6 +171,21 @@ func (a *Authentifier) makeClient(ctx context.Context) (*client.Client, error) {
 		if err != nil {
 			return nil, fmt.Errorf("client from ccache credential: %w", err)
 		}
+	} else if cert, ok := a.Config.Credential.(credential.Certificate); ok {
+		cc, err := PKINIT(ctx, a.Config, cert)
+		if err != nil {
+			return nil, fmt.Errorf("certificate credential: %w", err)
+		}
+		cli, err = client.NewFromCCache(cc, a.Config.KRB5Config, a.Config.ClientSettings()...)
+		if err != nil {
+			return nil, fmt.Errorf("client from certificate credential: %w", err)
+		}
+	}
+
+	if a.Config.FASTArmor != nil {
+		if cli, err = a.fastLogin(ctx, cli); err != nil {
+			return nil, err
+		}
 	}
 
 	_, err = cli.IsConfigured()
@@ -215,7 +276,7 @@ func (a *Authentifier) affirmLogin(ctx context.Context) error {
 	return nil
 }
 
-func (a *Authentifier) getServiceTicket(sname string) (messages.Ticket, types.EncryptionKey, error) {
+func (a *Authentifier) getServiceTicket(ctx context.Context, sname string) (messages.Ticket, types.EncryptionKey, error) {
 	if a.ccacheWithoutTGT != nil {
 		// the client is not configured for pre-authentication so we can only
 		// obtain service tickets from the ccache.
@@ -235,9 +296,37 @@ func (a *Authentifier) getServiceTicket(sname string) (messages.Ticket, types.En
 			return tkt, c.Key, fmt.Errorf("unmarshal ticket from CCACHE")
 		}
 
+		a.Expiry = c.EndTime
+
 		return tkt, c.Key, nil
 	}
 
+	if a.fastTGT != nil {
+		// the tgs exchanges are armored with the fast tgt.
+		tgt := a.fastTGT
+		if a.Config.Impersonate != "" {
+			tkt, key, err := getServiceTicketForUser(ctx, a.client.Config, tgt.Ticket, tgt.Key, tgt.CName, tgt.CRealm,
+				a.Config.Impersonate, sname, true)
+			if err != nil {
+				return tkt, key, fmt.Errorf("krb5: init: apreq: get service ticket for user: %w", err)
+			}
+			return tkt, key, nil
+		}
+		tkt, key, err := requestServiceTicket(ctx, a.client.Config, tgt.Ticket, tgt.Key, tgt.CName, sname, true)
+		if err != nil {
+			return tkt, key, fmt.Errorf("krb5: init: apreq: get service ticket: %w", err)
+		}
+		return tkt, key, nil
+	}
+
+	if a.Config.Impersonate != "" {
+		tkt, key, err := GetServiceTicketForUser(ctx, a.client, a.Config.Impersonate, sname)
+		if err != nil {
+			return tkt, key, fmt.Errorf("krb5: init: apreq: get service ticket for user: %w", err)
+		}
+		return tkt, key, nil
+	}
+
 	tkt, key, err := a.client.GetServiceTicket(a.Config.SName)
 	if err != nil {
 		return tkt, key, fmt.Errorf("krb5: init: apreq: get service ticket: %w", err)
@@ -258,16 +347,35 @@ func (a *Authentifier) APRequest(ctx context.Context) ([]byte, error) {
 		return nil, fmt.Errorf("krb5: init: apreq: affirm login: %w", err)
 	}
 
-	tkt, key, err := a.getServiceTicket(a.Config.SName)
+	tkt, key, err := a.getServiceTicket(ctx, a.Config.SName)
 	if err != nil {
 		return nil, err
 	}
 
-	tok, err := spnego.NewKRB5TokenAPREQ(a.client, tkt, key, a.Config.Flags, a.Config.APOptions)
+	if a.Expiry.IsZero() && a.client.Config != nil {
+		// the end time of the service ticket obtained by the client is not
+		// exposed, estimate it with the configured ticket lifetime.
+		a.Expiry = time.Now().Add(a.client.Config.LibDefaults.TicketLifetime)
+	}
+
+	cli = a.client
+	if a.Config.Impersonate != "" {
+		// the authenticator is built for the impersonated user.
+		user, realm := splitPrincipal(a.Config.Impersonate, a.client.Credentials.Domain())
+		cli = &client.Client{Credentials: credentials.New(user.PrincipalNameString(), realm)}
+	}
+
+	tok, err := spnego.NewKRB5TokenAPREQ(cli, tkt, key, a.Config.Flags, a.Config.APOptions)
 	if err != nil {
 		return nil, fmt.Errorf("krb5: init: apreq: call new_krb5_token_apreq: %w", err)
 	}
 
+	if len(a.Config.ChannelBindings) > 0 {
+		if tok.APReq, err = newAPReqWithBindings(cli, tkt, key, a.Config.Flags, a.Config.APOptions, a.Config.ChannelBindings); err != nil {
+			return nil, fmt.Errorf("krb5: init: apreq: channel bindings: %w", err)
+		}
+	}
+
 	a.APReq, a.SessionKey = (*APReq)(&tok.APReq), key
 
 	if err := a.APReq.DecryptAuthenticator(a.SessionKey); err != nil {
@@ -291,11 +399,21 @@ func (a *Authentifier) APRequest(ctx context.Context) ([]byte, error) {
 	return b, nil
 }
 
+// ServerPrincipal function returns the principal name of the service ticket
+// ("service/host@REALM").
+func (a *Authentifier) ServerPrincipal() string {
+	if a.APReq == nil {
+		return ""
+	}
+	return a.APReq.Ticket.SName.PrincipalNameString() + "@" + a.APReq.Ticket.Realm
+}
+
 func (a *Authentifier) APReply(ctx context.Context, b []byte) ([]byte, error) {
 	if len(b) == 0 {
 		if err := a.makeSecurityService(ctx); err != nil {
 			return nil, fmt.Errorf("krb5: init: aprep: make security service: %w", err)
 		}
+		a.destroyCredential()
 		return nil, nil
 	}
 
@@ -326,9 +444,30 @@ func (a *Authentifier) APReply(ctx context.Context, b []byte) ([]byte, error) {
 		return nil, fmt.Errorf("krb5: aprep: make security service: %w", err)
 	}
 
+	a.destroyCredential()
+
 	return b, nil
 }
 
+// destroyCredential function wipes the credential secrets and drops the
+// client tickets and the session keys other than the security context
+// session key once the security context is established (see
+// DestroyCredential).
+func (a *Authentifier) destroyCredential() {
+
+	if !a.Config.DestroyCredential {
+		return
+	}
+
+	if a.client != nil {
+		a.client.Destroy()
+	}
+
+	a.client, a.fastTGT, a.ccacheWithoutTGT = nil, nil, nil
+
+	credential.Destroy(a.Config.Credential)
+}
+
 func (a *Authentifier) MakeOutboundSignature(ctx context.Context, forSign [][]byte) ([]byte, error) {
 	sgn, err := a.state.OutboundCipher.MakeSignature(ctx, a.state.OutboundSequenceNumber, forSign)
 	if err != nil {
@@ -0,0 +1,66 @@
+package krb5
+
+// channel_bindings.go module contains the AP-REQ authenticator with the
+// channel bindings checksum (RFC 4121 section 4.1.1).
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/gssapi"
+	"github.com/jcmturner/gokrb5/v8/iana/chksumtype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+)
+
+// authenticatorChecksum function returns the GSS-API authenticator checksum
+// with the channel bindings hash `cb` and context flags.
+func authenticatorChecksum(cb []byte, flags []int) []byte {
+
+	b := make([]byte, 24)
+
+	// the length of the channel bindings hash.
+	binary.LittleEndian.PutUint32(b[:4], 16)
+
+	if len(cb) > 0 {
+		sum := md5.Sum(cb)
+		copy(b[4:20], sum[:])
+	}
+
+	for _, flag := range flags {
+		if flag == gssapi.ContextFlagDeleg && len(b) == 24 {
+			// the empty delegation option and length.
+			b = append(b, make([]byte, 4)...)
+		}
+		binary.LittleEndian.PutUint32(b[20:24], binary.LittleEndian.Uint32(b[20:24])|uint32(flag))
+	}
+
+	return b
+}
+
+// newAPReqWithBindings function returns the AP-REQ with the authenticator
+// checksum that includes the channel bindings hash.
+func newAPReqWithBindings(cli *client.Client, tkt messages.Ticket, key types.EncryptionKey, flags, apOptions []int, cb []byte) (messages.APReq, error) {
+
+	auth, err := types.NewAuthenticator(cli.Credentials.Domain(), cli.Credentials.CName())
+	if err != nil {
+		return messages.APReq{}, err
+	}
+
+	auth.Cksum = types.Checksum{
+		CksumType: chksumtype.GSSAPI,
+		Checksum:  authenticatorChecksum(cb, flags),
+	}
+
+	apReq, err := messages.NewAPReq(tkt, key, auth)
+	if err != nil {
+		return messages.APReq{}, err
+	}
+
+	for _, o := range apOptions {
+		types.SetFlag(&apReq.APOptions, o)
+	}
+
+	return apReq, nil
+}
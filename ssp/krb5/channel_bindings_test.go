@@ -0,0 +1,35 @@
+package krb5
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"testing"
+
+	"github.com/jcmturner/gokrb5/v8/gssapi"
+
+	gss "github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+func TestAuthenticatorChecksum(t *testing.T) {
+
+	cb, _ := gss.NewApplicationChannelBindings([]byte("tls-server-end-point:hash")).Marshal()
+
+	b := authenticatorChecksum(cb, []int{gssapi.ContextFlagInteg, gssapi.ContextFlagConf, gssapi.ContextFlagDeleg})
+
+	if len(b) != 28 || binary.LittleEndian.Uint32(b) != 16 {
+		t.Fatalf("checksum: unexpected length %d", len(b))
+	}
+
+	if sum := md5.Sum(cb); !bytes.Equal(b[4:20], sum[:]) {
+		t.Fatalf("checksum: unexpected channel bindings hash %x", b[4:20])
+	}
+
+	if flags := binary.LittleEndian.Uint32(b[20:]); flags != uint32(gssapi.ContextFlagInteg|gssapi.ContextFlagConf|gssapi.ContextFlagDeleg) {
+		t.Fatalf("checksum: unexpected flags %x", flags)
+	}
+
+	if b := authenticatorChecksum(nil, nil); !bytes.Equal(b[4:20], make([]byte, 16)) {
+		t.Fatalf("checksum: unexpected empty channel bindings hash %x", b[4:20])
+	}
+}
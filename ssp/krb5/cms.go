@@ -0,0 +1,403 @@
+package krb5
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// cms.go module contains the minimal CMS (RFC 5652) SignedData implementation
+// used by the PKINIT exchange: the client signs the AuthPack and verifies the
+// KDC signature over the KDCDHKeyInfo.
+
+var (
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	oidSHA256WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+)
+
+// contentInfo structure represents the CMS ContentInfo.
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+// signedData structure represents the CMS SignedData.
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+// encapContentInfo structure represents the CMS EncapsulatedContentInfo.
+type encapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,optional,tag:0"`
+}
+
+// signerInfo structure represents the CMS SignerInfo.
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+// issuerAndSerialNumber structure represents the CMS IssuerAndSerialNumber.
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+// attribute structure represents the CMS Attribute.
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// signCMS function returns the DER-encoded ContentInfo with the SignedData
+// that encapsulates the `content` of the `contentType`, signed with the
+// `signer` (RSA or ECDSA) key of the certificate `cert`.
+func signCMS(contentType asn1.ObjectIdentifier, content []byte, cert *x509.Certificate, signer crypto.Signer) ([]byte, error) {
+
+	var sigAlg pkix.AlgorithmIdentifier
+
+	switch signer.Public().(type) {
+	case *rsa.PublicKey:
+		sigAlg = pkix.AlgorithmIdentifier{Algorithm: oidSHA256WithRSA, Parameters: asn1.NullRawValue}
+	case *ecdsa.PublicKey:
+		sigAlg = pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA256}
+	default:
+		return nil, fmt.Errorf("cms: unsupported key type %T", signer.Public())
+	}
+
+	digest := crypto.SHA256.New()
+	digest.Write(content)
+
+	attrs, err := marshalAttributes(
+		attribute{Type: oidContentType, Values: []asn1.RawValue{rawValue(contentType)}},
+		attribute{Type: oidMessageDigest, Values: []asn1.RawValue{rawValue(digest.Sum(nil))}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cms: marshal attributes: %w", err)
+	}
+
+	digest = crypto.SHA256.New()
+	digest.Write(attrs)
+
+	sig, err := signer.Sign(rand.Reader, digest.Sum(nil), crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("cms: sign: %w", err)
+	}
+
+	sid, err := asn1.Marshal(issuerAndSerialNumber{
+		Issuer:       asn1.RawValue{FullBytes: cert.RawIssuer},
+		SerialNumber: cert.SerialNumber,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cms: marshal signer identifier: %w", err)
+	}
+
+	sd := signedData{
+		Version:          3,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: oidSHA256}},
+		EncapContentInfo: encapContentInfo{EContentType: contentType, EContent: content},
+		Certificates: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        0,
+			IsCompound: true,
+			Bytes:      cert.Raw,
+		},
+		SignerInfos: []signerInfo{{
+			Version:         1,
+			SID:             asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256},
+			// [0] IMPLICIT SET OF Attribute.
+			SignedAttrs: asn1.RawValue{
+				Class:      asn1.ClassContextSpecific,
+				Tag:        0,
+				IsCompound: true,
+				Bytes:      attrs[headerLen(attrs):],
+			},
+			SignatureAlgorithm: sigAlg,
+			Signature:          sig,
+		}},
+	}
+
+	b, err := asn1.Marshal(sd)
+	if err != nil {
+		return nil, fmt.Errorf("cms: marshal signed data: %w", err)
+	}
+
+	// [0] EXPLICIT (the raw value is marshaled as is).
+	ci := contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: b},
+	}
+
+	if b, err = asn1.Marshal(ci); err != nil {
+		return nil, fmt.Errorf("cms: marshal content info: %w", err)
+	}
+
+	return b, nil
+}
+
+// verifyCMS function parses the DER-encoded ContentInfo with the SignedData,
+// verifies the signature and returns the encapsulated content of the
+// `contentType` and the signer certificate. The signer certificate chain is
+// verified with `opts` unless `opts` is nil.
+func verifyCMS(b []byte, contentType asn1.ObjectIdentifier, opts *x509.VerifyOptions) ([]byte, *x509.Certificate, error) {
+
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(b, &ci); err != nil {
+		return nil, nil, fmt.Errorf("cms: unmarshal content info: %w", err)
+	}
+
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, nil, fmt.Errorf("cms: unexpected content type %s", ci.ContentType)
+	}
+
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, nil, fmt.Errorf("cms: unmarshal signed data: %w", err)
+	}
+
+	if !sd.EncapContentInfo.EContentType.Equal(contentType) {
+		return nil, nil, fmt.Errorf("cms: unexpected encapsulated content type %s", sd.EncapContentInfo.EContentType)
+	}
+
+	if len(sd.SignerInfos) != 1 {
+		return nil, nil, fmt.Errorf("cms: unexpected number of signers %d", len(sd.SignerInfos))
+	}
+
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cms: parse certificates: %w", err)
+	}
+
+	si, content := sd.SignerInfos[0], sd.EncapContentInfo.EContent
+
+	cert, err := findSigner(certs, si.SID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hash, err := hashAlgorithm(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signed := content
+
+	if len(si.SignedAttrs.Bytes) > 0 {
+
+		var attrs []attribute
+		if _, err := asn1.UnmarshalWithParams(si.SignedAttrs.FullBytes, &attrs, "set,tag:0"); err != nil {
+			return nil, nil, fmt.Errorf("cms: unmarshal signed attributes: %w", err)
+		}
+
+		digest := hash.New()
+		digest.Write(content)
+
+		if err := checkAttributes(attrs, contentType, digest.Sum(nil)); err != nil {
+			return nil, nil, err
+		}
+
+		// the signature covers the attributes with the SET OF tag.
+		signed = append([]byte{0x31}, si.SignedAttrs.FullBytes[1:]...)
+	}
+
+	sigAlg, err := signatureAlgorithm(cert, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := cert.CheckSignature(sigAlg, signed, si.Signature); err != nil {
+		return nil, nil, fmt.Errorf("cms: verify signature: %w", err)
+	}
+
+	if opts != nil {
+
+		vopts := *opts
+		if vopts.Intermediates == nil {
+			vopts.Intermediates = x509.NewCertPool()
+		}
+
+		for _, c := range certs {
+			if c != cert {
+				vopts.Intermediates.AddCert(c)
+			}
+		}
+
+		if len(vopts.KeyUsages) == 0 {
+			vopts.KeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageAny}
+		}
+
+		if _, err := cert.Verify(vopts); err != nil {
+			return nil, nil, fmt.Errorf("cms: verify certificate: %w", err)
+		}
+	}
+
+	return content, cert, nil
+}
+
+// findSigner function returns the certificate identified by the signer
+// identifier (issuer and serial number or subject key identifier).
+func findSigner(certs []*x509.Certificate, sid asn1.RawValue) (*x509.Certificate, error) {
+
+	if sid.Class == asn1.ClassContextSpecific && sid.Tag == 0 {
+		// [0] SubjectKeyIdentifier.
+		for _, cert := range certs {
+			if bytes.Equal(cert.SubjectKeyId, sid.Bytes) {
+				return cert, nil
+			}
+		}
+		return nil, fmt.Errorf("cms: signer certificate not found")
+	}
+
+	var ias issuerAndSerialNumber
+	if _, err := asn1.Unmarshal(sid.FullBytes, &ias); err != nil {
+		return nil, fmt.Errorf("cms: unmarshal signer identifier: %w", err)
+	}
+
+	for _, cert := range certs {
+		if bytes.Equal(cert.RawIssuer, ias.Issuer.FullBytes) && cert.SerialNumber.Cmp(ias.SerialNumber) == 0 {
+			return cert, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cms: signer certificate not found")
+}
+
+// checkAttributes function verifies the content type and the message digest
+// signed attributes.
+func checkAttributes(attrs []attribute, contentType asn1.ObjectIdentifier, digest []byte) error {
+
+	var hasContentType, hasDigest bool
+
+	for _, attr := range attrs {
+		if len(attr.Values) != 1 {
+			continue
+		}
+		switch {
+		case attr.Type.Equal(oidContentType):
+			var oid asn1.ObjectIdentifier
+			if _, err := asn1.Unmarshal(attr.Values[0].FullBytes, &oid); err != nil || !oid.Equal(contentType) {
+				return fmt.Errorf("cms: content type attribute mismatch")
+			}
+			hasContentType = true
+		case attr.Type.Equal(oidMessageDigest):
+			var md []byte
+			if _, err := asn1.Unmarshal(attr.Values[0].FullBytes, &md); err != nil || !bytes.Equal(md, digest) {
+				return fmt.Errorf("cms: message digest attribute mismatch")
+			}
+			hasDigest = true
+		}
+	}
+
+	if !hasContentType || !hasDigest {
+		return fmt.Errorf("cms: missing content type or message digest attribute")
+	}
+
+	return nil
+}
+
+// hashAlgorithm function returns the hash function for the digest algorithm.
+func hashAlgorithm(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA1):
+		return crypto.SHA1, nil
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("cms: unsupported digest algorithm %s", oid)
+}
+
+// signatureAlgorithm function returns the x509 signature algorithm for the
+// certificate public key and the hash function.
+func signatureAlgorithm(cert *x509.Certificate, hash crypto.Hash) (x509.SignatureAlgorithm, error) {
+
+	algs := map[x509.PublicKeyAlgorithm]map[crypto.Hash]x509.SignatureAlgorithm{
+		x509.RSA: {
+			crypto.SHA1:   x509.SHA1WithRSA,
+			crypto.SHA256: x509.SHA256WithRSA,
+			crypto.SHA384: x509.SHA384WithRSA,
+			crypto.SHA512: x509.SHA512WithRSA,
+		},
+		x509.ECDSA: {
+			crypto.SHA1:   x509.ECDSAWithSHA1,
+			crypto.SHA256: x509.ECDSAWithSHA256,
+			crypto.SHA384: x509.ECDSAWithSHA384,
+			crypto.SHA512: x509.ECDSAWithSHA512,
+		},
+	}
+
+	if alg, ok := algs[cert.PublicKeyAlgorithm][hash]; ok {
+		return alg, nil
+	}
+
+	return 0, fmt.Errorf("cms: unsupported signature algorithm %s with %s", cert.PublicKeyAlgorithm, hash)
+}
+
+// marshalAttributes function returns the DER-encoded SET OF attributes (the
+// elements are sorted as required by DER).
+func marshalAttributes(attrs ...attribute) ([]byte, error) {
+
+	elems := make([][]byte, len(attrs))
+	for i := range attrs {
+		b, err := asn1.Marshal(attrs[i])
+		if err != nil {
+			return nil, err
+		}
+		elems[i] = b
+	}
+
+	sort.Slice(elems, func(i, j int) bool { return bytes.Compare(elems[i], elems[j]) < 0 })
+
+	return asn1.Marshal(asn1.RawValue{
+		Class:      asn1.ClassUniversal,
+		Tag:        asn1.TagSet,
+		IsCompound: true,
+		Bytes:      bytes.Join(elems, nil),
+	})
+}
+
+// rawValue function returns the DER-encoded value `v` as raw value.
+func rawValue(v any) asn1.RawValue {
+	b, _ := asn1.Marshal(v)
+	return asn1.RawValue{FullBytes: b}
+}
+
+// headerLen function returns the length of the DER tag and length octets.
+func headerLen(b []byte) int {
+	if len(b) < 2 || b[1] < 0x80 {
+		return 2
+	}
+	return 2 + int(b[1]&0x7f)
+}
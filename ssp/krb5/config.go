@@ -1,6 +1,7 @@
 package krb5
 
 import (
+	"crypto/x509"
 	"os"
 	"strings"
 	"time"
@@ -69,6 +70,30 @@ type Config struct {
 	// AssumePreAuthentication used to configure the client to
 	// assume pre-authentication is required.
 	AssumePreAuthentication bool
+	// Impersonate is the user ("user@REALM", "REALM\\user" or
+	// "user") on behalf of whom the service ticket is requested
+	// with S4U2Self and S4U2Proxy exchanges (the client credential
+	// must be allowed to delegate to the service).
+	Impersonate string
+	// FASTArmor is the (machine account) credential used to obtain
+	// the ticket-granting ticket that armors (RFC 6113) the AS and
+	// TGS exchanges of the password, keytab or NT hash credential.
+	FASTArmor Credential
+	// PKINITRootCAs is the set of root certificate authorities used
+	// to verify the KDC certificate for the certificate (PKINIT)
+	// credential. If nil, the system roots are used.
+	PKINITRootCAs *x509.CertPool
+	// PKINITInsecureSkipVerify used to disable the KDC certificate
+	// verification for the certificate (PKINIT) credential.
+	PKINITInsecureSkipVerify bool
+	// ChannelBindings is the marshaled channel bindings, the channel
+	// bindings hash is included into the authenticator checksum.
+	ChannelBindings []byte
+	// DestroyCredential used to wipe the credential secrets (see
+	// credential.Destroy) and to drop the client tickets once the
+	// security context is established. The credential cannot be
+	// used to establish the new security context afterwards.
+	DestroyCredential bool
 }
 
 func (c *Config) FlagIsSet(f gssapi.Cap) bool {
@@ -164,10 +189,18 @@ func IsValidCredential(cred any) bool {
 		return true
 	}
 
+	if _, ok := cred.(credential.EncryptionKeys); ok {
+		return true
+	}
+
 	if _, ok := cred.(credential.CCache); ok {
 		return true
 	}
 
+	if _, ok := cred.(credential.Certificate); ok {
+		return true
+	}
+
 	return false
 }
 
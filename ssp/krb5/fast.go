@@ -0,0 +1,682 @@
+package krb5
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"time"
+
+	"github.com/jcmturner/gofork/encoding/asn1"
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/iana"
+	"github.com/jcmturner/gokrb5/v8/iana/errorcode"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+// fast.go module contains the Flexible Authentication Secure Tunneling
+// (FAST, RFC 6113): the armor key derivation (KRB-FX-CF2), the armored AS
+// exchange with the encrypted challenge pre-authentication and the armored
+// TGS exchange.
+
+const (
+	// The AP-REQ armor type.
+	fastArmorAPRequest = 1
+)
+
+// krbFastArmor structure represents the KrbFastArmor.
+type krbFastArmor struct {
+	ArmorType  int32  `asn1:"explicit,tag:0"`
+	ArmorValue []byte `asn1:"explicit,tag:1"`
+}
+
+// krbFastArmoredReq structure represents the KrbFastArmoredReq.
+type krbFastArmoredReq struct {
+	Armor       krbFastArmor        `asn1:"optional,explicit,tag:0"`
+	ReqChecksum types.Checksum      `asn1:"explicit,tag:1"`
+	EncFastReq  types.EncryptedData `asn1:"explicit,tag:2"`
+}
+
+// krbFastReq structure represents the KrbFastReq.
+type krbFastReq struct {
+	FastOptions asn1.BitString       `asn1:"explicit,tag:0"`
+	PAData      types.PADataSequence `asn1:"explicit,tag:1"`
+	ReqBody     asn1.RawValue        `asn1:"explicit,tag:2"`
+}
+
+// krbFastArmoredRep structure represents the KrbFastArmoredRep.
+type krbFastArmoredRep struct {
+	EncFastRep types.EncryptedData `asn1:"explicit,tag:0"`
+}
+
+// krbFastResponse structure represents the KrbFastResponse.
+type krbFastResponse struct {
+	PAData        types.PADataSequence `asn1:"explicit,tag:0"`
+	StrengthenKey types.EncryptionKey  `asn1:"optional,explicit,tag:1"`
+	Finished      krbFastFinished      `asn1:"optional,explicit,tag:2"`
+	Nonce         int64                `asn1:"explicit,tag:3"`
+}
+
+// krbFastFinished structure represents the KrbFastFinished.
+type krbFastFinished struct {
+	Timestamp      time.Time           `asn1:"generalized,explicit,tag:0"`
+	USec           int                 `asn1:"explicit,tag:1"`
+	CRealm         string              `asn1:"generalstring,explicit,tag:2"`
+	CName          types.PrincipalName `asn1:"explicit,tag:3"`
+	TicketChecksum types.Checksum      `asn1:"explicit,tag:4"`
+}
+
+// prf function returns the pseudo-random function output of the `key`
+// for the `b` octet-string (RFC 3961 section 3).
+func prf(key types.EncryptionKey, b []byte) ([]byte, error) {
+
+	switch key.KeyType {
+	case etypeID.AES128_CTS_HMAC_SHA1_96, etypeID.AES256_CTS_HMAC_SHA1_96:
+		// E(DK(key, "prf"), truncate(SHA-1(b))) (RFC 3962 section 6).
+		et, err := krb5crypto.GetEtype(key.KeyType)
+		if err != nil {
+			return nil, err
+		}
+		dk, err := et.DeriveKey(key.KeyValue, []byte("prf"))
+		if err != nil {
+			return nil, err
+		}
+		block, err := aes.NewCipher(dk)
+		if err != nil {
+			return nil, err
+		}
+		h, out := sha1.Sum(b), make([]byte, aes.BlockSize)
+		block.Encrypt(out, h[:aes.BlockSize])
+		return out, nil
+	case etypeID.AES128_CTS_HMAC_SHA256_128:
+		return kdfHMACSHA2(sha256.New, key.KeyValue, "prf", b, 256), nil
+	case etypeID.AES256_CTS_HMAC_SHA384_192:
+		return kdfHMACSHA2(sha512.New384, key.KeyValue, "prf", b, 384), nil
+	case etypeID.RC4_HMAC:
+		// HMAC-SHA1(key, b) (RFC 6113 appendix A).
+		mac := hmac.New(sha1.New, key.KeyValue)
+		mac.Write(b)
+		return mac.Sum(nil), nil
+	}
+
+	return nil, fmt.Errorf("prf: unsupported encryption type %d", key.KeyType)
+}
+
+// kdfHMACSHA2 function returns the KDF-HMAC-SHA2 output (RFC 8009 section 3).
+func kdfHMACSHA2(h func() hash.Hash, key []byte, label string, b []byte, k int) []byte {
+	mac := hmac.New(h, key)
+	mac.Write([]byte{0, 0, 0, 1})
+	mac.Write(append([]byte(label), 0))
+	mac.Write(b)
+	mac.Write(binary.BigEndian.AppendUint32(nil, uint32(k)))
+	return mac.Sum(nil)[:k/8]
+}
+
+// prfPlus function returns the `n` octets of the PRF+ output for the
+// `pepper` (RFC 6113 section 5.1).
+func prfPlus(key types.EncryptionKey, pepper string, n int) ([]byte, error) {
+
+	var out []byte
+
+	for i := 1; len(out) < n; i++ {
+		b, err := prf(key, append([]byte{byte(i)}, pepper...))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b...)
+	}
+
+	return out[:n], nil
+}
+
+// cf2 function returns the KRB-FX-CF2 combination of the keys `k1` and `k2`
+// with the peppers `pepper1` and `pepper2` (RFC 6113 section 5.1).
+func cf2(k1, k2 types.EncryptionKey, pepper1, pepper2 string) (types.EncryptionKey, error) {
+
+	et, err := krb5crypto.GetEtype(k1.KeyType)
+	if err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("cf2: %w", err)
+	}
+
+	// random-to-key is an identity function for the supported types.
+	n := et.GetKeyByteSize()
+
+	b1, err := prfPlus(k1, pepper1, n)
+	if err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("cf2: %w", err)
+	}
+
+	b2, err := prfPlus(k2, pepper2, n)
+	if err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("cf2: %w", err)
+	}
+
+	for i := range b1 {
+		b1[i] ^= b2[i]
+	}
+
+	return types.EncryptionKey{KeyType: k1.KeyType, KeyValue: b1}, nil
+}
+
+// fastTGT structure represents the ticket-granting ticket used to armor
+// the FAST exchanges.
+type fastTGT struct {
+	// The ticket-granting ticket.
+	Ticket messages.Ticket
+	// The session key.
+	Key types.EncryptionKey
+	// The client principal name.
+	CName types.PrincipalName
+	// The client realm.
+	CRealm string
+}
+
+// fastTGTFromCCache function returns the ticket-granting ticket of the
+// default principal from the credentials cache `cc`.
+func fastTGTFromCCache(cc *credentials.CCache) (*fastTGT, error) {
+
+	realm := cc.DefaultPrincipal.Realm
+
+	c, ok := cc.GetEntry(types.NewPrincipalName(nametype.KRB_NT_SRV_INST, "krbtgt/"+realm))
+	if !ok {
+		return nil, fmt.Errorf("tgt not found in ccache for realm %s", realm)
+	}
+
+	tgt := &fastTGT{Key: c.Key, CName: c.Client.PrincipalName, CRealm: c.Client.Realm}
+
+	if err := tgt.Ticket.Unmarshal(c.Ticket); err != nil {
+		return nil, fmt.Errorf("unmarshal tgt: %w", err)
+	}
+
+	return tgt, nil
+}
+
+// fastArmor structure represents the FAST armor.
+type fastArmor struct {
+	// The armor key.
+	Key types.EncryptionKey
+	// The explicit armor (empty for the TGS implicit armor).
+	Armor krbFastArmor
+}
+
+// newFASTArmor function returns the AP-REQ armor with the ticket-granting
+// ticket `tgt`.
+func newFASTArmor(tgt *fastTGT) (*fastArmor, error) {
+
+	et, err := krb5crypto.GetEtype(tgt.Key.KeyType)
+	if err != nil {
+		return nil, fmt.Errorf("fast armor: %w", err)
+	}
+
+	auth, err := types.NewAuthenticator(tgt.CRealm, tgt.CName)
+	if err != nil {
+		return nil, fmt.Errorf("fast armor: %w", err)
+	}
+
+	if err := auth.GenerateSeqNumberAndSubKey(tgt.Key.KeyType, et.GetKeyByteSize()); err != nil {
+		return nil, fmt.Errorf("fast armor: %w", err)
+	}
+
+	b, err := auth.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("fast armor: marshal authenticator: %w", err)
+	}
+
+	// the armor authenticator uses the ap-req key usage even for the krbtgt.
+	enc, err := krb5crypto.GetEncryptedData(b, tgt.Key, keyusage.AP_REQ_AUTHENTICATOR, tgt.Ticket.EncPart.KVNO)
+	if err != nil {
+		return nil, fmt.Errorf("fast armor: encrypt authenticator: %w", err)
+	}
+
+	apReq := messages.APReq{
+		PVNO:                   iana.PVNO,
+		MsgType:                msgtype.KRB_AP_REQ,
+		APOptions:              types.NewKrbFlags(),
+		Ticket:                 tgt.Ticket,
+		EncryptedAuthenticator: enc,
+	}
+
+	if b, err = apReq.Marshal(); err != nil {
+		return nil, fmt.Errorf("fast armor: marshal ap-req: %w", err)
+	}
+
+	key, err := cf2(auth.SubKey, tgt.Key, "subkeyarmor", "ticketarmor")
+	if err != nil {
+		return nil, fmt.Errorf("fast armor: %w", err)
+	}
+
+	return &fastArmor{Key: key, Armor: krbFastArmor{ArmorType: fastArmorAPRequest, ArmorValue: b}}, nil
+}
+
+// Request function returns the PA-FX-FAST that carries the request `body`
+// and the `padata` protected with the armor key.
+func (f *fastArmor) Request(body messages.KDCReqBody, padata types.PADataSequence) (types.PAData, error) {
+
+	b, err := body.Marshal()
+	if err != nil {
+		return types.PAData{}, fmt.Errorf("fast: marshal req-body: %w", err)
+	}
+
+	et, err := krb5crypto.GetEtype(f.Key.KeyType)
+	if err != nil {
+		return types.PAData{}, fmt.Errorf("fast: %w", err)
+	}
+
+	cksum, err := et.GetChecksumHash(f.Key.KeyValue, b, keyusage.KEY_USAGE_FAST_REQ_CHKSUM)
+	if err != nil {
+		return types.PAData{}, fmt.Errorf("fast: req-checksum: %w", err)
+	}
+
+	if padata == nil {
+		padata = types.PADataSequence{}
+	}
+
+	req, err := asn1.Marshal(krbFastReq{
+		FastOptions: types.NewKrbFlags(),
+		PAData:      padata,
+		ReqBody:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, IsCompound: true, Bytes: b},
+	})
+	if err != nil {
+		return types.PAData{}, fmt.Errorf("fast: marshal fast-req: %w", err)
+	}
+
+	enc, err := krb5crypto.GetEncryptedData(req, f.Key, keyusage.KEY_USAGE_FAST_ENC, 0)
+	if err != nil {
+		return types.PAData{}, fmt.Errorf("fast: encrypt fast-req: %w", err)
+	}
+
+	b, err = asn1.Marshal(krbFastArmoredReq{
+		Armor:       f.Armor,
+		ReqChecksum: types.Checksum{CksumType: et.GetHashID(), Checksum: cksum},
+		EncFastReq:  enc,
+	})
+	if err != nil {
+		return types.PAData{}, fmt.Errorf("fast: marshal armored-req: %w", err)
+	}
+
+	// PA-FX-FAST-REQUEST ::= CHOICE { armored-data [0] KrbFastArmoredReq }.
+	if b, err = asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: b}); err != nil {
+		return types.PAData{}, fmt.Errorf("fast: marshal pa-fx-fast: %w", err)
+	}
+
+	return types.PAData{PADataType: patype.PA_FX_FAST, PADataValue: b}, nil
+}
+
+// Response function returns the KrbFastResponse from the PA-FX-FAST of the
+// reply `padata`.
+func (f *fastArmor) Response(padata types.PADataSequence) (*krbFastResponse, error) {
+
+	for _, pa := range padata {
+		if pa.PADataType != patype.PA_FX_FAST {
+			continue
+		}
+
+		var rep krbFastArmoredRep
+		if _, err := asn1.UnmarshalWithParams(pa.PADataValue, &rep, "explicit,tag:0"); err != nil {
+			return nil, fmt.Errorf("fast: unmarshal armored-rep: %w", err)
+		}
+
+		b, err := krb5crypto.DecryptEncPart(rep.EncFastRep, f.Key, keyusage.KEY_USAGE_FAST_REP)
+		if err != nil {
+			return nil, fmt.Errorf("fast: decrypt fast-rep: %w", err)
+		}
+
+		resp := &krbFastResponse{}
+		if _, err := asn1.Unmarshal(b, resp); err != nil {
+			return nil, fmt.Errorf("fast: unmarshal fast-rep: %w", err)
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("fast: no pa-fx-fast in reply")
+}
+
+// Error function returns the KRB-ERROR carried in the PA-FX-ERROR of the
+// armored KRB-ERROR `e` and the padata of the FAST response. The error `e`
+// itself is returned if it is not armored.
+func (f *fastArmor) Error(e messages.KRBError) (messages.KRBError, types.PADataSequence) {
+
+	var padata types.PADataSequence
+	if err := padata.Unmarshal(e.EData); err != nil {
+		return e, nil
+	}
+
+	resp, err := f.Response(padata)
+	if err != nil {
+		return e, padata
+	}
+
+	for _, pa := range resp.PAData {
+		if pa.PADataType != patype.PA_FX_ERROR {
+			continue
+		}
+		var inner messages.KRBError
+		if err := inner.Unmarshal(pa.PADataValue); err == nil {
+			return inner, resp.PAData
+		}
+	}
+
+	return e, resp.PAData
+}
+
+// ReplyKey function verifies the FAST response `resp` for the request
+// with `nonce` and the reply `tkt`, and returns the reply key (strengthened
+// if the KDC has provided the strengthen key).
+func (f *fastArmor) ReplyKey(resp *krbFastResponse, nonce int, tkt messages.Ticket, key types.EncryptionKey) (types.EncryptionKey, error) {
+
+	if resp.Nonce != int64(nonce) {
+		return key, fmt.Errorf("fast: nonce mismatch")
+	}
+
+	b, err := tkt.Marshal()
+	if err != nil {
+		return key, fmt.Errorf("fast: marshal ticket: %w", err)
+	}
+
+	et, err := krb5crypto.GetEtype(f.Key.KeyType)
+	if err != nil {
+		return key, fmt.Errorf("fast: %w", err)
+	}
+
+	if !et.VerifyChecksum(f.Key.KeyValue, b, resp.Finished.TicketChecksum.Checksum, keyusage.KEY_USAGE_FAST_FINISHED) {
+		return key, fmt.Errorf("fast: ticket checksum verification failed")
+	}
+
+	if resp.StrengthenKey.KeyType == 0 {
+		return key, nil
+	}
+
+	if key, err = cf2(resp.StrengthenKey, key, "strengthenkey", "replykey"); err != nil {
+		return key, fmt.Errorf("fast: strengthen key: %w", err)
+	}
+
+	return key, nil
+}
+
+// newEncryptedChallenge function returns the PA-ENCRYPTED-CHALLENGE with the
+// client challenge key derived from the armor key and the client `key`.
+func newEncryptedChallenge(armor types.EncryptionKey, key types.EncryptionKey) (types.PAData, error) {
+
+	ck, err := cf2(armor, key, "clientchallengearmor", "challengelongterm")
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	now := time.Now().UTC()
+
+	b, err := asn1.Marshal(types.PAEncTSEnc{PATimestamp: now.Truncate(time.Second), PAUSec: now.Nanosecond() / 1000})
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	enc, err := krb5crypto.GetEncryptedData(b, ck, keyusage.KEY_USAGE_ENC_CHALLENGE_CLIENT, 0)
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	if b, err = enc.Marshal(); err != nil {
+		return types.PAData{}, err
+	}
+
+	return types.PAData{PADataType: patype.PA_ENCRYPTED_CHALLENGE, PADataValue: b}, nil
+}
+
+// verifyEncryptedChallenge function verifies the KDC PA-ENCRYPTED-CHALLENGE
+// (if present in the `padata`) with the KDC challenge key.
+func verifyEncryptedChallenge(armor types.EncryptionKey, key types.EncryptionKey, padata types.PADataSequence) error {
+
+	for _, pa := range padata {
+		if pa.PADataType != patype.PA_ENCRYPTED_CHALLENGE {
+			continue
+		}
+
+		var enc types.EncryptedData
+		if err := enc.Unmarshal(pa.PADataValue); err != nil {
+			return fmt.Errorf("kdc challenge: %w", err)
+		}
+
+		kk, err := cf2(armor, key, "kdcchallengearmor", "challengelongterm")
+		if err != nil {
+			return fmt.Errorf("kdc challenge: %w", err)
+		}
+
+		if _, err := krb5crypto.DecryptEncPart(enc, kk, keyusage.KEY_USAGE_ENC_CHALLENGE_KDC); err != nil {
+			return fmt.Errorf("kdc challenge: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// fastLongTermKey function returns the long-term key of the client `cl` for
+// the preferred encryption type from the ETYPE-INFO2 of the `padata`.
+func fastLongTermKey(cl *client.Client, etypes []int32, padata types.PADataSequence) (types.EncryptionKey, error) {
+
+	if len(etypes) == 0 {
+		return types.EncryptionKey{}, fmt.Errorf("no encryption types")
+	}
+
+	etype := etypes[0]
+
+lookup:
+	for _, pa := range padata {
+		if pa.PADataType != patype.PA_ETYPE_INFO2 {
+			continue
+		}
+		info, err := pa.GetETypeInfo2()
+		if err != nil {
+			return types.EncryptionKey{}, fmt.Errorf("etype-info2: %w", err)
+		}
+		for _, entry := range info {
+			for _, et := range etypes {
+				if entry.EType == et {
+					etype = et
+					break lookup
+				}
+			}
+		}
+	}
+
+	cname, realm := cl.Credentials.CName(), cl.Credentials.Domain()
+
+	if cl.Credentials.HasKeytab() {
+		key, _, err := cl.Credentials.Keytab().GetEncryptionKey(cname, realm, 0, etype)
+		return key, err
+	}
+
+	if cl.Credentials.HasPassword() {
+		key, _, err := krb5crypto.GetKeyFromPassword(cl.Credentials.Password(), cname, realm, etype, padata)
+		return key, err
+	}
+
+	return types.EncryptionKey{}, fmt.Errorf("credential has neither keytab or password")
+}
+
+// fastASExchange function performs the AS exchange for the client `cl`
+// (password or keytab credentials) armored with the ticket-granting ticket
+// `tgt` using the encrypted challenge pre-authentication, and returns the
+// credentials cache with the client ticket-granting ticket.
+func fastASExchange(ctx context.Context, cl *client.Client, tgt *fastTGT) (*credentials.CCache, error) {
+
+	realm := cl.Credentials.Domain()
+
+	armor, err := newFASTArmor(tgt)
+	if err != nil {
+		return nil, err
+	}
+
+	asReq, err := messages.NewASReqForTGT(realm, cl.Config, cl.Credentials.CName())
+	if err != nil {
+		return nil, fmt.Errorf("fast: new as-req: %w", err)
+	}
+
+	var (
+		asRep  messages.ASRep
+		key    types.EncryptionKey
+		padata types.PADataSequence
+	)
+
+	for attempt := 0; ; attempt++ {
+
+		fx, err := armor.Request(asReq.ReqBody, padata)
+		if err != nil {
+			return nil, err
+		}
+
+		asReq.PAData = types.PADataSequence{fx}
+
+		b, err := asReq.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("fast: marshal as-req: %w", err)
+		}
+
+		if b, err = sendToKDC(ctx, cl.Config, realm, b); err != nil {
+			return nil, fmt.Errorf("fast: %w", err)
+		}
+
+		err = asRep.Unmarshal(b)
+		if err == nil {
+			break
+		}
+
+		krbErr, ok := err.(messages.KRBError)
+		if !ok {
+			return nil, fmt.Errorf("fast: as-rep: %w", err)
+		}
+
+		krbErr, pas := armor.Error(krbErr)
+		if krbErr.ErrorCode != errorcode.KDC_ERR_PREAUTH_REQUIRED || attempt > 0 {
+			return nil, fmt.Errorf("fast: as-rep: %w", krbErr)
+		}
+
+		if key, err = fastLongTermKey(cl, asReq.ReqBody.EType, pas); err != nil {
+			return nil, fmt.Errorf("fast: client key: %w", err)
+		}
+
+		challenge, err := newEncryptedChallenge(armor.Key, key)
+		if err != nil {
+			return nil, fmt.Errorf("fast: encrypted challenge: %w", err)
+		}
+
+		padata = types.PADataSequence{challenge}
+		for _, pa := range pas {
+			// the cookie must be returned to the kdc as is.
+			if pa.PADataType == patype.PA_FX_COOKIE {
+				padata = append(padata, pa)
+			}
+		}
+	}
+
+	resp, err := armor.Response(asRep.PAData)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.KeyType != asRep.EncPart.EType {
+		// the pre-authentication was not required or the kdc has chosen the
+		// different encryption type.
+		if key, err = fastLongTermKey(cl, []int32{asRep.EncPart.EType}, resp.PAData); err != nil {
+			return nil, fmt.Errorf("fast: client key: %w", err)
+		}
+	}
+
+	if err := verifyEncryptedChallenge(armor.Key, key, resp.PAData); err != nil {
+		return nil, fmt.Errorf("fast: %w", err)
+	}
+
+	if key, err = armor.ReplyKey(resp, asReq.ReqBody.Nonce, asRep.Ticket, key); err != nil {
+		return nil, err
+	}
+
+	// the client name is taken from the authenticated finished message.
+	asRep.CName, asRep.CRealm = resp.Finished.CName, resp.Finished.CRealm
+
+	if err := decryptASRep(&asRep, &asReq, key); err != nil {
+		return nil, fmt.Errorf("fast: %w", err)
+	}
+
+	return newCCache(&asRep)
+}
+
+// fastArmorTGT function returns the ticket-granting ticket of the FAST
+// armor credential.
+func (a *Authentifier) fastArmorTGT(ctx context.Context) (*fastTGT, error) {
+
+	cfg := *a.Config
+	cfg.Credential, cfg.FASTArmor, cfg.CCachePath, cfg.Impersonate = a.Config.FASTArmor, nil, "", ""
+
+	switch cred := cfg.Credential.(type) {
+	case credential.CCache:
+		return fastTGTFromCCache(cred.CCache())
+	case credential.Certificate:
+		cc, err := PKINIT(ctx, &cfg, cred)
+		if err != nil {
+			return nil, err
+		}
+		return fastTGTFromCCache(cc)
+	}
+
+	cli, err := (&Authentifier{Config: &cfg}).makeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	realm := cli.Credentials.Domain()
+
+	asReq, err := messages.NewASReqForTGT(realm, cli.Config, cli.Credentials.CName())
+	if err != nil {
+		return nil, fmt.Errorf("new as-req: %w", err)
+	}
+
+	asRep, err := cli.ASExchange(realm, asReq, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fastTGT{Ticket: asRep.Ticket, Key: asRep.DecryptedEncPart.Key, CName: asRep.CName, CRealm: asRep.CRealm}, nil
+}
+
+// fastLogin function performs the armored AS exchange for the client `cl`
+// and returns the client with the obtained ticket-granting ticket.
+func (a *Authentifier) fastLogin(ctx context.Context, cl *client.Client) (*client.Client, error) {
+
+	if !cl.Credentials.HasPassword() && !cl.Credentials.HasKeytab() {
+		return nil, fmt.Errorf("fast: credential must be a password, keytab or nt hash")
+	}
+
+	armor, err := a.fastArmorTGT(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fast: armor tgt: %w", err)
+	}
+
+	cc, err := fastASExchange(ctx, cl, armor)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.fastTGT, err = fastTGTFromCCache(cc); err != nil {
+		return nil, fmt.Errorf("fast: %w", err)
+	}
+
+	if cl, err = client.NewFromCCache(cc, cl.Config, a.Config.ClientSettings()...); err != nil {
+		return nil, fmt.Errorf("fast: client from ccache: %w", err)
+	}
+
+	return cl, nil
+}
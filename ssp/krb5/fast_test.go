@@ -0,0 +1,399 @@
+package krb5
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jcmturner/gofork/encoding/asn1"
+	"github.com/jcmturner/gokrb5/v8/client"
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/iana/errorcode"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+)
+
+func TestCF2(t *testing.T) {
+
+	// RFC 6113 appendix A.
+	for _, tc := range []struct {
+		etype int32
+		cf2   string
+	}{
+		{etypeID.AES128_CTS_HMAC_SHA1_96, "97df97e4b798b29eb31ed7280287a92a"},
+		{etypeID.AES256_CTS_HMAC_SHA1_96, "4d6ca4e629785c1f01baf55e2e548566b9617ae3a96868c337cb93b5e72b1c7b"},
+		{etypeID.RC4_HMAC, "24d7f6b6bae4e5c00d2082c5ebab3672"},
+	} {
+		et, _ := krb5crypto.GetEtype(tc.etype)
+
+		k1, _ := et.StringToKey("key1", "key1", et.GetDefaultStringToKeyParams())
+		k2, _ := et.StringToKey("key2", "key2", et.GetDefaultStringToKeyParams())
+
+		key, err := cf2(types.EncryptionKey{KeyType: tc.etype, KeyValue: k1}, types.EncryptionKey{KeyType: tc.etype, KeyValue: k2}, "a", "b")
+		if err != nil {
+			t.Fatalf("cf2: %d: %v", tc.etype, err)
+		}
+
+		if hex.EncodeToString(key.KeyValue) != tc.cf2 {
+			t.Errorf("cf2: %d: unexpected key %x", tc.etype, key.KeyValue)
+		}
+	}
+
+	// RFC 8009 appendix A.
+	k, _ := hex.DecodeString("3705D96080C17728A0E800EAB6E0D23C")
+	out, err := prf(types.EncryptionKey{KeyType: etypeID.AES128_CTS_HMAC_SHA256_128, KeyValue: k}, []byte("test"))
+	if err != nil || hex.EncodeToString(out) != "9d188616f63852fe86915bb840b4a886ff3e6bb0f819b49b893393d393854295" {
+		t.Errorf("prf: aes128-sha256: unexpected output %x (%v)", out, err)
+	}
+}
+
+// fastKDC structure represents the test KDC that requires the FAST armor.
+type fastKDC struct {
+	// The armor ticket-granting ticket session key.
+	armor types.EncryptionKey
+	// The client long-term key.
+	key types.EncryptionKey
+	// The issued ticket session key.
+	session types.EncryptionKey
+}
+
+// fastRequest function returns the armor key and the decrypted FAST request
+// from the KDC-REQ `padata` and the `body`.
+func (kdc *fastKDC) fastRequest(padata types.PADataSequence, body messages.KDCReqBody) (types.EncryptionKey, *krbFastReq, error) {
+
+	var ar krbFastArmoredReq
+	for _, pa := range padata {
+		if pa.PADataType == patype.PA_FX_FAST {
+			if _, err := asn1.UnmarshalWithParams(pa.PADataValue, &ar, "explicit,tag:0"); err != nil {
+				return types.EncryptionKey{}, nil, err
+			}
+		}
+	}
+
+	if ar.Armor.ArmorType != fastArmorAPRequest {
+		return types.EncryptionKey{}, nil, fmt.Errorf("no armor")
+	}
+
+	var apReq messages.APReq
+	if err := apReq.Unmarshal(ar.Armor.ArmorValue); err != nil {
+		return types.EncryptionKey{}, nil, err
+	}
+
+	b, err := krb5crypto.DecryptEncPart(apReq.EncryptedAuthenticator, kdc.armor, keyusage.AP_REQ_AUTHENTICATOR)
+	if err != nil {
+		return types.EncryptionKey{}, nil, err
+	}
+
+	var auth types.Authenticator
+	if err := auth.Unmarshal(b); err != nil {
+		return types.EncryptionKey{}, nil, err
+	}
+
+	key, err := cf2(auth.SubKey, kdc.armor, "subkeyarmor", "ticketarmor")
+	if err != nil {
+		return types.EncryptionKey{}, nil, err
+	}
+
+	et, _ := krb5crypto.GetEtype(key.KeyType)
+
+	if b, err = body.Marshal(); err != nil {
+		return types.EncryptionKey{}, nil, err
+	}
+
+	if !et.VerifyChecksum(key.KeyValue, b, ar.ReqChecksum.Checksum, keyusage.KEY_USAGE_FAST_REQ_CHKSUM) {
+		return types.EncryptionKey{}, nil, fmt.Errorf("req-checksum mismatch")
+	}
+
+	if b, err = krb5crypto.DecryptEncPart(ar.EncFastReq, key, keyusage.KEY_USAGE_FAST_ENC); err != nil {
+		return types.EncryptionKey{}, nil, err
+	}
+
+	req := &krbFastReq{}
+	if _, err := asn1.Unmarshal(b, req); err != nil {
+		return types.EncryptionKey{}, nil, err
+	}
+
+	return key, req, nil
+}
+
+// fastReply function returns the PA-FX-FAST with the FAST response `resp`.
+func fastReply(key types.EncryptionKey, resp krbFastResponse) (types.PAData, error) {
+
+	b, err := asn1.Marshal(resp)
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	enc, err := krb5crypto.GetEncryptedData(b, key, keyusage.KEY_USAGE_FAST_REP, 0)
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	b, _ = asn1.Marshal(krbFastArmoredRep{EncFastRep: enc})
+	b, _ = asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: b})
+
+	return types.PAData{PADataType: patype.PA_FX_FAST, PADataValue: b}, nil
+}
+
+// asRep function returns the armored KRB-ERROR for the AS-REQ `b` without
+// the encrypted challenge, or the armored AS-REP.
+func (kdc *fastKDC) asRep(b []byte) ([]byte, error) {
+
+	var asReq messages.ASReq
+	if err := asReq.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	armorKey, req, err := kdc.fastRequest(asReq.PAData, asReq.ReqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.PAData.Contains(patype.PA_ENCRYPTED_CHALLENGE) {
+
+		inner := messages.NewKRBError(asReq.ReqBody.SName, asReq.ReqBody.Realm, errorcode.KDC_ERR_PREAUTH_REQUIRED, "")
+		ib, _ := inner.Marshal()
+		info, _ := asn1.Marshal(types.ETypeInfo2{{EType: kdc.key.KeyType, Salt: "CONTOSO.NETuser"}})
+
+		fx, err := fastReply(armorKey, krbFastResponse{
+			PAData: types.PADataSequence{
+				{PADataType: patype.PA_FX_ERROR, PADataValue: ib},
+				{PADataType: patype.PA_ETYPE_INFO2, PADataValue: info},
+				{PADataType: patype.PA_FX_COOKIE, PADataValue: []byte("cookie")},
+			},
+			Nonce: int64(asReq.ReqBody.Nonce),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		e := messages.NewKRBError(asReq.ReqBody.SName, asReq.ReqBody.Realm, errorcode.KDC_ERR_PREAUTH_REQUIRED, "")
+		e.EData, _ = asn1.Marshal(types.PADataSequence{fx})
+
+		return e.Marshal()
+	}
+
+	var cookie bool
+	for _, pa := range req.PAData {
+		switch pa.PADataType {
+		case patype.PA_FX_COOKIE:
+			cookie = bytes.Equal(pa.PADataValue, []byte("cookie"))
+		case patype.PA_ENCRYPTED_CHALLENGE:
+			var enc types.EncryptedData
+			if err := enc.Unmarshal(pa.PADataValue); err != nil {
+				return nil, err
+			}
+			ck, _ := cf2(armorKey, kdc.key, "clientchallengearmor", "challengelongterm")
+			if _, err := krb5crypto.DecryptEncPart(enc, ck, keyusage.KEY_USAGE_ENC_CHALLENGE_CLIENT); err != nil {
+				return nil, fmt.Errorf("client challenge: %w", err)
+			}
+		}
+	}
+
+	if !cookie {
+		return nil, fmt.Errorf("no cookie")
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	strengthen := types.EncryptionKey{KeyType: kdc.key.KeyType, KeyValue: bytes.Repeat([]byte{7}, 32)}
+	replyKey, _ := cf2(strengthen, kdc.key, "strengthenkey", "replykey")
+
+	enc := messages.EncKDCRepPart{
+		Key:       kdc.session,
+		LastReqs:  []messages.LastReq{{LRValue: now}},
+		Nonce:     asReq.ReqBody.Nonce,
+		Flags:     types.NewKrbFlags(),
+		AuthTime:  now,
+		StartTime: now,
+		EndTime:   now.Add(10 * time.Hour),
+		SRealm:    asReq.ReqBody.Realm,
+		SName:     asReq.ReqBody.SName,
+	}
+
+	eb, _ := enc.Marshal()
+	encPart, err := krb5crypto.GetEncryptedData(eb, replyKey, keyusage.AS_REP_ENCPART, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	tkt := messages.Ticket{
+		TktVNO:  5,
+		Realm:   asReq.ReqBody.Realm,
+		SName:   asReq.ReqBody.SName,
+		EncPart: types.EncryptedData{EType: kdc.key.KeyType, KVNO: 1, Cipher: []byte("ticket")},
+	}
+
+	tb, _ := tkt.Marshal()
+	et, _ := krb5crypto.GetEtype(armorKey.KeyType)
+	cksum, _ := et.GetChecksumHash(armorKey.KeyValue, tb, keyusage.KEY_USAGE_FAST_FINISHED)
+
+	kk, _ := cf2(armorKey, kdc.key, "kdcchallengearmor", "challengelongterm")
+	kb, _ := asn1.Marshal(types.PAEncTSEnc{PATimestamp: now})
+	kchallenge, _ := krb5crypto.GetEncryptedData(kb, kk, keyusage.KEY_USAGE_ENC_CHALLENGE_KDC, 0)
+	kcb, _ := kchallenge.Marshal()
+
+	fx, err := fastReply(armorKey, krbFastResponse{
+		PAData:        types.PADataSequence{{PADataType: patype.PA_ENCRYPTED_CHALLENGE, PADataValue: kcb}},
+		StrengthenKey: strengthen,
+		Finished: krbFastFinished{
+			Timestamp:      now,
+			CRealm:         asReq.ReqBody.Realm,
+			CName:          asReq.ReqBody.CName,
+			TicketChecksum: types.Checksum{CksumType: et.GetHashID(), Checksum: cksum},
+		},
+		Nonce: int64(asReq.ReqBody.Nonce),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rep := messages.ASRep{KDCRepFields: messages.KDCRepFields{
+		PVNO:    5,
+		MsgType: msgtype.KRB_AS_REP,
+		PAData:  types.PADataSequence{fx},
+		CRealm:  asReq.ReqBody.Realm,
+		CName:   types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, "anonymous"),
+		Ticket:  tkt,
+		EncPart: encPart,
+	}}
+
+	return rep.Marshal()
+}
+
+func TestFAST(t *testing.T) {
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	defer l.Close()
+
+	cfg := testKRB5Config(t, l.Addr())
+
+	cl := client.NewWithPassword("user", "CONTOSO.NET", "password", cfg)
+
+	key, _, err := krb5crypto.GetKeyFromPassword("password", cl.Credentials.CName(), "CONTOSO.NET", etypeID.AES256_CTS_HMAC_SHA1_96, types.PADataSequence{})
+	if err != nil {
+		t.Fatalf("client key: %v", err)
+	}
+
+	kdc := &fastKDC{
+		armor:   types.EncryptionKey{KeyType: etypeID.AES256_CTS_HMAC_SHA1_96, KeyValue: bytes.Repeat([]byte{1}, 32)},
+		key:     key,
+		session: types.EncryptionKey{KeyType: etypeID.AES256_CTS_HMAC_SHA1_96, KeyValue: bytes.Repeat([]byte{3}, 32)},
+	}
+
+	armor := &fastTGT{
+		Ticket: messages.Ticket{
+			TktVNO:  5,
+			Realm:   "CONTOSO.NET",
+			SName:   types.NewPrincipalName(nametype.KRB_NT_SRV_INST, "krbtgt/CONTOSO.NET"),
+			EncPart: types.EncryptedData{EType: etypeID.AES256_CTS_HMAC_SHA1_96, KVNO: 1, Cipher: []byte("armor")},
+		},
+		Key:    kdc.armor,
+		CName:  types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, "host$"),
+		CRealm: "CONTOSO.NET",
+	}
+
+	go func() {
+		// pre-authentication required, then encrypted challenge.
+		serveKDC(t, l, kdc.asRep)
+		serveKDC(t, l, kdc.asRep)
+	}()
+
+	cc, err := fastASExchange(context.Background(), cl, armor)
+	if err != nil {
+		t.Fatalf("fast: %v", err)
+	}
+
+	tgt, err := fastTGTFromCCache(cc)
+	if err != nil {
+		t.Fatalf("fast: %v", err)
+	}
+
+	if !bytes.Equal(tgt.Key.KeyValue, kdc.session.KeyValue) || tgt.CName.PrincipalNameString() != "user" {
+		t.Fatalf("fast: unexpected tgt for %s", tgt.CName.PrincipalNameString())
+	}
+
+	// armored TGS exchange.
+	go serveKDC(t, l, func(b []byte) ([]byte, error) {
+
+		var req messages.TGSReq
+		if err := req.Unmarshal(b); err != nil {
+			return nil, err
+		}
+
+		if len(req.PAData) != 2 || req.PAData[0].PADataType != patype.PA_TGS_REQ || req.PAData[1].PADataType != patype.PA_FX_FAST {
+			return nil, fmt.Errorf("tgs: unexpected padata %v", req.PAData)
+		}
+
+		var apReq messages.APReq
+		if err := apReq.Unmarshal(req.PAData[0].PADataValue); err != nil {
+			return nil, err
+		}
+
+		if err := apReq.DecryptAuthenticator(tgt.Key); err != nil {
+			return nil, err
+		}
+
+		subkey := apReq.Authenticator.SubKey
+
+		armorKey, _ := cf2(subkey, tgt.Key, "subkeyarmor", "ticketarmor")
+
+		rb, err := tgsRep(req, tgt.CName, subkey, kdc.session)
+		if err != nil {
+			return nil, err
+		}
+
+		var rep messages.TGSRep
+		if err := rep.Unmarshal(rb); err != nil {
+			return nil, err
+		}
+
+		// re-encrypt with the subkey usage.
+		pt, _ := krb5crypto.DecryptEncPart(rep.EncPart, subkey, keyusage.TGS_REP_ENCPART_SESSION_KEY)
+		rep.EncPart, _ = krb5crypto.GetEncryptedData(pt, subkey, keyusage.TGS_REP_ENCPART_AUTHENTICATOR_SUB_KEY, 0)
+
+		tb, _ := rep.Ticket.Marshal()
+		et, _ := krb5crypto.GetEtype(armorKey.KeyType)
+		cksum, _ := et.GetChecksumHash(armorKey.KeyValue, tb, keyusage.KEY_USAGE_FAST_FINISHED)
+
+		fx, err := fastReply(armorKey, krbFastResponse{
+			PAData: types.PADataSequence{},
+			Finished: krbFastFinished{
+				Timestamp:      time.Now().UTC().Truncate(time.Second),
+				CRealm:         tgt.CRealm,
+				CName:          tgt.CName,
+				TicketChecksum: types.Checksum{CksumType: et.GetHashID(), Checksum: cksum},
+			},
+			Nonce: int64(req.ReqBody.Nonce),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rep.PAData = types.PADataSequence{fx}
+
+		return rep.Marshal()
+	})
+
+	tkt, skey, err := requestServiceTicket(context.Background(), cfg, tgt.Ticket, tgt.Key, tgt.CName, "host/dc01.contoso.net", true)
+	if err != nil {
+		t.Fatalf("fast: tgs: %v", err)
+	}
+
+	if tkt.SName.PrincipalNameString() != "host/dc01.contoso.net" || !bytes.Equal(skey.KeyValue, kdc.session.KeyValue) {
+		t.Errorf("fast: tgs: unexpected ticket %s", tkt.SName.PrincipalNameString())
+	}
+}
@@ -37,6 +37,9 @@ func (c *Config) Copy() gssapi.MechanismConfig {
 	cp.APOptions = make([]int, len(c.APOptions))
 	copy(cp.APOptions, c.APOptions)
 
+	cp.ChannelBindings = make([]byte, len(c.ChannelBindings))
+	copy(cp.ChannelBindings, c.ChannelBindings)
+
 	return &cp
 }
 
@@ -82,6 +85,12 @@ func (Mechanism) New(ctx context.Context) (gssapi.Mechanism, error) {
 		c.SName = cc.TargetName
 	}
 
+	if cc.ChannelBindings != nil {
+		if c.ChannelBindings, err = cc.ChannelBindings.Marshal(); err != nil {
+			return nil, gssapi.ContextError(ctx, gssapi.BadBindings, gssapi.ErrBadBindings)
+		}
+	}
+
 	if cc.Capabilities.IsSet(gssapi.Anonymity) {
 		c.Flags = append(c.Flags, int(gssapi.Anonymity))
 	}
@@ -142,6 +151,10 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.Config.SName)
+		gssapi.SetAttribute(ctx, gssapi.AttributeServerPrincipal, m.ServerPrincipal())
+		gssapi.SetAttribute(ctx, gssapi.AttributeExpiry, m.Expiry)
+		// the server proved the knowledge of the service key with ap-rep.
+		gssapi.SetAttribute(ctx, gssapi.AttributeMutualAuthn, len(tok.Payload) > 0)
 
 		if !m.Config.DCEStyle && m.Config.FlagIsSet(gssapi.MutualAuthn) {
 			// return empty apreply for non-dce style mutual authentication.
@@ -166,6 +179,9 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.Config.SName)
+		gssapi.SetAttribute(ctx, gssapi.AttributeServerPrincipal, m.ServerPrincipal())
+		gssapi.SetAttribute(ctx, gssapi.AttributeExpiry, m.Expiry)
+		gssapi.SetAttribute(ctx, gssapi.AttributeMutualAuthn, false)
 
 		return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
 	}
@@ -0,0 +1,84 @@
+package krb5
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/jcmturner/gokrb5/v8/config"
+)
+
+// The default KDC exchange timeout.
+var DefaultKDCTimeout = 10 * time.Second
+
+// sendToKDC function sends the message `b` to the KDC of the `realm` over
+// TCP (RFC 4120 section 7.2.2) and returns the reply. The KDCs are tried in
+// the order of preference until one of them replies.
+func sendToKDC(ctx context.Context, cfg *config.Config, realm string, b []byte) ([]byte, error) {
+
+	n, kdcs, err := cfg.GetKDCs(realm, true)
+	if err != nil {
+		return nil, fmt.Errorf("send to kdc: %w", err)
+	}
+
+	for i := 1; i <= n; i++ {
+		var rb []byte
+		if rb, err = sendToKDCAddr(ctx, kdcs[i], b); err == nil {
+			return rb, nil
+		}
+	}
+
+	if err == nil {
+		err = fmt.Errorf("no kdc found for realm %q", realm)
+	}
+
+	return nil, fmt.Errorf("send to kdc: %w", err)
+}
+
+// sendToKDCAddr function sends the message `b` to the KDC at `addr` and
+// returns the reply.
+func sendToKDCAddr(ctx context.Context, addr string, b []byte) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultKDCTimeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	// the message is prefixed with the 4-byte big-endian length.
+	if _, err = conn.Write(binary.BigEndian.AppendUint32(nil, uint32(len(b)))); err != nil {
+		return nil, err
+	}
+
+	if _, err = conn.Write(b); err != nil {
+		return nil, err
+	}
+
+	var hdr [4]byte
+	if _, err = io.ReadFull(conn, hdr[:]); err != nil {
+		return nil, err
+	}
+
+	sz := binary.BigEndian.Uint32(hdr[:])
+	if sz > 1<<24 {
+		return nil, fmt.Errorf("kdc reply is too large: %d", sz)
+	}
+
+	rb := make([]byte, sz)
+	if _, err = io.ReadFull(conn, rb); err != nil {
+		return nil, err
+	}
+
+	return rb, nil
+}
@@ -1,12 +1,16 @@
 package krb5
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jcmturner/gokrb5/v8/credentials"
 	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
 	"github.com/jcmturner/gokrb5/v8/keytab"
 	"github.com/jcmturner/gokrb5/v8/types"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
 )
 
 // Entry represents a keytab entry.
@@ -96,3 +100,96 @@ func WithNTHash(creds *credentials.Credentials, ntHash []byte, kvno int) *creden
 
 	return creds.WithKeytab(kt)
 }
+
+// WithEncryptionKeys function returns the credentials with the keytab that
+// contains the encryption keys `keys` (with key version `kvno`).
+func WithEncryptionKeys(creds *credentials.Credentials, keys []credential.EncryptionKey, kvno int) *credentials.Credentials {
+
+	kt := new(keytab.Keytab)
+
+	for _, key := range keys {
+		AddEntry(kt, &Entry{
+			Principal: Principal{
+				Realm:         creds.Realm(),
+				Components:    creds.CName().NameString,
+				NameType:      creds.CName().NameType,
+				NumComponents: int16(len(creds.CName().NameString)),
+			},
+			Timestamp: time.Now(),
+			KVNO8:     uint8(kvno),
+			Key: types.EncryptionKey{
+				KeyType:  key.EType,
+				KeyValue: key.Key,
+			},
+			KVNO: uint32(kvno),
+		})
+	}
+
+	return creds.WithKeytab(kt)
+}
+
+// SelectKeytab function returns the keytab with the keys of the principal
+// `cname`@`realm`. The principal and the realm are compared case-insensitively
+// (the selected entries use the `cname` and `realm` spelling), and for every
+// encryption type, only the key with the version `kvno` (or the key with the
+// highest version if `kvno` is 0) is selected.
+func SelectKeytab(kt *keytab.Keytab, cname types.PrincipalName, realm string, kvno int) (*keytab.Keytab, error) {
+
+	if kt == nil {
+		return nil, fmt.Errorf("keytab: no keytab")
+	}
+
+	ret, idx := keytab.New(), make(map[int32]int)
+
+	for _, e := range kt.Entries {
+
+		if !strings.EqualFold(e.Principal.Realm, realm) || !strings.EqualFold(strings.Join(e.Principal.Components, "/"), cname.PrincipalNameString()) {
+			continue
+		}
+
+		if kvno != 0 && e.KVNO != uint32(kvno) {
+			continue
+		}
+
+		e.Principal.Realm, e.Principal.Components = realm, cname.NameString
+		e.Principal.NumComponents = int16(len(cname.NameString))
+
+		i, ok := idx[e.Key.KeyType]
+		if !ok {
+			idx[e.Key.KeyType] = len(ret.Entries)
+			ret.Entries = append(ret.Entries, e)
+			continue
+		}
+
+		if e.KVNO > ret.Entries[i].KVNO {
+			ret.Entries[i] = e
+		}
+	}
+
+	if len(ret.Entries) == 0 {
+		if kvno != 0 {
+			return nil, fmt.Errorf("keytab: no keys for %s@%s with kvno %d", cname.PrincipalNameString(), realm, kvno)
+		}
+		return nil, fmt.Errorf("keytab: no keys for %s@%s", cname.PrincipalNameString(), realm)
+	}
+
+	return ret, nil
+}
+
+// KeytabETypes function returns the encryption types from `etypes` that have
+// the key in the keytab (the order of `etypes` is preserved).
+func KeytabETypes(kt *keytab.Keytab, etypes []int32) []int32 {
+
+	var ret []int32
+
+	for _, et := range etypes {
+		for _, e := range kt.Entries {
+			if e.Key.KeyType == et {
+				ret = append(ret, et)
+				break
+			}
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,105 @@
+package krb5
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/types"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+func TestSelectKeytab(t *testing.T) {
+
+	kt, ts := keytab.New(), time.Unix(1700000000, 0)
+
+	for _, e := range []struct {
+		Principal string
+		KVNO      uint8
+		EType     int32
+	}{
+		{"svc", 2, etypeID.AES256_CTS_HMAC_SHA1_96},
+		{"svc", 3, etypeID.AES256_CTS_HMAC_SHA1_96},
+		{"svc", 2, etypeID.RC4_HMAC},
+		{"other", 4, etypeID.AES128_CTS_HMAC_SHA1_96},
+	} {
+		if err := kt.AddEntry(e.Principal, "CONTOSO.NET", "password", ts, e.KVNO, e.EType); err != nil {
+			t.Fatalf("add entry: %v", err)
+		}
+	}
+
+	cname := types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, "SVC")
+
+	// the highest version for every encryption type.
+	sel, err := SelectKeytab(kt, cname, "contoso.net", 0)
+	if err != nil {
+		t.Fatalf("select: %v", err)
+	}
+
+	if len(sel.Entries) != 2 || sel.Entries[0].KVNO != 3 || sel.Entries[1].KVNO != 2 {
+		t.Fatalf("select: unexpected entries\n%s", sel)
+	}
+
+	if _, kvno, err := sel.GetEncryptionKey(cname, "contoso.net", 0, etypeID.AES256_CTS_HMAC_SHA1_96); err != nil || kvno != 3 {
+		t.Errorf("select: get key: kvno %d: %v", kvno, err)
+	}
+
+	if etypes := KeytabETypes(sel, []int32{etypeID.AES128_CTS_HMAC_SHA1_96, etypeID.RC4_HMAC, etypeID.AES256_CTS_HMAC_SHA1_96}); len(etypes) != 2 || etypes[0] != etypeID.RC4_HMAC {
+		t.Errorf("etypes: unexpected %v", etypes)
+	}
+
+	// the key version selection.
+	if sel, err = SelectKeytab(kt, cname, "CONTOSO.NET", 2); err != nil || len(sel.Entries) != 2 || sel.Entries[0].KVNO != 2 {
+		t.Errorf("select: kvno 2: %v", err)
+	}
+
+	if _, err = SelectKeytab(kt, cname, "CONTOSO.NET", 5); err == nil {
+		t.Errorf("select: expected error for kvno 5")
+	}
+
+	// the principal and the realm from the keytab.
+	cred := credential.NewFromKeytab("", kt, credential.KVNO(2))
+	if cred.UserName() != "svc" || cred.DomainName() != "CONTOSO.NET" || cred.KVNO() != 2 {
+		t.Errorf("credential: unexpected %s@%s kvno %d", cred.UserName(), cred.DomainName(), cred.KVNO())
+	}
+
+	if cred = credential.NewFromKeytab("other", kt); cred.DomainName() != "CONTOSO.NET" {
+		t.Errorf("credential: unexpected realm %q", cred.DomainName())
+	}
+
+	if cred = credential.NewFromKeytabFile("svc@CONTOSO.NET", "/nonexistent.keytab"); cred.(interface{ Err() error }).Err() == nil {
+		t.Errorf("credential: expected load error")
+	}
+}
+
+func TestWithEncryptionKeys(t *testing.T) {
+
+	aes, _ := credential.ParseAESKey("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+
+	cred := credential.NewFromEncryptionKeys("CONTOSO.NET\\svc", []credential.EncryptionKey{
+		aes, {EType: credential.ETypeRC4, Key: make([]byte, 16)},
+	}, credential.KVNO(3))
+
+	creds := WithEncryptionKeys(credentials.New(cred.UserName(), cred.DomainName()), cred.EncryptionKeys(), cred.KVNO())
+
+	key, kvno, err := creds.Keytab().GetEncryptionKey(creds.CName(), "CONTOSO.NET", 0, etypeID.AES256_CTS_HMAC_SHA1_96)
+	if err != nil || kvno != 3 || string(key.KeyValue) != string(aes.Key) {
+		t.Fatalf("get key: kvno %d: %v", kvno, err)
+	}
+
+	if _, ok := credential.NTHashFromKeys(cred); !ok {
+		t.Fatalf("nt hash: rc4-hmac key is not found")
+	}
+
+	if _, ok := credential.NTHashFromKeys(credential.NewFromEncryptionKeys("svc", []credential.EncryptionKey{aes})); ok {
+		t.Fatalf("nt hash: unexpected nt hash for aes key")
+	}
+
+	if !IsValidCredential(cred) {
+		t.Fatalf("encryption keys credential is not valid")
+	}
+}
@@ -0,0 +1,185 @@
+package krb5
+
+// password.go contains the key derivation from the password kept in the locked
+// memory (see credential.Secure), so that the password is not copied into the
+// string for the kerberos client.
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/jcmturner/gokrb5/v8/config"
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/crypto/rfc3962"
+	"github.com/jcmturner/gokrb5/v8/crypto/rfc8009"
+	"github.com/jcmturner/gokrb5/v8/iana/errorcode"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+)
+
+// PasswordKey function derives the key of the encryption type `etype` from the
+// password credential `cred` with the `salt` and the string-to-key parameters
+// `s2kparams` (the default parameters are used if empty). The password is read
+// with credential.PasswordBytes and the intermediate buffers are zeroized.
+func PasswordKey(cred credential.Password, etype int32, salt, s2kparams string) (types.EncryptionKey, error) {
+
+	et, err := krb5crypto.GetEtype(etype)
+	if err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("password key: %w", err)
+	}
+
+	if s2kparams == "" {
+		s2kparams = et.GetDefaultStringToKeyParams()
+	}
+
+	var key []byte
+
+	switch etype {
+	case etypeID.RC4_HMAC:
+
+		pass := credential.PasswordUTF16(cred)
+		defer clear(pass)
+
+		if key, err = crypto.MD4(pass); err != nil {
+			return types.EncryptionKey{}, fmt.Errorf("password key: %w", err)
+		}
+
+	case etypeID.AES128_CTS_HMAC_SHA1_96, etypeID.AES256_CTS_HMAC_SHA1_96:
+
+		iter, err := rfc3962.S2KparamsToItertions(s2kparams)
+		if err != nil {
+			return types.EncryptionKey{}, fmt.Errorf("password key: %w", err)
+		}
+
+		tkey := pbkdf2.Key(credential.PasswordBytes(cred), []byte(salt), int(iter), et.GetKeyByteSize(), et.GetHashFunc())
+		defer clear(tkey)
+
+		if key, err = et.DeriveKey(et.RandomToKey(tkey), []byte("kerberos")); err != nil {
+			return types.EncryptionKey{}, fmt.Errorf("password key: %w", err)
+		}
+
+	case etypeID.AES128_CTS_HMAC_SHA256_128, etypeID.AES256_CTS_HMAC_SHA384_192:
+
+		iter, err := rfc8009.S2KparamsToItertions(s2kparams)
+		if err != nil {
+			return types.EncryptionKey{}, fmt.Errorf("password key: %w", err)
+		}
+
+		name, kl := "aes128-cts-hmac-sha256-128", et.GetKeyByteSize()
+		if etype == etypeID.AES256_CTS_HMAC_SHA384_192 {
+			name, kl = "aes256-cts-hmac-sha384-192", 32
+		}
+
+		tkey := pbkdf2.Key(credential.PasswordBytes(cred), []byte(rfc8009.GetSaltP(salt, name)), iter, kl, et.GetHashFunc())
+		defer clear(tkey)
+
+		if key, err = et.DeriveKey(et.RandomToKey(tkey), []byte("kerberos")); err != nil {
+			return types.EncryptionKey{}, fmt.Errorf("password key: %w", err)
+		}
+
+	default:
+		return types.EncryptionKey{}, fmt.Errorf("password key: unsupported encryption type %d", etype)
+	}
+
+	return types.EncryptionKey{KeyType: etype, KeyValue: key}, nil
+}
+
+// passwordKeys function returns the keys of the encryption types `etypes`
+// derived from the password credential `cred` with the salts returned by the
+// KDC (or the default salt, if the KDC has not returned the salt for the
+// encryption type).
+func passwordKeys(ctx context.Context, cfg *config.Config, cred credential.Password, cname types.PrincipalName, realm string, etypes []int32) ([]credential.EncryptionKey, error) {
+
+	info, err := passwordSalts(ctx, cfg, cname, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []credential.EncryptionKey
+
+	for _, etype := range etypes {
+
+		salt, s2kparams := cname.GetSalt(realm), ""
+		for _, entry := range info {
+			if entry.EType == etype {
+				salt, s2kparams = entry.Salt, hex.EncodeToString(entry.S2KParams)
+				break
+			}
+		}
+
+		key, err := PasswordKey(cred, etype, salt, s2kparams)
+		if err != nil {
+			// the encryption type is not supported.
+			continue
+		}
+
+		keys = append(keys, credential.EncryptionKey{EType: key.KeyType, Key: key.KeyValue})
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("password key: no supported encryption types")
+	}
+
+	return keys, nil
+}
+
+// passwordSalts function returns the ETYPE-INFO2 (the salts and the string-to-key
+// parameters) of the client principal requested from the KDC with the AS-REQ
+// without the pre-authentication data.
+func passwordSalts(ctx context.Context, cfg *config.Config, cname types.PrincipalName, realm string) (types.ETypeInfo2, error) {
+
+	asReq, err := messages.NewASReqForTGT(realm, cfg, cname)
+	if err != nil {
+		return nil, fmt.Errorf("password salt: new as-req: %w", err)
+	}
+
+	b, err := asReq.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("password salt: marshal as-req: %w", err)
+	}
+
+	if b, err = sendToKDC(ctx, cfg, realm, b); err != nil {
+		return nil, fmt.Errorf("password salt: %w", err)
+	}
+
+	var (
+		asRep  messages.ASRep
+		padata types.PADataSequence
+	)
+
+	if err = asRep.Unmarshal(b); err == nil {
+		// the pre-authentication is not required.
+		padata = asRep.PAData
+	} else {
+		krbErr, ok := err.(messages.KRBError)
+		if !ok {
+			return nil, fmt.Errorf("password salt: as-rep: %w", err)
+		}
+		if krbErr.ErrorCode != errorcode.KDC_ERR_PREAUTH_REQUIRED {
+			return nil, fmt.Errorf("password salt: as-rep: %w", krbErr)
+		}
+		if err := padata.Unmarshal(krbErr.EData); err != nil {
+			return nil, fmt.Errorf("password salt: padata: %w", err)
+		}
+	}
+
+	for _, pa := range padata {
+		if pa.PADataType != patype.PA_ETYPE_INFO2 {
+			continue
+		}
+		var info types.ETypeInfo2
+		if err := info.Unmarshal(pa.PADataValue); err != nil {
+			return nil, fmt.Errorf("password salt: etype-info2: %w", err)
+		}
+		return info, nil
+	}
+
+	return nil, nil
+}
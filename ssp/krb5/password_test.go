@@ -0,0 +1,100 @@
+package krb5
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/jcmturner/gofork/encoding/asn1"
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/iana/errorcode"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+func TestPasswordKey(t *testing.T) {
+
+	cred := credential.NewFromPasswordBytes("CONTOSO.NET\\user", []byte("P@ssw0rd"))
+	defer credential.Destroy(cred)
+
+	for _, etype := range []int32{
+		etypeID.RC4_HMAC,
+		etypeID.AES128_CTS_HMAC_SHA1_96,
+		etypeID.AES256_CTS_HMAC_SHA1_96,
+		etypeID.AES128_CTS_HMAC_SHA256_128,
+		etypeID.AES256_CTS_HMAC_SHA384_192,
+	} {
+
+		et, _ := krb5crypto.GetEtype(etype)
+
+		expected, err := et.StringToKey("P@ssw0rd", "CONTOSO.NETuser", et.GetDefaultStringToKeyParams())
+		if err != nil {
+			t.Fatalf("%d: string to key: %v", etype, err)
+		}
+
+		key, err := PasswordKey(cred, etype, "CONTOSO.NETuser", "")
+		if err != nil || !bytes.Equal(key.KeyValue, expected) {
+			t.Fatalf("%d: unexpected key: %x (%v)", etype, key.KeyValue, err)
+		}
+	}
+
+	// the rc4-hmac key is the nt hash of the utf-16 password.
+	pass, _ := utf16le.Encode("P@ssw0rdé")
+	expected, _ := crypto.MD4(pass)
+
+	key, err := PasswordKey(credential.NewFromPasswordBytes("user", []byte("P@ssw0rdé")), etypeID.RC4_HMAC, "", "")
+	if err != nil || !bytes.Equal(key.KeyValue, expected) {
+		t.Fatalf("unexpected rc4-hmac key: %x (%v)", key.KeyValue, err)
+	}
+}
+
+func TestPasswordKeys(t *testing.T) {
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	defer l.Close()
+
+	cfg := testKRB5Config(t, l.Addr())
+
+	// the salt of the renamed account differs from the default salt.
+	go serveKDC(t, l, func(b []byte) ([]byte, error) {
+
+		var asReq messages.ASReq
+		if err := asReq.Unmarshal(b); err != nil {
+			return nil, err
+		}
+
+		info, _ := asn1.Marshal(types.ETypeInfo2{{EType: etypeID.AES256_CTS_HMAC_SHA1_96, Salt: "CONTOSO.NETUser"}})
+
+		e := messages.NewKRBError(asReq.ReqBody.SName, asReq.ReqBody.Realm, errorcode.KDC_ERR_PREAUTH_REQUIRED, "")
+		e.EData, _ = asn1.Marshal(types.PADataSequence{{PADataType: patype.PA_ETYPE_INFO2, PADataValue: info}})
+
+		return e.Marshal()
+	})
+
+	cred := credential.NewFromPasswordBytes("user", []byte("password"))
+	cname := types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, "user")
+
+	keys, err := passwordKeys(context.Background(), cfg, cred, cname, "CONTOSO.NET", []int32{etypeID.AES256_CTS_HMAC_SHA1_96, etypeID.RC4_HMAC})
+	if err != nil {
+		t.Fatalf("password keys: %v", err)
+	}
+
+	et, _ := krb5crypto.GetEtype(etypeID.AES256_CTS_HMAC_SHA1_96)
+	expected, _ := et.StringToKey("password", "CONTOSO.NETUser", et.GetDefaultStringToKeyParams())
+
+	if len(keys) != 2 || !bytes.Equal(keys[0].Key, expected) || keys[1].EType != etypeID.RC4_HMAC {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+}
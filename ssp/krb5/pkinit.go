@@ -0,0 +1,416 @@
+package krb5
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+// pkinit.go module contains the Kerberos PKINIT (RFC 4556, MS-PKCA) client
+// implementation: the AS exchange is pre-authenticated with the certificate
+// and the reply key is derived from the Diffie-Hellman exchange.
+
+var (
+	oidPKINITAuthData  = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 2, 3, 1}
+	oidPKINITDHKeyData = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 2, 3, 2}
+	oidDHPublicNumber  = asn1.ObjectIdentifier{1, 2, 840, 10046, 2, 1}
+)
+
+// The 2048-bit MODP group (RFC 3526 group 14) prime.
+var dhGroup14P, _ = new(big.Int).SetString(""+
+	"FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD1"+
+	"29024E088A67CC74020BBEA63B139B22514A08798E3404DD"+
+	"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245"+
+	"E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED"+
+	"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3D"+
+	"C2007CB8A163BF0598DA48361C55D39A69163FA8FD24CF5F"+
+	"83655D23DCA3AD961C62F356208552BB9ED529077096966D"+
+	"670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B"+
+	"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9"+
+	"DE2BCBF6955817183995497CEA956AE515D2261898FA0510"+
+	"15728E5A8AACAA68FFFFFFFFFFFFFFFF", 16)
+
+// pkAuthenticator structure represents the PKINIT PKAuthenticator.
+type pkAuthenticator struct {
+	CUSec      int       `asn1:"explicit,tag:0"`
+	CTime      time.Time `asn1:"generalized,explicit,tag:1"`
+	Nonce      int64     `asn1:"explicit,tag:2"`
+	PAChecksum []byte    `asn1:"explicit,optional,tag:3"`
+}
+
+// authPack structure represents the PKINIT AuthPack.
+type authPack struct {
+	PKAuthenticator   pkAuthenticator            `asn1:"explicit,tag:0"`
+	ClientPublicValue subjectPublicKeyInfo       `asn1:"explicit,optional,tag:1"`
+	SupportedCMSTypes []pkix.AlgorithmIdentifier `asn1:"explicit,optional,tag:2"`
+}
+
+// subjectPublicKeyInfo structure represents the SubjectPublicKeyInfo.
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// dhDomainParameters structure represents the Diffie-Hellman domain
+// parameters (RFC 3279).
+type dhDomainParameters struct {
+	P *big.Int
+	G *big.Int
+	Q *big.Int
+}
+
+// paPKASReq structure represents the PA-PK-AS-REQ.
+type paPKASReq struct {
+	SignedAuthPack []byte `asn1:"tag:0"`
+}
+
+// dhRepInfo structure represents the PA-PK-AS-REP dhInfo.
+type dhRepInfo struct {
+	DHSignedData  []byte `asn1:"tag:0"`
+	ServerDHNonce []byte `asn1:"explicit,optional,tag:1"`
+}
+
+// kdcDHKeyInfo structure represents the KDCDHKeyInfo.
+type kdcDHKeyInfo struct {
+	SubjectPublicKey asn1.BitString `asn1:"explicit,tag:0"`
+	Nonce            int64          `asn1:"explicit,tag:1"`
+	DHKeyExpiration  time.Time      `asn1:"generalized,explicit,optional,tag:2"`
+}
+
+// dhKey structure represents the client Diffie-Hellman key.
+type dhKey struct {
+	p, g, q, x, y *big.Int
+}
+
+// newDHKey function generates the Diffie-Hellman key for the group 14.
+func newDHKey() (*dhKey, error) {
+
+	k := &dhKey{p: dhGroup14P, g: big.NewInt(2)}
+	k.q = new(big.Int).Rsh(new(big.Int).Sub(k.p, big.NewInt(1)), 1)
+
+	for {
+		x, err := rand.Int(rand.Reader, k.q)
+		if err != nil {
+			return nil, err
+		}
+		if x.Cmp(big.NewInt(1)) > 0 {
+			k.x = x
+			break
+		}
+	}
+
+	k.y = new(big.Int).Exp(k.g, k.x, k.p)
+
+	return k, nil
+}
+
+// publicKeyInfo function returns the client public value.
+func (k *dhKey) publicKeyInfo() (subjectPublicKeyInfo, error) {
+
+	params, err := asn1.Marshal(dhDomainParameters{P: k.p, G: k.g, Q: k.q})
+	if err != nil {
+		return subjectPublicKeyInfo{}, err
+	}
+
+	y, err := asn1.Marshal(k.y)
+	if err != nil {
+		return subjectPublicKeyInfo{}, err
+	}
+
+	return subjectPublicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidDHPublicNumber, Parameters: asn1.RawValue{FullBytes: params}},
+		PublicKey: asn1.BitString{Bytes: y, BitLength: len(y) * 8},
+	}, nil
+}
+
+// sharedSecret function returns the shared secret for the KDC public key
+// `pub` (the DER-encoded INTEGER) padded to the length of the prime.
+func (k *dhKey) sharedSecret(pub []byte) ([]byte, error) {
+
+	y := new(big.Int)
+	if _, err := asn1.Unmarshal(pub, &y); err != nil {
+		return nil, fmt.Errorf("kdc public key: %w", err)
+	}
+
+	if y.Cmp(big.NewInt(1)) <= 0 || y.Cmp(new(big.Int).Sub(k.p, big.NewInt(1))) >= 0 {
+		return nil, fmt.Errorf("kdc public key is out of range")
+	}
+
+	return new(big.Int).Exp(y, k.x, k.p).FillBytes(make([]byte, (k.p.BitLen()+7)/8)), nil
+}
+
+// octetString2Key function derives the reply key of the encryption type
+// `etype` from the shared secret (RFC 4556 section 3.2.3.1).
+func octetString2Key(secret []byte, etype int32) (types.EncryptionKey, error) {
+
+	switch etype {
+	case etypeID.AES128_CTS_HMAC_SHA1_96, etypeID.AES256_CTS_HMAC_SHA1_96,
+		etypeID.AES128_CTS_HMAC_SHA256_128, etypeID.AES256_CTS_HMAC_SHA384_192,
+		etypeID.RC4_HMAC:
+	default:
+		return types.EncryptionKey{}, fmt.Errorf("unsupported encryption type %d", etype)
+	}
+
+	et, err := krb5crypto.GetEtype(etype)
+	if err != nil {
+		return types.EncryptionKey{}, err
+	}
+
+	// k-truncate(SHA1(0x00 | x) | SHA1(0x01 | x) | ...); the random-to-key
+	// function is identity for the supported encryption types.
+	var b []byte
+	for i := 0; len(b) < et.GetKeyByteSize(); i++ {
+		h := sha1.New()
+		h.Write([]byte{byte(i)})
+		h.Write(secret)
+		b = h.Sum(b)
+	}
+
+	return types.EncryptionKey{KeyType: etype, KeyValue: b[:et.GetKeyByteSize()]}, nil
+}
+
+// PKINIT function performs the PKINIT AS exchange with the certificate
+// credential `cred` and returns the credentials cache with the ticket
+// granting ticket. The KDC certificate is verified with the PKINITRootCAs
+// (or the system roots) unless PKINITInsecureSkipVerify is set.
+func PKINIT(ctx context.Context, c *Config, cred credential.Certificate) (*credentials.CCache, error) {
+
+	cert := cred.Certificate()
+	if cert == nil {
+		return nil, fmt.Errorf("pkinit: no certificate")
+	}
+
+	signer, ok := cred.PrivateKey().(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("pkinit: private key does not implement crypto.Signer")
+	}
+
+	realm := strings.ToUpper(cred.DomainName())
+	if realm == "" {
+		realm = c.KRB5Config.LibDefaults.DefaultRealm
+	}
+
+	cname := types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, cred.UserName())
+
+	asReq, err := messages.NewASReqForTGT(realm, c.KRB5Config, cname)
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: new as-req: %w", err)
+	}
+
+	// request the encryption types supported by the key derivation.
+	etypes := []int32{}
+	for _, etype := range asReq.ReqBody.EType {
+		if _, err := octetString2Key(nil, etype); err == nil {
+			etypes = append(etypes, etype)
+		}
+	}
+
+	if len(etypes) == 0 {
+		return nil, fmt.Errorf("pkinit: no supported encryption types")
+	}
+
+	asReq.ReqBody.EType = etypes
+
+	body, err := asReq.ReqBody.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: marshal as-req body: %w", err)
+	}
+
+	dh, err := newDHKey()
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: generate dh key: %w", err)
+	}
+
+	pubKey, err := dh.publicKeyInfo()
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: marshal dh key: %w", err)
+	}
+
+	nonce, err := rand.Int(rand.Reader, big.NewInt(1<<32))
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: generate nonce: %w", err)
+	}
+
+	checksum, now := sha1.Sum(body), time.Now().UTC()
+
+	ap, err := asn1.Marshal(authPack{
+		PKAuthenticator: pkAuthenticator{
+			CUSec:      now.Nanosecond() / 1000,
+			CTime:      now.Truncate(time.Second),
+			Nonce:      nonce.Int64(),
+			PAChecksum: checksum[:],
+		},
+		ClientPublicValue: pubKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: marshal auth pack: %w", err)
+	}
+
+	signedAuthPack, err := signCMS(oidPKINITAuthData, ap, cert, signer)
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: sign auth pack: %w", err)
+	}
+
+	pa, err := asn1.Marshal(paPKASReq{SignedAuthPack: signedAuthPack})
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: marshal pa-pk-as-req: %w", err)
+	}
+
+	asReq.PAData = append(asReq.PAData, types.PAData{PADataType: patype.PA_PK_AS_REQ, PADataValue: pa})
+
+	b, err := asReq.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: marshal as-req: %w", err)
+	}
+
+	if b, err = sendToKDC(ctx, c.KRB5Config, realm, b); err != nil {
+		return nil, fmt.Errorf("pkinit: %w", err)
+	}
+
+	var asRep messages.ASRep
+	// the krb-error is returned as error.
+	if err := asRep.Unmarshal(b); err != nil {
+		return nil, fmt.Errorf("pkinit: as-rep: %w", err)
+	}
+
+	key, err := pkinitReplyKey(c, &asRep, dh, nonce.Int64())
+	if err != nil {
+		return nil, fmt.Errorf("pkinit: %w", err)
+	}
+
+	if err := decryptASRep(&asRep, &asReq, key); err != nil {
+		return nil, fmt.Errorf("pkinit: %w", err)
+	}
+
+	return newCCache(&asRep)
+}
+
+// pkinitReplyKey function verifies the PA-PK-AS-REP and derives the AS-REP
+// reply key.
+func pkinitReplyKey(c *Config, asRep *messages.ASRep, dh *dhKey, nonce int64) (types.EncryptionKey, error) {
+
+	var pa []byte
+	for _, p := range asRep.PAData {
+		if p.PADataType == patype.PA_PK_AS_REP {
+			pa = p.PADataValue
+		}
+	}
+
+	if pa == nil {
+		return types.EncryptionKey{}, fmt.Errorf("as-rep: no pa-pk-as-rep")
+	}
+
+	// PA-PK-AS-REP ::= CHOICE { dhInfo [0] DHRepInfo, encKeyPack [1] ... }
+	var rep asn1.RawValue
+	if _, err := asn1.Unmarshal(pa, &rep); err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("pa-pk-as-rep: %w", err)
+	}
+
+	if rep.Class != asn1.ClassContextSpecific || rep.Tag != 0 {
+		return types.EncryptionKey{}, fmt.Errorf("pa-pk-as-rep: unsupported reply [%d], expected dhInfo", rep.Tag)
+	}
+
+	var info dhRepInfo
+	if _, err := asn1.Unmarshal(rep.Bytes, &info); err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("pa-pk-as-rep: dh info: %w", err)
+	}
+
+	var opts *x509.VerifyOptions
+	if !c.PKINITInsecureSkipVerify {
+		opts = &x509.VerifyOptions{Roots: c.PKINITRootCAs}
+	}
+
+	content, _, err := verifyCMS(info.DHSignedData, oidPKINITDHKeyData, opts)
+	if err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("pa-pk-as-rep: %w", err)
+	}
+
+	var keyInfo kdcDHKeyInfo
+	if _, err := asn1.Unmarshal(content, &keyInfo); err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("pa-pk-as-rep: kdc dh key info: %w", err)
+	}
+
+	if keyInfo.Nonce != nonce {
+		return types.EncryptionKey{}, fmt.Errorf("pa-pk-as-rep: nonce mismatch")
+	}
+
+	secret, err := dh.sharedSecret(keyInfo.SubjectPublicKey.Bytes)
+	if err != nil {
+		return types.EncryptionKey{}, fmt.Errorf("pa-pk-as-rep: %w", err)
+	}
+
+	return octetString2Key(secret, asRep.EncPart.EType)
+}
+
+// decryptASRep function decrypts and verifies the AS-REP encrypted part.
+func decryptASRep(asRep *messages.ASRep, asReq *messages.ASReq, key types.EncryptionKey) error {
+
+	b, err := krb5crypto.DecryptEncPart(asRep.EncPart, key, keyusage.AS_REP_ENCPART)
+	if err != nil {
+		return fmt.Errorf("as-rep: decrypt: %w", err)
+	}
+
+	if err := asRep.DecryptedEncPart.Unmarshal(b); err != nil {
+		return fmt.Errorf("as-rep: %w", err)
+	}
+
+	if asRep.DecryptedEncPart.Nonce != asReq.ReqBody.Nonce {
+		return fmt.Errorf("as-rep: nonce mismatch")
+	}
+
+	if !strings.EqualFold(asRep.CRealm, asReq.ReqBody.Realm) {
+		return fmt.Errorf("as-rep: realm mismatch: %s", asRep.CRealm)
+	}
+
+	return nil
+}
+
+// newCCache function returns the credentials cache with the ticket from the
+// decrypted AS-REP.
+func newCCache(asRep *messages.ASRep) (*credentials.CCache, error) {
+
+	tkt, err := asRep.Ticket.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal ticket: %w", err)
+	}
+
+	enc := asRep.DecryptedEncPart
+
+	cred := &credentials.Credential{
+		Key:         enc.Key,
+		AuthTime:    enc.AuthTime,
+		StartTime:   enc.StartTime,
+		EndTime:     enc.EndTime,
+		RenewTill:   enc.RenewTill,
+		TicketFlags: enc.Flags,
+		Addresses:   enc.CAddr,
+		Ticket:      tkt,
+	}
+
+	cred.Client.Realm, cred.Client.PrincipalName = asRep.CRealm, asRep.CName
+	cred.Server.Realm, cred.Server.PrincipalName = enc.SRealm, enc.SName
+
+	cc := &credentials.CCache{Version: 4, Credentials: []*credentials.Credential{cred}}
+	cc.DefaultPrincipal.Realm, cc.DefaultPrincipal.PrincipalName = asRep.CRealm, asRep.CName
+
+	return cc, nil
+}
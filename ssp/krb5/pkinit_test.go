@@ -0,0 +1,422 @@
+package krb5
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+// newTestCertificate function returns the certificate signed by the `parent`
+// (or self-signed).
+func newTestCertificate(t *testing.T, cn string, key crypto.Signer, parent *x509.Certificate, parentKey crypto.Signer, exts ...pkix.Extension) *x509.Certificate {
+
+	tmpl := &x509.Certificate{
+		SerialNumber:    big.NewInt(time.Now().UnixNano()),
+		Subject:         pkix.Name{CommonName: cn},
+		NotBefore:       time.Now().Add(-time.Hour),
+		NotAfter:        time.Now().Add(time.Hour),
+		KeyUsage:        x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtraExtensions: exts,
+	}
+
+	if parent == nil {
+		tmpl.IsCA, tmpl.BasicConstraintsValid = true, true
+		parent, parentKey = tmpl, key
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(b)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+
+	return cert
+}
+
+// upnExtension function returns the subject alternative name extension with
+// the user principal name.
+func upnExtension(upn string) pkix.Extension {
+	val, _ := asn1.MarshalWithParams(upn, "utf8")
+	val, _ = asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: val})
+	oid, _ := asn1.Marshal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3})
+	name, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: append(oid, val...)})
+	san, _ := asn1.Marshal(asn1.RawValue{Tag: asn1.TagSequence, IsCompound: true, Bytes: name})
+	return pkix.Extension{Id: asn1.ObjectIdentifier{2, 5, 29, 17}, Value: san}
+}
+
+func TestDHGroup14(t *testing.T) {
+
+	if dhGroup14P.BitLen() != 2048 || !dhGroup14P.ProbablyPrime(20) {
+		t.Fatalf("group 14: invalid prime")
+	}
+
+	a, err := newDHKey()
+	if err != nil {
+		t.Fatalf("new dh key: %v", err)
+	}
+
+	b, err := newDHKey()
+	if err != nil {
+		t.Fatalf("new dh key: %v", err)
+	}
+
+	ya, _ := asn1.Marshal(a.y)
+	yb, _ := asn1.Marshal(b.y)
+
+	za, err := a.sharedSecret(yb)
+	if err != nil {
+		t.Fatalf("shared secret: %v", err)
+	}
+
+	zb, err := b.sharedSecret(ya)
+	if err != nil {
+		t.Fatalf("shared secret: %v", err)
+	}
+
+	if !bytes.Equal(za, zb) || len(za) != 256 {
+		t.Fatalf("shared secret: mismatch")
+	}
+
+	one, _ := asn1.Marshal(big.NewInt(1))
+	if _, err := a.sharedSecret(one); err == nil {
+		t.Errorf("shared secret: expected out of range error")
+	}
+
+	key, err := octetString2Key(za, etypeID.AES256_CTS_HMAC_SHA1_96)
+	if err != nil || len(key.KeyValue) != 32 {
+		t.Fatalf("octetstring2key: %v", err)
+	}
+
+	if h := sha1.Sum(append([]byte{0}, za...)); !bytes.Equal(key.KeyValue[:20], h[:]) {
+		t.Errorf("octetstring2key: unexpected key")
+	}
+
+	if _, err := octetString2Key(za, etypeID.DES3_CBC_SHA1_KD); err == nil {
+		t.Errorf("octetstring2key: expected unsupported encryption type error")
+	}
+}
+
+func TestCMS(t *testing.T) {
+
+	caKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ca := newTestCertificate(t, "CA", caKey, nil, nil)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	for _, key := range []crypto.Signer{rsaKey, ecKey} {
+
+		cert := newTestCertificate(t, "signer", key, ca, caKey)
+
+		b, err := signCMS(oidPKINITAuthData, []byte("content"), cert, key)
+		if err != nil {
+			t.Fatalf("%T: sign: %v", key, err)
+		}
+
+		content, signer, err := verifyCMS(b, oidPKINITAuthData, &x509.VerifyOptions{Roots: roots})
+		if err != nil {
+			t.Fatalf("%T: verify: %v", key, err)
+		}
+
+		if string(content) != "content" || !signer.Equal(cert) {
+			t.Errorf("%T: verify: unexpected content or signer", key)
+		}
+
+		if _, _, err := verifyCMS(b, oidPKINITDHKeyData, nil); err == nil {
+			t.Errorf("%T: verify: expected content type error", key)
+		}
+
+		if _, _, err := verifyCMS(b, oidPKINITAuthData, &x509.VerifyOptions{Roots: x509.NewCertPool()}); err == nil {
+			t.Errorf("%T: verify: expected untrusted certificate error", key)
+		}
+
+		// corrupt the content.
+		if i := bytes.Index(b, []byte("content")); i > 0 {
+			b[i] ^= 0xff
+		}
+
+		if _, _, err := verifyCMS(b, oidPKINITAuthData, nil); err == nil {
+			t.Errorf("%T: verify: expected digest error", key)
+		}
+	}
+}
+
+// testKDC structure represents the fake KDC that serves the PKINIT AS
+// exchange.
+type testKDC struct {
+	roots   *x509.CertPool
+	cert    *x509.Certificate
+	key     crypto.Signer
+	session types.EncryptionKey
+}
+
+// serveKDC function serves the single KDC exchange with the `handle`
+// function.
+func serveKDC(t *testing.T, l net.Listener, handle func([]byte) ([]byte, error)) {
+
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+
+	defer conn.Close()
+
+	var hdr [4]byte
+	if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+		return
+	}
+
+	b := make([]byte, binary.BigEndian.Uint32(hdr[:]))
+	if _, err := io.ReadFull(conn, b); err != nil {
+		return
+	}
+
+	rep, err := handle(b)
+	if err != nil {
+		t.Errorf("kdc: %v", err)
+		return
+	}
+
+	conn.Write(append(binary.BigEndian.AppendUint32(nil, uint32(len(rep))), rep...))
+}
+
+// testKRB5Config function returns the kerberos configuration for the
+// CONTOSO.NET realm with the KDC `addr`.
+func testKRB5Config(t *testing.T, addr net.Addr) *config.Config {
+
+	krb5conf, err := config.NewFromString(fmt.Sprintf(`[libdefaults]
+  default_realm = CONTOSO.NET
+  dns_lookup_kdc = false
+  noaddresses = true
+  default_tkt_enctypes = aes256-cts-hmac-sha1-96 des3-cbc-sha1
+  default_tgs_enctypes = aes256-cts-hmac-sha1-96
+
+[realms]
+  CONTOSO.NET = {
+    kdc = %s
+  }
+`, addr))
+	if err != nil {
+		t.Fatalf("krb5 config: %v", err)
+	}
+
+	return ParsedLibDefaults(krb5conf)
+}
+
+// asRep function returns the AS-REP for the AS-REQ `b`.
+func (kdc *testKDC) asRep(b []byte) ([]byte, error) {
+
+	var asReq messages.ASReq
+	if err := asReq.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	var req paPKASReq
+	for _, pa := range asReq.PAData {
+		if pa.PADataType == patype.PA_PK_AS_REQ {
+			if _, err := asn1.Unmarshal(pa.PADataValue, &req); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	content, _, err := verifyCMS(req.SignedAuthPack, oidPKINITAuthData, &x509.VerifyOptions{Roots: kdc.roots})
+	if err != nil {
+		return nil, err
+	}
+
+	var ap authPack
+	if _, err := asn1.Unmarshal(content, &ap); err != nil {
+		return nil, err
+	}
+
+	body, _ := asReq.ReqBody.Marshal()
+	if sum := sha1.Sum(body); !bytes.Equal(sum[:], ap.PKAuthenticator.PAChecksum) {
+		return nil, fmt.Errorf("checksum mismatch")
+	}
+
+	dh, err := newDHKey()
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := dh.sharedSecret(ap.ClientPublicValue.PublicKey.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	etype := asReq.ReqBody.EType[0]
+
+	key, err := octetString2Key(secret, etype)
+	if err != nil {
+		return nil, err
+	}
+
+	y, _ := asn1.Marshal(dh.y)
+	keyInfo, _ := asn1.Marshal(kdcDHKeyInfo{
+		SubjectPublicKey: asn1.BitString{Bytes: y, BitLength: len(y) * 8},
+		Nonce:            ap.PKAuthenticator.Nonce,
+	})
+
+	signed, err := signCMS(oidPKINITDHKeyData, keyInfo, kdc.cert, kdc.key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, _ := asn1.Marshal(dhRepInfo{DHSignedData: signed})
+	pa, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: info})
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	enc := messages.EncKDCRepPart{
+		Key:       kdc.session,
+		LastReqs:  []messages.LastReq{{LRValue: now}},
+		Nonce:     asReq.ReqBody.Nonce,
+		Flags:     types.NewKrbFlags(),
+		AuthTime:  now,
+		StartTime: now,
+		EndTime:   now.Add(10 * time.Hour),
+		SRealm:    asReq.ReqBody.Realm,
+		SName:     asReq.ReqBody.SName,
+	}
+
+	eb, err := enc.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	encPart, err := krb5crypto.GetEncryptedData(eb, key, keyusage.AS_REP_ENCPART, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	rep := messages.ASRep{KDCRepFields: messages.KDCRepFields{
+		PVNO:    5,
+		MsgType: msgtype.KRB_AS_REP,
+		PAData:  []types.PAData{{PADataType: patype.PA_PK_AS_REP, PADataValue: pa}},
+		CRealm:  asReq.ReqBody.Realm,
+		CName:   asReq.ReqBody.CName,
+		Ticket: messages.Ticket{
+			TktVNO:  5,
+			Realm:   asReq.ReqBody.Realm,
+			SName:   asReq.ReqBody.SName,
+			EncPart: types.EncryptedData{EType: etype, KVNO: 1, Cipher: []byte("ticket")},
+		},
+		EncPart: encPart,
+	}}
+
+	return rep.Marshal()
+}
+
+func TestPKINIT(t *testing.T) {
+
+	caKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ca := newTestCertificate(t, "CA", caKey, nil, nil)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	kdcKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	userKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	userCert := newTestCertificate(t, "user", userKey, ca, caKey, upnExtension("user@contoso.net"))
+
+	cred := credential.NewFromCertificate("", userCert, userKey)
+	if cred.UserName() != "user" || cred.DomainName() != "contoso.net" {
+		t.Fatalf("credential: unexpected %s@%s", cred.UserName(), cred.DomainName())
+	}
+
+	for _, tc := range []struct {
+		Name  string
+		Roots *x509.CertPool
+		Skip  bool
+		Err   bool
+	}{
+		{"verify", roots, false, false},
+		{"untrusted", x509.NewCertPool(), false, true},
+		{"skip verify", x509.NewCertPool(), true, false},
+	} {
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("listen: %v", err)
+		}
+
+		kdc := &testKDC{
+			roots:   roots,
+			cert:    newTestCertificate(t, "kdc", kdcKey, ca, caKey),
+			key:     kdcKey,
+			session: types.EncryptionKey{KeyType: etypeID.AES256_CTS_HMAC_SHA1_96, KeyValue: bytes.Repeat([]byte{1}, 32)},
+		}
+
+		go serveKDC(t, l, kdc.asRep)
+
+		c := &Config{
+			KRB5Config:               testKRB5Config(t, l.Addr()),
+			PKINITRootCAs:            tc.Roots,
+			PKINITInsecureSkipVerify: tc.Skip,
+		}
+
+		cc, err := PKINIT(context.Background(), c, cred)
+		l.Close()
+
+		if tc.Err {
+			if err == nil {
+				t.Errorf("%s: expected error", tc.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: pkinit: %v", tc.Name, err)
+		}
+
+		if !bytes.Equal(cc.Credentials[0].Key.KeyValue, kdc.session.KeyValue) {
+			t.Errorf("%s: unexpected session key", tc.Name)
+		}
+
+		cli, err := client.NewFromCCache(cc, c.KRB5Config)
+		if err != nil {
+			t.Fatalf("%s: client from ccache: %v", tc.Name, err)
+		}
+
+		if ok, err := cli.IsConfigured(); !ok {
+			t.Errorf("%s: client is not configured: %v", tc.Name, err)
+		}
+	}
+}
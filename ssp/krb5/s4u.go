@@ -0,0 +1,400 @@
+package krb5
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/jcmturner/gofork/encoding/asn1"
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/iana"
+	"github.com/jcmturner/gokrb5/v8/iana/chksumtype"
+	"github.com/jcmturner/gokrb5/v8/iana/flags"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+)
+
+// s4u.go module contains the Service for User (MS-SFU) extensions: the
+// S4U2Self (protocol transition) and S4U2Proxy (constrained delegation)
+// TGS exchanges.
+
+const (
+	// The cname-in-addl-tkt KDC option (MS-SFU 2.2.3).
+	kdcOptionCNameInAddlTkt = 14
+	// The PA-PAC-OPTIONS pre-authentication data type (MS-KILE 2.2.10).
+	paPACOptions int32 = 167
+	// The resource-based constrained delegation PAC option.
+	pacOptionResourceBasedConstrainedDelegation = 3
+	// The key usage for the PA-FOR-USER checksum.
+	keyUsageNonKerbChecksumSalt = 17
+)
+
+// paForUser structure represents the PA-FOR-USER (MS-SFU 2.2.1).
+type paForUser struct {
+	UserName    types.PrincipalName `asn1:"explicit,tag:0"`
+	UserRealm   string              `asn1:"generalstring,explicit,tag:1"`
+	Cksum       types.Checksum      `asn1:"explicit,tag:2"`
+	AuthPackage string              `asn1:"generalstring,explicit,tag:3"`
+}
+
+// paPACOptionsValue structure represents the PA-PAC-OPTIONS.
+type paPACOptionsValue struct {
+	Flags asn1.BitString `asn1:"explicit,tag:0"`
+}
+
+// newPAForUser function returns the PA-FOR-USER for the `user` of the
+// `realm` protected with the TGT session key.
+func newPAForUser(user types.PrincipalName, realm string, key types.EncryptionKey) (types.PAData, error) {
+
+	pa := paForUser{UserName: user, UserRealm: realm, AuthPackage: "Kerberos"}
+
+	// name-type (little-endian) | name-string... | realm | auth-package.
+	b := []byte{byte(user.NameType), byte(user.NameType >> 8), byte(user.NameType >> 16), byte(user.NameType >> 24)}
+	for _, s := range user.NameString {
+		b = append(b, s...)
+	}
+	b = append(append(b, realm...), pa.AuthPackage...)
+
+	et, err := krb5crypto.GetChksumEtype(chksumtype.KERB_CHECKSUM_HMAC_MD5)
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	cksum, err := et.GetChecksumHash(key.KeyValue, b, keyUsageNonKerbChecksumSalt)
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	pa.Cksum = types.Checksum{CksumType: chksumtype.KERB_CHECKSUM_HMAC_MD5, Checksum: cksum}
+
+	v, err := asn1.Marshal(pa)
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	return types.PAData{PADataType: patype.PA_FOR_USER, PADataValue: v}, nil
+}
+
+// newPAPACOptions function returns the PA-PAC-OPTIONS with the
+// resource-based constrained delegation option.
+func newPAPACOptions() (types.PAData, error) {
+
+	opts := types.NewKrbFlags()
+	types.SetFlag(&opts, pacOptionResourceBasedConstrainedDelegation)
+
+	v, err := asn1.Marshal(paPACOptionsValue{Flags: opts})
+	if err != nil {
+		return types.PAData{}, err
+	}
+
+	return types.PAData{PADataType: paPACOptions, PADataValue: v}, nil
+}
+
+// tgsRequest structure represents the TGS-REQ and the state required to
+// process the TGS-REP.
+type tgsRequest struct {
+	messages.TGSReq
+	// The reply key (the TGT session key or the authenticator subkey).
+	Key types.EncryptionKey
+	// The reply encrypted part key usage.
+	Usage uint32
+	// The FAST armor (the implicit armor for the armored request).
+	Armor *fastArmor
+}
+
+// newTGSReq function returns the TGS-REQ authenticated with the TGT `tgt`
+// and the session key `key` of the client `cname`. The additional `padata`
+// follows the PA-TGS-REQ, or is carried inside the PA-FX-FAST if the request
+// is `armored` (RFC 6113 section 5.4.1.1).
+func newTGSReq(c *config.Config, tgt messages.Ticket, key types.EncryptionKey, cname types.PrincipalName, sname types.PrincipalName,
+	opts []int, tkts []messages.Ticket, armored bool, padata ...types.PAData) (*tgsRequest, error) {
+
+	nonce, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt32))
+	if err != nil {
+		return nil, err
+	}
+
+	req := &tgsRequest{
+		TGSReq: messages.TGSReq{KDCReqFields: messages.KDCReqFields{
+			PVNO:    iana.PVNO,
+			MsgType: msgtype.KRB_TGS_REQ,
+			ReqBody: messages.KDCReqBody{
+				KDCOptions:        types.NewKrbFlags(),
+				Realm:             tgt.Realm,
+				CName:             cname,
+				SName:             sname,
+				Till:              time.Now().UTC().Add(c.LibDefaults.TicketLifetime),
+				Nonce:             int(nonce.Int64()),
+				EType:             c.LibDefaults.DefaultTGSEnctypeIDs,
+				AdditionalTickets: tkts,
+			},
+		}},
+		Key:   key,
+		Usage: keyusage.TGS_REP_ENCPART_SESSION_KEY,
+	}
+
+	for _, opt := range opts {
+		types.SetFlag(&req.ReqBody.KDCOptions, opt)
+	}
+
+	body, err := req.ReqBody.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal tgs-req body: %w", err)
+	}
+
+	et, err := krb5crypto.GetEtype(key.KeyType)
+	if err != nil {
+		return nil, err
+	}
+
+	cksum, err := et.GetChecksumHash(key.KeyValue, body, keyusage.TGS_REQ_PA_TGS_REQ_AP_REQ_AUTHENTICATOR_CHKSUM)
+	if err != nil {
+		return nil, fmt.Errorf("tgs-req checksum: %w", err)
+	}
+
+	auth, err := types.NewAuthenticator(tgt.Realm, cname)
+	if err != nil {
+		return nil, err
+	}
+
+	auth.Cksum = types.Checksum{CksumType: et.GetHashID(), Checksum: cksum}
+
+	if armored {
+		// the implicit armor key is derived from the authenticator subkey,
+		// which also becomes the reply key.
+		if err := auth.GenerateSeqNumberAndSubKey(key.KeyType, et.GetKeyByteSize()); err != nil {
+			return nil, err
+		}
+		armorKey, err := cf2(auth.SubKey, key, "subkeyarmor", "ticketarmor")
+		if err != nil {
+			return nil, fmt.Errorf("tgs-req armor: %w", err)
+		}
+		req.Key, req.Usage, req.Armor = auth.SubKey, keyusage.TGS_REP_ENCPART_AUTHENTICATOR_SUB_KEY, &fastArmor{Key: armorKey}
+	}
+
+	apReq, err := messages.NewAPReq(tgt, key, auth)
+	if err != nil {
+		return nil, fmt.Errorf("tgs-req ap-req: %w", err)
+	}
+
+	b, err := apReq.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("tgs-req ap-req: %w", err)
+	}
+
+	req.PAData = types.PADataSequence{{PADataType: patype.PA_TGS_REQ, PADataValue: b}}
+
+	if req.Armor == nil {
+		req.PAData = append(req.PAData, padata...)
+		return req, nil
+	}
+
+	fx, err := req.Armor.Request(req.ReqBody, padata)
+	if err != nil {
+		return nil, fmt.Errorf("tgs-req: %w", err)
+	}
+
+	req.PAData = append(req.PAData, fx)
+
+	return req, nil
+}
+
+// tgsExchange function sends the TGS-REQ to the KDC and returns the
+// decrypted TGS-REP.
+func tgsExchange(ctx context.Context, c *config.Config, req *tgsRequest) (messages.TGSRep, error) {
+
+	var rep messages.TGSRep
+
+	b, err := req.Marshal()
+	if err != nil {
+		return rep, fmt.Errorf("marshal tgs-req: %w", err)
+	}
+
+	if b, err = sendToKDC(ctx, c, req.ReqBody.Realm, b); err != nil {
+		return rep, err
+	}
+
+	// the krb-error is returned as error.
+	if err := rep.Unmarshal(b); err != nil {
+		if krbErr, ok := err.(messages.KRBError); ok && req.Armor != nil {
+			krbErr, _ = req.Armor.Error(krbErr)
+			err = krbErr
+		}
+		return rep, fmt.Errorf("tgs-rep: %w", err)
+	}
+
+	key := req.Key
+
+	if req.Armor != nil {
+		resp, err := req.Armor.Response(rep.PAData)
+		if err != nil {
+			return rep, fmt.Errorf("tgs-rep: %w", err)
+		}
+		if key, err = req.Armor.ReplyKey(resp, req.ReqBody.Nonce, rep.Ticket, key); err != nil {
+			return rep, fmt.Errorf("tgs-rep: %w", err)
+		}
+		rep.CName, rep.CRealm = resp.Finished.CName, resp.Finished.CRealm
+	}
+
+	if b, err = krb5crypto.DecryptEncPart(rep.EncPart, key, req.Usage); err != nil {
+		return rep, fmt.Errorf("tgs-rep: decrypt: %w", err)
+	}
+
+	if err := rep.DecryptedEncPart.Unmarshal(b); err != nil {
+		return rep, fmt.Errorf("tgs-rep: %w", err)
+	}
+
+	if rep.DecryptedEncPart.Nonce != req.ReqBody.Nonce {
+		return rep, fmt.Errorf("tgs-rep: nonce mismatch")
+	}
+
+	return rep, nil
+}
+
+// requestServiceTicket function returns the service ticket to the `spn`
+// ("service/host") with the TGT `tgt` and the session `key` of the client
+// `cname`.
+func requestServiceTicket(ctx context.Context, c *config.Config, tgt messages.Ticket, key types.EncryptionKey,
+	cname types.PrincipalName, spn string, armored bool) (messages.Ticket, types.EncryptionKey, error) {
+
+	sname := types.NewPrincipalName(nametype.KRB_NT_SRV_INST, spn)
+
+	req, err := newTGSReq(c, tgt, key, cname, sname,
+		[]int{flags.Forwardable, flags.Renewable, flags.Canonicalize}, nil, armored)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, err
+	}
+
+	rep, err := tgsExchange(ctx, c, req)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, err
+	}
+
+	return rep.Ticket, rep.DecryptedEncPart.Key, nil
+}
+
+// S4U2Self function requests the service ticket to the service `cname` itself
+// on behalf of the `user` of the `userRealm` (protocol transition). The `tgt`
+// and the session `key` are the ticket-granting ticket of the service. The
+// ticket is forwardable (and can be used with S4U2Proxy) only if the service
+// is trusted to authenticate for delegation.
+func S4U2Self(ctx context.Context, c *config.Config, tgt messages.Ticket, key types.EncryptionKey,
+	cname types.PrincipalName, user types.PrincipalName, userRealm string) (messages.Ticket, types.EncryptionKey, error) {
+	return s4u2Self(ctx, c, tgt, key, cname, user, userRealm, false)
+}
+
+func s4u2Self(ctx context.Context, c *config.Config, tgt messages.Ticket, key types.EncryptionKey,
+	cname types.PrincipalName, user types.PrincipalName, userRealm string, armored bool) (messages.Ticket, types.EncryptionKey, error) {
+
+	pa, err := newPAForUser(user, userRealm, key)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u2self: pa-for-user: %w", err)
+	}
+
+	req, err := newTGSReq(c, tgt, key, cname, cname,
+		[]int{flags.Forwardable, flags.Renewable, flags.Canonicalize}, nil, armored, pa)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u2self: %w", err)
+	}
+
+	rep, err := tgsExchange(ctx, c, req)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u2self: %w", err)
+	}
+
+	if !strings.EqualFold(rep.CName.PrincipalNameString(), user.PrincipalNameString()) {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u2self: unexpected client %s", rep.CName.PrincipalNameString())
+	}
+
+	return rep.Ticket, rep.DecryptedEncPart.Key, nil
+}
+
+// S4U2Proxy function requests the service ticket to the service `sname` on
+// behalf of the user identified by the evidence ticket `tkt` (the S4U2Self
+// ticket or the service ticket presented by the user), (constrained
+// delegation). The `tgt` and the session `key` are the ticket-granting ticket
+// of the service `cname`.
+func S4U2Proxy(ctx context.Context, c *config.Config, tgt messages.Ticket, key types.EncryptionKey,
+	cname types.PrincipalName, tkt messages.Ticket, sname types.PrincipalName) (messages.Ticket, types.EncryptionKey, error) {
+	return s4u2Proxy(ctx, c, tgt, key, cname, tkt, sname, false)
+}
+
+func s4u2Proxy(ctx context.Context, c *config.Config, tgt messages.Ticket, key types.EncryptionKey,
+	cname types.PrincipalName, tkt messages.Ticket, sname types.PrincipalName, armored bool) (messages.Ticket, types.EncryptionKey, error) {
+
+	pa, err := newPAPACOptions()
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u2proxy: pa-pac-options: %w", err)
+	}
+
+	req, err := newTGSReq(c, tgt, key, cname, sname,
+		[]int{flags.Forwardable, flags.Canonicalize, kdcOptionCNameInAddlTkt}, []messages.Ticket{tkt}, armored, pa)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u2proxy: %w", err)
+	}
+
+	rep, err := tgsExchange(ctx, c, req)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u2proxy: %w", err)
+	}
+
+	return rep.Ticket, rep.DecryptedEncPart.Key, nil
+}
+
+// GetServiceTicketForUser function returns the service ticket to the `spn`
+// ("service/host") on behalf of the `user` ("user@REALM", "REALM\user" or
+// "user" of the client realm) using S4U2Self and S4U2Proxy exchanges with
+// the client `cl` credentials.
+func GetServiceTicketForUser(ctx context.Context, cl *client.Client, user string, spn string) (messages.Ticket, types.EncryptionKey, error) {
+
+	realm := cl.Credentials.Domain()
+
+	// the ticket-granting ticket for the client realm.
+	tgt, key, err := cl.GetServiceTicket("krbtgt/" + realm)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, fmt.Errorf("s4u: get tgt: %w", err)
+	}
+
+	return getServiceTicketForUser(ctx, cl.Config, tgt, key, cl.Credentials.CName(), realm, user, spn, false)
+}
+
+// getServiceTicketForUser function returns the service ticket to the `spn`
+// on behalf of the `user` with the TGT `tgt` and the session `key` of the
+// client `cname` of the `realm`.
+func getServiceTicketForUser(ctx context.Context, c *config.Config, tgt messages.Ticket, key types.EncryptionKey,
+	cname types.PrincipalName, realm string, user string, spn string, armored bool) (messages.Ticket, types.EncryptionKey, error) {
+
+	userName, userRealm := splitPrincipal(user, realm)
+
+	tkt, _, err := s4u2Self(ctx, c, tgt, key, cname, userName, userRealm, armored)
+	if err != nil {
+		return messages.Ticket{}, types.EncryptionKey{}, err
+	}
+
+	sname := types.NewPrincipalName(nametype.KRB_NT_SRV_INST, spn)
+
+	return s4u2Proxy(ctx, c, tgt, key, cname, tkt, sname, armored)
+}
+
+// splitPrincipal function returns the principal name and the realm for
+// the "user@REALM", "REALM\user" or "user" (of the `realm`).
+func splitPrincipal(name string, realm string) (types.PrincipalName, string) {
+
+	if i := strings.Index(name, "\\"); i >= 0 {
+		name, realm = name[i+1:], name[:i]
+	} else if i := strings.LastIndex(name, "@"); i >= 0 {
+		name, realm = name[:i], name[i+1:]
+	}
+
+	return types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, name), strings.ToUpper(realm)
+}
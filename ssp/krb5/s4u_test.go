@@ -0,0 +1,165 @@
+package krb5
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jcmturner/gofork/encoding/asn1"
+	krb5crypto "github.com/jcmturner/gokrb5/v8/crypto"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/iana/flags"
+	"github.com/jcmturner/gokrb5/v8/iana/keyusage"
+	"github.com/jcmturner/gokrb5/v8/iana/msgtype"
+	"github.com/jcmturner/gokrb5/v8/iana/nametype"
+	"github.com/jcmturner/gokrb5/v8/iana/patype"
+	"github.com/jcmturner/gokrb5/v8/messages"
+	"github.com/jcmturner/gokrb5/v8/types"
+)
+
+// tgsRep function returns the TGS-REP for the `tgsReq` with the ticket of
+// the client `cname` to the requested service encrypted with the TGT
+// session key `key`.
+func tgsRep(tgsReq messages.TGSReq, cname types.PrincipalName, key, session types.EncryptionKey) ([]byte, error) {
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	enc := messages.EncKDCRepPart{
+		Key:      session,
+		LastReqs: []messages.LastReq{{LRValue: now}},
+		Nonce:    tgsReq.ReqBody.Nonce,
+		Flags:    types.NewKrbFlags(),
+		AuthTime: now,
+		EndTime:  now.Add(10 * time.Hour),
+		SRealm:   tgsReq.ReqBody.Realm,
+		SName:    tgsReq.ReqBody.SName,
+	}
+
+	eb, err := enc.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	encPart, err := krb5crypto.GetEncryptedData(eb, key, keyusage.TGS_REP_ENCPART_SESSION_KEY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	rep := messages.TGSRep{KDCRepFields: messages.KDCRepFields{
+		PVNO:    5,
+		MsgType: msgtype.KRB_TGS_REP,
+		CRealm:  tgsReq.ReqBody.Realm,
+		CName:   cname,
+		Ticket: messages.Ticket{
+			TktVNO:  5,
+			Realm:   tgsReq.ReqBody.Realm,
+			SName:   tgsReq.ReqBody.SName,
+			EncPart: types.EncryptedData{EType: etypeID.AES256_CTS_HMAC_SHA1_96, KVNO: 1, Cipher: []byte(cname.PrincipalNameString())},
+		},
+		EncPart: encPart,
+	}}
+
+	return rep.Marshal()
+}
+
+func TestS4U(t *testing.T) {
+
+	key := types.EncryptionKey{KeyType: etypeID.AES256_CTS_HMAC_SHA1_96, KeyValue: bytes.Repeat([]byte{2}, 32)}
+	session := types.EncryptionKey{KeyType: etypeID.AES256_CTS_HMAC_SHA1_96, KeyValue: bytes.Repeat([]byte{3}, 32)}
+
+	tgt := messages.Ticket{
+		TktVNO:  5,
+		Realm:   "CONTOSO.NET",
+		SName:   types.NewPrincipalName(nametype.KRB_NT_SRV_INST, "krbtgt/CONTOSO.NET"),
+		EncPart: types.EncryptedData{EType: etypeID.AES256_CTS_HMAC_SHA1_96, KVNO: 1, Cipher: []byte("tgt")},
+	}
+
+	svc := types.NewPrincipalName(nametype.KRB_NT_PRINCIPAL, "svc")
+
+	user, realm := splitPrincipal("CONTOSO\\Administrator", "")
+	if user.PrincipalNameString() != "Administrator" || realm != "CONTOSO" {
+		t.Fatalf("split principal: unexpected %s@%s", user.PrincipalNameString(), realm)
+	}
+
+	user, realm = splitPrincipal("Administrator", "contoso.net")
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	defer l.Close()
+
+	cfg := testKRB5Config(t, l.Addr())
+
+	// S4U2Self.
+	go serveKDC(t, l, func(b []byte) ([]byte, error) {
+
+		var req messages.TGSReq
+		if err := req.Unmarshal(b); err != nil {
+			return nil, err
+		}
+
+		if len(req.PAData) != 2 || req.PAData[0].PADataType != patype.PA_TGS_REQ || req.PAData[1].PADataType != patype.PA_FOR_USER {
+			return nil, fmt.Errorf("s4u2self: unexpected padata %v", req.PAData)
+		}
+
+		var pa paForUser
+		if _, err := asn1.Unmarshal(req.PAData[1].PADataValue, &pa); err != nil {
+			return nil, err
+		}
+
+		expected, _ := newPAForUser(user, realm, key)
+		if !bytes.Equal(expected.PADataValue, req.PAData[1].PADataValue) || pa.UserRealm != "CONTOSO.NET" {
+			return nil, fmt.Errorf("s4u2self: unexpected pa-for-user")
+		}
+
+		if !req.ReqBody.SName.Equal(svc) || !types.IsFlagSet(&req.ReqBody.KDCOptions, flags.Forwardable) {
+			return nil, fmt.Errorf("s4u2self: unexpected request")
+		}
+
+		return tgsRep(req, pa.UserName, key, session)
+	})
+
+	tkt, _, err := S4U2Self(context.Background(), cfg, tgt, key, svc, user, realm)
+	if err != nil {
+		t.Fatalf("s4u2self: %v", err)
+	}
+
+	if !tkt.SName.Equal(svc) {
+		t.Fatalf("s4u2self: unexpected ticket %s", tkt.SName.PrincipalNameString())
+	}
+
+	sname := types.NewPrincipalName(nametype.KRB_NT_SRV_INST, "host/dc01.contoso.net")
+
+	// S4U2Proxy.
+	go serveKDC(t, l, func(b []byte) ([]byte, error) {
+
+		var req messages.TGSReq
+		if err := req.Unmarshal(b); err != nil {
+			return nil, err
+		}
+
+		if len(req.ReqBody.AdditionalTickets) != 1 || !types.IsFlagSet(&req.ReqBody.KDCOptions, kdcOptionCNameInAddlTkt) {
+			return nil, fmt.Errorf("s4u2proxy: unexpected request")
+		}
+
+		if len(req.PAData) != 2 || req.PAData[1].PADataType != paPACOptions {
+			return nil, fmt.Errorf("s4u2proxy: unexpected padata %v", req.PAData)
+		}
+
+		return tgsRep(req, user, key, session)
+	})
+
+	tkt, skey, err := S4U2Proxy(context.Background(), cfg, tgt, key, svc, tkt, sname)
+	if err != nil {
+		t.Fatalf("s4u2proxy: %v", err)
+	}
+
+	if !tkt.SName.Equal(sname) || !bytes.Equal(skey.KeyValue, session.KeyValue) {
+		t.Errorf("s4u2proxy: unexpected ticket %s", tkt.SName.PrincipalNameString())
+	}
+}
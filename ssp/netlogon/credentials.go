@@ -7,7 +7,6 @@ import (
 
 	"github.com/oiweiwei/go-msrpc/ssp/credential"
 	"github.com/oiweiwei/go-msrpc/ssp/crypto"
-	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
 )
 
 func IsValidCredential(cred any) bool {
@@ -28,10 +27,8 @@ func DeriveKey(ctx context.Context, cred Credential) ([]byte, error) {
 		return nil, fmt.Errorf("invalid credential type %T", cred)
 	}
 
-	b, err := utf16le.Encode(cred.(credential.Password).Password())
-	if err != nil {
-		return nil, err
-	}
+	b := credential.PasswordUTF16(cred.(credential.Password))
+	defer clear(b)
 
 	return crypto.MD4(b)
 }
@@ -169,7 +169,7 @@ func (m *Mechanism) Unwrap(ctx context.Context, tok *gssapi.MessageToken) (*gssa
 		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
 	}
 
-	if !bytes.Equal(expSgn[:24], expSgn[:24]) { /* compare first 24 bytes only */
+	if !bytes.Equal(expSgn[:24], tok.Signature[:24]) { /* compare first 24 bytes only */
 		return nil, gssapi.ContextError(ctx, gssapi.BadMIC, gssapi.ErrBadMIC)
 	}
 
@@ -249,12 +249,16 @@ func (m *Mechanism) MakeSignatureEx(ctx context.Context, tokEx *gssapi.MessageTo
 // VerifySignature token.
 func (m *Mechanism) VerifySignature(ctx context.Context, tok *gssapi.MessageToken) error {
 
+	if len(tok.Signature) < 24 {
+		return gssapi.ContextError(ctx, gssapi.DefectiveToken, gssapi.ErrDefectiveToken)
+	}
+
 	expSgn, err := m.MakeInboundSignature(ctx, [][]byte{tok.Payload})
 	if err != nil {
 		return gssapi.ContextError(ctx, gssapi.Failure, err)
 	}
 
-	if !bytes.Equal(tok.Signature[:12], expSgn[:12]) { /* compare first 12 bytes */
+	if !bytes.Equal(tok.Signature[:24], expSgn[:24]) { /* compare header, sequence number and checksum */
 		return gssapi.ContextError(ctx, gssapi.BadMIC, gssapi.ErrBadMIC)
 	}
 
@@ -272,12 +276,16 @@ func (m *Mechanism) VerifySignatureEx(ctx context.Context, tokEx *gssapi.Message
 		}
 	}
 
+	if len(tokEx.Signature) < 24 {
+		return gssapi.ContextError(ctx, gssapi.DefectiveToken, gssapi.ErrDefectiveToken)
+	}
+
 	expSgn, err := m.MakeInboundSignature(ctx, forSign)
 	if err != nil {
 		return gssapi.ContextError(ctx, gssapi.Failure, err)
 	}
 
-	if !bytes.Equal(tokEx.Signature[:12], expSgn[:12]) { /* compare first 12 bytes */
+	if !bytes.Equal(tokEx.Signature[:24], expSgn[:24]) { /* compare header, sequence number and checksum */
 		return gssapi.ContextError(ctx, gssapi.BadMIC, gssapi.ErrBadMIC)
 	}
 
@@ -0,0 +1,97 @@
+package netlogon
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// testMechanism function returns the established client or server mechanism.
+func testMechanism(t *testing.T, caps Cap, isServer bool) *Mechanism {
+
+	a := &Authentifier{Config: &Config{
+		Capabilities:    caps,
+		Credential:      credential.NewFromPassword("CONTOSO\\WS01$", "P@ssw0rd", credential.Workstation("WS01")),
+		ClientChallenge: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		ServerChallenge: []byte{8, 7, 6, 5, 4, 3, 2, 1},
+		IsServer:        isServer,
+	}}
+
+	if err := a.makeSecurityService(context.Background()); err != nil {
+		t.Fatalf("make security service: %v", err)
+	}
+
+	return &Mechanism{Authentifier: a}
+}
+
+func TestSecureChannel(t *testing.T) {
+
+	for _, tc := range []struct {
+		name string
+		caps Cap
+	}{
+		{"aes_sha2", CapAES_SHA2 | CapStrongKey | CapSecureRPC},
+		{"rc4_md5", CapStrongKey | CapSecureRPC | CapRC4},
+	} {
+
+		t.Run(tc.name, func(t *testing.T) {
+
+			cli, srv := testMechanism(t, tc.caps, false), testMechanism(t, tc.caps, true)
+
+			if !bytes.Equal(cli.SessionKey(), srv.SessionKey()) {
+				t.Fatalf("session key mismatch")
+			}
+
+			ctx := gssapi.NewSecurityContext(context.Background())
+
+			hdr, body := []byte("request_header"), []byte("stub_data_payload")
+
+			// sealed request.
+			tokEx := &gssapi.MessageTokenEx{Payloads: []*gssapi.PayloadEx{
+				{Capabilities: gssapi.Integrity, Payload: append([]byte{}, hdr...)},
+				{Capabilities: gssapi.Integrity | gssapi.Confidentiality, Payload: append([]byte{}, body...)},
+			}}
+
+			if _, err := cli.WrapEx(ctx, tokEx); err != nil {
+				t.Fatalf("wrap: %v", err)
+			}
+
+			if bytes.Equal(tokEx.Payloads[1].Payload, body) {
+				t.Fatalf("wrap: payload is not encrypted")
+			}
+
+			if _, err := srv.UnwrapEx(ctx, tokEx); err != nil {
+				t.Fatalf("unwrap: %v", err)
+			}
+
+			if !bytes.Equal(tokEx.Payloads[1].Payload, body) {
+				t.Fatalf("unwrap: unexpected payload %q", tokEx.Payloads[1].Payload)
+			}
+
+			// signed response.
+			sgn, err := srv.MakeSignature(ctx, &gssapi.MessageToken{Payload: body})
+			if err != nil {
+				t.Fatalf("make signature: %v", err)
+			}
+
+			if err := cli.VerifySignature(ctx, &gssapi.MessageToken{Payload: body, Signature: sgn.Signature}); err != nil {
+				t.Fatalf("verify signature: %v", err)
+			}
+
+			// tampered sealed response.
+			tok, err := srv.Wrap(ctx, &gssapi.MessageToken{Capabilities: gssapi.Confidentiality, Payload: append([]byte{}, body...)})
+			if err != nil {
+				t.Fatalf("wrap: %v", err)
+			}
+
+			tok.Payload[0] ^= 0xFF
+
+			if _, err := cli.Unwrap(gssapi.NewSecurityContext(context.Background()), tok); err == nil {
+				t.Fatalf("unwrap: tampered payload accepted")
+			}
+		})
+	}
+}
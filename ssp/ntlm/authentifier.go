@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"hash"
 
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
 	"github.com/oiweiwei/go-msrpc/ssp/crypto"
 )
 
@@ -39,6 +40,21 @@ func (a *Authentifier) TargetName() string {
 	return ""
 }
 
+// IsAnonymous function returns `true` if the anonymous challenge response
+// was sent.
+func (a *Authentifier) IsAnonymous() bool {
+	return a.session != nil && a.session.Anonymous
+}
+
+// Version function returns the NTLM version (1 or 2) used for the challenge
+// response.
+func (a *Authentifier) Version() int {
+	if a.Config != nil && a.Config.NTLMVersion == NTLMv1 {
+		return NTLMv1
+	}
+	return NTLMv2
+}
+
 func (a *Authentifier) Reset() {
 	a.state, a.session = nil, nil
 	if a.mic.Reset(); a.Config == nil {
@@ -74,6 +90,10 @@ func (a *Authentifier) Negotiate(ctx context.Context) ([]byte, error) {
 
 	a.Reset()
 
+	if credential.IsDestroyed(a.Config.Credential) {
+		return nil, fmt.Errorf("ntlm: init: negotiate: %w", credential.ErrDestroyed)
+	}
+
 	nm := &NegotiateMessage{
 		Negotiate: a.Config.Negotiate(),
 		Version:   a.Config.Version,
@@ -143,6 +163,8 @@ func (a *Authentifier) Authenticate(ctx context.Context, b []byte) ([]byte, erro
 		return nil, fmt.Errorf("ntlm: init: authenticate: compute challenge response: %w", err)
 	}
 
+	a.session.Anonymous = resp.IsAnonymous
+
 	// compute key-exchange-key.
 	if resp.KeyExchangeKey, err = ntlm.KeyExchangeKey(ctx, cm, resp); err != nil {
 		return nil, fmt.Errorf("ntlm: init: authenticate: compute key-exchange-key: %w", err)
@@ -201,6 +223,14 @@ func (a *Authentifier) Authenticate(ctx context.Context, b []byte) ([]byte, erro
 		a.mic.Reset()
 	}
 
+	if a.Config.DestroyCredential {
+		// wipe the one-way-function keys and the credential secrets, the
+		// exported session key is kept for the signing and sealing.
+		clear(resp.KeyNT)
+		clear(resp.KeyLM)
+		credential.Destroy(a.Config.Credential)
+	}
+
 	return b, nil
 }
 
@@ -299,6 +329,7 @@ type SecurityParameters struct {
 	KeySize                 int
 	ServerName              string
 	DomainName              string
+	Anonymous               bool
 }
 
 // makeHashFunc creates the hash function.
@@ -117,6 +117,11 @@ type Config struct {
 	// The flag that indicates whether all the input buffers must be used to
 	// build a signature. (DO NOT USE IT).
 	NoSignAllBuffers bool
+	// The flag that indicates whether the credential secrets and the derived
+	// keys must be wiped once the security context is established (see
+	// credential.Destroy). The credential cannot be used to establish the
+	// new security context afterwards.
+	DestroyCredential bool
 }
 
 func IsCredentialEmpty(cred any) bool {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 )
 
@@ -62,7 +63,14 @@ func (Mechanism) New(ctx context.Context) (gssapi.Mechanism, error) {
 		return nil, gssapi.ContextError(ctx, gssapi.NoContext, gssapi.ErrNoContext)
 	}
 	if cc.Credential != nil {
-		if c.Credential, ok = cc.Credential.Value().(Credential); !ok || !IsValidCredential(c.Credential) {
+		cred := cc.Credential.Value()
+		if keys, ok := cred.(credential.EncryptionKeys); ok {
+			// the rc4-hmac key is the nt hash.
+			if ntHash, ok := credential.NTHashFromKeys(keys); ok {
+				cred = ntHash
+			}
+		}
+		if c.Credential, ok = cred.(Credential); !ok || !IsValidCredential(c.Credential) {
 			return nil, gssapi.ContextError(ctx, gssapi.DefectiveCredential, gssapi.ErrDefectiveCredential)
 		}
 	}
@@ -127,6 +135,8 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.SessionKey())
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.TargetName())
+		gssapi.SetAttribute(ctx, gssapi.AttributeAnonymous, m.IsAnonymous())
+		gssapi.SetAttribute(ctx, gssapi.AttributeNTLMVersion, m.Version())
 
 		return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
 	}
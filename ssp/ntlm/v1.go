@@ -5,12 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/oiweiwei/go-msrpc/ssp/credential"
 	"github.com/oiweiwei/go-msrpc/ssp/crypto"
 	"github.com/oiweiwei/go-msrpc/ssp/ntlm/internal"
-	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
 )
 
 // The LMv1Respons structure defines the NTLM v1 authentication
@@ -77,8 +75,13 @@ func (v1 *V1) LMOWF(ctx context.Context, cred Credential) ([]byte, error) {
 		return nil, nil
 	}
 
+	upper := bytes.ToUpper(credential.PasswordBytes(cred.(credential.Password)))
+	defer clear(upper)
+
 	secret := make([]byte, 14)
-	copy(secret, []byte(strings.ToUpper(cred.(credential.Password).Password())))
+	defer clear(secret)
+
+	copy(secret, upper)
 
 	buf := bytes.NewBuffer(nil)
 
@@ -104,12 +107,10 @@ func (v1 *V1) NTOWF(ctx context.Context, cred Credential) ([]byte, error) {
 		return nil, fmt.Errorf("v1: ntowf: encode password: invalid credential type %T", cred)
 	}
 
-	b, err := utf16le.Encode(cred.(credential.Password).Password())
-	if err != nil {
-		return nil, fmt.Errorf("v1: ntowf: encode password: %w", err)
-	}
+	pass := credential.PasswordUTF16(cred.(credential.Password))
+	defer clear(pass)
 
-	b, err = crypto.MD4(b)
+	b, err := crypto.MD4(pass)
 	if err != nil {
 		return nil, fmt.Errorf("v1: ntowf: derive key: %w", err)
 	}
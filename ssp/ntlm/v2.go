@@ -210,10 +210,8 @@ func (v2 *V2) NTOWF(ctx context.Context, cred Credential) ([]byte, error) {
 
 	if cred, ok := cred.(credential.Password); ok {
 
-		pass, err := utf16le.Encode(cred.Password())
-		if err != nil {
-			return nil, fmt.Errorf("v2: ntowf: encode password: %w", err)
-		}
+		pass := credential.PasswordUTF16(cred)
+		defer clear(pass)
 
 		k, err = crypto.MD4(pass)
 		if err != nil {
@@ -3,6 +3,7 @@ package ntlm
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -67,3 +68,15 @@ func TestSessionBaseKey(t *testing.T) {
 	fmt.Println(hex.Dump(resp.NT))
 
 }
+
+func TestNegotiateDestroyedCredential(t *testing.T) {
+
+	cred := credential.NewFromPassword("Domain\\User", "Password", credential.Secure())
+	credential.Destroy(cred)
+
+	a := &Authentifier{Config: &Config{Credential: cred}}
+
+	if _, err := a.Negotiate(context.Background()); !errors.Is(err, credential.ErrDestroyed) {
+		t.Fatalf("expected destroyed credential error, got %v", err)
+	}
+}
@@ -15,6 +15,31 @@ type Config struct {
 	MechanismsList []gssapi.MechanismFactory
 	// Require mechanism list MIC.
 	RequireMechanismListMIC bool
+	// The ordered list of mechanism types to offer. When set, the
+	// mechanisms list is filtered and reordered accordingly.
+	MechanismTypes []gssapi.OID
+}
+
+// Mechanisms function returns the list of mechanism factories to offer
+// in the order of preference.
+func (c *Config) Mechanisms() []gssapi.MechanismFactory {
+
+	if len(c.MechanismTypes) == 0 {
+		return c.MechanismsList
+	}
+
+	mechs := []gssapi.MechanismFactory{}
+
+	for _, oid := range c.MechanismTypes {
+		for _, mech := range c.MechanismsList {
+			if mech.Type().Equal(oid) {
+				mechs = append(mechs, mech)
+				break
+			}
+		}
+	}
+
+	return mechs
 }
 
 type Authentifier struct {
@@ -24,13 +49,25 @@ type Authentifier struct {
 	Mechanism gssapi.Mechanism
 	// The retrieved Mechanism List.
 	RetrievedMechanismList []asn1.ObjectIdentifier
+	// The mechanism list MIC is required since the acceptor
+	// has selected the mechanism other than the optimistic one.
+	mechListMICRequired bool
 }
 
 func (a *Authentifier) Negotiate(ctx context.Context) ([]byte, error) {
 
 	var err error
 
-	a.Mechanism, err = a.MechanismsList[0].New(ctx)
+	// select the first mechanism that can be initialized, (ie, the
+	// kerberos can be skipped when no kerberos credentials are provided),
+	// the skipped mechanisms are not offered.
+	for i := range a.MechanismsList {
+		if a.Mechanism, err = a.MechanismsList[i].New(ctx); err == nil {
+			a.MechanismsList = a.MechanismsList[i:]
+			break
+		}
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("spnego: init: mechanism new: %w", err)
 	}
@@ -95,9 +132,22 @@ func (a *Authentifier) Respond(ctx context.Context, b []byte) ([]byte, error) {
 		}
 
 		if resp.State == Reject {
+			if IsNegoEx(resp.ResponseToken) {
+				return nil, fmt.Errorf("spnego: init: %w: %w", ErrReject, negoExError(resp.ResponseToken))
+			}
 			return nil, fmt.Errorf("spnego: init: %w", ErrReject)
 		}
 
+		if IsNegoEx(resp.ResponseToken) || MechanismTypeNegoEx.Equal(resp.SupportedMech) {
+			return nil, fmt.Errorf("spnego: init: %w", negoExError(resp.ResponseToken))
+		}
+
+		if len(resp.SupportedMech) > 0 && !a.Mechanism.Type().Equal((gssapi.OID)(resp.SupportedMech)) {
+			// the acceptor has selected the mechanism other than the
+			// optimistic one.
+			return a.selectMechanism(ctx, (gssapi.OID)(resp.SupportedMech))
+		}
+
 		if resp.State == AcceptCompleted {
 
 			if len(resp.ResponseToken) > 0 {
@@ -115,7 +165,7 @@ func (a *Authentifier) Respond(ctx context.Context, b []byte) ([]byte, error) {
 				return nil, fmt.Errorf("spnego: init: marshal mech list: %w", err)
 			}
 
-			if len(resp.MechListMIC) > 0 || a.Config.RequireMechanismListMIC {
+			if len(resp.MechListMIC) > 0 || a.Config.RequireMechanismListMIC || a.mechListMICRequired {
 				err = a.Mechanism.VerifySignature(ctx, &gssapi.MessageToken{Payload: b, Signature: resp.MechListMIC})
 				if err != nil {
 					return nil, fmt.Errorf("spnego: init: verify mech list mic: %w", err)
@@ -198,6 +248,43 @@ func (a *Authentifier) MakeMechanismList(ctx context.Context) []asn1.ObjectIdent
 	return mechTypes
 }
 
+// selectMechanism function initializes the mechanism `oid` selected by the
+// acceptor and returns the response with the initial mechanism token.
+func (a *Authentifier) selectMechanism(ctx context.Context, oid gssapi.OID) ([]byte, error) {
+
+	var (
+		mech gssapi.Mechanism
+		err  error
+	)
+
+	for i := range a.MechanismsList {
+		if a.MechanismsList[i].Type().Equal(oid) {
+			if mech, err = a.MechanismsList[i].New(ctx); err != nil {
+				return nil, fmt.Errorf("spnego: init: selected mechanism %s: new: %w", oid, err)
+			}
+			break
+		}
+	}
+
+	if mech == nil {
+		return nil, fmt.Errorf("spnego: init: selected mechanism %s: %w", oid, gssapi.ErrUnavailable)
+	}
+
+	a.Mechanism, a.mechListMICRequired = mech, true
+
+	mechTok, err := a.Mechanism.Init(ctx, &gssapi.Token{})
+	if err != nil {
+		return nil, fmt.Errorf("spnego: init: selected mechanism %s: %w", oid, err)
+	}
+
+	b, err := (&NegTokenResp{ResponseToken: mechTok.Payload}).Marshal(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("spnego: init: marshal neg token resp: %w", err)
+	}
+
+	return b, nil
+}
+
 func (a *Authentifier) SelectMechanism(ctx context.Context, oid gssapi.OID) gssapi.Mechanism {
 
 	// select mechanism based on oid or first entry if default...
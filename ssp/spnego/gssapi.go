@@ -32,6 +32,9 @@ func (c *Config) Copy() gssapi.MechanismConfig {
 	cp.MechanismsList = make([]gssapi.MechanismFactory, len(c.MechanismsList))
 	copy(cp.MechanismsList, c.MechanismsList)
 
+	cp.MechanismTypes = make([]gssapi.OID, len(c.MechanismTypes))
+	copy(cp.MechanismTypes, c.MechanismTypes)
+
 	return &cp
 }
 
@@ -64,6 +67,9 @@ func (Mechanism) New(ctx context.Context) (gssapi.Mechanism, error) {
 	// set capabilities.
 	c.Capabilities = cc.Capabilities
 
+	// apply the mechanism types order.
+	c.MechanismsList, c.MechanismTypes = c.Mechanisms(), nil
+
 	// check that mechanism list is not empty.
 	if len(c.MechanismsList) == 0 {
 		return nil, gssapi.ContextError(ctx, gssapi.Unavailable, gssapi.ErrUnavailable)
@@ -106,6 +112,9 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 		}, gssapi.ContextContinueNeeded(ctx)
 	}
 
+	// the negotiated mechanism.
+	gssapi.SetAttribute(ctx, gssapi.AttributeMechanismType, m.Mechanism.Type())
+
 	return nil, gssapi.ContextComplete(ctx)
 
 }
@@ -0,0 +1,197 @@
+package spnego
+
+// negoex.go module contains the NegoEx (SPNEGO Extended Negotiation) message
+// parser as described in MS-NEGOEX. NegoEx is not supported as a mechanism,
+// the messages are parsed to report the negotiation failure.
+
+import (
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+var (
+	// The NegoEx mechanism type.
+	MechanismTypeNegoEx = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 2, 30}
+)
+
+const (
+	// The NegoEx message signature ("NEGOEXTS").
+	NegoExSignature uint64 = 0x535458454f47454e
+	// The NegoEx message header length.
+	negoExHeaderSize = 40
+)
+
+// NegoExMessageType represents the NegoEx message type.
+type NegoExMessageType uint32
+
+const (
+	NegoExInitiatorNego     NegoExMessageType = 0
+	NegoExAcceptorNego      NegoExMessageType = 1
+	NegoExInitiatorMetaData NegoExMessageType = 2
+	NegoExAcceptorMetaData  NegoExMessageType = 3
+	NegoExChallenge         NegoExMessageType = 4
+	NegoExAPRequest         NegoExMessageType = 5
+	NegoExVerify            NegoExMessageType = 6
+	NegoExAlert             NegoExMessageType = 7
+)
+
+func (t NegoExMessageType) String() string {
+	switch t {
+	case NegoExInitiatorNego:
+		return "initiator_nego"
+	case NegoExAcceptorNego:
+		return "acceptor_nego"
+	case NegoExInitiatorMetaData:
+		return "initiator_meta_data"
+	case NegoExAcceptorMetaData:
+		return "acceptor_meta_data"
+	case NegoExChallenge:
+		return "challenge"
+	case NegoExAPRequest:
+		return "ap_request"
+	case NegoExVerify:
+		return "verify"
+	case NegoExAlert:
+		return "alert"
+	}
+	return fmt.Sprintf("unknown(%d)", uint32(t))
+}
+
+var (
+	ErrNegoExNotSupported = errors.New("spnego: negoex is not supported")
+	ErrInvalidNegoEx      = errors.New("spnego: invalid negoex message")
+)
+
+// NegoExMessage structure represents the parsed NegoEx message.
+type NegoExMessage struct {
+	// The message type.
+	Type NegoExMessageType
+	// The message sequence number.
+	SequenceNum uint32
+	// The conversation identifier.
+	ConversationID *uuid.UUID
+	// The list of authentication schemes (NEGO_MESSAGE).
+	AuthSchemes []*uuid.UUID
+	// The authentication scheme (EXCHANGE_MESSAGE, VERIFY_MESSAGE,
+	// ALERT_MESSAGE).
+	AuthScheme *uuid.UUID
+	// The exchange data (EXCHANGE_MESSAGE).
+	Exchange []byte
+	// The error code (ALERT_MESSAGE).
+	ErrorCode uint32
+}
+
+// IsNegoEx function returns true if the token is the NegoEx message.
+func IsNegoEx(b []byte) bool {
+	return len(b) >= 8 && binary.LittleEndian.Uint64(b) == NegoExSignature
+}
+
+// ParseNegoEx function parses the sequence of NegoEx messages.
+func ParseNegoEx(b []byte) ([]*NegoExMessage, error) {
+
+	var msgs []*NegoExMessage
+
+	for len(b) > 0 {
+
+		if len(b) < negoExHeaderSize || !IsNegoEx(b) {
+			return nil, ErrInvalidNegoEx
+		}
+
+		msg := &NegoExMessage{
+			Type:           NegoExMessageType(binary.LittleEndian.Uint32(b[8:])),
+			SequenceNum:    binary.LittleEndian.Uint32(b[12:]),
+			ConversationID: &uuid.UUID{},
+		}
+
+		hdrLen, msgLen := int(binary.LittleEndian.Uint32(b[16:])), int(binary.LittleEndian.Uint32(b[20:]))
+		if hdrLen < negoExHeaderSize || msgLen < hdrLen || msgLen > len(b) {
+			return nil, ErrInvalidNegoEx
+		}
+
+		msg.ConversationID.DecodeBinary(b[24:40])
+
+		m := b[:msgLen]
+
+		switch msg.Type {
+		case NegoExInitiatorNego, NegoExAcceptorNego:
+			// random[32], protocol_version[8], auth_scheme_vector.
+			if len(m) < negoExHeaderSize+48 {
+				return nil, ErrInvalidNegoEx
+			}
+			off, cnt := int(binary.LittleEndian.Uint32(m[80:])), int(binary.LittleEndian.Uint16(m[84:]))
+			if off < 0 || off+cnt*16 > len(m) {
+				return nil, ErrInvalidNegoEx
+			}
+			for i := 0; i < cnt; i++ {
+				scheme := &uuid.UUID{}
+				scheme.DecodeBinary(m[off+i*16:])
+				msg.AuthSchemes = append(msg.AuthSchemes, scheme)
+			}
+		case NegoExInitiatorMetaData, NegoExAcceptorMetaData, NegoExChallenge, NegoExAPRequest:
+			// auth_scheme, exchange_byte_vector.
+			if len(m) < negoExHeaderSize+24 {
+				return nil, ErrInvalidNegoEx
+			}
+			msg.AuthScheme = &uuid.UUID{}
+			msg.AuthScheme.DecodeBinary(m[40:56])
+			off, sz := int(binary.LittleEndian.Uint32(m[56:])), int(binary.LittleEndian.Uint32(m[60:]))
+			if off < 0 || sz < 0 || off+sz > len(m) {
+				return nil, ErrInvalidNegoEx
+			}
+			msg.Exchange = m[off : off+sz]
+		case NegoExVerify:
+			if len(m) < negoExHeaderSize+16 {
+				return nil, ErrInvalidNegoEx
+			}
+			msg.AuthScheme = &uuid.UUID{}
+			msg.AuthScheme.DecodeBinary(m[40:56])
+		case NegoExAlert:
+			if len(m) < negoExHeaderSize+20 {
+				return nil, ErrInvalidNegoEx
+			}
+			msg.AuthScheme = &uuid.UUID{}
+			msg.AuthScheme.DecodeBinary(m[40:56])
+			msg.ErrorCode = binary.LittleEndian.Uint32(m[56:])
+		}
+
+		msgs, b = append(msgs, msg), b[msgLen:]
+	}
+
+	return msgs, nil
+}
+
+// negoExError function returns the descriptive error for the NegoEx token
+// returned by the server.
+func negoExError(b []byte) error {
+
+	msgs, err := ParseNegoEx(b)
+	if err != nil || len(msgs) == 0 {
+		return ErrNegoExNotSupported
+	}
+
+	desc := []string{}
+
+	for _, msg := range msgs {
+		switch {
+		case len(msg.AuthSchemes) > 0:
+			schemes := make([]string, len(msg.AuthSchemes))
+			for i := range msg.AuthSchemes {
+				schemes[i] = msg.AuthSchemes[i].String()
+			}
+			desc = append(desc, fmt.Sprintf("%s: auth_schemes [%s]", msg.Type, strings.Join(schemes, ", ")))
+		case msg.Type == NegoExAlert:
+			desc = append(desc, fmt.Sprintf("%s: auth_scheme %s: error 0x%08x", msg.Type, msg.AuthScheme, msg.ErrorCode))
+		case msg.AuthScheme != nil:
+			desc = append(desc, fmt.Sprintf("%s: auth_scheme %s", msg.Type, msg.AuthScheme))
+		default:
+			desc = append(desc, msg.Type.String())
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrNegoExNotSupported, strings.Join(desc, "; "))
+}
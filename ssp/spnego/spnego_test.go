@@ -3,9 +3,13 @@ package spnego
 import (
 	"context"
 	"encoding/asn1"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 )
 
 func TestNegTokenInit2(t *testing.T) {
@@ -36,3 +40,90 @@ func TestNegTokenInit2(t *testing.T) {
 	fmt.Println(negInit2)
 	fmt.Println(negInit2Exp)
 }
+
+// testMechanismFactory is the mechanism factory stub.
+type testMechanismFactory struct {
+	gssapi.MechanismFactory
+	oid gssapi.OID
+}
+
+func (f testMechanismFactory) Type() gssapi.OID { return f.oid }
+
+func TestMechanismTypes(t *testing.T) {
+
+	ntlm := testMechanismFactory{oid: gssapi.OID{1, 3, 6, 1, 4, 1, 311, 2, 2, 10}}
+	krb5 := testMechanismFactory{oid: gssapi.OID{1, 2, 840, 113554, 1, 2, 2}}
+
+	c := &Config{MechanismsList: []gssapi.MechanismFactory{ntlm, krb5}}
+
+	if mechs := c.Mechanisms(); len(mechs) != 2 || !mechs[0].Type().Equal(ntlm.oid) {
+		t.Fatalf("mechanisms: unexpected default order")
+	}
+
+	c.MechanismTypes = []gssapi.OID{krb5.oid, ntlm.oid}
+
+	if mechs := c.Mechanisms(); len(mechs) != 2 || !mechs[0].Type().Equal(krb5.oid) || !mechs[1].Type().Equal(ntlm.oid) {
+		t.Fatalf("mechanisms: unexpected preferred order")
+	}
+
+	c.MechanismTypes = []gssapi.OID{krb5.oid}
+
+	if mechs := c.Mechanisms(); len(mechs) != 1 || !mechs[0].Type().Equal(krb5.oid) {
+		t.Fatalf("mechanisms: unexpected filtered list")
+	}
+}
+
+func TestNegoEx(t *testing.T) {
+
+	scheme := []byte{0x5c, 0x33, 0x53, 0x0d, 0xea, 0xf9, 0x0d, 0x4d, 0xb2, 0xec, 0x4a, 0xe3, 0x78, 0x6e, 0xc3, 0x08}
+
+	// acceptor nego message with single auth scheme.
+	b := make([]byte, 96+16)
+	binary.LittleEndian.PutUint64(b[0:], NegoExSignature)
+	binary.LittleEndian.PutUint32(b[8:], uint32(NegoExAcceptorNego))
+	binary.LittleEndian.PutUint32(b[12:], 1)
+	binary.LittleEndian.PutUint32(b[16:], 96)
+	binary.LittleEndian.PutUint32(b[20:], uint32(len(b)))
+	binary.LittleEndian.PutUint32(b[80:], 96)
+	binary.LittleEndian.PutUint16(b[84:], 1)
+	copy(b[96:], scheme)
+
+	// alert message.
+	alert := make([]byte, 72)
+	binary.LittleEndian.PutUint64(alert[0:], NegoExSignature)
+	binary.LittleEndian.PutUint32(alert[8:], uint32(NegoExAlert))
+	binary.LittleEndian.PutUint32(alert[12:], 2)
+	binary.LittleEndian.PutUint32(alert[16:], 72)
+	binary.LittleEndian.PutUint32(alert[20:], uint32(len(alert)))
+	copy(alert[40:], scheme)
+	binary.LittleEndian.PutUint32(alert[56:], 0xC000006D)
+
+	if !IsNegoEx(b) || IsNegoEx([]byte("NTLMSSP\x00")) {
+		t.Fatalf("is negoex: unexpected result")
+	}
+
+	msgs, err := ParseNegoEx(append(b, alert...))
+	if err != nil {
+		t.Fatalf("parse negoex: %v", err)
+	}
+
+	if len(msgs) != 2 || msgs[0].Type != NegoExAcceptorNego || len(msgs[0].AuthSchemes) != 1 {
+		t.Fatalf("parse negoex: unexpected messages %+v", msgs)
+	}
+
+	if msgs[0].AuthSchemes[0].String() != "0d53335c-f9ea-4d0d-b2ec-4ae3786ec308" {
+		t.Fatalf("parse negoex: unexpected auth scheme %s", msgs[0].AuthSchemes[0])
+	}
+
+	if msgs[1].Type != NegoExAlert || msgs[1].ErrorCode != 0xC000006D {
+		t.Fatalf("parse negoex: unexpected alert %+v", msgs[1])
+	}
+
+	if _, err := ParseNegoEx(b[:90]); !errors.Is(err, ErrInvalidNegoEx) {
+		t.Fatalf("parse negoex: truncated message accepted")
+	}
+
+	if err := negoExError(b); !errors.Is(err, ErrNegoExNotSupported) {
+		t.Fatalf("negoex error: unexpected %v", err)
+	}
+}
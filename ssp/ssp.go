@@ -6,6 +6,7 @@ import (
 
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 
+	"github.com/oiweiwei/go-msrpc/ssp/credssp"
 	"github.com/oiweiwei/go-msrpc/ssp/krb5"
 	"github.com/oiweiwei/go-msrpc/ssp/netlogon"
 	"github.com/oiweiwei/go-msrpc/ssp/ntlm"
@@ -21,6 +22,8 @@ var (
 	KRB5 = krb5.Mechanism{}
 	// The Netlogon SSP Secure Channel mechanism.
 	Netlogon = netlogon.Mechanism{}
+	// The CredSSP authentication mechanism.
+	CredSSP = credssp.Mechanism{}
 
 	// The SPNEGO mechanism type.
 	MechanismTypeSPNEGO = SPNEGO.Type()
@@ -30,6 +33,8 @@ var (
 	MechanismTypeKRB5 = KRB5.Type()
 	// The Netlogon SSP Secure Channel mechanism type.
 	MechanismTypeNetlogon = Netlogon.Type()
+	// The CredSSP mechanism type.
+	MechanismTypeCredSSP = CredSSP.Type()
 )
 
 // MechanismTypeDefault function returns the default mechanism.
@@ -56,3 +61,7 @@ func WithKRB5(cfg *krb5.Config) gssapi.Option {
 func WithNetlogon(cfg *netlogon.Config) gssapi.Option {
 	return gssapi.WithMechanismConfig(cfg)
 }
+
+func WithCredSSP(cfg *credssp.Config) gssapi.Option {
+	return gssapi.WithMechanismConfig(cfg)
+}
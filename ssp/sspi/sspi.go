@@ -0,0 +1,239 @@
+// package sspi implements the external security provider that delegates the
+// security context establishment and the message protection to the Windows
+// Security Support Provider Interface (SSPI), so that the logon session of the
+// process (single sign-on) and the platform credential protections (ie
+// Credential Guard) are used instead of the pure Go mechanisms.
+//
+// The provider is available on Windows only (and not with the `purego` build
+// tag), on other platforms the security context creation fails with the
+// ErrNotSupported error:
+//
+//	cli, err := winreg.NewWinregClient(ctx, conn, dcerpc.WithMechanism(sspi.Negotiate), dcerpc.WithSeal())
+package sspi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/external"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+var (
+	// The SSPI is not supported on this platform (or in the `purego` build).
+	ErrNotSupported = errors.New("sspi: not supported on this platform")
+	// The credential cannot be passed to the SSPI package.
+	ErrUnsupportedCredential = errors.New("sspi: unsupported credential")
+	// The security package is not supported by the provider.
+	ErrUnsupportedPackage = errors.New("sspi: unsupported security package")
+)
+
+// The SSPI security package names.
+const (
+	PackageNegotiate = "Negotiate"
+	PackageKerberos  = "Kerberos"
+	PackageNTLM      = "NTLM"
+)
+
+var (
+	// The SSPI Negotiate (SPNEGO) mechanism.
+	Negotiate = NewMechanism(PackageNegotiate)
+	// The SSPI Kerberos mechanism.
+	Kerberos = NewMechanism(PackageKerberos)
+	// The SSPI NTLM mechanism.
+	NTLM = NewMechanism(PackageNTLM)
+)
+
+// Provider is the external security provider backed by the SSPI security
+// package.
+type Provider struct {
+	// The security package name (PackageNegotiate, PackageKerberos or
+	// PackageNTLM).
+	Package string
+	// The capabilities requested for the security context in addition
+	// to the capabilities requested by the caller (ie gssapi.DCEStyle).
+	Capabilities gssapi.Cap
+}
+
+// NewMechanism function returns the mechanism factory for the SSPI security
+// package.
+func NewMechanism(pkg string, caps ...gssapi.Cap) external.Mechanism {
+
+	p := &Provider{Package: pkg}
+	for _, c := range caps {
+		p.Capabilities |= c
+	}
+
+	return external.Mechanism{Provider: p}
+}
+
+// Type function returns the mechanism type of the security package, the
+// identifier of the built-in mechanism implementing the same protocol.
+func (p *Provider) Type() gssapi.OID {
+	switch p.Package {
+	case PackageNegotiate:
+		return ssp.MechanismTypeSPNEGO
+	case PackageKerberos:
+		return ssp.MechanismTypeKRB5
+	case PackageNTLM:
+		return ssp.MechanismTypeNTLM
+	}
+	return nil
+}
+
+// NewSecurityContext function returns the new SSPI security context.
+func (p *Provider) NewSecurityContext(ctx context.Context, cfg *external.ContextConfig) (external.SecurityContext, error) {
+
+	if p.Type() == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedPackage, p.Package)
+	}
+
+	if !Available {
+		return nil, ErrNotSupported
+	}
+
+	return newSecurityContext(ctx, p, cfg)
+}
+
+// identity function returns the explicit user name, domain name and password
+// for the credential, or `ok` = `false` if the logon session credentials must
+// be used (the credential is not set, or is the anonymous credential).
+func identity(cred any) (string, string, string, bool, error) {
+
+	if cred == nil {
+		return "", "", "", false, nil
+	}
+
+	if credential.IsDestroyed(cred) {
+		return "", "", "", false, credential.ErrDestroyed
+	}
+
+	switch cred := cred.(type) {
+	case credential.Password:
+		if cred.UserName() == "" && cred.Password() == "" {
+			return "", "", "", false, nil
+		}
+		return cred.UserName(), cred.DomainName(), cred.Password(), true, nil
+	}
+
+	return "", "", "", false, fmt.Errorf("%w: %T", ErrUnsupportedCredential, cred)
+}
+
+// The ISC_REQ_* and ASC_REQ_* context requirements.
+const (
+	reqDelegate        = 0x00000001
+	reqMutualAuth      = 0x00000002
+	reqReplayDetect    = 0x00000004
+	reqSequenceDetect  = 0x00000008
+	reqConfidentiality = 0x00000010
+	reqAllocateMemory  = 0x00000100
+	reqUseDCEStyle     = 0x00000200
+	reqDatagram        = 0x00000400
+	reqConnection      = 0x00000800
+
+	iscReqIntegrity   = 0x00010000
+	iscReqIdentify    = 0x00020000
+	iscReqNullSession = 0x00040000
+
+	ascReqIntegrity = 0x00020000
+	ascReqIdentify  = 0x00080000
+
+	iscRetNullSession = 0x00040000
+)
+
+// contextReq function converts the capabilities into the SSPI context
+// requirements (ISC_REQ_* for the initiator, ASC_REQ_* for the acceptor).
+func contextReq(caps gssapi.Cap, server bool) uint32 {
+
+	req := uint32(reqAllocateMemory)
+
+	for _, f := range []struct {
+		cap gssapi.Cap
+		req uint32
+	}{
+		{gssapi.Delegation, reqDelegate},
+		{gssapi.MutualAuthn, reqMutualAuth},
+		{gssapi.ReplayDetection, reqReplayDetect},
+		{gssapi.Sequencing, reqSequenceDetect},
+		{gssapi.Confidentiality, reqConfidentiality},
+		{gssapi.DCEStyle, reqUseDCEStyle},
+	} {
+		if caps.IsSet(f.cap) {
+			req |= f.req
+		}
+	}
+
+	if caps.IsSet(gssapi.Datagram) {
+		req |= reqDatagram
+	} else {
+		req |= reqConnection
+	}
+
+	if server {
+		if caps.IsSet(gssapi.Integrity) {
+			req |= ascReqIntegrity
+		}
+		if caps.IsSet(gssapi.Identify) {
+			req |= ascReqIdentify
+		}
+		return req
+	}
+
+	if caps.IsSet(gssapi.Integrity) {
+		req |= iscReqIntegrity
+	}
+	if caps.IsSet(gssapi.Identify) {
+		req |= iscReqIdentify
+	}
+	if caps.IsSet(gssapi.Anonymity) {
+		req |= iscReqNullSession
+	}
+
+	return req
+}
+
+// channelBindings function converts the flat channel bindings representation
+// (see gssapi.ChannelBindingsStruct) into the SEC_CHANNEL_BINDINGS structure.
+func channelBindings(b []byte) ([]byte, error) {
+
+	const hdrSize = 32
+
+	fields := make([][]byte, 3)
+	types := make([]uint32, 2)
+
+	for i := range fields {
+		if i < len(types) {
+			if len(b) < 4 {
+				return nil, gssapi.ErrBadBindings
+			}
+			types[i], b = binary.LittleEndian.Uint32(b), b[4:]
+		}
+		if len(b) < 4 {
+			return nil, gssapi.ErrBadBindings
+		}
+		sz := binary.LittleEndian.Uint32(b)
+		if b = b[4:]; uint32(len(b)) < sz {
+			return nil, gssapi.ErrBadBindings
+		}
+		fields[i], b = b[:sz], b[sz:]
+	}
+
+	ret := make([]byte, hdrSize, hdrSize+len(fields[0])+len(fields[1])+len(fields[2]))
+
+	// initiator and acceptor: type, length, offset; application data:
+	// length, offset.
+	for i, off := range []int{4, 16, 24} {
+		if i < len(types) {
+			binary.LittleEndian.PutUint32(ret[off-4:], types[i])
+		}
+		binary.LittleEndian.PutUint32(ret[off:], uint32(len(fields[i])))
+		binary.LittleEndian.PutUint32(ret[off+4:], uint32(len(ret)))
+		ret = append(ret, fields[i]...)
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,17 @@
+//go:build !windows || purego
+
+package sspi
+
+import (
+	"context"
+
+	"github.com/oiweiwei/go-msrpc/ssp/external"
+)
+
+// Available is `true` if the SSPI is available in this build.
+const Available = false
+
+// newSecurityContext function returns ErrNotSupported error.
+func newSecurityContext(ctx context.Context, p *Provider, cfg *external.ContextConfig) (external.SecurityContext, error) {
+	return nil, ErrNotSupported
+}
@@ -0,0 +1,94 @@
+package sspi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/external"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+func TestChannelBindings(t *testing.T) {
+
+	flat, _ := (&gssapi.ChannelBindingsStruct{
+		InitiatorAddrType: 2,
+		InitiatorAddress:  []byte{10, 0, 0, 1},
+		ApplicationData:   []byte("tls-server-end-point:hash"),
+	}).Marshal()
+
+	b, err := channelBindings(flat)
+	if err != nil {
+		t.Fatalf("channel bindings: %v", err)
+	}
+
+	u32 := func(off int) uint32 { return binary.LittleEndian.Uint32(b[off:]) }
+
+	if u32(0) != 2 || u32(4) != 4 || !bytes.Equal(b[u32(8):u32(8)+4], []byte{10, 0, 0, 1}) {
+		t.Fatalf("unexpected initiator: %x", b)
+	}
+
+	if u32(12) != 0 || u32(16) != 0 {
+		t.Fatalf("unexpected acceptor: %x", b)
+	}
+
+	if app := b[u32(28) : u32(28)+u32(24)]; string(app) != "tls-server-end-point:hash" {
+		t.Fatalf("unexpected application data: %q", app)
+	}
+
+	if _, err := channelBindings(flat[:len(flat)-1]); !errors.Is(err, gssapi.ErrBadBindings) {
+		t.Fatalf("truncated channel bindings: unexpected error: %v", err)
+	}
+}
+
+func TestContextReq(t *testing.T) {
+
+	caps := gssapi.Integrity | gssapi.Confidentiality | gssapi.MutualAuthn | gssapi.DCEStyle
+
+	if req := contextReq(caps, false); req != reqAllocateMemory|reqConnection|reqMutualAuth|reqConfidentiality|reqUseDCEStyle|iscReqIntegrity {
+		t.Fatalf("unexpected isc req: %08x", req)
+	}
+
+	if req := contextReq(caps, true); req != reqAllocateMemory|reqConnection|reqMutualAuth|reqConfidentiality|reqUseDCEStyle|ascReqIntegrity {
+		t.Fatalf("unexpected asc req: %08x", req)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+
+	if _, _, _, ok, err := identity(credential.Anonymous()); ok || err != nil {
+		t.Fatalf("anonymous: expected logon session: %v", err)
+	}
+
+	user, domain, password, ok, err := identity(credential.NewFromPassword("CONTOSO\\Administrator", "password"))
+	if !ok || err != nil || user != "Administrator" || domain != "CONTOSO" || password != "password" {
+		t.Fatalf("password: unexpected identity: %s %s %v", user, domain, err)
+	}
+
+	if _, _, _, _, err := identity(credential.NewFromNTHash("CONTOSO\\Administrator", "00000000000000000000000000000000")); !errors.Is(err, ErrUnsupportedCredential) {
+		t.Fatalf("nt hash: unexpected error: %v", err)
+	}
+}
+
+func TestProvider(t *testing.T) {
+
+	if !Negotiate.Type().Equal(ssp.MechanismTypeSPNEGO) || !Kerberos.Type().Equal(ssp.MechanismTypeKRB5) || !NTLM.Type().Equal(ssp.MechanismTypeNTLM) {
+		t.Fatalf("unexpected mechanism types")
+	}
+
+	if _, err := (&Provider{Package: "Schannel"}).NewSecurityContext(context.Background(), &external.ContextConfig{}); !errors.Is(err, ErrUnsupportedPackage) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if Available {
+		t.Skip("sspi is available")
+	}
+
+	if _, err := NewMechanism(PackageNegotiate).New(gssapi.NewSecurityContext(context.Background())); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,578 @@
+//go:build windows && !purego
+
+package sspi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/external"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// Available is `true` if the SSPI is available in this build.
+const Available = true
+
+var (
+	modsecur32 = windows.NewLazySystemDLL("secur32.dll")
+
+	procAcquireCredentialsHandleW  = modsecur32.NewProc("AcquireCredentialsHandleW")
+	procFreeCredentialsHandle      = modsecur32.NewProc("FreeCredentialsHandle")
+	procInitializeSecurityContextW = modsecur32.NewProc("InitializeSecurityContextW")
+	procAcceptSecurityContext      = modsecur32.NewProc("AcceptSecurityContext")
+	procCompleteAuthToken          = modsecur32.NewProc("CompleteAuthToken")
+	procDeleteSecurityContext      = modsecur32.NewProc("DeleteSecurityContext")
+	procQueryContextAttributesW    = modsecur32.NewProc("QueryContextAttributesW")
+	procFreeContextBuffer          = modsecur32.NewProc("FreeContextBuffer")
+	procEncryptMessage             = modsecur32.NewProc("EncryptMessage")
+	procDecryptMessage             = modsecur32.NewProc("DecryptMessage")
+	procMakeSignature              = modsecur32.NewProc("MakeSignature")
+	procVerifySignature            = modsecur32.NewProc("VerifySignature")
+)
+
+// The SECURITY_STATUS codes.
+const (
+	secEOK                      = 0x00000000
+	secIContinueNeeded          = 0x00090312
+	secICompleteNeeded          = 0x00090313
+	secICompleteAndContinue     = 0x00090314
+	secEMessageAltered          = 0x8009030F
+	secEOutOfSequence           = 0x80090310
+	secpkgCredInbound           = 0x00000001
+	secpkgCredOutbound          = 0x00000002
+	securityNativeDREP          = 0x00000010
+	secWinNTAuthIdentityUnicode = 0x00000002
+)
+
+// The SecBuffer types.
+const (
+	secBufferVersion              = 0
+	secBufferData                 = 1
+	secBufferToken                = 2
+	secBufferChannelBindings      = 14
+	secBufferReadOnlyWithChecksum = 0x10000000
+	secBufferReadOnly             = 0x80000000
+)
+
+// The SECPKG_ATTR_* context attributes.
+const (
+	secpkgAttrSizes           = 0
+	secpkgAttrSessionKey      = 9
+	secpkgAttrNegotiationInfo = 12
+)
+
+// secHandle is the SecHandle (CredHandle, CtxtHandle) structure.
+type secHandle struct {
+	lower, upper uintptr
+}
+
+// secBuffer is the SecBuffer structure.
+type secBuffer struct {
+	size uint32
+	typ  uint32
+	buf  *byte
+}
+
+// secBufferDesc is the SecBufferDesc structure.
+type secBufferDesc struct {
+	version uint32
+	count   uint32
+	buffers *secBuffer
+}
+
+// secWinNTAuthIdentity is the SEC_WINNT_AUTH_IDENTITY_W structure.
+type secWinNTAuthIdentity struct {
+	user           *uint16
+	userLength     uint32
+	domain         *uint16
+	domainLength   uint32
+	password       *uint16
+	passwordLength uint32
+	flags          uint32
+}
+
+// secPkgContextSizes is the SecPkgContext_Sizes structure.
+type secPkgContextSizes struct {
+	maxToken        uint32
+	maxSignature    uint32
+	blockSize       uint32
+	securityTrailer uint32
+}
+
+// secPkgContextSessionKey is the SecPkgContext_SessionKey structure.
+type secPkgContextSessionKey struct {
+	sessionKeyLength uint32
+	sessionKey       *byte
+}
+
+// secPkgInfo is the SecPkgInfoW structure.
+type secPkgInfo struct {
+	capabilities uint32
+	version      uint16
+	rpcID        uint16
+	maxToken     uint32
+	name         *uint16
+	comment      *uint16
+}
+
+// secPkgContextNegotiationInfo is the SecPkgContext_NegotiationInfoW structure.
+type secPkgContextNegotiationInfo struct {
+	packageInfo      *secPkgInfo
+	negotiationState uint32
+}
+
+// securityContext is the SSPI security context.
+type securityContext struct {
+	// The security package.
+	provider *Provider
+	// The security context configuration.
+	cfg *external.ContextConfig
+	// The credentials handle.
+	cred secHandle
+	// The security context handle.
+	handle secHandle
+	// The flag that indicates whether the security context handle is set.
+	hasHandle bool
+	// The SEC_CHANNEL_BINDINGS structure.
+	bindings []byte
+	// The context attributes returned by the security package.
+	attrs uint32
+	// The security context expiration time.
+	expiry windows.Filetime
+	// The message protection sizes.
+	sizes secPkgContextSizes
+}
+
+// newSecurityContext function acquires the credentials handle for the package
+// and returns the new security context.
+func newSecurityContext(ctx context.Context, p *Provider, cfg *external.ContextConfig) (external.SecurityContext, error) {
+
+	sc := &securityContext{provider: p, cfg: cfg}
+
+	if len(cfg.ChannelBindings) > 0 {
+		b, err := channelBindings(cfg.ChannelBindings)
+		if err != nil {
+			return nil, err
+		}
+		sc.bindings = b
+	}
+
+	if err := sc.acquireCredentials(); err != nil {
+		return nil, err
+	}
+
+	runtime.SetFinalizer(sc, (*securityContext).free)
+
+	return sc, nil
+}
+
+// statusError function converts the SECURITY_STATUS into the error.
+func statusError(fn string, st uintptr) error {
+	switch uint32(st) {
+	case secEMessageAltered, secEOutOfSequence:
+		return fmt.Errorf("sspi: %s: %w: %w", fn, gssapi.ErrBadMIC, windows.Errno(st))
+	}
+	return fmt.Errorf("sspi: %s: %w", fn, windows.Errno(st))
+}
+
+// acquireCredentials function acquires the credentials handle for the
+// explicit credential or for the logon session of the process.
+func (sc *securityContext) acquireCredentials() error {
+
+	user, domain, password, ok, err := identity(sc.cfg.Credential)
+	if err != nil {
+		return err
+	}
+
+	pkg, err := windows.UTF16PtrFromString(sc.provider.Package)
+	if err != nil {
+		return err
+	}
+
+	var authData *secWinNTAuthIdentity
+
+	if ok {
+		authData = &secWinNTAuthIdentity{flags: secWinNTAuthIdentityUnicode}
+		for _, f := range []struct {
+			s   string
+			ptr **uint16
+			len *uint32
+		}{
+			{user, &authData.user, &authData.userLength},
+			{domain, &authData.domain, &authData.domainLength},
+			{password, &authData.password, &authData.passwordLength},
+		} {
+			s, err := windows.UTF16FromString(f.s)
+			if err != nil {
+				return err
+			}
+			*f.ptr, *f.len = &s[0], uint32(len(s)-1)
+		}
+	}
+
+	use := uintptr(secpkgCredOutbound)
+	if sc.cfg.IsServer {
+		use = secpkgCredInbound
+	}
+
+	var expiry windows.Filetime
+
+	st, _, _ := procAcquireCredentialsHandleW.Call(
+		0,
+		uintptr(unsafe.Pointer(pkg)),
+		use,
+		0,
+		uintptr(unsafe.Pointer(authData)),
+		0,
+		0,
+		uintptr(unsafe.Pointer(&sc.cred)),
+		uintptr(unsafe.Pointer(&expiry)),
+	)
+
+	runtime.KeepAlive(authData)
+
+	if st != secEOK {
+		return statusError("acquire credentials handle", st)
+	}
+
+	return nil
+}
+
+// free function releases the security context and credentials handles.
+func (sc *securityContext) free() {
+
+	if sc.hasHandle {
+		procDeleteSecurityContext.Call(uintptr(unsafe.Pointer(&sc.handle)))
+		sc.hasHandle = false
+	}
+
+	if sc.cred != (secHandle{}) {
+		procFreeCredentialsHandle.Call(uintptr(unsafe.Pointer(&sc.cred)))
+		sc.cred = secHandle{}
+	}
+}
+
+// input function returns the input buffers descriptor for the token and
+// the channel bindings, or nil if there is no input.
+func (sc *securityContext) input(in []byte) *secBufferDesc {
+
+	buffers := []secBuffer{}
+
+	if len(in) > 0 {
+		buffers = append(buffers, secBuffer{size: uint32(len(in)), typ: secBufferToken, buf: &in[0]})
+	}
+
+	if len(sc.bindings) > 0 {
+		buffers = append(buffers, secBuffer{size: uint32(len(sc.bindings)), typ: secBufferChannelBindings, buf: &sc.bindings[0]})
+	}
+
+	if len(buffers) == 0 {
+		return nil
+	}
+
+	return &secBufferDesc{version: secBufferVersion, count: uint32(len(buffers)), buffers: &buffers[0]}
+}
+
+// InitSecurityContext function processes the input token with
+// InitializeSecurityContextW.
+func (sc *securityContext) InitSecurityContext(ctx context.Context, in []byte) ([]byte, bool, error) {
+
+	target, err := windows.UTF16PtrFromString(sc.cfg.TargetName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if sc.cfg.TargetName == "" {
+		target = nil
+	}
+
+	var (
+		phContext uintptr
+		out       = secBuffer{typ: secBufferToken}
+		outDesc   = secBufferDesc{version: secBufferVersion, count: 1, buffers: &out}
+		inDesc    = sc.input(in)
+	)
+
+	if sc.hasHandle {
+		phContext = uintptr(unsafe.Pointer(&sc.handle))
+	}
+
+	st, _, _ := procInitializeSecurityContextW.Call(
+		uintptr(unsafe.Pointer(&sc.cred)),
+		phContext,
+		uintptr(unsafe.Pointer(target)),
+		uintptr(contextReq(sc.cfg.Capabilities|sc.provider.Capabilities, false)),
+		0,
+		securityNativeDREP,
+		uintptr(unsafe.Pointer(inDesc)),
+		0,
+		uintptr(unsafe.Pointer(&sc.handle)),
+		uintptr(unsafe.Pointer(&outDesc)),
+		uintptr(unsafe.Pointer(&sc.attrs)),
+		uintptr(unsafe.Pointer(&sc.expiry)),
+	)
+
+	runtime.KeepAlive(in)
+
+	return sc.complete("initialize security context", st, &outDesc)
+}
+
+// AcceptSecurityContext function processes the input token with
+// AcceptSecurityContext.
+func (sc *securityContext) AcceptSecurityContext(ctx context.Context, in []byte) ([]byte, bool, error) {
+
+	var (
+		phContext uintptr
+		out       = secBuffer{typ: secBufferToken}
+		outDesc   = secBufferDesc{version: secBufferVersion, count: 1, buffers: &out}
+		inDesc    = sc.input(in)
+	)
+
+	if sc.hasHandle {
+		phContext = uintptr(unsafe.Pointer(&sc.handle))
+	}
+
+	st, _, _ := procAcceptSecurityContext.Call(
+		uintptr(unsafe.Pointer(&sc.cred)),
+		phContext,
+		uintptr(unsafe.Pointer(inDesc)),
+		uintptr(contextReq(sc.cfg.Capabilities|sc.provider.Capabilities, true)),
+		securityNativeDREP,
+		uintptr(unsafe.Pointer(&sc.handle)),
+		uintptr(unsafe.Pointer(&outDesc)),
+		uintptr(unsafe.Pointer(&sc.attrs)),
+		uintptr(unsafe.Pointer(&sc.expiry)),
+	)
+
+	runtime.KeepAlive(in)
+
+	return sc.complete("accept security context", st, &outDesc)
+}
+
+// complete function processes the status of the security context step,
+// returns the output token and the flag that indicates whether the security
+// context is established.
+func (sc *securityContext) complete(fn string, st uintptr, outDesc *secBufferDesc) ([]byte, bool, error) {
+
+	switch st {
+	case secEOK, secIContinueNeeded, secICompleteNeeded, secICompleteAndContinue:
+		sc.hasHandle = true
+	default:
+		return nil, false, statusError(fn, st)
+	}
+
+	if st == secICompleteNeeded || st == secICompleteAndContinue {
+		if st, _, _ := procCompleteAuthToken.Call(uintptr(unsafe.Pointer(&sc.handle)), uintptr(unsafe.Pointer(outDesc))); st != secEOK {
+			return nil, false, statusError("complete auth token", st)
+		}
+	}
+
+	out := outDesc.buffers
+
+	var b []byte
+
+	if out.buf != nil {
+		b = append([]byte{}, unsafe.Slice(out.buf, out.size)...)
+		procFreeContextBuffer.Call(uintptr(unsafe.Pointer(out.buf)))
+	}
+
+	if st == secIContinueNeeded || st == secICompleteAndContinue {
+		return b, false, nil
+	}
+
+	if st, _, _ := procQueryContextAttributesW.Call(uintptr(unsafe.Pointer(&sc.handle)), secpkgAttrSizes, uintptr(unsafe.Pointer(&sc.sizes))); st != secEOK {
+		return nil, false, statusError("query sizes", st)
+	}
+
+	return b, true, nil
+}
+
+// SignatureSize function returns the size of the signature (the security
+// trailer if `conf` is set).
+func (sc *securityContext) SignatureSize(ctx context.Context, conf bool) int {
+	if conf {
+		return int(sc.sizes.securityTrailer)
+	}
+	return int(sc.sizes.maxSignature)
+}
+
+// messageBuffers function returns the buffers for the payloads and the
+// signature buffer `sgn`. The payload without the gssapi.Integrity capability
+// is not signed, the payload without the gssapi.Confidentiality capability
+// is signed but not encrypted.
+func messageBuffers(payloads []*gssapi.PayloadEx, sgn []byte, seal bool) ([]secBuffer, *secBufferDesc) {
+
+	buffers := make([]secBuffer, 0, len(payloads)+1)
+
+	for _, p := range payloads {
+
+		if len(p.Payload) == 0 {
+			continue
+		}
+
+		typ := uint32(secBufferData)
+
+		switch {
+		case !p.Capabilities.IsSet(gssapi.Integrity):
+			typ |= secBufferReadOnly
+		case seal && !p.Capabilities.IsSet(gssapi.Confidentiality):
+			typ |= secBufferReadOnlyWithChecksum
+		}
+
+		buffers = append(buffers, secBuffer{size: uint32(len(p.Payload)), typ: typ, buf: &p.Payload[0]})
+	}
+
+	buffers = append(buffers, secBuffer{size: uint32(len(sgn)), typ: secBufferToken, buf: &sgn[0]})
+
+	return buffers, &secBufferDesc{version: secBufferVersion, count: uint32(len(buffers)), buffers: &buffers[0]}
+}
+
+// Wrap function encrypts the payloads in place with EncryptMessage.
+func (sc *securityContext) Wrap(ctx context.Context, payloads []*gssapi.PayloadEx) ([]byte, error) {
+
+	sgn := make([]byte, max(sc.sizes.securityTrailer, 1))
+
+	buffers, desc := messageBuffers(payloads, sgn, true)
+
+	st, _, _ := procEncryptMessage.Call(uintptr(unsafe.Pointer(&sc.handle)), 0, uintptr(unsafe.Pointer(desc)), 0)
+
+	runtime.KeepAlive(payloads)
+
+	if st != secEOK {
+		return nil, statusError("encrypt message", st)
+	}
+
+	return sgn[:buffers[len(buffers)-1].size], nil
+}
+
+// Unwrap function decrypts the payloads in place with DecryptMessage.
+func (sc *securityContext) Unwrap(ctx context.Context, payloads []*gssapi.PayloadEx, signature []byte) error {
+
+	if len(signature) == 0 {
+		return gssapi.ErrBadMIC
+	}
+
+	_, desc := messageBuffers(payloads, signature, true)
+
+	var qop uint32
+
+	st, _, _ := procDecryptMessage.Call(uintptr(unsafe.Pointer(&sc.handle)), uintptr(unsafe.Pointer(desc)), 0, uintptr(unsafe.Pointer(&qop)))
+
+	runtime.KeepAlive(payloads)
+	runtime.KeepAlive(signature)
+
+	if st != secEOK {
+		return statusError("decrypt message", st)
+	}
+
+	return nil
+}
+
+// MakeSignature function returns the signature for the payloads with
+// MakeSignature.
+func (sc *securityContext) MakeSignature(ctx context.Context, payloads []*gssapi.PayloadEx) ([]byte, error) {
+
+	sgn := make([]byte, max(sc.sizes.maxSignature, 1))
+
+	buffers, desc := messageBuffers(payloads, sgn, false)
+
+	st, _, _ := procMakeSignature.Call(uintptr(unsafe.Pointer(&sc.handle)), 0, uintptr(unsafe.Pointer(desc)), 0)
+
+	runtime.KeepAlive(payloads)
+
+	if st != secEOK {
+		return nil, statusError("make signature", st)
+	}
+
+	return sgn[:buffers[len(buffers)-1].size], nil
+}
+
+// VerifySignature function verifies the signature for the payloads with
+// VerifySignature.
+func (sc *securityContext) VerifySignature(ctx context.Context, payloads []*gssapi.PayloadEx, signature []byte) error {
+
+	if len(signature) == 0 {
+		return gssapi.ErrBadMIC
+	}
+
+	_, desc := messageBuffers(payloads, signature, false)
+
+	var qop uint32
+
+	st, _, _ := procVerifySignature.Call(uintptr(unsafe.Pointer(&sc.handle)), uintptr(unsafe.Pointer(desc)), 0, uintptr(unsafe.Pointer(&qop)))
+
+	runtime.KeepAlive(payloads)
+	runtime.KeepAlive(signature)
+
+	if st != secEOK {
+		return statusError("verify signature", st)
+	}
+
+	return nil
+}
+
+// QueryAttributes function returns the session key, the negotiated mechanism
+// type and, for Kerberos, the server principal and the expiration time.
+func (sc *securityContext) QueryAttributes(ctx context.Context) map[string]any {
+
+	attrs := map[string]any{
+		gssapi.AttributeMutualAuthn: sc.attrs&reqMutualAuth != 0,
+		gssapi.AttributeAnonymous:   sc.attrs&iscRetNullSession != 0,
+	}
+
+	var key secPkgContextSessionKey
+
+	if st, _, _ := procQueryContextAttributesW.Call(uintptr(unsafe.Pointer(&sc.handle)), secpkgAttrSessionKey, uintptr(unsafe.Pointer(&key))); st == secEOK {
+		if key.sessionKey != nil {
+			attrs[gssapi.AttributeSessionKey] = append([]byte{}, unsafe.Slice(key.sessionKey, key.sessionKeyLength)...)
+			procFreeContextBuffer.Call(uintptr(unsafe.Pointer(key.sessionKey)))
+		}
+	}
+
+	mech := sc.provider.Type()
+
+	if sc.provider.Package == PackageNegotiate {
+		if pkg, err := sc.negotiatedPackage(); err == nil {
+			if oid := (&Provider{Package: pkg}).Type(); oid != nil {
+				mech = oid
+			}
+		}
+	}
+
+	attrs[gssapi.AttributeMechanismType] = mech
+
+	if mech.Equal(ssp.MechanismTypeKRB5) {
+		if !sc.cfg.IsServer && sc.cfg.TargetName != "" && sc.attrs&reqMutualAuth != 0 {
+			attrs[gssapi.AttributeServerPrincipal] = sc.cfg.TargetName
+		}
+		attrs[gssapi.AttributeExpiry] = time.Unix(0, sc.expiry.Nanoseconds())
+	}
+
+	return attrs
+}
+
+// negotiatedPackage function returns the name of the package selected by the
+// Negotiate package.
+func (sc *securityContext) negotiatedPackage() (string, error) {
+
+	var info secPkgContextNegotiationInfo
+
+	if st, _, _ := procQueryContextAttributesW.Call(uintptr(unsafe.Pointer(&sc.handle)), secpkgAttrNegotiationInfo, uintptr(unsafe.Pointer(&info))); st != secEOK {
+		return "", statusError("query negotiation info", st)
+	}
+
+	if info.packageInfo == nil {
+		return "", errors.New("sspi: query negotiation info: package info is not set")
+	}
+
+	defer procFreeContextBuffer.Call(uintptr(unsafe.Pointer(info.packageInfo)))
+
+	return windows.UTF16PtrToString(info.packageInfo.name), nil
+}